@@ -0,0 +1,198 @@
+package vain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+
+	verrors "mcquay.me/vain/errors"
+	"mcquay.me/vain/metrics"
+)
+
+// A Dumper is a Storer that can write out its entire contents.
+type Dumper interface {
+	Dump(w io.Writer) error
+}
+
+// A Pinger is a Storer that can report whether it is able to serve requests.
+type Pinger interface {
+	Ping() error
+}
+
+// A Backuper is a Storer that can write a copy of itself while serving
+// requests.
+type Backuper interface {
+	Backup(w io.Writer) error
+}
+
+// A Compacter is a Storer that can reclaim unused space.
+type Compacter interface {
+	Compact() error
+}
+
+// Admin serves operational endpoints that should not be exposed on the
+// public listener.
+type Admin struct {
+	s      *Server
+	db     Storer
+	config interface{}
+}
+
+// NewAdmin populates an Admin for s, adds its routes to sm, and returns it for
+// use. config is rendered as json at /debug/config.
+func NewAdmin(sm *http.ServeMux, s *Server, config interface{}) *Admin {
+	a := &Admin{
+		s:      s,
+		db:     s.db,
+		config: config,
+	}
+	addAdminRoutes(sm, a)
+	return a
+}
+
+func (a *Admin) healthz(w http.ResponseWriter, req *http.Request) {
+	fmt.Fprintf(w, "ok\n")
+}
+
+func (a *Admin) readyz(w http.ResponseWriter, req *http.Request) {
+	if p, ok := a.db.(Pinger); ok {
+		if err := p.Ping(); err != nil {
+			http.Error(w, fmt.Sprintf("store not ready: %v", err), http.StatusServiceUnavailable)
+			return
+		}
+	}
+	fmt.Fprintf(w, "ok\n")
+}
+
+func (a *Admin) dump(w http.ResponseWriter, req *http.Request) {
+	d, ok := a.db.(Dumper)
+	if !ok {
+		http.Error(w, "store does not support dumping", http.StatusNotImplemented)
+		return
+	}
+	w.Header().Set("Content-type", "application/json")
+	if err := d.Dump(w); err != nil {
+		http.Error(w, fmt.Sprintf("problem dumping store: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// backup streams a copy of the store.
+func (a *Admin) backup(w http.ResponseWriter, req *http.Request) {
+	b, ok := a.db.(Backuper)
+	if !ok {
+		http.Error(w, "store does not support backups", http.StatusNotImplemented)
+		return
+	}
+	w.Header().Set("Content-type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="vain-backup.db"`)
+	if err := b.Backup(w); err != nil {
+		log.Printf("problem writing backup: %v", err)
+	}
+}
+
+// compact reclaims unused space in the store; it must be POSTed.
+func (a *Admin) compact(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: POST", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	c, ok := a.db.(Compacter)
+	if !ok {
+		http.Error(w, "store does not support compaction", http.StatusNotImplemented)
+		return
+	}
+	if err := c.Compact(); err != nil {
+		http.Error(w, fmt.Sprintf("problem compacting store: %v", err), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprintf(w, "ok\n")
+}
+
+func (a *Admin) configuration(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(a.config)
+}
+
+func (a *Admin) readOnly(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case "GET":
+	case "POST", "PUT":
+		req.ParseForm()
+		on, err := strconv.ParseBool(req.Form.Get("on"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid value for on: %v", err), http.StatusBadRequest)
+			return
+		}
+		a.s.SetReadOnly(on)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: GET, POST, PUT", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	resp := struct {
+		ReadOnly bool `json:"read_only"`
+	}{
+		ReadOnly: a.s.IsReadOnly(),
+	}
+	w.Header().Set("Content-type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// hooks lists (GET), adds (POST with form values url, secret and optionally
+// namespace) and removes (DELETE with ?id=) webhooks. Hooks added here without
+// a namespace receive events for every package.
+func (a *Admin) hooks(w http.ResponseWriter, req *http.Request) {
+	req.ParseForm()
+	switch req.Method {
+	case "GET":
+		hs := []Hook{}
+		for _, h := range a.db.Hooks() {
+			hs = append(hs, h.public())
+		}
+		writeJSON(w, hs)
+	case "POST":
+		h, err := newHook(a.db, "", namespace(req.Form.Get("namespace")), req.Form.Get("url"), req.Form.Get("secret"), true)
+		if err := verrors.ToHTTP(err); err != nil {
+			http.Error(w, err.Message, err.Code)
+			return
+		}
+		writeJSON(w, h.public())
+	case "DELETE":
+		if err := verrors.ToHTTP(a.db.RemoveHook(req.Form.Get("id"))); err != nil {
+			http.Error(w, err.Message, err.Code)
+			return
+		}
+	default:
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: GET, POST, DELETE", req.Method), http.StatusMethodNotAllowed)
+	}
+}
+
+// deliveries lists the recent deliveries of the hook given by ?id=.
+func (a *Admin) deliveries(w http.ResponseWriter, req *http.Request) {
+	req.ParseForm()
+	writeJSON(w, a.db.Deliveries(req.Form.Get("id"), deliveryEntries))
+}
+
+func addAdminRoutes(sm *http.ServeMux, a *Admin) {
+	sm.Handle("/metrics", metrics.Handler())
+	sm.HandleFunc("/healthz", a.healthz)
+	sm.HandleFunc("/readyz", a.readyz)
+	sm.HandleFunc("/debug/db", a.dump)
+	sm.HandleFunc("/debug/backup", a.backup)
+	sm.HandleFunc("/debug/compact", a.compact)
+	sm.HandleFunc("/debug/config", a.configuration)
+	sm.HandleFunc("/debug/readonly", a.readOnly)
+	sm.HandleFunc("/debug/hooks", a.hooks)
+	sm.HandleFunc("/debug/hooks/deliveries", a.deliveries)
+
+	sm.HandleFunc("/debug/pprof/", pprof.Index)
+	sm.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	sm.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	sm.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	sm.HandleFunc("/debug/pprof/trace", pprof.Trace)
+}
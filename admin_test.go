@@ -0,0 +1,148 @@
+package vain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAdmin(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	p := Package{
+		Vcs:  "git",
+		Repo: "https://example.org/foo",
+		Path: "example.org/foo",
+		Ns:   "foo",
+	}
+	if err := db.AddPackage(p, Edit{}); err != nil {
+		t.Fatalf("couldn't add package %v: %v", p, err)
+	}
+
+	config := struct {
+		Port int
+	}{
+		Port: 4040,
+	}
+	s := NewServer(http.NewServeMux(), db, nil, "", window, true)
+	sm := http.NewServeMux()
+	NewAdmin(sm, s, config)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	for _, route := range []string{"/healthz", "/readyz", "/metrics", "/debug/pprof/"} {
+		resp, err := http.Get(ts.URL + route)
+		if err != nil {
+			t.Fatalf("couldn't GET %s: %v", route, err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Errorf("bad status for %s; got %s, want %s", route, http.StatusText(got), http.StatusText(want))
+		}
+	}
+
+	{
+		resp, err := http.Get(ts.URL + "/debug/db")
+		if err != nil {
+			t.Fatalf("couldn't GET db dump: %v", err)
+		}
+		defer resp.Body.Close()
+		dump := struct {
+			Packages map[string]Package
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&dump); err != nil {
+			t.Fatalf("problem parsing json: %v", err)
+		}
+		if got, want := dump.Packages[p.Path].Repo, p.Repo; got != want {
+			t.Fatalf("dump has wrong repo; got %q, want %q", got, want)
+		}
+	}
+
+	{
+		resp, err := http.Get(ts.URL + "/debug/config")
+		if err != nil {
+			t.Fatalf("couldn't GET config: %v", err)
+		}
+		defer resp.Body.Close()
+		got := struct {
+			Port int
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatalf("problem parsing json: %v", err)
+		}
+		if got != config {
+			t.Fatalf("bad config; got %+v, want %+v", got, config)
+		}
+	}
+}
+
+func TestAdminReadOnly(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	s := NewServer(http.NewServeMux(), db, nil, "", window, true)
+	sm := http.NewServeMux()
+	NewAdmin(sm, s, nil)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	resp, err := http.PostForm(ts.URL+"/debug/readonly", url.Values{"on": {"true"}})
+	if err != nil {
+		t.Fatalf("couldn't POST: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("bad status; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if !s.IsReadOnly() {
+		t.Fatalf("server should be read-only")
+	}
+
+	resp, err = http.PostForm(ts.URL+"/debug/readonly", url.Values{"on": {"bogus"}})
+	if err != nil {
+		t.Fatalf("couldn't POST: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Fatalf("bad status; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if !s.IsReadOnly() {
+		t.Fatalf("server should still be read-only")
+	}
+}
+
+func TestPublicHasNoPprof(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(ts.URL + "/debug/pprof/")
+	if err != nil {
+		t.Fatalf("couldn't GET pprof: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusTemporaryRedirect; got != want {
+		t.Fatalf("pprof should not be served publicly; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+}
@@ -539,7 +539,7 @@ func TestSingleGet(t *testing.T) {
 		Path: fmt.Sprintf("%s/foo/bar", strings.TrimPrefix(ts.URL, "http://")),
 		Ns:   ns,
 	}
-	if err := db.AddPackage(p); err != nil {
+	if err := db.AddPackage(p, Edit{}); err != nil {
 		t.Fatalf("couldn't add package %v: %v", p, err)
 	}
 
@@ -805,3 +805,230 @@ func TestForgot(t *testing.T) {
 		t.Fatalf("pkgs should have something in it; got %d, want %d", got, want)
 	}
 }
+
+func TestMetrics(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+
+	p := Package{
+		Vcs:  "git",
+		Repo: "https://example.org/foo",
+		Path: fmt.Sprintf("%s/foo", strings.TrimPrefix(ts.URL, "http://")),
+		Ns:   "foo",
+	}
+	if err := db.AddPackage(p, Edit{}); err != nil {
+		t.Fatalf("couldn't add package %v: %v", p, err)
+	}
+
+	resp, err := http.Get(ts.URL + "/foo?go-get=1")
+	if err != nil {
+		t.Fatalf("problem getting route: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = http.Get(ts.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("problem getting metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("bad status fetching metrics; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	buf := &bytes.Buffer{}
+	io.Copy(buf, resp.Body)
+
+	for _, want := range []string{
+		fmt.Sprintf(`vain_go_get_total{path=%q} 1`, p.Path),
+		`vain_http_request_duration_seconds_count{code="200",method="get",route="root"}`,
+		`vain_packages 1`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("metrics output missing %q", want)
+		}
+	}
+}
+
+func TestNoMetrics(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true, NoMetrics())
+	ts := httptest.NewServer(sm)
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(ts.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("problem getting metrics: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusTemporaryRedirect; got != want {
+		t.Fatalf("metrics should not be served; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	mm := &mockMail{}
+	s := NewServer(sm, db, mm, "", window, true, ReadOnly())
+	ts := httptest.NewServer(sm)
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Errorf("failure to add user: %v", err)
+	}
+
+	p := Package{
+		Vcs:  "git",
+		Repo: "https://example.org/foo",
+		Path: fmt.Sprintf("%s/foo", strings.TrimPrefix(ts.URL, "http://")),
+		Ns:   "foo",
+	}
+	if err := db.AddPackage(p, Edit{}); err != nil {
+		t.Fatalf("couldn't add package %v: %v", p, err)
+	}
+
+	for _, u := range []string{ts.URL + "/foo?go-get=1", ts.URL + prefix["pkgs"]} {
+		resp, err := http.Get(u)
+		if err != nil {
+			t.Fatalf("couldn't GET %s: %v", u, err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Fatalf("reads should work while read-only; got %s, want %s", http.StatusText(got), http.StatusText(want))
+		}
+	}
+
+	post := func() *http.Response {
+		body := strings.NewReader(`{"repo": "https://s.mcquay.me/sm/vain"}`)
+		req, err := http.NewRequest("POST", ts.URL+"/bar", body)
+		if err != nil {
+			t.Fatalf("couldn't create request: %v", err)
+		}
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("couldn't POST: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	reqs := []struct {
+		method string
+		url    string
+	}{
+		{"DELETE", ts.URL + "/foo"},
+		{"POST", ts.URL + prefix["register"] + "?email=fake@example.com"},
+		{"POST", ts.URL + prefix["forgot"] + "?email=sm@example.org"},
+		{"GET", ts.URL + prefix["confirm"] + string(tok)},
+	}
+	for _, r := range reqs {
+		req, err := http.NewRequest(r.method, r.url, nil)
+		if err != nil {
+			t.Fatalf("couldn't create request: %v", err)
+		}
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("couldn't %s %s: %v", r.method, r.url, err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusServiceUnavailable; got != want {
+			t.Errorf("%s %s should be refused; got %s, want %s", r.method, r.url, http.StatusText(got), http.StatusText(want))
+		}
+	}
+
+	resp := post()
+	if got, want := resp.StatusCode, http.StatusServiceUnavailable; got != want {
+		t.Fatalf("POST should be refused; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if resp.Header.Get("Retry-After") == "" {
+		t.Fatalf("missing Retry-After header")
+	}
+	if got, want := len(db.Pkgs()), 1; got != want {
+		t.Fatalf("pkgs should not have changed; got %d, want %d", got, want)
+	}
+
+	s.SetReadOnly(false)
+	if got, want := post().StatusCode, http.StatusOK; got != want {
+		t.Fatalf("POST should work after leaving read-only; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if got, want := len(db.Pkgs()), 2; got != want {
+		t.Fatalf("pkgs should have grown; got %d, want %d", got, want)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	resp := hookReq(t, "PATCH", ts.URL+"/foo/bar", tok, `{"repo": "https://example.org/bar"}`)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Fatalf("patch of missing package; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+
+	resp = hookReq(t, "POST", ts.URL+"/foo/bar", tok, `{"vcs": "hg", "repo": "https://example.org/bar"}`)
+	resp.Body.Close()
+	resp = hookReq(t, "PATCH", ts.URL+"/foo/bar", tok, `{"repo": "https://example.org/moved"}`)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("couldn't patch; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	p, err := db.Package(host + "/foo/bar")
+	if err != nil {
+		t.Fatalf("couldn't find package: %v", err)
+	}
+	if p.Repo != "https://example.org/moved" || p.Vcs != "hg" {
+		t.Fatalf("bad package after patch: %+v", p)
+	}
+
+	resp = hookReq(t, "PATCH", ts.URL+"/foo/bar", tok, `{"vcs": "cvs"}`)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Fatalf("invalid patch; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+
+	other, err := db.addUser("other@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	resp = hookReq(t, "PATCH", ts.URL+"/foo/bar", other, `{"repo": "https://evil.example.org/bar"}`)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusUnauthorized; got != want {
+		t.Fatalf("patch of someone else's package; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+}
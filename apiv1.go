@@ -0,0 +1,334 @@
+package vain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+const apiV1 = "/api/v1/"
+
+// apiError is the body of every unsuccessful v1 response:
+//
+//	{"error": {"status": 404, "code": "not_found", "message": "..."}}
+type apiError struct {
+	Error apiErrorBody `json:"error"`
+}
+
+type apiErrorBody struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Rows lists the invalid entries of a bulk import.
+	Rows []RowError `json:"rows,omitempty"`
+}
+
+// errorCode returns the machine-readable code for an http status, e.g.
+// "not_found" for 404.
+func errorCode(status int) string {
+	t := http.StatusText(status)
+	if t == "" {
+		return "unknown"
+	}
+	return strings.ToLower(strings.Replace(t, " ", "_", -1))
+}
+
+// apiFail writes err as a v1 error object.
+func apiFail(w http.ResponseWriter, err *verrors.HTTP) {
+	w.Header().Set("Content-type", "application/json")
+	w.WriteHeader(err.Code)
+	json.NewEncoder(w).Encode(apiError{apiErrorBody{
+		Status:  err.Code,
+		Code:    errorCode(err.Code),
+		Message: err.Message,
+	}})
+}
+
+func apiFailf(w http.ResponseWriter, code int, format string, args ...interface{}) {
+	apiFail(w, &verrors.HTTP{Message: fmt.Sprintf(format, args...), Code: code})
+}
+
+// apiRefuse is refuse for the v1 api.
+func (s *Server) apiRefuse(w http.ResponseWriter) bool {
+	if !s.IsReadOnly() {
+		return false
+	}
+	w.Header().Set("Retry-After", fmt.Sprintf("%d", int(retryAfter/time.Second)))
+	apiFailf(w, http.StatusServiceUnavailable, "server is in read-only maintenance mode; try again later")
+	return true
+}
+
+// apiUser returns the user owning the request's bearer token, writing an
+// error if there is none.
+func apiUser(w http.ResponseWriter, req *http.Request, db Storer) (Email, bool) {
+	tok := bearer(req)
+	if tok == "" {
+		apiFailf(w, http.StatusUnauthorized, "missing token")
+		return "", false
+	}
+	e, err := db.UserForToken(tok)
+	if err := verrors.ToHTTP(err); err != nil {
+		if err.Code == http.StatusNotFound {
+			err.Code = http.StatusUnauthorized
+		}
+		apiFail(w, err)
+		return "", false
+	}
+	return e, true
+}
+
+func apiMethods(w http.ResponseWriter, req *http.Request, accepted string) {
+	w.Header().Set("Allow", accepted)
+	apiFailf(w, http.StatusMethodNotAllowed, "unsupported method %q; accepted: %s", req.Method, accepted)
+}
+
+// apiNotFound answers every v1 path that isn't a resource.
+func apiNotFound(w http.ResponseWriter, req *http.Request) {
+	apiFailf(w, http.StatusNotFound, "no such resource %q", req.URL.Path)
+}
+
+// apiPackages serves packages:
+//
+//	GET    packages/        list packages; see parseQuery
+//	GET    packages/{path}  get a package
+//	PUT    packages/{path}  create or replace a package
+//	PATCH  packages/{path}  change some fields of a package
+//	DELETE packages/{path}  remove a package
+//
+// {path} is the import path, with or without the host.
+func (s *Server) apiPackages(w http.ResponseWriter, req *http.Request) {
+	db := s.store(req.Context())
+	rest := strings.Trim(strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(prefix["v1-packages"], "/")), "/")
+	rest = strings.TrimPrefix(rest, req.Host+"/")
+
+	if rest == "" {
+		if req.Method != "GET" {
+			apiMethods(w, req, "GET")
+			return
+		}
+		q, err := parseQuery(req)
+		if err := verrors.ToHTTP(err); err != nil {
+			apiFail(w, err)
+			return
+		}
+		if q.Limit == 0 {
+			q.Limit = defaultPageSize
+		}
+		if q.Limit > maxPageSize {
+			q.Limit = maxPageSize
+		}
+		page, err := db.QueryPackages(q)
+		if err := verrors.ToHTTP(err); err != nil {
+			apiFail(w, err)
+			return
+		}
+		writeJSON(w, page)
+		return
+	}
+
+	pth := req.Host + "/" + rest
+	if req.Method == "GET" {
+		p, err := db.Package(pth)
+		if err != nil || p.Path != pth {
+			apiFailf(w, http.StatusNotFound, "package %q not found", pth)
+			return
+		}
+		writeJSON(w, p)
+		return
+	}
+
+	switch req.Method {
+	case "PUT", "PATCH", "DELETE":
+	default:
+		apiMethods(w, req, "GET, PUT, PATCH, DELETE")
+		return
+	}
+	if s.apiRefuse(w) {
+		return
+	}
+	e, ok := apiUser(w, req, db)
+	if !ok {
+		return
+	}
+	ns, err := parseNamespace(rest)
+	if err != nil {
+		apiFailf(w, http.StatusBadRequest, "could not parse namespace: %v", err)
+		return
+	}
+	if err := verrors.ToHTTP(db.NSForToken(ns, bearer(req))); err != nil {
+		apiFail(w, err)
+		return
+	}
+
+	cur, err := db.Package(pth)
+	exists := err == nil && cur.Path == pth
+	switch req.Method {
+	case "PUT":
+		p := Package{}
+		if err := json.NewDecoder(req.Body).Decode(&p); err != nil {
+			apiFailf(w, http.StatusBadRequest, "unable to parse json from body: %v", err)
+			return
+		}
+		p.Path = pth
+		p.Ns = ns
+		status, op := http.StatusCreated, addPackage
+		if exists {
+			status, op = http.StatusOK, updatePackage
+		}
+		if err := verrors.ToHTTP(op(db, e, p)); err != nil {
+			apiFail(w, err)
+			return
+		}
+		p, _ = db.Package(pth)
+		w.Header().Set("Content-type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(p)
+	case "PATCH":
+		if !exists {
+			apiFailf(w, http.StatusNotFound, "package %q not found", pth)
+			return
+		}
+		p := cur
+		if err := json.NewDecoder(req.Body).Decode(&p); err != nil {
+			apiFailf(w, http.StatusBadRequest, "unable to parse json from body: %v", err)
+			return
+		}
+		p.Path = pth
+		p.Ns = ns
+		if err := verrors.ToHTTP(updatePackage(db, e, p)); err != nil {
+			apiFail(w, err)
+			return
+		}
+		p, _ = db.Package(pth)
+		writeJSON(w, p)
+	case "DELETE":
+		if err := verrors.ToHTTP(removePackage(db, e, pth)); err != nil {
+			apiFail(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Namespace is a namespace as listed by the v1 api.
+type Namespace struct {
+	Name     namespace `json:"name"`
+	Packages int       `json:"packages"`
+}
+
+// apiNamespaces lists the namespaces owned by the user.
+func (s *Server) apiNamespaces(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		apiMethods(w, req, "GET")
+		return
+	}
+	db := s.store(req.Context())
+	e, ok := apiUser(w, req, db)
+	if !ok {
+		return
+	}
+	counts := map[namespace]int{}
+	for _, p := range db.Pkgs() {
+		counts[pkgNS(p)]++
+	}
+	nss := []Namespace{}
+	for _, ns := range db.UserNamespaces(e) {
+		nss = append(nss, Namespace{Name: ns, Packages: counts[ns]})
+	}
+	writeJSON(w, nss)
+}
+
+// Me describes the user owning a token.
+type Me struct {
+	Email      Email       `json:"email"`
+	Namespaces []namespace `json:"namespaces"`
+	Tokens     int         `json:"tokens"`
+}
+
+// apiMe describes the user owning the bearer token.
+func (s *Server) apiMe(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		apiMethods(w, req, "GET")
+		return
+	}
+	db := s.store(req.Context())
+	e, ok := apiUser(w, req, db)
+	if !ok {
+		return
+	}
+	writeJSON(w, Me{
+		Email:      e,
+		Namespaces: db.UserNamespaces(e),
+		Tokens:     len(db.Tokens(e)),
+	})
+}
+
+// apiTokens manages the user's tokens:
+//
+//	GET    tokens        list tokens
+//	POST   tokens        create a token
+//	DELETE tokens/{tok}  revoke a token
+func (s *Server) apiTokens(w http.ResponseWriter, req *http.Request) {
+	db := s.store(req.Context())
+	e, ok := apiUser(w, req, db)
+	if !ok {
+		return
+	}
+	rest := Token(strings.Trim(strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(prefix["v1-tokens"], "/")), "/"))
+	switch {
+	case rest == "" && req.Method == "GET":
+		writeJSON(w, db.Tokens(e))
+	case rest == "" && req.Method == "POST":
+		if s.apiRefuse(w) {
+			return
+		}
+		nt, err := db.AddToken(e)
+		if err := verrors.ToHTTP(err); err != nil {
+			apiFail(w, err)
+			return
+		}
+		audit(db, e, "token-add", "", "")
+		w.Header().Set("Content-type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(struct {
+			Token Token `json:"token"`
+		}{nt})
+	case rest == "":
+		apiMethods(w, req, "GET, POST")
+	case req.Method == "DELETE":
+		if s.apiRefuse(w) {
+			return
+		}
+		if err := verrors.ToHTTP(db.RevokeToken(e, rest)); err != nil {
+			apiFail(w, err)
+			return
+		}
+		audit(db, e, "token-revoke", "", "")
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		apiMethods(w, req, "DELETE")
+	}
+}
+
+func addV1Routes(sm *http.ServeMux, s *Server) {
+	// resources are served with and without a trailing slash so that
+	// clients aren't redirected
+	handle := func(route, p string, h http.HandlerFunc) {
+		sm.Handle(p, instrument(route, h))
+		if t := strings.TrimSuffix(p, "/"); t != p {
+			sm.Handle(t, instrument(route, h))
+		}
+	}
+	handle("v1", prefix["v1"], apiNotFound)
+	handle("v1-packages", prefix["v1-packages"], s.apiPackages)
+	handle("v1-namespaces", prefix["v1-namespaces"], s.apiNamespaces)
+	handle("v1-me", prefix["v1-me"], s.apiMe)
+	handle("v1-tokens", prefix["v1-tokens"], s.apiTokens)
+	handle("v1-bulk", prefix["v1-bulk"], s.apiBulk)
+	handle("v1-history", prefix["v1-history"], s.apiHistory)
+	handle("v1-rollback", prefix["v1-rollback"], s.apiHistory)
+}
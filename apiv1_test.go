@@ -0,0 +1,204 @@
+package vain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// apiErr decodes a v1 error response, checking its status and code.
+func apiErr(t *testing.T, resp *http.Response, status int, code string) apiErrorBody {
+	t.Helper()
+	defer resp.Body.Close()
+	if got, want := resp.StatusCode, status; got != want {
+		t.Fatalf("status: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if got, want := resp.Header.Get("Content-Type"), "application/json"; got != want {
+		t.Fatalf("content type: got %q, want %q", got, want)
+	}
+	e := apiError{}
+	if err := json.NewDecoder(resp.Body).Decode(&e); err != nil {
+		t.Fatalf("couldn't decode error: %v", err)
+	}
+	if got, want := e.Error.Status, status; got != want {
+		t.Fatalf("error status: got %d, want %d", got, want)
+	}
+	if got, want := e.Error.Code, code; got != want {
+		t.Fatalf("error code: got %q, want %q", got, want)
+	}
+	return e.Error
+}
+
+func TestV1Packages(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	s := NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+	u := ts.URL + prefix["v1-packages"]
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	other, err := db.addUser("other@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+
+	apiErr(t, hookReq(t, "PUT", u+"sm/foo", "", `{"repo": "https://example.org/foo"}`), http.StatusUnauthorized, "unauthorized")
+	apiErr(t, hookReq(t, "PUT", u+"sm/foo", "bogus", `{"repo": "https://example.org/foo"}`), http.StatusUnauthorized, "unauthorized")
+	apiErr(t, hookReq(t, "PUT", u+"sm/foo", tok, `{"repo": ""}`), http.StatusBadRequest, "bad_request")
+	apiErr(t, hookReq(t, "PUT", u+"sm/foo", tok, `{`), http.StatusBadRequest, "bad_request")
+
+	resp := hookReq(t, "PUT", u+"sm/foo", tok, `{"repo": "https://example.org/foo", "vcs": "hg"}`)
+	if got, want := resp.StatusCode, http.StatusCreated; got != want {
+		t.Fatalf("create: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	p := Package{}
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		t.Fatalf("couldn't decode package: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := p.Path, host+"/sm/foo"; got != want {
+		t.Fatalf("path: got %q, want %q", got, want)
+	}
+
+	apiErr(t, hookReq(t, "PUT", u+"sm/foo/bar", tok, `{"repo": "https://example.org/bar"}`), http.StatusConflict, "conflict")
+	apiErr(t, hookReq(t, "PUT", u+"sm/bar", other, `{"repo": "https://example.org/bar"}`), http.StatusUnauthorized, "unauthorized")
+
+	// replace, addressed by the full import path
+	resp = hookReq(t, "PUT", u+host+"/sm/foo", tok, `{"repo": "https://example.org/foo2"}`)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("replace: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if p, _ := db.Package(host + "/sm/foo"); p.Vcs != "git" || p.Repo != "https://example.org/foo2" {
+		t.Fatalf("replace left %+v", p)
+	}
+
+	resp = hookReq(t, "PATCH", u+"sm/foo", tok, `{"landing": "page"}`)
+	p = Package{}
+	json.NewDecoder(resp.Body).Decode(&p)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("patch: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if p.Landing != "page" || p.Repo != "https://example.org/foo2" {
+		t.Fatalf("patch returned %+v", p)
+	}
+	apiErr(t, hookReq(t, "PATCH", u+"sm/nope", tok, `{}`), http.StatusNotFound, "not_found")
+
+	resp = hookReq(t, "GET", u+"sm/foo", "", "")
+	p = Package{}
+	json.NewDecoder(resp.Body).Decode(&p)
+	resp.Body.Close()
+	if got, want := p.Repo, "https://example.org/foo2"; got != want {
+		t.Fatalf("get: got %q, want %q", got, want)
+	}
+	apiErr(t, hookReq(t, "GET", u+"sm/foo/sub", "", ""), http.StatusNotFound, "not_found")
+	apiErr(t, hookReq(t, "POST", u+"sm/foo", tok, ""), http.StatusMethodNotAllowed, "method_not_allowed")
+	apiErr(t, hookReq(t, "POST", u, tok, ""), http.StatusMethodNotAllowed, "method_not_allowed")
+
+	for _, l := range []string{u, strings.TrimSuffix(u, "/")} {
+		resp = hookReq(t, "GET", l, "", "")
+		page := Page{}
+		json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if got, want := len(page.Packages), 1; got != want {
+			t.Fatalf("list %s: got %d packages, want %d", l, got, want)
+		}
+	}
+
+	s.SetReadOnly(true)
+	resp = hookReq(t, "DELETE", u+"sm/foo", tok, "")
+	if resp.Header.Get("Retry-After") == "" {
+		t.Fatalf("missing Retry-After")
+	}
+	apiErr(t, resp, http.StatusServiceUnavailable, "service_unavailable")
+	s.SetReadOnly(false)
+
+	resp = hookReq(t, "DELETE", u+"sm/foo", tok, "")
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Fatalf("delete: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	apiErr(t, hookReq(t, "DELETE", u+"sm/foo", tok, ""), http.StatusNotFound, "not_found")
+	apiErr(t, hookReq(t, "GET", ts.URL+"/api/v1/nope", "", ""), http.StatusNotFound, "not_found")
+}
+
+func TestV1Users(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	for _, pth := range []string{"sm/a", "sm/b", "mc/c"} {
+		if err := db.NSForToken(namespace(strings.Split(pth, "/")[0]), tok); err != nil {
+			t.Fatalf("couldn't claim namespace: %v", err)
+		}
+		if err := db.AddPackage(Package{Vcs: "git", Repo: "https://example.org/" + pth, Path: host + "/" + pth}, Edit{}); err != nil {
+			t.Fatalf("couldn't add package: %v", err)
+		}
+	}
+
+	apiErr(t, hookReq(t, "GET", ts.URL+prefix["v1-me"], "", ""), http.StatusUnauthorized, "unauthorized")
+
+	resp := hookReq(t, "GET", ts.URL+prefix["v1-me"], tok, "")
+	me := Me{}
+	if err := json.NewDecoder(resp.Body).Decode(&me); err != nil {
+		t.Fatalf("couldn't decode user: %v", err)
+	}
+	resp.Body.Close()
+	if me.Email != "sm@example.org" || me.Tokens != 1 || len(me.Namespaces) != 2 {
+		t.Fatalf("users/me: got %+v", me)
+	}
+
+	resp = hookReq(t, "GET", ts.URL+prefix["v1-namespaces"], tok, "")
+	nss := []Namespace{}
+	if err := json.NewDecoder(resp.Body).Decode(&nss); err != nil {
+		t.Fatalf("couldn't decode namespaces: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := len(nss), 2; got != want {
+		t.Fatalf("namespaces: got %d, want %d", got, want)
+	}
+	if nss[0].Name != "mc" || nss[0].Packages != 1 || nss[1].Name != "sm" || nss[1].Packages != 2 {
+		t.Fatalf("namespaces: got %+v", nss)
+	}
+
+	resp = hookReq(t, "POST", ts.URL+strings.TrimSuffix(prefix["v1-tokens"], "/"), tok, "")
+	nt := struct {
+		Token Token `json:"token"`
+	}{}
+	json.NewDecoder(resp.Body).Decode(&nt)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusCreated; got != want {
+		t.Fatalf("new token: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	resp = hookReq(t, "DELETE", ts.URL+prefix["v1-tokens"]+string(nt.Token), tok, "")
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Fatalf("revoke: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	apiErr(t, hookReq(t, "DELETE", ts.URL+prefix["v1-tokens"]+string(tok), tok, ""), http.StatusConflict, "conflict")
+}
@@ -0,0 +1,1072 @@
+package vain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	verrors "mcquay.me/vain/errors"
+	"mcquay.me/vain/metrics"
+)
+
+func init() {
+	RegisterBackend("bolt", func(dsn string) (Storer, error) {
+		db, err := NewBoltDB(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	})
+}
+
+// Buckets of a BoltDB. Users, tokens, namespaces and packages are keyed by
+// email, token, namespace and path; audit entries by sequence; hooks by id;
+// deliveries by sequence in a bucket per hook; and revisions by number in a
+// bucket per path. Counts holds the number of users, namespaces and packages,
+// keyed by their bucket's name.
+var (
+	bucketUsers      = []byte("users")
+	bucketTokens     = []byte("tokens")
+	bucketNamespaces = []byte("namespaces")
+	bucketPackages   = []byte("packages")
+	bucketAudit      = []byte("audit")
+	bucketHooks      = []byte("hooks")
+	bucketDeliveries = []byte("deliveries")
+	bucketRevisions  = []byte("revisions")
+	bucketCounts     = []byte("counts")
+)
+
+// counted are the buckets whose keys are counted in bucketCounts.
+var counted = [][]byte{bucketUsers, bucketNamespaces, bucketPackages}
+
+// boltUser is a User as stored in a BoltDB.
+type boltUser struct {
+	Email      Email     `json:"email"`
+	Token      Token     `json:"token"`
+	Registered bool      `json:"registered"`
+	Requested  time.Time `json:"requested"`
+	Disabled   bool      `json:"disabled,omitempty"`
+}
+
+// BoltDB is a Storer kept in a bbolt file. Unlike MemDB each change is
+// written on its own rather than rewriting the whole file.
+type BoltDB struct {
+	filename string
+
+	// l guards db, which is replaced by Compact.
+	l  sync.RWMutex
+	db *bolt.DB
+
+	wl       sync.Mutex
+	watchers []func(Change)
+}
+
+// NewBoltDB opens, creating if needed, the bbolt file at p. It fails if
+// another process has it open.
+func NewBoltDB(p string) (*BoltDB, error) {
+	b := &BoltDB{filename: p}
+	if err := b.open(); err != nil {
+		return nil, err
+	}
+	return b, b.update(func(tx *bolt.Tx) error { return nil })
+}
+
+func (b *BoltDB) open() error {
+	db, err := bolt.Open(b.filename, 0600, &bolt.Options{Timeout: time.Second})
+	if err == bolt.ErrTimeout {
+		return fmt.Errorf("%s is in use by another process", b.filename)
+	}
+	if err != nil {
+		return err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{bucketUsers, bucketTokens, bucketNamespaces, bucketPackages, bucketAudit, bucketHooks, bucketDeliveries, bucketRevisions} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		if tx.Bucket(bucketCounts) != nil {
+			return nil
+		}
+		// files from before counts were kept are counted once
+		cs, err := tx.CreateBucket(bucketCounts)
+		if err != nil {
+			return err
+		}
+		for _, name := range counted {
+			if err := cs.Put(name, itob(uint64(tx.Bucket(name).Stats().KeyN))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return err
+	}
+	b.db = db
+	return nil
+}
+
+// Close closes the file.
+func (b *BoltDB) Close() error {
+	b.l.Lock()
+	defer b.l.Unlock()
+	return b.db.Close()
+}
+
+func (b *BoltDB) view(fn func(tx *bolt.Tx) error) error {
+	b.l.RLock()
+	defer b.l.RUnlock()
+	return b.db.View(fn)
+}
+
+// update runs fn in a write transaction, then updates the object count
+// metrics.
+func (b *BoltDB) update(fn func(tx *bolt.Tx) error) error {
+	defer metrics.DBTime("update")()
+	b.l.RLock()
+	defer b.l.RUnlock()
+	if err := b.db.Update(fn); err != nil {
+		return err
+	}
+	return b.db.View(func(tx *bolt.Tx) error {
+		cs := tx.Bucket(bucketCounts)
+		metrics.Packages.Set(float64(binary.BigEndian.Uint64(cs.Get(bucketPackages))))
+		metrics.Users.Set(float64(binary.BigEndian.Uint64(cs.Get(bucketUsers))))
+		metrics.Namespaces.Set(float64(binary.BigEndian.Uint64(cs.Get(bucketNamespaces))))
+		return nil
+	})
+}
+
+// itob encodes n as a key that sorts in numeric order.
+func itob(n uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, n)
+	return k
+}
+
+// get decodes the json at key in bucket, reporting whether it was found.
+func get(tx *bolt.Tx, bucket []byte, key string, v interface{}) (bool, error) {
+	buf := tx.Bucket(bucket).Get([]byte(key))
+	if buf == nil {
+		return false, nil
+	}
+	return true, json.Unmarshal(buf, v)
+}
+
+func put(tx *bolt.Tx, bucket []byte, key string, v interface{}) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return set(tx, bucket, []byte(key), buf)
+}
+
+// set stores v at key in bucket, and del removes key, keeping bucketCounts
+// current.
+func set(tx *bolt.Tx, bucket, key, v []byte) error {
+	bk := tx.Bucket(bucket)
+	if bk.Get(key) == nil {
+		if err := count(tx, bucket, 1); err != nil {
+			return err
+		}
+	}
+	return bk.Put(key, v)
+}
+
+func del(tx *bolt.Tx, bucket, key []byte) error {
+	bk := tx.Bucket(bucket)
+	if bk.Get(key) != nil {
+		if err := count(tx, bucket, -1); err != nil {
+			return err
+		}
+	}
+	return bk.Delete(key)
+}
+
+// count adds d to the count of bucket's keys, if they are counted.
+func count(tx *bolt.Tx, bucket []byte, d int) error {
+	for _, name := range counted {
+		if bytes.Equal(name, bucket) {
+			cs := tx.Bucket(bucketCounts)
+			n := int(binary.BigEndian.Uint64(cs.Get(bucket))) + d
+			return cs.Put(bucket, itob(uint64(n)))
+		}
+	}
+	return nil
+}
+
+// seqKey returns the key for the next entry in an append-only bucket.
+func seqKey(bk *bolt.Bucket) ([]byte, error) {
+	n, err := bk.NextSequence()
+	if err != nil {
+		return nil, err
+	}
+	return itob(n), nil
+}
+
+// trim deletes the oldest entries of an append-only bucket, keeping the last
+// max.
+func trim(bk *bolt.Bucket, max int) error {
+	old := [][]byte{}
+	c := bk.Cursor()
+	for k, _ := c.First(); k != nil && binary.BigEndian.Uint64(k)+uint64(max) <= bk.Sequence(); k, _ = c.Next() {
+		old = append(old, k)
+	}
+	for _, k := range old {
+		if err := bk.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tokenUser returns the user holding tok.
+func tokenUser(tx *bolt.Tx, tok Token) (boltUser, error) {
+	u := boltUser{}
+	e := tx.Bucket(bucketTokens).Get([]byte(tok))
+	if e == nil {
+		return u, verrors.HTTP{
+			Message: fmt.Sprintf("User for token %q not found", tok),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if _, err := get(tx, bucketUsers, string(e), &u); err != nil {
+		return u, err
+	}
+	u.Email = Email(e)
+	if u.Disabled {
+		return u, disabled(u.Email)
+	}
+	return u, nil
+}
+
+// NSForToken creates an entry namespaces with a relation to the token.
+func (b *BoltDB) NSForToken(ns namespace, tok Token) error {
+	return b.update(func(tx *bolt.Tx) error {
+		u, err := tokenUser(tx, tok)
+		if err != nil {
+			return err
+		}
+		nss := tx.Bucket(bucketNamespaces)
+		owner := nss.Get([]byte(ns))
+		if owner == nil {
+			return set(tx, bucketNamespaces, []byte(ns), []byte(u.Email))
+		}
+		if Email(owner) != u.Email {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("not authorized against namespace %q", ns),
+				Code:    http.StatusUnauthorized,
+			}
+		}
+		return nil
+	})
+}
+
+// UserNamespaces returns the namespaces owned by e.
+func (b *BoltDB) UserNamespaces(e Email) []namespace {
+	nss := []namespace{}
+	b.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketNamespaces).ForEach(func(k, v []byte) error {
+			if Email(v) == e {
+				nss = append(nss, namespace(k))
+			}
+			return nil
+		})
+	})
+	return nss
+}
+
+// Package fetches the package associated with path, or the package whose path
+// is its longest prefix.
+func (b *BoltDB) Package(pth string) (Package, error) {
+	p := Package{}
+	found := false
+	err := b.view(func(tx *bolt.Tx) error {
+		elems := strings.Split(pth, "/")
+		for i := len(elems); i > 0 && !found; i-- {
+			var err error
+			if found, err = get(tx, bucketPackages, strings.Join(elems[:i], "/"), &p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil && !found {
+		err = verrors.HTTP{
+			Message: fmt.Sprintf("couldn't find package %q", pth),
+			Code:    http.StatusNotFound,
+		}
+	}
+	return p, err
+}
+
+// AddPackage adds p into packages table.
+func (b *BoltDB) AddPackage(p Package, ed Edit) error {
+	err := b.update(func(tx *bolt.Tx) error {
+		if err := put(tx, bucketPackages, p.Path, p); err != nil {
+			return err
+		}
+		_, err := addRevision(tx, ed.revision(actionCreate, p))
+		return err
+	})
+	if err == nil {
+		b.notify(Change{Action: actionCreate, Package: p})
+	}
+	return err
+}
+
+// UpdatePackage replaces the package stored at p.Path.
+func (b *BoltDB) UpdatePackage(p Package, ed Edit) error {
+	err := b.update(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketPackages).Get([]byte(p.Path)) == nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("package %q not found", p.Path),
+				Code:    http.StatusNotFound,
+			}
+		}
+		if err := put(tx, bucketPackages, p.Path, p); err != nil {
+			return err
+		}
+		_, err := addRevision(tx, ed.revision(actionUpdate, p))
+		return err
+	})
+	if err == nil {
+		b.notify(Change{Action: actionUpdate, Package: p})
+	}
+	return err
+}
+
+// RemovePackage removes package with given path
+func (b *BoltDB) RemovePackage(pth path, ed Edit) error {
+	p := Package{}
+	found := false
+	err := b.update(func(tx *bolt.Tx) error {
+		var err error
+		if found, err = get(tx, bucketPackages, string(pth), &p); err != nil || !found {
+			return err
+		}
+		if err := del(tx, bucketPackages, []byte(pth)); err != nil {
+			return err
+		}
+		_, err = addRevision(tx, ed.revision(actionDelete, p))
+		return err
+	})
+	if err == nil && found {
+		b.notify(Change{Action: actionDelete, Package: p})
+	}
+	return err
+}
+
+// Watch registers fn to be called after every change to a package.
+func (b *BoltDB) Watch(fn func(Change)) {
+	b.wl.Lock()
+	b.watchers = append(b.watchers, fn)
+	b.wl.Unlock()
+}
+
+func (b *BoltDB) notify(c Change) {
+	b.wl.Lock()
+	ws := b.watchers
+	b.wl.Unlock()
+	for _, fn := range ws {
+		fn(c)
+	}
+}
+
+// PackageExists tells if a package with path is in the database.
+func (b *BoltDB) PackageExists(pth path) bool {
+	ok := false
+	b.view(func(tx *bolt.Tx) error {
+		ok = tx.Bucket(bucketPackages).Get([]byte(pth)) != nil
+		return nil
+	})
+	return ok
+}
+
+// Pkgs returns all packages from the database, sorted by path.
+func (b *BoltDB) Pkgs() []Package {
+	ps := []Package{}
+	b.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketPackages).ForEach(func(k, v []byte) error {
+			p := Package{}
+			if err := json.Unmarshal(v, &p); err != nil {
+				return err
+			}
+			ps = append(ps, p)
+			return nil
+		})
+	})
+	return ps
+}
+
+// QueryPackages returns the page of packages selected by q.
+func (b *BoltDB) QueryPackages(q Query) (Page, error) {
+	return q.apply(b.Pkgs())
+}
+
+// Register adds email to the database, returning an error if there was one.
+func (b *BoltDB) Register(e Email) (Token, error) {
+	tok := FreshToken()
+	return tok, b.update(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketUsers).Get([]byte(e)) != nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("duplicate email %q", e),
+				Code:    http.StatusConflict,
+			}
+		}
+		if err := put(tx, bucketUsers, string(e), boltUser{Email: e, Token: tok, Requested: time.Now()}); err != nil {
+			return err
+		}
+		return tx.Bucket(bucketTokens).Put([]byte(tok), []byte(e))
+	})
+}
+
+// Confirm  modifies the user with the given token. Used on register confirmation.
+func (b *BoltDB) Confirm(tok Token) (Token, error) {
+	nt := FreshToken()
+	err := b.update(func(tx *bolt.Tx) error {
+		toks := tx.Bucket(bucketTokens)
+		e := toks.Get([]byte(tok))
+		if e == nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("bad token: %s", tok),
+				Code:    http.StatusNotFound,
+			}
+		}
+		e = append([]byte{}, e...)
+		u := boltUser{}
+		if ok, err := get(tx, bucketUsers, string(e), &u); err != nil || !ok {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("inconsistent db; found email for token %q, but no user for email %q", tok, e),
+				Code:    http.StatusInternalServerError,
+			}
+		}
+		if err := toks.Delete([]byte(tok)); err != nil {
+			return err
+		}
+		u.Token = nt
+		u.Registered = true
+		if err := put(tx, bucketUsers, string(e), u); err != nil {
+			return err
+		}
+		return toks.Put([]byte(nt), e)
+	})
+	if err != nil {
+		return "", err
+	}
+	return nt, nil
+}
+
+// Forgot is used fetch a user's token. It implements rudimentary rate
+// limiting.
+func (b *BoltDB) Forgot(e Email, window time.Duration) (Token, error) {
+	u := boltUser{}
+	err := b.view(func(tx *bolt.Tx) error {
+		ok, err := get(tx, bucketUsers, string(e), &u)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("could not find email %q in db", e),
+				Code:    http.StatusNotFound,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if u.Disabled {
+		return "", disabled(e)
+	}
+	if u.Requested.After(time.Now()) {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("rate limit hit for %q; try again in %0.2f mins", u.Email, u.Requested.Sub(time.Now()).Minutes()),
+			Code:    http.StatusTooManyRequests,
+		}
+	}
+	return u.Token, nil
+}
+
+// UserForToken returns the email of the user holding tok.
+func (b *BoltDB) UserForToken(tok Token) (Email, error) {
+	var e Email
+	err := b.view(func(tx *bolt.Tx) error {
+		u, err := tokenUser(tx, tok)
+		e = u.Email
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return e, nil
+}
+
+// Tokens returns all tokens belonging to e.
+func (b *BoltDB) Tokens(e Email) []Token {
+	toks := []Token{}
+	b.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketTokens).ForEach(func(k, v []byte) error {
+			if Email(v) == e {
+				toks = append(toks, Token(k))
+			}
+			return nil
+		})
+	})
+	return toks
+}
+
+// AddToken creates an additional token for e.
+func (b *BoltDB) AddToken(e Email) (Token, error) {
+	tok := FreshToken()
+	err := b.update(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketUsers).Get([]byte(e)) == nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("couldn't find user %q", e),
+				Code:    http.StatusNotFound,
+			}
+		}
+		return tx.Bucket(bucketTokens).Put([]byte(tok), []byte(e))
+	})
+	if err != nil {
+		return "", err
+	}
+	return tok, nil
+}
+
+// RevokeToken removes tok from e. A user's last token cannot be revoked.
+func (b *BoltDB) RevokeToken(e Email, tok Token) error {
+	return b.update(func(tx *bolt.Tx) error {
+		toks := tx.Bucket(bucketTokens)
+		if owner := toks.Get([]byte(tok)); owner == nil || Email(owner) != e {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("token %q not found", tok),
+				Code:    http.StatusNotFound,
+			}
+		}
+		var other Token
+		toks.ForEach(func(k, v []byte) error {
+			if other == "" && Email(v) == e && Token(k) != tok {
+				other = Token(k)
+			}
+			return nil
+		})
+		if other == "" {
+			return verrors.HTTP{
+				Message: "cannot revoke last token",
+				Code:    http.StatusConflict,
+			}
+		}
+		if err := toks.Delete([]byte(tok)); err != nil {
+			return err
+		}
+		u := boltUser{}
+		if ok, err := get(tx, bucketUsers, string(e), &u); err != nil || !ok || u.Token != tok {
+			return err
+		}
+		u.Token = other
+		return put(tx, bucketUsers, string(e), u)
+	})
+}
+
+// Record appends a to the audit log, discarding the oldest entries past
+// maxAudit.
+func (b *BoltDB) Record(a AuditEntry) error {
+	return b.update(func(tx *bolt.Tx) error {
+		bk := tx.Bucket(bucketAudit)
+		k, err := seqKey(bk)
+		if err != nil {
+			return err
+		}
+		buf, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		if err := bk.Put(k, buf); err != nil {
+			return err
+		}
+		return trim(bk, maxAudit)
+	})
+}
+
+// AuditLog returns up to n of the most recent audit entries for e, or for
+// everyone if e is empty, newest first.
+func (b *BoltDB) AuditLog(e Email, n int) []AuditEntry {
+	as := []AuditEntry{}
+	b.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketAudit).Cursor()
+		for k, v := c.Last(); k != nil && len(as) < n; k, v = c.Prev() {
+			a := AuditEntry{}
+			if err := json.Unmarshal(v, &a); err != nil {
+				return err
+			}
+			if e == "" || a.Email == e {
+				as = append(as, a)
+			}
+		}
+		return nil
+	})
+	return as
+}
+
+// addRevision adds r to the history of its package within tx, numbered after
+// the newest unless it already has a number, discarding the oldest past
+// maxRevisions. The bucket's sequence is the newest number.
+func addRevision(tx *bolt.Tx, r Revision) (Revision, error) {
+	bk, err := tx.Bucket(bucketRevisions).CreateBucketIfNotExists([]byte(r.Package.Path))
+	if err != nil {
+		return r, err
+	}
+	if r.Number == 0 {
+		n, err := bk.NextSequence()
+		if err != nil {
+			return r, err
+		}
+		r.Number = int(n)
+	} else if uint64(r.Number) > bk.Sequence() {
+		if err := bk.SetSequence(uint64(r.Number)); err != nil {
+			return r, err
+		}
+	}
+	k := itob(uint64(r.Number))
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return r, err
+	}
+	if err := bk.Put(k, buf); err != nil {
+		return r, err
+	}
+	return r, trim(bk, maxRevisions)
+}
+
+// History returns the revisions of the package at pth, newest first.
+func (b *BoltDB) History(pth string) []Revision {
+	rs := []Revision{}
+	b.view(func(tx *bolt.Tx) error {
+		bk := tx.Bucket(bucketRevisions).Bucket([]byte(pth))
+		if bk == nil {
+			return nil
+		}
+		c := bk.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			r := Revision{}
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+			rs = append(rs, r)
+		}
+		return nil
+	})
+	return rs
+}
+
+// AddHook stores h.
+func (b *BoltDB) AddHook(h Hook) error {
+	return b.update(func(tx *bolt.Tx) error {
+		return put(tx, bucketHooks, h.ID, h)
+	})
+}
+
+// RemoveHook deletes the hook with id, along with its deliveries.
+func (b *BoltDB) RemoveHook(id string) error {
+	return b.update(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketHooks).Get([]byte(id)) == nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("hook %q not found", id),
+				Code:    http.StatusNotFound,
+			}
+		}
+		ds := tx.Bucket(bucketDeliveries)
+		if ds.Bucket([]byte(id)) != nil {
+			if err := ds.DeleteBucket([]byte(id)); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(bucketHooks).Delete([]byte(id))
+	})
+}
+
+// Hooks returns all hooks ordered by creation time.
+func (b *BoltDB) Hooks() []Hook {
+	hs := []Hook{}
+	b.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketHooks).ForEach(func(k, v []byte) error {
+			h := Hook{}
+			if err := json.Unmarshal(v, &h); err != nil {
+				return err
+			}
+			hs = append(hs, h)
+			return nil
+		})
+	})
+	sort.SliceStable(hs, func(i, j int) bool { return hs[i].Created.Before(hs[j].Created) })
+	return hs
+}
+
+// RecordDelivery appends d to the deliveries of its hook, discarding the
+// oldest past maxDeliveries.
+func (b *BoltDB) RecordDelivery(d Delivery) error {
+	return b.update(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketHooks).Get([]byte(d.Hook)) == nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("hook %q not found", d.Hook),
+				Code:    http.StatusNotFound,
+			}
+		}
+		bk, err := tx.Bucket(bucketDeliveries).CreateBucketIfNotExists([]byte(d.Hook))
+		if err != nil {
+			return err
+		}
+		k, err := seqKey(bk)
+		if err != nil {
+			return err
+		}
+		buf, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		if err := bk.Put(k, buf); err != nil {
+			return err
+		}
+		return trim(bk, maxDeliveries)
+	})
+}
+
+// Deliveries returns up to n of the most recent deliveries for hook, newest
+// first.
+func (b *BoltDB) Deliveries(hook string, n int) []Delivery {
+	ds := []Delivery{}
+	b.view(func(tx *bolt.Tx) error {
+		bk := tx.Bucket(bucketDeliveries).Bucket([]byte(hook))
+		if bk == nil {
+			return nil
+		}
+		c := bk.Cursor()
+		for k, v := c.Last(); k != nil && len(ds) < n; k, v = c.Prev() {
+			d := Delivery{}
+			if err := json.Unmarshal(v, &d); err != nil {
+				return err
+			}
+			ds = append(ds, d)
+		}
+		return nil
+	})
+	return ds
+}
+
+// Export returns every user, namespace, package and revision, sorted.
+func (b *BoltDB) Export() (Registry, error) {
+	var r Registry
+	err := b.view(func(tx *bolt.Tx) error {
+		var err error
+		r, err = exportTx(tx)
+		return err
+	})
+	return r, err
+}
+
+// exportTx returns every user, namespace and package as of tx, sorted.
+func exportTx(tx *bolt.Tx) (Registry, error) {
+	r := Registry{
+		Version:    RegistryVersion,
+		Users:      []RegistryUser{},
+		Namespaces: []RegistryNamespace{},
+		Packages:   []Package{},
+	}
+	toks := map[Email][]Token{}
+	tx.Bucket(bucketTokens).ForEach(func(k, v []byte) error {
+		toks[Email(v)] = append(toks[Email(v)], Token(k))
+		return nil
+	})
+	err := tx.Bucket(bucketUsers).ForEach(func(k, v []byte) error {
+		u := boltUser{}
+		if err := json.Unmarshal(v, &u); err != nil {
+			return err
+		}
+		r.Users = append(r.Users, RegistryUser{Email: Email(k), Tokens: toks[Email(k)], Disabled: u.Disabled, Pending: !u.Registered})
+		return nil
+	})
+	if err != nil {
+		return r, err
+	}
+	tx.Bucket(bucketNamespaces).ForEach(func(k, v []byte) error {
+		r.Namespaces = append(r.Namespaces, RegistryNamespace{Name: namespace(k), Owner: Email(v)})
+		return nil
+	})
+	err = tx.Bucket(bucketPackages).ForEach(func(k, v []byte) error {
+		p := Package{}
+		if err := json.Unmarshal(v, &p); err != nil {
+			return err
+		}
+		r.Packages = append(r.Packages, p)
+		return nil
+	})
+	if err != nil {
+		return r, err
+	}
+	revs := tx.Bucket(bucketRevisions)
+	err = revs.ForEach(func(k, _ []byte) error {
+		return revs.Bucket(k).ForEach(func(_, v []byte) error {
+			rev := Revision{}
+			if err := json.Unmarshal(v, &rev); err != nil {
+				return err
+			}
+			r.Revisions = append(r.Revisions, rev)
+			return nil
+		})
+	})
+	return r, err
+}
+
+// Import stores everything in r in a single transaction. Existing users gain
+// r's tokens and are registered unless pending in r, existing namespaces and
+// packages are replaced, and revisions are numbered as described by Registry.
+func (b *BoltDB) Import(r Registry) error {
+	changes := []Change{}
+	err := b.update(func(tx *bolt.Tx) error {
+		cur, err := exportTx(tx)
+		if err != nil {
+			return err
+		}
+		if errs := r.check(cur); len(errs) > 0 {
+			return ImportError(errs)
+		}
+		for _, ru := range r.Users {
+			u := boltUser{}
+			ok, err := get(tx, bucketUsers, string(ru.Email), &u)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				u = boltUser{Email: ru.Email, Requested: time.Now(), Disabled: ru.Disabled}
+			}
+			u.Registered = u.Registered || !ru.Pending
+			for _, t := range ru.Tokens {
+				if u.Token == "" {
+					u.Token = t
+				}
+				if err := tx.Bucket(bucketTokens).Put([]byte(t), []byte(ru.Email)); err != nil {
+					return err
+				}
+			}
+			if err := put(tx, bucketUsers, string(ru.Email), u); err != nil {
+				return err
+			}
+		}
+		for _, ns := range r.Namespaces {
+			if err := set(tx, bucketNamespaces, []byte(ns.Name), []byte(ns.Owner)); err != nil {
+				return err
+			}
+		}
+		for _, p := range r.Packages {
+			action := actionCreate
+			if tx.Bucket(bucketPackages).Get([]byte(p.Path)) != nil {
+				action = actionUpdate
+			}
+			if err := put(tx, bucketPackages, p.Path, p); err != nil {
+				return err
+			}
+			changes = append(changes, Change{Action: action, Package: p})
+		}
+		for _, rev := range r.Revisions {
+			if _, err := addRevision(tx, rev); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, c := range changes {
+		b.notify(c)
+	}
+	return nil
+}
+
+// Ping reports whether the file can be read.
+func (b *BoltDB) Ping() error {
+	return b.view(func(tx *bolt.Tx) error { return nil })
+}
+
+// Dump writes the users, namespaces and packages as json to w.
+func (b *BoltDB) Dump(w io.Writer) error {
+	r, err := b.Export()
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(r)
+}
+
+// Backup writes a consistent copy of the bbolt file to w without blocking
+// writes.
+func (b *BoltDB) Backup(w io.Writer) error {
+	return b.view(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// Compact rewrites the file without the free pages left behind by deletes.
+// Other calls wait until it is done.
+func (b *BoltDB) Compact() error {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	tmp := b.filename + ".compact"
+	os.Remove(tmp)
+	dst, err := bolt.Open(tmp, 0600, nil)
+	if err != nil {
+		return err
+	}
+	if err := bolt.Compact(dst, b.db, 1<<20); err != nil {
+		dst.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("couldn't compact: %v", err)
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	if err := b.db.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, b.filename); err != nil {
+		// carry on with the uncompacted file
+		os.Remove(tmp)
+	}
+	return b.open()
+}
+
+// Check returns the inconsistencies in the store, as MemDB.Check does.
+func (b *BoltDB) Check() []Problem {
+	users := map[Email]bool{}
+	toks := map[Token]Email{}
+	nss := map[namespace]Email{}
+	pkgs := []Package{}
+	b.view(func(tx *bolt.Tx) error {
+		tx.Bucket(bucketUsers).ForEach(func(k, v []byte) error {
+			users[Email(k)] = true
+			return nil
+		})
+		tx.Bucket(bucketTokens).ForEach(func(k, v []byte) error {
+			toks[Token(k)] = Email(v)
+			return nil
+		})
+		tx.Bucket(bucketNamespaces).ForEach(func(k, v []byte) error {
+			nss[namespace(k)] = Email(v)
+			return nil
+		})
+		return tx.Bucket(bucketPackages).ForEach(func(k, v []byte) error {
+			p := Package{}
+			if err := json.Unmarshal(v, &p); err != nil {
+				return err
+			}
+			pkgs = append(pkgs, p)
+			return nil
+		})
+	})
+	return problems(users, toks, nss, pkgs)
+}
+
+// Repair fixes the problems found by Check, as MemDB.Repair does.
+func (b *BoltDB) Repair() ([]Problem, error) {
+	fixed := []Problem{}
+	for _, p := range b.Check() {
+		if p.Kind == problemToken || p.Kind == problemNamespace {
+			fixed = append(fixed, p)
+		}
+	}
+	if len(fixed) == 0 {
+		return fixed, nil
+	}
+	return fixed, b.update(func(tx *bolt.Tx) error {
+		for _, p := range fixed {
+			var err error
+			switch p.Kind {
+			case problemToken:
+				err = tx.Bucket(bucketTokens).Delete([]byte(p.Key))
+			case problemNamespace:
+				err = del(tx, bucketNamespaces, []byte(p.Key))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AddUser adds a confirmed user, returning their token.
+func (b *BoltDB) AddUser(e Email) (Token, error) {
+	tok := FreshToken()
+	err := b.update(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketUsers).Get([]byte(e)) != nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("duplicate email %q", e),
+				Code:    http.StatusConflict,
+			}
+		}
+		u := boltUser{Email: e, Token: tok, Registered: true, Requested: time.Now()}
+		if err := put(tx, bucketUsers, string(e), u); err != nil {
+			return err
+		}
+		return tx.Bucket(bucketTokens).Put([]byte(tok), []byte(e))
+	})
+	if err != nil {
+		return "", err
+	}
+	return tok, nil
+}
+
+// SetDisabled disables or re-enables e.
+func (b *BoltDB) SetDisabled(e Email, disabled bool) error {
+	return b.update(func(tx *bolt.Tx) error {
+		u := boltUser{}
+		ok, err := get(tx, bucketUsers, string(e), &u)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("couldn't find user %q", e),
+				Code:    http.StatusNotFound,
+			}
+		}
+		u.Disabled = disabled
+		return put(tx, bucketUsers, string(e), u)
+	})
+}
+
+// TransferNamespace makes e the owner of ns, claiming it if it has no owner.
+func (b *BoltDB) TransferNamespace(ns string, e Email) error {
+	if err := checkNamespace(ns); err != nil {
+		return err
+	}
+	return b.update(func(tx *bolt.Tx) error {
+		if tx.Bucket(bucketUsers).Get([]byte(e)) == nil {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("couldn't find user %q", e),
+				Code:    http.StatusNotFound,
+			}
+		}
+		return set(tx, bucketNamespaces, []byte(ns), []byte(e))
+	})
+}
+
+// RemovePath removes the package at pth, which must exist.
+func (b *BoltDB) RemovePath(pth string) error {
+	return removePath(b, pth)
+}
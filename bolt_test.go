@@ -0,0 +1,167 @@
+package vain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+func testBoltDB(t *testing.T) (*BoltDB, string, func()) {
+	dir, err := ioutil.TempDir("", "vain-testing-")
+	if err != nil {
+		t.Fatalf("could not create tmpdir for db: %v", err)
+	}
+	name := filepath.Join(dir, "test.bolt")
+	db, err := NewBoltDB(name)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("could not create db: %v", err)
+	}
+	return db, name, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestBoltDBStorer(t *testing.T) {
+	db, _, done := testBoltDB(t)
+	defer done()
+	testStorer(t, db)
+
+	// the kept counts match the buckets
+	db.view(func(tx *bolt.Tx) error {
+		for _, name := range counted {
+			got := binary.BigEndian.Uint64(tx.Bucket(bucketCounts).Get(name))
+			if want := tx.Bucket(name).Stats().KeyN; got != uint64(want) {
+				t.Errorf("%s: counted %d, have %d", name, got, want)
+			}
+		}
+		return nil
+	})
+}
+
+func TestBoltDBMaintainer(t *testing.T) {
+	db, _, done := testBoltDB(t)
+	defer done()
+	testCheckRepair(t, db, func() {
+		db.update(func(tx *bolt.Tx) error {
+			tx.Bucket(bucketTokens).Put([]byte("dangling"), []byte("gone@example.org"))
+			return set(tx, bucketNamespaces, []byte("gone"), []byte("gone@example.org"))
+		})
+	})
+
+	db2, _, done2 := testBoltDB(t)
+	defer done2()
+	testDisabledUser(t, db2)
+}
+
+func TestBoltDB(t *testing.T) {
+	db, name, done := testBoltDB(t)
+	defer done()
+
+	src, done2 := queryDB(t)
+	defer done2()
+	tok, _ := src.AddUser("sm@example.org")
+	src.TransferNamespace("sm", "sm@example.org")
+	src.TransferNamespace("mc", "sm@example.org")
+	if _, err := src.Register("new@example.org"); err != nil {
+		t.Fatalf("couldn't register: %v", err)
+	}
+	if _, err := Migrate(src, db); err != nil {
+		t.Fatalf("couldn't migrate: %v", err)
+	}
+	if r, _ := db.Export(); len(r.Users) != 2 || !r.Users[0].Pending || r.Users[1].Pending {
+		t.Fatalf("migrated users: got %+v", r.Users)
+	}
+
+	if _, err := NewBoltDB(name); err == nil {
+		t.Fatalf("expected error opening a db in use")
+	}
+	db.Close()
+	db, err := NewBoltDB(name)
+	if err != nil {
+		t.Fatalf("couldn't reopen: %v", err)
+	}
+	if _, err := Verify(src, db); err != nil {
+		t.Fatalf("after reopening: %v", err)
+	}
+	if e, err := db.UserForToken(tok); err != nil || e != "sm@example.org" {
+		t.Fatalf("token after reopening: got %q, %v", e, err)
+	}
+
+	for _, p := range src.Pkgs() {
+		if err := db.RemovePackage(path(p.Path), Edit{}); err != nil {
+			t.Fatalf("couldn't remove: %v", err)
+		}
+	}
+	before, _ := os.Stat(name)
+	if err := db.Compact(); err != nil {
+		t.Fatalf("couldn't compact: %v", err)
+	}
+	after, _ := os.Stat(name)
+	if after.Size() > before.Size() {
+		t.Fatalf("compaction grew the file from %d to %d bytes", before.Size(), after.Size())
+	}
+	if e, err := db.UserForToken(tok); err != nil || e != "sm@example.org" {
+		t.Fatalf("token after compacting: got %q, %v", e, err)
+	}
+
+	s := NewServer(http.NewServeMux(), db, nil, "", window, true)
+	sm := http.NewServeMux()
+	NewAdmin(sm, s, nil)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/debug/backup")
+	if err != nil {
+		t.Fatalf("couldn't GET backup: %v", err)
+	}
+	buf := &bytes.Buffer{}
+	buf.ReadFrom(resp.Body)
+	resp.Body.Close()
+	backup := filepath.Join(filepath.Dir(name), "backup.bolt")
+	if err := ioutil.WriteFile(backup, buf.Bytes(), 0600); err != nil {
+		t.Fatalf("couldn't write backup: %v", err)
+	}
+	restored, err := NewBoltDB(backup)
+	if err != nil {
+		t.Fatalf("couldn't open backup: %v", err)
+	}
+	defer restored.Close()
+	if _, err := Verify(db, restored); err != nil {
+		t.Fatalf("backup: %v", err)
+	}
+
+	if resp, err := http.Get(ts.URL + "/debug/compact"); err != nil || resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("GET compact: got %v, %v", resp, err)
+	}
+	if resp, err := http.Post(ts.URL+"/debug/compact", "", nil); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST compact: got %v, %v", resp, err)
+	}
+
+	mem, done3 := TestDB(t)
+	defer done3()
+	ms := httptest.NewServer(func() *http.ServeMux {
+		sm := http.NewServeMux()
+		NewAdmin(sm, NewServer(http.NewServeMux(), mem, nil, "", window, true), nil)
+		return sm
+	}())
+	defer ms.Close()
+	for _, route := range []string{"/debug/backup", "/debug/compact"} {
+		resp, err := http.Post(ms.URL+route, "", nil)
+		if err != nil {
+			t.Fatalf("couldn't POST %s: %v", route, err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusNotImplemented; got != want {
+			t.Errorf("memdb %s: got %s, want %s", route, http.StatusText(got), http.StatusText(want))
+		}
+	}
+}
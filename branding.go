@@ -0,0 +1,72 @@
+package vain
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Branding customizes the pages served to browsers for a deployment.
+type Branding struct {
+	// Name is shown in the navigation bar and page titles.
+	Name string
+	// Host is the vanity host used in examples; it defaults to the host
+	// the page was requested on.
+	Host string
+	// Logo is the url of an image shown next to Name.
+	Logo string
+	// Contact is an email address people can ask for help.
+	Contact string
+	// CSS is the url of a stylesheet loaded after the default ones.
+	CSS string
+}
+
+const defaultName = "Vain"
+
+// Brand customizes the web pages with b.
+func Brand(b Branding) Option {
+	return func(s *Server) {
+		s.brand = b
+	}
+}
+
+// pageData is the data used to render the index, register and forgot pages.
+type pageData struct {
+	chrome
+	Host   string
+	Scheme string
+}
+
+// pages maps paths below the static prefix to the templates rendered for
+// them.
+var pages = map[string]string{
+	"":          "index",
+	"register/": "register",
+	"forgot/":   "forgot",
+}
+
+// host returns the vanity host to show in examples on pages for req.
+func (s *Server) host(req *http.Request) string {
+	if s.brand.Host != "" {
+		return s.brand.Host
+	}
+	return req.Host
+}
+
+// static serves the templated pages, and the static assets for everything
+// else. Pages present in an override static directory are served as is.
+func (s *Server) static(w http.ResponseWriter, req *http.Request) {
+	rel := strings.TrimPrefix(req.URL.Path, prefix["static"])
+	name, ok := pages[rel]
+	if !ok || (req.Method != "GET" && req.Method != "HEAD") || s.assets.overrides(rel+"index.html") {
+		s.assets.ServeHTTP(w, req)
+		return
+	}
+	scheme := "https"
+	if s.insecure {
+		scheme = "http"
+	}
+	s.render(w, http.StatusOK, name, pageData{
+		Host:   s.host(req),
+		Scheme: scheme,
+	})
+}
@@ -0,0 +1,132 @@
+package vain
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func getPage(t *testing.T, u string) string {
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("couldn't GET %s: %v", u, err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("%s: got %s, want %s", u, http.StatusText(got), http.StatusText(want))
+	}
+	return string(b)
+}
+
+func TestPagesDefault(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	page := getPage(t, ts.URL+"/_static/")
+	for _, want := range []string{
+		"<title>Vain</title>",
+		"http://" + host + "/foo",
+		"go get " + host + "/foo",
+		"GOINSECURE=" + host,
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("index missing %q", want)
+		}
+	}
+	if strings.Contains(page, "mailto:") {
+		t.Errorf("index should not have a contact without one configured")
+	}
+	for _, p := range []string{"/_static/register/", "/_static/forgot/"} {
+		if page := getPage(t, ts.URL+p); !strings.Contains(page, `id="send"`) {
+			t.Errorf("%s: missing email form", p)
+		}
+	}
+}
+
+func TestPagesBranded(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, false, Brand(Branding{
+		Name:    "Acme Go",
+		Host:    "go.acme.example",
+		Logo:    "https://acme.example/logo.png",
+		Contact: "go-team@acme.example",
+		CSS:     "/_static/acme.css",
+	}))
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	for _, p := range []string{"/_static/", "/_static/register/", "/_static/forgot/", "/_pkgs/", "/_dashboard/"} {
+		page := getPage(t, ts.URL+p)
+		for _, want := range []string{
+			"<title>Acme Go</title>",
+			`<img src="https://acme.example/logo.png"`,
+			`href="mailto:go-team@acme.example"`,
+			`<link href="/_static/acme.css" rel="stylesheet">`,
+		} {
+			if !strings.Contains(page, want) {
+				t.Errorf("%s: missing %q", p, want)
+			}
+		}
+	}
+	page := getPage(t, ts.URL+"/_static/")
+	for _, want := range []string{
+		"https://go.acme.example/foo",
+		"go get go.acme.example/foo",
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("index missing %q", want)
+		}
+	}
+	if strings.Contains(page, "GOINSECURE") {
+		t.Errorf("secure server should not suggest GOINSECURE")
+	}
+}
+
+func TestPagesOverride(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	dir, err := ioutil.TempDir("", "vain-static-")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("custom index"), 0644); err != nil {
+		t.Fatalf("couldn't write index: %v", err)
+	}
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, dir, window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	if got, want := getPage(t, ts.URL+"/_static/"), "custom index"; got != want {
+		t.Errorf("override index; got %q, want %q", got, want)
+	}
+	if page := getPage(t, ts.URL+"/_static/register/"); !strings.Contains(page, "<title>Vain</title>") {
+		t.Errorf("register should still be rendered when not overridden")
+	}
+}
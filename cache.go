@@ -0,0 +1,220 @@
+package vain
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	verrors "mcquay.me/vain/errors"
+	"mcquay.me/vain/metrics"
+)
+
+// cachedStore answers Package from an LRU of recent lookups, passing
+// everything else through to its embedded Storer.
+type cachedStore struct {
+	Storer
+
+	size        int
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	// l guards the cache. gen counts invalidations so that a lookup
+	// racing with one isn't cached.
+	l       sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	gen     uint64
+}
+
+// cacheEntry is the result of looking up path: the package it resolved to, or
+// the error if it didn't.
+type cacheEntry struct {
+	path    string
+	pkg     Package
+	err     error
+	expires time.Time
+}
+
+// Cache returns a Storer that remembers the results of up to size calls to
+// db.Package, most recently used first. Paths that don't resolve to a package
+// are remembered for negativeTTL, and those that do for ttl, or until db
+// reports a change to the package if ttl is 0. Errors other than not found are
+// never cached.
+func Cache(db Storer, size int, ttl, negativeTTL time.Duration) Storer {
+	c := &cachedStore{
+		Storer:      db,
+		size:        size,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		lru:         list.New(),
+		entries:     map[string]*list.Element{},
+	}
+	// changes made elsewhere, e.g. by another replica or another Cache
+	db.Watch(func(ch Change) { c.invalidate(ch.Package.Path) })
+	return c
+}
+
+// Package returns the cached lookup of pth if there is one that hasn't
+// expired, and otherwise asks the underlying store.
+func (c *cachedStore) Package(pth string) (Package, error) {
+	now := time.Now()
+	c.l.Lock()
+	if el, ok := c.entries[pth]; ok {
+		e := el.Value.(*cacheEntry)
+		if e.expires.IsZero() || now.Before(e.expires) {
+			c.lru.MoveToFront(el)
+			c.l.Unlock()
+			metrics.CacheLookups.WithLabelValues(cacheResult(e.err, "hit")).Inc()
+			return e.pkg, e.err
+		}
+		c.remove(el)
+	}
+	gen := c.gen
+	c.l.Unlock()
+
+	p, err := c.Storer.Package(pth)
+	metrics.CacheLookups.WithLabelValues(cacheResult(err, "miss")).Inc()
+	e := &cacheEntry{path: pth, pkg: p, err: err}
+	switch {
+	case err == nil:
+		if c.ttl > 0 {
+			e.expires = now.Add(c.ttl)
+		}
+	case isNotFound(err) && c.negativeTTL > 0:
+		e.expires = now.Add(c.negativeTTL)
+	default:
+		return p, err
+	}
+
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.gen != gen {
+		return p, err
+	}
+	if el, ok := c.entries[pth]; ok {
+		c.remove(el)
+	}
+	c.entries[pth] = c.lru.PushFront(e)
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+	metrics.CacheEntries.Set(float64(c.lru.Len()))
+	return p, err
+}
+
+// cacheResult labels a lookup as a hit or miss, and as negative if it
+// found nothing.
+func cacheResult(err error, result string) string {
+	if err != nil {
+		return "negative_" + result
+	}
+	return result
+}
+
+func isNotFound(err error) bool {
+	e := verrors.ToHTTP(err)
+	return e != nil && e.Code == http.StatusNotFound
+}
+
+// remove drops el from the cache; c.l must be held.
+func (c *cachedStore) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).path)
+}
+
+// invalidate drops every lookup that a change to the package at pth could
+// alter: pth itself and every path beneath it.
+func (c *cachedStore) invalidate(pth string) {
+	c.l.Lock()
+	c.gen++
+	for k, el := range c.entries {
+		if k == pth || strings.HasPrefix(k, pth+"/") {
+			c.remove(el)
+		}
+	}
+	metrics.CacheEntries.Set(float64(c.lru.Len()))
+	c.l.Unlock()
+}
+
+// purge empties the cache.
+func (c *cachedStore) purge() {
+	c.l.Lock()
+	c.gen++
+	c.lru.Init()
+	c.entries = map[string]*list.Element{}
+	metrics.CacheEntries.Set(0)
+	c.l.Unlock()
+}
+
+// Writes invalidate the cache whether or not they succeed, since a failed
+// write may still have changed the store, and without waiting for db to
+// report the change.
+
+func (c *cachedStore) AddPackage(p Package, ed Edit) error {
+	defer c.invalidate(p.Path)
+	return c.Storer.AddPackage(p, ed)
+}
+
+func (c *cachedStore) UpdatePackage(p Package, ed Edit) error {
+	defer c.invalidate(p.Path)
+	return c.Storer.UpdatePackage(p, ed)
+}
+
+func (c *cachedStore) RemovePackage(pth path, ed Edit) error {
+	defer c.invalidate(string(pth))
+	return c.Storer.RemovePackage(pth, ed)
+}
+
+// Export returns the underlying store's contents.
+func (c *cachedStore) Export() (Registry, error) {
+	return Export(c.Storer)
+}
+
+// Import stores r in the underlying store and empties the cache.
+func (c *cachedStore) Import(r Registry) error {
+	imp, ok := c.Storer.(Importer)
+	if !ok {
+		return fmt.Errorf("store does not support import")
+	}
+	defer c.purge()
+	return imp.Import(r)
+}
+
+// Ping reports whether the underlying store is able to serve requests.
+func (c *cachedStore) Ping() error {
+	if p, ok := c.Storer.(Pinger); ok {
+		return p.Ping()
+	}
+	return nil
+}
+
+// Dump writes out the underlying store.
+func (c *cachedStore) Dump(w io.Writer) error {
+	dm, ok := c.Storer.(Dumper)
+	if !ok {
+		return fmt.Errorf("store does not support dumping")
+	}
+	return dm.Dump(w)
+}
+
+// Backup writes a copy of the underlying store.
+func (c *cachedStore) Backup(w io.Writer) error {
+	b, ok := c.Storer.(Backuper)
+	if !ok {
+		return fmt.Errorf("store does not support backups")
+	}
+	return b.Backup(w)
+}
+
+// Compact reclaims unused space in the underlying store.
+func (c *cachedStore) Compact() error {
+	cp, ok := c.Storer.(Compacter)
+	if !ok {
+		return fmt.Errorf("store does not support compaction")
+	}
+	return cp.Compact()
+}
@@ -0,0 +1,128 @@
+package vain
+
+import (
+	"testing"
+	"time"
+)
+
+// countingStore counts the lookups that reach its Storer.
+type countingStore struct {
+	Storer
+	lookups int
+}
+
+func (c *countingStore) Package(pth string) (Package, error) {
+	c.lookups++
+	return c.Storer.Package(pth)
+}
+
+func (c *countingStore) Import(r Registry) error {
+	return c.Storer.(Importer).Import(r)
+}
+
+func TestCacheStorer(t *testing.T) {
+	db, done := TestDB(t)
+	defer done()
+	testStorer(t, Cache(db, 100, 0, time.Minute))
+}
+
+func TestCache(t *testing.T) {
+	mdb, done := queryDB(t)
+	defer done()
+	db := &countingStore{Storer: mdb}
+	c := Cache(db, 3, 0, 50*time.Millisecond)
+
+	lookup := func(pth string, found bool, lookups int) {
+		t.Helper()
+		_, err := c.Package(pth)
+		if (err == nil) != found {
+			t.Fatalf("%s: got %v, want found %t", pth, err, found)
+		}
+		if db.lookups != lookups {
+			t.Fatalf("%s: store saw %d lookups, want %d", pth, db.lookups, lookups)
+		}
+	}
+
+	lookup("a.org/sm/a/sub", true, 1)
+	lookup("a.org/sm/a/sub", true, 1)
+	lookup("a.org/sm/d", false, 2)
+	lookup("a.org/sm/d", false, 2)
+
+	// negative lookups expire
+	time.Sleep(60 * time.Millisecond)
+	lookup("a.org/sm/d", false, 3)
+
+	// adding a package invalidates lookups beneath it
+	lookup("a.org/sm/d/sub", false, 4)
+	if err := c.AddPackage(Package{Vcs: "git", Repo: "https://example.org/d", Path: "a.org/sm/d"}, Edit{}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	lookup("a.org/sm/d/sub", true, 5)
+	lookup("a.org/sm/a/sub", true, 5)
+
+	p, _ := c.Package("a.org/sm/d")
+	p.Repo = "https://example.org/moved"
+	if err := c.UpdatePackage(p, Edit{}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if got, _ := c.Package("a.org/sm/d/sub"); got.Repo != p.Repo {
+		t.Fatalf("after update: got %+v", got)
+	}
+	if err := c.RemovePackage("a.org/sm/d", Edit{}); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	n := db.lookups
+	lookup("a.org/sm/d/sub", false, n+1)
+
+	// imports empty the cache
+	if err := c.(Importer).Import(Registry{Version: RegistryVersion}); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	n = db.lookups
+	lookup("a.org/sm/d/sub", false, n+1)
+
+	// the least recently used lookup is evicted
+	lookup("a.org/sm/b", true, n+2)
+	lookup("a.org/sm/c", true, n+3)
+	lookup("a.org/sm/d/sub", false, n+3)
+	lookup("a.org/mc/x", true, n+4)
+	lookup("a.org/sm/d/sub", false, n+4)
+	lookup("a.org/sm/c", true, n+4)
+	lookup("a.org/sm/b", true, n+5)
+}
+
+func TestCacheShared(t *testing.T) {
+	db, done := queryDB(t)
+	defer done()
+	a := Cache(db, 10, 0, time.Minute)
+	b := Cache(db, 10, 0, time.Minute)
+
+	p, err := a.Package("a.org/sm/a/sub")
+	if err != nil {
+		t.Fatalf("package: %v", err)
+	}
+	if _, err := a.Package("a.org/sm/d"); err == nil {
+		t.Fatalf("found missing package")
+	}
+
+	// writes through b are seen through a
+	p.Repo = "https://example.org/moved"
+	if err := b.UpdatePackage(p, Edit{}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if got, _ := a.Package("a.org/sm/a/sub"); got.Repo != p.Repo {
+		t.Fatalf("after update: got %+v", got)
+	}
+	if err := b.AddPackage(Package{Vcs: "git", Repo: "https://example.org/d", Path: "a.org/sm/d"}, Edit{}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if _, err := a.Package("a.org/sm/d"); err != nil {
+		t.Fatalf("after add: %v", err)
+	}
+	if err := b.RemovePackage("a.org/sm/a", Edit{}); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if _, err := a.Package("a.org/sm/a/sub"); err == nil {
+		t.Fatalf("found removed package")
+	}
+}
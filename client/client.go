@@ -0,0 +1,278 @@
+// Package client talks to a vain server.
+//
+//	c := client.New("https://go.example.com", token)
+//	err := c.Add(ctx, "foo/bar", client.Package{Repo: "https://git.example.com/foo/bar"})
+//	if errors.Is(err, client.ErrConflict) {
+//		// someone already serves a prefix of foo/bar
+//	}
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	apiPrefix = "/api/v0/"
+	apiV1     = "/api/v1/"
+
+	// pageSize is the most packages the server returns at once.
+	pageSize = 1000
+)
+
+// Package is a package served by vain.
+type Package struct {
+	// Vcs is one of "git", "hg", "bzr" or "svn"; the server defaults it to
+	// git.
+	Vcs string `json:"vcs,omitempty"`
+	// Repo is the url of the repository.
+	Repo string `json:"repo,omitempty"`
+	// Landing is what browsers visiting the path get: "page", "redirect"
+	// or "" for the server default.
+	Landing string `json:"landing,omitempty"`
+	// Docs is the url of the documentation.
+	Docs string `json:"docs,omitempty"`
+	// Path is the import path. It is set by the server.
+	Path string `json:"path,omitempty"`
+}
+
+// Error is returned when the server responds with a non-2xx status. It
+// mirrors the errors returned by the server.
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// Is reports whether target is the sentinel for e's status code, so that
+// errors.Is(err, ErrNotFound) works.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Message == "" && t.Code == e.Code
+}
+
+// Sentinel errors for use with errors.Is.
+var (
+	ErrBadRequest         = &Error{Code: http.StatusBadRequest}
+	ErrUnauthorized       = &Error{Code: http.StatusUnauthorized}
+	ErrForbidden          = &Error{Code: http.StatusForbidden}
+	ErrNotFound           = &Error{Code: http.StatusNotFound}
+	ErrMethodNotAllowed   = &Error{Code: http.StatusMethodNotAllowed}
+	ErrConflict           = &Error{Code: http.StatusConflict}
+	ErrInternal           = &Error{Code: http.StatusInternalServerError}
+	ErrServiceUnavailable = &Error{Code: http.StatusServiceUnavailable}
+)
+
+// Client calls the api of the vain server at URL.
+type Client struct {
+	// URL is where the server is, e.g. https://go.example.com.
+	URL string
+	// Token authorizes changes. It is not needed to register, confirm,
+	// recover a token, or list packages.
+	Token string
+	// HTTP is used to make requests; http.DefaultClient if nil.
+	HTTP *http.Client
+}
+
+// New returns a Client for the server at base using tok.
+func New(base, tok string) *Client {
+	return &Client{
+		URL:   strings.TrimRight(base, "/"),
+		Token: tok,
+	}
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTP != nil {
+		return c.HTTP
+	}
+	return http.DefaultClient
+}
+
+// do performs a request against the api, decoding a json response into out
+// if it is not nil.
+func (c *Client) do(ctx context.Context, method, pth string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, strings.TrimRight(c.URL, "/")+pth, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<16))
+		e := &Error{
+			Code:    resp.StatusCode,
+			Message: strings.TrimSpace(string(b)),
+		}
+		// v1 errors are json objects
+		v1 := struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}{}
+		if json.Unmarshal(b, &v1) == nil && v1.Error.Message != "" {
+			e.Message = v1.Error.Message
+		}
+		return e
+	}
+	if out == nil {
+		return nil
+	}
+	if s, ok := out.(*string); ok {
+		b, err := ioutil.ReadAll(resp.Body)
+		*s = string(b)
+		return err
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("couldn't decode response: %v", err)
+	}
+	return nil
+}
+
+// pkgPath returns the url path for the package at pth, which may be given
+// relative to the server or as a full import path.
+func (c *Client) pkgPath(pth string) string {
+	pth = strings.Trim(pth, "/")
+	if u, err := url.Parse(c.URL); err == nil && u.Host != "" {
+		pth = strings.TrimPrefix(pth, u.Host+"/")
+	}
+	return "/" + pth
+}
+
+type msg struct {
+	Msg string `json:"msg"`
+}
+
+// Register asks the server to email instructions for obtaining a token to
+// email. It returns the server's message.
+func (c *Client) Register(ctx context.Context, email string) (string, error) {
+	m := msg{}
+	err := c.do(ctx, "POST", apiPrefix+"register/?email="+url.QueryEscape(email), nil, &m)
+	return strings.TrimSpace(m.Msg), err
+}
+
+// Forgot asks the server to email instructions for recovering a token to
+// email. It returns the server's message.
+func (c *Client) Forgot(ctx context.Context, email string) (string, error) {
+	m := msg{}
+	err := c.do(ctx, "POST", apiPrefix+"forgot/?email="+url.QueryEscape(email), nil, &m)
+	return strings.TrimSpace(m.Msg), err
+}
+
+// Confirm exchanges the token sent by email for an api token.
+func (c *Client) Confirm(ctx context.Context, tok string) (string, error) {
+	s := ""
+	if err := c.do(ctx, "GET", apiPrefix+"confirm/"+url.PathEscape(tok), nil, &s); err != nil {
+		return "", err
+	}
+	const prefix = "new token: "
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, prefix) {
+		return "", fmt.Errorf("unexpected response: %q", s)
+	}
+	return strings.TrimPrefix(s, prefix), nil
+}
+
+// Packages lists every package served.
+func (c *Client) Packages(ctx context.Context) ([]Package, error) {
+	ps := []Package{}
+	err := c.do(ctx, "GET", apiPrefix+"db/", nil, &ps)
+	return ps, err
+}
+
+// Namespace lists the packages in namespace ns.
+func (c *Client) Namespace(ctx context.Context, ns string) ([]Package, error) {
+	ps := []Package{}
+	v := url.Values{"ns": {ns}, "limit": {strconv.Itoa(pageSize)}}
+	for {
+		page := struct {
+			Packages []Package `json:"packages"`
+			Next     string    `json:"next"`
+		}{}
+		if err := c.do(ctx, "GET", apiV1+"packages/?"+v.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+		ps = append(ps, page.Packages...)
+		if page.Next == "" {
+			return ps, nil
+		}
+		v.Set("cursor", page.Next)
+	}
+}
+
+// Package returns the package at pth, e.g. "foo/bar".
+func (c *Client) Package(ctx context.Context, pth string) (Package, error) {
+	p := Package{}
+	err := c.do(ctx, "GET", apiV1+"packages"+c.pkgPath(pth), nil, &p)
+	return p, err
+}
+
+// Add serves p at pth, e.g. "foo/bar".
+func (c *Client) Add(ctx context.Context, pth string, p Package) error {
+	p.Path = ""
+	return c.do(ctx, "POST", c.pkgPath(pth), p, nil)
+}
+
+// Update changes the package at pth. Empty fields of p are left as they
+// are.
+func (c *Client) Update(ctx context.Context, pth string, p Package) error {
+	p.Path = ""
+	return c.do(ctx, "PATCH", c.pkgPath(pth), p, nil)
+}
+
+// Delete stops serving the package at pth.
+func (c *Client) Delete(ctx context.Context, pth string) error {
+	return c.do(ctx, "DELETE", c.pkgPath(pth), nil, nil)
+}
+
+// Tokens lists the tokens of the user owning c.Token.
+func (c *Client) Tokens(ctx context.Context) ([]string, error) {
+	toks := []string{}
+	err := c.do(ctx, "GET", apiPrefix+"tokens/", nil, &toks)
+	return toks, err
+}
+
+// NewToken creates an additional token for the user owning c.Token.
+func (c *Client) NewToken(ctx context.Context) (string, error) {
+	resp := struct {
+		Token string `json:"token"`
+	}{}
+	err := c.do(ctx, "POST", apiPrefix+"tokens/", nil, &resp)
+	return resp.Token, err
+}
+
+// RevokeToken revokes tok, which must belong to the user owning c.Token. A
+// user's last token cannot be revoked.
+func (c *Client) RevokeToken(ctx context.Context, tok string) error {
+	return c.do(ctx, "DELETE", apiPrefix+"tokens/"+url.PathEscape(tok), nil, nil)
+}
@@ -0,0 +1,221 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"mcquay.me/vain"
+	"mcquay.me/vain/client"
+)
+
+var confirmRE = regexp.MustCompile(`/api/v0/confirm/(\S+)`)
+
+// mailbox remembers the last confirmation token mailed.
+type mailbox struct {
+	sync.Mutex
+	tok string
+}
+
+func (m *mailbox) Send(to mail.Address, subject, msg string) error {
+	m.Lock()
+	defer m.Unlock()
+	if ms := confirmRE.FindStringSubmatch(msg); ms != nil {
+		m.tok = ms[1]
+	}
+	return nil
+}
+
+func (m *mailbox) last() string {
+	m.Lock()
+	defer m.Unlock()
+	return m.tok
+}
+
+func server(t *testing.T) (*httptest.Server, *mailbox, func()) {
+	db, done := vain.TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	sm := http.NewServeMux()
+	mb := &mailbox{}
+	vain.NewServer(sm, db, mb, "", 5*time.Minute, true)
+	ts := httptest.NewServer(sm)
+	return ts, mb, func() {
+		ts.Close()
+		done()
+	}
+}
+
+// login registers email and returns a client using its token.
+func login(ctx context.Context, t *testing.T, ts *httptest.Server, mb *mailbox, email string) *client.Client {
+	c := client.New(ts.URL, "")
+	if _, err := c.Register(ctx, email); err != nil {
+		t.Fatalf("couldn't register: %v", err)
+	}
+	tok, err := c.Confirm(ctx, mb.last())
+	if err != nil {
+		t.Fatalf("couldn't confirm: %v", err)
+	}
+	c.Token = tok
+	return c
+}
+
+func TestClient(t *testing.T) {
+	ts, mb, done := server(t)
+	defer done()
+	ctx := context.Background()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	c := client.New(ts.URL, "")
+	msg, err := c.Register(ctx, "sm@example.org")
+	if err != nil {
+		t.Fatalf("couldn't register: %v", err)
+	}
+	if msg == "" {
+		t.Errorf("expected a message from register")
+	}
+	if _, err := c.Register(ctx, "not an email"); !errors.Is(err, client.ErrBadRequest) {
+		t.Errorf("bad email; got %v, want %v", err, client.ErrBadRequest)
+	}
+	if _, err := c.Confirm(ctx, "bogus"); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("bogus confirm; got %v, want %v", err, client.ErrNotFound)
+	}
+	tok, err := c.Confirm(ctx, mb.last())
+	if err != nil {
+		t.Fatalf("couldn't confirm: %v", err)
+	}
+	if err := c.Add(ctx, "foo/bar", client.Package{Repo: "https://example.org/bar"}); !errors.Is(err, client.ErrUnauthorized) {
+		t.Fatalf("add without token; got %v, want %v", err, client.ErrUnauthorized)
+	}
+	c.Token = tok
+
+	if err := c.Add(ctx, "foo/bar", client.Package{Repo: "https://example.org/bar"}); err != nil {
+		t.Fatalf("couldn't add: %v", err)
+	}
+	if err := c.Add(ctx, host+"/foo/baz", client.Package{Vcs: "hg", Repo: "https://example.org/baz"}); err != nil {
+		t.Fatalf("couldn't add by import path: %v", err)
+	}
+	if err := c.Add(ctx, "foo/bar/sub", client.Package{Repo: "https://example.org/sub"}); !errors.Is(err, client.ErrConflict) {
+		t.Fatalf("overlapping add; got %v, want %v", err, client.ErrConflict)
+	}
+	if err := c.Add(ctx, "foo/nope", client.Package{Repo: "https://example.org/nope", Vcs: "cvs"}); !errors.Is(err, client.ErrBadRequest) {
+		t.Fatalf("bad vcs; got %v, want %v", err, client.ErrBadRequest)
+	}
+
+	if err := c.Update(ctx, "foo/bar", client.Package{Docs: "https://docs.example.org/bar"}); err != nil {
+		t.Fatalf("couldn't update: %v", err)
+	}
+	if err := c.Update(ctx, "foo/missing", client.Package{Repo: "https://example.org/x"}); !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("update missing; got %v, want %v", err, client.ErrNotFound)
+	}
+
+	ps, err := c.Packages(ctx)
+	if err != nil {
+		t.Fatalf("couldn't list packages: %v", err)
+	}
+	got := map[string]client.Package{}
+	for _, p := range ps {
+		got[p.Path] = p
+	}
+	if got, want := len(got), 2; got != want {
+		t.Fatalf("bad number of packages; got %d, want %d", got, want)
+	}
+	bar := got[host+"/foo/bar"]
+	if bar.Repo != "https://example.org/bar" || bar.Docs != "https://docs.example.org/bar" || bar.Vcs != "git" {
+		t.Fatalf("update should keep other fields: %+v", bar)
+	}
+	if got, want := got[host+"/foo/baz"].Vcs, "hg"; got != want {
+		t.Fatalf("bad vcs; got %q, want %q", got, want)
+	}
+	for _, pth := range []string{"foo/bar", host + "/foo/bar"} {
+		if p, err := c.Package(ctx, pth); err != nil || p != bar {
+			t.Fatalf("package %s; got %+v, %v, want %+v", pth, p, err, bar)
+		}
+	}
+	if _, err := c.Package(ctx, "foo/bar/sub"); !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("package beneath another; got %v, want %v", err, client.ErrNotFound)
+	}
+	var nerr *client.Error
+	if _, err := c.Package(ctx, "foo/missing"); !errors.As(err, &nerr) || !strings.Contains(nerr.Message, "not found") || strings.Contains(nerr.Message, "{") {
+		t.Fatalf("missing package should carry the server's message; got %#v", err)
+	}
+	if ps, err := c.Namespace(ctx, "foo"); err != nil || len(ps) != 2 {
+		t.Fatalf("namespace foo; got %+v, %v", ps, err)
+	}
+	if ps, err := c.Namespace(ctx, "nope"); err != nil || len(ps) != 0 {
+		t.Fatalf("namespace nope; got %+v, %v", ps, err)
+	}
+
+	if err := c.Delete(ctx, "foo/baz"); err != nil {
+		t.Fatalf("couldn't delete: %v", err)
+	}
+	if err := c.Delete(ctx, "foo/baz"); !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("delete missing; got %v, want %v", err, client.ErrNotFound)
+	}
+
+	other := login(ctx, t, ts, mb, "other@example.org")
+	if err := other.Delete(ctx, "foo/bar"); !errors.Is(err, client.ErrUnauthorized) {
+		t.Fatalf("delete in someone else's namespace; got %v, want %v", err, client.ErrUnauthorized)
+	}
+	var herr *client.Error
+	if err := other.Delete(ctx, "foo/bar"); !errors.As(err, &herr) || herr.Message == "" {
+		t.Fatalf("error should carry the server's message: %#v", err)
+	}
+}
+
+func TestClientTokens(t *testing.T) {
+	ts, mb, done := server(t)
+	defer done()
+	ctx := context.Background()
+
+	c := login(ctx, t, ts, mb, "sm@example.org")
+	first := c.Token
+
+	toks, err := c.Tokens(ctx)
+	if err != nil {
+		t.Fatalf("couldn't list tokens: %v", err)
+	}
+	if got, want := len(toks), 1; got != want {
+		t.Fatalf("bad number of tokens; got %d, want %d", got, want)
+	}
+	if err := c.RevokeToken(ctx, first); !errors.Is(err, client.ErrConflict) {
+		t.Fatalf("revoke last token; got %v, want %v", err, client.ErrConflict)
+	}
+
+	second, err := c.NewToken(ctx)
+	if err != nil {
+		t.Fatalf("couldn't create token: %v", err)
+	}
+	if toks, _ := c.Tokens(ctx); len(toks) != 2 {
+		t.Fatalf("bad number of tokens; got %d, want %d", len(toks), 2)
+	}
+	c.Token = second
+	if err := c.RevokeToken(ctx, first); err != nil {
+		t.Fatalf("couldn't revoke token: %v", err)
+	}
+	c.Token = first
+	if _, err := c.Tokens(ctx); !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("revoked token still works; got %v", err)
+	}
+
+	c.Token = ""
+	if _, err := c.Forgot(ctx, "sm@example.org"); err != nil {
+		t.Fatalf("couldn't request recovery: %v", err)
+	}
+	recovered, err := c.Confirm(ctx, mb.last())
+	if err != nil {
+		t.Fatalf("couldn't confirm recovery: %v", err)
+	}
+	c.Token = recovered
+	if _, err := c.Tokens(ctx); err != nil {
+		t.Fatalf("recovered token doesn't work: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// config is what vain login remembers between invocations.
+type config struct {
+	Server string `json:"server"`
+	Token  string `json:"token"`
+}
+
+// configPath returns where the config lives: $VAIN_CONFIG, or vain/config.json
+// in the user's config directory.
+func configPath() (string, error) {
+	if p := os.Getenv("VAIN_CONFIG"); p != "" {
+		return p, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("couldn't find config directory: %v", err)
+	}
+	return filepath.Join(dir, "vain", "config.json"), nil
+}
+
+func loadConfig(p string) (config, error) {
+	c := config{}
+	b, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("couldn't parse %s: %v", p, err)
+	}
+	return c, nil
+}
+
+// save writes c to p, readable only by the user since it holds a token.
+func (c config) save(p string) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, append(b, '\n'), 0600)
+}
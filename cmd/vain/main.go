@@ -0,0 +1,432 @@
+// Command vain manages packages on a vain server.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"mcquay.me/vain/client"
+)
+
+const usage = `usage: vain [-config FILE] [-server URL] [-o table|json] COMMAND
+
+commands:
+    login [TOKEN]    remember the server and token (read from stdin if not given)
+    add PATH REPO    serve REPO at PATH [-vcs VCS] [-docs URL] [-landing page|redirect]
+    rm PATH          stop serving PATH
+    ls               list packages [-ns NAMESPACE]
+    show PATH        show a single package
+    tokens           list tokens; "tokens new" creates one, "tokens revoke TOKEN" revokes one
+    import FILE      add the packages in FILE ("-" for stdin); either a json list of
+                     {"path", "repo", "vcs"} objects or lines of "PATH REPO [VCS]"
+
+flags:
+`
+
+// errUsage is returned when a command is invoked incorrectly.
+var errUsage = errors.New("bad usage")
+
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	cfgPath string
+	cfg     config
+	json    bool
+	c       *client.Client
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("vain", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	cfgPath := fs.String("config", "", "config file (default $VAIN_CONFIG or vain/config.json in the user config dir)")
+	server := fs.String("server", "", "url of the vain server, e.g. https://go.example.com")
+	out := fs.String("o", "table", "output format: table or json")
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+	if *out != "table" && *out != "json" {
+		fmt.Fprintf(stderr, "vain: unknown output format %q\n", *out)
+		return 2
+	}
+
+	v := &cli{
+		stdin:   stdin,
+		stdout:  stdout,
+		stderr:  stderr,
+		cfgPath: *cfgPath,
+		json:    *out == "json",
+	}
+	if v.cfgPath == "" {
+		p, err := configPath()
+		if err != nil {
+			fmt.Fprintf(stderr, "vain: %v\n", err)
+			return 1
+		}
+		v.cfgPath = p
+	}
+	cfg, err := loadConfig(v.cfgPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "vain: %v\n", err)
+		return 1
+	}
+	if *server != "" {
+		cfg.Server = *server
+	}
+	v.cfg = cfg
+	v.c = client.New(cfg.Server, cfg.Token)
+
+	cmd, rest := fs.Arg(0), fs.Args()[1:]
+	if cmd != "login" && cmd != "help" && cmd != "h" && cfg.Server == "" {
+		fmt.Fprintf(stderr, "vain: no server configured; run vain -server URL login\n")
+		return 1
+	}
+
+	ctx := context.Background()
+	switch cmd {
+	case "login":
+		err = v.login(ctx, rest)
+	case "add":
+		err = v.add(ctx, rest)
+	case "rm":
+		err = v.rm(ctx, rest)
+	case "ls":
+		err = v.ls(ctx, rest)
+	case "show":
+		err = v.show(ctx, rest)
+	case "tokens":
+		err = v.tokens(ctx, rest)
+	case "import":
+		err = v.load(ctx, rest)
+	case "help", "h":
+		fs.Usage()
+		return 0
+	default:
+		fmt.Fprintf(stderr, "vain: unknown command %q\n", cmd)
+		fs.Usage()
+		return 2
+	}
+	if err == errUsage {
+		fs.Usage()
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "vain: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// parse parses the flags of a command, allowing them to come before, after
+// or between its positional arguments, which are returned.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	pos := []string{}
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return pos, nil
+		}
+		pos = append(pos, args[0])
+		args = args[1:]
+	}
+}
+
+func (v *cli) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(v.stderr)
+	return fs
+}
+
+// print writes v as json, or calls table to write it for humans.
+func (v *cli) print(val interface{}, table func(w io.Writer)) {
+	if v.json {
+		enc := json.NewEncoder(v.stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(val)
+		return
+	}
+	tw := tabwriter.NewWriter(v.stdout, 0, 4, 2, ' ', 0)
+	table(tw)
+	tw.Flush()
+}
+
+// result is printed for commands that change things.
+type result struct {
+	Action string `json:"action"`
+	Path   string `json:"path,omitempty"`
+	Token  string `json:"token,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (v *cli) login(ctx context.Context, args []string) error {
+	pos, err := parse(v.flags("login"), args)
+	if err != nil || len(pos) > 1 {
+		return errUsage
+	}
+	if v.cfg.Server == "" {
+		return fmt.Errorf("no server given; run vain -server URL login")
+	}
+	tok := ""
+	if len(pos) == 1 {
+		tok = pos[0]
+	} else {
+		fmt.Fprintf(v.stderr, "token: ")
+		s := bufio.NewScanner(v.stdin)
+		if s.Scan() {
+			tok = strings.TrimSpace(s.Text())
+		}
+	}
+	if tok == "" {
+		return fmt.Errorf("no token given; register at %s/_static/register/ to get one", strings.TrimRight(v.cfg.Server, "/"))
+	}
+
+	v.c.Token = tok
+	if _, err := v.c.Tokens(ctx); err != nil {
+		return fmt.Errorf("couldn't log in to %s: %v", v.cfg.Server, err)
+	}
+	v.cfg.Token = tok
+	if err := v.cfg.save(v.cfgPath); err != nil {
+		return fmt.Errorf("couldn't save config: %v", err)
+	}
+	v.print(result{Action: "login"}, func(w io.Writer) {
+		fmt.Fprintf(w, "logged in to %s\n", v.cfg.Server)
+	})
+	return nil
+}
+
+func (v *cli) add(ctx context.Context, args []string) error {
+	fs := v.flags("add")
+	p := client.Package{}
+	fs.StringVar(&p.Vcs, "vcs", "git", "version control system: git, hg, bzr or svn")
+	fs.StringVar(&p.Docs, "docs", "", "documentation url")
+	fs.StringVar(&p.Landing, "landing", "", "what browsers get: page or redirect")
+	pos, err := parse(fs, args)
+	if err != nil || len(pos) != 2 {
+		return errUsage
+	}
+	p.Repo = pos[1]
+	if err := v.c.Add(ctx, pos[0], p); err != nil {
+		return err
+	}
+	v.print(result{Action: "add", Path: pos[0]}, func(w io.Writer) {
+		fmt.Fprintf(w, "added %s\n", pos[0])
+	})
+	return nil
+}
+
+func (v *cli) rm(ctx context.Context, args []string) error {
+	pos, err := parse(v.flags("rm"), args)
+	if err != nil || len(pos) != 1 {
+		return errUsage
+	}
+	if err := v.c.Delete(ctx, pos[0]); err != nil {
+		return err
+	}
+	v.print(result{Action: "rm", Path: pos[0]}, func(w io.Writer) {
+		fmt.Fprintf(w, "removed %s\n", pos[0])
+	})
+	return nil
+}
+
+func (v *cli) ls(ctx context.Context, args []string) error {
+	fs := v.flags("ls")
+	ns := fs.String("ns", "", "only list packages in this namespace")
+	pos, err := parse(fs, args)
+	if err != nil || len(pos) != 0 {
+		return errUsage
+	}
+	var ps []client.Package
+	if *ns == "" {
+		ps, err = v.c.Packages(ctx)
+	} else {
+		ps, err = v.c.Namespace(ctx, *ns)
+	}
+	if err != nil {
+		return err
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].Path < ps[j].Path })
+	v.print(ps, func(w io.Writer) {
+		fmt.Fprintf(w, "PATH\tVCS\tREPO\n")
+		for _, p := range ps {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", p.Path, p.Vcs, p.Repo)
+		}
+	})
+	return nil
+}
+
+func (v *cli) show(ctx context.Context, args []string) error {
+	pos, err := parse(v.flags("show"), args)
+	if err != nil || len(pos) != 1 {
+		return errUsage
+	}
+	p, err := v.c.Package(ctx, pos[0])
+	if errors.Is(err, client.ErrNotFound) {
+		return fmt.Errorf("package %q not found", pos[0])
+	}
+	if err != nil {
+		return err
+	}
+	v.print(p, func(w io.Writer) {
+		fmt.Fprintf(w, "path:\t%s\n", p.Path)
+		fmt.Fprintf(w, "vcs:\t%s\n", p.Vcs)
+		fmt.Fprintf(w, "repo:\t%s\n", p.Repo)
+		if p.Docs != "" {
+			fmt.Fprintf(w, "docs:\t%s\n", p.Docs)
+		}
+		if p.Landing != "" {
+			fmt.Fprintf(w, "landing:\t%s\n", p.Landing)
+		}
+	})
+	return nil
+}
+
+func (v *cli) tokens(ctx context.Context, args []string) error {
+	pos, err := parse(v.flags("tokens"), args)
+	if err != nil {
+		return errUsage
+	}
+	switch {
+	case len(pos) == 0:
+		toks, err := v.c.Tokens(ctx)
+		if err != nil {
+			return err
+		}
+		v.print(toks, func(w io.Writer) {
+			for _, tok := range toks {
+				current := ""
+				if tok == v.c.Token {
+					current = "\t(current)"
+				}
+				fmt.Fprintf(w, "%s%s\n", tok, current)
+			}
+		})
+	case len(pos) == 1 && pos[0] == "new":
+		tok, err := v.c.NewToken(ctx)
+		if err != nil {
+			return err
+		}
+		v.print(result{Action: "new", Token: tok}, func(w io.Writer) {
+			fmt.Fprintf(w, "%s\n", tok)
+		})
+	case len(pos) == 2 && pos[0] == "revoke":
+		if err := v.c.RevokeToken(ctx, pos[1]); err != nil {
+			return err
+		}
+		v.print(result{Action: "revoke", Token: pos[1]}, func(w io.Writer) {
+			fmt.Fprintf(w, "revoked %s\n", pos[1])
+		})
+	default:
+		return errUsage
+	}
+	return nil
+}
+
+// entry is a package to import.
+type entry struct {
+	Path string `json:"path"`
+	client.Package
+}
+
+// entries parses an import file: a json list of packages or lines of
+// "PATH REPO [VCS]", ignoring blank lines and # comments.
+func entries(b []byte) ([]entry, error) {
+	if t := strings.TrimSpace(string(b)); strings.HasPrefix(t, "[") {
+		es := []entry{}
+		if err := json.Unmarshal(b, &es); err != nil {
+			return nil, fmt.Errorf("couldn't parse json: %v", err)
+		}
+		return es, nil
+	}
+	es := []entry{}
+	for i, line := range strings.Split(string(b), "\n") {
+		if j := strings.Index(line, "#"); j >= 0 {
+			line = line[:j]
+		}
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 0:
+			continue
+		case 2, 3:
+		default:
+			return nil, fmt.Errorf("line %d: want PATH REPO [VCS], got %q", i+1, strings.TrimSpace(line))
+		}
+		e := entry{Path: fields[0]}
+		e.Repo = fields[1]
+		if len(fields) == 3 {
+			e.Vcs = fields[2]
+		}
+		es = append(es, e)
+	}
+	return es, nil
+}
+
+func (v *cli) load(ctx context.Context, args []string) error {
+	pos, err := parse(v.flags("import"), args)
+	if err != nil || len(pos) != 1 {
+		return errUsage
+	}
+	var b []byte
+	if pos[0] == "-" {
+		b, err = ioutil.ReadAll(v.stdin)
+	} else {
+		b, err = ioutil.ReadFile(pos[0])
+	}
+	if err != nil {
+		return err
+	}
+	es, err := entries(b)
+	if err != nil {
+		return err
+	}
+
+	rs := []result{}
+	failed := 0
+	for _, e := range es {
+		r := result{Action: "add", Path: e.Path}
+		if err := v.c.Add(ctx, e.Path, e.Package); err != nil {
+			r.Error = err.Error()
+			failed++
+		}
+		rs = append(rs, r)
+	}
+	v.print(rs, func(w io.Writer) {
+		for _, r := range rs {
+			if r.Error != "" {
+				fmt.Fprintf(w, "%s\tfailed: %s\n", r.Path, r.Error)
+			} else {
+				fmt.Fprintf(w, "%s\tadded\n", r.Path)
+			}
+		}
+		fmt.Fprintf(w, "imported %d of %d packages\n", len(es)-failed, len(es))
+	})
+	if failed > 0 {
+		return fmt.Errorf("%d of %d packages failed to import", failed, len(es))
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"mcquay.me/vain"
+)
+
+var confirmRE = regexp.MustCompile(`/api/v0/confirm/(\S+)`)
+
+type mailbox struct {
+	tok string
+}
+
+func (m *mailbox) Send(to mail.Address, subject, msg string) error {
+	if ms := confirmRE.FindStringSubmatch(msg); ms != nil {
+		m.tok = ms[1]
+	}
+	return nil
+}
+
+type vainFunc func(stdin string, args ...string) (stdout, stderr string, code int)
+
+// setup starts a server with a registered user, returning its url, the
+// user's token, and a function that runs vain with its own config file.
+func setup(t *testing.T) (string, string, vainFunc, func()) {
+	db, done := vain.TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	sm := http.NewServeMux()
+	mb := &mailbox{}
+	vain.NewServer(sm, db, mb, "", 5*time.Minute, true)
+	ts := httptest.NewServer(sm)
+
+	resp, err := http.Post(ts.URL+"/api/v0/register/?email=sm@example.org", "", nil)
+	if err != nil {
+		t.Fatalf("couldn't register: %v", err)
+	}
+	resp.Body.Close()
+	resp, err = http.Get(ts.URL + "/api/v0/confirm/" + mb.tok)
+	if err != nil {
+		t.Fatalf("couldn't confirm: %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	tok := strings.TrimSpace(strings.TrimPrefix(string(b), "new token: "))
+
+	cfg := filepath.Join(t.TempDir(), "config.json")
+	vn := func(stdin string, args ...string) (string, string, int) {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		code := run(append([]string{"-config", cfg}, args...), strings.NewReader(stdin), stdout, stderr)
+		return stdout.String(), stderr.String(), code
+	}
+	return ts.URL, tok, vn, func() {
+		ts.Close()
+		done()
+	}
+}
+
+// loggedIn is setup followed by vain login.
+func loggedIn(t *testing.T) (string, vainFunc, func()) {
+	u, tok, vn, done := setup(t)
+	if _, stderr, code := vn(tok+"\n", "-server", u, "login"); code != 0 {
+		done()
+		t.Fatalf("login: %d %s", code, stderr)
+	}
+	return u, vn, done
+}
+
+func TestLogin(t *testing.T) {
+	u, tok, vn, done := setup(t)
+	defer done()
+
+	if _, _, code := vn("", "ls"); code != 1 {
+		t.Fatalf("ls before login: got %d, want 1", code)
+	}
+	if _, stderr, code := vn("", "-server", u, "login"); code != 1 || !strings.Contains(stderr, "no token") {
+		t.Fatalf("login without token: got %d %q", code, stderr)
+	}
+	if _, stderr, code := vn("", "-server", u, "login", "bogus"); code != 1 || !strings.Contains(stderr, "bogus") {
+		t.Fatalf("login with bad token: got %d %q", code, stderr)
+	}
+	if _, stderr, code := vn("", "-server", u, "login", tok); code != 0 {
+		t.Fatalf("login: %d %s", code, stderr)
+	}
+	// the server and token are remembered
+	if _, stderr, code := vn("", "tokens"); code != 0 {
+		t.Fatalf("tokens after login: %d %s", code, stderr)
+	}
+}
+
+func TestCLIPackages(t *testing.T) {
+	u, vn, done := loggedIn(t)
+	defer done()
+	host := strings.TrimPrefix(u, "http://")
+
+	if out, stderr, code := vn("", "add", "sm/foo", "https://example.org/foo", "-vcs", "hg"); code != 0 {
+		t.Fatalf("add: %d %s", code, stderr)
+	} else if got, want := out, "added sm/foo\n"; got != want {
+		t.Fatalf("add: got %q, want %q", got, want)
+	}
+	if _, _, code := vn("", "add", "sm/foo"); code != 2 {
+		t.Fatalf("add without repo: got %d, want 2", code)
+	}
+	if _, stderr, code := vn("", "add", "sm/foo/bar", "https://example.org/bar"); code != 1 || !strings.Contains(stderr, "409") {
+		t.Fatalf("add of subpackage: got %d %q", code, stderr)
+	}
+
+	out, _, code := vn("", "-o", "json", "show", "sm/foo")
+	if code != 0 {
+		t.Fatalf("show: %d", code)
+	}
+	p := vain.Package{}
+	if err := json.Unmarshal([]byte(out), &p); err != nil {
+		t.Fatalf("show output %q: %v", out, err)
+	}
+	if got, want := p.Path, host+"/sm/foo"; got != want {
+		t.Fatalf("path: got %q, want %q", got, want)
+	}
+	if got, want := p.Vcs, "hg"; got != want {
+		t.Fatalf("vcs: got %q, want %q", got, want)
+	}
+
+	in := `
+# packages
+sm/a https://example.org/a
+sm/b https://example.org/b svn
+sm/foo/c https://example.org/c
+`
+	out, stderr, code := vn(in, "import", "-")
+	if code != 1 {
+		t.Fatalf("import: got %d, want 1: %s", code, stderr)
+	}
+	if !strings.Contains(out, "imported 2 of 3 packages") {
+		t.Fatalf("import output: %q", out)
+	}
+
+	out, _, _ = vn("", "-o", "json", "ls", "-ns", "sm")
+	ps := []vain.Package{}
+	if err := json.Unmarshal([]byte(out), &ps); err != nil {
+		t.Fatalf("ls output %q: %v", out, err)
+	}
+	if got, want := len(ps), 3; got != want {
+		t.Fatalf("packages: got %d, want %d", got, want)
+	}
+	if got, want := ps[0].Path, host+"/sm/a"; got != want {
+		t.Fatalf("first package: got %q, want %q", got, want)
+	}
+	if out, _, _ := vn("", "ls", "-ns", "other"); out != "PATH  VCS  REPO\n" {
+		t.Fatalf("ls of other namespace: %q", out)
+	}
+
+	if _, stderr, code := vn("", "rm", "sm/a"); code != 0 {
+		t.Fatalf("rm: %d %s", code, stderr)
+	}
+	if _, _, code := vn("", "show", "sm/a"); code != 1 {
+		t.Fatalf("show of removed package: got %d, want 1", code)
+	}
+}
+
+func TestCLITokens(t *testing.T) {
+	_, vn, done := loggedIn(t)
+	defer done()
+
+	out, stderr, code := vn("", "tokens", "new")
+	if code != 0 {
+		t.Fatalf("tokens new: %d %s", code, stderr)
+	}
+	tok := strings.TrimSpace(out)
+	out, _, _ = vn("", "-o", "json", "tokens")
+	toks := []string{}
+	if err := json.Unmarshal([]byte(out), &toks); err != nil {
+		t.Fatalf("tokens output %q: %v", out, err)
+	}
+	if got, want := len(toks), 2; got != want {
+		t.Fatalf("tokens: got %d, want %d", got, want)
+	}
+	if _, stderr, code := vn("", "tokens", "revoke", tok); code != 0 {
+		t.Fatalf("revoke: %d %s", code, stderr)
+	}
+	if _, _, code := vn("", "tokens", "frob"); code != 2 {
+		t.Fatalf("bad tokens subcommand: got %d, want 2", code)
+	}
+}
+
+func TestEntries(t *testing.T) {
+	es, err := entries([]byte(`[{"path": "a/b", "repo": "https://example.org/b", "vcs": "hg"}]`))
+	if err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if got, want := len(es), 1; got != want {
+		t.Fatalf("entries: got %d, want %d", got, want)
+	}
+	if es[0].Path != "a/b" || es[0].Vcs != "hg" {
+		t.Fatalf("entry: %+v", es[0])
+	}
+	if _, err := entries([]byte("a/b\n")); err == nil {
+		t.Fatalf("expected error for a line without a repo")
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/mail"
+
+	"mcquay.me/vain"
+)
+
+const adminUsage = `vaind db check <dbname>
+vaind db repair <dbname>
+vaind user add <dbname> <email>
+vaind user disable <dbname> <email>
+vaind user enable <dbname> <email>
+vaind ns transfer <dbname> <namespace> <email>
+vaind pkg add [-vcs git] [-landing page|redirect] [-docs url] <dbname> <path> <repo>
+vaind pkg rm <dbname> <path>`
+
+// adminCmd runs the offline maintenance command named by the first two args,
+// e.g. "db check", against a memdb or bolt store that vaind isn't serving.
+func adminCmd(args []string, stdout, stderr io.Writer) int {
+	usage := func() int {
+		fmt.Fprintf(stderr, "usage:\n%s\n", adminUsage)
+		return 2
+	}
+	if len(args) < 2 {
+		return usage()
+	}
+	cmd := args[0] + " " + args[1]
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	vcs := fs.String("vcs", "git", "version control system: git, hg, bzr or svn")
+	landing := fs.String("landing", "", "landing for browsers: page or redirect")
+	docs := fs.String("docs", "", "documentation url")
+	if err := fs.Parse(args[2:]); err != nil {
+		return usage()
+	}
+	nargs := map[string]int{
+		"db check":     1,
+		"db repair":    1,
+		"user add":     2,
+		"user disable": 2,
+		"user enable":  2,
+		"ns transfer":  3,
+		"pkg add":      3,
+		"pkg rm":       2,
+	}
+	if n, ok := nargs[cmd]; !ok || fs.NArg() != n {
+		return usage()
+	}
+	if cmd != "pkg add" && fs.NFlag() > 0 {
+		return usage()
+	}
+
+	s, release, err := openStore(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	defer release()
+	db, ok := s.(vain.Maintainer)
+	if !ok {
+		fmt.Fprintf(stderr, "%s isn't supported by this store\n", cmd)
+		return 1
+	}
+
+	switch cmd {
+	case "db check":
+		ps := db.Check()
+		for _, p := range ps {
+			fmt.Fprintf(stdout, "%s\n", p)
+		}
+		if len(ps) > 0 {
+			fmt.Fprintf(stderr, "%d problems\n", len(ps))
+			return 1
+		}
+		fmt.Fprintf(stdout, "ok\n")
+		return 0
+	case "db repair":
+		fixed, err := db.Repair()
+		for _, p := range fixed {
+			fmt.Fprintf(stdout, "fixed: %s\n", p)
+		}
+		if err != nil {
+			break
+		}
+		ps := db.Check()
+		for _, p := range ps {
+			fmt.Fprintf(stdout, "remaining: %s\n", p)
+		}
+		if len(ps) > 0 {
+			fmt.Fprintf(stderr, "%d problems need attention; see vaind ns transfer and vaind pkg rm\n", len(ps))
+			return 1
+		}
+		return 0
+	case "user add":
+		if a, perr := mail.ParseAddress(fs.Arg(1)); perr != nil || a.Address != fs.Arg(1) {
+			err = fmt.Errorf("invalid email %q", fs.Arg(1))
+			break
+		}
+		var tok vain.Token
+		tok, err = db.AddUser(vain.Email(fs.Arg(1)))
+		if err == nil {
+			fmt.Fprintf(stdout, "%s\n", tok)
+		}
+	case "user disable", "user enable":
+		err = db.SetDisabled(vain.Email(fs.Arg(1)), args[1] == "disable")
+	case "ns transfer":
+		err = db.TransferNamespace(fs.Arg(1), vain.Email(fs.Arg(2)))
+	case "pkg add":
+		if p, err := db.Package(fs.Arg(1)); err == nil && p.Path == fs.Arg(1) {
+			fmt.Fprintf(stderr, "package %q already exists\n", p.Path)
+			return 1
+		}
+		r := vain.Registry{
+			Version: vain.RegistryVersion,
+			Packages: []vain.Package{{
+				Path:    fs.Arg(1),
+				Repo:    fs.Arg(2),
+				Vcs:     *vcs,
+				Landing: *landing,
+				Docs:    *docs,
+			}},
+		}
+		var errs []vain.RowError
+		errs, err = vain.Import(db, r, vain.Edit{Action: "create"}, false)
+		if len(errs) > 0 {
+			err = fmt.Errorf("%s: %s", errs[0].Key, errs[0].Error)
+		}
+	case "pkg rm":
+		err = db.RemovePath(fs.Arg(1))
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"mcquay.me/vain"
+)
+
+const (
+	importUsage = "vaind import [-dry-run] [-format json|csv] <store> <file|->"
+	exportUsage = "vaind export [-format json|csv] <store>"
+)
+
+// importCmd loads a registry into a db that vaind isn't serving.
+func importCmd(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	dryRun := fs.Bool("dry-run", false, "only check the file")
+	format := fs.String("format", "json", "file format: json or csv")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 2 {
+		fmt.Fprintf(stderr, "usage: %s\n", importUsage)
+		return 2
+	}
+
+	in := stdin
+	if fs.Arg(1) != "-" {
+		f, err := os.Open(fs.Arg(1))
+		if err != nil {
+			fmt.Fprintf(stderr, "couldn't open registry: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		in = f
+	}
+	r, err := vain.ReadRegistry(in, *format)
+	if err != nil {
+		fmt.Fprintf(stderr, "couldn't read registry: %v\n", err)
+		return 1
+	}
+
+	db, unlock, err := openStore(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	defer unlock()
+	errs, err := vain.Import(db, r, vain.Edit{}, *dryRun)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	for _, e := range errs {
+		fmt.Fprintf(stderr, "%s\n", e)
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(stderr, "%d invalid entries; nothing imported\n", len(errs))
+		return 1
+	}
+	verb := "imported"
+	if *dryRun {
+		verb = "would import"
+	}
+	fmt.Fprintf(stdout, "%s %d users, %d namespaces, %d packages\n", verb, len(r.Users), len(r.Namespaces), len(r.Packages))
+	return 0
+}
+
+// exportCmd writes every user, namespace and package in a db.
+func exportCmd(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "json", "file format: json or csv")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		fmt.Fprintf(stderr, "usage: %s\n", exportUsage)
+		return 2
+	}
+	if p, ok := memdbPath(fs.Arg(0)); ok {
+		if _, err := os.Stat(p); err != nil {
+			fmt.Fprintf(stderr, "couldn't open db: %v\n", err)
+			return 1
+		}
+	}
+	db, unlock, err := openStore(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	defer unlock()
+	r, err := vain.Export(db)
+	if err == nil {
+		err = vain.WriteRegistry(stdout, r, *format)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "couldn't export: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// lock takes an exclusive lock on the db at p, so that only one vaind uses
+// it at a time. The lock is released by calling the returned function, or
+// when the process exits.
+func lock(p string) (func(), error) {
+	f, err := os.OpenFile(p+".lock", os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create lock file: %v", err)
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			return nil, fmt.Errorf("%s is in use by another vaind", p)
+		}
+		return nil, fmt.Errorf("couldn't lock %s: %v", p, err)
+	}
+	return func() { f.Close() }, nil
+}
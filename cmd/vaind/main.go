@@ -1,30 +1,66 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"mcquay.me/vain"
+	"mcquay.me/vain/listener"
+	"mcquay.me/vain/metrics"
+	"mcquay.me/vain/tracing"
 
 	"github.com/kelseyhightower/envconfig"
 )
 
-const usage = "vaind <dbname>"
+const usage = `vaind <store>
+` + importUsage + `
+` + exportUsage + `
+` + migrateUsage + `
+` + adminUsage
 
 type config struct {
 	Port     int
+	Listen   string
 	Insecure bool
+	ReadOnly bool `envconfig:"read_only"`
+	Landing  bool
+	DocsBase string `envconfig:"docs_base"`
+
+	// SocketMode is the mode of unix sockets listened on; 0 leaves it to
+	// the umask.
+	SocketMode os.FileMode `envconfig:"socket_mode"`
+
+	PrivateHooks bool `envconfig:"private_hooks"`
+
+	TrustedProxies []string `envconfig:"trusted_proxies"`
 
 	Cert string
 	Key  string
 
 	Static string
 
+	BrandName    string `envconfig:"brand_name"`
+	BrandHost    string `envconfig:"brand_host"`
+	BrandLogo    string `envconfig:"brand_logo"`
+	BrandContact string `envconfig:"brand_contact"`
+	BrandCSS     string `envconfig:"brand_css"`
+
+	DualWrite string `envconfig:"dual_write"`
+
+	CacheSize        int           `envconfig:"cache_size"`
+	CacheTTL         time.Duration `envconfig:"cache_ttl"`
+	CacheNegativeTTL time.Duration `envconfig:"cache_negative_ttl"`
+
+	AdminAddr    string `envconfig:"admin_addr"`
+	PackageLimit int    `envconfig:"package_limit"`
+
 	EmailTimeout time.Duration `envconfig:"email_timeout"`
 
 	SMTPHost string `envconfig:"smtp_host"`
@@ -44,6 +80,11 @@ func main() {
 		Port:         4040,
 		EmailTimeout: 5 * time.Minute,
 		SMTPPort:     25,
+		PackageLimit: metrics.PackageLimit,
+
+		CacheSize:        10000,
+		CacheTTL:         5 * time.Minute,
+		CacheNegativeTTL: 30 * time.Second,
 	}
 	if err := envconfig.Process("vain", c); err != nil {
 		fmt.Fprintf(os.Stderr, "problem processing environment: %v", err)
@@ -52,16 +93,42 @@ func main() {
 	if len(os.Args) > 1 {
 		switch os.Args[1] {
 		case "env", "e":
-			fmt.Printf("VAIN_PORT:           %v\n", c.Port)
-			fmt.Printf("VAIN_INSECURE:       %v\n", c.Insecure)
-			fmt.Printf("VAIN_CERT:           %v\n", c.Cert)
-			fmt.Printf("VAIN_KEY:            %v\n", c.Key)
-			fmt.Printf("VAIN_STATIC:         %v\n", c.Static)
-			fmt.Printf("VAIN_EMAIL_TIMEOUT:  %v\n", c.EmailTimeout)
-			fmt.Printf("VAIN_SMTP_HOST:      %v\n", c.SMTPHost)
-			fmt.Printf("VAIN_SMTP_PORT:      %v\n", c.SMTPPort)
-			fmt.Printf("VAIN_FROM:           %v\n", c.From)
+			fmt.Printf("VAIN_PORT:            %v\n", c.Port)
+			fmt.Printf("VAIN_LISTEN:          %v\n", c.Listen)
+			fmt.Printf("VAIN_SOCKET_MODE:     %#o\n", uint32(c.SocketMode))
+			fmt.Printf("VAIN_TRUSTED_PROXIES: %v\n", strings.Join(c.TrustedProxies, ","))
+			fmt.Printf("VAIN_INSECURE:        %v\n", c.Insecure)
+			fmt.Printf("VAIN_READ_ONLY:       %v\n", c.ReadOnly)
+			fmt.Printf("VAIN_LANDING:         %v\n", c.Landing)
+			fmt.Printf("VAIN_DOCS_BASE:       %v\n", c.DocsBase)
+			fmt.Printf("VAIN_PRIVATE_HOOKS:   %v\n", c.PrivateHooks)
+			fmt.Printf("VAIN_CERT:            %v\n", c.Cert)
+			fmt.Printf("VAIN_KEY:             %v\n", c.Key)
+			fmt.Printf("VAIN_STATIC:          %v\n", c.Static)
+			fmt.Printf("VAIN_BRAND_NAME:      %v\n", c.BrandName)
+			fmt.Printf("VAIN_BRAND_HOST:      %v\n", c.BrandHost)
+			fmt.Printf("VAIN_BRAND_LOGO:      %v\n", c.BrandLogo)
+			fmt.Printf("VAIN_BRAND_CONTACT:   %v\n", c.BrandContact)
+			fmt.Printf("VAIN_BRAND_CSS:       %v\n", c.BrandCSS)
+			fmt.Printf("VAIN_DUAL_WRITE:      %v\n", c.DualWrite)
+			fmt.Printf("VAIN_CACHE_SIZE:      %v\n", c.CacheSize)
+			fmt.Printf("VAIN_CACHE_TTL:       %v\n", c.CacheTTL)
+			fmt.Printf("VAIN_CACHE_NEGATIVE_TTL: %v\n", c.CacheNegativeTTL)
+			fmt.Printf("VAIN_ADMIN_ADDR:      %v\n", c.AdminAddr)
+			fmt.Printf("VAIN_PACKAGE_LIMIT:   %v\n", c.PackageLimit)
+			fmt.Printf("VAIN_EMAIL_TIMEOUT:   %v\n", c.EmailTimeout)
+			fmt.Printf("VAIN_SMTP_HOST:       %v\n", c.SMTPHost)
+			fmt.Printf("VAIN_SMTP_PORT:       %v\n", c.SMTPPort)
+			fmt.Printf("VAIN_FROM:            %v\n", c.From)
 			os.Exit(0)
+		case "db", "user", "ns", "pkg":
+			os.Exit(adminCmd(os.Args[1:], os.Stdout, os.Stderr))
+		case "import":
+			os.Exit(importCmd(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+		case "export":
+			os.Exit(exportCmd(os.Args[2:], os.Stdout, os.Stderr))
+		case "migrate":
+			os.Exit(migrateCmd(os.Args[2:], os.Stdout, os.Stderr))
 		case "help", "h":
 			fmt.Printf("%s\n", usage)
 			os.Exit(0)
@@ -69,18 +136,39 @@ func main() {
 	}
 	log.Printf("%+v", c)
 
-	db, err := vain.NewMemDB(os.Args[1])
+	db, release, err := openStore(os.Args[1])
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "couldn't open db: %v\n", err)
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 
-	sigs := make(chan os.Signal)
+	shutdown, err := tracing.Init(context.Background(), "vaind")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "problem initializing tracing: %v\n", err)
+		os.Exit(1)
+	}
+
+	srv := &http.Server{}
+
+	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		s := <-sigs
 		log.Printf("signal: %+v", s)
-		if err := db.Sync(); err != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Printf("problem draining connections: %+v", err)
+		}
+		cancel()
+		if err := shutdown(context.Background()); err != nil {
+			log.Printf("problem flushing traces: %+v", err)
+		}
+		var err error
+		if s, ok := db.(interface{ Sync() error }); ok {
+			err = s.Sync()
+		}
+		release()
+		if err != nil {
 			log.Printf("problem syncing db to disk: %+v", err)
 			os.Exit(1)
 		}
@@ -99,21 +187,109 @@ func main() {
 	} else {
 		hostname = hn
 	}
-	log.Printf("serving at: http://%s:%d/", hostname, c.Port)
-	sm := http.NewServeMux()
-	vain.NewServer(sm, db, m, c.Static, c.EmailTimeout, c.Insecure)
-	addr := fmt.Sprintf(":%d", c.Port)
+	trusted, err := listener.ParseTrusted(c.TrustedProxies)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "problem parsing trusted proxies: %v\n", err)
+		os.Exit(1)
+	}
 
-	if c.Cert == "" || c.Key == "" {
-		log.Printf("INSECURE MODE")
-		if err := http.ListenAndServe(addr, sm); err != nil {
-			log.Printf("problem with http server: %v", err)
+	metrics.PackageLimit = c.PackageLimit
+	opts := []vain.Option{}
+	if c.AdminAddr != "" {
+		opts = append(opts, vain.NoMetrics())
+	}
+	if c.ReadOnly {
+		opts = append(opts, vain.ReadOnly())
+	}
+	if c.Landing {
+		opts = append(opts, vain.LandingPages())
+	}
+	if c.DocsBase != "" {
+		opts = append(opts, vain.DocsBase(c.DocsBase))
+	}
+	if c.PrivateHooks {
+		opts = append(opts, vain.PrivateHooks())
+	}
+	opts = append(opts, vain.Brand(vain.Branding{
+		Name:    c.BrandName,
+		Host:    c.BrandHost,
+		Logo:    c.BrandLogo,
+		Contact: c.BrandContact,
+		CSS:     c.BrandCSS,
+	}))
+
+	store := db
+	if c.DualWrite != "" {
+		// like the primary's, any lock is held until exit
+		sec, _, err := openStore(c.DualWrite)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "couldn't open dual write store: %v\n", err)
 			os.Exit(1)
 		}
-	} else {
-		if err := http.ListenAndServeTLS(addr, c.Cert, c.Key, sm); err != nil {
-			log.Printf("problem with http server: %v", err)
+		if store, err = vain.DualWrite(db, sec); err != nil {
+			fmt.Fprintf(os.Stderr, "couldn't start dual writing: %v\n", err)
+			os.Exit(1)
+		}
+		log.Printf("dual writing to %s", c.DualWrite)
+	}
+	if c.CacheSize > 0 {
+		store = vain.Cache(store, c.CacheSize, c.CacheTTL, c.CacheNegativeTTL)
+	}
+
+	sm := http.NewServeMux()
+	s := vain.NewServer(sm, store, m, c.Static, c.EmailTimeout, c.Insecure, opts...)
+	srv.RegisterOnShutdown(s.Close)
+	srv.Handler = trusted.Handler(sm)
+
+	usr1 := make(chan os.Signal, 1)
+	signal.Notify(usr1, syscall.SIGUSR1)
+	go func() {
+		for range usr1 {
+			s.SetReadOnly(!s.IsReadOnly())
+			log.Printf("read-only: %t", s.IsReadOnly())
+		}
+	}()
+
+	if c.AdminAddr != "" {
+		am := http.NewServeMux()
+		vain.NewAdmin(am, s, c)
+		l, err := listener.ListenMode(c.AdminAddr, c.SocketMode)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "problem listening for admin endpoints: %v\n", err)
 			os.Exit(1)
 		}
+		log.Printf("serving admin endpoints at: %s", c.AdminAddr)
+		go func() {
+			if err := http.Serve(l, am); err != nil {
+				log.Printf("problem with admin http server: %v", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	addr := c.Listen
+	if addr == "" {
+		addr = fmt.Sprintf(":%d", c.Port)
+		log.Printf("serving at: http://%s:%d/", hostname, c.Port)
+	} else {
+		log.Printf("serving at: %s", addr)
+	}
+	l, err := listener.ListenMode(addr, c.SocketMode)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "problem listening: %v\n", err)
+		os.Exit(1)
+	}
+
+	if c.Cert == "" || c.Key == "" {
+		log.Printf("INSECURE MODE")
+		err = srv.Serve(l)
+	} else {
+		err = srv.ServeTLS(l, c.Cert, c.Key)
+	}
+	if err != http.ErrServerClosed {
+		log.Printf("problem with http server: %v", err)
+		os.Exit(1)
 	}
+	// wait for the signal handler to finish cleaning up
+	select {}
 }
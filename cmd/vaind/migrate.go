@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"mcquay.me/vain"
+)
+
+const migrateUsage = "vaind migrate [-verify] -from <scheme:dsn> -to <scheme:dsn>"
+
+// migrateCmd copies one store into another, empty, one and verifies the
+// copy. With -verify it only compares them.
+func migrateCmd(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	from := fs.String("from", "", "source store, e.g. memdb:vain.db")
+	to := fs.String("to", "", "destination store; one of: "+strings.Join(vain.Backends(), ", "))
+	verify := fs.Bool("verify", false, "only check that the stores match")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 || *from == "" || *to == "" {
+		fmt.Fprintf(stderr, "usage: %s\n", migrateUsage)
+		return 2
+	}
+
+	src, unlock, err := openStore(*from)
+	if err != nil {
+		fmt.Fprintf(stderr, "couldn't open source: %v\n", err)
+		return 1
+	}
+	defer unlock()
+	dst, unlock, err := openStore(*to)
+	if err != nil {
+		fmt.Fprintf(stderr, "couldn't open destination: %v\n", err)
+		return 1
+	}
+	defer unlock()
+
+	op, verb := vain.Migrate, "copied"
+	if *verify {
+		op, verb = vain.Verify, "verified"
+	}
+	s, err := op(src, dst)
+	if err != nil {
+		fmt.Fprintf(stderr, "%v\n", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "%s %v\n", verb, s)
+	return 0
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"mcquay.me/vain"
+)
+
+// memdbPath returns the file name of a memdb store, given as either
+// memdb:FILE or just FILE.
+func memdbPath(u string) (string, bool) {
+	if p := strings.TrimPrefix(u, "memdb:"); p != u {
+		return p, true
+	}
+	return u, !strings.Contains(u, ":")
+}
+
+// openDB locks and opens the memdb at p. The returned function releases the
+// lock.
+func openDB(p string) (*vain.MemDB, func(), error) {
+	unlock, err := lock(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := vain.NewMemDB(p)
+	if err != nil {
+		unlock()
+		return nil, nil, fmt.Errorf("couldn't open db: %v", err)
+	}
+	return db, unlock, nil
+}
+
+// openStore opens the store at u, e.g. bolt:vain.bolt, or a memdb if u is
+// just a file name. The returned function releases the store.
+func openStore(u string) (vain.Storer, func(), error) {
+	if p, ok := memdbPath(u); ok {
+		return openDB(p)
+	}
+	db, err := vain.OpenStore(u)
+	if err != nil {
+		return nil, nil, err
+	}
+	release := func() {}
+	if c, ok := db.(io.Closer); ok {
+		release = func() { c.Close() }
+	}
+	return db, release, nil
+}
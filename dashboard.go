@@ -0,0 +1,363 @@
+package vain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+const (
+	sessionCookie = "vain_session"
+	sessionTTL    = 12 * time.Hour
+	auditEntries  = 20
+)
+
+// session tracks a browser logged into the dashboard.
+type session struct {
+	email   Email
+	csrf    string
+	expires time.Time
+
+	l     sync.Mutex
+	token Token
+	flash string
+}
+
+// sessions is an in-memory store of dashboard sessions keyed by cookie value.
+type sessions struct {
+	sync.Mutex
+	m map[string]*session
+}
+
+func newSessions() *sessions {
+	return &sessions{m: map[string]*session{}}
+}
+
+func (ss *sessions) add(e Email, tok Token) (string, *session) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	id := randomString()
+	s := &session{
+		email:   e,
+		token:   tok,
+		csrf:    randomString(),
+		expires: time.Now().Add(sessionTTL),
+	}
+	ss.m[id] = s
+	return id, s
+}
+
+func (ss *sessions) get(id string) (*session, bool) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	now := time.Now()
+	for k, s := range ss.m {
+		if now.After(s.expires) {
+			delete(ss.m, k)
+		}
+	}
+	s, ok := ss.m[id]
+	return s, ok
+}
+
+func (ss *sessions) remove(id string) {
+	ss.Lock()
+	delete(ss.m, id)
+	ss.Unlock()
+}
+
+// setFlash records msg to be shown on the next render of the dashboard.
+func (s *session) setFlash(msg string) {
+	s.l.Lock()
+	s.flash = msg
+	s.l.Unlock()
+}
+
+// takeFlash returns and clears the pending message.
+func (s *session) takeFlash() string {
+	s.l.Lock()
+	defer s.l.Unlock()
+	msg := s.flash
+	s.flash = ""
+	return msg
+}
+
+// tok returns the token used to authorize against namespaces.
+func (s *session) tok() Token {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.token
+}
+
+// setToken changes the token used to authorize against namespaces.
+func (s *session) setToken(tok Token) {
+	s.l.Lock()
+	s.token = tok
+	s.l.Unlock()
+}
+
+func randomString() string {
+	buf := make([]byte, 32)
+	io.ReadFull(rand.Reader, buf)
+	return hex.EncodeToString(buf)
+}
+
+// dashboardPage is the data used to render the dashboard templates.
+type dashboardPage struct {
+	chrome
+	Host       string
+	Namespaces []namespace
+	Packages   []Package
+	Tokens     []Token
+	Audit      []AuditEntry
+	Vcss       []string
+}
+
+// session returns the logged in session for req, if any.
+func (s *Server) session(req *http.Request) (string, *session, bool) {
+	c, err := req.Cookie(sessionCookie)
+	if err != nil {
+		return "", nil, false
+	}
+	sess, ok := s.sessions.get(c.Value)
+	return c.Value, sess, ok
+}
+
+// authed wraps h so that it is only called for logged in POST requests that
+// carry the session's csrf token.
+func (s *Server) authed(h func(http.ResponseWriter, *http.Request, *session)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != "POST" {
+			http.Error(w, fmt.Sprintf("unsupported method %q; accepted: POST", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		_, sess, ok := s.session(req)
+		if !ok {
+			http.Redirect(w, req, prefix["dashboard"], http.StatusSeeOther)
+			return
+		}
+		req.ParseForm()
+		if subtle.ConstantTimeCompare([]byte(req.Form.Get("csrf")), []byte(sess.csrf)) != 1 {
+			http.Error(w, "invalid csrf token", http.StatusForbidden)
+			return
+		}
+		h(w, req, sess)
+	}
+}
+
+func (s *Server) dashboard(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != prefix["dashboard"] {
+		http.NotFound(w, req)
+		return
+	}
+	_, sess, ok := s.session(req)
+	if !ok {
+		s.render(w, http.StatusOK, "login", chrome{})
+		return
+	}
+	msg := sess.takeFlash()
+	s.renderDashboard(w, req, sess, http.StatusOK, "", msg)
+}
+
+// renderDashboard renders the dashboard for sess with an optional error or
+// message.
+func (s *Server) renderDashboard(w http.ResponseWriter, req *http.Request, sess *session, code int, errMsg, msg string) {
+	db := s.store(req.Context())
+	nss := db.UserNamespaces(sess.email)
+	owned := map[namespace]bool{}
+	for _, ns := range nss {
+		owned[ns] = true
+	}
+	pkgs := []Package{}
+	for _, p := range db.Pkgs() {
+		if owned[pkgNS(p)] {
+			pkgs = append(pkgs, p)
+		}
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Path < pkgs[j].Path })
+	vs := []string{}
+	for v := range vcss {
+		vs = append(vs, v)
+	}
+	sort.Strings(vs)
+
+	s.render(w, code, "dashboard", dashboardPage{
+		chrome: chrome{
+			Email:   sess.email,
+			CSRF:    sess.csrf,
+			Error:   errMsg,
+			Message: msg,
+		},
+		Host:       req.Host,
+		Namespaces: nss,
+		Packages:   pkgs,
+		Tokens:     db.Tokens(sess.email),
+		Audit:      db.AuditLog(sess.email, auditEntries),
+		Vcss:       vs,
+	})
+}
+
+// finish responds to a dashboard action, either redirecting back to the
+// dashboard with msg, or rendering err.
+func (s *Server) finish(w http.ResponseWriter, req *http.Request, sess *session, err error, msg string) {
+	if err := verrors.ToHTTP(err); err != nil {
+		s.renderDashboard(w, req, sess, err.Code, err.Message, "")
+		return
+	}
+	sess.setFlash(msg)
+	http.Redirect(w, req, prefix["dashboard"], http.StatusSeeOther)
+}
+
+func (s *Server) login(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: POST", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	req.ParseForm()
+	tok := Token(strings.TrimSpace(req.Form.Get("token")))
+	e, err := s.store(req.Context()).UserForToken(tok)
+	if tok == "" || err != nil {
+		s.render(w, http.StatusUnauthorized, "login", chrome{Error: "unknown token"})
+		return
+	}
+	id, _ := s.sessions.add(e, tok)
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    id,
+		Path:     prefix["dashboard"],
+		Expires:  time.Now().Add(sessionTTL),
+		HttpOnly: true,
+		Secure:   !s.insecure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, req, prefix["dashboard"], http.StatusSeeOther)
+}
+
+func (s *Server) logout(w http.ResponseWriter, req *http.Request, sess *session) {
+	if id, _, ok := s.session(req); ok {
+		s.sessions.remove(id)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   sessionCookie,
+		Path:   prefix["dashboard"],
+		MaxAge: -1,
+	})
+	http.Redirect(w, req, prefix["dashboard"], http.StatusSeeOther)
+}
+
+// owned authorizes the session against the namespace of the package at pth.
+func owned(db Storer, sess *session, pth string) error {
+	ns, err := parseNamespace(strings.TrimPrefix(pth, strings.SplitN(pth, "/", 2)[0]))
+	if err != nil {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("could not parse namespace: %v", err),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return db.NSForToken(ns, sess.tok())
+}
+
+func (s *Server) addPkg(w http.ResponseWriter, req *http.Request, sess *session) {
+	db := s.store(req.Context())
+	rel := strings.Trim(req.Form.Get("path"), "/")
+	p := Package{
+		Path:    fmt.Sprintf("%s/%s", req.Host, rel),
+		Repo:    strings.TrimSpace(req.Form.Get("repo")),
+		Vcs:     req.Form.Get("vcs"),
+		Landing: req.Form.Get("landing"),
+		Docs:    strings.TrimSpace(req.Form.Get("docs")),
+	}
+	var err error
+	if rel == "" {
+		err = verrors.HTTP{
+			Message: "must provide a path",
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if err == nil {
+		err = owned(db, sess, p.Path)
+	}
+	if err == nil {
+		p.Ns = pkgNS(p)
+		err = addPackage(db, sess.email, p)
+	}
+	s.finish(w, req, sess, err, fmt.Sprintf("added %s", p.Path))
+}
+
+func (s *Server) editPkg(w http.ResponseWriter, req *http.Request, sess *session) {
+	db := s.store(req.Context())
+	p := Package{
+		Path: req.Form.Get("path"),
+	}
+	err := owned(db, sess, p.Path)
+	if err == nil {
+		// start from what is stored so fields not on the form survive
+		if cur, err := db.Package(p.Path); err == nil && cur.Path == p.Path {
+			p = cur
+		}
+		p.Repo = strings.TrimSpace(req.Form.Get("repo"))
+		p.Vcs = req.Form.Get("vcs")
+		p.Landing = req.Form.Get("landing")
+		p.Docs = strings.TrimSpace(req.Form.Get("docs"))
+		p.Ns = pkgNS(p)
+		err = updatePackage(db, sess.email, p)
+	}
+	s.finish(w, req, sess, err, fmt.Sprintf("updated %s", p.Path))
+}
+
+func (s *Server) deletePkg(w http.ResponseWriter, req *http.Request, sess *session) {
+	db := s.store(req.Context())
+	pth := req.Form.Get("path")
+	err := owned(db, sess, pth)
+	if err == nil {
+		err = removePackage(db, sess.email, pth)
+	}
+	s.finish(w, req, sess, err, fmt.Sprintf("deleted %s", pth))
+}
+
+func (s *Server) newToken(w http.ResponseWriter, req *http.Request, sess *session) {
+	db := s.store(req.Context())
+	tok, err := db.AddToken(sess.email)
+	if err == nil {
+		audit(db, sess.email, "token-add", "", "")
+	}
+	s.finish(w, req, sess, err, fmt.Sprintf("new token: %s", tok))
+}
+
+func (s *Server) revokeToken(w http.ResponseWriter, req *http.Request, sess *session) {
+	db := s.store(req.Context())
+	tok := Token(req.Form.Get("token"))
+	err := db.RevokeToken(sess.email, tok)
+	if err == nil {
+		audit(db, sess.email, "token-revoke", "", "")
+		if tok == sess.tok() {
+			if toks := db.Tokens(sess.email); len(toks) > 0 {
+				sess.setToken(toks[0])
+			}
+		}
+	}
+	s.finish(w, req, sess, err, "revoked token")
+}
+
+func addDashboardRoutes(sm *http.ServeMux, s *Server) {
+	d := prefix["dashboard"]
+	sm.Handle(d, instrument("dashboard", http.HandlerFunc(s.dashboard)))
+	sm.Handle(d+"login", instrument("dashboard", http.HandlerFunc(s.login)))
+	sm.Handle(d+"logout", instrument("dashboard", s.authed(s.logout)))
+	sm.Handle(d+"add", instrument("dashboard", s.writable(s.authed(s.addPkg))))
+	sm.Handle(d+"edit", instrument("dashboard", s.writable(s.authed(s.editPkg))))
+	sm.Handle(d+"delete", instrument("dashboard", s.writable(s.authed(s.deletePkg))))
+	sm.Handle(d+"tokens/new", instrument("dashboard", s.writable(s.authed(s.newToken))))
+	sm.Handle(d+"tokens/revoke", instrument("dashboard", s.writable(s.authed(s.revokeToken))))
+}
@@ -0,0 +1,193 @@
+package vain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var csrfRE = regexp.MustCompile(`name="csrf" value="([0-9a-f]+)"`)
+
+func TestDashboard(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatalf("couldn't create cookie jar: %v", err)
+	}
+	client := &http.Client{Jar: jar}
+	d := ts.URL + prefix["dashboard"]
+
+	post := func(action string, v url.Values) (int, string) {
+		resp, err := client.PostForm(d+action, v)
+		if err != nil {
+			t.Fatalf("couldn't POST %s: %v", action, err)
+		}
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("couldn't read body: %v", err)
+		}
+		return resp.StatusCode, string(b)
+	}
+
+	if code, _ := post("login", url.Values{"token": {"bogus"}}); code != http.StatusUnauthorized {
+		t.Fatalf("bad token should not log in; got %s", http.StatusText(code))
+	}
+	code, page := post("login", url.Values{"token": {string(tok)}})
+	if code != http.StatusOK {
+		t.Fatalf("couldn't log in; got %s", http.StatusText(code))
+	}
+	if !strings.Contains(page, "sm@example.org") {
+		t.Fatalf("dashboard should show email:\n%s", page)
+	}
+	m := csrfRE.FindStringSubmatch(page)
+	if m == nil {
+		t.Fatalf("couldn't find csrf token:\n%s", page)
+	}
+	csrf := m[1]
+
+	if code, _ := post("add", url.Values{"path": {"foo"}, "repo": {"https://example.org/foo"}}); code != http.StatusForbidden {
+		t.Fatalf("missing csrf should be forbidden; got %s", http.StatusText(code))
+	}
+	if got, want := len(db.Pkgs()), 0; got != want {
+		t.Fatalf("package should not have been added; got %d, want %d", got, want)
+	}
+
+	code, page = post("add", url.Values{"csrf": {csrf}, "path": {"foo"}, "repo": {"https://example.org/foo"}, "vcs": {"cvs"}})
+	if code != http.StatusBadRequest || !strings.Contains(page, "invalid vcs") {
+		t.Fatalf("bad vcs should be reported; got %s", http.StatusText(code))
+	}
+
+	code, page = post("add", url.Values{"csrf": {csrf}, "path": {"foo"}, "repo": {"https://example.org/foo"}, "vcs": {"git"}})
+	if code != http.StatusOK {
+		t.Fatalf("couldn't add package; got %s:\n%s", http.StatusText(code), page)
+	}
+	pth := fmt.Sprintf("%s/foo", host)
+	if !db.PackageExists(path(pth)) {
+		t.Fatalf("package %q should have been added", pth)
+	}
+	if !strings.Contains(page, "added "+pth) {
+		t.Fatalf("dashboard should confirm addition:\n%s", page)
+	}
+
+	code, page = post("edit", url.Values{"csrf": {csrf}, "path": {pth}, "repo": {"https://example.org/bar"}, "vcs": {"hg"}})
+	if code != http.StatusOK {
+		t.Fatalf("couldn't edit package; got %s:\n%s", http.StatusText(code), page)
+	}
+	p, err := db.Package(pth)
+	if err != nil {
+		t.Fatalf("couldn't fetch package: %v", err)
+	}
+	if got, want := p.Repo, "https://example.org/bar"; got != want {
+		t.Fatalf("repo not updated; got %q, want %q", got, want)
+	}
+	if got, want := p.Vcs, "hg"; got != want {
+		t.Fatalf("vcs not updated; got %q, want %q", got, want)
+	}
+
+	if code, _ := post("tokens/new", url.Values{"csrf": {csrf}}); code != http.StatusOK {
+		t.Fatalf("couldn't create token; got %s", http.StatusText(code))
+	}
+	toks := db.Tokens("sm@example.org")
+	if got, want := len(toks), 2; got != want {
+		t.Fatalf("wrong number of tokens; got %d, want %d", got, want)
+	}
+	if code, _ := post("tokens/revoke", url.Values{"csrf": {csrf}, "token": {string(tok)}}); code != http.StatusOK {
+		t.Fatalf("couldn't revoke token; got %s", http.StatusText(code))
+	}
+	toks = db.Tokens("sm@example.org")
+	if got, want := len(toks), 1; got != want {
+		t.Fatalf("wrong number of tokens; got %d, want %d", got, want)
+	}
+	if code, _ := post("tokens/revoke", url.Values{"csrf": {csrf}, "token": {string(toks[0])}}); code != http.StatusConflict {
+		t.Fatalf("shouldn't be able to revoke last token; got %s", http.StatusText(code))
+	}
+
+	// the session continues to work with the remaining token
+	code, page = post("delete", url.Values{"csrf": {csrf}, "path": {pth}})
+	if code != http.StatusOK {
+		t.Fatalf("couldn't delete package; got %s:\n%s", http.StatusText(code), page)
+	}
+	if db.PackageExists(path(pth)) {
+		t.Fatalf("package %q should have been deleted", pth)
+	}
+	for _, action := range []string{"add", "update", "delete", "token-add", "token-revoke"} {
+		if !strings.Contains(page, "<td>"+action+"</td>") {
+			t.Errorf("missing audit entry for %q", action)
+		}
+	}
+
+	if code, page := post("logout", url.Values{"csrf": {csrf}}); code != http.StatusOK || strings.Contains(page, "sm@example.org") {
+		t.Fatalf("should have logged out; got %s", http.StatusText(code))
+	}
+}
+
+func TestDashboardNamespaceOwnership(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	owner, err := db.addUser("owner@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	if err := db.NSForToken("foo", owner); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	other, err := db.addUser("other@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatalf("couldn't create cookie jar: %v", err)
+	}
+	client := &http.Client{Jar: jar}
+	d := ts.URL + prefix["dashboard"]
+	resp, err := client.PostForm(d+"login", url.Values{"token": {string(other)}})
+	if err != nil {
+		t.Fatalf("couldn't log in: %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	csrf := csrfRE.FindStringSubmatch(string(b))[1]
+
+	resp, err = client.PostForm(d+"add", url.Values{"csrf": {csrf}, "path": {"foo/bar"}, "repo": {"https://example.org/foo"}})
+	if err != nil {
+		t.Fatalf("couldn't POST: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusUnauthorized; got != want {
+		t.Fatalf("shouldn't be able to add to another's namespace; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+}
@@ -3,8 +3,10 @@ package vain
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +25,11 @@ func NewMemDB(p string) (*MemDB, error) {
 
 		Packages:   map[path]Package{},
 		Namespaces: map[namespace]Email{},
+
+		Webhooks:       map[string]Hook{},
+		HookDeliveries: map[string][]Delivery{},
+
+		Revisions: map[path][]Revision{},
 	}
 
 	f, err := os.Open(p)
@@ -31,6 +38,16 @@ func NewMemDB(p string) (*MemDB, error) {
 		return m, nil
 	}
 	err = json.NewDecoder(f).Decode(m)
+	if m.Webhooks == nil {
+		m.Webhooks = map[string]Hook{}
+	}
+	if m.HookDeliveries == nil {
+		m.HookDeliveries = map[string][]Delivery{}
+	}
+	if m.Revisions == nil {
+		m.Revisions = map[path][]Revision{}
+	}
+	m.gauge()
 	return m, err
 }
 
@@ -38,15 +55,32 @@ func NewMemDB(p string) (*MemDB, error) {
 type MemDB struct {
 	filename string
 
-	l sync.RWMutex
+	l        sync.RWMutex
+	watchers []func(Change)
 
 	Users      map[Email]User
 	TokToEmail map[Token]Email
 
 	Packages   map[path]Package
 	Namespaces map[namespace]Email
+
+	Audit []AuditEntry
+
+	Webhooks       map[string]Hook
+	HookDeliveries map[string][]Delivery
+
+	Revisions map[path][]Revision
 }
 
+// maxAudit is the number of audit entries retained by a MemDB.
+const maxAudit = 1000
+
+// maxDeliveries is the number of deliveries retained per hook by a MemDB.
+const maxDeliveries = 100
+
+// maxRevisions is the number of revisions retained per package by a MemDB.
+const maxRevisions = 100
+
 // NSForToken creates an entry namespaces with a relation to the token.
 func (m *MemDB) NSForToken(ns namespace, tok Token) error {
 	m.l.Lock()
@@ -59,11 +93,14 @@ func (m *MemDB) NSForToken(ns namespace, tok Token) error {
 			Code:    http.StatusNotFound,
 		}
 	}
+	if m.Users[e].Disabled {
+		return disabled(e)
+	}
 
 	if owner, ok := m.Namespaces[ns]; !ok {
 		m.Namespaces[ns] = e
 	} else {
-		if m.Namespaces[ns] != owner {
+		if owner != e {
 			return verrors.HTTP{
 				Message: fmt.Sprintf("not authorized against namespace %q", ns),
 				Code:    http.StatusUnauthorized,
@@ -73,6 +110,20 @@ func (m *MemDB) NSForToken(ns namespace, tok Token) error {
 	return m.flush(m.filename)
 }
 
+// UserNamespaces returns the namespaces owned by e.
+func (m *MemDB) UserNamespaces(e Email) []namespace {
+	nss := []namespace{}
+	m.l.RLock()
+	for ns, owner := range m.Namespaces {
+		if owner == e {
+			nss = append(nss, ns)
+		}
+	}
+	m.l.RUnlock()
+	sort.Slice(nss, func(i, j int) bool { return nss[i] < nss[j] })
+	return nss
+}
+
 // Package fetches the package associated with path.
 func (m *MemDB) Package(pth string) (Package, error) {
 	m.l.RLock()
@@ -103,19 +154,66 @@ func (m *MemDB) Package(pth string) (Package, error) {
 }
 
 // AddPackage adds p into packages table.
-func (m *MemDB) AddPackage(p Package) error {
+func (m *MemDB) AddPackage(p Package, ed Edit) error {
 	m.l.Lock()
 	m.Packages[path(p.Path)] = p
+	m.addRevision(ed.revision(actionCreate, p))
+	err := m.flush(m.filename)
 	m.l.Unlock()
-	return m.flush(m.filename)
+	m.notify(Change{Action: actionCreate, Package: p})
+	return err
+}
+
+// UpdatePackage replaces the package stored at p.Path.
+func (m *MemDB) UpdatePackage(p Package, ed Edit) error {
+	m.l.Lock()
+	if _, ok := m.Packages[path(p.Path)]; !ok {
+		m.l.Unlock()
+		return verrors.HTTP{
+			Message: fmt.Sprintf("package %q not found", p.Path),
+			Code:    http.StatusNotFound,
+		}
+	}
+	m.Packages[path(p.Path)] = p
+	m.addRevision(ed.revision(actionUpdate, p))
+	err := m.flush(m.filename)
+	m.l.Unlock()
+	m.notify(Change{Action: actionUpdate, Package: p})
+	return err
 }
 
 // RemovePackage removes package with given path
-func (m *MemDB) RemovePackage(pth path) error {
+func (m *MemDB) RemovePackage(pth path, ed Edit) error {
 	m.l.Lock()
-	delete(m.Packages, pth)
+	p, ok := m.Packages[pth]
+	if ok {
+		delete(m.Packages, pth)
+		m.addRevision(ed.revision(actionDelete, p))
+	}
+	err := m.flush(m.filename)
 	m.l.Unlock()
-	return m.flush(m.filename)
+	if ok {
+		m.notify(Change{Action: actionDelete, Package: p})
+	}
+	return err
+}
+
+// Watch registers fn to be called after every change to a package.
+func (m *MemDB) Watch(fn func(Change)) {
+	m.l.Lock()
+	m.watchers = append(m.watchers, fn)
+	m.l.Unlock()
+}
+
+// notify calls the watchers with c. It must be called without holding the
+// lock so that watchers can use the db.
+func (m *MemDB) notify(c Change) {
+	m.l.RLock()
+	ws := m.watchers
+	m.l.RUnlock()
+	for _, fn := range ws {
+		fn(c)
+	}
 }
 
 // PackageExists tells if a package with path is in the database.
@@ -137,6 +235,11 @@ func (m *MemDB) Pkgs() []Package {
 	return ps
 }
 
+// QueryPackages returns the page of packages selected by q.
+func (m *MemDB) QueryPackages(q Query) (Page, error) {
+	return q.apply(m.Pkgs())
+}
+
 // Register adds email to the database, returning an error if there was one.
 func (m *MemDB) Register(e Email) (Token, error) {
 	m.l.Lock()
@@ -182,6 +285,7 @@ func (m *MemDB) Confirm(tok Token) (Token, error) {
 		}
 	}
 	u.token = tok
+	u.Registered = true
 	m.Users[e] = u
 	m.TokToEmail[tok] = e
 
@@ -202,6 +306,10 @@ func (m *MemDB) Forgot(e Email, window time.Duration) (Token, error) {
 		}
 	}
 
+	if u.Disabled {
+		return "", disabled(e)
+	}
+
 	if u.Requested.After(time.Now()) {
 		return "", verrors.HTTP{
 			Message: fmt.Sprintf("rate limit hit for %q; try again in %0.2f mins", u.Email, u.Requested.Sub(time.Now()).Minutes()),
@@ -212,6 +320,353 @@ func (m *MemDB) Forgot(e Email, window time.Duration) (Token, error) {
 	return u.token, nil
 }
 
+// UserForToken returns the email of the user holding tok.
+func (m *MemDB) UserForToken(tok Token) (Email, error) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	e, ok := m.TokToEmail[tok]
+	if !ok {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("User for token %q not found", tok),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if m.Users[e].Disabled {
+		return "", disabled(e)
+	}
+	return e, nil
+}
+
+// Tokens returns all tokens belonging to e.
+func (m *MemDB) Tokens(e Email) []Token {
+	toks := []Token{}
+	m.l.RLock()
+	for tok, owner := range m.TokToEmail {
+		if owner == e {
+			toks = append(toks, tok)
+		}
+	}
+	m.l.RUnlock()
+	sort.Slice(toks, func(i, j int) bool { return toks[i] < toks[j] })
+	return toks
+}
+
+// AddToken creates an additional token for e.
+func (m *MemDB) AddToken(e Email) (Token, error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if _, ok := m.Users[e]; !ok {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("couldn't find user %q", e),
+			Code:    http.StatusNotFound,
+		}
+	}
+	tok := FreshToken()
+	m.TokToEmail[tok] = e
+	return tok, m.flush(m.filename)
+}
+
+// RevokeToken removes tok from e. A user's last token cannot be revoked.
+func (m *MemDB) RevokeToken(e Email, tok Token) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if owner, ok := m.TokToEmail[tok]; !ok || owner != e {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("token %q not found", tok),
+			Code:    http.StatusNotFound,
+		}
+	}
+	var other Token
+	for t, owner := range m.TokToEmail {
+		if owner == e && t != tok {
+			other = t
+			break
+		}
+	}
+	if other == "" {
+		return verrors.HTTP{
+			Message: "cannot revoke last token",
+			Code:    http.StatusConflict,
+		}
+	}
+	delete(m.TokToEmail, tok)
+	if u, ok := m.Users[e]; ok && u.token == tok {
+		u.token = other
+		m.Users[e] = u
+	}
+	return m.flush(m.filename)
+}
+
+// Record appends a to the audit log, discarding the oldest entries past
+// maxAudit.
+func (m *MemDB) Record(a AuditEntry) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	m.Audit = append(m.Audit, a)
+	if len(m.Audit) > maxAudit {
+		m.Audit = append([]AuditEntry{}, m.Audit[len(m.Audit)-maxAudit:]...)
+	}
+	return m.flush(m.filename)
+}
+
+// AuditLog returns up to n of the most recent audit entries for e, or for
+// everyone if e is empty, newest first.
+func (m *MemDB) AuditLog(e Email, n int) []AuditEntry {
+	as := []AuditEntry{}
+	m.l.RLock()
+	for i := len(m.Audit) - 1; i >= 0 && len(as) < n; i-- {
+		if e == "" || m.Audit[i].Email == e {
+			as = append(as, m.Audit[i])
+		}
+	}
+	m.l.RUnlock()
+	return as
+}
+
+// AddHook stores h.
+func (m *MemDB) AddHook(h Hook) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	m.Webhooks[h.ID] = h
+	return m.flush(m.filename)
+}
+
+// RemoveHook deletes the hook with id, along with its deliveries.
+func (m *MemDB) RemoveHook(id string) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if _, ok := m.Webhooks[id]; !ok {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("hook %q not found", id),
+			Code:    http.StatusNotFound,
+		}
+	}
+	delete(m.Webhooks, id)
+	delete(m.HookDeliveries, id)
+	return m.flush(m.filename)
+}
+
+// Hooks returns all hooks ordered by creation time.
+func (m *MemDB) Hooks() []Hook {
+	hs := []Hook{}
+	m.l.RLock()
+	for _, h := range m.Webhooks {
+		hs = append(hs, h)
+	}
+	m.l.RUnlock()
+	sort.Slice(hs, func(i, j int) bool {
+		if !hs[i].Created.Equal(hs[j].Created) {
+			return hs[i].Created.Before(hs[j].Created)
+		}
+		return hs[i].ID < hs[j].ID
+	})
+	return hs
+}
+
+// RecordDelivery appends d to the deliveries of its hook, discarding the
+// oldest past maxDeliveries.
+func (m *MemDB) RecordDelivery(d Delivery) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if _, ok := m.Webhooks[d.Hook]; !ok {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("hook %q not found", d.Hook),
+			Code:    http.StatusNotFound,
+		}
+	}
+	ds := append(m.HookDeliveries[d.Hook], d)
+	if len(ds) > maxDeliveries {
+		ds = append([]Delivery{}, ds[len(ds)-maxDeliveries:]...)
+	}
+	m.HookDeliveries[d.Hook] = ds
+	return m.flush(m.filename)
+}
+
+// Deliveries returns up to n of the most recent deliveries for hook, newest
+// first.
+func (m *MemDB) Deliveries(hook string, n int) []Delivery {
+	ds := []Delivery{}
+	m.l.RLock()
+	all := m.HookDeliveries[hook]
+	for i := len(all) - 1; i >= 0 && len(ds) < n; i-- {
+		ds = append(ds, all[i])
+	}
+	m.l.RUnlock()
+	return ds
+}
+
+// addRevision adds r to the history of its package, numbered after the newest
+// unless it already has a number, discarding the oldest past maxRevisions. It
+// is for callers holding m.l, and replaces rather than modifies the path's
+// slice of revisions.
+func (m *MemDB) addRevision(r Revision) Revision {
+	pth := path(r.Package.Path)
+	old := m.Revisions[pth]
+	if r.Number == 0 {
+		r.Number = 1
+		if len(old) > 0 {
+			r.Number = old[len(old)-1].Number + 1
+		}
+	}
+	all := []Revision{}
+	for _, o := range old {
+		if o.Number < r.Number {
+			all = append(all, o)
+		}
+	}
+	all = append(all, r)
+	for _, o := range old {
+		if o.Number > r.Number {
+			all = append(all, o)
+		}
+	}
+	// keep the revisions within maxRevisions of the newest
+	rs := []Revision{}
+	for _, o := range all {
+		if o.Number > all[len(all)-1].Number-maxRevisions {
+			rs = append(rs, o)
+		}
+	}
+	m.Revisions[pth] = rs
+	return r
+}
+
+// History returns the revisions of the package at pth, newest first.
+func (m *MemDB) History(pth string) []Revision {
+	rs := []Revision{}
+	m.l.RLock()
+	all := m.Revisions[path(pth)]
+	for i := len(all) - 1; i >= 0; i-- {
+		rs = append(rs, all[i])
+	}
+	m.l.RUnlock()
+	return rs
+}
+
+// Export returns every user, namespace, package and revision, sorted.
+func (m *MemDB) Export() (Registry, error) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	return m.export(), nil
+}
+
+// export is Export for callers holding m.l.
+func (m *MemDB) export() Registry {
+	r := Registry{
+		Version:    RegistryVersion,
+		Users:      []RegistryUser{},
+		Namespaces: []RegistryNamespace{},
+		Packages:   []Package{},
+	}
+	toks := map[Email][]Token{}
+	for t, e := range m.TokToEmail {
+		toks[e] = append(toks[e], t)
+	}
+	for e := range m.Users {
+		ts := toks[e]
+		sort.Slice(ts, func(i, j int) bool { return ts[i] < ts[j] })
+		u := m.Users[e]
+		r.Users = append(r.Users, RegistryUser{Email: e, Tokens: ts, Disabled: u.Disabled, Pending: !u.Registered})
+	}
+	sort.Slice(r.Users, func(i, j int) bool { return r.Users[i].Email < r.Users[j].Email })
+	for ns, e := range m.Namespaces {
+		r.Namespaces = append(r.Namespaces, RegistryNamespace{Name: ns, Owner: e})
+	}
+	sort.Slice(r.Namespaces, func(i, j int) bool { return r.Namespaces[i].Name < r.Namespaces[j].Name })
+	for _, p := range m.Packages {
+		r.Packages = append(r.Packages, p)
+	}
+	sort.Slice(r.Packages, func(i, j int) bool { return r.Packages[i].Path < r.Packages[j].Path })
+	for _, rs := range m.Revisions {
+		r.Revisions = append(r.Revisions, rs...)
+	}
+	sortRevisions(r.Revisions)
+	return r
+}
+
+// Import stores everything in r with a single write to disk, leaving the
+// db as it was if that fails. Existing users gain r's tokens and are
+// registered unless pending in r, existing namespaces and packages are
+// replaced, and revisions are numbered as described by Registry.
+func (m *MemDB) Import(r Registry) error {
+	m.l.Lock()
+	if errs := r.check(m.export()); len(errs) > 0 {
+		m.l.Unlock()
+		return ImportError(errs)
+	}
+
+	users := map[Email]User{}
+	for e, u := range m.Users {
+		users[e] = u
+	}
+	toks := map[Token]Email{}
+	for t, e := range m.TokToEmail {
+		toks[t] = e
+	}
+	nss := map[namespace]Email{}
+	for ns, e := range m.Namespaces {
+		nss[ns] = e
+	}
+	pkgs := map[path]Package{}
+	for pth, p := range m.Packages {
+		pkgs[pth] = p
+	}
+	revs := map[path][]Revision{}
+	for pth, rs := range m.Revisions {
+		revs[pth] = rs
+	}
+
+	for _, ru := range r.Users {
+		u, ok := m.Users[ru.Email]
+		if !ok {
+			u = User{Email: ru.Email, Requested: time.Now(), Disabled: ru.Disabled}
+		}
+		u.Registered = u.Registered || !ru.Pending
+		for _, t := range ru.Tokens {
+			if u.token == "" {
+				u.token = t
+			}
+			m.TokToEmail[t] = ru.Email
+		}
+		m.Users[ru.Email] = u
+	}
+	for _, ns := range r.Namespaces {
+		m.Namespaces[ns.Name] = ns.Owner
+	}
+	changes := []Change{}
+	for _, p := range r.Packages {
+		action := actionCreate
+		if _, ok := m.Packages[path(p.Path)]; ok {
+			action = actionUpdate
+		}
+		m.Packages[path(p.Path)] = p
+		changes = append(changes, Change{Action: action, Package: p})
+	}
+	for _, rev := range r.Revisions {
+		m.addRevision(rev)
+	}
+
+	if err := m.flush(m.filename); err != nil {
+		m.Users, m.TokToEmail, m.Namespaces, m.Packages, m.Revisions = users, toks, nss, pkgs, revs
+		m.gauge()
+		m.l.Unlock()
+		return err
+	}
+	m.l.Unlock()
+
+	for _, c := range changes {
+		m.notify(c)
+	}
+	return nil
+}
+
 // Sync takes a lock, and flushes the data to disk.
 func (m *MemDB) Sync() error {
 	m.l.RLock()
@@ -220,9 +675,18 @@ func (m *MemDB) Sync() error {
 	return m.flush(m.filename)
 }
 
+// Dump takes a lock, and writes the database as json to w.
+func (m *MemDB) Dump(w io.Writer) error {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	return json.NewEncoder(w).Encode(&m)
+}
+
 // flush writes to disk, but expects the user to have taken the lock.
 func (m *MemDB) flush(p string) error {
 	defer metrics.DBTime("flush")()
+	m.gauge()
 	f, err := os.Create(p)
 	if err != nil {
 		return err
@@ -230,21 +694,36 @@ func (m *MemDB) flush(p string) error {
 	return json.NewEncoder(f).Encode(&m)
 }
 
+// gauge updates the object count metrics, but expects the user to have taken
+// the lock.
+func (m *MemDB) gauge() {
+	metrics.Packages.Set(float64(len(m.Packages)))
+	metrics.Users.Set(float64(len(m.Users)))
+	metrics.Namespaces.Set(float64(len(m.Namespaces)))
+}
+
 func (m *MemDB) addUser(e Email) (Token, error) {
 	tok := FreshToken()
 
 	m.l.Lock()
+	defer m.l.Unlock()
 	m.Users[e] = User{
 		Email:     e,
 		token:     tok,
 		Requested: time.Now(),
 	}
 	m.TokToEmail[tok] = e
-	m.l.Unlock()
 
 	return tok, m.flush(m.filename)
 }
 
+func disabled(e Email) error {
+	return verrors.HTTP{
+		Message: fmt.Sprintf("user %q is disabled", e),
+		Code:    http.StatusForbidden,
+	}
+}
+
 func (m *MemDB) user(e Email) (User, error) {
 	m.l.Lock()
 	u, ok := m.Users[e]
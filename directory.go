@@ -0,0 +1,99 @@
+package vain
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// directoryPageSize is the number of packages listed per page of the
+// directory.
+const directoryPageSize = 50
+
+// directoryGroup is a run of packages sharing a namespace.
+type directoryGroup struct {
+	Namespace namespace
+	Packages  []Package
+}
+
+// directoryPage is the data used to render the package directory.
+type directoryPage struct {
+	chrome
+	Query  string
+	Groups []directoryGroup
+	Total  int
+	Page   int
+	Pages  int
+	Prev   string
+	Next   string
+}
+
+func (s *Server) directory(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != prefix["directory"] {
+		http.NotFound(w, req)
+		return
+	}
+	req.ParseForm()
+	q := strings.TrimSpace(req.Form.Get("q"))
+	pg, err := strconv.Atoi(req.Form.Get("page"))
+	if err != nil || pg < 1 {
+		pg = 1
+	}
+
+	pkgs := []Package{}
+	for _, p := range s.store(req.Context()).Pkgs() {
+		if strings.Contains(p.Path, q) {
+			pkgs = append(pkgs, p)
+		}
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Path < pkgs[j].Path })
+
+	pages := (len(pkgs) + directoryPageSize - 1) / directoryPageSize
+	if pages == 0 {
+		pages = 1
+	}
+	if pg > pages {
+		pg = pages
+	}
+	start := (pg - 1) * directoryPageSize
+	end := start + directoryPageSize
+	if end > len(pkgs) {
+		end = len(pkgs)
+	}
+
+	groups := []directoryGroup{}
+	for _, p := range pkgs[start:end] {
+		ns := pkgNS(p)
+		if len(groups) == 0 || groups[len(groups)-1].Namespace != ns {
+			groups = append(groups, directoryGroup{Namespace: ns})
+		}
+		g := &groups[len(groups)-1]
+		g.Packages = append(g.Packages, p)
+	}
+
+	link := func(n int) string {
+		v := url.Values{}
+		if q != "" {
+			v.Set("q", q)
+		}
+		v.Set("page", fmt.Sprintf("%d", n))
+		return prefix["directory"] + "?" + v.Encode()
+	}
+	page := directoryPage{
+		Query:  q,
+		Groups: groups,
+		Total:  len(pkgs),
+		Page:   pg,
+		Pages:  pages,
+	}
+	if pg > 1 {
+		page.Prev = link(pg - 1)
+	}
+	if pg < pages {
+		page.Next = link(pg + 1)
+	}
+	s.render(w, http.StatusOK, "directory", page)
+}
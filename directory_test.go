@@ -0,0 +1,92 @@
+package vain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDirectory(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	for i := 0; i < directoryPageSize+10; i++ {
+		ns := "foo"
+		if i%2 == 0 {
+			ns = "bar"
+		}
+		p := Package{
+			Vcs:  "git",
+			Repo: fmt.Sprintf("https://example.org/%s/%03d", ns, i),
+			Path: fmt.Sprintf("example.org/%s/%03d", ns, i),
+		}
+		if err := db.AddPackage(p, Edit{}); err != nil {
+			t.Fatalf("couldn't add package %v: %v", p, err)
+		}
+	}
+
+	get := func(query string) string {
+		resp, err := http.Get(ts.URL + prefix["directory"] + query)
+		if err != nil {
+			t.Fatalf("couldn't GET directory: %v", err)
+		}
+		defer resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Fatalf("bad status; got %s, want %s", http.StatusText(got), http.StatusText(want))
+		}
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("couldn't read body: %v", err)
+		}
+		return string(b)
+	}
+
+	tests := []struct {
+		query string
+		count int
+		has   []string
+	}{
+		{
+			query: "",
+			count: directoryPageSize,
+			has:   []string{"<h2>bar</h2>", "<h2>foo</h2>", "page 1 of 2", "go get example.org/bar/000"},
+		},
+		{
+			query: "?page=2",
+			count: 10,
+			has:   []string{"<h2>foo</h2>", "page 2 of 2"},
+		},
+		{
+			query: "?q=foo/00",
+			count: 5,
+			has:   []string{"5 packages matching"},
+		},
+		{
+			query: "?q=nothing",
+			count: 0,
+			has:   []string{"0 packages"},
+		},
+	}
+	for _, test := range tests {
+		page := get(test.query)
+		if got, want := strings.Count(page, "<code>go get "), test.count; got != want {
+			t.Errorf("%q: wrong number of packages; got %d, want %d", test.query, got, want)
+		}
+		for _, h := range test.has {
+			if !strings.Contains(page, h) {
+				t.Errorf("%q: page missing %q", test.query, h)
+			}
+		}
+	}
+}
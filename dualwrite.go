@@ -0,0 +1,184 @@
+package vain
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"mcquay.me/vain/metrics"
+)
+
+// dualStore reads from its embedded primary Storer and mirrors writes of
+// users, tokens, namespaces, packages and revisions to secondary. Failures writing to
+// secondary are logged and counted rather than returned, so that the primary
+// stays authoritative.
+type dualStore struct {
+	Storer
+	secondary Storer
+}
+
+// DualWrite returns a Storer that serves from primary while copying every
+// change to users, tokens, namespaces, packages and revisions to secondary, so
+// that a server can be cut over to secondary without losing writes. If
+// secondary is empty primary is first migrated to it; otherwise the two must
+// already match. secondary must be an Importer, and audit entries, webhooks
+// and deliveries are not mirrored.
+func DualWrite(primary, secondary Storer) (Storer, error) {
+	if _, ok := secondary.(Importer); !ok {
+		return nil, fmt.Errorf("secondary store does not support import")
+	}
+	r, err := Export(secondary)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Users)+len(r.Namespaces)+len(r.Packages)+len(r.Revisions) == 0 {
+		_, err = Migrate(primary, secondary)
+	} else {
+		_, err = Verify(primary, secondary)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &dualStore{Storer: primary, secondary: secondary}, nil
+}
+
+// mirror records the outcome of writing op to the secondary.
+func (d *dualStore) mirror(op string, err error) {
+	if err == nil {
+		return
+	}
+	metrics.DualWriteErrors.WithLabelValues(op).Inc()
+	log.Printf("problem mirroring %s to secondary store: %v", op, err)
+}
+
+// mirrorUser copies e's tokens to the secondary, registering e there unless
+// pending. A pending e that is already in the secondary is left as it is.
+func (d *dualStore) mirrorUser(op string, e Email, pending bool, toks ...Token) {
+	_, err := load(d.secondary, Registry{
+		Version: RegistryVersion,
+		Users:   []RegistryUser{{Email: e, Tokens: toks, Pending: pending}},
+	}, nil, false)
+	d.mirror(op, err)
+}
+
+func (d *dualStore) NSForToken(ns namespace, tok Token) error {
+	if err := d.Storer.NSForToken(ns, tok); err != nil {
+		return err
+	}
+	d.mirror("NSForToken", d.secondary.NSForToken(ns, tok))
+	return nil
+}
+
+// Both stores are given the same time for the revision of each change, so
+// that their histories match.
+
+func (d *dualStore) AddPackage(p Package, ed Edit) error {
+	ed = ed.now()
+	if err := d.Storer.AddPackage(p, ed); err != nil {
+		return err
+	}
+	d.mirror("AddPackage", d.secondary.AddPackage(p, ed))
+	return nil
+}
+
+func (d *dualStore) UpdatePackage(p Package, ed Edit) error {
+	ed = ed.now()
+	if err := d.Storer.UpdatePackage(p, ed); err != nil {
+		return err
+	}
+	d.mirror("UpdatePackage", d.secondary.UpdatePackage(p, ed))
+	return nil
+}
+
+func (d *dualStore) RemovePackage(pth path, ed Edit) error {
+	ed = ed.now()
+	if err := d.Storer.RemovePackage(pth, ed); err != nil {
+		return err
+	}
+	d.mirror("RemovePackage", d.secondary.RemovePackage(pth, ed))
+	return nil
+}
+
+// Tokens are generated by the store, so the secondary is given the primary's
+// rather than asked for its own.
+
+func (d *dualStore) Register(e Email) (Token, error) {
+	tok, err := d.Storer.Register(e)
+	if err != nil {
+		return tok, err
+	}
+	d.mirrorUser("Register", e, true, tok)
+	return tok, nil
+}
+
+func (d *dualStore) Confirm(tok Token) (Token, error) {
+	nt, err := d.Storer.Confirm(tok)
+	if err != nil {
+		return nt, err
+	}
+	e, err := d.Storer.UserForToken(nt)
+	if err != nil {
+		d.mirror("Confirm", err)
+		return nt, nil
+	}
+	d.mirrorUser("Confirm", e, false, nt)
+	d.mirror("Confirm", d.secondary.RevokeToken(e, tok))
+	return nt, nil
+}
+
+func (d *dualStore) AddToken(e Email) (Token, error) {
+	tok, err := d.Storer.AddToken(e)
+	if err != nil {
+		return tok, err
+	}
+	// e is already in the secondary, registered or not
+	d.mirrorUser("AddToken", e, true, tok)
+	return tok, nil
+}
+
+func (d *dualStore) RevokeToken(e Email, tok Token) error {
+	if err := d.Storer.RevokeToken(e, tok); err != nil {
+		return err
+	}
+	d.mirror("RevokeToken", d.secondary.RevokeToken(e, tok))
+	return nil
+}
+
+// Export returns the primary's contents.
+func (d *dualStore) Export() (Registry, error) {
+	return Export(d.Storer)
+}
+
+// Import stores r in both stores.
+func (d *dualStore) Import(r Registry) error {
+	imp, ok := d.Storer.(Importer)
+	if !ok {
+		return fmt.Errorf("primary store does not support import")
+	}
+	if err := imp.Import(r); err != nil {
+		return err
+	}
+	d.mirror("Import", d.secondary.(Importer).Import(r))
+	return nil
+}
+
+// Ping reports whether both stores are able to serve requests.
+func (d *dualStore) Ping() error {
+	for _, db := range []Storer{d.Storer, d.secondary} {
+		if p, ok := db.(Pinger); ok {
+			if err := p.Ping(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Dump writes out the primary.
+func (d *dualStore) Dump(w io.Writer) error {
+	dm, ok := d.Storer.(Dumper)
+	if !ok {
+		return fmt.Errorf("store does not support dumping")
+	}
+	return dm.Dump(w)
+}
@@ -0,0 +1,219 @@
+package vain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// eventHistory is the number of events kept for resuming streams.
+	eventHistory = 1000
+
+	// eventBuffer is how many events may be queued for a subscriber before
+	// it is considered too slow and disconnected.
+	eventBuffer = 64
+
+	// heartbeat is how often a comment is written to idle streams.
+	heartbeat = 30 * time.Second
+)
+
+// broker numbers events and fans them out to subscribers, keeping a bounded
+// history so that clients can resume where they left off.
+type broker struct {
+	sync.Mutex
+	// next is the id of the next event. It starts at the time the broker
+	// was created so ids keep increasing across restarts.
+	next    uint64
+	history []Event
+	subs    map[chan Event]bool
+	closed  bool
+}
+
+func newBroker() *broker {
+	return &broker{
+		next: uint64(time.Now().UnixNano() / int64(time.Microsecond)),
+		subs: map[chan Event]bool{},
+	}
+}
+
+// publish assigns ev the next id, records it and sends it to all
+// subscribers.
+func (b *broker) publish(ev Event) Event {
+	b.Lock()
+	defer b.Unlock()
+
+	ev.seq = b.next
+	ev.ID = strconv.FormatUint(ev.seq, 10)
+	b.next++
+	b.history = append(b.history, ev)
+	if len(b.history) > eventHistory {
+		b.history = append([]Event{}, b.history[len(b.history)-eventHistory:]...)
+	}
+	for ch := range b.subs {
+		select {
+		case ch <- ev:
+		default:
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+	return ev
+}
+
+// subscribe returns a channel of future events. If last is the id of an
+// event the client has already seen, the events after it are returned as
+// well; reset reports that they are no longer all available.
+func (b *broker) subscribe(last string) (backlog []Event, ch chan Event, reset bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	ch = make(chan Event, eventBuffer)
+	if b.closed {
+		close(ch)
+		return nil, ch, false
+	}
+	b.subs[ch] = true
+
+	if last == "" {
+		return nil, ch, false
+	}
+	n, err := strconv.ParseUint(last, 10, 64)
+	switch {
+	case err != nil || n >= b.next:
+		return nil, ch, true
+	case n+1 == b.next:
+		return nil, ch, false
+	case len(b.history) == 0 || b.history[0].seq > n+1:
+		return nil, ch, true
+	}
+	for i, ev := range b.history {
+		if ev.seq > n {
+			backlog = append([]Event{}, b.history[i:]...)
+			break
+		}
+	}
+	return backlog, ch, false
+}
+
+func (b *broker) unsubscribe(ch chan Event) {
+	b.Lock()
+	defer b.Unlock()
+	if b.subs[ch] {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
+
+// close ends all streams.
+func (b *broker) close() {
+	b.Lock()
+	defer b.Unlock()
+	b.closed = true
+	for ch := range b.subs {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
+
+// changed is called by the Storer after each change to a package.
+func (s *Server) changed(c Change) {
+	ev := Event{
+		Time:      time.Now(),
+		Action:    c.Action,
+		Path:      c.Package.Path,
+		Namespace: pkgNS(c.Package),
+	}
+	if c.Action != actionDelete {
+		p := c.Package
+		ev.Package = &p
+	}
+	ev = s.events.publish(ev)
+	// the replica that made a change delivers its hooks
+	if !c.Remote {
+		s.hooks.publish(ev)
+	}
+}
+
+// Close ends any open event streams. It is meant to be registered with
+// http.Server.RegisterOnShutdown.
+func (s *Server) Close() {
+	s.events.close()
+}
+
+func writeEvent(w http.ResponseWriter, ev Event) error {
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", ev.ID, ev.Action, b)
+	return err
+}
+
+// stream streams package changes as Server-Sent Events, optionally limited
+// to the namespace given by ?ns=. Clients resume by sending the id of the
+// last event they saw in the Last-Event-ID header (or ?last_event_id=). If
+// the events since then are no longer available a reset event is sent, and
+// the client should fetch the full state again.
+func (s *Server) stream(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: GET", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	req.ParseForm()
+	ns := namespace(req.Form.Get("ns"))
+	last := req.Header.Get("Last-Event-ID")
+	if last == "" {
+		last = req.Form.Get("last_event_id")
+	}
+
+	backlog, ch, reset := s.events.subscribe(last)
+	defer s.events.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if reset {
+		fmt.Fprintf(w, "event: reset\ndata: {}\n\n")
+	}
+	for _, ev := range backlog {
+		if ns != "" && ev.Namespace != ns {
+			continue
+		}
+		if err := writeEvent(w, ev); err != nil {
+			return
+		}
+	}
+	f.Flush()
+
+	t := time.NewTicker(heartbeat)
+	defer t.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-t.C:
+			fmt.Fprintf(w, ": ping\n\n")
+		case ev, ok := <-ch:
+			if !ok {
+				return
+			}
+			if ns != "" && ev.Namespace != ns {
+				continue
+			}
+			if err := writeEvent(w, ev); err != nil {
+				return
+			}
+		}
+		f.Flush()
+	}
+}
@@ -0,0 +1,204 @@
+package vain
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sse is an event as read off of the wire.
+type sse struct {
+	id, event, data string
+}
+
+// readEvents sends the events read from the stream at u on the returned
+// channel until ctx is done.
+func readEvents(ctx context.Context, t *testing.T, u, last string) <-chan sse {
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		t.Fatalf("couldn't create request: %v", err)
+	}
+	req = req.WithContext(ctx)
+	if last != "" {
+		req.Header.Set("Last-Event-ID", last)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("couldn't GET events: %v", err)
+	}
+	if got, want := resp.Header.Get("Content-Type"), "text/event-stream"; got != want {
+		t.Fatalf("bad content type; got %q, want %q", got, want)
+	}
+	ch := make(chan sse)
+	go func() {
+		defer resp.Body.Close()
+		defer close(ch)
+		s := bufio.NewScanner(resp.Body)
+		cur := sse{}
+		for s.Scan() {
+			line := s.Text()
+			switch {
+			case line == "":
+				if cur != (sse{}) {
+					select {
+					case ch <- cur:
+					case <-ctx.Done():
+						return
+					}
+				}
+				cur = sse{}
+			case strings.HasPrefix(line, "id: "):
+				cur.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				cur.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				cur.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	return ch
+}
+
+func nextEvent(t *testing.T, ch <-chan sse) sse {
+	select {
+	case ev, ok := <-ch:
+		if !ok {
+			t.Fatalf("stream ended")
+		}
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for event")
+	}
+	return sse{}
+}
+
+func TestEvents(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	all := readEvents(ctx, t, ts.URL+prefix["events"], "")
+	bar := readEvents(ctx, t, ts.URL+prefix["events"]+"?ns=bar", "")
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	resp := hookReq(t, "POST", ts.URL+"/foo/pkg", tok, `{"repo": "https://example.org/pkg"}`)
+	resp.Body.Close()
+	p := Package{Path: host + "/foo/pkg", Repo: "https://example.org/moved"}
+	if err := updatePackage(db, "sm@example.org", p); err != nil {
+		t.Fatalf("couldn't update package: %v", err)
+	}
+	resp = hookReq(t, "DELETE", ts.URL+"/foo/pkg", tok, "")
+	resp.Body.Close()
+	resp = hookReq(t, "POST", ts.URL+"/bar/pkg", tok, `{"repo": "https://example.org/bar"}`)
+	resp.Body.Close()
+
+	got := []sse{}
+	for _, want := range []string{actionCreate, actionUpdate, actionDelete, actionCreate} {
+		ev := nextEvent(t, all)
+		if ev.event != want {
+			t.Fatalf("bad event; got %q, want %q", ev.event, want)
+		}
+		got = append(got, ev)
+	}
+	prev := uint64(0)
+	for _, ev := range got {
+		id, err := strconv.ParseUint(ev.id, 10, 64)
+		if err != nil || id <= prev {
+			t.Fatalf("ids should increase; got %q after %d", ev.id, prev)
+		}
+		prev = id
+	}
+	e := Event{}
+	if err := json.Unmarshal([]byte(got[1].data), &e); err != nil {
+		t.Fatalf("couldn't decode event: %v", err)
+	}
+	if e.Package == nil || e.Package.Repo != p.Repo || e.ID != got[1].id {
+		t.Fatalf("bad update event: %+v", e)
+	}
+
+	if ev := nextEvent(t, bar); ev.id != got[3].id {
+		t.Fatalf("namespace filter; got %+v, want %+v", ev, got[3])
+	}
+
+	resumed := readEvents(ctx, t, ts.URL+prefix["events"], got[0].id)
+	for _, want := range got[1:] {
+		if ev := nextEvent(t, resumed); ev != want {
+			t.Fatalf("resume; got %+v, want %+v", ev, want)
+		}
+	}
+
+	lost := readEvents(ctx, t, ts.URL+prefix["events"], "bogus")
+	if ev := nextEvent(t, lost); ev.event != "reset" {
+		t.Fatalf("expected reset; got %+v", ev)
+	}
+}
+
+func TestBroker(t *testing.T) {
+	b := newBroker()
+	evs := []Event{}
+	for i := 0; i < eventHistory+10; i++ {
+		evs = append(evs, b.publish(Event{Action: actionCreate, Path: fmt.Sprintf("example.org/ns/%d", i)}))
+	}
+
+	tests := []struct {
+		last    string
+		backlog int
+		reset   bool
+	}{
+		{last: "", backlog: 0},
+		{last: evs[len(evs)-1].ID, backlog: 0},
+		{last: evs[len(evs)-6].ID, backlog: 5},
+		{last: evs[9].ID, backlog: eventHistory},
+		{last: evs[8].ID, reset: true},
+		{last: strconv.FormatUint(evs[len(evs)-1].seq+1, 10), reset: true},
+	}
+	for _, test := range tests {
+		backlog, ch, reset := b.subscribe(test.last)
+		if got, want := len(backlog), test.backlog; got != want {
+			t.Errorf("%+v: bad backlog; got %d, want %d", test, got, want)
+		}
+		if got, want := reset, test.reset; got != want {
+			t.Errorf("%+v: bad reset; got %t, want %t", test, got, want)
+		}
+		b.unsubscribe(ch)
+	}
+
+	// slow subscribers are dropped rather than blocking publishers
+	_, ch, _ := b.subscribe("")
+	for i := 0; i < eventBuffer+1; i++ {
+		b.publish(Event{Action: actionCreate})
+	}
+	n := 0
+	for range ch {
+		n++
+	}
+	if got, want := n, eventBuffer; got != want {
+		t.Fatalf("slow subscriber; got %d events, want %d", got, want)
+	}
+
+	_, ch, _ = b.subscribe("")
+	b.close()
+	if _, ok := <-ch; ok {
+		t.Fatalf("close should end subscriptions")
+	}
+}
@@ -0,0 +1,125 @@
+package vain
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// feedEntries is the most entries a feed has.
+const feedEntries = 100
+
+// feedActions maps the audit actions included in the feed to how they are
+// described.
+var feedActions = map[string]string{
+	"add":      "added",
+	"update":   "updated",
+	"delete":   "deleted",
+	"import":   "imported",
+	"rollback": "rolled back",
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title   string   `xml:"title"`
+	ID      string   `xml:"id"`
+	Updated string   `xml:"updated"`
+	Link    atomLink `xml:"link"`
+	Summary string   `xml:"summary,omitempty"`
+}
+
+// feed serves an Atom feed of recent package changes, optionally limited to
+// the namespace given by ?ns=.
+func (s *Server) feed(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: GET", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	req.ParseForm()
+	ns := namespace(req.Form.Get("ns"))
+	scheme := "https"
+	if s.insecure {
+		scheme = "http"
+	}
+	self := fmt.Sprintf("%s://%s%s", scheme, req.Host, prefix["feed"])
+	if ns != "" {
+		self += "?ns=" + string(ns)
+	}
+
+	f := atomFeed{
+		Title:  fmt.Sprintf("%s packages", s.brand.Name),
+		ID:     self,
+		Link:   []atomLink{{Href: self, Rel: "self"}},
+		Author: atomAuthor{Name: s.brand.Name},
+	}
+	updated := time.Time{}
+	for _, a := range feedItems(s.store(req.Context()), ns) {
+		verb := feedActions[a.Action]
+		if a.Time.After(updated) {
+			updated = a.Time
+		}
+		f.Entries = append(f.Entries, atomEntry{
+			Title:   fmt.Sprintf("%s %s", verb, a.Path),
+			ID:      fmt.Sprintf("tag:%s,%s:%s/%s/%d", req.Host, a.Time.Format("2006-01-02"), a.Action, a.Path, a.Time.UnixNano()),
+			Updated: a.Time.UTC().Format(time.RFC3339),
+			Link:    atomLink{Href: fmt.Sprintf("%s://%s", scheme, a.Path)},
+			Summary: a.Detail,
+		})
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	f.Updated = updated.UTC().Format(time.RFC3339)
+
+	w.Header().Set("Content-type", "application/atom+xml; charset=utf-8")
+	fmt.Fprint(w, xml.Header)
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		fmt.Fprintf(w, "problem encoding feed: %v", err)
+	}
+}
+
+// feedItems returns the newest feedEntries package changes in ns, or in every
+// namespace if ns is empty, reading further back in the audit log until it
+// has found enough or run out.
+func feedItems(db Storer, ns namespace) []AuditEntry {
+	for n := feedEntries; ; n *= 2 {
+		as := db.AuditLog("", n)
+		items := []AuditEntry{}
+		for _, a := range as {
+			if _, ok := feedActions[a.Action]; !ok {
+				continue
+			}
+			if ns != "" && pkgNS(Package{Path: a.Path}) != ns {
+				continue
+			}
+			items = append(items, a)
+			if len(items) == feedEntries {
+				return items
+			}
+		}
+		if len(as) < n {
+			return items
+		}
+	}
+}
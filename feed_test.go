@@ -0,0 +1,93 @@
+package vain
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFeed(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	var tok Token
+	for _, e := range []Email{"a@example.org", "b@example.org"} {
+		var err error
+		tok, err = db.addUser(e)
+		if err != nil {
+			t.Fatalf("failure to add user: %v", err)
+		}
+		ns := strings.Split(string(e), "@")[0]
+		resp := hookReq(t, "POST", fmt.Sprintf("%s/%s/pkg", ts.URL, ns), tok, `{"repo": "https://example.org/pkg"}`)
+		resp.Body.Close()
+		if ns == "a" {
+			resp := hookReq(t, "DELETE", fmt.Sprintf("%s/%s/pkg", ts.URL, ns), tok, "")
+			resp.Body.Close()
+			// undoing the delete
+			resp = hookReq(t, "POST", ts.URL+prefix["v1-rollback"]+ns+"/pkg", tok, `{"revision": 1}`)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("rollback: got %s", resp.Status)
+			}
+		}
+	}
+	// every imported package is listed
+	resp := hookReq(t, "POST", ts.URL+prefix["v1-bulk"], tok, `{"version": 1, "packages": [{"path": "`+host+`/b/x", "repo": "https://example.org/x"}, {"path": "`+host+`/b/y", "repo": "https://example.org/y"}]}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("import: got %s", resp.Status)
+	}
+	// changes in a namespace aren't lost behind many in others, nor behind
+	// entries that aren't package changes
+	for i := 0; i < feedEntries+10; i++ {
+		db.Record(AuditEntry{Email: "b@example.org", Action: "token", Path: host + "/b/pkg"})
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"imported " + host + "/b/y", "imported " + host + "/b/x", "added " + host + "/b/pkg", "rolled back " + host + "/a/pkg", "deleted " + host + "/a/pkg", "added " + host + "/a/pkg"}},
+		{"?ns=a", []string{"rolled back " + host + "/a/pkg", "deleted " + host + "/a/pkg", "added " + host + "/a/pkg"}},
+		{"?ns=nope", nil},
+	}
+	for _, test := range tests {
+		resp, err := http.Get(ts.URL + prefix["feed"] + test.query)
+		if err != nil {
+			t.Fatalf("couldn't GET feed: %v", err)
+		}
+		if got, want := resp.Header.Get("Content-Type"), "application/atom+xml; charset=utf-8"; got != want {
+			t.Errorf("bad content type; got %q, want %q", got, want)
+		}
+		f := atomFeed{}
+		if err := xml.NewDecoder(resp.Body).Decode(&f); err != nil {
+			t.Fatalf("couldn't decode feed: %v", err)
+		}
+		resp.Body.Close()
+		got := []string{}
+		for _, e := range f.Entries {
+			got = append(got, e.Title)
+			if e.ID == "" || e.Updated == "" {
+				t.Errorf("incomplete entry: %+v", e)
+			}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(test.want) {
+			t.Errorf("%q: got %v, want %v", test.query, got, test.want)
+		}
+		if f.Title == "" || f.ID == "" || f.Updated == "" {
+			t.Errorf("incomplete feed: %+v", f)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package vain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// Actions of revisions other than creates, updates and deletes.
+const (
+	// actionRollback restored an earlier revision.
+	actionRollback = "rollback"
+	// actionImport stored the package from a Registry.
+	actionImport = "import"
+)
+
+// rollback restores revision n of the package at pth on behalf of e, who
+// must already have been authorized against pth's namespace, and returns the
+// package as restored.
+func rollback(db Storer, e Email, pth string, n int) (Package, error) {
+	var rev *Revision
+	for _, r := range db.History(pth) {
+		if r.Number == n {
+			rev = &r
+			break
+		}
+	}
+	if rev == nil {
+		return Package{}, verrors.HTTP{
+			Message: fmt.Sprintf("revision %d of %q not found", n, pth),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if rev.Action == actionDelete {
+		return Package{}, verrors.HTTP{
+			Message: fmt.Sprintf("revision %d of %q deleted it; delete the package instead", n, pth),
+			Code:    http.StatusConflict,
+		}
+	}
+
+	p := rev.Package
+	if err := validate(&p); err != nil {
+		return Package{}, err
+	}
+	var err error
+	ed := Edit{Email: e, Action: actionRollback}
+	if db.PackageExists(path(pth)) {
+		err = db.UpdatePackage(p, ed)
+	} else {
+		if !Valid(p.Path, db.Pkgs()) {
+			return Package{}, verrors.HTTP{
+				Message: fmt.Sprintf("invalid path; prefix already taken %q", p.Path),
+				Code:    http.StatusConflict,
+			}
+		}
+		err = db.AddPackage(p, ed)
+	}
+	// e.g. the package was deleted or its prefix taken since it was checked
+	if _, ok := err.(verrors.HTTP); ok {
+		return Package{}, err
+	}
+	if err != nil {
+		return Package{}, verrors.HTTP{
+			Message: fmt.Sprintf("unable to roll back package: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	audit(db, e, "rollback", pth, fmt.Sprintf("to revision %d", n))
+	return p, nil
+}
+
+// apiHistory serves package history to the owner of the package's namespace:
+//
+//	GET  history/{path}   list revisions, newest first
+//	POST rollback/{path}  restore {"revision": n}
+//
+// {path} is the import path, with or without the host.
+func (s *Server) apiHistory(w http.ResponseWriter, req *http.Request) {
+	db := s.store(req.Context())
+	route, accepted := "v1-history", "GET"
+	if strings.HasPrefix(req.URL.Path, strings.TrimSuffix(prefix["v1-rollback"], "/")) {
+		route, accepted = "v1-rollback", "POST"
+	}
+	if req.Method != accepted {
+		apiMethods(w, req, accepted)
+		return
+	}
+	rest := strings.Trim(strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(prefix[route], "/")), "/")
+	rest = strings.TrimPrefix(rest, req.Host+"/")
+	if rest == "" {
+		apiNotFound(w, req)
+		return
+	}
+	pth := req.Host + "/" + rest
+	if route == "v1-rollback" && s.apiRefuse(w) {
+		return
+	}
+	e, ok := apiUser(w, req, db)
+	if !ok {
+		return
+	}
+	ns, err := parseNamespace(rest)
+	if err != nil {
+		apiFailf(w, http.StatusBadRequest, "could not parse namespace: %v", err)
+		return
+	}
+	mine := false
+	for _, n := range db.UserNamespaces(e) {
+		mine = mine || n == ns
+	}
+	if !mine {
+		apiFailf(w, http.StatusUnauthorized, "not authorized against namespace %q", ns)
+		return
+	}
+
+	if route == "v1-history" {
+		writeJSON(w, db.History(pth))
+		return
+	}
+	in := struct {
+		Revision int `json:"revision"`
+	}{}
+	if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+		apiFailf(w, http.StatusBadRequest, "unable to parse json from body: %v", err)
+		return
+	}
+	p, err := rollback(db, e, pth, in.Revision)
+	if err := verrors.ToHTTP(err); err != nil {
+		apiFail(w, err)
+		return
+	}
+	writeJSON(w, p)
+}
@@ -0,0 +1,133 @@
+package vain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// goneStore loses every package between checking for it and updating it.
+type goneStore struct {
+	Storer
+}
+
+func (g *goneStore) UpdatePackage(p Package, ed Edit) error {
+	return verrors.HTTP{Message: fmt.Sprintf("package %q not found", p.Path), Code: http.StatusNotFound}
+}
+
+func TestHistory(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+	pkgs := ts.URL + prefix["v1-packages"]
+	hist := ts.URL + prefix["v1-history"]
+	rb := ts.URL + prefix["v1-rollback"]
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	other, err := db.addUser("other@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	db.NSForToken("mc", other)
+
+	history := func() []Revision {
+		t.Helper()
+		resp := hookReq(t, "GET", hist+"sm/foo", tok, "")
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("history: got %s", resp.Status)
+		}
+		rs := []Revision{}
+		if err := json.NewDecoder(resp.Body).Decode(&rs); err != nil {
+			t.Fatalf("couldn't decode history: %v", err)
+		}
+		return rs
+	}
+	summary := func(rs []Revision) string {
+		s := []string{}
+		for _, r := range rs {
+			s = append(s, fmt.Sprintf("%d %s %s %s", r.Number, r.Email, r.Action, r.Package.Repo))
+		}
+		return strings.Join(s, "; ")
+	}
+
+	for _, req := range []struct{ method, body string }{
+		{"PUT", `{"repo": "https://example.org/foo"}`},
+		{"PATCH", `{"repo": "https://example.org/oops"}`},
+		{"DELETE", ""},
+	} {
+		resp := hookReq(t, req.method, pkgs+"sm/foo", tok, req.body)
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			t.Fatalf("%s: got %s", req.method, resp.Status)
+		}
+	}
+	rs := history()
+	if got, want := summary(rs), "3 sm@example.org delete https://example.org/oops; 2 sm@example.org update https://example.org/oops; 1 sm@example.org create https://example.org/foo"; got != want {
+		t.Fatalf("history:\ngot  %s\nwant %s", got, want)
+	}
+	if rs[0].Time.IsZero() || rs[0].Package.Path != host+"/sm/foo" {
+		t.Fatalf("revision: got %+v", rs[0])
+	}
+
+	apiErr(t, hookReq(t, "GET", hist+"sm/foo", "", ""), http.StatusUnauthorized, "unauthorized")
+	apiErr(t, hookReq(t, "GET", hist+"sm/foo", other, ""), http.StatusUnauthorized, "unauthorized")
+	apiErr(t, hookReq(t, "POST", hist+"sm/foo", tok, ""), http.StatusMethodNotAllowed, "method_not_allowed")
+	apiErr(t, hookReq(t, "POST", rb+"sm/foo", other, `{"revision": 1}`), http.StatusUnauthorized, "unauthorized")
+	apiErr(t, hookReq(t, "POST", rb+"sm/foo", tok, `{`), http.StatusBadRequest, "bad_request")
+	apiErr(t, hookReq(t, "POST", rb+"sm/foo", tok, `{"revision": 4}`), http.StatusNotFound, "not_found")
+	apiErr(t, hookReq(t, "POST", rb+"sm/foo", tok, `{"revision": 3}`), http.StatusConflict, "conflict")
+
+	// restoring a deleted package recreates it, and restoring over an
+	// existing one replaces it
+	for _, n := range []int{2, 1} {
+		resp := hookReq(t, "POST", rb+"sm/foo", tok, fmt.Sprintf(`{"revision": %d}`, n))
+		p := Package{}
+		json.NewDecoder(resp.Body).Decode(&p)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || p.Repo != rs[3-n].Package.Repo {
+			t.Fatalf("rollback to %d: got %s, %+v", n, resp.Status, p)
+		}
+	}
+	if p, err := db.Package(host + "/sm/foo"); err != nil || p.Repo != "https://example.org/foo" {
+		t.Fatalf("after rollback: got %+v, %v", p, err)
+	}
+	if got, want := summary(history()[:2]), "5 sm@example.org rollback https://example.org/foo; 4 sm@example.org rollback https://example.org/oops"; got != want {
+		t.Fatalf("history after rollback:\ngot  %s\nwant %s", got, want)
+	}
+	if as := db.AuditLog("sm@example.org", 1); len(as) != 1 || as[0].Action != "rollback" || as[0].Detail != "to revision 1" {
+		t.Fatalf("audit: got %+v", as)
+	}
+
+	// failures the store reports as http errors keep their status
+	racy := &goneStore{Storer: db}
+	if _, err := rollback(racy, "sm@example.org", host+"/sm/foo", 2); verrors.ToHTTP(err) == nil || verrors.ToHTTP(err).Code != http.StatusNotFound {
+		t.Fatalf("rollback of a package deleted meanwhile: got %v", err)
+	}
+
+	// imports are versioned too
+	resp := hookReq(t, "POST", ts.URL+prefix["v1-bulk"], tok, `{"version": 1, "packages": [{"path": "`+host+`/sm/foo", "repo": "https://example.org/imported"}]}`)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("import: got %s", resp.Status)
+	}
+	if got, want := summary(history()[:1]), "6 sm@example.org import https://example.org/imported"; got != want {
+		t.Fatalf("history after import: got %s, want %s", got, want)
+	}
+}
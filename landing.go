@@ -0,0 +1,57 @@
+package vain
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// landingRefresh is how many seconds a landing page waits before sending the
+// browser on to the repository.
+const landingRefresh = 5
+
+// landingData is the data used to render a package landing page.
+type landingData struct {
+	chrome
+	ImportPath string
+	Package    Package
+	Docs       string
+	Refresh    string
+}
+
+// wantsLanding reports whether browsers visiting p should get a landing page
+// rather than a redirect.
+func (s *Server) wantsLanding(p Package) bool {
+	switch p.Landing {
+	case landingPage:
+		return true
+	case landingRedirect:
+		return false
+	}
+	return s.landing
+}
+
+// renderLanding writes the landing page for the package p, as reached by req.
+func (s *Server) renderLanding(w http.ResponseWriter, req *http.Request, p Package) {
+	ip := strings.TrimRight(req.Host+req.URL.Path, "/")
+	d := landingData{
+		ImportPath: ip,
+		Package:    p,
+		Docs:       s.docsURL(p, ip),
+	}
+	// only refresh to web urls; the repo is user supplied
+	if u, err := url.Parse(p.Repo); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		d.Refresh = p.Repo
+	}
+	s.render(w, http.StatusOK, "landing-page", d)
+}
+
+// docsURL returns where documentation for the import path ip, which is p or
+// one of its subpackages, lives.
+func (s *Server) docsURL(p Package, ip string) string {
+	ip = strings.TrimRight(ip, "/")
+	if p.Docs == "" {
+		return s.docs + ip
+	}
+	return strings.TrimRight(p.Docs, "/") + strings.TrimPrefix(ip, p.Path)
+}
@@ -0,0 +1,226 @@
+package vain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLanding(t *testing.T) {
+	noFollow := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	tests := []struct {
+		global  bool
+		landing string
+		want    int
+	}{
+		{global: false, landing: "", want: http.StatusTemporaryRedirect},
+		{global: false, landing: landingPage, want: http.StatusOK},
+		{global: false, landing: landingRedirect, want: http.StatusTemporaryRedirect},
+		{global: true, landing: "", want: http.StatusOK},
+		{global: true, landing: landingPage, want: http.StatusOK},
+		{global: true, landing: landingRedirect, want: http.StatusTemporaryRedirect},
+	}
+	for _, test := range tests {
+		db, done := TestDB(t)
+		if db == nil {
+			t.Fatalf("could not create temp db")
+		}
+
+		opts := []Option{}
+		if test.global {
+			opts = append(opts, LandingPages())
+		}
+		sm := http.NewServeMux()
+		NewServer(sm, db, nil, "", window, true, opts...)
+		ts := httptest.NewServer(sm)
+
+		p := Package{
+			Vcs:     "git",
+			Repo:    "https://example.org/foo",
+			Path:    fmt.Sprintf("%s/foo", strings.TrimPrefix(ts.URL, "http://")),
+			Landing: test.landing,
+		}
+		if err := db.AddPackage(p, Edit{}); err != nil {
+			t.Fatalf("couldn't add package %v: %v", p, err)
+		}
+
+		resp, err := noFollow.Get(ts.URL + "/foo/bar")
+		if err != nil {
+			t.Fatalf("couldn't GET: %v", err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if got := resp.StatusCode; got != test.want {
+			t.Errorf("%+v: got %s, want %s", test, http.StatusText(got), http.StatusText(test.want))
+		}
+		if resp.StatusCode == http.StatusTemporaryRedirect {
+			if got, want := resp.Header.Get("Location"), "https://pkg.go.dev/"+p.Path+"/bar"; got != want {
+				t.Errorf("%+v: bad redirect; got %q, want %q", test, got, want)
+			}
+		} else {
+			page := string(b)
+			for _, want := range []string{
+				"go get " + p.Path + "/bar",
+				"https://pkg.go.dev/" + p.Path + "/bar",
+				`http-equiv="refresh"`,
+				`<meta name="go-import" content="` + p.Path + ` git https://example.org/foo">`,
+			} {
+				if !strings.Contains(page, want) {
+					t.Errorf("%+v: landing page missing %q", test, want)
+				}
+			}
+		}
+
+		ts.Close()
+		done()
+	}
+}
+
+func TestBadLanding(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+
+	body := strings.NewReader(`{"repo": "https://example.org/foo", "landing": "sometimes"}`)
+	req, err := http.NewRequest("POST", ts.URL+"/foo", body)
+	if err != nil {
+		t.Fatalf("couldn't create request: %v", err)
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("couldn't POST: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Fatalf("bad landing should be rejected; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+}
+
+func TestLandingRefreshOnlyWeb(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true, LandingPages())
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	p := Package{
+		Vcs:  "git",
+		Repo: "ssh://git@example.org/foo",
+		Path: fmt.Sprintf("%s/foo", strings.TrimPrefix(ts.URL, "http://")),
+	}
+	if err := db.AddPackage(p, Edit{}); err != nil {
+		t.Fatalf("couldn't add package %v: %v", p, err)
+	}
+	resp, err := http.Get(ts.URL + "/foo")
+	if err != nil {
+		t.Fatalf("couldn't GET: %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if strings.Contains(string(b), `http-equiv="refresh"`) {
+		t.Fatalf("should not refresh to non-web repo:\n%s", b)
+	}
+}
+
+func TestDocsRedirect(t *testing.T) {
+	noFollow := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	tests := []struct {
+		base string
+		docs string
+		url  string
+		want string
+	}{
+		{url: "/foo", want: "https://pkg.go.dev/%s/foo"},
+		{url: "/foo/bar/baz", want: "https://pkg.go.dev/%s/foo/bar/baz"},
+		{base: "https://docs.example.org", url: "/foo/bar", want: "https://docs.example.org/%s/foo/bar"},
+		{base: "https://docs.example.org/", docs: "https://example.org/foo/docs/", url: "/foo", want: "https://example.org/foo/docs"},
+		{docs: "https://example.org/foo/docs", url: "/foo/bar/", want: "https://example.org/foo/docs/bar"},
+	}
+	for _, test := range tests {
+		db, done := TestDB(t)
+		if db == nil {
+			t.Fatalf("could not create temp db")
+		}
+
+		opts := []Option{}
+		if test.base != "" {
+			opts = append(opts, DocsBase(test.base))
+		}
+		sm := http.NewServeMux()
+		NewServer(sm, db, nil, "", window, true, opts...)
+		ts := httptest.NewServer(sm)
+		host := strings.TrimPrefix(ts.URL, "http://")
+
+		p := Package{
+			Vcs:  "git",
+			Repo: "https://example.org/foo",
+			Path: host + "/foo",
+			Docs: test.docs,
+		}
+		if err := db.AddPackage(p, Edit{}); err != nil {
+			t.Fatalf("couldn't add package %v: %v", p, err)
+		}
+
+		resp, err := noFollow.Get(ts.URL + test.url)
+		if err != nil {
+			t.Fatalf("couldn't GET: %v", err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusTemporaryRedirect; got != want {
+			t.Errorf("%+v: got %s, want %s", test, http.StatusText(got), http.StatusText(want))
+		}
+		want := test.want
+		if strings.Contains(want, "%s") {
+			want = fmt.Sprintf(want, host)
+		}
+		if got := resp.Header.Get("Location"); got != want {
+			t.Errorf("%+v: bad redirect; got %q, want %q", test, got, want)
+		}
+
+		ts.Close()
+		done()
+	}
+}
+
+func TestBadDocs(t *testing.T) {
+	for _, docs := range []string{"javascript:alert(1)", "/relative", "https://"} {
+		p := Package{Repo: "https://example.org/foo", Docs: docs}
+		if err := validate(&p); err == nil {
+			t.Errorf("docs %q should be rejected", docs)
+		}
+	}
+	p := Package{Repo: "https://example.org/foo", Docs: "https://example.org/foo/docs"}
+	if err := validate(&p); err != nil {
+		t.Errorf("unexpected error for valid docs: %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+// Package listener creates the net.Listeners vaind serves on.
+//
+// Addresses take one of the following forms:
+//
+//	:4040                  tcp
+//	unix:/run/vaind.sock   unix domain socket
+//	systemd:               first socket passed by systemd (LISTEN_FDS)
+//	systemd:name           socket passed by systemd with FileDescriptorName=name
+package listener
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	unixPrefix    = "unix:"
+	systemdPrefix = "systemd:"
+
+	// sdListenFDsStart is the first file descriptor passed by systemd.
+	sdListenFDsStart = 3
+)
+
+var inherited struct {
+	sync.Once
+	files map[string][]*os.File
+	order []*os.File
+	err   error
+}
+
+// Listen announces on addr. Unix sockets are created subject to the process
+// umask.
+func Listen(addr string) (net.Listener, error) {
+	return ListenMode(addr, 0)
+}
+
+// ListenMode is Listen, giving unix sockets mode unless it is 0.
+func ListenMode(addr string, mode os.FileMode) (net.Listener, error) {
+	switch {
+	case strings.HasPrefix(addr, unixPrefix):
+		p := strings.TrimPrefix(addr, unixPrefix)
+		if err := removeStale(p); err != nil {
+			return nil, err
+		}
+		l, err := net.Listen("unix", p)
+		if err != nil {
+			return nil, err
+		}
+		if mode != 0 {
+			if err := os.Chmod(p, mode); err != nil {
+				l.Close()
+				return nil, err
+			}
+		}
+		return l, nil
+	case strings.HasPrefix(addr, systemdPrefix):
+		return systemd(strings.TrimPrefix(addr, systemdPrefix))
+	}
+	return net.Listen("tcp", addr)
+}
+
+// removeStale removes a socket left at p by a previous run. Anything other
+// than a socket at p is an error, so that a mistyped address doesn't delete
+// a file.
+func removeStale(p string) error {
+	fi, err := os.Lstat(p)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%q exists and is not a socket", p)
+	}
+	if err := os.Remove(p); err != nil {
+		return fmt.Errorf("couldn't remove stale socket %q: %v", p, err)
+	}
+	return nil
+}
+
+// systemd returns a listener for a socket passed in by systemd socket
+// activation. If name is empty the first unused socket is returned.
+func systemd(name string) (net.Listener, error) {
+	inherited.Do(func() {
+		inherited.files, inherited.order, inherited.err = files(
+			os.Getenv("LISTEN_PID"),
+			os.Getenv("LISTEN_FDS"),
+			os.Getenv("LISTEN_FDNAMES"),
+		)
+		os.Unsetenv("LISTEN_PID")
+		os.Unsetenv("LISTEN_FDS")
+		os.Unsetenv("LISTEN_FDNAMES")
+	})
+	if inherited.err != nil {
+		return nil, inherited.err
+	}
+
+	var f *os.File
+	if name == "" {
+		if len(inherited.order) > 0 {
+			f = inherited.order[0]
+		}
+	} else if fs := inherited.files[name]; len(fs) > 0 {
+		f = fs[0]
+	}
+	if f == nil {
+		return nil, fmt.Errorf("no socket %q passed by systemd", name)
+	}
+	take(f)
+
+	l, err := net.FileListener(f)
+	if err != nil {
+		return nil, fmt.Errorf("socket %q passed by systemd: %v", f.Name(), err)
+	}
+	f.Close()
+	return l, nil
+}
+
+// take removes f from the set of inherited files so that it is only handed
+// out once.
+func take(f *os.File) {
+	for i, o := range inherited.order {
+		if o == f {
+			inherited.order = append(inherited.order[:i], inherited.order[i+1:]...)
+			break
+		}
+	}
+	fs := inherited.files[f.Name()]
+	for i, o := range fs {
+		if o == f {
+			inherited.files[f.Name()] = append(fs[:i], fs[i+1:]...)
+			break
+		}
+	}
+}
+
+// files interprets the systemd socket activation environment, returning the
+// passed files keyed by name as well as in the order they were passed.
+func files(pid, fds, names string) (map[string][]*os.File, []*os.File, error) {
+	if fds == "" {
+		return nil, nil, fmt.Errorf("LISTEN_FDS not set; not started by systemd socket activation?")
+	}
+	if p, err := strconv.Atoi(pid); err != nil || p != os.Getpid() {
+		return nil, nil, fmt.Errorf("LISTEN_PID %q does not match pid %d", pid, os.Getpid())
+	}
+	n, err := strconv.Atoi(fds)
+	if err != nil || n < 1 {
+		return nil, nil, fmt.Errorf("invalid LISTEN_FDS %q", fds)
+	}
+	var ns []string
+	if names != "" {
+		ns = strings.Split(names, ":")
+	}
+
+	byName := map[string][]*os.File{}
+	order := []*os.File{}
+	for i := 0; i < n; i++ {
+		name := "LISTEN_FD_" + strconv.Itoa(sdListenFDsStart+i)
+		if i < len(ns) && ns[i] != "" {
+			name = ns[i]
+		}
+		f := os.NewFile(uintptr(sdListenFDsStart+i), name)
+		byName[name] = append(byName[name], f)
+		order = append(order, f)
+	}
+	return byName, order, nil
+}
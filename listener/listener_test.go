@@ -0,0 +1,127 @@
+package listener
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListenUnix(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "vain.sock")
+	// a stale socket from a previous run should not prevent listening
+	stale, err := net.Listen("unix", p)
+	if err != nil {
+		t.Fatalf("couldn't create stale socket: %v", err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+	l, err := ListenMode(unixPrefix+p, 0660)
+	if err != nil {
+		t.Fatalf("couldn't listen: %v", err)
+	}
+	defer l.Close()
+	if got, want := l.Addr().Network(), "unix"; got != want {
+		t.Fatalf("bad network; got %q, want %q", got, want)
+	}
+	if fi, err := os.Stat(p); err != nil || fi.Mode().Perm() != 0660 {
+		t.Fatalf("socket mode: got %v, %v, want %v", fi.Mode().Perm(), err, os.FileMode(0660))
+	}
+
+	// anything else is left alone
+	db := filepath.Join(dir, "vain.db")
+	if err := os.WriteFile(db, []byte("{}"), 0600); err != nil {
+		t.Fatalf("couldn't create file: %v", err)
+	}
+	if _, err := Listen(unixPrefix + db); err == nil {
+		t.Fatalf("expected error listening over a regular file")
+	}
+	if b, err := os.ReadFile(db); err != nil || string(b) != "{}" {
+		t.Fatalf("file was clobbered: %q, %v", b, err)
+	}
+}
+
+func TestFiles(t *testing.T) {
+	pid := fmt.Sprintf("%d", os.Getpid())
+	tests := []struct {
+		pid, fds, names string
+		order           []string
+		err             bool
+	}{
+		{pid: pid, fds: "1", order: []string{"LISTEN_FD_3"}},
+		{pid: pid, fds: "2", names: "http:admin", order: []string{"http", "admin"}},
+		{pid: pid, fds: "2", names: "http", order: []string{"http", "LISTEN_FD_4"}},
+
+		{pid: pid, fds: "", err: true},
+		{pid: pid, fds: "0", err: true},
+		{pid: "1", fds: "1", err: true},
+	}
+	for _, test := range tests {
+		byName, order, err := files(test.pid, test.fds, test.names)
+		if (err != nil) != test.err {
+			t.Errorf("%+v: unexpected error: %v", test, err)
+			continue
+		}
+		if got, want := len(order), len(test.order); got != want {
+			t.Errorf("%+v: wrong number of files; got %d, want %d", test, got, want)
+			continue
+		}
+		for i, name := range test.order {
+			if got, want := order[i].Name(), name; got != want {
+				t.Errorf("%+v: bad name; got %q, want %q", test, got, want)
+			}
+			if len(byName[name]) != 1 {
+				t.Errorf("%+v: %q not indexed by name", test, name)
+			}
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tr, err := ParseTrusted([]string{"10.0.0.0/8", "192.168.1.1", "unix"})
+	if err != nil {
+		t.Fatalf("couldn't parse trusted proxies: %v", err)
+	}
+	tests := []struct {
+		remote string
+		xff    string
+		want   string
+	}{
+		{remote: "1.2.3.4:5555", want: "1.2.3.4"},
+		// untrusted peers can't spoof
+		{remote: "1.2.3.4:5555", xff: "5.6.7.8", want: "1.2.3.4"},
+		{remote: "10.1.1.1:5555", xff: "5.6.7.8", want: "5.6.7.8"},
+		{remote: "192.168.1.1:5555", xff: "5.6.7.8, 10.2.2.2", want: "5.6.7.8"},
+		// only believe the chain as far back as it is trusted
+		{remote: "10.1.1.1:5555", xff: "9.9.9.9, 5.6.7.8", want: "5.6.7.8"},
+		{remote: "@", xff: "5.6.7.8", want: "5.6.7.8"},
+		{remote: "10.1.1.1:5555", xff: "garbage", want: "10.1.1.1"},
+	}
+	for _, test := range tests {
+		req, err := http.NewRequest("GET", "/", nil)
+		if err != nil {
+			t.Fatalf("couldn't create request: %v", err)
+		}
+		req.RemoteAddr = test.remote
+		if test.xff != "" {
+			req.Header.Set("X-Forwarded-For", test.xff)
+		}
+		if got := tr.ClientIP(req); got != test.want {
+			t.Errorf("%+v: got %q, want %q", test, got, test.want)
+		}
+	}
+}
+
+func TestParseTrustedInvalid(t *testing.T) {
+	for _, spec := range []string{"bogus", "10.0.0.0/99"} {
+		if _, err := ParseTrusted([]string{spec}); err == nil {
+			t.Errorf("%q should not parse", spec)
+		}
+	}
+	if _, err := ParseTrusted([]string{"::1"}); err != nil {
+		t.Errorf("couldn't parse ipv6: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package listener
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// unixPeer is the name used to trust clients connecting over unix sockets.
+const unixPeer = "unix"
+
+// Trusted is a set of peers, typically reverse proxies, whose
+// X-Forwarded-For headers are believed.
+type Trusted struct {
+	nets []*net.IPNet
+	unix bool
+}
+
+// ParseTrusted builds a Trusted from a list of IPs, CIDRs, and the special
+// value "unix", which trusts any peer connected over a unix socket.
+func ParseTrusted(specs []string) (*Trusted, error) {
+	t := &Trusted{}
+	for _, s := range specs {
+		s = strings.TrimSpace(s)
+		switch {
+		case s == "":
+			continue
+		case s == unixPeer:
+			t.unix = true
+			continue
+		case !strings.Contains(s, "/"):
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", s)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			t.nets = append(t.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+		default:
+			_, n, err := net.ParseCIDR(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %v", s, err)
+			}
+			t.nets = append(t.nets, n)
+		}
+	}
+	return t, nil
+}
+
+func (t *Trusted) trusts(ip net.IP) bool {
+	for _, n := range t.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// peer reports whether the directly connected peer at addr is trusted.
+func (t *Trusted) peer(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		// unix sockets have no meaningful remote address
+		return t.unix
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && t.trusts(ip)
+}
+
+// ClientIP returns the address of the client that made req, believing
+// X-Forwarded-For only as far back as the chain of trusted proxies goes.
+func (t *Trusted) ClientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	if !t.peer(req.RemoteAddr) {
+		return host
+	}
+
+	hops := []string{}
+	for _, h := range req.Header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(h, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(hops[i])
+		if ip == nil {
+			return host
+		}
+		host = hops[i]
+		if !t.trusts(ip) {
+			break
+		}
+	}
+	return host
+}
+
+// Handler rewrites req.RemoteAddr to the client address reported by trusted
+// proxies before passing the request on to h.
+func (t *Trusted) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if ip := t.ClientIP(req); ip != "" {
+			req.RemoteAddr = net.JoinHostPort(ip, "0")
+		}
+		h.ServeHTTP(w, req)
+	})
+}
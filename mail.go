@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/mail"
 	"net/smtp"
+
+	"mcquay.me/vain/metrics"
 )
 
 // A Mailer is a type that knows how to send smtp mail.
@@ -34,8 +36,11 @@ type Mail struct {
 }
 
 // Send sends a smtp email using the host and port in the Mail struct and
-//returns an error if there was a problem sending the email.
-func (e Mail) Send(to mail.Address, subject, msg string) error {
+// returns an error if there was a problem sending the email.
+func (e Mail) Send(to mail.Address, subject, msg string) (err error) {
+	done := metrics.MailTime()
+	defer func() { done(err) }()
+
 	c, err := smtp.Dial(fmt.Sprintf("%s:%d", e.host, e.port))
 	if err != nil {
 		return fmt.Errorf("couldn't dial mail server: %v", err)
@@ -0,0 +1,211 @@
+package vain
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// A Maintainer is a Storer that supports vaind's offline maintenance
+// commands.
+type Maintainer interface {
+	Storer
+	Check() []Problem
+	Repair() ([]Problem, error)
+	AddUser(e Email) (Token, error)
+	SetDisabled(e Email, disabled bool) error
+	TransferNamespace(ns string, e Email) error
+	RemovePath(pth string) error
+}
+
+// Kinds of Problem found by Check.
+const (
+	problemToken     = "token"
+	problemNamespace = "namespace"
+	problemPackage   = "package"
+	problemConflict  = "conflict"
+)
+
+// A Problem is an inconsistency in a store.
+type Problem struct {
+	// Kind is one of token, namespace, package or conflict.
+	Kind   string `json:"kind"`
+	Key    string `json:"key"`
+	Detail string `json:"detail"`
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s %s: %s", p.Kind, p.Key, p.Detail)
+}
+
+// Check looks for tokens belonging to missing users, namespaces owned by
+// missing users, packages in unowned namespaces and packages whose paths
+// conflict. Problems are sorted by kind, then key.
+func (m *MemDB) Check() []Problem {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	users := map[Email]bool{}
+	for e := range m.Users {
+		users[e] = true
+	}
+	pkgs := []Package{}
+	for _, p := range m.Packages {
+		pkgs = append(pkgs, p)
+	}
+	return problems(users, m.TokToEmail, m.Namespaces, pkgs)
+}
+
+// problems returns what Check finds among users, the owners of tokens and
+// namespaces, and packages.
+func problems(users map[Email]bool, toks map[Token]Email, nss map[namespace]Email, pkgs []Package) []Problem {
+	ps := []Problem{}
+	for tok, e := range toks {
+		if !users[e] {
+			ps = append(ps, Problem{problemToken, string(tok), fmt.Sprintf("belongs to missing user %q", e)})
+		}
+	}
+	for ns, e := range nss {
+		if !users[e] {
+			ps = append(ps, Problem{problemNamespace, string(ns), fmt.Sprintf("owned by missing user %q", e)})
+		}
+	}
+	for _, p := range pkgs {
+		if _, ok := nss[pkgNS(p)]; !ok {
+			ps = append(ps, Problem{problemPackage, p.Path, fmt.Sprintf("namespace %q has no owner", pkgNS(p))})
+		}
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Path < pkgs[j].Path })
+	for i, p := range pkgs {
+		for _, other := range pkgs[i+1:] {
+			if !Valid(p.Path, []Package{other}) {
+				ps = append(ps, Problem{problemConflict, other.Path, fmt.Sprintf("conflicts with %q", p.Path)})
+			}
+		}
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		if ps[i].Kind != ps[j].Kind {
+			return ps[i].Kind < ps[j].Kind
+		}
+		return ps[i].Key < ps[j].Key
+	})
+	return ps
+}
+
+// Repair fixes the problems found by Check that have only one fix, returning
+// them: tokens of missing users are revoked, and namespaces of missing users
+// released. Unowned and conflicting packages are left for an operator to
+// transfer or remove.
+func (m *MemDB) Repair() ([]Problem, error) {
+	fixed := []Problem{}
+	for _, p := range m.Check() {
+		if p.Kind == problemToken || p.Kind == problemNamespace {
+			fixed = append(fixed, p)
+		}
+	}
+	if len(fixed) == 0 {
+		return fixed, nil
+	}
+
+	m.l.Lock()
+	defer m.l.Unlock()
+	for _, p := range fixed {
+		switch p.Kind {
+		case problemToken:
+			delete(m.TokToEmail, Token(p.Key))
+		case problemNamespace:
+			delete(m.Namespaces, namespace(p.Key))
+		}
+	}
+	return fixed, m.flush(m.filename)
+}
+
+// AddUser adds a confirmed user, returning their token. Unlike Register, no
+// confirmation is needed.
+func (m *MemDB) AddUser(e Email) (Token, error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if _, ok := m.Users[e]; ok {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("duplicate email %q", e),
+			Code:    http.StatusConflict,
+		}
+	}
+	tok := FreshToken()
+	m.Users[e] = User{
+		Email:      e,
+		token:      tok,
+		Registered: true,
+		Requested:  time.Now(),
+	}
+	m.TokToEmail[tok] = e
+	return tok, m.flush(m.filename)
+}
+
+// SetDisabled disables or re-enables e. The tokens of disabled users are
+// refused.
+func (m *MemDB) SetDisabled(e Email, disabled bool) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	u, ok := m.Users[e]
+	if !ok {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("couldn't find user %q", e),
+			Code:    http.StatusNotFound,
+		}
+	}
+	u.Disabled = disabled
+	m.Users[e] = u
+	return m.flush(m.filename)
+}
+
+// TransferNamespace makes e the owner of ns, claiming it if it has no owner.
+func (m *MemDB) TransferNamespace(ns string, e Email) error {
+	if err := checkNamespace(ns); err != nil {
+		return err
+	}
+
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if _, ok := m.Users[e]; !ok {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("couldn't find user %q", e),
+			Code:    http.StatusNotFound,
+		}
+	}
+	m.Namespaces[namespace(ns)] = e
+	return m.flush(m.filename)
+}
+
+func checkNamespace(ns string) error {
+	if ns == "" || strings.Contains(ns, "/") {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("invalid namespace %q", ns),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return nil
+}
+
+// RemovePath removes the package at pth, which must exist, recording it in the
+// package's history as made offline.
+func (m *MemDB) RemovePath(pth string) error {
+	return removePath(m, pth)
+}
+
+func removePath(db Storer, pth string) error {
+	if !db.PackageExists(path(pth)) {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("package %q not found", pth),
+			Code:    http.StatusNotFound,
+		}
+	}
+	return db.RemovePackage(path(pth), Edit{})
+}
@@ -0,0 +1,144 @@
+package vain
+
+import (
+	"net/http"
+	"testing"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+func TestCheckRepair(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+	testCheckRepair(t, db, func() {
+		db.TokToEmail["dangling"] = "gone@example.org"
+		db.Namespaces["gone"] = "gone@example.org"
+	})
+}
+
+// testCheckRepair exercises the maintenance commands of db. orphan adds a
+// token "dangling" and a namespace "gone" of the missing user
+// gone@example.org.
+func testCheckRepair(t *testing.T, db Maintainer, orphan func()) {
+	tok, err := db.AddUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("couldn't add user: %v", err)
+	}
+	if err := db.NSForToken("sm", tok); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	for _, p := range []string{"a.org/sm/a", "a.org/sm/a/b", "a.org/gone/x", "a.org/nobody/y"} {
+		if err := db.AddPackage(Package{Vcs: "git", Repo: "https://example.org/r", Path: p}, Edit{}); err != nil {
+			t.Fatalf("couldn't add package: %v", err)
+		}
+	}
+	orphan()
+
+	want := []string{
+		`conflict a.org/sm/a/b: conflicts with "a.org/sm/a"`,
+		`namespace gone: owned by missing user "gone@example.org"`,
+		`package a.org/nobody/y: namespace "nobody" has no owner`,
+		`token dangling: belongs to missing user "gone@example.org"`,
+	}
+	got := db.Check()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %q", got, want)
+	}
+	for i := range got {
+		if got[i].String() != want[i] {
+			t.Errorf("problem %d: got %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	fixed, err := db.Repair()
+	if err != nil {
+		t.Fatalf("couldn't repair: %v", err)
+	}
+	if got, want := len(fixed), 2; got != want {
+		t.Fatalf("fixed: got %v, want %d", fixed, want)
+	}
+	for _, p := range db.Check() {
+		if p.Kind == problemToken || p.Kind == problemNamespace {
+			t.Fatalf("not repaired: %v", p)
+		}
+	}
+	// the released namespace orphans its package
+	if got, want := len(db.Check()), 3; got != want {
+		t.Fatalf("after repair: got %v, want %d problems", db.Check(), want)
+	}
+
+	if err := db.TransferNamespace("gone", "sm@example.org"); err != nil {
+		t.Fatalf("couldn't transfer: %v", err)
+	}
+	if err := db.TransferNamespace("nobody", "sm@example.org"); err != nil {
+		t.Fatalf("couldn't transfer: %v", err)
+	}
+	if err := db.RemovePath("a.org/sm/a/b"); err != nil {
+		t.Fatalf("couldn't remove: %v", err)
+	}
+	if got := db.Check(); len(got) != 0 {
+		t.Fatalf("after fixing: got %v", got)
+	}
+
+	for _, err := range []error{
+		db.TransferNamespace("sm", "nobody@example.org"),
+		db.RemovePath("a.org/sm/a/b"),
+	} {
+		if got, want := verrors.ToHTTP(err).Code, http.StatusNotFound; err == nil || got != want {
+			t.Errorf("got %v, want %s", err, http.StatusText(want))
+		}
+	}
+	if err := db.TransferNamespace("a/b", "sm@example.org"); err == nil {
+		t.Errorf("expected error for invalid namespace")
+	}
+}
+
+func TestDisabledUser(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+	testDisabledUser(t, db)
+}
+
+func testDisabledUser(t *testing.T, db Maintainer) {
+	tok, err := db.AddUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("couldn't add user: %v", err)
+	}
+	if _, err := db.AddUser("sm@example.org"); err == nil {
+		t.Fatalf("expected error adding a duplicate user")
+	}
+	if r, err := Export(db); err != nil || len(r.Users) != 1 || r.Users[0].Pending {
+		t.Fatalf("added user isn't registered")
+	}
+
+	if err := db.SetDisabled("sm@example.org", true); err != nil {
+		t.Fatalf("couldn't disable: %v", err)
+	}
+	forbidden := func(err error) {
+		t.Helper()
+		if err == nil || verrors.ToHTTP(err).Code != http.StatusForbidden {
+			t.Fatalf("got %v, want forbidden", err)
+		}
+	}
+	_, err = db.UserForToken(tok)
+	forbidden(err)
+	forbidden(db.NSForToken("sm", tok))
+	_, err = db.Forgot("sm@example.org", window)
+	forbidden(err)
+
+	if err := db.SetDisabled("sm@example.org", false); err != nil {
+		t.Fatalf("couldn't enable: %v", err)
+	}
+	if e, err := db.UserForToken(tok); err != nil || e != "sm@example.org" {
+		t.Fatalf("enabled user: got %q, %v", e, err)
+	}
+	if err := db.SetDisabled("nobody@example.org", true); err == nil {
+		t.Fatalf("expected error disabling a missing user")
+	}
+}
@@ -1,58 +1,226 @@
 package metrics
 
 import (
-	"runtime"
+	"net/http"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+const namespace = "vain"
+
+// other is the path label used for go-get hits once PackageLimit distinct
+// paths have been seen.
+const other = "other"
+
+// PackageLimit caps the number of distinct path labels tracked by GoGet.
+var PackageLimit = 1000
+
+// Registry is the private registry all vain metrics are registered with.
+var Registry = prometheus.NewRegistry()
+
 var (
 	// Errors tracks http status codes for problematic requests.
 	Errors = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
-			Name: "errors_total",
-			Help: "Number of upstream errors",
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Number of upstream errors",
 		},
 		[]string{"status"},
 	)
 
-	// Func tracks time spent in a function.
-	Func = prometheus.NewSummaryVec(
-		prometheus.SummaryOpts{
-			Name: "function_microseconds",
-			Help: "function timing.",
+	// Requests tracks latency of http requests by route, method and status
+	// code.
+	Requests = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "http request latency.",
+			Buckets:   prometheus.DefBuckets,
 		},
-		[]string{"route"},
+		[]string{"route", "method", "code"},
 	)
 
 	// DB tracks timing of interactions with the file system.
-	DB = prometheus.NewSummaryVec(
-		prometheus.SummaryOpts{
-			Name: "db_microseconds",
-			Help: "db timing.",
+	DB = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_duration_seconds",
+			Help:      "db timing.",
+			Buckets:   prometheus.DefBuckets,
 		},
 		[]string{"what"},
 	)
+
+	// Packages tracks the number of packages in the db.
+	Packages = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "packages",
+			Help:      "Number of packages.",
+		},
+	)
+
+	// Users tracks the number of users in the db.
+	Users = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "users",
+			Help:      "Number of users.",
+		},
+	)
+
+	// Namespaces tracks the number of claimed namespaces in the db.
+	Namespaces = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "namespaces",
+			Help:      "Number of namespaces.",
+		},
+	)
+
+	// ReadOnly is 1 while the server is refusing mutations.
+	ReadOnly = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "read_only",
+			Help:      "Whether the server is in read-only mode.",
+		},
+	)
+
+	// GoGet counts go-get requests that resolved to a package.
+	GoGet = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "go_get_total",
+			Help:      "Number of go-get hits per package.",
+		},
+		[]string{"path"},
+	)
+
+	// Mail counts attempts to send email by result.
+	Mail = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "mail_sent_total",
+			Help:      "Number of emails sent.",
+		},
+		[]string{"result"},
+	)
+
+	// DualWriteErrors counts writes that could not be mirrored to the
+	// secondary store while dual writing, by Storer method.
+	DualWriteErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "dual_write_errors_total",
+			Help:      "Number of writes not mirrored to the secondary store.",
+		},
+		[]string{"op"},
+	)
+
+	// CacheLookups counts package lookups answered by the cache (hit) or
+	// the store (miss), prefixed by negative_ for paths with no package.
+	CacheLookups = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_lookups_total",
+			Help:      "Number of package lookups by cache result.",
+		},
+		[]string{"result"},
+	)
+
+	// CacheEntries is the number of lookups held in the package cache.
+	CacheEntries = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "cache_entries",
+			Help:      "Number of cached package lookups.",
+		},
+	)
+
+	// MailDuration tracks time spent talking to the smtp server.
+	MailDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "mail_duration_seconds",
+			Help:      "smtp timing.",
+			Buckets:   prometheus.DefBuckets,
+		},
+	)
 )
 
+var seen = struct {
+	sync.Mutex
+	paths map[string]bool
+}{
+	paths: map[string]bool{},
+}
+
 func init() {
-	prometheus.MustRegister(Errors)
-	prometheus.MustRegister(Func)
-	prometheus.MustRegister(DB)
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		Errors,
+		Requests,
+		DB,
+		Packages,
+		Users,
+		Namespaces,
+		ReadOnly,
+		GoGet,
+		Mail,
+		MailDuration,
+		DualWriteErrors,
+		CacheLookups,
+		CacheEntries,
+	)
 }
 
-// Time is a function that makes it simple to add one-line timings to function
-// calls.
-func Time() func() {
-	start := time.Now()
-	return func() {
-		elapsed := time.Since(start)
-		pc := make([]uintptr, 10)
-		runtime.Callers(2, pc)
-		f := runtime.FuncForPC(pc[0])
+// Handler returns an http.Handler that serves the metrics in Registry.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// Instrument wraps h so that requests are recorded in Requests under route.
+func Instrument(route string, h http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerDuration(
+		Requests.MustCurryWith(prometheus.Labels{"route": route}),
+		h,
+	)
+}
+
+// PackageHit records a go-get request for the package at path. Once
+// PackageLimit distinct paths have been recorded the rest are lumped together
+// under a single label.
+func PackageHit(path string) {
+	seen.Lock()
+	if !seen.paths[path] {
+		if len(seen.paths) >= PackageLimit {
+			path = other
+		} else {
+			seen.paths[path] = true
+		}
+	}
+	seen.Unlock()
+	GoGet.WithLabelValues(path).Inc()
+}
 
-		Func.WithLabelValues(f.Name()).Observe(float64(elapsed / time.Microsecond))
+// MailTime makes it simple to add one-line timings to smtp interactions. The
+// returned function records the outcome based on the error it is given.
+func MailTime() func(error) {
+	start := time.Now()
+	return func(err error) {
+		MailDuration.Observe(time.Since(start).Seconds())
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+		Mail.WithLabelValues(result).Inc()
 	}
 }
 
@@ -60,7 +228,6 @@ func Time() func() {
 func DBTime(name string) func() {
 	start := time.Now()
 	return func() {
-		elapsed := time.Since(start)
-		DB.WithLabelValues(name).Observe(float64(elapsed / time.Microsecond))
+		DB.WithLabelValues(name).Observe(time.Since(start).Seconds())
 	}
 }
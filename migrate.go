@@ -0,0 +1,98 @@
+package vain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// A Summary counts the contents of a Registry and identifies them with a
+// checksum.
+type Summary struct {
+	Users      int    `json:"users"`
+	Tokens     int    `json:"tokens"`
+	Namespaces int    `json:"namespaces"`
+	Packages   int    `json:"packages"`
+	Revisions  int    `json:"revisions"`
+	Checksum   string `json:"checksum"`
+}
+
+func (s Summary) String() string {
+	return fmt.Sprintf("%d users, %d tokens, %d namespaces, %d packages, %d revisions (sha256 %s)", s.Users, s.Tokens, s.Namespaces, s.Packages, s.Revisions, s.Checksum)
+}
+
+// Summarize counts r and computes a checksum over its contents that doesn't
+// depend on the order of entries.
+func Summarize(r Registry) Summary {
+	c := Registry{Version: RegistryVersion}
+	s := Summary{Namespaces: len(r.Namespaces), Packages: len(r.Packages), Revisions: len(r.Revisions)}
+	for _, u := range r.Users {
+		ts := append([]Token{}, u.Tokens...)
+		sort.Slice(ts, func(i, j int) bool { return ts[i] < ts[j] })
+		c.Users = append(c.Users, RegistryUser{Email: u.Email, Tokens: ts, Disabled: u.Disabled, Pending: u.Pending})
+		s.Users++
+		s.Tokens += len(ts)
+	}
+	sort.Slice(c.Users, func(i, j int) bool { return c.Users[i].Email < c.Users[j].Email })
+	c.Namespaces = append(c.Namespaces, r.Namespaces...)
+	sort.Slice(c.Namespaces, func(i, j int) bool { return c.Namespaces[i].Name < c.Namespaces[j].Name })
+	c.Packages = append(c.Packages, r.Packages...)
+	sort.Slice(c.Packages, func(i, j int) bool { return c.Packages[i].Path < c.Packages[j].Path })
+	c.Revisions = append(c.Revisions, r.Revisions...)
+	sortRevisions(c.Revisions)
+	// times are compared to the microsecond, as postgres keeps them
+	for i := range c.Revisions {
+		c.Revisions[i].Time = c.Revisions[i].Time.UTC().Truncate(time.Microsecond)
+	}
+
+	b, _ := json.Marshal(c)
+	sum := sha256.Sum256(b)
+	s.Checksum = hex.EncodeToString(sum[:])
+	return s
+}
+
+// Verify checks that a and b hold the same users, tokens, namespaces, packages
+// and revisions, returning a's Summary.
+func Verify(a, b Storer) (Summary, error) {
+	ra, err := Export(a)
+	if err != nil {
+		return Summary{}, err
+	}
+	rb, err := Export(b)
+	if err != nil {
+		return Summary{}, err
+	}
+	sa, sb := Summarize(ra), Summarize(rb)
+	if sa != sb {
+		return sa, fmt.Errorf("stores differ: source has %v; destination has %v", sa, sb)
+	}
+	return sa, nil
+}
+
+// Migrate copies the users, tokens, namespaces, packages and revisions of from
+// into to, which must be empty, then verifies the copy. Audit logs and
+// webhooks are not copied.
+func Migrate(from, to Storer) (Summary, error) {
+	dst, err := Export(to)
+	if err != nil {
+		return Summary{}, err
+	}
+	if len(dst.Users)+len(dst.Namespaces)+len(dst.Packages)+len(dst.Revisions) > 0 {
+		return Summary{}, fmt.Errorf("destination is not empty: %v", Summarize(dst))
+	}
+	r, err := Export(from)
+	if err != nil {
+		return Summary{}, err
+	}
+	errs, err := load(to, r, nil, false)
+	if err != nil {
+		return Summary{}, err
+	}
+	if len(errs) > 0 {
+		return Summary{}, fmt.Errorf("source has %d invalid entries, e.g. %s; see vaind db check", len(errs), errs[0])
+	}
+	return Verify(from, to)
+}
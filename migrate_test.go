@@ -0,0 +1,171 @@
+package vain
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenStore(t *testing.T) {
+	db, done := TestDB(t)
+	defer done()
+
+	s, err := OpenStore("memdb:" + filepath.Join(filepath.Dir(db.filename), "other.json"))
+	if err != nil {
+		t.Fatalf("couldn't open memdb: %v", err)
+	}
+	if _, ok := s.(*MemDB); !ok {
+		t.Fatalf("got %T, want *MemDB", s)
+	}
+	for _, u := range []string{"vain.db", "sqlite:vain.sqlite"} {
+		if _, err := OpenStore(u); err == nil {
+			t.Errorf("%s: expected error", u)
+		}
+	}
+	if got, want := strings.Join(Backends(), ","), "memdb"; !strings.Contains(got, want) {
+		t.Errorf("backends: got %q, want to contain %q", got, want)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	from, done := queryDB(t)
+	defer done()
+	tok, _ := from.AddUser("sm@example.org")
+	from.AddToken("sm@example.org")
+	for _, ns := range []string{"sm", "mc"} {
+		if err := from.TransferNamespace(ns, "sm@example.org"); err != nil {
+			t.Fatalf("couldn't claim namespace: %v", err)
+		}
+	}
+	from.AddUser("off@example.org")
+	from.SetDisabled("off@example.org", true)
+	p, _ := from.Package("a.org/sm/a")
+	for _, action := range []string{actionCreate, actionUpdate} {
+		if err := from.UpdatePackage(p, Edit{Email: "sm@example.org", Action: action}); err != nil {
+			t.Fatalf("couldn't update: %v", err)
+		}
+	}
+
+	to, done2 := TestDB(t)
+	defer done2()
+
+	s, err := Migrate(from, to)
+	if err != nil {
+		t.Fatalf("couldn't migrate: %v", err)
+	}
+	if got, want := s.String()[:62], "2 users, 3 tokens, 2 namespaces, 5 packages, 7 revisions (sha2"; got != want {
+		t.Fatalf("summary: got %q, want %q", got, want)
+	}
+	if e, err := to.UserForToken(tok); err != nil || e != "sm@example.org" {
+		t.Fatalf("migrated token: got %q, %v", e, err)
+	}
+	if u, _ := to.user("off@example.org"); !u.Disabled {
+		t.Fatalf("disabled user was enabled")
+	}
+	if h := to.History("a.org/sm/a"); len(h) != 3 || h[0].Number != 3 || h[0].Action != actionUpdate {
+		t.Fatalf("migrated history: got %+v", h)
+	}
+	if _, err := Migrate(from, to); err == nil {
+		t.Fatalf("expected error migrating to a store that isn't empty")
+	}
+
+	if err := to.RemovePath("a.org/sm/a"); err != nil {
+		t.Fatalf("couldn't remove: %v", err)
+	}
+	if _, err := Verify(from, to); err == nil {
+		t.Fatalf("expected stores to differ")
+	}
+
+	// the checksum doesn't depend on order
+	r, _ := from.Export()
+	orig, _ := from.Export()
+	r.Packages[0], r.Packages[1] = r.Packages[1], r.Packages[0]
+	ts := r.Users[1].Tokens
+	ts[0], ts[1] = ts[1], ts[0]
+	if got, want := Summarize(r), Summarize(orig); got != want {
+		t.Fatalf("reordered: got %v, want %v", got, want)
+	}
+}
+
+func TestDualWrite(t *testing.T) {
+	primary, done := queryDB(t)
+	defer done()
+	tok, _ := primary.AddUser("sm@example.org")
+	if err := primary.NSForToken("sm", tok); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	secondary, done2 := TestDB(t)
+	defer done2()
+
+	if _, err := DualWrite(primary, secondary); err == nil {
+		t.Fatalf("expected error dual writing with packages in unowned namespaces")
+	}
+	if err := primary.TransferNamespace("mc", "sm@example.org"); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	db, err := DualWrite(primary, secondary)
+	if err != nil {
+		t.Fatalf("couldn't start dual writing: %v", err)
+	}
+	check := func(what string) {
+		t.Helper()
+		if _, err := Verify(primary, secondary); err != nil {
+			t.Fatalf("%s: %v", what, err)
+		}
+	}
+	check("initial copy")
+
+	if err := db.AddPackage(Package{Vcs: "git", Repo: "https://example.org/z", Path: "a.org/sm/z"}, Edit{}); err != nil {
+		t.Fatalf("couldn't add: %v", err)
+	}
+	if err := db.UpdatePackage(Package{Vcs: "hg", Repo: "https://example.org/z", Path: "a.org/sm/z"}, Edit{}); err != nil {
+		t.Fatalf("couldn't update: %v", err)
+	}
+	if err := db.RemovePackage("a.org/sm/a", Edit{}); err != nil {
+		t.Fatalf("couldn't remove: %v", err)
+	}
+	check("packages")
+
+	reg, err := db.Register("new@example.org")
+	if err != nil {
+		t.Fatalf("couldn't register: %v", err)
+	}
+	check("register")
+	if u, _ := secondary.user("new@example.org"); u.Registered {
+		t.Fatalf("mirrored user was registered before confirming")
+	}
+	conf, err := db.Confirm(reg)
+	if err != nil {
+		t.Fatalf("couldn't confirm: %v", err)
+	}
+	check("confirm")
+	if u, _ := secondary.user("new@example.org"); !u.Registered {
+		t.Fatalf("mirrored user wasn't registered by confirming")
+	}
+	if err := db.NSForToken("new", conf); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	extra, err := db.AddToken("new@example.org")
+	if err != nil {
+		t.Fatalf("couldn't add token: %v", err)
+	}
+	check("add token")
+	if err := db.RevokeToken("new@example.org", extra); err != nil {
+		t.Fatalf("couldn't revoke token: %v", err)
+	}
+	check("tokens")
+
+	// the secondary must already match
+	secondary.TransferNamespace("other", "sm@example.org")
+	if _, err := DualWrite(primary, secondary); err == nil {
+		t.Fatalf("expected error dual writing to a store that differs")
+	}
+
+	// failures writing to the secondary don't fail the write
+	if err := secondary.RemovePath("a.org/sm/z"); err != nil {
+		t.Fatalf("couldn't remove: %v", err)
+	}
+	if err := db.UpdatePackage(Package{Vcs: "git", Repo: "https://example.org/z", Path: "a.org/sm/z"}, Edit{}); err != nil {
+		t.Fatalf("failure mirroring was returned: %v", err)
+	}
+}
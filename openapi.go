@@ -0,0 +1,24 @@
+package vain
+
+import (
+	_ "embed"
+	"fmt"
+	"net/http"
+)
+
+// openapiSpec describes the api. openapi_test.go checks it against the
+// routes and their responses.
+//
+//go:embed openapi.json
+var openapiSpec []byte
+
+// openapi serves the OpenAPI document.
+func openapi(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" && req.Method != "HEAD" {
+		http.Error(w, fmt.Sprintf("unsupported method %q", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Write(openapiSpec)
+}
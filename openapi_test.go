@@ -0,0 +1,382 @@
+package vain
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type object = map[string]interface{}
+
+// spec is a parsed OpenAPI document, with just enough of a schema validator
+// for the schemas vain uses. Objects may not have fields that aren't
+// documented unless additionalProperties is set.
+type spec struct {
+	doc object
+}
+
+func loadSpec(t *testing.T, b []byte) spec {
+	s := spec{}
+	if err := json.Unmarshal(b, &s.doc); err != nil {
+		t.Fatalf("couldn't parse openapi.json: %v", err)
+	}
+	return s
+}
+
+// resolve follows $refs.
+func (s spec) resolve(v interface{}) object {
+	o, _ := v.(object)
+	for o != nil {
+		ref, ok := o["$ref"].(string)
+		if !ok {
+			return o
+		}
+		var cur interface{} = s.doc
+		for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			m, _ := cur.(object)
+			cur = m[part]
+		}
+		o, _ = cur.(object)
+	}
+	return o
+}
+
+func (s spec) paths() object {
+	ps, _ := s.doc["paths"].(object)
+	return ps
+}
+
+// route returns the documented path that u is served by, preferring the one
+// with the most literal characters.
+func (s spec) route(u string) string {
+	best, bestLen := "", -1
+	params := regexp.MustCompile(`\{([^}]+)\}`)
+	for p := range s.paths() {
+		lits, names := params.Split(p, -1), params.FindAllStringSubmatch(p, -1)
+		re := "^" + regexp.QuoteMeta(lits[0])
+		for i, n := range names {
+			if n[1] == "path" {
+				re += ".+"
+			} else {
+				re += "[^/]+"
+			}
+			re += regexp.QuoteMeta(lits[i+1])
+		}
+		if !regexp.MustCompile(re + "$").MatchString(u) {
+			continue
+		}
+		if l := len(strings.Join(lits, "")); l > bestLen {
+			best, bestLen = p, l
+		}
+	}
+	return best
+}
+
+func (s spec) validate(schema interface{}, v interface{}, at string) error {
+	sch := s.resolve(schema)
+	if sch == nil {
+		return fmt.Errorf("%s: no schema", at)
+	}
+	if enum, ok := sch["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			found = found || e == v
+		}
+		if !found {
+			return fmt.Errorf("%s: %v not in %v", at, v, enum)
+		}
+	}
+	switch sch["type"] {
+	case "object":
+		o, ok := v.(object)
+		if !ok {
+			return fmt.Errorf("%s: got %T, want object", at, v)
+		}
+		req, _ := sch["required"].([]interface{})
+		for _, r := range req {
+			if _, ok := o[r.(string)]; !ok {
+				return fmt.Errorf("%s: missing required field %q", at, r)
+			}
+		}
+		props, _ := sch["properties"].(object)
+		for k, val := range o {
+			p, ok := props[k]
+			if !ok {
+				if sch["additionalProperties"] == true {
+					continue
+				}
+				return fmt.Errorf("%s: undocumented field %q", at, k)
+			}
+			if err := s.validate(p, val, at+"."+k); err != nil {
+				return err
+			}
+		}
+	case "array":
+		a, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: got %T, want array", at, v)
+		}
+		for i, item := range a {
+			if err := s.validate(sch["items"], item, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("%s: got %T, want string", at, v)
+		}
+	case "integer":
+		if f, ok := v.(float64); !ok || f != math.Trunc(f) {
+			return fmt.Errorf("%s: got %v, want integer", at, v)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%s: got %T, want boolean", at, v)
+		}
+	default:
+		return fmt.Errorf("%s: unsupported schema type %v", at, sch["type"])
+	}
+	return nil
+}
+
+// check validates resp, a response to method on the documented route,
+// returning the content type's schema.
+func (s spec) check(method, route string, resp *http.Response) (interface{}, error) {
+	op := s.resolve(s.resolve(s.paths()[route])[strings.ToLower(method)])
+	if op == nil {
+		return nil, fmt.Errorf("%s %s is not documented", method, route)
+	}
+	responses, _ := op["responses"].(object)
+	r := s.resolve(responses[fmt.Sprintf("%d", resp.StatusCode)])
+	if r == nil {
+		return nil, fmt.Errorf("status %d is not documented", resp.StatusCode)
+	}
+	content, _ := r["content"].(object)
+	if content == nil {
+		return nil, nil
+	}
+	mt, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("bad content type %q: %v", resp.Header.Get("Content-Type"), err)
+	}
+	media, ok := content[mt].(object)
+	if !ok {
+		return nil, fmt.Errorf("content type %q is not documented", mt)
+	}
+	if mt != "application/json" {
+		return nil, nil
+	}
+	return media["schema"], nil
+}
+
+func TestOpenAPIRoutes(t *testing.T) {
+	s := loadSpec(t, openapiSpec)
+	// html for people rather than programs
+	pages := map[string]bool{"static": true, "dashboard": true, "directory": true}
+	for name, p := range prefix {
+		if pages[name] {
+			continue
+		}
+		documented := false
+		for sp := range s.paths() {
+			documented = documented || strings.HasPrefix(sp, p) || sp == strings.TrimSuffix(p, "/")
+		}
+		if !documented {
+			t.Errorf("route %q (%s) is not in openapi.json", name, p)
+		}
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	srv := NewServer(sm, db, &mockMail{}, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+	// the event stream replays everything after this
+	last := strconv.FormatUint(srv.events.next-1, 10)
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := http.Get(ts.URL + prefix["openapi"])
+	if err != nil {
+		t.Fatalf("couldn't get openapi.json: %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	s := loadSpec(t, b)
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	if err := db.NSForToken("sm", tok); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	if err := db.AddPackage(Package{Vcs: "git", Repo: "https://example.org/foo", Path: host + "/sm/foo"}, Edit{}); err != nil {
+		t.Fatalf("couldn't add package: %v", err)
+	}
+	spare0, _ := db.AddToken("sm@example.org")
+	spare1, _ := db.AddToken("sm@example.org")
+	confirm, err := db.Register("new@example.org")
+	if err != nil {
+		t.Fatalf("couldn't register: %v", err)
+	}
+	h, err := newHook(db, "sm@example.org", "hooked", "http://127.0.0.1:1/", "secret", true)
+	if err != nil {
+		t.Fatalf("couldn't add hook: %v", err)
+	}
+
+	tests := []struct {
+		method string
+		u      string
+		tok    Token
+		body   string
+		status int
+	}{
+		{"GET", "/sm/foo?go-get=1", "", "", http.StatusOK},
+		{"GET", "/sm/foo", "", "", http.StatusTemporaryRedirect},
+		{"GET", "/sm/nope?go-get=1", "", "", http.StatusNotFound},
+		{"POST", "/sm/bar", tok, `{"repo": "https://example.org/bar"}`, http.StatusOK},
+		{"POST", "/sm/bar", tok, `{"repo": "https://example.org/bar"}`, http.StatusConflict},
+		{"POST", "/sm/baz", "", `{"repo": "https://example.org/baz"}`, http.StatusUnauthorized},
+		{"PATCH", "/sm/bar", tok, `{"vcs": "hg"}`, http.StatusOK},
+		{"DELETE", "/sm/bar", tok, "", http.StatusOK},
+		{"GET", prefix["pkgs"], "", "", http.StatusOK},
+		{"GET", prefix["pkgs"] + "?sort=bogus", "", "", http.StatusBadRequest},
+		{"POST", prefix["register"] + "?email=newer@example.org", "", "", http.StatusOK},
+		{"POST", prefix["register"], "", "", http.StatusBadRequest},
+		{"GET", prefix["confirm"] + string(confirm), "", "", http.StatusOK},
+		{"GET", prefix["confirm"] + "bogus", "", "", http.StatusNotFound},
+		{"POST", prefix["forgot"] + "?email=nobody@example.org", "", "", http.StatusNotFound},
+		{"GET", prefix["tokens"], tok, "", http.StatusOK},
+		{"POST", prefix["tokens"], tok, "", http.StatusOK},
+		{"DELETE", prefix["tokens"] + string(spare0), tok, "", http.StatusOK},
+		{"GET", prefix["hooks"], tok, "", http.StatusOK},
+		{"POST", prefix["hooks"], tok, `{"namespace": "sm", "url": "nope", "secret": "s"}`, http.StatusBadRequest},
+		{"GET", prefix["hooks"] + h.ID + "/deliveries", tok, "", http.StatusOK},
+		{"DELETE", prefix["hooks"] + h.ID, tok, "", http.StatusOK},
+		{"GET", prefix["feed"], "", "", http.StatusOK},
+		{"GET", prefix["events"] + "?last_event_id=" + last, "", "", http.StatusOK},
+		{"GET", prefix["v1-packages"] + "?ns=sm&limit=1", "", "", http.StatusOK},
+		{"GET", prefix["v1-packages"] + "?cursor=bogus", "", "", http.StatusBadRequest},
+		{"GET", prefix["v1-packages"] + "sm/foo", "", "", http.StatusOK},
+		{"GET", prefix["v1-packages"] + "sm/nope", "", "", http.StatusNotFound},
+		{"PUT", prefix["v1-packages"] + "sm/baz", tok, `{"repo": "https://example.org/baz"}`, http.StatusCreated},
+		{"PUT", prefix["v1-packages"] + "sm/baz/sub", tok, `{"repo": "https://example.org/baz"}`, http.StatusConflict},
+		{"PATCH", prefix["v1-packages"] + "sm/baz", tok, `{"landing": "page"}`, http.StatusOK},
+		{"DELETE", prefix["v1-packages"] + "sm/baz", tok, "", http.StatusNoContent},
+		{"GET", prefix["v1-namespaces"], tok, "", http.StatusOK},
+		{"GET", prefix["v1-me"], tok, "", http.StatusOK},
+		{"GET", prefix["v1-me"], "", "", http.StatusUnauthorized},
+		{"GET", strings.TrimSuffix(prefix["v1-tokens"], "/"), tok, "", http.StatusOK},
+		{"POST", strings.TrimSuffix(prefix["v1-tokens"], "/"), tok, "", http.StatusCreated},
+		{"DELETE", prefix["v1-tokens"] + string(spare1), tok, "", http.StatusNoContent},
+		{"GET", prefix["v1-bulk"], tok, "", http.StatusOK},
+		{"POST", prefix["v1-bulk"] + "?dry_run=true", tok, `{"version": 1, "packages": [{"path": "` + host + `/sm/qux", "repo": "https://example.org/qux"}]}`, http.StatusOK},
+		{"POST", prefix["v1-bulk"], tok, `{"version": 1, "packages": [{"path": "` + host + `/sm/foo/sub", "repo": "https://example.org/qux"}]}`, http.StatusBadRequest},
+		{"GET", prefix["v1-history"] + "sm/baz", tok, "", http.StatusOK},
+		{"GET", prefix["v1-history"] + "other/baz", tok, "", http.StatusUnauthorized},
+		{"POST", prefix["v1-rollback"] + "sm/baz", tok, `{"revision": 1}`, http.StatusOK},
+		{"POST", prefix["v1-rollback"] + "sm/baz", tok, `{"revision": 3}`, http.StatusConflict},
+		{"POST", prefix["v1-rollback"] + "sm/baz", tok, `{"revision": 99}`, http.StatusNotFound},
+		{"GET", prefix["openapi"], "", "", http.StatusOK},
+	}
+
+	covered := map[string]bool{}
+	for _, test := range tests {
+		name := test.method + " " + test.u
+		route := s.route(strings.SplitN(test.u, "?", 2)[0])
+		if route == "" {
+			t.Errorf("%s: no documented route", name)
+			continue
+		}
+		covered[test.method+" "+route] = true
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		req, err := http.NewRequest(test.method, ts.URL+test.u, strings.NewReader(test.body))
+		if err != nil {
+			t.Fatalf("%s: couldn't create request: %v", name, err)
+		}
+		req = req.WithContext(ctx)
+		if test.tok != "" {
+			req.Header.Set("Authorization", "Bearer "+string(test.tok))
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got, want := resp.StatusCode, test.status; got != want {
+			t.Errorf("%s: got %s, want %s", name, http.StatusText(got), http.StatusText(want))
+		}
+		schema, err := s.check(test.method, route, resp)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+
+		var body interface{}
+		switch {
+		case route == prefix["events"]:
+			// the backlog holds the changes made above
+			schema = s.doc["components"].(object)["schemas"].(object)["Event"]
+			sc := bufio.NewScanner(resp.Body)
+			for sc.Scan() {
+				if strings.HasPrefix(sc.Text(), "data: ") {
+					err = json.Unmarshal([]byte(strings.TrimPrefix(sc.Text(), "data: ")), &body)
+					break
+				}
+			}
+		case schema != nil:
+			err = json.NewDecoder(resp.Body).Decode(&body)
+		}
+		resp.Body.Close()
+		cancel()
+		if err != nil {
+			t.Errorf("%s: couldn't decode body: %v", name, err)
+			continue
+		}
+		if schema != nil {
+			if err := s.validate(schema, body, "body"); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	}
+
+	// every documented operation is exercised
+	missing := []string{}
+	for p, item := range s.paths() {
+		for method := range item.(object) {
+			if method == "parameters" {
+				continue
+			}
+			if op := strings.ToUpper(method) + " " + p; !covered[op] {
+				missing = append(missing, op)
+			}
+		}
+	}
+	sort.Strings(missing)
+	for _, op := range missing {
+		t.Errorf("%s is documented but not tested", op)
+	}
+}
@@ -0,0 +1,120 @@
+package vain
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// validate fills in defaults for p and checks that it is well formed.
+func validate(p *Package) error {
+	if p.Repo == "" {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("invalid repository %q", p.Repo),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if p.Vcs == "" {
+		p.Vcs = "git"
+	}
+	if !valid(p.Vcs) {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("invalid vcs %q", p.Vcs),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if !landings[p.Landing] {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("invalid landing %q; accepted: page, redirect", p.Landing),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if p.Docs != "" && !webURL(p.Docs) {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("invalid docs url %q", p.Docs),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return nil
+}
+
+// addPackage stores p on behalf of e, who must already have been authorized
+// against p's namespace.
+func addPackage(db Storer, e Email, p Package) error {
+	if err := validate(&p); err != nil {
+		return err
+	}
+	if !Valid(p.Path, db.Pkgs()) {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("invalid path; prefix already taken %q", p.Path),
+			Code:    http.StatusConflict,
+		}
+	}
+	if err := db.AddPackage(p, Edit{Email: e}); err != nil {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("unable to add package: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	audit(db, e, "add", p.Path, fmt.Sprintf("%s %s", p.Vcs, p.Repo))
+	return nil
+}
+
+// updatePackage replaces the package at p.Path on behalf of e, who must
+// already have been authorized against p's namespace.
+func updatePackage(db Storer, e Email, p Package) error {
+	if err := validate(&p); err != nil {
+		return err
+	}
+	if !db.PackageExists(path(p.Path)) {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("package %q not found", p.Path),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if err := db.UpdatePackage(p, Edit{Email: e}); err != nil {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("unable to update package: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	audit(db, e, "update", p.Path, fmt.Sprintf("%s %s", p.Vcs, p.Repo))
+	return nil
+}
+
+// removePackage deletes the package at pth on behalf of e, who must already
+// have been authorized against pth's namespace.
+func removePackage(db Storer, e Email, pth string) error {
+	if !db.PackageExists(path(pth)) {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("package %q not found", pth),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if err := db.RemovePackage(path(pth), Edit{Email: e}); err != nil {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("unable to delete package: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	audit(db, e, "delete", pth, "")
+	return nil
+}
+
+// audit records an action taken by e. Failure to record is logged rather than
+// failing the action that has already happened.
+func audit(db Storer, e Email, action, pth, detail string) {
+	err := db.Record(AuditEntry{
+		Time:   time.Now(),
+		Email:  e,
+		Action: action,
+		Path:   pth,
+		Detail: detail,
+	})
+	if err != nil {
+		log.Printf("problem recording audit entry: %v", err)
+	}
+}
@@ -0,0 +1,1068 @@
+package vain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+
+	verrors "mcquay.me/vain/errors"
+	"mcquay.me/vain/metrics"
+)
+
+func init() {
+	RegisterBackend("postgres", func(dsn string) (Storer, error) {
+		db, err := NewPostgresDB(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	})
+}
+
+// Connection pool limits for a PostgresDB, per replica.
+const (
+	pgMaxOpen     = 20
+	pgMaxIdle     = 5
+	pgMaxLifetime = 30 * time.Minute
+)
+
+// pgChannel is the LISTEN/NOTIFY channel on which replicas announce changes
+// to packages; the payload is a pgChange.
+const pgChannel = "vain_packages"
+
+// pgChange is a Change as sent between replicas. Origin is the id of the
+// replica that made it.
+type pgChange struct {
+	Origin  string  `json:"origin"`
+	Action  string  `json:"action"`
+	Package Package `json:"package"`
+}
+
+// pgMigrations are applied in order, each at most once, to bring the schema up
+// to date. Append to the list; never edit an entry that has been released.
+var pgMigrations = []string{
+	`CREATE TABLE users (
+		email      text PRIMARY KEY,
+		token      text NOT NULL DEFAULT '',
+		registered boolean NOT NULL DEFAULT false,
+		requested  timestamptz NOT NULL,
+		disabled   boolean NOT NULL DEFAULT false
+	);
+	CREATE TABLE tokens (
+		token text PRIMARY KEY,
+		email text NOT NULL REFERENCES users ON DELETE CASCADE
+	);
+	CREATE INDEX tokens_email ON tokens (email);
+	CREATE TABLE namespaces (
+		name  text PRIMARY KEY,
+		owner text NOT NULL
+	);
+	CREATE TABLE packages (
+		path    text PRIMARY KEY,
+		vcs     text NOT NULL,
+		repo    text NOT NULL,
+		landing text NOT NULL DEFAULT '',
+		docs    text NOT NULL DEFAULT ''
+	);
+	CREATE TABLE audit (
+		id     bigserial PRIMARY KEY,
+		time   timestamptz NOT NULL,
+		email  text NOT NULL,
+		action text NOT NULL,
+		path   text NOT NULL DEFAULT '',
+		detail text NOT NULL DEFAULT ''
+	);
+	CREATE INDEX audit_email ON audit (email, id);
+	CREATE TABLE hooks (
+		id        text PRIMARY KEY,
+		namespace text NOT NULL DEFAULT '',
+		url       text NOT NULL,
+		secret    text NOT NULL DEFAULT '',
+		owner     text NOT NULL DEFAULT '',
+		created   timestamptz NOT NULL
+	);
+	CREATE TABLE deliveries (
+		id       bigserial PRIMARY KEY,
+		hook     text NOT NULL REFERENCES hooks ON DELETE CASCADE,
+		event    text NOT NULL,
+		action   text NOT NULL,
+		time     timestamptz NOT NULL,
+		attempt  integer NOT NULL,
+		status   integer NOT NULL DEFAULT 0,
+		error    text NOT NULL DEFAULT '',
+		duration bigint NOT NULL
+	);
+	CREATE INDEX deliveries_hook ON deliveries (hook, id);`,
+	`CREATE TABLE revisions (
+		path    text NOT NULL,
+		number  integer NOT NULL,
+		time    timestamptz NOT NULL,
+		email   text NOT NULL,
+		action  text NOT NULL,
+		vcs     text NOT NULL,
+		repo    text NOT NULL,
+		landing text NOT NULL DEFAULT '',
+		docs    text NOT NULL DEFAULT '',
+		PRIMARY KEY (path, number)
+	);`,
+	`CREATE INDEX packages_path ON packages (path COLLATE "C");`,
+}
+
+// pgSortKeys are the sql expressions of sortKeys. They compare bytewise, as
+// the other stores do, so that cursors agree.
+var pgSortKeys = map[string]string{
+	"path":      `path COLLATE "C"`,
+	"repo":      `repo COLLATE "C"`,
+	"vcs":       `vcs COLLATE "C"`,
+	"namespace": `split_part(path, '/', 2) COLLATE "C"`,
+}
+
+// pgRepoHost is the sql expression of repoHost(repo), lower cased.
+const pgRepoHost = `lower(coalesce(
+	substring(repo from '^[A-Za-z][A-Za-z0-9+.-]*://(?:[^@/]*@)?([^:/?#]+)'),
+	substring(repo from '^(?:[^:]*@)?([^:@]*):')))`
+
+// PostgresDB is a Storer kept in PostgreSQL, so that several replicas of
+// vaind can share it. Each replica caches packages in memory for go-get
+// lookups, and drops the cache when any replica announces a change.
+// Watchers are called for the changes of every replica, once committed.
+type PostgresDB struct {
+	db       *sql.DB
+	listener *pq.Listener
+	id       string
+
+	// pkgs is the cache, or nil if it must be reloaded. gen counts
+	// invalidations so that a reload racing with one isn't kept.
+	cl   sync.Mutex
+	pkgs map[string]Package
+	gen  uint64
+
+	wl       sync.Mutex
+	watchers []func(Change)
+}
+
+// NewPostgresDB connects to the database described by dsn (a postgres:// url
+// or key=value connection string) and applies any missing migrations.
+func NewPostgresDB(dsn string) (*PostgresDB, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(pgMaxOpen)
+	db.SetMaxIdleConns(pgMaxIdle)
+	db.SetConnMaxLifetime(pgMaxLifetime)
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("couldn't connect to postgres: %v", err)
+	}
+	if err := pgMigrate(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("couldn't migrate: %v", err)
+	}
+
+	p := &PostgresDB{db: db, id: newID()}
+	p.listener = pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("postgres listener: %v", err)
+		}
+		// notifications may have been missed while disconnected
+		if ev == pq.ListenerEventReconnected || ev == pq.ListenerEventConnectionAttemptFailed {
+			p.invalidate()
+		}
+	})
+	if err := p.listener.Listen(pgChannel); err != nil {
+		p.listener.Close()
+		db.Close()
+		return nil, fmt.Errorf("couldn't listen for changes: %v", err)
+	}
+	go func() {
+		for n := range p.listener.Notify {
+			p.invalidate()
+			// nil after reconnecting
+			if n == nil {
+				continue
+			}
+			c := pgChange{}
+			if err := json.Unmarshal([]byte(n.Extra), &c); err != nil {
+				log.Printf("problem decoding change %q: %v", n.Extra, err)
+				continue
+			}
+			p.notify(Change{Action: c.Action, Package: c.Package, Remote: c.Origin != p.id})
+		}
+	}()
+	return p, nil
+}
+
+// pgMigrate applies the pgMigrations that haven't been, holding a lock so that
+// replicas starting together don't race.
+func pgMigrate(db *sql.DB) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext('vain_migrations'))`); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version integer PRIMARY KEY, applied timestamptz NOT NULL DEFAULT now())`); err != nil {
+		return err
+	}
+	var version int
+	if err := tx.QueryRow(`SELECT coalesce(max(version), 0) FROM schema_migrations`).Scan(&version); err != nil {
+		return err
+	}
+	if version > len(pgMigrations) {
+		return fmt.Errorf("schema version %d is newer than this vaind (%d)", version, len(pgMigrations))
+	}
+	for i := version; i < len(pgMigrations); i++ {
+		if _, err := tx.Exec(pgMigrations[i]); err != nil {
+			return fmt.Errorf("migration %d: %v", i+1, err)
+		}
+		if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES ($1)`, i+1); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Close stops listening for changes and closes the connection pool.
+func (p *PostgresDB) Close() error {
+	p.listener.Close()
+	return p.db.Close()
+}
+
+// Ping reports whether the database can be reached.
+func (p *PostgresDB) Ping() error {
+	return p.db.Ping()
+}
+
+// inTx runs fn in a transaction, committing if it returns nil.
+func (p *PostgresDB) inTx(fn func(tx *sql.Tx) error) error {
+	defer metrics.DBTime("tx")()
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (p *PostgresDB) invalidate() {
+	p.cl.Lock()
+	p.pkgs = nil
+	p.gen++
+	p.cl.Unlock()
+}
+
+// changed announces a change to pkg to this and every other replica, once tx
+// commits, to invalidate their caches and call their watchers.
+func (p *PostgresDB) changed(tx *sql.Tx, action string, pkg Package) error {
+	b, err := json.Marshal(pgChange{Origin: p.id, Action: action, Package: pkg})
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`SELECT pg_notify($1, $2)`, pgChannel, string(b))
+	return err
+}
+
+// cache returns every package by path, loading them if needed.
+func (p *PostgresDB) cache() (map[string]Package, error) {
+	p.cl.Lock()
+	pkgs, gen := p.pkgs, p.gen
+	p.cl.Unlock()
+	if pkgs != nil {
+		return pkgs, nil
+	}
+
+	rows, err := p.db.Query(`SELECT path, vcs, repo, landing, docs FROM packages`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	pkgs = map[string]Package{}
+	for rows.Next() {
+		pkg := Package{}
+		if err := rows.Scan(&pkg.Path, &pkg.Vcs, &pkg.Repo, &pkg.Landing, &pkg.Docs); err != nil {
+			return nil, err
+		}
+		pkgs[pkg.Path] = pkg
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	metrics.Packages.Set(float64(len(pkgs)))
+
+	p.cl.Lock()
+	if p.gen == gen {
+		p.pkgs = pkgs
+	}
+	p.cl.Unlock()
+	return pkgs, nil
+}
+
+// pgTokenUser returns the enabled user holding tok.
+func pgTokenUser(q interface {
+	QueryRow(string, ...interface{}) *sql.Row
+}, tok Token) (Email, error) {
+	var e Email
+	var dis bool
+	err := q.QueryRow(`SELECT t.email, coalesce(u.disabled, false) FROM tokens t LEFT JOIN users u ON u.email = t.email WHERE t.token = $1`, tok).Scan(&e, &dis)
+	if err == sql.ErrNoRows {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("User for token %q not found", tok),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if dis {
+		return "", disabled(e)
+	}
+	return e, nil
+}
+
+// NSForToken creates an entry namespaces with a relation to the token. The
+// namespace's row is locked while its owner is checked, so that replicas
+// can't both claim it.
+func (p *PostgresDB) NSForToken(ns namespace, tok Token) error {
+	return p.inTx(func(tx *sql.Tx) error {
+		e, err := pgTokenUser(tx, tok)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO namespaces (name, owner) VALUES ($1, $2) ON CONFLICT DO NOTHING`, ns, e); err != nil {
+			return err
+		}
+		var owner Email
+		if err := tx.QueryRow(`SELECT owner FROM namespaces WHERE name = $1 FOR UPDATE`, ns).Scan(&owner); err != nil {
+			return err
+		}
+		if owner != e {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("not authorized against namespace %q", ns),
+				Code:    http.StatusUnauthorized,
+			}
+		}
+		return nil
+	})
+}
+
+// UserNamespaces returns the namespaces owned by e.
+func (p *PostgresDB) UserNamespaces(e Email) []namespace {
+	nss := []namespace{}
+	rows, err := p.db.Query(`SELECT name FROM namespaces WHERE owner = $1 ORDER BY name`, e)
+	if err != nil {
+		log.Printf("problem listing namespaces: %v", err)
+		return nss
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var ns namespace
+		if rows.Scan(&ns) == nil {
+			nss = append(nss, ns)
+		}
+	}
+	return nss
+}
+
+// Package fetches the package associated with path, or the package whose path
+// is its longest prefix.
+func (p *PostgresDB) Package(pth string) (Package, error) {
+	pkgs, err := p.cache()
+	if err != nil {
+		return Package{}, err
+	}
+	elems := strings.Split(pth, "/")
+	for i := len(elems); i > 0; i-- {
+		if pkg, ok := pkgs[strings.Join(elems[:i], "/")]; ok {
+			return pkg, nil
+		}
+	}
+	return Package{}, verrors.HTTP{
+		Message: fmt.Sprintf("couldn't find package %q", pth),
+		Code:    http.StatusNotFound,
+	}
+}
+
+func (p *PostgresDB) putPackage(tx *sql.Tx, action string, pkg Package) error {
+	_, err := tx.Exec(`INSERT INTO packages (path, vcs, repo, landing, docs) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (path) DO UPDATE SET vcs = $2, repo = $3, landing = $4, docs = $5`,
+		pkg.Path, pkg.Vcs, pkg.Repo, pkg.Landing, pkg.Docs)
+	if err != nil {
+		return err
+	}
+	return p.changed(tx, action, pkg)
+}
+
+// AddPackage adds p into packages table.
+func (p *PostgresDB) AddPackage(pkg Package, ed Edit) error {
+	err := p.inTx(func(tx *sql.Tx) error {
+		if err := p.putPackage(tx, actionCreate, pkg); err != nil {
+			return err
+		}
+		_, err := pgAddRevision(tx, ed.revision(actionCreate, pkg))
+		return err
+	})
+	p.invalidate()
+	return err
+}
+
+// UpdatePackage replaces the package stored at p.Path.
+func (p *PostgresDB) UpdatePackage(pkg Package, ed Edit) error {
+	err := p.inTx(func(tx *sql.Tx) error {
+		res, err := tx.Exec(`UPDATE packages SET vcs = $2, repo = $3, landing = $4, docs = $5 WHERE path = $1`,
+			pkg.Path, pkg.Vcs, pkg.Repo, pkg.Landing, pkg.Docs)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("package %q not found", pkg.Path),
+				Code:    http.StatusNotFound,
+			}
+		}
+		if _, err := pgAddRevision(tx, ed.revision(actionUpdate, pkg)); err != nil {
+			return err
+		}
+		return p.changed(tx, actionUpdate, pkg)
+	})
+	p.invalidate()
+	return err
+}
+
+// RemovePackage removes package with given path
+func (p *PostgresDB) RemovePackage(pth path, ed Edit) error {
+	err := p.inTx(func(tx *sql.Tx) error {
+		pkg := Package{}
+		err := tx.QueryRow(`DELETE FROM packages WHERE path = $1 RETURNING path, vcs, repo, landing, docs`, pth).
+			Scan(&pkg.Path, &pkg.Vcs, &pkg.Repo, &pkg.Landing, &pkg.Docs)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := pgAddRevision(tx, ed.revision(actionDelete, pkg)); err != nil {
+			return err
+		}
+		return p.changed(tx, actionDelete, pkg)
+	})
+	p.invalidate()
+	return err
+}
+
+// Watch registers fn to be called after every change to a package made
+// through any replica.
+func (p *PostgresDB) Watch(fn func(Change)) {
+	p.wl.Lock()
+	p.watchers = append(p.watchers, fn)
+	p.wl.Unlock()
+}
+
+func (p *PostgresDB) notify(c Change) {
+	p.wl.Lock()
+	ws := p.watchers
+	p.wl.Unlock()
+	for _, fn := range ws {
+		fn(c)
+	}
+}
+
+// PackageExists tells if a package with path is in the database.
+func (p *PostgresDB) PackageExists(pth path) bool {
+	pkgs, err := p.cache()
+	if err != nil {
+		log.Printf("problem loading packages: %v", err)
+		return false
+	}
+	_, ok := pkgs[string(pth)]
+	return ok
+}
+
+// Pkgs returns all packages from the database, sorted by path.
+func (p *PostgresDB) Pkgs() []Package {
+	ps := []Package{}
+	pkgs, err := p.cache()
+	if err != nil {
+		log.Printf("problem loading packages: %v", err)
+		return ps
+	}
+	for _, pkg := range pkgs {
+		ps = append(ps, pkg)
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].Path < ps[j].Path })
+	return ps
+}
+
+// QueryPackages returns the page of packages selected by q.
+func (p *PostgresDB) QueryPackages(q Query) (Page, error) {
+	after, err := q.check()
+	if err != nil {
+		return Page{}, err
+	}
+	name, desc := strings.TrimPrefix(q.Sort, "-"), strings.HasPrefix(q.Sort, "-")
+	key := pgSortKeys[name]
+
+	where, args := []string{"true"}, []interface{}{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if q.Namespace != "" {
+		where = append(where, "split_part(path, '/', 2) = "+arg(string(q.Namespace)))
+	}
+	if q.Vcs != "" {
+		where = append(where, "vcs = "+arg(q.Vcs))
+	}
+	if q.RepoHost != "" {
+		where = append(where, pgRepoHost+" = lower("+arg(q.RepoHost)+")")
+	}
+	if q.Prefix != "" {
+		a := arg(q.Prefix)
+		where = append(where, fmt.Sprintf("(starts_with(path, %[1]s) OR starts_with(substr(path, strpos(path, '/') + 1), %[1]s))", a))
+	}
+	count := fmt.Sprintf(`SELECT count(*) FROM packages WHERE %s`, strings.Join(where, " AND "))
+	countArgs := args
+
+	cmp, dir := ">", "ASC"
+	if desc {
+		cmp, dir = "<", "DESC"
+	}
+	if after != nil {
+		k, pth := arg(after.Key), arg(after.Path)
+		where = append(where, fmt.Sprintf(`(%[1]s %[2]s %[3]s OR (%[1]s = %[3]s AND path COLLATE "C" %[2]s %[4]s))`, key, cmp, k, pth))
+	}
+	list := fmt.Sprintf(`SELECT path, vcs, repo, landing, docs FROM packages WHERE %s ORDER BY %s %s, path COLLATE "C" %s`,
+		strings.Join(where, " AND "), key, dir, dir)
+	if q.Limit > 0 {
+		// one more than asked for, to tell whether there is a next page
+		list += " LIMIT " + arg(q.Limit+1)
+	}
+
+	page := Page{Packages: []Package{}}
+	// a single snapshot, so that the total agrees with the page
+	err = p.inTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY`); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(count, countArgs...).Scan(&page.Total); err != nil {
+			return err
+		}
+		rows, err := tx.Query(list, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			pkg := Package{}
+			if err := rows.Scan(&pkg.Path, &pkg.Vcs, &pkg.Repo, &pkg.Landing, &pkg.Docs); err != nil {
+				return err
+			}
+			page.Packages = append(page.Packages, pkg)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return Page{}, err
+	}
+	if q.Limit > 0 && len(page.Packages) > q.Limit {
+		page.Packages = page.Packages[:q.Limit]
+		last := page.Packages[q.Limit-1]
+		page.Next = cursor{Sort: q.Sort, Key: sortKeys[name](last), Path: last.Path}.encode()
+	}
+	return page, nil
+}
+
+// Register adds email to the database, returning an error if there was one.
+func (p *PostgresDB) Register(e Email) (Token, error) {
+	tok := FreshToken()
+	err := p.inTx(func(tx *sql.Tx) error {
+		res, err := tx.Exec(`INSERT INTO users (email, token, requested) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`, e, tok, time.Now())
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("duplicate email %q", e),
+				Code:    http.StatusConflict,
+			}
+		}
+		_, err = tx.Exec(`INSERT INTO tokens (token, email) VALUES ($1, $2)`, tok, e)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return tok, nil
+}
+
+// Confirm  modifies the user with the given token. Used on register confirmation.
+func (p *PostgresDB) Confirm(tok Token) (Token, error) {
+	nt := FreshToken()
+	err := p.inTx(func(tx *sql.Tx) error {
+		var e Email
+		err := tx.QueryRow(`DELETE FROM tokens WHERE token = $1 RETURNING email`, tok).Scan(&e)
+		if err == sql.ErrNoRows {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("bad token: %s", tok),
+				Code:    http.StatusNotFound,
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`UPDATE users SET token = $2, registered = true WHERE email = $1`, e, nt); err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT INTO tokens (token, email) VALUES ($1, $2)`, nt, e)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return nt, nil
+}
+
+// Forgot is used fetch a user's token. It implements rudimentary rate
+// limiting.
+func (p *PostgresDB) Forgot(e Email, window time.Duration) (Token, error) {
+	var tok Token
+	var requested time.Time
+	var dis bool
+	err := p.db.QueryRow(`SELECT token, requested, disabled FROM users WHERE email = $1`, e).Scan(&tok, &requested, &dis)
+	if err == sql.ErrNoRows {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("could not find email %q in db", e),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if dis {
+		return "", disabled(e)
+	}
+	if requested.After(time.Now()) {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("rate limit hit for %q; try again in %0.2f mins", e, requested.Sub(time.Now()).Minutes()),
+			Code:    http.StatusTooManyRequests,
+		}
+	}
+	return tok, nil
+}
+
+// UserForToken returns the email of the user holding tok.
+func (p *PostgresDB) UserForToken(tok Token) (Email, error) {
+	return pgTokenUser(p.db, tok)
+}
+
+// Tokens returns all tokens belonging to e.
+func (p *PostgresDB) Tokens(e Email) []Token {
+	toks := []Token{}
+	rows, err := p.db.Query(`SELECT token FROM tokens WHERE email = $1 ORDER BY token`, e)
+	if err != nil {
+		log.Printf("problem listing tokens: %v", err)
+		return toks
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tok Token
+		if rows.Scan(&tok) == nil {
+			toks = append(toks, tok)
+		}
+	}
+	return toks
+}
+
+// AddToken creates an additional token for e.
+func (p *PostgresDB) AddToken(e Email) (Token, error) {
+	tok := FreshToken()
+	res, err := p.db.Exec(`INSERT INTO tokens (token, email) SELECT $1, email FROM users WHERE email = $2`, tok, e)
+	if err != nil {
+		return "", err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return "", verrors.HTTP{
+			Message: fmt.Sprintf("couldn't find user %q", e),
+			Code:    http.StatusNotFound,
+		}
+	}
+	return tok, nil
+}
+
+// RevokeToken removes tok from e. A user's last token cannot be revoked.
+func (p *PostgresDB) RevokeToken(e Email, tok Token) error {
+	return p.inTx(func(tx *sql.Tx) error {
+		// lock the user so that concurrent revocations can't remove
+		// every token
+		var primary Token
+		if err := tx.QueryRow(`SELECT token FROM users WHERE email = $1 FOR UPDATE`, e).Scan(&primary); err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		res, err := tx.Exec(`DELETE FROM tokens WHERE token = $1 AND email = $2`, tok, e)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("token %q not found", tok),
+				Code:    http.StatusNotFound,
+			}
+		}
+		var other Token
+		err = tx.QueryRow(`SELECT token FROM tokens WHERE email = $1 ORDER BY token LIMIT 1`, e).Scan(&other)
+		if err == sql.ErrNoRows {
+			return verrors.HTTP{
+				Message: "cannot revoke last token",
+				Code:    http.StatusConflict,
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if primary == tok {
+			_, err = tx.Exec(`UPDATE users SET token = $2 WHERE email = $1`, e, other)
+		}
+		return err
+	})
+}
+
+// Record appends a to the audit log, discarding the oldest entries past
+// maxAudit.
+func (p *PostgresDB) Record(a AuditEntry) error {
+	return p.inTx(func(tx *sql.Tx) error {
+		_, err := tx.Exec(`INSERT INTO audit (time, email, action, path, detail) VALUES ($1, $2, $3, $4, $5)`,
+			a.Time, a.Email, a.Action, a.Path, a.Detail)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`DELETE FROM audit WHERE id NOT IN
+			(SELECT id FROM audit ORDER BY id DESC LIMIT $1)`, maxAudit)
+		return err
+	})
+}
+
+// AuditLog returns up to n of the most recent audit entries for e, or for
+// everyone if e is empty, newest first.
+func (p *PostgresDB) AuditLog(e Email, n int) []AuditEntry {
+	as := []AuditEntry{}
+	rows, err := p.db.Query(`SELECT time, email, action, path, detail FROM audit
+		WHERE $1 = '' OR email = $1 ORDER BY id DESC LIMIT $2`, e, n)
+	if err != nil {
+		log.Printf("problem reading audit log: %v", err)
+		return as
+	}
+	defer rows.Close()
+	for rows.Next() {
+		a := AuditEntry{}
+		if rows.Scan(&a.Time, &a.Email, &a.Action, &a.Path, &a.Detail) == nil {
+			as = append(as, a)
+		}
+	}
+	return as
+}
+
+// pgAddRevision adds r to the history of its package within tx, numbered
+// after the newest unless it already has a number, discarding the oldest past
+// maxRevisions.
+func pgAddRevision(tx *sql.Tx, r Revision) (Revision, error) {
+	// serialize numbering of the path's revisions across replicas
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext($1))`, "vain_revisions "+r.Package.Path); err != nil {
+		return r, err
+	}
+	if r.Number == 0 {
+		if err := tx.QueryRow(`SELECT coalesce(max(number), 0) + 1 FROM revisions WHERE path = $1`, r.Package.Path).Scan(&r.Number); err != nil {
+			return r, err
+		}
+	}
+	pkg := r.Package
+	_, err := tx.Exec(`INSERT INTO revisions (path, number, time, email, action, vcs, repo, landing, docs)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (path, number) DO UPDATE SET time = $3, email = $4, action = $5, vcs = $6, repo = $7, landing = $8, docs = $9`,
+		pkg.Path, r.Number, r.Time, r.Email, r.Action, pkg.Vcs, pkg.Repo, pkg.Landing, pkg.Docs)
+	if err != nil {
+		return r, err
+	}
+	_, err = tx.Exec(`DELETE FROM revisions WHERE path = $1 AND number <= (SELECT max(number) FROM revisions WHERE path = $1) - $2`,
+		pkg.Path, maxRevisions)
+	return r, err
+}
+
+// History returns the revisions of the package at pth, newest first.
+func (p *PostgresDB) History(pth string) []Revision {
+	rs := []Revision{}
+	rows, err := p.db.Query(`SELECT number, time, email, action, path, vcs, repo, landing, docs FROM revisions
+		WHERE path = $1 ORDER BY number DESC`, pth)
+	if err != nil {
+		log.Printf("problem reading history: %v", err)
+		return rs
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r := Revision{}
+		pkg := &r.Package
+		if rows.Scan(&r.Number, &r.Time, &r.Email, &r.Action, &pkg.Path, &pkg.Vcs, &pkg.Repo, &pkg.Landing, &pkg.Docs) == nil {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+// AddHook stores h.
+func (p *PostgresDB) AddHook(h Hook) error {
+	_, err := p.db.Exec(`INSERT INTO hooks (id, namespace, url, secret, owner, created) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET namespace = $2, url = $3, secret = $4, owner = $5, created = $6`,
+		h.ID, h.Namespace, h.URL, h.Secret, h.Owner, h.Created)
+	return err
+}
+
+// RemoveHook deletes the hook with id, along with its deliveries.
+func (p *PostgresDB) RemoveHook(id string) error {
+	res, err := p.db.Exec(`DELETE FROM hooks WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return verrors.HTTP{
+			Message: fmt.Sprintf("hook %q not found", id),
+			Code:    http.StatusNotFound,
+		}
+	}
+	return nil
+}
+
+// Hooks returns all hooks ordered by creation time.
+func (p *PostgresDB) Hooks() []Hook {
+	hs := []Hook{}
+	rows, err := p.db.Query(`SELECT id, namespace, url, secret, owner, created FROM hooks ORDER BY created, id`)
+	if err != nil {
+		log.Printf("problem listing hooks: %v", err)
+		return hs
+	}
+	defer rows.Close()
+	for rows.Next() {
+		h := Hook{}
+		if rows.Scan(&h.ID, &h.Namespace, &h.URL, &h.Secret, &h.Owner, &h.Created) == nil {
+			hs = append(hs, h)
+		}
+	}
+	return hs
+}
+
+// RecordDelivery appends d to the deliveries of its hook, discarding the
+// oldest past maxDeliveries.
+func (p *PostgresDB) RecordDelivery(d Delivery) error {
+	return p.inTx(func(tx *sql.Tx) error {
+		res, err := tx.Exec(`INSERT INTO deliveries (hook, event, action, time, attempt, status, error, duration)
+			SELECT id, $2, $3, $4, $5, $6, $7, $8 FROM hooks WHERE id = $1`,
+			d.Hook, d.Event, d.Action, d.Time, d.Attempt, d.Status, d.Error, int64(d.Duration))
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return verrors.HTTP{
+				Message: fmt.Sprintf("hook %q not found", d.Hook),
+				Code:    http.StatusNotFound,
+			}
+		}
+		_, err = tx.Exec(`DELETE FROM deliveries WHERE hook = $1 AND id NOT IN
+			(SELECT id FROM deliveries WHERE hook = $1 ORDER BY id DESC LIMIT $2)`, d.Hook, maxDeliveries)
+		return err
+	})
+}
+
+// Deliveries returns up to n of the most recent deliveries for hook, newest
+// first.
+func (p *PostgresDB) Deliveries(hook string, n int) []Delivery {
+	ds := []Delivery{}
+	rows, err := p.db.Query(`SELECT hook, event, action, time, attempt, status, error, duration FROM deliveries
+		WHERE hook = $1 ORDER BY id DESC LIMIT $2`, hook, n)
+	if err != nil {
+		log.Printf("problem listing deliveries: %v", err)
+		return ds
+	}
+	defer rows.Close()
+	for rows.Next() {
+		d := Delivery{}
+		var dur int64
+		if rows.Scan(&d.Hook, &d.Event, &d.Action, &d.Time, &d.Attempt, &d.Status, &d.Error, &dur) == nil {
+			d.Duration = time.Duration(dur)
+			ds = append(ds, d)
+		}
+	}
+	return ds
+}
+
+// Export returns every user, namespace, package and revision, sorted.
+func (p *PostgresDB) Export() (Registry, error) {
+	var r Registry
+	// a single snapshot, so that the sections agree
+	err := p.inTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY`); err != nil {
+			return err
+		}
+		var err error
+		if r, err = pgExport(tx); err != nil {
+			return err
+		}
+		rows, err := tx.Query(`SELECT number, time, email, action, path, vcs, repo, landing, docs FROM revisions
+			ORDER BY path COLLATE "C", number`)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			rev := Revision{}
+			pkg := &rev.Package
+			if err := rows.Scan(&rev.Number, &rev.Time, &rev.Email, &rev.Action, &pkg.Path, &pkg.Vcs, &pkg.Repo, &pkg.Landing, &pkg.Docs); err != nil {
+				return err
+			}
+			r.Revisions = append(r.Revisions, rev)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return Registry{}, err
+	}
+	return r, nil
+}
+
+// pgExport returns every user, namespace and package as seen by tx, sorted.
+func pgExport(tx *sql.Tx) (Registry, error) {
+	r := Registry{
+		Version:    RegistryVersion,
+		Users:      []RegistryUser{},
+		Namespaces: []RegistryNamespace{},
+		Packages:   []Package{},
+	}
+	rows, err := tx.Query(`SELECT u.email, u.disabled, u.registered, t.token FROM users u LEFT JOIN tokens t ON t.email = u.email ORDER BY u.email, t.token`)
+	if err != nil {
+		return r, err
+	}
+	for rows.Next() {
+		var e Email
+		var dis, reg bool
+		var tok sql.NullString
+		if err := rows.Scan(&e, &dis, &reg, &tok); err != nil {
+			rows.Close()
+			return r, err
+		}
+		if n := len(r.Users); n == 0 || r.Users[n-1].Email != e {
+			r.Users = append(r.Users, RegistryUser{Email: e, Disabled: dis, Pending: !reg})
+		}
+		if tok.Valid {
+			u := &r.Users[len(r.Users)-1]
+			u.Tokens = append(u.Tokens, Token(tok.String))
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return r, err
+	}
+
+	rows, err = tx.Query(`SELECT name, owner FROM namespaces ORDER BY name`)
+	if err != nil {
+		return r, err
+	}
+	for rows.Next() {
+		ns := RegistryNamespace{}
+		if err := rows.Scan(&ns.Name, &ns.Owner); err != nil {
+			rows.Close()
+			return r, err
+		}
+		r.Namespaces = append(r.Namespaces, ns)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return r, err
+	}
+
+	rows, err = tx.Query(`SELECT path, vcs, repo, landing, docs FROM packages ORDER BY path`)
+	if err != nil {
+		return r, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		pkg := Package{}
+		if err := rows.Scan(&pkg.Path, &pkg.Vcs, &pkg.Repo, &pkg.Landing, &pkg.Docs); err != nil {
+			return r, err
+		}
+		r.Packages = append(r.Packages, pkg)
+	}
+	return r, rows.Err()
+}
+
+// Import stores everything in r in a single transaction. Existing users gain
+// r's tokens and are registered unless pending in r, existing namespaces and
+// packages are replaced, and revisions are numbered as described by Registry.
+func (p *PostgresDB) Import(r Registry) error {
+	err := p.inTx(func(tx *sql.Tx) error {
+		// keep out claims and packages made while r is checked and stored
+		if _, err := tx.Exec(`LOCK TABLE users, tokens, namespaces, packages IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+			return err
+		}
+		cur, err := pgExport(tx)
+		if err != nil {
+			return err
+		}
+		if errs := r.check(cur); len(errs) > 0 {
+			return ImportError(errs)
+		}
+		for _, u := range r.Users {
+			first := Token("")
+			if len(u.Tokens) > 0 {
+				first = u.Tokens[0]
+			}
+			_, err := tx.Exec(`INSERT INTO users (email, token, registered, requested, disabled) VALUES ($1, $2, $5, $3, $4)
+				ON CONFLICT (email) DO UPDATE SET token = CASE WHEN users.token = '' THEN $2 ELSE users.token END,
+					registered = users.registered OR $5`,
+				u.Email, first, time.Now(), u.Disabled, !u.Pending)
+			if err != nil {
+				return err
+			}
+			for _, t := range u.Tokens {
+				if _, err := tx.Exec(`INSERT INTO tokens (token, email) VALUES ($1, $2) ON CONFLICT (token) DO UPDATE SET email = $2`, t, u.Email); err != nil {
+					return err
+				}
+			}
+		}
+		for _, ns := range r.Namespaces {
+			if _, err := tx.Exec(`INSERT INTO namespaces (name, owner) VALUES ($1, $2) ON CONFLICT (name) DO UPDATE SET owner = $2`, ns.Name, ns.Owner); err != nil {
+				return err
+			}
+		}
+		for _, pkg := range r.Packages {
+			var exists bool
+			if err := tx.QueryRow(`SELECT EXISTS (SELECT 1 FROM packages WHERE path = $1)`, pkg.Path).Scan(&exists); err != nil {
+				return err
+			}
+			action := actionCreate
+			if exists {
+				action = actionUpdate
+			}
+			if err := p.putPackage(tx, action, pkg); err != nil {
+				return err
+			}
+		}
+		for _, rev := range r.Revisions {
+			if _, err := pgAddRevision(tx, rev); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	p.invalidate()
+	return err
+}
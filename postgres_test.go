@@ -0,0 +1,212 @@
+package vain
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testPostgres returns the dsn of an empty schema in the database named by
+// VAIN_TEST_POSTGRES, skipping the test if it isn't set.
+func testPostgres(t *testing.T) (string, func()) {
+	dsn := os.Getenv("VAIN_TEST_POSTGRES")
+	if dsn == "" {
+		t.Skip("set VAIN_TEST_POSTGRES to a postgres dsn to run")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("couldn't open postgres: %v", err)
+	}
+	schema := fmt.Sprintf("vain_test_%d", time.Now().UnixNano())
+	if _, err := db.Exec("CREATE SCHEMA " + schema); err != nil {
+		db.Close()
+		t.Skipf("postgres unavailable: %v", err)
+	}
+	sep := " "
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		sep = "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+	}
+	return dsn + sep + "search_path=" + schema, func() {
+		db.Exec("DROP SCHEMA " + schema + " CASCADE")
+		db.Close()
+	}
+}
+
+func TestPostgresDBStorer(t *testing.T) {
+	dsn, done := testPostgres(t)
+	defer done()
+	db, err := NewPostgresDB(dsn)
+	if err != nil {
+		t.Fatalf("couldn't open: %v", err)
+	}
+	defer db.Close()
+	testStorer(t, db)
+}
+
+func TestPostgresDB(t *testing.T) {
+	dsn, done := testPostgres(t)
+	defer done()
+	a, err := NewPostgresDB(dsn)
+	if err != nil {
+		t.Fatalf("couldn't open: %v", err)
+	}
+	defer a.Close()
+	// a second replica finds the schema already migrated
+	b, err := NewPostgresDB(dsn)
+	if err != nil {
+		t.Fatalf("couldn't open second replica: %v", err)
+	}
+	defer b.Close()
+
+	src, done2 := queryDB(t)
+	defer done2()
+	if _, err := Migrate(src, a); err != nil {
+		t.Fatalf("couldn't migrate: %v", err)
+	}
+	if _, err := Verify(src, b); err != nil {
+		t.Fatalf("second replica: %v", err)
+	}
+
+	// only one of several users racing for a namespace gets it
+	toks := []Token{}
+	for i := 0; i < 8; i++ {
+		reg, err := a.Register(Email(fmt.Sprintf("%d@example.org", i)))
+		if err != nil {
+			t.Fatalf("register: %v", err)
+		}
+		tok, err := a.Confirm(reg)
+		if err != nil {
+			t.Fatalf("confirm: %v", err)
+		}
+		toks = append(toks, tok)
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, len(toks))
+	for i, tok := range toks {
+		db := a
+		if i%2 == 1 {
+			db = b
+		}
+		wg.Add(1)
+		go func(db *PostgresDB, tok Token) {
+			defer wg.Done()
+			errs <- db.NSForToken("race", tok)
+		}(db, tok)
+	}
+	wg.Wait()
+	close(errs)
+	won := 0
+	for err := range errs {
+		if err == nil {
+			won++
+		}
+	}
+	if won != 1 {
+		t.Fatalf("%d users claimed the same namespace", won)
+	}
+
+	// a change through one replica is seen by the other's cache and
+	// watchers
+	var cl sync.Mutex
+	changes := []string{}
+	for _, db := range []*PostgresDB{a, b} {
+		db.Watch(func(c Change) {
+			cl.Lock()
+			changes = append(changes, fmt.Sprintf("%s %s remote=%t", c.Action, c.Package.Path, c.Remote))
+			cl.Unlock()
+		})
+	}
+	pkg, err := b.Package("a.org/sm/a")
+	if err != nil {
+		t.Fatalf("package: %v", err)
+	}
+	pkg.Repo = "https://example.org/moved"
+	if err := a.UpdatePackage(pkg, Edit{}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got, _ := b.Package("a.org/sm/a")
+		if got.Repo == pkg.Repo {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("second replica still has %+v", got)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for {
+		cl.Lock()
+		got := strings.Join(changes, ", ")
+		cl.Unlock()
+		if strings.Contains(got, "update a.org/sm/a remote=false") && strings.Contains(got, "update a.org/sm/a remote=true") {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("changes: got %s", got)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPostgresQuery(t *testing.T) {
+	dsn, done := testPostgres(t)
+	defer done()
+	db, err := NewPostgresDB(dsn)
+	if err != nil {
+		t.Fatalf("couldn't open: %v", err)
+	}
+	defer db.Close()
+	mem, done2 := queryDB(t)
+	defer done2()
+	if _, err := Migrate(mem, db); err != nil {
+		t.Fatalf("couldn't migrate: %v", err)
+	}
+
+	// the sql agrees with the in-memory stores, page by page
+	for _, q := range []Query{
+		{},
+		{Namespace: "sm"},
+		{Vcs: "git", Sort: "-repo"},
+		{RepoHost: "GitHub.com"},
+		{Prefix: "mc/"},
+		{Prefix: "b.org/"},
+		{Sort: "vcs"},
+		{Sort: "-namespace"},
+		{Namespace: "zz"},
+	} {
+		for _, limit := range []int{0, 2} {
+			q.Limit = limit
+			for {
+				want, err := mem.QueryPackages(q)
+				if err != nil {
+					t.Fatalf("%+v: memdb: %v", q, err)
+				}
+				got, err := db.QueryPackages(q)
+				if err != nil {
+					t.Fatalf("%+v: %v", q, err)
+				}
+				if paths(got.Packages) != paths(want.Packages) || got.Total != want.Total || got.Next != want.Next {
+					t.Fatalf("%+v: got %q (%d, %q), want %q (%d, %q)", q, paths(got.Packages), got.Total, got.Next, paths(want.Packages), want.Total, want.Next)
+				}
+				if got.Next == "" {
+					break
+				}
+				q.Cursor = got.Next
+			}
+			q.Cursor = ""
+		}
+	}
+	for _, q := range []Query{{Sort: "bogus"}, {Cursor: "bogus"}, {Limit: -1}} {
+		if _, err := db.QueryPackages(q); err == nil {
+			t.Errorf("%+v: expected error", q)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package vain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// Limits on the number of packages returned by a listing.
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// sortKeys are the fields packages can be sorted by. Ties are broken by path,
+// so the order is always stable.
+var sortKeys = map[string]func(Package) string{
+	"path":      func(p Package) string { return p.Path },
+	"repo":      func(p Package) string { return p.Repo },
+	"vcs":       func(p Package) string { return p.Vcs },
+	"namespace": func(p Package) string { return string(pkgNS(p)) },
+}
+
+// A Query selects, orders and pages through packages. The zero Query returns
+// every package sorted by path.
+type Query struct {
+	// Namespace, Vcs and RepoHost, if set, must match exactly.
+	Namespace namespace
+	Vcs       string
+	RepoHost  string
+	// Prefix matches the start of the import path, with or without the
+	// host.
+	Prefix string
+
+	// Sort is one of the sortKeys, optionally prefixed with "-" to reverse
+	// the order (including the tie break). It defaults to "path".
+	Sort string
+	// Cursor is the Next of the previous page.
+	Cursor string
+	// Limit is the most packages returned; 0 means no limit.
+	Limit int
+}
+
+// A Page is the result of a Query.
+type Page struct {
+	Packages []Package `json:"packages"`
+	// Total is the number of packages matching the query's filters across
+	// all pages.
+	Total int `json:"total"`
+	// Next is the cursor for the following page, or empty if this is the
+	// last.
+	Next string `json:"next,omitempty"`
+}
+
+// cursor is the position after which a page starts.
+type cursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	Path string `json:"p"`
+}
+
+func (c cursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	c := cursor{}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(b, &c)
+	}
+	if err != nil {
+		return c, verrors.HTTP{
+			Message: fmt.Sprintf("invalid cursor %q", s),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return c, nil
+}
+
+// sortKey returns the key function for q.Sort and whether it sorts
+// descending.
+func (q Query) sortKey() (func(Package) string, bool, error) {
+	desc := strings.HasPrefix(q.Sort, "-")
+	key, ok := sortKeys[strings.TrimPrefix(q.Sort, "-")]
+	if !ok {
+		return nil, false, verrors.HTTP{
+			Message: fmt.Sprintf("invalid sort %q; accepted: path, repo, vcs, namespace, optionally prefixed with -", q.Sort),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return key, desc, nil
+}
+
+// repoHost returns the host of a repository url, including scp-like
+// user@host:path urls.
+func repoHost(repo string) string {
+	if u, err := url.Parse(repo); err == nil && u.Host != "" {
+		return u.Hostname()
+	}
+	if i := strings.Index(repo, ":"); i > 0 {
+		h := repo[:i]
+		return h[strings.LastIndex(h, "@")+1:]
+	}
+	return ""
+}
+
+// matches reports whether p passes q's filters.
+func (q Query) matches(p Package) bool {
+	if q.Namespace != "" && pkgNS(p) != q.Namespace {
+		return false
+	}
+	if q.Vcs != "" && p.Vcs != q.Vcs {
+		return false
+	}
+	if q.RepoHost != "" && !strings.EqualFold(repoHost(p.Repo), q.RepoHost) {
+		return false
+	}
+	if q.Prefix != "" {
+		rel := ""
+		if i := strings.Index(p.Path, "/"); i >= 0 {
+			rel = p.Path[i+1:]
+		}
+		if !strings.HasPrefix(p.Path, q.Prefix) && !strings.HasPrefix(rel, q.Prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// check defaults q's sort and validates it, returning the position its
+// cursor starts after, if any.
+func (q *Query) check() (*cursor, error) {
+	if q.Sort == "" {
+		q.Sort = "path"
+	}
+	if _, _, err := q.sortKey(); err != nil {
+		return nil, err
+	}
+	if q.Limit < 0 {
+		return nil, verrors.HTTP{
+			Message: fmt.Sprintf("invalid limit %d", q.Limit),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if q.Cursor == "" {
+		return nil, nil
+	}
+	c, err := decodeCursor(q.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if c.Sort != q.Sort {
+		return nil, verrors.HTTP{
+			Message: "cursor is for a different sort",
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return &c, nil
+}
+
+// apply runs q against every package, for Storers that keep them all in
+// memory.
+func (q Query) apply(all []Package) (Page, error) {
+	after, err := q.check()
+	if err != nil {
+		return Page{}, err
+	}
+	key, desc, _ := q.sortKey()
+
+	// less orders by key, then path
+	less := func(ak, ap, bk, bp string) bool {
+		if ak == bk {
+			ak, bk = ap, bp
+		}
+		if ak == bk {
+			return false
+		}
+		return (ak < bk) != desc
+	}
+
+	ps := []Package{}
+	for _, p := range all {
+		if q.matches(p) {
+			ps = append(ps, p)
+		}
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		return less(key(ps[i]), ps[i].Path, key(ps[j]), ps[j].Path)
+	})
+
+	page := Page{Packages: []Package{}, Total: len(ps)}
+	start := 0
+	if after != nil {
+		start = sort.Search(len(ps), func(i int) bool {
+			return less(after.Key, after.Path, key(ps[i]), ps[i].Path)
+		})
+	}
+	end := len(ps)
+	if q.Limit > 0 && start+q.Limit < end {
+		end = start + q.Limit
+		last := ps[end-1]
+		page.Next = cursor{Sort: q.Sort, Key: key(last), Path: last.Path}.encode()
+	}
+	page.Packages = append(page.Packages, ps[start:end]...)
+	return page, nil
+}
+
+// parseQuery reads a Query from the ns, vcs, repo_host, prefix, sort, cursor
+// and limit parameters of req.
+func parseQuery(req *http.Request) (Query, error) {
+	req.ParseForm()
+	q := Query{
+		Namespace: namespace(req.Form.Get("ns")),
+		Vcs:       req.Form.Get("vcs"),
+		RepoHost:  req.Form.Get("repo_host"),
+		Prefix:    req.Form.Get("prefix"),
+		Sort:      req.Form.Get("sort"),
+		Cursor:    req.Form.Get("cursor"),
+	}
+	if l := req.Form.Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n < 0 {
+			return q, verrors.HTTP{
+				Message: fmt.Sprintf("invalid limit %q", l),
+				Code:    http.StatusBadRequest,
+			}
+		}
+		q.Limit = n
+	}
+	return q, nil
+}
+
+// nextURL returns the url of the page after the one requested by req.
+func nextURL(req *http.Request, next string) string {
+	v := req.URL.Query()
+	v.Set("cursor", next)
+	return req.URL.Path + "?" + v.Encode()
+}
@@ -0,0 +1,156 @@
+package vain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func queryDB(t *testing.T) (*MemDB, func()) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	for _, p := range []Package{
+		{Vcs: "git", Repo: "https://github.com/sm/c", Path: "a.org/sm/c"},
+		{Vcs: "hg", Repo: "https://hg.example.org/sm/a", Path: "a.org/sm/a"},
+		{Vcs: "git", Repo: "git@github.com:sm/b", Path: "a.org/sm/b"},
+		{Vcs: "git", Repo: "https://github.com/mc/x", Path: "a.org/mc/x"},
+		{Vcs: "svn", Repo: "https://svn.example.org/mc/y", Path: "b.org/mc/y"},
+	} {
+		if err := db.AddPackage(p, Edit{}); err != nil {
+			t.Fatalf("couldn't add package: %v", err)
+		}
+	}
+	return db, done
+}
+
+func paths(ps []Package) string {
+	s := []string{}
+	for _, p := range ps {
+		s = append(s, p.Path)
+	}
+	return strings.Join(s, " ")
+}
+
+func TestQuery(t *testing.T) {
+	db, done := queryDB(t)
+	defer done()
+
+	tests := []struct {
+		q     Query
+		want  string
+		total int
+	}{
+		{Query{}, "a.org/mc/x a.org/sm/a a.org/sm/b a.org/sm/c b.org/mc/y", 5},
+		{Query{Sort: "-path"}, "b.org/mc/y a.org/sm/c a.org/sm/b a.org/sm/a a.org/mc/x", 5},
+		{Query{Namespace: "sm"}, "a.org/sm/a a.org/sm/b a.org/sm/c", 3},
+		{Query{Vcs: "git"}, "a.org/mc/x a.org/sm/b a.org/sm/c", 3},
+		{Query{RepoHost: "GitHub.com"}, "a.org/mc/x a.org/sm/b a.org/sm/c", 3},
+		{Query{Prefix: "mc/"}, "a.org/mc/x b.org/mc/y", 2},
+		{Query{Prefix: "b.org/"}, "b.org/mc/y", 1},
+		{Query{Sort: "vcs"}, "a.org/mc/x a.org/sm/b a.org/sm/c a.org/sm/a b.org/mc/y", 5},
+		{Query{Sort: "-namespace", Limit: 2}, "a.org/sm/c a.org/sm/b", 5},
+		{Query{Namespace: "zz"}, "", 0},
+	}
+	for _, test := range tests {
+		page, err := db.QueryPackages(test.q)
+		if err != nil {
+			t.Fatalf("%+v: %v", test.q, err)
+		}
+		if got, want := paths(page.Packages), test.want; got != want {
+			t.Errorf("%+v: got %q, want %q", test.q, got, want)
+		}
+		if got, want := page.Total, test.total; got != want {
+			t.Errorf("%+v: total: got %d, want %d", test.q, got, want)
+		}
+	}
+
+	for _, q := range []Query{{Sort: "bogus"}, {Cursor: "bogus"}, {Limit: -1}} {
+		if _, err := db.QueryPackages(q); err == nil {
+			t.Errorf("%+v: expected error", q)
+		}
+	}
+}
+
+func TestQueryCursor(t *testing.T) {
+	db, done := queryDB(t)
+	defer done()
+
+	for _, sort := range []string{"path", "-path", "vcs", "-repo"} {
+		all, _ := db.QueryPackages(Query{Sort: sort})
+		got := []Package{}
+		q := Query{Sort: sort, Limit: 2}
+		for i := 0; ; i++ {
+			if i > 5 {
+				t.Fatalf("%s: too many pages", sort)
+			}
+			page, err := db.QueryPackages(q)
+			if err != nil {
+				t.Fatalf("%s: %v", sort, err)
+			}
+			got = append(got, page.Packages...)
+			if page.Next == "" {
+				break
+			}
+			q.Cursor = page.Next
+		}
+		if paths(got) != paths(all.Packages) {
+			t.Errorf("%s: paged through %q, want %q", sort, paths(got), paths(all.Packages))
+		}
+	}
+
+	page, _ := db.QueryPackages(Query{Limit: 1})
+	if _, err := db.QueryPackages(Query{Sort: "repo", Cursor: page.Next}); err == nil {
+		t.Errorf("expected error using a cursor with another sort")
+	}
+}
+
+func TestPkgsPaging(t *testing.T) {
+	db, done := queryDB(t)
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	next := regexp.MustCompile(`^<(.*)>; rel="next"$`)
+	u := ts.URL + prefix["pkgs"] + "?ns=sm&limit=2"
+	got := []string{}
+	for u != "" {
+		resp, err := http.Get(u)
+		if err != nil {
+			t.Fatalf("couldn't GET: %v", err)
+		}
+		ps := []Package{}
+		if err := json.NewDecoder(resp.Body).Decode(&ps); err != nil {
+			t.Fatalf("couldn't decode: %v", err)
+		}
+		resp.Body.Close()
+		got = append(got, paths(ps))
+		if got, want := resp.Header.Get("X-Total-Count"), "3"; got != want {
+			t.Fatalf("total: got %q, want %q", got, want)
+		}
+		u = ""
+		if m := next.FindStringSubmatch(resp.Header.Get("Link")); m != nil {
+			u = ts.URL + m[1]
+		}
+	}
+	if got, want := fmt.Sprint(got), "[a.org/sm/a a.org/sm/b a.org/sm/c]"; got != want {
+		t.Fatalf("pages: got %s, want %s", got, want)
+	}
+
+	resp, err := http.Get(ts.URL + prefix["pkgs"] + "?limit=-1")
+	if err != nil {
+		t.Fatalf("couldn't GET: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Fatalf("bad limit: got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+}
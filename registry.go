@@ -0,0 +1,527 @@
+package vain
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"sort"
+	"strconv"
+	"strings"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// RegistryVersion is the version of the Registry format.
+const RegistryVersion = 1
+
+// A Registry is the portable form of a store's users, namespaces, packages
+// and package history used for bulk import and export. It is written as json,
+// or as csv with the header
+//
+//	vain,1
+//	kind,key,owner,vcs,repo,landing,docs
+//
+// followed by user, token (key is the token, owner the user), namespace and
+// package rows. Revisions aren't written as csv.
+type Registry struct {
+	Version    int                 `json:"version"`
+	Users      []RegistryUser      `json:"users,omitempty"`
+	Namespaces []RegistryNamespace `json:"namespaces,omitempty"`
+	Packages   []Package           `json:"packages,omitempty"`
+	// Revisions are sorted by path, then number. Those numbered 0 are
+	// given the next number of their path when imported; the rest keep
+	// theirs, replacing any revision with the same number.
+	Revisions []Revision `json:"revisions,omitempty"`
+
+	// lines holds the csv line of each entry, by section.
+	lines map[string][]int
+}
+
+// A RegistryUser is a user and their api tokens.
+type RegistryUser struct {
+	Email  Email   `json:"email"`
+	Tokens []Token `json:"tokens,omitempty"`
+	// Disabled applies to new users only, and isn't written as csv.
+	Disabled bool `json:"disabled,omitempty"`
+	// Pending users haven't confirmed their email; an entry that isn't
+	// pending registers the user. It isn't written as csv either.
+	Pending bool `json:"pending,omitempty"`
+}
+
+// A RegistryNamespace is a namespace and the user that owns it.
+type RegistryNamespace struct {
+	Name  namespace `json:"name"`
+	Owner Email     `json:"owner"`
+}
+
+// A RowError is a problem with one entry of a Registry.
+type RowError struct {
+	// Row is where the entry is, e.g. "packages[3]" or "line 12".
+	Row   string `json:"row"`
+	Key   string `json:"key"`
+	Error string `json:"error"`
+}
+
+func (e RowError) String() string {
+	return fmt.Sprintf("%s: %s: %s", e.Row, e.Key, e.Error)
+}
+
+// An Importer is a Storer that can apply a Registry atomically: either all of
+// it is stored or none of it is. Existing packages are replaced, and revisions
+// are added to the history of theirs. r is checked
+// against the store's contents under the same lock or transaction it is
+// stored in, so that it can't take over a namespace or prefix claimed since
+// it was last checked; invalid entries are returned as an ImportError.
+type Importer interface {
+	Import(r Registry) error
+}
+
+// ImportError lists the entries of a Registry that an Importer refused.
+type ImportError []RowError
+
+func (e ImportError) Error() string {
+	if len(e) == 0 {
+		return "no invalid entries"
+	}
+	return fmt.Sprintf("%d invalid entries, e.g. %s", len(e), e[0])
+}
+
+// An Exporter is a Storer that can list everything needed to rebuild it. An
+// incomplete listing is an error, never an empty or partial Registry.
+type Exporter interface {
+	Export() (Registry, error)
+}
+
+const csvKinds = "kind,key,owner,vcs,repo,landing,docs"
+
+// sortRevisions orders rs by path, then number.
+func sortRevisions(rs []Revision) {
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Package.Path != rs[j].Package.Path {
+			return rs[i].Package.Path < rs[j].Package.Path
+		}
+		return rs[i].Number < rs[j].Number
+	})
+}
+
+// row names entry i of section for error messages.
+func (r Registry) row(section string, i int) string {
+	if ls := r.lines[section]; i < len(ls) {
+		return fmt.Sprintf("line %d", ls[i])
+	}
+	return fmt.Sprintf("%s[%d]", section, i)
+}
+
+// check validates r, filling in package defaults, against cur, the current
+// contents of the store. Every problem found is returned.
+func (r *Registry) check(cur Registry) []RowError {
+	if r.Version != RegistryVersion {
+		return []RowError{{
+			Row:   "version",
+			Key:   strconv.Itoa(r.Version),
+			Error: fmt.Sprintf("unsupported version; want %d", RegistryVersion),
+		}}
+	}
+	errs := []RowError{}
+	fail := func(section string, i int, key, format string, args ...interface{}) {
+		errs = append(errs, RowError{Row: r.row(section, i), Key: key, Error: fmt.Sprintf(format, args...)})
+	}
+
+	users := map[Email]bool{}
+	tokens := map[Token]Email{}
+	for _, u := range cur.Users {
+		users[u.Email] = true
+		for _, t := range u.Tokens {
+			tokens[t] = u.Email
+		}
+	}
+	seen := map[Email]bool{}
+	for i, u := range r.Users {
+		if a, err := mail.ParseAddress(string(u.Email)); err != nil || a.Address != string(u.Email) {
+			fail("users", i, string(u.Email), "invalid email")
+			continue
+		}
+		if seen[u.Email] {
+			fail("users", i, string(u.Email), "duplicate user")
+			continue
+		}
+		seen[u.Email] = true
+		users[u.Email] = true
+		for _, t := range u.Tokens {
+			if owner, ok := tokens[t]; ok && owner != u.Email {
+				fail("users", i, string(u.Email), "token %q belongs to %s", t, owner)
+				continue
+			}
+			tokens[t] = u.Email
+		}
+	}
+
+	owners := map[namespace]Email{}
+	for _, ns := range cur.Namespaces {
+		owners[ns.Name] = ns.Owner
+	}
+	seenNS := map[namespace]bool{}
+	for i, ns := range r.Namespaces {
+		switch owner, ok := owners[ns.Name]; {
+		case ns.Name == "" || strings.Contains(string(ns.Name), "/"):
+			fail("namespaces", i, string(ns.Name), "invalid namespace")
+		case seenNS[ns.Name]:
+			fail("namespaces", i, string(ns.Name), "duplicate namespace")
+		case !users[ns.Owner]:
+			fail("namespaces", i, string(ns.Name), "unknown owner %q", ns.Owner)
+		case ok && owner != ns.Owner:
+			fail("namespaces", i, string(ns.Name), "already owned by %s", owner)
+		default:
+			owners[ns.Name] = ns.Owner
+		}
+		seenNS[ns.Name] = true
+	}
+
+	// packages may replace their current version, but must not conflict
+	// with any other
+	incoming := map[string]bool{}
+	for _, p := range r.Packages {
+		incoming[p.Path] = true
+	}
+	pkgs := []Package{}
+	for _, p := range cur.Packages {
+		if !incoming[p.Path] {
+			pkgs = append(pkgs, p)
+		}
+	}
+	for i := range r.Packages {
+		p := &r.Packages[i]
+		ns := pkgNS(*p)
+		if ns == "" {
+			fail("packages", i, p.Path, "path must be host/namespace/...")
+			continue
+		}
+		if err := verrors.ToHTTP(validate(p)); err != nil {
+			fail("packages", i, p.Path, "%s", err.Message)
+			continue
+		}
+		if !Valid(p.Path, pkgs) {
+			fail("packages", i, p.Path, "prefix already taken")
+			continue
+		}
+		if _, ok := owners[ns]; !ok {
+			fail("packages", i, p.Path, "namespace %q has no owner", ns)
+			continue
+		}
+		pkgs = append(pkgs, *p)
+	}
+
+	for i, rev := range r.Revisions {
+		switch {
+		case rev.Package.Path == "":
+			fail("revisions", i, strconv.Itoa(rev.Number), "missing package path")
+		case rev.Number < 0:
+			fail("revisions", i, rev.Package.Path, "invalid revision number %d", rev.Number)
+		}
+	}
+	return errs
+}
+
+// Import checks r against db and, unless dryRun, stores it atomically along
+// with a revision made by ed of each package, which is an "import" unless
+// ed.Action is set. If any entry is invalid nothing is stored and the problems
+// are returned as RowErrors; problems storing r are returned as an error.
+func Import(db Storer, r Registry, ed Edit, dryRun bool) ([]RowError, error) {
+	return load(db, r, &ed, dryRun)
+}
+
+// load is Import, adding no revisions if ed is nil.
+func load(db Storer, r Registry, ed *Edit, dryRun bool) ([]RowError, error) {
+	imp, iok := db.(Importer)
+	exp, eok := db.(Exporter)
+	if !iok || !eok {
+		return nil, verrors.HTTP{
+			Message: "store does not support bulk import",
+			Code:    http.StatusNotImplemented,
+		}
+	}
+	cur, err := exp.Export()
+	if err != nil {
+		return nil, verrors.HTTP{
+			Message: fmt.Sprintf("unable to read store: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	if errs := r.check(cur); len(errs) > 0 {
+		return errs, nil
+	}
+	if ed != nil {
+		e := ed.now()
+		for _, p := range r.Packages {
+			r.Revisions = append(r.Revisions, e.revision(actionImport, p))
+		}
+	}
+	if dryRun {
+		return nil, nil
+	}
+	if err := imp.Import(r); err != nil {
+		if errs, ok := err.(ImportError); ok {
+			return errs, nil
+		}
+		return nil, verrors.HTTP{
+			Message: fmt.Sprintf("unable to import: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	return nil, nil
+}
+
+// Export returns the contents of db.
+func Export(db Storer) (Registry, error) {
+	exp, ok := db.(Exporter)
+	if !ok {
+		return Registry{}, verrors.HTTP{
+			Message: "store does not support bulk export",
+			Code:    http.StatusNotImplemented,
+		}
+	}
+	return exp.Export()
+}
+
+// WriteRegistry writes r in format, which is "json" or "csv".
+func WriteRegistry(w io.Writer, r Registry, format string) error {
+	r.Version = RegistryVersion
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	case "csv":
+	default:
+		return fmt.Errorf("unknown format %q; accepted: json, csv", format)
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"vain", strconv.Itoa(RegistryVersion)})
+	cw.Write(strings.Split(csvKinds, ","))
+	for _, u := range r.Users {
+		cw.Write([]string{"user", string(u.Email), "", "", "", "", ""})
+		for _, t := range u.Tokens {
+			cw.Write([]string{"token", string(t), string(u.Email), "", "", "", ""})
+		}
+	}
+	for _, ns := range r.Namespaces {
+		cw.Write([]string{"namespace", string(ns.Name), string(ns.Owner), "", "", "", ""})
+	}
+	for _, p := range r.Packages {
+		cw.Write([]string{"package", p.Path, "", p.Vcs, p.Repo, p.Landing, p.Docs})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadRegistry reads a Registry written in format, which is "json" or "csv".
+func ReadRegistry(rd io.Reader, format string) (Registry, error) {
+	r := Registry{}
+	switch format {
+	case "json":
+		if err := json.NewDecoder(rd).Decode(&r); err != nil {
+			return r, fmt.Errorf("couldn't parse json: %v", err)
+		}
+		return r, nil
+	case "csv":
+	default:
+		return r, fmt.Errorf("unknown format %q; accepted: json, csv", format)
+	}
+
+	cr := csv.NewReader(rd)
+	cr.FieldsPerRecord = -1
+	head, err := cr.Read()
+	if err != nil || len(head) != 2 || head[0] != "vain" {
+		return r, fmt.Errorf("line 1: want \"vain,VERSION\"")
+	}
+	if r.Version, err = strconv.Atoi(head[1]); err != nil {
+		return r, fmt.Errorf("line 1: invalid version %q", head[1])
+	}
+	if r.Version != RegistryVersion {
+		return r, nil
+	}
+	if cols, err := cr.Read(); err != nil || strings.Join(cols, ",") != csvKinds {
+		return r, fmt.Errorf("line 2: want %q", csvKinds)
+	}
+
+	r.lines = map[string][]int{}
+	user := map[Email]int{}
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := cr.FieldPos(0)
+		if err != nil {
+			return r, err
+		}
+		if len(rec) != 7 {
+			return r, fmt.Errorf("line %d: got %d fields, want 7", line, len(rec))
+		}
+		switch rec[0] {
+		case "user":
+			user[Email(rec[1])] = len(r.Users)
+			r.Users = append(r.Users, RegistryUser{Email: Email(rec[1])})
+			r.lines["users"] = append(r.lines["users"], line)
+		case "token":
+			i, ok := user[Email(rec[2])]
+			if !ok {
+				return r, fmt.Errorf("line %d: token for %q before its user", line, rec[2])
+			}
+			r.Users[i].Tokens = append(r.Users[i].Tokens, Token(rec[1]))
+		case "namespace":
+			r.Namespaces = append(r.Namespaces, RegistryNamespace{Name: namespace(rec[1]), Owner: Email(rec[2])})
+			r.lines["namespaces"] = append(r.lines["namespaces"], line)
+		case "package":
+			r.Packages = append(r.Packages, Package{Path: rec[1], Vcs: rec[3], Repo: rec[4], Landing: rec[5], Docs: rec[6]})
+			r.lines["packages"] = append(r.lines["packages"], line)
+		default:
+			return r, fmt.Errorf("line %d: unknown kind %q", line, rec[0])
+		}
+	}
+	return r, nil
+}
+
+// ImportResult is the response to a successful bulk import.
+type ImportResult struct {
+	DryRun     bool `json:"dry_run"`
+	Users      int  `json:"users"`
+	Namespaces int  `json:"namespaces"`
+	Packages   int  `json:"packages"`
+}
+
+// bulkFormat returns the registry format named by the format parameter, or
+// else by header h.
+func bulkFormat(req *http.Request, h string) string {
+	if f := req.URL.Query().Get("format"); f != "" {
+		return f
+	}
+	if strings.HasPrefix(req.Header.Get(h), "text/csv") {
+		return "csv"
+	}
+	return "json"
+}
+
+// apiBulk exports and imports the user's namespaces and packages:
+//
+//	GET  bulk  export as json, or csv with ?format=csv or Accept: text/csv
+//	POST bulk  import a Registry; ?dry_run=true only checks it
+//
+// Imported namespaces are owned by the user, and users and revisions can't be
+// imported.
+func (s *Server) apiBulk(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case "GET", "POST":
+	default:
+		apiMethods(w, req, "GET, POST")
+		return
+	}
+	db := s.store(req.Context())
+	e, ok := apiUser(w, req, db)
+	if !ok {
+		return
+	}
+
+	if req.Method == "GET" {
+		all, err := Export(db)
+		if err := verrors.ToHTTP(err); err != nil {
+			apiFail(w, err)
+			return
+		}
+		r := Registry{Version: RegistryVersion}
+		mine := map[namespace]bool{}
+		for _, ns := range all.Namespaces {
+			if ns.Owner == e {
+				mine[ns.Name] = true
+				r.Namespaces = append(r.Namespaces, ns)
+			}
+		}
+		for _, p := range all.Packages {
+			if mine[pkgNS(p)] {
+				r.Packages = append(r.Packages, p)
+			}
+		}
+		f := bulkFormat(req, "Accept")
+		if f != "json" && f != "csv" {
+			apiFailf(w, http.StatusBadRequest, "unknown format %q; accepted: json, csv", f)
+			return
+		}
+		ct := "application/json"
+		if f == "csv" {
+			ct = "text/csv"
+		}
+		w.Header().Set("Content-type", ct)
+		WriteRegistry(w, r, f)
+		return
+	}
+
+	if s.apiRefuse(w) {
+		return
+	}
+	r, err := ReadRegistry(req.Body, bulkFormat(req, "Content-type"))
+	if err != nil {
+		apiFailf(w, http.StatusBadRequest, "unable to parse registry: %v", err)
+		return
+	}
+	dryRun, _ := strconv.ParseBool(req.URL.Query().Get("dry_run"))
+
+	errs := []RowError{}
+	for i, u := range r.Users {
+		errs = append(errs, RowError{Row: r.row("users", i), Key: string(u.Email), Error: "users can't be imported"})
+	}
+	for i, rev := range r.Revisions {
+		errs = append(errs, RowError{Row: r.row("revisions", i), Key: rev.Package.Path, Error: "revisions can't be imported"})
+	}
+	// like the rest of the api, packages can only be published on the
+	// host they were sent to
+	for i, p := range r.Packages {
+		if !strings.HasPrefix(p.Path, req.Host+"/") {
+			errs = append(errs, RowError{Row: r.row("packages", i), Key: p.Path, Error: fmt.Sprintf("path must be on host %s", req.Host)})
+		}
+	}
+	listed := map[namespace]bool{}
+	for i := range r.Namespaces {
+		r.Namespaces[i].Owner = e
+		listed[r.Namespaces[i].Name] = true
+	}
+	// claim the namespace of every package, so that packages in someone
+	// else's namespace are refused
+	for _, p := range r.Packages {
+		if ns := pkgNS(p); ns != "" && !listed[ns] {
+			listed[ns] = true
+			r.Namespaces = append(r.Namespaces, RegistryNamespace{Name: ns, Owner: e})
+		}
+	}
+	if len(errs) == 0 {
+		errs, err = Import(db, r, Edit{Email: e}, dryRun)
+		if err := verrors.ToHTTP(err); err != nil {
+			apiFail(w, err)
+			return
+		}
+	}
+	if len(errs) > 0 {
+		w.Header().Set("Content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(apiError{apiErrorBody{
+			Status:  http.StatusBadRequest,
+			Code:    errorCode(http.StatusBadRequest),
+			Message: fmt.Sprintf("%d invalid entries", len(errs)),
+			Rows:    errs,
+		}})
+		return
+	}
+	if !dryRun {
+		for _, p := range r.Packages {
+			audit(db, e, "import", p.Path, fmt.Sprintf("%s %s", p.Vcs, p.Repo))
+		}
+	}
+	writeJSON(w, ImportResult{
+		DryRun:     dryRun,
+		Namespaces: len(r.Namespaces),
+		Packages:   len(r.Packages),
+	})
+}
@@ -0,0 +1,312 @@
+package vain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegistryFormats(t *testing.T) {
+	r := Registry{
+		Version:    RegistryVersion,
+		Users:      []RegistryUser{{Email: "a@example.org", Tokens: []Token{"t0", "t1"}}},
+		Namespaces: []RegistryNamespace{{Name: "a", Owner: "a@example.org"}},
+		Packages: []Package{
+			{Vcs: "git", Repo: "https://example.org/a/x", Path: "example.org/a/x"},
+			{Vcs: "hg", Repo: "https://example.org/a/y", Path: "example.org/a/y", Landing: "page", Docs: "https://docs.example.org/y"},
+		},
+	}
+	for _, f := range []string{"json", "csv"} {
+		buf := &bytes.Buffer{}
+		if err := WriteRegistry(buf, r, f); err != nil {
+			t.Fatalf("%s: couldn't write: %v", f, err)
+		}
+		got, err := ReadRegistry(buf, f)
+		if err != nil {
+			t.Fatalf("%s: couldn't read: %v", f, err)
+		}
+		got.lines = nil
+		if !reflect.DeepEqual(got, r) {
+			t.Errorf("%s: got %+v, want %+v", f, got, r)
+		}
+	}
+
+	bad := []string{
+		"",
+		"vain,1\nkind,key\n",
+		"vain,x\n",
+		"vain,1\n" + csvKinds + "\ntoken,t,a@example.org,,,,\n",
+		"vain,1\n" + csvKinds + "\nbogus,a,,,,,\n",
+		"vain,1\n" + csvKinds + "\nuser,a@example.org\n",
+	}
+	for _, in := range bad {
+		if _, err := ReadRegistry(strings.NewReader(in), "csv"); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+	if _, err := ReadRegistry(strings.NewReader("{}"), "yaml"); err == nil {
+		t.Errorf("expected error for unknown format")
+	}
+}
+
+func TestImport(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	if err := db.NSForToken("sm", tok); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	if err := db.AddPackage(Package{Vcs: "git", Repo: "https://example.org/sm/a", Path: "example.org/sm/a"}, Edit{}); err != nil {
+		t.Fatalf("couldn't add package: %v", err)
+	}
+
+	in := "vain,1\n" + csvKinds + `
+user,mc@example.org,,,,,
+user,nope,,,,,
+token,` + string(tok) + `,mc@example.org,,,,
+namespace,mc,mc@example.org,,,,
+namespace,sm,mc@example.org,,,,
+namespace,zz,nobody@example.org,,,,
+package,example.org/mc/x,,git,https://example.org/mc/x,,
+package,example.org/mc/x/y,,git,https://example.org/mc/x,,
+package,example.org/qq/x,,git,https://example.org/qq/x,,
+package,example.org/mc/z,,cvs,https://example.org/mc/z,,
+package,mc,,git,https://example.org/mc,,
+`
+	r, err := ReadRegistry(strings.NewReader(in), "csv")
+	if err != nil {
+		t.Fatalf("couldn't read: %v", err)
+	}
+	errs, err := Import(db, r, Edit{}, false)
+	if err != nil {
+		t.Fatalf("couldn't import: %v", err)
+	}
+	got := []string{}
+	for _, e := range errs {
+		got = append(got, e.Row+" "+e.Key)
+	}
+	want := []string{
+		"line 3 mc@example.org",
+		"line 4 nope",
+		"line 7 sm",
+		"line 8 zz",
+		"line 10 example.org/mc/x/y",
+		"line 11 example.org/qq/x",
+		"line 12 example.org/mc/z",
+		"line 13 mc",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("row errors:\ngot  %q\nwant %q", got, want)
+	}
+	if got, want := len(db.Pkgs()), 1; got != want {
+		t.Fatalf("invalid import changed the db: got %d packages, want %d", got, want)
+	}
+
+	r = Registry{
+		Version:    RegistryVersion,
+		Users:      []RegistryUser{{Email: "mc@example.org", Tokens: []Token{"mctok"}}},
+		Namespaces: []RegistryNamespace{{Name: "mc", Owner: "mc@example.org"}},
+		Packages: []Package{
+			{Repo: "https://example.org/mc/x", Path: "example.org/mc/x"},
+			{Repo: "https://example.org/sm/a2", Path: "example.org/sm/a"},
+		},
+	}
+	if errs, err := Import(db, r, Edit{}, true); err != nil || len(errs) > 0 {
+		t.Fatalf("dry run: %v %v", errs, err)
+	}
+	if got, want := len(db.Pkgs()), 1; got != want {
+		t.Fatalf("dry run changed the db: got %d packages, want %d", got, want)
+	}
+	if errs, err := Import(db, r, Edit{}, false); err != nil || len(errs) > 0 {
+		t.Fatalf("import: %v %v", errs, err)
+	}
+
+	if e, err := db.UserForToken("mctok"); err != nil || e != "mc@example.org" {
+		t.Fatalf("imported token: got %q, %v", e, err)
+	}
+	if err := db.NSForToken("mc", "mctok"); err != nil {
+		t.Fatalf("imported namespace: %v", err)
+	}
+	if p, err := db.Package("example.org/mc/x"); err != nil || p.Vcs != "git" {
+		t.Fatalf("imported package: got %+v, %v", p, err)
+	}
+	if p, _ := db.Package("example.org/sm/a"); p.Repo != "https://example.org/sm/a2" {
+		t.Fatalf("replaced package: got %+v", p)
+	}
+	if rs := db.History("example.org/sm/a"); len(rs) != 2 || rs[0].Action != actionImport || rs[0].Package.Repo != "https://example.org/sm/a2" {
+		t.Fatalf("import history: got %+v", rs)
+	}
+
+	exp, err := db.Export()
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if got, want := fmt.Sprint(len(exp.Users), len(exp.Namespaces), len(exp.Packages)), "2 2 2"; got != want {
+		t.Fatalf("export: got %s, want %s", got, want)
+	}
+
+	db2, done2 := TestDB(t)
+	defer done2()
+	if errs, err := load(db2, exp, nil, false); err != nil || len(errs) > 0 {
+		t.Fatalf("import of export: %v %v", errs, err)
+	}
+	if got, _ := db2.Export(); !reflect.DeepEqual(got, exp) {
+		t.Fatalf("round trip: got %+v, want %+v", got, exp)
+	}
+}
+
+// TestImportRecheck checks that stores refuse a registry that has become
+// invalid since Import checked it.
+func TestImportRecheck(t *testing.T) {
+	mdb, done := TestDB(t)
+	defer done()
+	bdb, _, done2 := testBoltDB(t)
+	defer done2()
+	for name, db := range map[string]Storer{"memdb": mdb, "bolt": bdb} {
+		reg, _ := db.Register("sm@example.org")
+		sm, _ := db.Confirm(reg)
+		reg, _ = db.Register("mc@example.org")
+		db.Confirm(reg)
+		r := Registry{
+			Version:    RegistryVersion,
+			Namespaces: []RegistryNamespace{{Name: "sm", Owner: "mc@example.org"}},
+			Packages:   []Package{{Repo: "https://example.org/a", Path: "example.org/sm/a"}},
+		}
+		if errs, err := Import(db, r, Edit{}, true); err != nil || len(errs) > 0 {
+			t.Fatalf("%s: dry run: %v %v", name, errs, err)
+		}
+		// claimed between the check and the import
+		if err := db.NSForToken("sm", sm); err != nil {
+			t.Fatalf("%s: claim: %v", name, err)
+		}
+		err := db.(Importer).Import(r)
+		if errs, ok := err.(ImportError); !ok || len(errs) != 1 || errs[0].Key != "sm" {
+			t.Fatalf("%s: import: got %v", name, err)
+		}
+		if db.PackageExists("example.org/sm/a") {
+			t.Fatalf("%s: refused import stored a package", name)
+		}
+	}
+}
+
+func TestBulk(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	srv := NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	tok, _ := db.addUser("sm@example.org")
+	other, _ := db.addUser("mc@example.org")
+	if err := db.NSForToken("mc", other); err != nil {
+		t.Fatalf("couldn't claim namespace: %v", err)
+	}
+	if err := db.AddPackage(Package{Vcs: "git", Repo: "https://example.org/mc/a", Path: host + "/mc/a"}, Edit{}); err != nil {
+		t.Fatalf("couldn't add package: %v", err)
+	}
+
+	bulk := func(method, query, ct, body string) (int, string) {
+		req, _ := http.NewRequest(method, ts.URL+prefix["v1-bulk"]+query, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+string(tok))
+		if ct != "" {
+			req.Header.Set("Content-type", ct)
+			req.Header.Set("Accept", ct)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("couldn't %s: %v", method, err)
+		}
+		defer resp.Body.Close()
+		buf := &bytes.Buffer{}
+		buf.ReadFrom(resp.Body)
+		return resp.StatusCode, buf.String()
+	}
+
+	csv := "vain,1\n" + csvKinds + "\nnamespace,sm,,,,,\npackage," + host + "/sm/x,,git,https://example.org/sm/x,,\n"
+	if status, body := bulk("POST", "?dry_run=1", "text/csv", csv); status != http.StatusOK || !strings.Contains(body, `"dry_run":true`) {
+		t.Fatalf("dry run: got %d %s", status, body)
+	}
+	if got, want := len(db.Pkgs()), 1; got != want {
+		t.Fatalf("dry run changed the db: got %d packages, want %d", got, want)
+	}
+	next := srv.events.next
+	if status, body := bulk("POST", "", "text/csv", csv); status != http.StatusOK {
+		t.Fatalf("import: got %d %s", status, body)
+	}
+	if err := db.NSForToken("sm", tok); err != nil {
+		t.Fatalf("namespace wasn't claimed: %v", err)
+	}
+	if got, want := len(db.Pkgs()), 2; got != want {
+		t.Fatalf("import: got %d packages, want %d", got, want)
+	}
+	if got, want := srv.events.next-next, uint64(1); got != want {
+		t.Fatalf("import events: got %d, want %d", got, want)
+	}
+
+	js := fmt.Sprintf(`{"version": 1, "users": [{"email": "x@example.org"}], "packages": [{"path": %q, "repo": "r"}, {"path": %q, "repo": "r"}]}`, host+"/mc/b", host+"/sm/y")
+	status, body := bulk("POST", "", "", js)
+	if status != http.StatusBadRequest {
+		t.Fatalf("bad import: got %d %s", status, body)
+	}
+	e := apiError{}
+	if err := json.Unmarshal([]byte(body), &e); err != nil {
+		t.Fatalf("couldn't decode error: %v", err)
+	}
+	if got, want := len(e.Error.Rows), 1; got != want {
+		t.Fatalf("row errors: got %+v, want %d", e.Error.Rows, want)
+	}
+	if got, want := e.Error.Rows[0].Key, "x@example.org"; got != want {
+		t.Fatalf("row error: got %q, want %q", got, want)
+	}
+
+	js = fmt.Sprintf(`{"version": 1, "packages": [{"path": %q, "repo": "r"}]}`, host+"/mc/b")
+	if status, body := bulk("POST", "", "", js); status != http.StatusBadRequest || !strings.Contains(body, "already owned by mc@example.org") {
+		t.Fatalf("import into another's namespace: got %d %s", status, body)
+	}
+	js = `{"version": 1, "packages": [{"path": "other.example.org/sm/z", "repo": "https://example.org/sm/z"}]}`
+	if status, body := bulk("POST", "", "", js); status != http.StatusBadRequest || !strings.Contains(body, "path must be on host "+host) {
+		t.Fatalf("import onto another host: got %d %s", status, body)
+	}
+	js = fmt.Sprintf(`{"version": 1, "revisions": [{"revision": 1, "action": "create", "package": {"path": %q, "repo": "r"}}]}`, host+"/sm/a")
+	if status, body := bulk("POST", "", "", js); status != http.StatusBadRequest || !strings.Contains(body, "revisions can't be imported") {
+		t.Fatalf("import of history: got %d %s", status, body)
+	}
+
+	status, body = bulk("GET", "", "text/csv", "")
+	if status != http.StatusOK {
+		t.Fatalf("export: got %d %s", status, body)
+	}
+	r, err := ReadRegistry(strings.NewReader(body), "csv")
+	if err != nil {
+		t.Fatalf("couldn't read export: %v\n%s", err, body)
+	}
+	if got, want := paths(r.Packages), host+"/sm/x"; got != want {
+		t.Fatalf("export: got %q, want %q", got, want)
+	}
+	if len(r.Users) != 0 || len(r.Namespaces) != 1 {
+		t.Fatalf("export: got %+v", r)
+	}
+
+	srv.SetReadOnly(true)
+	if status, _ := bulk("POST", "", "text/csv", csv); status != http.StatusServiceUnavailable {
+		t.Fatalf("read-only: got %d", status)
+	}
+}
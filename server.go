@@ -3,92 +3,224 @@ package vain
 import (
 	"encoding/json"
 	"fmt"
+	"html/template"
 	"net/http"
 	"net/mail"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
-	"github.com/elazarl/go-bindata-assetfs"
-	"github.com/prometheus/client_golang/prometheus"
-
 	verrors "mcquay.me/vain/errors"
 	"mcquay.me/vain/metrics"
-	"mcquay.me/vain/static"
+	"mcquay.me/vain/tracing"
 )
 
 const apiPrefix = "/api/v0/"
 const emailSubject = "your api token"
 
+// defaultDocs is where browsers are sent for package documentation unless
+// configured otherwise.
+const defaultDocs = "https://pkg.go.dev/"
+
+// retryAfter is how long clients are asked to wait while in read-only mode.
+const retryAfter = 5 * time.Minute
+
 var prefix map[string]string
 
 func init() {
 	prefix = map[string]string{
-		"pkgs":     apiPrefix + "db/",
-		"register": apiPrefix + "register/",
-		"confirm":  apiPrefix + "confirm/",
-		"forgot":   apiPrefix + "forgot/",
-		"static":   "/_static/",
+		"pkgs":      apiPrefix + "db/",
+		"register":  apiPrefix + "register/",
+		"confirm":   apiPrefix + "confirm/",
+		"forgot":    apiPrefix + "forgot/",
+		"hooks":     apiPrefix + "hooks/",
+		"tokens":    apiPrefix + "tokens/",
+		"feed":      apiPrefix + "feed",
+		"events":    apiPrefix + "events",
+		"openapi":   "/api/openapi.json",
+		"static":    "/_static/",
+		"dashboard": "/_dashboard/",
+		"directory": "/_pkgs/",
+
+		"v1":            apiV1,
+		"v1-packages":   apiV1 + "packages/",
+		"v1-namespaces": apiV1 + "namespaces",
+		"v1-me":         apiV1 + "users/me",
+		"v1-tokens":     apiV1 + "tokens/",
+		"v1-bulk":       apiV1 + "bulk",
+		"v1-history":    apiV1 + "history/",
+		"v1-rollback":   apiV1 + "rollback/",
 	}
 }
 
 // Server serves up the http.
 type Server struct {
 	db           Storer
-	static       string
 	emailTimeout time.Duration
 	mail         Mailer
 	insecure     bool
+	noMetrics    bool
+	readOnly     atomic.Bool
+	sessions     *sessions
+	landing      bool
+	docs         string
+	assets       *assets
+	templates    *template.Template
+	brand        Branding
+	hooks        *notifier
+	events       *broker
+}
+
+// An Option configures optional behavior of a Server.
+type Option func(*Server)
+
+// NoMetrics keeps the metrics endpoint off of the public ServeMux, for use
+// when metrics are served from a separate listener.
+func NoMetrics() Option {
+	return func(s *Server) {
+		s.noMetrics = true
+	}
+}
+
+// ReadOnly starts the server in read-only mode.
+func ReadOnly() Option {
+	return func(s *Server) {
+		s.SetReadOnly(true)
+	}
+}
+
+// LandingPages serves browsers an html landing page for packages by default,
+// rather than redirecting them to the repository. Packages can override this
+// by setting Landing.
+func LandingPages() Option {
+	return func(s *Server) {
+		s.landing = true
+	}
+}
+
+// DocsBase sets the url that import paths are appended to when sending
+// browsers to documentation, e.g. a self-hosted pkgsite. It defaults to
+// https://pkg.go.dev/.
+func DocsBase(base string) Option {
+	return func(s *Server) {
+		s.docs = strings.TrimRight(base, "/") + "/"
+	}
+}
+
+// PrivateHooks lets users add webhooks that deliver to loopback, link-local
+// and private addresses. Only hooks added by admins may reach them otherwise.
+func PrivateHooks() Option {
+	return func(s *Server) {
+		s.hooks.private = true
+	}
 }
 
 // NewServer populates a server, adds the routes, and returns it for use.
-func NewServer(sm *http.ServeMux, store Storer, m Mailer, static string, emailTimeout time.Duration, insecure bool) *Server {
+func NewServer(sm *http.ServeMux, store Storer, m Mailer, static string, emailTimeout time.Duration, insecure bool, opts ...Option) *Server {
 	s := &Server{
 		db:           store,
-		static:       static,
 		emailTimeout: emailTimeout,
 		mail:         m,
 		insecure:     insecure,
+		sessions:     newSessions(),
+		docs:         defaultDocs,
+		assets:       newAssets(static),
+		hooks:        newNotifier(store),
+		events:       newBroker(),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.brand.Name == "" {
+		s.brand.Name = defaultName
+	}
+	s.templates = template.Must(templates.Clone()).Funcs(template.FuncMap{
+		"asset": s.assets.url,
+		"brand": func() Branding { return s.brand },
+	})
+	store.Watch(s.changed)
 	addRoutes(sm, s)
 	return s
 }
 
+// SetReadOnly toggles read-only mode. While read-only the server continues to
+// serve go tool metadata, but refuses requests that would modify the store.
+func (s *Server) SetReadOnly(on bool) {
+	s.readOnly.Store(on)
+	v := 0.0
+	if on {
+		v = 1
+	}
+	metrics.ReadOnly.Set(v)
+}
+
+// IsReadOnly reports whether the server is in read-only mode.
+func (s *Server) IsReadOnly() bool {
+	return s.readOnly.Load()
+}
+
+// refuse writes a response telling the client that mutations are not
+// currently possible, returning true if it did so.
+func (s *Server) refuse(w http.ResponseWriter) bool {
+	if !s.IsReadOnly() {
+		return false
+	}
+	w.Header().Set("Retry-After", fmt.Sprintf("%d", int(retryAfter/time.Second)))
+	http.Error(w, "server is in read-only maintenance mode; try again later", http.StatusServiceUnavailable)
+	return true
+}
+
+// writable wraps h so that it is refused while in read-only mode.
+func (s *Server) writable(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if s.refuse(w) {
+			return
+		}
+		h(w, req)
+	}
+}
+
 func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	defer metrics.Time()()
+	db := s.store(req.Context())
 	if req.Method == "GET" {
 		req.ParseForm()
 		if _, ok := req.Form["go-get"]; !ok {
 			route := prefix["static"]
-			if p, err := s.db.Package(req.Host + req.URL.Path); err == nil {
-				route = p.Repo
+			if p, err := db.Package(req.Host + req.URL.Path); err == nil {
+				if s.wantsLanding(p) {
+					s.renderLanding(w, req, p)
+					return
+				}
+				route = s.docsURL(p, req.Host+req.URL.Path)
 			}
 			http.Redirect(w, req, route, http.StatusTemporaryRedirect)
 			return
 		}
 		if req.URL.Path == "/" {
 			fmt.Fprintf(w, "<!DOCTYPE html>\n<html><head>\n")
-			for _, p := range s.db.Pkgs() {
+			for _, p := range db.Pkgs() {
 				fmt.Fprintf(w, "%s\n", p)
 			}
 			fmt.Fprintf(w, "</head>\n<body><p>go tool metadata in head</p></body>\n</html>\n")
 		} else {
-			p, err := s.db.Package(req.Host + req.URL.Path)
+			p, err := db.Package(req.Host + req.URL.Path)
 			if err := verrors.ToHTTP(err); err != nil {
 				metrics.Errors.WithLabelValues(fmt.Sprintf("%d: %s", err.Code, http.StatusText(err.Code))).Add(1)
 				http.Error(w, err.Message, err.Code)
 				return
 			}
+			metrics.PackageHit(p.Path)
 			fmt.Fprintf(w, "<!DOCTYPE html>\n<html><head>\n%s\n</head>\n<body><p>go tool metadata in head</p></body>\n</html>\n", p)
 		}
 		return
 	}
 
-	const prefix = "Bearer "
-	var tok string
-	auth := req.Header.Get("Authorization")
-	if strings.HasPrefix(auth, prefix) {
-		tok = strings.TrimPrefix(auth, prefix)
+	if s.refuse(w) {
+		return
 	}
+
+	tok := bearer(req)
 	if tok == "" {
 		http.Error(w, "missing token", http.StatusUnauthorized)
 		return
@@ -99,11 +231,16 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	if err := verrors.ToHTTP(s.db.NSForToken(ns, Token(tok))); err != nil {
+	if err := verrors.ToHTTP(db.NSForToken(ns, tok)); err != nil {
 		metrics.Errors.WithLabelValues(fmt.Sprintf("%d: %s", err.Code, http.StatusText(err.Code))).Add(1)
 		http.Error(w, err.Message, err.Code)
 		return
 	}
+	e, err := db.UserForToken(tok)
+	if err := verrors.ToHTTP(err); err != nil {
+		http.Error(w, err.Message, err.Code)
+		return
+	}
 
 	switch req.Method {
 	case "POST":
@@ -117,45 +254,43 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			http.Error(w, fmt.Sprintf("unable to parse json from body: %v", err), http.StatusBadRequest)
 			return
 		}
-		if p.Repo == "" {
-			http.Error(w, fmt.Sprintf("invalid repository %q", p.Repo), http.StatusBadRequest)
+		p.Path = fmt.Sprintf("%s/%s", req.Host, strings.Trim(req.URL.Path, "/"))
+		p.Ns = ns
+		if err := verrors.ToHTTP(addPackage(db, e, p)); err != nil {
+			http.Error(w, err.Message, err.Code)
 			return
 		}
-		if p.Vcs == "" {
-			p.Vcs = "git"
-		}
-		if !valid(p.Vcs) {
-			http.Error(w, fmt.Sprintf("invalid vcs %q", p.Vcs), http.StatusBadRequest)
+	case "PATCH":
+		pth := fmt.Sprintf("%s/%s", req.Host, strings.Trim(req.URL.Path, "/"))
+		p, err := db.Package(pth)
+		if err != nil || p.Path != pth {
+			http.Error(w, fmt.Sprintf("package %q not found", pth), http.StatusNotFound)
 			return
 		}
-		p.Path = fmt.Sprintf("%s/%s", req.Host, strings.Trim(req.URL.Path, "/"))
-		p.Ns = ns
-		if !Valid(p.Path, s.db.Pkgs()) {
-			http.Error(w, fmt.Sprintf("invalid path; prefix already taken %q", req.URL.Path), http.StatusConflict)
+		// fields missing from the body keep their current values
+		if err := json.NewDecoder(req.Body).Decode(&p); err != nil {
+			metrics.Errors.WithLabelValues(fmt.Sprintf("%d: %s", http.StatusBadRequest, http.StatusText(http.StatusBadRequest))).Add(1)
+			http.Error(w, fmt.Sprintf("unable to parse json from body: %v", err), http.StatusBadRequest)
 			return
 		}
-		if err := s.db.AddPackage(p); err != nil {
-			http.Error(w, fmt.Sprintf("unable to add package: %v", err), http.StatusInternalServerError)
+		p.Path = pth
+		p.Ns = ns
+		if err := verrors.ToHTTP(updatePackage(db, e, p)); err != nil {
+			http.Error(w, err.Message, err.Code)
 			return
 		}
 	case "DELETE":
 		p := fmt.Sprintf("%s/%s", req.Host, strings.Trim(req.URL.Path, "/"))
-		if !s.db.PackageExists(path(p)) {
-			http.Error(w, fmt.Sprintf("package %q not found", p), http.StatusNotFound)
-			return
-		}
-
-		if err := s.db.RemovePackage(path(p)); err != nil {
-			http.Error(w, fmt.Sprintf("unable to delete package: %v", err), http.StatusInternalServerError)
+		if err := verrors.ToHTTP(removePackage(db, e, p)); err != nil {
+			http.Error(w, err.Message, err.Code)
 			return
 		}
 	default:
-		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: POST, GET, DELETE", req.Method), http.StatusMethodNotAllowed)
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: POST, GET, PATCH, DELETE", req.Method), http.StatusMethodNotAllowed)
 	}
 }
 
 func (s *Server) register(w http.ResponseWriter, req *http.Request) {
-	defer metrics.Time()()
 	req.ParseForm()
 	email, ok := req.Form["email"]
 	if !ok || len(email) != 1 {
@@ -169,7 +304,7 @@ func (s *Server) register(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	tok, err := s.db.Register(Email(addr.Address))
+	tok, err := s.store(req.Context()).Register(Email(addr.Address))
 	if err := verrors.ToHTTP(err); err != nil {
 		metrics.Errors.WithLabelValues(fmt.Sprintf("%d: %s", err.Code, http.StatusText(err.Code))).Add(1)
 		http.Error(w, err.Message, err.Code)
@@ -186,7 +321,8 @@ func (s *Server) register(w http.ResponseWriter, req *http.Request) {
 		Msg: "please check your email\n",
 	}
 
-	err = s.mail.Send(
+	err = s.send(
+		req.Context(),
 		*addr,
 		"your api string",
 		fmt.Sprintf("%s://%s/api/v0/confirm/%+v", proto, req.Host, tok),
@@ -200,14 +336,13 @@ func (s *Server) register(w http.ResponseWriter, req *http.Request) {
 }
 
 func (s *Server) confirm(w http.ResponseWriter, req *http.Request) {
-	defer metrics.Time()()
 	tok := req.URL.Path[len(prefix["confirm"]):]
 	tok = strings.TrimRight(tok, "/")
 	if tok == "" {
 		http.Error(w, "must provide one email parameter", http.StatusBadRequest)
 		return
 	}
-	ttok, err := s.db.Confirm(Token(tok))
+	ttok, err := s.store(req.Context()).Confirm(Token(tok))
 	if err := verrors.ToHTTP(err); err != nil {
 		metrics.Errors.WithLabelValues(fmt.Sprintf("%d: %s", err.Code, http.StatusText(err.Code))).Add(1)
 		http.Error(w, err.Message, err.Code)
@@ -217,7 +352,6 @@ func (s *Server) confirm(w http.ResponseWriter, req *http.Request) {
 }
 
 func (s *Server) forgot(w http.ResponseWriter, req *http.Request) {
-	defer metrics.Time()()
 	req.ParseForm()
 	email, ok := req.Form["email"]
 	if !ok || len(email) != 1 {
@@ -231,7 +365,7 @@ func (s *Server) forgot(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	tok, err := s.db.Forgot(Email(addr.Address), s.emailTimeout)
+	tok, err := s.store(req.Context()).Forgot(Email(addr.Address), s.emailTimeout)
 	if err := verrors.ToHTTP(err); err != nil {
 		metrics.Errors.WithLabelValues(fmt.Sprintf("%d: %s", err.Code, http.StatusText(err.Code))).Add(1)
 		http.Error(w, err.Message, err.Code)
@@ -247,7 +381,8 @@ func (s *Server) forgot(w http.ResponseWriter, req *http.Request) {
 		Msg: "please check your email\n",
 	}
 
-	err = s.mail.Send(
+	err = s.send(
+		req.Context(),
 		*addr,
 		emailSubject,
 		fmt.Sprintf("%s://%s/api/v0/confirm/%+v", proto, req.Host, tok),
@@ -260,39 +395,50 @@ func (s *Server) forgot(w http.ResponseWriter, req *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+// pkgs lists packages as a json array, filtered, sorted and paged as
+// described by parseQuery. The total is in the X-Total-Count header, and the
+// next page is linked with a Link header.
 func (s *Server) pkgs(w http.ResponseWriter, req *http.Request) {
-	defer metrics.Time()()
+	q, err := parseQuery(req)
+	page := Page{}
+	if err == nil {
+		page, err = s.store(req.Context()).QueryPackages(q)
+	}
+	if err := verrors.ToHTTP(err); err != nil {
+		http.Error(w, err.Message, err.Code)
+		return
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
+	if page.Next != "" {
+		w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", nextURL(req, page.Next)))
+	}
 	w.Header().Set("Content-type", "application/json")
-	json.NewEncoder(w).Encode(s.db.Pkgs())
+	json.NewEncoder(w).Encode(page.Packages)
+}
+
+// instrument wraps h with the metrics and tracing for route.
+func instrument(route string, h http.Handler) http.Handler {
+	return metrics.Instrument(route, tracing.Handler(route, h))
 }
 
 func addRoutes(sm *http.ServeMux, s *Server) {
-	sm.Handle("/", s)
-	sm.Handle("/metrics", prometheus.Handler())
-
-	if s.static == "" {
-		sm.Handle(
-			prefix["static"],
-			http.FileServer(
-				&assetfs.AssetFS{
-					Asset:     static.Asset,
-					AssetDir:  static.AssetDir,
-					AssetInfo: static.AssetInfo,
-				},
-			),
-		)
-	} else {
-		sm.Handle(
-			prefix["static"],
-			http.StripPrefix(
-				prefix["static"],
-				http.FileServer(http.Dir(s.static)),
-			),
-		)
-	}
-
-	sm.HandleFunc(prefix["pkgs"], s.pkgs)
-	sm.HandleFunc(prefix["register"], s.register)
-	sm.HandleFunc(prefix["confirm"], s.confirm)
-	sm.HandleFunc(prefix["forgot"], s.forgot)
+	sm.Handle("/", instrument("root", s))
+	if !s.noMetrics {
+		sm.Handle("/metrics", metrics.Handler())
+	}
+
+	sm.Handle(prefix["static"], http.HandlerFunc(s.static))
+
+	sm.Handle(prefix["pkgs"], instrument("pkgs", http.HandlerFunc(s.pkgs)))
+	sm.Handle(prefix["register"], instrument("register", s.writable(s.register)))
+	sm.Handle(prefix["confirm"], instrument("confirm", s.writable(s.confirm)))
+	sm.Handle(prefix["forgot"], instrument("forgot", s.writable(s.forgot)))
+	sm.Handle(prefix["hooks"], instrument("hooks", http.HandlerFunc(s.hooksAPI)))
+	sm.Handle(prefix["tokens"], instrument("tokens", http.HandlerFunc(s.tokens)))
+	sm.Handle(prefix["feed"], instrument("feed", http.HandlerFunc(s.feed)))
+	sm.Handle(prefix["events"], instrument("events", http.HandlerFunc(s.stream)))
+	sm.Handle(prefix["directory"], instrument("directory", http.HandlerFunc(s.directory)))
+	sm.Handle(prefix["openapi"], instrument("openapi", http.HandlerFunc(openapi)))
+	addV1Routes(sm, s)
+	addDashboardRoutes(sm, s)
 }
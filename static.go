@@ -0,0 +1,176 @@
+package vain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	pth "path"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed _static
+var embedded embed.FS
+
+// immutable is the Cache-Control used for fingerprinted assets, i.e. those
+// requested with a ?v= matching their current content.
+const immutable = "public, max-age=31536000, immutable"
+
+// contentTypes covers extensions that mime.TypeByExtension does not know
+// about on every system.
+var contentTypes = map[string]string{
+	".css":   "text/css; charset=utf-8",
+	".js":    "text/javascript; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".map":   "application/json",
+	".svg":   "image/svg+xml",
+	".eot":   "application/vnd.ms-fontobject",
+	".ttf":   "font/ttf",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+}
+
+func contentType(name string) string {
+	ext := pth.Ext(name)
+	if ct, ok := contentTypes[ext]; ok {
+		return ct
+	}
+	return mime.TypeByExtension(ext)
+}
+
+// assets serves the static tree, either embedded in the binary or from a
+// directory on disk for live editing.
+type assets struct {
+	fsys fs.FS
+	live bool
+
+	sync.Mutex
+	sums map[string]string
+}
+
+// newAssets serves the files in dir, or the embedded _static tree if dir is
+// empty.
+func newAssets(dir string) *assets {
+	a := &assets{
+		fsys: os.DirFS(dir),
+		live: true,
+		sums: map[string]string{},
+	}
+	if dir == "" {
+		sub, err := fs.Sub(embedded, "_static")
+		if err != nil {
+			panic(err)
+		}
+		a.fsys = sub
+		a.live = false
+	}
+	return a
+}
+
+// sum returns a short hash of the content of name. Sums of embedded files are
+// cached since they cannot change.
+func (a *assets) sum(name string, b []byte) string {
+	if s, ok := a.cached(name); ok {
+		return s
+	}
+	h := sha256.Sum256(b)
+	s := hex.EncodeToString(h[:8])
+	if !a.live {
+		a.Lock()
+		a.sums[name] = s
+		a.Unlock()
+	}
+	return s
+}
+
+// cached returns the sum of name if it has been cached.
+func (a *assets) cached(name string) (string, bool) {
+	if a.live {
+		return "", false
+	}
+	a.Lock()
+	defer a.Unlock()
+	s, ok := a.sums[name]
+	return s, ok
+}
+
+// overrides reports whether name is present in a static directory on disk.
+func (a *assets) overrides(name string) bool {
+	if !a.live {
+		return false
+	}
+	_, err := fs.Stat(a.fsys, name)
+	return err == nil
+}
+
+// url returns the fingerprinted url for the asset at name, for use in
+// templates.
+func (a *assets) url(name string) string {
+	name = strings.TrimLeft(name, "/")
+	u := prefix["static"] + name
+	if s, ok := a.cached(name); ok {
+		return u + "?v=" + s
+	}
+	b, err := fs.ReadFile(a.fsys, name)
+	if err != nil {
+		return u
+	}
+	return u + "?v=" + a.sum(name, b)
+}
+
+func (a *assets) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" && req.Method != "HEAD" {
+		http.Error(w, fmt.Sprintf("unsupported method %q; accepted: GET, HEAD", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	name := strings.TrimPrefix(req.URL.Path, prefix["static"])
+	name = strings.TrimPrefix(pth.Clean("/"+name), "/")
+	if name == "" {
+		name = "."
+	}
+
+	fi, err := fs.Stat(a.fsys, name)
+	if err != nil {
+		http.NotFound(w, req)
+		return
+	}
+	if fi.IsDir() {
+		if !strings.HasSuffix(req.URL.Path, "/") {
+			http.Redirect(w, req, req.URL.Path+"/", http.StatusMovedPermanently)
+			return
+		}
+		name = pth.Join(name, "index.html")
+		if fi, err = fs.Stat(a.fsys, name); err != nil {
+			http.NotFound(w, req)
+			return
+		}
+	}
+	b, err := fs.ReadFile(a.fsys, name)
+	if err != nil {
+		http.NotFound(w, req)
+		return
+	}
+
+	sum := a.sum(name, b)
+	w.Header().Set("ETag", `"`+sum+`"`)
+	if ct := contentType(name); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	if v := req.URL.Query().Get("v"); v != "" && v == sum {
+		w.Header().Set("Cache-Control", immutable)
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	var mod time.Time
+	if a.live {
+		mod = fi.ModTime()
+	}
+	http.ServeContent(w, req, name, mod, bytes.NewReader(b))
+}
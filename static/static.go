@@ -1,592 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// _static/css/bootstrap.min.css
-// _static/css/bootstrap.min.css.map
-// _static/css/vain.css
-// _static/fonts/glyphicons-halflings-regular.eot
-// _static/fonts/glyphicons-halflings-regular.svg
-// _static/fonts/glyphicons-halflings-regular.ttf
-// _static/fonts/glyphicons-halflings-regular.woff
-// _static/fonts/glyphicons-halflings-regular.woff2
-// _static/forgot/index.html
-// _static/index.html
-// _static/js/bootstrap.min.js
-// _static/js/html5shiv.js
-// _static/js/jquery.js
-// _static/js/respond.min.js
-// _static/js/vain.js
-// _static/register/index.html
-// DO NOT EDIT!
-
-package static
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var __staticCssBootstrapMinCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xbd\x6b\xb3\xe3\xba\x91\x20\xf8\x7d\x7e\x85\xba\x1c\x37\x6e\x95\x4b\x52\x51\xd4\xeb\x48\x8a\x7b\xb6\x7b\x3d\x13\xd3\x8e\x68\xf7\x87\x1d\x6f\xc4\x44\x5c\xd7\x6e\x50\x22\x75\x44\x5f\x4a\x94\x49\xaa\x1e\xd6\x6a\x7e\xfb\xe2\xfd\x48\x64\x92\x94\xce\xb9\xb6\x37\xd6\x5d\xd1\xd7\x3a\x40\x22\x91\xc8\x4c\x64\x02\x49\x20\xf1\xe9\xb7\xff\xf2\x5f\x06\xbf\x1d\xfc\xef\x65\xd9\xd4\x4d\x95\x9c\x07\x5f\xa6\xe3\xe9\x78\x31\x78\x7f\x68\x9a\xf3\xfa\xd3\xa7\x97\xac\xd9\xea\xba\xf1\xae\x3c\x7e\xe0\xd0\xbf\x2b\xcf\xdf\xab\xfc\xe5\xd0\x0c\xe2\x68\x32\x19\xb1\xff\xcc\x07\x7f\xfc\x9a\x37\x4d\x56\x0d\x07\xbf\x3f\xed\xc6\x1c\xe8\x3f\xf2\x5d\x76\xaa\xb3\x74\x70\x39\xa5\x59\x35\xf8\xc3\xef\xff\x28\x91\xd6\x1c\x6b\xde\x1c\x2e\x5b\x8e\xef\x53\xf3\x75\x5b\x7f\x32\x5d\x7c\xda\x16\xe5\xf6\xd3\x31\xa9\x19\xaa\x4f\xff\xf1\xfb\xdf\xfd\xb7\xff\xfc\x1f\xff\x8d\x77\xf9\x89\xd1\x39\x38\x95\xd5\x31\x29\xf2\xbf\x66\xe3\x5d\x5d\x73\x42\xa3\xf1\x74\xf0\xff\x08\xcc\xaa\x33\xf6\x97\x83\xfa\x94\xed\xca\x22\xa9\x3f\xf9\xed\x7e\xfb\xe9\xd0\x1c\x8b\xeb\xbe\x3c\x35\xa3\x7d\x72\xcc\x8b\xef\xeb\x3a\x39\xd5\xa3\x3a\xab\xf2\xfd\x66\xf4\x35\xdb\xfe\x92\x37\xa3\x26\xfb\xd6\x8c\x6a\xd6\x66\x94\xa4\x7f\xbe\xd4\xcd\x7a\x12\x45\x3f\x6c\x46\xc7\x1a\xaf\xb9\x6d\xcb\xf4\xfb\xf5\x98\x54\x2f\xf9\x69\x1d\xdd\x92\xaa\xc9\x77\x45\x36\x4c\xea\x3c\xcd\x86\x69\xd6\x24\x79\x51\x0f\xf7\xf9\xcb\x2e\x39\x37\x79\x79\xe2\x3f\x2f\x55\x36\xdc\xb3\x71\x33\x9e\x1d\xb2\x24\xe5\xff\xf3\x52\x95\x97\xf3\xf0\x98\xe4\xa7\xe1\x31\x3b\x5d\x86\xa7\xe4\xcb\xb0\xce\x76\xa2\x45\x7d\x39\x32\xf4\xdf\xaf\x69\x5e\x9f\x8b\xe4\xfb\x9a\x31\x6a\xf7\xcb\x2d\xb9\xa4\x79\x39\xdc\x25\xa7\x2f\x49\x3d\x3c\x57\xe5\x4b\x95\xd5\xf5\xf0\x0b\xeb\xb5\x34\x90\xf9\xa9\xc8\x4f\xd9\x48\x34\xd8\x7c\xc9\x38\x69\x49\x31\x62\x0c\x79\x39\xad\xb7\x49\x9d\xf1\x5a\x89\x68\x7d\x2a\x9b\xf7\x3f\xef\x18\x67\xaa\xb2\xa8\x3f\x7f\x30\x28\x4e\xe5\x29\xdb\x1c\x32\x2e\x72\x36\xba\x9f\x0f\x79\x9a\x66\xa7\xcf\xc3\x26\x3b\xb2\xea\x26\xf3\xe0\x6e\xc9\x75\x9b\xec\x7e\xe1\x63\x39\xa5\x23\x26\x81\xb2\x5a\x33\xd1\x9e\xea\x73\x52\x65\xa7\xe6\x96\xac\x13\x36\xa2\x2f\x8c\x39\xeb\x43\xc9\xc8\xb9\x96\x97\x86\x93\xc0\xd9\xb6\xdd\x56\x3f\x37\x79\x53\x64\x9f\xaf\xdb\xb2\x62\x3c\x19\x6d\xcb\xa6\x29\x8f\xeb\xc9\xf9\xdb\x20\x65\x3f\xb3\xf4\xb6\x1d\x32\x4d\x29\x4f\x2f\x52\x82\x5f\x25\x51\xcb\x28\xba\xa5\xfb\x93\x2c\xab\x9b\xef\x45\xb6\xce\x1b\x36\xc4\xdd\xed\x30\xd1\x62\x19\x2f\x96\xd9\x71\x10\x6d\x24\x0c\x13\xe0\x3a\xce\x8e\x37\x56\xf9\xcb\x55\x52\xf9\x9b\x28\x8a\x36\x96\xf6\xf5\x6f\xf6\xfb\xe8\x56\x33\xd5\x51\xda\x22\xda\x3c\x31\x61\xd7\x17\x46\xc4\xe5\x7c\x3d\x97\x75\xce\x85\xb3\xae\x32\xc6\x06\x36\x26\x07\xf7\x72\xfe\xc3\x46\xf0\x5d\xb3\x8d\x64\x3d\xc7\xd4\x94\xe7\xf5\x68\x3c\x67\xf4\x30\xdc\x57\x35\xe8\xd1\x38\xe6\x25\xf9\xf1\x45\x71\x83\xb1\xa8\xfe\xf2\x22\xa4\xb4\xae\x98\xea\x7c\xb8\x72\x06\xee\x8b\xf2\xeb\x5a\x8a\xe4\x26\xf5\x4a\x8f\x78\xc2\xc6\x3b\x8b\xce\xdf\x6e\x87\xea\x6a\xc8\xd0\x1a\xbe\x2d\xbf\x71\x4a\xf3\xd3\xcb\x9a\x4b\x9c\x89\x86\x17\x31\x15\x2f\xff\x4a\xd5\xe1\xc5\xb7\x33\xeb\xd1\x10\x92\x5c\x9a\xf2\xb6\x2b\x99\xda\xff\xb2\x4d\x99\x4a\x66\xc3\x3a\x39\x9e\xbd\xe9\x76\x2c\x4f\x25\xd3\x86\x5d\x36\x34\xbf\x1c\xc6\x31\xa2\x6f\xdb\x0b\x63\xc0\x69\x98\x9f\xce\x97\x66\x58\x9e\x1b\x39\x31\x18\xbf\xd8\x64\x18\xf2\x09\xc8\x54\x29\x31\xd3\x4d\x34\x66\x6a\x7e\x60\x33\xb8\xd9\x48\x59\xaa\xbf\x14\x26\x4b\xde\x97\xbc\xce\xb7\x45\xa6\x7b\x90\x28\xaf\x62\x4e\x0b\x25\xdd\x33\x5b\x21\xd5\x58\x41\x70\x63\x31\x10\x84\xfc\xdc\x7c\x3f\x67\x3f\xc9\xe2\xcf\x43\xa7\x88\xcd\xb9\xac\xf1\x4a\x98\x10\x8f\x79\xf3\xf9\xaa\x79\x9d\x9c\xcf\x59\xc2\xd0\xef\xb2\xb5\x6c\xbf\xd9\x5d\xaa\x9a\x91\x79\x2e\x73\xc6\xc7\x4a\x75\xf6\x33\x9b\x47\x09\xa3\x2e\xfd\xec\x76\x6b\x0a\xaf\xaa\x51\x9a\xed\x93\x4b\xa1\xc7\xb6\x5e\x0b\x91\xed\xcb\xdd\xa5\x1e\xe5\xa7\x13\x33\x24\xa2\x5d\x58\x7e\x3d\x27\x69\xca\x85\xc7\xb4\x5c\xeb\x93\x00\xbd\xba\x8a\x2a\xad\xe5\xcd\x19\xcd\xee\x90\xed\x7e\x61\x82\xf6\x07\x9d\x30\x83\x61\x47\xe8\xa8\x86\x99\xb9\xa1\x32\x39\x55\x78\xa9\xa1\xd0\xed\xff\x74\x39\x6e\xb3\xea\x33\x1b\x90\xea\x4c\x8c\x66\x54\x9f\xf3\xd3\xc8\xd5\x14\x02\x9a\xd9\x17\x1f\x5a\xcf\x05\xa1\xaa\xae\xd4\x98\x8c\x76\x07\x74\x4c\xaf\x9b\x21\x1b\x44\x0f\xb8\xca\xed\xf3\xac\x48\x11\x0a\x2c\xed\xb2\x60\xb4\xe3\x4d\x0a\x64\xb0\x54\x83\x94\xb9\xbe\x2a\xe1\xb6\x09\xd3\x41\xa1\xdf\xa2\x73\xa6\xb8\x46\x2b\xc6\x53\x66\x6d\x06\xe3\x45\x2c\xfe\x67\xc9\xfe\xbb\xd1\x33\x6c\x10\x9f\xbf\x69\x9d\xe1\xa6\xb8\x2e\x8b\x3c\x1d\xd4\x79\xc1\xa6\xd5\xad\xc8\x5e\xb2\x53\x8a\x29\x97\x99\xa9\xbe\x75\xd0\x13\x3a\xb0\xe0\x0d\xd7\x73\x6d\xf9\xb9\x5d\x70\xf1\x71\x57\x52\x24\xe7\x3a\x5b\xeb\x1f\xb7\x26\x1d\x36\x07\xdb\xf1\x8d\x2f\x12\xfe\x47\x79\xa9\xd8\x10\x07\xc8\x52\xe3\x30\xdf\x9e\x85\xf3\x9f\x33\xa1\xe4\x45\x56\x09\xe7\xe5\x2d\x39\xea\x6a\xf7\x89\x2d\x12\x3e\x71\x1f\xac\x56\x0b\xff\x7a\xcc\xd2\x3c\x19\x9c\x2b\x36\x53\xaf\xbf\x1d\xae\x93\x3d\x77\xd9\xeb\x6d\xc6\x4c\x45\xe6\x78\x8e\x7f\xc9\x8f\xe7\xb2\x6a\x92\x53\xb3\x91\x4b\x84\x43\x92\xb2\x11\x73\x5e\x3b\x55\x8e\x7b\x89\x06\x6e\x1b\x4f\xe9\xf0\xa6\x54\xcd\x2d\x61\xbe\x94\x1b\x36\xe6\x1e\xa5\x29\xb3\xe2\x5f\x8b\xd5\x97\x74\xf1\x3f\x1f\xaa\x6c\xff\x59\x0e\xe0\xaa\xd4\x73\xfd\x6e\xf0\xfe\xdd\x20\x69\x9a\xea\x3d\xaf\xfd\x30\x78\xf7\xe1\x9d\xeb\x87\x49\x68\x51\xad\xc0\x05\xe2\xff\xeb\xa7\x77\x7f\x4e\xd8\x32\x64\x57\xe5\x67\x06\xa8\x5a\x0e\x4d\xe5\x6f\xde\x05\xc8\xde\xdd\xc4\xa2\xe4\x2f\x17\xb6\x0a\xe2\xae\xe2\x1a\xa8\xd8\x6f\x56\xab\x15\x33\x0a\x2f\x6c\xf9\xc2\xf4\xe8\x17\x36\xf3\xf9\x8a\x6a\x9d\x7c\x29\xf3\xf4\xd6\xf0\x75\x93\x59\x7b\x08\xe5\x19\xc9\xa5\xd4\x48\xe8\x17\xf7\x9c\xc3\x86\x9b\x3d\xbc\x3d\x77\xac\xc7\xe4\xdb\xe8\x6b\x9e\x36\x07\xb1\x8c\x73\x78\x7a\x88\x87\x87\xe9\xf0\x7c\x2d\xab\xf3\x81\xf9\x85\xf5\x74\xc3\xc0\xca\xaf\xec\x87\xac\x72\xb1\x8a\x61\x29\xa4\x63\xb6\x68\xdb\x26\x95\xbf\x24\x1a\x6f\x9b\xd3\xf3\x78\xc7\xe6\x42\x33\x1c\xa7\x55\x79\xbe\x9c\x9f\x9d\x32\xad\xf2\x6c\x15\x30\xc2\x14\xea\x36\x2e\x92\x6d\x56\x20\xec\x61\x60\xb7\xb1\x37\x6d\x82\x59\xe2\xa2\x11\x90\x03\x36\x6d\xf4\xaf\x43\xb8\x56\x63\xab\x9e\x7d\xd0\x66\x24\xb1\xb3\xd5\xbc\x69\xec\x14\x1d\x10\xca\xd2\x34\x75\xb0\xdc\xfe\x55\x2d\x00\x76\x99\xb7\x14\xf8\xf1\xbf\x17\xdf\xcf\x87\x9c\x69\x44\x3d\xf8\xf7\xa4\xd8\x33\x45\x7d\xa9\x7f\xdc\xb0\x69\xb8\xbe\x54\xc5\xfb\xf1\xf8\x13\x87\xae\x3f\xbd\x18\xb0\xd1\x41\x83\x8d\xaa\xec\xe5\x52\x24\xd5\x38\x63\x2b\xa1\xfb\x9b\xfc\x6f\xbf\xc9\xb3\x7d\xfe\xed\xc3\x80\xbb\xfc\xa4\x79\xff\x63\xc6\xfc\x06\x5b\x44\xa5\xa3\xf2\xcc\xb4\x93\x59\xd7\x1f\x3f\x0c\xfb\x63\xfc\x5a\xee\xf7\xb1\x45\x26\xfe\xbc\x1b\x81\xdf\xfe\xae\xe6\x4d\xe3\xb4\x6e\xaa\x4b\x76\xf7\x08\xd8\xd2\xf2\x37\x16\xe0\xff\x36\x00\xaa\xde\x62\x67\x80\x3f\x7e\xb8\x8d\x0d\x2c\xb2\x0e\xe6\xeb\x59\xa6\x0c\x1b\x74\x0f\xd2\x43\x01\x9c\x75\xbc\x5c\x8f\x6c\x5c\x5f\x31\x63\xeb\x74\x77\xc9\x32\x31\xd6\x53\xb6\x3b\xb2\xb5\xf1\x81\xbb\x04\xa6\x7a\x39\x5b\x6d\xb3\x95\x76\x2a\xdd\x76\x59\x7f\x83\x30\x2f\x55\xf2\xbd\x66\xcb\xf2\xcc\x19\xd1\x48\x78\x83\xbc\xfe\xc5\x9a\x79\x65\xb2\xfe\x14\x45\x71\xf2\xce\x05\x3d\x17\x97\x1a\x05\xdb\x7a\x60\xd9\xa5\x52\x50\x43\xbf\xb4\x0c\x1b\xc7\x51\xb2\xf3\x1a\x1f\xf3\x13\xd6\x49\x1c\x4f\x62\x0f\x6e\x57\x94\x97\x14\x81\x5b\x44\x13\x9f\x98\xd3\x97\xac\x60\x6a\x8e\x80\x2e\xa3\x95\x3f\xbc\xec\xb4\xcb\x0b\x14\x70\xef\x01\xbe\xb0\xad\x36\x42\x63\x16\x81\xbe\x8f\x97\x3a\xdf\xa1\x70\xfe\x58\xe4\x4a\x06\x05\x9c\x7a\x80\xcc\xe4\x57\x0d\x0a\x37\xf7\x11\xb2\xb5\x08\x0a\xb6\x08\xc0\x46\x6c\x63\xdb\x7c\x47\x81\x97\x1e\xf0\xa5\xce\x70\x9c\x4f\x1e\xd8\x3e\x2f\x8e\x28\x98\xcf\xeb\xe6\x30\x62\x13\xed\x05\x11\x4b\x16\x4d\x22\x00\x8a\x02\x4d\x02\x7c\x79\x8d\xf2\x06\x28\x4e\x89\x68\x3a\x03\xf2\x19\x5d\x65\x47\xb6\x90\x43\x01\x67\x1e\xe0\x5f\xcb\xf2\xc8\x5c\x2d\x0a\x39\x0f\x21\xd9\x02\x1d\x05\xf5\xe5\xc2\x0c\x22\x0a\xe5\x0b\xa4\x66\x5b\xeb\x04\x51\x57\x06\xe8\x8b\x64\x57\xbe\xa0\x50\x40\x22\x55\x52\xa3\x9c\x8e\x7d\x71\x1c\xca\x23\xca\x98\x78\x02\xf5\x00\x07\xf3\xa5\xd1\xe4\x04\x36\x20\x8f\x32\x41\x26\x3b\x03\xf3\xa5\xc1\x56\x2d\xa7\x82\x81\x8e\x92\x02\xe5\x73\x3c\x47\xc1\x51\x50\x5f\x24\x97\x33\x09\xe8\x4b\x25\x3f\xb1\xc5\x2b\x0a\xf7\x04\x6c\x69\xf2\x7d\xb4\xcb\xab\x1d\xc1\xa6\x15\xd0\x47\xb6\xa3\x41\x87\x34\x8d\x00\xe0\x9e\xed\xd1\x51\x39\x4e\x7d\x01\xf1\xe9\x42\xf1\x69\xea\x0b\x89\xbb\x32\x14\xcc\x17\xd2\xbe\x48\x50\x45\x9b\xce\xa0\x11\x4b\xcf\x07\xb6\x56\x44\x4d\xe8\xd4\x17\xd1\x97\xb2\xb8\x1c\x33\x6a\x46\x4c\x17\x18\x30\x17\x2b\x0a\xbd\xc4\xa0\x2f\x67\x14\xd6\x97\xd6\x5f\x2a\x1e\xe7\x41\x01\x7d\x41\xb1\xf5\x30\x05\x39\x03\x66\x0d\x67\xd6\x6c\x02\xa1\x50\x36\xcd\x7c\x09\x6d\x4b\xdc\xac\xcd\xa6\x01\x18\x8f\x02\xa2\xa0\xbe\x94\xc4\x26\x10\x85\xf3\x05\xb4\x4b\x8e\x59\x95\xa0\x80\xbe\x70\x44\xe4\x0a\x03\x5b\x02\x12\x0b\x74\x9a\xcd\x7c\x81\xc8\x90\x27\x0a\x08\xcc\x1a\xdf\x24\xaa\xc5\x13\x02\x3d\x8f\x42\x68\xb9\x49\xc2\x80\x7d\xd9\x88\xe0\xe6\xa8\xc8\xf6\x38\xe6\x18\x01\xde\x65\x3c\x06\x86\x82\x4f\x11\xf0\x8a\x24\x7b\x86\x40\xf3\x10\x7d\xbe\x47\x7d\xf9\x7c\x1e\xcc\x7d\x14\x6c\x01\x6c\x59\xca\xc3\x3a\xe4\x08\xa1\xe5\x13\xd0\x34\xcd\x60\xa1\xc0\x36\x48\xdc\xfa\x8f\x44\x08\x1f\x6d\x00\x96\x67\xf9\xae\xb9\x54\xe8\xd4\x5a\xf8\x52\x3c\x26\xe7\x11\x57\x73\x9c\xd3\x0b\x20\x18\xf9\x69\x03\x03\x9c\x02\x57\x85\x2b\xf0\xc2\x97\x45\x96\xe6\x38\x18\x58\xa2\x1d\x12\x62\x2c\xbe\x0c\x44\x44\x12\x85\xf3\xb9\x4f\xad\x57\x16\x4f\x60\xc9\x97\x9d\x47\x7c\x23\xfc\x35\xa9\xd0\x79\xb6\x58\x01\x29\x31\x2f\xd1\x06\xbf\x8c\x80\xfd\x6b\x01\x9d\x04\x1e\x10\x05\xf3\xe5\x73\x4e\xd8\xca\x13\x85\x9b\x82\x91\x95\xa8\x25\x5f\xce\x80\x19\xaa\x48\xfa\xe6\xe1\xd0\xdb\xc0\xe1\x62\x9a\x71\xb6\x0d\xdc\x97\x57\xf6\xe7\x6c\x87\xea\xc9\xf2\x09\xca\xff\x4b\x55\xd2\x66\x66\xb9\x42\xc1\xc9\x59\xf8\x14\x05\x5b\x3a\xb1\x92\x44\x61\x27\xe1\xd6\x8c\x06\x8e\x91\x15\x34\x0d\x3d\x05\x8b\x72\x1a\xd2\x97\xdf\x5f\x2e\x59\xcd\x37\xe0\x34\xfc\x1c\x58\xa5\x7d\x49\xc3\x02\x11\xee\xaa\x2c\x3b\xd5\x87\x12\xe7\xdc\x12\x1b\x20\xbd\x84\x7b\x7a\x82\x43\x6c\x81\x85\xab\x88\x53\x0b\xf0\xca\x17\x61\x52\x55\xe5\x57\x52\x3f\x56\x13\x04\x98\xd4\x8e\x55\x8c\x40\xe3\x2b\xa4\xd5\x14\x01\xa5\x96\x5e\xab\x59\x68\xfc\xa8\xc5\xe7\x6a\x0e\xf8\x2c\xbe\x40\xef\x2f\x05\xba\xd7\x59\x2d\x30\x68\xf1\x29\x13\x05\x07\xb3\xf0\xdb\xae\x48\x8e\x49\x9b\x42\x4d\xc0\xa6\xfe\x25\x47\x19\x3d\x01\x7b\xfa\x22\x4b\xb0\x25\xeb\x04\xec\xe8\xf7\x39\xea\x05\x26\x11\x70\x2a\xdf\x33\x11\xab\x43\x41\xe7\x01\xe8\xae\x28\x51\x9b\x39\x01\x01\x00\x66\xab\x4e\xf9\xe9\x85\x1e\xfa\x12\x5a\xec\x13\x8e\x16\xd8\xac\xa4\xc8\x4e\x29\x1a\x82\x98\x80\x38\x40\x95\x9c\xd2\x12\x0b\x18\x4c\x40\x14\x60\x57\x1e\x8f\x19\xea\x80\x27\x20\x14\x70\x4c\x5e\x4e\x19\x0e\x18\xa3\xb6\x12\xd5\xef\x09\x88\x08\x68\x60\x42\xc3\x27\x20\x2e\x50\x65\xcd\xd7\x8c\xa0\x02\x2e\x04\xca\xf3\x99\x0b\x61\x87\xc7\x76\x26\x13\xb8\x8e\x2e\x44\xf0\x9b\x12\x31\x88\x12\x28\x70\x4a\x79\x40\xa8\x40\x4d\x1f\xfd\xfd\x1e\x6d\x01\x77\xa6\xa2\xc5\xa1\xac\xf2\xbf\x32\x28\xbc\x0d\x0c\x21\xa4\x98\x87\x9c\x80\x08\xc2\x96\xcd\x78\x86\x16\x25\x1b\x44\x11\xb6\x19\x3a\xdb\x27\x20\x8a\xb0\xe3\xc3\xda\xb3\x81\x35\x28\xe7\x40\x30\xa1\x39\x5c\x8e\xdb\x9a\xd0\x0e\x10\x49\x50\xb0\x94\x72\x80\x60\xc2\x81\x29\x3d\x69\x83\x27\x20\xa0\x20\x80\x09\xeb\x3e\x01\x41\x05\x01\x4b\x10\xbc\x0a\x21\x29\x72\x41\x4c\x41\x7a\xa2\x0e\xd7\x31\x01\xe1\x05\xaf\x11\x45\x3e\x88\x33\x78\x6d\xf0\x61\x80\x90\x83\xd7\x82\x1c\x8e\x2f\xd7\x97\xa2\xdc\xa2\xf2\x07\xa1\x87\xaf\x55\x76\x42\xa3\xb2\x13\x10\x76\x68\x92\xfa\x17\x6c\x93\x3e\x01\x01\x87\x7d\x5e\xe0\x9b\xbf\x09\x88\x36\x6c\xab\x3c\xdb\xef\x12\x7c\x7e\x83\x80\x03\xf7\x8b\x72\xdd\x82\x01\x83\x98\x43\x9a\xd4\x87\x6d\x89\x2f\x50\x27\x20\xf2\x70\x4e\xce\x19\x63\x6e\x8e\x8a\x01\x84\x1f\x44\x5c\x9a\x8c\x24\x4f\x40\x14\xa2\xc8\x4f\xd8\x8e\x66\x02\x23\x10\x3c\x46\x84\xc2\xf9\x72\x3a\x5f\xea\xc3\x19\x0d\xc1\x4e\x40\x08\xe2\x52\xe3\x03\xf7\xb9\xff\xb2\xc5\x87\xec\xf3\xbd\x2e\x71\x6b\x0d\x02\x0a\x1c\x6c\xb4\xfd\xce\xd6\x3a\xe7\x43\xb2\xc5\x1d\x02\x08\x2b\xc0\x26\xc4\x3a\x69\x02\x02\x0c\xba\x99\xfc\x3c\x89\xc1\x4f\x69\x78\xb2\x8f\x19\x4e\x5a\xd3\x54\xf9\xf6\xd2\xa0\x21\xbc\x09\x08\x36\x84\x8d\xc8\xde\x80\xb8\x4e\x62\xf3\x9b\xa1\x42\x9b\xc3\x85\xdc\x99\x59\x34\x14\x10\x06\xc3\xe5\xb7\x62\xd2\x5a\x80\xa8\x83\x81\xc7\xed\x11\x88\x3c\x14\xe5\x0b\xfe\x35\x60\xb2\x98\xc0\x58\x29\x1a\xa5\x9d\x2c\x60\xe8\xf5\x85\xf8\x68\x30\x01\xe1\x89\x53\xf6\x75\xf4\x35\x3f\xf1\x33\x13\x18\x30\x5c\x9e\xec\x4a\xdc\x0a\xc0\x30\x45\x82\x86\x15\x26\x20\x4a\x41\x2d\x2f\x40\x90\x82\x63\xc3\x7b\x05\xd1\x3d\xf1\x35\x1d\x05\x5c\x41\xb1\x13\x80\x20\x2e\x51\x67\xb8\x76\x2c\xa1\x58\xd8\x62\xec\xfb\x28\x45\xbf\x87\x32\xe8\x18\x83\x26\x47\xb5\x84\xf1\x71\x01\x4e\x7e\x5b\x9a\xc0\x50\x85\x45\x8f\x42\xcf\x31\x68\x4a\x12\x20\x5a\xc1\x3c\x46\x9a\x37\x7c\xcd\x89\x53\xee\xcb\x4d\x9e\x17\xc4\xcd\x0a\x8c\x57\x5c\x9a\x22\xab\x50\x37\x00\x42\x15\xf2\xfc\x0a\x06\xf8\x14\x2c\xfd\xcf\xfc\xb0\x2f\xce\x64\x10\xa4\x60\x9e\x88\x74\x1c\x20\x44\x21\xe0\x28\x5b\x04\x02\x14\x4d\xf9\x95\xa0\x15\x58\xc8\x26\x69\x50\xa3\x08\xc2\x12\x75\x4a\xc6\x3d\x27\x20\x2a\x71\x68\x03\x05\xf3\xeb\xb2\x15\x87\x95\x70\x0a\x40\x24\x50\x1c\x84\xe1\x1f\xff\x09\xd4\xd0\xdf\x5d\xc4\x8a\xb1\xd8\xa2\xb2\x5d\x41\xb7\xc7\xa1\xe7\xa3\x09\x0a\x0b\xfd\x1d\x87\x5d\x10\xb0\xd0\xc9\x71\xd8\x25\x01\x0b\xd6\x86\xfa\xe8\xfe\x88\xf8\xe4\x31\x59\x41\xa3\xf8\x92\xf3\xa3\xf9\x22\x1a\x40\xb6\x01\x9f\x3f\xf8\x31\x84\xb6\x0f\x89\x13\x10\x71\x90\x0d\xc8\xcf\x89\x93\xd5\x13\x98\x79\x19\xdb\xce\x97\xa7\x9c\x98\x7d\x2b\xf8\x11\x97\x81\xa7\xd9\x2e\x4f\x2f\x25\x76\x8c\x22\x8b\x23\x30\xb7\x30\x22\x62\x10\xf2\xe0\x16\x88\xfa\xa0\x1b\x83\xb8\x07\xb7\x3f\x34\x2c\x58\x08\x66\x5f\xb2\x02\x77\xac\x31\x08\x80\x70\x61\xa2\x60\x60\x2d\xc8\x4f\xb3\xa0\x70\xfe\x9c\x4a\x98\x8d\xc2\xa6\x7d\x0c\xc2\x13\xd9\x5f\x2e\xe2\x3a\x05\xc6\xfb\x18\x44\x28\x7e\x11\x07\x7c\x11\xb0\x09\x0c\x60\xa2\x16\x1a\x1e\x70\x39\x27\xe8\xfa\x24\x06\x71\x89\x6d\xce\x03\x08\x28\xa0\xcf\xc1\x5f\x4e\xc4\xce\x2d\x06\x01\x89\x6d\xc2\x16\x6b\x0c\xe8\x78\x29\xb0\xef\x7a\x31\x88\x47\x34\x68\x54\x26\x5e\xec\xfd\xb3\x43\xdb\x22\xe1\x27\xab\x71\x7f\x13\x83\x30\xc4\x16\x35\xf5\x31\x08\x3d\x24\xe7\x33\xa6\x66\xfb\xa7\xbd\x7f\x5c\x27\xab\xf0\xad\x54\x0c\x02\x0e\x87\xf2\x52\x11\x47\x7b\xe2\xe9\xc4\x3f\xe3\x54\x24\x47\x94\xe9\x20\xe2\x90\xb2\x89\x4e\xc5\x1b\x62\x10\x6f\x38\xe7\x2f\x2f\xdf\x79\xe8\x17\xb3\x3b\x31\x08\x38\xd4\xbb\xbc\x66\x0b\x6b\x74\x8a\x83\x68\xc3\x36\x6f\x76\x25\xba\x28\x8d\x41\xa8\x61\xdb\x60\x5f\x54\x21\xd4\xb7\x2d\xaa\x45\x00\xea\x3b\xa6\xe4\x51\x94\xf8\xc3\xf8\x33\x36\xab\x03\xa8\xea\xb2\xc5\x04\x1d\x47\xdb\x14\xc2\xf5\x80\x12\x27\xe0\xb0\x11\x80\xb0\x47\xbe\xcb\xd8\xf2\xbb\x28\x50\xbb\x03\xa2\x1d\x06\x96\x07\x03\x1a\x5c\x7b\x41\xb0\x23\x4b\x2f\x3b\x79\x6e\x19\x83\x05\x9f\x47\xc4\x55\xaa\xf6\x20\x5b\x0c\xc2\x1c\xaa\x4d\x4b\x28\x2f\x06\x01\x0f\x7e\x11\x6b\x74\x48\x8e\x5b\x36\x0d\x70\x8b\x07\x02\x1f\xc7\x32\x4d\x0a\x7a\xd3\x11\x83\xf8\x47\x89\x1d\xae\x63\x50\x60\xfb\x5d\x25\xb8\xb2\x82\xc0\x47\x7d\x39\x89\xc9\x8a\x2e\x76\x62\x78\xd8\x42\xdf\x64\x43\x61\x27\x21\xac\x3c\x20\x8c\x01\xc7\x21\xb0\x73\xd2\x1d\x6b\x01\x64\xb9\xe5\x5f\xfe\xd4\x27\x7b\xfc\x9b\x65\x0c\x22\x21\x5e\x13\x75\x63\x0a\x6b\x35\xa7\x5b\xb5\xab\x0e\x88\x93\x78\x2d\x89\xd8\x5d\x0c\xce\x6d\x78\x6d\xda\x94\x0e\x44\x5b\xbc\x76\x54\x70\x31\x86\x87\x3a\xaa\x3c\x61\xd2\xcf\xe8\x06\xf0\x5c\x87\x6e\x40\x8d\x06\xc4\x60\x0c\x3c\xcd\x6d\x10\x7e\x31\x2d\x08\x91\xce\xe1\xe2\xf4\x54\x97\xb8\x19\x82\x31\x97\xcb\x39\xab\xd4\x55\x03\x0c\x7a\x0e\x77\x00\x2d\xb0\x8b\x70\xbe\x93\x0c\x59\x86\xb0\x34\xb7\x9f\x42\x60\x22\xbe\x12\x83\xf8\x8a\x80\xc5\x97\x80\x3c\xb6\xf2\xdb\x37\xbe\x76\x75\x03\x97\x5a\xde\x18\xbb\xbd\x6f\x2b\x2f\xf8\x45\x67\x7b\x23\xaa\x49\xce\xa3\x03\xe3\x60\x21\xf6\x24\xd2\xc0\x54\x2f\xdb\xe4\x7d\x34\x14\xff\x3e\xc8\x9b\xb5\xee\x91\xf1\x77\xff\x9e\x15\x5f\x32\x3e\x95\x06\xff\x99\x5d\xb2\x77\x43\xf3\xf7\xf0\xdf\x98\xbe\x15\x43\xe7\x3a\xaf\xd3\xeb\x8c\xf5\xea\x1d\x1a\x1f\xcf\xe2\xa7\xf9\x72\x32\x9b\x6e\xd4\xc5\x87\xe9\x74\xba\x41\xaf\x43\xf8\xf7\x11\xe1\x35\x44\x97\x36\x7d\x09\xd1\xf6\xab\x4b\xdc\xae\xf5\xe5\xc4\xe4\x6a\x7a\x5e\x26\xdb\xe5\x06\xde\xdd\x91\xd7\x69\xd7\xe2\x0a\x9f\xb9\x2e\xab\x9a\x30\x3f\x15\x2f\x77\x41\x13\xe7\xba\x8f\x6c\x67\xae\xd7\xf2\xd3\xee\xea\x0e\xed\x46\x97\xcd\xcf\xdf\x06\xfc\x4a\xd6\xc0\x9e\x9f\xe7\xb7\x05\x2b\xf1\xe5\x8d\xf7\xa3\x21\xf9\x59\xc3\x3a\x6b\xd6\xa3\xf8\xfc\x0d\x5c\x32\x8d\xc4\x3d\x1a\x70\xb9\xf5\x98\xa7\x29\x3f\x51\xbf\x4b\x18\x2f\x19\xc3\xe4\x95\xbd\xe7\x71\xde\x64\xc7\xe7\xe4\x99\x5f\xcc\xc1\xeb\x44\x0d\xfb\x0f\xff\x84\x76\x66\xe6\x80\x5f\x14\x1e\x8b\x0f\x49\xa7\x24\x2f\x06\xaa\xa9\x29\xe0\x7f\xfa\x57\xa2\x37\xfe\x8d\x9e\x8d\x7b\xd7\x4f\x22\xe6\xc2\xcd\x52\x7d\x5f\x86\xdf\x63\x64\x9b\xc2\x05\x1b\x97\xa8\x36\xa8\xf1\xfb\xd3\x34\x76\x73\x77\xb1\x45\xd1\x50\xed\x0a\x2f\xd6\xf1\xcb\x33\x1b\x9f\xbe\x99\x3b\x67\x78\xe0\x49\x5e\xb8\x48\x8a\x62\x30\x8e\xeb\x41\xc6\x16\xf3\x8c\x8f\x3c\x2a\xba\x19\x95\x5d\x10\xed\xd5\x92\x0f\xf2\x23\x12\xe0\xd2\x3c\xfa\x81\xdf\x25\x96\x92\x17\x46\x3d\xe6\x93\x59\xfd\xad\xdc\x82\x28\xd2\x77\x00\x37\xf6\x6a\x93\x3b\xc0\x2c\x63\xca\x51\x57\xa3\xf2\x54\x7c\xb7\xd7\x47\x92\x2d\xab\xbe\x34\xd9\x46\x71\x98\xe1\xd1\x3c\x3c\x3b\x77\x43\xf5\xb5\xc4\x11\x2f\x05\x37\xa0\x37\xe2\xe3\x4c\xc5\x26\xa8\xb1\x20\xf6\x3e\xa2\xee\x51\xaa\x39\xbf\xc4\xa4\xef\xa2\x23\x35\x72\xf6\x18\xda\x78\x0c\x2b\xdf\x29\xca\x84\xbc\x5d\xd9\x9b\xab\xc8\xf0\xa2\xb1\xa4\x47\x68\xdf\xcf\x15\xf3\x6d\xfa\xfe\xf0\x15\x5c\x00\x1e\x1f\x26\xc3\xf1\x21\x66\xff\x3f\x65\xff\x3f\x63\xff\x3f\x67\xff\xbf\x18\xb2\x62\x79\x13\x8d\x95\xb1\xa2\xc3\x82\x36\x37\xea\x72\xcc\x1c\x5e\x8e\x19\x4f\x36\xfe\x9d\x68\xd6\xd7\x60\x2c\x0e\x83\x0c\xf9\x4f\xfd\x2b\xb6\x85\xb1\x29\x9c\xda\xc2\xa9\x29\x9c\xd9\xc2\x99\x29\x9c\xdb\xc2\xb9\x29\x5c\xd8\xc2\x85\x2a\xb4\x9d\x9b\xbe\x6d\xd7\xa6\x67\xdb\xb1\xe9\xd7\x76\x6b\x7a\xb5\x9d\x9a\x3e\x6d\x97\xba\xc7\x6b\xfb\xd5\x21\x35\x11\x97\xcb\xa5\x27\x04\xcd\xf8\x0e\x65\xe7\xce\xec\xb5\x0c\x7d\x94\x23\x8e\x4f\x5d\xcc\x7f\xb8\x79\x6a\xa3\xb5\xc5\xa1\x7e\x42\x52\xff\x3a\x79\xbe\x4a\x2c\x3a\x57\x82\xe0\xfd\x61\xe2\x14\x4e\x85\x49\xe6\x32\x88\xdd\x52\x49\xf1\x94\x4b\xc6\x49\xe4\x30\x93\xe3\x60\x24\xb8\x0b\x8d\x27\x51\xca\xf8\x30\xbf\xfa\x0b\x81\x9b\xe0\xd1\xc2\x2d\xe5\x8e\xed\x6c\x7c\xda\x20\x1a\x48\xde\x14\xfc\x8a\x29\x62\xdf\x9c\x96\x0b\xfd\xa7\x52\xb1\x69\x30\x01\x67\x37\x75\x89\xf8\xfd\x91\xa1\x91\x26\x64\xb9\x60\xd4\x7d\xb8\xca\x0e\x9c\x91\x30\x9b\x76\xbb\x29\x56\x05\xb9\x27\x38\x9f\x78\x0c\x76\x28\xb2\x56\x98\x8b\xdb\x71\x76\xc4\x5c\xcb\x6e\xff\x94\x4d\x6f\x63\xb1\x44\xe0\x2b\x5a\x79\x37\x58\x3a\x68\xfe\xb7\xaa\x12\x0b\x58\xb7\x4e\x14\xa8\x4a\x79\x36\xdb\xad\x95\x25\xaa\x5a\x9d\xae\x76\xeb\x55\x91\x02\x38\x95\x5f\xab\xe4\x7c\xfd\x7a\x60\xde\x5d\x5c\xe9\xe6\x17\xfa\x78\x91\xa6\x8b\x7f\x42\xe0\x5f\xf5\x61\x0e\x06\x53\xa1\x00\x2f\xe7\x33\x0e\x68\x2a\x34\xc5\xc9\x59\x9c\x83\xff\x6b\x00\x69\x6b\x14\xe8\xf1\xc2\x6f\x4c\xbb\x06\x40\x14\x9f\xab\x5c\xa4\x5a\xf1\x16\x67\xb7\xc4\xab\xd4\x8b\x32\xbf\xd0\x5f\xa1\x3d\x2d\xa2\x55\xa4\x70\xd6\x97\xdd\x2e\xab\x6b\x83\x73\xb7\x5c\x4c\x53\x8d\x53\x55\xfa\x38\x75\xa1\x8f\x73\x3b\x9f\xc5\x3b\x85\x93\x9f\xe2\x34\x08\x27\xcb\xe8\x69\xaf\x11\xf2\x1a\x1f\x9b\x28\xf1\x51\xcd\xe6\xf1\x62\xa5\x50\xa9\x33\x6f\xba\xee\x29\x59\xa4\xd3\xad\xc6\xa6\x2a\x7d\x84\xba\xd0\xc3\xb9\x58\xcc\x27\x86\xbc\x94\x6d\xfd\x6c\x55\xb2\x9a\xcd\x66\xb1\x46\x29\xeb\x7c\x8c\xaa\xcc\x43\xf8\x34\x9b\xce\xa7\xb3\xdb\x78\xfb\x02\xa5\x22\x16\x4e\x81\xce\x1b\x59\xd9\x06\xa6\x13\xa7\x48\xf6\x11\x36\xd7\x22\x63\xa0\x5a\x60\x21\x50\xba\xdf\x47\xe9\x93\xec\x03\x4a\xce\x29\xa2\xfa\xd8\x4d\xb2\x78\x3b\x15\x7d\x08\x01\x22\x1d\xac\xb2\x74\xaf\x06\xe1\x49\x52\xff\x4d\xa1\x4e\xf6\xac\x69\x26\x50\x6b\x81\x92\x66\x21\x71\xa0\xdc\x0e\x7c\xb9\x22\xcd\x97\xd9\x6e\x3b\x17\x7d\x28\x01\x23\x30\x31\x5b\x60\x67\xb2\x0b\x20\x69\x5b\x42\x75\x90\xcd\xb6\xab\x2d\xd3\x4b\x71\xbf\x5e\x7e\x0e\xd5\x96\x4e\x5b\xe0\x95\x71\x64\x6b\x9e\xde\x86\x19\x6b\x67\xcd\xe9\xa6\x0c\x72\x56\x9b\x65\x31\xbc\x14\xae\x3b\x8c\x30\x5f\x58\x16\x03\x06\xc8\xfe\x7b\xe1\xe0\x03\xd1\x68\x60\xdb\x29\x50\xa6\x21\xe2\xa2\xd8\xe5\x24\xae\x28\x9b\xdc\x17\x32\x6e\xc0\xad\x7f\x6d\x6f\x2f\xf3\xeb\xff\xa2\x40\xee\x23\x20\xac\xc2\x2c\xfe\x1a\xcd\xc5\xd6\x81\x6e\xfc\x5c\xe4\xf8\xb6\x44\x23\x95\xc1\x88\xb9\x5d\x2d\x4b\xc4\xac\xe0\x96\xb6\x8e\x9e\x33\xf0\x96\xa6\xc3\xd4\x4f\x06\x63\xf7\x2e\x37\x56\x13\x64\x5c\x32\xae\x51\x0e\xa6\xc5\xcd\xa5\x85\x13\x6d\x1b\x70\x5c\x45\x99\x34\xc2\x0d\xe9\xd5\xfe\x22\x42\x97\xf3\x59\x52\x49\x30\xe8\xa1\x64\x81\x69\x90\x15\x6c\xa5\x5d\xe7\xf5\x06\xf3\x35\xa0\x7b\x9f\xee\xc9\x13\x1f\xbc\xcc\x7a\x91\x26\x4d\x32\x62\x90\xac\x92\x6d\x66\x65\x0e\x8c\xa1\x9b\x97\x4a\xad\xdb\x0f\x59\x71\x46\x14\x4e\xee\xaf\x07\xd2\x99\xe4\xa7\x5c\x5c\x3f\xaf\x8f\x8e\x0f\x5f\xb1\x5d\x23\xe9\xc1\x6c\x3a\x0c\xe3\xdc\xb9\x5a\x0e\x9c\x85\xa7\x58\x9b\xc0\x25\xc8\x72\x3c\xb7\xfa\xaf\x25\xee\x6a\xbf\x45\xcc\x74\x7a\x5d\xf0\x4b\x1c\xbb\x43\x5e\xa4\x43\xa7\xe2\x4c\x94\x5f\xdc\x06\xc1\x4c\x70\x00\xd5\xaa\xc5\x29\x51\xf9\xcd\x9c\x12\xb9\xa4\xf1\x77\xec\x5e\x72\xad\x8e\x18\x0d\x67\x6c\xd0\xa5\x8a\x5b\x85\x3d\x23\x15\xe8\x21\xf8\x1f\xff\x14\x47\x93\xd9\xe0\x4f\x51\xf4\x6f\xd1\x8f\xcc\xb6\x19\xf0\x51\x95\x31\xfd\xaa\x5d\x0c\xe3\xf3\xa5\x28\xd4\xa2\xc9\x9f\x76\x93\x60\xde\x45\xa1\xd2\xea\x0d\xb5\x9e\xa8\x8e\x94\x3c\x01\x46\x18\x19\x60\xbc\x18\x84\x3f\x70\x0c\x82\x60\x99\x33\x2e\x92\xad\x2e\x0c\xc5\x61\x17\x06\x67\x36\xca\x61\xdd\xa7\x8c\x44\xb6\x8c\x8c\x06\x70\x11\xb4\x8e\xab\x0d\xc4\xeb\xa5\x6d\x54\x7e\x2e\x9b\x1f\x85\xee\x0c\x84\x1e\xfd\x78\x63\x3a\xc0\xcf\xe6\xd0\x1b\x07\x37\xb3\x05\x61\x6f\xdb\x73\xa8\xfd\x21\x3b\x15\xe5\xf0\x0f\xe5\x29\xd9\x95\xc3\xdf\x89\xb8\x79\x52\x0f\xdf\xfd\xae\xbc\x54\x79\x56\x0d\xfe\x33\xfb\xfa\xce\x66\x57\x13\xb8\x8c\x45\x61\x3b\x9d\xc1\xcc\xb3\x1f\xdc\x26\xe9\xd5\xc9\x32\x9e\xcf\x32\x6c\x37\xb1\xda\xc7\xfb\x59\x18\x95\xba\x31\x12\xfb\xa1\xa6\x56\x6c\x53\x80\x74\xea\x84\xba\x9c\x9c\x47\xf9\xa9\xce\x1a\x66\xfb\x78\xd4\x87\xfd\x8f\x13\x24\x1e\xc7\xf3\x0f\x9b\xde\x90\x9c\xe0\x81\x4b\xb4\x9b\x11\x50\x04\xf5\x80\x9b\xa3\x52\x33\xc1\x84\x4c\x22\x0f\x9e\x6f\xd9\x74\x17\x2b\x61\x9f\xc1\xe6\xd2\xed\x76\xda\x2b\x38\xfd\x95\xf1\x49\xa6\x1c\x5a\xab\xc4\x43\x45\x21\x0b\xb9\x97\x53\x65\xfc\x6f\x4c\x7e\x73\xfe\x0f\x89\x35\xee\x76\x3b\x44\xaa\x6c\x2c\x03\x4f\x6b\x22\x24\xa6\xed\x85\x95\x3c\xbf\xcb\x9a\x0b\x9a\x42\x42\x9c\x54\x90\xa0\x5b\x66\xf1\x78\xb3\x7a\x57\xf1\xd3\xa5\x3c\xa5\x11\x0f\xb6\x2a\x86\x4c\x67\xee\xea\x60\xf4\x7d\x2d\xc1\x6e\x63\x3e\x01\x93\xdc\xc9\x6e\x47\x1a\xe3\x89\x95\x81\x82\x71\x42\x77\x12\x44\xc4\xe9\xe8\x45\x8c\xed\x4b\x95\xcf\xc5\xc2\x21\x6c\xb0\x5a\xc5\x68\x83\xd5\x92\x68\x30\x89\xa3\x08\x6d\x31\x99\xc8\x26\xb6\x62\xb4\x2f\x2e\x79\xfa\x66\xa3\x1d\x57\xe5\xd7\xab\x07\x37\x72\x9b\xca\x75\x29\x2f\xe1\x24\x14\xa3\xe2\x65\x34\x19\x9a\x5f\x91\xfd\xe9\x94\xc6\xe6\xa7\xfd\x35\x35\xbf\x66\xe6\xd7\xdc\xfc\x5a\x98\x5f\x4b\xf3\xeb\xc9\xfc\x5a\xc9\x5f\xc7\x54\x77\xcd\x7f\x45\xf6\xa7\x53\x1a\x9b\x9f\xf6\xd7\xd4\xfc\x9a\x99\x5f\x73\xf3\x6b\x61\x7e\x2d\xcd\xaf\x27\xf3\x4b\x75\x5d\x1f\x75\xd7\xfc\x57\x64\x7f\x3a\xa5\xb1\xf9\x69\x7f\x4d\xcd\xaf\x99\xf9\x35\x37\xbf\x16\xe6\xd7\xd2\xfc\x7a\x32\xbf\x54\xd7\xdf\x6a\xdd\x35\xff\x15\xd9\x9f\x4e\x69\x6c\x7e\xda\x5f\x53\xf3\x6b\x66\x7e\xcd\xcd\xaf\x85\xf9\xb5\x34\xbf\x9e\xcc\xaf\x15\x92\xd1\x89\xeb\x6a\x18\x8c\x6f\x55\xbf\xdb\xdf\x71\x00\x76\x7b\x61\xa9\x88\xaf\xf6\xcb\x8d\x2d\x9d\xe8\xb9\x39\x19\x2f\xe4\xff\x2d\x9d\xda\x48\xd5\x3e\x4d\xc7\x53\xf5\x7f\xb6\x76\x65\xec\x80\x2d\x7b\x52\x65\x8b\x05\x82\x6e\xa9\x2a\xe7\x4f\x08\xb6\x85\xae\x74\xa8\x9b\xab\xb2\x19\x46\xdc\x4c\x55\x4e\x31\xda\xa6\xaa\x32\x76\x68\x33\x0c\xc0\x68\xd3\x7c\xc0\x48\x13\x8b\x1f\xc6\x3f\x25\x6d\x97\x7f\xb2\x6a\xa2\xaa\x50\x26\x4a\x90\x48\x81\xa0\x9c\x14\x20\x2b\x05\xe1\xb2\x53\x54\x3c\xa9\x0a\x94\xa7\x02\x62\xa9\x20\x50\xc6\x0a\x88\x85\x86\x80\xb4\xcf\x55\x05\xca\x62\x01\x31\x53\x10\x28\x9f\x05\xc4\x54\x41\xc4\x90\x72\xc3\x32\x92\x72\xcd\x39\x92\x70\xcd\x37\x69\xad\x4d\x4d\x7d\xe0\x02\x91\x73\xcd\x97\x07\xaf\x99\xc8\x1a\x42\x1c\x1c\x22\x92\x10\x84\x34\x18\xc4\x4a\x02\xf8\xc2\x60\xe5\x4f\xb2\x9c\x90\x05\x03\x58\x4a\x00\x42\x14\x0c\x60\xa1\x00\x20\xd5\x73\x59\x4e\x08\x82\x01\xcc\x24\x00\x21\x07\x06\x30\x95\x00\x31\xa4\x59\x33\x8a\xa4\x59\xf1\x8b\x24\x59\x71\xcb\x93\x81\xfc\x24\xce\xa5\xe0\x05\x13\x5c\x61\x68\x90\x89\x07\x82\x4a\x45\x83\x46\x1e\x28\x2a\x1e\x05\xba\xf2\x20\x5d\x39\x29\x80\x27\x0f\x00\x15\x98\x82\x5c\x7a\x90\xa8\xe4\x14\xe4\xc2\x87\x0c\xc7\x3a\xf7\x00\x50\x59\x2a\xc8\x99\x07\x89\x0a\x55\x41\x4e\x3d\xc8\x38\x1c\x29\x10\x41\xcb\x48\x7d\x49\xb4\x0c\x34\xea\x1d\xda\xfa\xbb\x2d\x10\x02\x27\x27\x7a\x09\x9c\x9c\x20\x83\x74\x72\x82\x5e\xd2\xc9\x89\x6e\x80\x93\xe3\x44\x90\x4e\x8e\xd3\x4a\x3a\x39\x3e\x24\xe8\xe4\xf8\x80\x49\x27\xc7\xf9\x42\x3a\x39\xce\x3e\xe8\xe4\x38\x73\x49\x27\xc7\x87\x4a\x39\x39\x56\x47\x39\x39\x53\x45\x3b\x39\x03\x42\x3b\x39\x0d\x12\x38\x39\x5d\x41\x3b\x39\x0d\x41\x3b\x39\x0d\x11\x38\x39\x5d\x41\x3b\x39\x0d\x41\x3b\x39\x0d\x11\x38\x39\x5d\x41\x3b\x39\xc3\x17\xca\xc9\x69\x80\xd0\xc9\x89\x1a\xd4\xc9\x99\x1a\xd2\xc9\x19\x08\xd2\xc9\x69\x08\xe8\xe4\x74\x39\xe9\xe4\x34\x00\xe9\xe4\x34\x00\x74\x72\xba\x9c\x74\x72\x1a\x80\x74\x72\x1a\x00\x3a\x39\x5d\x4e\x3a\x39\xc3\x0e\xc2\xc9\xe9\xfa\xc0\xc9\xb1\x8a\x2e\x27\xe7\x80\x74\x39\x39\x07\xb4\xcb\xc9\x59\x50\xc2\xc9\x59\x80\x2e\x27\x67\x21\xbb\x9c\x9c\x85\x24\x9c\x9c\x05\xe8\x72\x72\x16\xb2\xcb\xc9\x59\x48\xc2\xc9\x59\x80\x2e\x27\xe7\xf0\xb7\xdd\xc9\x59\x40\xe8\xe4\x5a\x43\x19\x7f\xa7\x1d\x78\xe0\xe5\x44\x2f\x81\x97\x13\x64\x90\x5e\x4e\xd0\x4b\x7a\x39\xd1\x0d\xf0\x72\x9c\x08\xd2\xcb\x71\x5a\x49\x2f\xc7\x87\x04\xbd\x1c\x1f\x30\xe9\xe5\x38\x5f\x48\x2f\xc7\xd9\x07\xbd\x1c\x67\x2e\xe9\xe5\xf8\x50\x29\x2f\xc7\xea\x28\x2f\x67\xaa\x68\x2f\x67\x40\x68\x2f\xa7\x41\x02\x2f\xa7\x2b\x68\x2f\xa7\x21\x68\x2f\xa7\x21\x02\x2f\xa7\x2b\x68\x2f\xa7\x21\x68\x2f\xa7\x21\x02\x2f\xa7\x2b\x68\x2f\x67\xf8\x42\x79\x39\x0d\x10\x7a\x39\x51\x83\x7a\x39\x53\x43\x7a\x39\x03\x41\x7a\x39\x0d\x01\xbd\x9c\x2e\x27\xbd\x9c\x06\x20\xbd\x9c\x06\x80\x5e\x4e\x97\x93\x5e\x4e\x03\x90\x5e\x4e\x03\x40\x2f\xa7\xcb\x49\x2f\x67\xd8\x41\x78\x39\x5d\x1f\x78\x39\x56\xd1\xe5\xe5\x1c\x90\x2e\x2f\xe7\x80\x76\x79\x39\x0b\x4a\x78\x39\x0b\xd0\xe5\xe5\x2c\x64\x97\x97\xb3\x90\x84\x97\xb3\x00\x5d\x5e\xce\x42\x76\x79\x39\x0b\x49\x78\x39\x0b\xd0\xe5\xe5\x1c\xfe\xb6\x7b\x39\x0b\xd8\xc3\xcb\x39\xf1\xf7\xbf\x53\x8c\x3b\x70\x73\xa2\x97\xc0\xcd\x09\x32\x48\x37\x27\xe8\x25\xdd\x9c\xe8\x06\xb8\x39\x4e\x04\xe9\xe6\x38\xad\xa4\x9b\xe3\x43\x82\x6e\x8e\x0f\x98\x74\x73\x9c\x2f\xa4\x9b\xe3\xec\x83\x6e\x8e\x33\x97\x74\x73\x7c\xa8\x94\x9b\x63\x75\x94\x9b\x33\x55\xb4\x9b\x33\x20\xb4\x9b\xd3\x20\x81\x9b\xd3\x15\xb4\x9b\xd3\x10\xb4\x9b\xd3\x10\x81\x9b\xd3\x15\xb4\x9b\xd3\x10\xb4\x9b\xd3\x10\x81\x9b\xd3\x15\xb4\x9b\x33\x7c\xa1\xdc\x9c\x06\x08\xdd\x9c\xa8\x41\xdd\x9c\xa9\x21\xdd\x9c\x81\x20\xdd\x9c\x86\x80\x6e\x4e\x97\x93\x6e\x4e\x03\x90\x6e\x4e\x03\x40\x37\xa7\xcb\x49\x37\xa7\x01\x48\x37\xa7\x01\xa0\x9b\xd3\xe5\xa4\x9b\x33\xec\x20\xdc\x9c\xae\x0f\xdc\x1c\xab\xe8\x72\x73\x0e\x48\x97\x9b\x73\x40\xbb\xdc\x9c\x05\x25\xdc\x9c\x05\xe8\x72\x73\x16\xb2\xcb\xcd\x59\x48\xc2\xcd\x59\x80\x2e\x37\x67\x21\xbb\xdc\x9c\x85\x24\xdc\x9c\x05\xe8\x72\x73\x0e\x7f\xdb\xdd\x9c\x05\x0c\xdc\x9c\x7a\x17\xa8\xed\x31\x46\xf5\x1e\xa5\xf9\x9a\xcc\x8f\x06\x3e\x39\xdf\xf2\xd4\xc9\x15\x5e\xb4\x33\x07\xb0\x36\xf0\x1c\x79\x73\x40\x8e\x96\x8b\xce\x9d\xab\x52\xe0\xe6\x14\x72\xfc\x50\xb6\x79\x6e\xf8\x8d\xbf\xe7\xa6\x7a\x36\x4f\x0d\x39\x45\x07\x53\xc4\x0f\xea\x00\x28\x53\x64\xa1\xf8\x01\x52\x00\x65\x8a\xec\xd3\x61\x4f\xf4\xf1\x0b\x70\xb1\x8d\x31\x88\xb8\xd2\x94\xa6\xe9\x0d\xe9\x02\xbe\xfa\x28\xc6\x0b\x4e\x0e\xc6\x28\x16\x25\x9b\x8f\x1a\xdb\x7a\x9f\x57\xfa\x18\x9e\x33\x9e\x76\x30\xc3\x09\x26\x3e\xf1\x28\x56\x27\xba\x76\x38\x9f\xb3\x7e\x1d\x85\xb2\x1b\xf4\xe0\xbc\x80\xb5\x8e\x3c\x45\xf8\x28\xfe\xeb\xd6\xa3\xdc\x1a\x8c\x09\x6d\x17\xf7\x37\xd5\x8b\x55\xbb\x92\x67\x63\xaf\xb3\x14\xd1\x31\xb4\xf2\x80\x54\x06\x7a\x87\x56\x62\x2d\x03\x5d\x44\x2b\xad\x56\xce\xcd\x9c\x30\x6f\x6d\xe1\x0f\x6d\x41\x28\x6c\x78\x48\xdd\x21\xac\x0b\x07\x87\xd4\x21\xed\xc2\xa1\x21\x75\xc4\x33\x61\x21\xf5\x77\x61\x53\x93\x48\xd9\x96\xd8\xf2\xac\x6e\xaa\xfc\xec\x0c\x78\x7d\x6a\x0e\xcc\x56\x8e\xf8\xc3\x58\xef\xcb\x34\xfd\x80\x29\xcb\x8a\xff\xd3\x18\xc4\x11\x75\xdb\x9e\x3c\x12\x2f\x8e\x56\x49\x73\x3b\x60\x65\x3f\xef\x78\x0a\x86\xdf\xfe\xc4\xcd\xf3\xe7\xe0\x06\xa1\xff\x5e\xdd\x8e\xbf\xb7\x71\xda\xc8\xc5\xbf\x38\x45\xa6\xdf\x68\xf3\xb0\x0c\xf5\x7b\x6d\x77\xe1\xce\x8a\xc2\xc5\x0c\x8c\xe9\x58\xde\x7d\x44\xcc\xac\xa9\x39\x84\x06\x38\x1d\xeb\x2b\x93\x81\x69\x86\x35\x4a\x61\x90\x7e\x60\x0d\x66\xd5\x09\x6c\x48\x3f\x4a\x25\x90\x7e\x60\x0d\xe6\x17\x08\x6c\xb6\x1f\x5a\xe2\xa8\x9a\xa8\x56\x6b\x55\x6a\x54\xb8\x15\xea\x80\x43\xa9\x6a\x9f\x44\x00\x63\x87\x20\xa1\x29\xa8\x83\x07\x85\x5d\xbc\x78\xe2\xff\x02\x2d\x51\xf7\x59\x30\x35\x31\x55\xa8\x9e\xa8\x5a\x4c\x51\x60\x95\xd6\x07\xa4\xaf\xa0\x0a\xd5\x15\x02\x21\xd6\x97\xd6\x09\xa4\xaf\xa0\x0a\xd5\x17\x02\xa1\xd3\x17\x7d\x69\x08\xd7\x05\xef\xca\x10\xad\x32\x00\xac\x43\x67\x7c\x32\x11\xa5\xf1\xd0\xd1\x5a\xd3\x75\x9f\x29\x8d\xb2\xd5\x6e\x11\xa8\x0d\xbf\xa8\x84\xe9\x8c\x2c\x47\x15\x86\x57\x61\xda\xe2\x95\x6b\x7d\x80\xf8\xfd\x72\x54\x49\x30\x3c\x01\x7e\xad\x03\x10\xbf\x5f\x8e\x2a\x06\x86\x47\xe3\xa7\xaf\x79\xe1\xb2\xb6\xf7\xbc\x68\x7d\x70\x61\x3a\x94\xc1\x21\x0d\xd1\x04\x8b\x88\x56\x83\xd6\x8b\x67\xbb\x59\x36\xdd\x4f\x03\x1d\x50\x77\xc9\x30\x35\x30\x55\xa8\x26\xa8\x5a\x4c\x19\x60\x95\x96\x3b\xd2\x57\x50\x85\x6a\x05\x81\x10\xeb\x4b\xeb\x00\xd2\x57\x50\x85\x6a\x08\x81\xd0\xe9\x8b\xbe\xb0\x87\xeb\x80\x77\x5d\x8f\x56\x15\x00\xd6\xa1\x2d\x3e\x99\x88\xc2\x78\xe8\x68\x9d\xe9\xbc\x4b\x98\xec\xe3\xdd\x2e\x50\x1b\x79\x41\x10\xd3\x1a\x5d\x83\x2a\x8d\xac\xc4\x74\x06\xd4\x68\xbd\x08\xfb\x81\x35\xa8\xc2\xe0\xd8\x90\x7e\xb4\x4e\x84\xfd\xc0\x1a\x54\x59\x70\x6c\xb6\x1f\xfa\xe2\x25\xae\x03\xee\xbd\x4b\x5a\x53\x7c\xa8\x0e\x45\xf1\x48\x44\xf4\xc4\x45\x46\xab\x49\xd7\x85\xd0\xed\x6e\x67\xb4\xc4\xc9\x0b\x73\x75\x8e\x24\x8f\xa3\xc9\x0f\xf6\x76\xc0\x37\xef\x20\xbf\x4c\x04\x3f\x48\x4e\xe9\xe0\xbd\x0d\x42\x2c\x17\x4b\x11\xf0\x0f\xb0\x92\x31\x0a\x71\xc8\xd9\xb9\x81\xa0\x6e\x28\x8e\x8e\xb5\xb9\x84\xa8\x2e\xf6\xf0\x22\x4e\x01\x83\x10\x0f\x89\x88\xab\x0a\xdb\xa4\xc2\x33\xbd\x84\x23\x7b\x56\x9b\xd9\xe0\xd6\x29\x01\x88\xed\xf7\xda\x80\x0e\x2d\x40\xe1\x0e\xb0\x0d\xa8\x0d\x53\xb8\x8b\x6b\x03\x3a\xe0\x39\x02\xf0\x76\xc1\x7e\x98\xe6\x0d\xba\x29\x76\xa3\x0f\x24\x71\xe8\x96\xf9\xde\x96\x96\x9d\x0f\xb7\xbc\xbb\x4f\xcb\xf8\x87\x5b\x7a\x7d\x5e\xc1\xbd\xc4\xbb\x38\xed\xdc\x29\xbd\x8f\xd1\xf7\x35\x74\xf8\xfc\x60\xc3\x7b\x7b\x74\xb8\xfc\x60\x43\xb7\xc7\xab\x77\x2f\xf4\x1e\x26\x3b\x48\xda\xa6\x5a\x47\x43\x7a\x22\x87\xbc\xba\xbf\x47\xac\x21\x88\xdf\xf0\x80\xf5\x3e\xcf\x8a\xb4\xce\x9a\xab\xfd\x30\x1b\x85\x69\x9f\x22\x9b\xd0\xa9\xc8\x5e\xb2\x53\x0a\x2e\xdd\x39\x06\x1c\xb6\x25\x52\xb8\xf0\xa4\x2b\x58\x82\x36\x2f\x31\x1c\x48\x68\x85\x64\x19\x98\xf3\x7f\x37\xf9\x54\x7f\x9f\xe4\x61\x3e\x4d\x73\x90\x46\x86\x5f\xb0\x17\x09\xe7\x7e\xe6\x01\xab\x9f\xe4\x73\xd8\x9f\xdf\x3a\x37\x9f\xd3\x83\x78\x57\x82\x95\x7d\x1e\x3a\x85\xfc\x6e\x5e\xf9\x59\xe7\xc5\x99\x89\x0b\x95\x86\x9b\x2a\x02\xfe\xa7\x95\xc7\x3c\x79\xad\xd5\xc5\xcc\x33\x5f\x7f\xf6\xa5\x74\xf3\xfa\x60\xfe\xff\x33\x29\xc5\x9b\x4c\xb8\xf7\xf3\xf1\x52\x34\xf9\x99\xdf\xc7\x57\x05\x5c\x76\x9f\xaf\x6e\x82\x37\xd8\xa7\x4a\x3b\x81\x0d\x32\xac\x92\x43\xfd\xd5\xb2\xe6\xb1\x32\xd6\x15\x7e\x41\x54\x70\x72\xe9\x5f\x09\xed\xce\x57\x38\x9f\xcf\x6f\x63\x9e\x41\x80\xc7\x91\x1b\xb6\xc6\xa0\x27\x82\xb9\x45\xe9\xe4\x51\x5b\xb0\x61\xf0\xb4\x47\x77\x77\x4a\xa5\xb1\xb3\xa5\xf9\x31\x79\xc9\xf4\x25\xd9\x5e\x17\x4e\xdb\x6e\xfc\xf2\xb6\xfc\xff\xdd\x8b\xbc\xd1\x12\xbf\xf3\x4b\xc2\x22\xc9\xf3\x14\x11\x62\x08\x6e\x02\xbc\xc1\x78\x32\xaf\x87\x21\x41\x01\x0c\x48\xb5\xd7\x8e\xaf\x0d\xcf\x5b\x20\xf1\x55\x41\xa9\xb1\x8b\x8d\xa7\xe7\x49\xf6\xd9\xca\xe8\x31\x7a\xb3\xb9\x8b\x91\x43\x7e\x7d\xf9\x49\x57\x4c\xa2\x78\x38\x59\xce\x87\xf1\x74\x3a\x1c\x2f\xee\x92\x48\x2b\x22\x30\x98\xb5\xb0\x6b\x4c\xb5\x77\xd9\x41\x3c\xdd\xa6\xb3\x00\xad\x56\x6c\x38\x6c\xc5\x98\x37\xdf\xd7\x13\xd0\x88\xaf\xc2\xc5\xf4\x26\x1a\x06\x7d\x28\x66\xdc\xd5\x86\xf5\x21\x5f\xd9\x69\xfd\xce\xea\xa4\x20\x74\xdb\xff\xcc\xe6\x2b\xf7\x94\xe9\xe7\xa1\x5f\x5e\xb1\x25\x02\x4f\x47\xf8\x79\xa8\x5d\xa2\x05\x1d\xf8\x53\x1e\xd9\x2f\x65\x19\xc5\x13\xa7\xc3\x4e\xc4\x2a\xd1\xc9\xa9\xe4\xdf\x75\x79\x36\xae\xf4\xa6\x53\x9e\xfa\x80\x84\x01\x86\x0e\x2b\x39\x9f\x59\x49\x72\xda\xa9\xbc\x36\xc8\xee\x4c\x83\x72\xf7\x9f\x66\x5f\x78\xfe\xf0\x73\xfe\x2d\x2b\x46\x22\xb9\xe9\x3a\xfa\x70\x75\xf0\xa7\x49\x93\x7d\xf6\x28\x71\x8d\x39\x7f\x44\x99\xae\xe5\x6d\xc5\x33\xcb\xcc\x4e\x26\x05\x0d\x77\x64\x45\x07\xbf\xda\x4b\x8f\x33\x15\xb9\xe4\xa4\xc2\x88\xaf\xa6\xa3\xfa\x38\x80\x34\x0e\x5b\x00\x04\x99\x6d\x00\x80\xd2\x36\x50\x49\x2c\x1c\xe6\x67\xd5\xa2\x3e\x86\xec\xc1\x6a\x20\x6b\x30\x18\xc5\x16\x5d\xe5\xb3\x24\x82\x2c\x29\x5e\x3a\x58\xe2\x03\x20\x2c\x09\x31\x90\x2c\xf1\x41\xdb\x59\x52\xbc\x50\x2c\xf1\x6b\x70\x96\xf8\x30\x1e\x4b\x8a\x17\x8f\x25\xb3\x85\xb8\xc1\x2f\xb4\x48\x50\x79\x0d\x03\x0b\xb7\xb1\x5e\x99\x0c\xc7\x62\x21\x82\x5c\xc1\x86\x79\x6e\xbb\x33\x3b\x6a\x9c\x03\xb1\x28\x55\x98\xe5\x1f\x6e\xf4\x44\x2c\x87\xbd\xeb\xdb\x48\xa6\xcb\x68\x03\xf3\x68\xc2\x14\xa6\xa6\x37\x74\x51\x69\xaa\x55\x2e\x2b\x02\x4a\x92\x18\x2c\xcb\x54\x05\xd2\x56\xad\x50\xc3\x24\xb2\x0e\x83\x66\x62\x91\xea\xa5\x34\x88\x3d\x06\x7d\x84\xec\xff\xa8\xa4\xe0\x20\x19\x79\x62\x52\x94\xf8\x74\xb5\x08\x0d\x4d\xd7\xd5\x9f\xd7\x68\x7e\x65\x52\x02\x8a\x9c\x8f\xed\xd4\x7e\xf4\x69\xc7\xb2\x83\xa9\x03\x62\x22\xe9\x73\xe0\x2f\x50\x01\xb6\xc3\x29\x61\x62\x2d\xc7\xba\x01\x5a\xeb\x78\xae\x00\x1d\xda\x52\x56\xd9\x66\x98\x3f\x83\xec\xb1\x88\x3c\xce\xd8\x62\xcc\x69\x42\x16\x63\x30\x1e\x9f\xdb\x08\x31\x5d\x79\x33\x16\x96\xb6\x91\xd1\x02\xe2\xce\x7e\x8c\x08\xd7\xcd\x8d\xe4\x89\x02\xd7\x4a\xb8\x9b\x07\xb3\x61\x01\x07\xc5\x96\xa1\x2a\xa3\x78\xad\x0d\x6d\xa9\x65\x9e\xc5\x4f\x33\x11\x81\xec\x5a\x37\x0b\xe8\x78\x9f\x8d\x73\x7e\x26\x48\xb4\x13\x07\x1b\x9c\x79\x98\x7e\x48\xed\x38\x71\xec\x81\xb3\x83\xdb\x53\xeb\x32\xcd\x7a\x09\x22\x92\xa7\x32\xad\x43\xe0\x9e\x1c\x5d\x54\xbd\xd1\x78\x40\x57\x6a\x78\x64\x87\xa1\x3f\xc7\xda\x3b\x23\xf6\xd6\x4f\x00\xb8\x7b\xd1\xd8\xc6\x08\xad\x87\x2e\x79\xae\x4e\xc6\x70\x43\xdb\xcd\x9f\x9b\xf5\xd2\x8e\x83\xde\x78\x09\xf7\x6c\x52\x5f\x93\x49\x18\xa0\x51\xa7\x30\x37\x61\x1a\x79\x4f\x79\x40\x27\xc1\xb2\x80\x50\x1e\x36\x31\x80\xf2\x58\x44\x01\xcf\xd8\x9a\x07\x65\xee\xdb\x0e\x0b\xf4\xd8\xa2\x43\xf8\x48\xd1\xf6\x7d\x74\x88\x01\xdf\xa7\x43\x90\x1f\x40\x87\x04\x79\xae\x0e\x3d\xb9\x6c\x9a\xdc\xc3\xa6\xdb\xf8\x90\xd4\xa3\x7d\x96\xa5\x7c\x1b\x16\x7a\x7f\xbf\x1e\x48\xc9\xb7\x6d\xb3\x78\x3c\x37\x5c\xd2\x84\x87\x98\xcd\xea\x46\xba\x69\x6d\x17\xff\xca\x9c\x4b\x9a\x7d\x5b\xc7\x1b\x2c\x04\x24\x2c\xb7\x6b\xc5\xe1\x1e\x66\x13\xe4\x77\xde\xa8\x35\xc5\x28\xfb\xc2\xfe\xae\xd5\x01\xb2\x16\x26\x7f\xc4\x29\x87\xab\xf3\x0e\x30\x12\x40\xdf\x2a\x59\xd8\x91\x74\xab\x19\x34\x26\xbd\x68\xac\x8f\x1d\x60\x24\x80\xbe\xdc\x12\x39\xdc\xc6\xcd\x29\xd7\x0a\x75\xae\x66\xe0\xac\x3c\xd1\x62\xb3\x74\xf3\x6b\x55\xdf\x6a\x99\xe0\xd5\xf1\xdc\xa4\x72\xa1\x09\x2a\xc4\x02\x00\x2b\xc3\xfa\x08\x36\x0f\x58\x9d\x5e\x8f\x23\x20\xce\x6a\x03\xa9\xf0\x1a\x82\xac\xd8\x3e\x81\x7e\xb4\xc3\x8b\x67\x49\xf8\x5f\x3b\x74\xd8\x42\x0f\x1a\x65\x93\x39\xba\x1f\x8e\xad\x71\x2f\xf6\x9b\xc5\x72\x3b\x59\x3c\xdd\x1d\x4e\x73\xda\x02\xaa\x5d\x0d\x67\x76\xa7\x3c\xf9\x3c\x47\x42\xba\xf2\xc4\xda\x06\xe3\x78\x0b\x47\xec\x64\x40\x5a\xa8\xf3\x20\x50\xe5\x83\x62\x4f\x1d\x6d\x6d\xa8\xf2\xa6\x0e\xaa\xbc\xa9\x70\x54\xde\x2f\xc3\xfa\x40\x55\x1e\xd6\x21\x2a\xaf\x41\x02\x95\xf7\x2a\x50\x95\x57\x99\xd6\x7d\x02\x5b\x54\x5e\xc2\xff\x6d\x54\x1e\xa5\x87\x08\x2c\xf3\xbc\xef\xaf\x53\xf9\x5d\x94\x4c\x16\xdb\xc7\x54\x5e\xb6\x05\x54\x93\x2a\xaf\x78\x48\x9d\xb4\xda\x60\x1c\x6f\xe1\x48\xa0\xf2\x6e\x8b\xac\xaa\xca\x0a\x2a\x3c\x28\xf4\x54\x51\xd7\x85\xca\xae\x6a\xa0\xaa\xab\x62\x47\xd1\xdd\x92\x10\x37\xaa\xe4\x7e\x0d\xa2\xe2\x12\x20\x50\x70\xa7\x18\x55\x6f\x95\xf5\xdf\x25\xab\x45\xb9\x25\xf4\xdf\x46\xb9\x11\x6a\x50\xd5\x96\x2f\x10\xbc\x52\xb5\xb3\xa7\xd9\xd3\xf4\x41\xd5\x16\x6d\x3d\x9a\x49\xc5\x56\xfc\xa3\xce\x85\x6d\x30\x6e\x93\xdc\x08\xd4\xda\x85\x37\x4b\x5a\x21\xed\xff\x45\x34\x14\xb7\x75\xe6\x7a\xc1\xe3\xb7\xd1\x2f\x2e\xb5\xb5\x65\x1b\x7c\xab\xed\xc1\x33\x5f\x26\x5a\x35\x47\xa3\x9f\xe6\xc2\xda\x94\xff\x6b\xc9\xdf\x25\xfa\x57\xca\xeb\x06\x69\x89\x4f\xf8\x7e\x9c\x8e\x78\xf7\x2c\xc4\x09\x3f\xd1\x7a\x58\x9d\x57\xa5\xee\x45\xa8\x37\x37\x18\x5e\xd0\xcc\xd1\x1b\x08\x2e\x0e\x6c\xf4\xea\xdb\x41\x32\xc0\xf6\x6c\x28\x5c\xa0\xb1\x7d\x81\xb7\xcd\xe9\x6a\x99\x43\x93\xf2\xec\x33\xd9\xbd\x35\xef\x35\xf1\xcc\x2a\x3c\x5f\xd7\x6b\xfc\xd6\x92\x7b\xc5\x32\x5a\xdc\xa6\x32\xd8\xab\x0b\xf7\x75\xa9\x0d\x6f\xd8\xb1\x32\xba\x30\x3a\x86\x63\xc1\xe3\xee\x08\xd2\x96\x20\xbb\x4d\x0b\xeb\x5d\x39\xf5\xb1\xd0\x7b\x5f\x38\xcd\x55\x43\xe7\x89\x06\xc8\x67\xac\xca\xf8\xb6\x00\x42\x39\x43\xbc\x1c\x3e\xc4\xa1\x43\x99\x6d\x72\xba\x8f\x40\xf5\xe9\xc0\xf9\xc0\xb2\x34\x3b\x53\x17\x2e\xfc\x22\xd4\x9e\x81\xb9\xc3\x86\x79\xd4\x79\x8a\x4e\x0c\xd5\x68\x61\xf0\xf8\x13\x42\x6c\x1f\x69\xda\x6c\xc0\xf7\xd0\x0a\xa2\x0a\x34\xe9\x3c\x38\x03\xe2\x32\x58\x32\x8f\x3e\x1d\xf1\xd0\x00\xdd\xd1\x22\x08\x22\x32\x8e\x70\x5b\xd4\xf6\x35\xc7\x1e\xa8\x41\x3f\xe6\xd8\x03\x36\xad\xef\xd1\xd9\x03\x37\x61\x4c\x26\x3c\x21\x4b\x7c\x17\xe2\xc7\x12\x9a\xf2\xb2\x3b\x8c\xf8\x9d\x2f\x36\x5f\x8f\xc9\x29\x3f\x5f\x0a\xf1\x46\xe8\x86\xae\xf1\xbf\x27\x99\x45\xcf\xa5\x66\xab\x06\x19\xb0\x93\x87\x7a\xc4\x71\x0c\xa4\xb4\x0e\x0b\x83\x82\x9e\xc7\x84\xe8\x3c\xf1\xe2\x9b\x3b\x13\x86\xba\xcf\x36\x96\x07\xb9\x9c\x92\xb5\x53\x62\x7f\xae\x03\xf0\x75\x00\xfe\xab\x1d\xfe\x82\xb4\x38\x3f\xbd\xf7\xae\x78\xe8\x15\x7d\x05\xd6\x19\x9e\x4b\xfb\x15\xe7\x66\xaf\x13\x3e\x53\x36\x92\x39\x58\x7b\x4e\x88\xa7\x14\x28\x58\x49\x97\xfd\x6c\xc6\xfe\xea\x38\x63\xc2\x67\x51\xf8\xf1\x69\xb3\xcf\x0b\xf1\xec\x46\x71\x3e\x24\xef\xd5\xe9\x95\x9f\x16\xce\xb9\xad\x8e\xc7\x17\xcc\x89\x97\xf1\x62\xce\x1f\x9e\x72\xa9\x42\xc8\x10\x10\x57\x34\xc6\xc9\x2a\x18\xf7\xf7\xc9\xa5\x68\x5c\xa9\xb4\x3d\xdd\x6a\xae\x41\xf1\x6b\x0a\x4e\x7b\x47\xe2\xba\x48\x69\x58\x2b\xe2\x6c\xc1\xff\xc1\x6d\xe8\x8e\xff\xf3\xd0\x87\xaa\xd3\x13\x57\x92\xf2\x7f\x3e\xa9\x8e\x6e\x19\xfc\xba\xac\x3c\x67\xa7\xe7\x71\x5a\x95\x67\xfe\x7e\x35\xb3\x2c\x2f\x2f\x45\xd6\x9f\x51\x77\xd2\x80\x70\x2d\x9c\xd8\xb0\x46\x5d\x31\x09\xa9\xc7\x64\x40\x62\x5b\xfb\xd8\x3a\xc7\xad\x91\x77\x02\xae\x7b\x03\xf6\x90\x69\x3a\xe3\xff\xba\xf5\xe3\x95\x32\x45\x4d\x8b\xdf\x81\x9e\x50\x98\xc8\x74\x1d\x26\x34\x53\x17\x8a\xcd\x4e\x52\x04\xa9\xad\x44\xb0\x3a\x95\x12\x2d\x61\x7e\x80\xf0\x3a\xa0\xd6\xfd\xa0\xc8\x2b\x6e\x9d\x46\x82\xe1\x49\xd2\x97\xac\xeb\x5d\xc3\xa9\x6c\x74\xc7\x2b\x88\xa0\xdf\x38\x5b\xa4\xc9\xcc\xc3\xe2\x72\xd8\x7b\x2d\xb1\x1d\xbd\x7c\x25\x11\xa0\x9f\xc4\xf1\x76\x16\x79\xe8\x7d\x5d\xbe\x03\x57\x1c\xcd\xd2\x25\x20\xd5\xd5\x65\x8d\xbf\x5b\x97\x7b\xb1\xeb\x4e\x1a\x10\xae\x21\xf6\x09\xd4\x38\x8a\xee\x53\x8f\xc9\x80\xc4\xd6\xdb\x3e\x01\x01\x77\x02\x76\xdb\xa7\x7b\x64\x2a\x58\xd7\xad\x1f\xaf\x94\x69\x8b\x7d\xd2\x1d\x60\xf6\x09\xd6\x61\x42\xc3\xec\x93\xaa\xc3\xed\x53\x50\x89\x60\xed\x6d\x9f\x7c\xe1\x75\x40\x75\xd8\xa7\xae\x17\x4f\xfb\x9a\x0a\x60\xa5\x74\x33\x3c\xdb\x0e\x6f\x07\x1e\xbc\xc5\x35\x65\xbe\xdb\x3e\xcd\x77\xa0\xf7\xd9\x2e\xc9\x66\x3b\x0f\x8b\xcb\x6a\xef\xc9\xd5\x76\xf4\xb3\xd9\x2a\x9d\x41\x45\x8c\xe7\xf3\x45\x3c\xf7\xd0\xf7\x51\x6a\x14\xd7\x74\xf5\x34\x9b\xae\x7c\x52\x5d\xa5\xd6\xf8\xbb\x95\xba\x17\xbb\xee\xa4\x01\xe1\x1a\x62\xa8\x40\x8d\xa3\xf1\x3e\xf5\x98\x0c\x48\x6c\xbd\x0d\x15\x10\x70\x27\x60\xb7\xa1\xba\x43\xa6\x92\x75\xdd\xfa\xf1\x4a\x99\xb6\x18\x2a\xdd\x01\x66\xa8\x60\x1d\x26\x34\xcc\x50\xa9\x3a\xdc\x50\x05\x95\x08\xd6\xde\x86\xca\x17\x5e\x07\x54\x87\xa1\xea\x4a\x33\xd2\xd7\x54\x00\x43\xa5\x9b\xd1\x86\xca\x7d\x45\x9b\xb0\x52\xdb\x5d\x14\x7c\x3d\x99\x2d\xb6\x4f\x69\x62\x51\xb8\x4c\xb6\x8f\x36\x77\xe8\xdf\x64\x1b\xa5\x73\xe8\x28\xb7\x8b\xf4\x69\x6e\x11\xf7\x52\x64\x0c\x51\xbc\x58\x25\xdb\x9d\x43\xa1\xab\xc5\x02\x73\xb7\x0a\x77\x33\xe7\x9e\xae\x21\x8f\x10\x6b\xe4\x16\x3b\x3a\xed\x90\x1b\x30\x1a\x47\xd2\xdb\x02\xb9\xc2\x6b\x87\xea\xb6\x3d\x7d\xe5\x25\xf9\xd3\x21\xf8\x87\xe5\xd5\x62\x6f\x04\x5e\xcc\xd8\x78\x15\x81\x40\x30\x33\xc3\x2b\x70\x1b\xe3\xd7\x40\x64\xbd\xad\x8b\x23\x98\x36\x90\x0e\xbb\xd2\x9a\xb7\xa6\xd7\xcc\x86\x16\x45\xb5\xa1\x2d\x0a\x78\x4c\x1f\x57\x81\x7d\x94\xa4\x33\xd8\x75\x96\x25\xf1\x74\xe1\x61\x71\x19\xeb\x3d\xd7\xde\x8e\x3e\xdb\xad\x96\x13\xb8\xf5\x5c\x3d\xcd\xf7\x51\xea\xa1\xef\xa3\xad\x28\xae\x74\xfe\x34\x9f\xc4\x3e\xa9\xae\xc2\x6a\xfc\xdd\x3a\xdb\x8b\x5d\x77\xd2\x80\x70\x0d\x31\x36\xa0\xc6\x51\x6e\x9f\x7a\x4c\x06\x24\xb6\xde\x86\x07\x08\xb8\x13\xb0\xdb\xfc\xdc\x21\x53\xc9\xba\x6e\xfd\x78\xa5\x4c\x5b\x4c\x91\xee\x00\xb3\x46\xb0\x0e\x13\x1a\x66\x93\x54\x1d\x6e\x96\x82\x4a\x04\x6b\x6f\xe3\xe4\x0b\xaf\x03\xaa\xc3\x44\x75\xa6\x49\xea\x69\x2a\x60\x24\x49\x35\xa3\x0d\x95\xca\x23\xd4\xae\x28\xab\xf9\x74\x16\x4c\xbc\xd9\x74\x3f\x4d\x5c\x24\x5e\xb0\x4e\xe6\xf3\xe9\x61\xa5\x76\xab\x69\x14\x43\x3f\xb8\x5c\x4c\x76\x93\x95\x8b\xbc\x8f\x42\xa3\xa8\x92\x5d\xbc\xd2\x6b\x79\x45\xa7\x17\x13\x95\xd8\x7b\x84\x44\x7b\x30\xea\x3e\x02\x42\x7e\x61\x31\x6e\xaf\xc2\x8d\x95\xba\x84\x23\xac\xa7\x50\xf5\x8f\x6f\x7b\x42\xed\x82\xeb\x11\xdd\xee\x2d\x47\xc9\xb1\x4e\x95\x78\x95\x1c\xdb\x22\xdb\x12\x3b\x1a\xd8\xf6\xab\x10\x41\xa1\x61\x6d\x51\x45\x44\xb5\x41\x5d\x88\xb2\x7f\x4c\xdb\x15\x58\x3b\x50\x57\x44\xbb\x3d\x1b\x57\x4f\x7b\x00\x6c\x91\x6e\x45\xdb\xa2\x22\x3f\xfd\x72\x0d\x6e\x98\x62\x41\x2a\xfb\x5c\xb8\x6e\x37\x34\xbf\x3c\xb5\xe0\x05\x6b\x58\xd0\xfd\x79\x52\x92\xd2\x7a\x9d\xbf\xef\x8b\xf0\x08\x85\x01\x41\xae\xd0\xc5\xdf\x8a\xf3\x2e\x83\xdd\x94\xed\x5d\x0d\xf5\x16\x63\x3a\x8f\x97\xbb\xe0\x63\x32\x1b\x4f\x56\xf1\x6f\xc3\xad\x2f\xb3\xdf\x10\x76\xc1\xee\x7a\xab\xa6\x43\x6b\x2b\x88\x47\xbe\x49\x33\x8f\x7d\x08\x37\x87\x45\x9e\xf9\x5f\x8a\xa4\x97\xeb\xdb\x5d\x75\xb2\x7d\xd4\x47\xa7\x0f\x7b\x21\xf1\x35\x77\xf1\x2c\xf2\x6f\xb5\x83\xfc\x5b\x6d\x07\x20\xbf\xb6\x3f\x88\x1b\x3b\x2d\xe9\x1e\x88\x33\x30\x1f\x1d\x70\xff\x38\xa5\x9b\xc7\x61\x7b\x69\x9a\xf2\xf4\xd9\xc2\x7a\xb7\x6c\x33\x26\x4d\xa2\xae\xbe\x6c\x8f\xb9\x5b\xe9\x1f\xcb\x4b\xd2\xec\xaa\x3f\xda\x47\x58\x7a\x16\x55\x29\x92\x9b\x0c\xf8\xd8\x93\x0a\x24\x5c\xc1\x20\xda\xab\x65\xbf\xe3\xfc\x74\x75\x32\x64\x30\x95\x2b\x92\x73\x9d\x19\x9e\x49\x45\xd3\xc5\x1c\xda\xcf\x5d\xd4\x54\x68\xa5\xca\x83\x55\x7e\xbd\x89\x14\x5b\xed\x30\x52\x05\x4c\x2f\x7c\xb1\x1c\x9e\xae\x53\xc2\x8e\x4c\xda\x41\x93\x74\x30\x60\xd7\xa8\xc9\x8f\xfc\x0c\xca\xfe\x72\x92\x07\x7b\x78\x7e\x18\x9f\x5f\x38\x48\x27\x8a\xb0\xab\xf4\xa2\x66\xe4\x78\x0a\x93\xe0\x80\x3a\xba\x51\x88\xf5\xcc\x1c\x76\x56\x31\x91\xc8\x51\x0f\xbf\xe4\x75\xbe\xcd\x0b\x26\x24\xd0\x45\x0b\x60\x2f\x28\xc6\x74\x66\xe5\x9a\xb6\xa3\xb8\x91\x65\xbd\xf7\x90\x07\x9b\x85\xf8\x89\x2b\xe7\x7d\x02\x9e\x24\x2b\x4d\xea\x43\x96\xc2\x52\x71\xb2\xe9\x4f\x26\xe0\xac\x2e\xfe\xb5\x1d\x79\x92\x4f\x92\x60\x10\x37\xb3\xc0\x19\x8a\x5f\x97\x33\x76\xfd\x10\x2c\x82\xc0\xf9\xa6\xc8\x01\x38\x66\xa7\x0b\x71\xcd\x50\x64\x90\x92\x27\x3c\xcd\x45\x43\x56\x16\x6d\xdc\xf9\xb2\xb1\xef\x57\x6d\x9c\x77\xb5\x16\xf0\x02\xb3\x49\xe3\x16\xab\x5c\x62\xe0\x70\x1c\x78\x48\x84\x19\xbd\xba\x51\x69\x35\xe1\xf1\x31\x67\x1d\x69\x1c\xb2\x53\x5b\xe4\xe7\xb5\xbd\x9e\xfe\x6d\xd3\x5a\xd7\x92\xa5\xca\x29\xf5\x8e\x3f\x89\x93\x52\x3d\xd2\x58\xc9\x83\xfc\xdc\x82\xfb\xed\xc1\xb5\x85\x16\x30\x20\xa6\xb1\x78\x65\x49\x68\xcf\x55\x5f\x00\x75\x1e\xfb\xf1\x60\x07\x6c\x51\xfa\x25\xe7\x49\x8c\xb4\xe3\x30\xe7\x13\xd7\x2b\x21\x0e\x68\x5a\x90\xd8\x8b\x4c\x6c\xe7\x23\x7e\x2e\xf2\xe7\x04\xcf\x66\xc6\x5d\xd1\x40\x64\xb5\xd8\x15\xcc\xe6\xf2\x37\x4f\x0e\x7d\x0f\x3d\x3a\x07\x5f\xb0\x7c\xa0\x21\x09\x7a\x59\x82\xd4\xf8\xeb\xa1\x05\xff\x87\xae\x29\x30\x9d\x52\x09\xfe\x7d\xac\xfa\x1d\x81\x04\x76\x67\x2a\x70\x6a\x6c\x75\xb0\x01\xea\x49\x8f\x5a\x01\x53\x13\xf7\xd9\x6c\x3d\x10\xda\x6c\x15\x41\x9d\x03\x00\xd7\x60\x2d\xfd\xf4\x45\x86\x8e\x10\x39\x01\xd8\x9e\x3f\x0b\x3d\xe6\xa8\x0e\x0d\x32\x4b\xff\x92\xa7\xeb\xff\xfa\x3f\x7f\xcf\xab\xfe\xc8\x9b\xf1\x93\xbe\xe3\x3f\xe4\xbb\xaa\xac\xcb\x7d\x33\x7e\xe1\x33\x94\xe1\x79\x9f\x9d\x04\x71\x3f\xed\x93\xa2\xce\xd8\xbc\x02\x5b\x45\x61\x04\x7d\x5f\x2f\x41\x12\xd2\x66\xf6\x9c\x87\xc2\x92\x3b\xef\xa2\x6d\xf4\x89\x79\x03\xc5\xd3\x82\x32\x76\x75\xcc\xa8\xd6\x25\x21\x9c\x45\x7c\x11\xdd\x3a\x8b\x38\x5b\xf9\x1f\xd6\xf0\xef\xf3\x6f\x4c\x16\xfe\xe5\x72\x73\x8e\x19\xf8\x80\xd5\x8a\xd1\x6f\x6d\x11\xe4\x23\xc1\x12\x7e\xc5\x43\xf8\xdf\xe1\xf8\x94\x7c\xd9\x26\xd5\x48\xf4\xa9\x4e\x4b\x0f\x0c\x12\x05\x75\xe5\xa7\xb4\x99\xe8\xd6\xef\xde\xb9\xee\x14\xe6\xe1\x0c\x9d\xae\x53\xa1\xfc\xae\xed\xdf\x23\xb4\x93\x0e\x7f\x58\xbc\x77\x21\x41\x73\xd7\x28\x7c\xcd\xaa\xf5\xfc\xbb\xea\x4d\xb0\x07\x22\x47\x78\xd6\x02\x4e\x29\x95\xb3\xc7\x18\x3a\xdb\x0d\xbd\x72\xe9\x9b\xa5\x88\xb8\x9b\x12\x22\x74\x76\x31\xea\x36\xce\x56\x9c\xae\x85\xbd\xb8\x4f\x5c\x12\x58\xbc\x0d\x3c\x52\xbf\xee\xaa\x77\x76\xa9\x58\xb5\x13\x99\xb1\xa4\x86\x9d\x12\x7d\xe1\x5d\x38\x98\xaf\x26\x11\x83\x33\x42\xb1\xc7\xfd\x08\x78\x64\x0b\x03\x41\x0d\xec\x4f\xb4\x95\x53\xe5\xdf\x1e\x51\x57\x45\xf4\x46\xb0\x29\x4b\x9e\x75\xdc\xaf\x9d\x83\xda\x81\xed\xc1\x2d\x71\x89\x32\xe5\xee\x05\x32\x28\x4b\x05\xf4\x1c\xa0\x7b\x26\xd0\x3d\x7b\xe8\xbc\x37\xfa\xbc\x5d\xb2\xe4\x2e\xf3\x14\xef\xdd\x9c\xd4\x1f\x64\x89\x4d\x28\x2c\x0b\xe0\x82\xf7\xc3\x15\x0d\x1a\xb9\xc2\x74\xf2\x5c\x83\xcb\x4c\x34\xe4\x9d\x9d\x33\x93\x21\xe7\xaf\x21\xc3\x37\x52\xa0\x32\xe8\xd9\x76\x14\xf2\xc1\xd3\x43\xb8\xdc\x0f\xa0\x5d\x8a\xf8\x30\x29\x82\xbc\x3a\x48\x0f\xa1\x01\x10\xa0\x87\xcc\xa4\xa1\xe8\x10\x91\xc2\xd6\xc6\x7e\xc8\x26\x38\x39\x7b\xa1\x00\xcc\x7b\x23\xe1\xa9\xae\xdb\x44\x18\x2a\xe2\x2b\xa5\x34\x08\x14\x21\xb0\x64\x62\x59\x13\xc0\xb9\xab\x1c\x7f\x18\x1f\x03\x50\x3f\x7d\xcd\x13\xcc\x20\xf8\x14\x4e\x62\x91\xdd\xa5\x1d\xcd\x24\x86\x78\x26\xb1\x87\x88\xa0\xfb\x6f\x71\xcd\xa5\x8d\x00\x1b\x37\xbe\x27\x34\xac\x97\x38\x88\xdd\x11\x17\xe1\x44\xa5\x12\xb9\x7a\x69\x59\x11\x08\x35\x41\x45\x2d\xec\x0a\xa7\x05\x47\x34\x50\x58\xfa\xb8\x72\xbf\x82\x5e\x53\x3c\x43\xef\xef\xaf\x63\xed\x2b\x74\x1b\xf2\x49\x50\x92\x1c\x17\xad\xfb\x9c\x1d\x01\x0e\x7d\x26\x52\xdb\x6b\x1c\xed\x78\x48\x27\x2c\x52\x45\x4e\xc0\xfd\xcd\x88\xa4\xb6\x87\x95\x6c\x31\x90\x70\xf1\xd3\x62\xe0\x28\xa3\xc2\xa3\x16\x94\xa9\x73\xea\x50\x63\xd7\xd3\x1e\x01\x2a\xdb\x6c\x61\xa7\xe9\xbb\xcf\x1c\x87\x03\x00\x43\xef\x50\xba\x37\xf0\x61\x28\xda\x07\x9d\xd9\x03\xb8\x08\xaf\xf6\x76\xe2\xfc\x55\x1c\x5c\x28\x65\xb7\xff\x3f\x5f\xea\x26\xdf\xe7\x59\xea\x87\xd5\x5d\xd3\x22\xe3\xec\xac\x8a\x79\x34\xb5\xa9\xb5\x5f\xd4\x44\x54\x7e\x5d\x67\xe7\xa4\x4a\x9a\x0c\xc5\x1c\xd8\x41\xbf\x06\x64\x51\x90\xbd\x81\xf7\x36\x35\x39\x3f\xd0\x1d\x38\x8b\xfa\x2b\x6e\x08\x71\x78\x7f\xd3\x68\x37\x8b\x3f\xa7\x49\x93\x28\x49\xab\x0f\x37\xf5\x67\xd1\x12\xbf\xf5\xdf\x0f\x5e\x25\x6d\xa5\x81\x1d\x03\x7d\x6f\x3f\x44\x53\x32\xa9\xaf\x88\xdc\x56\xd9\xae\x51\xee\x39\xfa\x80\x67\xaa\x73\xf7\x16\xf4\x76\x57\xaa\x0d\xad\x18\x0e\x16\xff\xe1\x55\x47\xca\xfd\x52\x94\x2a\xc1\xf9\x09\x00\x03\xba\x6c\x1a\x3f\x27\x9a\x4e\xbe\x18\xd2\x86\x5c\x45\xfa\x35\xc2\x29\x4c\xc9\xfd\x0c\x72\x77\xc0\x5a\x24\x63\x47\x1b\x08\x13\x9f\xb4\x83\xbf\x7e\x46\x4d\x62\x04\x04\x4c\x38\x8e\x1e\x80\xe8\x68\xfa\xa6\xee\x6c\xa5\xaf\x05\x9a\xa2\xb4\x67\x13\x4d\x33\xcc\x1b\x4a\x90\x43\x42\x85\x64\xf4\x02\x85\x2c\x93\xb1\x2b\x3f\xaf\x62\x9b\xce\xf1\xda\x0e\x9d\x83\x20\xb0\xcb\x5f\x23\xf7\x2f\x41\x3a\x01\xd3\x4b\xd9\x7a\x0d\xa3\x6f\x92\xe1\x56\xfa\x5a\xa0\xef\x54\x36\x8a\x66\x5c\x37\x02\x72\x48\xa8\x9e\xca\xd6\xc5\xb2\x40\xd9\x60\x66\xa2\x0e\xcd\x72\xf7\x2b\xd6\x95\xb7\x59\xd7\xee\x65\x21\xd2\xe9\xdd\xad\x1e\xdd\x1e\xf4\x18\xae\x5e\x9b\xf4\xce\x29\x82\x60\xbd\x76\xbd\x24\xd4\xfa\xcd\xcf\x7d\x50\x28\x4c\x75\x82\x3f\x6b\xd2\xf3\x31\x21\x84\xd6\x20\xa5\x38\x69\x21\x90\x53\x35\x14\xb6\x3e\x67\x8e\x90\xd3\x45\x01\x3a\x22\x25\x53\x2b\x9c\xf7\x30\xd7\x48\x65\x4b\x6b\x59\x0e\xb8\x0f\x01\x86\x9a\x49\x56\x83\x85\xbb\x5a\x16\x77\x41\x38\xab\xba\x0e\x60\xb0\x3d\x09\xa1\x9d\x27\xe3\xe0\xb6\x0c\xee\x99\x3a\x1b\xff\xda\x61\xdb\x56\xbe\x5e\xe1\x0b\x7f\xb4\xb0\xdc\xbd\x5f\x88\x93\xaa\xa5\x05\x41\xec\xc8\xba\x9b\x63\xe1\xe6\x0e\x1e\xf7\x94\x60\x0f\xd8\x96\x78\xec\x1d\x41\xd1\x36\xfe\x5d\xdd\x53\x36\x00\x76\x8b\x7f\xc1\x32\x93\x3b\x42\xbf\xac\xa2\x5e\x0a\x39\x97\x83\xc1\x89\x58\x12\xf2\x29\x07\x83\x35\x01\x5d\xb4\x52\x7d\xa0\x42\xeb\x82\xcf\x54\x6f\x35\xdb\x61\x0a\x33\x84\xf4\x07\x75\xc5\x52\xbb\x09\xb9\x73\x4a\xbe\x80\xbc\x77\x41\xfa\x2d\x70\x84\x48\xb4\x79\x2e\xf2\xae\x17\x76\x34\xdc\x73\xd2\xf9\x16\x8f\xef\x05\xe6\x8a\x2e\xef\x7c\x8a\xf9\xbb\xe5\x54\x04\xea\xf4\x34\x2a\xe7\x64\x85\x77\x40\x23\xa8\xf5\x7b\x6c\x3b\xdd\x81\x9f\x3e\xb9\xf3\x6c\x86\xa0\x60\xa0\x8e\x49\x0c\xdd\x3f\x1c\x42\x4c\x11\xf9\xc8\x73\x06\x2f\xb8\xc8\x23\x2f\x0a\x3b\xfb\xcf\xe8\xed\x0e\x32\x69\x59\x3c\xe7\xc7\x97\xab\x0d\x42\x1b\xe5\xe0\xe9\x30\xeb\x2b\xf9\xd0\xa7\x78\xb3\x59\x83\x71\x45\x72\x8f\xbe\x79\xba\x67\x54\x54\x83\x32\xd9\x79\xd3\xa4\xe5\x0c\xc7\x7d\x19\xd1\x06\xea\x69\x4e\xd0\x1b\x76\x98\x9c\xf3\x7a\x20\xff\x03\x47\xe2\x1c\x70\xc2\x4a\x1d\x81\x86\x75\x9e\x72\xf1\x45\x9d\xd2\x23\x95\xe6\xa6\x35\x81\x16\xe0\x2e\xb0\xe8\xb8\xca\x09\x0a\xc4\x0f\x1b\x0e\x74\x42\x25\xbe\xf4\x22\xaa\x89\x15\x9f\x2f\xfd\x00\xca\x4a\xce\x79\xaf\x35\x58\xb7\x92\x08\xba\x8f\x93\xd8\x28\x5e\xeb\xd1\x11\x62\x0c\x48\x24\xd2\x86\x1f\xfb\x0f\x8a\x9f\x1d\xe9\x01\x6d\x4f\x06\x05\x2b\x6f\x6a\xfc\xa1\x66\x51\x10\x50\xcb\x48\x38\x57\xb7\xe1\xfc\x7c\x80\x87\x6c\x78\x6d\x53\xbe\xcf\x9c\xfb\x1b\x0f\xda\x9f\x20\xe2\x2e\x8d\xa4\xe5\x9c\x17\x05\xb0\x4c\x7e\x85\x1d\x2b\x94\x9d\x86\xf8\xc8\x5a\x83\xa3\xcf\x3e\x00\x18\x5c\x50\xec\x8e\x28\xac\xec\x93\xd4\xc0\x3a\x00\x9e\xe4\x78\xf7\x0b\x3e\x5b\x6d\x95\x43\xb2\xc8\x3c\x1d\x7e\x83\xa3\xac\xc5\xad\xdb\x28\x3c\x6a\x0b\x7e\x0d\x13\x70\xdf\xcc\xef\x3d\xe1\x1d\xd6\x90\xd6\xf3\x71\x83\xd0\x3e\x2f\xfa\xcc\x89\x5f\xc1\x08\xbc\xb5\x01\xf8\x1b\x0c\x12\x9d\xf4\xac\xe5\x48\x1d\x94\x14\xaf\xaf\x8f\xce\xc9\x09\xde\x67\xf1\x60\x54\xfa\xd0\x70\xc1\x2b\x88\x80\x1a\x0a\x3f\x6d\x3f\xf2\xf9\x4e\x9e\x63\xc4\x52\x48\xdb\x3c\xc9\xf3\xf0\x29\x44\x71\xea\xb5\x6d\x2d\xd4\x79\xd2\x12\xb1\x74\xdd\xa7\x33\xd5\x69\x5c\xc7\x7e\x9a\x93\x98\xe6\xba\x10\x38\xba\x32\x0f\x8e\xae\xf0\x12\xbd\x2a\x1d\x7d\x5b\x8b\x1b\x28\x85\xbd\x51\x63\xaa\xea\x1d\xdb\xf5\x17\x7c\x03\x21\x52\xf3\xba\xe7\x5c\xf1\xe5\x5f\xc7\x6b\x04\x91\x3c\xc0\x12\xcf\xe7\x43\xfd\xff\xe3\x09\xf9\x46\x02\x0e\x1d\x0c\x57\x5c\x9a\xd2\x14\x7f\xef\x61\xa6\x3c\x5e\x39\x19\xee\xbd\x53\xbc\xbd\x8f\xcb\x40\x6a\x82\x4b\x5b\x42\x85\xff\x25\x3f\x9e\xcb\xaa\x49\x18\x8f\x9c\xd8\xb0\x53\x0a\xde\x2b\x74\x76\x0d\x4a\x3a\x16\xb6\x8b\x03\xaa\xc1\x0d\x3f\x3e\x0c\xda\x82\x43\xc6\x6c\xf0\x34\x88\xcc\xe8\x8f\xc2\x74\x3d\x88\xf8\x66\xc4\x88\x6d\x91\x79\x21\x2c\x72\x0f\x34\xb3\x0a\xf5\x38\xb3\x7a\x93\xeb\x89\x55\x7f\x90\x2f\x38\x97\x15\x3f\x66\x2f\x37\x93\x05\x2b\xa9\x77\xc9\x39\xb3\xea\xf0\x96\x54\xc5\x51\x24\xd2\x72\x73\x93\x96\xb0\x2d\x14\x6b\x57\x5c\x72\x66\x35\x03\xb4\x14\x84\x9c\xe3\x4e\x7d\x5b\x5b\xd0\xea\xb5\x09\xdb\xff\x11\xa8\x8e\xc0\xf2\xe8\x16\x2a\xe0\xd5\xbb\xef\x05\x8e\x91\x09\xf3\xd1\x6d\x03\x1c\x6c\xf0\x4b\x05\xaa\xae\xa1\x1e\xc0\xeb\x09\xde\x29\x79\xf7\x4e\xda\x34\xea\x26\xa7\xa3\xab\x0e\x12\x39\x88\x77\xff\xc0\x67\x06\xd6\xc9\xd5\xd8\x1a\x18\x98\xf2\x50\x4c\x9c\xb5\x04\x47\xb1\xad\xf8\xab\xf2\x4e\x68\xc1\xf5\x91\x26\xe0\x34\x57\x01\xa7\xb6\x4f\xeb\xf2\xa9\x63\x17\xad\xb3\xe8\xb0\x65\x74\x60\xca\x6f\x2c\xa2\x26\xfe\xc2\xa1\x8b\xe9\xcf\x56\x23\x07\x1e\xae\x61\x08\x20\xd5\xdd\x07\xbb\x86\x53\xcb\xd0\xa4\xcf\x91\x12\xb7\x0d\x84\xae\x18\x7e\xad\xf4\x47\x1f\x67\x45\xf3\x64\xff\x74\x3c\xb9\x2f\x2b\xf1\x25\xe7\xfe\x0b\x4a\xf7\x66\xb5\xf7\x86\x14\x5e\xd6\xf4\xaa\x07\xe3\x9c\xf1\x6c\xc4\x17\x38\xd8\x5d\xef\x38\xb6\xaf\x10\x86\x9f\xb5\x26\x41\x6f\x16\xdd\x47\x8b\xd8\x7f\x42\xbb\x7b\x6e\x29\x59\x78\xeb\x4e\xd3\x0f\x8f\xd4\xaa\x80\xdd\x92\xbf\x75\x39\x18\x99\x48\xa9\xaa\x56\xc1\x56\xf7\xc5\x87\x28\x7c\x63\x78\x02\x3f\x90\xc7\xd0\x3d\x69\xda\x96\x2e\x6d\x26\x0a\x09\x17\xb7\x46\x73\xa4\xa1\x0a\x4f\x70\x89\x55\x8b\xf7\x3a\x48\xbb\x2a\x48\xa1\xdf\xbf\xb6\xa1\x28\x1c\xc0\x4b\x62\xc3\xae\x16\x1e\x23\xd7\xda\x46\x88\x73\xbf\x31\xe0\x79\x4b\x7b\xd2\x86\xb4\x36\x03\xb6\xa5\x1d\x36\x08\x07\x3b\xf9\x58\xba\xd5\x8d\x6b\x54\x10\x7b\x75\x26\x8a\x54\x29\x18\x04\xa1\x95\x6d\x8e\x28\x9b\x67\x6b\xf8\x57\xba\x6b\x10\xec\x6f\x35\x26\x23\xc2\x9a\x04\x16\xad\x7b\xd5\x4f\x6d\x4e\x5f\xbd\x33\x18\xbe\xdd\xb6\xa1\x15\x55\x0f\xe7\xcc\x18\xfc\x66\x8f\x70\x85\x38\x5f\xf9\x08\x17\x89\xb0\xfd\x11\x2e\xaf\xd9\xa3\x8f\x70\x51\x48\xe0\x51\x17\x1a\x0e\x39\x15\xd2\x0f\x78\x0b\x1e\xe1\xa2\x5a\xb5\x3c\xc2\xe5\x35\x79\xe8\x11\x2e\x1f\x83\x79\x7b\xc9\x2b\x7e\xe3\x47\xb8\xd0\x2e\xf5\x23\x5c\x61\xc7\xc4\x23\x5c\x38\x16\xfc\xc4\x07\x82\xf4\x81\x47\xb8\x3c\x2c\x77\x3c\xc2\xd5\xe9\x42\x83\xd9\x19\x44\x41\xb1\x39\x02\xcf\x87\x87\x11\xc8\x5e\x66\xc1\x0d\x21\xb8\x56\x3b\x0a\xf7\xf4\x6d\xbb\x9c\xfb\xdd\x33\x74\x1a\x74\x6c\x2c\x7a\x45\x60\x0c\xdf\x1b\xb7\x76\x09\xf7\x11\x7f\xbb\x9b\x1d\x7a\x6d\x6e\xcf\x2b\x00\xdf\x67\x1d\x9d\x0b\x2c\x3e\xe9\xd7\x47\xb7\xcd\x24\x8c\xf8\x4d\x22\xa4\xd5\xb7\xda\x6b\x35\x0b\x5b\x79\x0b\x68\xb6\x89\xf1\xe0\x91\x27\x22\xdb\xf7\xe7\x2e\xa2\xf0\xf3\x6e\xb8\x4f\x30\x01\xbf\x1e\xda\x2c\x6e\x6d\x5b\xa4\x48\xc4\x49\xe6\x14\x70\x36\x30\x4e\x04\x2b\x5c\x5d\xf8\xcd\xfe\x97\x8f\xc4\x9f\x0b\x56\x99\x91\x57\x5e\x74\x44\xf9\x89\xff\x83\xe9\x26\x97\xfc\x1f\x6c\x0d\x76\x69\xe0\x58\x02\x09\x08\x96\x88\x38\x8c\xff\x3d\x59\x7c\xba\xef\x3e\x87\x80\xa1\x13\x32\xec\x41\x9a\x59\x13\xde\x01\xdb\x31\x92\x93\x7f\xe8\xc3\x49\x6e\xf2\xd0\x48\x38\x3a\xff\xb3\x42\x27\x54\x0f\x02\x5b\x3e\xe1\x23\x47\x28\xda\xf5\x40\xe0\x73\x73\x91\xf4\x83\xeb\x43\x25\x75\x8e\x45\x9c\x00\x7d\x48\x2f\xbc\x63\x65\x3a\x51\xa1\x3a\x11\x41\x37\xe8\xa0\x55\x01\x91\xe9\x13\x3b\xf1\x3b\xfb\xfa\xb0\xf9\xd3\xd3\x13\xd9\x3c\x88\xa3\x42\x00\xe1\x45\xef\x9a\xd6\x82\xf1\xce\xc9\x9e\x0e\x98\x3e\x62\xf4\xce\x01\xf5\x51\xb5\xce\x55\x09\xd2\x4d\xcb\xa6\xb6\xdf\xe4\xee\xbd\xbd\xbd\xaf\xed\x9b\x99\x01\xbc\x8f\x5e\xb6\xa1\xa3\xe9\xa3\xe3\x7b\x63\x2b\x42\x74\xd2\xcf\xb4\x74\x36\x7e\x78\x90\x0f\x1b\x21\x72\xac\xe2\xd2\x78\x0f\xa5\x0c\x53\x64\x8a\x37\xc1\x20\xb4\xb9\x87\xde\x86\x72\x0b\x32\x88\x92\xf5\xfd\xfb\x0b\xf3\x84\xf6\x80\xa4\xf3\x86\x76\x50\x7c\x57\x13\x28\x28\x33\x88\xfc\xc4\x2a\xea\x0c\x31\xb3\x71\x0c\x53\xa3\x47\x4f\xfc\x1f\x6c\x8a\xaf\x7f\x56\x29\xff\xd7\x0e\x0b\xb8\x84\xc3\x74\x1f\x92\xc1\xcc\x05\xc4\xe5\xae\x7f\x3a\x48\x83\x4b\xa0\x9e\xe0\x1d\x83\xc1\x57\x41\x0f\x8f\x07\x5f\x05\xb5\x42\xf5\x20\xf0\xae\xa3\x49\x1d\xda\x40\xad\x82\x3a\xe0\xfa\x50\x49\x19\xa0\x19\x7f\x17\xea\x21\xed\xc0\x56\x41\xee\x54\xc7\x1b\x74\xd0\xda\xb1\x0a\xea\xc6\xdf\xba\x0a\x12\x49\xa3\x89\xe6\xc1\x2a\x08\x02\x20\xab\xa0\x49\xc4\xff\xb5\x8b\x13\xac\x82\x5a\x60\xfa\x88\x11\x5b\x05\xb5\xaa\x5a\xe7\x2a\x08\xe9\x86\xf2\x61\x20\xe3\xdc\x5d\x86\x8e\x42\x6b\xf3\x4c\x3e\x32\x5b\xba\xd7\x6b\xdd\x96\xa8\xf7\x92\xed\xbe\xb6\x6f\x66\xb3\xfa\x2e\xd9\xee\x6f\xfa\xe8\xf8\xde\xd8\xe4\xf5\x5f\xb2\x3d\xd2\xf8\xe1\x41\x3e\x6c\x31\xc9\xb1\xba\xeb\xab\x0e\xbd\x0c\x17\x20\xa8\xed\x82\xab\x36\x0a\x2b\xb1\x70\x0b\xeb\xfb\x77\x49\x2e\xdc\xda\x20\x3b\x17\x6e\x14\xc5\x77\x35\x81\xe2\xba\x8d\xb7\x15\x33\x59\xbb\xea\x72\xdc\x9a\xaf\x70\x4f\xe0\x23\x9c\x7b\x6c\xb0\x47\xee\x60\x91\xe7\x15\xfb\x14\x6f\xbb\x72\x0f\xd9\xfa\x1f\x76\x3c\x98\x8f\x45\xbe\xde\x66\xcc\xbb\x98\x43\x5a\x32\xd3\xd2\xc6\xd9\x22\x98\x5c\x96\x9f\xfe\x14\x45\x49\xf4\xce\x43\xa1\xcf\x64\xba\x2b\xf7\x73\xc2\xc6\x24\x0e\x62\xe0\x9f\x35\xd0\x9b\x4f\x62\xf0\x03\xf4\x30\xae\xc5\x17\x8e\x0a\xd4\xf2\xf9\xea\x17\xb0\x89\xd1\x9e\xd5\x71\x13\x5c\x08\x86\xf7\xb5\x3a\xd3\xfe\x8a\x34\xb7\x7d\x93\xf4\x52\xf7\x47\xc0\x48\xbc\x0b\x6b\x70\x54\x5e\xa5\x18\x21\xf6\xb9\xa0\x2d\xb6\x4e\x25\x16\xf2\xbb\x71\x6e\xb6\x05\x24\x38\x75\x82\x82\x07\x83\xf6\x61\xaf\xd6\x6c\xc2\x62\x95\x07\x33\x14\x30\xde\x40\xd4\x80\xab\x83\x1b\xff\xa5\x89\x3e\x77\xba\xa0\x6c\x1c\xef\x87\x95\x22\xb4\x00\x9f\x85\xd6\x71\x62\xc9\x8a\x16\x9c\xc8\x20\x8d\x66\x72\x5d\xeb\xbc\xd0\x84\x3e\xa6\xab\xaf\x31\xb8\x1d\xba\x3e\x11\x2f\xc7\xc8\x84\x9e\x8c\xa8\x0d\x87\xef\x55\xb5\x62\x76\x58\xe0\x5c\x13\xec\x73\x23\x10\x79\xe9\x1c\x48\x9b\xe7\x0c\x81\x66\x45\x95\x49\xcb\xf2\x50\xba\x98\xb0\x07\x7a\xba\x23\xf5\x70\xbe\xb9\xb3\x78\xd1\x3a\xc7\x17\xfe\x6c\x53\xb8\xa9\x69\x1e\x56\xb7\xce\xf4\xb0\x6b\x58\xed\xf5\x5d\x1f\x43\xce\xca\x32\x8f\xb3\x3d\x93\xa2\x84\xa8\x5b\x58\x1a\xd6\xb7\xb1\x74\xda\xca\xd2\x29\x36\x2c\x9a\xa5\x41\x75\x2b\x4b\xc3\xae\x61\xb5\xe8\x9b\x69\x7e\xab\x53\x0d\x53\x55\x04\xd7\x8b\x05\x96\x01\xee\x5f\x45\x85\x1a\x87\xfc\x2d\x88\x6e\x73\xee\x52\x6c\x33\xec\x04\x62\xf7\x15\x4a\x7d\xde\x6f\x6e\x86\x37\x00\x6e\x41\x97\xdc\x7f\x2f\x59\x36\x1e\x9f\x58\x13\x3b\x22\xf9\xa7\x18\x94\xf3\xfd\xd2\x00\x9f\xab\xec\x4b\x5e\x5e\x6a\xa7\x81\x29\x72\x1a\xc9\xc3\x5a\x0a\x00\x58\x4b\xbf\xc8\x1f\x09\x6e\x23\xbd\x0a\xd1\xcb\x03\xc6\xed\x36\x96\xe7\x3b\x7c\x51\x19\x21\x8d\xe3\xec\x38\x18\x2f\xf8\x7f\xa6\xd9\xd1\x99\x61\xcb\xf9\x0f\x5e\x22\x94\x25\x95\x08\xc5\x3c\x07\xe0\x69\x57\x77\x7e\x96\x6d\x52\x67\xf2\x45\x27\x4f\xe4\xe3\x78\x9e\x1d\x6f\x89\xa4\x5a\x71\x49\xff\xd5\xef\x19\x02\xc5\x19\x95\x5f\x4d\x8d\x7f\x9d\x1d\xcf\xcd\x77\x70\xb5\x88\x67\x6f\x53\xec\x09\xd7\x87\xfa\xd6\x90\x42\xd0\xf2\xe5\x59\x2c\x79\x3d\xa0\x9f\x0f\x55\xb6\x37\x3b\x13\xac\x8a\x7c\xda\x55\x7c\x31\xd6\xe8\xd4\x2b\xf6\xe4\x03\xf8\x00\x0e\xeb\xd6\xaf\xa2\xba\x8d\x9f\x16\x11\x4f\x8b\x2f\xdb\x20\x2f\x80\x6b\xf2\xc4\xbb\xd4\x00\x0e\xeb\xd6\xaf\xa2\xba\x95\x2f\xc3\x6b\x74\xf0\x15\x60\xdd\xa7\x78\xb9\xd6\x05\xc2\x3a\x74\xca\xc9\xf0\x9d\x78\xf3\x59\x23\x42\xde\xfa\xd4\x9a\x25\x5e\xa0\x04\x70\x58\x9f\x7e\x15\x99\x23\x40\xbc\x01\x6b\x34\x24\x78\xcb\x4f\x2f\x3b\xc4\x5b\x73\x3e\x18\xaa\x47\x6e\x0d\xd5\xa7\x7c\xd5\xf1\xa6\x5e\xb3\xc3\x8f\x96\xd9\xd7\x6e\xdc\xa3\xf9\x3c\x97\xf0\x32\xf4\xb5\xbf\xaa\x29\xd0\x8f\x11\x61\x33\x0b\x7a\x04\x71\xca\x46\x0c\x8b\x9e\xd2\x72\xd4\x6d\x53\x7a\x0b\x1f\x33\x53\x8d\xf4\x9b\x66\x1a\x87\x77\x52\x4b\x3f\x70\xc6\xac\x93\x24\x40\x5b\x27\xf9\xd7\x83\xd6\x89\xbb\x61\x49\x0a\x63\xd3\x51\x2f\xea\x35\x39\x27\x73\x0d\xd9\x6c\x21\x9e\xfd\x37\x0a\xf5\xea\x9d\x30\xfe\x3e\x7a\xdd\xd6\xf3\x71\x38\xcc\x47\x05\xea\x9d\xc4\x99\xc3\xeb\xd4\x96\x1c\x77\xff\x29\x56\x25\x7f\xbe\x1c\xd9\x8a\xa5\xb2\x09\xb7\xc4\x99\xa6\x29\x72\x34\x7e\x1a\x9e\xa6\x12\x45\x72\x20\xf9\xe9\x90\x55\x39\xb6\x71\x11\xee\xdc\x74\x33\x18\x1f\x26\x43\xe7\xcf\xc3\xe4\xea\x21\x70\x41\xe1\x19\x40\x70\x17\x25\x9e\x00\x95\x8f\xa3\xc8\x69\xfe\x7c\xa8\xdc\xf5\x9a\x9e\xc0\x73\xfe\xef\xe6\xde\x19\x31\x2d\x82\x7b\x51\x03\x84\x3d\xad\x17\x32\x91\x84\x5c\xce\xc8\x0d\xf6\x2b\x48\x4e\xad\x02\xf0\xf5\xae\xca\xb2\x93\xbc\xec\x16\x1e\xf0\xc2\x25\x35\x7b\x0a\x25\x35\x13\x47\xe3\x5e\x39\x42\xf7\x71\x2d\x39\xc2\x45\x04\xc6\x13\x48\xd2\xca\x66\x31\x15\x47\xdb\x9b\x03\xab\x3e\x25\x79\x41\x3c\x3d\x13\x1e\xb5\x8b\xe1\x05\x0c\x37\x6b\xc9\xc3\x4b\x54\xf7\xf5\x2c\xe7\x11\x3f\x09\x34\x18\xc7\xf5\x80\x3f\x47\xc7\xdc\xd3\xa8\xbc\x34\xe0\x21\x40\x02\xa8\x13\xc2\x19\xfd\x40\x64\x83\x19\xda\x02\x95\x1c\xc6\x99\xb3\xee\x3d\x10\x7b\x55\x3f\xb1\x4d\xf4\x3b\x22\x4e\x91\x31\x6d\xb6\x04\x4b\xc9\xa2\x57\x21\x96\x9c\xf1\x2e\x39\x8b\xb8\x9f\x73\x75\x69\xe3\x7e\x3e\x4b\x0a\x66\xfb\xaf\xee\x45\xb0\x7b\x6f\x4e\x63\x01\x42\x81\x75\x70\x98\xf9\xc7\x5a\xc1\xf4\x97\x40\xf2\x7f\xc2\x87\x5b\x97\x7c\x8a\x8b\xba\xe7\xf3\x50\xfd\xb8\x04\xe7\xbc\x0d\xc8\xc7\x33\x7c\x7c\x52\x21\x66\xea\x78\xcc\x6b\xb1\x6a\x1f\xfa\x45\xfc\xda\x2d\x98\x0a\x53\xbc\x21\x63\x63\x51\xd6\x58\x7b\x55\x43\x39\x37\xee\xaa\xd5\x11\x4b\x61\xc3\x30\x0e\x98\xe5\x9d\x16\xcb\x6e\xb9\x98\x62\xbb\x87\x74\xbf\x8f\x52\x78\xa2\x32\x5d\x64\xab\xdd\x02\xa0\x1a\xa0\x06\x71\xb7\xca\xe2\xed\x14\x82\xba\xfc\xd7\xab\xcf\xed\x7c\xc6\x57\x2b\xb2\x46\x2c\x03\xcd\x92\x6d\x19\x3d\xe1\xef\x7b\x67\xe9\x1e\xc6\xac\xb6\xbb\xec\x69\x3f\x71\xf1\xe0\x84\x25\x8b\x6c\x92\x79\xfd\xa1\x54\xcd\xe6\xf1\x62\xa5\xa1\xc0\x73\xff\x4f\xc9\x22\x9d\x6e\x31\xbb\xb1\xdb\x3f\x65\x53\x40\xd8\x3e\xc9\xb6\xbb\x1d\x40\x85\xd3\xb6\x5f\x66\x93\xed\x1c\x82\x22\xe4\x2d\x16\xf3\x89\x65\x9a\xff\xd4\x77\xb2\x9a\xcd\x66\x31\x46\x5d\x9c\x66\x69\xf0\x26\x3b\xa3\x2d\x9d\xf8\x98\x70\xe2\xb2\xd9\x76\xb5\x8b\x00\x24\x42\xdb\xd3\x6c\xca\x16\xb3\xb7\x7f\xd5\x86\xf1\x97\xec\xfb\xbe\x4a\x8e\x59\x3d\xe0\x8f\x9e\x55\x4c\x0d\xf8\x27\xf2\x51\xdd\x54\xf9\x39\xab\xaf\xfb\x8a\x5f\x37\xb5\xc4\x1a\xe5\x9e\x89\xe0\xc5\xad\x29\xd1\x5a\x7e\xed\x94\xf5\x51\xfe\xaa\xe8\x7f\x45\xdc\x63\x8d\xf1\xea\xdc\x56\xc3\xac\x61\x77\x8a\x30\xea\x83\x50\xd7\xc5\xaa\xe0\xd9\x46\xf2\xde\x54\x08\x69\xe9\x17\xe7\x1d\x82\xd4\xdf\xe6\x31\x52\x91\xd8\xaa\x25\x76\x17\xdb\xb5\x1e\xb1\x85\x20\x63\xd6\x2d\xc3\x1b\x39\x17\xba\xbc\xb7\x2f\xfb\x42\x22\x5e\x5d\x0c\x6c\x30\x5e\x48\x6f\x0c\xbc\x39\xa8\x24\x6b\x7c\xbe\x29\x4d\x4a\x87\xb6\x54\x95\x0c\x7c\xfe\x06\xb7\x0d\x35\x7d\xf2\x89\xe0\x91\x79\x2c\x70\x36\x4f\xb3\x97\x21\x72\x91\x6d\xfe\x61\x10\xcf\x7f\x18\x3a\xae\x34\xf8\x7b\x1e\xfd\x40\xb4\xa4\x6b\x96\x00\x07\xf8\xfb\x43\x78\xc9\x98\xf1\xed\xff\x7b\x44\xff\xc3\x53\x8c\x3c\x26\x2b\xe6\x9b\xb0\x44\xb3\xc8\x0f\xc4\xfa\x35\xbe\x4a\x9a\x77\xe5\x74\xa1\x2a\x00\x0a\xa9\xfb\x4b\x4e\x8c\x41\x42\xcf\x31\x0b\x39\x88\xf5\x23\xd6\x03\xe6\x68\xf3\x13\xdb\x61\xf2\x79\x73\x7f\xa3\xbb\x5b\xc0\x79\xd6\x19\xd4\x6a\x9f\x80\x18\x82\x7f\x4e\xc4\x7f\x4e\xc4\x80\x62\xa0\x77\x1d\x51\xcd\x0e\xa5\x83\xad\xff\xa9\x71\xff\xd4\xb8\x2e\x8d\xeb\x8e\x6c\x77\x28\x1d\x82\xe0\x9f\x7a\xf7\x4f\xbd\xeb\xd2\xbb\xce\x4f\x1b\x1d\x6a\x17\xb6\xff\xa7\xd6\xfd\x53\xeb\x10\xad\x13\x31\x6d\x78\x51\x5a\x15\x63\x2f\xe3\xaa\x67\x26\x44\xfd\x50\xfe\x0f\xdb\x31\xa6\xdf\xaf\x70\x63\xfd\xd7\x92\x7f\x0b\xb8\xb9\x20\x26\x96\x2e\xf2\xb6\xa9\x9a\x72\xfb\xe7\x6c\xd7\xc0\x34\x94\x6e\xdd\x38\x3f\xbe\x8c\x6c\x8c\x1a\x66\xeb\x96\xa0\x22\x52\xa7\x08\x7a\x76\x9e\x24\xf7\x8f\x72\x4c\x9c\x8e\x79\x81\xdf\x40\xdc\xca\x06\x5f\x10\x9c\x06\x7c\x0c\xc3\xb0\xb1\xea\x07\x49\xc2\x0a\x3e\xcd\x31\xd6\x69\x54\xf2\x2b\xdd\x95\xc8\x3a\xa1\xbb\x13\xa9\xc3\xe0\xa7\x7e\x51\xaa\x61\xf8\xf9\x7d\xee\x5d\x5a\x72\x5b\xcc\x9d\x11\xe7\x75\x03\x8f\xb6\x04\x47\x57\xec\x17\xac\xf6\xa4\xfa\x32\x1d\x10\xf8\xde\x75\x7f\x9a\x7c\x24\x5d\xfa\x7d\xe7\x3c\x01\x05\x7d\xde\x18\xec\xc8\x8a\x10\xe2\xa4\x33\x57\xbc\xee\xe5\xc9\x04\xf6\x34\x94\x2f\xe3\x05\x6c\xb5\x57\x29\xc3\x36\x03\x58\xa0\xb5\x82\x40\x46\xc2\xbb\xb7\xfc\x82\x5e\xec\xf1\x11\x50\x2e\xcf\xd8\xe0\x5d\xa9\x46\x44\x65\x70\x4b\xb4\xef\xa1\x5f\x11\xa0\xbb\x11\xac\x72\x5f\x80\xb4\xc1\x2f\x79\x9a\x08\x7e\x20\xd6\x27\x82\x86\x64\x8d\x39\x2f\x40\xd5\x3f\x76\x52\x52\x7c\x6d\xa5\x70\xd2\x02\xed\x20\xf3\x91\x86\x82\xfe\x2e\x95\x30\x1f\x3d\xfa\x93\xcc\x79\x7f\x3f\xbd\x1d\xad\x08\x62\x83\xf4\x0e\xf8\x41\x80\x10\xb1\x2c\xa7\x64\xac\x6a\x89\x33\xcf\x6d\x19\xcb\x89\xf3\xbf\x78\x07\x77\x48\xad\xa3\xc1\xf3\xb8\x3e\x32\x9d\xbb\xbf\x5d\x6b\xb3\xbb\x75\xab\x57\xb3\x0e\x5a\xbb\x5a\xb7\x37\x6e\x57\xea\x07\x9b\x75\x51\xdc\xd1\x5a\x34\xee\x9a\x4f\x94\xa0\xf0\x79\xd1\xce\xaa\xd6\x36\x3d\x66\xd2\x6e\xc9\xd6\x71\xe1\xb1\x97\x3b\x3f\x79\x86\x9e\x44\x23\x20\xdc\x02\x8e\x9f\x46\x73\xaf\xfb\xeb\x6c\x07\x64\x44\x76\x4c\x79\x44\x53\xdf\xe6\x19\x01\x92\x0e\x20\xff\x12\x3e\xc9\xf0\x48\x7c\x4e\x26\x09\xb2\x67\x13\xda\x01\xba\x06\xe6\xe9\x50\x07\xe9\xba\xcf\x5e\x50\xbd\x98\xe1\x9b\xe5\x76\x63\xac\x38\xe5\x1b\x63\xa9\x4f\x01\xfa\x3b\x3e\x95\x23\x2c\xe6\xad\x29\xc2\x43\xcc\x04\x82\xbb\x55\xb9\xb5\x51\xa7\x1e\xf3\xd6\xa4\xac\x45\x65\xab\x84\x9d\xe6\x6d\x10\xbe\xee\x52\x8c\xdd\xcd\xb2\xe9\x1e\x59\x76\x0a\x1c\xb4\xe2\x3a\xb5\xad\x23\xe9\xa5\xb2\x6e\x57\xdd\x20\xdd\x43\xbf\x47\x53\x15\x5f\x7c\x4d\x95\xba\x12\xe0\xbe\xef\xf8\x04\xc2\x53\x85\x80\xa2\x1d\xc5\x4f\xa3\xb9\x5b\x6b\xbb\xda\x75\x2a\xae\x42\x40\x4a\x5c\xd7\xb7\x4a\xdb\x47\xd2\x01\xe4\x89\x90\x66\x78\xb2\x8f\x77\x3b\x9a\x60\x5a\x8f\x7d\x80\xae\x81\xf5\xd2\x66\xd0\x67\x2f\xa8\x5e\xcc\xb8\x43\xad\x35\xa7\x3c\xb5\x56\xfa\x14\xa0\xbf\xeb\xd8\x0d\xc2\x64\xd9\x9e\x22\x1e\xc3\x4e\x22\xb9\x5b\xa5\x3b\x9a\x75\x6a\xb4\x6c\x4f\xca\x5d\x55\xb7\xca\xdb\x43\xd1\x0e\xe3\x49\x8e\x64\x34\x3f\xca\x84\x6a\xb3\xc4\x42\x2b\xb3\x57\xdf\x31\xa6\x5e\xaa\xec\x77\xd8\x07\xa8\x0f\x1b\xee\xd0\x63\xcd\x23\x4f\x8f\x95\x0e\x91\x32\xef\x8a\xc9\xa1\x6b\xef\xf0\xe1\x4a\xef\xc2\x23\xbf\x10\x75\x0a\x32\xe6\xc6\x51\xff\xa0\x59\xeb\xe1\x4f\xec\x0c\x90\x3c\xb2\x34\x01\x47\x96\x22\xff\xf8\x0f\x09\xa4\x08\x96\xf1\x5f\xf7\xa4\xaa\xae\xd0\xdc\x0a\x03\x83\x7d\x72\x5d\x77\xdf\x31\xc4\x2e\x01\x82\xbe\xd1\x67\xb9\x54\x52\x1c\xb0\x5f\x93\xed\x9a\xbc\x29\xb2\x36\xf9\x46\xee\x11\xad\x45\x78\x76\xd4\x41\x63\x76\x95\x61\x99\xbc\xec\x66\x4b\xc1\x9f\x48\x3b\xd5\x0c\xa7\x7a\x5f\x96\x8d\xbd\xda\xe8\x32\xba\xe3\x08\x9c\x9f\x8e\x1c\x79\x9d\xb1\xe3\x92\x25\x72\xbf\x93\xd1\xf3\xec\x4c\x80\xa1\x2e\x92\x94\xee\x54\x02\x1f\x17\x24\x3c\x3c\x1c\x60\x09\xcc\x6f\x0f\xb4\x41\x9b\x6b\xf8\x22\xc7\x26\x78\x5d\x3d\xe8\xda\x8d\x3a\x07\x28\xfd\xa7\xb5\x3b\x49\xea\x8d\xeb\xea\x3d\xa3\xf4\xaa\xa9\xe0\xf5\x6f\xa3\xdd\x61\xf7\xee\xcb\xd3\xdd\x23\xe9\x87\x09\x3e\x35\xf7\x36\xda\xe5\x4d\xf1\x8f\xf7\xa8\x40\x9f\x4f\x08\xad\xe9\x9c\x41\xd7\x0f\x74\xa5\x4e\x7d\xba\x4e\xe2\xa3\x3f\x8d\x31\x60\x42\x20\x8d\x3c\x3f\xaf\x6a\x91\xbf\x46\x55\x56\x9f\xcb\x53\x2d\x2e\x4b\x89\x12\x72\xb6\xa1\xb8\x07\xea\xa2\x82\x8f\x15\x2f\x0d\xfb\x1a\x80\x6b\x0e\xad\x77\x77\x6e\x14\x32\xff\x49\x6a\x51\x8b\xce\xbb\xa0\xa6\xc7\x3d\xf5\xd6\x89\x73\x27\x21\xcf\x0d\x77\x85\x7e\x49\x45\x53\x7a\x0f\x62\xae\x70\xbd\x11\xbf\x82\xa6\xbb\x7a\xfd\x47\x63\xef\xa0\x49\xdf\x86\xdb\x9d\xfd\x1c\xfe\x26\x52\x7d\xb3\xf1\x74\xf6\xd3\x32\x9e\xb7\x93\xc0\xdb\xf1\xf8\xed\xb8\xf8\x1a\x3e\xb5\xa8\xff\xaf\xaa\xe2\x88\x9f\xfe\x35\x34\xfc\x2d\xba\xe9\x21\x9a\xbf\x49\x37\xf4\x68\xde\x8c\xfb\x6f\xc6\xdf\x37\xe3\xe0\x2b\x78\xd4\x96\x66\x85\xd6\x6d\x27\x57\x8b\xa2\x80\x24\x8d\x5a\x21\x3e\xba\x22\xbc\x8b\x16\x25\x1e\xb7\xa0\xea\xa5\x87\xed\x48\xf9\xea\xad\x27\xd2\x47\x89\xe9\xdf\xdf\x3f\x1e\x5b\x7b\x7b\xb3\x57\xf5\xd1\xd3\x33\xbf\x42\x92\x6f\x34\x8e\x8e\x3e\xda\xbc\xdf\xdb\xb0\xfc\x8d\xb8\xfa\x46\x8c\x7b\x98\x37\xd7\xbf\x8f\x26\xbf\xde\x5c\x74\xb2\xfc\x57\xb6\x48\x6f\x33\x8a\x4e\x51\xbd\xde\x04\xb6\xfa\xb8\x37\xe1\xe8\xdb\x30\xed\x51\xbe\x74\x98\x6a\xb0\x3f\xe7\x83\xfc\x08\x76\xfa\x41\x8d\x23\x3f\x9f\xc6\x8f\x0e\x2c\x29\x72\x17\xe8\x4a\x06\x0a\xfd\xa9\xd5\x67\xed\x34\xbc\xb7\xc5\xc1\x0b\x83\x81\xb9\x2c\xab\x32\x5a\x73\x21\xe0\x55\xbf\x0d\x46\x20\x92\x04\x31\x22\x9e\x5b\x2d\x3c\x02\xde\xe6\x74\x2c\x38\x57\x89\x3b\xb0\x1b\xf0\x7e\xd8\xf9\xfa\xee\x0e\xec\x06\xbc\x9f\xc3\x7c\x90\x57\xbd\x10\x3c\x4a\x41\x2f\x7e\xf6\x42\xf0\x28\x05\xbd\x78\xde\x0b\x01\xea\xd2\xf4\x8b\x7f\xdd\xda\x4a\xda\x71\x94\xdd\x3d\xa0\x1d\xde\xde\x03\xdd\x0b\xb7\xc3\xb5\x7b\xa0\x7b\x39\xc4\xc7\xb8\xd4\x53\x4d\x1f\x6a\xdf\x87\x93\x3d\x95\xf4\xa1\xf6\x7d\xb8\xdd\x53\x45\x43\x97\xa5\xdf\x9d\xeb\x50\x3a\xdf\xc8\x77\xea\x28\xd8\xb8\x76\xa9\x46\x5f\xec\x28\x38\xc4\xde\x43\x15\xda\xfb\xbb\x1b\xc1\x3d\x14\xf4\x1a\xf1\xdd\x08\x0e\xf0\x2b\x4d\xa7\x3c\xdd\x85\x4d\xb7\x38\x5d\x68\x5a\x9a\x4a\xd1\x7b\xe2\xc6\xa0\x1f\x91\x65\x5b\x6f\xf7\xb6\xbf\xa7\xff\x3e\xa3\xbd\xb7\x7d\x97\x1c\x2d\x3e\xe2\xaa\x8b\xfd\xc0\x85\x7d\x97\x95\xb7\x82\x9c\xfa\x81\xfc\x83\xba\x37\xe3\xe7\x2d\x87\xad\x3e\xfa\x8d\x4d\x76\xa8\x10\xd2\x9c\x23\xc0\x47\x87\xc2\xb6\x7d\x14\x1c\xde\xd5\xee\x8e\xa5\xb0\x8f\x31\xf8\x98\x47\x50\x2c\xe1\x60\xc7\x03\xa4\xe3\xe0\xb8\x84\xdb\xb7\xc9\xbb\xea\xa7\xa1\x0a\x00\xc0\xd7\xd3\xd6\xe7\xea\xc0\x61\x81\xbe\x38\x7b\x32\xb1\x37\xbe\x81\x9f\xc9\x51\x13\x85\xbf\x3b\x84\x22\xc2\x79\x8c\xd1\xa5\x36\x61\x21\x69\x26\xc7\x2c\x7a\xfd\xc3\x83\x21\x58\xfc\xc0\xad\x92\x36\xb4\x77\x72\xb9\x0f\xca\xc1\x3d\x29\x33\x51\x44\x8f\x32\xda\xa7\xce\xa4\x10\x41\x53\xaa\x79\x30\x94\x3a\x3f\x94\xac\xad\x0d\xf3\xbd\x4a\xdd\x03\x25\x60\xb7\x26\x8d\x38\xeb\x4e\xe0\x7a\x58\xb5\x3d\x02\x65\xfe\x0c\x34\x4b\x9c\x05\xa0\x78\xfd\x50\xf2\x39\x12\xed\x9d\x8c\xee\xc4\x07\xb9\xac\x88\x22\xce\x69\x63\x88\x1e\x65\xb1\x4f\x9a\xc9\x16\x81\xa6\xbc\xf3\x60\x08\x46\x3f\x96\x4c\xaf\x0d\xf3\x9d\xbc\xee\x83\x12\x1a\x6b\x45\x1a\x71\x7e\x98\xc0\xf5\x28\xc7\x7d\x02\x75\xa2\x04\x34\x8b\x9f\x0b\x42\xf0\xfb\xb1\xf4\x80\x2d\x88\xef\x64\x77\x0f\x8c\x90\xdb\x8a\x30\xe2\x94\x2b\x8e\xea\x51\x66\x6b\xf2\xb2\xe3\x36\x4b\xdd\xc5\x65\xd7\x45\x71\x75\xe6\xd5\x26\x8c\x8e\x60\xea\xbe\x10\xe9\x20\x28\x51\xc7\x00\x03\x40\x51\x80\x94\xe7\x22\x3f\x21\x52\x21\x33\x11\x20\x15\xfc\xc5\xbb\xd2\x8e\x26\xd9\xb2\x55\xd7\xa5\x91\x29\x44\xf9\x2a\x57\x9f\xe3\x95\x57\xe8\x9d\xcb\xc9\x6e\x42\x3f\xbb\xb2\x0e\x06\x30\x59\x6c\xbf\xaf\xae\x20\x75\xf0\x7c\x31\x8e\xe7\x3f\x20\xd0\xb3\xed\xf7\x29\x04\x5e\x72\xc8\xaf\x59\xc1\x96\xd1\xf9\xc9\xcb\x13\x68\x8e\x82\xae\x88\xcc\xb1\xed\x6b\x3d\x77\x91\x99\x4d\xf9\xbf\xfb\x93\x26\x76\x9c\x2b\xee\x00\x95\xe3\x1a\x08\x8d\xf9\xcb\x85\x69\x68\xb8\xb2\xf5\x27\x20\xc8\x4b\x28\xdb\x8f\x0a\x7b\xfc\x38\x9e\xe1\xf9\xa1\x05\x5c\x7d\xf4\x52\xf1\xfa\x60\x22\x0a\x2f\x13\xca\x3a\x19\xc1\xdb\x12\x61\x93\xb9\xdf\xa3\x48\x3d\x32\xe2\x9d\xae\x8e\x06\x62\x75\xb8\xcf\x0b\x36\x13\xd7\x49\x71\x3e\x24\xef\xcb\x73\xb2\xcb\x9b\xef\x3f\xc5\xd1\x87\x8d\xfa\xbd\x1e\xc7\x8a\x0e\x7d\xaf\x59\xfe\xe1\x1d\x73\x37\x3d\xb4\xa7\x54\xc7\x3b\x9b\xbb\x9d\x99\x7b\xf8\x72\xe8\x26\x91\xdd\xf9\x9c\x25\x55\x72\xda\xa9\x77\xd7\xec\x2c\x06\x3d\x58\x1d\xe3\x89\x3c\x9d\x99\x70\x2c\xd3\xa4\x18\xf1\xf7\xfc\x60\x6a\x11\x55\x67\x27\xdd\x3e\xff\x96\xa5\x6a\xc6\xa9\x68\x53\x30\xf3\xf4\xfd\xed\x49\x34\x8f\x36\x6e\x9e\xfb\x20\x23\xa8\x1e\x82\x2e\x1f\xd5\xbb\x8a\xd9\x3b\x4e\x7d\x53\x5e\x76\x87\x4d\x79\x69\xb8\xd8\x0c\x91\xe3\x7d\x92\x32\xdb\x23\x09\x4e\xf3\xa4\x28\x5f\xae\x48\xc2\x4b\xaf\x88\x3f\x41\x3a\x18\x4f\x55\x2a\xea\x30\x9d\xb5\xfe\x2b\x84\x73\x80\x28\x4c\xb0\x23\x09\xc8\xcc\x6c\xc6\x74\x7f\xc4\x2c\xc7\x87\xcd\xe8\x58\xb7\xd7\x97\xad\xd5\x2d\x75\x9a\x29\xf9\xa9\x8d\x25\x41\xdb\xa8\x8d\xa6\xa8\x85\xa0\x88\xa2\x26\xfa\xe0\x2a\x91\x22\xc6\xe8\xd2\xe8\x9b\x96\xb7\x29\xf9\x2e\xb3\x79\xfb\x54\x87\x7e\x4a\xda\x70\x27\x13\xb8\x4e\x12\x23\x9a\xa9\xf7\xfd\x90\x76\xf8\x05\x0d\x24\xd3\xe4\xae\xc8\xcf\x6b\x6b\xc4\x7d\x3b\x1c\xd4\x05\xa6\x78\xb5\x5a\x85\xa5\xae\xe1\x8b\x3f\x84\x16\xce\x2a\x35\x7e\x07\x84\x3f\x65\xb1\x02\x06\x18\x5e\x01\xc1\x61\x34\x63\xf8\x20\xf8\xed\x8a\x47\xa7\xed\x2c\xc2\x9e\x24\x8d\x22\x88\x5f\xcc\xc6\x2b\x6a\xb8\x1c\xbb\x15\x36\xcb\x4f\x78\x23\x60\xed\x54\x33\xf5\x70\xae\x97\x7b\x9d\x8c\xc0\x88\x77\x68\x40\x53\x9d\x7c\xdc\x09\x6d\xf1\x8c\xe3\x1a\xc8\xbd\x62\x12\x5c\x03\xd2\xa9\xf6\xcd\x18\xc4\xe5\x9a\x40\xe1\xfc\xeb\x36\x12\x14\x5e\x02\xe1\x74\x3b\x69\x52\xa4\xcf\xc2\x63\x58\xfe\x28\x24\x22\xf1\x54\xe7\x47\xfe\x9f\x20\xc0\xe7\x66\xc9\x0f\x08\x18\xd8\x57\x43\x42\x14\xe6\xa9\x48\xac\x8d\xf0\xf5\x1f\xed\x4f\xff\xad\x46\xdd\x42\x9a\x6c\x9e\x08\xed\xc8\xcc\xe2\xa5\xca\x88\x15\xda\x88\x4d\x16\xee\xca\xe5\x9c\x9e\xf3\x45\x8f\xe2\xf2\xdc\x4b\x16\x2d\xf1\xd9\x27\x98\x83\x67\x1f\x3c\x9b\x21\xab\x16\x91\x4d\x41\x2d\xde\xe0\x18\xb8\xe6\x45\xdb\x09\x74\xb6\xcd\xd5\xd5\x9c\xd6\xe9\x46\x00\x19\x16\xe8\x54\x38\x53\x91\x6d\x0b\xa1\x7d\xb5\x8a\x1d\xda\x0b\x4d\xf7\x4a\xc2\x8f\x9b\xb2\x2c\x9a\xfc\x8c\x70\xce\x4e\xca\x65\x04\x96\xed\x62\x7d\xb3\x4f\x8e\x79\xf1\x7d\xfd\xee\xdf\xb3\xe2\x4b\xc6\x93\x57\x0d\xfe\x33\xbb\x64\xef\x86\xe6\xef\xe1\xbf\x55\x8c\x53\xc3\x9a\x19\xeb\x51\x9d\x55\xf9\x1e\x7d\x22\x47\x67\xa5\xaa\x8e\x49\xe1\x2d\x9c\x66\x70\xe1\x64\x1f\x9f\x00\x29\x7f\xdc\xbf\xeb\x26\xa9\x1a\x7c\xd5\xe3\x2e\xb6\x6c\x81\x75\x2b\xa2\xac\xc8\x1a\xa6\x85\xe2\xf1\x1d\x3e\x75\x14\x5d\x5f\xd9\x5c\x19\xf1\x27\x5c\x7f\xf1\x4a\x30\x28\xfe\x5c\x8f\x29\xf0\x1e\xf2\x91\x23\xec\x32\x59\x72\xcc\xb2\x2f\xa9\x4a\x4a\x44\xa4\xed\x5a\xb9\xb6\x6b\x65\xe1\x9b\xf2\xec\xbd\x08\x68\x66\xab\x98\x13\x62\x25\xab\x41\xbd\x24\x6a\xea\x39\xdb\xe0\x79\x1a\x0d\xac\x52\x96\x91\xa8\x3d\x60\x37\xdb\x1a\x82\xd8\x23\x83\x29\x9c\xff\x20\x4b\x1c\xc1\x87\x96\x9e\xee\x4f\x7c\xce\x57\xc1\xc8\x77\x08\xdd\x67\x52\x55\xe5\x57\x44\xfd\x41\x4a\xf6\xc8\xdf\x62\x20\xd7\x13\xa5\x22\x0b\x33\xea\xc9\x60\x00\xba\xf2\x9d\xdf\x9c\x6d\x0c\x3d\x86\x38\x3e\x46\xd9\x2b\xf9\x86\xd2\xc0\xbb\x8c\xe4\x7a\x46\xa7\x2f\x81\x03\x76\x68\x1e\x22\xda\x40\xb3\xad\xd3\xb1\xbd\xae\x4f\x81\xbf\x63\x94\x61\x0a\xb8\xc7\xfb\x44\xfb\x13\x5f\x8d\x18\x2f\xfd\x2c\x76\x42\xd3\xc9\x9e\xbc\xde\xe4\x61\x2b\xac\x3f\x8c\xa7\xba\x3b\xbd\xa2\xe9\xec\x2f\xd2\x3d\x6e\x9c\x23\x1d\x68\x77\x92\x41\x58\x87\xbd\x55\x26\xe8\xcc\x0f\xd7\x20\xdd\xa1\x8a\xe3\x2e\xda\x1c\x09\xe2\x83\x7c\xa0\x4f\x52\x90\xa1\xd6\x3c\xde\xe7\xb9\x3c\x8b\x3d\x31\x15\xbc\x09\x96\xa0\x0b\xb0\x73\x74\xcc\xd1\x72\xe1\xc6\x53\xb4\x07\x7b\x9d\x23\x9c\xfd\xff\xd4\x11\xfe\xad\x36\x4a\xfc\x39\xf6\xfb\x37\x4a\xf4\x82\x2d\x02\x6b\xb1\x18\x5b\xb0\x21\x40\x81\x5b\x57\x8a\x29\xdc\xb4\xab\xe5\x72\x9f\xa9\x6b\xa5\x67\x76\xa7\xbb\x5f\xaf\x9c\xb1\x9b\xda\xd5\xab\x17\xfe\xd7\x5f\x72\xbb\xf5\x6a\x0b\xe2\x3d\xb2\x6f\x1f\x02\xf3\xaf\xb8\x13\x2f\xd3\x2e\xf9\xbf\x96\x6d\xd1\x96\xff\x03\x2c\x36\xd6\x77\x60\x67\xa8\xdd\x54\xdb\xe8\x9b\x20\xc6\x40\x3c\x8f\x85\x89\x18\x82\xbf\xd7\xc9\xbe\x41\x27\xb8\xbf\x68\x7d\x9d\x43\xf7\xbb\x04\xf7\xc7\x27\x21\x91\x8a\x28\x35\xa6\xf5\xbb\x77\xbe\xe5\xf2\x85\xc4\xc4\x66\x11\xab\x0c\xa9\xe2\x11\x7d\xd4\xdc\x8b\xaa\xd0\x47\xda\x88\x80\x53\xea\x29\xe1\xfc\x03\xb0\x93\xf6\x4e\x71\x40\x88\xa2\xdf\xca\x73\x13\x28\xd1\xc6\x0c\x6e\xf0\x0e\xa1\xc7\x79\x27\x9c\xea\x4e\x28\xb7\x1e\xb9\xe7\xc1\xe5\x20\xbd\x69\xe1\x8c\xda\xf3\xd5\xce\xb8\xdd\x72\x62\xe4\xc2\xed\xb6\x12\xe2\x8f\x5c\x8e\x54\x4e\x3c\x7c\xc8\x1e\x31\xce\xa0\xf1\x9e\x24\x5a\x77\xcc\xf7\x88\x5a\xeb\x30\xea\xee\x1c\x46\x78\x15\x80\x13\x04\x2d\x6a\xd8\x2a\x1c\xd0\x5f\xda\xad\x24\xc9\xe3\x01\x8e\x29\x82\xc2\x56\x4f\xaf\xf5\x90\x36\xe8\xc7\x5d\x3e\x39\x03\x77\x8a\xc9\x61\x3b\x74\xa8\x41\xab\xbb\xe8\x76\x85\x4c\x8e\xb8\x93\x16\x31\xe2\x5d\xc2\x4c\x64\x8d\x3d\x92\x6c\xeb\xd4\x66\x87\x8a\x3c\x8a\x2f\x46\x41\x48\xdc\x6f\xfc\x3c\x16\xb9\x2c\xe8\x8f\x6c\xc2\x91\x23\x41\x6a\xfd\xb0\x92\x7a\x1d\x71\x20\xd6\x0e\x7e\x68\x1a\x05\xe9\xa8\xc7\xe9\x7b\x56\x0f\x2e\xa2\x75\xfc\xed\x45\x6f\x6d\xa3\x23\x18\x49\x51\xc8\xc7\x37\xcd\xb2\x64\x34\x4d\x3f\x0c\xdf\x07\xf1\x65\x5e\x7c\xc5\x19\xd3\x2f\x3c\xbf\x68\x7b\x70\xd2\x8f\xd0\x2f\xc8\x67\x27\x5b\xf0\x39\x2b\x9a\x3d\x5b\xff\x8c\xbe\xe4\x75\xbe\xcd\x0b\xbe\x5b\x77\x5e\x27\x23\xaa\x74\xeb\x73\x56\xd5\xe7\x4c\xa6\x32\x9a\x44\x72\x5b\x1c\x14\xe1\xfc\x57\x19\x90\xc6\x2a\x77\x3a\x0a\xc2\xdf\x72\xbf\xaa\x95\x70\x4b\x04\x7f\x9a\xbe\xe7\x7a\x39\xa4\xe2\xf1\x6e\x7d\x3b\x31\x32\xb3\x3a\x0a\xc1\x9f\x89\xef\x47\xcb\xa8\x8b\x98\x51\x3f\x6a\x5a\x98\xd2\x45\xa8\x5a\x9f\xf5\x22\x37\x6a\x23\x55\x56\xde\x42\x3a\x29\x12\x4f\x22\xf1\x2b\x2c\x15\xcc\x03\x59\xf6\x71\x8c\x57\x1d\x4b\xbd\x03\x31\xb5\x87\x72\x9e\xcf\x45\xd1\x5d\xd5\xc2\x15\xab\xb7\xe2\x1e\xd1\x08\x08\x31\x04\x12\xa0\x06\x2b\x97\xc1\x6d\xbd\xb8\x93\x04\x25\x97\x3b\x83\xaa\x2c\xee\x3d\x04\x30\x77\x1f\xf2\xeb\x7e\xb7\x2f\xfc\x20\x0c\x9f\x11\x5c\x7c\x08\x17\xe1\x4e\x3d\x53\xb0\x5e\x1f\x38\xe0\xb8\x24\x87\x7a\xbf\x24\x22\xe4\xe1\x87\xa4\x07\x91\x57\xc0\x2c\xd2\xe4\xc3\x80\x33\xb1\xdf\x33\x1f\xaf\xc5\xa8\x08\xb5\xf8\x04\xfe\xa1\x88\x6b\x30\x09\x0d\x65\xb0\x81\xff\xe2\x2f\x50\xbe\xf7\x7b\xfa\x30\x6c\xca\xf7\x41\x5f\x1f\x7a\xbc\xf5\xd1\x94\x03\x69\x58\x7b\x93\xae\xc4\xc3\x1f\x72\xc9\xd3\xf5\x7f\xfd\x9f\xbf\xe7\x78\xff\xa8\x8d\xc2\xf8\x0f\xf9\xae\x2a\xeb\x72\xdf\x8c\x4d\x1f\x62\x2b\xff\x3b\x2e\xe9\xba\xa9\x7e\xfa\xf1\x37\x4f\x91\xfc\xbf\x1f\x87\x83\xec\x94\x3a\x15\x91\xad\xf8\xef\xaa\xf1\x1f\xbf\x9f\xb3\x9f\x26\xde\x40\xaa\xec\x9c\xf1\x93\x0b\xe2\x7f\x46\xdf\x10\x5d\x90\xb3\x40\xc7\xb6\xcc\x13\xc8\x34\xd7\x3b\x85\x29\x19\x10\x41\x2e\xbd\x42\x3d\xee\xc4\xf8\x0a\xf5\x90\xba\x00\x35\x64\xfe\xb8\x7a\xb4\x92\xfe\x7a\xf5\x88\x28\xf5\x78\x7a\x1b\xf5\x30\x27\x4e\x60\x79\xbf\xf7\xfc\xe9\x6f\x18\xe6\xe3\xb4\xfb\x35\x03\xf6\x32\x18\xbf\x14\xdf\xcf\x87\x9c\xfd\x39\xda\x1d\xb2\x2f\x55\x79\x1a\x01\xef\xd0\x02\x09\x17\x41\x06\x54\x40\x01\xd7\xe7\x57\xb6\x78\x40\xbe\x93\xd1\x01\xc4\xb9\x59\x7d\xe7\x27\x19\xf3\x11\xd1\x87\x30\xc2\xf3\x9a\x81\x59\x82\x88\x7d\x63\xef\x1e\xba\x19\xa2\xbf\x1f\xd8\x3e\xf4\xa6\x8d\xe8\xa4\x27\x2b\xf5\x27\x1e\xfb\x35\x56\xfc\x76\x43\xaa\x32\x58\xea\xef\x0f\xda\x70\xae\xb7\x19\x9b\x24\x99\x89\xba\xfc\xf8\xa7\x38\x9a\xae\x7e\x6c\x25\x12\x6d\x93\xfc\xe8\xad\x10\xd2\x7c\x97\x34\x6c\x16\x21\xe2\xd7\x01\x92\xc8\xdd\xc3\x9b\x60\xf2\x7c\xa3\xbf\x12\xff\xb0\xc1\xdf\xd9\x51\x5f\xc1\xfc\x77\x4c\xd4\x62\x40\x5c\x54\x71\x5f\xed\x41\x48\x1a\x14\xf9\x15\x55\x38\x1b\x67\xb2\x87\x10\x6d\x60\x6f\xa2\x4f\x04\x70\x42\xd9\xa8\x47\xf2\x0b\x39\x79\x6c\xcb\x09\xa9\xff\x69\xd5\xb5\x00\x09\x83\xb0\x4e\x58\x44\x45\x00\x27\x70\xbf\x62\x06\xa4\xd7\xa7\x6a\x00\xb1\x33\x80\xd8\x8d\x4c\x12\x97\x11\xac\xa8\x75\xc2\xb9\x40\x66\x3a\xf5\xdc\x0f\x1b\xf7\x1c\xa4\xca\x3e\xe7\x48\xcf\x1c\x4b\x15\xd3\xd6\x3d\x4c\xe9\x1d\xa0\x7c\xf5\x82\x2e\x24\x5a\x9c\x82\xb8\xc2\x38\xbd\xde\x1b\xd7\xbb\x2a\xcb\x4e\x72\x7b\x1c\x1e\x52\xf8\xc7\x32\x98\xfa\x74\x82\x95\xe2\x34\x82\xb1\x1e\x33\xed\xc5\x22\x79\xfa\x86\xd6\xf1\xd7\x33\x8a\xed\xa6\x50\xa9\x5e\xa5\xcc\x9a\x0a\x6a\xc6\x8e\x15\x50\x0a\x34\xa5\xa6\xc1\xd5\xbd\x6a\x77\xb3\x07\x69\x46\xfa\xa5\xb0\x67\x5b\x26\xa3\x58\xc3\x0e\x20\x69\xe7\x24\x14\xff\xe4\xb6\x4d\x2a\xb7\xa1\x2e\xd2\x60\xbb\x82\x2d\x64\xf6\xf9\x37\x0d\x63\xfe\x36\x00\x8c\x29\x09\xb3\x37\xd5\x68\x5f\x5c\xd8\x52\x45\xc3\x81\xe2\x00\x3c\x00\x34\x20\x69\x31\x3a\x94\x55\xfe\x57\x5e\x51\x0c\x52\x83\x32\x28\xd7\x0d\x44\xfc\xc7\xa9\x92\x05\x1e\x4f\xda\x40\x34\x1a\xf7\xfc\x91\x6e\xe7\x95\xf9\x80\xf2\x68\x97\x0f\xa8\xca\x34\xe0\x29\xf9\xa2\xeb\xf9\x4f\xa7\x98\x9f\x56\xd2\x07\xeb\x1d\x10\xaf\x18\x80\xfb\xfd\xf9\x85\x3e\xa8\x0f\x63\x2a\xcf\xc9\x8b\x6d\x2f\xff\xb0\x55\xfa\x64\xbf\xad\x37\x25\x1a\xc8\x04\x4a\xe5\x4f\xe5\x30\xbd\xa7\xf0\xdc\x58\xe9\x1d\xda\x0a\xf4\x30\xd0\xb9\x0e\x15\x23\x15\xa4\x87\xf0\x31\x39\x63\x22\x75\xc4\x48\x88\x0b\x15\x8c\x2f\x09\x8f\xf7\x21\xa3\x1d\xe6\xaa\xcf\x46\x9c\x11\xfc\x15\xc0\x03\x33\x0e\xc2\x91\xa8\x73\x70\xfe\x27\x2d\xcf\x08\x39\x67\x55\x47\x66\xcb\x76\x73\x1f\x49\x74\x4e\xac\xff\x4b\x7e\x3c\x97\x55\x93\x9c\x9a\x9b\xf3\x2a\xa2\x04\xe0\x3f\xdd\xfa\x43\x9e\x5a\x69\x73\x3f\xe4\x56\xd6\x87\xf2\xab\x4f\x95\x5b\x9b\x9f\x44\x48\x93\x3f\x82\x08\x43\x9b\xb7\xb1\x70\x6f\x02\x39\x37\xfe\xeb\xe8\x53\x34\x48\x36\xe1\xd7\xb8\xe0\x6b\x75\xe0\xf8\xc3\x6f\x77\x3c\x12\x24\xbb\x21\x09\x4f\xf6\x4c\xd1\xc0\x31\xd5\xdb\xbf\xf2\xd3\xc9\x5f\xf2\xec\x2b\x07\x53\xbe\x2b\xcd\xbe\xe4\xbb\x4c\x3a\xd9\xdb\x58\x8d\x67\x54\xbc\x0c\xcd\xef\x63\x6a\x7f\xd7\x47\xfb\xfb\x5b\x4d\xf6\x6e\xd1\x48\xc1\x0e\xdd\x12\xb9\x8e\x43\x8a\x20\xec\x31\x45\x4a\x60\x6b\x53\x04\x61\xeb\x23\x52\x02\x5b\x9b\x22\x08\xfb\xad\x46\x4a\x60\x6b\x53\x04\xd4\x17\xb0\xc3\x9c\x5a\x34\x47\x2d\x96\x8b\xa5\x58\xcc\x20\xac\x84\x4a\x26\xec\x0f\x06\x28\x2a\x5c\xc0\x8a\x84\x1a\xb1\xa9\xe7\x42\xa6\x0e\xe4\xb0\x39\xd0\xed\xf8\x0b\xa0\x4e\xc3\x5e\x23\xc1\xa6\xf2\xdd\x38\x24\x5b\xc1\xd2\xff\x41\x2c\x80\x20\xb7\x10\xc5\x08\x96\x9c\x6a\x21\x6a\xfa\x59\xad\x26\x5e\x3f\xf5\xb1\xa7\xec\x1c\xc0\x16\xd9\x41\x28\x5a\x76\x6c\x2e\x3a\xb2\x0b\xda\x91\xb2\xbb\x7b\x7c\xbd\x25\x7a\x3f\xe6\xfe\x72\x7e\x14\xf7\xc3\xd2\x97\x27\x8b\x61\x3f\x93\x09\xdf\x49\x3a\x1d\x1d\xd3\x9e\xe2\x77\x00\x5b\xc4\x0f\xa1\x68\xf1\x33\xb3\xec\x88\x3f\x68\xd7\x2d\xfe\xde\x03\xbc\x5f\xfe\xfd\x51\x3f\xa0\x00\xf7\x22\x7f\x58\x03\x26\xe2\x80\xae\x83\xb2\x78\xe9\x29\x6b\x07\xb0\x45\xd6\x10\x8a\x96\x35\x73\xc7\x8e\xac\x83\x76\xdd\xb2\x46\x86\x72\xbf\x54\x31\x24\x0f\xc8\x8f\x46\x73\xaf\xa4\x02\xdb\x2f\x17\x45\x2d\x2b\x93\xbb\xed\x89\xc2\xe8\x58\xd7\x6e\x8c\x1d\x0a\xaa\x50\x3a\x33\xb6\x1b\xa5\xe1\x99\x6a\xec\xa8\x00\x6c\x6c\xd8\x7a\xae\xf2\x53\xd3\xb1\x26\x91\x30\x44\x93\x76\x1d\xf7\x61\x5b\xd4\x1c\x01\xa4\x35\x5d\x00\xbb\xca\x8e\xb5\x86\xfa\xee\x03\xf7\x5a\x8c\x61\x03\xef\x9a\x11\x00\x1a\xa8\xfe\x1d\xfd\x74\x4e\x1a\x14\xfe\xf1\x71\x3d\x32\xbb\x14\x22\xa5\x6f\xad\xba\x74\xfb\x2f\x9f\x7e\xfb\x9b\x41\x5d\x5e\xaa\x5d\xf6\x87\xe4\x7c\xce\x4f\x2f\xff\xe7\xff\xf1\x1f\x3f\x6d\xd9\xae\xb3\x66\x9b\x97\xf3\x98\xe9\xf1\x78\x57\xd7\xe3\x63\x72\x1e\xfc\xf6\xd3\xff\x1b\x00\x00\xff\xff\x6e\xeb\x38\xf5\xac\xd9\x01\x00")
-
-func _staticCssBootstrapMinCssBytes() ([]byte, error) {
-	return bindataRead(
-		__staticCssBootstrapMinCss,
-		"_static/css/bootstrap.min.css",
-	)
-}
-
-func _staticCssBootstrapMinCss() (*asset, error) {
-	bytes, err := _staticCssBootstrapMinCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/css/bootstrap.min.css", size: 121260, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticCssBootstrapMinCssMap = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\xbd\x5b\x77\xe2\xba\xb3\x38\xf8\x5d\xf6\x2b\xd9\x7f\xcc\xdd\xfc\xe7\xc9\x92\x8c\x01\xe3\x10\x3a\x9d\x4e\xa7\x67\xcd\x03\x4d\x88\x31\xe6\x6e\x1b\x03\xb3\xe6\xbb\x4f\x95\x6e\x16\x86\x74\xf6\xfe\x9d\xb3\xce\xac\xe9\x87\xb4\x29\x97\x4a\xa5\xaa\x52\xa9\x24\x95\xe4\xff\xfb\xaf\xe3\xfc\x90\x44\xdb\xcd\x5f\xff\xbb\xf1\xf0\x57\xb2\xcd\x0e\xb3\x79\xf2\xd7\xff\xfe\x3f\xff\x5a\xcd\x93\xa4\xba\xd9\x1e\xd6\xd3\x55\x74\x99\xff\x2f\xfc\xf9\xd7\x83\x80\xee\x0e\xd1\x26\x55\x90\xdf\xdb\x6d\x9a\xa4\x87\xe9\xee\x7f\xcd\xf8\xef\xf7\x28\x49\xab\xf0\x58\x2d\xbf\xe0\x45\xc3\xd5\x79\xb7\x88\x66\xdb\x4d\x72\x45\x31\x99\x4d\x3f\x3e\xb6\xab\xf7\x68\x13\x5e\xc1\xd7\xd1\x29\xda\x24\xd5\xe3\x7c\xf3\xbe\x3d\xfc\xbd\x3b\xcc\x3f\xa2\xd3\x3c\xb9\x87\x92\x4e\x7f\xff\xfd\xb1\x9d\x65\x77\x5f\x46\xeb\x69\x78\xdd\x84\xf4\xbc\x9b\xdf\x25\x33\x3f\xa5\x7f\xcf\xd7\xbb\xc5\x34\x89\xee\x92\xfa\x3d\x9d\xc5\xe1\x61\x9b\x6d\xde\xff\x3e\x4e\x0f\xd1\xb4\x10\xc4\x0d\x99\x2d\x48\xf6\x63\xb5\xcd\xaf\x10\x66\xdb\xf7\xeb\x8a\xc3\x43\xf4\x7e\x8f\xc4\x9f\xe0\x7f\x7f\x1c\xa6\xeb\x79\xbe\x3d\xc4\xd7\x8d\x9a\xfe\x5e\x7d\x2a\x9d\xd5\xfc\xef\x43\x89\x97\x0f\xd0\xee\x5d\xf4\xdb\x17\xbf\xb3\x34\x2d\x2b\x4d\x49\xe4\xf3\x57\xdb\xdd\x74\x16\xa5\xe7\x92\x00\xd6\xbb\xed\x66\xbe\x49\xff\x9e\x6e\x40\x2f\x69\x54\x2e\xfb\x7e\xd8\xee\xde\xb7\xf9\x7d\x8a\x9b\xe9\xf1\xef\xf7\xe8\x18\xbd\xcf\x0f\xf7\x5e\x1f\xe6\xc9\x3c\xfd\xfb\x23\x5a\xa5\xa5\xf7\x82\xc9\xbf\x51\x73\xbb\xfb\xad\xd8\x1e\x80\xe6\xdf\x87\xe9\x7b\x54\xb2\xa1\x68\xb3\xcb\xd2\x7b\x25\x81\x97\x1b\xc0\xef\xe9\x5d\xbe\x90\x6d\xb0\x86\x34\x9a\x4d\x57\x7f\x43\x87\x0a\x37\x57\x58\x59\x1a\xad\xa2\x34\x2a\xe9\xee\xf7\x61\x3e\x7d\x9f\x1d\xb2\xf5\xef\x6b\xf8\x6e\x1a\x46\x1b\x2e\xb8\x7b\x55\x7d\xf2\x16\xc0\x25\x91\xac\xa6\xbf\xe7\xab\xbb\xb2\xb8\xf3\xe6\xf7\xf4\x3d\x2c\xb1\xb7\x04\xc6\xb6\xe9\xa1\x54\x4f\xba\x00\xf0\x66\x1a\x95\xca\x4f\x57\xd0\xfa\xbb\x95\xdd\x79\xb3\x3b\x6c\x43\xd0\x64\xf2\x37\x48\xf3\x6e\x99\x10\xd5\x04\x26\x74\xf7\xa5\x59\xfa\xfa\xfd\xfc\x3d\x9a\x5e\x8b\x00\x7c\x95\xd0\xec\x5d\x31\xdc\x7f\xbb\x9b\x6e\x3e\x91\xdb\x9d\x37\xc0\x08\x58\x7b\x12\x1d\xe7\xe0\x55\x7e\xcf\xaf\x7b\x74\x3e\x5f\x95\xd0\x67\xab\x6d\x52\xf2\x4b\xdb\xf7\x69\x09\x29\xdd\x6e\x57\x69\x74\x97\x65\x61\xff\xe8\x7d\xae\x59\xde\xee\xd0\x19\x95\xea\x9a\x42\xd3\x92\xf9\xea\x1e\x9d\xd9\x6a\x3e\x3d\x80\xaf\xbd\xfb\x0e\x04\x0f\x3d\xe5\xf7\x6a\x3b\x8b\xef\xbd\x5f\x40\xe7\xbc\x65\xc1\x10\xc4\x7d\x6b\x2f\x5a\xa0\xf0\x8e\x51\x12\xfd\x46\x54\xe9\x40\xfe\xaf\x87\xbf\x36\xe0\xf6\x70\x74\x82\xc7\xf5\x74\xb7\x83\xe1\x02\x7e\xfd\xf5\x7f\xc0\xbf\xa6\xeb\x4c\x9c\x07\xdf\xa1\xee\xc3\x9b\xe3\x38\x0f\xaf\x0e\x75\x1e\xce\x04\x1f\x7d\x7c\xdc\xcb\xc7\x71\x4f\x20\x8d\xf1\xa7\xeb\x4c\x7b\x0f\x13\x7c\x0a\xf0\xcf\x44\x14\x84\x3f\xe3\x3b\x7f\x7c\xfd\x67\xa0\x91\x27\xce\xd4\x55\x04\x26\x3d\x41\x85\x23\x3f\x0b\xd8\x40\xbe\x9d\x22\x0b\x73\xf1\x02\xf0\x12\x82\x2c\x4c\x34\x73\x92\x19\x78\xf3\x2c\x70\x5c\xfd\xf6\xa5\x07\x2f\x00\x39\xe2\xfc\xbf\x69\x9c\x89\xc6\x71\x11\xe7\x0d\x71\x66\x9a\xb9\x31\xb6\xd3\x15\x4f\xae\x94\xc8\x00\x61\x03\xc4\x93\x4d\x04\x52\x9e\x16\x45\x20\x48\x01\x33\x2f\x1a\x99\x0b\x2a\xd0\x5c\xbe\x6a\x09\x06\xf8\xe2\xc5\x20\xca\x9f\xa0\x22\xc9\x7e\x41\x85\x3a\xa2\x72\xd7\x10\x80\x27\xd9\x18\x88\x7a\xd5\x4f\x29\xe9\x17\xf3\xa7\x8b\xe4\xe7\xf8\xf3\x59\xbf\x1d\xeb\xb7\x03\xcd\x90\x67\x94\x80\x3f\x6b\x29\x2c\xf8\x13\xca\x47\xc5\xff\x9b\x96\xc2\xb3\x61\x10\x8a\x98\xa4\x38\x90\x22\x7b\x11\x7f\x8a\xe6\xc4\xa4\x67\x88\xeb\xd9\x50\xb7\xaf\xeb\xa7\xd2\x4a\x26\xf8\x24\xed\x6a\x2c\xd9\x96\xaa\x7b\xe9\xa9\xd6\x82\xcc\xe6\xa2\xce\x57\x09\xcb\x39\xc3\x31\x91\xed\x00\x56\x44\x6b\xc6\xda\x50\x38\x39\x61\x0f\x60\xd5\xae\x6b\x40\x85\xc1\xe7\xc4\x30\x8e\x2b\xf3\x0a\x74\xff\x18\x63\x85\x7b\x55\x97\xab\x6a\x79\x2d\x64\xf6\x6a\xfc\xa1\x8e\x22\xf7\xdc\x7b\xb0\x29\x3e\xc2\x5f\x55\xb5\x8f\xa4\xd7\xdc\xa6\xd7\x85\xb8\xc3\x42\x09\xaf\xfa\x49\xbc\x7f\x41\x32\x11\x2b\xc8\xac\x8b\xde\xf9\xac\xbb\x46\xd1\x9b\x02\xa3\x15\xca\xac\x0a\x0b\x08\xb4\x39\x8f\x75\x59\xb3\xdd\x8a\xe8\xb3\xe6\xf5\x59\x8b\x61\x80\xba\xe0\x52\x99\xeb\x62\x82\x71\x6e\xa7\x1e\x3e\x79\x5a\x9a\x59\x8f\x2e\x01\x7f\xea\x74\x7a\x03\xd5\xbd\xb8\xe8\xbd\x81\xe0\x62\xae\x0d\x7e\xae\x2d\x6e\x56\xb4\xbb\x00\xce\x1d\x6f\x28\x28\xbc\x38\xee\x40\x56\xf9\x82\xc0\x99\x43\x07\xaa\xf3\x2b\xd1\x0d\xf0\x05\xa8\xe9\xea\x8d\xe4\xdf\x1f\x3e\xb4\x95\xb1\xb8\xf2\xbd\x87\xf8\xb2\x24\xc0\x4a\xa2\xf2\x69\xd3\x1f\x49\x0b\x0b\xd8\xd1\xf7\x1f\x02\x6a\x01\x64\x22\xa8\xb0\x03\x40\x06\xae\xed\x3f\x3e\x78\x6c\x44\x35\x22\x07\xd3\x1c\x10\x79\x67\x98\xd3\x17\xf6\xe0\xf5\x5a\x7e\xf0\xe0\xb9\x75\x40\x76\x7b\x35\x78\x16\xd2\xa7\x19\xa0\x09\xf1\xd3\x67\x44\x6b\x20\x1a\x4d\x00\x1a\x49\x72\xd5\xa1\xff\x30\xa1\xb1\xaa\xd7\x47\xbc\xb7\x5e\xea\xbf\x3c\x6c\x09\x8d\xfc\xef\x12\x71\xce\x3a\x80\x38\xa6\xa1\x22\x68\xb4\x83\xb5\xf8\xab\xca\x70\x24\x05\xb8\x26\x48\xe5\xa5\xb7\x03\x2a\x2f\x00\x2f\x88\x5c\x86\xcf\xf0\xd6\x8d\xfd\x1f\xd8\x42\x68\x55\x89\x56\x48\xdc\xe5\x13\x13\x96\xce\x05\x9b\x91\x5e\x02\x00\x8e\x51\xa1\x6e\x67\x4c\xf1\xd9\x7f\x48\xc0\x6a\x33\xf6\xd0\x04\xba\x6d\xc2\x6d\x38\x66\xbc\xf6\x88\x3c\x84\xf0\x2b\x62\x68\x49\x21\x81\x42\xf0\x1f\xe3\x8a\xdf\x92\x87\x3a\xe8\xba\x89\x1e\xda\xed\xbc\x8a\x5a\x0a\x57\x39\xd0\x36\x5d\x8c\x15\x92\x07\x6d\x2f\x63\x0d\x34\x7d\xea\x20\xa6\x88\x24\x8c\xe1\x55\xba\x0e\xf0\x57\x3f\x1f\xea\x04\x01\x72\x8c\x72\x7f\x02\x16\xcb\x9e\x56\x14\x21\xcf\x20\xf6\xf4\xe9\x4c\x60\x14\xea\x45\x4f\x1b\xa4\x00\x6d\xb7\xa9\xc2\x86\xd2\xb9\x59\xfa\xce\xef\x7a\xe9\xf7\xf9\x0b\xfc\xf2\xef\xaf\xf0\xb3\xd2\xef\xf6\x17\xef\xcb\xbf\xcb\xfc\xed\x4b\xbf\xad\x2f\xde\x97\xf9\x2b\xe3\x97\xe9\x27\x5f\x94\x2f\xbf\xff\xaa\xbd\xff\xf6\x77\xe5\xbf\xa8\x1f\xfb\xbf\xd8\xfe\xaf\xf8\x2b\xeb\xaf\xfc\xbb\x5c\x7f\xf3\x5f\xf2\x53\x96\xef\xbf\xb5\x8f\xaf\xec\xf3\x2b\x7a\x65\xfc\x72\x7b\xbe\x6a\x7f\x59\x7f\xe5\xf2\x21\xfd\x73\xfd\x65\xfc\x32\xbd\x88\xfe\x59\x7e\x65\x7e\xfe\x6d\xfb\xbf\xea\xef\x65\xfc\x72\x7b\xca\xbf\xbf\xb2\xaf\xaf\xe8\x97\xdb\x57\x96\x47\xf9\x77\x99\x5e\xf9\x7d\x99\xbf\xb2\x7d\x7e\x65\xcf\x65\x7e\xfe\x2d\xbd\x7f\x8b\xff\x15\x3f\x65\xf9\x96\xf1\xcb\xf4\xbf\xb2\xaf\xf5\x17\xf6\xf9\x6f\xfb\x63\xb9\xfe\xaf\xf4\xf5\x95\xbf\x2b\xcb\xbf\xfc\xfe\xab\xfe\xf8\x95\xfe\xbe\xea\xbf\x71\x09\x7f\x4b\xff\xec\xbf\xbe\xb2\xff\xaf\xfc\xe5\x57\xfe\xa7\x8c\x5f\x6e\x5f\xf9\xfd\xfe\x0b\xfe\xbf\x6a\xdf\xbf\x1d\xff\xcb\xef\xbf\xb2\xf7\xf2\xfb\xaf\xe4\xf1\x95\x3f\xf9\x6a\x7c\xf9\x6a\xbc\xb9\xe9\x0f\x5f\xf8\xbb\xb2\xbc\xca\xf2\x3e\x7f\xd1\xff\xcb\xf2\x2a\xd3\xff\x6a\x7c\xff\xaa\xfd\xff\xdd\xe3\x43\xb9\xfc\x57\xf4\xbe\xf2\x3f\x5f\xb5\xf7\xab\xdf\xe5\xfe\xf6\x6f\xfd\xc5\x57\xfe\xeb\xdf\xc6\xd3\xff\xd6\x1f\x7e\xe5\x0f\xbe\xfa\x5d\x8e\x0f\xca\xf6\x58\x7e\xff\xd5\x78\x5a\xc6\x2f\xc7\xd7\x5f\xf1\xf3\x47\xff\xf7\xfc\xb5\x3c\xcb\xf4\xfe\x6d\xfc\xf0\x95\x7d\x96\xdb\xfb\x55\xbc\xfb\x55\xfc\xff\x6f\xf9\xff\xca\x1f\x7e\x65\xcf\x5f\xf9\xb3\xaf\x7e\x97\xf9\xfd\xea\x7d\x99\xdf\xb2\x7f\x2c\xbf\xff\x6a\x7c\xf9\xca\x1e\xbf\xe2\xe7\xab\xf1\xa3\xfc\xbe\x5c\x7f\x99\xff\xa4\x1c\x5f\x7f\x35\x1e\x7e\xe1\xef\xcb\xed\x2b\xfb\xb7\x32\x3f\x5f\xc5\x2b\xff\xd6\x3f\xfc\x49\x3e\x34\x7d\xae\x52\xa8\x81\x86\x6a\x55\x6c\xd2\x8f\x2a\xa4\xf7\xe0\xb2\x47\xaa\x56\x11\xbd\xd3\x82\x0e\xd4\xb2\xc8\x6b\x2f\x8a\xe8\x2f\x5c\xc7\x78\x83\x37\xab\x0a\xf9\xf5\x30\x76\xd8\xe8\x61\x32\x0c\x2b\x64\xa8\x8b\xa4\x66\x11\x87\xee\x88\x5c\x7c\xec\x9f\x17\x94\x3d\xf8\xde\x61\x41\x29\x2e\x3d\xbd\x3e\xf8\xb8\xa2\xd1\xe4\xc5\x66\x8e\x2b\x57\xa7\xf9\x92\x88\x58\xee\xe1\xeb\x53\x81\x28\x9c\x61\x61\x0e\xf0\xbd\x0d\x52\x00\xbc\x29\xb0\xd4\x5c\xd0\xdf\x88\x33\xc6\xd2\x4f\xbc\x60\x4c\x1e\x7c\x17\x18\x5f\x11\xe4\x0f\x29\x00\xed\x71\x7f\x8f\x14\x9e\xbd\x6a\xa8\x0a\x83\x48\x7e\x0b\x92\x13\x5d\xf1\x04\x71\xdd\x5e\xbe\x10\x6b\xbe\xe3\x87\x89\x0b\xed\x51\xeb\x52\xbe\x33\xe8\x81\x98\xec\x10\x28\x4d\xfc\x4d\x0c\x94\x02\x5d\x4a\xae\xfd\x8d\x92\x18\xdf\x7a\x27\xac\x67\x82\xcb\x34\x3e\xae\x37\x8d\x1d\xfa\x2e\xc4\x2c\xd7\xa6\x1d\xdc\xd6\xe8\x5b\x48\x6a\x2e\x9b\x85\x25\xc6\xfd\x04\x41\xc5\x9a\xdb\x40\x2e\x6a\x86\xd0\x1a\xc6\x96\x04\x04\x16\x2c\x56\x14\x55\x37\x5a\xaf\x68\x0f\xea\xf5\x77\x2b\xb1\xb3\x00\x8d\x9d\xf7\x9b\xae\x23\xea\x0d\x1c\xfa\x5d\x8a\xac\x9f\x6b\xa2\xbe\xb7\xc4\x7a\xf8\xea\x94\xdc\x68\x70\xe5\x8a\x26\x67\x6f\x4a\xdb\x3d\x29\x7a\x90\xa8\xaf\x56\x86\xa9\x27\xb9\x77\x8f\xa3\x6f\xc6\xd6\x4c\x24\x05\x73\xbb\x92\xeb\x3f\x86\x6b\xa8\x54\xd4\xe1\xad\xb0\x52\xb9\x88\x06\x38\x03\xf1\xe7\xcd\xa1\x2d\x62\xec\x77\xf0\x3f\x6f\xfd\x2d\x72\xfb\x5a\x82\x7a\x9d\x2a\xa1\x62\x49\xba\xe0\xdb\xeb\x87\x1a\xd7\xf7\x4e\x88\x01\x2f\xe7\xc8\xd0\x87\xb1\x90\xff\x5a\x62\x0d\x8b\x3e\x5f\xaf\xde\x05\x02\xa5\x68\x87\x5e\x2b\xef\x5b\x55\xa2\x85\xb7\xc2\x2a\xc4\x86\x41\xaf\x5e\x25\x5c\x93\xc1\xc3\xcc\xbd\x54\xa1\x0b\xc8\x0a\x81\xb8\x45\xe4\xbe\x15\xd5\xdb\x0b\xaa\x0e\xbf\xbf\xad\x92\xbe\xa2\x77\xa9\x10\xbe\x88\x39\x41\x46\xbf\xc9\x8d\x85\x7e\xbd\x02\x35\x8a\x8d\x2a\xb4\x81\x99\xdc\x16\x99\x00\x47\x93\x1f\x82\xb7\x41\xb0\xd9\xf1\xcd\x17\xea\x98\x7f\x1e\xcf\x5b\xca\xf7\x51\x9c\xfb\x7f\x98\x43\xa5\xa5\x8f\xa1\xbc\xec\x09\x83\xc7\xe6\x0e\xfa\x82\x78\x76\x54\x87\x9a\x80\xae\xbe\x83\xe8\x7e\xa1\x39\x4c\x1d\xf9\x14\x74\x76\xd0\xbf\x5f\x1f\xed\x1d\x1d\xfe\xf9\x17\x0b\xe8\xc3\x2b\x1d\x31\xb5\xf3\xf2\x0b\x45\x31\xfd\xb7\x4f\xc1\x61\x27\x16\xf9\x91\xcd\xf3\x5e\xb1\xe9\x3a\x45\xbf\xf7\xbe\x83\x34\x2e\x7b\x2e\x8d\x61\x0f\x5b\xb3\xe7\xed\x45\xfb\xc0\x35\x62\x8f\x3e\x53\xe1\xb6\xc0\x2c\x7e\x29\x03\x79\x5e\xa2\x67\x7a\x84\x7a\x18\x45\x46\xd1\xbf\x04\x1d\x2c\xf9\xfa\x58\xd9\x53\xbd\x61\xf4\x88\x4c\x00\x7c\xf0\xf0\x82\xf0\x21\xfc\x6a\xf1\xd7\x8f\xf6\x9e\x6b\x77\xe2\x0a\x77\x36\x70\xbc\xdf\xc0\xc8\xe1\x50\x30\x92\x1f\xfe\x53\x46\x4e\x07\xce\x88\x75\x28\x31\x72\x39\x70\x46\xea\x07\xce\xc8\x91\xbf\x7e\x3c\xe3\x7f\xfc\xdd\x8b\xde\xb0\xfb\x21\xec\xdd\x73\x9c\x17\xdd\x9b\xff\x9b\x61\xe3\x6f\x00\x63\x60\x6d\x4f\x59\x22\x7c\xba\xb4\x57\x37\xa8\x25\x57\x1b\x79\xae\xee\x75\xc1\xd3\x3e\x51\x5e\xc1\x1f\x2f\x92\x62\x2c\xc8\xc7\x3f\x95\x05\xd6\xc4\x23\xb4\x03\x29\xf7\xa1\x1f\x8d\xbb\x07\x74\xb6\x8f\x59\x42\xa5\xb4\x83\x60\x95\xa8\x3e\x1e\xe0\xce\x8e\x6c\xb8\xdf\x83\x3a\xea\x88\xed\x8f\x1b\x07\x9a\x10\xd1\x8b\xfd\x27\xfb\x40\x53\x22\xfd\xd5\x64\xdc\xe1\xaf\x5e\x9f\xc2\x04\xa1\xc2\x7f\x8c\xab\x1c\xf8\xf6\x14\x17\xc0\x60\xbc\x4c\x10\x38\x7d\xda\x16\xc0\xf1\x78\xc3\x81\xb3\xa7\xa4\x00\x4e\x24\x70\x2a\x81\x62\x7b\x6e\x7c\xe0\xc0\x90\x3c\xe5\x1c\xca\x7d\xfd\xcb\xf8\xa8\xa0\x96\x09\x4d\x51\x1c\x11\x79\x3a\x27\x6a\x54\x78\x9d\xb4\xce\xd8\x4b\xbf\x55\xce\x00\x0a\x0a\x8f\x30\x3e\x5c\xe8\x40\x8d\x3e\x7d\xdc\x02\x64\xb9\x87\xff\x3d\xd9\x89\x1e\x96\x04\x66\xeb\x06\xb3\x2e\x30\xe3\xf4\x0a\xf3\x75\xbc\xb1\xae\x30\x63\xc2\x6c\x0f\xff\x7b\x4a\xd2\x12\xcd\x9a\x55\xa6\x19\xf1\xff\x9e\xac\x6b\xcc\xe9\x78\x59\xd3\x98\x2c\x07\x9c\x2d\x71\xf8\x5f\xf7\xd4\x77\x15\x05\x1c\x7d\xbf\xd5\x6b\x6a\xcc\xf5\x27\x97\x1a\x55\x3b\x4c\x9c\xfe\x9a\xf4\x6c\xfe\xdf\x53\x33\xe5\x22\xd2\x15\xbc\x4d\x16\x75\xa8\xe0\x1a\x37\x1a\x70\xdc\x30\xbb\xc6\x7d\x9e\x1c\x4b\xb8\x21\xe9\x6d\x07\xf8\xdf\xd3\x36\x2b\xd3\xed\xde\xd2\xcd\x04\xdd\xbc\x84\xfb\x3a\x39\x34\x0a\xdc\x5e\x7b\x80\x32\xc3\x8e\x21\x76\x64\x25\xfc\x29\xcb\x28\x0a\xa4\x8b\x2d\x10\x03\xfa\xe0\x11\x60\x0b\x22\x5c\x3b\x0f\x13\xa0\xa3\xb8\x0f\x7e\x70\xc8\xc0\xb4\x67\xe5\x41\xab\x87\x5d\x6d\x8d\x95\x17\x8e\xc4\x2d\x1c\x49\x50\x4d\xa1\x14\x18\x7f\x8a\xa6\x32\x6e\xf1\xff\x9c\x27\x94\xfe\x73\x4f\x50\x73\x31\x80\x99\x3b\x23\x17\xc6\xff\xab\xb1\xd1\x47\xba\xc8\x5d\x35\xe5\xc1\x81\x33\x83\x97\xfe\x2f\x61\xc4\x46\xd6\xc0\x93\x9d\x42\x7f\x0c\xc9\xf8\x94\xaa\xf0\x66\x8a\x61\xc6\x4c\x8f\x99\x53\x39\x36\x03\xaf\x8f\x51\x26\xb2\x14\x80\x4d\x64\xee\x97\xec\xf6\x8e\x87\x7e\x84\x3b\x8e\x7a\x4a\xe5\x78\xf4\x3a\x3e\xe2\xb3\x87\x16\xc4\x64\xef\x1f\xef\x36\xf0\xec\x39\xc7\x5e\xa0\xb6\xca\x5c\x35\xaa\xc6\xc4\x4b\x1f\x87\x46\x5a\x43\x20\x24\xa5\x07\xdc\x1f\x49\x5b\x99\xa2\x3f\xee\x6e\xa8\x14\x58\xe0\x2c\x7a\x3f\x04\x23\xcf\x4f\x11\xb6\x46\x76\xd4\x23\x76\xba\x98\x3c\x02\x4c\x8e\x37\xe3\xa0\x91\x50\x70\xce\x16\x11\x0e\xee\x4d\x74\x50\x19\x11\x75\x07\x7b\x72\x95\xaa\xc1\xa1\xeb\xe1\x91\x88\x2c\x8e\x22\x73\x42\xf4\x6e\x64\xfd\x15\x09\x31\xe9\x91\x04\x67\xe0\xc5\xbc\x1b\x4f\xf9\x58\x4f\x60\x14\xe7\x7e\x6e\x8c\x81\xbb\xf4\x8b\x0e\xef\x49\x01\x1a\x48\x4a\x2f\x04\x24\xf1\x14\xa2\xd0\x72\x32\x5e\xa0\xd4\xce\xe4\xd1\x4a\x45\x02\xc9\x1c\x44\xbe\x4b\x60\x8c\x04\x1b\xec\xf1\xdc\x00\x1e\xac\x3d\xad\xd1\x9f\x4e\x84\x67\x5b\x60\xd5\x82\xcd\xc7\x33\x56\x28\x62\xea\x62\x64\x0d\xc0\xf7\x7d\xc7\x5a\x9a\xe8\x4f\xc1\x0b\xb7\x0e\x50\x8b\x91\x96\xc0\xc3\xf6\x2d\xa7\x9d\x10\x1d\x99\x71\x5f\xcb\xa4\x89\x8d\x61\x24\xf3\xa4\x2f\x7d\x8c\xb0\x16\x6e\x5d\x46\x63\x1c\x7a\x22\x72\x50\xc7\x0a\x71\x5a\xf4\x08\x6c\x0e\xe1\x7f\x6c\xc2\x1c\xa7\x51\x7c\xf2\xf5\xb8\x45\xe8\x96\xe2\x50\xba\xa6\xf4\x45\x1a\xa0\x87\x23\x01\x4e\xb6\xf0\x3d\x34\x92\xe2\xe8\x13\xe0\x64\x6b\x0c\xb3\x12\x0e\x85\x6e\xcb\x4b\xc5\x54\x0c\x24\x82\x79\x14\x06\x28\xe3\xf5\x54\xa1\x2a\x6c\xfc\x11\x55\xa1\x0f\x71\x43\x19\x63\xcf\x12\x12\x79\xe9\x56\x40\x26\xfe\x4f\xab\x02\xf8\x83\xd7\x94\xff\x87\x3a\x2e\x66\x2c\xa5\x94\xa1\x99\x56\xb9\xaf\x03\x49\x11\xcb\xfe\xcc\x2b\x6a\x26\x33\x78\xdd\x55\x84\x89\x62\xb0\x25\x67\x2f\xd2\x1f\x16\x21\xee\xa0\x44\xa0\x48\x63\xf9\xd1\xae\xa0\xdd\xbf\x74\xf0\x3f\xff\x67\x82\x84\x65\x3c\xfe\xda\xd2\x6d\x1a\x38\xcf\xbf\xe5\xe4\x2c\x30\xc6\x62\xf5\x14\x16\x9b\xd3\x9f\xbc\xc6\xf9\xc4\x0f\xbb\x02\x42\x9c\xa0\x20\xdc\x07\x17\xab\xea\xcb\xf1\xfe\x75\x59\xd1\x61\x24\x5a\xce\x9a\x94\x5c\xc6\x75\x1b\x82\xeb\xa7\x9b\x10\x81\x16\x73\x0f\xc7\x98\x66\x94\xb2\x97\x5e\x7e\xae\xb5\x14\x5f\x5f\xbb\x15\xe9\x8f\x63\x1c\xec\xe7\xc2\xa1\x5e\xbb\xce\x2b\x89\x3e\x1b\x79\x30\xaa\xbe\xc9\xcf\x18\xdb\x24\x06\xa4\xd7\x4e\x57\xb5\xe9\x19\xdb\xa4\x92\xb6\x14\x15\x97\xd6\x18\x8e\x1a\xb4\xef\x2a\xc9\x15\x1b\xfc\xd8\xcd\x7f\x57\x16\x4c\x05\x39\x6f\x97\x05\xa3\xa2\x33\xf8\xce\xde\x75\x84\xfd\xfb\xce\xd1\x55\xee\xf5\x57\x18\x31\x0c\x72\xde\x1a\x88\xf9\xea\xc4\xae\x2b\x8c\x62\xfc\xcb\xee\x42\xe0\x05\x6f\x3a\xe2\x4d\x85\xe9\x37\x20\x80\x1f\xe0\x99\xde\x6a\xfc\x0d\x3d\x12\x9d\xa4\xe4\x61\x47\xff\xbd\x5d\x16\x1c\x1c\x25\x07\x50\x2d\x20\xbe\x2b\x66\x94\x84\x7d\xea\xb8\x22\x49\x6c\x2a\x1a\xe3\x6a\xa3\xf6\xa4\x78\x5e\xaf\xff\xbc\xfc\x9b\x3f\xff\x3f\x2a\xfb\x1e\x2d\xd9\x48\x4e\x0a\x67\xcb\x88\x79\x6a\xe6\xa8\xb2\xf2\xfc\xf7\x75\x04\x18\x42\x80\x33\xd0\x97\xf7\x9f\xd7\x65\x2f\x98\x34\x38\x7f\xd6\x45\x4a\xaf\xef\x51\x54\x80\x44\xf5\xef\x5b\x0d\x9a\xce\x76\x02\x94\x19\xa0\x23\x82\x5e\xde\x2d\x0d\x1a\xcc\x6a\x02\xd4\x36\xb0\x3a\x02\x14\x2e\x0b\xd0\x62\xc9\x41\xeb\x65\x51\x70\x23\x40\x89\x81\x95\x0a\xd0\xd9\x00\x5d\x04\xa8\x69\x14\x6c\x09\x50\xe5\x4f\xe4\xdf\x04\xf9\x90\x18\xf4\x7d\x41\x1f\x60\x37\x15\x00\xac\x69\xc0\x78\x0d\x73\xa3\x86\xc1\xac\x2a\x40\x71\x5c\x60\xad\x62\x0e\xda\x1b\xa0\x83\x00\xe5\x71\x51\xf0\x24\x40\x75\x03\xab\x21\x40\xb6\x01\xea\x0a\x50\xb4\x2a\x0a\x2e\x57\x1c\xb4\x5d\x15\x58\x55\x81\x15\xaf\x8a\x66\x2e\xd7\x02\x6b\x5d\xb4\x72\xb7\x16\x2d\xca\x10\x26\xc6\xfd\xd9\x51\xc2\x2c\x0d\x9b\xce\x6a\x12\xd6\x36\x60\x1d\x41\x2e\xdc\x28\xd0\x60\xb6\xd8\x70\xd0\x7a\x53\x60\x6d\x04\x28\x31\x40\xa9\x00\x9d\x8d\x82\x17\x01\x6a\x1a\x58\x2d\x01\xaa\x18\xa0\xaa\x00\xc5\xdb\xa2\xe0\x6a\x2b\x04\xbb\x2d\xb0\x16\x02\xb4\xd6\xa0\xb7\xd9\x4e\x14\xcc\x36\x46\x2b\x11\x14\x43\x2b\x11\x26\x9c\xcd\xac\x26\x61\x6d\x0d\x83\x56\x4a\x58\xb8\x2d\x60\xbc\x86\x88\x88\x2a\xde\xa4\x89\x4a\x58\x62\xe0\xa5\x12\x76\x36\x60\x17\x09\x6b\x1a\x65\x5b\x12\x56\x31\xf0\xaa\x12\x16\xef\x0a\xd8\x6a\x27\x60\xfb\x5d\x51\xf6\x20\x61\xb9\x81\x77\x92\xb0\xba\x86\xbd\xcd\x3a\x07\x0e\xd3\x79\x89\x2e\xc6\x20\x67\xa2\xe3\xc1\xff\xc4\x4f\xd4\x0f\x4c\x4d\xfd\x66\x8d\x03\x7a\xfb\x77\xdb\x00\x75\x05\x28\x4a\x14\x68\x3a\x5b\x26\x1c\xb4\x35\x40\x3b\x04\xbd\xbc\x67\x1a\x34\x98\x1d\x05\xc8\x32\xb0\x6a\x02\xd4\x36\x40\x1d\x01\x0a\xd3\xa2\xe0\x22\xe5\xa0\x75\x5a\x60\x6d\x04\x28\x31\x40\xa9\x00\x9d\x8d\x82\x17\x01\x6a\xa6\x7f\x20\xff\x26\xc8\x43\x2f\x28\xe8\xfb\x82\x3e\xfa\x8e\x72\x05\xe8\x3b\x0c\x18\xaf\x61\x6e\xd4\x00\x6a\x17\xa0\x8a\x81\x55\x15\xa0\x38\x2b\x40\xab\x8c\x83\xf6\x59\x51\xf0\x20\x40\xb9\x81\x75\x12\xa0\xba\x01\x6a\x08\x90\x6d\x14\xec\x0a\x50\x74\x2c\xb0\x5a\x02\x54\xc9\x8a\x66\x76\x8f\x02\x2b\x2f\x5a\xb9\xcc\x45\x8b\xb6\x08\x93\x3d\x68\x27\x61\x99\x86\x81\xd3\x97\x30\xcb\x80\xd5\x72\x4e\xae\xad\x41\x83\x59\x47\x80\xc2\x53\x81\xb5\x38\x71\xd0\xda\x00\x6d\x04\x28\x39\x15\x05\x53\x01\x3a\x1b\x58\x17\x01\x6a\x1a\xa0\x96\x00\x55\x8c\x82\x55\x01\x8a\xcf\x05\x56\x47\x80\x42\x0d\x02\x0f\x29\x40\xdb\x93\xd1\x4a\x04\x41\xff\xcf\x10\x26\xfd\xc4\x51\xc2\x2c\x0d\x83\x56\x4a\x58\xdb\x80\xf1\x1a\xa0\x1f\xf2\x2a\x64\x7f\x5d\x9c\x05\x6c\x7d\x2e\xf0\x36\x12\x96\x18\xb0\x54\xc2\xce\x46\xd9\x8b\x84\x35\x0d\xbc\x96\x84\x55\x0c\x58\x55\xc2\xe2\x4b\x51\x76\x75\x11\xb0\xfd\xa5\xc0\x3b\x48\x58\xae\x61\x6f\x6f\x55\xa4\x13\x11\xb7\xa1\x1c\x85\x87\xf9\xcc\xff\x45\x47\x71\xae\x15\xa6\x74\xa9\x71\x17\xd0\x34\x40\x2d\x01\xaa\xd4\x8c\x8e\x20\x40\x71\xdd\xe8\x08\x75\xde\x21\xf7\x75\xa3\x23\x08\x50\x6e\x60\x9d\x04\xa8\x6e\x80\x1a\x02\x64\x1b\x05\xbb\x02\x14\x35\x0c\xd7\xd4\xe0\xa0\xad\x01\xda\x09\x50\xd6\x30\x5c\x93\x00\x59\x8d\x3f\x90\x7f\x13\xe4\xa1\x1b\x14\xf4\x7d\x41\x1f\xbb\x50\xb9\x02\xec\x42\x06\x8c\xd7\x30\x37\x6a\x80\xb8\x49\x80\xda\x06\x56\x47\x80\xc2\x66\x01\x5a\x34\x45\x0f\x6a\x16\x05\x37\x02\x94\x18\x58\xa9\x00\x9d\x0d\xd0\x45\x80\x9a\x46\xc1\x96\x00\x55\x0c\xac\x9a\x00\xb5\x9b\x45\x33\x5b\x2d\x81\xd5\x2a\x5a\x59\x6d\x89\x16\xc5\xed\xa2\x0b\xad\xda\x02\xb6\x6f\x17\x9d\xef\x20\x61\xb9\x01\x3b\xb5\x85\x0f\x6b\x17\xdd\xb6\x21\x40\xb6\x81\xd5\x15\xa0\xa8\x53\x80\x96\x1d\xd1\x6d\x3b\x45\xc1\x9d\x00\x65\x06\xd6\x51\x80\x2c\x03\x54\x13\xa0\xb6\x51\xb0\x23\x40\xa1\x5d\x60\x35\x04\xc8\xee\x14\x8e\xa2\x2a\x98\x88\x3b\x46\x2b\x3b\xc2\x01\xec\x3b\x85\xa3\x38\x48\x58\xde\x29\x3a\xdd\x49\xc2\xea\x06\x8c\xd7\x00\x1d\xd1\xee\x14\x1d\xb6\x2b\x61\x91\x5d\xe0\x2d\x6d\x01\xdb\x1a\xb0\x9d\x84\x65\x76\x51\xf6\x28\x61\x96\x81\x57\x93\xb0\xb6\x01\xeb\x48\x58\xd8\x35\x1c\x54\x57\x3a\xa8\xae\xe1\xa0\x24\x2c\xe9\x16\x8e\xa2\x66\x0b\x47\xd1\xbd\x72\x14\xd6\x7f\xcd\x51\x64\xd5\xc2\x94\x8e\x55\xee\x02\x2c\x03\x54\x13\xa0\x76\xd5\xe8\x08\x02\x14\x86\x6e\xd1\x11\x42\x97\x47\x01\x1a\x04\x1d\x41\x80\x12\x03\x2b\x15\xa0\xb3\x01\xba\x08\x50\xd3\x28\xd8\x12\xa0\x8a\x81\x55\x15\xa0\x78\x51\x80\x56\x0b\x0e\xda\x2f\x8a\x82\x07\x01\xca\x17\x7f\x20\xff\x26\xc8\x43\x37\x28\xe8\xfb\x82\x3e\x76\xa1\x72\x05\xd8\x85\x0c\x18\xaf\x61\x6e\xd4\x00\x13\x08\x01\xaa\x1b\x58\x0d\x01\xb2\x0d\x50\x57\x80\xa2\xa8\x28\xb8\x8c\x38\x68\x1b\x15\x58\x3b\x01\xca\x0c\xd0\x51\x80\x2c\xa3\x60\x4d\x80\xda\x06\xd6\x49\x80\xea\x51\xd1\xcc\xda\x52\x60\x2d\x8b\x56\x76\x96\xa2\x45\x61\xec\xea\x2e\xb4\x88\x05\x6c\xad\x61\x60\x7a\x12\x96\x18\xb0\x34\xe6\xe4\xce\x1a\x04\x43\xa3\x00\x35\x0d\xac\x96\x00\x55\x0c\x50\x55\x80\xe2\x55\x51\x70\xb5\xe2\xa0\xfd\xaa\xc0\x3a\x08\x50\x6e\x80\x4e\x02\x54\x37\x0a\x36\x04\xc8\x36\xb0\x2e\x02\xd4\xd4\x20\x08\xbb\x45\x8d\xe1\xca\x68\x25\x62\x81\x03\x58\x23\x4c\x3a\x8a\x8d\x84\x25\x1a\x06\xad\x94\xb0\xb3\x01\xe3\x35\x60\x04\xa0\x61\x60\xa2\x12\x56\x31\xf0\xaa\x12\x16\xaf\x0b\xd8\x6a\x2d\x60\xfb\x75\x51\xf6\x20\x61\xb9\x81\x77\x92\xb0\xba\x01\x6b\x48\x98\x6d\x94\xed\x4a\x58\xb4\x29\xf0\x96\x1b\x01\xdb\x6a\xd8\xdb\xfc\x78\x70\xf9\xea\x9f\x5a\xd4\xf1\x70\xed\x26\x28\xb6\xe8\xdf\xf8\x3a\xde\x87\x75\xd0\xa4\xe7\xa7\x83\xab\x77\x22\x1c\xdc\x87\x10\x46\x23\x70\xc4\xe2\xdd\x7c\x83\x38\x5e\xb1\x9a\xf7\x91\xe1\xcb\xf1\xfc\x82\x70\xb1\x4a\x0c\xf3\x0c\xf7\x49\xed\xe2\xd1\x9f\x72\x25\x75\x06\xd4\xbf\x3f\xac\xa1\x81\x07\xf7\x1b\xfc\x3f\xab\x1d\xdc\x09\xae\x0e\xf6\xf9\x11\x27\xea\x18\xef\x58\x5f\x2e\xc9\x0e\xe6\xbb\xc4\x55\xb9\x1d\xce\x96\xfc\x14\x6b\xe7\x83\xbb\x7b\xf8\x07\x9e\x74\x02\xd3\x04\x57\x27\x48\xf0\x56\xc8\x55\x4f\xbd\xbe\xcc\x86\x35\xf2\x90\x51\x1a\x30\xf8\x0b\xb3\x06\x90\x43\x4e\xdf\x33\xf1\xff\x0c\x1a\x38\x78\xa8\x53\xe7\x11\xff\x7e\xec\x0f\xd0\x14\x5e\x93\x3b\xaf\xed\x5d\x0f\x2a\xf8\x68\xef\xdd\xfe\x4d\xed\xf4\x55\x6e\xcb\xab\x8c\x93\xd9\x62\xef\xee\xc8\x43\x1b\x18\xc2\x45\xbe\x36\x99\xad\xf6\xc0\x58\x9b\x38\xbf\x02\xf8\xfb\x91\xed\x81\x72\x9b\x20\x17\x6d\xa3\xc1\x48\x27\x24\x7a\x7f\xdf\x60\x7b\xd6\xe0\x04\x9b\x60\x0e\x48\xb0\x49\x66\x5d\xf1\xbf\x33\x99\xc0\xdf\x8f\xca\x0e\x08\x36\x39\x41\x7c\xb7\x73\x83\xdb\x65\xcf\xb7\x1e\xe2\xf7\x86\x88\xbf\xdf\x81\x64\xb7\x44\xaa\x7e\x0b\x6d\x3b\xd3\x8f\xfa\x16\xda\x26\x97\x9d\xe7\xcb\x2d\x60\x34\x49\x61\x33\x93\x8f\xfd\x16\xb4\x9d\x91\x79\x75\x03\xf8\x62\x5f\xe5\xdd\xda\xba\x3d\xb9\xf5\x03\xf3\x6c\x77\xa4\x2a\x73\x71\x13\xe0\x23\x46\x48\x42\x42\xd2\xcd\x5d\x95\x29\xf1\x71\x3e\xa9\xa4\x94\x57\xdc\x2d\x12\x76\xe3\x3c\x7f\x87\x81\x6c\x9e\x9e\x40\x4c\x16\xf9\x08\x4f\x2e\x1f\xd8\x3c\xcc\x9e\x98\x5f\x4e\xd0\x1e\xf8\x15\x0c\xf1\x5d\x64\xbe\x43\xfe\x2c\x82\x59\x4a\x16\xa1\x0e\x2b\xe0\xcf\x5a\x1b\x13\x36\xda\x90\x87\x84\x86\x64\x73\x06\x2e\x12\x7e\xd2\x71\x4b\x3f\xac\x33\xd0\x49\xe8\xfc\x88\xd4\x13\xfa\xd1\x3c\xb9\xc3\x62\xe5\xf8\x19\xc4\x5d\x27\xf3\xc6\x19\x18\xaa\x83\xc0\x10\xb9\xce\x89\x03\xb4\x7b\x86\x22\x75\xce\x10\xbc\x4b\xcc\x77\xc8\x50\x9d\x33\x54\xe7\x0c\x69\x78\x99\xa1\x0c\x18\x3a\x5d\x80\xa1\x8c\x33\xb4\xa7\x1f\x95\x0b\xd0\xc9\xe8\xbc\x85\xd4\x33\xfa\x11\x5d\xca\x0c\xe5\x64\xbe\xb0\x80\xa1\x9c\x7c\x58\x88\x9c\x73\xe2\x00\xdd\x58\x50\x24\xe7\x0c\xc1\xbb\xba\xf9\x0e\x19\xca\x39\x43\x39\x67\x48\xc3\xcb\x0c\x6d\x81\xa1\x8e\x85\x76\xc1\x19\x8a\xe9\xc7\xb6\x06\x74\xb6\x74\xbe\x44\xea\x20\xb1\xc4\xba\x23\xa1\x43\x4d\x48\xa8\x62\x99\x12\x3a\xd5\x4c\x09\x85\xb5\xff\x54\x42\xab\xba\x29\xa1\x73\x5d\x48\x28\xad\x09\x09\xd5\x6b\x65\x86\xc0\x5a\x6a\x75\x61\x43\xdb\xba\x69\x43\x9d\xba\x69\x43\xfb\xfa\x7f\x66\x43\xf3\x65\x2a\x4c\x88\xa1\x60\xde\xcf\xe8\x37\x00\xda\xa8\x73\x13\x62\xdb\xe1\x50\xef\xdb\xb4\xc5\x9e\xaf\xda\xf4\xf4\x9d\x06\xfb\x61\xec\x82\x80\x90\xa9\xda\x8a\x93\xae\x76\x9f\xba\x6a\x95\x7e\xde\xc5\x0e\x2a\xb7\x9e\x36\xec\x87\xdc\x49\x39\x43\xab\x52\xb7\x7f\x7b\xf8\xf3\x95\xc9\xf9\xa2\xd8\xed\x9b\x75\xf6\xee\x92\x80\xc4\xde\xc3\x83\x8b\x92\x9b\xad\x52\xf0\x6c\x7b\xea\x7c\x0b\x50\x8e\xe0\x26\xbf\xc3\xff\xe8\x2e\xf6\x14\xfd\x8f\xd8\xf6\x44\x32\x31\xd5\xfe\xc7\x45\xb7\xfd\xe3\xc1\x62\xe8\x9a\x41\x20\x6c\xd6\x10\xff\x3b\x17\xf2\x02\xff\x7d\xb4\x13\x20\x63\x31\x24\x03\xc0\x40\xee\x94\xba\xb3\x45\xe2\x7e\x3c\x9c\xd9\xfb\x3a\xc1\x1d\x5a\x36\xdb\x88\xff\xc1\x49\xbf\xc0\x7f\x1f\x11\xd6\x7e\xe6\xc5\xce\xbc\xd8\x94\x6f\x31\xb3\x11\xb0\x7c\x66\xf3\x46\xe2\xbe\xc2\xff\xee\xe5\xf1\x07\xfc\xb7\x24\xeb\x36\x88\xe2\xcc\x22\xb2\x6a\x2b\xf9\x78\x4e\xe0\x3c\x3c\xf3\x57\x72\xe3\xc7\x8d\xc8\x02\xf1\xb8\x5f\x71\xd1\xaf\x14\x09\x5f\x45\x26\xd6\xf8\x7a\xc3\xa9\xd8\x0f\x2a\x36\x80\x66\x1a\x26\xb6\x9e\x96\x24\x6e\xab\x51\x72\x12\x91\x6a\x4b\x0f\x75\xb8\x49\x37\xbe\x2e\x57\xa8\x84\x67\x01\x06\x4b\x12\x61\x61\x91\x4b\x17\x91\x14\x0b\x8b\xb1\xf1\x77\x93\xfc\xd4\x1b\xe5\x9e\x1c\x84\x87\x76\xc3\xc5\x61\x4c\x62\xca\x0c\xb9\xd7\x05\x52\x59\x12\x9d\x47\x19\x22\x17\x3a\x95\x72\x49\xac\x16\xd4\xb1\x87\x52\xa7\x16\x0f\x5f\xd0\x7a\x8b\x01\x84\x67\x11\xb8\x98\x71\x28\x76\xa7\x96\x24\x6b\xa9\x06\x8d\x41\xa8\x2d\x1e\x3e\x18\x82\xe1\x19\x0b\x0b\xd2\x6c\x89\x8d\xdd\xe7\x87\x20\x24\xb5\x96\xfb\x5d\x89\x6b\xa0\x0e\xaa\x4f\x17\xa4\xde\x72\xa5\xb1\xf8\x83\x45\x05\xc6\xef\x8c\x8c\xea\x5d\x17\x3b\xb5\x5f\xeb\xba\xe8\x73\xde\xea\xca\xdd\xff\x21\xf7\x51\xef\x8c\xfb\x25\x35\x81\x04\x35\xb7\x83\x88\x1c\x1b\x5a\x82\x72\x2b\xfc\x7a\x4f\xd0\x45\x45\x7c\xa2\xe3\xf1\xb5\xb6\x07\x25\x45\xdf\xdb\x63\xfc\x7d\x62\x46\x56\xe3\x44\x3f\x9e\xfb\xdf\xee\x6c\x2b\xfa\x86\x42\xcb\x7b\xac\x45\xee\x62\x44\x86\xe0\xbb\xbf\x97\x77\x59\x4d\x84\x98\xac\x6c\x57\xe5\xdd\x4e\x45\xc0\xa6\x64\x65\x5c\x83\xe0\xeb\x9d\xa8\x12\xce\xab\x89\x22\x7f\x7e\x8e\xf2\xfe\x0a\xce\x9d\x0c\xeb\x1d\x60\x8a\x63\x4d\x06\xb5\x96\x4b\x75\x9f\x70\xff\xd8\x18\x0d\x2c\xde\xec\xef\xed\x22\x7f\x5d\x06\x3c\x32\xe6\x44\x5b\x2a\xf4\x1c\xb6\x5b\x6e\x43\x66\x6c\xb9\x83\x56\xcb\x8d\x70\x68\x1a\x56\x5a\xee\x52\xa5\x72\x80\xf9\x36\x79\xec\x62\x68\x9a\xcf\x88\x17\x24\xc7\xd4\x57\xe9\xbd\xdf\x1c\x91\xff\x8b\x3e\x2d\x24\x87\xa6\xfb\x08\x76\xb9\x24\x95\x06\xb8\xd2\x1c\xfa\x4d\x0b\xad\x2a\x82\x70\x31\x33\x0a\xf9\x1c\x73\xa1\x18\x40\xb3\xcf\xb9\x8b\x8d\xe8\x92\x34\x1b\xca\x0b\xbf\x81\x4f\xc0\x31\x2a\x01\x82\x71\xc3\x55\xb9\xa1\xe0\x8e\xea\x3c\x62\x84\x8e\x84\x63\x8d\xec\xcb\x60\xa1\x29\xf8\x65\x06\x23\x2d\x0e\x19\x36\x14\x4a\xea\x40\xca\x06\x2e\x36\x48\x26\xe7\x43\x5d\x05\x8a\x55\xea\x62\xe7\x75\x8a\x9c\x00\xf6\x4f\x5e\x71\x1d\xb1\x23\x0a\x7d\x1d\xb1\xeb\x72\xa4\x96\x95\xa8\xa1\x0c\xff\x8a\xf1\x05\x46\x55\xdf\xd5\x54\x56\x0d\x49\x25\x6c\x70\x2a\x0e\x85\x51\x19\x68\xa5\x48\x0b\xa9\x9c\xeb\xae\x73\x97\x4a\x48\x1a\x75\xbd\x11\xbc\x20\xeb\x06\xd4\xf6\x16\x92\x0b\x0e\xb5\x45\x1e\xcf\x0b\x26\x1d\x8f\xc1\x2d\xd7\x41\x0e\x62\xe3\x14\xba\x6c\x5d\xb9\x65\x28\x08\x12\xfa\x2e\xc7\x43\x70\x2a\x75\xf7\x87\x51\x3a\xe4\x39\xcb\x53\xbe\x67\x2e\x5d\xef\x1a\x5b\x3b\x2d\x04\x2a\xb3\x18\x40\x2b\x20\x87\x0f\x23\xd1\x40\xe4\xc3\x86\xd8\x92\x6f\x20\x3d\x50\x05\xb2\x90\x40\xdb\x76\x58\x4a\x38\x18\xb0\xae\x01\x4f\xc9\x78\x79\x78\x5e\x10\x0b\x6b\x17\x59\x40\x21\x4a\xf3\x97\xda\xe6\x1e\xf4\x64\x64\x3c\xc3\xd4\x96\x57\xcd\x9f\xc8\xa9\x9e\x89\xa4\x01\xc7\xcc\x1c\x98\xea\x5c\x27\xe0\xb9\x52\x53\xa3\x14\x8c\x49\x9d\x9a\x9a\xc1\x0c\xc0\x24\xeb\xee\x6f\x35\x39\xe1\xac\xaa\xe4\x84\x89\xf3\x82\x5a\x04\x5b\xc0\xb2\x10\xc6\xbf\x9a\x09\x5d\x4a\x7b\x97\x1a\x14\xcf\x65\x28\xb4\x24\x7b\xc4\xb5\x09\x1d\x33\x68\x30\x0f\x94\x60\xd2\x02\x76\x13\xd5\x5d\x0c\xf1\x71\x5c\xe5\x52\x79\x63\x8f\x18\x5a\x47\x58\xde\xc3\x53\x27\x41\x4f\x46\x78\x68\xf1\xc0\xad\x63\xe0\x9d\x71\xec\xa9\xb9\x3e\x66\xf0\x4c\x31\x61\x06\xf3\xcc\x40\x6e\x9e\x4c\xe0\x7c\x43\x41\xec\x41\xbe\x75\x4b\x4f\x0b\x81\xb2\xe5\xca\x10\x02\x5a\xb9\xad\xf1\x14\x72\x3e\x91\x04\xb6\x6a\xee\xbb\x94\x06\xf4\x1a\x4b\x9a\x7a\xf7\x22\x78\xdf\x4c\x5c\x95\x8b\xa6\x24\xc8\xc7\xe9\x97\xc2\x5f\xaf\xc8\x36\xee\x31\xd3\x65\x83\x77\x8c\x7b\x54\x7b\x6d\xd7\xb8\x42\x41\xfa\x5f\x0f\xf3\x31\xa0\xba\xb8\x27\xdb\x06\x3c\xd6\xe2\xde\xa3\x74\x78\xac\xfe\xc4\xed\xbb\xc7\xef\xdf\xf0\x06\x6a\x64\xe0\x33\xb5\xa2\x66\xde\xc8\xbe\x59\x73\x44\x96\xb5\x62\xfc\x81\x19\xcb\x4d\xcd\x3f\x50\x66\x0b\x12\xd6\xf5\xb0\xc8\x45\xa0\x6a\x46\xe9\xb5\xa9\xb3\x84\xc9\x2a\xc4\x62\x31\x31\xab\x16\x5d\xed\xaa\x6a\x25\xdf\xa3\xa5\xba\x10\x1f\xd1\x7d\x5a\xfd\xf6\xbb\x3c\x6a\x0d\x6e\xe4\x76\x5e\xf7\xae\xb2\xcb\x62\x72\x5c\xdf\xca\xed\xe5\x56\x6e\xd1\xc6\x90\x5b\x75\x5d\xc8\x2d\xfb\xf6\xcf\xe4\x06\x26\xd0\x37\x6b\x06\xe9\xdf\x91\xdb\xcb\xad\xdc\xce\xa6\xdc\x1a\xff\x89\xdc\x78\xd5\x4a\x6e\x0d\x2d\x37\x1e\x7a\x00\xfa\xbb\x8e\xea\x9a\x96\x0a\x2b\x5e\xc0\x99\x5a\xd0\x35\x66\x52\xea\xca\x71\xa5\x96\x9e\x01\x73\x2e\x79\x36\x4f\x46\xee\xdc\xf3\xe1\xea\xe1\xc7\xbd\x0e\x26\xcb\x61\xc8\x92\x9c\x2d\x63\x88\x38\x5a\xca\x3b\xf8\xe0\x1a\xb1\x7b\x17\xc9\x66\xa2\x07\xf9\x3c\x28\xde\x32\xd9\xc7\x60\x22\x84\x01\x09\x15\xcb\x39\x18\x84\xf2\x72\x4f\x2a\x69\x94\x7e\x93\xe4\xd8\xe8\xfd\x4e\x29\xbf\x28\x15\xde\x2b\xe5\xf8\x1f\xd8\x40\x68\xa6\x4d\x9c\x21\x5f\x5e\x60\x3c\x3a\x13\x89\x7d\xae\x9a\xb7\x35\x21\x42\x39\x66\x3d\x0f\xfd\xc3\x10\x11\x98\xf5\x6b\x08\x6e\xe2\xf7\xf1\x55\xa5\xf1\x0e\xdb\x87\x1e\x46\x81\x31\xa9\x21\xa2\x88\x2b\xbe\x8a\x25\xde\x9b\x74\xf8\xa7\xb8\x61\x18\x27\x40\x14\x47\xbf\x06\xda\xd3\x14\xa7\x0d\x13\xda\x7a\xfb\xf5\x6f\x23\x94\xc9\xbf\x0f\x50\x26\x2b\x52\xcf\x7a\x7d\x68\xa5\x1c\x89\xb8\x24\x27\x34\x7d\xd3\xc9\xc9\xd0\xe5\x10\x43\xb4\x15\xe7\x52\x5b\x2a\x2f\xdb\xc2\x94\x3e\xff\xfb\x3f\x96\xed\x21\x37\x65\xdb\x16\xb2\xad\x15\xb2\xad\xa7\x52\xb6\xa7\xfc\xbf\x4f\xb6\x61\x26\x65\x9b\x36\x0a\xd9\x56\xff\xa7\x64\x7b\xce\xa5\x6c\x1b\x8d\x42\xb6\x17\x53\xb6\x59\xfe\x47\xd9\xee\xb9\x0c\x9b\x5c\xb6\x16\x97\xad\x90\x76\x4c\x44\x9a\xb0\x98\xde\xc7\x64\x73\x06\x91\x45\x5c\xb6\x19\x61\x21\x0c\x72\x36\xf9\xdd\x29\x64\x7b\x3e\xf6\x70\x2e\x1d\x93\xf4\xfc\xdf\x27\x5b\x1b\x89\x56\x60\xe0\x5b\xb6\x0a\xd9\xae\x7e\xfd\x0f\xc9\x36\x3b\xf7\xc4\xb0\x9b\xb6\x0a\xd9\xb6\x4c\xd9\xee\xcf\x5a\xb6\x9c\x47\x8f\x47\x23\xdb\x96\xab\x92\x20\x25\xb4\xae\xa0\x6a\x3c\xbc\x34\x71\x9e\xc2\x0c\x4f\x88\x51\x22\x78\xd7\xa6\x9a\xf6\x06\x80\x74\x51\x6b\x19\x03\x27\x77\xf5\x51\x97\x74\xf0\xd3\x98\x2b\x9f\x31\xa8\xc0\xb0\x14\x43\x90\xe3\xd1\xd5\x09\xe5\xd5\xfe\x93\x11\x23\xf0\x49\x22\x84\x98\x16\xa2\xd6\x31\x76\x37\x50\x4f\x80\xaa\x1c\x6d\x86\x08\x02\x1b\x42\xeb\x06\x6f\x92\x11\xb0\xad\xfb\x43\x3e\x4d\xb0\x73\x35\xcb\xe5\xa9\xeb\x3a\x3a\x83\x89\xe6\x3b\x04\x5b\x32\x54\x6e\x63\xc0\x9d\xe3\xb4\x16\x43\xf0\xdc\x2d\xe6\x1f\x55\x8c\xf2\x73\x73\xfe\xb1\xf6\x30\xfc\x82\xf8\xf2\xe4\xaa\xe5\x98\x10\xfb\xd4\x6f\xc9\x0d\xfa\xee\x8c\x1b\xe8\x96\x48\xf2\x7a\x7d\xe0\xa4\x04\x05\xa1\x61\x74\x56\xa2\x72\x39\x3b\x3f\x55\xf9\x04\x06\x64\x71\x3b\x18\xd8\xf9\xb2\x37\x50\x71\x53\x84\x4b\xbb\xc0\x5f\xe5\xc4\x97\xa8\x22\x8c\xaf\xe5\x3a\xed\xf3\x92\xb4\x31\x94\x16\x69\x48\x11\x06\x8b\xec\x21\x66\xd0\x7c\x1c\x70\xf9\x1d\x58\x5e\x88\xa1\xf2\x08\x64\xba\xc4\x10\xb2\x0f\xe2\xe2\x78\x1e\x4f\xf1\xc6\x29\x89\xf3\xb3\xa7\x43\xd9\xa4\xa1\x06\x2b\x9c\x50\xe9\x38\xde\x1d\x1e\x23\x82\x2b\x7d\x83\x64\x41\x70\xbe\x34\x3c\x2c\x88\x8a\xa1\x07\xcd\x25\xe1\x91\x02\x38\x3d\xd1\x07\x02\x67\x43\x7f\xa8\xb0\x5b\xda\x80\x85\x03\x78\xc8\xa7\x26\x6a\xd2\x5f\x43\xed\x09\x49\x38\xb9\xc8\xc8\x46\x39\x00\x75\xbc\xb9\xeb\x7d\xa2\x75\x0e\x83\xda\x88\xc7\xfa\xbd\x87\x0a\x70\x7f\xd0\x61\xa9\x2f\xcf\x04\xbd\xe2\x82\x42\x6e\x81\x60\x15\xd6\x9a\xa4\xed\x9e\xa7\x67\x12\x1f\xea\x9c\xa9\x67\x2e\x15\x4c\xcb\x8b\x06\x8e\x63\x98\xe4\x4d\x20\x76\xb5\x90\x20\x98\xa5\x96\x02\x8e\x0b\x2b\x2e\xd6\xd6\x8b\x09\xc4\x14\x42\xf2\xa1\xbe\x82\x8e\x7a\x72\x2a\x02\xe4\x69\xb1\x10\xa1\xee\x96\xf3\x87\x51\xd6\x93\xd1\x94\xbf\x26\x17\x6c\x89\x44\x82\x3e\xdd\xe9\xf5\xca\xfb\x08\x62\x22\x2c\xf6\x44\x58\x1f\xa6\x1f\x31\xf1\x4f\x76\x8f\x4a\x2f\xf9\x2a\x96\x24\x87\x75\xbb\x27\x4e\xad\x00\x08\xa2\xed\x4e\xcf\x35\xa2\xb9\x31\x38\xeb\x4e\xcf\x31\xe3\x51\xb4\x1f\x14\xe8\x8a\xa8\xb0\x05\xfc\x6c\xa7\x07\xf4\x5f\x37\xa4\xd9\x06\xdf\xf7\xba\x26\x55\xe4\xee\x15\x46\x51\xe4\x4b\x84\x21\xe0\x64\x3b\x3d\x7d\x9e\xf6\xa3\x4d\x90\x43\x06\xd1\xc8\x70\xdf\xea\xe9\xf4\xf5\x35\x69\xb5\x7b\x6a\x39\x62\x45\x72\xac\x5c\x4e\xa7\x79\xa3\xf8\x02\x57\x71\x8c\x14\xd7\xb2\x5a\x44\x9d\xef\xb8\xf7\x7e\x4b\x36\x95\xde\x0e\xe7\x92\xa0\xe5\xf9\x92\xd8\x4c\xac\x93\x4f\xe4\x1c\x67\xd8\x6e\xf5\xe4\xfa\x61\x4c\x78\xed\x6a\x3d\xc7\xe7\xd5\x07\x72\x06\xcb\xd9\x57\x0b\x04\x03\xe7\xf9\xf1\x61\x4e\x1b\xcc\xbd\x5a\xce\xda\x91\x6d\x05\x9a\x32\x85\xbe\x5e\xe9\x29\xb7\xb1\x21\xed\x4a\xaf\x58\x68\x58\x93\x46\x05\xe9\x4c\x65\x89\x7d\x05\xbc\xf1\x5a\xb0\x39\xe6\x57\x00\x3e\xea\x35\x26\xd7\x3c\x17\x20\x3c\x36\xaf\x42\x14\xf8\xb4\x8e\x09\x57\x8e\x18\x78\xc0\x50\x2a\xbd\x6f\x68\x58\x6c\x08\x7f\x55\x71\xca\x8b\x7b\x66\xf1\xde\xa7\xc5\x1f\xc1\xf7\x6c\x48\xa5\xd2\xc3\xb4\x73\xdc\xc5\xb3\x40\x50\x9d\x4a\x2f\x50\xf0\x47\x0d\x67\xc0\xd2\x19\x88\x43\x1b\xc1\x4a\xa8\x7c\x7b\x86\x9e\x57\xab\xf4\x5c\x5d\x5d\x5c\xfd\x8c\x5b\x28\xf8\x8b\xf3\xb9\x46\x3e\xb7\x42\x2e\x18\x1f\x6d\x48\x58\x2d\x78\x44\x3b\x41\x7e\x9a\xc0\x40\x54\xe5\x0f\xc8\x40\x93\xef\xaf\xb5\xf9\x3c\xb9\xcd\x21\x6d\x18\xdc\xbb\xdd\x1e\x2e\xdc\x43\x35\xb8\x6e\xcf\x0b\x3c\xc2\x03\xaf\x6c\x60\x9e\x63\x16\x3a\x61\x33\x94\x14\xed\x32\xf3\xea\x54\x89\xb5\x23\xed\x85\xc7\x35\x5c\x5b\x78\x85\xf4\x93\xc8\x2b\xda\xb3\x26\xbb\xc8\x53\x1a\x06\x85\xd9\x0b\x4f\x28\xac\xb5\xf0\xfe\x91\x86\xdb\xaa\xc0\xe7\x75\x5c\xc9\x6c\x15\x79\xa6\x86\x45\x71\xca\x8b\x7b\x66\xf1\x4f\x34\x0c\xc5\x85\x86\xf3\xc8\x33\x35\x9c\x46\x5e\xa0\xe0\xb7\x1a\x86\x36\x0a\x0d\xf3\xb7\xa8\xe1\x4d\xe4\x15\x1a\xae\x7f\xca\x2d\x14\x14\x1a\x6e\x22\x9f\x5b\x21\x17\xa1\xe1\xb3\xc1\x23\x68\xf8\x80\xfc\xa0\x86\x2d\xf1\xf0\xb9\x86\x77\x0b\x8f\x6b\xf8\x20\x35\x6c\x21\x4f\xa8\x61\xa8\x6c\x70\x2b\x62\x06\x6e\x0f\x55\xbc\x72\xcd\x83\x88\x5a\xc5\xc9\x4a\xa8\x78\xb3\x32\xc4\x5f\x59\x5d\xa9\xb8\xb3\x32\x55\x9c\xad\xa4\xc6\x0e\xab\x7f\xa6\xe2\x44\x15\xf8\xbc\x8e\xeb\x6e\xb1\xba\x52\xb1\x28\x4e\x79\x71\xcf\x2c\xfe\x89\x8a\xa1\xb8\x50\x71\xb4\xbe\x52\x71\x75\x25\x55\x0c\xf0\x5b\x15\x43\x1b\x85\x8a\xf9\x5b\x54\x71\x6b\x65\xa8\x78\xbb\xfe\x8c\x5b\x28\x28\x54\xbc\x5f\x49\x15\x1f\x56\x52\xc5\xf1\xfa\x4a\xc5\xdd\x95\x54\xf1\x7a\xfd\x85\x8a\x2f\xb1\x50\xf1\x45\xaa\x78\xbd\x96\x2a\x86\xca\xee\xa9\x38\x11\x2a\x4e\xef\xab\xb8\xb2\x05\x15\xbf\x80\xe9\x6d\x0d\xf1\xe7\xbb\x2b\x15\xa7\x3b\x53\xc5\xe1\xce\xc3\xe3\x8f\x5b\xd2\xdd\x82\x8a\xc3\xaf\x55\x0c\x55\x88\x02\x9f\xd7\x71\xdd\x2f\x76\xa0\xe2\x88\xab\x38\x22\xaa\x38\xe5\xc5\x3d\xb3\xf8\x27\x2a\x86\xe2\x8f\x10\x6a\x82\xed\xef\x40\x92\x19\x97\x5b\x06\x2a\x3e\xed\x40\xc5\x12\xfe\xa8\xe1\xa8\xe2\x04\x88\x43\x1b\x7d\xd1\x63\x76\x52\x9c\x87\x9d\xa1\xe2\xf6\xa7\xdc\x42\xc1\x5f\x9c\x4f\x1b\xf9\x8c\x84\x5c\x3c\x60\x18\xba\xfe\xee\x4a\xc5\x47\xe4\xe7\x0c\x0c\x34\xc5\x03\x32\x70\xe6\x2a\xb6\xb8\x8a\x95\xd5\x81\x9f\xde\x40\x6d\x6b\x4a\x3b\xa0\x62\xb4\x95\x26\xf2\xb4\xa6\xbc\xb2\x7b\x2a\xb6\x08\x8a\x8a\xd6\xee\xab\x38\x4f\x44\x2f\x3e\x24\x86\xf8\xa3\xf4\x4a\xc5\xd5\xc4\x54\xf1\x39\x91\x9d\xf2\x98\xfc\xb3\x5e\x9c\xab\x02\x9f\xd7\x71\xdd\x2f\x92\xab\x5e\x2c\x8a\x53\x5e\xdc\x33\x8b\x7f\xa2\x62\x28\x2e\x7a\xf1\x3a\xbd\xea\xc5\xcb\x54\xf6\x62\x80\xdf\xf6\x62\x68\xa3\xe8\xc5\xfc\x2d\xf6\xe2\x6e\x62\xa8\x38\xf9\x94\x5b\x28\x28\x7a\x71\x96\xc8\x5e\x7c\x4c\x64\x2f\xde\xa6\x57\x2a\x5e\xa4\xb2\x17\xef\xd3\x2f\x7a\xf1\xe6\x20\x7a\xf1\x92\x8a\x5e\xbc\x4f\xa5\xd9\x41\x65\xf7\x54\x6c\x8b\x5e\xdc\xbd\xaf\xe2\x28\x07\x15\xbf\x81\xe9\x1d\x0d\xf1\x5b\xf9\x95\x8a\x4f\xb9\xa9\xe2\x38\x87\xa6\xc4\xd0\x94\x45\x0e\x2a\x8e\xbf\x56\x71\xa4\x0a\x7c\x5e\xc7\x75\xbf\xc8\x3d\xcc\x6b\x42\x15\x43\x9f\x94\xc5\x29\x2f\xee\x99\xc5\x3f\x51\x31\x14\x7f\x14\x9d\x25\x37\x3a\x0b\x44\x4f\x39\xa8\x58\xc2\x1f\x8b\x4e\xd4\xc3\x3d\xa4\x2d\xb6\xd1\x87\x07\xf9\x36\x07\x71\x1e\x73\x43\xc5\x95\x4f\xb9\x85\x82\xbf\x38\x9f\x61\x2e\x2d\x19\xe4\x82\x93\x67\xe8\xfa\xf9\x95\x8a\x2f\xc8\x4f\x1d\x18\xb0\xc5\x03\x32\x50\xe7\x2a\x6e\x72\x15\x2b\xa5\xc7\xe4\x94\xe1\xae\x01\x8c\xc5\x14\xb3\x0f\x78\x81\x47\x78\xe0\x95\xdd\x53\x71\x46\x87\xf2\xea\xf1\x1b\x0d\xc3\x70\x91\xa1\x9f\x06\xf3\x48\x3d\xb5\xe9\x80\x4b\x73\x6a\x45\x94\x9f\x9d\x95\x93\x45\xdc\x34\x7f\xf1\x5a\xd8\xf0\x88\xcc\xd7\x5c\x9d\x1f\x2b\xca\xb7\x3b\xf7\xb9\xf7\x1d\xec\x0f\x2c\x35\xf3\xd4\xd4\xea\x6d\x45\x9a\x48\x48\x6f\xd6\xa9\x1b\x8e\x20\xd4\xbf\x64\x1e\x48\xe9\x05\x77\x29\x05\x72\x08\x2d\x0f\x91\x97\x10\x88\x74\x53\x4f\x2e\x16\xbd\x41\x57\xc8\xb8\x9b\x87\x30\x2a\x43\xff\x47\x70\xd7\x49\x34\x64\x45\xac\x8c\x2f\xb2\xf3\x8d\xfc\x17\x07\x0f\x94\xab\x3d\x4a\xf6\xb8\x21\xbc\xef\x6e\x32\x50\x1d\x74\xd9\x17\xf4\x74\xce\x77\x74\x7c\x2b\x62\x67\xde\x50\xce\x9a\xa0\xd7\x9f\xbd\x27\x7d\x0b\x91\xe0\x97\x89\x18\xe2\xec\x5d\x2d\xf9\xc3\xf0\x8c\x52\x12\x0b\xef\x30\x77\xc9\x3c\x79\x7c\x1c\xe4\xb7\xbb\x78\xbf\xf5\xc2\xbf\x41\xa3\xad\x69\x0c\x24\x8d\xaa\x49\xa3\xae\x69\x0c\x20\x88\xf8\x84\xc6\xf6\x72\x45\x63\xc0\x05\x74\xb3\x71\x6f\x6e\x9c\x0c\x7a\x38\xfb\x54\x97\xc4\x83\x89\xa4\x9e\xbe\xe2\xe2\x8d\xc9\x8f\x2b\x88\x35\xa4\x3d\x69\x35\xc0\x46\xdb\xfc\x56\x85\x26\x49\xc9\xbe\xe1\xa1\x5b\x19\x2c\x0a\x97\xeb\xb8\x93\x87\x60\xb8\x4d\x40\xc7\x62\xde\x98\x90\x13\x62\xad\xa5\xad\xe9\xc9\xac\xde\x42\x37\x60\x29\xb1\x1a\x9e\xdc\x45\x30\xc0\x09\x39\x20\x89\xe7\x94\xe4\x0d\xd5\x3c\xa0\x9b\x36\x3c\x90\x67\x4a\xce\x0d\x90\x85\x40\xe7\x95\x4d\x1f\xa6\x29\xa9\x6b\x60\x90\x90\x1a\x02\xe7\x29\x69\x6b\xe0\x4b\x42\x2e\x48\x33\x2e\xf2\x2f\x42\x02\x7a\xf2\xf9\x01\xe3\x89\xda\x67\x70\x8a\x6b\xef\xbf\xe9\x1b\x84\x26\xfc\xc6\x32\xc9\x9c\xad\x8c\xe1\x1b\xbf\x27\x4e\xa6\x2b\x34\x89\x46\xc8\xe4\x82\x81\x8d\x22\x91\x6b\x0a\x19\x39\xb5\x3c\xaa\xae\x3d\xe7\x4b\x05\xa0\x83\x83\xba\x7e\x9c\x57\xc9\x97\x04\xb6\xe6\xef\xab\xfc\x8c\xe9\xf5\xce\x47\x91\x0e\x53\x28\x58\xac\x75\x1c\x89\xd5\xf2\xcc\x7b\x9c\xc6\x19\x49\xb1\x76\xb9\x2e\x08\x13\xf3\x09\x68\xb2\x2d\x3d\xb3\x79\x27\xc0\x5b\x42\x36\x6d\xef\x44\x14\x4d\x47\xd6\xf8\x86\xfb\x19\x2f\x68\x04\x93\x23\xc9\x90\x96\x10\x57\x46\x96\xf8\x23\x23\xc6\x5e\x8c\xba\xd5\xaa\xb4\x71\xc3\xe7\x71\xbe\x66\x7a\xa2\x61\xc5\x53\x31\xdf\x93\x2b\x43\x57\x4b\x41\x45\xab\xe5\x4f\xe7\x66\x51\xe8\xb5\xf4\x04\xe3\x2b\xb9\x4a\x2a\x11\x37\xbc\xbf\x41\x9c\x45\xbe\xa9\x0f\x2c\xa4\x24\x6e\x7b\xdc\xe6\x56\xf8\xbf\x91\x66\x72\x24\x7b\x14\xe4\xcd\x55\xf7\x19\x59\x60\xab\x55\x06\x8a\x5a\xb0\x2e\x16\x82\x79\x33\xa3\x22\x35\xe3\xf6\xcd\x85\xe0\x9a\x28\x84\xd3\xae\xa1\x52\xf9\x85\x14\xcc\x8f\x25\x27\x92\xd9\xba\x6e\x10\x73\xc7\xa3\x57\x89\x46\x6b\xfa\xc3\xb8\xe0\x4a\xf9\x75\x7d\x2b\xc4\xe4\x8e\x00\x0d\x19\x1f\x49\xd4\xf2\xd4\x21\xcb\x8c\x34\x9a\xca\xbf\xbf\x42\x3f\x6a\xe9\x1d\x2f\xe8\x32\x2d\x6f\x24\x17\xd3\x8e\xa4\xd2\x84\x32\x16\x34\xbf\xd3\x04\x67\x6b\xf1\xed\x6d\xe1\x6c\x53\x34\x0a\x47\x39\x5b\xdf\x74\xb6\x93\x84\xec\x5a\x68\x6a\x67\xb5\xb6\xc9\xf0\x2f\x18\x29\x52\x0b\x64\xef\x58\x20\x07\xd2\xcd\xa6\x24\x6c\x95\x87\x4c\x91\x6b\xc6\x7e\xe1\xe0\x97\x91\x2e\x76\xe4\x48\x6e\xac\x3b\x2a\x3a\xf1\xdd\x0b\x0e\x9a\xb4\x57\xc7\x41\x88\x16\x77\xc3\x41\x73\x43\xac\x4c\x2c\x39\x41\x73\x1b\x1e\x2d\xf2\x55\x90\xb7\x48\xe1\xd5\x1b\x0a\xcf\x62\x19\xfa\x1b\xef\x61\x0f\xbc\x9e\x11\x2c\x64\x0a\xbc\x62\xf1\x89\x61\xf1\x13\xfe\x25\x10\x7a\x47\x95\x22\xbf\xe1\xea\x2b\x30\xbe\xee\x25\xd1\xe7\x9a\x02\x46\xea\x9e\xba\x77\x2f\x23\xc7\xba\x47\xaf\x2f\x09\x31\x57\x41\xf9\x66\xe9\xba\xd8\x2c\x0d\x8a\x2d\x02\xe0\xbc\xae\x9c\xbb\x9b\x91\x43\xdd\x33\x2f\x3d\x2b\xf5\xc6\x01\x36\xa4\x0e\x4e\xbe\x52\xf7\xe4\x76\x0f\xf8\xdc\x46\x1d\x2c\xa0\xb0\x4c\xde\xb5\x13\x0a\xc6\x50\x53\x22\xf1\x40\x50\x75\xe5\x5e\xdc\x94\x24\x38\x64\x14\xc9\xe0\x63\x4e\x63\x69\xde\xd9\x32\x11\xdb\xbb\x92\x88\x4d\x33\x52\xb3\x3c\xf9\x81\x08\xdf\xf9\x39\x52\x5b\xce\x3b\x57\x0d\x5a\x47\x62\x63\x97\x3b\x83\xf2\x6b\x35\x8f\xc7\xb4\x35\xe6\xdc\x08\xfc\x48\x72\xec\xb1\x31\xb5\xc8\x31\xea\x7b\xd2\x8c\x2f\x24\x5b\xf6\x9f\x1e\xf8\x8d\x71\x1e\x84\x71\xcb\xfe\xb7\x87\xd7\x1a\x39\x47\x7d\x06\xea\xb1\x48\x27\xea\x53\x99\xab\x72\x21\xed\x65\xff\x87\xdc\xa5\x38\x93\x23\x96\x2b\xf6\x12\xce\x84\x97\xc2\x88\x83\x86\x44\x95\xc1\x3b\xba\x44\x45\x67\xb2\x58\xf6\xbf\xc3\x34\x8c\xa3\x81\x41\x53\x1c\x50\x2b\xfc\x26\x98\x0a\xe1\x35\xf3\xe1\xe1\x11\xe7\x84\x17\x62\x2d\xfb\x8f\x38\xdc\xf6\xf8\x2d\x2e\x13\xb9\x39\xe2\x9e\xc9\x01\xab\xdd\x43\x65\x16\x56\x56\x07\x16\x0f\xc8\x6b\x5d\xe6\x44\xd5\x48\x86\x3f\xe5\x1d\x12\x21\xc1\x2b\x5f\xd4\xdd\x39\x3e\xfb\xf5\x0e\x63\x1c\x6f\x14\x93\xfe\xce\x82\xba\xec\x65\x5f\xea\x14\x30\xbe\x71\x8c\x95\xc4\xa0\x98\xc2\x5b\x23\x5b\x4d\x73\x60\x91\xea\x02\xd8\x3f\xbb\x35\x12\x23\x54\x2c\xc0\x43\xfc\xb9\xea\x8b\x6d\x7a\x99\x45\xe9\xb2\x84\x8d\x1e\xd6\xee\x85\x84\x48\x1e\x6b\x3b\x93\x07\x17\x8a\xaf\xfa\x17\x22\x87\x47\xb7\x41\xb6\x2b\x20\xd2\xa6\x9c\x2c\xc3\x4f\xd6\x40\x95\x62\xe0\x83\x1a\x22\xac\xc1\x12\xbf\x2c\xd2\xc5\x8a\x61\xfc\x16\xe0\xa0\x68\xa2\x0d\x13\xce\xd5\xa6\xaf\x92\x58\x1a\x24\x5b\x03\xc2\x5a\x14\x01\x3d\xba\x74\xe9\xba\xb2\xc1\x2e\x72\x28\x6b\x67\x7b\x77\x04\x6f\x41\xb1\x51\x5f\xd4\x9a\x13\x94\x71\x23\xea\x9f\x88\xaa\xd7\xc1\xad\x94\x35\x15\xe2\x35\xc7\xb2\x1e\xff\xed\xde\xde\xb3\xe4\x63\x66\x4f\x4a\xaa\xfa\x82\x0a\x1c\x5e\xf8\x3b\xf0\x9a\x31\xfb\x06\xe5\x52\xb2\x61\xce\x9f\xb7\x1d\xe9\xe4\x4f\x5b\x8c\x09\x39\xb3\x1f\x78\x19\xaa\x1e\x62\x7c\x30\x89\xb0\xaf\xb2\x81\x2c\x92\x86\x7d\x79\x35\xa3\x3c\xf5\xee\xc9\xcf\x3d\x4d\xaf\x69\x81\x98\xf3\x90\x5b\x3b\xff\xc5\x05\x9a\x11\x89\x38\x33\x97\xed\xcf\xa4\x13\xf6\x57\x44\x2e\xac\x57\x64\x66\x1a\xd8\x41\xd8\x57\x1e\x10\xcc\x09\xab\x95\x99\xb9\x33\xe5\xc4\x6b\xc4\xae\x7a\x23\x75\x1b\x1d\xd6\x60\xec\x1b\x43\xd7\x38\x86\xfd\x11\x06\x4f\x92\x60\xb3\x8a\xd3\x5a\xec\xa8\x55\xe8\xd8\xa2\x91\xaf\x92\xd0\xb9\xaa\x06\x27\x30\xba\xd5\x1e\x8d\x8e\x39\x94\xaa\x8d\xa0\x09\xf4\x17\xd6\x20\x95\x5d\xbf\x27\xcd\x08\x2c\xf6\x82\x64\x84\xfa\x07\xb4\xea\x3b\x4a\xff\x0e\xbf\xb2\xf3\xaa\xe0\x7e\xaf\x0b\x82\xf9\xd4\x54\x41\x34\x5d\x87\x6a\xbb\x1d\x70\x1e\x95\x69\x0e\x84\xcc\x00\xff\x72\x30\x8c\xd0\xde\x83\x54\x9b\x2e\xaf\x1e\x4c\xdc\xa5\x9d\x91\x7b\xa7\x66\xb6\x0d\xfa\xf0\xd6\xb0\x7b\x14\x83\x32\x56\x4c\x1b\x07\x8b\xcf\xaa\x2a\xd4\x05\x11\x37\xaa\x1e\xd5\xee\xab\x1e\x82\x3f\xe0\x9a\x0a\xce\x64\x17\xf6\x7f\xe9\x6f\x2c\xf1\x1c\x42\x50\x6e\x95\x2f\x57\x59\x64\x19\xf6\xa9\x3a\xf0\xf1\xf6\xa4\x35\xc3\xdf\xf3\x1f\x9e\x45\x76\x55\x1c\xd5\x28\xaf\xb0\x6f\x76\xb1\x0a\x15\x77\x2b\xe1\x29\x18\x70\x65\x55\x9c\x3e\x31\xf4\x4e\x6d\x74\x68\x15\x50\x6e\x1d\x3a\xe1\xa9\x82\xd3\x5d\xe6\x5e\xc6\x43\xc3\x01\xca\x8d\x3a\xbd\x19\xd3\x22\xdb\x14\x44\x33\x6d\x92\x45\xda\xa7\x3a\x18\x74\x8d\xf1\x4e\xb6\xa1\x45\xd6\x69\x1f\xe3\x8a\x26\x69\xa4\x85\x5d\xcd\x47\xb7\xe9\x6a\x63\x75\xa6\xe1\x59\x96\xb4\x12\x65\x95\x6e\x93\x9c\x92\xbe\x67\x2c\x27\x04\x92\x0f\xc0\x51\xa4\x78\x2b\x45\x87\x16\xae\x36\x22\xe9\x01\x1c\xb6\xb0\xca\x35\x15\x63\x9b\x99\xd8\x55\x47\xfd\x5e\x25\x76\x5d\xd0\x1c\x55\x66\xd3\xf9\xd0\x97\x89\xa3\x2f\x38\x30\x8b\x41\x2f\x22\xa7\x43\xff\x37\xd8\xf6\x8a\xb4\xf7\xc0\xdf\x99\xc6\xa4\xc6\x8d\x18\xc2\x22\x0b\x4b\xdc\xa4\x10\x7d\x7f\x07\xaf\xd8\x24\x2b\x14\xd9\x9a\x7f\xd7\x0b\xed\xb4\x9d\xf6\x15\x3f\x98\x3a\xda\x3a\xf4\x07\x80\xb6\x24\x51\xd2\xef\xe9\xa4\xb1\x4e\x02\xa3\xd9\xe7\x0d\x88\x93\x7e\x29\xa3\x6f\x91\x18\x0d\x08\x53\xd5\x80\x81\xd9\x80\x6a\x22\x1b\xb0\x4d\x64\x03\x96\x89\x6c\x40\x94\xfe\xa1\x01\x8b\xec\xaa\x01\xf5\xec\xaa\x01\x9b\x54\x36\x20\x4f\x75\x03\xea\xa4\x95\xf5\x71\x26\xd5\x82\x19\x3e\x16\x26\x9c\x0a\xc6\x67\x0d\x12\x1d\xfb\xea\xf8\x12\xc7\xfb\x09\xa3\x00\xc7\x83\x21\x82\xc9\xda\xb6\xcc\x71\x75\x66\x23\x0f\x84\x5a\x24\xc6\x17\x11\x50\xea\x68\x83\xf2\x70\xad\xe2\x66\x57\x58\x07\x4e\x7f\x4e\x53\x2f\x7d\x87\x4f\x59\x56\x25\x55\xae\x78\xdc\x84\xd9\x7f\xdf\xd8\x36\x76\xff\x70\x15\x16\x2f\xd8\x87\x71\x54\x16\xba\xaa\xdb\x35\xea\xbe\x41\x5f\x1d\xfb\x57\x49\x76\x0d\x12\xe7\xfd\x77\x39\xd7\xaf\x93\x53\xd6\xd7\x37\x02\xfa\x43\x9d\xbf\xda\x20\x79\xd6\x57\xf7\x01\x62\x5e\x5e\x46\x0b\xdf\x8e\xa1\xd2\xa2\x06\xaf\xdb\x14\x07\x4d\x9b\xd2\x26\xf4\xf3\x36\x88\x39\x47\xde\x72\xe8\x57\x47\xfe\x20\x7c\x15\x74\xa7\x54\x44\x22\xba\x3f\x55\xcc\x71\x04\xf3\x6c\x85\xc2\x6d\x02\xf1\x02\xea\xbf\x02\xb4\xce\x48\x62\xcb\x30\x99\x28\x82\x0a\x0f\x58\x61\x93\x57\xd8\xa6\x14\x63\x9c\x4c\x91\xaf\xa7\x45\x34\xd0\x24\x07\x2c\x67\xf3\x53\x16\x6f\x12\x21\x4b\xa5\x6e\x1b\xa9\x8e\xda\x9c\x97\x6f\xf2\x68\x0a\x77\x3b\xea\x6a\x41\xde\xa1\x30\xbf\xb6\x45\xf6\x69\x5f\xdd\x2f\xc5\x99\xae\x93\x22\x62\xaa\x23\xa9\xdf\x32\xcf\x03\x74\x42\x2a\xd8\x67\xb8\x7a\x12\x28\x2e\x43\x01\xfc\x82\x1c\x17\x28\x88\xd5\x93\x89\x20\x3e\xc7\x1d\x41\x9b\xda\xa4\x53\xef\x33\x2e\x59\xf3\x0b\x7a\x22\x44\xeb\x90\xb0\x01\x2f\xfd\x36\x59\x35\xfa\xfa\x96\xc7\xf1\x2f\xc3\xc1\xc9\x99\xeb\xb7\x21\x14\x7e\x0d\xca\x49\xcf\x41\x07\x62\x72\xa8\xe7\x85\x57\xe3\x6b\xf7\xd7\x6e\xf4\x87\x32\xdf\x1b\xe4\xd1\xe8\x5f\x65\x82\xe2\xf1\xab\x90\x74\x88\x55\xe7\x01\x6b\x9b\x5c\xb0\xa8\x9c\x11\x75\x48\x13\x09\x4a\x7b\xe5\x22\xc1\x9e\x77\x6a\xf4\x55\x8a\x87\x88\x77\x45\xe2\x5c\x87\xb4\xeb\x3a\xdf\xa7\x0d\xd3\x88\xbe\xa7\x08\x81\x68\x1b\xaa\x33\xbf\xa1\x43\x50\xf3\xaa\x26\x36\x16\xaf\x3c\xbb\xd0\x47\xa0\x0d\x32\x68\xf5\x71\x51\x28\x27\x9b\x96\xd9\x53\xd4\x4a\xa4\x48\x20\x3b\x91\xa4\xd5\x57\xd3\xde\x36\x49\x11\xd5\x9c\xf6\x5a\x4c\x4d\x7b\x41\x24\xb5\xbe\x3e\x43\xd9\x46\x9b\x82\x00\xa1\x43\xf2\x5a\x5f\x4d\x9c\xa0\x7c\xad\xef\x89\xeb\x00\x6f\x2e\xf5\x1c\x0d\xa1\xe2\xe7\xa0\xdc\x3b\xc5\x24\xa7\x43\xb2\x5a\x5f\x5e\x37\x36\xe0\x64\x7c\x9d\x67\x72\xd3\xb5\xdf\x78\xa5\x4f\xe5\xeb\xb4\xdd\xa2\x8b\xec\x8b\x2e\x22\xb3\x63\xf1\x4f\x93\x54\x6b\x18\x6a\x25\x2a\x9d\x8e\xf1\xbf\xae\x62\xb1\x43\xb6\x48\x76\x2c\x1b\x58\xb5\x0c\xb1\xed\xd9\xed\xbd\xdf\x0e\x74\x14\x19\xe1\xbd\x39\x98\x57\x0c\x7d\x36\xd7\xdb\xe8\x1d\x62\x9f\x40\x5e\xc2\xe4\xda\xa4\x75\x82\x26\xd5\x8d\x23\x71\x4e\x83\xf1\x2f\x21\x86\xae\x19\xed\xca\xfc\x9f\xb7\x21\x7e\xaa\xcf\x62\xae\x9a\xa3\x2d\xf5\x81\x6f\xb0\x0d\xa4\x7b\x06\x03\x6b\x49\xd7\xd0\x65\xf2\x3b\x86\xaf\xfc\xa5\x3c\xa8\xea\x81\x1c\xb1\x8f\x40\x1d\x59\xcf\x55\x87\xcb\x8e\xbd\x1e\x40\xa0\xdf\x37\xfa\x8e\xea\x19\xf4\x5d\xe5\xcd\xfc\xc2\xbc\x19\xe8\x5c\x17\x74\xe8\xd0\xf1\x1a\x84\xf7\xbf\xef\xb7\x47\x6e\x3b\x24\x56\x6c\x74\x33\x5e\x4b\x8b\xd8\xc7\xbe\x7b\x73\x2a\x99\x47\x78\x05\xd0\x38\xb7\xc5\x7e\xd5\x44\x6d\xcb\x06\xaf\x0d\x48\x8a\x87\x36\x4c\xf9\xfb\x2a\x3d\x88\x7f\x94\x76\xc6\xdf\x8d\x74\x8f\xa8\x8a\xae\x25\xa1\x42\x7c\x22\xd8\x28\xfc\xcc\xa0\x49\x6a\x0d\xd0\x27\xb8\xba\xc9\x37\xe0\x0f\x6c\xac\xce\xf7\x68\x64\x87\x52\xed\xc8\xeb\xa6\x59\xa7\xb2\xd3\x16\x7a\x7a\x7b\x94\x0b\xf6\x62\x81\x8f\xf7\x03\x39\x71\x43\xbd\xa2\xc5\xcf\x65\xe7\x50\xec\x75\xd0\x7e\xcd\xf3\x8f\xce\xd3\x23\x36\xe7\x6d\x70\x57\xd5\x20\xe1\x4f\x54\x6d\xd7\xa4\x8c\x3b\xa7\x3e\x5e\xda\x7a\xa5\x6a\xbb\x66\xa8\xfa\x50\x13\xd3\x0d\xd5\xaf\x3c\x14\x9b\xf1\xdb\x75\x1e\xf1\x82\xae\x16\x7a\x16\xf7\xbe\xe2\x6d\xac\xe7\xdc\xe7\xf3\x08\x54\xfc\xf6\x13\xc5\x27\x8a\xa9\xd5\xa5\xcf\x38\xcd\xd8\xfa\xf7\x8a\xc7\xda\x1a\x16\xaf\x0d\x9c\xa3\x78\x68\x93\x9a\x65\x28\x9e\x67\x13\x82\x49\x5b\xda\xd1\x42\x9d\xd8\x2b\xf7\xa4\xd7\x18\x2b\x5f\x0d\x83\xf2\x72\x80\x7c\xd8\x30\xc7\x1c\x98\xf7\x8e\xdf\x0f\xfd\x27\x5d\x98\x9a\x0e\xd4\x21\x1e\x1b\xa6\xfa\x45\x21\x7b\x43\x74\x0e\xe7\x62\x4b\x7e\xdf\xb8\x9e\x2e\x69\x46\x83\x3e\xaf\xac\xb5\x18\x60\x70\x10\x6e\x88\x6c\xbb\xdf\x25\x51\xc7\xe3\x73\x39\x9b\xec\xf0\xed\x5c\x5b\x40\x20\x4f\xd9\x14\x6e\xcf\x51\x09\x38\xfa\x50\x10\x30\xb6\x5f\x0c\xd4\x60\x1f\xd8\x64\xb3\x10\x9c\x5d\xaf\x0c\x7f\x96\xb0\xcb\x9f\xf6\x84\x13\xe9\xdf\x9d\xe6\x4a\x0c\x60\xbd\xe3\x71\x63\x39\xac\xc8\xc0\x38\xb7\x2b\xbe\xc6\x1b\x11\xc9\x87\xd0\xb7\xc9\x86\x71\x62\xae\x74\xf1\xa9\xa5\xea\x95\x0b\xfd\x36\x59\x61\x29\x2e\xbe\x79\xf1\xb9\xda\x2e\xd9\x22\x12\x17\x7d\x4c\x20\xcc\x65\x72\xbd\x8e\xcb\x7f\xc2\x7e\x7d\x40\x34\xc3\xcb\xfa\x10\x5d\x83\x3c\x11\x7b\x0b\x90\x56\x38\x50\x07\xa4\xa0\xaf\x2d\x06\x1f\xc2\x84\xc1\xf0\x77\x15\x14\x39\xc4\x40\x9b\x25\x28\x62\x8d\xc6\xb4\x18\x38\x32\x47\x8e\xbd\xff\x84\xa9\xac\x0d\xf3\xf6\x01\x1e\x99\xea\x92\x0c\x1f\xd6\x14\x6a\xf6\xe4\x81\x04\xf0\x5f\xe1\xe0\x1d\x63\x8d\x47\x17\xa6\x74\x5d\x72\x0e\x07\x18\xfd\xd9\xa4\x16\x0e\xf8\xaa\x6b\x86\x8b\x15\xbc\x3a\x88\x0b\x92\xaa\xf7\x2e\xfc\x4b\xe0\xe0\x49\x60\xc2\x7e\xe1\xf9\x32\x1b\xa9\xf8\xbc\x7c\x5b\x95\xef\xca\xf2\xb3\x9e\xee\x6f\x0b\xa2\x2e\xad\x08\xf8\xb9\x39\x3b\x24\xae\x0a\x30\x17\x0b\xf2\xa4\x26\x5e\x5a\x6d\x20\xd5\x50\xda\xdb\x02\x45\x80\x03\x7b\xbc\x18\x48\x8b\x83\xe6\x77\xc2\x01\x9e\x86\xe6\x83\x59\x0c\x4a\x58\x87\xca\xb4\xc1\x80\x5a\x55\x15\x8e\xbb\x4e\x18\x12\xb9\x24\x87\x12\x0c\xfb\x8f\xb2\x5e\xf6\xfe\x4d\x91\x67\xdc\x7c\x22\x7c\x88\x55\x85\xd2\x99\x78\xea\xc0\x77\xb1\x35\x70\xcb\xea\x56\x90\xb0\x49\xa7\xda\x37\xd7\x3d\x4b\xf3\x4a\x73\x3b\x41\x1d\x6a\x15\xb6\xd6\x25\x95\xaa\x8a\x46\x7c\x49\xc6\x88\xb0\x90\xef\x81\x23\xc3\x6f\xde\x74\x95\x20\x8a\xdb\x90\x60\x48\xbf\x71\xfb\xbc\x0b\xd3\xf7\x81\x5e\xdc\x06\x35\x56\xfb\x78\xe5\x62\x07\x15\x23\xef\x73\x08\x44\xa3\x31\x4d\x36\x02\xd5\x6d\xb0\x1e\x11\xa4\xcb\x19\x0b\x26\x4f\xd2\xee\xe8\xe6\xbb\x19\x01\xab\x8c\x6e\x26\x2f\xaf\xda\xa5\x4f\xaf\x63\x1b\x10\x49\x52\xed\xab\x64\xcf\x37\xb0\xeb\xea\x1f\xe7\x2c\xe0\x5d\xb6\x55\x15\x33\x0c\x6c\x52\xad\xf4\x3d\x79\xe2\xb4\xd8\xeb\x39\x43\x03\xe3\xaa\x5e\x1c\xb2\xc9\x09\x5b\x2b\x27\xf1\x67\xa2\xbe\x3a\x2c\x91\x14\xa9\xe3\x12\xe7\x7a\xd4\x39\xfe\x96\x1d\x1e\x10\xd6\xd5\xbe\xb0\xac\x4b\xa5\x4f\x8d\x2f\xa3\x04\x78\x24\xee\x4d\x4f\x6a\x45\xc3\xc1\xf1\x55\xf8\x38\x09\x6c\xd9\x7d\x9d\x7b\x5c\x7f\x53\x07\xe2\x60\xe0\x9a\xea\x7b\xd4\xf5\xed\xe5\x62\x75\xaf\xfc\x65\x10\xe3\xc9\x51\x5b\x36\xd0\xf1\x2a\x7a\x20\x06\x59\x75\x63\x6d\x43\x1d\x12\xad\xfb\xdf\xf4\xd6\x35\x84\x27\x55\x4c\xf0\x2d\x8e\x2f\xc6\xcc\xd9\xff\x7a\xe4\x1d\x7c\xbf\x2a\xcd\xf0\xf4\x13\x5d\x13\xf4\x01\x6d\x34\x88\x89\x62\x74\x4d\xbe\xc1\xc4\x08\xea\xee\xe2\x14\x04\x6c\xa1\xb6\xea\x7b\x2a\xa6\x86\x46\x57\x56\xfd\x8d\x70\xe8\xd5\x15\x1f\xf5\xd3\xb7\x81\x36\xd6\x1c\x19\x16\xfb\x82\xe0\x62\x2a\x30\xe6\xcf\x4d\x5b\xb6\x2a\xfd\x27\x2e\xb0\x6e\xb7\xef\xa9\x63\x48\xf4\x43\xb8\x42\x3e\x20\x4c\x8d\xfd\x38\x87\x2f\x1c\x75\x1f\x0b\x7b\x92\xeb\x9b\x41\x9d\x54\xd9\x37\x23\x70\x10\x49\xe5\x55\x52\x39\x0e\xd8\xcd\xf0\x10\x61\xec\x46\xcb\x83\xf1\x9b\x93\xbb\x30\x1a\xdf\x4f\x2d\xe6\x4f\x7b\xb5\x20\x57\x86\xa6\x83\x2a\xfb\xd3\x68\x72\x1f\xa8\x4e\x3d\x9c\xfb\xf2\xd4\xc3\xbe\xff\xd5\xa9\x87\x73\x5f\x9e\x7a\x30\x50\xaf\x4e\x3d\x20\x82\x3e\xf5\x70\xee\x7f\x72\xea\x21\x49\xfa\x77\x4f\x3d\x54\xcf\x7d\x79\xea\x01\xe9\xf0\x53\x0f\x87\xbe\x3c\xf5\x70\xe8\x1b\xa7\x1e\x4e\xfd\x4f\x4e\x3d\xc4\x69\xbf\x38\xf5\xd0\x3d\xf7\x3f\x39\xf5\x70\xee\x1b\xa7\x1e\x70\x15\x47\x9d\x7a\x80\x49\x79\x71\xea\x01\xd8\xf9\xe7\xa7\x1e\x90\x0a\x3f\xf5\x80\xbc\xe9\x53\x0f\x27\x35\xf1\x76\xe5\x00\x07\xdd\x60\xfd\xaa\xce\x3c\x38\xc7\xd7\xbe\xbc\x38\x03\x5d\x06\x8c\xa4\xa3\xc2\xb1\x54\x45\x94\xb7\xfd\xa1\x06\x12\xa7\xf6\xe3\x43\x76\xd9\xe9\x75\x3f\x15\x35\xd4\xc8\xe5\xa9\xa7\xbf\x39\x2f\x2d\xd3\x1d\x26\xf9\xe0\x49\x2f\xd4\xf4\x8a\xbe\x80\x23\x5f\x91\x69\xa2\xbf\x31\x02\x7d\x02\xf9\xb4\x09\x47\x31\x0f\x02\xcb\x50\xad\x7f\x79\x29\x62\xb5\x89\xc3\x8f\xdf\x50\xbd\xf8\xdb\xb4\x06\x7a\x01\x1a\x9c\x29\x52\x90\xeb\xc8\x03\xba\xf8\x51\x6c\x80\x20\xdb\x6a\x21\x38\xfe\x01\x4d\xa0\x9b\x1f\xa6\x9b\x16\x62\x60\xfb\x1f\xfc\x0b\xec\xe9\x0f\x73\x23\x5b\x44\x67\xac\xf2\x03\xc7\x67\xba\x78\x75\x6f\xdf\x65\xaf\x38\xa0\x27\xcf\xfa\xf6\x93\xda\xf3\x0f\x63\x32\xcb\x1d\x34\x36\x73\x8a\x22\x51\x41\xd4\x01\x7d\xf9\x54\xcc\x48\x17\x44\xc5\x01\x7c\x5d\xf6\x4c\xdc\xd6\x2b\xc8\xbd\x67\xab\xa3\x5a\x73\x3c\x9a\x3c\x73\xfc\x81\xda\x01\x07\xc1\xa1\xb3\x9e\x4a\x97\xbf\xa8\xf2\x05\x13\x3d\xe4\xf0\x8f\x4c\xc0\x80\xe7\xeb\x8c\x23\xe9\xdd\xf9\xdb\x09\xf7\x7c\x18\x6f\x6f\xc2\x81\x9e\x80\x7f\x83\x19\x09\x46\x2b\x38\x24\x61\x84\xc5\x07\x9b\x40\x16\xe6\x63\x87\x76\xc5\x1d\x74\x6d\x10\x27\x91\xb0\xaa\xe7\x60\x9c\x26\xae\xc3\x54\xab\xc5\x12\x86\x8f\x37\xd8\xf0\x91\x62\x84\x17\x51\x81\x00\xd5\xc0\x54\x7c\xb2\xec\xad\x8d\x61\xc9\x2f\x8c\xd8\x40\x9b\xe8\x7b\x2d\x44\x6f\x7e\x82\x3e\xe1\xfe\xfa\x17\xf6\xc8\x13\x84\x12\x36\xe5\x21\x24\x85\x07\xee\x72\x3d\xed\x6d\xcd\xd1\x83\x33\x57\x21\x7c\xa0\x08\xd4\xa8\xcc\xf3\xb4\x9c\x1d\xc3\xf4\xac\x2e\xb1\x91\x88\x5a\x8e\x41\xf4\x33\xb4\xe5\x58\xed\x17\x19\xf2\x63\x57\x6e\x79\xe3\xd4\x71\x5f\xed\x0f\x65\x1e\x19\xcc\x57\xab\x30\x1a\x41\xf7\x89\xe8\xe3\x83\x05\xb4\x62\x1b\xd7\xd0\x30\x06\x3c\x15\xeb\xe9\x9d\x11\x35\xd3\xbf\x70\x51\x27\xc2\x72\x09\x6b\xa3\xac\x5f\x0c\x99\x35\x19\xc8\xac\x0b\xae\xae\xc9\x3e\x97\x59\xa5\xff\xe3\xa1\xce\xf8\x0d\x25\x30\x56\x5a\x88\x1e\xb9\x9f\xcb\x0c\xd1\xdb\x40\xb7\xdd\x05\x3f\xb3\x76\x1d\x98\x5f\xae\xa1\x67\x76\x96\x45\xb0\x60\x7b\x4f\xfa\x8c\x0e\xbf\xc1\x0b\x44\xdb\xd5\x0a\xe6\xe3\xbc\xc7\xa3\xf0\xb8\xab\xe7\xb6\x5c\x54\x16\xce\xfd\x2a\x7d\x6d\x4a\x03\x7e\xff\x00\x1a\x2a\x44\xdf\xeb\x4a\x5f\x65\x73\x81\xfc\xbb\xfd\xef\xb8\xd7\xf4\x6d\x82\x7f\x9f\x61\x26\x0d\x2c\x59\x36\x5f\xb4\x86\xb8\xc3\xd6\x7e\x97\x9f\xc5\x37\x0c\x18\x14\xda\xb6\x6f\x0d\xb8\xd3\x55\xb5\x4e\x94\x01\x67\x5d\x69\xc0\x17\xdb\xb0\x86\xa6\x6d\x1a\xf0\xd1\x96\x06\x0c\x55\xcb\xf6\x4d\xb4\x01\x03\x8f\x13\x45\x53\x1a\x70\x62\xff\xd9\x80\xd3\xee\x95\x01\x6f\xed\x3f\x1b\xf0\xae\x7b\x6d\xc0\x36\xc6\x5b\x68\xc0\xd5\x8e\xc1\x72\x6c\xdf\x31\xe0\x55\xb7\x6c\xc0\x07\x69\xc0\xb9\x11\x4b\x6a\x03\x5e\x74\x0d\x03\x0e\x4c\x03\xae\xd8\x86\x01\x37\x6c\x61\xc0\x99\x34\x60\xbe\x06\xc8\x0d\xb8\x51\x18\xf0\x91\x15\x06\x9c\x31\x9e\x9e\xb9\x63\x2f\x0f\x31\x46\x6a\x9d\xbe\x9c\xaa\x4c\xa0\x17\x76\xfa\xe3\x07\x8b\x49\xa8\x2c\x21\x82\x2d\x30\x75\xd0\xca\x8b\x21\x5d\x34\xf5\xa4\xf3\x67\x53\x4f\xed\x2b\x53\xdf\x76\xfe\x6c\xea\x3b\x5b\x9a\x7a\xd6\xb9\x36\xf5\x45\x61\xea\x95\x5b\x53\x5f\x77\xb4\x29\x40\xbb\xdb\xd2\xd4\xa3\xce\xad\xa9\x2f\xed\xc2\xe8\xae\x4c\x3d\xb6\xb5\xa9\x87\xb4\x16\x0f\xd1\xd4\xfb\xd6\x96\xa0\xb1\x7b\x97\x2d\x41\x73\x5f\xd2\xfa\x72\x88\xe6\x1e\xd1\xda\x72\x58\xe4\xf0\xc9\xcf\x6c\x18\x91\xea\x92\xda\x88\x29\x06\x92\x88\x6e\xe2\xa1\x5e\x96\x98\xfc\x50\x72\xe5\x48\x2a\xac\x8f\x68\x63\x39\xf4\xcd\x6f\x1a\x2e\x69\x65\x39\xc4\x73\x82\xf0\x0a\xcb\xcb\x2b\xa3\x78\x4c\x17\x13\x75\xcd\xd1\x92\x36\x91\x08\x2f\xf2\x1c\xd3\x2a\x62\x6e\xcd\xd5\x2a\xe4\x6e\x45\xe3\xd5\x50\xdd\xef\x14\xd3\x74\x35\x34\x57\xd2\xc7\x3a\x68\x75\x39\xa2\x62\x89\x0b\x6d\x1e\xd1\xd5\x7a\x28\x17\xaa\xc6\x45\xea\xcd\xb6\x74\xc1\x40\x11\x64\x94\x66\x54\x6f\xd7\x4f\xaf\x2b\xba\x5e\x0d\x95\xb6\x62\xba\x5c\x0d\xf5\x7a\xf8\x92\x6e\xd7\xc3\x22\x75\x37\xa2\xcb\xf5\xf0\xd7\xcd\xa2\xd5\xd3\x0f\x08\xf9\x48\x83\xd8\xab\x21\x1e\x38\x8f\xe9\x02\x49\x88\xc0\x65\x49\xd7\x48\x41\x66\x2a\x0c\x2c\x52\xdb\x0c\x75\xa6\xc2\x00\xa2\x8e\xf5\x10\x07\xdb\x18\x25\xea\xe1\x05\x2c\x14\xa8\xf4\x74\xe4\xa1\x77\xb0\x9b\x84\x6b\x64\x85\x85\x33\x9e\x64\x92\xa9\xcb\xd6\x7a\xf8\x77\x45\x23\x6c\x84\x98\xc9\xc5\xf4\x82\xd4\x02\xa9\xd4\xe6\xca\x68\x43\x91\xb3\xec\x8b\xfd\x1d\xd0\x66\x6d\x05\xf2\x6c\x72\x4a\x4d\xe0\xa0\xbd\x1a\x3e\x72\x2d\x73\xb8\x4a\x78\xc1\xda\xe4\x51\x42\x6a\xc7\x4a\x60\x7e\x4c\x0f\xdb\xa1\x9c\x4b\x41\x5d\xf9\x6e\xf8\xed\x5e\x7e\x74\x84\x3a\xfe\x21\x8f\xc4\xda\x3c\x9e\xb5\xa1\xaa\x0c\xab\x82\xb8\x9c\x5e\xb0\x2a\x90\x2f\xb5\x10\x12\x51\x5c\xf8\x50\x15\xac\x62\x55\xc1\xdb\x92\xee\x57\x45\x05\xf0\x6e\xb1\x1f\xbe\x1b\x4b\x5d\x5b\xb2\xa1\xd1\x0e\x98\xcb\xc8\x9a\x56\xb7\x43\xcf\x9c\x00\x81\x2d\xed\x87\xf2\x83\x50\xbe\x45\x3a\xbb\xa1\xcc\xa7\x7d\x69\x90\xca\x16\xf4\x10\x52\xe8\xa5\x58\x68\x4d\x41\x9e\xfb\x6b\xbd\xed\xaf\xf5\xb6\x1b\xa2\x0f\x8d\x69\x27\x1e\x62\x8a\xd2\x92\x86\xeb\xa1\x4e\x9a\x19\x00\x63\x87\xe1\x41\x27\x1f\x68\xd6\xf6\x8a\xb5\x9d\x62\x6d\xa0\x58\x3b\x98\xac\xed\x15\x6b\x03\xcc\x8c\x50\xac\xed\x14\x6b\x87\x6b\xd6\x0e\xd7\xac\xed\x39\x6b\x30\x7c\xed\x87\xea\x5a\x3a\x99\x4a\xc1\xf7\x6e\x0b\x96\xc6\x3b\x1a\x1e\x86\xea\x1b\x53\x5b\xba\x3b\x28\x41\xcb\xcf\xa8\x8d\x7f\xca\x85\x64\x8e\xa8\xee\x07\xe1\xdd\xf0\x65\x4d\x17\xc9\x55\x37\x7c\x13\x13\x2e\xed\x2e\x76\xc8\xe7\xc8\x6c\xe5\x96\x56\xb1\x06\xa5\xbd\x0d\x8d\xd3\xa1\xba\x5f\xca\x58\xdb\x5d\x23\xda\xbb\x0a\x7a\x37\xe0\xe9\x62\xb2\xa3\xdb\x64\x88\xf9\xbe\xdc\x53\xab\xd4\xc4\x35\x6d\x1d\x86\xbf\xf5\x98\xd4\x00\x54\x3e\x8b\x88\x89\x13\xd3\x40\x2d\x8e\xae\xf8\x9e\x15\x3e\x27\xa0\x83\x30\x1b\xaa\x34\x96\x35\x3d\x60\xab\xd6\x72\x46\x29\xb6\xc7\x76\x34\x47\x2d\x25\x64\x4f\x0f\xe9\xf0\xe6\xce\xba\x37\x23\xa7\xfe\x3a\xa5\x77\x5c\x9a\x6f\x6b\x37\x53\x7c\xc3\xec\xf3\xfd\xe7\x03\xcd\xd2\xa1\xda\x7f\xde\xd3\x4d\xaa\x3c\x08\x88\x3e\xc9\x86\x3d\x39\xd5\x7c\x06\x25\x65\x43\xb9\xd7\x18\xf0\x42\x30\xf1\xe2\x9c\xe2\x25\x6a\xfe\xd0\x48\x99\x90\x3e\xec\x80\x52\x96\x2b\x09\x93\x3d\xed\x24\x43\xef\x6a\x69\x60\x3c\x84\x88\x00\xbf\x77\x26\x5c\x67\x4a\xe3\xe3\x90\x99\xab\x1a\xf3\x3d\x5d\xe4\xd8\x3b\x65\xa3\x47\x78\x33\x25\xb3\xf0\x0a\x2c\x72\xa0\x95\x64\x48\xaf\xc6\x65\x40\x3f\x16\xe8\x13\x89\x6e\x4b\xf4\x38\xbd\x45\xef\xde\xa2\xc7\x4c\xa0\xef\xcb\xe8\x6f\xd0\xd6\xd3\x35\x7a\x4e\x58\xc2\xd0\x65\x1e\x68\x7e\x87\x7a\xeb\x74\x43\xdd\x92\xd4\xeb\x65\x74\x90\xe4\xe6\x5c\xa0\x53\x36\xe4\x57\x62\xf0\x0b\xc2\x8a\x6f\x10\xde\xc9\xe4\x7e\xb9\x33\xd8\x0c\xfe\x69\xee\x41\xb1\x5f\x73\xa4\xf9\x69\xa8\xae\x18\xcc\xb0\xa5\x54\x1f\x79\x77\x75\x5e\x2b\xcd\x4e\x6a\x48\xf4\x33\xba\x3a\x95\x14\xea\xf5\x1e\xde\x52\xba\x3f\x0f\xd5\x95\x5d\x09\x36\xea\x9b\x1a\x01\x03\x1c\x2e\x8e\xb4\x92\x03\x8d\x39\xef\x4d\x7c\x4a\xef\x26\x74\x71\x1e\x5e\x1d\x3e\x38\xd2\xf5\x09\xcd\x2b\xa3\xcb\xd3\xb5\x79\xa5\xb4\x72\x1a\x16\x19\xc2\x09\xed\x9c\x86\xf2\x2b\xa2\x13\x49\xba\x42\x32\xda\xc8\x87\x22\xc3\x50\x9d\x47\x39\xd2\x36\xbe\x93\x0d\xc9\xe8\x25\x1f\xe2\x35\x09\x47\xda\x44\xb0\xe8\xae\x19\x3d\xe5\x7c\x28\x3c\xd2\x3a\x42\xb9\x73\x1a\xe4\x74\x67\x0d\x55\x6e\x7a\x71\x6f\xd3\x6b\x31\xa0\x9f\x68\x66\x0d\xd5\x67\xa0\x73\xda\x42\x74\xb1\x73\x02\x1c\xd5\x80\x3d\xde\x91\x27\x19\x4d\x6b\x43\xbd\x57\x8e\x21\xd7\x1c\x57\x76\x67\xce\x4c\x7d\x60\x93\x13\x82\x7a\x73\x7a\xa8\x69\x1a\xce\x9a\xa8\x9b\xa3\x4e\x74\x8f\xef\xdf\x8a\x90\x04\x24\xb4\xa9\x0d\x07\xa6\x7d\x24\xe4\x44\xb7\x88\xd6\x24\x39\xed\x1a\x74\x6c\xa0\x33\x95\x74\xd6\x96\x0e\x6d\x72\xba\xb8\x0c\xf9\x71\x8d\x8f\x81\xae\x67\x8b\x0d\xda\xd2\x9c\x2e\xb1\x31\xaf\x47\xba\xad\x0d\xd5\xd5\x76\xa0\xf8\xda\x50\x2e\xf2\xf8\xb2\xae\x04\xea\xaa\x5d\x78\x40\x01\x92\xb6\x86\x6a\xeb\x08\x14\x58\x1b\xaa\x6a\x69\x8b\x0e\x1f\xe6\x17\x5a\xa9\x63\x3c\x78\xa6\xdd\xfa\x90\x1a\x97\x37\xbc\xde\x4d\x63\xd6\xeb\x13\x07\x1a\x8d\x8a\x5b\x1a\xfc\x62\xbd\xd9\xbb\x09\x8b\x86\x51\x32\x54\x19\x2c\x67\xda\x68\x0c\xf5\xc5\x9c\x63\xd4\x04\x77\xf8\x6f\x27\x6a\x37\xf1\x66\x69\xce\xa8\x01\x87\x36\x37\x87\x1d\xf5\xa5\x58\x4d\xf9\x0d\xc3\xfc\x88\x5c\xa8\x8d\xdc\x0b\xf3\x95\xc1\x0c\x95\x3b\x27\xa0\xfb\x5a\x03\x24\x03\x75\xd5\x08\x5e\xb8\x75\xa1\xfb\x26\x1f\x3a\xce\xb4\x56\x1f\xaa\xab\x69\xe8\x85\x1f\x87\xd3\x19\x1e\x83\xeb\x08\x75\x5c\xa3\xe7\xa6\xea\x0e\xbe\x45\x3b\xcd\x42\x85\x93\xdb\x9d\x3b\x23\x29\xe1\xc7\x10\xa4\x39\x97\x0b\xe1\x6e\x0d\x2b\xef\x9b\xb3\xc2\x35\xf0\xb1\x6a\x0d\x07\xca\x8a\xf1\x02\xee\x67\x08\x1a\x40\xed\x35\x1a\x37\x95\x45\xb8\x1c\xf9\x15\x04\xd7\x1c\xaa\xdd\x61\x9c\xc7\x8a\x81\x0c\xfe\x06\x03\x63\x59\x48\x5d\x3d\x58\xa3\xcd\xc6\x50\xdd\xc4\x65\xd1\x1d\x0a\x5d\xec\xfc\xd2\x23\x1b\xa9\x01\x51\xb5\x74\xd2\xa0\x95\x36\xca\xd1\xa2\x97\xc6\x50\xdf\x70\xb3\x62\xfa\x86\x9b\x06\xb5\xdb\xaa\x0f\x4e\xea\xb4\xd1\x1e\xe2\x6e\x87\x84\x4a\x9c\x3a\x4d\x3b\x43\x6a\xa6\x1f\x40\x31\xab\x83\x7e\xcc\xa2\x8b\x66\x41\x37\x35\xe9\x9e\x3b\x06\x5d\x20\xe0\x3d\xcc\x25\x50\x93\x5d\xda\x43\x2a\xef\x61\x56\x64\xf7\xb6\x60\xf7\x68\x90\xad\x99\x64\xb7\xb6\x41\x16\x08\x08\x76\x39\x54\xd3\x6d\xd9\x37\xec\x86\x5d\xec\x0c\x30\x5b\x35\xe8\x76\x4d\xba\x15\x93\x6e\x0b\xe9\x46\x44\x42\x25\x4e\x93\x2e\xba\x43\x91\x23\xaa\x67\xfc\x30\x25\xc7\xbc\x2d\x88\xa7\x5a\x34\xe9\x0e\x9f\xf4\xa5\x24\xa8\xe0\x26\x5d\x61\xad\x9e\x9c\xbc\x38\x72\x7d\xdb\x93\xc9\x7f\xa2\x54\x7e\x5b\xaa\xf6\x69\xa9\xf0\x0f\xa5\x16\xf7\x4b\x79\x57\x77\xdf\x9d\x68\x52\xec\x21\xab\x63\x54\xe3\x21\x68\xfb\x7b\xa9\x99\x72\x0b\x80\x7e\xfc\x21\x25\x7a\xae\x07\xb6\xfb\x6f\xaf\xb7\xe7\xf4\x53\x71\x34\xc8\xc8\xde\x3b\x51\xbc\xfc\xe6\x4d\x73\x26\xcb\xe0\x7d\xb2\xd2\x45\x68\x67\x34\xb9\x77\x8d\xac\xf9\x2d\xce\x61\x6c\x0d\x6f\xae\x91\x35\x11\x9a\xb4\x63\x17\xde\x8a\x07\xa5\xe2\xbe\x96\x06\xcd\x2b\x43\x95\x78\x60\xc0\x9b\xf4\x58\x19\xb6\x88\x41\x53\x0c\xb6\x0c\x2f\x4a\x27\xf8\x61\xc8\x90\x32\x47\x1d\x80\x3c\x83\x86\x22\x54\x87\xf8\xb9\x85\xe2\x35\x5b\x46\x22\x30\x9c\x46\x50\x4d\x52\x19\x2e\x89\x9a\x4d\x88\x04\x23\x6f\xb5\xf0\xbb\x44\x9f\x5e\xe4\x1e\xb6\x59\x1d\xe2\x9e\xe8\xa0\x56\x1d\x3e\xc3\x88\x99\xd3\x35\xc3\xd3\x00\x0e\x8c\x56\x7c\xf3\x64\x4f\xf0\x53\xe3\x9e\x70\xac\xcf\xee\xc1\x5d\xc9\x60\x5f\xec\xac\x78\xda\xb9\x3e\x77\x69\x25\xf4\x65\xaa\xd5\xcd\xcb\x36\x6d\x75\xc1\xe2\x13\xf3\x52\xfb\x0e\x0d\x2b\x60\xfc\x39\x6d\xd2\x86\x5d\x04\xf5\x67\xdf\xbd\xb8\x2b\xa2\x9b\xd6\xa5\xd9\xc2\x57\x2d\x8d\xfc\x36\xdd\x55\x86\x9e\x4c\xd3\xd4\x94\x72\xa4\xb4\x07\x4a\xd5\x6b\x4a\x9d\x6b\x4a\x95\x6b\x4a\xad\xca\x1d\x9e\xaa\x92\xa7\x4d\xf7\x8a\xd2\xb2\x77\xcd\x53\x74\xcd\x53\x75\xa8\xb6\x42\x0b\x9e\x90\x52\x46\x2b\xb4\x1a\xf9\x06\x25\xc7\x64\xa9\x4a\xc3\xa5\x41\xa8\x42\x3b\x91\x0f\xc2\xe6\x60\x3d\x59\x6a\x72\x9f\x6f\xd3\x34\xf6\xa5\x1f\x77\xab\xb4\x82\x0c\x8c\x2b\xb4\x81\xff\xf3\xac\x0b\xd1\xd5\xaa\xb4\x8d\x10\x61\x8c\x15\x7a\xc1\xba\xdf\xaa\xb4\x89\x40\xe1\x53\x2a\xf4\x84\x95\xcc\xaa\xb4\x1e\xf9\x6a\x9f\x96\x57\xd2\x84\x4a\x96\x58\x89\x8c\x39\xbd\x9e\xd0\xf5\x9a\xc7\xae\x53\x0d\xe4\x9d\x28\x26\x5d\x1a\x23\xb2\x18\xd3\x6c\xda\x58\xfa\x4b\xdc\x37\xe8\xbb\x58\x21\x17\xd0\xb4\x42\x37\xc8\x80\x48\x09\xaa\xd2\x04\x81\xe2\x53\x12\x15\xba\xc2\x37\xc0\xc5\x5e\x03\xa1\x39\x0b\x0e\x34\x52\x72\x03\x79\x82\xe1\x55\x77\x6c\xb9\x92\x73\x64\xc8\x88\x7b\xfb\x09\x6d\x3c\xb6\xf4\xa6\x0f\x3d\x98\x37\x8b\xeb\x1c\x97\x72\x3e\xe5\x82\x85\x2b\xff\x2a\xb1\x3b\x64\x9d\xd8\xa7\x2a\x64\x21\x0d\xf3\xdc\x20\xbd\x09\x54\xf6\xa4\x4d\x78\x26\x9b\x4e\xe8\x1f\x1c\xc8\x91\xf4\xd4\xac\xb9\x41\xce\x6b\x1f\xe5\x1b\xb2\xa3\x24\x3b\x47\x25\xae\xd7\xfe\x87\x5e\x9c\xa9\xd0\xe5\xda\x6f\xe9\x69\xb8\x23\x2e\x82\x72\xf8\x3a\x8d\xda\x6a\xe1\x47\xc8\xc4\xd5\xc3\x21\xe3\xe5\xd5\xd9\x3c\x30\xb3\x95\x3f\xc2\x0a\x79\xa6\xdf\x82\x35\x63\x1f\x17\x95\x42\xd6\x88\x41\xdb\xc0\x9b\xdf\x33\x66\xa2\xca\x09\x14\x06\x8b\x47\x3a\x33\x02\x86\xb5\xd2\x34\x11\x51\x2c\xf1\x55\x68\x6b\xe5\xe3\x2d\x07\x0b\x96\x20\xdd\x90\x86\xec\x80\x74\x61\xc0\x18\xa9\x5d\x24\xd0\x2f\xf2\x73\x93\xfb\xe9\xb3\x13\xd9\x90\x87\x35\x03\x96\xb1\xf5\x67\x9e\x10\x7e\x06\xfe\x9b\x9a\xff\x09\xe0\x7c\x83\x36\x85\x6c\x83\x28\x89\xcc\x19\x87\x8e\x60\x36\xb1\x25\x9b\x68\xac\x00\x49\x86\xc4\xba\x12\x6f\x2c\xd5\xec\x64\x58\xf6\xce\x52\x12\x5f\xb6\x8a\x18\xdb\x41\xec\x97\x33\x9a\xe1\x49\x0e\xc6\xef\x2d\x64\x50\xc7\x1a\x6c\xda\x66\x98\x0c\xd7\x66\x5c\x1c\x8f\x1c\xde\x10\xf0\x2a\xb5\xd7\x20\xc9\x36\xc3\xdb\x9d\x0d\xd6\x6d\x1a\xb1\xce\x1e\x24\xb2\x65\xfc\x0b\x05\xcc\x29\x6e\x49\xe0\x6b\x03\x0b\x66\x25\x4a\x05\x93\x90\x9d\x12\x5f\x0f\xfc\xa8\x6a\xa1\xf5\x4a\xa1\xea\x09\xf6\x34\x91\x3b\x9f\xb3\x05\xab\x27\xfe\x50\xd5\x97\xa2\xa8\xa0\xbe\xcd\xc1\x1f\xf3\x2b\x82\x7b\xb8\xf2\xde\x73\xe1\xef\x82\xd9\x89\xff\x28\x11\xcd\x85\x68\x07\xaf\xad\x8f\x40\x6d\xb5\xc4\x9f\x60\x36\x09\x2e\x00\x02\x7a\x3b\xf1\xbf\xe1\xf7\x13\x52\x82\xf9\xfc\x11\x4b\x91\x66\x93\x42\x50\xf9\xc7\xbb\x18\xc4\x0c\x65\xc1\xb2\xcc\x68\xd3\x21\xbb\x6e\x93\xe8\x0a\xcd\x52\x9b\x42\xde\xa6\x3d\xb4\x29\xcf\xae\xdb\xd4\x26\x11\x5b\xa4\x50\x3f\x58\x2b\x66\xf4\xf0\x36\xc5\xc0\x64\x3d\xfb\xbc\x4d\x36\x76\xaa\x0c\xda\xb4\xe5\x6d\xda\x02\xba\x95\x41\x9b\xd6\xa2\x4d\x67\x94\x13\xd2\x3c\xff\xa3\x36\xa1\x9e\xb6\xb9\xd1\xa6\x55\xfe\xef\xf4\xb4\xcf\x6f\xf5\xd4\xc9\xee\xe8\x29\xcf\xff\xac\xa7\x5d\x7e\xa5\xa7\x2c\xbf\xd6\xd3\xe2\xf8\x8f\xf5\x84\xde\x20\x3a\x1b\x6d\xaa\x9e\xae\xdb\x24\x12\xeb\xec\x52\x9b\x62\xde\xa6\x0c\xda\x14\x9f\xaf\xdb\x54\x01\x3d\xd5\x72\xa8\xbf\xc2\xf5\xb4\xe5\x6d\x42\xc1\xef\xcf\x9f\xb7\x09\x5d\xc6\xf2\x0c\x6d\x4a\x78\x9b\x12\x40\xdf\x9e\xa1\x4d\x7b\xd1\xa6\x3a\xca\x09\x69\xd6\x29\x8b\xbd\xc7\xd2\x56\x4a\xa9\x4d\xea\x13\x8d\xe5\x41\xc1\xad\xf5\x7b\xf2\xc2\x82\x22\x83\xa3\x98\xeb\x8f\xbb\xd4\x62\x66\x74\x2a\x5d\xfa\x30\x3c\xfa\xdf\x6f\x66\x64\x31\x4b\xcf\xfe\x6d\x9c\x5a\x0a\x42\x43\xb2\x62\xf9\xd9\x67\xe5\x93\xdd\xf2\x65\xcc\x56\x48\xa4\xd8\x4c\xf1\x8f\xe0\x0b\x29\x4c\x55\x88\x75\xf1\x7b\x57\x67\x89\xd8\xc5\xa8\xaf\x48\x8d\x09\x8e\x44\x0d\x2a\x2b\x96\x9c\xfd\xbe\x1a\x55\x62\xb6\xc0\x02\x56\x91\x0c\xc2\xd5\x3d\x5d\xb1\x75\xc1\x50\xcc\x8e\x9a\xaa\xbb\x64\x6b\xcb\xff\x30\x2e\x83\x30\x94\xba\x05\x5e\x3b\x27\x9f\x5f\xa7\xcf\xf6\xc8\xdb\x3c\x62\x8d\x0b\x98\x04\xb4\x1b\xf4\xbf\x27\x85\x47\x3b\x92\x23\x97\x39\xbd\xca\x7f\x83\x51\xce\xf2\x55\x88\x3a\x89\xd9\x09\xa9\x15\x97\x0c\x88\xa5\xd9\x25\xdb\x5e\xfc\xd4\xd8\x92\x60\xcb\x8b\x5f\x2b\x46\xbd\xfe\x50\x4d\x50\xa9\x13\x04\x52\x8d\x2b\x56\x3f\x81\xe7\xdf\x52\xd0\xc9\x89\xfb\xd8\x25\x8b\x91\xc5\xa9\xbe\xaf\xc0\x85\x2e\x71\xf1\xd5\x19\x8a\x23\x49\xdd\xd1\x43\x93\x35\x48\x58\xc7\x6d\x3d\x10\xc3\x0e\x0b\x0a\x99\x2c\x59\x88\x85\xd5\xfa\x77\xc4\xba\x67\x7f\x45\x94\x58\x45\x59\x0b\xca\xda\x35\xc0\xb2\xa0\x6c\xa7\x10\xe1\x91\xd4\xd8\x87\x5e\x02\xd7\x47\xfa\x06\x19\xb1\x7a\xfd\x87\x8a\xeb\xb8\x03\x95\x2d\xb2\x62\xd1\x49\x6b\x0b\xa9\xe4\x3e\x8e\x37\x2b\x16\x9e\x74\xcc\xe7\xf2\xc0\x4b\x7c\x47\x50\x76\x17\x68\xc9\xe1\xec\x73\xcf\xb6\x62\x6d\x2c\x33\xe3\x65\x71\x09\x7f\xc9\xac\xb3\x0a\xb9\x00\xaf\x76\xc6\x90\x0b\x3a\xd4\x24\x00\xb5\x81\x94\x72\x1c\x1f\x29\xd0\x54\x4b\x0d\x16\x39\xb5\xfc\x0f\x19\xbb\x65\xe4\x0c\xdd\x15\x39\xc8\x51\x35\x16\xe1\x88\x4a\x0c\x0e\x3d\xe9\x09\x03\xc8\xe4\x70\x01\x85\x3e\x9c\x7b\x0e\x6e\x18\x9e\x7b\x9c\xf8\xd3\x43\x9d\xc5\xac\xc5\xbb\x29\xa8\x20\xc7\xee\x7e\xb7\xb8\x83\x65\x73\x0f\x94\x6b\xc1\x10\x9c\x7b\x4b\x56\xb9\xf0\x07\xde\xd7\x73\x8f\x8f\xb9\x16\xea\x0c\xdf\x5b\xa0\x11\xfb\xc2\x1f\x22\xec\x74\x2f\x02\x82\xde\x41\x53\x47\x1b\xc9\x80\x60\x03\x0b\x64\x40\xf0\x2c\x1e\x38\xc1\x4c\x10\x3c\xa3\x48\x10\x7c\x86\xe2\xb9\x78\x88\x58\x0b\x09\x9e\x41\x71\x1d\x98\xea\xe5\x4a\x5b\x78\xb9\x5d\x82\x92\xb8\x60\x44\x22\x24\xa1\x6c\x92\xdf\x62\x89\x7a\xad\x73\x49\x34\xce\x10\x01\x3e\xec\x7b\x4e\x0d\x62\x1a\xf8\xef\x8c\x37\x47\x40\xd1\xe4\x04\x02\x81\xf8\xe1\xd3\xa2\x8e\xd7\x21\x0f\x5b\xc1\xe2\xd6\xa3\x39\xa6\x13\xa3\x4c\xea\xc0\xd9\x16\x9a\x00\xf6\x05\x4d\x10\x96\xfb\x02\x0f\xdc\xa8\x7f\x08\x48\x9d\xbf\x5a\xb2\xa4\xee\xbb\xd7\x04\xd7\x82\xe0\x5a\x10\x5c\xa3\x4c\x10\x79\x0d\x04\x73\x7c\x48\x50\x88\x48\x30\x41\x29\x20\x41\x84\xd4\xc4\x2b\x30\xfd\x86\xef\x1a\xbd\xef\x20\x7b\xdf\x8a\xd9\x68\xac\x7b\xe8\x62\x97\x9a\xaf\xce\x5c\xc1\x70\x55\x61\x83\xdb\x6f\xbe\x55\xd9\x50\x8e\x0f\x98\xd4\xe5\xaa\xb5\x2a\x67\xe3\x0e\xa5\xf5\x9f\xc1\x56\x6d\xac\x4a\xe4\x48\x46\x6c\x87\x0a\x86\x18\x57\xb0\x54\x01\x77\xbf\x6a\x4a\xc8\x56\x3c\xf0\xfb\x20\xa8\xf3\x88\x7f\xd9\x12\x9a\x18\xb9\x74\x8b\x59\x10\x50\x7a\x83\x28\x11\xb0\xbf\x17\x0f\x7c\xd7\xd6\x45\x5c\xf8\xfb\xaa\x3e\x7f\x04\x3d\xf9\x02\x3d\x00\x66\x31\x2c\xb6\xa0\x16\x0c\xca\x16\x2d\x28\xc0\x21\xe2\x81\x07\x8f\xbc\x16\x5b\xd4\x12\x8a\x5a\x42\x28\xbd\x44\x94\x10\xdd\xa4\x78\xe0\x77\x46\xf0\x5a\x42\x5e\xcb\x54\x6c\x30\x0f\x20\x7c\xc4\x06\x74\x5b\xb2\x01\x4d\xf1\x00\xd2\xb4\x40\xee\xbc\x91\x58\x3d\x32\x5e\x6d\x49\xc6\xdb\xe2\x81\xeb\x0e\x1a\xd6\x56\x5d\x54\xdc\x95\x84\x9c\xa6\x58\xd8\x56\x85\x11\x72\x6a\x4b\xde\xb3\x36\xe7\x87\x17\x06\x7e\x73\xc9\xef\xc6\x02\xf7\x1c\xba\xc6\x29\x8a\x97\x61\xf1\x35\x2a\xee\x82\x12\x1c\x0a\xc6\xd2\x03\xc1\x58\x24\x57\xfa\xe5\x18\xf8\xc8\x57\x30\x9f\x0b\x3f\x0b\x23\xb8\xca\xc3\xe3\xc3\x0b\x9e\x9b\xe7\xe3\x89\xfa\xf4\x9e\x9c\x28\xf2\xa1\x41\x15\xc3\xa9\x45\xce\x9c\x23\x19\x0a\xe7\x72\xf1\x6f\xce\x05\xc0\xe8\x06\x51\x87\xcf\xa9\xc5\x58\x87\x30\x98\x35\x3b\x2c\x46\xd0\x7f\xd9\x86\xe5\x8b\x11\xbb\x39\x96\xc5\xdf\x7b\x62\x1d\x48\xf8\xb0\x0d\x3b\x2f\x46\x78\xa7\xf6\x9a\x35\xb0\xa8\x34\xc8\x40\xaf\x9d\x73\x4a\xf2\xac\x96\x28\xef\xe3\x27\xb2\x8c\x3d\x37\x8f\x7f\xca\xc0\x55\xb1\xce\x86\x25\x58\x40\xbe\x5d\xb3\x63\x34\xc2\x39\xca\x86\x59\xd1\x48\x1d\x91\x17\x60\x93\x11\x28\x56\x8f\x24\x23\x9d\xe8\x3e\x23\x40\x40\x32\x32\xe1\xe5\xaf\x19\x99\xdc\x32\x92\x47\x26\x23\x97\xa5\x64\xa4\xb9\xd4\x8c\x4c\x38\xb8\xc4\x48\x7b\x29\x19\xa9\x2e\x15\x23\x93\x2b\x46\x80\x40\xc1\x08\x94\xff\x92\x11\x6b\xa9\x19\x81\x12\x8d\x58\x32\x62\xc7\x26\x23\x00\xf6\x44\x64\xa3\x18\xa9\xc4\x23\x4c\x92\x5d\xb3\xe5\xea\x3e\x23\x40\xa0\x60\x04\xca\xfb\x38\xf1\xba\x66\x24\xbe\x62\xa4\x19\x9b\x8c\x74\x90\x6c\x02\x8c\x84\x6b\x93\x11\x00\x97\x24\x12\xad\xa5\x44\x36\xeb\xfb\x8c\x00\x81\x82\x11\x28\xff\xa5\x44\xec\x95\xc9\x48\x75\x2d\x25\x12\x6f\x4c\x46\x00\xec\x89\xd5\x0b\xc5\xc8\x7a\x33\xc2\x59\x38\xd8\xe1\xe6\x3e\x23\x40\xa0\x60\x04\xca\xfb\x38\x3d\xbe\x66\x64\x7b\xc5\x48\xb8\x29\x18\xd9\xb2\x2a\x92\xcd\x59\xb1\x2a\x2b\x0f\x4e\x16\xab\x1c\x3b\x16\x6f\x47\xea\x22\x81\x2d\x3b\x6e\x47\x2a\x6f\x1d\xb8\xdb\x8f\xbe\xa9\x24\x1c\xfa\x5d\x6c\xf6\x8d\x1d\xbc\xa3\x9b\x82\x9d\xec\x46\x03\x1c\x2f\xf5\xb7\x12\xce\xf2\x83\x86\xd7\x2b\x28\xa5\xcb\x98\x5c\x5e\x5f\x5f\x2e\x40\x6d\x59\x47\xb6\xdb\xdc\x01\xf7\x77\xac\x82\x72\x1b\x4b\x9c\x1a\x6f\x04\xd9\xb1\x36\x42\xc5\xc2\xcf\x9e\x1d\x77\x23\xb5\x5d\x27\xbf\x3e\xea\xca\xad\x87\x22\xe5\x55\x44\x9f\xe0\x31\xef\x84\xf5\x93\xe1\x7a\x3d\xba\x09\xeb\x27\x7b\xb6\xda\x8f\x74\x98\xfd\xfc\xfb\x0f\xcb\xcf\xe1\x9f\xbf\xa6\x15\xf2\xb8\x38\x22\x7a\xad\xa9\x38\xbe\x1a\xf2\x6f\x6a\x15\x17\x1c\x18\x87\x2e\x68\x4a\xcc\x37\xe6\x4d\x6c\xae\x79\x0a\x21\x28\x96\xae\x97\xf4\x9b\x9e\xc6\xe8\xa3\xcf\x07\x92\x1f\x46\xea\x14\x54\xc2\x16\x87\x11\x55\xfb\xc2\x8e\x99\xed\xee\x1f\x58\x33\x19\xc9\x65\x99\x98\xec\x59\x2d\x19\x3d\x8a\xba\xbd\x25\xfd\x0d\x31\x74\x44\xe7\x2e\xe6\x66\xfd\x52\x0b\x39\x07\x12\x26\x23\xa6\xd6\x88\x12\xd6\xda\x8f\xa8\xbe\x17\xdd\x55\xd7\x73\x6b\xf9\x7b\x7c\xd6\xc1\x37\x1d\x44\x89\x94\xd9\xfb\x91\x5a\x93\x01\xdf\x9e\x28\x93\xe3\xfb\x19\x62\x64\x38\xb2\x7d\xa2\x4d\x00\x26\x99\xc9\x48\xce\x92\xe4\x61\x0c\x25\x8b\xf2\x15\x46\x77\x16\xf9\x8b\x0b\x7f\x5c\xdc\x8d\x95\xd3\x2c\xc6\xdb\x20\x7e\x64\xac\x83\xe2\x11\xa4\xc1\x4d\x79\x2a\x89\x25\xc0\x1b\xdd\xd5\xe1\x95\x01\x06\x3d\xb1\xfb\x4d\x36\x23\x26\x5a\x90\xcf\x8e\x2b\xbf\x30\x37\x35\x60\xd4\x33\xbe\xf5\x2d\x60\xc3\x70\x01\x26\x17\xcb\xf2\xb2\xe9\x33\xf1\x0b\x06\x0c\x4f\x12\x40\xe1\xc9\x4d\x5a\xbc\xf5\x12\xbb\xd9\xb0\xbe\xd2\x25\xe7\x19\x3b\x26\x23\x5f\xd8\x28\xf8\xb5\x8d\xbc\x32\x73\xae\x4d\x62\x8e\xeb\x80\xc6\xb1\xcf\x71\xd1\x2d\x8e\x2c\xc7\x86\xcf\x64\xe5\x57\x77\x12\x49\x43\x2c\xbe\xe0\x63\xdc\xcc\xb6\x61\xd3\xbb\x77\xa3\xe1\xf5\x40\xea\x6a\x34\x56\x49\xd1\x92\x12\x56\xc5\xff\xcd\x80\xd0\xbd\x39\x7f\x3c\x97\x1a\x16\xb6\x9d\xb1\x25\x72\x53\xf8\x9a\xbb\x93\xe9\x62\xbf\xe7\xde\x56\x4f\x78\xf7\x4e\xab\xc2\x20\x8e\x6c\x6d\x9a\x14\xaf\x50\xe5\x00\x1e\xdc\x22\xbb\x41\x37\x33\xa6\x6b\x7e\xd7\xca\x8a\xa6\x8a\xff\xc8\xc8\x52\x8b\x69\xee\x2a\x29\xdf\xda\xfc\x00\x53\xe0\x75\xff\x96\x75\x97\xe2\x97\x49\xc6\xba\x68\x78\xc6\x67\xf9\x78\x46\xf5\x54\x93\x2c\x56\x28\x5e\x91\x62\xb1\x90\xff\x6c\xac\x18\xb0\xf6\x41\x68\xb4\x7a\x18\xe9\x0b\x1f\x26\x4f\xca\x33\xd1\x9b\x8f\x32\x4f\x9c\xef\x98\xd5\xe2\x4c\x07\x6a\x3e\xcc\xce\x87\x91\xbe\xe0\x8d\x1d\x0e\x23\xcc\x03\x03\x53\xd1\x50\xe8\x23\x2b\xa4\xbe\xa5\x46\x82\x89\x76\xfa\x82\x25\xd0\x28\x32\x32\x90\x4d\xdb\xec\x0b\xd7\xfe\xeb\x49\x6f\xb8\xa9\x9d\x08\x10\x09\x62\x9f\xa1\x1b\x2c\x38\xff\x1b\x76\x74\x0b\xe7\x36\x31\x92\x34\x21\xd8\xc1\xaf\xc7\xcd\x71\xad\xe0\xee\xe5\x79\x60\x4c\xf1\x61\xf4\xf3\xee\xf5\x79\xd0\x5b\xc0\x41\xe1\xce\x53\x4f\x52\x87\xaa\xb7\xa2\x6a\x08\x47\xad\x11\x15\x1b\x94\xe2\x15\x7d\x94\xf7\xe4\x94\xa5\x7c\x9d\xb5\xf0\x26\x8d\xc1\xd1\x97\x0f\x3a\x32\xb3\xa1\x74\xbd\xe0\xf8\x4e\x66\xce\xeb\xf5\x20\x55\xa8\xc9\x58\x75\x2f\x72\x48\xe7\xd7\x4f\x63\xd6\x57\xa4\x37\xf4\x87\x74\x18\x63\xb1\xcd\x8a\x4f\x07\x72\xae\x8d\x54\x86\x4f\xce\xba\x96\xf2\xcd\x11\xd9\xd0\x77\x65\xbb\xe0\xc2\xeb\x23\x75\x31\x54\xce\x96\xb5\xd1\x1b\x16\x59\x13\x65\xc9\x27\xb6\xaf\x8d\xaa\xf2\x0b\x0a\x5e\xce\x76\x88\x31\xe5\x18\xc6\x1d\x8b\x27\x96\x73\x2c\x45\xe7\x88\x58\xf3\x02\x4b\xa8\xe2\xc4\xea\x1c\x4b\x88\x21\x67\x35\xc4\x0a\xc9\x2d\x31\xbb\x20\x06\x68\x97\xda\x48\xee\xf5\x18\xb4\x0c\xd9\xb8\xa6\xc7\x0b\x4e\xac\x59\x1b\x5d\x65\x17\xe5\xec\x54\xbb\x0a\x2e\x0a\x85\x44\x85\xa0\x8b\xb1\x75\x7e\x62\x16\x92\x10\x9a\xcf\x59\x5a\x53\x21\x99\x7b\xdd\x1f\xb9\xc8\x8b\xc0\x36\x10\xf7\x31\x70\x59\xa8\x03\x75\xb2\xb4\x3c\xbf\xa7\xbe\xe8\x56\x04\x00\xe6\xbd\x0f\xf4\x2a\xdd\x2f\xa2\x92\x50\x20\x1b\xc1\x09\x8d\x35\x1b\xb3\x7f\x4a\x28\xa6\x7f\xe6\xe8\x1f\x13\xb2\x55\xd3\x06\x26\x47\xbe\xe6\xe8\xf5\x33\x42\x05\xb5\x75\x41\xad\x7d\x97\x5a\xf0\x47\x6a\xa6\xb7\xa7\x6a\xe4\xf5\xc5\xfd\x90\x26\xb5\x2f\xc5\x5e\x22\xb4\x2d\x08\x6d\xe9\x3d\x42\x81\x26\xf4\xfa\x4f\x09\xed\xaf\x09\x59\xec\xd0\x11\x1c\xe1\x56\x3e\x7d\xfc\xe7\x84\x9e\xef\xc5\xd1\xec\xa7\x0e\x65\xdc\x1b\xcf\x74\xff\x2e\xd4\xff\x2f\xbc\x15\x75\xcc\x3b\x93\x0a\xd2\xd2\x59\x29\x3e\x8a\xea\xca\xbd\x32\x57\x11\x85\xad\x2b\x7a\xd3\x63\x82\x99\x02\xb6\x6f\xdd\xc6\xf0\x73\x8b\x2d\xec\x91\xbe\x71\xf5\xd3\x41\xa3\xc6\xd6\xf6\xe8\xf7\xfd\x41\xc3\x62\x2b\x7b\x34\xd5\xed\xfe\x85\xac\xbf\xe9\xc6\xff\x12\x63\x91\xfa\x04\xe2\x3b\x8f\x3d\x5c\xe3\x42\x81\x99\x6e\x19\xf7\x0e\x50\x55\x6e\x5f\x79\x27\x1f\x0c\xc3\x1e\x99\xc7\xcc\x85\x2a\x6a\x2c\x43\x44\x39\x45\xb1\x58\xb5\x63\x34\x44\x0d\xdf\x17\xd6\xc4\xd3\xdd\x77\x6f\xa7\x38\xb3\x4b\x77\x74\x24\x72\x27\xdb\x3c\x99\xa0\x43\xa4\x84\xd4\x58\x64\x8f\x74\x16\x17\xeb\x76\x54\xdc\x10\xc8\xef\xfe\x63\x62\x19\x0b\x6d\x1d\x2e\x49\x9c\x29\x2e\x70\xbd\x61\x26\x1c\xd7\x64\xc0\xe9\xe0\x4c\xc0\x62\x2d\xf9\x9e\xd7\xe4\xca\xb4\x09\x19\x0b\x51\xe7\x2a\x83\xbe\xc6\xec\x8e\xf2\x4b\x81\xc5\x3a\x1d\x88\x36\x4a\x5d\x36\x28\xd9\x83\x78\x0c\x49\xd1\x47\x04\x7f\x23\x88\xc9\x65\xdd\xe3\x3b\x14\x8a\x2e\x60\xd0\x52\xe9\x49\xae\x90\x04\xe7\x45\xf4\x40\x93\x97\x40\x2b\x34\x30\x3d\x98\x7e\x14\xbc\xc4\x57\xbc\x34\xaf\x78\x09\xe4\xf5\x78\x92\x83\x82\x17\x83\x56\x5c\xf0\x92\x5d\xf1\xf2\xb5\x5c\x5c\xd3\x5f\xe8\xc7\x90\x48\x5e\xda\xc0\x4b\x03\x79\x19\xfc\x03\xb9\xb8\x65\x27\x06\x54\xda\xa6\x5c\x5a\xc8\x4b\xf0\xa9\x5c\x5c\xd3\x2d\xeb\x47\xe4\xa5\xd2\x19\xe1\x97\xcb\xea\xec\xb8\x0c\xe4\x58\x3c\xd0\x13\xfd\xa0\xc1\xce\xcb\x40\x75\x8b\x3a\x4b\x11\x47\x26\x45\x01\xc9\x1d\x31\x5d\xfd\x4b\x83\xe5\x88\x2c\x8c\xb6\xce\x0e\xcb\xc0\x33\x63\x7e\x08\x3f\xdb\x7e\x4f\x4f\x63\x7c\x95\x5b\xd6\x6e\x8e\xf0\xda\xd4\x3a\x5b\xc5\x81\x9a\xef\x00\x5f\xab\x40\xde\xdb\x0a\x3d\x71\xb9\x0a\x46\xb2\x25\x32\x09\x14\x69\xf0\xfa\x46\x46\xe7\x13\xe0\x3a\xeb\x2e\x02\x73\x01\x53\x80\x21\x9c\xf5\x7d\x7e\xc6\x05\x6d\xd2\xf1\x26\x2a\x48\x6e\x0c\x71\x37\xdb\xa9\x78\xdf\xf8\xd5\x4d\x93\xdb\xe9\xe3\xdb\x30\xea\x8c\x9e\xca\x13\xc8\xb7\x3a\x6b\x61\x13\x4b\x73\xc8\x37\xa8\xa8\x3f\x94\x17\x88\xf3\xcb\xc6\xe5\x1d\xe0\x30\xa3\xe9\xab\xef\x23\x8f\x1b\xac\x82\xbc\x0b\xdf\x3a\xa8\x62\x27\x5d\xd3\xfe\x19\xaf\x7f\xa8\x13\xef\x54\x19\x4d\xe4\x30\x02\xc1\xb1\xa7\x12\x49\x12\xd2\x60\x61\x1c\xc8\x4b\x39\x13\x42\xeb\x84\x1f\xef\x60\xbc\x50\x15\x0b\xab\x51\x7f\xa2\x73\x4f\x32\x86\x9f\x97\x78\x11\x3f\x9c\x03\xc5\xb3\xe0\x0d\x96\xad\x03\xdc\x8f\x02\xb5\xaf\x03\xbc\x7b\xb9\xc6\xe2\x6d\x20\x2f\x8b\xc4\x31\x71\x15\x3c\x4a\x0a\x6b\xc2\x79\xed\x0b\x12\x5b\x3e\xbf\xca\x41\x5b\x55\xa4\x90\x48\xbe\x9c\x84\x0d\x54\xf4\xcd\x77\xac\x1b\xac\x8d\x65\x04\x3b\x75\x76\x41\x41\x21\xfb\x4d\x84\xa2\xb2\x4f\x1b\xad\x6c\xa7\xc2\x64\x6e\xba\x2b\x11\x84\x8d\x50\x0b\x0f\xf9\x41\x37\x59\xad\x82\x81\x9c\x68\xf0\x1d\x0a\xa8\x2f\x73\x07\xca\x0d\x1c\x5d\x4c\xe9\x77\x2c\x80\x34\xa1\x86\xba\x59\xef\x0a\x0d\x56\x7c\x61\xd7\xd7\xdc\xd9\xc5\x6f\x3f\xa5\x8d\x1e\xea\x3a\xa1\xed\xde\x9f\x56\xc5\x8c\x25\x0a\x35\x15\x19\x34\x58\x82\xf6\xfe\x22\xad\x6e\x29\x3b\x10\xfc\x78\xdf\x78\x6e\x79\xc0\xbe\x37\x55\x16\x9d\x7b\x26\x10\x9d\xeb\x09\x6a\x87\xe6\xbb\x00\x6f\xd2\xe3\x3d\x49\x9d\x52\x48\xdc\x22\x4d\x20\x32\x02\xc1\xac\xf7\x7e\xd2\x13\xe3\xb8\x60\xbc\xe2\x15\xdd\xfd\x59\x0e\xdc\x7a\xc2\xdd\xa1\xe1\x5e\x09\xcb\x97\x1e\x40\x55\x03\x63\xef\x2a\x70\x74\x46\xa2\xcb\x3b\xe1\x87\xae\xad\xa8\xf9\xaa\xb2\x94\x1e\xfb\xca\x5a\x9f\x13\x7a\xee\xf5\xb8\x2d\x9c\x57\x01\x2e\x10\xf2\x9d\xb6\xeb\x1c\x26\x7d\xbd\xb8\x5c\xde\x82\xc8\x00\x4d\xaf\xb8\x72\x4f\x08\x43\xaa\xd5\x02\x83\xdd\xa0\xdd\xd5\xa9\x53\xa7\x03\xfe\x21\x03\x8c\x58\x6b\x6c\xbf\x0a\x7a\xd2\x9c\x38\x89\x6f\x46\xcc\x36\x91\xa6\x95\x23\x09\x71\x62\x03\xec\x6f\x1d\x50\xf5\xa5\xbb\xbd\xb8\x96\x97\x93\xe2\x1e\x4f\xca\x04\x3c\x5e\x07\xd1\xe4\x7d\x3c\x16\xfb\x89\x15\x73\x34\x7d\xad\xdb\xc0\x98\x66\x04\xae\xfc\xb4\x02\x92\x49\x0a\x43\xe6\xb6\xe1\x19\x13\xec\x37\xe9\x5c\xd7\xda\x07\x80\xad\x9e\xa2\x40\x4d\xdf\x39\x93\x93\xe2\x13\xcd\xc5\xef\x7d\x69\xf5\x76\x2c\x3d\x61\x3d\x0a\x98\x66\x7a\x87\x8a\xe4\xbe\xd3\xd3\xb7\xc6\x0e\x8c\x88\xf6\x2a\x20\x2c\xaf\x3f\x89\xfe\xab\x6b\x9d\x3a\xaf\xce\xcd\xd2\x53\x83\x45\x51\x20\x03\x04\xa8\x6f\x11\x2b\x21\x8d\x9d\x98\xfe\xd4\x4b\x8d\xca\x44\xc6\xa6\xad\xd3\xdb\x9b\xee\x24\xb9\x99\x14\xd6\x65\xa1\x3b\xed\xcd\x9a\xd7\x55\x87\x34\x27\x53\xf7\xce\x7e\x0e\x4c\x8e\x9b\x0b\x43\x42\x8d\x45\x40\x75\xf0\xe3\xea\xf1\xa4\xbd\x08\xe4\x1d\x20\xc0\xc6\xae\x5a\x4c\x4e\x17\x7a\x76\x0a\x11\xd5\x32\x90\x47\x30\x4a\x9f\x47\x9c\x3b\x78\x09\x4a\x06\x36\xdc\x8d\x82\xc0\x98\x05\x0b\x25\x5a\x14\x8c\x02\x2b\xa8\x53\x3e\x44\x31\x79\x29\x47\x9b\x5f\xdc\xa6\x24\xc1\x31\xc6\x92\x85\x15\x6a\x55\x8c\xe5\x10\x7b\x2c\x03\xf9\x25\x4e\x4c\xc8\xfb\xc5\x3b\xc3\x52\x92\x29\x82\x07\xfc\x1a\x32\x54\xb4\x16\x2f\xc0\x4d\x87\x81\xcc\xf8\x05\x7b\x8b\xb1\xd9\x31\xd1\x17\x47\x0c\x9a\x6c\x71\x0a\xd4\x7c\x0c\x64\x74\x0e\x5c\x7d\xdf\x0e\xe6\xb9\xee\xd5\xe2\x19\xe6\x5b\xc7\x50\xe9\x9e\xdb\x7f\xc2\xff\xae\xe5\xa5\x24\xce\x4f\x1e\x6d\x31\xae\x90\xb1\xba\xac\x24\x57\x10\x7e\x73\x54\x01\x39\x13\xe7\x99\x67\x87\x60\x59\x1c\x80\x2e\x81\x2b\x47\x45\x91\x76\xac\x9e\x1b\xcc\xbe\x04\xf8\x6d\x13\x86\xe2\x2e\xd1\x15\x37\x52\x25\xfc\xef\x0c\xff\xcc\x41\x5a\x16\x0c\x13\x53\xe8\xe7\x56\x30\x7c\x98\xb1\x23\xc1\xb6\x42\x0b\x2f\x81\xc7\x47\xb1\xfc\x12\x0c\x15\xb9\x5c\x5d\x8c\xc5\x03\xea\x89\xf8\x20\xe7\xf3\x4f\xd9\x3e\x68\xd9\x23\x6f\x93\xbe\x7a\x25\x52\x75\x0f\x90\x77\xb6\xc0\x8c\x00\xd2\x66\xab\x1a\xf6\x59\xd2\x62\xfb\x3a\xb2\x5a\x21\xd5\x5a\x80\x45\x16\x34\xb3\x02\x5c\x37\x6c\xb2\x2a\x56\x3f\xa3\x0b\x7e\xc9\x17\xf7\xc5\x2d\x16\x4b\xec\x53\x2d\x50\x27\x11\x17\xb4\x69\x81\xd7\x9a\x85\xf4\x62\x05\x66\xb0\xcf\x75\x07\xaf\xeb\x56\xa0\xee\x88\x0f\xe9\x0a\x71\xde\x16\x74\x8f\x40\xae\xca\x90\x48\xe8\xab\x09\x9d\x4b\x60\x20\x81\xdc\xe4\xe7\x36\x6b\xd5\xf8\x58\xa5\x3a\x79\x58\x7c\xc3\x54\x58\x79\x97\xd8\x44\x7e\x56\xa6\x48\x8b\x17\x7f\x16\x74\xad\xc9\xbf\x85\xb4\x75\x51\x7d\xdf\xc7\x1b\x87\x45\x97\x04\xce\x40\x77\x6a\x6e\x52\x61\x5d\xac\x6e\x52\x65\x51\x5d\xf3\xc0\xf7\xa1\xc7\x72\xb5\xf1\xe5\x21\xa8\xb0\xb4\x09\x11\xdf\x6c\xe1\x56\xea\x8a\xfc\x94\x56\xf0\xc0\x9f\xd0\x58\xf1\x67\x20\x55\x37\xaf\xb0\x63\x2b\xc0\x45\xf7\x85\x7b\x6e\xf2\xc8\x34\x74\x4f\xcd\x00\x17\x0e\x17\xee\x16\x1f\x62\x52\x61\x87\x5a\x80\xf9\xd7\x4e\x7b\xc0\x2f\x4f\x61\x95\x34\x50\xfe\x62\x4d\x6c\xb6\x6b\x04\xfc\x0a\x1b\x16\x36\x82\x9e\x86\xe3\xdf\x33\x14\xae\xb5\x82\x37\xc9\x31\xae\xc1\x63\xa6\xdd\x0b\x34\xcf\x6d\x37\x83\xef\x4a\x76\xbc\x29\x11\x20\x1f\xdb\xc1\x8b\x02\x2e\xdc\xb0\x15\xfc\x7c\x98\x55\x18\x98\x84\x27\x18\xde\x92\x2a\x5b\xd7\xb1\x95\xc0\x55\x3d\xc0\xec\x69\x96\xd4\xd5\xa0\x94\x00\x85\x65\x1d\x70\xcf\x80\xb6\xaf\x73\xee\xb1\xa7\xf2\x97\xd1\xcd\xcb\x75\xf1\x32\x86\x76\x6f\xda\x01\xe5\xed\xce\xc4\x43\xe8\xa6\x6d\x25\x6c\xe1\xce\x2b\x54\xf2\x5e\x6f\xdf\xe1\xfd\x60\x9b\xbc\xdb\x6d\xc1\xfb\xa9\x6e\xf2\xde\x94\xbc\x77\x25\xef\x95\x2b\xde\x6b\x88\x5b\xa1\x55\x66\xdf\xe1\xfd\xfa\x65\x89\xf7\xa5\x0d\xf4\x2a\x74\xe1\xee\x6d\xc9\xfb\xce\xbe\xe6\x1d\x73\x4e\x39\xef\x67\xfb\x0e\xef\x9b\x8a\xc9\x7b\xd3\x16\xbc\x2f\x1b\x26\xef\xfb\x86\xe0\xfd\xd8\x10\xbc\xe7\x0d\x93\xf7\x0d\xe2\x86\xac\xca\xb2\xc6\x2d\xef\xd7\x2f\x4b\xbc\x57\xbb\x40\x2f\x64\x0b\x77\x5d\x91\xbc\xaf\x2a\xd7\xbc\x5b\xca\x66\xb2\xca\x1d\xde\x97\xe1\xa3\xc1\xbb\x55\x11\xbc\xd7\xae\x78\xb7\x25\xef\x8b\xa6\xe0\x3d\x6a\x9a\xbc\xb7\x10\xd7\x02\xb4\xb0\x79\xcb\xfb\xf5\xcb\x12\xef\x87\x6a\x80\x59\x64\x20\xd5\x2a\xe7\x5d\xbf\xcc\xe1\x65\xad\x2a\x0d\xca\xae\xf2\x48\x49\xbd\x0b\xb1\xd1\x55\xa9\xb0\x75\xf8\x58\x7e\xb7\x43\x10\x0a\x24\x2f\xbf\x13\xb7\x15\x70\x79\xe2\x8b\xa6\x72\xc1\x20\x8d\xd6\x88\x1f\x64\x7c\x31\xba\xda\x14\x7f\xce\x41\x9d\x8b\xc7\x6b\xa9\x6d\x81\x44\xba\x34\xa5\x56\x59\x3c\xfe\x14\xc2\x6c\x16\x62\x73\x02\x84\x71\xe1\x09\x49\x5f\xb4\x2d\x27\x84\xdb\x21\x8c\x66\x00\x5e\x35\x95\xbb\x9d\x83\x95\xa0\x0f\x9a\x42\x9f\x6d\x70\xef\xed\xcc\x24\x8b\x48\x28\x69\x40\x09\x6e\x2c\x66\x89\x44\x96\xe0\xba\x81\xf1\x68\x2c\x4b\xc4\xa2\xea\x5f\x30\x27\x91\xe4\x44\x09\xba\x1b\x81\x4f\x07\x63\x58\x3e\x8a\x93\xb1\x5a\xe4\xb4\xad\xbe\xfc\xa1\xf6\xa5\x9e\xff\xfa\x7f\xfe\xdf\x00\x00\x00\xff\xff\x12\xfe\x82\xe4\x90\xd4\x00\x00")
-
-func _staticCssBootstrapMinCssMapBytes() ([]byte, error) {
-	return bindataRead(
-		__staticCssBootstrapMinCssMap,
-		"_static/css/bootstrap.min.css.map",
-	)
-}
-
-func _staticCssBootstrapMinCssMap() (*asset, error) {
-	bytes, err := _staticCssBootstrapMinCssMapBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/css/bootstrap.min.css.map", size: 54416, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticCssVainCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x34\xcd\x41\xae\xc2\x20\x10\xc6\xf1\x3d\xa7\xf8\xd6\x4d\x4a\xdf\xee\x25\xba\xf4\x06\xde\x80\x96\x01\x66\x21\x83\x40\x9b\x1a\xe3\xdd\xa5\xa8\xb3\x9d\xdf\x97\xff\x2c\xf6\x81\xa7\x42\xbb\x69\xc0\x95\xee\x2b\x67\xb2\x48\xc6\x5a\x8e\x1e\x4e\x32\x74\x34\xdb\x6c\xf2\xe8\x78\x27\x3b\x56\x49\xba\xb9\x9b\x6c\x04\x76\x58\xcb\xc1\x7e\xa4\x54\x53\x79\xf9\x98\x4b\x30\xd1\x77\x13\x88\x7d\xa8\x10\x87\xc6\xd8\x37\x22\x11\x4b\x7f\x17\x8d\x61\xea\xf5\x6f\xf1\xd8\x9e\xf0\xff\x97\xf6\xb3\x7a\xa9\x77\x00\x00\x00\xff\xff\x3d\xbf\xe1\xc1\x9e\x00\x00\x00")
-
-func _staticCssVainCssBytes() ([]byte, error) {
-	return bindataRead(
-		__staticCssVainCss,
-		"_static/css/vain.css",
-	)
-}
-
-func _staticCssVainCss() (*asset, error) {
-	bytes, err := _staticCssVainCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/css/vain.css", size: 158, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticFontsGlyphiconsHalflingsRegularEot = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\xf7\x65\x50\x1d\x7f\xd0\x2d\x0a\x6f\x36\xee\xee\xb6\x71\x87\x8d\x7b\x70\x77\x77\x77\x77\x09\x10\xdc\xdd\xdd\xdd\x3d\xb8\xbb\xbb\x07\x82\x04\x82\xbb\x13\xe4\xfd\x3f\xe7\xbc\x75\xea\xd6\x3d\x5f\xee\xfa\x55\x4f\x75\xaf\x5e\xb3\xba\x6b\xbe\xcc\x4c\x81\x22\x00\x20\xa2\x00\x00\x00\xff\x3b\x50\x80\xff\x01\x34\xe0\x7f\x03\x02\x90\x08\x01\xf8\x8f\x93\x57\xfe\xff\x13\x40\xc0\xff\xe9\xfc\x6f\xd0\x60\x04\x30\x02\xfe\x2f\xd0\x02\xa4\x00\xf2\x00\x1d\x80\x32\x40\x1a\x20\x03\x10\x03\x28\x01\x14\x01\x6a\x00\xd0\x7f\x95\x09\xc0\x1e\x60\xf9\x5f\xd8\x00\x1c\x01\x56\x00\xb7\xff\xb4\x28\x00\x55\x80\xc5\x7f\xb9\xc7\x7f\xac\x09\xc0\xf5\x3f\xc6\x1b\xa0\xf9\x1f\xe3\xfa\x5f\xd7\x06\xe0\xf4\x9f\x0e\x04\x60\x05\x30\x03\xc0\xff\x1d\x5e\x00\xff\x7f\x9e\xff\xe3\xf4\x3f\xd5\xff\x93\xb5\xfe\x4f\xe9\x0e\x30\xfb\x5f\x7a\xcf\xff\x73\x07\x33\x80\xfb\xbf\x2b\x3f\xc0\xe1\x3f\x67\xbb\xff\x3c\xff\x47\x63\xf9\x1f\xfb\x3f\xf3\x4d\x01\x6c\xff\x65\x9c\xff\x2b\x78\x00\xec\xff\x55\xbc\xff\xcd\xe6\xf9\xff\xbc\x39\xe8\xff\xda\x1b\x00\x10\x55\x93\x52\xfe\x7f\x3f\x0b\x48\x40\xd5\x36\x40\x01\x13\xa0\x80\x0f\x90\xbc\xc2\x9a\x3d\x8d\x9d\x5f\x42\xdf\x2c\xa1\xf5\x20\x9c\x8a\x15\x80\x00\x8b\xff\x11\x65\xd1\x46\xbe\x56\xbc\x46\x8c\x04\x88\x89\x9d\x19\x8e\x43\x23\xb9\x3a\xa8\xb2\xb9\xd9\x4d\x4e\x2b\xcb\x92\x73\x32\xa4\x5b\xd9\x9f\x6a\xd1\xdb\xac\x6a\xa5\xb3\x48\x5c\x25\xe5\x2e\x43\x73\x50\xd4\xae\x94\x68\x94\xfb\x37\x5b\xa2\x45\x25\x11\x6a\x88\x6f\x8f\x74\x3e\x47\xd6\x34\xed\xc9\xb6\xb3\xee\xb3\x90\x7d\x7e\xb2\xe4\x77\x4d\xb9\x55\x03\xb9\xf1\x93\x53\xec\xa2\xd3\x52\x61\x61\x68\xbb\x22\x8b\xac\x49\x15\x5f\x4e\x79\x9c\x12\x2f\xcd\x29\xbf\xbb\xc4\x77\xb2\xb6\x1c\xac\xf1\xe2\x84\x08\xa3\x33\x45\x05\x4b\x4b\x25\x43\x3c\xe0\xfd\x54\x23\x7f\x91\xb4\x7d\x80\xda\x28\xe4\x12\x63\x4f\x2d\xa5\xce\x8f\x18\x24\x79\xa9\xd6\xe2\x64\x1d\x5c\x26\xdc\x5e\x17\x2d\x68\x30\x99\x3e\x53\x31\x51\x0d\xd7\x60\x79\x6c\xf3\xa5\xef\x0f\x9e\xbe\x06\x04\x0b\xa6\x0b\x9b\x3f\xbc\x58\x53\x16\x91\xb0\x4e\xaa\xff\xca\x70\x34\x63\x30\x4b\xfe\x2d\x52\x54\xd1\x03\x8f\x9b\xa6\x3b\x19\x58\x2a\xac\xb2\x68\x56\x67\x42\x10\xb1\xfa\xdb\xfe\x39\x91\xed\xdb\x94\x5c\xe3\x04\x14\x84\xc3\x3d\x0d\x08\x31\x65\x1a\x5f\x2c\x42\x5a\x61\xa4\xd6\x52\x34\x4a\x3b\x8e\xb1\x4f\x07\x02\xf7\x6e\xc8\xb9\xd2\xa2\x38\x11\xe1\x2a\xae\x43\xe3\x65\x03\x82\x84\x7e\x29\x95\xc0\xe7\x0b\xc1\x21\x78\x7a\xda\x06\x07\x4d\x89\xd5\x51\x62\x8c\x19\x2d\x27\xf4\x10\xd9\xda\x9b\xcc\x44\x0c\x41\x5b\x7d\x0b\x4b\xb3\x78\x14\xa7\x6a\x28\x26\x1d\x29\x03\xf6\x54\x95\xb8\x2c\x9b\x84\xaa\x0c\x0a\xca\x4f\xe6\xb0\x50\xfa\x26\x71\x74\x55\x39\x84\x93\x7c\xd4\x34\x59\xb1\x19\x0d\xfd\xa7\xe0\xc1\x4b\x88\xeb\x26\x8b\xd1\x91\x6a\x6c\x4e\x6a\x96\x11\xe2\x1e\xd5\x52\x68\x9c\x87\xcf\xe4\xe9\x52\xe6\x1a\x67\x32\xdb\x48\xd1\xd2\x1f\x68\xe5\x2d\xba\xfc\x95\x4f\xf4\x7c\xc2\xb1\x61\xc9\x10\x6e\xb5\x18\x08\x97\x50\xc9\x01\x5f\xc7\x7d\x1b\x2c\x93\x20\x60\x7b\x87\xb0\x38\xfa\x82\x5b\x24\x7f\x36\x1e\xe9\x0f\x5e\xc2\x83\xda\x32\xa1\xd1\xae\x24\x18\xfc\x8a\x21\x44\x24\x55\xdf\x72\x75\xbb\x4b\xfe\xdf\x3f\xc7\x73\xd7\x35\xf4\x15\xf3\x75\x5f\x72\x54\x98\xba\x0d\x57\xdc\x12\x03\xb8\xef\x2c\x11\xc1\xf8\x99\x53\x63\x6a\xec\x22\x48\xdb\xc8\x46\x8e\x89\x41\xc6\x5a\x44\xdc\xca\x01\x18\xce\x99\xdd\x0f\x7b\x70\x36\x35\x97\x2d\x64\x37\x56\x1b\x2c\x7b\x46\xce\xdd\x83\x4b\x50\xba\x81\x4c\x82\xc3\xeb\x65\xfe\x5a\xba\x14\x2c\xfb\x1c\x20\x39\x49\x80\x71\x2b\xa8\x3b\x16\xc2\x67\x17\xb8\x04\x86\xfd\xe5\x07\x2f\x1e\x0c\x83\xb5\xf8\x13\x70\x01\x08\xa6\x36\x1f\x2e\x95\x89\x6a\x8d\x7f\x1f\x4e\x18\xe4\x6f\x57\xb8\x48\xf5\xa5\x69\x73\x72\x08\x4f\x75\x28\x46\x94\x41\x44\x87\x21\xf3\x22\xb2\xe1\xf5\x93\xe6\xab\xf8\x55\x31\xd0\x7d\xfc\x3c\xf5\xd9\xe7\x8b\xfd\xcf\xff\xa9\xf3\xc3\x05\xaa\xab\xa3\x4a\xe9\xe5\xe8\xe1\xaa\xaa\x10\xf5\x2d\x87\x31\x13\x14\x2c\x6f\x40\x1d\x2a\x0b\xbe\x4d\xdb\xe4\x65\x81\xbc\x44\xe5\xa5\x9e\xd1\x79\x41\x3a\x49\x69\xf4\xe5\x7f\x7e\xa3\x19\x60\x37\x26\x4a\xb6\x00\x04\x08\x7b\x62\x49\xa3\x86\x7e\x87\x13\xd7\xa5\xec\x02\x84\xb2\x07\x4d\x43\x46\x8a\x7b\xb3\xf1\x20\x41\x41\x87\x68\x05\x82\xc4\xbc\x39\xbd\xf1\xe0\xbc\x60\x83\x45\x8d\x4b\x28\xc5\x28\x69\xfe\x4c\x49\xd8\x87\x11\x7d\x2f\x5c\xb5\xee\x3f\x27\x5a\x09\x44\x46\x14\x97\x45\xa0\xa4\x75\xce\x83\xe9\x85\xbd\x52\x11\x49\x15\xd9\x8e\x12\x49\x17\xa6\x19\xa7\x44\xb6\x86\x74\x26\x9a\x02\xcf\x69\x62\x80\x41\xc6\x79\x5d\x6e\x7d\xd5\x16\x4b\x94\x93\x1c\x94\xe4\xee\x6c\x82\x96\xad\x99\x1c\xa1\x22\xa1\xbe\xea\x76\xe9\xb0\x60\x3f\x57\x3c\xe9\xd2\x27\xb4\x91\x05\x60\xc9\xb5\x31\xa1\x3c\x75\x53\xab\xdb\x8a\x75\xb9\x01\x44\x6a\x85\x88\x40\x09\x41\x51\x4c\xb3\xb1\x6a\x2b\x4d\x09\x30\x8d\x2c\x24\x26\x88\x55\xb1\x1c\x82\xbf\x04\xc3\x28\xda\x9d\xfd\x17\x45\x21\xe8\xe0\xee\xfc\x0d\x6f\xe3\x4c\xf3\x0f\xf4\x19\x22\xbd\xa7\xc5\xa2\xa4\xb1\x44\x00\x48\x89\x32\xde\x97\x6e\x53\x4b\xd0\x99\xaf\xfe\xf7\x1a\xba\xa4\x71\x74\x1f\x1b\xc1\xd8\x08\xdb\x56\x8a\xd8\xf9\x60\xec\x92\xe1\x3a\xf3\x14\xf6\x48\x04\x64\x66\xd1\x4b\xfa\x8b\x14\xdc\xa7\x80\x80\x50\x31\xde\xe9\xb1\xbf\xcf\x02\x61\x57\x71\xd5\x11\x90\xa0\xec\xdb\x3f\x66\x67\x92\x63\x45\x0b\xb6\xb3\x98\xeb\xb5\xd6\xae\xdf\x0c\x3a\x17\x6e\x38\xbf\xec\xf9\x86\x86\xa7\x7b\xae\x7f\x22\x6d\xd7\x78\x70\x56\x95\xff\xbe\x5a\x70\x1f\xf0\x48\x33\xe4\xe9\x9b\xe1\x3a\xb9\x27\x85\x9d\x08\x55\x77\xf8\x7e\xa3\x32\x49\x85\xf3\xe9\xe1\xff\x70\x97\x4d\xe5\xcf\xea\xba\x94\x69\xf2\xe1\xb5\x9b\x6d\x98\xf8\x6a\x54\xa5\x5b\x12\xa7\x9b\x67\x52\x1c\xd2\x0c\x26\xdb\x3c\x81\x8e\x2b\x6a\x7f\x53\x7c\x32\x2e\x63\x8e\x47\xf6\x70\x19\x68\x3a\x3e\xd3\x11\x2d\xd3\xcf\x22\xac\xbc\x0f\x0b\x9d\x42\xdf\xaf\x0c\x5c\xc1\x3e\x0b\xc3\xd4\x83\xb5\xb8\x89\xb9\xba\xfa\xe4\x13\x2e\x3c\x93\xa5\x91\xc0\xfd\x15\xc4\xd8\xc0\x4e\x4a\xec\x61\x6a\x2b\x1c\xe7\x5c\x39\x38\x76\x88\x7b\x83\xd9\x6a\x59\x39\xb9\x8b\x7d\x1f\x70\xf5\xb3\xdd\x42\x8b\x97\x16\xfe\x9e\xb0\xeb\x08\x58\xd8\xfa\x3b\x2c\xaa\xe5\xaf\x60\x0c\xae\x7d\xaa\x14\x49\xf9\x1e\x04\x37\x86\x15\x9a\x2d\x01\xe8\x40\x2d\x44\x81\x6b\x0c\xa2\xb1\xf0\x01\x1c\xf2\xc5\x7b\x57\x91\xae\x34\x9d\x54\x99\xf7\x7e\x19\x67\x5f\x51\x2f\xda\x83\x02\x6b\x52\xbd\x13\x74\xd7\x50\x88\x18\x22\x50\x7e\x0c\x84\xc8\x1f\x17\x5d\xcd\xa2\x85\xd2\x54\x15\x71\x2e\xb2\x7c\x35\x6c\x7c\x6b\x60\xb0\xe2\x2d\x74\x59\xde\x41\xe9\x8c\x8b\x56\x18\x73\x27\xc2\x33\x80\x22\xee\xde\xa8\x0e\x72\x3e\x97\x88\x4b\x73\x85\x15\x36\x88\x10\x26\xb9\x47\xad\xd2\x8c\x2b\xb0\xd3\x2c\x43\x80\xf6\xc0\x3c\x23\x38\x34\x3f\x35\x5c\x44\xd7\x06\xc5\x61\x1f\xa5\xc3\x64\xa8\x0a\x0b\x49\x10\xaf\x3e\xe9\xb0\x3d\x54\x66\x12\x4d\x5e\x1b\x50\xec\x8d\xf7\x64\x73\x40\x0e\xc8\xfe\x3e\x92\x7b\x1a\x84\x3d\x5c\xa3\x19\x1e\xec\x33\x6f\x3e\x50\x16\xf3\x73\xf8\xe3\x70\xe4\x78\x48\xd1\x17\x8c\x06\x4b\xc9\xc0\xc1\xe3\x41\x83\x1e\x96\x78\x6c\x41\xfd\xaf\x3f\x50\xcf\x51\x4f\x1e\x7d\x7f\x82\xf8\x35\x39\xbf\x89\xca\x57\x54\x4c\x7c\x4b\x9e\x0f\xc2\x90\xac\x30\x26\x78\xd9\x74\x2c\x8b\x8f\x7a\xf2\x0b\x35\xf2\x2a\xd8\x8a\x3d\x85\x89\x7d\xe1\xc5\xa3\xf5\x24\xa0\x6e\xfc\xef\xc2\x4d\x67\x88\x29\xb2\x8d\xbc\xee\xe9\x56\xb0\x56\xb0\xa4\xda\xe4\xa1\x8c\xf9\x2c\xf0\xb6\x88\x8b\x39\xc9\x8e\x53\xf9\x2a\x14\xca\x9e\x3b\x9f\xfb\x5d\xc3\x27\x59\x9d\x4f\xf1\x43\xb1\x28\x29\x83\xf9\x83\x3c\xb0\xfa\xe8\x9d\x2e\x6a\x14\x3f\xe3\x22\x59\x8f\x66\xd7\x4f\x83\x1c\x55\x84\x28\x04\x2a\xb0\x25\x23\x58\x26\x2d\xe8\x97\x67\x95\xd7\xc2\xaf\x0d\xe7\xf3\xac\xcb\x3a\xf7\x4e\x73\xbb\xe0\xc8\x7c\xde\x0d\xb8\x9c\xe8\xa3\x9a\x9a\x59\x5e\x80\x63\xa5\x78\x8c\x83\x4b\x67\x06\xb7\x0c\x9e\x1f\x57\x8e\x1c\xa4\xa9\x59\x88\x41\x1b\x8c\xc2\x43\x0a\x3e\x46\x55\xf3\xc8\xdc\x73\xc6\x49\x1b\x86\x68\x9c\x48\x62\xa0\xe8\xcb\x44\x35\x0c\xc0\xe8\x41\xb6\x4b\x1d\x69\xf3\x1d\x0e\x0f\xc2\x05\xb4\x00\x0b\x22\xe1\x12\xce\xa8\x08\xed\x90\x8c\xcb\x88\x08\xc9\xb7\x36\x37\x96\xaa\x4f\x50\x3f\xc7\xba\x6d\xe7\x53\x20\x09\x18\xe5\xa6\xd9\x0b\x93\x67\x3c\xc5\x95\xb1\xce\x18\x93\x54\x86\xef\x9e\xf2\xb7\x05\xf4\xc2\x44\xc7\x5e\x8e\x20\xd5\x52\x0e\x15\x0d\x85\x82\x46\xd8\xb3\xac\x45\x30\x77\x0e\x01\x41\xc9\x4c\x6a\xb1\x86\xff\xb6\xc2\x13\xc3\x60\x16\x0e\x99\xa8\x5c\x2c\xe6\xb1\x2e\xce\xfd\x07\xc6\x54\x5c\x6a\x2a\x70\x6a\xed\x1d\xc9\x6d\xfa\xf2\x61\xa9\x13\x93\x60\xff\x2e\xaa\x58\x08\x49\xda\xa3\xc8\x5d\x57\x92\xbf\x89\xb8\x61\x0d\xd0\xee\xb0\x13\xeb\xf0\x93\x17\xc9\xe7\x6f\xad\x58\x04\xba\x1f\x11\xd1\x8c\xa8\xfa\x08\x28\x68\x91\xd5\xee\xaa\x45\xb3\x53\xba\x42\xeb\xe2\xe3\xf0\x45\x26\x9b\x96\x31\xa1\x18\x12\x8d\x87\xb2\x84\x50\xaa\x7c\xb4\xbb\xa0\x3e\xca\x42\x89\xd2\x68\x99\xef\x4b\x89\x28\xb5\x54\x4e\xfd\x31\x66\x9e\xa5\x85\xdf\x45\xcf\x6f\x82\x73\x2a\x25\x66\xc7\x7a\x12\x05\xbf\xcc\x4c\x4d\x26\xbd\xb0\x1e\x15\xce\x38\xa3\xd0\xd6\x8f\x5c\xd2\xd2\xa7\x3b\x3d\x1a\x4e\x8e\x57\xdb\xa5\x84\xcb\x1a\x7b\xde\xc6\xc2\x1e\x34\xe9\x25\xc1\xc4\x14\x89\xf9\xf1\x42\xe1\xe5\x0a\xde\x6c\x1e\x9d\x52\x84\xe6\x8a\x3c\x0d\xa9\x64\x46\xfd\xa1\x40\x01\x15\xdf\x4d\x72\xf3\x43\xa3\x30\x1b\xc8\xf3\xa1\x5f\xf5\x8f\xcc\x77\x98\xd5\x1f\x07\x2e\x98\x17\x60\xa0\x0e\x9d\xd9\x04\x46\x4a\xb8\x01\x5d\x1b\x8a\xe0\x12\x0e\xee\xb9\x84\xfe\xfe\x20\x6e\x08\xc7\xb2\x18\x17\x96\x66\xca\x06\x98\x76\x87\x2d\x8e\xa5\x8b\xb5\xb1\xf2\xd7\xa7\x66\x9d\x30\x23\xa3\x03\xb0\x3c\x99\x11\x9b\x26\xfb\x28\x8d\xd5\xaf\x6a\x3a\x92\x5e\x9f\x48\x87\xde\x5f\x41\xb2\x5a\x3d\x23\xdb\x9b\x54\xa2\xd3\xba\x0d\x32\x60\x8e\x91\xcd\x97\x31\x4c\x43\x3e\x3b\x0d\xc6\x93\x27\x31\xfc\x28\xc3\x33\x2d\x4d\xc9\xaa\xaf\xb2\x2d\x63\xa2\x12\xc9\x5a\x53\xe2\x24\x8c\x3e\xda\x5b\x4c\xa5\xcd\x29\xa5\x2a\xc4\x09\x36\x81\xd1\x5d\xe1\x15\x6d\x9c\x2c\x8f\x24\x40\xd0\x8d\x95\xff\xda\x64\xd2\x9c\x16\x28\xdb\x3d\x89\x9a\x55\xff\x81\xb5\x42\x3e\x96\x6f\x3e\x3c\x21\x94\x3c\x82\x2c\x49\xb9\x6d\x0c\xa2\x78\x18\x11\xed\x84\x9d\x65\xe3\x73\xc5\xb0\xf4\x65\xbc\x31\xcf\x25\x28\xd2\xcb\x58\x46\x04\xa6\xe7\x50\x1c\x21\x93\xae\xd1\x05\xb7\x44\x6c\xc1\xf2\x20\x10\x13\x6d\x51\x29\x05\x73\x1d\xd4\x74\x0a\x7e\x64\xd3\x61\xe3\xad\x67\x57\x6c\x8c\xb2\x44\xff\x43\xe8\xbc\x17\x5a\x19\x75\xaa\x0f\xc8\x14\x5b\x18\x1e\x33\xb1\x7e\x7a\xa6\x6e\xe4\x3a\x72\x9e\x36\x3e\xd8\x0a\xf2\x69\xb1\x0a\x16\x45\xb0\x56\xb5\xac\x03\xa0\xe6\xd7\x19\x60\xf2\x23\x19\xcc\x50\x38\x7b\x19\xb9\xd3\xe6\x38\x23\xb9\x40\x9e\xf5\x27\x6c\xb6\x33\xce\xcf\x5d\x9a\x9a\xdb\xc5\xa8\x46\xad\x8a\xc6\x4f\x9f\x6c\xa9\x0b\x6d\x5e\xb0\x15\xc5\x66\x0c\xc0\xb0\x35\x98\x1d\x49\x86\x7e\x67\xcb\xc4\xce\xa2\x82\xab\x9d\x9d\x17\xc3\x9e\x77\x84\x1b\xe4\x2d\x5c\xc0\x8a\x0d\x03\xe7\xdc\x6e\x3f\xf3\x4a\x50\x53\xac\xdb\x27\xab\x1b\x19\x0d\xa8\x2d\x74\x5b\xb5\xd1\x59\x58\xb3\x6c\xb5\x43\x32\x3c\x53\xf0\x49\x12\x4b\x39\xb9\x3f\xdb\x71\x4a\x99\xba\xf2\x63\x8a\x99\x62\x4c\xb7\x62\x6d\xf3\x8d\x4c\x2d\x51\xf4\xf6\x4c\xe6\xc2\x53\x03\x56\xff\x00\x54\xd0\x21\xc5\xb0\x0a\x6f\xbd\xbe\x92\xc3\xb2\x1c\xbc\xed\x31\x15\x23\xe0\x6a\x5c\x22\x91\xaa\x03\x4f\x95\xc7\x21\x5b\xe9\x82\x30\x67\x13\xd9\x2d\x99\x3c\x39\xe5\x60\xa3\x20\x3a\x2a\xad\x3f\xda\xc1\x22\xeb\x39\xc5\x15\x0b\xb6\xdd\x8e\x6a\xce\xb5\xf0\x5f\xb9\x86\xd2\x78\xc0\xea\x80\x05\xa9\x76\xc9\xe8\x01\xcb\x1d\x40\xdf\x7d\xb0\x74\x3c\x3c\x88\x9c\x8a\x40\x0f\x7b\xb1\x1b\x23\x6a\x77\x16\x24\x8c\x08\x40\xfa\x75\xe2\x0c\x9a\x34\x19\x2c\x9f\x6b\x02\x5c\x50\x52\x06\xe5\xc2\xce\x06\x8f\x62\xd1\x1f\x0c\x19\x6d\xeb\x0f\x5c\x0b\x7f\x53\x20\xc8\xad\x66\x45\x42\x07\x57\xfa\x0a\xfe\x4d\x7c\xa6\xcb\x0f\x44\x6c\x91\x2b\xa4\x4a\xa0\x14\x43\x18\x7a\x49\xe2\x8d\x56\xb8\x79\xea\x39\xef\xe1\x30\x83\x80\x3f\x0b\x94\x70\x72\xb7\x9d\x1d\xd2\xac\x90\x12\x8f\xa7\xb3\xbf\xe5\x49\x69\x60\xcc\xd4\xf7\x1a\x28\xdb\x43\x24\x03\x50\x51\xf6\xaf\xb3\xbb\x4f\xc2\xc3\xab\x63\x88\x12\xf8\x24\xe7\x2f\xb5\xc2\xdb\x68\x10\xc8\x2e\xed\x60\xe8\x15\x8f\x7b\x63\x58\x61\x9a\x13\x85\x92\x88\xba\x5e\x53\x8e\xe2\x91\x59\xb8\x44\x06\x25\x93\xcb\x7e\xdb\x43\x2b\x81\x47\xa2\xd3\xcf\xf3\x2f\x0a\x8e\x8e\x62\x87\x4b\x44\xa1\x85\x33\x44\x3e\x28\x54\x1d\x0a\xe4\xa1\xf8\xb6\x06\x6b\x9a\x03\x7d\x28\x1d\x78\x20\x45\xc3\x6d\x84\x64\xb9\x5c\x63\x36\xd7\xe5\x2e\x0e\x70\x12\x11\xd9\x0d\x09\xa7\x75\xc9\x12\x15\xc0\xf9\x30\x1e\x87\xac\x23\x32\xd6\x8c\x7c\x7f\x39\x9d\xab\x49\xad\x5a\xcb\xc4\x1f\xa2\x7f\x33\xfd\x3d\xef\x11\x83\xa5\x5c\xc7\x81\xe9\x29\x9c\xc1\x90\x36\x05\xc5\x0c\x97\x90\x7c\xa5\x72\xb4\x52\x46\xa9\x19\x8c\x51\x84\x9f\x76\x29\x2c\x06\x46\x40\xbc\x54\x2d\x5e\x26\x82\xda\x4e\x54\xd6\x4b\x22\x46\xf7\xe1\xd3\x2e\x97\xc6\xa3\xb0\x38\x1c\x44\x04\xde\xb9\x15\x7e\x13\x7a\x83\x96\x98\xd0\x52\xfa\x26\x8c\x22\xb3\x41\xe9\xe7\x56\x9b\x6f\x43\x70\x80\x20\x01\xc4\x50\x2b\x6b\xa6\x03\x8b\xc4\x0a\xe1\x94\xf0\xa6\x99\x98\x32\xb6\xc5\x09\xcb\x65\xdf\xce\x54\x39\x51\x5f\x41\x7d\xbe\xa3\x44\xd6\xaa\xb4\x52\xd5\x22\xcd\xe7\xcb\x7b\x30\xe4\x20\x0c\x31\x24\x4a\xfb\xcd\x8c\x25\x0a\xb5\xe2\x28\xca\xcd\x10\x72\xc4\x8e\xe1\x26\x2f\x8b\x39\x2a\xbb\x9f\xb4\x20\xd3\x28\xa9\xdf\xe5\x2e\x31\xdb\x72\xee\x8d\xb4\xf2\x4c\x6c\x7b\xb6\x79\xad\xe5\x26\xd6\x60\xc8\x94\x61\x50\xa7\x31\xec\x1a\x03\x7b\xa2\xbf\xe3\xa9\xe5\x25\x9a\x99\x28\xa1\xeb\x51\x14\x59\x35\x56\xc0\xa9\x54\xe4\x06\x76\x6c\x99\x57\xe3\xea\x5d\xc9\xf5\xca\x1c\x17\x64\x18\x3f\xc0\x8e\xc4\xca\x2b\x7f\x2c\xe3\x75\xc0\x53\x34\x0e\xe6\xf6\x82\xaa\xe8\xd8\x96\xb8\x71\x4c\xd4\x43\x02\x0e\x71\xce\x6d\x84\xcd\xf4\x7f\x27\x26\x5d\x21\x0e\x1d\x4c\x80\x1d\xcf\xc4\xba\x9d\xef\xd0\x76\xd3\x4f\x83\x62\x38\x66\x7f\xc6\x27\x7a\x3f\xd1\xdf\x4e\x2f\xa8\xa6\xfa\xac\xa2\xe8\x1c\x84\x9a\x46\x0c\xbc\xfd\x52\xd7\xd3\x7f\x23\xb6\xd3\x00\x66\x79\x5e\x48\x4f\x45\x6e\xbb\x24\xc3\x39\xef\x95\x87\xca\x0c\x5d\xca\xe0\x9d\x8f\x66\xd0\xc1\x21\xc6\x98\x8a\x22\xc3\xa3\x69\xa7\x2a\x77\x29\xb6\x86\x65\xbb\xa3\xfa\x5c\xc2\xb3\x21\xcb\xfc\x15\xc4\x10\x2c\x05\x95\x93\x12\x12\xa1\xb4\x44\x7e\x54\x0e\xf3\xff\x08\x37\x8d\xce\xdd\x73\xf9\x5c\xb6\xb5\xf9\x1d\x5e\x61\xd2\x4c\x41\x0e\x5f\x8b\x5b\xf0\x8d\x15\xa2\x27\x38\xb1\x91\xf5\x50\x24\xa7\xc4\x99\x75\xed\x72\x47\x39\xb8\x42\x09\x83\x60\x1c\x0a\x93\xf8\x44\x29\xc3\xc3\xfb\x59\xe5\xe1\xb5\xb1\x73\x0e\x41\x72\xbe\x99\xf6\xcd\x7a\x27\xb4\x4a\x89\x46\x41\xdc\x7b\x4a\xfe\x52\x0e\x9d\x72\xe2\x67\xae\xf5\x5f\x6a\xe4\xd7\x98\xf2\x05\x28\x00\x6b\xec\xf5\x08\x47\x4e\x8e\x9a\x4d\xe1\xf6\x1f\x57\x69\x59\x9c\x64\xe5\x46\x77\x80\xb8\xc2\x34\xbf\xab\xc2\x9f\x60\x86\xd4\xa7\x0c\x04\xfa\xf4\xbd\x82\xcb\x8d\x0c\x4e\x0f\x5c\x36\x4a\xd8\x6a\x4b\x34\xf6\x64\x39\xd0\xae\xc0\xab\x72\xb3\xfc\xb1\xeb\xa2\x59\x8c\x0c\x5d\x50\xe6\xda\x11\x8e\xe1\x39\xa9\xb9\xc9\x37\x04\xaa\xd1\xbd\xc5\x16\x53\x44\xda\x82\xb7\x74\x61\x38\xc6\x24\x36\x4b\x46\x7f\xf2\x72\xee\x5f\xaa\xfc\xf5\x12\x8b\xc4\xf1\xfc\xb5\x75\xa9\xd2\xda\x47\xb4\x6f\x9d\x18\x05\xb8\x44\x1c\xd1\x6c\xba\x90\xa4\x87\x3b\x70\x34\x00\xf5\xea\x5f\xfd\x4f\x31\x41\xa1\x50\xa8\xa9\xbd\x6c\xb2\x0c\x5f\x86\x64\x14\x4e\x84\xf1\xa1\x39\xa9\x3a\xfe\x1c\x1b\x4c\xeb\x21\x77\x52\x5a\xa4\xf4\x37\x64\xbb\xc3\x22\x69\x04\x60\x6a\x9e\x40\xcb\x43\x5f\xe3\x9e\xde\x97\x3d\xad\x27\xc1\x64\x71\x65\x9e\x64\x9a\x2f\x4c\x41\xd0\x9a\x6a\x39\xc8\xaa\xea\x07\xda\x83\x8d\xf2\xb7\x94\x29\x48\x8a\xce\xe9\xa3\x4e\x8e\xe1\x25\x89\x05\x51\x55\x9d\xfa\x31\x43\x0e\x8b\x7b\x60\xde\x47\x70\x85\x22\x4f\x47\x82\x26\xa3\x9e\x68\x4c\x38\xf6\x48\xe5\x95\x38\x45\x90\x76\xf8\x8b\x41\x33\x23\xfe\xda\xc9\x41\x8d\xbc\xa8\x9b\xa2\xd8\x37\x5c\x64\x86\xe2\x4b\xa7\xd9\x33\x43\xef\xca\x8b\x0a\x84\xc2\x67\x94\xb4\xc4\x37\x5b\xb1\x0b\x66\x0e\xd4\x01\x1d\x74\x93\x28\x23\x5f\x0b\x88\x72\x11\x36\x41\xd7\xf8\x9b\x0d\x06\x40\x14\x74\x5d\xeb\xda\x55\x05\x54\x11\xaf\xb2\x52\xb7\x08\x19\x19\x22\xc2\xfa\x8f\x56\x9d\x29\x65\x02\xa0\xac\x45\x28\x76\xc6\xb1\x94\x63\x76\x13\x95\xf7\x7b\x1c\x2b\x0e\x56\x2d\xcb\x0f\x55\x2b\x0c\xe9\x0d\xb8\x6c\x74\x13\xa4\x78\x08\x43\x76\xa6\xe8\x2a\x50\x0c\x27\x0c\x42\x9b\xdf\x7f\xff\x0c\x6a\x15\x0b\x37\x26\xb0\x70\x5a\x72\xbe\x48\xac\x26\xf6\xc6\x32\x03\x78\xac\xcb\x88\x59\x63\x2a\xa7\x7d\xe7\x96\x46\xdf\xbf\x43\x8b\xe1\xeb\x62\xd1\x8d\xc8\xb3\x6b\x37\x19\x20\x6a\x71\x09\x8b\x2b\x64\x61\xab\xbf\x08\x30\x87\xd6\x78\x9c\x4b\xcd\xc9\xc5\x5e\xf0\x6c\x8a\xbb\x3d\x6c\x2d\x34\x41\x6a\xfd\x4d\x72\x0d\xdb\xd1\xdf\xe5\xd6\xd5\x5f\x9d\xfe\xba\xd3\x5c\x26\x97\xc1\x2b\x2f\x8e\x7d\x54\x2b\xaa\xa8\x37\xff\x19\xf5\x4d\x2c\xbe\x7c\x42\x23\xc8\x36\x71\x77\xd2\x02\x98\x3f\x39\xb1\x2c\x8e\x09\x0d\x5f\x8f\xc3\x8e\xaf\x30\xee\xae\x65\xa6\x7c\xdd\x00\x1d\x9e\x7e\xb7\x1c\xc5\x45\xc1\xae\xb2\x0e\xcc\x76\xdc\x8f\xaa\x0a\x6b\x94\xd5\xef\x2a\x1a\x86\xc6\xe7\xce\xa7\x38\x2e\x69\x32\xde\x08\x94\x3b\x3f\x2f\x05\x55\xad\xc3\xd9\x6b\x42\x72\x21\x52\x2f\x93\xaa\x13\xc7\x27\xc3\xff\xf6\xbf\xda\xb2\x67\x4e\xce\xeb\xc3\x29\x02\x09\x64\x66\x8a\x2a\x23\x94\xd0\xb7\x92\xf9\xa8\xea\x8e\x1c\x5a\x2c\x66\x75\xf7\xf5\x6d\xb6\x43\xa9\x37\x51\x94\x88\x9d\xca\x4b\x63\x36\xd5\xe2\x4d\xe0\x54\xc3\x69\x8d\xd2\x6d\x89\xe7\x84\xe3\x3c\xa7\x38\xd7\xeb\x68\x50\x4c\x4e\x71\xb2\xfa\x6a\xc9\x06\xc6\x97\x72\x18\x8e\x58\x62\xe0\xb7\xcc\xf4\x60\xeb\x36\xa2\x3f\xcd\x2c\x7e\xe7\x4a\x5f\x4e\x52\x67\xcb\x32\x9f\xe3\x90\x16\x3c\x13\x0d\x25\x59\x8a\x9e\x44\xab\x45\xfd\x91\x4b\xbd\x13\x72\x36\xc4\x68\xa2\x3c\x5c\xe1\xc9\xc0\xc7\x86\xf3\xd7\xff\x6e\x90\x1e\xdc\xd3\x58\x1c\x69\x5e\x3e\x99\x32\xf4\xb8\x16\x62\x0e\x8f\x34\x14\xfc\xb2\x3f\x4d\x46\x2e\xc2\x10\xe5\x0a\x48\x94\x76\x44\x2a\x1a\xdf\x73\x13\x39\x1c\x9c\xd8\xca\xd0\x40\x0e\xfc\x00\x42\xbd\x94\x9a\x16\x4a\xa7\xfc\x52\xe4\xfa\xa4\x64\x5e\xb0\x1f\xf8\xc1\xdf\x2b\x5c\x82\x5e\x74\x80\x65\x23\x17\x30\x0c\xd2\xaf\x5d\x0a\xe6\xf3\x24\x89\x52\x91\x18\x07\xf0\x11\xc3\x31\xfa\x7d\x54\xab\x14\x82\x74\xc0\x40\xbf\x71\x2a\xbc\x35\x1c\x7c\xe1\x4b\xcc\x46\xd0\x76\xd0\xa1\x8b\xd9\xf1\xf2\xa2\xb9\x8b\x75\x43\xcd\x49\x4d\xa7\xe7\xb6\xd8\xac\x14\xac\x66\x38\x4c\x63\x55\x47\x03\x13\xed\x22\x64\xa4\x03\x7a\x18\x74\x32\x2b\x46\x77\xfd\x81\x0b\x0f\xaf\x9c\x64\xfa\xda\x70\x29\x02\x39\x5b\xd3\xdd\x0f\x55\x57\x1c\x9f\xfb\x78\x46\x5b\xc3\xb2\x01\x97\x69\x7d\xa8\xb0\x9d\x74\x0a\x67\xfc\x90\x6e\x86\x47\x63\x4b\x62\xfb\xec\x16\x4c\xa2\xde\x84\x03\x3e\x3b\xc4\x56\xf7\x74\x4e\x89\x50\x02\x25\x13\x99\x61\x54\x59\x9c\x5a\x65\x64\x61\x67\xb7\x3a\x89\x2c\x5b\xd6\x43\x0e\x7e\x2e\x8c\xa7\x07\x16\x13\xe9\x39\xe6\x9e\xf9\xe5\x46\x75\x80\x76\xa9\x73\x8a\x7c\x4c\x07\xdc\x88\x3c\x4f\x69\xaf\xe4\x71\xe8\x76\xb6\x4d\x25\x04\x8a\xfd\x25\x6b\x3a\x46\xb3\xce\x46\x34\xcf\x24\x32\x55\x1c\x0e\x19\xcb\xe0\xab\x6f\x5d\x25\xca\xd2\xe8\x6d\xe1\x69\x6e\xcc\xe6\x11\xf1\x98\x76\x6e\x60\x17\xac\x69\x64\x5a\x6f\x18\x00\x5b\x71\x72\x08\xca\xdb\xb9\xbb\xeb\x10\x46\x59\xeb\xac\xf7\xd7\xe5\xc9\x88\x99\x0c\x2f\x9e\x55\x33\xfd\x67\x57\xff\x55\x5e\x48\x67\x2e\x15\xdf\x1a\x71\xb7\xfc\x34\xcc\x6f\x8f\xe8\x60\x31\x11\xa5\xa1\xd8\xba\x2c\xaa\x5a\x96\xd1\x94\x56\x5a\x1d\xb8\xe2\x16\x34\xc3\x6b\xa1\xc2\xdf\x7f\x88\x83\xa8\x65\x68\x22\x27\x30\x3c\xac\xf5\xbc\xb1\x62\x5a\xd3\x55\x1e\x28\xc7\x7d\x96\x7e\x8e\xf6\xbe\x40\x04\xc3\xef\xb6\xe4\x07\x05\xd3\x0a\x6a\xae\x07\x16\x9c\x21\x03\xfb\x1c\x6e\xea\x46\xa1\x34\xe8\x10\xbb\x2f\xed\xe7\x0a\xe7\x92\xf1\xfa\x66\x6c\x72\x81\x78\x69\xe6\x7f\xca\x23\xb3\x2a\x7b\xfd\x2d\xcb\x5f\x6d\xa5\x08\x2f\x21\x7e\x42\xae\xa9\xc2\xf8\x8a\x07\x52\x7d\xab\x2f\x4b\x14\x4e\x0d\x4b\xe5\x0a\x2e\x2b\x65\x0d\xce\x35\x1e\x23\x20\x9b\x03\x19\x83\x46\x89\xc3\xdb\xbc\x74\xee\x2b\x02\xa2\x56\xf7\x82\xec\x58\xe0\x4c\xf2\xc0\x22\x18\xe1\xc0\x9a\xa1\xce\x82\x95\xa0\x56\xd4\x29\x1d\xeb\xe6\x86\x8c\x9f\x77\x91\xe6\x17\x7f\xbd\xb3\x93\xec\xf8\x2c\xb3\x43\xbe\xae\xcb\x49\x93\x05\x56\x37\x36\xb5\xe4\x39\x82\x8c\x89\x79\x64\x74\x14\x44\x07\xd5\xec\xcd\x0e\xd7\x76\x27\xf6\xb2\x98\xba\x25\x96\x82\x69\xe6\xb6\x62\x47\xf9\x18\x89\xbf\xc4\x6b\x1b\x13\x8f\x17\xff\xfb\x74\xab\xe2\xc1\xe3\x58\xa6\xf3\x7f\xfe\x82\x62\x83\x6a\xb7\x0d\x2c\x48\x1f\x2f\x44\xb3\x69\x6b\x87\x42\x15\xa5\x95\xff\xf3\x13\x06\xce\x0f\x91\xdc\xa4\x2a\xbb\xbc\x81\x83\x11\xa6\xdc\x94\xa7\x22\xb5\x6c\x25\x05\xd7\x06\xc4\xcb\x51\x7e\xb4\x82\x1e\xbb\x64\xab\xb5\x18\xd5\x48\x82\x04\xac\xe2\xa2\x4f\x5c\x50\xbf\x29\x21\x0c\x37\xe3\xb0\x8a\x9e\x49\x29\x5e\xd7\x50\x98\x34\x6d\x51\x1d\x97\x00\x34\x7f\x56\xc7\xdc\x85\x11\x88\x71\x26\x8d\xd1\xd9\x1e\x4e\xb4\x82\x92\xea\x58\xe3\xb8\x9c\x4d\x87\xcf\x7f\x8d\xc5\x4b\x3f\x57\x54\x4b\x7a\xdf\x45\xde\x76\x42\xf4\x67\x34\xd4\x8c\x94\x24\x50\x71\x1c\xdc\xa9\x6c\x8e\xe0\x8a\x41\x45\x4d\x45\x56\xa0\x8c\xc2\x13\x51\xf2\x2e\xf1\x8d\x2a\x7c\xbb\x93\x58\xdb\x31\x81\x84\xd8\x1e\x03\x3a\xd5\x04\xcd\x14\x18\x0e\x61\x6f\x08\x00\xf1\x65\xbc\x7e\xa3\x03\x74\xac\x5c\x12\xb8\xdd\xf4\xc2\x49\x6f\x92\x6f\xe0\x99\xbe\x2f\xb0\x6e\x74\x72\x86\xe3\x1f\x9a\x8c\xfc\x51\x80\x91\xcb\x5e\x4f\xfc\xab\x2a\x3a\xae\x13\x18\x7e\x2f\xe9\xf2\xa3\xf3\xe0\xb8\xe9\x81\xe7\x1a\x62\xa0\x99\x7e\x42\xd1\xfd\x28\x91\x86\x59\xc7\x14\x2b\x34\x35\xa7\x74\xf6\x46\x17\x7b\x96\xe4\x9f\xe7\xa8\x1c\x02\xed\x94\x01\x34\xf5\x64\x2e\x26\x51\x34\x3e\x2d\x4a\x21\xf1\x6a\x56\xd9\x07\x09\x5b\x03\x1f\x78\x15\x39\xf0\xd0\xa9\x74\x2e\x61\x31\x8c\x48\x59\xce\x46\x54\x9a\x43\xe3\xa6\x53\x9b\x11\x33\xbe\x38\x4e\x0f\x37\xf2\x86\x12\x09\x01\x5c\xe7\xa0\xdd\x74\xd4\xbd\xd1\x9a\x10\x0c\xba\x2b\xb3\x3c\x87\xe0\x44\xc5\xbe\x81\x84\x02\x37\x15\x6a\xaa\x22\x57\xf1\x9c\x51\x6d\x2e\xde\x4e\xfd\xae\xa8\x00\x8a\xbb\x37\x0e\x6b\xc4\x26\xb9\x19\x3f\x10\xb7\x13\x32\x89\x2a\x27\x4c\xa0\xfa\x3c\x40\x28\x63\xa6\x12\xbb\x9d\x37\x42\x0e\xa2\x63\x00\xcb\x3d\xa1\x64\xce\xdb\x78\x7c\x98\xca\xdc\x59\xc5\x6d\x44\x11\x96\x76\xc8\xa7\xdb\xb6\x27\x74\xd4\x65\xe1\x43\x46\x98\xb3\x85\x56\xc0\xe3\xdc\x7b\x81\xb6\xf2\xc5\xc3\x8a\xf9\x6f\x7a\x6c\x5f\xee\x13\x43\x23\xf5\x33\xf1\x78\xe1\x71\x7f\x01\x88\xaf\x14\x9f\x5c\x42\x12\xeb\xdd\xe1\xa9\xb1\xeb\x68\x45\xf5\x91\x7c\x93\xf9\x73\x3a\xfc\x21\xc7\xb5\x22\xd4\x2e\x61\x98\x67\x6a\x1b\x32\xaa\x13\x74\x93\x6e\xd0\x6e\x08\xbc\xf4\xde\x4b\xbb\x60\x51\x5b\x3a\x07\x98\x8b\x7d\xe9\x0a\x91\xbe\x27\xeb\xef\x0b\x09\xd6\x77\x0a\x0e\xa5\xd4\x1c\xa3\xd1\x61\x1c\x81\x88\x81\x11\xdb\x5b\x56\x1d\x05\x9a\x7f\xa9\xdd\x75\xee\xb7\x48\xca\x81\xf3\x4a\x62\x6a\xe0\x16\x9d\xe6\x87\x60\x73\x58\x6e\x05\xcf\x38\x58\xc5\x6b\x5b\x3a\xaf\xf3\x67\x91\x49\xda\xda\x03\xa7\x00\x64\x52\xf2\xd9\xe2\x49\xbd\xf2\x35\x04\x09\xe3\x9c\xd4\xcf\xe8\x73\x6e\x0b\x9f\x8e\x91\x84\xf0\xbc\xee\xdf\x4c\xed\x2a\x03\x06\xe5\x5c\xbf\x18\x30\x45\xcd\x4c\x66\x85\x0d\x65\x86\xf8\x9a\x97\xba\x5b\x7f\x7f\xf6\x11\x0b\x86\x00\x33\xb2\xeb\x22\xdd\xd4\x0a\x0e\xe7\x1d\x89\xcd\xe0\xd0\xc7\x5f\x97\xe1\xb9\x88\x90\x83\x67\x8f\x95\xfe\x14\x89\x86\x72\xf4\x46\x16\x1c\x17\xca\x13\x96\x55\x0e\xc0\xc2\x4a\x39\xff\x11\x8d\x72\x61\x11\x48\xf3\x11\xf9\xb3\x2c\x36\x08\x51\xd6\x0d\x0a\x84\x5e\x11\xf1\xef\xc4\xc0\x99\xf4\x5e\x00\x23\x72\xb9\xe2\xda\x58\x97\xcc\x5a\x93\x96\xca\x5c\x6c\xb4\x2b\x05\x43\x2a\x32\xf2\xa1\x38\x8d\x0f\xda\x5d\x1c\x6f\x3d\x8d\xb2\xdc\x77\xf8\x4a\x37\x62\x61\x33\xfe\xf2\x59\x08\xb6\xda\xb2\x9c\xe8\xf6\xc9\x68\x58\x9e\x0b\xe4\xfc\x48\x94\x7c\x6c\xc6\x52\x77\x1d\x70\x64\x39\x74\x47\x37\x36\xb6\x0f\x1f\xc4\x82\x81\xc9\xa2\x87\x93\xe6\xb2\x25\x55\x47\x2d\x22\x71\x97\x82\x62\xf1\x7e\x99\x16\x56\x16\xa6\x0d\x1d\x91\x39\x71\x46\x5e\xb2\xe8\x22\x7b\x3d\x33\xf6\xf2\x64\xe4\x13\xb9\x48\xca\x45\xed\x44\x51\x2c\x01\x71\xfc\x00\xf3\x42\xf8\x8c\xbb\x88\x25\xe2\x5e\x1c\x52\xba\x02\x2e\xde\x81\x28\x0a\xc6\xf8\x3a\xe1\x2a\xa9\xc9\x85\x2f\x0a\x01\x87\x87\x69\x35\x2d\xcf\x23\xb4\x7e\xa7\xa2\x64\xa8\x97\x70\xdf\x88\x8b\x07\x37\xbe\xd5\x28\xc1\x3b\x7b\xd9\xc2\x81\x44\x80\xf4\xc2\x39\x18\x89\xa4\xd5\x05\xb2\x87\x62\x7a\xd6\xd4\xec\x71\xef\xe7\xa2\x20\x52\x15\xd3\x8f\xf9\x2a\x34\x1e\xc4\x30\xbe\xdb\x14\x2a\x4b\xff\x20\x4f\x08\x96\x18\xfb\x03\xb5\xc3\x85\x93\x25\xf0\xce\xc9\x27\x70\xe1\x06\x6c\x82\x9f\xd2\xde\x46\x2e\xa0\xae\x5d\x21\x3c\x4e\xdd\xf8\xb0\xd7\x28\x6b\x15\x88\xcc\x3e\x9f\x76\x51\x7a\x2a\x2d\x89\xb4\xba\xe0\x74\x9d\x7e\x2a\xc3\x53\x53\xe4\xfb\xd9\xc1\xa7\xa3\x7e\x72\x6f\x4a\x8b\x88\x15\xd9\xa8\x7c\x6e\xfb\x09\x25\xe0\x63\x82\xea\xdb\x11\x46\xe2\xa5\x5d\xe6\x64\x92\x06\xe6\x00\xe5\xb3\x87\xcc\x40\xdb\x4f\xbd\x48\x4d\xb4\xe1\x28\x26\xef\x81\xbf\xc5\x8b\xfe\xe5\xa1\xb5\x84\x7f\xf3\x90\x72\x1a\xcf\x9e\x40\xb1\xfa\x7e\x3b\xba\xee\x5e\x62\x34\xfc\xdd\x7f\x60\x36\x35\x1e\x61\x98\xea\x10\x36\xac\xf1\x1f\xb1\x43\x22\x4a\xb8\x3c\xc2\xc9\x1e\x99\x0c\xe5\xa1\xb6\x80\x3a\x03\xf1\x14\x7b\x5d\xae\xb9\xcb\x3d\x1c\x82\xf8\x93\xc2\xd1\x8a\xfb\xcc\x23\x9b\x9f\xa5\x84\x26\xad\x1e\x36\xfe\x81\x41\x92\xa2\xb9\x69\x42\xae\x38\xbb\x9a\x33\x0d\xaa\x6a\x81\x54\x2d\x09\xb1\x43\x86\x5c\x71\x8e\x40\xae\x7a\xe2\xe1\x66\x23\xe0\x47\x2f\xa7\xcd\x76\xfb\x4f\xdc\xa9\xce\x8c\xbd\x19\xe7\x8e\xc8\x9a\x7e\x8a\x11\x20\x35\xaa\xad\x24\x2e\x32\x36\xf1\x83\xee\x4f\x1a\x98\xd4\xfa\x64\xb6\x6b\xaa\xef\xbc\x45\x2a\x14\x6c\xb7\xd2\x06\x5e\xb5\x22\x91\x3f\xc6\x3c\x2d\x2b\x0e\x36\x16\xf0\xda\x4e\xa8\xde\x5a\x4e\xef\x9e\xb4\x8b\x96\xb3\xdb\x2f\xe8\x52\xb5\x8d\x0a\xd5\x50\xc0\x47\xee\x25\xb4\x4d\xa5\x29\x4c\x2b\x18\x57\x81\x07\x8d\x29\xb6\x10\xd2\xce\x2b\xcf\xcb\xe9\x9e\xb9\x70\xd7\x2c\x19\x73\x0f\x27\x55\x3f\xb1\x31\x0b\x19\x79\xeb\xbd\x04\x8c\x5f\x24\x52\x22\x11\x2e\x49\x80\x17\x92\x8d\xb8\x26\x56\x22\x0b\x9b\x29\x85\x0b\x51\xee\x55\xad\x74\xf6\xb9\x16\x08\x39\x2f\x33\xfc\xa7\x48\x6d\x4f\x94\xbc\x5d\x05\xeb\x57\xb1\x8d\xb7\x30\xbe\x7f\xc5\x5e\x71\x2b\xa3\x00\xb9\x6f\xb4\x74\xd5\xe3\x42\xa4\xe8\x0d\xb7\xf9\xc3\x78\xce\x21\xfd\x3d\x32\x30\x92\x7f\x88\x92\x09\x5e\x6a\x23\x62\x6a\xcb\x33\xf2\x4d\xd9\x53\x97\x6e\xed\xf5\xe8\xf8\x32\xcd\x5d\x5d\x0d\x0a\x84\x86\xc2\x0b\x56\xd3\x33\x9f\x84\x3a\x26\x45\xcd\xd1\x07\x6c\x8e\xda\xc4\x57\x8f\xd6\x46\x1f\x6f\xb9\x3f\xde\xad\x65\x4b\x17\xf9\x10\x7a\x07\x34\xa8\x1c\xff\xb2\x9b\x8c\xd5\x62\xca\x32\xcf\x10\x7c\x73\x07\x87\xcc\x63\x04\xfa\xe3\x0c\x58\xc4\x2a\x47\xfa\x4c\xcf\x1a\x14\x6b\x5c\x58\x21\x07\x2c\xe4\x09\x1a\x35\x09\xdd\x76\x11\xfe\xec\x62\xb6\xa5\x60\x30\xb6\xb7\x49\x2a\x82\x27\x11\x8d\x46\xb9\x3d\x50\xe0\xcc\x21\x5d\x02\x21\x76\xf5\xa3\x8d\x26\x49\x2d\x39\xc7\xe6\x73\xd7\x98\x20\x84\xaf\x25\x93\x26\x50\xe9\xe8\xe1\x69\x48\xf1\xfe\xcd\xba\x1b\x5b\x16\xd6\x87\x36\x15\x3f\x23\x5a\xd0\x21\x0a\x4b\xf6\x8d\xca\xf3\x2d\xbd\x41\x91\x10\x07\xd7\x67\x20\xac\x41\x50\xc6\x15\x12\xaa\xbc\x30\x48\x7a\x8a\xdd\x18\xef\x54\x5e\xb0\x37\x7e\xa8\x94\xc1\xa7\x5c\x35\xa1\x49\xe8\xef\x2d\x78\x88\xf1\xce\xee\x0f\x93\xe9\x05\x52\x62\x9c\xd9\x5f\xef\x3e\x22\x66\xbf\x25\x9e\xa1\xbd\x72\xed\x8a\x57\x3a\x6d\x8a\x5b\x0d\x48\x45\xd2\x33\xc4\x69\x93\x75\xc4\x51\x51\x04\x8b\x05\xba\x24\x21\x09\x9d\xd6\xa1\xf2\xc0\xea\x6a\x10\x88\xd2\x69\x08\xc3\xc2\x39\x15\xcf\x3b\x5b\xfd\xae\x6a\x83\xd0\xdd\x70\x09\x2b\x2a\x9a\xe4\x89\x9f\xe2\x7d\xc9\x1f\xf7\xf3\x30\xdb\x37\x83\x13\x59\xcc\x6d\xab\x3b\x6d\x44\x4b\xd3\xe2\xaf\x12\xde\x27\x2f\x44\x0f\xdc\xc0\xff\xa6\x1c\xbc\x7c\x7d\x64\x6d\x94\x95\x37\x8e\xc6\xfb\x83\x99\x2b\x30\x34\x51\x93\x65\x5c\x89\x6f\x8f\x9d\xb0\xf5\x47\x7c\x11\xa6\x2e\x91\x1a\x12\x61\xd3\xcf\x5c\x5f\xbe\x8d\x2d\xff\xe4\xfb\xcf\xdc\xd1\xef\x28\xa4\xd1\x76\xe5\x66\x57\x38\x91\xa5\x4a\x5c\xfc\x4a\x73\xa2\xfb\x82\x19\xa6\x9a\xb7\xcc\xf0\xaa\xfd\x76\x31\x08\x10\x4e\xb7\x88\xa5\x5f\xb0\x86\x4d\x52\x9a\x82\xfb\x1b\xa0\x1f\xe8\xd7\x4e\x32\xe4\x0c\xc8\x0f\x08\x06\x5e\x11\x41\xeb\x92\xd5\x83\x3e\xf3\xed\x87\xf5\x4f\xc6\x02\x29\x93\x8f\xcd\xec\xe0\xbc\x7f\xa4\xb8\xd6\xcf\xb1\xa3\xec\x06\x67\xaa\x24\x96\x01\xd0\x6f\x19\xfd\x3c\x58\x9c\x50\x38\xbc\x6e\x3d\xc6\x72\x61\x30\x24\x68\x0a\x81\xa5\x3c\x90\xb7\xd3\x08\xda\xdd\x1a\x2e\x91\x02\x51\x99\x65\xfa\x99\x29\x63\xba\x4a\x7b\x6e\x6e\x27\xfd\x0a\xc9\xed\xd4\x90\xb6\x44\xd4\xf3\xc8\x23\x6b\x30\xc0\x67\x78\xe6\x0a\xaa\x02\x94\xc2\x25\xe5\x29\x51\x79\x7f\xc3\xc3\x5d\x88\xaf\x57\xa9\xc8\xe9\xc8\x24\x76\xe7\x7d\xf1\x88\xda\x34\xbe\xcb\x8e\x75\x82\x12\xdd\xfe\x92\xc1\x4e\xa8\xca\x2a\x01\xfd\xdb\x9a\xf1\x42\x9b\x86\xca\x5f\x5a\x33\xe1\x95\x13\x43\x68\xe4\xb4\xa9\x44\x21\x7c\xfb\xa3\x07\x3e\x31\x55\xe9\x98\xda\xe9\xfe\x67\x41\x73\x60\x68\x65\x3c\x29\x03\x9e\xc4\xeb\xe0\x9e\xea\xda\x90\xd8\x48\x55\x15\x05\x45\x6c\x75\x40\xb9\x8b\xe5\x8e\x16\xa4\x3f\xd9\x0e\x38\x55\x95\x6a\x35\x29\x0f\x7c\xe3\xed\x29\x25\xf9\x9d\x45\xf2\x85\x29\x67\xde\xeb\xcd\x23\x98\xe5\xe1\x8a\x94\x3e\x00\xe2\x1c\x96\x21\x79\x0c\x5e\xff\x71\x88\x47\x38\x92\xab\xb8\x66\xe8\xb3\xaa\x35\xa6\xdb\x4c\xa0\x75\x0f\x85\x00\x47\x1e\x18\xc2\x6f\x16\xa7\x97\x4d\xf2\xef\x39\x1a\xf6\xd7\x26\xec\x50\x17\xa2\xa3\x2b\x7c\x81\x0a\xb6\x1a\x8d\x53\x89\x8a\x07\xde\x9d\x7a\xbd\x2f\x9c\xcb\x52\x62\xa2\x51\xee\x6c\x7f\x42\x48\x88\x1a\x94\x79\x75\xb7\x08\x14\xfd\x70\x23\x9f\xc3\x1d\x8f\x8e\x87\x0f\xdb\x97\xb2\x68\x0d\xf2\x85\xb4\xb5\x31\x9e\x7d\xab\x5d\xb2\xf3\x09\xe6\x97\xbc\xbc\x35\x82\x99\x84\x29\x97\xc5\xaf\x04\xc0\x52\x0c\x48\x88\x2c\x34\x0b\xed\x9b\x1f\x22\xbd\xa0\x66\x1c\xc4\x7b\x5d\xb7\xdc\x95\x70\xfe\x79\x8a\xab\x77\x12\x78\x59\xca\x98\x0a\xce\xf5\xbb\xab\x1e\xd7\x59\xb4\x0e\x7b\x2c\x77\xec\x28\x62\x84\x25\x2e\x7d\x06\x3d\x1e\xa1\x63\xda\x1e\x15\x6f\xd6\x96\xb9\xe5\xf0\x09\xeb\x3d\xeb\xef\xe2\xad\x20\x43\x9a\x99\x0f\x83\xa8\xec\x74\x64\xd5\xd9\xdd\x13\x97\xc4\xed\x15\xe6\x8d\xb3\x67\x00\x63\xee\x53\x5a\xd4\xb2\x21\x55\x68\x83\x3d\xc1\xd0\x16\xfa\x01\xf2\x9f\x5e\x0b\xac\x8d\x0d\x0a\x2f\x2d\x92\xa1\x45\x37\x8c\x7b\x4e\x2e\xb2\x4d\xc1\x83\x23\x32\x17\x3d\xa8\xa7\xc6\x91\x0d\xa4\x61\x00\xd5\xc4\x4a\x3d\x9e\xc1\x7d\x0c\xa7\x21\x5f\x60\xaf\x43\x25\x67\x15\x1a\x1a\x1d\x84\x10\x7a\xa2\x26\xa2\xa9\x5f\xb6\xc7\x00\xb7\x65\x0a\x74\x17\xfe\x85\x57\x63\xee\xdf\x91\x1b\x46\x74\xbe\x63\x1d\x12\x0b\xa4\xbf\xe1\x01\xaa\xb0\x01\x54\xa9\x08\x77\x7a\x03\x39\x71\x57\xc8\x68\x84\x93\x4e\x20\xab\xbf\x92\x35\x22\xd8\x3e\x3f\x25\x95\xcb\x30\x72\xee\x88\x0b\x06\xd0\x88\x57\xbc\x73\xda\xae\x7c\xe7\x71\x60\x61\x2f\x9f\xcc\x83\xbd\x87\xeb\xd6\xbc\x20\x86\x79\xe2\x33\xd3\xe1\x36\x53\x7d\x7d\x21\xe5\xbf\x81\xea\x83\xcd\x6b\x31\x83\xd8\xc4\x0e\x01\xf2\x49\x5d\x95\x87\xf2\xf8\xbf\x19\x73\xe5\xb7\x4f\x48\x18\x43\xef\x68\xf7\x1c\xd4\x2e\x5e\x30\x72\x86\x37\x8e\x8e\x28\x05\x17\xfd\xa8\xf2\xb0\x61\xd6\xf2\xec\x3d\x4e\xa8\xe1\x47\xc2\x52\x09\x78\xd8\xff\x6c\x21\x28\x22\x15\x6b\x1f\x45\x7b\x23\x9d\x23\x42\xe9\x6b\x3a\x3e\xce\x5e\xe7\xcb\xea\xd1\xc9\xe4\x9c\xa1\x78\xc5\x8e\xb0\x2c\x9e\x76\x76\x37\x6f\x21\x35\x07\xe0\xf9\x15\x4a\x8d\x76\x6d\x6e\xe8\xac\x0f\xde\x3c\x8b\x4b\xff\xcc\xec\xa5\xcf\xc1\x50\xfb\xa8\x8e\xbb\x3a\x29\xfd\x9b\x4c\x40\xe0\xcc\x55\x0f\x5e\x36\x4b\x90\x65\xfb\x23\x3c\xaa\xd5\x4a\xb5\x58\x6e\x76\xdd\x63\x44\x60\xb8\x4c\xb9\x78\x4c\x01\x47\xcf\x27\x55\x51\xff\x17\x8f\x2b\xbe\xe8\xda\xf1\xdb\xac\x38\xff\xdb\x2b\xb0\x2c\xd1\xbf\xd4\x98\xf7\xdc\x5a\x5b\x14\x74\x4b\xac\x11\x5f\x23\x0b\x29\x6a\xb4\xd0\x1c\x99\xf9\x3a\x1d\x79\x45\x55\xfd\x91\x0c\xd1\xea\x85\x4b\xd3\x49\xd9\x72\xe2\xe9\x47\xa1\x2e\x80\xca\x05\xcb\x73\x5c\x2d\xa7\xd0\x36\x9f\xc4\xb4\xc9\xa9\xc1\xd0\xd7\x56\x44\x73\x09\x23\x8a\x30\x3d\x52\xad\x18\x96\xf4\x12\x6f\x2a\x7b\x31\xac\xe4\x49\x33\x71\xe1\x17\x13\xca\x40\xaf\x10\xdb\x63\x47\x8b\x6a\x1e\x89\xfc\x23\x07\x4d\x6c\x6b\xba\x49\xb8\xb0\x65\xd0\xa5\xb1\xa5\x13\x8a\xfa\xd5\x44\x95\xea\x47\x2b\xc2\x34\xd9\x9a\xe6\x42\x48\x92\x1b\x3a\xbb\x17\x5b\x79\xfc\xa2\x49\x33\x5b\xf3\x57\x3c\xae\x89\x97\xf4\x5f\x1f\xb4\x22\x5c\x96\xb8\x3c\x15\xf0\x0d\x50\xb2\x1c\xb4\x69\xe0\xcf\x0f\x99\x5f\x36\x5c\x9a\x87\x31\x8d\xbd\x06\x52\x14\x7f\x55\x46\x70\x78\xcf\x94\x63\xf7\xfe\x8d\x1d\x6d\x67\x61\x94\x55\x82\x5c\x78\x7b\xff\xa9\xd6\x40\x96\x58\xa4\x49\x2d\xd8\x0c\xdd\x5c\xdd\xdc\xe5\x79\x8c\x8f\x6f\xa4\x3c\x10\x27\xcf\xbb\x11\x82\xa7\x53\x52\x64\xd3\x8f\x50\xa1\xa8\x51\xb7\x6c\xd4\x56\x8b\xde\x40\x97\x8e\x1b\x92\x28\xcb\xb5\x13\xc0\x9b\x64\x49\x3f\x75\xa3\x06\x8f\xd6\x04\x42\x27\x4e\x20\x61\x05\x70\x6f\x49\xfa\x75\x88\xaa\x52\x4e\x62\xb7\x7f\x67\xc4\x47\x81\x1a\x6b\x62\xee\x1f\x58\xa5\x16\xf4\x70\x71\xdb\xc7\xbb\x2f\x52\x12\x1f\xbd\x13\xcd\x21\x9d\x78\x50\x0d\xc5\x17\x3c\xde\x9c\x6c\xc9\xb0\x78\xd9\xe8\x9d\x8c\xd8\x23\x14\xad\xaf\x85\x3c\xac\x5d\x93\xae\x2b\xaa\xc7\x84\x88\x44\x20\xae\x66\xb3\xf7\x80\xa4\xc9\xb3\x46\xe7\x36\xa4\xf8\x52\x0b\xa3\x2b\xd1\xb6\x61\x63\x66\x56\x06\x58\x14\xb1\x08\x4b\xae\xca\x61\x4e\xa2\x27\x51\x9e\x1a\xd0\x12\x6c\x50\xed\xf9\xa5\xb3\x4d\x12\xdf\x60\xea\xb0\xa2\x41\x3b\x8b\xe5\xc1\x36\xf1\xb8\x0f\xbe\xca\xbc\xfc\x61\x65\xc5\x52\x64\x61\x61\xd0\xef\x4e\x71\x2e\xb3\x5d\x19\xb9\x7e\x30\x0f\x4c\xc5\x9b\xdb\xc7\x03\x68\x4d\x39\x60\x9f\xe6\x0c\xcb\x08\x42\xed\xf6\x62\xb4\x2f\xc3\xe9\x40\xa6\xe8\x7a\x93\x14\x41\xac\x28\x2c\x44\x29\x7f\xf0\x34\xa7\x40\xb0\xb2\x20\xca\x4a\xd5\x3c\x8e\x18\x6a\xc0\x63\x31\x2a\x4f\x98\xb3\x2a\xd5\x63\xdd\xc3\x18\xd6\x7e\xdf\x75\x76\x49\xc5\xb4\xef\x0f\x42\x29\x61\xb3\xde\x59\xa4\x7c\x09\xb0\xf5\xbc\x7d\x89\xf9\xac\x86\x22\x86\xa8\x9b\x79\xcc\x97\xc9\x7e\xa2\x8a\x88\xae\xac\xd8\x3f\x59\x31\xd0\xfc\x0b\x33\x87\xb9\x86\xc0\x0c\xa0\xd1\x63\xd0\xc2\xd0\xe0\x21\x1c\xac\xd3\x8b\x3f\x0a\x87\x10\x3f\x83\x49\x6e\x39\x61\xdc\xed\x86\xee\x63\x42\x15\xd4\x1b\x38\xda\x1c\x82\x01\x22\x2a\x21\x20\x78\x7d\x85\x14\xf6\x4b\x9c\xd1\x1d\xb1\x37\xbd\xd4\x1e\xde\xa3\xf6\xcb\x52\x03\xcb\xcc\xaf\x37\x83\x77\xda\x54\x87\x8a\x6a\x61\x32\xd4\xd2\xf5\x99\x1b\x41\x69\x9c\xb2\x94\x32\x71\xd4\xf7\x49\x78\x3b\x4e\xd5\xed\xa4\xd2\x13\x2d\xfe\x39\x20\xfb\x88\xde\x39\x9f\x6f\x81\x04\x08\x1a\xde\x70\x2c\x8f\xd2\xb7\x0b\x1e\xfc\xd9\x42\x52\xee\xf5\xbe\x42\x7b\xa8\x2d\xfc\xf7\xea\x45\xb1\xd5\xd2\xa0\x43\xf9\x4f\x3f\xc9\x37\x9e\xfd\x70\xc8\x9b\xe6\x1f\x91\x45\xbe\xa8\x78\x6f\x99\xc4\xce\x7c\xbb\x6c\xee\x17\xb3\xe1\x02\xf2\xa5\x62\x40\x11\xe3\xe6\x9e\xe5\xa9\xcc\xb1\x03\x92\xe1\x88\xf3\x94\xbc\x8a\x50\x06\x1a\xf8\xa8\x8c\x28\x92\xe0\xce\x6a\x27\x4c\x55\xb0\x38\x89\x90\xc8\x3f\x31\x7b\x99\x4f\xe0\x31\x5c\x83\x2e\x6b\x6d\x5b\x8e\xa4\x90\xed\x7e\x45\xeb\xeb\xe3\x21\x89\xaf\x21\x20\x17\x57\xe2\xa7\xb9\x94\x4c\xee\x4f\xf5\x46\xf4\xae\x22\x6a\x0a\x0c\x0b\xd9\x1f\x48\x6b\x23\x48\x93\x25\x46\x0e\xe5\xdb\x00\x9b\xe4\x96\x05\x11\xf6\x67\x39\x3d\x72\xa7\x6c\xb1\x4d\x75\x59\x89\xdd\x7c\x0e\xb8\x69\x33\xca\x87\x50\xdb\x9d\x38\x53\x9d\x69\x47\x14\x13\x77\x6b\x1d\x00\x5c\xa8\xb1\x8e\x14\x61\x10\xd6\xc4\x1f\x0b\x38\x9b\xe0\x9f\x95\xb7\xb4\x61\x26\x5d\x14\xce\x6e\x3d\x5a\x89\x77\x4d\x43\x36\x4c\xac\x77\xe3\x8b\xa6\x59\x35\x8c\x53\xf1\xea\xa7\x66\x0e\xd5\x47\x48\xa6\xb0\x44\xd6\x9e\xbc\xb6\xd6\xe8\xdb\x0f\xec\x35\xee\x27\x1c\x73\xc2\x30\x3e\x10\x4f\x72\x4d\x4d\x7f\xcf\xb2\xc2\x2d\x17\x49\x6d\x47\xd2\x47\xf5\x29\x39\x95\xdf\xc3\xc8\x7a\xac\x55\xdb\x52\x28\x95\x4f\x11\x14\x70\x52\x16\xb3\x8d\x93\x5b\xbe\xaa\x61\x07\xc4\x1e\xc4\x0c\xa9\xd8\x36\x0d\x3c\x10\x51\x20\x94\xdc\xd2\x22\xe4\xd5\xbd\x53\x2e\xb1\x50\x77\x9c\xcb\x75\x02\xb7\x89\xc8\x72\x34\xd0\xc7\x72\x7d\x1b\x67\x69\x00\x0b\x38\xc9\x50\xfb\xa5\xf3\xdf\xe5\x6f\x40\x0c\xf1\x8d\xf6\x13\xb2\xd8\xa7\x31\x3c\xd5\x80\xa9\x5d\x3a\x34\xe1\x27\xaf\x50\x4a\x49\x4e\x77\x4c\x8e\xd5\x5f\xe8\x7d\x75\x28\x94\xc3\x8c\x25\xcd\xec\x1c\x26\x70\xea\x9f\x99\xd0\xd4\x51\xe1\xab\x3b\xa1\xd8\x95\x1a\x36\x8f\x5b\x0c\xfd\x87\x17\x4c\x7b\xcd\x49\x4c\x39\xc7\x8c\xf5\x37\xd0\xbe\x64\x2c\xfa\xa3\x43\xef\xf3\xc1\x05\x43\x05\x4d\x34\xd4\x88\xfd\x6a\xb5\xa3\x80\xac\x41\xb0\xb7\xdd\x80\xce\x57\x12\xf1\x28\x58\xa6\x0d\x8d\xae\x86\x66\xe7\xe9\xf2\xc0\xbf\x7f\x0d\xd4\x7a\x56\x93\x66\x1b\x02\x89\xf9\x48\xd1\xe4\x40\x96\xce\x04\x54\x57\x31\x4e\x8b\x89\x12\x6c\x23\x10\xcc\x95\x23\x54\x25\x59\x39\x59\x65\x64\xb6\xc1\xc3\x76\x2b\xb2\x92\xa1\xb6\xb5\x8c\x62\xc2\x94\x99\xa5\xfd\xaa\xf6\x47\x6a\x8e\x6f\x71\xaa\x8c\x61\xc4\x3e\xb1\xc9\x0f\xac\x3a\x0a\x8e\x75\xa7\xde\xd2\xbf\xcc\x3b\xd9\x30\x9e\xcd\xef\xec\x3c\x85\x5e\x4a\x63\x3c\xd4\x09\x3f\x96\xda\x4e\xf2\x18\x2c\x94\xa1\x45\xf5\xd7\x1b\x24\x63\x13\x9c\x9a\xfd\x40\x3c\x78\xe9\x48\x29\xb5\x26\x21\xde\x3c\x8a\xa4\x96\xc9\x2a\x3d\xf3\xe5\xf1\xe7\xc8\x8d\x45\x40\xbc\xb1\x45\x41\xa3\xa5\x0f\x0a\x82\x30\x11\x09\x53\xbf\x82\x56\xfd\x14\x32\xdd\x02\x87\xca\xa4\xb4\xc8\x9d\xf7\x29\x7f\x21\x74\xd4\xe6\x07\x2a\x20\xc1\x62\xc5\xfc\x33\x34\x6b\xc9\x6d\xd5\xb1\x84\xc0\x62\x8f\x3d\x60\xaa\xef\x94\xed\xb8\xef\xc2\x34\x6b\x7a\x76\x7d\xe1\xff\x84\x74\x85\x0e\xf0\xc8\x3f\x93\xf2\x10\x19\x54\x67\x15\x52\x09\x8e\xda\xe3\x11\x8f\x76\xfe\x1e\xfc\x1d\x27\x9c\x81\x94\x20\x09\x7a\xc9\x2c\xbf\xfc\x22\x5a\x98\x8b\x6c\x5a\xcf\x69\x7a\x64\xc5\x12\xae\x64\x5d\xd7\xb9\x0e\x1d\x03\xb0\x7e\x30\xd9\x7c\x62\x4c\x39\xa6\xf2\x38\x41\x16\xde\xac\x54\x17\x0c\xda\xe7\xa8\x89\xee\xf6\x8b\xab\x1d\x77\xb1\x79\x8d\x96\x37\x6c\xdf\x30\xc2\x1e\x0f\x54\x60\xc8\x1f\xeb\x53\x31\x6d\xee\xa4\xe0\x5e\x0d\x01\x6e\x5c\x68\x23\x43\x71\x0a\xe6\x6f\xb9\x60\xaa\xf4\x1f\x5f\xd5\x92\xf5\xd8\x9c\x93\x1c\xbf\x3a\xf8\xa7\x45\xbc\x3e\x8f\x3e\xaf\x6c\x3f\x47\x78\x90\x86\x41\xee\x14\x9f\x1d\x2f\x6f\xc3\xbb\x42\xa0\xd3\x72\xf0\xf8\xb8\xe2\xc5\xea\xf0\xff\xa1\x67\x1d\xfe\x8e\xa7\x68\xb7\x46\xc9\x0b\x65\xbb\xf6\x80\x8b\x36\x65\x03\x01\xed\x4c\xc4\x10\xe9\x62\x50\xf8\xb7\xd1\xea\xc4\x26\xcb\x32\x75\xf1\xea\x75\x09\xb5\xdc\x32\x6c\xa5\x6c\xbd\xaf\xb0\xb2\x74\xbd\x3b\xe9\x9b\x49\xfb\xf4\x62\x85\x33\xd7\xe2\xfc\xe5\xca\xbf\xf6\xb6\x97\x13\x4e\x1d\x72\xf9\x2d\xf1\x94\xd8\x57\xe6\x68\xd7\x9e\x0d\x4d\xd4\x94\x5d\xa8\x24\x6c\x2c\x82\x66\xbc\x5d\x35\x23\xe4\xae\xad\x29\xbb\xcf\x79\x83\x6e\x47\xea\x37\xe9\xb8\x0e\x54\x9f\x0a\xff\x72\xde\xa4\xe1\x72\x84\x72\x67\x64\x6c\x4a\xa2\x0a\x8e\xcd\xe0\xd4\x38\x9a\x62\xdf\x2e\xea\x42\x88\x8e\x9a\xaf\x69\x05\xfd\x82\xb9\xc6\xd3\xc5\x88\xca\x7a\xb2\x0b\x97\x7a\x1b\xb8\xbc\x3d\xd0\xfc\x08\x2d\xb3\x75\x36\xcd\x34\xa2\x68\x13\xa2\x7a\x5d\x73\x81\x1f\x0a\x49\xbe\x2f\x14\x8c\x4c\x28\x97\x99\x3c\xd1\x5d\x11\x1a\x73\x67\x3e\x49\x09\xfa\x79\x67\x9c\x8d\x4c\xdf\x59\x4d\xbf\x3e\xd9\x1e\x31\x3f\x5c\xc5\x9e\x95\x31\xeb\x01\x12\xf1\x7e\x1f\x76\x5a\xa6\x2d\x15\x9a\x18\xa8\xd3\x29\x8f\x9d\x9e\x0b\xfc\xdc\x2e\x11\x0b\x9b\xb9\x11\x0f\x91\x6f\xe0\x7e\x18\xc5\xbc\xce\x44\x05\x46\xd6\x21\x89\x92\x1b\x37\x72\xb4\x74\x37\x00\xb5\xe3\xd2\x6b\x4a\x8a\x91\x8e\xb9\xcb\x26\x37\x8e\xa8\xaf\x60\x30\xe0\x94\xea\x6b\xe9\x26\xf2\xec\xdf\x8d\x1c\xac\xa3\x59\x11\x4a\x2e\x40\x35\xef\x01\x37\x0d\x11\x7e\xe7\x5b\x62\xe5\xb5\x81\x38\xb3\x31\x64\x04\xdb\xd6\x5f\x29\xb8\x9e\x98\x85\x1f\xd9\x7c\x96\x86\xf9\xb5\xb2\x8d\xbf\x44\x59\x46\xb2\x54\xa0\xa1\x5f\xa2\x09\xac\x2e\x1b\xdf\xb1\xad\xa3\xf1\x6d\xbf\xcb\xe6\x2d\xe3\x37\x06\x2a\x36\x8b\x68\x97\xb2\x57\xef\x40\x33\x3e\x96\xe6\x00\x10\xe5\x0e\xb9\x4e\x92\xcf\xb9\x08\x13\xb6\x9a\x37\x14\xc0\xf5\xea\xc2\x85\xf1\x61\x4a\xbe\x45\x25\x99\x1c\x76\x98\xb6\xa4\x25\x7e\xe4\xb5\x96\xee\x8b\x05\xa9\xbf\x42\x35\xde\x83\xf1\x04\xe0\x70\x98\xde\xf1\x7e\x9b\x84\x63\xd5\xaf\xe2\xff\xe5\x34\x4d\x78\xa3\xbb\x34\x54\x6d\x6a\xe4\xc9\x0a\x9d\x1b\xcc\xe7\x0b\xaf\xb0\xb1\x27\x49\xec\xab\x1b\x90\x33\x19\xe7\x12\xfe\xf9\x37\x75\x98\x6c\x60\x96\x60\x18\xd5\xa0\xd0\xd4\xc3\x13\x95\x78\x2e\x81\x12\x1e\x3d\xc2\x49\x63\x7c\x28\x37\xc8\x9f\x8f\x39\x0c\x0d\x06\x4b\xfe\x9f\x7b\xfa\xc9\x99\x45\xc6\x3b\x68\x9c\x10\x35\xb0\x58\x76\xae\x4f\x19\xc1\x9e\x52\x0d\x53\xcf\x07\x25\x3d\xc1\x1f\x43\xd5\x85\x9d\xf7\x34\xb9\xeb\xa1\x8d\xf7\x5c\x05\xee\x1a\x73\x9a\x25\xd1\x81\x3c\x8d\x6a\xaa\x34\xaa\x21\xbc\x87\xbb\x00\x17\x12\x25\x1b\x7e\xf8\x8a\x94\x53\x41\x4e\xbe\xe0\x34\xa9\x82\x5c\x66\x37\x41\x2a\x14\xfe\x44\x5b\xfd\x77\x3c\x81\xb6\x7c\xbf\xe4\xcd\x4e\x85\x26\xb2\x6d\x92\xb3\x61\x11\x8b\xc2\xb0\xd5\xe0\xaf\x6e\xdf\xb6\xce\x6e\xaf\x07\x84\x0b\xfa\x97\xae\xc0\x7c\x5e\x94\xaf\x9b\x8c\x29\xa5\xbe\xa9\x48\x81\x69\x72\x13\x0c\x52\xd4\x87\xc1\xcf\x67\x91\xfd\xab\xeb\x48\x97\xfe\xee\xe2\x70\x66\x52\x85\x80\x0c\x4c\x00\x9b\xb0\xc3\xd3\x79\x84\x73\xbf\xe1\x16\x4d\x8a\xd7\x6e\x9a\xc3\xd3\xb8\x1d\x84\xb7\x12\x0f\xe2\x3b\xa5\xde\x7d\x0d\x35\xee\xe0\xbb\x9f\x4e\xb0\xee\xf7\x0f\x63\x36\x23\x3f\x2a\x74\xd7\x8c\x14\x96\x23\x59\xa1\x22\x95\xd7\x61\x14\xc5\x99\x26\xfb\x28\xf6\xe1\xfc\xb9\x5d\xb9\x22\x2c\x09\xca\x74\xaf\x2f\x4f\x58\x4c\x3c\xb1\x69\x08\xeb\x80\x92\x7f\x88\x26\xc2\xcf\x1a\x81\xac\x55\x0a\x2f\x7d\x54\x40\x1c\xb0\x10\x2b\x7d\x19\x62\x3a\xa5\xd4\xb2\xee\xb2\x2f\x99\xdf\xf8\x54\x3f\x1e\x3a\x14\x1a\xc1\x00\xe5\xf0\xee\xaf\x34\xb4\x21\x26\xc2\xbb\xcd\x6a\x09\x4f\xfe\xc4\x98\xd9\x14\xbe\xc3\x5e\xec\x98\x02\x02\x18\x63\x51\xfb\xf9\xe9\x8e\xc2\x8b\xd8\x8a\x71\xd8\xc9\xda\x1c\x27\x95\x1d\x30\x75\x0c\x30\x0d\xea\xdf\xe7\x2d\x63\xb8\xa4\x50\xd1\xc7\xd8\x61\xa8\x61\x9e\x1b\x63\x84\x59\x4a\xd6\xa0\xf5\x74\xda\x17\xe5\xb0\x04\xe6\xbb\x99\x3d\xf8\x08\xb3\xcb\xcb\xf8\xe3\x20\xa1\xab\x73\x34\xb2\x4a\x98\x25\xd0\x15\xd6\x10\xc7\x3e\x93\xc7\x4c\xbd\x9d\x3d\x2b\x6f\xdb\x4e\x38\x67\xfb\x4d\x76\x68\x27\xb5\xd5\x43\x79\x01\x0f\x9a\x7a\xaa\x5a\x71\xf2\xde\xfc\x55\xd6\x16\x4b\x3d\x51\xae\xa3\xda\x06\xbe\xfd\x94\xde\xa3\xa2\xfb\x54\x3b\xdd\xd7\x3d\xc5\xd6\xbf\x77\xb2\xe8\xc7\xeb\x2d\x06\xaa\x8d\xbe\xe8\x84\x1c\xb1\x85\xe3\x41\xcc\xc4\x4f\x3f\xc5\xf3\xb5\x33\xc4\x6c\x8f\xbc\xe4\x6b\x8a\x61\x03\x61\x52\xfb\x47\x31\x5d\x4a\x1b\xe5\xbf\x26\xbd\x7f\x3a\xaa\xdc\xc1\x1c\xdf\xd9\xc0\xef\xc8\x6e\xe8\x1b\x6b\xd5\x07\x8c\x22\x1e\x63\x64\x2a\xb0\x4b\x1b\xa2\x87\xf3\xe9\x4f\xe9\x86\x17\x91\xc2\x9a\x6e\x93\x49\x81\xc1\xfa\xbf\x75\x77\x4d\xfe\x70\x57\x5d\xf4\x45\x41\x55\x61\x75\x1c\x2c\x46\xb6\x74\x73\x31\xa9\x5f\xbb\x36\x0d\xe0\x1e\x6a\x64\x26\x20\xee\x79\xe0\xa5\xc4\xfa\x9f\x37\xb2\x4a\x0f\xc9\x56\xd2\x0a\xcc\x35\xc5\x07\xdf\xf5\x26\x2e\x13\xa3\xb4\x98\xaa\xae\xa4\x77\xb8\xd8\x20\xce\x9f\xfe\x2d\x46\xab\x95\x15\x22\xbd\xb4\xf8\x92\x02\x0c\x22\x57\xd0\xdb\x6a\x8e\x54\x20\x91\xef\x6f\xfc\x09\xe7\x43\xd1\xa1\xe3\x4f\x60\x92\x3c\x82\x81\x36\x7f\xc0\x25\xbb\x91\x2e\xe9\xde\x94\xf2\x6f\x4d\x23\xf5\x28\xf7\x86\x37\x33\x4d\xe0\x72\x48\xc3\xe9\x79\xa1\xa3\xe6\xbf\x05\x13\x47\xcc\x9b\x6b\x08\x45\xf0\x8c\x6a\xfb\x5f\x17\xb0\x2e\xb2\x25\x64\xe3\xfe\xea\xa9\x74\x0f\x8c\x94\x01\x6c\x78\x9f\x70\x42\x8e\x0c\xf8\x42\xd2\x9e\xfb\x84\x9e\x21\x6c\xd3\xf4\xff\xfb\xd2\x2b\x1c\x0d\xb1\x8b\x87\x3b\xd1\x5d\x08\xdb\xc0\xb8\x38\x9d\xb5\x61\xbc\x19\x35\xcd\x62\x9d\x89\x88\x29\x9e\xdd\x37\xee\x6a\xa2\x7d\xa8\x76\x48\x90\xde\x0f\x52\xbc\xb9\x78\xb7\x88\x92\x2b\x6d\x44\xdb\x5d\x61\x41\xd0\xdb\x6b\xa5\x7d\x7c\xa2\x50\x79\xc8\x90\x82\x31\x9f\xf2\x20\x98\x53\xaf\xea\x0d\x30\x7c\x71\x68\x29\xee\xa7\x7a\x0d\x8f\x1d\x25\xa3\xcd\xe4\x96\x23\xb2\x82\x3c\x0d\x53\x16\x3c\xc1\xb4\x46\x79\x21\x9d\x4f\xdb\xb7\x9b\x4c\xd4\x60\x88\x1d\x1a\x63\xd6\x5b\xb2\x80\x6e\x88\x99\x5f\x80\xec\x69\x11\x1b\x4c\xc8\x4f\xf8\x3a\x22\x97\x12\xff\x9a\xab\x2c\xc9\x11\xa0\x17\x58\x9b\xff\x3c\xb5\x46\xac\xa1\xc3\x2b\x35\x06\x89\x64\x6a\x20\x09\x2b\x19\x58\x63\x7d\xd8\x8f\x5a\xb2\xa5\x86\x91\x49\xe7\x1f\xcf\x4b\x52\x00\x54\x44\x87\x4e\x05\x3d\x26\xfa\x78\x2d\x5b\xad\x61\x03\xef\xf9\xc9\x55\x43\xcb\xb6\x00\xc9\x10\x72\xdb\xec\x4c\xc1\xb4\x70\x52\xf0\x74\xd0\x3d\xf8\xca\x4d\xfb\x2f\x45\x95\xf9\xe5\xcd\x68\xea\x06\x8c\xdc\x83\x16\x66\xbf\x11\xce\x89\xe1\x25\x39\xac\xa5\x91\x96\xdd\x5a\xb0\xb8\xd9\xf7\xd3\x0b\x0a\x31\x8a\x9a\x71\x10\x0f\x7e\x19\xef\x75\xa9\xa6\x17\xa9\x5f\x7a\x59\xce\xb6\xf0\x73\x89\xde\x7f\x1b\xcd\x44\xe6\xa5\xc6\x86\x99\x20\x84\x3e\xf8\x05\xb3\xb8\xb5\x1d\x0c\x84\x9e\x83\x13\x4a\xce\x78\x7e\xa1\x1b\xd0\xaf\x8c\x0d\x0c\x12\xa0\x56\x9a\x09\xcf\x22\xba\xe1\x84\x47\x97\x1f\x96\xf5\x28\x13\xd6\xc9\xdf\xd9\x61\x24\x4e\x3c\x0f\x69\xde\xea\xfb\x05\x65\xe4\x05\x3b\x04\xce\x84\x34\x3c\x65\xe5\xbe\xbd\x81\xbb\x79\x42\xbf\x70\xfe\xdd\x1d\x41\xff\x4d\x3c\x95\xe7\x4f\x01\x7a\xe6\x7b\xa3\x11\x1d\xa5\xc6\x68\xd7\xfd\x14\xb6\x6a\x2b\x99\x2d\x81\x92\xe4\xc3\xda\x37\xdf\xc8\x05\xd2\xe4\x24\xc4\x6e\x77\xc6\x06\x26\xa2\x30\x53\xe5\x9f\x8c\x0e\xc6\xe2\xe4\x2c\x05\x3e\x37\x22\x07\xc8\xb3\xa9\xc0\x8d\xb2\xb3\x5d\x15\x5d\xa9\x55\x6a\x0d\x83\x44\x6f\x0b\x5b\x28\xef\xbf\x98\x47\xf2\x5e\x9e\x8f\xfe\x68\xcd\xb9\x82\x74\xc2\x1b\xa2\x84\x0d\x6b\x70\x5c\x53\x42\x64\xfc\x9c\x58\xec\x2c\xce\x6e\xc2\x40\xf7\x10\x25\x5d\x35\x6e\x34\xc8\xaa\xd4\x8a\x8d\x2c\xac\xfa\x64\xc7\x82\x0d\x7b\xef\x93\x7f\x57\x5f\xba\x42\x18\xfb\x21\xba\xaf\x19\xb6\x90\xc8\x26\x28\xcd\xe9\xd8\xbe\x1f\xff\xb8\x32\xca\xbe\xf0\xe5\x39\x72\xa2\x6b\x58\x0b\x20\x03\x10\xd9\x3a\x5b\x5f\x51\x1a\x62\x54\x22\x6c\xf0\xb6\xf1\x3f\xa8\xd7\xca\xec\x43\x22\xf7\x7b\x47\x2f\x0c\x9c\x04\x21\x49\x0b\xb9\x89\x47\x46\x7a\xe0\x9d\x2c\xdf\x17\xd2\xdf\x5d\x11\x15\x24\x34\x85\x43\x0e\x50\xa5\x1f\x21\xcb\x4f\xee\xfe\xb1\x9c\x7c\x98\xc0\x89\x97\x6d\x96\x9f\x4b\xa7\x85\xd4\xd7\xdb\xcb\x71\xd2\x0d\xc5\xea\xc2\x2b\x49\xb4\xe6\x66\x92\x86\x42\xb3\xa3\x0d\x96\xcb\x40\x48\x55\xd1\x2c\xb4\xa0\xf2\x5f\x89\xd3\xb4\xf9\xd8\xd3\x26\x15\x3e\xe7\x49\x14\xcc\x2f\x52\xe6\x45\x33\x6f\xe6\xde\x30\x69\x21\xc1\xaa\xd2\xe8\x7b\x31\xd0\x0d\x79\xb1\xff\x3d\xeb\xea\x7b\xed\x00\x09\xff\xa2\x52\x5d\xc1\xa2\xfb\x62\xbe\x83\xf5\x8f\xc6\x82\xe6\x85\xe6\x37\x78\xc0\x62\x1e\xca\xb6\x62\xa0\xdb\x80\xa4\x9a\xc8\x7b\xb2\x5b\x9d\x1b\x58\x8a\x6a\xa9\x34\x5c\x22\x7d\x52\x0c\x84\xd1\x6a\xff\x66\xca\xea\xc3\x47\xec\x16\x5e\xe9\x24\x8e\x20\x17\xf7\xd4\xdc\x35\xc4\xe6\xa5\xe7\xf7\x8d\xf7\xa0\xde\x53\x4b\x41\xf2\xa0\xc1\xe3\xb7\x71\x27\xd1\x39\xe6\xac\x89\x77\x84\x99\xf5\x6a\xcd\xa3\x46\x3b\x56\x90\x31\xfd\x54\xb8\xc5\x3f\x03\xa5\x42\x4d\x1a\xae\x8f\x88\x01\xc8\x10\xef\x15\x73\x12\xa1\x1e\x69\x03\x23\xd7\x47\x91\xee\x12\x06\x1f\xf4\x01\xf3\x3a\xc9\x9c\x06\x69\xf8\x91\xa9\x18\x81\x52\x12\x88\xb0\xf1\x56\xe2\x12\xa8\x55\xe0\x18\x14\xae\x9d\x86\x9b\xa4\xcf\x40\xd7\xdd\xbc\x89\xf4\xda\x38\x2f\x2c\x53\x4b\x6e\x22\x20\x33\xc4\xca\x33\xd8\x14\x36\x86\x54\x70\xf9\x5c\x9e\x05\x3d\x5f\xa2\x9b\x0e\xad\x71\x84\x39\xb3\xd7\x9b\xd7\x16\x93\x4a\xf4\x0f\x5d\x21\x65\x46\xec\xa9\xc8\xb4\x68\x71\xc2\xdf\x0b\xf8\x90\x5f\x04\x05\x10\xee\x20\x53\x66\xd3\x25\xa0\x08\x9a\x44\x65\xbe\x6e\x9c\xaa\xe9\x93\x34\x6d\x52\xc9\xa3\xec\xd7\x77\x93\x3f\x91\x24\x4b\xbc\x5a\xb6\x60\x68\xcb\x52\xc2\x5d\x49\x9a\x9c\x46\xed\x3d\x9f\xbe\x61\xc4\x6b\x4a\x71\x95\x3a\xae\xd6\xf3\x7c\x97\x90\x0d\x63\x78\xa7\xa9\x05\x78\x1b\x2c\xc1\xa0\xfd\x41\x5f\x0a\x0e\xac\x52\xcc\x2a\x49\x1d\xe9\x57\xe1\x0d\xd9\x05\x76\x64\x76\x1b\xff\x24\x77\x9d\x85\xe2\x4d\x6b\x3b\x68\xe9\x08\xe5\xf9\xdb\x75\x54\xdc\xe7\x0f\x56\xee\xe5\xbd\x3d\xa8\xb2\x70\x9d\x6d\xf1\x0c\xf2\x6d\xe7\x8c\x91\x72\x7e\x9a\x16\x56\x20\x57\xe0\x98\x65\x86\xb3\x07\x1e\x30\x06\xb7\xf0\xa1\x0f\xea\x1b\xfa\xa7\x64\x8e\x93\xf8\xa8\x8a\x4d\xa5\xe3\xfe\x4c\x72\x1c\xd3\xfc\x31\x92\xb0\x32\xc5\x61\x76\x40\x4d\x04\x08\xa3\xdc\x3a\xc5\x16\xf4\x3b\x18\x26\x72\x34\xd0\xb2\xb5\x4d\x0c\x05\x2f\x1d\x94\x1e\x14\xc2\x3d\xd4\x08\x91\x8a\xab\xce\x29\xad\xa9\x3d\xe9\x7c\xf9\x34\x09\xb4\xc3\xb0\x1f\xa9\x8b\x6d\xa9\x37\xc8\xda\xfa\xae\x0d\xe3\x3a\x46\x27\x34\xdb\xb5\x2c\xaa\x28\x9b\x8d\x24\x1a\xf2\xfc\x72\xd8\x4b\x31\x0d\x9b\xc3\x6b\x81\x87\x95\x0a\xa0\xa6\xee\x36\x02\xa7\x2d\x93\x0a\xd6\x67\x07\x04\x38\x63\xeb\x62\x25\x4c\x72\x55\x54\x5b\x97\x6e\xd2\x41\xde\x20\xcf\x64\x84\x98\x80\x4c\x07\x62\xb1\x2f\x05\x69\x9d\xe9\x22\x0e\x36\x8f\x2e\x6b\xf9\xa3\x99\x15\xd1\xd2\x18\x53\x86\xb5\x2e\x0d\x91\xe8\x21\xad\x57\xba\xa2\xc5\x32\xae\x1c\x7e\x48\x64\x71\x03\x6c\xd0\x1e\xf7\x22\xb8\x06\x83\x61\x80\xc3\xcf\x26\xd3\x32\x97\x9e\xb3\xcd\xde\xce\x21\xf4\x22\x01\x66\x58\x14\x1c\xc1\x5f\xb8\x1f\xff\x62\x5b\xc6\xe1\x0a\x15\x9f\xa8\x6f\x8c\xe9\xea\x47\xa2\x22\x4b\xa4\x4b\xb4\x2c\x72\x42\xbe\x63\x70\xdb\xda\x17\x5d\xc3\x35\x02\x9c\x76\x30\x25\xac\x8d\x1c\xd9\x41\x75\xf0\x31\xb6\xce\x42\xd5\x1c\x27\xce\x6c\x5a\x03\x12\xd0\xc4\x34\x76\xea\x46\x7f\x90\xa9\x4b\x1a\xc9\x26\xd2\x6d\x35\xed\x33\xa5\x2f\x97\xbb\x8d\xec\x26\xb3\xf7\x27\x0e\x9e\xa6\x5a\x5e\x51\x4d\x74\x5e\x63\x0b\x8b\x08\x41\x6e\x73\xc2\x87\x7a\x61\x50\x52\xe4\x53\xcb\xa6\x3a\xab\xf2\xbf\xb6\x6b\xb4\xcb\xab\x6d\x12\x90\x57\xc5\x12\x33\xd1\x5d\x78\x20\x6e\xa9\xdf\x7c\x12\x13\x35\x4d\x74\x7c\x4f\xc6\x50\x28\x64\x84\x7f\x56\x04\x90\xe6\xd5\xb1\x65\xc6\x3b\xc0\xdc\x93\x3d\x98\x43\xc4\xea\xb1\xc2\x87\x89\xa0\xed\x8d\x15\x67\xc3\x7e\x39\xca\x9d\xdc\xdc\xad\xc2\x8c\x59\xac\xe7\x35\x1f\xb1\x7c\x23\x46\xdb\x35\x0f\x01\x3a\x84\x7b\x68\xf3\x79\x4f\xb5\xeb\x87\x1e\x38\xdf\x29\x26\xfd\xfb\xa8\x22\xb1\xcf\x63\x08\x91\x66\x30\x1b\x77\x57\x1b\x6f\x0b\xfe\x4b\x8a\x25\x36\xd0\xa4\x77\xb9\xe7\xcf\x1e\x3b\x6b\x31\xf0\x83\xab\x11\x14\xa7\xdf\xc2\x38\x77\x3b\x19\x9a\xb9\xee\x57\xaa\x73\x07\x85\xbe\x53\x71\xfc\x92\x59\xe1\x69\x50\x4b\xf3\xcf\xaf\x3f\xbf\xe0\x1c\x8e\xfa\x32\xfd\x42\xe1\x81\xad\x11\x64\x8e\xf6\x58\x0b\x20\x34\x77\xdb\xf3\x86\x64\xa2\xa5\xe5\xe6\x4a\xad\x32\x81\x86\xd4\xce\xe9\x75\x10\x5c\xbd\x5a\x8e\xee\xff\xa3\x12\x99\x21\x40\x09\xac\xae\x06\x04\xbe\xdf\xab\x40\x93\x0c\x85\x67\x86\x76\xe9\x27\x02\x53\x10\x56\x90\xaa\x5d\x6c\x4e\xa5\x4f\x33\x23\x6a\x63\xa2\x6c\x60\x68\x6c\x4a\x53\x29\x13\xc8\x4b\x7c\xd5\xe8\x35\x13\xbc\x24\x63\x52\x58\x1a\xdd\x05\x2e\xa6\x06\xf7\x55\x8c\xa7\x92\x75\x71\x3a\xb2\x49\x18\x6e\x9c\x5d\xe9\x72\x68\x3b\x99\x31\x6d\x7f\x9e\x76\xd4\xc8\xbc\xdb\x41\x72\x3e\xc2\x0b\x40\x1d\xb6\x62\x4c\x85\x37\x4d\xd7\x4f\xae\x31\x82\xec\x08\x95\x92\x22\x72\xc5\xd5\x23\xa7\x64\xa9\x0d\x51\xb8\xbb\x4a\x60\x23\x46\xaa\x55\xe2\x3e\xd1\x4a\xa7\xe7\x3d\x77\x2d\xd0\x10\xa8\xf3\x75\x13\x37\xe0\x92\x91\x0d\x39\xde\xc6\x0c\x61\xdc\x1a\x6f\xc5\x19\x8c\xb5\xa4\x7f\x66\xd5\xa3\x07\x99\x5e\x70\x6d\x8e\x40\x63\x41\x57\x65\x82\xe0\x3a\xea\x27\xe4\xf3\x32\xb5\x7c\x75\x75\x41\xe5\xc2\x8d\x87\x0e\x2e\xfb\xff\x3c\xcb\x27\xdb\x69\xa1\xc4\x07\xf5\x19\x56\xec\x8f\x4e\xb1\xfe\x61\x81\xe9\xcb\x34\xcb\x76\x23\x1a\x35\x1d\x56\x49\xa3\x2b\xfe\x5a\x8f\x25\xfc\xdd\x60\x51\x33\xf6\x6b\x31\xd4\x17\x60\xca\x0a\xad\x1a\xe6\x3a\xef\x60\xab\x52\x64\xcf\x0b\x26\x87\x45\x56\xc7\x70\x2a\xb8\x87\x81\x8a\xb5\x77\x3d\x1c\xb5\x28\x0c\xc6\x20\x7b\x84\x1a\x95\x66\x43\x8c\xc1\x76\x0e\x11\x01\xad\xe5\x54\x16\xe2\x45\x00\x7f\x0f\xcf\x03\x7d\x73\x26\x7a\x3e\x59\x4a\x48\xee\x0a\x16\x01\xc7\xb9\x1e\xe6\x1a\x6c\xf4\x6c\x77\x68\xe4\x66\x35\x33\xe4\xce\x61\x94\xbe\x55\x81\xdf\x83\x18\x11\x4f\xcb\x62\x9e\xd0\xd1\x89\xa8\xf5\xf7\x1c\xfa\xa8\xbb\x8c\x89\x16\xfc\xbc\x41\xe5\xce\x61\x91\x62\xd5\x4b\x5e\x14\x43\x93\xff\xb4\x12\x79\xc5\xeb\x64\x60\x6d\x6e\x17\xd3\xc5\x22\x13\xd0\x73\x80\x72\x6e\xd7\xe9\xc7\x11\x79\xc4\x6a\x6d\xb0\x29\x7f\xd8\xaf\x91\x18\xdb\x50\x92\xb0\xd3\x3f\x15\x72\x92\xf9\x89\x6d\x2b\x3f\x45\xcf\x2b\x38\xb2\x73\x94\x73\xd8\x80\x9b\x9b\x36\x3f\xaf\xae\x2d\xdb\xea\x01\x93\xfd\x97\xb8\x83\xa6\x22\x75\x06\x26\xc5\x36\xe6\x97\x43\x37\x68\xd1\x75\x95\xa8\xe1\xe4\x23\x77\xeb\x98\x36\x64\xb0\x26\x2a\xd6\x2b\x62\x8a\x4a\xbd\x4d\xf9\xd1\xd7\xc1\x04\x8f\x3a\x97\x08\x29\xde\x30\x18\x92\x94\x23\x2f\x56\x19\xbc\x38\x58\xf9\x65\x68\x49\xba\xc6\x5a\x35\xa4\x8d\x61\x9a\xa2\x07\x56\x1d\x4d\x85\x17\x5b\x14\xb6\xfa\xd3\xc1\x19\x95\x81\x16\xaf\x52\xb7\xff\x88\x10\xf5\x30\x5b\x98\x51\x89\xf7\xfa\x0c\x62\xaa\xc1\x98\x58\x5d\xfe\xda\xbe\x6c\x39\xe2\x55\xa1\x42\x88\xd1\x2f\xe4\xf7\xd1\xec\x23\xa2\xb0\xfb\xb6\x58\x8c\x03\x68\x72\xfe\xa8\x63\x30\x00\x53\x8a\x50\x30\xb0\x76\x32\x59\x3e\x13\xc2\xce\x6c\x96\x96\x0b\xd4\x81\x04\x44\x68\x32\x79\x87\x92\x20\xe0\x9e\xeb\x0e\x62\x59\x8d\x6f\xa0\x02\xd4\x81\xde\x9b\x20\x73\x51\xdb\xaf\x41\x7b\x93\x23\x45\xaa\xe7\x86\xb2\x58\xff\x08\xcf\x47\x31\xa0\xc4\xe6\x13\x74\x19\xb8\x59\xb5\x7f\x11\x60\xb6\x2e\x68\x28\x31\x0e\x86\x3e\xed\x63\xfd\x40\xa5\x48\x64\x4a\x4d\xb4\xd8\x2c\x2d\xe4\x78\x62\x1f\x0d\xbe\xb9\x74\x1f\x86\xe2\xa9\xc8\xd2\x85\xe2\x86\x0b\x9f\xb3\xd9\xab\x9d\x12\xe0\xb3\x59\x14\x92\x74\x8f\x91\xb4\x4b\x0f\x10\x43\xd2\xa2\xc9\x3c\x83\xb0\x04\x43\xd8\x00\x09\x05\x0e\xb4\xcd\x6d\x33\x5e\xd1\x62\x53\xc1\xbf\x30\xb4\x28\x6c\x30\x63\x50\x62\xb2\xd3\x2d\xe0\xc4\xe6\x11\x7c\x64\xb8\x23\xe7\x50\x44\x1b\x21\xdd\xf0\xda\x6b\x33\x99\xe5\x42\x50\x40\x18\x20\x7a\xe8\xf3\xb8\x1d\xcd\xa4\xc6\xbc\x1a\x0c\xf3\xaf\x88\x02\xf1\x20\x7b\xdb\x27\xe1\x8b\x9a\x6b\x2a\x84\xaa\xed\xc8\xbc\xa3\x3c\x64\xec\x39\xdd\xc8\x11\x37\xbc\x9d\xeb\xfa\x44\x37\xeb\x99\x0e\xaa\x08\xc3\x5d\x84\x8f\x93\x97\xd9\x67\x61\x3c\x9e\xf0\xbb\x8a\xd2\x97\x39\x21\xc4\x42\xc5\x2e\x1e\x2b\xdc\x15\xa9\x17\x2b\xd7\x4a\xed\x46\x2c\xdc\x40\xb5\x90\xee\xc2\x77\x98\x43\xc2\x34\x64\x11\x67\xa4\x24\xd8\x96\x2e\x8e\x08\x1f\xfa\xa7\x4e\x8e\xfd\xa0\x9d\xbd\x0a\xc6\xe1\xba\xce\x11\x77\x0e\xa9\x4d\xc7\x95\x93\xe7\xa4\x1e\xec\x47\x73\x35\xd4\x23\xcc\x6f\x96\x57\xa9\x2a\xe9\x62\x77\x68\xf3\x24\x5a\x1a\xba\xbe\x6a\x70\x9b\xfc\x8a\x09\x70\xde\x47\xbd\x53\x3f\x1a\xc7\xa5\xa9\xbb\x7a\xe8\x11\xeb\x00\xbc\xeb\x1d\x0d\x2e\x98\x31\xd9\x1a\xac\xa0\xa9\x7b\x24\x93\x42\x63\x5a\xf4\x00\x05\x26\x93\x09\xa9\x1f\xab\x8e\x6d\x33\xbd\x27\xc5\x5b\x60\xf8\xf4\x1e\xe7\xa9\x95\x71\x07\x33\xc7\xdb\x78\xe4\xbb\x9b\xe0\xc9\xcc\x01\xa3\x37\xfd\xa6\x77\x5c\xfb\x9e\xdf\xbf\xf6\x3e\xbb\x7f\xcc\xe1\x11\xc0\x4f\x00\x10\x7f\xf3\x3e\x4b\xee\x13\x79\xe6\x4b\x07\x0c\xf7\xd9\x85\xc8\xea\xbe\x2b\x2a\x81\x8e\x5a\x1d\xe0\x74\xff\x7b\x07\xa9\xe4\x5f\x2e\x95\xc2\x9b\xa8\xfd\x3b\xb7\x7c\xc3\x09\x42\xa0\x43\x57\x11\x63\x60\xc3\x41\x30\x4f\xce\x2c\xd2\x6b\xf8\xe8\x86\x9f\xb9\x86\x1c\x7c\x94\x26\x77\xa7\xff\x8d\x3c\x68\x46\x6f\x3a\x3f\xb6\x55\x0c\xf1\xdc\xe0\xd2\x58\xc9\x08\xfd\x33\xeb\xfe\x0f\x26\x05\x84\x5a\x66\x01\x45\xf1\xa1\x47\x0e\x16\x2a\xcd\x52\xed\x23\x1f\x47\x15\xb1\x89\x5b\x19\x64\x55\x9f\x98\xb8\x42\xa2\x64\x05\xa6\xdf\x64\x87\x43\x95\xd1\x30\xab\xbc\xc1\xd3\xbe\x4e\x26\xee\x94\xfe\x40\xc5\x07\x7d\xa9\x76\x6e\xf4\x37\xf7\xb7\xf4\x86\x8e\x19\x1c\xab\x98\x8a\x0d\x71\xa2\xa8\x82\x92\xe8\x97\x13\x54\x28\x73\x61\x6a\xbd\x46\x7c\x3a\x9e\x61\xbc\x39\x7e\x81\x5c\xdf\xfb\x29\x1e\x01\xf4\xf4\x09\x06\x4d\x29\xfd\x46\xe9\xcc\xfa\x72\x62\xc3\x38\xa8\x06\x93\x8c\x18\x93\x44\x8b\x1d\x26\x8e\xd4\x37\xb7\x2d\xd9\x15\x11\x78\x42\xfd\x3d\xa4\x44\x6b\x05\xbd\x60\x7d\x63\xac\x1b\x8c\x94\x1e\x8a\x7e\x67\x71\x4e\x5d\x5e\x6e\x2b\xfe\xe6\x67\x51\xad\x03\x87\xb9\xc3\x28\x17\x75\x6d\xed\x24\x2a\x70\x27\xc0\xb3\x2b\xff\x4f\xf8\x2b\xf5\x6b\xbb\x81\x04\x62\x27\xee\x2f\x0e\x83\x7f\x92\x68\x05\x32\xb2\x87\xa5\xaf\xe9\xb9\xa1\xd4\x3b\x6a\xf1\x46\x18\xe5\x57\x21\x1c\x13\xaa\x51\xe0\x42\x60\x43\x99\x38\xf4\x3b\xc3\xb4\x83\x45\x27\xd4\x0a\x74\xa5\x0e\x7c\x88\xd5\xf3\x82\xd2\xcb\x2d\x83\x82\x6f\x2a\x18\x06\x95\x7d\xde\x13\x40\x33\x1b\x66\x19\x36\x38\xea\xde\xb0\x0f\x38\x29\xe4\xe3\x7f\xd8\x68\x9a\x82\x57\x7c\x80\x2d\x77\xdc\xd3\x1f\x4f\x35\xdc\x66\x44\xa1\x63\xfd\x6d\xff\x67\x3f\xd9\x44\xf4\x1a\x52\x57\x77\x0b\x22\x54\x2f\x6d\x0f\x1e\xaa\x23\x0f\xfe\x9f\xae\xda\xd2\xd2\x61\x8f\x86\x8e\xf5\xf6\x6e\xf8\xfc\x94\x57\x40\xe0\x63\x0c\x5c\xb6\x5a\x87\x7e\x3d\x4b\x29\xbd\x90\x8f\xca\x42\xcc\x97\x71\xa2\x36\xc7\x35\x91\x55\xa7\xeb\xe5\x2f\xbd\x0a\xb8\x68\x31\xad\xbf\xbf\xa4\x70\xfe\x61\x31\x57\xe8\x84\x5e\x80\x55\xfc\xfe\xa9\xae\x84\x04\x4b\xc4\xe9\x79\xf5\x95\x5f\x85\x7e\x0f\xa1\xeb\x40\xdb\xbf\x4e\x22\x60\x5c\x8c\xbc\xa6\x9c\x56\x94\x13\x36\x23\x66\x31\x45\x60\xa2\xd0\x6d\x90\x41\x26\xec\x94\xa2\x1c\xcb\x68\xc8\x8a\x71\x77\x38\x9e\x92\xa6\x38\xbb\x09\xa0\xd3\x2e\xf1\x52\x46\xd7\x15\x11\x0f\xf0\x2b\x56\x43\x76\xfa\xb0\x4c\x8c\x7e\x54\x2f\x0d\xdc\x91\xde\x3a\xa7\xeb\x98\x3b\x5c\x3e\x1e\xff\x56\xad\xc0\x5a\x68\x8a\xcc\x4d\x02\xb9\x8f\xd2\x18\x36\x19\xae\x16\xe3\xb1\x30\xec\xb2\x8e\xf0\xd4\xfe\x64\x2b\xc7\xa1\xe7\x76\x03\x17\x1a\x81\x06\x4f\x92\x96\xb1\xee\x0d\x94\x47\x02\xe5\xac\x61\xf6\x8c\x17\x57\x9d\x1b\x70\x22\xbe\x91\x29\x90\x91\x8a\xf4\x99\x8f\x55\x28\x16\x4e\x7f\x13\xa8\xe1\x1b\xef\xd6\xa5\x6d\xe4\x49\x0c\x7f\xc9\xa3\xb3\x08\x43\x0c\xdd\x45\x06\x75\x97\x65\x85\xb3\x64\x69\x61\x6f\x4c\x0c\xf8\x21\x7f\x34\x9a\x56\xe3\x6f\x9c\x46\xbe\x09\x9c\x3e\xe7\x86\xd4\xab\x0b\x51\x0d\x83\x20\x9b\xf9\x84\x9b\x0e\x57\x18\x99\xf6\x5e\x02\x2d\xcc\x14\x94\x6f\x78\x3c\x4a\x1d\x1a\x35\x17\xac\xe2\x62\x79\x89\xa4\x4f\x9f\xb8\xea\x89\x30\xa6\x51\x8f\x63\x7e\xa0\xf6\x09\x42\xb6\x70\x02\x42\x82\x32\x44\x4c\x97\x46\xe2\xff\x7e\xcc\x5f\x34\x06\xd5\xce\x65\x0d\x68\xf2\x25\xe7\x85\x19\x15\x12\xd9\x5b\x10\x4f\x76\xee\x22\xa0\xcf\x4f\x5d\xa5\xa7\xe1\x78\xe2\x22\x7e\x18\xcf\xa2\x83\xe3\x0f\xc9\x28\x8d\xa5\x06\x4f\x45\xc8\x65\xf8\x29\x77\x0d\x85\xde\x82\x80\xf5\xd1\x98\x97\x51\x72\x16\xf0\xd0\x4a\xc5\xd8\xa0\xd9\xe7\x16\x45\x54\xda\xd5\xde\xe3\xa7\x14\x9d\xef\x53\x9c\xa2\xc7\x04\x45\x5e\x1d\x0b\x9a\x9d\x54\x91\xb6\x1e\xfc\x74\x38\xf6\x92\x3f\x9d\x4c\x8e\x2e\xa6\xb1\x73\xb8\x13\xee\xbd\x4f\xbf\x92\xb7\x3a\x36\x38\x98\xa2\x26\xa9\xce\xf3\x40\xd0\xe1\x37\x94\xf3\x6b\x9d\x2c\x3e\x6e\x76\xe3\x99\xb4\x6e\xd6\xdf\xba\x07\xc5\xc5\xab\x46\x43\x26\xdd\xcb\xd4\x47\xd0\xb9\x9f\x5c\x3d\x5c\x09\x06\x70\x72\x3b\xc0\x93\xa4\x4b\xc5\x85\xc6\xc0\xed\x56\x6b\x4e\x64\x20\x67\x62\x6c\xf9\xe4\x61\x1b\x20\x45\x6d\xff\x47\xc7\xe2\xce\x04\x36\xcc\xd7\x12\x07\xa1\xb5\xab\xa7\x09\x46\x0f\xe4\xa9\xfd\xa9\x19\x99\xae\xad\xa8\x41\x50\x67\x7c\x79\x15\xc1\x5c\x80\xf7\x81\x78\x03\x5e\xdb\x97\x69\xba\xba\x77\x82\x8f\x8e\x5a\x30\xe9\x2e\x97\xd1\x13\x5d\xc8\x94\x53\xe3\x8a\x33\xdc\xb7\x4c\x8a\x04\x61\xe5\x62\xb5\x65\xfa\x0f\xd3\x6d\x35\x7d\x17\xc4\xb1\x18\xef\x5b\x1d\x4b\x95\x6f\x02\xe4\xf7\x01\xed\xcd\x7f\x8a\x91\x75\x88\xb8\x92\x46\xef\x64\x48\x25\x50\x00\xae\x46\xdc\xb7\x91\x4b\x8d\x48\x9a\x2b\xd2\xc3\x64\x80\x96\x51\x99\x63\xd7\x58\x09\xf9\xe3\x78\x2e\xfc\x7c\x32\xc5\x6e\x00\xc6\x93\x0b\x39\x4d\x32\x91\x79\x61\x0a\x84\xc9\xa7\x8b\x82\x67\xd7\x28\x6a\x8c\x08\x69\x30\xd5\xe5\x63\x57\x57\x1f\xfc\xad\x67\xcb\x25\x76\x6f\x3c\xa2\xa3\x80\xc4\x42\x53\x14\x7f\xd0\x30\xe3\x96\x57\xfd\x2e\x9b\xb3\x12\x15\x49\xad\x6b\x72\x84\x76\x0a\x8c\xf4\x93\x71\xa6\x87\x0c\x45\x16\x4b\xb6\x5c\x27\xcc\x28\x16\xe2\x1d\x7f\x14\x27\x29\x1e\x57\xc4\xae\xbc\x3c\x73\xae\x82\x0c\x21\xcf\x0a\x1c\xd0\x85\x94\x60\xb0\x94\x43\x23\xc9\xf1\xd0\x75\xb2\x08\x9f\xdc\x8d\xb7\x9c\xf6\xdf\xb6\xb0\x60\xed\x1d\xd6\x0f\x45\xdc\xe2\x52\x3a\x62\x21\x35\xe2\x2c\x9c\xb7\xe0\x30\xe6\x37\xd7\x5e\xb0\x3c\x7b\x83\x03\xb7\x26\xf1\xd0\x87\x1c\x48\x5a\xaa\x91\xa5\xfe\xbb\x4a\xdf\xf0\x29\x1b\xdc\x3f\x16\x2d\xaf\x1a\x49\x3a\xa2\xa6\xc1\x3f\xe6\x74\x44\x6e\x24\x69\xb5\xf8\x10\x92\xe7\x98\x48\xdc\x7e\xf7\xf1\x63\x90\x5d\x9e\x2a\x33\xad\xd3\x22\x3b\x32\x98\x3e\xc6\x81\xfa\x51\xe1\x1d\x7d\x47\xdb\x10\xe9\x0b\xc0\xc3\xd0\x1c\x81\x6f\xa4\x37\xcc\xfc\xa4\xd9\xa4\xe7\x22\x2c\xf3\xb0\xc7\x8d\x0f\x6c\x53\xcd\x51\xb9\x26\x3c\xe2\x94\xb8\x5f\x90\x52\x5a\x9e\x61\x14\x33\x09\xff\x90\x79\xeb\xed\x46\x47\x69\x60\x20\xde\xa3\xa6\x9d\x36\x0a\x72\x9d\x02\x85\x0f\x3e\x66\xe0\x69\x0d\xac\xec\x2e\x19\x7e\xb0\x40\xc2\xd3\x3a\xba\xe0\x44\x74\x5d\xc8\xd1\x5a\x16\xcf\xae\x21\xee\x8c\x15\x2c\x74\xe4\x3b\x13\xbf\xbf\xcc\xb0\xad\x90\x4e\xb0\xeb\x34\xfe\xd9\x39\xf5\x02\x34\x3b\x8b\x7f\x9a\x4b\xb5\xba\xb0\x51\xce\x4c\xfe\x48\x92\xc3\x5c\x6e\x10\xa9\x23\x7f\x92\xa0\x56\x0c\x76\xb7\xc2\xa7\x51\x84\xb5\xf8\x70\x5b\xbd\x27\x20\x5b\xa8\xbf\xe1\x70\xb9\xc8\xc5\x75\xc2\xfa\x71\xd1\x9f\x57\xa6\xf6\x13\xfa\xe7\x39\x09\xa3\xd3\xaa\xad\x2b\x8b\x1b\x52\xae\x3a\xca\x27\x94\x82\x7a\x06\xf5\xd3\x02\xe1\x8d\x8a\xa5\x3e\x2b\x5b\x91\x35\x0d\xb5\x99\xbb\x62\xf9\x1b\x8d\xfa\xa8\x37\x77\x4f\x90\x01\xdb\x3f\x10\xbf\xd4\x2c\x0a\x10\x7e\x2a\xec\xbc\xf8\x0f\xae\x9f\x7a\x35\x04\x03\x03\x1f\x15\x0b\x28\xfc\xa4\xfc\x5f\x74\xcb\x17\xab\xfa\x10\x22\x44\xd2\xe8\xdb\x3c\x41\x0a\x12\xc1\xfd\x74\x60\x81\xc0\xca\xed\x0c\xc7\x10\xca\xf2\xca\x6b\x34\xca\xc0\x6c\xea\xfa\x57\xca\x74\x06\xfc\x24\x23\x18\xd5\x7f\xff\x2e\x8f\xac\x48\x2b\x46\xa9\x8a\x78\x8f\x26\x0a\xb4\x3f\xcb\xe7\x76\xeb\x01\x31\x31\x36\xb5\x54\xbe\x19\xfe\x9a\x00\x49\xdb\x6a\x78\x5d\x1f\x8f\x87\xf4\xb3\x2c\x77\x88\x2e\xf3\x85\xfb\x64\x03\x60\x39\x18\xb8\xe8\x60\x5f\x76\xb7\xd4\x79\xc5\x3a\x5a\xe3\x8e\xbf\x4d\xbc\xa4\x1b\x02\x75\x02\x67\x3d\x80\x3c\x25\x0f\x46\xaa\x28\x69\x4f\x27\xe0\x5a\xd2\x26\x97\x5d\x0a\xbe\x44\x88\x8d\xe4\xa8\xe0\xdd\x58\x04\xe5\xad\x55\x89\x9e\x12\xff\x08\xf9\xbd\x52\x67\x7c\x62\x9c\x54\x56\x7f\x13\xba\x9c\x1c\x70\x0c\x4f\x51\x2e\xfb\xfb\xda\xf1\xf4\xf0\x61\xd1\x28\x49\xb2\x0e\x24\xed\x97\x5d\x44\xa9\x34\x21\x37\xf7\x26\x58\x34\x9a\x42\x6a\x83\xcf\xfa\x5c\x54\xce\x1d\x9b\xea\xc4\x14\x20\x3d\xed\x42\x5c\x54\xfd\xcf\x59\x6f\xb2\x86\x8a\x8a\x0f\x0d\x2a\xf2\x30\x70\x32\x12\x1b\xcd\x86\x9d\xf8\x57\xe1\x5b\xa8\x14\x48\x2c\x03\x20\x2f\x1f\xc5\x11\xad\xb6\xc0\x97\x6f\x2e\x7a\x67\xeb\x17\x15\xe9\xac\xb3\xfc\xa4\xf6\x29\x9e\xa8\x4c\xfc\xad\xcd\x79\xd9\x13\xf0\x1b\xaa\x99\x53\x4e\xde\xe2\xc4\xd7\x1d\x5c\x7f\x97\xf4\x7d\x5c\x42\x51\x9c\x72\x8f\x3e\x8f\xdf\x87\xa8\x9b\x33\x3b\x5a\x31\x19\xb8\x2f\x2a\x73\xbf\x69\xe5\x18\x1b\x18\xc0\xac\x38\xe9\xb7\xde\xb6\xb7\xe8\xb8\x00\x1d\x97\xaa\x3a\x61\xa2\x56\xaa\xbb\x3e\x24\x6b\x26\xdd\x8c\x06\x72\x98\x5d\xd4\x7e\x81\xd9\x9e\xda\x85\xb5\x48\xc4\xb0\xe3\x37\x42\xe2\x9a\x60\xfc\x4e\x02\x31\x35\x64\x9a\x1a\x07\xa6\x28\xd7\x0d\xed\x10\xc1\x42\xc6\x85\xb1\xf0\xf3\x0a\x5e\x99\x91\x42\xd6\xe8\x65\xb5\xef\x98\x96\x17\xdf\xe0\x1e\x0e\xd5\x2c\x71\x49\xde\x71\xd7\xab\xf3\xf6\xd8\x91\x48\x55\xd1\x90\x8f\x84\xb8\xbe\x51\x16\xcd\xb3\xd7\x55\xb3\x4e\x4f\x66\xde\x3f\x65\x55\x01\x6b\x5c\x6c\x36\xf1\xa8\x86\xc2\xd8\xc4\xbd\x39\xf3\x97\xdf\xfa\xde\x3f\x48\x53\xa4\x4b\x41\x2a\x29\x02\xc9\x84\xce\x4b\xa0\x4f\xdc\xbf\x55\xa9\xc0\xc3\xf9\x39\x4f\xd9\x95\x26\xfe\xba\xaf\x74\x21\x3b\xb6\x52\x67\x7c\x5a\xb5\x1d\x5f\x60\x83\x1a\x9e\xc0\x71\x1b\x8f\xe3\xb8\x8c\x8c\x76\x27\x4c\xb5\x3d\x44\x69\x77\x1c\xcb\x61\x81\x93\x99\x54\xca\x94\x00\x92\x4d\xc7\x38\x4a\x3e\xe6\x70\x60\x54\x86\xb7\x47\x2a\xa0\x52\x93\xac\xb0\x86\x12\x26\x67\xf4\x85\x79\x2f\x53\x56\x58\x78\x31\x78\x66\xa9\xdd\x4c\x23\xa0\x20\x99\x3a\x40\x1b\x58\x50\xf1\x20\x35\x1f\x26\xc2\x28\xcf\x0c\xa1\x23\x90\xbd\x5d\xb7\xa0\x27\xc6\xba\x0d\xa1\xdf\x1b\x81\x61\xb8\x4a\x08\xef\xe8\x86\x12\x54\x5a\xd9\x64\x45\xc3\xcf\x36\x3e\x20\x90\x42\xf5\xdc\x79\xeb\xd3\x43\x68\x52\x2b\x5a\x56\x72\x19\x2b\x8a\x67\x1e\xf4\x7a\x2d\x01\xc3\xe1\xeb\xc9\x6d\x06\x99\x24\x43\xa2\xd1\xa2\xa4\xc3\xc2\xd9\xe5\x57\xae\xd4\xa2\xa1\x6e\x7e\x69\x2a\xd9\xc8\x8d\x15\x59\xeb\x56\x95\x97\x4a\x62\x91\xf9\xac\x11\xdb\xb2\x20\xf1\x63\x81\x99\xf9\x03\x8d\xaf\x88\xe6\xb9\x9e\x65\x74\x5a\xa7\x2a\xb2\x96\x20\xeb\xf2\xc4\x6d\xb9\x5e\xe9\xf7\x33\x10\xd8\x25\x27\x32\xb9\x42\x64\x51\x33\x12\xb6\x87\xc6\xb9\xd5\x72\xe6\x3b\xb9\x0a\x82\x12\x54\xd8\x2f\x24\xb6\x71\x18\xdd\xee\xbe\x02\x97\xa1\xbd\x5e\x20\x85\x39\x79\x8e\xb0\xa9\x4a\x37\x0c\x63\x7d\xca\x10\xa2\x17\xdd\x95\xf2\xac\x3e\xb1\xa9\xb1\x37\x69\xf4\x2e\x40\xe9\x93\xee\x77\x31\x92\x79\x0f\xa6\x82\x58\x20\xa5\x9e\xb4\x53\x29\x66\x22\x9e\xd6\xef\xf2\x45\x4f\x13\x0e\xdf\xc3\xf1\x26\x63\x4e\xb6\x4b\xd4\x8f\xae\x08\x98\x16\x12\x4e\xfe\x60\xfc\x30\xed\xc6\x2f\x13\x27\x80\x5c\x38\x7f\x26\x57\x0a\xd5\xa0\x2a\xed\x40\x30\x74\xec\xf2\xd8\x62\x98\x37\x6f\x34\x0f\xd2\x00\x9b\xeb\x1f\x71\x12\xb3\x67\xb4\x32\xe1\xcd\xc8\xd8\xbf\x7d\x93\x68\xa5\x57\x92\xeb\x02\xe1\xe6\xa8\x38\xb0\xb4\x13\x22\x8a\x14\x92\x41\x32\xed\xf9\x39\x3e\x44\x0e\xc4\x12\x50\x9f\x51\xdd\x92\x82\x55\x4d\x61\x63\xd9\x75\x47\x69\x6a\xc1\x81\x96\x08\x1c\x53\x3c\x15\x02\x34\xad\xa1\x97\xb6\x89\x67\xf1\x0f\x4c\xd5\x99\x5b\x34\x70\xe3\x99\x02\x4b\xea\xa6\xb7\x6c\x3b\x9e\x81\x12\x03\xee\x1d\xfd\x2e\x45\x4b\xec\x2e\x4a\xe2\xe6\x46\x97\x0b\xbe\xc6\x2c\xb9\xa8\xd6\xd6\x67\xc1\x4f\x33\xc6\x43\xc9\xbc\x46\xef\xac\xd6\x22\x3a\x7e\xf8\x2c\xaf\xe7\x88\x35\xbf\xdb\x35\xce\xe0\x98\x66\xd3\xba\x16\x46\x44\x7e\xa3\xa6\xae\x3a\xd1\xde\x4e\x9f\x91\xa7\x50\xba\x6e\x84\xab\xcf\xac\x2b\xab\xf2\x63\x57\x40\x33\x77\x30\x79\x7d\xa3\x96\x97\x7d\xb1\x41\x23\x10\x33\x86\x16\x29\x31\x24\x6c\xca\x58\xa6\x88\x0a\x1c\xd1\xc6\xe5\xc9\x70\xcf\x78\x3a\x90\x16\x0a\xc4\x82\x33\x1f\xec\x37\x19\x1e\x49\x57\x57\x53\xdf\x82\x34\x21\xa9\x94\x2e\x00\x0b\x2f\x5d\x45\xe3\xde\xdc\x6b\xd0\x45\x96\xfc\xbd\x36\x24\xb4\xf8\x21\x6b\xca\x8d\x4b\xb5\xbe\x9d\x4a\x85\xc2\x97\xb3\x3c\xd6\x2f\x0c\xc8\x68\x50\x88\x87\x65\xb8\xed\xc6\xfd\x9e\xea\xe6\x12\x0f\xa8\x2e\x3a\x97\x9f\x59\xfa\x78\xe9\xdf\x47\x9c\xc2\xd0\xa9\xc6\x27\x00\xa3\x62\x81\x87\x53\x9f\xa5\xde\xc5\x8b\xe0\x71\xbe\x8d\x54\x9d\x89\x68\x21\xa2\x47\xb6\xdd\xb0\xc5\xc9\xfc\xe2\x1b\xd9\x94\x84\xcf\x9f\x80\x03\x8e\x43\x66\xb3\xff\x29\x60\x13\xb0\xeb\xfd\xa1\x47\xe0\x7b\xdc\x43\x9f\x45\x2e\x12\xe7\x03\x0e\x9b\xe3\x13\xfe\x8d\x3e\x50\xcc\x5a\x29\xc2\x87\x99\xf7\xf3\xe6\x38\xfa\x12\x77\xbb\xd4\x7a\x8d\x50\x7b\xf8\xaa\x44\xec\xdf\x6d\x4a\x4a\x09\xef\x49\x24\xcf\xc4\x34\xd6\xc9\x4f\x9c\x15\x89\xd0\xd7\x3b\xbc\xc9\x51\x8d\xb5\x0d\x0b\x0f\x3a\x3d\xa1\x2a\x07\x77\x26\xce\x69\xd7\x54\x7f\x59\xc2\xe4\x9d\xea\x65\x4d\x3c\x36\x7b\x1b\x3a\xc2\xfd\xd5\x7c\x81\x8e\xa5\x16\xa1\x3b\x5a\xf1\xf0\x00\xdc\xf2\x2b\x7e\xba\xe4\x3c\x27\x10\x88\xb6\xae\x34\x73\x4a\x9b\x90\x48\xa6\x5f\x86\xb2\x3c\x3d\xf9\x96\xb2\x9a\x6e\x80\xc6\x86\x76\xa0\x9a\x3e\xb9\xdc\xe8\x27\x41\x81\x04\xbc\x5c\x6b\x35\x7d\x2f\x87\x58\x8c\xdd\xde\x07\xc1\x3c\xea\xb3\x97\x9d\x92\xcc\x4d\xa6\xa4\xa5\x0a\x25\xcf\x5c\x4b\x1e\x3f\x2d\x98\x81\xb9\x97\xb7\x79\x18\x6b\x08\x85\x88\xd7\x63\x07\x86\x72\x7f\x08\xfc\x74\x91\xe0\x8e\x98\xc4\xca\x0d\x8e\xba\xb0\x9c\x28\x47\x46\x1e\x4f\xfe\x67\xa2\xf4\x53\x50\x1d\x85\xf1\xc4\x81\xbf\x31\xf0\x01\xdb\x11\x83\x28\x2b\x89\x10\x50\xf6\x32\xdd\x31\xfa\x41\x32\x57\x91\x81\x6a\x76\x29\xc0\x7b\x03\x7e\x13\x38\x1b\xbb\x41\x36\xea\x15\xb4\x02\x61\x0f\xa0\xab\x68\x52\xff\x26\x18\x15\x6d\xda\x0e\x1c\xa4\xc2\xb2\x5c\x62\x37\xe6\x9c\x95\x04\xec\xb4\xa5\xfb\xe3\x46\x55\x18\xc3\x71\x99\x4f\x32\x9f\xb1\x19\x0b\xcd\x0d\xa0\x10\x9c\x1a\x00\x43\x31\x7f\x5c\x28\xe1\xb5\x82\x0d\xeb\x44\x80\xfd\x72\xad\x8a\xfa\x2a\xc7\xb4\x32\xf0\xbe\x93\x63\x48\xb1\xd7\xce\x7a\x67\xb9\xd5\x4b\x1c\xa3\x94\x5c\xde\x15\xca\xd4\xab\x20\xbe\x66\x52\x1a\x00\xb7\x47\x28\x2c\x0c\xd4\xf8\xd9\xfe\xee\x4a\x00\xfc\x5f\x98\x8a\x56\xea\xd3\xc7\x02\xd5\xfb\x7a\x60\xbd\xf9\x00\xcd\x08\xf2\x6c\x2b\x85\x2f\xbd\xd6\x0b\x54\xc0\xcf\x3d\x92\x03\x7b\xfc\xc2\x76\xf1\x31\x95\x8a\xd5\x99\x78\x66\x7a\x5d\x89\xab\x2f\xe4\x6c\x48\xb3\x90\x1d\xc8\x53\x08\xee\x9f\x3d\x7e\x40\x8e\x40\xc4\x3f\x81\xab\xc1\x4e\xae\xfa\x09\xf4\x50\x4f\x97\x08\xd2\x18\xd0\xda\x65\x2d\x1b\x78\x84\x34\x13\x14\x76\x84\x42\x5a\xaa\x03\x11\x49\x0e\xca\x00\x6f\xc4\x4d\xca\xcd\x14\x83\x44\x82\xaa\x06\x00\x31\x32\xce\x7a\xa8\xa4\xdc\x20\xc8\x7f\x82\x83\x1f\x59\x6f\x29\xda\x1a\x86\x0d\x69\x5c\x04\x6d\x66\x32\xca\x32\x8a\x38\x11\xb1\x52\x40\x19\xd9\xdc\xaa\xed\x34\x19\xe9\x99\x2e\x83\xcc\x94\x42\x17\xb2\x4c\x08\xec\x04\xdb\xba\x02\xb1\xaf\xd7\xd0\x00\x21\x01\x51\x68\xad\xbd\x4d\xf9\xd4\x86\x44\x12\xb6\x7c\xa6\xf6\x89\x76\x99\xbc\xd1\x87\x65\xf1\x19\x89\xd2\x4b\xaa\xf8\xeb\x82\x32\xf1\x2e\xfd\xef\x4d\x4a\xac\x60\x01\x31\x0e\x2e\xcf\x42\x25\xb9\x21\x7c\x84\xe6\xa6\x99\xa4\xf8\xca\xb5\x77\xe6\x60\xc5\xc7\xa2\xaa\x36\x76\x8b\xd8\xa3\x76\xca\x7e\xfe\x1c\x33\x9f\x24\x5c\x48\xbf\x0b\x63\x78\xe8\x5f\x56\x3a\xd3\x22\xd1\x2e\x56\x08\xe4\xbb\x8a\x66\x86\x22\x95\x7c\xba\x3b\xa3\x31\xa5\xef\x12\x25\x71\x2d\xf0\x08\xf8\x0f\x05\xef\x51\x7d\x5d\x9d\x8e\x24\xea\xce\x20\x22\xe8\xd0\xec\x1f\xac\xc6\x57\x79\x14\x28\x08\xeb\x25\xe6\x38\x4c\xbd\x6b\x65\x14\xa1\x4a\xb7\xfb\x07\xa1\xd9\xee\x58\xd7\x13\x7c\x9c\x0b\x31\x05\x2c\x02\xa6\xe0\x46\x1a\x87\x3c\x27\x17\x99\x92\x5d\x94\x41\xd1\x03\xdc\x58\x49\xad\xa8\x77\xe6\x24\x7d\x3b\xfc\x06\xb3\x17\xce\xdd\x72\x26\xcf\x1c\x75\xbd\xd9\xe0\xbd\x87\xbc\x46\xec\xb3\x3d\x4c\xc8\x26\x5c\xd0\xc3\x7b\x0e\x13\x47\x1d\x9f\xf9\x4e\x86\x47\x6a\xb8\x1e\x2e\x3c\x0f\x9f\x32\xd1\x59\x47\x64\x75\x49\xb5\xa8\x7a\xe6\xbf\x9f\x1a\x33\xd3\xc7\x58\x63\x2a\x37\x85\x8c\x92\x6c\x1c\x68\xc1\xf6\x03\x12\x4a\xcf\x58\x42\xd5\xe2\x9f\x76\x25\xb9\xbe\x18\xc8\x47\x4d\xac\x1d\x76\x5c\x74\xaa\x87\x33\x99\x52\x32\x93\xd3\x5c\x15\x3a\xce\x86\x1a\x83\x68\x75\x18\x64\x22\x07\xc2\xa7\xbe\xde\x01\x56\x89\xe8\x14\x86\x2c\xf0\xc1\xeb\x12\x51\xea\x3e\x8d\xe3\x23\xc9\x53\xdd\xc2\x40\x3e\xc9\x78\x1a\xfd\x04\x15\x2e\xec\x10\x77\xc6\xbf\x2b\xce\xea\x64\x36\x8f\x08\x8a\x64\xe5\xc4\xe6\x52\x04\x83\xa2\xb0\xfb\x23\x86\x08\xf7\x94\xc2\xdf\x1d\xf3\xd0\x22\x8d\xf0\x49\x88\x3d\x72\x35\xde\x21\xba\x30\x51\xc5\x96\xf6\x2e\xe5\x2a\x8d\x70\x61\xe4\xb8\xd8\x32\x2f\x44\xfd\x10\x88\x0c\x23\x82\x8a\x83\xd5\xff\x7f\x00\xd6\x0d\x29\xf2\xb2\x6d\x72\xbd\x17\x8e\xa1\x4c\x44\x67\xca\xbf\xcb\x66\x9b\x29\x21\x2d\x1c\xee\xfa\xfa\x90\xd3\x6f\x8a\xa4\x90\x63\x68\xbb\x7d\xc9\xed\x40\x6f\xe0\x5b\x10\x18\x72\xe0\x45\x5d\x20\x90\xeb\x2f\x69\xda\x57\x4a\x38\xc1\x4f\x18\x67\x62\xea\xd3\x81\x46\xd6\x65\x82\x28\x2f\x94\xc2\x45\xce\xa0\x08\xe7\x79\x4f\xf1\x1e\x99\x4c\x42\x8d\x9c\x5d\x49\x6b\x54\xda\x89\x61\xcc\xe0\x62\x56\x8e\x11\xcc\xd5\x0a\xcf\xce\x0a\x09\x32\xbc\xb9\x93\x99\xd6\x81\x25\xcd\x62\x04\xbb\x8a\xc2\x6a\xfa\xaa\xd4\x67\x86\x8c\x27\x82\xc0\xf1\x87\x32\x85\x02\x2d\x36\xb5\xff\x1b\x98\x44\xfc\x04\xed\xbb\xa2\xf2\x4a\x17\x5a\x65\x27\x09\xb7\x6f\x42\x69\x32\x1d\xfc\xfb\x1b\x2b\x5d\x78\x3b\x53\xbe\x10\x50\xcf\x19\x08\x83\x0e\xa5\x7b\xa3\x1e\x7b\x4a\x75\xa4\x6d\xb2\x0c\x84\x66\x5e\x4c\x0a\x53\x15\x30\xc3\x19\x91\x92\xcd\xc4\x7f\x7e\x6f\xc7\xc7\xeb\xe6\xfc\x2d\xeb\xf7\xd0\x0f\x53\xb8\x45\x63\xf4\x2a\x80\x76\x6c\x00\x00\x70\x4f\x6d\xf3\x81\x14\x40\x13\x00\xf6\x76\x09\x2d\x0e\x1f\x53\xe3\xff\x44\x3b\x3c\x55\x1c\x0b\xc8\x43\xaf\x11\x12\x59\xd7\xe0\x15\x19\xbe\xee\xfb\xd6\x6e\x41\x29\xa4\x70\x78\x4f\xfd\x40\xa5\x69\xe5\x4c\x8a\x90\xc2\x37\x0f\x97\x45\x60\x4b\x5c\x87\x4a\x60\xf4\x39\xdb\x02\x55\x24\xfc\x09\x05\x70\xba\x27\xb0\xd5\x8f\xa0\xdd\x01\x85\x8c\xd3\x33\xc1\x10\x76\x0d\x2b\x00\x93\x6e\x9c\x98\x0f\x25\xa7\x6c\x08\x53\x8a\x7d\xdc\x05\x01\x89\x41\xc5\x18\xec\x4e\x6a\x30\x2a\x0f\xb1\xa6\xe8\xd7\xb3\x34\x38\xd8\xcf\x02\xce\x69\x25\xd1\x05\x87\x16\x8a\x94\xd2\x38\xd1\xe3\x50\x35\xc2\x63\x89\x8c\x23\xc5\xe8\x54\x24\x7f\x46\xbb\x3f\x24\xb2\x97\x80\x4c\x7e\xaa\x49\x9e\x0e\x51\x4e\x0f\x5f\xf2\x4d\x03\x43\x0a\x54\x6e\x89\x4c\x8a\x60\x29\x65\x7c\xc8\x90\x8b\x21\x64\x9a\xe4\xf3\xda\xf2\xec\x04\xdc\x91\x5b\xec\x73\xfb\x91\x44\x94\x5c\x56\x07\x6f\x15\x8d\x82\x06\x67\x46\x1d\xfb\x9c\x08\x8e\x47\x28\x31\xa8\x20\xc6\xcc\x1b\x4f\x4a\x42\x99\xc9\x11\x00\x4a\xdb\x46\x52\x25\x70\xe1\x9d\x06\x8e\x1d\x8d\x33\x4e\xf0\x50\x20\x43\xe9\x53\xea\xf3\xd2\xeb\x40\x70\x4d\x14\x94\x00\xcd\xdd\x76\x41\x00\x66\x2c\x2d\x13\x20\x2b\xae\x48\x83\x46\x74\xbb\x2c\x9f\xb6\x87\x06\xf3\x77\x66\x03\x41\xa8\x12\x80\x00\xa9\xb2\x8b\xe6\x8e\x29\x79\xc1\xf3\xee\x5e\xad\xc6\xb8\x7d\x02\xc8\x4e\xc5\x2b\x73\x38\x16\x04\x5a\x16\x82\x24\x6a\x87\x4e\x46\xb3\xf1\xd7\xe2\x69\x23\xda\x6c\xc8\xd6\x08\x16\xe2\x68\x81\x96\xb4\xff\x50\x21\x39\x67\x65\x1d\x0c\x5d\x02\x06\xf0\x69\xcb\x07\xa8\xf6\x68\xf2\xef\xe7\x80\x01\xf6\x1a\x66\x93\x76\x16\x27\x05\xf4\x83\x6c\x17\xe1\xf0\x21\xea\xe8\x1c\x79\x6e\xdb\x4f\xdb\xe4\x5d\x33\xe3\x00\x69\x86\x99\xd1\x8f\xc0\x0e\x46\xcd\x09\x9b\x80\xc8\x05\x50\x6b\x63\x9c\x5c\xe7\x88\x0a\x60\xe7\xf5\x0f\xde\x16\x40\xde\x15\x08\x39\x32\x01\xc4\x0d\x7a\xbf\xb5\x58\xe3\xfa\x3b\x5d\xdb\xa9\xe1\x69\xaa\x25\x5b\x1b\x35\x8b\x14\x94\x80\xe0\x70\xac\x38\x51\x20\x63\x8b\xc8\xe5\x64\xdb\xf0\x5c\xd1\x4c\x6f\xb1\xe4\x02\x3b\x6a\x50\xda\x2f\x8a\xad\xf8\x6e\xfd\x67\x81\xa2\xf3\x5b\xe5\xd2\x71\x42\xd2\x51\x50\x3b\xf6\x98\x2c\x18\x56\x93\x65\xd9\xe7\xd0\x33\xdc\x50\x1e\x72\xb4\x27\x02\xd8\xb7\xf5\x34\x59\xff\xa9\x86\x20\x38\xbb\x0f\x06\xf7\x5b\x25\x0b\xcd\xf6\xa6\x63\x93\x08\x0a\x19\x5e\x88\x60\xd5\xf7\xb0\x09\x9a\x8b\x06\x19\x0e\x50\x1a\x1f\x05\x6a\x4c\x3e\xca\xa0\xed\x71\x17\x80\xf7\xc0\x94\x3a\x36\x53\x95\x97\x8e\xd1\x5d\x4b\xce\xcf\x22\xa0\xa5\x08\xae\x67\x5b\xa6\x9d\x09\xe5\xcf\x91\x48\x93\x1b\x1d\x1e\x98\xe2\x03\x42\xac\x35\xc8\x56\x06\x1c\x45\x71\xdb\x4c\x4a\x8c\x95\x58\x7b\x43\xbc\x88\xb9\xa7\x42\xbd\xc5\x03\xd9\xd2\x21\x08\xa5\x50\xab\x49\xe1\x90\x1d\x71\x39\xbb\xf8\x4c\x6c\x78\x96\xae\xca\xaa\x37\xd2\x1b\x3e\xd6\xa4\x96\xdb\x5d\x40\xd5\x21\x40\x39\x48\x03\x94\x21\xaa\xed\xe4\xc8\x70\xc0\x1e\xc9\x99\x15\xd5\x24\x09\xe2\x3f\xde\xd5\x29\x9b\xab\x81\xdc\x8e\xa8\x05\x6c\xb0\x06\x2f\x22\xb1\x94\xc0\xcc\x81\x96\xaf\x2b\x93\x40\x60\x7d\x7d\x3a\x5c\xf7\x95\xaf\xd0\x09\x38\x95\x7a\x51\x67\x53\xa3\xbf\x18\x2b\xf2\x92\xa4\xbf\xc1\x92\x43\x84\xa3\x7d\x80\x1b\x52\x3a\x9f\xf5\x48\xfe\x55\x46\x02\x5c\xa1\x58\xfe\x92\x67\xf6\xc0\x18\x2f\xe2\x08\xeb\x80\x04\x41\x5a\x25\x11\x63\x1f\x31\x10\xd5\x77\x6c\x45\x54\x00\x05\x96\x0f\x77\x58\x0c\xa0\x08\x5a\x4e\x68\xa5\xa0\x85\x08\xc4\x79\x66\x32\x44\xc6\x20\x80\xc3\xb8\x18\x89\x26\x76\xae\x4c\x93\x71\xee\x1e\x34\xc6\x37\x95\xf1\xa7\xfb\xca\x7a\xfa\xf0\x5c\x90\x69\x4a\x11\x79\xc0\xe8\x4a\x2d\x6b\xaf\x4e\xc4\x33\xbd\xa0\xeb\x09\xa3\x2d\xbc\x05\x73\x91\xd1\x4a\x17\x35\x16\x89\x0f\x97\x1e\x29\xd9\x56\x30\x99\x4e\x17\x30\xdd\x64\xda\x5c\xd3\x9b\x64\x30\x64\x2d\xa9\xe3\x45\xda\x5b\x6d\x66\xa3\x5c\xa3\x55\x6d\xc1\x78\x11\xb2\xd2\xd2\x43\xab\x52\x3c\x28\x60\xaa\xd1\x95\xe6\x1d\x83\x70\x34\x5e\x21\x06\x9d\x68\xd4\x51\xe8\x0b\x00\x60\xa2\xf9\x81\x21\x6c\x08\x93\x20\x7e\xc6\x99\xec\x3a\x4a\x87\xc9\xa0\xf1\x6c\xfc\x57\xb1\xfe\x80\x39\xcb\xb8\xcc\x5a\x58\x42\x14\x3d\xeb\xc8\x6c\x29\x60\x6a\x9e\x1d\xaa\x65\x56\x4a\xb3\xe0\x55\x80\xb3\x02\x86\x47\x21\xae\x73\xd8\xe7\x31\xd4\x3f\xc6\xbc\x33\x84\xa8\xc3\x8a\x2e\xb3\x1e\x7d\x62\x1b\x49\x61\xd9\xea\x36\xe0\x03\xca\x95\x06\x16\x0d\x1e\x9c\x74\x3f\xe8\xc0\x80\xde\x53\x07\x78\x5a\x4a\x27\xc3\x70\x0a\x69\xeb\x2c\xa6\x12\x2e\x88\x8f\xf1\xac\x12\x90\xd8\x52\x07\x07\x01\x32\x54\x60\x35\x00\x98\x2d\x52\x0a\x42\x78\x72\xe6\x57\x48\x03\xf6\x0c\x4a\x50\xb0\x65\x23\x42\x62\x89\x7c\x93\xaf\x94\x2d\xb1\xfe\x90\xa1\x90\xec\x8b\x5b\xb2\x84\xa0\xc6\x1c\xe4\x50\xc2\xe2\xfd\x85\x1a\xa4\xa8\x45\x68\x8b\xb1\xb3\xc2\x8b\x28\x35\x53\x9c\xa2\x1d\x95\x66\xd5\x72\xe4\x1c\xc3\x19\x2f\x5d\x7f\xcb\x1c\xb0\xd1\x49\xc6\x8a\x0d\xcc\x15\xd6\x64\x94\xde\x45\x23\xfa\xa2\x4f\xae\x53\xfa\x33\x97\x39\xd3\xbb\x5d\xb8\x16\xba\xb3\x80\x65\x1e\x82\xbf\xdb\xae\xd5\xc9\xb9\x2e\x39\x17\x5f\x8c\x62\xea\x65\xa7\xe6\xbe\x4d\x8c\x14\xb4\x81\x39\x62\x1e\x13\x19\x23\x65\x8d\xa9\x28\x92\xa6\x11\x2d\x88\x20\x30\x9d\xa7\xd2\xd7\x52\x61\xb1\xe0\xc6\x1d\x84\x39\xf9\xba\x96\x88\x06\x22\x02\xfe\x89\xb1\xfe\xfd\xfd\x55\x2c\xe1\xc2\x25\xfa\x7e\xbe\x58\xe8\xdc\x80\x1f\xf6\x97\x94\xeb\x7a\x80\x15\xdb\xbd\x7b\x27\x36\x5b\x40\x84\x74\x5b\x57\x25\xfd\xd1\x2a\x0b\x2e\x64\x27\x76\x52\x20\x7b\x94\xd2\xf0\x68\x1e\x8a\x04\xa6\x21\xde\x41\x65\x64\x92\x43\xaa\x45\x7d\xbb\x78\x3d\x45\x5b\x0e\x01\x7c\xef\x42\x24\x37\x4a\xa1\x2a\x20\x42\x2d\xe1\x00\x0c\x2c\x3d\x11\x6b\x37\x94\x5b\x5f\xb6\xea\x2d\xd0\x49\xf4\x96\x0c\xa2\x15\xab\x80\x92\x87\x4a\x35\x65\xd6\xcc\xb6\xc4\x01\xb4\x13\x7b\x0d\xc8\xed\x28\x09\xb4\x86\x3b\x10\x8d\x05\x14\x57\x4d\x77\xa7\x60\xab\xb0\x02\x80\xcb\x7e\x70\xdc\x41\xa0\xb0\x7a\x15\x0e\x20\x38\x87\x02\xee\x66\xe6\x13\x29\x29\xe2\x8c\xe2\xc2\x28\xde\xfc\x40\x09\xa9\x08\xc4\xaa\x07\xd9\x85\x85\xd9\x03\x3c\xe1\xee\x85\xe4\x2e\x61\x25\x4e\x20\xf2\xec\x6e\x14\xe9\x40\x62\x7a\xad\xc3\x19\x87\xc8\xd1\x1a\xc0\xb5\xbf\x3e\x1a\x1a\xc0\x90\xeb\x18\x03\xf4\x25\x85\x87\x80\x05\x54\x07\x2a\x3f\x6c\x67\x62\xbf\x64\xf6\x10\xc8\x3c\x82\xc4\xb5\xe3\xfa\xc0\x77\x39\x4e\x61\xac\xc5\x13\xbc\x38\x3b\x3c\x5e\x2a\x25\x9d\x9b\x79\xd2\x3a\x74\x11\x44\xa5\xd2\x95\x5a\x3c\x1c\x40\x1b\x05\xfc\x89\x30\xaa\xa8\xab\xe4\x82\x71\x34\xb1\xe4\xd0\xed\x6c\x5c\x0d\x96\x1f\x15\x86\x31\x86\x0e\xee\xc9\x1b\x9f\xd3\x00\x60\x2f\x9e\x24\x12\x49\x4a\x20\xd2\x93\x73\x4e\x29\xbc\x3b\x07\x3a\x41\x3b\x92\x29\x24\xd7\x95\x0a\xb0\x57\x77\xa2\x12\x79\x25\x4b\x72\xde\x49\x76\x0f\x5c\x62\xb6\x56\x07\x99\x06\xa3\x5c\x1c\x6e\xad\x64\x7b\xc0\xde\xf4\xc8\x36\x15\x74\x10\xbb\x90\x76\x9d\xed\xd7\x2f\x7e\xa2\x00\xfc\x2a\x4f\xd6\xed\x0a\x11\x37\x55\x16\x3e\xa3\x38\xfb\x17\x72\x82\x41\x43\x3c\xba\x13\x6a\xe9\x8a\x45\xe2\xa2\x2d\x6a\x0f\x1c\xe7\xd8\xe7\x89\xb7\xa8\xfc\x78\x73\xee\x29\x81\x8d\xcc\x44\xa2\x9b\x96\x31\xbc\xc3\x8c\x2f\xcf\xca\x71\x93\x70\x2a\x2a\xcc\xb8\x18\xc0\x24\x02\xd9\x91\x8d\x13\x2c\x0b\x16\xdb\xe1\x0d\x83\xb3\x42\x1a\xf5\xc8\xbc\x70\xc4\xfa\x0e\x6b\x09\x1b\x4d\x17\x68\x70\x88\x4b\xea\x37\x17\xc6\x15\x55\xe8\xa4\xc3\x5d\xf0\xf8\xe1\x03\x68\x26\x18\x84\x2d\x19\x13\x9e\x24\x03\x88\xe9\x8e\xbb\x81\xaf\x94\x93\x59\xea\x90\x1b\xa3\x3b\xe0\x71\xcb\xe9\x36\x77\x95\x7a\xdd\xf7\xd6\x57\xfb\xee\xcb\x84\xd6\xad\x41\xa6\x68\xb2\xec\x44\x7f\x9c\x91\x03\x5e\x52\xf6\x03\xde\x1e\xc9\x01\x22\xad\xc6\x73\x0f\x35\x00\x66\xfd\xfc\xee\x77\x0d\x88\xbf\xe4\x2b\x10\xe7\x51\x26\x12\x1f\x11\x92\x0e\x2f\x39\xc8\x82\x96\x9c\xb8\x77\x4e\x1e\x62\xc7\xeb\xe9\xfc\x90\x90\x19\xad\x0f\xe3\xfc\xb0\xd8\x7a\x7b\xe5\xd8\x1c\x95\xd3\xfe\x59\x17\xc5\x3e\x0a\x5d\x4e\x45\xda\xc1\xb1\x63\x2c\xdf\x9e\x23\x0c\x42\x46\xfd\x3a\x30\xcd\xd8\x2f\x2d\x45\x18\x13\xc8\xbe\xc6\xc2\x8c\xc7\xd7\x83\xeb\x11\x46\x5c\xea\x8c\xf4\xe4\x49\xa7\x7b\x74\xec\xe4\x41\xbb\x5a\x89\x43\x99\x4f\x0e\x07\x52\xc3\x1b\x75\x6b\xa5\x0c\x69\xfa\x94\xf4\x29\x85\x79\x74\x6b\x64\x9d\x4e\xb8\x26\x16\x9b\x76\xa7\x15\x41\xb1\x99\x88\x0c\x50\x02\x7b\xcd\xd6\xee\xf4\xcb\x50\x27\x9a\xf3\x92\x3e\x02\xc8\xea\xe0\x78\x02\xe0\xc6\x86\x60\x2e\xdc\x04\xe4\x25\x2c\x3b\x3a\xd4\xbf\xd9\x3a\x0f\x8e\xa9\x1a\xab\xad\xbf\xed\x61\x46\xf1\xa7\x6f\x1a\x54\x51\xab\x10\x7d\x76\x23\xf4\xec\x9a\xd7\xa3\x02\x91\xf6\xda\x51\x6b\xe8\xc6\x27\xdd\x73\xc4\xd4\xd3\xd6\x1f\x08\xd8\xf7\x12\x7e\x85\x0f\xb5\xde\xcd\x7a\x35\x68\x1f\x4d\xc4\x51\xca\x92\x06\xe1\x59\x3e\x43\x19\x85\xe8\x07\xca\x8d\x99\x00\x84\xe8\xbe\x69\xb7\xcc\x02\x1d\x18\x55\xb1\xa0\xd3\x4e\x46\x23\x11\x4a\x18\x30\x75\x8c\x05\xce\x43\xf0\xe4\xf0\x38\x6b\x93\x08\x21\x0a\x66\xab\xe9\xec\xa7\x76\x0c\xb9\x7b\x7f\x1a\x45\xf1\x2f\x1f\xcf\xeb\xe6\x49\x4b\x49\x45\xcb\x3e\x0c\xba\x70\xb7\x79\x64\x86\x13\xcc\x65\x09\x0a\xca\xbe\x12\x94\x3d\x7a\xf4\x86\x3a\x40\x37\xd6\x4a\xe0\xf7\xc4\x0e\xb3\xcc\x7c\xc6\xc6\x35\x67\x07\x0f\x38\xc0\xee\x78\x0c\xc5\x33\xe7\x4f\xb1\x8c\x1d\xaa\xfe\x80\xc4\x10\xdc\xdc\x07\x0a\xfe\x33\x1f\x80\x48\x31\x8b\xf3\xb1\xd8\x84\x46\x2e\xa0\x79\xb4\x66\x7a\xb4\xec\x57\x06\x49\x4d\x1b\x0b\xf1\xd9\x19\x83\x18\x11\xc6\x6a\x5b\x81\x17\x2e\x77\xe6\x25\x08\x84\x69\x3f\x01\xd2\x86\x55\x12\xc2\xe8\xa9\x66\x7c\x7d\x40\x2b\x04\x5b\x38\x95\x6b\x37\x43\x78\xa4\x98\x53\x90\x85\xed\x45\x4f\x02\x12\xde\xaf\x70\xa0\x24\xe4\x17\x97\xfc\xe1\xe7\xbe\x51\xe6\xbb\x2b\x99\x19\xca\x03\x3a\xb8\x3c\xe1\x5d\x81\xb6\x01\xb8\x4b\xe2\x33\x8f\x8b\x54\x2d\x79\xb2\xc2\xcd\x16\x08\x5b\x4e\xd1\xd6\x7a\xb4\xb5\x84\x3b\x79\xb3\x8a\xa4\x2d\x48\x5a\xfe\xaa\x59\x5e\x15\xa1\xd4\x2e\xa5\x4d\x00\x2a\x08\xd4\x27\x68\x38\xd2\xed\x41\x85\x04\x2e\xb0\x4e\xef\x32\x16\x72\x89\x9c\x4c\x42\x9c\x0b\x37\x3a\x4f\x72\x8f\x92\xa9\x7d\x91\x12\x43\x89\x53\xcb\x9a\x53\x19\x39\xe4\x01\x4a\x71\x23\xec\xa3\x12\x57\x49\x7d\x2a\x38\xcb\x44\x21\x1b\x88\xb8\x23\x09\x11\x14\x67\x23\x59\x8f\x3e\x13\x38\x18\x60\x95\x0a\xec\x15\xd0\x92\xc2\xf1\x03\x0c\x3f\x61\x85\x81\x32\x12\x48\x2c\x1f\x5e\xf1\xe3\xc4\x27\x83\x8f\xef\x3f\xb8\xff\x03\x90\x0e\x5e\xb8\x88\xe6\x03\xa7\x6e\x83\x68\xe3\x4f\xc6\x92\xad\x07\x1a\xbf\x69\x3c\xd1\x19\xaa\xe6\xdd\x59\x0f\x61\x32\xc9\x2b\x1e\x99\xfc\xcc\x36\x61\xb0\x46\xb1\xe2\x61\x3c\xcc\x21\x84\xdb\x30\xac\x89\x32\xbd\x5d\x81\x63\x3a\xef\x65\xbc\x4b\xa4\xac\x1c\x58\x98\x58\x98\x5b\x55\x67\xe9\x4f\x12\xaf\x75\x17\x35\x69\xd4\x79\x50\x63\x56\xd9\x54\xba\x9e\x35\x1f\x52\x01\x49\xfa\x9f\x41\x36\xf2\x4f\xd4\xb8\x69\x0d\xa4\xfd\x43\x04\x8e\x5c\x87\xf1\x1e\x85\x97\xe3\x51\x0f\x5a\x13\x8d\x4d\x84\x44\xc4\xc6\x83\xd3\xda\x01\x90\xcf\x42\x21\x58\x96\xc4\x3a\xf4\xd0\xe3\x08\x1c\x5c\x21\xc7\x14\x18\x5e\xc1\x85\x22\x14\x7b\xa1\x45\x20\x14\x56\x61\x07\x78\x24\x50\x1d\x09\x17\x18\x5c\x24\x02\x0c\xb3\x44\x42\x42\x54\xde\xd3\x46\x74\xe8\x9f\x7e\x99\xc3\x7b\x4f\x87\x1e\x04\x00\xbc\x0d\x77\x00\xef\xf8\x9e\x35\x61\x23\xf8\x07\x60\x90\xab\x3d\x04\x16\x00\x11\x67\x81\x12\x80\x11\xd0\x81\x13\xb0\x01\x59\xfd\x19\x32\x3e\x1d\x87\xb1\x4d\x47\xaf\x2d\x47\xad\x6b\x8f\xc3\xa8\xaa\xdb\x31\x54\xbe\x62\xa6\xfc\x85\x4c\x18\x0a\xb9\x60\x2a\xd9\x80\xab\x56\x02\xac\x58\x0a\xad\xde\x2a\xa5\x78\xaa\x65\xc2\xa7\xd6\x8a\x9d\x5a\x2a\x63\x60\xaa\x56\xc1\x53\xd5\x62\xa5\xba\xc5\x4a\x55\x8a\x92\xaa\x15\x1d\xd0\x2a\x36\xa0\x54\x4b\x40\xa8\x7a\x71\x50\xc4\xe2\xb6\xc9\xc5\x68\x93\x8a\xc7\x67\x15\x86\xcc\x2a\xdf\x94\x55\xa7\x28\xaa\xf6\x51\x55\x34\x82\xa7\x39\x05\x4c\x92\x0a\xad\x63\x15\x4d\xc6\x2a\x90\x8c\x54\xbb\x18\xa9\x52\x21\x52\x2c\x42\xa3\xc8\x85\x45\xb0\x8a\x88\xa0\x15\x0f\xbe\x2a\x43\x7c\x54\x7a\xf8\xf4\x70\xf0\xf4\x46\xe8\xf4\x40\xe8\xf4\x34\xe8\xf4\x2a\xe0\xf4\x10\xe0\xf7\xf5\xb1\xee\x05\xb1\xed\x85\xb0\x96\x86\xd8\xac\xe1\xb1\x58\xf3\x62\xb0\x06\x80\x1c\x4c\x80\x2e\x99\x00\x54\x32\x00\x79\x60\x00\xae\xc0\x01\x55\x70\x02\x62\xe0\x03\xe5\xc0\x05\xeb\x00\x0a\x15\x00\x10\xaa\x00\x1b\x54\x00\x2c\xa0\x00\x25\x40\x05\x1a\x60\x07\xe4\xc0\x0b\xe8\x80\x15\x90\x00\x23\xa0\x00\x3f\x40\x00\x74\x80\x00\xa4\xf8\x19\x47\x4c\x88\xeb\xc5\x9e\x00\x53\xc0\x29\xf6\x01\x9e\xc3\xbf\xa0\x7a\x93\xff\x74\x07\xcf\xb2\x46\x79\x15\xd7\x8e\x00\x20\x1a\x00\x31\x34\x00\x4c\x68\x00\x8c\xd0\x00\xe1\x80\x01\x83\x00\x01\x66\x00\x7f\x99\x00\xb0\xc8\x05\x65\xc0\x28\x2e\x01\x29\x70\x08\x4b\x80\x40\x5c\x01\xe2\xe0\x0c\x97\x00\x58\xb8\x02\x65\x40\x05\x15\x00\x08\x54\x0f\x62\xa0\x76\x95\x03\x68\x98\x1a\x44\xc0\xc5\x26\x05\xf9\x30\x2d\x49\x81\x62\x44\x02\x89\x10\x09\x64\x40\x1f\x11\x00\x5a\x44\x01\x31\x10\x03\xa4\x40\x0c\x91\x00\x18\x44\xe0\x04\x79\xc0\xb8\xf3\x80\xd1\xa7\x00\x43\x4e\x7c\x0d\x39\xdc\x34\xe6\xd8\xd3\x9a\x23\x4e\x63\x0b\x6c\xc2\x16\x05\xc0\xb0\x3b\x05\x81\xb8\x2c\x0d\x81\x60\x63\x8b\x03\x04\x58\x16\xe2\xc0\xb3\x16\x05\x40\x90\x28\x84\x81\x32\x24\x03\x30\x90\x0c\x22\x40\x2d\x09\x00\x98\x24\x01\xe8\x90\x06\x42\x40\x16\x89\x00\x3c\x24\x00\xd0\x90\x01\xc1\xc0\xf8\x0e\x07\x38\x70\x37\x43\x81\xa6\x1c\x0c\x80\xe0\x62\x05\x02\xe8\x28\x16\x81\x40\xa5\x0a\x05\x18\x50\x05\x41\x40\x13\x85\x00\x46\x0c\x00\xb8\x30\x02\x00\xc0\xf5\x06\x00\x74\x89\xfc\x01\x9c\xde\x04\x1d\x8b\xe4\x47\x16\xad\xe9\xd4\x4f\x52\x97\x89\xe9\xc4\xb2\xe2\x49\xef\x1e\x54\xf1\x79\x53\x8d\xcd\x4d\x57\x35\x32\x5c\x54\xc6\x6f\x52\xe5\xa5\x4b\x56\x95\x30\xc8\x8e\xac\x04\xe0\xbb\x1a\x82\x8c\x28\x0a\x2d\xa0\x24\xb2\x80\x92\x9a\x01\x80\x94\x0d\xa4\x02\x21\x36\xa6\x84\xa2\x77\x88\xea\x48\xa2\xa9\x0e\x86\xa3\xf9\xfa\x8f\x47\x12\xf9\xad\xa0\x11\x4f\x20\x20\x72\x7e\x07\x07\xe0\x65\x7e\x06\x2f\xe0\x5d\xfe\x05\xb7\xe0\x56\x7e\x05\x2f\xe0\x50\x7e\x05\x37\xa0\x53\x7a\x04\xef\xa0\x4b\xfa\x04\x97\xa0\x46\x76\x04\x17\x60\x3b\xf6\x03\xaf\x60\x39\x76\x05\xd1\x23\x0a\x4a\xa4\x1a\xdc\x17\xa7\x42\xcd\x4e\x82\x2c\x90\xe4\xd7\xce\x1f\xc5\xd3\xad\xb2\x27\xb0\x00\x00\x01\x03\x01\x60\x00\x00\x15\xa1\x27\xe2\x8f\x10\x82\x60\x04\x5c\x4c\x54\xfe\xf0\xd9\x02\x41\x18\x70\x12\x42\x73\x81\x29\x72\x85\x21\xd5\x0a\xe2\x28\x0a\x03\xd2\x04\x69\x82\x60\x01\x00\x00\xff\xff\x58\xc7\xb1\x9c\x9f\x4e\x00\x00")
-
-func _staticFontsGlyphiconsHalflingsRegularEotBytes() ([]byte, error) {
-	return bindataRead(
-		__staticFontsGlyphiconsHalflingsRegularEot,
-		"_static/fonts/glyphicons-halflings-regular.eot",
-	)
-}
-
-func _staticFontsGlyphiconsHalflingsRegularEot() (*asset, error) {
-	bytes, err := _staticFontsGlyphiconsHalflingsRegularEotBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/fonts/glyphicons-halflings-regular.eot", size: 20127, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticFontsGlyphiconsHalflingsRegularSvg = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xbd\x6b\x8f\xf4\x46\x96\x26\xf6\x7d\x7f\x05\x2d\x03\xfe\x60\x83\xaf\x18\xf7\x08\x4f\xf7\x2c\xe0\x99\xc5\xc2\x80\x65\x2f\xe0\xb5\x0d\x7f\x32\xb4\x2d\xa9\xab\x01\x8e\x34\xd9\xe2\xe6\x8c\xf5\xeb\x7d\x9e\xe7\x9c\x20\x99\x59\x99\xcc\x7a\xab\xea\xbd\x68\xdc\xe8\xd6\x9b\x2c\x32\x18\x8c\xcb\x89\x88\x73\x7d\xce\x1f\xfe\xfd\xbf\xfe\xd3\x3c\x9c\x7f\xfc\xeb\xaf\x7f\xf9\xe5\xe7\x3f\x7e\xe3\x3e\x4c\xdf\x0c\xbf\x2e\xdf\xff\xfc\xc3\xf7\xf3\x2f\x3f\xff\xf8\xc7\x6f\x7e\xfe\xe5\x9b\x7f\xff\xf7\xff\xee\x0f\xff\xcd\x3f\xfe\x6f\xff\xf0\x9f\xff\xef\xff\xf4\x1f\x86\x5f\xcf\x7f\x1e\xfe\xd3\xff\xf1\x3f\xfd\x2f\xff\xf3\x3f\x0c\xdf\x8c\xdf\x7e\xfb\x7f\x85\x7f\xf8\xf6\xdb\x7f\xfc\xcf\xff\x38\xfc\xef\xff\xe7\x7f\x1c\xdc\x07\xf7\xed\xb7\xff\xe1\x7f\xfd\x66\xf8\xe6\x69\x59\xfe\xf9\x7f\xfc\xf6\xdb\x7f\xf9\x97\x7f\xf9\xf0\x2f\xe1\xc3\x2f\x7f\xfd\xf3\xb7\xff\xf1\xaf\xdf\xff\xf3\xd3\x5f\xfe\xf4\xeb\xb7\x52\xf0\x5b\x14\x94\x97\xbe\x95\xca\x9c\xfb\xf0\xc3\xf2\xc3\x37\x83\x7c\x03\x55\x4b\x63\x7e\xfe\xf5\x8f\x37\xde\xf7\xd3\x34\xa1\xfc\x37\x52\xf0\x9f\x7e\x5c\xbe\xff\xe1\xfb\xe5\xfb\xbf\xff\xc3\xb7\xeb\xe5\xbf\xfb\xc3\x0f\x3f\xfe\xf4\xab\xfc\xfc\xf4\xcb\xcf\xcb\xf0\x97\x1f\xfe\xf8\xcd\x9f\xe7\xff\x17\x5f\xfc\xe5\xe7\x5f\xff\x9f\xa7\xef\xe7\x9f\xe6\xbf\xfc\xfc\xe7\x5f\xff\xfa\xe3\x9f\xff\xeb\xfc\xfd\x5f\xbf\x19\x9e\x7e\xf9\xeb\x5f\x7e\x1b\xbf\xff\xe1\x3c\xfe\xab\x74\x5a\x2a\x67\x0b\xf0\xee\xf8\xd3\xf7\x7f\xfa\x71\xf8\xaf\x3f\xff\x65\xf9\x75\xfc\xe7\x1f\xff\x3a\xfe\xf8\x4f\xbd\xc0\xf7\xbf\xfe\xe9\xc7\x9f\x97\x3f\x7e\xd3\xb2\xfc\xf1\xc3\x8f\xf6\xd7\xe8\xa3\xfc\xf9\x2d\x9a\xf5\x97\x5f\x7f\x95\x8f\x8c\xfc\xf0\xe5\x17\xd2\x64\x65\x6e\x3c\xbb\xff\x24\x5e\xbe\x25\x6d\xfa\xd3\x2f\x3f\xc8\x9c\x0c\x37\xef\xfe\xf7\xd2\xa8\x3f\x7e\xf3\x5d\x9e\xa6\xc1\xb9\x69\x3a\xb9\x34\x4c\x43\x88\xc3\xe8\x3e\xa4\x25\x4c\xc3\x18\x3e\xa4\xd9\x39\xf9\xfb\xe4\xe4\x2f\x3f\xb8\xf2\x21\xc9\x5f\x93\x3c\xd6\xab\xfa\x21\x9d\x47\xef\xe3\xec\x52\x1d\xe4\xbf\x53\x19\xca\xe0\xea\x50\x17\xd7\x86\x31\xcf\x6e\xca\x28\x9f\xe5\xfe\x58\x07\x5c\xb7\x45\x2e\xe4\xc5\x79\xc4\x2b\xf8\xe7\x49\xde\x47\xfd\xd2\x88\x8a\x4a\xa5\xe6\x05\x9f\x90\x87\x72\x79\x92\x97\xa2\xc3\xab\x25\x9d\x26\xbc\xc0\xe6\x49\xdb\xe2\x82\xf6\xc9\xc5\x24\x75\xc9\x4d\x77\x92\x16\x8e\x68\xe8\xf6\xf6\x32\xae\x75\x3e\xad\xed\xe4\x97\xd1\xa2\x32\xb0\x29\xcb\xc8\x86\xcd\xe3\xda\x5a\x34\xb1\xe0\x9e\xf4\x01\x55\x0c\xd6\x5c\xf9\x8f\xdd\x65\x43\x26\x56\xab\x83\xb0\x8c\xdb\xd0\x9c\xd0\xde\x91\x0d\x96\x9f\xd3\xc8\x51\x95\xe6\x0e\x18\x55\x69\xed\x80\x51\x95\xe6\x0e\xd2\x60\xa9\xc4\x6b\x4b\x07\x8e\x2a\x6b\xe4\xa8\xa2\xb1\xeb\x10\x9d\xc6\xa2\x0d\x92\x3f\xa5\xb9\xd2\xae\xac\xad\x65\x63\xd9\x0d\xb9\x6e\x8b\xb4\xb0\xf6\xa9\x60\x7f\xf9\x85\x49\xdb\x38\xa0\xee\x45\x07\x87\x23\x2b\xef\x68\x4b\x39\xb2\xd2\x4c\x8c\xab\x0c\x2b\x46\x55\x06\x55\x1a\xe8\x4e\x32\xe7\xd3\xb0\xbe\xb2\xf4\x7a\x9e\xd6\xe6\x59\xeb\x38\xbf\x32\x94\x68\xc6\xdc\x5b\x56\x41\x0c\xd2\xd6\x85\xed\x5e\xc7\xfe\xac\x23\x28\x15\xf7\xde\x2e\xeb\x08\x9c\x38\xd7\x32\x72\xf9\xb7\x9b\x24\xfb\x3f\x28\xc9\xc6\xa4\x24\xfb\x24\xcb\xec\xe4\x1d\xc8\x36\x71\xfc\x23\x2a\x8b\x24\x8b\x04\xda\x0c\x69\x7a\x92\xff\x0e\xcb\xa0\x0e\x90\xb7\xd3\x67\x7a\x7b\x19\xb7\xd2\x4f\xa8\x86\x75\x3d\x2a\x87\xaa\x46\x7e\x4b\x6f\xf3\x5b\x5a\x9a\xdf\x42\x73\x58\xcf\x51\x21\x6d\x8f\x14\x58\x6f\x2d\x6b\xb9\x01\xbd\x39\x6b\x4b\x6e\x97\xb8\x3d\x6e\xff\xdd\x7f\xfb\xaf\xdf\x4f\x7f\x77\xf7\x51\xfa\x3b\x1d\xd7\xea\x93\x8d\xab\x7c\x41\xd7\xa4\x47\xef\xa4\x7d\xda\xcb\x30\x8f\x21\xcb\x0e\x21\xff\x80\x7e\xb8\x72\x30\x1e\xb2\x8a\x73\xb5\x37\x02\x57\x4d\xc0\x98\x08\x8d\x78\x90\x5f\x9e\x58\xba\x2a\x09\x3b\x59\xba\x5e\xe8\x3e\x3f\xc9\x63\x99\x00\x7e\x50\x88\xf0\xb5\xaf\x17\x5d\x90\xee\x72\x41\x6e\x0b\xdf\x45\xa1\x51\xe7\xf6\x0b\x61\xb7\xd2\xe4\xf5\x41\x66\x4e\xbe\xdf\x17\x4b\x10\x92\x0d\x32\x98\x33\xbe\x8e\x8f\xe7\x01\xc4\x2d\x77\x16\xf9\x6e\x7e\xc2\x57\xd1\xe6\x8f\x7b\xc9\x49\x23\xe4\x93\x9e\x2d\xc5\x06\x88\x81\xe4\x38\x56\x2c\x60\xce\x5f\x58\x38\xde\x89\xe3\xef\xb1\x71\xc4\xc0\xdd\xd2\x61\xd0\xe5\x9f\x53\xd5\x85\x86\x7d\x80\xfb\x12\x1e\xe0\xbe\xe3\x5d\x29\xed\xea\x5d\x0a\xc0\x89\xf8\x77\x57\x27\x59\x4e\xb7\x4f\x0c\x2d\xee\xae\x8b\xbb\x70\xe7\x84\xd1\xf2\xfe\xe3\xaa\x0f\x1f\x59\x7d\xbc\x2e\x1f\x43\x38\x28\x9e\xae\x8b\x07\x9f\x0e\x8a\xe7\xeb\xe2\xde\xe5\x83\xe2\xe5\xe3\x8a\xd7\x67\x7d\xcd\xfe\xa0\x78\x7b\x56\x7b\x3e\x1a\x99\xef\xaf\x8b\x97\x83\xca\xfd\x4f\x1f\x55\x79\x7a\x56\xfc\x70\x1c\xbf\xff\x93\x6d\x26\x25\x0a\x69\xba\x56\x4f\x3e\x7a\xee\xbe\x20\xe1\xda\x4e\x99\xa7\x67\x1c\xb2\x2c\x69\x3f\x35\x59\x9f\xd5\xc9\x02\x8e\x76\x66\x0f\xd5\x63\x7d\x46\x1c\x43\x99\x67\xa6\x9c\x1b\x91\xa7\x63\xc6\x41\xe4\x65\xf9\x27\xa7\xcb\xf8\x84\xb3\x50\xaa\x8b\x3c\xf1\xb9\x1d\xc7\x86\x4b\xcf\xf2\x49\x16\x4f\xa8\xb8\x27\xbb\x05\x6b\x0d\x72\x55\xa5\xc4\x53\x28\x3c\xf0\xd9\x14\xec\xc9\x93\x6e\x31\x72\xae\x70\x4f\x93\x7b\x71\xca\xd7\x25\xe4\x65\xd9\x2c\x02\x96\x64\xf0\x4b\xe2\xd6\xd8\xdc\x92\xc9\x90\x24\x6c\xe3\x49\x8a\xf8\x36\x2c\xb1\x70\xef\x39\x79\xf4\x3c\x4d\xb6\xe9\x58\xfb\x51\x4e\xba\xe4\x16\xeb\xa3\x1e\x7f\x75\x7a\xc2\x4e\x06\x0e\x41\xc6\x01\x9b\xaa\x97\x65\xee\x0b\xf6\xae\x09\x87\xbf\x2b\xf8\x33\xf2\x4a\x0e\x10\x39\x64\x71\x82\xf8\xa8\x67\x70\x90\xd3\x1c\x7c\x40\x93\x5d\x24\x48\x97\xe5\x43\x53\x94\x2d\xca\x95\x19\x9d\x40\x1f\x9c\xf3\x27\x37\x48\xd3\xf2\xa0\xdb\x17\x36\x96\xfe\x2c\xc4\x93\x6c\x5c\xb5\x0c\x49\x76\xa7\x14\xd0\x5a\x1c\xfe\x72\x71\xc2\x97\x9d\x1c\xcc\xbe\xf1\xf7\x60\x7f\xf9\x2f\x3f\xd8\xdc\x47\x69\x3b\x78\x60\x1e\xbe\xb9\xf0\x20\xe1\x2e\xb9\x54\x19\xde\xe0\x96\x24\x9d\xc1\x54\xc9\xc1\x25\x43\x97\xc9\x03\x48\xeb\x31\x36\x19\xa3\x89\xa1\x5a\x74\x56\x8b\x6c\xf1\x19\x07\x35\xfe\xc5\x4e\x2f\x5d\x1d\xed\x91\xd0\x04\x4b\x3b\x70\x60\xf2\xcb\xf3\x58\x1f\xe0\x0b\x89\x64\xa0\x9f\xe4\xa1\xa0\x8d\x10\x92\x2b\xb2\x01\x7b\x9d\x5a\x5f\x5f\x75\x72\x85\x8a\xa3\x27\xf5\xa3\x47\xcf\xc7\x0a\x0e\x8b\x97\x05\xe7\x4e\x5b\x8f\x87\x72\x71\xee\x14\x9e\x3b\x60\x18\x72\xf9\x88\x13\xa4\xd8\xb1\xf3\x91\x2f\x45\x65\xf0\x8c\xdf\x2a\x9d\xdf\x92\xff\x7e\x53\xe6\x5f\xce\x85\x33\x08\x5c\xd8\xa9\x70\x92\x03\x45\xe8\x00\xc3\x2a\x74\xe4\x41\xe3\xb2\x88\xe5\xfd\x2a\x94\x67\x7f\x66\x8c\x29\x0a\x78\xb0\x3c\xe1\x3e\x41\x78\xe7\x8d\x20\xe4\x40\x1b\x8a\x7c\xa1\x3e\x60\xd8\x5e\xc0\x8c\xd5\x07\x4c\xd6\x11\xff\x74\xbf\xa9\xc1\xfd\x17\x6b\x2a\x86\x83\xc4\x2b\xf3\xfb\x98\xe5\x03\x67\xe8\xb4\xd1\xcd\x0d\xb6\xfd\xb8\xcc\x72\x72\x9c\xa9\x54\x20\x37\x4f\xd5\xe1\xf5\x61\x7f\x6f\xd9\x95\xe6\xc7\x9e\x1e\xb0\xaa\x28\x82\xfe\x9f\xdd\x01\x07\x28\x95\xd8\x73\x69\x10\xbf\xa0\xcd\xd1\x2f\x6b\x63\x46\x69\x4d\x20\x43\xb7\xde\x1b\x6c\xef\xd4\xd6\x90\xd0\x1e\x30\xaa\x68\x8d\x34\xe6\xb7\xef\xe2\x4a\x44\x36\x12\xb2\xc5\x07\xbf\x75\xb3\x92\x2f\x2b\xd8\x4b\xb0\xa7\x09\x0f\x14\xc1\x43\x96\xc5\x61\xcb\x45\x9d\xf6\xab\xb7\x85\x4f\xc2\x16\x2e\xd7\xdb\x8b\x32\xe8\x5b\x85\x3a\x10\x71\x1d\x87\xcc\x8d\xb0\x77\x64\xd1\xd7\xf8\xb9\x91\xfb\xd0\x80\x8a\xe5\x73\xf8\x8e\x7c\x46\xff\xe5\xad\xe1\x94\xb0\x23\x0f\xeb\x1b\xcb\x56\x8f\x8e\x81\x7c\xe5\x3e\xd1\xa4\x9f\xfe\x74\x7d\x36\x52\x70\x07\x19\xc9\xfa\xbc\xff\x62\x26\x6b\x85\x62\x69\x92\xe5\x3b\xc9\xb1\xe8\xb8\xa2\x85\xec\xb8\xb3\x35\x4e\x18\xa7\xde\x61\x7b\x3c\xe1\x30\x8d\x43\x93\x81\x16\x66\xcf\x6b\x51\x79\x88\x75\xb8\x54\x9e\x78\xb6\x3b\x3a\x50\xab\xde\xe1\x86\xb3\x16\x7b\x12\x89\x54\x26\xb4\x34\xdd\x38\x30\xa3\xdc\x27\x33\x39\xcf\x82\x71\x6c\x43\x64\xf7\xb1\xce\x45\x08\x07\xb3\x9c\x79\xcc\x7a\x8e\xa2\x1f\x58\x4c\x8e\x07\x34\x79\xf0\xdc\xa4\xe5\x5f\xf9\xab\x1c\xad\xad\xfc\xd3\xf7\x9d\x27\x28\xe4\x09\x78\xaa\xc8\x9c\x83\xde\x3c\x3b\xec\x8b\x89\x18\x41\xc5\x63\xec\xf1\xae\x96\x39\x66\x9c\xa4\x31\x3f\x85\xe9\xc4\x5e\x87\xce\xe1\x43\x13\x10\x48\x0c\xd1\x43\x12\x10\x6a\x88\xde\x16\x85\x10\xa8\x9d\x2d\x28\x2a\xef\x4a\x3d\x22\x8d\x46\x9c\xe8\xbc\x19\xb8\x81\x92\xec\x54\xe8\x65\x2b\x7c\x5d\x44\x4e\xc7\x5a\xe1\xd1\x3b\x51\xfd\xc1\xb5\x2f\x07\xa5\x0c\x00\xa8\xad\xe1\x2d\x5f\xd8\x6c\x94\x1e\x6d\x13\xe5\x61\x57\x8a\x7c\xa0\x4a\x41\xe7\xe5\xa2\xc9\x00\x87\x78\x96\x53\x2d\xff\x76\x5b\x03\x83\xc1\x29\xe4\xf5\x74\x8f\x54\xe1\xcb\xb9\xfb\xd2\x57\x82\x20\x90\x70\x00\xd4\xf5\x54\xaa\x5d\x47\x81\x87\xf6\xec\x5a\xa2\xf8\xed\x3b\x14\xa9\x7e\xf6\xa8\xc0\x77\x49\xc2\x14\x20\xa6\x92\x59\x9f\xf5\xca\x83\x31\x55\x89\x2d\x39\x27\xae\x36\x69\x99\xfe\xbd\xd8\xf3\xdf\xbe\x6b\x52\x79\x96\x1a\x50\x81\xd6\x5d\x71\x9a\xe2\x5d\xb4\x1b\xef\x8e\xfa\x32\xf5\x26\x76\x0f\x1b\x24\xca\x0c\xfa\x65\xbf\xca\x42\x54\x25\xe0\x9f\xdf\xbe\xab\x90\x6a\xa4\xd9\xab\xd4\xc9\xc7\x36\x1c\xcb\x68\x03\x24\xf3\x8f\x31\xeb\x87\x22\x7b\xbc\x68\xff\xe7\x2e\x66\x55\x3d\x18\x6b\xd7\x46\x3c\x92\xa8\x2e\xcb\xdf\x27\xee\x32\xfd\xb4\x1e\x1c\x90\xec\xbc\x93\xc9\xc3\x1a\x53\x3e\x71\xc6\x6a\x91\x35\x1c\x4e\x8e\x8c\x05\xb9\x0c\x70\x68\xfa\x3b\x8f\x4d\x58\xc6\x26\xe4\xe2\xe5\x99\xfc\x37\x0b\xd5\xb4\x8c\xc2\xf2\x77\x10\x52\x8a\xbf\xa1\xe2\x20\xfc\xe1\x34\x0b\xa3\x43\x6e\x27\x6e\xc5\xe5\x0f\x19\x7a\x29\x14\x84\x01\x6a\x42\xe0\x81\xdf\x15\x02\xc4\x86\x12\xc2\xfd\x65\xf9\xe3\xb4\x6e\x42\x72\x32\x0f\x19\x27\x99\x4c\x92\xca\x9e\x07\x87\x50\x3f\x83\x8e\x8e\x20\xa9\x03\xd4\x22\x24\x29\x35\xa3\x52\xac\xcf\xa3\x96\x74\x3e\x21\xc8\x3a\x95\xee\x96\x39\x47\x1c\x45\xe1\x24\x1c\xbb\xf4\x39\x87\x61\x5a\x70\x22\xc4\x76\x1e\x6b\x80\xbc\xc0\x43\x22\x92\xf5\x6e\x7a\xda\x3a\xfe\x91\xb9\xef\x17\x30\xe2\x6c\x3d\x18\x4d\xe3\x02\x8b\x2c\x6c\x36\x11\x25\x17\xee\x72\x22\x6d\x9c\x8a\xc7\x11\x20\x0c\xdd\x50\xcf\xc9\x3b\x19\x65\x72\xfd\x49\xfa\x9a\xa7\x8c\x4f\x45\x3d\x42\xc6\x36\x41\x81\x06\x69\xa2\xd9\x27\xb2\x7d\x22\xad\x9f\xf8\x70\xf3\x23\xd2\xa6\xed\x33\xe5\x9c\x03\x58\xaa\x50\x75\xf0\x44\x6a\x58\x12\xd5\x6e\xf1\x68\x8c\xc2\x7a\x64\x40\xfb\xd5\xa0\x95\xe5\x26\x01\xbe\x23\xb4\x85\xe7\x04\x45\x2b\x6e\xde\xdb\xd9\xb1\x40\xea\x91\x52\xd4\x93\x0a\x5d\x48\x6b\xb9\x12\xa1\xfd\x2b\xd8\xe8\xb2\x6c\x0b\x85\x9b\xb0\x08\x01\x81\xbd\x62\xb3\xa5\xcf\x3c\x41\xf4\xda\x63\x63\x86\x80\x03\x71\xc3\x53\x78\x93\xbb\x59\xc5\xad\x85\x7b\xb1\xec\x82\x33\xb4\x22\x03\x6a\x92\x09\x81\xc0\x04\xa9\x25\xe3\x4b\xb2\x37\xca\xa8\x28\xdb\x7d\x1a\xb5\xed\x68\x96\xec\xd5\x8b\x36\x74\xd0\xb6\x6b\x17\x06\x6d\x3b\x44\x36\x14\x5a\xf4\x77\xd9\x3f\xdb\xbf\xa4\x15\xfd\xa6\x83\x33\x79\x2f\x5b\x49\xe5\x07\x32\xe6\x0e\xeb\xcd\xa9\x10\x05\xd1\x92\xb7\x97\xfe\xbb\x3e\xe9\x65\xf1\x8b\x1f\xc8\x3d\x72\x1b\x5a\x15\x29\x36\xf6\xdf\x7e\x7f\xd4\x72\x47\x53\xd6\x15\x6b\x59\x44\xd0\x26\xe2\x80\x88\x68\x24\xf0\xa6\xed\x16\xf2\xa0\xd2\x95\xe7\x99\x6a\xac\x64\x28\xa5\xf9\x24\xe4\xb8\x50\x0c\x9c\x78\xca\xa2\x89\x3e\x90\xee\x8b\x9e\x92\x6c\x33\x46\xa8\x61\x27\xf3\x3a\x72\x5e\x69\xc0\xab\xf2\x3b\x91\x8e\x75\xab\x8d\x20\x55\x4c\x8b\x34\xba\x72\x65\x90\x4a\x4b\xd4\x72\xbd\x18\x2b\xb0\xf7\x71\x4e\x0f\x56\x33\xbf\xc8\x0f\x82\x51\x90\xbd\x07\x8c\x55\x58\x0a\x58\x81\x49\x09\x4e\x6e\x2f\x98\x73\xa1\x0b\xe9\x18\x8f\x75\xed\x99\xd1\xe2\x74\x48\xdd\xb9\x8b\x8e\x22\xec\x62\xaf\x73\x01\x2c\x6a\x38\x51\x87\xac\x0a\xe2\xb6\x98\x9a\xbd\x89\xb8\xd3\xb0\x1f\x85\xa7\xe8\x9d\xee\x56\xe4\x17\xb5\xa7\x76\x76\x8f\x01\xeb\xd6\x47\x29\x1d\xa8\x24\x70\xd0\xfa\x83\x6a\x95\xe5\xe0\x9a\x45\xef\x86\xac\xa5\x83\x54\x92\xd7\xd7\x20\x64\x71\xd4\x07\x2d\x34\x92\x1c\xc9\x27\x24\x70\x06\x52\x67\xc4\x9e\x1c\x64\xfd\xfb\xe4\x58\x9a\xea\xbd\xa8\xcc\x81\xac\x24\x30\x1f\xd1\xb7\xa3\x6e\x97\x7f\xc3\xdd\xfe\x2e\xca\xea\x2f\x14\x59\xa3\xf4\xac\xa1\xda\x28\x47\x5d\xa1\x28\x99\x07\xb9\x25\xe4\x13\xa4\xa1\x8d\x04\x39\xf1\x91\xf7\xb8\x81\x93\x0f\x2f\x06\x39\xeb\x64\x93\xf2\xee\xbe\xde\xe1\x47\xd5\xae\xad\xb6\x2c\x08\x80\x42\xb5\x18\x1c\x34\xac\x0a\x2f\x81\x5d\xce\x79\x11\x2f\x95\x09\x89\xd4\x08\xc8\x7e\x0e\xe3\x0f\x76\x34\x79\x64\xa2\xfc\x04\x5b\x93\x77\xc6\x60\x62\xe0\xe7\x00\xc3\x85\x0f\xd4\xff\x60\xeb\x19\xa8\x89\x58\xac\xdc\x59\x96\xc9\xa5\x41\xa8\x6c\x06\x21\xd5\x3f\xef\x79\x93\xe7\x8a\x00\xbe\xce\xef\xf6\xcf\xa2\x7a\x7e\x55\x3f\x9a\x69\x9c\xa1\x02\x89\x45\xce\x50\x3b\x07\xee\x6e\xd2\xf8\xe4\x45\x72\x93\xce\xb4\x7a\xd6\xde\xa1\xeb\x15\x23\xe6\x17\xf4\xbf\xde\xe7\x5d\x7e\x9c\x8e\x78\xcf\xde\x81\x65\xed\x15\xe4\xbb\x8d\x8b\x7b\x55\x67\xad\x82\xdb\xca\x08\xb7\x97\x23\x9f\x1c\xe4\x3b\x10\x8f\x83\x8c\x59\x4b\xe2\xb3\x27\x32\x92\xfb\x0f\x8c\xeb\x17\xac\x91\x61\x6d\xe3\xd6\xc4\xad\x85\xf2\xfa\xb0\xeb\x61\xef\x20\x5f\x0d\xbd\x71\x6b\x83\x97\xf1\xa2\x79\xf7\xdb\x87\xb6\xd7\xbb\x8f\x8e\x9e\x89\xf8\x7a\xff\xb5\x3b\xcf\x30\x1a\xe9\xb5\x83\x31\x5c\x8e\xc6\xab\x07\x03\xfb\xc0\xdd\x86\xdf\x7f\x36\xf8\x83\xd7\x6e\x3f\xbb\x4f\xc0\x6e\x5a\x99\x22\x25\xe0\xf8\x40\xc1\x14\x1f\x2b\x98\xe2\x03\x05\x53\x3c\x52\x30\x7d\x97\x3f\x6f\x4b\x86\x83\xa6\xa4\x89\x44\xf2\x55\x0c\xc9\xfb\x35\x64\x78\x7d\x4b\x0e\xe8\xc8\x5d\xd1\xd1\x23\xcb\xf2\x0b\x14\x95\x8f\xac\xc1\x87\x8a\xca\x97\xdb\xb8\xdf\xa7\x25\x47\x74\x54\xbf\x9a\x41\x31\x2d\xf2\xfb\xb4\xe3\x98\x90\x1e\xcf\xce\xfb\xb5\xe4\x0d\x0d\xa9\x2f\x6f\xc8\xf0\xc9\xe7\x26\x7c\x0d\x23\x12\xb7\x86\x0c\x5f\x7e\x6e\xbe\xd8\x90\x0c\x2f\xdb\xf7\xfc\x57\xbb\xef\x95\xb7\xb7\xa4\xbc\x6d\xdf\xfb\x5a\xd6\x78\xdf\x6c\xde\x67\x44\xde\xb2\xed\x7d\x6d\x6b\xfc\x05\x23\xf2\x70\xd7\x7b\x01\x91\xbc\x8a\xa3\xe8\xea\xba\x98\xd3\x20\x82\xf0\x9c\x8a\x1b\xe4\xbf\x9d\x62\xb9\x50\x11\x0d\x0d\x99\xfc\x73\xaa\xdd\x23\xb1\xac\x0a\xf9\x52\x03\x54\x74\x91\x0e\x80\xb5\xb3\xf8\xd5\xf8\x70\xf8\x10\xd5\x38\x84\x7a\xad\x37\x2f\xac\x14\x75\xa2\x42\xf9\xcc\xaa\xf4\xa6\x42\xa9\x38\xf5\x7e\xc4\xbd\x82\x27\xe5\xa8\x1b\xd1\xba\xd1\xa6\x08\xab\x4f\x90\x4a\xd8\x92\xb6\xb3\x20\x50\xf9\x69\xd2\x32\xcc\xd3\x50\xf9\xc1\x1a\xa0\x17\xbb\x9e\xf5\x82\x95\x46\x1e\xad\xa8\x6e\xb6\x8c\xab\xde\xc9\xbb\x83\x54\xa2\x17\x6a\xf6\xbe\x1e\x87\xaa\x25\x51\x95\xd5\x64\xe3\x20\x5f\xaa\x14\x5a\xd8\x0c\x34\x67\xb4\x0b\x14\x52\x7f\xc5\xa2\x85\xd0\x98\x5e\x81\x4e\x0e\x2a\x35\x21\xa9\x0e\x73\xff\xfa\xac\xed\x31\x2f\x57\x4a\x42\x8b\xb9\x99\x1d\x0d\x60\x5a\x77\x58\xaa\x0d\xcb\xa9\x55\x3a\xbb\xb2\x7a\x55\x90\x25\x92\x26\xbd\x2d\x16\xfe\x0b\xb5\x1d\xc8\x30\xb0\x7a\x96\x7d\xa6\xb2\x0d\xd0\x52\xfb\x76\x6b\x1e\x74\x78\xa1\xa8\x96\x19\xbb\x3b\x68\xa8\x00\xef\x3f\x53\xd4\x9e\xc6\xa6\xba\xd3\x5a\x55\x37\xa9\xad\x81\x3b\x08\x94\xb3\x61\xd0\xc6\xb1\xf1\xd2\x91\xba\xd8\x85\xba\xee\xda\xe3\x61\x59\x5f\xc2\x6d\xab\x0a\x5b\x88\x2a\x6d\x7d\x38\xd1\x15\x47\x95\xb4\x5c\x8f\x31\x76\x17\x0d\xf5\xdf\x89\x34\xd1\xe4\x45\x7f\xb1\xf0\xfa\xd3\x65\x7b\x43\x0a\x0c\xf8\xa1\xc2\x11\x8f\x60\x29\x55\xfb\x3b\xaf\xf2\x32\xae\xcf\xd8\x15\x96\x97\x2d\xc4\xa7\xa1\x41\xd0\x3c\x54\x78\x94\xf4\xb4\x7a\x26\xde\x54\x88\xdc\xd0\x87\x0c\x17\x0a\x91\x92\x76\xba\xa5\x7b\x4a\x93\x43\x9d\x49\x61\x2d\x47\x4a\x95\xbb\x3a\x15\x69\xfc\xf9\xbe\xf7\xc7\x01\xd1\xe6\xbf\x11\xed\xd7\x48\xb4\xc1\xc3\x51\x4c\xdd\xaa\x3e\x8e\x28\x2f\xe8\x2d\x3c\xa7\xb7\xe1\x45\xf4\x74\x44\x32\x5d\x93\x0d\x05\x1b\x7d\xbf\xdc\xdb\xc5\x7d\xf7\x26\xbd\x83\x8c\xd4\xd0\x64\x05\xc8\xe8\x9d\x1c\x0e\xa3\xec\x07\x5f\x3c\xdd\x0e\x39\xeb\xf8\x26\x3c\xc3\xd4\x89\x0b\x96\x42\x7e\xdb\x7b\xce\x29\x5d\x6f\xf5\x83\x55\x69\xdd\x07\x58\xc3\xa2\x96\xd3\x4b\xbe\xd1\x4b\xb0\x00\x5e\xb4\xf7\xf8\xd0\xdc\xdd\x2b\x8e\x4f\x38\x55\x41\xc9\x8b\x56\x78\x73\x65\xc9\x08\x12\x98\x82\x1a\x34\x85\x08\xb0\x8c\xe0\xec\x88\x76\x7a\x9d\x48\x78\xac\x80\xd2\x75\x08\x93\x0e\x10\x7e\x16\xaf\x23\x97\x8a\x5d\xc9\x85\x3e\xd7\xc7\xf2\x16\xef\x9f\xcc\x01\x0f\x03\x00\x63\x1d\x3e\x23\x25\x8e\xa6\xb3\xae\x56\x6d\x9f\x6c\x3e\x8f\x89\xee\x21\xd5\x3d\xd0\x0c\x1f\x50\xdd\x77\xc5\xe8\xfe\xd1\x66\xfd\xa6\x16\x94\x44\x66\xf1\x76\x0b\x70\x5c\xa4\x87\x2d\x88\x6f\x6b\x41\x3c\x52\x8e\xfb\x44\x96\xf7\xb8\x01\xfe\x4d\x0d\x18\xba\x09\xe1\x23\x97\x7e\xdb\x9b\x5f\x60\x64\x0c\x41\x3e\x00\x33\x4f\x9a\x03\xa3\x3e\xfc\x8c\xc6\x9c\xe0\x89\x0b\x37\x26\x38\x73\x34\xdc\xf2\xb3\x0b\x51\xa6\xf6\x84\x4d\x3c\xc2\x34\x8a\x6d\x73\x92\x93\xa0\xd2\x4b\x29\xce\x58\x18\xf0\x85\xa7\x0f\x17\x7c\x25\x02\x2c\xd8\x79\x96\x3a\x71\x12\x9d\xf0\x24\x0c\xe8\x1e\xcd\x30\x74\xc9\x81\xcb\x10\xe2\x53\x58\x82\x66\x6f\x0d\xc4\xa1\x27\xb0\x55\x02\x3f\xcf\x34\xdb\x57\x4e\x23\x9d\x67\x69\x6e\xed\x0d\x60\xc3\x1c\x8c\xd6\xa3\xec\xfa\xd8\xce\xd1\x0a\x6b\xfa\xc8\xb6\x8f\xda\x39\x27\x22\x15\x8c\xc9\xfc\x2e\x9a\x3b\xb2\xff\xf8\x23\xb1\x8c\x14\xc1\x1b\xfe\x84\x06\xc0\x50\xd7\xd0\x17\xdc\x0a\xfa\x9d\xb1\xba\x13\xbc\xe6\xd0\x0a\x47\x46\x9b\x4d\x43\xff\xa5\x9d\x70\xc0\x4a\x74\x90\x96\xba\xd0\x74\xcf\x00\x20\xf9\xae\xf4\x1f\x61\x3c\x9e\x61\x3c\xd8\x66\xe4\xc3\x72\x05\xaf\x13\x3c\x9c\xa5\x20\x5c\x49\x22\xce\x31\xbc\x19\x06\x1d\x59\x74\x19\x8e\xc0\x8e\x7b\x12\xbe\xe6\xe0\x36\x52\xa7\x19\xad\x84\xf3\x70\x1a\xb4\x8d\x30\xd9\xb3\x07\x72\x1b\xc3\x8c\x3e\xa9\xc7\x53\x95\x01\xc5\x69\x38\xa8\x91\x19\x9e\x17\xb2\x6b\xd1\xd9\x05\x5b\xdc\x76\xd5\x1f\xe2\x57\x7e\x32\xce\x5a\xba\xe3\x6e\x57\xfa\xe8\x80\xc4\xfc\xb4\x77\x7e\xc0\x3a\x80\x43\x76\xc4\xc9\x50\xd4\xac\x89\x1d\xdd\xd3\x9f\x12\x37\xae\xa3\x4a\xee\xf0\x76\x23\x34\x0d\xf7\x39\x24\x54\x70\x56\x9f\x46\xfa\x3f\xe3\x2e\xa3\xdf\x68\x0e\x6d\xca\x19\xac\xce\xa2\xda\xa8\x73\x77\x17\x97\x25\x0b\x6b\x47\xc3\xb3\xaa\x47\x1d\x38\xa1\xad\x8d\xcb\xb8\x35\xdd\xc4\x50\x76\x68\x5c\xab\x5f\xc6\xf5\x9b\xc3\x19\xfb\x5f\x43\xb5\xa1\x9b\x93\x8a\xd9\x49\xca\x6a\x43\x49\xf7\x1f\x95\xfb\x8f\xda\xdd\x47\x07\x13\xd2\x15\xe6\xe6\xfa\x95\x3d\x8c\x55\x55\x83\xbe\xaa\xca\x46\x3a\xe8\xb3\xdc\x86\x4b\x68\xbd\xeb\xbb\x05\x16\x39\x3d\xe2\x91\xfd\xe1\xce\x19\x30\x99\x81\x7e\xcc\x6f\xab\x28\x15\x6d\xd1\x4d\x67\xb2\xa3\xf1\xf0\x57\x26\xe8\x95\xbf\xe9\x94\x33\x6e\xa4\xa3\x53\xce\xe6\xe6\xc7\x2e\xc6\x8f\x1c\x9e\x9d\x3b\xe0\x82\x9e\xe4\x4c\xd9\x4c\x90\xd6\xfb\x23\x6d\x47\x3a\x9c\xf5\x70\xb9\xd3\x17\x06\x07\x4c\xc3\xc6\x14\xed\x58\xa5\x1d\x07\xb5\xe3\xab\x5e\xc7\x62\x3d\xe2\xb0\x96\xf5\x62\x7b\xb8\xbd\xd1\x6b\xe9\xfe\xee\x1e\x4e\x0e\x8e\x01\x14\x1b\x03\x35\x5e\x30\x56\xe3\xc6\x70\xad\x57\xc3\xab\x78\x2f\x56\x65\x57\xfb\xe7\x63\x7f\x4b\x96\xad\xc9\xa2\xc7\x47\xbb\x50\xa6\x3b\xdc\xd0\x1e\x9d\xfd\x0f\x96\xd0\x6b\x8e\x7e\xdf\xd5\x43\xd8\x93\x07\x18\xa4\x71\xe0\x0a\xc9\x47\x75\xfb\xf0\xfc\x63\xe4\x5f\x78\x14\x83\x53\x66\x52\xb8\xdf\x99\x54\x4f\x9e\x26\xc3\x01\xb2\xeb\x16\xab\x0c\x88\x4b\xea\xf0\x08\xbf\x27\xde\x45\xc0\xa9\xfc\x11\xb8\x33\x95\x43\x1a\x4d\xd7\x82\xc8\x23\x75\x65\x84\x83\x62\x73\xea\x60\xec\xb5\x8c\xeb\x63\x33\xc2\x17\x4a\x4e\x61\xb4\x84\x66\x50\xfa\x71\xc2\x81\x04\x4e\x2f\x88\x17\xf1\x22\xb0\x69\x81\xfe\x1c\x8f\xf1\x2e\xdd\xa8\xcc\x1b\x50\x26\x40\xbe\x61\x2c\xde\x3d\x89\xc5\x81\xcb\x8b\x8f\x28\x21\x1c\x4f\xf4\xf0\xa4\x7e\x1a\xaf\x99\xea\xe3\x0f\x23\x66\x05\xe7\x99\x7b\x8d\x4e\xc1\xe7\xbf\xed\x1d\x9f\x70\xef\x78\x20\x17\x60\xf3\x08\xc5\x7c\xc6\x72\x27\xf0\xda\xc9\xdb\xd3\xef\x93\xc7\xde\x6d\xf2\x46\x81\xfe\xdc\x31\x22\x4a\x89\x3b\x1b\x69\x87\x72\xf6\xf7\xa9\xe2\xae\xfb\xbb\x90\x45\xf9\x1b\x59\x7c\x02\xb2\xc8\x32\x6f\xcd\xc5\x79\x9d\x58\x9b\x57\x9b\xf1\x65\xec\x44\x80\x50\xb6\x42\x02\x79\x8b\xe4\xea\x8b\x56\xd4\x79\x0b\xa3\x8b\x45\xc9\x64\xee\xd4\xa3\xc4\x43\x92\x1a\x16\xa5\xb0\xa3\x1d\xa3\xeb\x20\x7c\x57\x41\x94\x95\xc8\x95\xa3\xd2\xe8\x86\x11\x16\x0f\x44\xf9\x65\x91\x52\x0a\x43\x87\x8a\xba\x8a\x8b\xd4\xce\x5e\xc1\xaf\x57\x83\x4c\x20\x80\x62\xaa\x28\x32\x09\xb3\xa8\xc1\x7c\xd4\x33\x39\xcf\x96\xcb\x36\xce\x68\x42\x8a\x56\x8c\x09\x84\x24\x25\x27\x1a\xeb\x92\x96\x48\x81\x26\xdf\x4b\x03\x3e\x17\x88\x90\xc0\xa8\x19\x69\x0d\xb9\x75\xb8\x67\xd5\xd5\x59\x2d\xaf\xa3\x06\x7d\xe5\x62\xf1\xf2\x22\x85\x51\xdd\x34\x29\x6a\x85\xd4\x8e\xbb\x0c\x88\x2a\x58\xca\xc3\x55\x37\x9d\x75\xd3\xa4\xe9\x93\x42\x5d\xf4\xdb\x8b\x95\xdb\xc5\xed\x37\x65\xc1\x1d\x1b\xd6\x25\x38\x85\x73\x70\xfb\xc6\xee\xf6\x0d\x11\x1c\x97\x38\x58\xdb\xac\x69\x6c\x2d\xe2\xd5\x09\x3b\x71\x64\xe7\xf0\xed\x6f\xeb\xf8\x53\x6c\xef\x51\x18\x77\x3f\xcd\x9e\x61\x24\x4d\xe6\x4a\x9d\x73\x1d\x19\x23\x0d\x7b\x05\x04\xca\xfa\x9c\x9b\x37\xbd\x77\xab\xf9\xee\xca\x1f\xf0\xea\xde\x29\x4b\xf5\xc6\x62\x25\x0e\x26\x35\x74\xb1\xbb\xc5\x02\x4c\x0f\xf8\x27\x43\x10\x4a\x6a\x03\xdb\x64\x23\x59\xfb\xc2\x97\x64\xff\x40\xef\x2c\x05\x3a\x12\x43\x0f\x0f\x42\x25\xd4\x3e\xc8\x7f\x27\xea\xff\x0b\x3c\x6b\x11\x26\x91\x5f\x35\x15\xaf\x9a\x89\xa7\xae\xbd\xbc\xd0\x02\x0f\x5f\x80\x10\x4f\xf0\x7d\x06\x0e\x8f\x06\x25\x1c\x4d\x8e\xfb\xb8\xc9\x71\xda\xc1\x7b\x30\x19\x28\x70\x67\x72\x22\x15\x46\xd8\x26\x07\xc6\x89\x84\x36\x90\x15\x78\xe9\xe4\x3c\x99\x49\x02\xa3\xfb\xaa\x41\x80\x16\xba\xc0\x19\x56\xf7\xc6\xd7\xa8\xeb\x81\x15\xc0\x0d\x3e\x40\xcd\x48\x04\x22\x62\x1d\x85\xcd\xba\xae\x0a\x09\xb6\xfc\x83\xf6\xfd\x1c\xb2\xb6\x7b\x87\x55\xd3\x31\x70\xf0\xc8\xed\xa2\xf4\xfa\x7b\xac\x07\xb5\x33\xfe\x2b\x30\xb0\x73\x14\x9a\xf6\x91\xbf\x27\x1d\xb5\x43\x6a\x3c\x9a\xf4\xae\x67\x70\xab\x5a\x7e\x7a\xab\x5e\xfe\x58\x58\x38\x54\xcc\xbb\x69\xf5\xcb\xae\x8f\x5c\x91\xeb\xa1\x5f\x76\xbd\xef\x89\x5c\x57\xc5\xfc\x4d\x57\xe4\xf0\x12\xe9\xf9\xd1\x20\x1c\x2b\x85\xee\x0f\x40\x67\xbf\xe3\x9b\xbe\x1e\x1f\x7c\xfe\xbe\x5d\x02\x9d\x2f\x5f\xb2\xf3\xe5\x0b\x77\x3e\x7d\xc9\xce\xa7\x2f\xdc\xf9\xf0\x25\x3b\x1f\xbe\x5c\xe7\x0f\xf6\xc7\xb0\xfa\x2b\xa9\x52\x5b\xdd\xb7\xe0\x48\x95\x68\xf0\x8f\x6a\x70\x60\xe4\x28\xee\x18\xf8\x4b\xe5\xd9\x40\x5f\x85\xd4\x16\xc6\x39\xca\x1f\xf4\x20\x33\x33\xf4\x4e\x37\x3f\xee\x94\xf3\x66\x6e\xc8\x0f\x94\xbd\xed\xc2\x5c\xb1\x59\x2b\xf8\x76\xee\x26\x86\x4d\xdf\xbf\x6c\x56\x00\xd3\xd2\x47\xeb\xd0\x86\xfd\xb0\xea\x8f\x2c\xe8\x96\x0e\xaf\x6b\x90\xbc\xb2\x42\x17\xd0\x11\xe1\x50\xa5\x1f\xe2\xfe\x68\x71\x8f\x48\x0b\x5b\x3f\x9b\x76\xc6\xd5\xa3\x13\x02\xf6\x23\xa9\x09\x31\xb3\x0c\xfd\xcf\xd4\x22\xf8\x00\x19\x8d\x8c\x09\xa4\x31\x9c\x9d\x34\x9e\x71\xfc\x33\x63\x19\x9b\xcc\x86\xfa\x4d\xa4\x6a\x68\x05\xa3\x86\x24\x2a\x00\x4f\x26\x3e\x01\x19\x91\x84\x8f\xe6\xf5\x91\x23\x6e\x44\xc6\x58\x71\xf6\x18\x3b\x1d\x18\xda\xba\x68\x59\x97\xac\x6e\xfc\x71\x02\x6a\x02\xfd\x3a\x58\x15\xb9\x02\xf2\x32\xb4\xe8\xf1\x03\xc2\x07\x80\x47\xa5\x78\x89\x78\x37\x7f\x8e\xa1\x02\xe4\x27\x21\xc6\x77\x68\x1a\xc7\xd9\x06\x44\xc5\x22\x92\x16\xe1\xa1\x03\x26\x13\xd6\xc1\xa2\xd8\x12\x8c\xd6\xa3\x18\x17\xec\x86\x73\xa0\x0c\x54\x98\xe9\x45\xe3\x15\x1e\xc7\xc5\x45\xff\x16\x49\x6a\x0a\xf4\xbe\x09\x43\x35\x9f\xbe\x3a\x1c\x85\x75\x85\x74\x29\x8b\xa5\xce\x74\xf8\x0e\xbb\x23\xbc\x90\x32\x86\x6e\x41\xc4\xe1\xc8\xbf\x0d\x8f\x44\x4a\x11\x0e\xe6\x0d\x3b\x87\xbe\xed\xf0\x39\x25\x63\xc3\xdf\x51\x46\x4f\x61\x9d\x7c\x54\x00\x0a\xfc\x2a\xf3\x68\x6c\x9b\x3e\xb6\xb5\x13\x18\x62\xf7\x82\xd6\x0c\x8f\x9b\x23\x63\xc0\x1e\x4a\x07\xd9\x6b\xed\xb4\x0e\x05\x46\x02\xc3\x13\x69\xd5\xf3\x5e\x23\x56\x80\xa8\x43\xc7\xa7\x08\x68\x20\x06\x7b\x0b\xc1\x92\x27\x1c\xab\x42\x45\x45\x0d\xbd\x84\x16\x01\x60\x41\xea\x83\x24\x53\x09\x58\x49\x29\xad\x85\x01\x7e\x29\x25\x71\xff\xb7\xef\xea\x7b\x56\x3e\x5c\xd5\x7e\x40\x14\x5d\xff\x1a\x64\xef\xa8\x50\x0d\x01\xbf\xb1\xf8\x1e\x61\x88\xb1\xc0\x37\xc3\x95\x2b\x85\xdd\x03\x73\x3f\x90\xb3\xed\xef\x8d\xd4\x62\x33\x9a\xd2\xc5\xd7\x5b\x17\x76\x60\x77\x99\x70\x07\xd8\xb9\x73\xae\x0a\x69\xe4\x27\x73\xb7\xa4\x41\x11\xe6\x44\x70\xed\xc2\xb4\xd3\x85\x95\xae\xb3\x0b\xb1\x31\xcb\xbc\xba\x61\xcd\xfd\x62\x57\x68\x58\x4b\xd9\xfb\x1d\x53\xb3\xa8\x56\x46\x8a\x69\x05\x8e\x50\xa0\xac\xc0\xb5\x9b\xa5\xa4\x02\x7d\x5f\xbd\x68\xcb\x52\xe8\x72\x8b\x78\x5d\xc4\xbf\xdb\xc5\xb3\x02\xfa\xda\xe6\x91\x5b\x8e\x60\x25\x64\xba\xca\xef\x64\xba\x4a\xce\xca\x01\xe3\xac\x18\x1d\xf7\x75\x37\x9d\x1a\xd5\x10\x6d\xc0\xaf\x6f\xda\xbc\x44\x0c\x05\xa8\x26\x1c\x61\xb5\x9a\x8e\xac\x9b\xe8\x8d\x21\xf7\xd4\x99\x20\x4b\x0f\x67\xe0\xa8\xc1\xdd\x4e\xc1\x45\xb9\x6d\x60\x87\xb2\xe6\x00\x4d\x01\xbb\x22\xfc\xa8\x3c\xb0\x14\x1c\xb4\x89\x08\x14\x56\x97\x88\x4a\xd4\x07\xe8\xad\x18\x6a\x5f\x66\xd4\x06\xe5\x20\x36\xfd\xe1\x90\x81\xe8\x4a\xc7\x96\x21\x55\x4f\xf1\x09\x22\x1c\x3b\xc6\xa6\x9e\x1c\x4f\xd7\x02\xa0\x35\x9c\xae\x2d\xeb\xc8\x27\xf5\x3d\x21\x0e\xcf\x02\x5f\x44\xd9\xcf\x28\x76\x96\x5d\x3f\x9f\xe0\x4a\xb2\x76\x72\x06\xb0\x44\xa0\xf7\x36\x07\xc3\x65\x88\xdc\xf5\x04\xd0\x19\xc6\xe4\xe3\xbc\xf1\xf5\x04\x27\x02\x63\x0d\x5c\x01\xf8\x16\xbb\x86\x23\x2a\x0f\x1a\x93\xdc\x16\x28\xf6\xf2\x8c\xfa\xc2\xd6\xcb\x57\xd2\xce\xf0\x3b\x27\x9e\x37\xd0\xce\x70\x41\x3c\xf2\x52\xb3\xb7\x3f\x82\x80\xda\xce\x55\xa5\x99\x47\xc5\xd8\x63\x59\xed\x2a\x5e\xdc\xcb\xe4\xb5\x14\x84\x0d\x9e\x5e\xd3\x64\x61\xf3\xbd\x14\x2f\xbc\x39\x96\x9c\x43\x7f\x16\x7a\x05\x78\xc4\x50\xe1\xd4\x83\x6c\xe9\xd8\x32\x9a\xa3\x8c\x16\x64\xe5\xf5\xe0\x59\xb0\x06\x8f\x3d\xf8\xb6\xb7\xd7\x1c\x08\xee\x3c\xc3\x6b\xa9\x37\x12\x9d\xc6\x71\x0c\x7c\x18\xbd\x75\x59\x18\xdf\x0f\xbd\xf0\xd5\xa0\xf8\x5d\xcc\xef\xfa\x9a\xdf\x3f\x09\xdb\x20\xf5\x18\xe3\xdb\xdd\xf0\x77\xba\xe1\xef\x3f\x2b\x6a\xc0\xbe\xf3\x92\x0d\xfd\xda\x88\x63\xef\x98\x38\x6d\x60\x72\x17\xdf\xdb\xea\xbd\xd1\xd0\x8b\x87\xa5\x3f\xf4\xfd\xa1\xdf\x1e\xb6\xa3\x37\xb7\x4e\xde\x7a\x35\xde\xe9\x0c\x79\x45\xab\xb1\x39\xad\xaf\x39\x9d\xb0\x1b\xb7\xe9\xdb\x64\x9f\x90\xfb\xde\xee\x1f\x0c\x89\xdb\x7b\x70\x49\xf9\x39\x57\x18\x61\xaa\xbf\x05\x45\x15\x33\x37\x9b\x78\x13\xe7\x0a\x2f\xca\x7f\xb0\xc9\xdc\x77\xdd\x8a\x85\xa0\x48\x8a\xb4\x02\x81\xe1\xa4\x3b\xa8\x89\x67\xce\x1c\xac\x8a\xbb\x92\xe6\xdc\x87\xd5\x8f\x0c\x97\xeb\xf3\xfe\x86\x4a\x68\x6e\x7d\x03\x97\x47\xdd\xf6\x5f\xa0\xdb\xf5\xcd\xdf\x4a\x79\x48\x38\x4e\xe2\x10\xbd\xec\xa7\xc5\x76\x24\x07\x67\xa2\x97\x0f\xea\xf0\xc9\x46\xb5\x4b\xf9\xc1\x7a\xfa\x54\xbd\xec\xeb\x38\x58\xa0\xa0\x86\x43\x59\x33\xef\xf2\xa4\x3d\x8b\x41\x06\x10\xac\xf4\x5d\x74\xb3\x76\x80\x77\xd0\xa3\xc4\x28\x20\x1a\x26\xf3\x55\x75\xdd\xaf\xad\xbb\xb5\xe1\x85\xfb\xc2\x73\x7b\xa4\x1a\xb9\xd6\xa6\x5e\x9e\xb0\x4d\x7a\x79\xa2\x31\x92\xa8\xcf\xb3\x03\x3a\x52\x68\x2f\x82\x81\x96\xd1\x8b\x3b\x54\x4e\x1d\xbd\x07\x1e\x32\xd0\x01\xcf\x50\xd7\x0c\x31\x46\xbd\x18\x63\xa4\x6e\xd8\x9d\x5e\x11\x1c\x17\x37\xc4\x71\xaf\x2d\x90\xea\xe2\x6a\x7e\x68\xa6\x3c\x6e\xda\x79\x22\xc0\xc8\x8e\xe5\xc8\x4c\x99\x75\x37\xcd\x14\x92\xa7\x7a\x52\xde\x49\x87\x11\x06\x1f\x6a\x05\x36\xcd\x14\x6f\x64\xc3\x98\x4f\x33\x61\x6d\x85\xc7\x02\x7c\x1a\x4c\xb9\x70\x88\x0d\x03\x2b\x77\x9c\xfd\xd6\xe7\xbe\x99\x02\x43\xe4\x67\xf5\x9d\xdb\x99\xc0\xe1\xb9\x94\x8d\xcb\x26\xe2\xab\xb6\xd1\xc1\xdc\x1b\x95\x37\xb1\x07\x4b\x2f\x4b\xb0\x8e\x61\x57\x41\x7f\x7f\x7d\xdd\xde\xd6\x97\xd7\x77\xf3\x2d\xeb\xfb\x33\xe5\x7a\x3e\x54\xae\xbb\xfb\xca\xf5\xc3\xe0\xa1\x97\x45\x99\xbc\xe8\x8b\xc3\xf6\xc9\x7c\x88\x2c\x02\x6d\x3f\x29\x22\x4d\x5b\x9f\xcd\x0e\x3e\xae\x86\xf0\xb1\x5b\xc2\x2d\xeb\x83\xc6\x1f\xc1\x9c\xc2\x92\xe0\x86\xec\xfb\xd4\xef\x30\xab\x81\xbd\x66\x6f\x91\xe1\x34\x5b\xf8\x68\x96\xfb\x03\x8a\xcd\x17\xe8\x6b\xe6\x55\x96\xa0\x55\x31\xbc\x2e\x46\xea\x14\x5d\xfd\x0c\xe8\x81\x22\xd1\x27\x45\x21\x9e\x89\x97\xc6\x51\x39\x72\x5a\xce\x8f\xfd\x84\x71\x8a\x1f\x39\x0a\x6f\xca\xc4\x1b\xfe\xca\x6c\x80\x87\xc2\x00\x37\xb8\x29\xcb\x0e\x5f\x97\x48\x1c\x6a\x61\x88\xe5\x5a\xbb\x83\x87\x3b\xcf\x60\x9c\xf6\x57\x78\x2d\xe0\x17\x4a\x90\xb6\x34\x53\x9b\xa9\x3a\x8e\xb3\x99\x95\x24\x08\xba\xbd\xbf\xde\x17\xd2\x6b\x5e\xb2\x88\x96\x18\xf7\xd7\x5b\x11\xfd\x5c\x9e\x02\xbc\xd0\xf1\x5d\x5a\xbf\x81\x6f\x55\x02\xb3\x6a\xf0\xd7\x6e\xe3\x17\x3f\x01\x05\x17\xfd\x97\xb7\x8e\xe6\x77\x75\x43\xaa\xc8\x7c\xd1\xda\x1c\x2a\xb5\x8f\x15\x2a\x55\xe8\x97\xa0\x16\x6c\xfe\x44\x1c\xe8\x36\x84\xbc\x4d\xa0\x76\xd0\xc1\x54\x8a\x95\x2d\xd3\x98\x65\x3f\x73\xe5\x9c\xf3\x29\x05\x3d\x93\x80\xf5\x2d\xd2\x5d\x65\x94\x5b\x85\xe4\xe1\x85\x09\x6b\xb2\xeb\x30\x2d\x06\x74\x60\x0d\x46\x7b\xb5\xb3\xaa\x2c\xc9\x4f\xc0\x72\x4d\x9a\x0e\x65\x89\xa4\xa2\x05\x81\x42\x59\xbf\x12\x72\xe2\x57\x32\x51\x6c\xe3\x20\xa4\xed\x08\xd6\x4a\xfd\x3c\x1a\x27\x4b\x03\x2d\x2b\xc4\xc2\x6c\x0d\x22\x6d\x7e\x6a\x41\x18\x41\x44\x15\x79\xf7\x14\xe2\x24\xd2\x36\xb1\xec\xd2\xe1\x0a\xe8\x82\x71\x91\x3e\xe5\xe8\x10\x79\x61\xfb\x3e\x11\x16\x09\xd9\x4a\x2b\xa6\x52\x2b\xb6\x37\xa2\x1a\x8a\xb0\x63\xf7\x34\x3c\x50\x57\xe8\x64\x07\x4c\x2d\xd4\xfe\x16\xf5\x4b\x82\xd8\x97\x94\x85\xc0\x1f\x4d\x8f\x01\x1a\xa9\xb3\xee\x97\x44\xa5\x1d\x15\x10\x5e\x48\x75\x20\x9e\x37\xe4\x8d\xda\xb0\xb4\x8a\x2e\x2d\x68\x56\x75\x65\x11\xce\xb8\x7a\x7c\x05\xa6\x60\x3e\xb4\xdc\x31\xb0\x1b\x53\x3a\x6c\xba\x03\x99\xc6\x98\xd0\x76\xb8\x50\x30\x5c\x22\x6e\xb9\x61\x8e\x0c\x9e\x3e\x25\xa2\x99\xeb\x36\xe3\x15\xf9\xae\x72\x96\x2a\xbb\xd8\xe2\x42\x7d\xaa\xd7\xa8\xab\x31\xb9\xc1\x10\xc1\x9b\xc2\x19\x22\x18\xda\xdc\x02\x3a\x40\xb9\x06\x4c\x62\xba\x94\x3d\x27\xa7\x25\xa7\x79\xa1\x76\x90\x10\xcd\x11\xb2\x68\x5a\xc1\x79\xcd\x5a\x50\xfb\x02\xd7\xe5\xaf\x56\x77\xae\x99\xa0\x5d\x62\x58\x26\x7a\x05\x1f\xa7\x4a\xcf\x51\xfd\x04\xdd\x62\x5d\x3e\x55\x35\x60\x3b\x82\xd4\x2f\x50\x92\xe3\x73\xa6\x71\x60\x4d\x16\x17\x69\x08\x8d\xd9\xac\xf9\xed\x7c\x88\x8c\x2c\xd4\xd2\xa5\xe0\x8a\x50\x74\xf0\xa2\x5e\xb5\xea\x35\xe8\x01\x0f\x6d\xac\x39\x50\xc0\x30\x9e\xb3\x1a\xcd\x01\xd8\x26\x5d\xc7\x09\x8a\x58\x19\xb8\x5f\xb5\x85\x6a\x00\xa1\xf8\x56\x74\xaa\x5b\x39\xc1\xdf\x0b\x48\xbc\x10\x4b\x11\xd8\x1e\x86\x1a\xe8\x8d\x55\x06\x5d\xfc\xa0\x1f\x55\xf5\xaf\x07\xba\xc2\x19\xea\xb1\x4a\x0e\x96\x5e\x70\xf4\x83\x50\x78\x43\xf5\xde\xd5\x06\x22\x14\xe0\x29\xfa\x23\x2e\x26\x4d\x7b\xe7\xe7\xa6\x67\x96\xb2\x43\xa3\x02\x4e\x93\x37\x56\xba\xb5\x78\x73\xe0\xc7\x3b\x07\x22\xc3\x84\xf1\xd3\xb2\x53\x2f\xf0\x44\x9b\x0c\x17\x61\xb5\x94\x7b\x2e\xe3\x31\xd0\xbd\x65\x52\xdd\xcc\x8c\x3b\x7e\xa7\x45\x80\x6d\x2a\x92\x24\x69\x69\x10\x1a\xd7\x2d\x6f\x61\x66\x80\x45\x6e\x83\x14\x61\x43\xef\xa0\x0b\x9a\x90\xa8\x70\x2d\xf0\xf3\xa3\xb7\xf6\x8c\xe6\xcf\x82\xd9\x59\x14\xf1\x5e\x17\xe0\xba\xa0\x47\x22\x74\x13\xf6\x3c\xd8\x91\xb0\x4a\xe4\xab\x27\x00\xd8\xef\x4a\x6f\x3d\x68\x52\xb9\xf9\x17\xbd\x92\x0b\x84\x24\x57\x2d\x35\xdb\xad\xa3\x21\x5e\xc3\x4c\xdc\xfb\x0e\x71\x7e\xff\x21\xce\x9f\x7e\x88\x11\xc5\x0a\x4e\x9c\xd1\xac\xc8\x43\x53\x34\x3e\x04\x5a\x3c\xbd\x2b\x37\x67\xfb\x65\x84\x53\x65\x7c\xd3\xd1\x08\x5f\x23\xc0\xe4\x47\xb2\xc0\xe3\xb0\xdd\xfc\x28\x62\xe5\x11\xc8\x48\x55\x8d\xc6\x9b\x1b\xa2\xcc\xd2\x71\x4b\x5e\x80\xa0\xf6\x50\x3c\x7a\x7b\xd6\x82\x87\x43\x42\x57\x9f\x47\x21\xd5\x2f\x19\x12\xf7\x08\x02\xe6\xb0\x29\x07\x84\x14\xf6\x52\xe5\x4b\x10\x7c\x5e\xd0\xda\x47\xe0\x2c\x2f\xa3\xa4\x77\x1a\xb6\xb7\x50\x92\x37\x52\xfa\x1a\xc6\xe4\xeb\x27\xa5\xb8\x57\xa1\xa7\xe9\xb0\x9e\xcf\xb0\x61\x19\x4e\xe7\xa3\x86\xbc\xd7\x8e\x75\x38\xa6\xaa\x36\x0e\x8f\xda\xf2\xe9\xb7\xac\xdf\xbe\x03\x45\x3f\x18\x91\x17\x50\xd9\x43\x80\xa6\x47\x4b\xef\x88\x90\xd2\xd5\xe1\xf6\xb9\xb6\x82\xdf\xc7\xa6\x64\x7b\xd2\xd7\x31\x28\x5f\xff\xae\x94\xaf\x89\xe9\x5d\x96\xfb\x1b\x46\x2d\xf4\x96\x7c\x1e\x06\xe5\x01\x29\xd5\xaf\x65\x48\xea\xbb\x8d\xc8\x1b\xe8\x68\x5d\x5c\x5f\xc3\x88\xbc\x90\x89\x7d\xb8\x15\xbf\x0f\x17\xfb\x35\x8c\x88\x59\x3d\x8e\x11\x42\x3f\x35\x5f\x7f\xb0\xd7\x6c\x79\x01\x36\xab\xf4\x66\x96\xa6\x02\x36\x7d\x35\xfb\x50\xb2\x45\x97\xde\xde\x90\xf4\xb6\x45\x07\x8c\xbf\xf4\x4c\x42\xee\xee\x03\x5e\x85\x61\xb6\x37\xa9\xf1\xfb\xad\xed\x0d\x6f\x1e\x38\xf3\xdd\x78\x33\x25\xbe\x49\xe8\x3e\xa0\xc4\xfa\xb5\x9d\x7a\xed\x60\x49\x7c\x2d\x74\x48\x26\x24\x5f\xf8\xb9\xf8\x03\x35\xcd\x7a\x5a\x7c\x79\x8a\xfc\xfa\x09\xb2\xad\x76\x08\xd5\x37\xc2\x4c\x19\xf0\x19\x84\x40\x7a\x8f\xc4\x97\x63\x0a\xab\x8a\x8f\xda\x37\xde\xd2\x8c\xb0\x30\xbc\x30\x13\x1e\xdf\xc1\x2d\xef\xa9\x91\x93\x77\xf4\x15\xa8\x0b\xf1\xe7\xc2\x67\xe6\x50\x13\x98\xf4\xc4\x2e\xec\x97\x06\xbb\x83\xa6\xe6\xae\x20\x66\x2a\x5c\xf2\xb7\x8e\x16\x24\x7e\x83\xde\x02\xb0\x58\x39\x0c\xa7\xd3\xd8\x49\x73\x4c\x08\x4e\xd5\x86\x4c\x56\x8a\x97\x46\xbe\x85\x7b\x4e\x93\x87\xc9\x5b\xf6\x92\x63\xb2\x35\x79\x83\x0f\xf7\x69\x34\x4a\x28\xb3\x47\x70\x20\x12\xbf\xd1\x25\x31\x38\xe6\x58\x71\x29\xcb\x0d\xbc\x54\x67\x0f\xc3\x8d\xaf\x95\x0e\x5b\xdd\x33\x29\x22\x71\x48\x54\xfb\x26\x92\xb3\x84\xc6\x2c\x52\x8d\x53\xc8\x2b\xe8\x2c\xf9\xe0\x83\x5e\xe9\x85\x3c\x6a\x4c\x38\x85\x92\xc3\xc2\xfb\x47\x03\xb4\xa2\xe6\x30\x28\x2f\xb7\x1e\x34\xcc\x10\x81\x69\xcd\x69\x95\xe1\x12\x8f\x2b\xef\x2a\x87\x09\xd6\xf5\x42\xf7\x0e\xb8\x7b\x12\x33\x92\x66\x73\x84\xb7\xab\x01\xc9\xd1\x4d\xa3\x34\x66\xad\x82\x31\xcd\xc3\xa8\x80\xe7\xa0\x31\x8d\x20\xa0\x7f\xbd\x5a\x2c\x9b\x53\xa4\x48\xda\x9a\x9c\xaf\x0b\xb3\x8a\x0d\xcc\x1d\x9e\xcd\x45\x10\xa1\x04\x91\xb9\x50\xeb\x80\x98\xc8\x8c\x2e\x37\x9a\x12\xa2\x97\xa9\x44\x02\x36\x2c\x13\x06\x2e\x84\x8c\xc4\x07\xb0\xa0\x34\x64\x5d\x62\x31\xba\x31\x6a\xc6\x5a\x98\x54\x14\xeb\xaf\x2c\xfa\xbb\x65\xb4\xd2\x3c\xb8\x9b\xc1\x65\x21\x5a\x1f\x75\xc3\xbc\x2a\xab\x35\xe6\x68\x9d\xe4\x7f\x13\x58\x3d\x25\x9c\x6b\x8a\xef\x1a\x93\x7d\x34\x66\xab\xe2\x49\x48\xc6\xf9\x96\xb0\x07\x8e\x45\x36\x03\xb5\x4d\xd2\x8a\x5b\xe8\x26\x3b\x2d\xb4\x64\x01\x71\xd9\xa2\x38\xca\x52\x48\x9a\xb9\xe7\x75\xf5\xd9\xf0\x81\x5d\xb0\xe4\x9c\xf4\x7b\xd2\x36\x05\xcc\xa6\x3e\x85\x25\x00\x69\x00\x2b\x93\x8b\xa9\x95\x80\x43\xae\xc3\x0f\xcc\xd1\x38\xe8\x32\xf8\xa0\xd6\x4b\xc7\x6c\x6b\x85\xa6\x2b\xd8\x74\x7d\xa2\x79\xc3\x81\x5a\x4e\x4c\xe4\xc9\x4d\x81\x5e\xaf\x74\xd6\xa2\x65\x21\xf3\x92\xbe\xb0\x95\x54\x4b\xd3\x43\xd5\xcc\x5e\x6d\x90\x8e\xe1\x0a\xd9\x99\x35\x52\x2a\x60\x23\x88\x43\x81\x5d\xcc\x33\x5d\xe1\xa0\x79\xb9\xcd\x2d\x21\x5a\x2a\x5b\xb5\xd5\x5b\x86\x32\x47\xc3\x87\x8e\x35\x17\x08\x8c\xf3\xc9\xc3\xb6\x08\x13\xb6\xda\x3a\xb9\xfc\x18\x20\x6b\x23\x3c\x10\x0c\x02\xdb\xa1\xf7\x73\x86\x53\xb4\x3a\x68\xd0\x15\xc1\x77\xdb\x5d\x43\x0a\x7d\x04\xed\x62\x72\xcc\xe8\xa9\x96\x60\x46\xd2\x58\xca\xd7\x3c\x54\xf8\x39\xfb\xe2\x4e\x0a\x60\x00\x2c\x0a\xb8\x69\xd1\x14\x8b\x06\x1f\x51\x40\xba\x48\x03\x3c\x21\x83\xe3\x84\xbd\x29\xa1\x32\x99\x05\xcf\x46\x6a\xc2\x50\x69\x31\x31\x01\x41\x5d\xda\x2f\xa7\x49\xbf\x69\x57\x2a\x4c\x26\x87\x4d\xdb\xcf\x9a\x55\x1c\xf8\xb6\x95\xbb\xd7\x4e\x76\x47\x58\x7e\xa2\x19\x76\x22\x5d\x20\x85\x28\xc6\xb2\x16\x98\xf7\xeb\xa3\xc0\xb3\xb4\xba\x8c\xdc\x0e\x3c\xc3\xf3\xbb\x81\x67\x3c\x18\x7a\xda\x9d\x20\x73\xc0\xcd\x15\xfb\x51\xd3\x50\xa4\xa4\x06\x35\xdd\x96\x10\x28\x63\xb9\x60\x50\x66\xb2\xe7\x43\x53\x33\x2b\xe7\x0a\x37\x0c\x69\x00\x65\xb6\x9b\xcb\x56\x96\x47\x94\xec\xc5\x13\x50\x18\x81\x73\x1a\x80\xff\xe4\x00\xbf\x28\xac\x7b\x82\x47\x8e\x1c\xb1\x09\x3c\xc1\xe1\x06\xb7\x06\xb4\xf4\xa1\xd4\x10\x76\x66\xf6\xa4\xab\x04\x43\xcc\x78\xaa\xc9\x4a\xfe\x84\xe3\xc8\x31\x74\xe0\x39\xe4\x0c\xd9\x86\xd0\x5f\x0c\x61\x79\xd7\x21\x1c\xbe\xab\xb0\x3e\x4e\x25\xcc\x01\xe7\x31\x1c\xd8\x9e\xe3\xac\x17\xcd\xf0\x06\x1a\x9c\x32\xbd\xe7\xe8\x58\x8f\x96\x44\xcd\x9d\xee\xdc\x19\x59\xbf\x09\xd8\xa2\x0e\x1a\x03\x1c\x68\x3a\x2c\xc0\x42\xaf\x14\xab\x10\x21\x7a\x70\xcd\x98\x68\xc9\x97\xe5\x99\x1a\x00\x27\x85\xa6\x17\x66\xe4\xaf\x4e\xcf\x2a\x6c\x1f\x0b\xf7\x36\x2e\x4e\xc0\xae\x73\x63\xce\xea\x60\x21\xcd\x89\x80\x8c\x41\x2c\x05\x13\x9f\x7d\x48\x3d\x9f\x2c\x1b\x76\xc8\xfd\xe5\xf2\x6c\xd2\x85\x67\xcb\xea\xc6\x80\x9d\x3f\xac\xc0\xf2\x58\x3f\x48\xc1\xfe\x09\xd7\x4f\x6e\xdb\xf2\xf1\x4c\x93\xfe\x59\xa6\x3e\x0b\x6f\x93\x43\x9b\x03\xf9\xab\x74\x01\x48\xaf\x1e\x78\xb2\x69\x11\xcc\xd4\xed\x42\xa0\xf0\x99\xd2\x89\x22\x81\xbb\x4c\xc1\x6f\x11\x4e\x7c\xaf\x3b\xe2\xcd\xb2\x45\x57\x99\xb6\xba\x0b\x63\xc2\xb8\x28\xa4\x3e\x2a\xd7\xba\x9f\x7f\x57\x11\x63\x8c\x18\x5f\x94\x62\x20\x77\x09\x53\xb8\xd1\xa1\x5d\xa7\x11\xde\x52\xf6\x22\xd3\x34\xd3\x13\x13\xae\x41\x59\x90\x4b\x1c\x49\x0b\x92\xa0\xb8\x5f\x2c\xad\x1e\x68\x4b\x33\x15\xf7\xdc\xc7\xd3\xbd\x85\x62\xcf\x13\x5d\x7a\x8b\x32\xe2\x49\xfd\x83\x51\x9f\x89\x70\x54\x61\x95\xeb\x0c\xcd\xd6\x1c\x54\x62\xad\xbc\xe5\x56\x7d\x99\xe7\x98\x0c\x66\x18\x2c\x45\x33\xc0\xfd\xb6\x68\x87\x51\x3b\x40\x0f\x61\xf2\x12\x64\xfa\x53\xaf\x22\x3d\xcb\x62\x50\x3e\x24\x1b\xb8\x49\x41\x38\xc3\x60\xd9\x97\x59\x17\x07\x45\xf7\xc2\x8f\x07\xb7\xcc\xed\xda\xb4\xfb\x48\x09\x10\x81\xf5\x97\xc1\xc9\x09\x47\x00\x5e\x42\x71\xf5\x1c\xb2\x9b\xea\xd2\xd0\x78\x84\x55\x86\xb4\xbb\xcb\x68\xe5\xd4\x3f\x3d\x62\x4f\x45\x8c\xed\xdb\x15\x0a\xaf\x92\x35\xcb\x75\x7a\xb9\xf7\xe8\xf7\xa7\x1a\x9b\xf7\x1f\xc2\xe1\x5d\xc6\x70\x75\xe1\xe1\x29\x39\xa5\xe9\xdd\xba\xd7\xe0\xb0\x3a\x11\xda\x19\xc1\x69\x0c\x88\x5f\xf8\xef\xdc\x9f\xbd\x7e\x12\x8e\xba\xd4\xc5\x56\x0f\x57\xfb\xa9\xc4\xb9\x32\x38\xae\x55\x75\x0f\x75\xdc\xf8\x7c\x56\x07\x1f\x04\x50\xac\xcf\x47\x2b\x40\x77\x3f\x9e\xc2\x5c\x84\xbe\xee\xc7\x57\xef\x2c\x56\xe4\xa8\x21\x17\x3e\x17\x16\xe9\x73\x48\xa0\xf5\xb1\xde\xe6\x51\x6a\x9e\x7a\xa8\x40\xca\x9f\xb7\x25\x07\x36\xa9\x83\x61\x8b\x17\x5e\x65\x69\x7a\x51\x6b\x1e\xa9\xfa\x8f\xc6\xe5\x81\xf5\xe5\xa8\xad\x69\xe7\x0b\x91\x9d\x42\x0e\xe8\x67\x34\x29\xaf\xb9\x07\xcb\x82\x00\xcd\x8f\x46\xf4\x94\x91\x92\x32\x6c\xfa\xab\xeb\x65\xec\x0b\x66\x64\xc2\x60\x7b\x75\x19\xad\x32\xd4\xde\x43\x83\x52\x38\x2a\xb8\x23\xd7\xab\x76\x1c\x75\x26\xaf\xd2\x15\x15\x3e\x93\x9f\xa9\xad\xba\x03\x5b\x41\xd0\xf8\xe9\xd1\xcc\xcc\x34\x5d\x21\x02\xf4\xfe\xe4\x00\x33\x3d\x86\x82\xae\x41\x88\xa5\x46\x41\x77\x0c\xcb\x72\xec\xcc\xa1\xf8\x83\xe5\x39\xde\xca\x63\xcc\x52\xba\x1e\x8a\xb6\x15\x67\x13\x54\x17\x79\x31\x20\x1f\xb6\x21\x91\x12\x31\xd3\xdf\x15\xbe\xd6\xa8\x9a\x73\x38\x0d\x6b\x0d\xcb\x5a\x6d\x2f\xe9\x76\x05\x3f\x9a\xc0\x3b\x97\x5c\x3f\x42\x6b\xff\x66\xb5\xfd\x3b\xd0\xce\xdc\x5f\x3f\xbf\xb6\xe7\x6b\x2a\x68\x38\x08\x4f\xd5\xcd\x69\xa2\x60\x63\xab\x22\x0d\xb6\xbf\xe7\x65\xb4\x64\xe9\x0e\x3d\x8b\x88\x09\x51\x00\x48\x15\x8b\xf4\x1c\x90\xbd\x1b\xef\xdb\xeb\x38\x5a\x10\x40\x6d\xbe\xee\x22\xc7\x26\x46\xa1\x7e\x36\x5f\xc5\x57\x8d\xc8\xea\x89\x5d\x09\x55\x25\x02\x21\x13\x23\x24\x91\x1c\xfb\xc5\xa8\xc9\xb9\x1b\x22\xff\x12\xe0\x4c\xfc\xac\xbf\x47\x8b\xb9\x6e\x41\xae\x32\x69\x29\x22\xc1\x3f\xdc\xdd\x13\x48\x57\xea\x1a\x50\x9f\xd4\x3e\xf0\x0b\xfd\xc2\x1e\x1c\xd5\xeb\x7e\xef\x8a\xcb\xb4\xc5\x4a\x5f\xcc\xe8\xb8\x9b\xd2\x4e\x18\x49\x03\xcb\x86\x87\x05\x57\x0a\xda\x11\xd0\x8e\x7e\x1c\xf9\xaa\xf4\xa0\xd0\x05\x99\x6e\x54\xaa\xf4\x95\x34\x40\xe9\x7e\x81\x4e\x80\x1b\x51\x2e\x1b\xa9\xb2\x1b\x1b\x3a\xd1\xcd\x32\x47\xb3\xee\x7f\xe7\xb3\xde\x33\xb1\x6a\xdc\xda\xc7\x4f\xe6\xb0\x9f\xa8\x74\x38\x51\x87\xf3\x70\x34\xc6\xbf\x77\x93\x40\x21\x6c\x57\x73\x38\x5d\xc0\x24\x4f\xaa\x72\x95\x73\x73\x87\xfe\x32\x76\xe0\x98\xb1\x11\x6f\x8a\xaf\x0c\xcb\xae\xa4\xc2\x2d\x11\x32\xc2\xfe\xcf\x58\x38\xfb\x9d\x2f\x6b\x1a\xad\xaa\x9b\x25\x7b\x4d\x7c\x66\x1f\xd0\x10\xd4\xd6\xf1\x6b\x36\x34\x9a\x9b\x85\x10\x73\xc3\x0a\xf0\x3f\x56\x6e\x85\xd6\x7e\xac\x40\x38\x37\xcb\xd8\xfb\xfb\xbe\x1e\x51\x40\xfc\x9d\x53\x40\x8a\x79\xc8\x50\xf2\xd5\x38\xd4\x74\xda\x74\x5b\x03\x11\xa4\x3e\x50\x09\x05\xfb\x08\xb4\x31\xad\x43\x7b\x52\x9f\xbe\xf0\xdf\x61\xf6\xc8\x97\xe3\xa3\x3b\xad\xfa\x13\x68\x68\xf8\x2b\xe7\x55\x91\x05\x55\x54\xa5\x66\x4a\xaf\x51\x33\x9a\x68\xad\x56\xe9\xfe\xa3\xaa\xf9\x3a\x1a\xf4\xf4\x7b\x1f\xf4\x5a\x87\x06\x22\xf3\xc4\x58\x01\x38\x1b\x6b\x07\x2f\xad\x09\xea\x60\x78\xd5\xac\x7b\x51\xe3\xaf\xf1\x41\xb2\x57\x4c\x97\xc7\xcd\x2e\x81\xe1\x95\x9f\x27\x17\xdc\x89\x56\x21\x70\xfc\xce\x9d\x10\xc6\x35\xd0\xa0\xe2\xab\x36\xa9\x9a\x40\xe0\x56\xd6\x5c\x03\x7f\x61\x65\x61\x5d\x81\x73\x0a\x53\x18\x71\xcc\x21\x57\x53\xa1\xa5\x1a\xe4\xa5\x07\x0c\x73\x30\xa6\xce\xdd\x33\xea\x66\x25\x13\x8e\x65\xfa\x60\x32\x12\x20\x02\x80\x37\xbc\xa5\xa8\x21\x42\x32\x79\x45\xa0\x04\x42\x1d\xa7\x73\x36\x54\x20\xee\x41\x3c\xc7\xc4\xc7\x6a\xea\x60\x8c\xe1\xa0\x3b\x73\x54\xc4\xa8\x1a\x06\x8b\x21\x84\xb9\x32\xa1\x14\xe3\x8c\x00\x1c\x00\x1c\x06\x19\x46\x95\x98\x8b\xe6\x7c\x78\x1c\xe4\xfd\x91\x21\xd7\x37\x82\xbc\x6f\x45\x5c\x1f\x10\xee\xef\x3e\x65\xc3\x1a\x93\xf6\x9a\xb1\x1d\xde\x61\x70\xd9\x04\x05\xf3\x79\xd4\x82\xc3\xc9\x2d\xc9\x00\x80\xae\x32\x81\x7e\x5c\x2d\xe1\x7e\x2f\x9e\x75\x62\xb8\x80\xbf\x48\x5b\x7a\x87\x8f\xa4\xa1\x35\x2f\xe4\x0b\xf3\x08\x36\x60\x41\x31\x69\x81\x14\x50\x03\x39\x2d\xcc\x16\x66\xcf\x7b\x4f\x5a\xea\x6e\x1d\x0f\x21\x8f\xe5\x75\xe6\x96\xe1\x97\xf6\x35\x2f\xe3\xfe\xa3\xda\x9c\xe3\xaa\x8e\xc1\x93\x5b\x1c\x34\xbd\x23\xbf\xc6\xaa\xbb\xc1\x7c\xd2\xac\x01\x75\x6d\xd0\xfd\x5a\xee\x63\x34\xe0\x45\x8c\x14\x32\x98\x6e\x15\x2e\xbb\x2f\x9d\xb5\x0b\xb7\xde\xb6\xac\x60\x0d\x86\xcb\x5c\xdf\xd4\x4f\x18\xaa\x99\x27\x99\x4e\x11\x00\xf0\x00\x7a\x3a\xd2\xe1\xc9\x5f\x4f\x78\xfc\x96\xe9\x92\xd7\x51\xb1\x7c\xc0\x6a\x5c\xec\x1b\x67\x6d\xf7\xed\xa1\x39\xa6\x33\x79\x11\xf9\x9c\x03\x7d\x1f\x64\xfb\x86\x5b\x94\x34\x95\x0f\x5e\x37\x15\x19\xf0\x61\x52\x61\x66\x8c\x37\x6a\x5b\x46\xad\xfc\x68\x7d\xd4\xdf\xfb\x1e\xfb\x55\xe6\x4d\x28\x72\xba\x56\xe1\x13\x0d\xa4\xe7\x8e\x15\xd4\x61\x58\xe4\x9f\xb9\x5f\xdc\x2b\xd8\xb1\x7e\xee\x18\x4b\xf1\x3a\xaa\x59\x2b\xbc\x5b\x52\x2a\xca\xd8\x12\x6e\x59\x55\xf7\x95\x58\x1d\xcf\x0a\xf1\xfd\x9b\x86\xd7\x7d\x57\xb4\x27\xd7\x65\x8e\xa8\xf0\x6f\xd9\x3b\x3e\x09\x15\x56\x3f\x94\x5a\x67\x37\xd1\xff\x27\xdf\x23\xaf\xe0\xe9\x26\xe4\x56\xcb\x73\x55\xa2\xa9\xb4\xc4\x4f\x48\x16\x1c\x6e\x5a\x7c\x51\xb1\xd6\x6b\x92\xcc\x9a\xb7\x7e\x2e\xd8\x20\x45\xc0\x6c\x42\x06\xad\x7c\x1c\x39\xb4\xe9\xff\x7f\xe4\x70\x52\x29\x7d\xc8\x6c\x76\x1a\xe6\x54\xcb\x20\xff\xe1\x30\x48\xeb\xdd\x0c\x07\xf4\x0c\x5f\x17\xe4\x6f\xad\x40\xf9\xa9\x60\x45\xa2\x26\x57\xc6\xa9\x94\xfd\x4b\x72\x3c\x9c\x88\x22\xd2\xdf\x3a\x9a\x8b\xae\x0e\x15\xe9\x33\x55\x3f\x27\x11\x41\xa2\xf4\xcc\x31\x3b\x91\xb7\x49\x57\xf1\x28\x0b\x93\xd8\xdc\x53\x4a\xc7\xda\x68\xff\x02\xff\x77\xa8\x13\xa5\xae\xb5\x9c\x7d\x60\x51\x98\x16\x28\x8a\xad\x1d\xa3\x36\x64\x64\x62\xa7\x85\xff\x1e\xf5\x66\x55\xf3\x05\x62\xd1\x34\xf6\x67\xb4\x0e\xf5\xec\x62\x01\xee\x0e\x59\x3d\xe7\xd6\xe7\xa3\x15\x30\xa0\x18\x38\x55\x10\xe4\x23\x9f\xd1\x67\xb4\xf8\x38\xb3\xe5\x91\x79\x0e\xdd\xd5\xde\xa2\x80\x56\x6b\xc0\x3e\x47\xaa\xea\xb6\x2a\xd4\x2a\x5c\x0d\xb3\x9b\x23\xf8\x3c\x69\xf2\x41\xbe\x2d\xf9\xce\x99\xad\x7d\xa3\xf5\x33\x11\x6f\xa2\xb9\x3b\x39\xb7\xd0\x12\x6b\x48\xcf\xb9\xf5\x38\xe5\x56\x8b\x1f\x9b\x3c\x11\xad\x40\x23\xfc\x9d\xcc\x72\xeb\x80\xdc\xcb\x2c\xd7\xdb\x79\x27\xb3\x1c\x46\x2b\x1d\x25\x4d\x3c\xe8\x4c\xda\xd3\x9a\x7b\x37\x5a\x3b\x31\x0b\x34\x7a\x51\x99\x4c\x1e\xa7\xbb\xe2\x1b\xa1\x2a\x48\xff\xcd\x38\x7c\x75\x12\x45\x5b\x4f\x89\xfe\x9d\x60\xd5\xe1\x5a\xd9\x21\xaf\x90\xa2\x0b\xa9\x8f\xe0\x35\x5e\x86\x46\x44\x2e\xda\xc2\xa2\x3f\x7b\xa6\x53\xfa\x38\x7a\xcc\x7b\xbb\x9f\x7f\x41\x40\x49\xb8\xe9\x88\xc1\x0d\x75\x9a\xd1\xcf\x86\xc4\x9b\xc8\x9b\x0d\xd7\xf7\x5b\xc7\x53\x3f\x82\xd4\xa5\x0a\xba\x32\xf0\x2a\x75\xc6\x4b\xf2\x9f\x55\x42\x7b\x2b\x4d\xae\x53\xb7\x77\xd2\x12\xa7\x8e\xb0\xd3\xe3\xb0\x95\xf0\xcc\x64\xa8\x07\xc9\x34\x37\x26\xd4\xee\xdf\x1b\x2c\x05\xf1\x40\xbf\x33\x7a\xc2\x6d\x67\x2f\x4f\x50\xe8\x5e\xf8\xb3\x75\xcc\xea\x38\x39\x73\x2e\x9e\xcc\x45\x59\xe1\x23\xa7\x63\x2a\x2b\xab\x19\x0c\xaf\x52\x61\xfb\x1e\x9f\x7c\xbf\x21\xb9\x1a\x11\x05\xa3\xc8\xef\x43\x1c\xc3\xa7\xa5\x8e\xbb\xc3\x2e\x83\xfd\x3b\x67\x51\x8a\xf0\x0c\x4d\xdd\xfd\x44\xe0\xa4\xbe\x53\xb7\xc6\x68\xfb\x27\x94\x85\x33\x15\xab\x13\x92\x67\xa0\x88\x1a\x0f\x02\x59\xd6\x50\xd6\xa3\x22\x45\xd3\x97\x96\x4e\x25\x5e\x23\x05\xb4\x02\x7d\x1f\x2c\x66\x35\x0d\x24\xb6\xde\xaf\x5b\xf7\x28\xcc\x5b\x77\x6d\xca\xba\x95\x9d\xa0\x7b\x06\x7f\xe5\xe9\x1f\x12\xe1\x1c\xe2\xe9\x6c\xef\x00\x4b\x4a\xd7\x5f\x47\xe0\x70\x57\x19\x54\x80\xa0\x28\x38\x80\xcb\xe0\xd0\x34\x32\x04\x86\x28\x00\xcd\x1d\x39\xf1\x1b\xdc\xc4\xf0\x02\xcb\x7b\xde\x43\xa6\x0a\x64\xd8\x2b\xc8\x97\x5f\xa0\x73\x30\x6d\x2e\x94\xc1\x78\x1d\x38\x61\xb3\x1e\x64\x5c\xb9\x5e\x5d\xbd\xa2\x82\x46\xc9\x04\xcf\xf8\x2b\x22\x0a\xa2\x0d\x75\x5b\x54\x1c\xa8\x04\xb0\x12\x77\xa0\x93\xe8\xb9\xcd\xee\x2a\x42\x1e\x4e\x52\x51\x17\xe3\x0d\x22\x9c\xf8\xd8\x8a\x62\x75\xa0\xbf\x7b\x6d\x22\x20\x76\x07\x1b\x88\x0c\x42\x58\x90\x33\x53\xc6\x6b\x10\x3e\xd5\xcf\xd4\xf6\xd5\x53\x22\x48\x6d\x93\xa1\x3d\xf9\x21\x0c\x14\x3f\x18\xcb\x61\x34\x4a\xec\x3d\x75\xb9\xa0\x0d\xd4\x5b\x0a\xcd\x46\x4f\x8d\x02\x6b\x1d\xdc\x61\x5c\x5e\x3d\x95\x39\xce\x50\xb6\x8f\xc2\x1c\xb8\x66\xc3\x89\x8a\x3d\x11\x3e\x13\xd8\x04\xd9\x8c\x82\x21\x7c\x32\x54\x84\x9c\xf6\x6f\xdf\xc1\xfb\xa7\x31\x55\x04\x50\xe9\x08\x47\xc7\xed\x39\x0f\x18\x0c\xe2\xf6\xcd\x0a\xd9\x17\xc3\x89\xb0\xf2\xb2\xe6\x06\xc5\x15\x05\xaa\xa8\x0f\xbd\xbf\xea\x39\x6b\x89\xe2\x34\xf1\x3e\x0e\xb1\x2b\xed\xec\xe5\x30\x22\x49\x7d\xb4\xcc\xf0\x16\x22\x1a\x2d\x65\xfd\x83\x79\x97\x12\x70\x0b\x7f\x3a\xc4\xdc\x73\x53\x58\xdd\xda\x32\x43\x7a\xe0\x09\x1c\x68\x28\x66\x70\x59\xd0\x9a\x15\x32\x15\x3e\xf2\x7a\x5e\x04\x4f\xd8\x43\x95\x8a\xbc\x6e\x71\x8c\x40\x51\x9c\xc2\xae\x1d\xb5\x84\x81\x20\xf7\xdc\x2f\xf9\x3a\xb7\xc1\x49\x41\x20\x29\x2b\x69\x48\xe0\x44\xdc\xfc\xd3\x98\x99\x70\x92\xf8\xb6\xc8\xc3\xc3\x28\x1c\xda\x6e\x17\x82\x5c\x9a\x24\xad\x31\x09\x13\x95\x32\x4c\x65\x99\x34\x28\x01\x70\xbf\x44\x52\xb3\xc3\xcf\x11\x49\x53\x46\x0b\xbe\x9b\x30\xb8\x78\xba\x89\x13\x5f\x52\x96\xef\xc2\xd8\xbb\x54\x4f\x48\xc2\x51\x60\x5f\x8a\x20\xb8\x21\xd3\x42\x9a\x78\x3a\x9a\x02\xb6\x20\xbf\x02\x16\x35\x62\xb9\xd0\x3a\x90\xde\x09\x42\x96\x1b\x2a\x62\xfc\x16\x9a\xae\x10\x23\xb8\x30\x9a\x31\xc2\x0a\x05\xda\x0d\x79\x41\x72\x02\xe4\xce\xa1\x57\x7e\xc0\xc1\x2a\xc2\x50\xd1\xc8\x5f\xc8\x43\x13\x5c\x3d\x2b\x4d\xb2\xec\x59\xe8\xa7\x0d\x03\x4f\xb1\x47\x14\xd3\x93\x12\xa3\x11\xa7\xba\x57\x5b\xd9\x3c\x32\x22\x46\x7a\x9f\xc0\x94\x92\x2c\xbc\x09\xae\x94\x32\xeb\x3a\x25\x95\x56\xad\xca\x7a\x9b\x1b\x68\x58\x42\x21\x4e\x31\x24\xd3\xac\xd1\xaf\x55\x58\x47\xf2\xb9\xc1\xf5\x05\xcc\xc9\x86\x49\xea\x44\xa0\x50\x1c\xe4\x85\x67\x26\x99\xcf\xc2\x35\x1e\x29\xe8\xb9\x53\xc1\xc1\x32\xe8\x6c\x67\x64\x9a\xf0\x43\x61\xe4\x63\xe3\x08\x56\x2e\x5e\x00\x54\x32\x13\x12\x61\x36\xf3\xc4\x0c\x35\x07\x62\xad\x34\xb3\x9f\xdf\x32\xdd\x0e\x0b\x8b\x71\xa5\x08\xdb\x4f\x7a\xfe\x22\x06\x56\xd9\x0e\xa7\x67\x1a\x6f\x33\xc4\x32\x2a\x27\x3c\x59\x86\x24\xc5\x99\x55\x60\x50\x06\xba\x61\xc4\x81\x92\xbb\xda\x69\xe9\x06\x89\x02\xa1\x63\x7d\xae\x17\x51\xe3\x69\xa3\x57\x10\xbe\xa8\x4c\x18\xfe\x8e\x78\xa6\x33\xde\x16\x39\x0b\x60\xe0\x64\x6a\x0a\x10\x1e\x83\x36\x27\x0e\x94\xc6\x6d\xd5\x05\xde\x54\x2e\x65\x5d\x68\xc8\x22\xc6\xf9\x45\x00\x9e\x0a\x3f\x20\xbf\xc8\x29\xc2\x90\x2d\xd4\x97\xd7\xd2\xa3\x6d\x18\xe9\x39\x2a\x80\x70\x4c\x3d\x56\xf9\x84\xe8\x43\xb5\x5c\xd2\x82\xe0\x18\xb7\x6c\xd2\x4b\x3b\x89\x60\xc7\xdc\x38\x90\xf2\x14\xf5\x54\x96\x3b\x62\xed\x62\xd4\x50\x3b\x92\xaf\x5f\xa3\x73\x18\xf3\xa3\x51\xbc\xf4\xd3\x35\xaa\x22\x9e\xe9\x89\xfb\xe5\x64\x20\xa9\x32\x5c\x6d\xb1\x95\x47\x3a\xd3\xa8\x30\x64\x1f\x51\x41\x47\x23\xc4\x18\xbb\xa8\x2e\x8c\x42\xf0\x13\x4f\x5c\xa7\x74\x86\xec\x45\x49\x71\x97\x21\xa3\x0e\x7a\x9a\x12\x16\x9e\x7a\x91\x35\xe4\x27\x99\x68\x23\x54\x03\xeb\x3e\x5a\xc7\x50\x45\xcc\x28\x99\x4a\x0c\xc5\x66\x07\xe2\xdc\xb7\x69\x21\x44\x2e\x76\xa2\xa8\xdb\x58\x5b\xc8\x88\x9e\x18\x06\xcd\xc9\xc2\x4a\x65\x64\x46\xc2\x94\x36\xad\x7a\x94\x26\x16\x15\xc8\x41\xf2\xf4\x53\xac\xd2\x03\xcb\x4b\xa5\x2d\x86\xa1\x79\xe1\xca\xa2\x28\xc7\xbe\x66\xa7\x61\x93\x58\x22\x47\x84\x7d\x61\xed\x17\x89\xfa\xc4\xdc\x72\x2e\x2b\x07\xcd\x2e\x44\x5a\xd1\x17\x67\x26\x72\xdf\xb7\x18\x84\x55\x83\x2a\xe4\x27\xfa\xf5\x11\x18\x0d\x0d\x25\xda\xae\x10\xdd\x5c\x15\x30\xb9\xda\x8b\xe3\x5a\xc5\xb8\xaf\x78\xec\x5f\x03\x4d\xae\x4d\xb0\x3f\xfc\x5a\x82\x05\xf8\x9a\xbe\xc5\xca\x5a\xaf\xbf\xe9\x27\x7b\x85\x8c\x87\x5d\xfa\x2f\x9b\x5a\x75\x0d\x22\x5a\x7c\xe9\xef\xee\xea\xb3\x4f\xe8\x28\x78\x06\x01\xd6\x2a\x3b\xb7\x6f\xea\xbe\x4f\x82\xc3\x51\xcd\x06\x9e\x88\x9b\x5d\x88\xcd\xe9\x2c\xae\x0a\x3b\x5a\xc5\xa1\xc5\xd0\x4f\x1c\x29\xb2\x45\xf3\x02\xbf\xf2\xc4\x33\x1a\x3e\x35\xb5\xe9\x20\x7c\xc8\xd1\xc2\xae\x01\xa4\x7a\xb0\x62\x3d\x4d\x83\x46\x8c\x23\x50\x47\x18\xf3\x4d\x31\xe1\xec\x08\x82\x7b\x0f\xfa\x44\xe6\x52\xa8\x05\x7b\x75\x71\x8c\xee\xad\x7e\xd1\xd8\xae\xea\x28\xb9\x33\xa9\xa0\x50\xb1\x46\x4b\xaa\x2a\x39\x18\xb6\x30\x9e\xf3\x31\xdf\xe0\xf2\x93\x2a\xf8\x1b\x15\xa6\xf8\xa4\x91\x02\xd2\xca\x3e\xae\xf0\x9e\x38\xf1\xec\x64\x3c\x6d\x86\x00\xd1\x84\x6f\x89\xc2\xa7\x78\xc0\x97\x83\xd0\xb3\xf9\xd6\xe2\x3f\xce\x28\x3d\x2f\x38\xf9\x7c\x9f\x59\xcd\xa5\x5a\x9a\xe2\x72\xd7\x75\x04\x0b\x36\x5e\x08\x5d\x8d\x84\x54\x90\x10\x4e\xea\x27\xce\x13\x39\xe8\x49\x94\xed\xf2\x84\xcd\x0a\xfb\x55\xc5\x46\x4f\x55\xaa\xc6\x35\xc8\x50\x4f\x8b\xca\x2a\x07\xaa\x3e\xd9\xe5\xba\x2e\x09\x89\x77\x45\xc4\x88\x75\xc6\xda\xa4\x3b\x95\xa3\x3c\xe2\xb0\x02\x07\x63\x27\xa2\x62\xf0\x1a\xcc\xb3\x45\x25\x2f\x9a\x06\xd0\x11\xac\xd7\x23\x27\xb4\x29\x82\x6e\x92\xde\x8b\x28\xef\x94\x95\x83\xe3\x8c\xcf\x10\x85\xbc\x7b\x92\xc6\x09\xd3\x89\x68\xe7\x32\xcd\x84\x4c\x00\xa3\x8e\x0d\x1a\x63\xa5\x8e\xc2\xcd\xdb\x21\x23\x6b\xe7\xa4\x0a\xb7\xee\x68\xe2\xcc\xc1\x03\x7b\x14\xb6\x46\x0c\xb4\x67\xfa\xe8\x66\x47\xf2\xa2\xe8\x04\xe8\xf0\x8c\xe4\x47\xf0\x27\x41\xba\x39\x84\x69\x63\x78\x17\xe8\xe9\x95\xbd\xbc\x45\x12\x49\x5a\xc6\xed\x99\xfc\x0a\x92\x70\x20\x2f\x78\xfc\x28\x72\x76\x94\x25\x07\x1e\xb3\x13\xad\x1f\x1a\xec\x18\x89\x9e\x4e\xc4\x67\xa7\xa7\x19\xbe\x39\x33\x65\x22\xcd\xd2\x1a\xc8\xd7\x6d\xca\x9a\x9e\x4f\x99\x77\xe5\xc5\x6e\xae\xb9\x61\x5b\x74\x9f\x88\x80\xe1\x70\xe3\x66\x78\xe4\x64\xe4\x80\x8a\x6f\x26\xd8\xae\xc9\xe1\x02\x44\xfa\x14\x72\x98\x39\x9f\x88\xa2\x3e\x20\x22\x92\x0d\xad\x16\x9e\x80\xe7\xe0\x78\x33\xe5\x4d\xa9\x9f\xfd\x55\x40\x09\x45\x3c\x47\xb1\x27\x50\x81\x34\x0e\xf8\xc3\x0a\xf9\x8d\x18\x7e\x1e\x78\x19\x2e\xe4\x89\x88\x2b\x21\x02\xab\xe8\x75\xb6\x6c\x91\x2b\x02\x12\x59\x44\xe1\x80\x8b\x07\xb6\xbb\x70\x29\x85\xca\xdb\xee\x49\xd5\x15\x0b\x5d\xaf\x40\x8f\x2d\x2f\x1c\x0b\xf3\x55\xf8\xd5\x91\xd2\xf4\x03\x9b\x6e\x61\x04\x09\x98\x76\x62\x55\x2f\x8c\x9b\x7e\x01\x15\x79\x64\x54\xa0\x86\xc1\x14\x0c\x5d\xbf\x70\x30\xd6\xab\xd9\xdb\x34\x95\xa7\xcc\x53\x12\x9a\x33\xe1\x5d\x8c\x4d\x42\x50\x7a\x9b\x03\xfc\xcc\x82\x4f\x3d\xae\x05\xeb\x2e\x68\xae\x4d\x14\x66\xdc\x9d\x1a\x2a\x78\x4e\x0c\x86\xa3\x2c\x4b\x2f\xd1\xb8\x96\xa8\x6a\x3a\xcb\x6d\x11\xee\x11\x2a\xd2\x20\x1b\x52\x38\x04\x4c\x4c\x5c\x75\x5c\xe0\xb8\x9a\x6a\x47\x60\x76\x23\x1f\xa0\x17\x23\x64\x59\xf2\x73\xcd\xb4\x34\x64\x1a\x7d\x3b\xf3\x75\x46\x72\x60\xd3\x0e\x67\xf9\x88\x7e\x52\x55\x62\x96\xae\x1f\x8d\x51\x9f\xb9\x35\x1b\xbf\x1b\xec\x45\xa7\x3c\xa7\xf4\x0a\x9d\x62\x6f\xa5\xb3\x67\xe9\x39\xc4\x1c\x0f\x8a\x96\xa1\x58\x30\x34\xf1\xc0\x93\x40\x58\x8d\x6b\xe5\xef\xa3\xa0\x8f\xc4\x14\xaf\x87\x71\x0b\x74\x4f\x77\x17\x69\x67\x6f\x99\x4f\xa6\xf3\xd1\xe3\x17\xb5\xe3\xb0\x0a\x13\x97\x0d\xea\xfa\x86\xe9\x64\xf8\xc8\x60\x8a\x92\x14\x6d\xd2\xa0\x5d\x3b\x4a\x93\xbb\x48\x71\x11\xee\x3f\x4a\xf7\x1f\x95\xfb\x8f\xda\xfd\x47\xab\x36\xe0\x76\x33\xee\x3c\x4a\xfa\x68\xb8\xd7\x8e\x3b\xaf\xb5\xfb\x8f\x5c\x8f\x67\xbf\xdd\x8e\x3b\x8f\xd2\xfd\x47\xe5\xfe\xa3\x76\xf7\xd1\x7d\x2a\x77\x6b\xd4\x89\xa3\xf7\x68\x4e\xb3\x2a\xa0\xc3\xdd\xb8\xb7\xf5\xf9\x68\xca\xf6\x35\xe6\x6b\x17\x92\xa4\x71\x18\x6d\x06\xa4\x13\xce\xc7\x4b\xdb\xda\x47\x07\xe4\xc8\xd6\xdb\xe6\xdc\x41\xc0\xa2\xdb\xad\x9f\x2b\x65\x3d\x92\x69\x7b\xb5\x27\x77\x77\x72\xb8\x80\x80\x2d\xf1\xd0\xe1\xb5\x87\x61\xb9\x07\x8d\xd0\x54\x6d\x01\x32\xd6\x3b\x8e\x93\x67\x13\x91\x28\xa5\xba\xee\xc1\x3e\x3b\x15\x52\xfd\x13\xb2\x43\xdc\xef\xed\xc1\xcc\xba\x5d\xd2\xbc\x1e\xb4\xf6\xe6\x7c\x39\xa9\x81\x39\x80\x54\x1b\x76\x19\x13\x5f\x9d\x41\xe7\xa8\xfd\x5d\x63\xed\x5e\x6a\x3b\xf5\x96\x94\xfb\xec\x0f\xb7\xbe\xfa\xce\xf5\x6d\x5b\x69\xd8\xc6\x0f\x1e\xd5\x2a\x63\x26\x55\x44\x29\xc3\xd4\xb2\x3a\x77\x43\x19\x50\xe1\x51\x52\x8c\xa9\x4d\x38\x01\x4b\xec\xf6\x4f\x9e\xfe\xfa\x08\x4f\xa4\x28\x4a\xca\x9b\x55\x0f\x54\xd4\x03\x41\x5b\x2b\xa6\x86\xe9\xdc\x53\x37\xa2\xdd\xf2\x4f\x39\x93\x4d\x5d\x9c\xf2\x81\x0b\xf9\x42\x24\x36\x1d\x69\x1e\x65\x37\x27\x8b\x14\x54\x00\x21\xf2\x8f\x4b\x54\xee\x5c\x98\x73\x74\x05\xff\xd2\xce\xb3\xc2\x8a\x84\xae\x65\xb6\xa0\x4c\x28\x7f\x34\x71\x0b\xd4\x42\x32\x3c\x75\xf1\x06\x23\x44\x2d\xa2\x97\xe1\xc3\x08\x3e\x1d\xa6\x4b\x97\x55\xb5\x0f\x22\x8e\xce\x82\xde\x46\xe4\x0e\x1a\x2f\xc2\xde\xb6\x10\x3b\x3c\x43\x8c\x9c\xfc\x77\x54\xf3\x2e\x3c\xd9\x0d\xa5\xc2\xd3\x04\xc9\x29\x18\x58\x67\xaf\x5b\x1c\x5d\x9b\xd7\x0f\xd8\x27\x8f\xea\x4d\x2b\xa8\x68\x34\xe8\xbc\x98\x1f\x18\xf7\xdd\x16\x12\xd6\x0d\x93\xcb\xd8\x8d\x95\x33\x76\x8b\xce\xe8\x58\x38\x2d\x27\x85\x9e\x0c\xc8\xb6\xc6\x02\xfd\xf9\x0d\x03\x1f\xc3\xd6\xe2\xe4\x84\x6c\x2b\xb6\x2a\x79\xab\xd5\x38\xaf\xd5\x1e\x58\x47\x19\xf0\x06\xa5\x78\xa8\xb2\xb5\x31\x92\x57\xfd\xa3\x1f\xe0\x18\xa4\xd4\xcd\x5d\xe6\x36\xa1\x2d\x59\xac\x65\x73\x6f\xb1\x6e\x90\xec\x86\x52\xda\xe1\xda\x5f\x65\xdd\xac\x4b\x15\x96\xdd\xd2\x71\xfe\x1c\x0d\xaf\x22\xfe\x30\x69\x59\xf6\x4f\xb5\xc9\xb8\x23\xa5\x08\xe3\x54\xaa\x7e\x9d\xd4\x6f\xc1\xcc\xc8\xf0\x08\x95\x17\x98\x1c\x02\x49\x69\xd2\xfa\xa4\x6c\xfa\xd3\x98\x43\x98\x21\x86\xe0\x53\x29\xa4\x83\x49\x5c\xee\xbb\xf8\x4c\xdd\x5b\xfc\xbe\x17\xca\x72\xc7\xf5\xc4\xf6\x99\x6e\xb1\x7a\x5d\x05\x6e\x45\x35\xa4\xe3\x32\x24\xfc\x89\x52\xa5\x86\x53\x83\xf3\x76\xb2\x71\xb8\x0b\x19\x64\xad\xcb\xf6\x35\xb7\xee\x6f\x8f\x44\x0e\x57\x2e\x81\x28\xb6\x0c\xb4\x3b\x7c\xd8\xbb\x1b\x3f\x8d\xe5\xf7\xcf\xa2\x27\x66\xde\x35\x10\x6c\x4d\xe2\xb3\x56\x5d\x8f\xf7\x91\x2e\x09\x79\xdd\x95\xd1\x6d\x50\xc0\xa4\x79\x81\x1f\xb7\x09\x72\xd3\x9a\x50\x13\x5b\x73\x6f\x8f\x45\x0c\x38\xcd\x0a\x9b\x34\xb3\x94\x82\x58\x8e\xa5\xb7\x8e\x58\x96\xc5\x5a\x7d\xd4\xcc\xd5\x43\x94\x6c\xd7\xc7\x2c\xd2\x3c\x7d\x8e\x5d\x04\x5f\x79\xf7\x75\xed\x3b\xb7\xd9\x42\x1e\x6a\x8e\xc6\x43\x79\xeb\x74\x62\x6b\xfa\x32\x33\xad\xc0\xb8\x96\x59\xb5\x1c\x1b\x0e\x40\xe7\xa4\x22\x2d\xe3\x8e\x28\xa9\x10\xb3\x36\xf9\x75\x5a\xcf\xb7\x8e\x74\xd2\xeb\xd4\x2a\x95\x6f\x93\x1f\x25\x7f\xfd\x9d\xfb\x73\xd5\xa5\xae\x2f\xae\x68\x26\x14\xe6\x62\x63\x96\x68\x47\x10\xcb\x3b\x40\x05\x47\x83\xb1\x25\x63\xca\x0d\xf2\x03\xec\xa8\x7e\x28\x40\x91\x82\xe5\x9c\xde\xff\x6a\x3f\x94\x8d\xce\x13\xef\x2a\x33\x79\xb8\x2e\xdd\xaa\xcc\x02\x0a\x92\x81\x84\x65\xa3\x84\x26\x72\x58\xa8\xc6\x95\x74\xfe\x6c\x7a\x04\xdb\xe5\x1e\xc0\x76\x5d\xb1\x8e\xd7\xb8\x77\x7d\x51\x6d\x5c\xdf\xb2\xf1\x82\x96\x40\xf7\x2a\x11\x34\xdb\x35\x58\x2a\xec\x9b\x0f\x8f\x86\x6e\xe5\x0d\x9d\x21\x32\x1c\x63\xa3\xbc\x72\x5f\xae\xc7\x7c\xdf\xa4\xf2\x96\xc8\x38\x45\x95\x4e\x23\x50\x70\x98\x7a\x2f\x9f\x4c\x6b\xa8\xec\xd5\xd4\x47\x3b\xca\x89\xfa\x34\xd6\x6d\xff\xa5\x23\x4b\xec\xd6\x34\x9c\x52\xd0\x16\x17\x5a\xb7\x42\x72\x60\x9c\xce\x50\xd2\xc2\x0a\x3a\xd0\xb2\xac\xf6\x41\x0b\xa6\xca\x14\x8d\xa6\x99\x2d\x48\x9a\xeb\x0c\xfb\x66\x49\xca\x07\x42\x8f\xa4\x66\x7b\x0d\xd9\x8b\x43\x66\x22\x47\xa7\x7f\x30\xb3\x9b\x63\x3a\x3c\x53\x17\x64\xa5\xe2\x98\x17\x5a\x32\xb1\x01\xba\xac\xf4\x7c\xe0\xe1\xec\x7c\x58\x1d\x1d\xc3\x7a\x62\xb3\x8b\xaa\xb1\xc5\xc8\x29\x75\xcc\x74\x68\x0a\xe5\x64\x99\xeb\x1c\x35\x8c\xb4\x20\x67\x33\x8e\xcd\xaa\xa0\x02\xfa\x19\xf5\xa4\xe1\xc9\x15\x58\xa0\xa7\x81\x18\x97\xd1\x6c\x38\x94\xb5\x42\x4f\x5e\x48\x5c\x62\x7a\x20\x50\x03\x17\x0c\x53\x24\x9b\x52\x74\xd1\xd8\x24\xcf\x77\x7c\x9f\x14\x97\xd4\xda\x73\x1a\x99\x87\x90\x40\xb8\xc9\x6c\x78\x1e\x56\x90\x0f\xc9\x32\xc7\x96\xd0\x95\x88\x6a\x4f\xa5\xc7\xa5\xba\x2b\xcc\x1c\xc2\x81\xa7\x6f\xa5\x89\x18\x78\xb7\xb0\x81\x4e\x7d\x47\x01\x38\xa7\xaa\x6e\xb4\x53\xd2\xa7\x99\x7a\xa9\x82\x1d\xa6\x94\xa1\x20\x7f\x3f\x72\x38\xc6\xa1\x12\xb2\x72\xc0\x2e\x00\x9b\x2f\x53\xb1\x06\xaf\x88\x98\x8e\xca\x48\x42\xbd\x00\xe4\x12\x05\x60\xcd\xb6\xbc\x98\xb8\x86\x2e\xd8\x33\xd9\x1f\xb1\x84\xe1\xb3\xce\x61\x50\xb3\xb9\x5a\x1e\x83\x32\xec\x30\x10\x97\x63\x19\xcc\x77\xd6\x19\x2b\xb8\xc5\x3c\xc3\xdf\xaa\x54\xcd\xce\x2b\xd7\x72\x0a\xd0\xa1\x0b\x2d\xc6\xf0\xa5\x34\xaf\x17\x63\x7f\xd6\xcb\x8e\xfd\x65\xa4\x6a\x94\x87\x70\x4d\x90\x2e\xd5\x49\x2f\x46\x5e\xd1\x67\xc8\xca\xca\x57\xf0\xb2\xbe\x1b\xe0\xe8\xc5\xda\x59\x79\xbf\xb0\xfb\xa3\x95\xeb\xaf\x59\x35\x6b\xbd\xf6\xa5\xa3\xae\xa6\x2b\x0d\x2a\x74\x79\x71\xe2\x8e\x67\x34\x86\x63\xb5\x46\xd3\xe3\xcd\xd0\x83\x03\x54\xf6\x29\xf8\x7a\xbf\xa0\xed\xab\x49\x55\x96\x85\x3d\xa1\xd2\x38\xaa\x53\x2c\xfd\x31\x6a\x27\x62\x94\x73\xcc\x50\xcc\xb5\x4b\x9a\x8c\xc8\xa9\x07\xbb\x0d\x53\xd5\x81\xba\x70\x0e\xc0\xf7\x83\x01\xfb\xb4\x70\xc3\x21\x92\x36\xdf\x54\x0c\xef\x30\x30\x0a\x38\x87\x19\xb9\x50\x9d\x08\xed\xf0\xcc\x02\xa0\x56\x10\xea\x1c\x7a\xac\x2d\x71\x26\xfd\x04\x0c\x44\xdb\x47\x8d\x61\xf6\x24\xeb\xc4\xab\x40\x97\x1a\xec\xcd\xb3\xa3\x3d\x1a\x6c\x94\x93\xb1\x4e\xf0\x64\x90\xdd\x1f\x0d\x8a\x80\x1a\x85\xbb\x60\x3a\xd3\x6b\x2a\x4d\x2f\x4e\x62\x92\xde\x0e\x95\x93\x5e\x85\xe5\xe2\xfc\x65\x40\x2e\xfa\x05\xbe\x8a\x86\x75\x9b\x89\xa5\x4f\xce\xbc\xce\x1b\xdd\xdf\xda\xa0\xf3\xb9\x4d\x71\x54\xf7\x12\xf5\x5e\xec\xa4\x20\x47\x82\x50\x07\x29\xc5\xc1\xdc\x41\x8a\x59\xbd\xff\xee\xbd\x43\xae\x4b\xcd\x1b\x3a\x41\x66\x42\x88\xb2\x78\x74\x1e\xa1\xdc\xe2\xbc\xf2\x20\x21\x52\x27\x66\xbb\x4f\x7e\x1b\x8c\x1a\x16\x23\x0f\x7a\x96\x11\xe1\x14\xe0\xdb\x0c\x15\x90\x49\xef\xd8\x62\x5f\x7a\x86\x84\x09\xce\x6c\x09\x40\x01\x8c\xc2\x46\x67\xbe\x5c\x73\x27\xc3\xb9\x53\x26\xf4\xe3\x0e\xd0\xff\x4a\x70\xc8\x4d\x0e\x22\x9c\x47\xa3\xc8\x27\x44\xa0\x1c\x4d\xfb\x06\x7a\x2a\x5c\x28\x20\x5f\x65\x4b\x84\xa2\x0e\xe6\xd4\x4c\xdc\xfb\x2c\xdb\x34\xcf\x03\x8b\x3c\x08\x83\xc6\xc2\xd3\xbb\xdd\x2c\x3b\x04\x4d\x18\x61\xed\x84\x47\x68\x65\x4a\x4b\xd2\x88\xac\x2b\x86\x55\x4e\x2a\x62\x12\x90\x9a\x10\x0a\x01\xf1\x90\x53\x46\x2f\xa1\x22\x02\xf6\x41\xb6\x61\x6d\xa6\xa5\xb1\xb4\x96\x1e\xcc\x44\xf4\xb6\x05\x34\xd8\xb9\x17\xe4\x7a\x6d\xf1\x9c\x3c\xcd\x5a\x00\x85\xd6\x71\xf4\x6a\x47\xb3\x64\xb7\xcd\x63\xbe\x6c\xb0\x6a\x7b\xf2\x0d\xf9\xec\xdd\x00\x58\x5b\x40\x03\x38\x6a\x6f\x84\x59\xd3\x13\xc5\x43\x24\x0a\x36\xfb\xa6\x5c\xc4\x4f\x9a\x96\xbe\xd7\xc4\x94\xc9\xb5\xc8\xbf\x9c\xf6\xac\xbc\x84\xcc\x9d\xfe\x78\xe4\xd8\xd4\x0c\xbf\x03\x10\x04\x50\x3b\x1d\x41\x98\x0c\x14\x2e\x63\x33\xf0\x83\x1d\x0e\xb6\x2a\x03\x1e\x3e\xf3\xce\xf0\x1a\x4c\x3b\xe7\xbb\x18\x19\x0b\xcd\xe5\x20\x92\xac\xea\x5c\xe8\x65\xe1\x32\x14\x8c\x0f\x51\xc1\xa0\xda\x7e\xa1\x0d\x95\x25\xab\x19\xad\x19\xf5\xac\x53\x46\x66\x05\xa8\xbf\x2d\xaa\x4d\xbb\x3a\x9b\x64\xa7\xd0\x2b\xd5\xd6\xba\x4d\x90\x92\x49\x30\xa2\x39\xc1\x74\xce\x4a\x88\x7c\xd0\x3a\x1f\x22\xc4\x95\x98\x0e\x61\x01\xf1\x85\x0a\x62\x9c\x69\x66\x47\xfc\x1b\x4c\xb6\x26\x89\xc0\x4a\xaa\xde\x57\x9e\xc4\x0d\xda\x06\xae\x36\x52\x33\x0c\xf9\xb7\xef\x42\xc1\x11\x9f\x38\x57\x72\x66\x86\x01\x1a\x17\x26\x72\x80\xfe\x84\x5e\x8e\x9e\x9d\x36\xd7\xc4\x48\x64\x08\xf0\x5a\x4a\x06\xc8\xc9\x8a\x4f\x29\x89\x8c\xdc\xc8\x84\x64\xb2\xfd\xc6\xa2\xc7\xe1\x93\x97\x76\xb1\xc3\xdc\x47\xa7\x85\xbe\x8e\x4e\x68\x74\xe2\x92\x10\x82\x8d\xf4\xf8\xaf\xed\x9c\x08\x69\x8d\x03\x10\xf0\xff\xc0\xba\xfc\x4c\x64\x33\xbc\xfa\x44\x69\x17\x01\x3f\xc2\x04\x17\xda\x61\x1d\x81\xbc\x16\xc3\x51\x39\x73\x17\x08\xfa\x17\x63\x7d\x64\xc4\xa2\x6d\x03\x1c\x10\xd0\xc2\x99\xc4\x31\xd1\x4b\xb7\x3a\xfa\x68\x31\x52\xa6\x22\x43\xb7\x4f\x34\x86\x6e\xed\xb7\x9d\x03\x16\x56\x30\xd5\x2a\xe4\xcb\xc6\x46\x36\x83\x3e\x6e\x40\x0b\xa3\x8d\x7f\x62\x10\x53\x60\x10\x13\x88\x98\x7e\xa3\x8a\xd4\x03\xf7\xc6\x78\xc6\x7e\x36\x21\xff\x39\xd2\xfb\x43\x69\x2c\xe4\x92\xa2\x86\x01\xb6\xe2\x09\x71\xad\x88\x49\x3d\x2f\x3a\xb6\xe2\x89\x9a\x41\xe6\xff\x75\x67\xec\x1a\xe7\xb1\x9c\x91\x59\x3d\x75\x5d\xf0\x08\xad\x35\x7e\xe9\x12\xc8\x7f\x49\x4e\x2b\x31\x71\xc9\xd0\xdf\x32\xa9\x9d\x57\xa4\x29\x32\x7b\x60\x9f\x81\xfb\xc9\x15\xa4\x39\x36\x40\x2b\x20\x90\xca\xd3\xec\x8c\x7d\x0e\xf7\xe8\xf5\x81\xa9\xcd\x14\x67\x95\xf7\xc7\x19\xa9\x5b\xa4\x25\x64\xf9\xe2\x29\x6d\xee\x93\x50\x98\xae\x21\xb9\xdf\x80\x5e\xf5\x6a\xf8\xac\x1d\x7a\x96\xce\x7b\x80\xfb\x00\xf6\x21\xf2\x2b\xc3\x68\xa4\x8c\xe3\x50\x69\x7b\x54\x2a\xa1\xb2\x42\x88\xe6\xcc\x7d\xc8\x28\xca\x54\x37\xd8\x3f\x8c\xd6\xd4\x2d\x20\x50\x1e\x54\xa2\x1c\x88\x18\x08\xab\x17\x49\xb5\x93\xaf\x9c\x41\x14\x81\xb2\xea\x5e\x48\xb0\x4e\x3d\x56\xb0\x52\xf8\x37\x9d\x82\xf3\x13\x16\x85\x03\xf3\xa6\xab\x64\xd1\x45\xb3\x2e\x23\xd5\x69\xeb\xda\x5a\xc6\xbe\xdc\x60\x87\xad\x0a\xe4\xd7\xc9\x6a\x36\x32\x33\xb2\x63\x34\x43\x81\xdb\x5a\xd2\xbd\xbf\x93\xe9\x42\xaa\x35\x12\x26\x3d\x1b\x71\x2b\xad\x93\xf0\xb9\x00\x0a\x97\x03\xc7\xd8\x12\x64\xbb\x45\x17\xcc\x62\x0b\x68\xb0\x35\xe5\x88\xea\xa3\x6e\x31\x4a\xbc\xb3\xd1\xb4\x12\xb8\x12\x3b\x66\xf5\xc8\x32\x11\xae\x82\x79\x84\xed\xa7\x66\x18\x11\xb7\x2f\x09\xe6\x49\x5b\x30\x4f\x3a\x0a\xe6\x49\x5b\x30\x4f\x7a\x49\x30\x4f\x5a\xd6\x8b\xdb\xc1\x3c\xc9\x82\x79\x9a\x9c\xab\x19\x11\x84\x1e\x7a\x0d\x1f\xd6\xa8\x58\x8b\xcf\x1d\xb7\x00\x5d\x87\xe4\xde\x28\xf6\x96\x78\x1b\xcf\xc3\x29\x94\xbe\x0c\x7a\x74\xae\x05\xe7\xf6\x56\x68\x23\x34\x32\x77\x7c\x10\x9a\xeb\x82\xff\x9d\x4f\x42\xca\x6e\xa8\x14\xe4\x0b\x5b\x11\xb6\x30\x4e\x0b\xf4\xb4\x38\xcf\xf5\xb9\x3d\xb6\xc0\xca\x61\xe9\x41\x9e\x98\x23\xe0\xdd\xbc\x22\xa4\x89\x73\x7b\xd6\xa9\x59\x03\xa7\xd7\xb8\xe9\xa3\xe8\x4d\x17\xc2\xef\x7c\x02\x64\xf0\x99\x97\x00\x63\x3b\xf8\x92\x4e\x5b\x8c\xed\x60\x11\xbf\x23\x42\x7e\x3d\x75\xc5\xf6\x7c\xd4\xa0\x5f\x1d\x9f\x65\x0d\x93\x96\x11\x3c\x8f\x8a\x39\xf4\x8a\xa0\x33\x5f\x58\xc3\x1a\x95\xdc\x83\x92\xfd\x71\xd0\x59\x88\xbf\xf3\x19\x80\x5a\xb5\xbd\x28\x9e\x0f\x23\xc4\x1d\x64\x8b\x1e\x57\x5a\x5d\x7a\xe8\xf6\x3a\x4b\x6b\x74\x3f\x7e\x16\x0d\xdb\xee\x33\xac\x13\x6c\x31\xdb\x3d\x04\x9d\x15\xbf\x12\x30\xca\x85\x2b\xb4\xbc\xdc\xd1\x14\x34\x92\x26\x22\xa9\x12\xc5\x58\x1e\xac\x5e\xa3\x10\x6a\x5c\x98\xf1\x45\xfd\xd9\x77\x97\xbb\x02\xe3\xee\xbd\x71\xab\xcd\x2e\x63\xd6\x02\xf6\x5c\xdf\xe3\x6b\x52\x9b\x56\xb6\x5e\x6c\x0f\xb7\x37\x7a\x2d\x32\x07\x08\xf2\x46\x7a\x8c\x8d\x87\x50\xd1\x6a\xda\x71\x64\xde\x5c\xf8\x35\x9b\x1a\x9e\x6b\xf1\xa0\x94\x81\xe8\x28\xa3\x24\x2a\x4c\x18\xf8\x66\x9b\x3c\x6b\x51\xbe\x87\x62\x2b\xbd\x64\x87\x55\x7a\x5b\xd4\x4d\xb6\xda\x23\xf0\x2f\xba\xf5\x34\xb7\x9a\x50\x1a\x01\x2d\x03\xb3\x4a\xb5\xb0\x30\x0e\x05\x0b\x8d\x41\x53\x74\xa9\xe4\x56\xc9\x00\x21\x07\x79\xc0\x33\x15\x86\x8d\xa5\x3c\x54\x5f\xe7\x78\xd2\xb5\xab\x99\x9c\x16\xcb\x07\x05\xfe\x5c\xfb\x48\x41\xa1\x0d\x1a\xdc\x15\xd4\x41\x03\xca\x06\x25\x43\x37\x20\x3e\xda\x3a\x4d\x28\x3f\x0b\x02\xd3\x40\x1c\x29\x49\xca\xf3\x8e\x5f\xa7\x5f\xa9\x76\x84\xb4\xa6\x63\xaa\xe9\xe0\x8a\x89\x79\xdc\x63\xb4\x65\x4c\x7d\x51\x14\x98\x03\x4c\x9a\xa3\xf8\x18\x28\xc4\xd1\x35\x24\x16\x6d\x66\x04\x14\xe7\x40\xb1\x23\x50\x1b\x5e\x06\xb2\x5b\x88\x12\xb1\xe8\x10\x0c\x09\x53\x67\x41\x6c\x50\xbf\xe7\x6e\xb9\x06\x53\xa6\x8a\xd0\x60\x92\x24\x5c\x82\x75\x8a\x4c\xc0\x3e\x31\xa0\x10\x96\x46\x06\x32\xaa\xc7\xad\xd3\x16\xd3\xf6\xd6\x8d\x70\x54\x9f\x87\xc1\x00\xa8\x75\x3d\xa1\x85\xa8\x43\xa5\x62\x0a\xc5\x6a\xab\x52\x95\x3d\x55\xec\x6e\xb5\x26\x38\xcd\xd3\xc5\xed\xc5\xc3\x1f\xda\x35\xaa\xf0\x2a\x22\xe2\x32\x16\x2a\x19\xd6\x13\xda\xa1\x58\x0d\x18\x48\xc3\x4c\xb4\x39\x85\x47\xb7\xfa\xac\xd2\x67\x21\x6a\xf0\x90\x12\x2f\xed\x93\x30\xb9\x7f\x30\x11\x1d\x31\xb8\x01\x89\xb1\xd4\xf5\x99\x6d\xeb\x31\xc6\x6c\xac\x67\x1c\x66\x25\x43\x2c\x23\x0f\xad\x83\xae\x20\x6e\x67\x20\x5f\xd8\x11\xd4\x84\x13\xf8\xae\xb3\x67\x5e\xe5\x3c\x3a\xec\x63\x60\x2a\x76\x92\x90\x4f\xdc\x8e\xa3\x36\xdd\x9c\xca\xb4\x65\xa4\x0f\x72\xdc\x1c\x4b\xa7\x44\x0b\xb7\x6d\x6e\x04\xd4\x47\xaa\x1d\x11\xa6\xd2\xb2\x68\x2a\x33\x4f\x26\x76\x20\xaa\xa3\x0e\x25\x27\x58\x2d\xb5\x1a\xc9\x48\x2a\x81\x57\xba\xba\x08\xa7\x2d\xac\x97\x1e\xe9\x9e\x72\xe8\x00\x84\x07\x8c\x75\xb3\xbd\x84\xf4\x89\xd0\xf2\x44\x26\x99\xfc\x3b\x22\x1f\x9c\xda\x7b\xe0\x0c\x2e\x5b\x85\xd4\xd7\x0c\x61\x8e\x01\xf2\xc8\x23\x58\x18\xff\x45\x57\x3c\xcb\x5a\x46\x0d\x0a\x4f\x89\xd4\x3d\x25\x98\xed\x0d\x8d\x82\xff\x30\x26\xb6\xe9\x9a\x25\x21\x69\x20\xd7\xa0\xe1\x85\x50\xf9\x64\x0b\xba\x8d\xab\x2c\xe2\x8f\xf7\xe0\xbc\xe6\xd8\xc4\x8e\x8e\x6c\x4f\x39\x0e\xcd\x43\xa8\x22\x84\xae\x4f\x94\xa6\x2a\xb5\xf5\xd2\x50\xc4\xfb\x22\xb4\x23\x83\xb6\x6a\x33\xb1\x49\x73\x16\x6a\x28\x0b\x86\x94\xa9\x14\xf5\x11\x34\xc0\x16\x82\x87\x43\x23\xd3\x93\xbc\x71\xcf\xe9\xd1\x01\xb9\x6f\x9c\xae\x0f\x27\x78\x07\x48\x6f\xd0\x08\xac\x51\x43\x88\xf2\xfa\xa0\x7e\x20\x38\xb5\x70\x6a\xa4\x86\xf8\x5f\x90\x95\x23\x98\xa7\x67\xcc\x82\x53\x21\x33\x3d\x90\x66\xa1\x6b\x7d\xfa\xe5\xaf\x7f\xf9\x6d\xfc\xfe\x87\xf3\xf8\xaf\x7f\xfc\xc6\x79\x3f\xed\x1d\x13\x5b\x7e\xec\x98\xe8\x1e\x3b\x26\x3e\xb3\x72\x5f\xbb\x21\xba\x23\x37\x44\x35\x97\xbb\x49\x1a\x33\xae\x2e\xb4\xe6\xc7\xa2\x4e\xbc\xe5\x1d\xdb\x39\xbc\xb5\xa1\x19\xed\x4c\xbd\x9d\x69\xdf\xce\xf0\x15\x8d\xa7\x47\x33\x43\x6f\x66\xb0\x66\x0e\x07\xd4\x52\xaf\x7d\x3e\xdb\xa1\xa2\xe6\xbe\x37\x56\x3b\x56\xf0\x2d\x37\xcd\x10\xf0\xad\x4e\xe6\xc8\x69\x7e\x43\x9e\x8e\xf4\x44\xf9\xd7\x94\xc2\xed\xd0\x31\x21\xb4\x5d\x7a\x91\xd5\xc9\xf4\xc1\x54\x3c\xf0\x85\x52\xb7\xbc\x31\x75\x87\xee\x2d\x07\x32\x6e\xc5\x07\x0e\x4d\x0f\xe6\x29\x99\xe5\xeb\x82\xe2\xf9\x0d\x4b\xd4\x1b\xcd\xf1\xe9\xed\x74\xed\x1f\x7b\x45\xc5\x7d\x82\xa8\x0b\xbc\x9d\x5b\x7e\x4e\x06\xcb\xe2\x90\xec\x53\xfe\xb9\x05\x0f\xb3\x42\xc7\xdc\x04\x53\x41\xc8\x75\x6b\xbd\xa2\x23\x3f\xed\x70\x68\xbe\x7a\x4f\x78\xa0\x41\xdb\xc5\x0e\x7d\x0c\x02\xcc\xbe\x33\x87\xf8\x40\x88\xf4\x2f\xc7\xa0\x3a\xbd\x01\x9f\x03\x54\xc7\xda\x7d\x8d\x33\x84\xcd\xac\x38\x64\x1d\x9d\xb5\x43\xda\x1f\x1b\xab\x5d\xe6\xa9\xf0\x58\x53\xbb\x6b\xe3\xb5\x17\x9b\x21\x32\x59\x23\x9e\x0f\xaa\x0d\xf6\x55\xdb\x0e\x08\xd8\xad\x41\x9a\x38\xe9\x9b\x3f\x39\x25\x5f\xc5\xde\x8e\x94\xb8\x1b\xe3\xaa\xa4\x32\x86\xa0\xb7\xc9\xe2\xef\x59\x66\x19\x2f\xfe\xe8\x45\x78\xdd\xdf\x1b\xd7\xda\xfa\x25\xae\x58\x6a\xe0\x73\x47\x5c\x2c\x65\xa4\x8b\x7e\x7b\x59\x2f\xec\xe1\xb2\x95\xef\x75\x70\x3f\x40\xbb\x27\x32\x04\x4e\xf3\xb7\x4c\x0a\xb6\xa2\xbb\x2d\xc1\x3c\xe0\x91\x6e\xf0\x16\xd3\x8a\x7e\x60\x05\x97\xb5\xc8\xfe\x45\xbd\xe6\x25\x9e\xe3\x71\x52\x4e\x42\x3d\x6a\x76\xd7\xfd\xf9\xb8\xbd\x03\xb5\x3d\xa6\xb6\x4d\x88\x21\xfe\x00\xdb\x91\x05\x2f\x66\x55\x41\x69\xc0\x2b\xae\xe0\x1d\x6f\x8f\x54\x68\x20\x9b\x63\x8f\x43\x23\x9e\x5e\xed\xac\xa6\xcb\x00\x36\xfd\x98\x9a\xbb\x08\xe2\xe8\x55\x05\xdf\xa8\x69\x56\x1b\xaf\xf0\x6d\x6a\x2f\x23\x80\xc0\xa8\x0e\x88\xc4\xcb\xe1\xe0\x64\x35\x8b\x29\xef\x9f\xd6\x6b\xbd\x64\x11\xfc\x7d\x62\x64\x21\xb9\x6e\xf2\x7a\x6a\xfd\x6f\xe4\x88\x21\x15\xe4\x59\xc6\xe6\x44\xfb\x0e\x73\x1f\x30\xa5\x8f\x2a\x24\xdb\xd6\xa5\x40\x49\xb7\x4c\xed\x51\x97\xea\x3a\x44\xca\x13\x6a\x05\x3a\x54\x55\xf9\xdf\xcb\xc1\xaa\x1c\xac\x1b\x35\x0f\x6f\xad\xfa\x60\x45\x75\x35\x6a\xd2\x30\xdf\x39\xc2\x59\xb2\x4c\xa7\x26\xf2\x84\xfc\x1f\x0b\xd7\xcb\x26\x86\x58\x81\x04\x45\xbd\x11\x6a\x24\x2e\x03\x6d\x0a\x91\x76\xa4\x48\x1e\x16\x4e\x53\xb9\xef\xee\x9a\x87\x17\x3a\x22\x25\x61\x11\xa6\x47\xb8\x81\x47\x5d\x88\xb5\x2f\x59\x04\x9b\xa2\x57\xd0\x73\x86\x46\x09\x02\xb8\x23\x20\x81\xa2\xce\x3d\xfa\x86\xc6\x79\xc2\x8a\x0f\xe5\x4e\xec\x74\xa7\xcb\xde\x2c\x5f\xa0\x1f\xa2\x5a\x0e\x84\x46\x00\xbe\x40\x53\xf9\x3c\x9c\x0a\x7d\x08\x60\x34\x77\x73\x92\x86\xe0\xbf\x93\x10\x91\x3a\xf3\x14\xe6\x67\xa0\xd3\x50\x57\x82\x9a\xa7\x37\xa1\x5d\xe0\x2d\x32\xa9\x94\x43\x0d\x93\x57\x1b\x0d\x92\x48\x05\x46\xa4\x84\xba\x79\xd0\xda\xf6\x98\x56\xe5\x87\x33\xd8\x14\x95\x36\xed\xf7\x14\xa4\x7f\x21\x00\xf8\xc9\xc9\x7f\xe5\x14\xe8\xe9\x4b\xb0\xbd\xc4\x00\xfc\xbe\xbe\x4e\x5c\x11\xf4\xf5\x3b\xd1\xa1\x6f\x28\xdb\x66\xac\x49\xae\x09\x3f\xe1\x74\x2e\xbc\x01\x8f\x10\xb7\xa5\x76\x6f\x01\x4d\xe4\x47\x6c\x15\x80\x5d\x73\x54\xe0\xf7\xe0\x74\x63\x77\x8e\x1b\x62\x57\xfc\x7d\x58\x55\x82\x6a\xe3\x6b\x96\x1f\x41\x31\x48\x63\x52\xc4\x17\xee\x9f\xb4\xfa\x14\x06\x77\x73\x0b\x6d\x54\xe0\xb7\x30\x54\xe4\xb3\x95\xb6\x56\xf5\x84\x2f\xb4\x19\xb9\x1c\xe6\x24\x4d\x91\xff\x4e\x72\x04\x15\x4f\xf3\xad\xc8\x51\x0d\xa1\x22\xf0\xbe\x91\x9f\x53\x55\x9c\x24\x05\x97\x61\x05\x59\x31\x6f\x40\x5a\x61\x31\x22\x4b\x8c\x5b\xb0\x08\x76\x1c\xae\x6c\x76\xeb\xd2\xdc\x2c\x5f\xa7\x95\x33\x0f\xbb\xb9\xc9\xb6\x68\x8a\x7a\x86\x52\x15\x10\x3b\xc7\x7a\xb4\x54\x56\x85\xb7\x88\xe4\x2d\xb6\x93\x74\x2d\x43\xd0\xa7\xb6\x65\x02\x82\x1a\xe3\x8c\x35\xd6\xd7\xfc\x4c\x3a\x10\x7c\x8e\x4b\x21\x5b\x42\xcd\x84\x65\x1f\x9f\x08\x1b\x31\x06\xc3\x26\x29\x6b\xe3\x95\x0d\x70\x96\xf2\xcb\xdb\xce\x4d\x7d\x86\x05\xda\xa3\x13\xc8\xd4\x2f\x84\x5e\x75\x83\x44\x23\x4a\xd4\x72\xbd\x98\x2a\x67\xf4\xfd\xa6\x50\x21\xac\x39\x28\x5e\x41\xe9\xd8\x44\xbe\x52\x33\x5b\x18\x69\xcc\xf3\x1b\xc0\xcd\x84\x2d\x47\xa0\x94\xd3\xcc\x12\xda\xb3\xc9\x38\x8c\x28\x1b\x96\x9c\x66\x0d\x2e\xd3\x21\x70\xe5\xeb\x93\xd6\x57\x3e\x14\x0b\x7a\xdc\xa6\xa0\x5a\x27\xde\x4d\xdb\x5a\x46\x59\x4c\x6c\x43\x7a\x76\x53\x2d\xc7\x30\x68\x71\xd9\x63\x50\x43\x66\xcf\xe0\xcb\xa3\x3e\xa4\xaa\x77\x33\x38\x5c\x5d\x67\x58\xa7\xe4\x52\xba\x1f\x91\x1c\x70\x18\xa1\x9c\x4c\x7b\x97\x03\xdd\x5f\x0b\xd4\xc4\xd0\x3b\xf6\x98\x42\xac\xa5\x18\x17\x8d\x7e\xb7\x97\x10\xcf\x4d\x15\xd8\xe2\x75\x9d\xab\x22\xd1\x79\x85\x6f\x08\x19\xa5\xf0\x12\x41\xb5\x3c\x82\xbf\x63\x38\xc9\x3f\xd0\x2b\x39\xb9\x0a\x90\x06\x08\xf5\x93\xfd\xc2\xbc\x64\x78\x23\x31\x66\x1a\xfa\x60\x72\x03\xd0\xa2\x00\xf3\x18\xfe\xbc\x86\x64\xe1\x61\xdf\x3d\x94\xf6\x63\x57\xf8\x17\x4d\x7e\xef\x5d\x3c\x71\x5a\x31\xe3\x5c\x1b\x39\x6f\x59\x7e\xa0\xc0\x93\xff\x14\x90\x4c\x05\x0c\x74\x9e\x80\x2d\x3c\x22\x01\x4a\x52\xe8\xae\x4f\x97\x3b\x62\xf5\x24\x46\xd6\x0d\xe0\x74\xa1\xc4\xaa\x73\x03\x42\x05\x3c\xfa\x91\x17\x1b\x9b\x1d\xf5\x25\xb5\xcd\x04\x35\x51\x47\x46\x86\x9c\xd3\xf1\x0a\xa9\xfc\x77\x69\x7e\xec\xf3\x19\x69\xe8\xda\xa2\x3f\xb3\xaf\x70\x92\x0c\xa7\x0c\x48\x81\x41\x7d\x84\x81\x2d\x90\x19\xda\x21\x1d\x40\x28\xfb\xa4\x7a\xa1\xaa\x6a\xa1\xb2\xf6\x6a\xb8\xe8\x56\x22\xe2\xc6\xa0\xa1\xb0\xa8\x17\xff\xf0\xb3\xfd\x36\x2e\x08\x1f\x23\x2f\xdc\x6b\x98\x0c\x46\xbf\x40\x3e\x77\xd9\x7d\x18\x32\x28\x47\x31\xfb\xe5\x67\xf5\x93\x9e\x01\x1d\x24\xff\xcd\x9e\x4e\xe8\xc0\x07\x91\xb1\x90\xff\x8e\x66\x6c\x33\x0f\xa8\xe3\xf6\x89\x6e\x46\x2e\x7b\xd5\x4d\x52\xb1\x24\xdc\x30\x40\x2f\x2a\xc3\x61\x62\x3e\xcb\x37\xc2\x23\x79\x30\x3f\x50\x1f\x68\x15\x80\x11\x9c\xa8\x41\xc6\xce\x2b\x5f\xe0\xe6\x4c\x2e\x19\x60\x2d\x49\xa3\xa4\x35\xb8\x08\x51\x35\x88\xb7\x60\xac\x4d\x86\x80\x8a\x09\xf1\x08\x66\x8a\x5c\xde\x45\x5d\xf4\xd4\x2e\xc0\xa6\x14\xba\xb7\x6c\x97\xbb\x12\xbc\xe4\x23\x2d\xc0\xe7\xe3\xee\x72\x2d\x70\x34\x7a\x5d\xb1\xd7\x26\x9c\x12\x88\x9c\xd3\x39\xf6\x6b\x7a\x44\x6e\xe8\xf7\xf4\x14\x6b\x44\x0e\x0e\x79\x5a\x5a\x41\xe8\xb9\x74\x0d\x04\x5c\xe8\x1c\x63\x8c\x88\x35\x02\xf4\xf2\x4a\x9e\x3c\xcc\x1e\x49\x30\x2a\xe2\x8d\x10\x7f\x09\x5c\x05\xc8\x34\xce\x43\x06\x9d\x3d\x92\xc4\x17\x06\x4e\x0e\x06\x0f\xb2\xcb\xe8\xfb\x5c\x84\x7d\x1c\xb9\x13\xbb\x66\xa6\x50\xb1\x11\xab\xcc\x47\x3d\x81\x23\x06\x46\xc6\xda\x9f\xa2\x7c\x14\x79\x03\x67\xdb\x2a\xce\xc8\x1a\x17\x6a\x99\x9d\xa6\xe7\x48\x38\x77\x15\xdc\x8a\xcc\x6e\x03\x04\x06\x10\xe1\xb0\xcb\xea\x20\x71\xaf\xf6\x93\x4a\xcd\x85\x89\x07\x64\xef\x82\xb2\xb3\x0c\xfc\x23\x53\x91\xa1\x86\x10\xdd\xc3\x69\x54\xea\x50\x66\xde\xdc\x37\xd5\x65\x4e\xb5\xa8\xc4\x26\xf5\xd8\x53\x9b\x0c\x31\x32\xda\x43\xf3\x51\x18\x59\x34\x29\x67\x15\x98\xfc\xe3\xc4\xdd\x7e\xd2\x3a\x07\xee\x60\x8b\x66\x37\xa2\xfb\xdd\x13\xd2\x56\x25\xee\x43\xca\x24\xca\x39\xb6\x38\x35\x0c\x66\x40\x4d\x70\x2a\x07\xe1\xb7\x22\xf5\xf1\xc2\xcb\x0c\x6a\x68\x12\x66\x02\xd4\x20\xb4\x0d\xcc\x2f\xf9\x42\xf1\xc6\x24\x36\x30\xf3\x85\x8a\x0e\xd4\xc6\x2e\x35\xaf\x08\x3c\xc0\x3c\xc7\xec\x96\xb3\xb4\xd9\x75\xca\x17\xd1\xe5\xec\x71\xc4\x72\x55\x2a\xf7\x07\x57\x53\x6e\xa2\x66\xc5\xf8\xb0\xf2\xa6\x2a\x31\x67\x3d\x86\x1a\x04\xfe\x34\x7d\x58\x03\x81\xe3\x62\x7e\xa7\xb4\x13\x68\x9e\xa8\xd8\x31\x51\xa7\x01\x1c\x2b\x5d\xc6\xc6\x68\x5a\xb2\x08\x1a\x15\xce\x13\x16\x10\x9a\x04\x06\x02\xc9\x59\xb8\x04\xb3\x56\x41\xb2\x04\xf0\x0e\xc0\x25\x93\xe9\xa6\x3f\x24\xbb\x14\xca\x51\x68\x38\x84\xd9\x3a\x83\x25\xf2\x0f\x4e\x97\x55\xb9\x06\x10\x44\xa0\xf0\xab\xd3\xad\x42\x81\x21\x7c\xc2\x2c\x6a\x60\x78\x16\x42\x02\xc6\xa2\xf4\x86\xbd\x06\xf3\x49\xab\x4c\x51\xf6\x9e\xa6\x05\x0a\x2a\x8a\xdd\x95\xed\xb8\x6e\x1d\x5b\xa6\xb4\xbe\x4a\x89\x21\x48\x3b\x48\x74\xea\xb6\xcd\xdd\x7e\xd2\x30\xb3\x6c\x87\xba\x1c\xe2\xca\x10\x65\x02\x21\x4a\x9f\xa0\xbe\x67\x26\x39\x17\x89\x5a\x60\x9e\xcd\x68\xb9\x71\xeb\x2b\x63\xab\x83\xde\x12\xfd\xfa\xf9\x41\x2a\x61\x60\xe0\xa2\xea\x1d\xf9\xaf\x34\x73\x85\xa3\x25\x0d\x76\x63\x8d\xfe\x80\x60\x07\xbb\x9c\x63\x4c\xcd\x44\x04\xa4\x40\x6f\x7c\xf4\x9f\x87\x26\xa1\x56\x14\xfb\x56\xb9\x92\xce\xfb\x83\x56\xd4\x32\xae\x2e\x9b\xb4\x78\xa1\xeb\x90\xb5\xd4\xb7\x2b\x28\x7e\x99\x9a\xa2\x15\x55\x90\x60\x69\xca\xa4\x02\x60\x90\xae\xd7\xe4\x70\x29\x0c\xcb\x99\x22\xdc\x06\xc5\x52\x67\xe6\x27\xd5\xb9\x84\xa1\xd9\x9f\x5e\x37\x21\x21\x31\x2e\x1a\x5a\x57\x21\xef\x08\x75\x80\x4b\x51\x3c\x3f\x84\x29\x45\x46\xbd\x79\xef\x34\x3c\x2c\x67\x36\x30\xd2\xaa\x0a\x96\x16\x12\x91\xc1\xd4\x44\xf3\x3c\x25\xe3\x85\xd3\xf3\xc3\x2e\x44\x6d\xe1\x21\x5e\xdc\xa9\xc9\xb2\xac\x32\xd7\xc2\xfb\x1f\xf9\x8e\xa4\x69\x8d\xed\xd6\x28\xba\xcb\x80\x4b\x67\x6a\xc8\x35\xd8\x32\x4d\xc7\xc1\x96\xf6\xbc\x6b\xe4\x54\x1f\xc7\x30\xce\x73\x33\xbd\x2b\x54\xd6\xbf\x7d\xd7\x3e\x3a\x1c\xb4\x75\x9d\xf0\xe6\xa8\xf0\x8e\x11\x9b\x69\x87\x22\x61\x09\x39\xf7\xc8\x22\x5b\xb4\x1e\xb4\xe4\x4f\x16\xc4\x3c\xfd\xf6\xd9\xc7\xad\x4e\xce\xf4\xd8\x86\xd4\x73\xf6\x7b\x0c\x16\x9c\x2a\xab\x4a\x3f\xd9\x8b\x6c\x7a\x50\x30\x9e\xd9\x93\x97\xb0\xa1\xec\xe6\x0a\x0c\xe7\x91\xbd\x22\xf9\x2f\x44\x26\x16\x35\xf9\xea\x3e\x69\x05\x2f\x9d\x4c\x0c\x6e\xbc\x39\xb6\x47\x63\x13\xbe\xe4\xd8\xac\x94\x1a\xf7\x24\xeb\xd7\x2e\xd1\x3e\xb5\xef\xbf\xb7\xb2\x5b\xaf\xdb\xdd\x5e\x1f\x74\x3a\x7e\xa9\x4e\xbb\x67\xcb\xf3\x76\x8f\x0e\x17\xc9\xe1\x98\x1d\xf4\x3a\x7d\xa9\xdd\xd2\x84\x03\xed\xac\xef\xcf\xfc\x25\x19\x04\x7b\x16\xb6\x67\x4e\x2d\x5b\x0a\xe5\x8d\x67\x71\x7b\x66\x70\x06\x6a\x5e\x33\x67\x71\x7d\x76\x30\x02\xf9\x4b\xcd\xbb\x5f\x87\xe0\x59\x73\x77\x44\x71\xa3\x9b\x53\x1f\x82\xe7\xc3\xd3\xfa\x08\x3c\x1b\xd5\x83\x11\x28\xd7\x6e\xeb\xb0\x4c\x3a\xc2\xd0\x79\x68\xe2\x9a\x22\x3f\xa3\xf3\xd9\x8c\x9a\x64\xce\x15\x08\x97\xcc\xa7\x62\xb1\x7a\x55\xce\x34\x1e\xfd\x28\xc5\x32\x93\x3d\x57\x04\x59\x3e\x1d\x78\xc3\x2c\xa0\x28\xb3\xdd\x5c\xb6\xb2\x1a\x7e\xde\xa6\x9e\x4a\xfa\x38\x10\x3e\x3d\x08\x84\x4f\x07\x71\xf0\x3d\x6a\xe4\x6e\x20\xfc\xc1\xe8\xd5\xdb\xa3\xc7\x38\x19\x55\x11\x29\x72\xae\x5f\x07\xea\x6a\x04\xfd\xc5\x08\x96\x67\x23\x18\x74\x04\xf3\x36\x82\xe1\xd9\x08\x22\xbe\x6c\x60\x99\x05\x53\x86\x12\x5f\xd1\xe8\x7d\x17\x21\x2f\x15\x39\xd6\x88\x68\xde\xa6\x93\xa1\x01\xe2\x87\x91\xec\xfc\x85\x37\x8d\x3d\x57\x7f\x65\xd3\x29\xa9\x36\xbe\xc3\x05\xae\x66\x49\xbd\xb1\x58\x89\xa3\x29\x6a\xb7\xa7\x28\x28\x81\x73\xc0\xe3\xd2\x29\x39\x7f\xe4\xf4\xbc\x84\xc0\x43\x27\xf0\xbc\x11\xf8\x57\x36\x45\xc1\xb2\xb6\xc7\xd5\x6e\xdf\xd1\xa4\x46\xb5\xb5\xd0\xba\xcc\xae\x06\xd3\xf9\x17\x8d\x81\x85\xb8\x01\x8e\xbf\x68\x81\xfe\x1c\x33\x48\xd1\xb0\x5a\x5a\x83\x23\x97\xfb\xfc\x2c\x74\xe6\x63\x56\x51\xd8\xa6\x29\xef\xa7\x29\xdc\x59\x45\x71\x5d\x43\xf9\x77\xb4\x07\x7d\x97\x2a\xfc\x87\x90\xdc\xc3\xa6\x81\xb3\x90\xcd\xe3\x15\x81\xe4\x36\x65\xd6\xe9\xee\xcf\xac\x72\x9b\xce\xc5\xdc\xa7\x48\x57\x1f\xe7\x6d\xd1\x59\x3c\x9a\x1e\xb7\x0f\x8e\x7b\xff\x4d\xee\xb5\xd8\x82\x31\x1d\x60\x9b\x1c\x0f\x27\x03\xdf\x1f\x7d\xf9\x20\x00\xf5\xbb\x10\xea\x50\x73\x99\x03\xd4\x63\xbe\xaa\x0b\x30\x4e\x7e\x86\x22\x99\x35\x15\x91\x6d\xfd\xf9\x68\x05\x68\xd9\x62\xf8\x15\x23\x2c\x52\x4f\x89\xfc\xda\x94\x6d\x40\x0f\xd9\x60\x06\x9d\x26\xc8\x92\x2f\x1c\xcd\x67\x97\x7e\x0a\x10\x60\x5d\xf5\x33\x00\x5d\xa1\xda\x40\x24\x98\x9a\xdc\xcc\xf0\x45\xbd\xa6\x5a\xbb\x15\x0e\x02\xe1\x86\x54\xed\x1a\x70\x97\x2a\x42\xd4\x1b\xe0\x43\xea\xda\x16\xcb\x42\x6e\x7e\xd2\x98\x74\xe8\x1b\x82\x53\xa5\x59\xb7\x2f\xfb\xd5\x7e\x98\x06\x75\xbc\x87\x03\x18\xe2\x20\x2b\x8d\xe5\x50\xd9\xc0\x77\xd6\x13\x07\xda\x1c\xcc\xa1\x92\xa3\x58\xac\xc9\xcc\x9e\x98\x16\xc8\xb4\x1f\x88\x03\x6f\xea\xf3\x8a\x38\x42\xe4\xb6\x4f\x32\x4b\x83\xa7\x25\xcd\x07\x0d\xad\x40\xdc\x75\x2a\x4b\x53\xaf\x61\x47\xbb\x2e\x5d\x46\xe5\xdf\xa7\xc3\x71\x0b\xb7\xb6\xa9\x37\x86\x23\x92\x0e\xdf\xb6\xaf\xc4\x47\xe8\xb5\x2f\x68\xc5\x8b\x4e\xb3\xe1\x78\xaf\x6c\xbf\xe7\x35\xb1\x5a\xc0\xba\x4f\xd8\xa3\xb9\x8d\x87\x3e\x61\x6e\x82\x93\x09\x34\xf9\x34\xe8\x3b\xff\xdc\x86\xdf\xc3\x3d\xd3\xce\x7c\xb4\xf3\xe6\x55\xa7\x1b\x85\xb5\x42\x25\x41\x2d\x62\xac\xf6\xbe\x9b\x58\x04\xff\xa7\x0a\xa5\xfc\x02\xba\x1a\x5e\x7d\x60\x9d\x81\x63\xef\x3b\x72\xad\x6f\xf5\xb3\x08\x05\x0f\xc0\x28\xf3\x65\xd0\x4c\x45\xd0\x4c\x63\xd0\x8c\x7a\x78\xd0\x43\x9a\x49\x7d\x18\x42\x84\x91\xae\xc5\x90\x33\xbd\x5a\xb3\x2f\xfe\x58\x8b\x8c\xeb\x5b\xe3\xbe\x2e\xfb\xc3\x3c\x46\xa4\x88\xf3\xf6\x16\x5f\x62\x5d\x5a\xd5\xee\xb2\x3f\xee\xe5\xb7\x3a\xd6\x84\xad\xa0\x16\x03\x17\x60\x86\x27\xad\x3f\xd9\x7e\xaa\x46\x6c\x6d\x27\x52\x45\xf4\x2b\x38\x71\xed\x8a\x6c\xaf\xe2\x2a\xa9\x77\xb8\x26\x9d\xa2\x79\x87\x2d\x02\x87\xb7\x5d\xed\x9f\x8f\xfd\x2d\xb3\x5a\x20\x83\x07\x7d\x27\x88\x72\x55\x08\x9c\x2f\xd7\xea\xb6\xc1\x8b\xfe\x84\x17\x49\x9f\xe0\x81\xfd\xe8\xdd\xa3\xd9\x5b\x85\xf1\x97\x22\xbd\x06\xa8\xcf\x90\x53\x6b\xda\x49\xec\x34\xc5\xc1\x9e\x0c\xb3\x87\xcf\x3b\x81\x3d\xac\x0b\xcf\xdb\xc2\x0b\x05\x47\x4b\xd6\x08\x3d\x79\xc8\x48\x06\xc3\xcd\x12\xb9\x1d\x1b\x49\x38\x04\x13\x4b\xa6\x36\x98\x5e\x82\x49\x4b\x1d\xce\x21\x28\xed\xb0\x81\x41\x27\xd5\xf4\xa4\x87\xba\xab\xdc\x25\xf8\x54\xed\x38\x0f\x38\xab\x63\x48\x1d\x9d\xaf\x31\x72\x59\x8f\x58\x38\xca\xc7\xa8\x20\x63\x34\x49\x1a\x2b\x9f\x34\x04\xcb\xad\x19\x57\x7b\x26\x3e\xdb\x79\xbd\xed\xbb\xdb\xe6\x3c\xe9\x32\xfe\x60\x9e\x7a\x04\x42\x23\x7b\x20\xcd\x88\x48\xe1\xc0\x9d\x5f\x3d\x42\x8a\xc5\xd7\xb8\xf8\x75\x0c\xd9\x2a\xb6\x23\x43\x80\x07\x48\x48\x1b\xe4\xbf\x9b\x19\xc5\x2d\x62\x7e\xe7\x70\x4b\x76\x68\xb0\xa4\xd5\x89\x49\x5a\x3c\x9d\xbc\xc6\x3a\x58\x9c\x61\x35\x07\x20\x18\x78\x9b\xc8\xaa\xc0\xd0\xbe\x76\x52\x65\x80\x78\x4f\x4b\xb9\xff\xe6\xbb\x8f\xd1\x8d\x21\x1a\x1e\x8e\x51\x97\x9b\x09\x30\x3e\x21\xad\x20\x98\xb0\xe8\xcd\xa5\x97\x48\xa3\x6a\x88\x88\x73\x41\x16\x8d\xa2\x96\x6f\xf5\xdd\x20\x38\xda\x05\x4e\x63\x56\x4c\x35\xd9\x4e\x52\x62\x80\xb2\x5a\xbe\xa7\x3b\x2e\xc2\x33\xa1\x26\xe0\xdc\xeb\x8c\x4c\x8d\xc1\x5c\x39\x17\xc6\xca\x60\x1e\xee\x5a\x4e\x26\xa4\xbc\x80\xe8\x0a\xf3\x14\xbf\x08\xc3\xbc\x7f\xe9\xf8\x0e\x6f\x19\xe0\x47\xe3\x5b\x2e\x84\x5e\x80\x01\xa6\xc6\xc4\x4d\xec\xeb\xa0\xda\x45\xe5\x01\x14\x50\x13\x76\xf9\x56\x0e\x78\x8e\x5d\x77\xf9\x72\xec\x9e\x08\x5e\x71\xd4\xf4\x86\x8e\xf9\x5d\x06\x22\x09\x73\x96\x38\x81\xf0\x6b\x2a\xc6\xb6\x44\x73\x6f\x8b\xaa\xee\x84\xeb\xb5\x11\xc3\x5a\x51\x54\x1f\x05\xf8\x43\x60\xee\x8f\x89\x78\x78\x27\x2a\x7e\x38\xc8\xee\x12\xec\x36\xc0\x73\x0f\x21\xbb\x38\x20\xd8\xab\xd6\xce\xd1\x87\x27\x5c\xcc\x08\x6a\x42\xc0\x10\x58\xe6\x86\x3c\x89\xd8\xc9\x3c\x36\xcb\x30\xcd\x19\x30\x4e\x87\xc0\xd2\xc5\xef\xa1\xb0\xd5\xf9\xe5\x21\x84\xa5\x5a\x03\xa9\xd0\x2d\x93\x41\x39\xef\xad\x81\xf7\x84\x53\x8e\xd3\xd4\xc7\xc9\x46\x49\x59\x5f\x26\xbb\x4c\x06\xf3\xee\x7a\xf2\x01\x55\x36\x77\xe3\x81\x7b\xac\x19\x2e\xe1\xba\x3b\x5c\x92\x0e\xe9\x6a\x3d\x49\x95\x61\x0b\x70\xe3\x3a\xa9\xb5\x9c\x6e\x8a\xcd\xd2\xa2\x8c\x44\xf2\xa7\x0d\xbd\x00\xe5\xd8\x7f\xfe\xbe\x01\xf3\xdf\x0f\x39\xd4\x8b\xa8\x89\xd2\xd3\xe1\xed\x36\xf1\x48\x34\x23\x44\x8e\xee\x42\x37\x86\x1e\xbb\xe1\x27\x78\x77\x07\x4b\x93\x59\xd5\x9e\xaf\x3f\x6b\xa8\x01\x36\x77\xdd\xf1\x6d\x17\xaf\x33\x84\xe7\xd2\x66\x2f\x7b\x94\xfc\x77\xe3\x6c\x39\x1a\xfc\xf8\x9c\x96\x7c\xc6\x90\x4e\xcc\xc7\x09\xb0\x49\xf8\x17\x84\xb8\x0a\x0d\x7a\xd2\xee\xe2\xa2\x1d\x81\xb4\x3d\xf1\xaf\x32\x22\xba\x7c\xfb\x12\x73\x80\xd1\x4b\xc5\xcf\x85\xa2\xc8\x85\x83\x95\xf9\x7c\xdb\x06\xa7\xf0\x6a\xf8\x67\xee\x57\x47\xa5\xad\xbe\x43\xb9\x8a\x49\xf0\xdc\x56\xf3\x61\xe9\x02\x41\xe7\xae\x0c\x76\x51\x57\xaf\xea\x56\x41\xad\x46\x1f\x5a\xdd\xcb\x7a\x62\xf5\x86\xf4\xea\x2e\xcb\x0d\x6b\xc1\x23\xd2\x48\xcf\x49\x83\x80\xc1\x21\x3d\x86\x0c\x4a\x66\x70\xfa\xfc\x74\x50\x2d\xdb\xb6\x7b\x01\x4b\x73\x00\x93\x3d\x74\x53\xdc\x3e\x41\x87\x72\x21\xeb\xd9\x44\x53\x5c\xb8\x7b\xc8\xd1\x1c\x77\x78\xd2\x1c\x0c\x7e\x7e\x3e\xf8\x0a\x01\x8d\x5c\xb3\x39\xad\x1c\x8f\xfa\x69\x98\x91\x10\xab\x90\x21\xed\x14\x82\xbf\xc4\x4e\xd8\x7a\xf2\x13\x8e\xdd\x0e\xb8\x7b\xea\x81\x2d\x57\xee\x29\x7b\xf9\xc0\x66\x67\x4b\x2e\x74\x0b\x89\x6d\x6b\xba\x0a\x09\x77\x3c\x5a\x52\xf7\x68\xf1\x1f\xe9\xd1\x52\xca\x2e\xc6\x90\x5e\x45\x8f\x28\x49\x93\xd9\xf8\xe9\x38\x6b\x93\xd9\x62\x99\x08\x24\xbd\xe0\xb4\x7e\xd6\xf1\xab\x59\x43\x62\x05\xd7\x4d\xb5\xc3\x8a\x3f\xdf\x2d\xb9\xe3\x71\xf2\xe2\x52\x57\xa4\xf5\xd4\xd3\xfa\x54\xa5\xf6\xee\x50\xd4\x21\x94\xf9\x0b\x07\xea\x3a\xbc\x28\x5d\xcd\xde\x96\x7c\x90\x86\xc7\x06\xc3\xd0\x4c\x5f\xa9\x9a\x4f\x9d\xee\x62\xaf\xed\xc6\xc1\x50\x45\x4c\x54\xa7\x67\x10\xc4\xdd\x48\x41\x34\x7e\xf5\xe7\x18\xa3\xb9\xc2\x1c\xf1\xc1\x5a\xe3\xb5\xfb\x2c\xa3\x30\xc8\x43\x86\x3b\x0d\x9a\x74\xc2\x9e\x92\xb3\x74\x71\xf1\x36\x5d\xdd\xd1\xa7\xc6\x8d\x4a\x80\x00\x7e\x3c\xcf\x6b\x96\xb2\x8c\xe4\x7a\x25\xcc\x1e\xbe\x7b\x2e\x9f\xa8\x1d\x62\x7a\x67\xb0\x12\x1a\xcb\x04\x0f\x44\xc3\x70\x3b\xa2\x4d\xff\x28\xa8\x53\xb8\x10\x4b\x3d\xed\x06\x8e\x98\x01\x6c\x10\x10\x84\x7f\x47\x02\xa5\x52\xef\x73\x0e\xcc\xef\x88\x73\x8a\x9e\xf4\x16\x10\x94\x20\x3e\xf5\x6c\x75\xde\x6f\xc3\xe4\xd7\x61\xa2\xf0\xc5\x73\xee\x4c\xfd\xf6\xa4\x96\x5c\x9d\x2a\x3a\x6d\xea\x76\x93\xf2\xa2\x4e\xb0\xc9\x82\xe9\xe8\x4d\x39\x73\xaf\x77\xc0\x22\x8c\xe5\xad\x5d\x46\x15\xc0\x37\x65\x97\x15\x23\x84\xf8\xe9\xd6\x6b\x02\xdf\xc4\x41\x61\xf0\xe4\x78\x3a\x6b\x73\x3d\x41\x11\xf1\x31\x02\x8a\x2b\x9c\xb8\xf9\x3e\x7a\x85\xad\x83\x9d\xc3\x72\x0a\xde\xea\x7d\xd0\xde\x07\x93\x54\x02\x52\xc3\xe1\x04\x62\x0d\x03\x14\x00\xa4\x48\xd4\x96\x7a\x6d\x61\xdb\xca\x58\x5b\xb8\x1e\xcb\x5d\x6d\x17\x8d\x44\x04\xc0\x45\x95\x51\x1f\x1c\xd0\x5f\x9d\x2e\x04\x23\x2e\x02\x6a\x8c\x69\xb9\xe9\x70\x2e\x44\xb9\xe6\x40\x8d\xa5\xff\xed\x74\xac\x46\xc8\x45\xb5\x52\xed\xdb\xd1\xf1\x30\xf6\x74\x5f\x05\x3e\x20\xee\x00\xd3\x36\xeb\x9a\x95\xca\x0b\x34\x9a\x08\x0c\xa1\xcf\x31\x9d\x8a\x41\x51\x42\x96\xd1\x98\x92\xa0\x20\x20\x70\xe6\x56\x10\x1c\x28\x22\xab\xfa\x67\x53\x63\xbd\x55\x46\x0f\x62\x85\xc7\xc7\x84\xc2\x8a\x59\xf4\xda\x31\x23\xa2\x34\x4f\x5b\xc7\xc0\x9c\x64\x68\x2c\x49\x91\x07\xc1\x44\xc8\xb6\x27\xf5\xe8\xce\x82\x9e\xb3\x75\xe3\xfa\x31\xf3\x7a\x66\x13\xd8\x30\x3d\x6b\xd9\x40\xa6\x83\xee\xcd\x5f\xc6\xde\x25\xab\xee\x68\xd4\x57\x80\x3f\xa0\xd3\xe2\xa8\x46\xaa\xfc\xc7\x67\xd8\xc4\x58\x1a\xc8\x54\xf5\xdc\xaa\xfa\x0e\xf6\xdd\x70\x36\x46\x04\x55\xe9\x99\xd3\xa6\xc7\xd0\x04\xe9\x31\x32\x40\x78\x10\x09\x92\x8e\x81\x24\xfa\x99\xda\x5d\x94\xd0\x66\x56\x6a\xfe\x4d\x09\x87\x17\x8e\x07\x18\x0a\xcf\x08\xde\x8b\x04\xb9\x16\x3a\x97\x3f\xae\x4e\xcf\xee\xeb\xb4\x7b\x3f\xc8\x58\xe0\xf0\x7b\x18\x45\x5e\x7a\x36\x21\xf5\xb1\xec\x7f\x9a\xfd\xae\xdb\x30\x64\x61\x1d\xae\x97\x55\xb6\x47\xa0\xc1\x54\x66\x78\x48\xc9\x7f\xf0\x65\x01\xc8\xe4\x44\x65\x18\x81\x77\x4d\x63\x68\xf1\xf7\x9a\xda\x72\xb4\x7c\xea\x93\x25\x95\xb5\xa4\xf7\xae\x9e\xd4\x15\x1c\x58\xb9\xd8\x22\x7d\x86\xbf\x04\xab\x41\xc6\x01\x98\x38\x6b\xff\x04\xf7\x8e\x66\x69\xbe\xa1\xd3\x69\x78\x33\xe4\x93\xe6\x57\x1f\x2c\xc5\xbe\x1a\x2e\x34\x22\x6e\xe2\xa7\x32\x81\xcb\xbc\x1a\x75\x15\x9a\x67\xec\x20\x58\xde\x22\xe9\xb2\xe5\x1a\xd0\xfd\xb3\x10\x25\x88\xab\xa0\x4d\xfa\x65\x30\x13\x10\xeb\xf3\xa4\xee\xe5\x15\x06\x3d\x34\x70\x22\xc4\x56\x89\x43\x61\xa8\x87\x1a\x10\x48\x4c\x8c\x81\x70\x1a\x53\x9c\x09\x19\xb3\x1e\x93\x32\x84\x14\xe5\x0a\x3f\x87\x3b\xf1\xc8\xad\xbb\x86\x2b\xc0\xf7\x53\x23\x3c\x6f\xe9\xe2\x81\x8b\x1a\xa2\x2d\xa2\xf5\xc4\x23\x33\x68\x68\x92\x8e\x82\x66\x33\x97\xa1\x59\x34\xdb\xc4\x4c\x44\xa0\x15\x1e\x63\x47\x3d\xa1\x1f\xe3\x4d\xa5\xfe\xa0\x19\x3f\x0c\x78\x4b\x51\x9e\x79\xb6\xd4\x33\x10\x46\x69\xb8\xf3\x9a\x54\x8f\x28\x47\x4c\x2a\x84\x9d\x11\x4f\x2f\xab\xae\xbd\x6a\x3f\x69\xdd\xa3\x55\x3e\xf6\xda\x5b\xaf\x3c\xd0\xbc\x19\xe4\xd4\xd2\x08\x50\x0c\x7f\xa0\x19\x33\x45\x8d\xb2\xaa\xb8\x47\xe0\x20\x58\x04\x35\xf0\x0a\x4d\xe1\xc6\x5a\xba\x2e\x4f\xed\x36\xe9\x94\x10\x65\x0c\x4d\x29\x23\xd2\x28\x3c\x7d\xd0\x6c\x07\x1e\x91\x5b\x68\x31\x8f\x18\x6a\xd6\xe6\x00\x23\x5e\x98\x42\x17\x11\x94\x35\x8c\x9d\x25\x8b\x90\xd1\x34\x40\xe7\x4d\x7c\xb2\xd6\xc1\x94\xb1\x98\x7e\x63\x41\xa3\x7e\x5f\x3e\x7f\xd6\xa4\x5a\x71\x70\x26\x3c\x00\x4e\x7a\x51\x4e\x25\x4f\x8a\x8c\x85\x5c\x72\x87\xfb\xed\x85\x16\xa5\xec\xd1\x61\x18\x8a\xb6\xee\xa4\x74\x9c\xee\xde\x92\x4f\xd7\xee\x93\x97\x37\xe8\x92\x0b\xc8\x7a\x08\x71\xe5\x95\x38\x37\x58\xbf\x3c\x46\xd0\x92\x96\x26\xfe\xc5\x3f\x60\x2a\xfe\x58\x00\x1c\x4d\x08\x70\x53\x22\x1a\xae\x53\xcc\xaa\x44\xf4\x3a\xe9\xb7\xa6\x7d\x34\x99\x9b\x6e\x64\x91\xbc\x9d\xa4\x68\x45\x11\x3a\xf6\x71\x72\x6e\x27\x2e\x3c\xaa\xb6\x58\xad\xe5\x91\xfb\xa1\x3a\x48\x87\xe9\x01\xe8\x8e\x7b\x8c\xe6\x73\xe4\x37\x5f\xf3\x2e\x81\x19\xc7\x06\x0d\x73\x74\x93\xe9\x79\xfa\x2d\x71\xff\x7a\xfc\xde\xcc\xe6\x4f\x45\xf0\x69\xd4\x37\xaf\x32\xfb\xdb\xa1\x8b\x67\x7a\x63\xd1\xe7\xeb\xc1\xaf\xc3\xd2\x26\x0b\xb7\x6c\x3d\x2b\x6d\x99\xa6\x2b\x84\x2c\xd3\x19\xed\xbc\xc5\x57\xd7\xe1\x35\x23\xa5\xeb\x5e\xc5\x65\xea\xe8\x4a\xdb\x49\xba\x5b\x36\xbf\x0d\xa4\x88\x5e\x4a\x99\xd4\xc7\x5e\xf7\xb5\x7c\xcd\x43\x76\x21\x25\x8e\x6e\xda\x25\x92\x63\xbf\xdd\xb5\x43\xbe\xdb\x39\xec\x7f\xb2\x21\xab\x5f\xf3\x90\x6d\x54\x16\xa6\xeb\xc0\x96\x74\x8b\xa8\x5e\x56\xfa\x60\x38\xda\xd7\x3c\x1c\xb1\xe7\x19\x44\x50\x8f\xfd\x9e\x95\x85\x45\xff\x53\xea\x69\x08\x71\xff\xd8\xb3\xa5\x4d\x5f\x6b\x47\x57\x85\x4a\xb1\x69\x2b\xd3\x16\x7f\xd4\x63\x5b\xa0\x8d\x09\x75\x43\x71\x89\xa1\x67\x4c\x11\x7e\x4d\x61\x2b\xa6\xb2\x64\x42\x3f\x3e\x49\x69\x8e\xc6\x77\x61\xb7\x40\x06\xdc\xd6\xfd\x59\x8a\x45\x8f\x97\xf0\x8e\x56\x83\x5a\xb4\xea\x18\xf8\xb9\xa3\xa1\x74\x5f\xeb\x50\xbe\xc3\x46\xbd\x6e\x5a\xb7\x63\x81\x74\xd3\x09\xfb\x67\xee\xf1\x2a\x6b\xfe\x6b\x1d\xb1\x9e\xb5\xd3\xaf\x26\x8d\xb0\xdf\x9d\x5f\x3a\x2c\x5b\x8a\xfb\x67\x01\x56\xaf\x1c\xb1\xf0\xb5\x8e\x58\x52\xb1\xda\xc0\xe2\x76\x07\x1a\x11\xdd\x4c\x59\x7e\x15\x79\x18\x5e\x3a\x8c\x65\x72\x6b\x99\xe1\xc5\x63\x75\x89\x36\xde\x90\xf1\x8a\xfe\x5c\x96\x7a\x11\x9e\x72\x5b\xb4\x3e\x9d\x98\x2a\x13\x2d\x50\x4c\x26\x5b\xb8\xbb\xdc\x15\x18\x77\xef\x8d\x5b\x6d\x76\x49\xec\x35\xcd\x14\x88\xe7\xc4\x5f\xd5\xd7\xa0\x3e\xf6\x16\x8a\xae\x17\xdb\xc3\xed\x8d\x5e\xcb\xea\xb4\xc7\xb4\x3d\x36\x4d\xee\xd8\x69\xcf\xad\x4e\x7b\xee\x99\xd3\x9e\x5b\x9d\xf6\xdc\x5d\xa7\x3d\xb7\x3a\xed\xb9\x67\x4e\x7b\xce\x9c\xf6\xea\x3e\x8c\x6e\x55\xa2\xac\x27\xec\xaa\x04\x01\xa9\x6f\x3a\xa4\x17\x4c\x57\xfa\xdb\x74\x7d\xba\xe9\xe2\xe8\x33\x43\x1a\x44\xf1\xce\x39\x9a\xe0\xb6\x9b\x35\xb3\xd2\xb9\xfd\x82\x0c\xbb\x33\xe4\x06\x2e\xe8\xc1\x94\x6e\xa6\x35\x00\x35\xd5\x76\x02\x6c\x05\x05\xe3\x0f\xa6\xb1\x5f\x9c\x69\xf9\x09\x84\x16\x69\xaa\x69\x50\x7a\xf5\xd9\x07\x54\x4f\xa5\xd2\x85\x2a\x0e\x99\xad\xf5\x62\x7d\x06\xd9\xb3\x6e\x96\xd7\x9d\x54\xbb\xcf\x7c\x73\x0d\x4d\x79\xc3\x8c\xad\x5e\x88\x70\x93\xad\x0a\x0d\x16\xf2\xa0\xfe\xc6\x00\x6a\x86\xee\xe7\x84\x60\x19\x61\x06\x06\xa7\x40\xdb\x53\x25\xfc\x19\xd2\x61\x41\x5d\xe6\x07\x66\xa6\xc0\x60\x46\x40\x0b\x41\x4c\x56\x77\x8a\x05\x7f\xc4\x06\x47\xa8\x44\x63\xd0\x65\x7a\x05\xea\xc1\xaf\xf2\x07\xec\x33\x2c\x8c\x3d\xc5\x82\x26\x0f\xb0\x1c\x0f\xcc\x1a\xb9\xe6\xdf\x18\x46\xcb\x02\xd1\x93\x40\xf4\xe7\x0a\x23\x4f\x27\xe1\x9e\x42\xe0\x22\x83\xc0\x9a\x40\xe0\x05\xf9\x03\x5a\x5d\xe7\xd4\x5f\xce\xa9\x5b\x67\x74\x9b\x4f\x7a\xbf\x22\x49\x4d\xea\xc0\x97\x70\x98\xd1\xf9\x84\xfb\xc8\xa4\x43\x4a\x6c\x0b\x28\x90\x1c\xf1\xdd\x14\xb9\x04\x4c\x6c\xa9\x43\xc8\xd3\x96\xe8\x82\xe8\xe1\xb9\xa3\x63\x38\xba\xe3\x02\xfb\x0a\x06\x70\xc0\x75\x36\x9d\x35\x9a\x3e\xca\xba\xd4\x5e\x38\x73\x75\x3f\x73\x54\xb8\xa8\xd6\x91\x93\x47\x25\x52\xa3\x5f\x68\x96\x21\x8e\xb9\x58\x06\x07\x5f\x7b\x06\x07\x9b\x9c\x65\x4d\xf6\xa0\x89\x1a\x76\x69\x36\xb6\x0c\x11\xcb\x96\x37\xe2\x79\x36\x09\xf5\x21\xb2\x49\x39\xdf\x48\x25\x41\x62\xd0\x19\x3d\xe9\xf1\x0a\x35\x6d\x18\xd8\x98\xc6\x2c\xb1\x0a\xa4\xbf\x98\x15\xe9\x28\x44\xa1\xb5\xbd\xf2\xc5\x56\x36\x45\x0c\x5f\xcc\xd3\xae\x5c\xff\x01\xbe\x26\x30\xe6\x02\x3b\x89\x5c\x6d\x65\xc6\x8b\x37\xf8\x57\x9c\x36\x84\xe2\x47\x4b\xd0\x8c\xb2\x0f\xd6\x72\x3a\xd8\x76\x64\x77\xb9\x4c\x71\xd1\x4e\x4d\x71\x61\x14\x16\x08\xc4\xa7\x10\x8b\x04\xa1\x3b\x01\x09\x28\xab\x6e\x59\xe6\x03\x23\xab\xb8\x9c\x0e\xc8\x21\x98\xb8\xbc\x1a\x33\x9c\x46\x1a\x19\x14\xa1\x57\xe8\x46\x4f\x1f\xb5\xc4\xa2\xd0\x55\x27\x33\xdc\x99\xb1\x87\x3b\x7c\x62\x4c\x52\xd2\x8c\x7d\x64\x78\xb0\xd9\xb8\x07\x69\xeb\x3a\xd7\x79\x57\xa5\x76\x46\x1d\x04\x88\x09\x56\x31\x2e\xa8\x35\x9f\xac\x09\x83\x22\xeb\x29\xb2\xbd\xd7\x75\x00\x3b\x30\xc8\x9d\x47\x1c\xa2\xb1\x50\x5f\x4e\x26\xf3\xd0\x17\x54\x6a\xd8\x5e\x59\xb6\x9a\x4e\x0c\xce\x03\xdd\xd3\x52\x38\x3a\xc2\xe5\xc3\xe5\x08\xf5\x62\x11\x28\xe1\x71\x37\x69\xf4\x5b\x73\x47\xfb\x89\x6c\x1b\x2b\xa0\x88\x9b\x14\x6a\x96\x19\xc4\x08\x44\xaf\x73\xe2\x01\xe8\xb3\x4c\x74\x0c\xe4\xee\xb0\xde\x3c\x69\x06\x38\x86\xf7\xba\xdd\xa8\x7b\xb3\x6f\x87\xed\x0f\xbd\xd6\x62\x3a\x0e\x86\x75\x4f\x78\x5a\x2a\xeb\xec\x33\xf8\xca\xee\x0e\x40\x74\xe0\x00\xbf\xbd\xb9\xec\x6a\xdc\x7f\x69\xdf\x86\xdf\xbe\x03\x88\xa3\x9b\xe0\xb9\xc3\xc9\x80\x9d\xfa\xa4\xf9\xcf\x98\x33\x64\x52\x82\xf2\x66\x03\xf1\x96\x83\xba\x11\x54\x68\x60\x60\x32\xef\x04\xb3\xd5\xbb\x38\xc3\x53\xb6\x31\x91\x38\x3d\x0d\xd5\xb0\x8b\xcc\xb3\xfe\x24\x42\x2b\xa0\x0d\xeb\x00\x48\x1c\x38\x8a\x01\xb6\x92\x20\x52\x40\xec\xd1\x23\x46\x73\xfb\x7d\xd0\xe4\x0f\x88\x86\x0b\x4c\x61\xc2\xcd\x2b\x32\xc0\xad\x0c\xaa\x99\x87\x33\xbd\x34\xa0\xf9\x9e\x2f\x9c\xfe\xc3\x23\xa1\xc6\x68\x8c\xa9\x9d\xfe\x17\xfe\x6b\xb7\xb4\xe1\x61\xa0\xe2\x7f\x51\xeb\xd1\x8c\xce\xb6\x13\x1e\x0c\x1c\x02\x5a\xf6\x11\x2a\xe7\x86\x93\x43\xe6\x0c\xcd\xf8\x80\xa8\xb9\x85\xf4\x92\xaa\x66\x45\x61\x62\x0b\x6d\xf3\xc0\xf0\xc0\xad\xc5\xfe\x56\x8b\x8b\x61\x39\xe9\x08\x36\xdb\xae\x07\x85\x03\xe5\xf5\x62\xd7\xa3\xfe\xb1\x7b\xbc\xec\xaf\xf7\x85\x76\x2f\x6b\x8e\x12\xb7\xbd\xea\x34\x5d\x5c\x25\x02\x9f\x23\xb2\xac\x39\x24\x90\x07\xac\x8a\xb0\x64\x19\xc5\xc9\x51\x32\x34\x70\x64\xd0\x11\x53\x71\x72\xbc\x4a\xdf\x05\x83\x3a\xba\xc2\x88\x14\xd2\x49\xa1\x1c\x69\x2a\x61\x88\x80\x7a\x7f\x45\xda\xa7\x65\xca\x11\x33\x03\x9b\x34\xa7\x82\x33\xc1\x89\xd0\x79\x88\x9a\xe8\xe4\x83\x66\xc8\xd0\x34\x26\x11\xb0\xaf\x43\x33\x3f\x0d\x19\xd9\xee\x97\xe2\x56\x90\x27\xa2\x2a\xfa\x93\x46\xcd\x81\xd6\x88\x6f\x9c\x4f\xea\x11\xe9\xf8\x07\xee\x6a\xdb\x83\x1d\x56\xa3\x42\xc5\xaa\x53\x81\xbf\x68\x7f\x78\xde\xfe\x81\x1d\x90\xcf\x93\x1f\x40\x06\x12\x45\x23\xb5\xc4\x3f\x6c\x33\x17\x01\xd1\x29\xb5\x0f\x61\xdf\x87\x4c\x3c\x2e\xf4\xa1\x48\x1f\x0e\xf6\x15\xbf\x3b\xd5\xea\x2a\x71\x56\xf0\xb5\xb5\x1b\x7d\x63\xd7\xbd\xca\xfd\x73\x49\xf7\xd8\xa1\xa7\x54\x4c\x4f\xaf\x63\xb6\x42\x2c\xf9\xee\x1e\xc7\x33\x2f\xfb\x7d\xa0\x82\xb9\xc8\xe3\xf6\x95\x3f\x50\xf2\x6a\x38\x68\xe9\xe0\xd4\x97\xa7\xf6\x35\xef\x71\x7a\xb6\x47\x3c\x44\x3b\xdc\x65\xc3\xad\xd1\x48\xde\x12\x19\x10\x0c\x29\x51\x38\x40\x88\xe3\xd4\x5e\x37\x12\xf0\x39\x52\xc9\x5f\xfa\xbc\xe9\x00\xfa\xad\x2d\x4c\x22\x56\x1d\x81\x74\x34\x02\x29\x3d\x61\x5a\x64\xdb\xb1\x8f\x55\x7a\xd2\x3d\x62\xa5\xd2\x59\xe6\xf8\x30\xb7\xbb\x70\x4b\xab\xf9\x0e\x70\xb4\x17\x2d\x76\xd3\x26\x1e\xe9\xf9\x1b\x9e\x35\xbf\xbb\xb2\xd1\x53\x09\xd0\x70\x80\x92\xf3\x4b\x47\xfb\xfd\xd0\x53\x39\x85\x69\x29\xba\xde\x77\x32\xf2\x6a\x1e\xc4\xc8\xc3\x2f\x88\x06\x22\x66\x68\x2f\xca\xb4\x7a\x45\x9a\xd4\xfa\x58\x9d\xd4\xef\x55\x94\x83\x3c\xe6\x23\x9d\xd1\x60\xf3\x47\x85\x72\x9b\x4a\x60\x7a\x98\x3d\x94\xb3\x45\x68\xda\x27\xb6\x37\x5d\xca\x23\x46\xe4\xed\x91\xc0\x8f\x12\x03\xb8\x07\x6e\x7f\xc7\x9e\x96\x24\x0f\x8b\xce\x3d\xb2\xb5\x61\x08\x1f\xf9\xa7\x10\xa9\x54\x39\x6d\x19\xef\xd9\x23\x2f\x19\x92\xcd\x06\x8f\x24\x6d\xf9\x1c\x7c\xbc\x6b\xec\x5b\x5d\x08\x85\xae\x22\x3d\x84\x01\xc6\x47\x90\x5e\xba\xce\x10\xfe\x06\x37\x00\xda\x0b\x10\x37\x44\x83\x30\x31\xf6\xb4\xed\x1d\x67\x21\xb6\x4d\x53\xc6\xdf\x7c\x00\x73\x21\x42\xd4\x3e\xbe\xf1\xdf\xc6\x8c\xd6\x17\x4e\x68\xa2\xf7\xcf\xde\xd8\x43\x47\xaf\xac\x29\xdd\x54\x1a\xeb\x6b\x71\xdc\x2d\xd0\x91\x4b\xf6\x31\x2c\x6d\x3d\xb0\xec\xbe\x66\xb2\x9d\x7f\xf3\x6c\xb7\x75\xfd\x56\xa2\x5e\xce\x39\x68\x06\xa8\x78\x62\x4a\x2e\x06\x93\x64\x4e\x17\x7d\x32\x74\x5f\xf4\x88\x9a\x8b\x0a\x78\x3f\xa9\x2e\xa0\x0c\x28\xc5\xd7\xf5\x6d\x61\xba\x1a\x10\x17\x43\x63\xc6\xfa\xd0\x70\xb8\x45\xdd\x20\xfd\x54\x77\xc1\x9b\xc6\xb2\x54\xf5\x30\x99\x15\x4a\xd2\x9f\xd4\x2f\xc9\x5b\x42\x7c\xcc\x68\x59\xe9\x25\x45\xf3\x6b\x2c\x9d\x56\x14\x7d\xdc\xde\xd7\xd7\x3d\x39\x65\xf5\xa9\xb3\x18\xd1\xd5\x68\xba\xaa\x9e\x86\x17\xe8\x9e\xbc\x5b\x11\x1b\x09\x95\x85\x37\x0f\x15\x2e\x5b\x42\xd8\x3b\x27\x8b\x3f\x14\xd2\xd7\x9c\xa5\xcf\x75\x29\x6c\x41\xfd\xec\x0d\x18\x76\x2d\xa8\x5f\xa4\x05\xbb\x06\x98\xce\xeb\x53\x7f\x7f\x38\x9c\x83\xcf\xd1\x82\xbb\x0d\xa8\x9f\xa1\x01\xc3\xa3\x39\xf0\x5f\x7a\x19\x7c\x9a\x06\x0c\x1f\x31\x07\x5f\x72\x08\x0e\x76\x2b\x77\x01\xa7\xbd\xf7\x02\x19\xbb\xa9\xeb\x09\xee\xd9\x3c\x53\xaa\x82\x34\xb7\x55\xee\x82\x47\xe4\xac\x08\xf2\xc8\x09\x09\xee\x86\x8a\x11\xfa\x77\xc7\xa7\xb1\x20\xe7\x41\x84\xb0\xc7\x9b\x72\x8f\xa5\x59\xd8\x13\x67\x97\xf5\xa0\x9a\x85\x95\x13\xd5\x3a\xa4\x1d\x16\x68\x6f\x93\xdb\xb9\x54\xbc\xab\xbb\xda\xd0\xfd\xd5\xaa\xd9\x21\xc2\xbb\xfa\xab\xbd\xa3\xbb\x9a\x1c\xda\x7b\x05\x23\x5d\xa2\x27\xfa\x0f\x6c\x2c\x4a\xed\x2c\xca\x13\x3d\x76\x95\x85\xa8\x9d\x85\x60\xc9\x0e\x62\x17\xf4\x78\x0a\xb0\x79\x68\x86\x54\x46\x89\x52\xd0\xa9\x94\xb3\xbd\xcc\x85\xaf\xca\x07\x99\x9b\xae\x86\x63\x92\x27\x82\xc8\x3f\x8f\xea\xb3\x1a\xc1\xbf\x16\x84\x6a\x97\x4a\xe0\xe5\x91\x49\x3e\x29\xdb\xd3\x61\x36\x6a\x36\x48\xd6\x37\x6a\x6e\xa2\x20\xfc\x08\xe4\x8f\xca\x94\x56\x49\x27\xb5\xdc\x9e\xd4\xe1\x65\xb3\x6a\x61\x3a\x96\x09\x7c\x32\x57\x44\xa6\x4d\x5f\xe5\xc1\xe3\x30\xa0\x2f\x3b\xbf\x97\x6e\xc1\xd5\x69\x88\x81\x83\x6e\x27\x85\x85\x70\x82\x40\x46\x5b\x2f\xfa\x13\x5e\xe0\x17\x59\x32\xe4\x81\xfd\xe8\x5d\x58\x08\xfd\x50\x5a\x7d\x8a\xf9\xc4\xd4\x43\x96\x28\xab\x76\x5e\x88\x86\x11\xaa\x91\x91\xc1\x09\xd6\x10\x04\x90\x8b\xb8\x01\x44\x07\xe0\xfa\xc3\x30\x01\x45\x6b\xaa\xca\x64\xd1\x90\xa2\x26\xc0\x4a\xc0\xa6\x77\x58\x91\x61\xe0\x1a\xfc\xf4\x1e\xa4\xef\x3a\x63\xf1\xd9\x8a\x84\x5c\xae\x00\x71\x34\x89\x52\x7f\x85\x64\xde\x16\x08\x13\x6c\xa4\xeb\xb0\x26\x65\x51\x0d\xb1\x02\x8a\x58\x7e\x33\xfe\xb1\x4b\xdd\xc2\x1b\xb0\x24\xc1\x85\x5b\x1a\x05\xf0\x0a\x46\xc7\xc9\x85\x6a\x93\xd5\x2f\x5e\xfe\xc3\x14\x8b\xc4\x28\x7f\xf8\xfc\x39\xa6\x7e\x78\x8f\xb9\xff\x3d\x4e\xfd\x85\xdf\x80\x37\x65\x88\xcc\x02\x82\xdb\x42\x7b\xea\x19\x01\x93\xa1\xa1\xd0\x33\x0c\xaa\xae\x46\x53\x0f\xd2\xc7\x78\x26\x8e\x80\x79\xa8\xa7\xdf\x71\x4f\x42\x35\x13\x13\xea\xe2\x20\xd5\xd4\x78\xa5\xac\x9b\xae\x2b\xcc\x56\x86\x89\xe6\x99\xc9\xb3\x3e\x74\x1f\x01\xe6\xb2\xd2\x0c\x47\x88\x1f\x38\x8f\xad\x7e\xba\xc9\x19\xbe\xee\xd9\xc9\xcf\x16\xe6\x81\x34\x5f\xe4\x90\x23\xaa\xc0\x50\x74\x5e\xd4\x83\xcf\xfc\x14\x56\xbb\xa0\x08\xa7\xf4\xd2\x9b\x90\x00\xaa\xf8\x73\xa9\x77\x65\xf4\x2d\x52\x14\x3e\xda\x89\x76\x40\x7a\xea\x97\xa5\x78\x64\x81\x91\xde\x49\x0d\xa3\xbb\xf0\x14\xbd\xf4\x93\x7b\x76\x8b\x27\x73\xe8\xba\x7d\x67\x09\x35\x34\x54\x24\x55\x43\x24\x1b\x68\x9c\x9a\xec\x39\xcd\x1a\x8b\xbe\xc1\x1b\xe6\x33\x5e\x2d\xe3\xb5\x56\xb2\x15\x3d\x1a\xd2\x8b\xa4\xb8\x6e\x7a\x94\x14\x77\x75\x04\x3b\x52\xe6\x3c\xc8\x8f\x6b\x2e\x61\xf7\x23\x9d\x13\x69\xbb\x1d\x32\xd6\x0f\xf9\xea\x1a\x66\x4f\x0c\x3f\xd9\xf7\x9c\x19\x10\x82\x5a\xfd\x17\xfa\x39\x30\x11\x9c\xd9\xfe\xd5\xea\x40\xa3\x5c\x98\xe1\xb9\x06\xa7\xb6\xe4\x85\xb3\x05\xa4\x5a\xad\xe6\x19\x11\x4c\x5f\x11\x54\xa1\x60\x7b\x3c\x12\xbe\xe1\x5f\xd8\x5b\xf0\x49\xf9\x0f\xdf\x6f\x47\xea\xe8\xfb\x72\x45\x8a\x74\x4f\xdb\x79\x64\x07\xb4\x63\x73\xa9\xf1\xf1\x68\x3e\x9f\xf9\x1a\x43\xe9\xa4\xa9\x3b\x36\x9a\x5a\x76\xc4\xb6\x09\x06\xa7\x23\x28\x52\xff\x08\x02\xf2\xbd\xaa\x30\xd4\xc4\xbb\xb4\x4c\xbf\x41\xc3\x68\x78\xb6\x01\x0c\x17\x3b\x80\x2a\xdb\xcd\x07\xf5\x60\x55\x5b\x1a\xa8\x4e\x54\x87\x11\x43\x0f\x49\xfb\xc9\xaf\xd1\x7f\xef\x5b\xe7\xfd\x49\xf7\x5d\xe0\x83\x29\x84\xf1\xf8\x19\x29\x54\xb2\x9c\xf1\x88\x25\x93\xff\x82\x19\xe6\x97\xb1\x5f\x6d\x70\x23\xb2\xc1\x54\x24\x57\x11\xf6\x3d\x39\x30\xf2\x9e\xc5\xe5\x87\x6f\xd8\xef\x8c\xd0\x4e\xdf\xf2\x49\x6b\xa3\x95\x3f\xb8\xa5\xa8\xf4\x48\x03\x60\x05\xfe\xde\x1c\x22\xa3\xc6\xbc\x9c\x9c\xb2\x26\xa6\xa8\x19\x22\xe5\x3f\x3d\xb5\x3a\x49\x3e\x3a\x26\xda\xab\x4f\x09\xbf\x42\x02\x4d\x09\xe9\x9c\x00\xfa\x5c\xdb\x90\x34\x51\x33\xdd\x73\xb0\xd8\x10\x46\x17\x79\xfc\xc6\x0b\x88\x5f\xde\x23\x50\x87\x62\x28\xc2\x31\x05\xef\x8f\x56\x01\xd3\xbc\xe9\x03\xcb\xfe\x25\x15\x64\x9f\xf6\x01\xff\xbb\x99\xde\x75\x71\x9b\xdc\x9d\x5e\x9b\x40\x0c\x3d\xbc\x56\x9b\xb3\x50\x95\x19\x90\x61\xb0\xc0\xb2\x95\x5a\x3a\x1a\x0a\x55\x7f\xdf\xc7\x01\x7e\x8a\xf5\x1c\xf6\x6e\xda\xb1\xbc\x51\x9f\x7f\xe8\x30\xe3\xe3\x9a\xb6\x6c\x6f\x1d\x7c\xe4\xb6\x14\x8a\x74\xb2\x39\xf3\x06\x92\x57\x6a\xbc\x67\x36\x0c\x9b\xd9\xd0\x3f\x33\x1b\x46\xdb\x1d\x5e\xf6\xd5\x7c\xa8\x7d\x69\x77\xb7\xe9\x5d\x1b\xca\xde\x1f\xde\x1f\x86\x5f\x79\xbf\xe6\xb2\xa8\x50\xe1\xb7\xf4\x04\x53\xac\xca\x83\x91\x6e\x77\x64\xfa\xe0\x62\x9d\x97\xba\x39\x75\x2c\x96\x09\x0c\xb8\x76\xa0\x40\x02\xdb\xc9\x07\xb9\xbc\x1d\x92\x58\x7e\xb0\x1c\x59\x10\x31\x22\x9d\x1e\x00\x79\x30\x30\x7c\x15\x0e\x04\x6d\xa0\x21\x1e\xc9\x1a\x13\x2c\xe6\xb4\xef\x3b\xf5\xab\x30\x03\xa3\xcb\x27\x4b\x40\x45\x99\x61\xa2\x7b\x04\xb2\x89\x31\x7f\x93\x9b\xd4\x05\x09\xc9\xa0\x22\x51\xcb\x11\xac\x5b\x98\x29\x50\x01\x64\x00\xb2\x9e\x58\x12\x86\x60\x2c\x33\xc4\x8e\x06\x6a\xf1\x35\x84\x94\x32\xbf\x66\x3a\x7e\xb6\xf1\x05\xdb\xf6\x9a\x2a\xf1\x9d\x27\xcc\x91\x42\x73\x81\xbd\xe6\x32\xa3\xe5\x7a\x54\x37\xf3\x4c\xdc\x42\x38\x0f\xe4\xe7\x9f\x79\xe1\x57\xe0\xfd\x96\x8a\xac\x6a\x0b\x0c\x46\x26\x50\x86\x5e\x27\xd9\x2f\x02\x87\x8d\x19\x4a\x99\xbf\x0b\x19\x33\x0f\xf7\x24\x59\xc8\x11\x2e\x32\xc2\x32\xc0\xc3\x93\x29\x17\x91\xdc\x95\x0e\x1a\x9a\xab\xcd\xa9\x0f\xc2\x07\x0b\x24\xc5\xb1\x08\xcc\x2e\xf8\x3a\xb9\xc9\x0d\xba\x41\xca\x4e\xb9\x86\xde\x3f\xd3\x0f\x8e\x2b\x89\x5e\x33\x42\x1b\x85\x6f\x04\x7e\xa9\x9c\xec\x2c\xd4\x05\x0b\xb2\x92\xfb\x32\xbe\x40\xb7\xe8\x57\x16\x5c\x68\x45\x56\xde\x1c\xe9\x1a\x5a\xe0\x29\x88\x88\x97\x81\x39\xde\x8a\x79\x9f\x30\xdf\x6e\x5d\x61\x7c\x34\xf6\x3b\xf7\x15\x08\xc5\xa1\x86\xa1\x7b\x7a\x30\x4c\xbf\x6d\xb0\x39\xb2\xd7\x32\xe3\xa9\x22\x89\x15\xa4\xa8\x1c\x8b\x9b\x19\x88\x0e\x47\x88\xea\xc3\x83\x2d\x6a\x3b\x75\x19\x17\x8c\x59\x24\xe4\x6a\xa1\x8a\x30\x28\x1e\xf7\xc8\x87\x08\x2c\xf7\x00\xd7\x3e\x21\x67\x59\x36\x15\x62\x2e\x66\x96\xa4\x9a\x74\x54\xdb\x9b\xd0\xaf\x5a\xdf\x8c\x4d\x51\x6b\x5d\x37\xd6\xc5\x28\x7b\x52\x41\x2a\x85\x0a\x53\x3a\x09\xc8\xdc\x78\x70\xa0\xd0\x99\x85\x40\x0e\x10\x21\x1d\x86\x4b\x7e\x9e\x80\x8f\xe6\xf2\x60\xb6\x2e\x91\x27\x41\x23\xdc\xfd\xe8\x26\xc3\xd4\x85\x1e\x87\x33\x1d\x81\x3c\x61\x53\xe5\x47\x11\x4e\x0a\x81\xd0\xd9\x42\x26\xd0\xd6\x2c\x7b\xa3\xe5\x89\xa5\x65\x6c\xbb\xdc\x95\xe0\x25\xaf\x14\x29\x24\xae\x6f\xe9\xe5\xfa\x5c\x7a\x14\xa0\xd0\x6b\xc3\x8c\x8c\xc4\x08\x70\x72\x3c\x46\xa1\x92\x88\x0c\x56\x5f\xe9\x59\xc9\xcf\x9b\x3a\xe2\xe4\x35\xf5\xf1\x96\x60\x56\x77\xb1\x30\x23\x45\xe1\x1c\x89\x43\xda\xc8\x9c\x67\xad\x05\xb9\x13\xf1\x71\x75\xae\x84\x67\xe4\x4c\x47\xb1\xdc\xe8\x5e\x48\x3b\x2b\x62\xc9\xbb\x74\x2b\xc4\x91\x14\xa9\x00\x59\x02\xe8\xbe\x38\x31\x81\x9b\xae\x49\xa6\xea\x63\x9e\x73\xe6\x21\x16\x46\xaf\xc2\x64\x08\x86\x60\xf2\xca\xd6\x6b\xac\x3d\xbc\xcc\x84\x6b\x02\x65\xc8\x06\x49\x56\x3f\x0d\x7a\xfc\xd3\x67\x4b\xb3\x16\x22\xa7\x72\x46\x92\x58\xba\xc5\x72\x41\xc7\x06\x97\x5f\x4b\x12\x97\x48\xd9\x43\xa4\x37\xa0\x3a\x96\x21\x9b\xfa\x42\x42\x3b\x40\x0e\xf0\xbe\xbb\xb6\x67\x26\x68\x7c\xe8\xcf\x0d\x70\x6d\xe0\xf6\x61\x3b\x43\x07\x2a\x95\x0d\xaa\x3f\xc4\xd6\x6f\x3b\x76\xd2\xf4\xd7\x8a\x99\x9b\x9d\xe6\xe1\x63\x88\xbf\xc1\x20\xce\x74\x18\x43\x22\xc1\x49\x41\xe2\x3e\x28\xc4\x55\x53\x80\x94\x5e\x6b\x98\xf8\x77\xec\xcc\x4c\xb6\x7c\xd1\x23\x8f\xe8\x31\x11\x24\x82\xad\x9b\x38\x2d\xa6\xaf\xd6\x1c\xdc\x91\x33\x6f\xfe\x71\x2a\x7c\x25\xd3\x3a\xf7\xbb\x9a\x91\x4f\xf3\x23\xbb\xf3\xf8\x36\x07\xe7\x62\x52\xc4\x7b\x54\x53\x8e\x3c\x8a\xee\x9b\x66\x9e\x84\x37\x00\x0b\x32\x3e\x7a\xff\x9e\x85\xf1\x49\xbe\x7c\xbe\xeb\x4f\xff\x80\x38\x0a\x83\xc4\xef\xbf\xae\x2c\x52\xeb\x16\x21\x9f\x03\xd6\xe9\xa4\xc9\x46\xe9\xce\xc0\x1c\x51\x40\xe9\xf1\x4d\xf7\xa2\x44\x74\x1f\x47\x11\x8a\xa0\x3b\x60\x1d\xc6\xac\x0e\x81\xf4\x2e\x22\x38\x53\xb4\xb4\xf6\x4e\x44\x0a\x0f\x74\x94\x78\x81\x8e\xf2\xec\x43\xc3\xbb\x7c\x49\xea\x3d\x58\x5a\x61\x0d\x49\x85\x03\xa4\x6b\xf5\xa9\xc8\xbe\x5c\x49\xf1\xd8\x28\x64\x59\x64\x1e\x12\x45\xd8\x39\xd7\x37\xe6\x51\xb9\x2c\xee\xa4\x84\x94\x85\x26\x8f\x39\xa1\x47\x66\x89\x44\xca\xd7\xd2\x41\xe4\x9d\x21\xab\x2b\x28\x7b\xa2\xa8\x9b\x60\x23\xab\x7a\x6e\xc8\x67\x90\x83\x16\x69\x2c\x80\xf3\xa4\x3b\x38\x52\xee\x9b\x16\xc1\x1c\x8b\xd1\x20\xf8\x81\x68\x2b\xa4\x85\xc8\x43\x8a\x40\x25\x79\x3f\xbb\x1e\x11\x84\xcd\xc5\x32\xcd\x9b\x37\xbe\x25\x60\xe5\xca\x54\xc8\xa8\x62\x78\x5c\x5c\x56\x9a\xaf\x5e\xbb\x11\x01\xa8\x32\xf5\x82\xf3\xc8\x04\x46\xb2\xab\x43\x4b\x16\xc3\x53\xca\x13\xff\x90\x6b\xec\x6a\x89\x9e\xec\xde\x1a\xa7\xb2\x7a\x60\xf4\xaa\x67\xb5\x8a\x16\x43\x6c\x72\x36\xc0\x8a\x68\xbb\xe0\xd9\x56\x32\x98\x38\x28\xc5\x52\x99\x1d\xc4\xc7\x7c\xe4\x64\x19\x56\x29\x16\xcc\x4f\x58\x77\x41\xea\x2e\x20\x80\x81\x27\x66\x20\x56\xbb\x80\x06\xc2\x29\x0a\xa0\x17\x85\x52\xbf\x48\x56\x41\x68\x5c\x26\x22\xad\xa1\x07\x22\x4a\x7d\x15\x91\x57\x6d\x41\xa5\x35\xaf\x3e\x97\x67\x24\x11\xe9\x5c\x03\xb8\xe5\x58\x41\x66\xb8\xbe\xb0\x30\x45\xc4\x4a\xf0\x44\x16\xc1\xca\xfb\x07\x70\xff\x3e\xf8\xeb\x6e\x35\x92\x19\xd6\xbf\xb2\xf8\x9e\xba\x5e\xfe\x8b\x54\xc2\x84\x71\x50\x64\x96\xdf\x43\xf7\x56\x69\xdb\x37\xe1\xa2\xb3\x1c\xae\x19\xbc\x37\xf4\x6f\x0c\xb9\x61\xf4\x06\x2c\x23\x99\x4d\x88\xe6\x10\xc9\xc0\x30\x26\x5a\x07\xcf\x17\xeb\x6c\x91\x3a\xb1\x6d\xb1\x7e\x2b\x02\x66\xdf\x0b\xd4\xa7\x9e\x9a\x71\xe1\x93\xa4\xa7\x4c\x19\x94\xe7\x08\x70\xa3\x99\x4b\x0d\xca\x0f\xb0\xa1\x35\xf4\xdc\x9c\xd5\xfc\x52\x47\x72\xe4\x04\xb2\xce\xf9\x2c\x65\x67\xbb\x9b\x66\xbd\x89\x93\x35\x1e\xe0\xdd\x8a\x3c\xd3\x37\x93\x44\xd1\x76\x73\xca\x1a\xd5\x2b\x8b\x5e\x5b\xe3\x7a\xd1\x9f\x18\xef\xd8\x59\xc7\xe5\x8a\x63\xcc\xef\x5a\x1b\x12\x77\xbe\x5f\x75\x07\x83\x91\xae\x12\x2e\xbd\x53\xfb\x93\x1a\x8c\xdf\xb1\xb6\xf0\x19\x06\x63\x45\x5c\xed\x0e\x62\xc7\xda\xeb\x87\x6e\x21\xed\xd5\x9e\x31\x68\x41\xf9\xec\x0d\x18\xae\x5a\x10\xbe\xe0\x10\x1c\x4c\x53\xb9\x82\xb3\x67\xf6\x98\x83\x3c\x92\x3d\xea\xfd\x38\x65\x0c\xd3\xae\x1d\xa7\x8c\xb1\xdd\xf9\x20\x2f\x91\xea\x8b\xa7\xe7\x5e\xa4\x37\x50\x85\xf2\x7a\xfa\xdd\xce\x89\x93\xa7\xeb\x9c\x38\xc3\x2e\x29\x4e\x7e\x80\x45\x84\xc1\x69\x36\x36\x87\x89\x4d\x1e\x5b\x82\xd2\x23\xb0\xcf\x43\x4b\x90\x59\xdb\x3e\x06\x9b\xd6\x87\x67\x06\x2d\x77\x9c\x3f\xe1\xbe\x91\xd4\x1c\x4f\x8e\xb5\xa1\x5f\xcf\xeb\xc3\x33\xab\xc2\x47\x3b\xc5\x40\x95\xec\x6f\x84\x56\x7e\xb5\xaf\x1e\x11\x42\xbb\x08\x4d\xa8\xe5\x04\x96\x3d\xc3\x3d\x59\xd5\x10\x22\xae\x73\x6b\x81\xcb\x4d\xbf\x85\x20\x40\xe1\x21\x18\x72\xab\x80\xaa\x60\x38\x50\xa6\xff\x9d\xd5\x5c\x54\xef\x21\x65\xdc\x81\xc1\x58\xa3\x4e\xee\xa3\x68\x9c\x6e\xa2\x64\x58\x30\xc6\xc7\x7d\x6c\xd0\xaf\x1d\x61\x76\xdc\xfe\x1a\x3d\x06\x5b\x03\x23\x14\x8f\xd7\xf6\x72\xc7\x4f\x3f\xfa\x13\x83\x49\x10\x1c\x3d\x46\xc0\x42\x4a\x3b\x64\x48\x0f\x66\x2a\x76\x19\xad\xc4\x34\xd4\x52\xe7\x8c\xa8\x63\x60\x0c\x43\xf7\x45\x19\xc5\x23\x7c\xcc\xb7\x74\xd2\x68\xce\x41\x93\xf1\x71\x5b\x54\x61\xe6\x34\x26\x55\xf5\x39\x02\x4d\xab\x1a\x82\x58\x82\x4f\x63\x3d\x81\xa6\x68\xec\x62\x9f\x18\xa0\x4d\x5c\x5f\xce\x7a\xf6\x8a\x79\x99\x83\x2a\x09\xe9\x72\x05\x7d\x94\x08\x6d\x45\x65\x2c\x68\x9e\x89\xa4\x58\x35\x16\x56\x7e\xbc\xe2\xb6\x32\x77\x3e\xc4\x3a\x4f\x2e\x15\xea\xc0\x45\x75\xe6\x27\xc5\x9f\x55\x60\x4d\x85\x08\xc6\x1c\xe0\x5d\x5c\xa1\x46\x3f\x9c\xc0\x6e\x4f\xaa\xa7\x91\x89\xa0\x02\x1d\xea\x12\x18\xdd\x54\xb0\xcb\x43\x31\xc7\x4c\xf8\xf9\x61\xd6\x10\x13\xe9\x44\xaa\xd2\xb4\x27\x93\x99\xb3\x54\xf6\xa2\x2b\x20\xbc\x8a\x18\x84\xcc\x40\x74\xc2\x12\x9a\x68\x8d\x61\x84\x0d\xcf\x6b\xd8\x2e\x62\xbf\x67\xa4\x50\xc1\x68\x43\xf2\x55\x0b\x94\x29\x08\x39\xeb\x65\x53\x26\x22\x3b\x93\xba\xe0\x23\xa7\x0d\x14\x5c\x90\x88\xa9\xe5\x0a\x4f\x15\xa6\x1b\xfc\x25\x7f\xcc\xca\x57\x79\x05\x8e\xb4\x5a\xa8\x63\x45\x3d\x52\x4d\x56\xbf\x7d\xc2\x45\xfa\xc3\x45\x1c\x2f\x52\xa3\x58\x8e\x53\x3d\x31\x90\x76\x51\xe4\x14\xe2\x83\x5a\x6a\x33\x53\x63\x7b\x4f\xad\x01\xd5\x50\xdd\xe6\xe9\xd1\xf6\x61\x32\x34\xe6\x2d\xe5\xc9\x01\x28\xb0\xb3\xc3\x86\xb1\x27\xb7\x90\x7c\xf7\x38\xbe\x88\xee\x30\x48\x9a\x13\x60\x7b\x27\xfa\x5e\x2a\x40\x31\x75\x8c\x5d\x2d\x96\xac\x85\x9a\xb7\xbb\x76\xbe\x47\x05\x41\x55\x99\xe1\x55\xee\xff\x8a\x28\x35\xad\xe8\x36\x84\xeb\xd2\xdc\x56\xa7\xc9\x42\x20\xbd\xd9\x33\x6c\x21\x16\x65\x93\x00\x07\x4a\xf8\xea\xd5\xaa\x2e\x52\xa2\x2a\xc4\x9c\xe9\xe1\x77\xaa\x5a\x2a\x95\xbc\xda\x5d\xcd\x3b\x27\x81\xef\x4a\xe0\x8a\xce\xf2\xa2\x19\x51\xb8\x98\x09\x8a\x3d\xd9\x47\x00\xbc\x0c\x1d\xa2\xf6\xa1\xef\x0c\x06\xef\x8e\x76\xd2\xc0\xa9\x81\x64\x87\x56\xc3\xb8\x42\xd7\x4e\x26\xae\xa6\x75\x20\x53\x42\x82\xcd\xd1\x60\xe5\x3d\x49\x08\x0b\xc3\x8b\xac\x89\xf5\x35\xc3\xd9\x62\x28\xc8\xe0\x82\xe4\x23\xf4\xd8\x2a\x29\x6a\xd8\x2c\xf4\x99\xd0\x77\x02\x3d\xb9\x69\xf0\xb1\xaa\x1f\xe9\x42\x63\xe9\x68\xa3\xa2\xdd\x4a\x29\x0e\x82\x2f\x81\x9f\x8f\xd4\xde\x53\x9d\xe2\x75\x35\x52\x0d\x0c\x5d\x10\xb6\xa8\x92\x9f\xbc\x50\x7b\xd6\x60\x56\xaa\x8d\x61\x72\x23\x9b\x98\x39\x95\xea\x98\x07\x85\x08\xeb\x0d\x0c\x57\x94\xad\x36\x37\xe4\xd6\x10\x3a\x8a\x7e\xb6\x28\x6e\x8f\xc8\xa3\xd4\xe1\x25\x3b\x6e\xca\x0b\x5c\x07\xde\x10\x37\xf5\x80\x33\x3a\x9a\xae\xb0\xcb\x73\xe0\x5f\xe2\xfa\xe5\x5e\xd2\x9b\x47\x9e\x10\xee\xa8\x3b\x22\x48\xab\xd7\x24\xf1\xa8\xe8\xb1\x33\x99\x26\xcf\x5b\x32\xa0\x42\xa3\x5e\x51\x2f\x9e\x40\xa3\x84\x52\x09\x03\xac\xa1\x1d\x8f\x9a\xec\x95\xa7\x0e\x6e\x77\x4b\x52\x1d\xac\x9d\xd6\xa9\x3c\x80\x4a\x4e\x95\x7a\x44\xd9\xdb\x60\x0d\x50\x93\x5a\xa5\xda\x2c\xd2\x5e\x98\x3b\x4c\x43\x91\x3d\x22\x50\x9a\x21\x9a\x4f\x68\xb3\xfa\x75\x3e\xf9\x9c\x67\xe6\x26\x84\x55\x41\x7d\x35\xa0\x96\x4b\x69\x97\xc0\x1a\x1b\x34\x15\x87\x93\x1e\x71\x80\x41\xc6\xd5\xd6\xb6\x6c\x2d\x93\x67\x42\x64\x37\x9b\x55\xb8\xf5\x0c\x70\x5d\x22\xee\x72\xe9\xcb\x19\xca\x92\xc4\xa5\xe6\xd5\xc2\x92\xa1\xb1\x9b\x79\x5a\x95\x58\x71\xb0\x76\x53\x86\x50\x6f\x96\xb5\x84\x61\x86\x29\xb6\xcc\xd0\x36\xf9\x7c\x24\x18\xc7\x78\x0d\xe1\xde\xb3\x5d\x4c\x9a\x81\xc2\x7c\xff\x93\x49\x3f\x8f\x12\x9a\xb6\xc7\x54\xf4\x28\x5d\x73\x3b\xa6\xa1\x97\xb6\x24\xbe\xa8\x25\xc3\x31\xf0\xff\x61\x53\x4a\xd7\xd3\x77\x04\xbd\x8e\xb1\x77\x34\xde\xe9\x6a\x61\x7e\x15\x03\x5a\xde\x6d\x3c\x1f\x0d\xe7\x51\x3a\xec\xd2\xf1\xf3\x9e\x8d\xa7\x52\xe7\x6d\xd2\x3c\x18\xeb\xbc\x1b\xeb\x97\x90\x4c\x4f\x8d\x70\x94\xe9\xe5\x71\xba\xe6\xb4\x01\xd6\xf5\x78\x9e\xc7\x3e\x66\x0f\x73\x98\xa7\x5e\x59\x78\x51\x65\xc7\x74\x5d\x1f\xd2\x75\xf9\x58\xba\x2e\x1b\x7f\x30\x74\x58\xbf\x35\xb4\x49\x53\xd9\xf8\x97\x66\xbc\x7e\x1f\x52\x3b\xe8\xa1\x39\x14\xde\x24\x35\xb4\x32\xbd\xc0\xcf\xd6\x88\xf9\x4d\x8e\xb6\x87\xad\x3c\x18\xec\x7a\x85\x64\xbf\xe5\xba\x7a\xfd\x88\xae\x50\x70\x8f\x32\x81\xbf\xa0\xae\xb7\xf4\x7b\x17\x89\x76\x43\xdf\x30\xbc\xb8\x1a\x97\xae\x80\x36\xa9\xae\xba\x33\xeb\x07\x63\xdd\xf6\x07\xe4\x1d\xca\x4e\x5f\x0d\x65\xe7\x03\xca\xfe\xfa\x09\x3b\x4d\x6b\x42\x23\xcd\x34\x26\x2c\x3e\x3c\x80\x5a\x54\x6f\x20\x7a\x04\x41\x5a\x0b\x14\x37\x54\x40\x54\x37\xef\xca\x90\xb7\x35\xdd\x19\x9c\xe8\x18\x03\x67\x05\xe0\x13\x63\x3a\xd5\x30\xaf\x95\xde\xcc\x86\x75\xd4\xbe\x2e\xee\x06\x61\x4e\x73\x48\xac\x09\x15\x01\x98\xa9\x51\x1e\xa3\x04\xea\x14\x51\x48\x51\xeb\xbb\xa1\xb0\xdf\x65\x02\x19\x4f\x03\x6f\x7f\x7d\x7c\x9e\xff\xf4\xa8\x15\x3b\x59\x8c\x32\xf7\xe6\x69\xad\x0e\x1b\x7e\xb5\x23\x03\x7b\x60\x1b\xc2\x3b\x99\xee\xd6\x56\x68\x2f\xf4\xd5\x34\x29\x08\x92\x3f\x32\x09\xa7\x35\x76\x2e\x20\x48\x29\x6d\x23\xeb\x15\x2b\xad\xb7\xa2\xbb\x92\x78\xd7\x65\x22\x55\xa0\xdb\x27\x16\xfb\xe4\x3a\x9e\xcf\xb3\x03\x1f\xb5\x22\xee\xe2\x69\x0b\xf3\x5c\x55\x91\x28\x32\x52\x9b\x04\x1f\xce\xde\xbb\x39\x22\xbc\x80\xaa\x0f\xbd\xc0\xcd\x8d\xad\xa8\xa6\xbb\xee\xca\xec\xa3\x8f\xa5\x67\x1a\x8f\x1b\x01\x24\x7d\xe1\x5d\x00\x2d\xbb\x69\x0f\x85\xab\x5c\x57\xe7\x34\xa8\x2c\x59\x75\x25\x5d\x55\xa2\x9a\x92\x55\x51\x42\x3d\x89\xa9\x49\xd4\x77\x7d\xd5\x92\x8c\xab\x9a\x64\x5c\xf5\x24\xe3\x4e\x51\xd2\xf5\x24\x6b\x6e\xa6\x9e\x9a\x49\x33\x33\x15\xcd\xe3\x45\x11\x5a\xb5\x25\xc9\xb0\x6a\x28\xe2\x77\x55\x09\x35\x25\xc3\xb2\x69\x4a\x4c\x51\xb2\xea\x49\x36\x35\x89\x6a\x49\x92\xed\x3f\xb5\x77\xfb\x60\x60\x3b\xb7\xb6\x62\xce\xbe\x59\x93\x74\x35\x46\x97\x9a\xa4\x68\x9a\x24\xf7\xf1\x9a\xa4\x94\x7a\x72\x89\x77\xd5\x24\xad\x83\xa4\x3a\x06\xff\x6a\xda\x3a\x18\xe4\xb2\x2e\x15\xb8\xf1\xb4\xfa\xe4\xf2\xd4\x9d\x1b\xb5\xa5\x85\x5b\xe4\x18\x33\x56\x72\x8e\x73\xbf\x38\x51\xca\xf4\x03\x9f\x2f\x7a\x3a\x60\x35\xe7\x55\x94\x97\xdd\xb9\xc9\x8b\x22\x4b\xc7\xf2\x3c\x53\x79\xbf\xef\x08\x69\x26\x45\x8f\x9a\xb9\xa6\x56\x67\x44\x64\x6f\x26\x21\xf0\x10\xa1\xc7\xca\xf0\xa5\x5d\x3e\x75\x4d\xc4\x5b\xb5\x01\x7c\x46\xdb\x65\xd3\x53\xa3\xf5\x96\x66\x55\xc7\x83\x6e\x90\xe0\x8f\xdd\x43\x37\x47\xbb\xa0\x5c\x8f\xae\xe2\xe9\xc2\x82\x47\x0d\x5d\xb9\x83\xa9\x4a\x7f\xab\xa7\x92\x20\x51\xa9\xec\x41\x59\x59\x35\xfc\xc1\x1a\xb2\x6f\xdd\x2e\x49\xf0\x62\x39\x82\xd7\xb1\x6b\x83\xb6\xdd\x23\xa9\x95\x5a\x26\x51\x27\x76\x4a\xc4\xca\xcd\x17\xb3\x63\x93\xc3\xdc\x36\xc3\x91\x7f\x44\x5e\xb1\x32\xe5\x73\x4d\x76\xc3\xb5\x31\x74\x28\x67\xf6\xe5\xb0\x35\x9a\x56\x16\x3b\x4d\x02\x9b\xb7\x1f\xab\xb1\x0f\x16\xff\x63\xb3\x74\xf7\x70\xbd\xc9\x81\x13\xbd\x4e\xbc\xa6\xa8\x47\xe2\xe2\x7a\x94\xb6\xf8\xa7\xfa\xd3\x4f\x7b\x5d\xa3\xf3\x1f\x6d\x25\x89\x5d\xe0\x5b\x95\x5e\x74\x4c\x8d\x00\xc4\x45\xbc\x48\xb1\xd4\x5a\x09\x69\x81\xf2\xc0\xe8\xdd\x8c\x40\x43\x9c\xce\x19\xeb\x3d\x93\x59\x51\xd7\xb3\xa9\x03\x37\x87\x25\x6a\x36\x37\xa0\x7f\xd1\xcd\x32\xc1\xc2\xbc\xa8\x0f\x2a\xe0\x1e\x09\x34\xc9\x69\x25\x4a\xa5\xaa\x24\xf5\xfc\xd3\x93\x50\x7d\xca\x70\x55\xa9\xb0\x54\x9f\x34\xb8\x0f\x61\x44\xcb\x34\x74\x8c\xc5\xa4\xab\x6a\x4c\xea\x60\x04\x9f\x5b\x34\x89\x66\x6b\xe6\xda\x22\xc6\xa8\xf2\x40\xe6\xbb\x89\x47\xb0\x6c\xf0\x22\x9a\x0e\x29\x15\x4b\x7f\x0b\x3e\x8c\x71\xac\xc8\x51\xe7\xdc\xf6\x75\xc7\xe1\xcc\x72\x94\xcd\xd2\x70\x80\x2e\x3a\xf0\x36\xa4\x4c\x27\x9b\x1e\x5e\x24\x0c\x36\xe2\x9b\xe5\x34\x26\x46\x22\x71\xa9\x3d\x8d\x16\x1a\xab\xe6\xc2\xc2\xd4\x5f\x7e\x49\x0a\xa8\x88\x07\x64\x18\xab\xfa\xfd\xb8\xe6\x75\x69\x55\x55\x74\x01\xf2\x8c\xae\x01\xf7\xe1\x8f\xdc\x4f\x69\x85\xca\xf0\x5d\x5f\x92\xdf\xce\x61\x53\xd2\x21\x7c\xbd\x67\x8e\xfb\x52\xe9\x08\x4e\xf7\x6f\x90\x33\x63\x06\x7d\x3b\xe7\xf4\x18\xba\xe0\x01\x3b\x1e\x4d\x40\x33\x4c\x8f\xbe\x63\x1f\x6d\xd2\xee\xa7\xfc\xfd\xf7\xd7\xbd\x2e\xd2\x8a\x00\x6d\x77\xd6\xd1\x55\x37\x50\x0e\x6f\xa4\x96\x08\x51\x50\x85\x8e\x5b\xae\x20\x2e\x66\xd6\x00\xe9\x64\x09\xe1\xdd\x9a\xd1\xd7\x4d\xb4\x33\x78\x80\x35\x63\x26\xdb\xa2\x49\xc9\xb2\xa1\xfa\x7b\xe5\x5a\x67\x3d\x81\xce\x9b\x86\xe5\xdb\x9f\x7e\xf9\x79\xc1\xef\x0f\x3f\xfe\xf4\xeb\xdf\xff\xe1\xdb\x5f\xcf\x7f\xfe\xfb\xe1\xff\x0b\x00\x00\xff\xff\x7c\xee\xc6\xc9\xc2\xa8\x01\x00")
-
-func _staticFontsGlyphiconsHalflingsRegularSvgBytes() ([]byte, error) {
-	return bindataRead(
-		__staticFontsGlyphiconsHalflingsRegularSvg,
-		"_static/fonts/glyphicons-halflings-regular.svg",
-	)
-}
-
-func _staticFontsGlyphiconsHalflingsRegularSvg() (*asset, error) {
-	bytes, err := _staticFontsGlyphiconsHalflingsRegularSvgBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/fonts/glyphicons-halflings-regular.svg", size: 108738, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticFontsGlyphiconsHalflingsRegularTtf = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xfd\x79\x60\x1b\xd5\xb5\x38\x8e\xcf\x9d\x45\xa3\x7d\xb4\x8d\x64\xd9\x96\x6d\x49\xb6\x64\x79\xb7\x64\x49\x71\x9c\xd8\xd9\x57\x67\x21\xab\xc3\x12\x02\x11\x84\x04\x92\x98\x25\x21\x40\xd8\x42\xd8\xa1\x0d\x2d\x38\x84\xfd\x25\x05\xd2\x90\x90\xb0\x94\xa5\xa4\x2d\x85\x52\xf4\xca\xeb\x2b\xa5\xa6\x7d\x50\x68\x9d\x3c\xe0\x93\xd7\x52\x1e\xd0\xb4\x40\x62\x4d\x7e\xe7\xdc\x19\xad\x76\x02\x7d\xfd\xf5\xbf\x6f\x62\xcd\x5d\xe6\xce\x3d\xe7\xee\xe7\x9c\x7b\xee\xb9\x0c\x61\x18\x46\x62\xae\x63\x38\xa6\x7f\xda\xb4\x45\x73\xd6\x36\x6c\x7f\x0f\x62\x86\xe1\x57\x39\x7d\xca\xd4\x69\x64\x0a\xc3\x33\x0c\x29\x83\x70\xcd\xbc\x85\xad\xd1\x55\x2f\x5e\x78\x2b\x84\xc7\x40\x78\xc5\xca\xb5\xe7\xf4\xbf\xbb\xef\xbf\xaf\x87\xf0\xbd\x0c\xa3\xbb\x64\xe5\xc6\xcb\x6a\x98\x7a\xf6\x4b\x86\xd1\x9b\xe1\x3d\xbf\xea\x9c\x4b\xfb\x4f\x9c\x80\x9c\x19\xbd\x0d\xc2\xfa\x55\x17\x5d\x71\xfe\xd5\x67\xbd\xb2\x1e\xbc\x90\xdf\xdd\xdf\xbb\xe0\xbc\x73\x52\xc2\x9c\xd6\xff\x62\x98\x07\x0e\xc2\xfb\xc4\x05\x10\x61\x9c\xc2\xdb\x21\x7c\x14\xc2\xb5\x17\xac\xbd\x6c\xd3\x5b\xaf\xd7\xac\x60\x98\x07\x21\x3d\x77\xd9\x45\xeb\x57\x9e\xb3\xfe\xf8\x3d\xff\xc9\x30\x8f\xdc\xc1\x30\x6c\xdb\xda\x73\x36\xf5\x93\x35\x0c\x7c\xbf\x13\xbf\xaf\x59\x77\xce\xda\xf3\x9e\x69\x7a\xe4\x7e\x08\x43\x19\xb8\x9d\xfd\xeb\x2f\xbd\xec\x87\xbb\x3e\x8c\x33\xcc\x63\xd7\x31\x8c\xf1\xd7\x97\x9f\x77\xee\xf9\xaf\x96\x2d\x9a\xcf\x30\x4f\x2d\x82\xf4\x3a\xf8\x61\xe9\x99\xff\x18\xbf\xf3\x57\xe8\xbe\xb9\xf1\xfa\x0d\xaa\x7b\xe9\x76\xfa\xc6\x0a\x3f\x2c\x39\x00\x83\x30\x21\x6e\x78\xf2\x34\x04\xd9\xf3\xb7\x93\x6d\x8c\xc0\xf0\x9c\x99\x7d\x13\xc2\xa7\xab\x2e\xf9\x1e\x13\x65\x5f\xc0\x4f\x04\x26\xff\x8f\x2d\xf0\x2f\x9e\xbd\x74\x2e\x33\x81\xa9\x39\x71\x82\x7b\xf9\x04\xd4\x8b\xe0\x62\xae\xca\x62\xa2\xfd\xab\xa1\x21\xcc\x00\xea\x8e\x69\x82\x9f\x11\x12\xbc\xa7\xa6\xe1\x2f\x80\x58\x42\xe3\x39\x2d\x9e\x27\x07\x20\x7c\x01\xe4\x2a\x30\xf5\xf0\x75\x23\xf3\x08\xf3\x68\x8d\xb1\xa6\xb5\xe6\xec\x9a\xbd\x35\x3f\x0a\x38\x82\x15\x75\xc3\x21\x12\x3a\x16\x36\x84\xa5\x21\x6e\xc8\x30\xe4\x1d\x8a\x0c\x75\x0e\xcd\x1c\x5a\x36\xb4\x62\x68\xf5\xd0\x15\x43\xb7\x0e\x6d\x3f\x64\x38\xe4\x3d\x14\x39\xd4\x79\x68\xda\xa1\x99\x87\x96\x1d\x5a\x7d\xe8\x8a\x43\xb7\x1e\xba\xe7\xd0\x8e\xc3\xba\xc3\x86\xc3\xee\xc3\xde\xc3\xfe\xc3\xe1\xc3\x9d\x87\x67\x1e\x5e\x76\x78\xc5\x97\x27\xa0\x5d\x11\xcf\x06\x0a\x89\x39\x09\x24\x32\x24\x0c\xd9\x86\x6a\x86\xda\x86\x26\x0c\xcd\x07\x48\xe7\x0e\xf5\x0f\x5d\x37\xb4\xed\x10\x39\x64\x3b\x54\x73\xa8\xed\xd0\x84\x43\x33\x0e\xcd\x3f\xb4\xe2\x50\xff\xa1\xeb\x0e\x6d\x3b\xb4\xfd\x30\x03\x90\x6c\x87\xcb\x00\x52\xf0\x70\xdb\xe1\x09\x87\xe7\xab\x90\x4e\xfc\xf7\x89\x77\x4f\x9c\x7f\xe2\xdc\x21\xfd\x1f\x3f\xf8\xe3\x0f\xfe\xb8\xfa\x8f\xcb\xfe\x20\xbc\x97\x79\xd7\xf7\xae\xf7\x1d\xff\x3b\xce\x77\xac\x35\xbe\x9a\xca\x1a\x77\x8d\x0d\x4a\xcc\x57\x2b\xd5\x5f\x56\x7f\x51\xfd\x59\xf5\x9f\xab\x3f\xac\xde\x5c\xbd\xb1\xba\xbf\x7a\x4d\x75\xaa\x7a\x65\xf5\x59\xd5\x4b\xab\x4f\xab\x9e\x5d\x3d\xb5\x7a\x4a\xd5\x1f\xaa\xde\xab\xfa\x6d\xd5\xdb\x55\xff\x59\xf5\xcb\xaa\xd7\xaa\x7e\x52\xf5\x62\xd5\x33\x7a\xb9\xa8\x05\xfe\xb5\xff\x70\x74\x30\xec\x1d\x5a\x28\x4e\x6b\x52\xfd\x61\x7b\x37\xc0\xaf\x11\x1b\x1a\x7e\x8f\x68\x3f\xec\xab\x8f\x6a\x3f\x51\x4b\x0e\x6d\xcf\xe8\xc1\x6d\x55\x7f\x8c\x13\xdc\xb3\xd5\x1f\xe3\x02\x77\xaf\xfa\x63\x64\x70\x7f\xa4\xfe\x18\x37\xc3\x04\x1c\xea\x8f\xf1\x30\x4c\xb0\x42\xfd\x61\x2f\xaf\x1b\x56\x7f\x8c\x97\x61\x42\x44\xfd\x31\xe5\xe0\x1e\x53\x7f\x0c\xa4\x0b\x1b\xd4\x1f\x53\x09\xae\xa4\xfe\x18\x1f\xc3\x0c\x41\xda\x21\xc4\xbd\x0a\x5c\xe8\xb7\x43\x98\xc6\x0f\x2e\xf4\xef\x21\xc8\x8f\x09\x81\x0b\x48\x0f\x45\xc0\xdf\x06\x2e\xfe\x3a\xc1\x3f\x16\xdc\x09\xf0\x9b\x09\xfe\x29\xe0\xc2\xd8\x1c\x5a\x06\xfe\xb9\xe0\xae\x50\x7f\x4c\x1f\xb8\xe7\xc2\x6f\x35\xf8\xe1\xdd\x50\x3f\xfc\xae\x00\xff\x39\xe0\x5e\x07\x3f\x98\x8f\x98\x0b\xc1\xdd\x06\xbf\xed\xe0\x87\x31\x7c\x08\xf0\x39\x84\x38\x5c\x0d\x2e\xe0\x70\x08\x71\xb8\x09\x5c\xc0\xe1\x10\xe2\x00\x69\x0f\x01\x0e\x87\x10\x87\xfb\xc0\x05\x1c\x0e\x4d\x03\xff\xf7\xc0\x9d\x01\x3f\xc4\xe7\x09\x70\x01\x9f\x43\x88\xcf\x3e\x70\x01\x97\x43\x88\xc3\xf3\xe0\x02\x0e\x87\x10\x87\x9f\x82\x0b\x38\x1c\x42\x1c\xde\x00\x17\xf3\xbd\x07\xfc\x6f\x83\x0b\xb8\x1c\xda\x01\xfe\xdf\x33\xcc\x61\x70\x0e\x63\x1b\xfe\x01\x5c\x83\xfa\x63\x3e\x04\x17\x70\x3b\x0c\x6d\xc2\x7c\x04\x2e\xb4\xc1\x61\xc4\xf3\x2f\xe0\xfa\xd5\x1f\xf3\xbf\xe0\x06\xe1\x17\x06\xff\xa7\xe0\x02\xce\x87\x11\xe7\xbf\x81\x0b\x38\x1f\x46\x3c\x61\x24\x1e\x06\x3c\x0f\x03\x9e\x04\xf3\x5e\xa1\xfe\x08\xf4\x87\x2f\x4f\xa8\x3f\xe2\x62\xc8\xdf\xec\xf4\xc7\x10\xe8\xe9\x7f\xdf\x43\x7f\x0c\xcc\x68\x3a\x16\xfb\x11\x76\xfe\xd2\xfe\x4f\x72\xd3\x16\x7b\xaa\xce\xcc\x64\xbf\xe4\x46\x79\xc3\x17\x4d\x83\xff\xdf\x3f\xfc\x27\xfe\x03\x69\x75\x5f\x9b\x42\xfe\xa6\x59\x41\x1f\xf8\xba\x7f\x74\xed\x66\x08\xcc\xed\x2c\xfc\xaf\x07\xef\x05\x5c\x0d\xb4\xab\xc8\xb4\x3c\x45\x98\xd6\x71\x4f\x8b\x3c\xf3\x97\xe8\x53\x3a\xe1\xbd\x71\x4f\x73\x2c\x78\x99\xa7\x38\x8c\x16\x30\xfa\x69\x51\x47\x86\xc7\x3d\x4d\x30\x3e\x66\xf7\xdb\xeb\x62\xf6\x60\x3d\x99\xa0\x1c\xf9\xf4\x53\xae\x66\x78\xa8\x1e\x56\x47\xc2\xa4\x98\x14\xdf\xcb\xf7\x32\x67\x40\xde\x51\x77\x35\xa9\x22\x3e\x92\xec\x26\xd5\xc4\xad\x93\x48\x2c\xea\xf6\xb8\x65\x17\xf8\xac\xa4\x91\x78\xaa\x88\x44\x74\xa1\x56\x02\x31\xa2\x2e\x18\x00\x5f\x0b\x19\x4f\x44\x2b\x69\x25\xa1\x44\x0f\x81\x98\x70\x28\xde\x01\xbe\x6e\xd2\x45\xc2\x2d\xa4\x87\x24\x20\x47\x88\x49\x26\xd8\x3e\x29\x64\x32\x19\xa5\x87\x44\xb7\x7e\x8d\xc8\x8a\x0f\x0d\x19\xed\xac\x8e\x63\x09\x6b\x37\x0e\x3d\x04\x11\x6b\xf4\x6e\xf1\x21\xc9\x18\x09\x7c\xc3\x74\x7c\xef\x3f\xf1\xf1\xa8\xe9\x68\x93\xe0\x83\xd6\x07\x8c\x71\x2c\xbe\x6c\xf7\x47\xdd\x3e\xe2\xd2\x05\xfd\x76\x97\xae\x91\x04\x42\x71\xbb\x3f\x10\x1a\x4f\x3a\x12\x31\xbf\xbd\x23\x41\x5e\x49\xcb\x3e\xb2\x5c\xf6\xf9\x64\x65\xa7\x4f\x86\x80\xb2\x13\x03\x64\xb9\x8f\xef\x1d\x19\x27\x6b\xa9\x55\x58\x30\x67\xf1\x13\x00\xd6\x54\x15\x96\x48\x74\x22\x40\x53\x2b\x5b\xce\xfb\x54\xb8\xe3\x09\xc0\x55\xab\x36\x9e\xf3\x85\xc2\x50\xf3\x5d\x24\x0a\xcd\x15\x85\xda\xe6\x3a\x8f\x19\x05\xbd\x72\xb7\x4e\x20\x66\xa3\x4e\xb7\x49\x57\x66\xbc\x9a\x38\xb3\x3e\xc9\x74\xb7\x49\x52\xfe\x92\x0d\xe6\x7c\x1b\x05\x9d\x72\xb7\x5e\x30\x1e\xf3\x3a\xbe\xa7\x77\xe9\xbf\xe7\xe0\x7b\x8d\x98\x89\xd9\xac\x7f\x44\x6f\x4e\xd1\xe7\x93\x26\x49\x32\x3d\x59\x10\x61\xd6\x91\x8b\xf4\x46\xf8\x00\xd2\x33\xb4\x1f\x09\xfc\x1d\xfc\x93\x4c\x17\xf6\x23\x8f\xdb\x13\xec\x68\xe1\x82\x01\x2b\x27\xfa\x45\xbf\x4e\x86\x47\x15\x1f\x8b\x76\x73\xf1\x18\xed\x3a\xd0\x39\xc2\xc1\x64\x2c\x91\x84\x47\x37\x81\xee\x71\xe4\xf3\xfe\x71\xba\x1f\x04\x3b\x26\x74\x7a\x9c\x8d\x63\xa2\x6d\x12\xb9\x2a\xa5\x0c\xea\xc8\x00\x3c\x0d\x6d\x9d\x93\x3a\x64\x77\xe7\x84\x8e\xe0\x93\x55\xe7\x5e\xf8\xf8\x7f\x6e\xac\x9d\x64\x7e\x27\xd5\x4f\x84\x77\x53\x37\xb9\xba\xab\x37\xf0\x4f\xfe\xe8\x82\xd5\xcd\xb3\xdb\xfc\x92\xa9\xaa\x65\x5e\x34\x15\x8d\xa6\x66\x5d\x36\xa9\x51\x92\x02\x6d\xb3\x1a\x9f\xb8\x70\xc3\x43\x6d\x7b\x2e\x4f\xb5\xc6\x53\x4b\x6f\xac\xbb\x13\xe7\xce\xab\x68\x1b\x1f\x60\xba\x99\xe9\x80\xad\x3f\x5a\x25\xc8\x95\x50\xcd\x56\xa1\x91\x88\xdf\xa8\xda\xa1\xe1\x3d\xf6\x58\x34\x11\xef\x08\x05\xc9\x5e\xb2\x7c\xf2\x9a\x49\x6d\x6e\x33\x21\x66\x77\xdb\xa4\x35\x93\x9f\x66\x49\x8d\x56\xb5\xb7\x99\x8c\xf7\x18\x25\xe5\x6f\x5a\x70\x43\xde\x27\x3d\xf7\xc6\x84\xe6\xe6\x09\xfc\x81\xf2\xda\x9e\xe8\xbc\x86\x5a\x93\xc9\x54\xdb\x30\x3f\x3a\xa1\xae\x5c\xad\xdf\xfd\x26\xab\x64\xdc\x5f\x50\xe1\xe4\xf7\x46\x29\xad\x0c\x4e\x5a\xb2\x68\x02\xd6\x77\x9a\x6c\xe3\x7b\xd9\x83\xc0\x29\x30\xce\x5c\x17\x55\x3b\xe6\x31\xae\x06\xbb\xdb\xf0\x10\x3c\xd9\x83\xb4\xcf\xd1\x07\x83\x65\x4f\xc3\xb2\x70\x04\xca\x5e\xc7\x4c\xc4\x95\x07\x0b\x89\xc3\x19\x87\x7d\x0c\xb3\xf1\xc3\x70\xed\xa2\x63\x16\xc7\x76\x10\x73\xf4\xc8\x2e\x37\x8c\x7d\x48\x65\x25\x34\x41\x08\x9a\x0f\x12\x74\x43\x02\xee\x88\x4f\x8e\xae\x3c\xed\xb4\x95\x51\xd9\x37\x3c\x94\xf7\xa7\x27\x26\x2b\x6b\x6a\x2a\x93\x13\xc9\xb6\xac\x8f\x3f\x30\x20\xfb\x52\x67\xdc\x13\x0c\xde\x73\xc6\x80\x4f\x1e\x80\xd0\x80\x1a\x4a\x41\x28\x9d\x1a\xb7\xb2\xcc\x1c\x1d\x17\x35\x97\xad\x1c\x37\x30\x50\x10\x48\x31\x25\x2b\x61\x3b\xce\x88\x9f\x93\x26\xfe\x15\x7e\x22\xd2\x4f\x30\x6f\x79\x12\x38\x56\xd5\x2a\x40\xdc\xf1\x41\xbe\x58\xf7\x83\xa6\xa6\x96\x4d\x7b\xf6\x6c\xca\x38\xe6\x5d\xbc\x78\x12\xfb\x5f\xfc\xc4\xcb\xcf\xb1\xee\xdb\x74\xc5\xbe\x4b\xe6\x4f\xbd\xd0\x6a\xf6\xee\xf8\x1d\xa3\xae\xa5\x29\xfe\x00\xbf\x1f\x68\x2b\xa0\x96\x18\x9c\xe5\x0a\xeb\x82\x84\x43\xdd\x04\xe6\xb1\x64\x82\xf3\x87\x39\xf6\xef\x35\x06\xe3\x2d\xe4\x37\x55\xae\xaa\xe3\xf3\xe1\x41\x06\x6f\x36\x1a\x6a\x6a\x8d\xeb\xfb\x0d\x57\x92\x2b\x9e\x7b\x95\x7f\xc6\x19\xb4\xbd\x94\x71\xd7\xc9\x0d\x0d\x72\x1d\xfb\xa7\xe7\x6d\xb5\x2e\x83\xed\xee\x01\xdb\x70\x55\x3f\xeb\xa6\xd3\x8b\x06\xaf\x97\x31\x01\xc5\x17\x64\x92\x6a\x0b\xc2\xe8\x0f\xe0\x68\xf6\x10\xb7\x0b\xfc\x30\xcf\x74\x24\x0c\x30\x81\xca\x30\xef\x40\x7c\x87\x08\x33\x32\x16\x2f\x49\x12\xd9\xe1\x1e\xf5\xf2\xd7\xc0\x78\xcf\xfc\x07\x8c\x5a\x78\x08\x1c\xd1\xeb\xf5\xca\x97\x7a\xa3\x91\xbb\x03\xbc\x46\x23\x06\xce\x84\x71\x2a\x18\x8f\x6f\x81\x84\xe0\x83\xe1\xad\xfe\x70\x8c\x67\xfe\x5d\xaf\x67\x93\x7a\xe3\xbb\x90\xcc\x85\x69\x05\x23\x1b\x32\x0a\xca\xa7\xf0\xb1\x60\xcc\xbc\x8b\x9f\xe8\xf5\xae\xeb\x60\x76\x80\xbc\xe0\x6b\x3d\x0e\x77\xa0\x70\x19\xee\xc4\xa7\x27\x3e\xe5\x7f\xc8\xff\x90\x31\x30\x16\x06\x79\x49\xc4\xc9\x25\x91\x70\xd2\x49\xc2\x06\x22\x38\xb9\xa1\x90\x75\x87\xc5\xb2\xf2\xb7\x67\x07\x32\x3b\x7e\xcb\x9e\xaf\x54\x2b\xcf\xac\xe3\x7f\x68\xd9\x61\x0d\x59\xcf\xfc\xed\x0a\xe5\xb2\xcc\x7d\x10\x3b\xbc\xa6\x9f\xcc\xcb\xcd\xbb\x07\xa0\x5f\x42\x7f\x26\xf6\x82\xda\xb7\x13\x3f\x7b\xf0\x98\xda\xb9\x8e\x29\x66\xfe\x00\x7b\x1b\x30\x1a\x72\x14\xfa\x18\x1b\x62\x43\xf4\x5b\x2b\xa3\x87\xf1\xfc\x00\x53\x0d\x81\x3a\xb5\xc6\x44\x5d\x0b\x81\x65\xc7\x63\x17\x0a\x03\xd0\x29\xfa\xd9\x1b\xc3\x6d\x0b\xa7\xcd\xbb\xa5\x76\xe9\xbc\x19\x93\x32\xbb\xfb\x26\x6a\x5e\x1f\xf7\xd5\x2e\xa9\x2a\x30\xfc\xd3\xe4\x69\x1e\x6f\x64\xed\x25\xde\x32\x1b\x6b\xb8\x3f\xb3\xb3\x61\x85\x5c\x1e\x59\x77\x71\x79\x99\x9d\xbd\x36\xd4\x40\xfb\x8b\xe1\xc4\x97\xfc\x4b\xfc\xe3\x40\x67\x23\xe5\x1a\xad\x62\x65\xd1\xe3\x12\xe9\x8a\x89\x6d\xa8\x0b\x86\xc5\x40\x0b\xdb\xd1\x4d\xf8\x80\x95\x40\x17\x89\x76\x93\x8e\x10\xd9\x96\x7e\xee\x86\xb9\x84\xcc\x25\x4e\x68\xe2\xae\xf2\x88\x53\xf9\xcb\xe5\xdf\x12\xd8\x14\xc4\xce\xbd\x81\x5c\x72\xe0\xee\x25\x4b\xee\xc6\x07\xff\xf8\xdc\x1b\x9e\x4b\xb1\xc2\x9d\x57\x28\x9f\x94\xd7\x95\x8d\x75\xb9\x88\x63\x0e\x7c\x77\xc3\x73\x90\xc1\x19\x6a\x1a\x78\xd0\x39\xb7\x8f\xdf\xcf\x4f\x41\xfe\x80\x74\xb3\x80\x86\x0b\xe6\xdb\x16\x3e\xde\x01\x01\xc2\xde\xda\x76\xd5\xa6\x0d\x7d\x89\xc9\x57\x6c\xdd\xdd\xdd\xfd\xf8\x8d\x9b\x26\x8f\x39\x7d\xe3\xa6\x2b\xb8\x1f\x4c\x99\x21\x34\x2f\xdc\x38\xa1\xf3\x8a\xeb\xae\x7d\x7a\xf1\xe2\xa7\xaf\xbd\xee\x8a\xce\x09\x1b\x17\x36\x0b\x33\x28\xa5\xf1\x1b\x66\x39\x7f\x15\x7f\x37\xf2\x1f\xc4\x99\x88\x7a\x68\xd7\x14\x9c\x6e\x5d\xb8\x4e\xd0\x85\x92\xce\xba\x50\x22\x46\xb6\xdf\x20\xca\xe2\xf5\xe4\x51\x59\x67\x57\xf6\x6c\x11\x25\xbb\xf2\x7d\x65\x8f\x5d\x12\xb7\x28\x8f\xda\x75\x2e\xae\x86\x9c\xe3\x74\x2a\x0f\xdb\xcd\x5f\x29\xeb\x5d\x26\xf3\xdf\xbf\x30\x9b\x5c\x64\xdb\x71\xb3\x9d\x61\x0b\xf2\x0f\x9c\x02\x82\x10\xf4\x88\x49\x4f\x38\x19\x0c\x7f\x0d\x2c\xf2\xd7\x4f\x5f\x9b\xf5\xd3\x57\x67\xbd\xfc\xa7\xb9\x27\x07\x9b\xfa\xd6\xe1\xdb\xef\xf8\xf0\x8e\xdf\x14\xf6\xb3\x10\x9d\x37\xe8\xb4\x89\xd3\x9b\x80\x13\x60\x7e\x0a\x4d\xd6\xe1\xe4\xa6\xfa\xd9\xbe\xcd\x07\xba\x43\x66\x03\x39\xdf\x60\x96\x70\x20\x49\xaa\x3f\xd4\x0d\xf9\x1c\xd8\x7c\xac\xe5\x82\xd9\x46\x8f\xee\x23\xc1\x63\x3c\xcb\x28\x49\xf0\xf0\x08\x1f\xe9\x3c\xc6\xd9\x17\xb4\x1c\xdb\x8c\x52\x00\x8b\x06\xb3\x17\xfa\xb6\x13\xc6\x7b\x18\x78\xc9\x56\x26\x06\xe3\x7e\x3a\x33\x9b\x99\xc7\x2c\x64\x96\xaa\x33\x00\xf6\x5b\x3a\x81\xe1\xf2\x22\xc7\xe2\x11\x12\xd0\x41\x9c\x3b\xe6\x87\x95\xc6\xde\x11\x8a\x41\x9c\x00\x3f\x7f\x81\x2b\xfa\x8b\xd3\x94\xbe\xc7\x19\x42\x52\xb1\x96\x16\xa7\xd8\x5d\x30\xa8\x21\x08\xa3\x5c\x92\x2e\x4d\x0d\x97\xa5\xb8\x1a\xed\x79\xa8\xe0\xcd\xf0\xff\xe6\xe3\xf9\x5e\xf8\xfa\x23\x7c\xc5\x97\x1b\xa5\x54\x2a\x25\x19\x95\x9d\x18\x24\xcb\x31\x98\x86\x18\xf5\x51\xf2\x22\x1b\xad\xce\x77\x2a\x4d\x25\xc1\x08\x6d\x65\x7a\x46\x96\x36\x42\x8a\xc3\xe4\x6b\xde\x47\xc9\x36\x4a\x71\xf5\xe3\x0a\xb7\xb6\x20\x90\xf9\xd1\xc9\xde\x50\x6a\xac\x9f\x92\x61\xf9\x48\xea\xcf\xec\x3e\xd9\x1b\x86\x31\x8c\xc0\x7d\x1e\x73\x36\xb3\x9e\xb9\x96\xf9\x36\x94\x22\xa6\x76\xa0\x46\xad\xa3\xf8\xbf\x26\x4c\xfe\xc5\xe9\xa3\xd9\x85\xde\x47\x1e\x1d\xcd\x3b\xfc\xb7\x7f\x36\x01\x56\x62\x2e\x30\xca\x43\xe9\xff\xe7\xde\xab\xdc\x95\x36\x56\xf2\xf5\x3d\xa2\xa6\x23\xa4\x98\xe2\x29\xad\xa9\x7f\xf4\x7d\x61\xcd\xb1\x07\xd1\x9f\x99\x02\x4f\xe5\xc6\x7f\x2c\x7e\xf4\xea\x19\xbd\xd0\xa3\xc7\xaa\x7c\x41\x88\x69\xe2\x8f\xf0\x35\x28\x0f\x32\xa8\xab\xbd\x4b\xa3\x0e\x3a\x7a\xb4\xd5\x9f\xfc\x9a\xed\xd2\xbb\xc4\xa7\xf4\xfa\xe1\xcf\x60\x7e\x54\xae\xd3\xeb\x9f\x12\x5d\xfa\x27\x44\x37\x79\x1f\xde\xd0\xc0\xf0\xa7\x7a\x3d\xb9\x4e\x4d\xf5\x84\x08\xf9\xae\x63\xd6\xf1\x93\xf8\x49\x94\xb7\xf1\x40\x9e\x06\xba\x64\x43\xc6\x06\x78\x00\x0f\xd7\x91\x34\x68\x20\x3c\x08\x97\xbb\xe5\x69\x20\x27\xfe\x46\x4c\x7a\xfd\xd3\x7a\x80\x71\x54\x39\x2a\xca\xe0\xd5\x13\x93\xf2\x37\x35\x8e\x98\x89\x59\x94\xf9\xae\x67\x4e\xf9\x5e\xaf\xe6\x63\x16\x81\x73\xf5\x9c\xf8\x33\xff\x1a\xbf\x03\xd6\xac\x3a\x66\xa6\xb6\x7a\xe2\xda\xe9\xca\xae\x9d\x23\x57\x4e\x81\xb6\x5e\xae\x09\x73\x84\x79\x50\x6d\x3c\x4a\xad\x92\x1d\x3f\x7b\x66\xcb\x9c\xb9\xa4\x49\xaf\x5f\x0b\x00\x95\xc1\xcb\xbf\x75\xee\xb3\xd7\xcf\x99\x73\x3d\x59\xff\xcc\xbd\x7d\x7d\xf7\x3e\xb3\xa3\xaf\x4f\x39\x38\x60\x94\x66\xe3\x14\x35\x5b\x32\xe6\xbd\xfc\x8e\x39\x5b\x9e\x39\x17\x56\xdb\xb7\xf5\xb2\x1e\x68\x1c\xd2\x08\x9f\x3d\x8b\xd9\xf5\xed\xd0\xbe\x0d\x96\x7c\xa2\x79\x99\x92\xf2\xc4\xbf\x61\x79\x4a\xe8\xf5\x6f\x84\x7b\x1f\x4e\xab\xda\x14\xfb\x0d\x30\xbe\x99\xe2\x48\x1f\x0c\xa5\x59\x3c\x8c\x87\xdf\x41\xe9\xab\xe6\x2c\x67\x9b\xe5\x67\x3b\x12\x42\xdc\xed\x00\xe2\x81\x05\x5c\xe3\x1d\x8e\xa4\x6c\x85\x4a\xa6\xc8\x02\xad\xcf\x86\x52\xd8\x39\xf1\x41\xa4\xc7\xdf\x3a\xe3\xfe\xdf\xfe\xe9\xb7\xf7\x9f\xf1\xd6\xe3\xab\x36\x5f\xf2\xda\x91\xd7\x2e\xd9\xcc\x1f\x28\x9c\x32\x0f\x3d\xd6\xad\xbc\xf3\xc2\x06\x48\x01\x09\x37\xbc\x40\xc2\xdd\x8f\x25\xd3\x9b\x2e\x7b\xed\x92\x4b\x5e\xbb\x6c\x53\x1a\xd6\x82\x54\x8e\xce\xd3\xd6\x82\x52\x5c\xbe\x2e\x2c\x94\x8c\x65\x9e\x64\x0b\x2a\x29\x7f\xf8\x1a\x2f\x7f\x40\x5b\x0a\x8d\xb8\x2c\x2a\xfd\x92\x31\xe3\xa0\xab\xde\x27\x10\x1a\x84\xda\xed\xa6\x0b\xd8\x2b\xc0\x69\x49\xc6\x63\xe8\x3f\x96\xad\xbf\x72\xa6\x82\x1f\xe0\x07\x60\xcd\x9e\x87\x94\x43\x35\x8b\x1c\x81\xdb\x23\x7a\xdc\xe0\x95\x5d\x12\x0b\xc4\x9f\x47\x27\x86\x45\x1d\x78\x83\x81\x56\x36\xd4\x4a\xc4\x50\x38\x19\x0e\x81\x37\xde\xd1\xc3\x26\x7a\x48\x38\x91\xf4\x24\x13\xe0\x75\x04\x74\x2e\x77\x34\xd1\xc1\xf6\xf9\x23\x21\xa1\xbd\x59\xb8\x69\x7c\xe3\x7c\xce\x6b\x25\xf7\x0a\xc2\xbd\x44\x2a\xe3\xe6\xb7\x8d\xb9\x49\x68\x8a\x0a\xa1\xc6\xea\x6c\x8a\x71\x4d\x90\xc2\xc2\x6e\xd7\xe9\xb6\xb3\x16\x2f\x37\xbf\x75\xec\x8d\x42\x73\x3b\xa4\xd8\xf4\xf4\x35\xd7\x3c\x7d\x0d\x3f\x00\x5f\x5a\xbd\xec\xfc\xb6\x4e\xf8\xb2\x5d\x17\x6a\xac\x0a\xd4\x87\x74\xed\x4d\x90\x77\xd3\x69\x90\x37\xbb\x5d\x10\xb6\xb3\x56\x2f\x77\x1a\xa6\x68\x6e\xd3\x85\x1a\x6a\xfc\x90\xa2\x0d\xa1\x37\xcd\x67\x29\x74\x65\xc7\x35\x4f\x5d\x73\xcd\x53\x8c\xa8\xb6\x93\xe0\x02\x8a\xc5\xc3\xf8\xa1\x6f\x47\xa0\xdf\xb4\x53\x6e\x19\x49\xf3\x2c\x7d\x1e\xc3\x87\x47\xf6\xc7\x49\x6e\x75\x06\xc6\xb8\xe8\x3f\x39\x4a\x9a\x22\x5d\xc4\x69\x94\x8e\x3f\x0b\x75\xeb\xec\x8a\x90\x26\xd2\xd4\x15\xc9\x4c\x89\x74\xa5\xb4\x7f\x82\xab\x2b\x02\xf4\xc3\x6c\x18\x53\x29\x1a\xab\x0c\x0e\x0f\x45\xba\xba\x22\x40\x80\x64\xa6\xb0\x07\x0b\x7f\x30\x8f\x99\x19\x46\xd0\xf3\xbf\x46\x7a\xdf\x89\x93\x15\x50\xde\x8d\x24\x2f\x9e\xc9\xf9\x60\x82\x70\xb0\x17\xc1\x34\xc4\xae\x00\x7e\x66\x3f\x6d\x4d\x6c\x66\x70\xf6\x1b\x05\x76\x0d\xbb\x42\x14\x33\x8f\xe8\x8d\x99\x9f\xd2\x66\xbf\x5c\xb9\x95\x76\x86\x1e\x23\xb4\x77\x4a\xe3\x93\xed\x38\x0b\x13\x4a\x61\x15\x10\x20\x31\x75\xf2\x61\xfb\xb0\x34\x1a\xab\x2d\x73\x0b\x80\x4f\xe1\x0f\x28\xfd\x91\xae\xcc\x46\x8c\xe0\x7b\xd5\x79\xfe\x18\xce\x13\x74\xfc\xed\x80\x7e\x5f\x81\xf2\x76\x9c\x27\xd4\x01\x37\xca\x64\x27\xdb\x0b\xfa\x38\x1d\x03\xff\xfd\x27\x75\x44\xa9\x0e\xf9\xc9\x11\x1c\x50\xf8\x50\x36\x47\xb1\x30\x6a\x4f\x85\x89\x21\x97\x06\x9d\x2e\x35\x0d\x3c\x2c\xd0\xb7\xff\x22\x19\xa3\x59\xfa\x8c\xf6\xe9\x13\x0f\xe0\x5e\x17\x94\xd1\x84\x5c\x57\x84\x70\x41\xce\x4f\x62\x1c\xfc\x27\xf0\x10\x5c\x99\x8f\x22\x9f\x47\x32\x1f\x91\xfd\xbf\x96\x77\xba\xde\x54\x1e\xaa\x18\xaa\x20\xdb\x94\x7e\x28\xe1\x20\x69\x52\xfa\xf1\xa9\x8e\x8d\xec\x98\x96\x61\x44\x6b\xe3\x39\x96\x67\x68\xa9\xb8\x24\x3b\x8a\xb3\x15\xd8\x45\xb2\x5d\x88\x0d\xc1\xba\xf7\x13\x97\xc9\xa2\xbc\x68\x09\xe1\xc3\xe4\xfa\x89\x8f\x5d\x44\x51\x55\x09\x4f\xf0\x72\x5b\x25\x3a\xcf\x74\x7b\x6c\x4a\xbf\xcd\x46\xb6\xd9\x3c\xe4\x15\x64\x0a\x0b\x89\x4e\xc9\xb8\x1f\x6a\xa2\xa4\xae\xdb\xbf\x49\x5d\x8b\x1c\xa0\xca\x65\x51\x3d\x45\x65\xc3\x24\x72\xab\x6c\xb4\xfc\x11\x30\xfd\xa3\xc5\x28\xdf\xfa\x35\x35\x0e\xd8\xfe\x05\xb0\x75\x02\xb6\x4e\x5a\xe9\xdf\x1c\xb7\x3a\x67\x51\xa7\x86\xee\x96\x04\x66\xe9\x14\xb8\xfd\x3f\x15\x21\x63\x1e\xc5\x93\xe2\x46\x00\x2b\x8f\xf2\x17\x5a\x6f\x4e\x0f\xe2\xc7\x68\x72\x10\xb5\x1d\xbd\x4c\xa7\x4a\xa3\x7b\x9c\x1a\x33\x8d\xcd\x16\x0e\xc5\x9d\x1d\x49\x67\x37\x11\x80\xe7\x80\x1a\x73\x77\x69\x02\x63\x70\x35\x99\x6f\x34\x11\xe6\x5a\xc8\x21\x98\x4e\x9d\xdc\x0f\x45\xbd\x60\xf6\x1e\x7f\xd6\x5c\xc5\xea\x88\xf8\x22\xe7\x64\xb7\x67\x06\x8d\x0e\x76\x29\x6b\x06\x1c\x9d\xc0\xbc\x3b\xa1\xcf\xaa\xee\x80\xd1\x0c\xf1\x0e\xfe\x80\xd5\x98\xb9\xac\xcc\xab\x2c\xc5\x8f\xf4\xbc\xc5\x4b\xbe\xef\x2d\x63\xef\x30\x5a\xd3\x56\x93\xd2\x6d\xb2\x5a\x8d\xf7\x1a\xb5\x87\x89\xbc\x62\xb2\x96\xd4\x67\xf8\x54\x6d\xed\xa1\x4b\xf2\xd7\x0d\xa7\xdb\x33\x76\x7b\xc6\x56\x56\x76\xd2\xba\x3b\xeb\xc7\xe6\x5a\xf3\x8f\xcd\x26\x5c\xed\x4c\x30\x17\x65\xd7\x55\x94\xcd\x27\x13\xb9\x3e\xde\x43\x42\xc1\x1c\xf4\x78\x2c\x8b\x12\x1b\x8b\x72\xcf\xdc\x2c\x9a\xa0\x73\x0c\x98\x78\xf1\xc6\xb5\xd7\x5f\xa6\x81\x1e\xc8\xc3\xdb\xf0\x32\x5f\x7b\xb3\xc8\x9b\x14\x68\x4a\x93\x78\x63\xaf\x06\xfb\xb2\x0d\x79\xa4\xb3\x6b\x3a\xa1\xb0\xcb\x91\xf2\x18\x05\xba\x1c\xa4\x10\x9d\x08\x3e\x18\x80\xa5\x49\x9d\xbb\xa8\x88\xd3\x1d\x03\xc4\x54\x5c\x4c\xca\x76\xc0\xe5\xa6\x75\x88\xcb\x00\x85\xff\x67\xd5\x79\xe0\x26\x7c\x4b\x2e\x30\x0a\xfa\x1b\xd7\x6d\x81\xb7\x1a\x5e\xdb\x21\x56\xbc\x69\xb6\x86\x52\xe6\x27\xf8\xdc\x78\x13\x4c\xb1\xe4\x02\x93\x86\x31\x83\x3b\xe4\xa5\x6b\x7d\x9e\x77\x7a\x78\x24\x0f\x28\x94\x72\xb2\x25\xeb\x7c\xac\x84\x6a\x12\xff\xc5\xef\xaf\x46\x7e\x57\x9b\x82\xb8\x97\x87\x9f\xc4\x00\xb7\x00\x9e\x19\x7d\x54\x9b\x95\xa4\xb7\x90\x54\xd0\xc8\x86\x1f\xff\xd3\xb1\xc5\xd4\x09\x50\x20\x79\xa0\x29\x6d\xee\x2e\x7a\xa4\xff\xb9\xc8\xfc\x98\xef\xa5\x63\x1e\xb9\x02\x14\x68\x43\x3b\xf8\x60\x79\x2b\x6a\x8c\xa0\x2a\x8c\xc3\x1a\xe2\xb6\x6e\x58\xa8\x0c\x2e\xd8\x98\xa2\xeb\xf3\x56\x7c\x66\x76\x57\xb9\xd2\xae\x2a\xae\x26\xbd\x70\xc3\xc6\x05\x69\x8c\xc0\x68\xb6\x2f\xd2\x35\x80\xeb\xe0\x80\xb6\x46\x20\x1f\x6d\xa0\xf2\x4e\x3a\xf1\x06\xb1\xd5\xa3\x1e\xec\x05\xa2\x95\x03\x42\x99\x84\x5b\xa0\x9f\xd2\xfd\x01\xe8\x12\xb8\x2f\x50\xc5\x79\xaa\xa0\x07\x27\x13\x57\x47\xb1\x12\xb8\x97\x79\xc1\xc6\xf9\x27\x4f\x68\xaf\x2c\xef\x69\x08\x47\x9a\xa6\x57\x2d\x9e\x2d\xea\x75\x82\xd5\xbd\xa9\xcb\x53\xdf\xe2\xec\xe4\x23\xcd\x53\x7d\xab\xe6\x8b\x54\x6c\xf1\x4b\x3e\x66\x94\xda\x58\x9d\x5d\x39\x43\xa7\xeb\x9a\x5f\x6b\xb1\x58\xad\x92\x24\x75\x0a\x9c\x28\x91\xe7\x92\x4b\x39\x96\x33\xe9\x5c\xc4\x6a\xb5\x2e\xd5\x6b\xbb\xa5\xd0\x5f\xb7\x03\xbd\xd3\x8e\xd2\x71\x4a\xcb\xe7\x29\xcf\x16\x52\x03\x43\x2a\x1f\x86\xe1\x53\x42\x9a\xfa\x4b\x49\xd7\x0f\x8e\xfc\x61\xd7\x4a\x5a\xd9\x77\x7e\xa0\x1c\xfb\xe0\x4e\xea\x5d\xb9\xab\x01\xf7\x4f\xe8\x26\x0a\xbb\x22\xe7\xe5\xb7\xaf\xdc\xf5\x87\xcb\x94\x41\x3a\x2b\x37\x5d\xfb\xeb\x4b\x2e\xf9\xf5\xb5\xd9\xd0\x65\x7f\xd8\x95\x79\xd9\xac\x57\x3a\x30\x31\xf9\x0f\x7c\xe6\xfd\xd9\x76\xfc\x0d\x3f\x9d\x7b\x1f\xd6\xe0\xce\xbc\x14\x34\x5c\x17\xca\xe2\x02\x8d\x27\x24\x13\x9a\x94\x56\x9d\x0b\x81\xe5\x13\x55\xbe\x0f\x48\x53\x8d\xef\x23\x13\x09\x6b\x30\x9b\x0d\x8a\x22\x7b\x55\x02\xd8\xcb\xf6\x6d\x12\x5d\x62\x40\x14\x37\x6d\x12\xc1\x71\xa1\x4b\xc3\x97\x5f\xae\x86\xb9\x96\xbd\x3a\x11\x29\x69\x51\xb7\xd7\xaa\x2e\xc5\x5b\x4f\x92\x54\x0d\xeb\xf5\x39\x9c\xb9\x4b\x28\xce\xad\x27\xc3\xb9\x2e\x46\x77\x20\x81\xaa\x26\x01\xdc\x6e\x4c\x26\xe2\x1d\x61\xdc\x6c\x4c\x8c\x82\x29\x79\x8b\x37\xea\x56\x9c\xae\x33\x71\x06\xd1\x07\x2b\xd4\x8c\xb9\x3a\x4e\xef\x1b\x0d\xbf\x14\x31\x5c\xff\x6f\xf7\x5d\x6b\x20\x44\xe7\x11\x9d\xfa\x55\xd7\x6c\x5d\x03\x7c\xb2\x9b\x6e\x8d\x63\x3f\xf8\x19\x7f\x2e\xf7\x31\x5d\xa1\x67\x23\x45\xa3\xee\x82\x8a\x3a\x31\x98\x43\x22\xa4\x62\x21\xfc\x83\x58\x73\xaf\x43\x97\xbb\x79\xca\x04\xd1\x08\x38\x36\xe8\x59\xdd\xfa\xae\xa4\x8e\x35\x34\x88\x99\x2d\xdf\xb8\x38\x1f\x13\xc3\x33\xef\xac\x7e\x63\x11\x72\xed\x3a\x97\x7e\xe0\xe9\xd3\xf7\x4e\x37\xb8\x74\x7e\xe1\xc7\xdf\xa8\x9c\xb2\x4e\x2d\xa7\x25\xb7\xde\x1b\xa0\x05\xbc\x8c\x8f\xd2\xf9\xcd\x4c\x17\xcc\xd4\x93\x35\xd9\x24\x94\x5d\x0e\xca\x7e\x39\x68\xf7\x93\xa0\x1c\x93\xfd\x30\x68\x83\x71\xbf\x00\x44\x36\xfe\x17\x82\xf1\x18\xfe\x08\x6e\x2f\xc7\xd0\x85\xd4\xc1\x38\xc4\xc4\xb5\x54\x62\x36\x05\xba\xfe\xb8\x9f\x1c\x05\xe2\xbe\x3f\x45\x8e\xb2\x07\xd3\x69\x65\x30\x9d\x62\xfb\x8e\x3f\x0b\x0c\xc1\x36\xd2\x34\x3c\x94\x4a\xb1\x07\x53\x40\xf0\xa7\x15\x73\x1a\x92\xa4\x51\x82\x98\x26\x4d\x99\x29\xa4\x09\x78\x02\x6e\x2b\x7c\x6a\x4e\xc1\x0b\xa4\x3c\x31\x04\x1f\x43\x44\x1a\xf9\x89\x74\x8a\x12\xa5\x83\xe8\xc3\x64\x8a\x19\x20\x29\x83\xaa\x18\x32\x9d\x8b\x1f\xa4\x12\xc9\xac\x5c\x0f\xcb\x8e\x7b\xe9\x26\x2a\x99\xf5\x40\x7b\x57\xe3\x2c\x98\x0c\xda\x63\x4e\xf8\x91\x02\x17\x48\x55\xbf\x07\xca\xe0\xd1\xca\xa3\x8a\x37\xc9\xb6\x74\x1a\x9d\xa6\x74\x7a\x78\x48\x03\xa2\x85\xd3\xdc\x91\xe1\xb2\xe2\xdf\xf1\xf9\xa9\xd4\x19\xf8\x4f\xed\xfb\x46\xfe\x31\x80\x6f\x41\x7d\x01\x43\x4e\xc2\xe3\x84\x85\x88\x0f\xa9\xec\x21\x54\xd2\x1e\xbd\x5e\x69\xc3\xdd\x9d\x25\x04\x1a\x74\x72\xd7\xc2\x2e\xf8\xe3\x0f\x64\x96\xe0\x7e\x4d\x9b\x5e\xcf\xee\xc1\x6d\xc1\x5f\x76\x75\x2d\xea\xea\xca\xf6\x5d\xa3\xf0\x16\xcd\xd7\x8b\xfa\x3e\xc5\x39\xfb\xb5\x50\x2b\x31\x90\x93\x41\xe1\x74\xb9\xf0\x18\xb2\x3b\x33\x45\xf9\xcd\x49\xa0\xe6\x82\x63\xc8\x1e\xf6\x60\x1e\x07\x92\x5b\xf7\x43\x94\x5f\x84\xe1\xa1\x11\x5e\xb8\xb6\x80\x17\x57\x95\xc2\xa5\x1f\x07\x73\x13\xd7\x39\xdb\x31\x41\x6f\x04\x8e\x7c\x8a\x17\x3c\x82\x91\x3d\x88\xa3\x00\x97\x40\xc7\xed\x40\x19\xce\x1e\xde\xac\xc6\x73\xcf\x41\x0c\x4d\x39\x3c\x8b\x2e\x8f\xbf\xf2\x42\x02\x0a\x37\x7d\xe2\x04\x5d\xcb\x8c\x59\xea\xc2\x80\xf4\x3c\xdd\x0e\x55\xba\x95\x6e\x1f\xb2\x10\xc7\xb7\x90\x83\xca\x64\xfe\x5a\x55\xd6\xc6\xe5\xfa\x81\xcc\x4c\x65\x16\x23\xbe\x94\xb6\x45\xb2\x16\xd7\x3e\x40\x0d\x29\x5a\x5c\x0e\x71\x57\x18\x11\xc6\xf1\xdc\xa8\x49\xe6\x91\xe2\x2d\xa0\x1d\x68\x18\xcb\x89\xb9\x68\x05\x4d\x60\x7a\x2e\xa1\x4c\x31\xd9\xc8\x5c\xd1\xda\xa9\xf3\xa0\x7c\xc1\xa3\xeb\xb6\x8a\x0b\x89\x8d\x2c\x19\x30\xba\xf9\xb9\xbc\x1b\x77\xdb\x54\x4f\x4e\x58\x41\xa5\x12\x9a\x08\xe3\x29\xc5\x4c\x49\x65\x93\x91\xed\x33\x9a\x90\x66\x46\x62\x41\xf9\x4d\x99\x7c\x11\x4e\x1a\x17\xc9\x65\xa4\x19\x05\x1a\x16\xc3\x03\x06\x0b\x7d\x40\x45\x6e\xcc\xe5\xa4\x66\x77\x1b\xb4\xd9\xee\x3c\x09\x5d\xbc\x17\x89\xa3\x3f\x42\x39\xfc\x18\x2c\x7b\xd5\x23\xf9\xb5\x6e\x9e\xc8\xb1\x38\xaf\x49\x2e\x6a\xa3\x6e\x97\x2e\x10\xea\x80\x51\x3a\xe6\xac\x8e\x5a\x51\x37\x80\x2b\xfe\x70\x19\x3e\x07\x58\x43\x38\x76\x16\x81\x21\xf9\xab\x1f\xdf\x7c\xf3\x8f\x6f\x56\xfe\x77\xc9\xb8\x71\x4b\xc6\xf1\xbd\xf0\x52\x76\x15\x52\x07\xfa\x8a\xa9\xf1\x46\x1c\x94\xd3\x6e\xc6\x94\xfa\x71\x98\x50\x5d\x1b\xe2\xb4\x5d\xf6\x33\x35\x38\x22\xa1\x17\x7b\xaa\x48\x35\x91\xfd\x71\xac\x50\x3f\x4c\xc5\x40\x16\x40\x08\x37\xa1\x7b\x08\xe1\xfc\x1c\xfb\x7d\x72\xbd\xab\xde\x51\x67\x30\x2a\x67\xc7\x9b\xec\x0b\x94\x2b\xc6\x06\x4c\x86\x86\x68\x95\xf2\xdd\x31\x95\xe6\x72\x51\x24\xdf\xde\x4b\x16\xed\xe4\xf7\x0f\x97\x47\x1b\x9c\x32\x61\x27\x4d\x5a\xda\xfc\x87\xbb\x96\x05\x5a\x2a\xcd\x93\x26\x95\x55\x9a\xeb\x2c\x16\xee\x93\xcc\xe9\xe4\xdf\xd5\x7e\x81\xfc\xff\xa7\xd0\x2f\xc2\x4c\x94\xea\x34\x54\xe9\x70\x77\x0d\xe8\x20\x20\xe7\x81\x16\xe0\xc3\x71\x81\x8a\xfa\xac\xac\x58\x47\x35\x01\x5a\xd8\x46\xa2\xe9\x04\x34\x12\xf6\x33\xc1\x19\x6f\xeb\x6a\xf0\x25\xfb\xd6\x5e\xd4\xa6\x98\x23\x5d\x44\x67\xf2\xf8\xcb\xc9\xef\x3a\x37\xac\xec\xae\x6e\x6e\xb6\x29\xdf\xbe\x7d\xee\x6a\x7b\xc3\xc2\xee\x8d\xff\x35\x7f\xc1\xd5\xcb\x1f\x66\xaf\x27\x62\x99\x2f\x36\x76\x41\xcb\xe4\x0d\x73\x93\xe5\xcb\xc4\x58\x3d\xd7\x55\xe6\xaa\x34\xd9\x0c\xe2\x74\x12\x99\x79\xe9\xe4\xd8\x19\x63\x1b\x0d\xed\xe7\xcc\x8a\x74\x75\x54\x0c\x3f\x79\xde\xfc\xd3\xaf\xa7\x7d\x1e\xf0\x5c\x4f\xe9\x37\x86\x78\xac\x04\xf9\x32\x0f\xad\x18\x67\x02\xb1\x0c\x85\xe3\xdc\x5a\x76\xe1\x24\x71\xab\xa1\x69\x96\x62\xfe\xa1\x75\x1f\xc7\x49\xe6\x6a\xd9\x63\xd1\xf1\x07\x3a\x9d\xf1\xfa\xe1\x17\xdb\xeb\x75\xe7\x9c\x63\x5b\xc0\x4d\xb0\x97\x3b\x6d\x06\x13\x74\x7f\xb6\x53\x95\x0f\xfc\x40\x93\x0f\xd4\xd1\xf5\x39\xd8\xd1\x22\x20\x47\xea\x56\xdb\xa1\x9b\x43\xae\xd4\x2a\xe0\xda\x20\xc0\x0c\x29\x86\x63\xf6\x60\x52\x70\x45\xf5\x26\xaf\x33\x54\xe6\x4d\x47\xbd\x5e\xa5\x9f\xb7\x06\xca\xdd\x69\x6f\x59\xc8\xe9\x35\xe9\xa3\x30\x07\xde\x3f\x7b\xf6\xd5\x57\xc3\x8f\xdb\x2a\xd7\xc8\x56\x3d\x47\x86\x9f\x74\x7b\x09\x0b\x93\xa6\x60\x90\xad\xdc\x02\xc2\xe9\xad\xf0\x06\xa6\xd0\xe1\xa1\xc7\x1f\xe7\x6a\x1e\x87\xbe\xe0\x3f\xf1\x03\xfe\xdb\xdf\x04\x0f\xa7\x18\xf7\xcb\xe1\x24\x44\xf3\xbd\xa7\xc6\x63\xf2\xe3\x6a\xf6\x39\x34\x32\x1b\x0b\xd0\x60\x6f\xcb\xa1\x71\x7c\xcb\xd5\x2a\xc6\xda\x3e\xd6\x81\xd2\x7d\xac\x42\x9e\xe1\x1f\x0d\x47\xd9\x3e\xba\x79\xb0\x9b\x8a\x8f\x8e\x50\xad\x8d\x32\xea\xcf\x6b\x70\x50\x29\x12\xcc\x5b\xcf\x6a\x1b\x0c\x59\x69\xac\x32\xf8\xf5\xfe\x6f\x82\x33\xf7\x35\x38\x96\xbe\x3f\x56\xb0\xe1\xf1\x4c\x01\x6e\xef\x9f\x24\xfe\xff\x1f\x38\x93\x78\x31\x7f\x56\x1a\x76\x7e\xcd\x7b\x72\xd4\x27\xe7\xab\x1a\x45\x72\xf9\xca\x4e\x17\xd6\x36\x22\x92\xc7\x9d\x7b\x2e\x27\xfa\x56\xfe\xe7\x6b\xbc\xcc\xbf\xa4\x7f\x14\xd4\xa3\xfc\x0d\xfc\xff\x70\x5d\xeb\x47\xdd\x6f\x43\x1e\xfd\x5f\xbe\xe7\xf6\xb5\x7b\x72\xf9\x6d\x87\x89\x05\xc3\xa1\xa0\xca\xff\xa9\xf8\xc2\xaa\x1a\xbd\x72\xfe\x6f\xb1\x0c\xa3\x43\xb9\x2a\xad\x53\x8e\xee\xf7\x37\x30\x63\x99\x59\x0c\xe3\x0f\xda\xbb\xd8\xe2\x22\x97\x36\xb7\x20\x52\xd2\xb9\x74\x7f\xa8\x34\x19\xd9\x96\x4a\x0d\xe4\x81\x92\xa3\xf8\x54\xcc\x58\xd8\x63\x8f\xa7\xd3\xe4\x15\xd2\x44\x63\x06\xf1\x59\x30\xc3\x9c\xac\x77\x1c\xbb\x7a\x76\x6a\xf6\xfe\x93\xf4\x10\x4d\xe3\x15\xd6\x81\xac\xfe\x82\x56\x9e\xd2\xfe\xa1\x52\xc9\x25\x05\x42\x7e\x20\x9c\xfc\xba\x02\xe5\x9b\x9a\x5b\x95\x4a\x0d\x3f\x59\x50\x22\xbe\x35\x9d\x7e\xfc\xf1\xe3\x1f\x7d\x83\x22\x1d\x7f\x96\xef\x2d\x44\x3d\x9d\x82\x79\xfb\xe4\xc5\xa2\x34\x78\x9a\x3f\xc0\x1d\x81\x72\x39\x46\xca\xa3\x0c\xec\x6c\xf6\x93\xea\xa6\xa6\xea\x8c\x03\x9e\xfc\x56\xca\x74\x1c\x81\xe0\x46\x8c\x64\x6f\xab\xa6\x7c\x09\x69\xca\xd1\x90\x58\x3f\x1e\xba\x27\x51\xaa\xcb\x61\x4f\x0a\x40\x7f\xdb\x79\x8d\x66\x6a\xe2\xfb\x1c\xe5\xe5\x8e\xe3\xbb\xe1\x39\xeb\x0b\xd2\x30\x8b\xd4\x7d\x3c\xfc\x56\xff\xdc\xb9\xfd\x73\xf9\xde\x72\xc7\xb0\x19\xdf\x72\x47\x1d\xe5\xa9\x4c\xf5\x73\x37\x3c\x40\xba\x95\x21\x72\xf4\xb2\xb9\xeb\xe7\xcd\x5b\xaf\xe2\xfd\x7b\x46\xe0\x13\xfc\x5d\x8c\x1b\xf5\xd5\x48\xb4\x8a\x00\xab\x69\x25\x12\xc1\xf5\x1e\x95\x7f\xdc\xd9\xed\xa5\x5b\x36\xfc\xfc\xd2\x69\x53\x9f\x4e\x24\x0c\xfe\xe5\x7d\x6b\x23\xe3\xa6\x5c\xfa\xf0\xf3\xcf\x6f\xd9\xf2\xfc\x16\xfe\xae\x4d\xbf\xd8\x70\xe9\xb7\xd7\xfe\x4f\x4f\xb7\xde\x7f\xfe\x05\xf7\xce\xd8\xba\xa1\x6f\xd7\x15\x33\x5e\xdd\xf2\xc2\xf5\xd7\xbf\x50\xb0\x5f\xb8\x83\x96\xa9\x58\xaa\x4a\xec\xaa\x60\xb3\x54\x8a\x3a\x9b\x8a\x32\x4b\x04\xa7\xc3\xeb\xb9\x25\x54\x7e\x89\x79\x6e\x60\x38\xee\x8f\x82\xa4\xc9\x2b\x55\x8a\x4a\x6c\xe1\x00\x65\x81\xb3\xb2\x9e\x2a\xde\xe3\x46\x8a\x3b\x14\x6e\x61\xc3\xa1\x64\x22\x18\x62\x1b\xe4\x99\xf3\x96\xce\xed\xae\x8b\x2d\xe9\xbf\xba\x67\xd1\xfd\x9b\x67\xd5\xf6\xf4\x2e\x9d\x3f\xf3\xa0\x27\x52\xcf\x91\x0a\x7f\x7b\xa5\x53\x76\xb3\xc0\x3f\x1b\x04\x47\xc4\x5c\x5e\xc3\xea\xf5\x82\x34\xe3\xdb\x1b\x2e\xbf\x74\xc3\xcd\x53\xa7\x5f\xb7\x7c\x5a\x88\x17\xc6\xae\xdc\xbe\x6c\xea\xcd\x1b\x2f\xdd\xb4\x71\x5b\x46\xf1\xcf\xde\x32\xb6\x6e\x62\x38\x68\xd5\x8b\x66\x5b\xc0\x58\x26\xf2\xb6\x89\x91\x65\xcf\x99\x48\x8e\x17\x78\x83\xbf\x88\x31\x43\x1f\x47\x4a\x23\x89\x7a\x54\xa8\xf5\xcc\xd6\xf9\xa3\x9e\x42\x55\x9b\xf1\x24\x59\xd8\x55\x88\x98\x24\xdc\x91\x8b\x58\x9d\x4b\xf6\x59\xdd\x46\x41\x77\x51\x46\x20\xdb\xa2\xc9\x97\x94\x6d\x48\xea\x92\xa3\x91\xae\xf4\x4b\xbb\x94\xfe\x47\x5f\x7c\x91\x1b\xa3\x0c\xfc\x5b\x92\xac\xe4\xfe\x76\x29\x61\x59\x5e\xb2\xba\x1b\x4c\xc6\x4b\xd3\x96\x97\xba\x22\x48\x26\x76\x45\x9e\x4b\x2b\xcf\x42\xba\x47\xc9\xb6\x47\x5f\x54\x7e\xa7\x0c\x44\xf7\x90\x95\x9a\x9c\xee\xbb\xd0\xc7\x2a\x98\x04\x72\x29\x28\x8a\xc8\x4b\xe8\x92\x72\x81\x1c\x55\xd3\x1f\x0c\xc7\x43\x50\xbb\xdd\xba\x24\x50\xac\x64\x39\x59\xfd\xbd\x8e\x57\x73\xb8\xbc\xf8\x28\xc5\x85\xbd\x96\x2c\x04\x56\x6f\xaf\x68\x22\xe5\x9d\xa9\x45\x7d\xe5\xc6\x96\xce\x99\x13\xa6\x35\x38\x89\x89\xef\xfd\x4e\x22\x8b\xd1\x8e\x55\x59\x7c\x2a\x94\xdf\xa0\xb2\xc1\x7f\xea\x78\xc3\x1b\x84\xb5\xb8\xdb\xfc\x5d\xe7\x4e\xec\xf0\x01\xbc\xb7\x8c\x42\x5e\x9e\xf8\x20\xe5\x27\xe2\x94\xfb\x2b\xad\x36\xb9\xb0\xda\xf2\xba\x0d\xad\x85\xba\x0d\xcb\xc9\xf2\x71\x93\x9f\x56\x76\x95\xe2\x4b\x7e\x41\x6e\x07\xf8\xe7\x89\x62\xe6\x2f\xe0\x7e\x29\x82\x57\x16\xd7\xa3\x32\xa3\xe7\xa9\x2c\xb6\x53\xd3\xbf\xcf\x55\x5f\x0c\xd2\xd3\x34\x99\x8f\x45\x48\x4e\x3f\x5d\x2f\x52\x5e\xd1\x48\xf9\xef\xc7\x90\xb6\x37\xe4\xf6\x10\x81\xc1\xe9\xc8\x29\x60\x8e\x47\x89\x47\xe1\x36\x56\x3c\x28\xab\xaa\x98\x49\x92\x40\x85\x84\x78\x10\x28\x8b\x6e\xd4\x90\xd4\x13\x5e\x14\x8c\x7b\xd2\x46\x7d\x56\x03\x33\xbd\xc7\x28\x88\xca\x70\x56\xad\x72\x7f\x9a\xea\x62\xe2\x6b\x63\x7a\xbf\x51\xe0\x06\x72\x09\xf7\x19\x79\x48\x88\xd5\xfa\x85\xc8\x1b\xf7\x15\x26\xc4\x57\xc4\x00\xaf\x20\x7b\xfa\x4a\xa3\xf7\xb7\x42\xfd\x7a\xb3\x52\xd4\x9c\x8a\x68\x81\xe0\xf1\x18\x4c\x71\xe4\x4d\x49\x96\x25\xa5\x4d\x9d\xf4\xa8\xfa\xd3\x2c\xf2\x9a\x55\x8f\x44\x8f\xde\x4a\x5e\x53\x66\x65\x89\xce\x02\xfd\x4b\xaa\xf7\x5e\x90\x6f\x61\xe6\x45\x10\xa2\x1a\x84\x2c\x94\x51\x21\x8d\x00\x38\x0a\xdc\x07\x19\x2f\xc0\x8d\xa1\x14\x84\xe4\x21\xa9\x75\x4c\xe1\xf3\x07\xb4\xfc\x5d\x92\x44\x3e\x56\x21\xf2\xe5\x45\x79\x0e\x59\x1b\xf0\x91\x05\xa8\xca\x02\x18\x37\x1f\xe3\x13\x8c\x09\xf7\x88\xf3\x1a\xb5\x7f\xe5\x5a\x1c\x8e\xe1\xb7\x1c\x5e\x2f\x1f\x55\xac\xa6\x2a\x13\x3c\xac\x88\x8d\x95\xea\x46\xa7\xb8\xad\x74\x3d\xa8\xce\xd6\xc2\xc9\x85\xbb\xc7\x46\x53\x90\x42\x75\x68\x6d\x33\xb8\x80\x82\x47\xbf\x8a\x53\x8a\xef\xa5\xf9\x33\xa4\x70\x33\x19\xd6\x0b\xac\xb5\x7c\x62\xee\xb9\x82\x4c\x18\xad\x7d\xbc\xbc\x33\x5b\x4f\x1d\x05\x5a\xc2\x5a\xc1\x3c\xb0\x68\x62\xfd\x60\x3d\xc9\x4a\x1b\xb6\x0a\x9b\x22\xcf\x41\xf5\x28\x35\x50\x3d\xf0\xd0\xcb\xe4\x39\xa5\x0b\x1c\x28\xad\xde\x8a\xba\x9e\x0a\xc3\xf2\xcf\xf0\xf3\xa8\xe4\x3f\x09\xa5\x73\x02\x4e\x22\xe0\xc4\x61\x9e\xea\x03\x33\xce\x3e\xf8\xb2\x94\xab\x4a\xa0\xad\xcc\x2a\xed\x56\x99\x55\xa2\x92\x2c\x00\xd4\xb7\x58\xfa\xf0\xf1\x73\x89\x4a\x53\x83\x43\x7e\xa0\x8c\x95\x0c\xe8\x74\x5a\x0d\x2e\x00\x69\x90\x94\x6e\xf2\x9c\xcb\x60\x55\xc6\x03\x5a\x58\x1f\xb8\x7d\xcc\x1d\x51\xfb\x72\xb1\x96\x47\xae\x4c\xf0\xe0\x16\xe4\x16\x70\x5a\x2a\xf2\x26\xed\x62\x2a\x9f\x54\x58\x22\xa5\x4b\xcd\x17\xf7\x1a\x60\x5d\xe7\x67\x40\xdb\x57\x50\xd9\x41\x4e\x6f\xba\x94\x3e\x61\x9f\x24\x7f\x93\x0c\xee\xe3\x9f\xb8\x0d\x12\xf8\x1a\x32\x5f\x14\x30\x61\x7c\x67\xe6\x03\xc9\xed\x96\xd8\x4a\x29\x33\xa3\x80\x74\x20\xcc\x2d\x27\x86\xb9\x0d\xfc\x2c\x3c\x49\x65\x60\x45\x03\xe1\x36\x28\x8f\x90\x15\xaf\x65\xfe\x8b\xad\xe7\xb6\x2a\x0f\x2b\x0f\xff\x9c\xad\xa7\xaa\xc9\x50\xc6\xae\x13\xc3\x7c\xbd\x96\x16\x35\xa3\x93\x7c\x7d\xe6\x9d\x9f\x93\x73\x30\x51\x90\xfa\xc8\x39\x3f\x2f\x5a\x6f\x63\x25\xeb\xad\x10\xd4\x36\x64\x54\x09\x93\x9c\x15\x3b\xc5\xa8\xec\x29\xd4\x88\x9b\x36\xa5\x6b\xf1\xd5\x80\xa8\xba\xf5\x42\x71\xd6\xbc\x25\x6b\xf3\x0f\x4b\x5e\x6b\x5e\xa6\x64\xfd\xaf\x2e\x5d\xff\xfd\x2a\x2a\xea\x84\x3e\x02\x36\x3b\x95\x12\x6e\x2a\x59\x5a\x02\x51\xb9\xa6\xa0\x1e\x4f\x55\x66\x3e\x20\x91\x70\x08\x85\x85\xae\x6a\x22\xe2\x03\x15\xde\x3d\x54\xeb\xbd\xa3\x95\x24\xf1\x51\x5a\xe6\x0f\xbd\x86\x4d\x9b\x0c\x5e\xc3\x9d\x06\x74\xc1\x29\x09\x97\xe0\xb2\x6f\xf4\x54\xb9\x70\x11\x7e\xb5\xa5\x75\x90\x43\xce\x1d\x4d\x52\x94\x42\x01\xb1\x14\x21\x79\x91\x28\xeb\x61\x01\xfa\x1c\x67\xf0\xcb\x45\x11\xd7\xae\xd2\x0a\x69\x5f\x0c\xf1\xb0\x4a\x7c\x06\x53\xfe\xe5\x6a\x72\xa6\x44\x4f\xa0\x0b\xf7\x20\x8a\x7b\x44\xc0\xca\xc9\xae\x2e\xe2\x4e\x76\x13\xf5\xdc\x83\x18\xb0\x0a\x22\xdd\x91\xef\x06\x72\xaa\x85\x73\xe6\xba\xcb\xa8\x6d\x44\x7a\x7c\xe3\x26\x45\xfd\xae\x1b\xaa\x04\x9d\xaf\xda\xe5\xb1\x3a\x89\xd7\x5a\xee\x70\x9a\x59\x62\xb1\xee\x13\xab\xea\x83\x65\x81\xd8\x94\x71\x63\xf3\x8a\x53\xc5\x68\xdf\x65\xaa\x6a\x9d\x17\xf5\x0a\x36\x97\x4d\x76\x78\xdc\xa2\x5e\xb4\xba\x2a\x1b\xbc\x7a\xbb\xad\x7e\xcc\x99\x89\xd6\x19\x0d\x15\x86\xcc\x7f\x17\x6a\xa0\x71\x45\xfd\xa9\x7b\xd4\x1e\x9e\xc7\x96\xcb\x77\xf6\x60\x71\x5f\x6b\x24\xf6\x91\x85\x19\xc8\xcb\x38\xe9\xce\xc9\xec\xd9\x79\x8d\x90\xd9\xa5\xa8\x2f\xc9\xa3\x85\xaa\x40\xc5\x9b\xa3\x54\x4b\x23\xaf\x07\xd1\x8a\xba\xa7\x2a\x9f\x0a\x74\x60\x8e\xdf\xb1\x12\x31\xa7\x83\x88\xe7\x98\xb2\x6a\x88\xdd\x24\x09\xe4\x83\x33\xf7\x0e\xd2\xe5\xbe\x81\xef\x73\x3b\xa8\x90\x2e\xf7\x4d\x0b\x90\x51\x80\xca\xaa\xed\x15\x74\x1f\xf8\x95\x8a\xed\xab\x28\x6e\x45\x11\x2f\xd2\xa8\x29\xe7\x7b\x76\x63\xd4\x6e\xcf\xf9\x53\x4a\x23\x50\x1a\x3c\xfa\xb7\xf9\x08\xe5\x97\x27\xfd\x3c\x1b\x51\xa2\x4f\xd1\x73\x0a\x7d\x0a\xd1\x73\xca\x8d\x44\x3c\x2e\x73\x0a\x6d\x95\xfb\x26\x88\xe2\xe6\xcd\xa2\x38\x01\x86\x04\xb8\xb2\x58\x12\x3e\xa9\xfa\xc5\xe9\x27\xfb\x42\x0b\x8f\xd0\xb1\x39\x95\xae\x15\x96\xa1\x98\xf8\x3c\x25\xd2\x37\xac\x11\x45\xe5\x65\x18\xac\xbf\xd0\xeb\xd7\x00\xc4\x79\xaf\x9d\x02\xd1\xab\x31\x85\xf2\x13\x51\x7c\x03\xdc\x35\x7a\x3d\x24\x2e\xc1\xad\x0c\xf7\x67\x4a\xc7\x35\x4c\xef\x1e\x12\xf2\xa8\xea\x19\xf1\x8e\xd1\x78\xac\x89\xec\xec\xd5\x7f\xcb\xbc\x70\xc1\xa5\xa8\x90\x31\x02\xfc\x65\x9b\x56\xb3\xb3\x27\xbe\x99\x79\xa1\x1b\xfc\x97\xaa\xb4\x83\x87\x59\x08\x7c\xee\x57\x48\x3b\x38\x29\x59\x55\x78\xa6\x11\x89\xad\x30\x6b\xb4\x79\xd8\x10\x65\xab\xdf\xf5\xd8\x32\x7f\xb7\xb1\xd3\xc8\x13\x16\xa3\xac\xbc\xaf\x2a\x2b\xbf\x2f\x1b\x2d\x10\x11\xca\xd2\x22\x0b\xf9\x1d\x6a\x7e\x86\x1c\x1d\x52\x78\x2a\x92\xbd\x16\x32\xc4\x6c\x3c\x99\x77\x29\xc7\x1e\xf2\x70\x9f\x29\x8b\x51\xbf\x6b\x31\xe4\x4a\x82\xea\x41\xc8\xa0\x6c\xa4\xf9\x3d\x0f\xe3\xed\x2c\xa8\x13\x39\xbb\x5a\x17\x1e\xb5\xc4\x75\x9b\xdd\x33\x2a\x36\xfc\xad\x45\x20\x6c\x00\x54\xcb\xcf\x03\xf9\x1d\xa0\xb4\x52\xf6\x0c\x67\x96\x8c\x57\xf1\x0b\x69\xe0\x2d\x45\x48\xe1\xfe\x90\x56\x7e\x5b\xb6\x46\xd4\xf2\x3e\x0f\x6d\x76\x96\xaa\x8f\x9d\x2d\x2f\x32\x57\x7c\xb2\xa8\xb0\xcb\x0f\x3c\x7a\x7d\xad\xd0\x74\xc6\xcd\xaf\x5f\xe3\xe1\x17\x17\x66\xcd\xd6\x4e\xd9\x30\x67\xf5\x53\x0f\x5e\x3c\x0d\x3e\x96\x8d\x05\x73\x8c\x8c\x96\x05\xb2\xe7\x1d\x8a\xc6\x51\x82\x68\xf4\x21\xed\x96\xd9\x8d\x7b\x1d\xb7\x00\x65\x21\xb2\x75\xf9\x57\xd0\x13\xa1\x47\x7e\xb5\xdc\xaa\x57\xfc\x28\x19\x29\x8e\xa3\xfa\x72\x83\x40\x17\x2d\xff\x4a\x84\x0e\xe8\xd2\x43\xa4\x7c\x7c\x3e\x2c\xcc\x4d\x25\x91\x74\xbd\x9f\xc9\xcc\xe4\x57\xf1\xab\x34\x7c\xb4\xc1\x9d\x23\x51\xd5\x91\x31\x2a\x96\xdc\xdf\x35\x80\x72\x1e\x87\x8c\x79\x24\x8e\xfc\x8a\x2c\xbc\x3c\x0a\x99\xcf\x46\xc1\xb1\x74\xfd\x43\xb9\xe4\xc8\xf5\xc2\xe3\x54\x15\xcf\x92\xce\x44\xc1\x7a\x31\xfa\x6a\xf7\xcc\x9b\xae\x32\x7e\x2c\x1f\x74\x25\x5c\x41\x70\xcb\xda\x4f\xb6\xae\xfd\xd0\xe7\x52\xde\x72\xf9\x7c\x2e\xd2\xe2\xf2\x29\xe6\x62\x1d\x6a\x21\xd7\x66\x33\x99\xc5\xcc\x39\xcc\x05\xcc\x7a\xed\xcc\x34\xfe\x79\xdc\x49\x94\x5d\x24\xf1\x20\x22\xc6\xb0\x14\xbd\xac\x84\x0c\xd0\xc3\xad\x73\x3f\xdd\x1c\xca\xce\xfd\xb8\x57\x84\x9f\x74\xf3\xda\xda\x4d\xf7\x0a\x85\x70\x88\xee\x1d\x62\xd8\x49\xab\x3f\xa2\x32\x0b\x76\xc2\x1b\x5c\x82\xe0\x76\xff\x4c\x96\x7c\x7a\x53\xe8\x67\x6e\x8f\x5d\xf6\xb4\x59\x2c\x56\xde\xab\x27\x92\x71\xbc\xb6\x88\x29\xfd\x69\xd4\x6c\xa6\x6b\xda\x78\x93\xd5\xe0\x15\xac\xac\x4e\x06\x8c\x1e\x7c\x0c\xb8\xc8\xdf\x1b\x8d\x5c\x8c\xb3\x29\xf1\xa7\xf4\x36\xf0\x18\x8d\xff\xcf\xc4\x55\xa1\xe2\x2b\xd9\xc6\xf6\x49\x46\xe5\x56\x28\x21\xcb\x1a\x2d\x1b\x2d\x26\xd9\x60\x71\x6f\xb4\x18\x45\xbf\xdd\x26\x97\x5d\xd5\x64\x30\x69\x99\xee\xc7\x8d\xf6\xfd\x1a\x34\x9d\xc0\xb7\x5d\xe7\x75\x71\x7a\xb7\xdd\x7a\xc5\x4e\xd1\x68\x35\xde\x63\xe4\xf7\x3c\x08\x90\xbe\x0d\xfe\xe1\x4b\xb2\x7a\xb5\x64\x5b\x56\x3e\x60\xe6\xf7\x03\xdf\xdd\x88\xda\xff\x38\x19\xe9\x58\x2b\x0f\xab\x68\x48\x0c\x59\xe9\xf1\xf2\x78\x07\x50\x35\xf0\x17\x6e\xc1\x85\xb2\x5b\xe7\x09\xe9\xa8\x70\xcb\xca\x83\xe3\x86\xda\x62\x93\xdd\x1c\xbc\xe7\xef\xd4\x97\xb3\xcd\x93\x2e\x3a\xe7\xf6\x73\xbf\x35\x65\xcc\x78\x3b\x6f\x8f\x55\xec\x28\x77\x37\xe8\x17\x97\x39\x6b\x38\x03\x6b\x1b\xdb\x1e\x5e\x70\xce\x99\x37\x5f\xf5\x72\x9d\xde\x57\x1d\xda\x39\xbe\x7b\xcc\x99\x97\x2d\x5b\xe0\x29\xb3\x3a\x9a\xfd\xde\xdb\x2e\x3e\x63\xda\x39\x17\x9e\x11\x71\xf3\xfc\x63\x9c\x53\xff\xb4\xf2\x7e\xfa\x9e\xbe\x76\xbb\xde\x64\x32\x13\xc2\x56\x54\xec\x28\x73\x06\x26\x84\xf4\xb3\x64\xfb\xb8\xea\xe4\xd9\xd7\xde\xd8\x13\x9b\x15\xaf\x0b\x56\xb4\x4e\x31\x94\x85\x5a\x2f\xf6\xfb\xab\x12\x0b\xe6\xad\x8a\x8f\x19\xc7\x87\xeb\xab\xce\x98\xd0\x5e\x37\xe1\xec\x6b\x17\x47\x5d\x30\x57\x9c\xc5\x54\xf1\x97\xf0\xbf\xa2\xba\x13\x56\x52\xc5\x7b\x5a\x44\x28\x46\x15\x07\x85\xe0\x45\x1d\x8a\xb5\xa0\xbd\xa1\xbc\x6e\x2b\x87\xc7\x68\xc3\x21\x98\x44\x74\x55\x58\x20\x14\x7a\x75\x73\xec\x9d\xe1\x6a\x63\x78\xcc\xf4\x49\x35\x7c\x85\xb1\xcc\x68\xe3\x88\xc1\x61\x5d\x71\xdb\x8c\x1a\x9d\xcd\xd2\x3d\x6b\x61\xb7\x7b\x46\xb2\xda\xa1\x33\x59\xc2\x8d\x01\x83\x7b\xee\x44\xa3\x1c\x9f\x73\xc6\x8a\xd6\xf9\xab\x58\x96\x17\xdc\xfe\xae\x7a\xbd\xce\x17\x8e\x96\x55\x4b\x65\x81\x90\x28\x05\x66\x9e\xbb\x8c\xff\xd5\xe4\xeb\xce\x3b\x73\x4a\x67\xad\x59\x1c\xe3\x19\x53\x95\x08\xb6\xd7\x45\x1c\x0d\x37\xdc\xf5\xf8\xf6\x59\xc9\x55\xd3\xc7\xd6\xe8\xdc\x26\x9d\x85\xe3\x85\x9a\x31\xd3\xcf\x3c\x67\x43\xe7\x05\x7b\xd6\xcf\xf6\x89\x72\xed\x03\x67\x01\xc1\x32\x6e\xdc\xd4\x40\x7c\xe5\x69\x63\xdc\x06\x4b\xcb\x84\x89\x8b\xfd\x8b\x77\x5c\x70\x5a\x84\x8e\xcf\x13\xef\x31\x6b\xf8\x41\x7e\x1a\xa5\x12\x4e\xa3\xe3\x53\x70\x59\x85\x40\x8b\xd0\x01\xdd\x39\xa4\xa3\x0a\x33\x78\x16\x37\x99\x08\x89\xd8\x80\x9e\x2a\x1e\x68\x53\x08\x85\x43\x02\x06\xdd\x59\x59\x1f\xd6\x02\xab\xfb\xde\x83\x57\xad\x9b\xd7\x59\x59\x39\x76\xde\xba\xab\x1e\xdc\x89\x81\xb1\x95\x95\x9d\x10\x60\x25\x49\xa7\xf7\x1e\xf8\xf2\x80\x07\x88\xcc\x69\xa7\x5b\x78\x93\x3b\x7a\xfa\xd9\x37\xdc\x72\xc3\xd9\xa7\x47\xdd\x26\xde\x7c\x7a\xe6\x44\x50\x32\x18\xea\xc6\xd8\x9a\xad\x41\x2b\x27\x35\x35\x9b\x02\xfc\xb4\xe6\x99\x67\x9e\x7b\xe6\xcc\x06\xfa\x6c\x2e\x0c\xbc\x27\xe9\x9c\x9d\xdd\x9b\x0f\x1c\xd8\xdc\x95\x74\xea\xa4\xa9\x97\xda\xda\x1d\xb5\xd3\x96\x4f\x69\x6f\x9b\xba\x7c\x6a\xad\xa3\xdd\xbe\xa1\xaa\x56\x6a\x75\x4c\x69\xb3\xf0\x52\x40\xaa\x93\x9a\x66\x3a\x70\x5f\x87\x87\xf2\x32\x50\xde\x03\x8c\x8b\x09\x03\x45\xde\x8b\xf2\xfc\x24\x3d\x6a\x29\xc4\xa2\x9e\x64\xcc\x99\x44\x29\x2c\xac\x09\xd8\x79\xb1\x54\x75\x5a\xa1\x3d\xc9\x16\x42\x6b\xc1\x93\x2b\x35\xab\x96\x79\xfe\xdd\xd5\x67\x7c\x7f\xe3\xb2\x26\x5a\xcc\x07\x17\x8c\x9f\x58\x73\x77\xa8\xf5\xa2\x5b\xc3\xf3\xd7\xdc\xbb\xa0\x65\xce\xc6\x10\x14\x2e\x91\x39\x8d\x16\xd9\xd1\x30\xa3\xf7\x82\x78\xe4\xc2\x6f\x43\x25\x6c\xce\x15\xd7\xec\xe6\xb0\xb4\x9b\x5c\xe7\x5c\x76\xe5\xb9\x15\xb2\x5a\x58\xe9\x0a\x65\xc1\x0f\x16\xff\xb6\xa3\xfc\xf4\x4b\xd6\xad\x2c\xdf\xd7\x7e\x4b\x0f\x14\x6a\xda\x25\xb4\xa0\x55\xe3\x4e\x9b\xd8\x69\xb9\xcf\xf1\xf8\x3a\x2c\x7c\x7f\xae\xa0\xa6\x25\xbc\x56\x4e\xee\xc4\x43\x30\xd1\x39\xf8\x27\x80\x77\x2e\x43\x9d\xd6\x30\x0c\x51\x8f\xc6\x41\xd7\xe5\x95\x00\xfd\x94\xb4\x55\x0f\xca\xa3\x4a\x44\x28\x71\x3a\xbb\xc5\x35\xc6\xc5\x6e\x91\x2b\xea\x8e\x7d\x5e\x57\x41\x8c\x64\x19\x9d\x39\xc9\x32\x65\xf2\x05\x25\xf3\x6f\x8a\x6f\xa8\x16\xfc\xc7\x87\x6a\xa6\x4f\xd9\xaa\x9e\xa8\x61\x7f\xf3\x47\x9f\x6b\x18\x67\x5e\x78\x68\x3a\x24\x32\x9d\x6b\xeb\xb1\x6f\xa1\x24\x48\x3d\xa0\x19\xae\xb3\xd3\x39\x15\xd7\x20\x9c\x46\x93\x76\x41\xa7\x9e\xd8\x04\xce\x80\xed\xdd\xdc\x47\x2e\x94\xdc\x65\x76\x65\x47\xaa\xdc\xf9\xcb\x5f\x3a\xcb\x53\xca\x0e\x7b\x19\x20\x7a\x21\x7f\xa0\xaf\x5b\xd9\xa7\xbc\x64\x6d\x77\x45\x5c\x16\xf3\x57\xca\x57\x67\xb9\x26\xc8\x36\xc3\x75\xd7\x19\x6c\xf2\x04\xd7\x59\x44\xfc\xca\x6c\x81\x57\xed\x56\x32\x95\x2c\x44\x0e\xc5\x4e\xe7\x7b\xd4\x27\xf1\x15\x9c\xd9\x2c\xd4\x8e\xc2\x93\x9b\x67\x00\x8f\xb0\x32\xcb\x25\x14\x9f\xa0\xcf\xeb\xb8\x17\x9c\x8e\x90\x63\xf1\x58\xc9\x4f\xf8\x86\x71\x54\x12\x12\xc5\x8d\x0e\x9f\x1c\xa5\x3c\x34\x39\xea\x23\x92\x2a\xae\x48\xe5\xfe\x0d\x6f\x1e\xcd\x8b\xe4\x40\x54\x3d\x75\x1f\x45\x7a\x09\x0f\x50\x2b\xfd\x19\x07\xa5\x9d\x3e\x49\xe7\x3f\x1f\xdd\xab\xc9\xc7\xbd\xc2\xe5\xfc\x76\x7a\xd6\x29\x99\xa5\x12\xa8\xa0\x94\xf8\xb3\x8b\x1a\x41\x11\x2a\x11\xb3\x5c\x51\xcc\x5f\x90\x2a\x9c\xf4\xe0\x5b\x7e\xfd\x57\x92\xf4\x95\x55\x7e\x38\xb3\x1b\x48\x39\x80\xff\x6b\xb6\xef\x33\x39\xf3\xfd\x3b\xaf\xfc\x35\x15\x1c\x04\xb9\x72\x35\xc1\x67\x3f\xb8\xf3\xca\x87\x65\xfe\xce\x8f\xac\x0d\xd6\x8f\x80\xc0\x18\xc0\xa3\x9a\x28\x7d\xea\x1b\x90\xf5\xca\xbd\x77\x5e\xa9\x8a\x85\xe6\xe4\xde\x43\x7a\x78\x53\x24\xcf\x2c\xda\xd9\x21\xa8\x47\x8f\xf4\x4c\x8a\x3b\x42\x15\x57\xf7\x2a\x6f\xa2\x1e\x2b\xdf\xab\x6a\xa5\xe0\x1e\x8f\xaa\x99\x92\xd5\x01\xa1\xed\x6f\x60\x9c\x74\x37\x37\xd7\xbc\xfe\x58\x41\xcb\xd2\x59\x0f\x48\x55\xd9\x25\x57\x92\x2a\x5d\xb4\x1b\xd7\xb0\xf1\x64\x20\x4d\xb7\x9c\xd8\x5f\xab\x2e\x91\x74\x65\xf5\xbd\xe7\x3f\xf0\xf2\x03\xe7\xf7\xd6\x97\xe9\x48\x13\x27\x5a\x5c\xd5\xe1\xc4\xa4\x44\xb8\xda\x29\x09\x02\xc1\x06\x3a\x76\x0c\x2b\x00\x9f\x78\xbc\x22\x32\x73\xcd\x92\x33\xbb\xeb\xeb\xbb\xcf\x5c\xb2\x66\x66\x84\x34\x1d\x33\x38\xe4\xc6\x70\x9d\xbf\xc2\x6d\xd3\x1b\x6c\x9e\x4a\x7f\x5d\xb8\x41\xb6\xeb\xd1\x12\x14\x5a\x88\x19\xe4\x7b\xb9\x57\x90\x4a\x21\xa2\x81\x84\x09\xdf\xfb\x73\x14\x45\xbd\xc6\x86\xc9\xfd\xaf\xa3\xbc\xe9\x75\x36\x4c\xeb\xe5\x13\x7e\x0e\xf7\x9e\x2a\xbb\x4a\x1a\x08\x3f\x27\xf3\xae\x26\x86\xe2\xe4\xcc\x3b\x6c\xf8\x75\xe5\x61\x72\x8e\xaa\xff\x71\x1a\x93\x12\xce\xe6\x8e\xc0\x9c\x17\xc9\xd2\xa8\x74\xd4\x53\xea\x0f\x0f\x4b\xf8\xeb\x3c\xf4\x94\x82\xdf\xe3\xd7\x0e\x29\x20\x1b\x45\xee\x66\xff\x84\x92\x24\xbd\x35\xd7\x30\xca\x0d\x4a\x25\xf5\x90\xeb\x7f\x87\x7d\x4e\x7b\x89\xe2\xd2\xcc\x3b\xb2\x55\x6d\x6e\x72\xd7\x0b\xf0\x04\xf2\x05\xa9\x79\x8c\xc4\x76\xd4\x41\x1b\x3c\x04\x6d\xd0\xa6\xd6\x7f\x35\xa2\x21\x72\xa8\x8a\xe5\xf1\xe3\x7e\x1a\x3d\x0f\x48\xcd\x74\x68\x2e\xb5\x4b\x03\x14\x61\xa8\x23\x31\x66\xb9\xbd\x92\x0f\x71\xd7\x95\x55\x09\x29\xa1\xc9\x9f\xb9\xb9\x8d\xf5\xd0\xbe\xef\x6b\xc0\x26\x69\xf0\x55\x3b\x7c\x3a\xe1\xdf\x13\xae\xaa\x2a\xfe\x80\xdb\xb6\xb3\xae\x4c\xa9\x93\x1b\xd3\xf0\x22\x37\x44\xe0\xe9\x32\x18\xb9\x9f\x42\x5c\x7e\x7f\x43\xb5\xdd\x80\xe8\xc9\xfe\x78\x4c\x15\xe0\xca\x82\xdf\xee\xe7\x0f\x1c\x9f\x9f\xc6\x23\x41\x91\xae\xcc\x14\x0c\x70\x47\x52\x54\x4f\x3a\x3d\x3c\xa4\x7e\x2b\xbc\x07\xdf\x9a\xd1\x62\x82\x93\xb3\x67\x3f\xf5\xcb\x02\xcc\xa8\xe9\x34\xfd\xb4\x61\x2c\x9e\x98\x52\x06\x8f\xcf\x87\x3e\x52\xa3\xf4\x63\x6f\x84\x0c\x52\xea\xd9\x27\x32\x15\x3a\xe6\x85\xfc\x7e\x94\x6c\x12\xb5\xf2\x8b\x9b\x44\x6b\x03\xf6\x2a\x5a\xdd\x45\x8d\xa0\x05\xf8\x47\xa0\x96\x33\x8f\x67\x6b\x9d\x5d\xa6\x56\x35\xb5\xa7\x37\x85\xdf\xcf\x5d\xa8\xe5\xad\x8d\x5a\xac\x57\x8d\x41\xd6\xfa\x3c\xb7\xfb\x2b\x2b\x7c\x82\x3b\xb0\x92\xea\x63\xfb\x64\x6e\xc5\x87\x52\x44\xfa\x50\x32\xb8\xb6\x6f\x77\x19\x24\x2d\x20\xdf\x73\x8f\x6c\x60\xf2\xba\x11\x38\x96\x54\x4d\x53\x86\x70\x2d\xa8\x4f\xa7\x13\x39\xa1\x44\xa0\x28\x68\x33\x1e\x7f\xd7\x5e\xa1\xd6\x95\x59\xe1\xaa\x15\xf6\xf2\xcd\xaa\x6e\x19\x1d\xb7\xca\x13\x29\xd4\xbd\x64\xdf\xf3\x34\xd7\xcb\x19\x5f\xaa\x2b\x42\x2b\x19\x1e\xe9\xec\x5c\x75\xe2\x81\x9c\x8d\x8d\xa0\x7a\xce\x86\x4e\x01\x74\xf4\x73\x75\x41\x27\xac\x68\x01\x5c\xba\xc2\x2c\x40\x84\x55\x1c\x88\xf5\x3a\x22\x07\x5a\x38\x08\x02\x85\xc9\xaf\x6e\xf0\x41\x93\xa7\x32\x67\x85\x5a\x39\xbb\x2c\xb0\x8b\x5c\x3e\x3e\xc9\x9a\xcc\x69\x76\xd7\x70\x19\x55\x26\x82\xbe\x61\xa2\x82\xe8\x27\xa9\xa4\x7e\x81\x3c\xbc\x23\xad\x7c\x6c\xd3\x57\xca\xe4\x34\xeb\x31\x96\xb7\x49\x96\x63\xca\xe0\x31\x62\x72\x46\x2a\xa3\xe5\xf5\x2e\x33\xa1\xfd\x67\x16\xd4\xc3\xf9\x50\x0f\x4d\x74\x7f\x8c\xb6\x5d\x15\xf1\x38\x51\xba\x84\x06\x56\xf8\x60\xa0\x85\x47\x36\x00\xe7\x52\x3c\x6c\x93\x44\x16\xcb\x1d\x8b\x26\xad\x80\x2d\x1b\x59\xee\x72\xe8\x1c\x0b\xaf\x94\x7a\x36\xda\x9d\x95\xe5\x0e\x7d\x55\x6a\xcd\xc3\xf3\x3a\x2f\x3b\x73\x71\x77\xab\xd9\xe4\x28\xaf\x74\xda\x37\xf6\x48\x57\x2e\x74\xe8\x9c\xb5\xd1\x8e\x78\xbb\x39\x39\x6d\x0c\x7f\x40\x76\xd6\x39\xaf\x9d\xa3\xbc\xb8\xcf\x17\xa9\x17\x79\x53\xa5\xdb\x61\xb4\xda\x1d\x56\x81\xd7\xd7\x47\x7c\xfb\xc8\xf4\x39\xd7\xb8\x6a\x9c\xe5\xc7\x7f\xac\xd3\xb5\x8f\x1f\x0f\xfd\xc0\xc5\xb8\xf8\x07\xf8\x07\xe8\xc9\xb7\xa4\xe8\xc1\xff\x61\x11\xff\x27\xc3\xf8\xdf\x93\xf4\x24\xb9\x9a\xa1\xb9\x7f\x7a\xf6\xd9\x3f\xcd\x1d\x6a\xbe\xff\xfe\xe6\x02\x3f\xf7\xf4\x88\x28\xd5\x4f\xd7\x8f\x14\xed\x03\x7e\xa6\x99\x19\x9f\x5d\x33\x45\x55\x18\x81\x63\x5a\xa5\x25\xd4\x29\xba\x87\xed\x26\x1e\x09\x7a\xb7\x27\xe6\x8c\xfb\x93\xf1\xd2\x63\xa0\xec\x6d\xd1\xfa\x71\x95\x64\x46\xfd\x38\xdf\x27\xb6\x26\xcf\x31\x71\x5a\x75\xf5\xf8\xe6\xa4\xeb\xae\x15\x96\xf6\x8a\xd4\x40\xea\x96\xdf\x1e\x53\xba\xa3\x99\x8d\x39\xd5\x02\xfe\xc0\x69\x4d\x03\x57\x9f\xd6\x94\x6a\xf6\x29\xbb\xcb\xfc\x51\xaf\xb7\x36\x4c\xb6\xd5\x56\xfc\xec\xe7\x95\xf5\xa9\xc1\xb7\x95\x5b\x53\xe4\xf2\xab\x0f\xed\x47\x2d\x81\xbc\x00\xbb\x50\x47\xb4\x97\xa9\x81\x59\x68\x9c\x8a\xb7\xc7\xa9\x49\x50\x72\x3a\x10\x78\xcc\x93\x2a\x4a\x26\x84\xd2\x9d\x23\x31\x68\xaf\x86\x75\x31\xec\x8f\x73\x6c\xdf\x31\x4f\x93\xed\x13\xdf\xb8\x7a\xe5\x85\xca\x71\xf5\xd1\x8a\x76\xcb\x8a\xbb\x5c\xc9\xe6\xf1\x9b\x33\x79\x45\x15\xf6\xec\x5b\xa0\x04\xd1\x28\x79\xe5\x18\xdf\xeb\x2f\x53\x76\xfb\x9a\x53\x4d\xa7\x6d\x14\x07\x9a\x4e\xab\xaf\xfc\xf9\xcf\x2a\x6a\xc9\xb6\x70\x6d\x2a\x55\x88\x68\x4a\xb9\xf5\xed\xc1\xfd\x87\xae\x26\x97\xab\xf5\x2c\x58\xf9\x79\x30\x8e\x93\xd4\xc2\x51\x5d\x02\xf5\x59\x25\xa2\x4d\x98\x9c\xaa\xc8\x8a\xa8\x25\x3d\x76\x8f\xdf\xa9\x1e\xdd\x0a\x05\xfd\x0d\x1c\xaa\x10\x00\x5b\x5f\x5a\x04\x92\x22\x17\x56\xbb\xd7\x59\x88\x79\x11\x69\x59\x74\xd1\xba\x45\x6b\x6e\xd0\x55\x48\xca\x1e\xf1\xb1\x4a\xeb\xd8\x14\xa9\xc3\xd0\x1b\xb2\xd3\xe1\x56\xc6\xb0\x46\xd6\xc0\xc1\xa0\xe6\x88\xd9\xfe\x9d\x25\x99\x79\x79\xdd\x8e\xd5\x1f\xd9\xdc\xfd\x96\x90\x74\x45\xef\xb6\xb9\xca\x3e\xb9\x7e\xa7\x85\xb5\xd8\xeb\x8c\x33\x14\xc3\x32\xb2\x50\xae\x87\xf2\xb2\x2c\x27\xb0\x5e\xf7\xf3\x0b\x3f\x28\x69\x84\xec\x78\x9e\x07\x73\x47\x82\x99\x06\x65\x12\xaa\xf8\xac\x8e\x45\x58\x9d\xed\x63\x7e\x55\xc5\x5e\xf4\xb8\x45\xab\xd0\xc0\x06\xfd\x32\x6d\x28\x7f\xd2\x5e\x37\xa2\x3c\xbf\x27\xeb\x58\xbd\xcb\x66\xd9\x2b\x2a\x7b\xa4\x0a\xdd\x0d\x6b\x16\xaf\xbd\x08\x8a\xb6\xd8\x44\x2c\xeb\xdc\xb3\xbe\x63\x37\x03\x26\x50\x0a\x03\x67\x24\x4a\xdd\xfb\x52\xa5\x6e\x0b\x09\xad\x51\xf6\xb0\xf3\xf3\xfd\x69\xde\x47\x44\xb0\xd9\x3d\xa6\xcc\x5f\x2d\x3b\xeb\x65\xb2\x70\xee\xb6\xde\x2b\xa4\x90\xa5\xdf\xfd\xe4\xf3\x6e\x2f\x2b\x70\x2c\x4b\x52\xf5\xb2\xb2\x6f\x19\xf9\xe2\xe3\x11\xe5\x41\x5b\x89\x7c\xaf\x60\xcd\xb6\x11\x83\x83\x40\xa8\x22\xd9\x32\xc1\x12\x46\x6d\x4d\x55\x03\x59\x0b\x8c\x58\x48\x27\xdb\x2b\x39\xe4\x20\x81\xf0\xa5\x25\x6a\x21\xa5\x8a\x79\xe4\x0f\xdb\xe6\x62\x3d\xee\xb4\x64\xfe\x0a\xd5\x6a\xc8\xd6\xf6\xcf\x0b\x2b\xf6\x63\xf2\xc5\x32\xac\xfb\x81\x02\x1d\x1b\xc1\x5a\xd4\x9e\xd6\xc2\xa6\xfe\xed\xa8\xad\xaa\xec\x53\xdb\x3c\x63\x2a\xd8\xcf\xe1\x4e\x64\x18\x1d\x77\x44\x70\xd1\x33\xe6\x33\x29\xc5\x40\xe7\xf3\xa0\x36\xbf\xab\x2b\x75\xdc\x2e\xa2\x0d\xb9\x10\x4c\x6e\x7c\x56\x11\x5c\x24\x9c\x27\x99\xf0\x54\x09\x95\x78\xe0\xc6\x9d\x50\x4d\xb0\xd8\xc3\x64\x79\x01\x9a\x44\xca\xd5\xb0\x4d\xab\x7a\xd6\x82\x55\x9f\xb9\xf5\xe3\x85\xb9\x2a\x8f\x46\xb1\x2d\x96\x15\x2a\x06\x0d\x7f\xb4\x66\xd1\x3a\x6c\xdd\x45\x66\x6c\xdd\x6a\x72\x21\xab\x77\x4a\xe6\xc7\xd4\xa6\x27\xbf\x52\xf6\x2d\x81\x06\x27\x6a\x83\x43\x41\x95\x31\x6e\x87\x53\x7e\x03\xbb\x05\xa9\x4b\xc1\x9c\xed\x66\xdc\xfc\x7d\xfc\x7d\x50\xae\xba\xd2\x7d\xa0\xba\x10\x8a\x76\xb2\xeb\x97\xec\x72\x27\xeb\x12\x1d\xe4\xf0\xc7\xaa\x48\x49\x75\xd8\x9b\x46\x9c\xb7\xe4\xef\xcb\xbd\x45\x47\xe9\x1c\x71\x78\x93\x39\x25\x5c\x0b\xd1\xb9\x3c\x02\x56\x54\xae\x8e\x71\x17\xb0\x18\x2e\xa9\x2b\x3c\x7d\x4a\xcf\xa3\x96\xc0\x3d\x92\x3f\xc8\xaa\x81\x66\x4e\x0d\x17\x7a\x65\x40\x3d\xfa\x69\xcf\x6a\xbb\xc7\xa2\x89\xd2\xf2\xce\x1e\x91\x6d\x69\x79\x27\x00\x56\xb6\xa2\x5a\x39\x35\x5c\x81\x6a\xbb\x50\xb1\x9f\x3b\x8a\x22\x3f\x75\x23\xa8\xa4\xbc\xdb\x47\x1e\xcf\x2d\x86\xfb\xd2\x68\x87\x72\x39\xe0\x74\xcb\xf8\x7b\xf9\x7b\x01\xee\x00\xf3\x58\x09\xe4\x3a\xe0\xe9\x75\x21\xe0\xdd\x45\x9d\x5b\x7b\xba\xdc\x62\x14\xf5\x82\x58\xf8\x4b\xba\xad\xd4\xe7\x82\xf6\x70\xa3\x6c\x0a\x0f\xb1\x91\x70\xc0\xca\xa2\xb0\x27\x11\x07\x7f\xa2\x85\xe8\x34\xc9\x0f\x7e\xc2\x55\x93\x6e\x94\xf4\x10\x14\x63\x85\x92\x51\x77\x34\x91\x6c\xe1\xc2\x40\x75\x84\xc2\x55\x68\x32\xb1\x85\x0d\xeb\x44\x17\x4c\x05\xe0\x81\x54\xee\x28\x54\xfb\x07\x47\xde\xbe\xff\xcc\x33\xef\x7f\x5b\x75\x48\x4a\x6a\xac\x1c\x6b\x94\xc6\x4b\xae\x1e\x8e\x73\x12\xae\x5d\x30\x54\xea\x02\xc4\xec\xf6\x8a\xb6\x40\xab\x49\xee\xe9\xf4\x89\x2e\x2f\x67\x71\x05\x9d\xa2\x70\xc1\x86\x2a\x7f\x2d\x67\x69\x33\x4b\x53\xed\x8e\x96\xfa\x26\x5b\x4c\xe2\x89\x2e\xc2\x13\xce\x54\xee\xf0\x38\x9d\x26\x9d\x4d\x57\x5f\xa1\x13\xdd\xb2\x53\x27\xb0\x26\x81\xe3\x2c\x1e\x5e\x67\x10\xcb\xdc\x3a\x9b\xbe\xc6\xee\x31\x18\x1b\xfc\x13\x4d\x3c\x4b\x38\xae\x3a\x69\x32\x0b\xbe\xa6\x31\x16\x87\xdf\xe6\xd0\xf7\x34\xd8\xb8\x72\xce\xe8\x30\xda\xf9\x7b\x73\xb8\xa1\x63\x37\xdb\x45\x03\xcb\xe9\x04\x31\x2c\x99\x3c\x62\xe0\x12\x77\x60\x63\xa5\x61\x7a\x99\xd1\xc5\xeb\xad\x36\xde\xd0\x52\xc5\x4b\xa1\x06\xbb\x5c\x51\xc9\x8b\x0e\xa3\x99\xbd\xc4\x57\xdb\x5d\xa3\x27\xa2\x68\x33\x11\xd6\x64\x0a\x7a\x48\x3b\xb1\x18\x58\xa9\xda\xcb\xba\xbc\xbe\xca\x2a\x9b\x8e\x10\xd1\x68\x2e\xb3\x5b\x78\xce\x5c\x57\x63\x73\xb8\x3d\x16\x6b\x83\xcb\x6b\x74\x38\x38\x83\xc9\x13\x76\x05\x74\xc4\x6a\x30\x8b\x04\xe5\x58\x95\xb5\x66\x87\x89\x5f\x65\x8f\xd6\x73\xc4\x60\x32\x99\xea\xe0\x4b\x7b\x76\xdf\x82\xe5\xf7\xf3\x37\x52\x9b\x71\x09\x8f\xe0\x49\xba\x51\x48\x13\xce\x1a\x3f\x01\x02\x9a\x3d\xfa\xbd\x6f\x29\x99\xbb\x8e\xeb\xe6\xcf\x19\x77\x53\x57\x66\xaf\xd4\x28\xad\x97\x24\xb6\x2f\x30\x8f\xbf\xf1\xcc\xf3\x6e\x3b\x72\xcd\xb2\x9b\x1b\x03\x26\x77\x66\xaf\xcd\xb6\x4e\x6a\xb4\xb1\x4b\xcf\xfb\x77\xaa\x1f\xb9\x02\xd6\xa6\xbd\x39\xfb\x4e\x28\x25\x18\xa1\xfb\x2b\x04\xe5\x58\xa9\x22\xa4\xe0\x97\xfd\xa3\xc5\x69\xec\x29\x25\x79\x79\x7b\x3a\x8d\xa7\x87\x0a\x22\xf0\x70\x53\x69\x14\x70\xae\xfc\xde\x3c\x21\x0c\xcc\xfb\x49\x03\x0c\x93\x3b\x8f\x89\x67\xff\xed\x2a\xae\x2a\x06\xc8\xd1\x8a\x76\xe2\x1f\xe0\xb6\x52\x45\x88\xcd\x48\x8e\x74\xa5\x95\x9d\xdc\x56\x60\x50\x29\x51\x9c\x99\xa2\x0c\xa6\x81\x47\x39\xaa\xd1\x46\x2a\x4d\x87\xa7\xa7\xea\xb2\x34\x1d\x9d\xd6\xfd\xf1\xa0\xec\xb7\xe7\x6c\x44\xc4\xe2\x44\xce\x96\x92\x1c\x4d\x47\xba\x90\x4b\x52\xcc\x69\xc5\x8c\x5c\x0f\x70\x38\xe4\xa8\x5a\x1a\xfe\x00\xe0\xd9\x15\x51\xfa\x91\xc6\x07\x7c\xa9\x41\x88\xcc\x94\x34\x65\x83\x8a\x78\x09\xdc\xab\x98\x98\xb5\x9e\x55\xb4\x19\x59\x64\x35\xaa\x64\x57\x65\xd4\xbd\x0d\xa1\xe8\xf4\x74\xf6\x34\x64\x54\xdd\xa8\x78\x5d\xdd\x9c\x78\x1d\xf7\x2e\xb8\xb8\xb6\xb5\xf1\xba\xba\xb5\xf1\x3a\x6e\xbf\xb4\x6a\x9e\xec\x16\x08\xbc\x63\xdf\xa0\x3b\x1d\xea\xee\x86\x96\x01\x50\xe2\x45\xdf\xc9\x39\xad\xd6\x62\x20\x99\x50\x0e\x9c\x9c\xc5\xe0\x3f\x8b\xb3\xc7\x2c\xb2\xba\xb9\xfc\x6e\x7e\x37\xdd\xeb\x0c\x42\xbf\x9b\x3c\x42\x5b\xa0\x70\xc7\x33\x7b\x6c\x87\xba\x78\x24\x3c\x2b\xa6\x4d\xf4\xa0\x95\x12\xbe\xf0\x35\x9e\xee\xf9\xfd\xe7\xef\x3f\x7c\xf6\xd9\x0f\xbf\xff\xf9\xfb\x8f\x2c\x5f\xfe\x08\xf9\xf9\xd0\x4f\xd6\xaf\xff\x09\x3e\x94\xe6\xe6\x9a\x9a\xe6\x1a\xd2\x0b\x8e\xdb\x2a\x25\xed\xb1\x19\xb1\x48\xf5\x95\xc4\xa0\xdc\x4c\x5f\xb0\x3e\xea\xf0\xbb\x73\x1f\x83\xf3\xf0\x52\xf5\x63\x78\xe8\x6a\x9a\xfd\x7e\x4c\xe2\x37\xde\xe5\xa9\xac\x8d\xc5\x6a\x6b\xda\x85\x6b\x88\xd5\xea\xf9\x5e\x0d\xfd\x92\xfe\x31\xe4\xc4\x7f\x31\xa7\xf3\x2f\xf2\x07\xd1\x3e\x6d\x1d\x6e\xaf\x84\xc2\x21\x6a\x56\x94\x01\x5e\xc9\x53\x05\xdd\x2d\x49\x12\x30\xe3\xe2\xb1\x61\x91\x58\x71\x18\x33\x54\x2b\x02\xde\x79\x70\x78\xab\x96\x6a\xa1\xdd\x93\xea\xe6\x8c\x16\x0f\x49\x51\x06\x1b\x20\x6f\x9f\xa1\x17\x37\x9e\xed\xf6\x06\x26\xb6\xf5\xf8\x6b\x94\x5b\xa4\xaa\xaa\x66\xa7\x45\x30\x2c\x4b\xce\x8a\xb1\xb5\xb5\x36\xd1\xe7\x28\x2f\xb3\x94\x2b\x4f\x48\x91\x4a\x2b\x99\x1e\x08\x4e\x6c\xb1\x4a\x4d\xe3\x2d\xd6\xb2\xa0\xb9\xfe\xf2\x75\xb7\x5f\xb8\xc4\x3a\x66\xc2\xb4\x8d\x81\xb6\xf2\x29\xe4\xc4\xf4\xfa\x33\x2e\x9c\x3f\x63\xee\x2a\xc9\x3e\x66\x92\x52\x25\x35\xd8\x6c\xdc\x3e\xf2\xdb\x33\xce\x3b\xf7\x7e\x3b\xef\x2a\x8f\xfa\xab\x95\x5b\xa5\x0a\x5f\xbc\x3e\xe9\xeb\x9e\x84\xb9\x87\x6d\x2e\x8f\xb9\x5c\x79\x52\x22\x95\x11\x2b\x99\x13\x08\xfa\x89\x3e\x19\x69\x5d\x19\x34\x35\x6c\x5a\x77\xeb\xf9\xbd\x62\xc5\x84\xce\xb9\x3f\x86\x8c\x67\x94\x25\xfd\xb5\x37\x2d\x3d\xeb\x07\x93\x94\x5a\x89\x95\xa4\x90\x36\x86\xb3\xf6\xff\xa6\x8c\x66\x01\x10\xd5\x44\x02\x68\x85\x55\x27\xbb\x60\xe5\x71\x67\x45\xf0\xc9\x6e\x1e\xed\xfa\x8c\x6e\x1f\x10\x58\x83\x9e\x68\x73\x83\x3f\x1c\x69\xee\x09\x4c\x5e\x5f\x31\x31\x1a\x93\x44\x69\x6c\x63\xbb\xdf\x12\x88\x45\x26\x98\x79\x7d\x63\xa4\xc7\xe7\x59\x73\x0a\x3b\x82\xcd\xb0\xee\x85\x42\xf1\x44\x68\xfd\x1c\xcf\x98\x59\x1d\xed\x92\x68\x1d\xd7\xd0\x52\x56\xd6\x56\x3f\xc1\xc4\x1b\x22\x0d\x53\x1a\xa2\xce\x79\x6b\x01\xff\xce\x13\x9f\xf3\x97\xf3\x3f\x86\xb9\xa3\x85\x51\x2d\x48\x52\x5b\xc3\x40\x1d\x26\xc3\xc4\x23\xaa\x93\x32\x97\x74\x17\x2b\x89\x42\x50\xf4\x10\xf6\x89\x97\x26\xdd\x39\x69\x4c\xc0\x57\xdd\xe2\x38\xfb\x0b\xe5\xaa\xc1\xd5\xce\x3b\x27\x4d\x22\x15\x8e\xd5\x46\x03\x78\x94\x0f\x27\xfd\x10\xde\x4f\x4a\xfa\xf0\x35\xb9\x91\xff\x31\x84\x96\x37\x5c\x18\xf0\xc5\x21\xe2\x4b\x48\xbf\xc6\x71\x27\x24\x21\x15\xca\xb4\xd5\x7a\x03\x7a\xe1\x13\x48\xf3\xd2\xa4\x64\xb2\xd5\x7e\xf6\x97\xe4\x46\x95\xf7\x4b\xe7\xec\xc0\xc8\x68\x57\x3d\x7b\x26\x5c\x9b\x3c\xba\x89\x68\xf7\xdb\x99\xac\xbe\xb5\x6e\xcf\x7d\xa9\xb1\xaa\x28\x71\xdc\xf9\xdd\x6c\x9f\x72\x62\xc9\xf8\xf1\x4b\xc6\xf3\x07\xaa\x5a\xa2\xf2\xf0\x74\x2a\xc0\x78\x51\x8e\x35\xef\xa1\xc6\x6c\xdc\xe3\xf1\x6d\x81\x4d\x42\xb7\xba\xbf\x8c\x05\x25\x40\xfc\x0a\x4e\x12\xf6\xe3\x5c\x94\x88\x72\x37\x91\x0a\xc9\xd7\x60\xad\x55\xfe\x67\x50\xf9\x0f\xe5\xc8\x1b\x24\xf6\x16\x69\x22\xa6\x20\x44\xf2\xff\xa6\x7c\x24\x35\xf8\xa4\xa0\xf2\x37\x65\xf0\x2d\xe5\x97\x6f\x90\x32\xd2\x31\x48\x3c\xb5\x56\x55\x66\xc5\x91\x30\x63\xe7\x0d\xfc\x10\x13\x65\x26\xd0\xf3\xcc\x72\x15\xef\x09\x02\xcd\x61\x87\x1e\x81\xca\x43\x72\x30\x4e\xf7\xa2\x62\x30\x36\xec\x61\xb5\xfb\x24\xe3\x5e\x82\x5a\x46\x55\x1c\x74\x1c\x7b\x37\xdf\xd1\xc2\xb2\x07\x43\x53\x17\x8c\x6b\x62\x1f\xe6\x2d\xbe\x58\x78\x42\x60\xdc\xfc\x64\xfd\xd3\xf7\xa7\x06\x96\xd6\x7b\xb9\xdb\x85\xa5\xb3\x2a\x5a\x26\xcd\x6d\xab\x89\x2e\xbe\x60\xac\xaf\xa1\x41\xb6\x3a\x43\x5e\xaf\xe1\x02\x77\xa4\x3d\x50\xe6\x8d\x25\xf9\xa1\xb9\x82\xb3\xa9\x67\xf5\xa4\xda\x96\xa6\x0a\x51\xf9\xa5\x45\x74\x36\x8f\x4b\x75\xdf\xbe\xc7\x3c\x67\x9e\x6d\x69\xfd\x85\x4b\xe6\x9c\x6b\x23\xf3\xf4\x56\x6f\x6b\x67\x5f\x62\x5a\xff\x8c\x06\xdd\x1c\xc5\x41\x1c\xbc\xd5\x97\x08\xf9\x1a\xbd\x2e\xbd\x8e\x7b\x53\xf9\x05\x6b\x70\xd7\x74\x4c\xee\xa8\xf3\x6a\x7b\x04\xe7\x73\x77\xf3\xfb\x50\xa6\x42\xb5\xe0\x83\x1d\x68\x3f\x00\x46\x3c\x5a\xaf\x02\xb4\x63\x72\xd0\x8d\xa7\xb6\x61\x3e\x48\xa0\x08\xc5\xa3\x5a\x19\xa0\xdb\x89\xe1\x6e\x01\x37\x19\x83\xf1\x18\xaa\x9b\x53\x1b\xc4\xed\x8b\x76\x2c\xdf\x11\x1e\xd7\x55\x5e\xb7\xc8\x15\xa9\x28\x2f\xd3\x71\x9f\xbd\xa6\x97\xe5\xe6\x44\xe0\x5c\x77\xcd\x1d\x81\x85\xf1\x68\x75\x62\x4c\x65\x8d\x67\xcd\x65\xa1\xf0\x92\x32\x4f\x92\x2f\x13\x9d\x66\xbb\xa1\xcd\x5c\xfb\xfe\x63\x1e\x59\x34\xb2\xd6\xe6\xda\x73\xf8\x7d\xf3\x6f\x9f\x5b\xdf\x13\x70\xf8\xe4\xa6\xce\xf2\x59\x6d\x0d\x06\x21\x15\xdd\x52\xd5\x8d\xda\x45\x81\xaa\xef\x3a\x3d\x7a\x12\xa8\xe6\xb9\x72\xb3\xe9\x2e\xce\x26\x58\x4c\x76\x63\xf2\xdb\xd3\x53\xad\x9d\xae\xc6\x29\x13\x7b\xfd\x7d\x74\x5c\x7b\x4f\x9c\xe0\xb7\x6b\x76\x33\x34\x19\x51\x4e\xbe\x17\x13\x54\xa9\x5f\x30\x27\x6d\xdd\x56\x24\xe4\x4b\xb3\x1e\xd5\xd5\x62\x49\x93\xdb\x7a\x4c\x92\x8e\x59\xdd\xdc\x56\xdb\x57\x56\x58\xd1\x61\x65\xa7\x02\x3f\x1e\xe1\xd0\xbe\x2d\xe6\x56\xef\x20\xac\xd9\x78\xd6\xbb\x14\x26\xac\xde\x4e\x11\x0f\x5c\x26\x83\x71\x3f\x0a\x35\x53\xa4\x29\xb3\xbb\x18\x30\xb9\x2b\xb5\x92\x9e\xe9\x4e\x93\x26\x3c\xc7\x0d\x34\xca\xe6\x3c\xec\x54\x3a\x9d\x71\x1c\x4b\x0d\x1c\xa3\xe7\xaf\xf3\xb0\x25\x7a\x4e\xb9\x6e\x94\x72\xe6\xe0\xd9\x35\xac\x44\xa4\x21\x4a\x4b\xdb\x97\x05\x49\x71\x7a\x3d\x55\x58\xde\x01\x0a\x0f\x8f\x5d\x93\xa3\x00\x3f\x0b\x97\xd2\x3d\x12\x8c\xe7\x8a\xd1\xea\x97\xe0\x09\xf7\x98\x13\x60\x9e\x0c\x62\x4a\x3b\x97\x5e\x0c\x0c\x88\xb2\x6d\x08\x0b\x9e\x14\x54\x09\x2c\xef\xe8\xb0\xb2\x50\x38\x0a\x75\x04\xac\x83\x1a\x1c\x0a\xaf\x10\x96\x0a\xe6\xf8\xb3\xe0\xa8\xba\x09\x08\x4b\xb5\xb5\x92\x3d\xc9\x3e\xb2\x46\xb1\x5c\xfe\xb8\x3f\xfb\x2b\x85\x06\x54\x99\x7a\x8c\x3e\xa5\xf4\x43\xbe\x48\x65\x16\x02\x4d\xa7\x29\xb5\x86\x7f\xdf\x10\x1e\xc0\xe0\xb2\xbf\x51\x4a\xc7\xd5\xa8\xa7\xf4\x29\x30\x04\x9b\x4e\x9f\x0c\x5e\xd6\x4e\x49\xaf\x36\x26\xbe\xc6\x76\x0c\x59\x4e\xb6\xed\x3c\xa8\x9d\xb8\x60\x57\xa9\x07\x03\xf0\x24\x41\x17\xdf\xab\xc5\xd2\x03\x02\xe9\xec\xa1\x01\x7c\x97\x97\xf5\x65\xe1\x34\x7e\x3d\xa4\x22\xb3\x42\xcb\x31\x4f\x3c\xab\xb0\xeb\xa5\x83\x6c\xaa\x00\xaa\xb2\x3e\x6b\x56\xa8\x57\x3b\xca\x00\xe0\x77\xbd\x54\x04\x7e\x4b\x91\x61\xa1\xff\x13\x2e\xfe\x7c\xfd\x23\x2e\xbb\x5e\xca\x9e\xf3\x28\xac\x01\x85\x02\x30\x6b\xd0\xf8\xde\x83\x3b\x4f\x82\x50\xba\xcc\xa6\x62\xfd\x7f\xac\x17\x8d\x96\x4e\x44\x0b\xeb\xa5\x04\x97\xe3\x58\x60\xa5\x5f\xc5\x47\xad\x1c\xa8\xbb\x9d\x23\xda\xe6\x57\x58\x79\x80\x4e\x6e\x0f\x86\x9e\x47\xab\xd6\xce\x98\xe4\x30\xd1\xce\xe2\x6b\x26\x6b\x34\x3b\x3f\xf9\xe3\x1c\x05\xf6\x22\xd9\x50\x16\x27\x2a\x95\x7b\x05\x01\x29\xdd\xe0\xff\x09\x99\x62\xb3\x29\x07\x6d\x6e\x75\x2b\xce\x9d\x6d\x31\x2a\x49\x52\x91\xa1\xde\xff\x51\x3e\xb0\x86\xac\xf0\x30\xa2\xe6\x74\x1a\xb7\xb4\xf0\xea\x1f\xe6\x1d\x86\xe5\x7e\xc7\x3f\xa4\xd9\xd8\x14\x75\xac\x58\x17\x75\x8b\x01\xe0\x6e\x61\xd6\x0e\x87\xe8\x79\x75\x7f\x00\xa5\x01\x0c\xd2\xa3\x9c\xd7\xa0\xe7\xb8\x7b\x59\xd2\x54\x66\xb7\x12\xe5\x0b\xe5\xaf\xbc\xd9\x6a\x30\x18\x04\x76\x7e\x38\xac\xbc\xe5\xd1\x1b\x6d\xb3\x89\xe5\x25\xc1\x60\xe3\x1f\x32\x18\xed\x26\x65\xa1\x48\x82\x0e\xe5\x17\xca\x6b\x82\xa4\x37\xc9\x86\x0f\xd7\xad\x73\xca\xae\x25\xa4\xf5\x37\x3a\x5b\x6e\x7f\x4a\x29\xac\x1b\x8d\xb7\xcc\xb6\x4e\x8e\xd7\x2c\x3c\x2b\x54\x62\x4b\x73\xb9\x66\x08\xb7\x9b\x56\xff\x2b\xd4\x4f\xfb\xd1\x67\x23\x2a\x47\xab\x16\x6c\x29\xea\x55\xc7\xd5\xa8\x95\x53\xa8\xf3\xd6\x5e\xa8\xf3\x96\xe3\xbf\x91\x3b\x0b\x9e\xec\x90\x55\xcc\xcf\x7e\x82\x78\xc9\xd6\xb5\x4a\x5a\x8a\x48\x77\x4a\x12\x19\xb3\x16\x98\xaf\xff\x7d\xa0\xf4\x8c\xd5\xa3\xc0\x0b\xab\x56\x29\xf5\x34\x31\xa4\x8d\xd0\xc4\xc0\x40\x65\x7b\xd5\xdd\x69\xe5\xb7\xd9\x69\x00\xfb\xb7\x95\xb1\xf2\x3b\xf9\x9d\x1a\x1f\x75\x72\x0e\x2a\xcb\x22\x91\x43\x9f\xbc\xf3\xe0\x59\x67\x3d\xf8\x8e\xea\x90\x57\x3f\x7a\xe5\xe2\x8b\x5f\xc1\x87\x72\xd5\x23\x97\x5c\xf2\xc8\x25\xfc\xce\xdc\x4b\x74\xa6\xab\x2f\xe1\x71\xe9\x25\xf8\x3e\x3f\xa6\xb0\x3e\xaa\xf3\xf7\x4d\x8c\xb0\x9c\x97\x33\xb6\x88\xab\x30\xbd\x6f\xe2\x6f\x2e\xa3\x55\xb9\xde\x1a\xb6\x28\x37\x5a\x0c\xf2\x31\x9f\x72\x23\x1e\x5b\x38\xfe\x2c\x1e\x9f\xa4\x05\xdf\xe9\x96\x94\x25\x50\x3f\x7b\x24\x37\xde\x36\x31\xbc\x59\xdd\x54\x4e\x45\xa3\x05\x63\x79\x6f\x16\xae\x41\x3d\xfb\x54\x60\x56\x35\x49\x50\xcd\xbf\x10\x2e\x3b\x9d\x5c\x09\xad\x79\x34\xe0\x4e\xbb\xca\x8e\xb9\x4c\x56\x72\xad\x35\x9c\xf9\xaa\x00\xee\x43\xca\x1c\xa9\xe6\x98\xab\x0a\x40\xf9\x25\xb2\xdf\xc6\x0e\x5f\x9b\x87\x9a\x85\x39\x9b\x71\x31\x55\x68\xf9\x7b\x34\xbb\xb6\x1e\xae\xb8\xa8\x77\x90\x8b\x45\x59\xbc\x5d\x14\x33\x7f\x45\x5d\xda\xf7\x50\x53\x5e\x16\x07\xf3\x30\xd9\xdf\x40\x0a\x1a\x99\xf9\xab\x5e\x4f\x82\xc0\x16\x63\xea\xb7\xf2\x70\xb3\xb6\xa7\xef\xa7\xfb\x8e\x28\x9b\x61\x9c\x54\x99\x52\x04\xb2\x9a\xf2\xfc\xb8\x19\x22\x50\x9e\x3f\x59\x5c\xe2\x9b\xbf\x05\x0b\xd5\x9c\xc1\x39\x56\xd6\xfd\x96\x55\x66\x89\x4f\xce\x3c\x01\xfd\xe9\xcd\xfb\x06\x95\xf1\x79\x14\xb8\x13\xdf\xb2\x42\xaa\x5e\xa9\xe1\xbe\xb7\x60\x51\xcb\x7c\x1f\x05\x77\xd6\x37\xef\x1f\xcc\x8c\x2d\xac\x73\x3e\xd7\xd6\x52\x16\x0f\x2a\xed\x77\x52\xe9\x04\x5a\x09\x4d\x38\x73\x42\x8a\x92\x16\x5f\xce\x2e\xcd\x66\x7a\x3f\xc0\x78\x15\x60\x59\x11\xa8\x72\xac\xb0\xd5\x0b\x11\x04\x54\xe4\xcc\xfc\x39\x56\x2b\x2d\x82\xd2\x97\xc7\x84\x65\xf8\x13\x1f\x53\xbb\x1a\x02\x9e\xfd\xae\x33\x10\xbb\xdf\x40\x64\xc2\x1f\x50\x7c\xca\xcf\x95\x8b\xf9\xbd\x99\x18\xfb\xc4\x2a\xe2\x52\x2e\x26\x8f\x73\x95\xc3\x5f\x7d\xc4\xbd\x98\x9d\x73\x53\x5a\x5f\xf5\xaa\xa7\x97\x70\xdb\x3f\xab\x4e\x81\xaa\x00\xf6\x18\xda\xfa\xc1\x19\x61\x20\x33\x85\x9e\x28\x39\x86\x54\x57\x2a\xc5\x1d\xc1\x2d\x52\xb4\x1c\x89\xc7\xbe\x5c\x55\xd4\x4e\x4e\x5a\xed\x87\x53\xf9\xf7\x29\xdf\x53\x4e\xcf\x5b\x8a\xea\xb1\x8a\x51\xb2\x25\x23\x34\xb5\x09\x1e\x8e\x67\x95\xb7\xcf\x36\x78\x0d\xf7\x28\xe9\x42\x80\xe4\x00\x2a\x6a\x9f\x59\xa0\xa8\x4d\x22\x22\x80\xbe\x07\x53\x1b\xbe\x5b\x8c\x47\xe6\x3f\xa9\xa2\xf6\x19\x79\x45\x6d\x48\x4c\x71\xb3\x08\xa2\x86\x5b\x37\x96\x58\x04\x0e\x49\x3c\x19\x6e\x5f\xa7\x09\xcf\xf7\xae\xba\x49\xaa\xb3\x29\x7f\x57\x9e\x2f\xc2\xf4\xef\xd3\x24\xe9\x86\x1b\x24\x69\x1a\x4c\x50\xe0\x46\xa4\x92\x30\x77\x44\xf9\x2b\x7c\xc9\x59\xe1\xcb\x62\xb4\xef\x38\xd9\x27\x5a\x58\xad\x5f\x99\xf2\x11\xd8\x66\x58\xbf\x76\xbf\xa6\x88\x5a\x5a\x04\x67\x56\x7b\x29\x4f\xbe\xa1\x9e\x4e\x2f\xde\x5b\xa3\x98\x0b\x31\x1e\x48\x95\x68\x76\xf8\x00\xc5\x41\x68\xf3\x12\xf4\x76\xc3\x17\x32\xa4\x80\x3f\xf0\x14\xec\xa3\x16\xe1\x13\xc6\x53\x33\xa3\xe1\xe3\x29\x9d\x91\x7a\x68\x7b\x3f\x8a\x27\xd9\xbe\x52\xd6\x15\x62\xf4\x67\x15\x0d\x39\x55\xa0\x55\x02\xab\x80\x24\xc1\x62\x5a\x8c\xd3\x8d\x1f\x4a\x32\x4c\x50\x55\xae\x63\xb2\xf4\xa1\x44\xf1\x49\xd3\x7d\x5d\x03\xa5\x61\x35\x89\x6d\xb1\xbe\x96\x13\x4d\x8c\xa0\x85\x85\xe3\xf3\x7d\xfc\x77\x55\x33\x0b\x29\xbc\x7a\x45\x55\xa5\x52\x06\xb3\xda\xe7\xc8\x84\xa0\x3c\x8e\x63\xae\xe6\xf7\xf1\xdb\xe9\x38\xf7\x51\x9b\xb9\x5d\x45\xda\x14\x71\x9a\x7f\x6e\xb9\x45\x7b\x52\x68\xb9\x11\xe0\xca\x59\x4d\x0b\xa0\xd3\xf3\x96\x02\xb8\xc7\xbf\xb4\x5a\xbf\x44\xb5\x18\x58\xfc\x32\xad\xaa\x4a\x0a\x14\x5b\xd9\xf2\x85\x55\xc6\x41\x25\x5b\xbf\x90\xb8\xeb\x81\xfb\x89\xa1\xfa\x5c\xac\x40\x5b\x0a\x59\x40\xe5\x46\xf5\x2c\x7c\x3a\xf3\x46\x11\x5b\x78\x45\xba\xe0\x1c\x7c\x2a\x27\x7f\x38\x2d\x6f\x87\x4d\xdd\x2a\x6d\xe1\xb1\x65\xea\x50\xf1\xc6\x0e\xeb\xa1\x44\x8a\xdf\x74\x73\x54\x27\xc7\x1e\xb3\x8f\x23\x68\x6a\x52\xf5\x43\x34\xb7\x9c\xb8\xbc\x75\x74\xed\xa7\xda\x12\x75\xca\x21\xb7\x97\x25\xa8\x34\x61\xcc\xc7\x12\xd1\xe8\xb4\xb8\xbc\x2c\x97\x12\x0c\xb2\xe4\xf1\x12\x42\xed\xaf\xf0\xed\x97\x39\x5c\xd5\xc7\xa9\x62\x05\xb9\x93\xe8\x2b\xaa\xe3\x55\x64\x55\xf5\x94\xbb\xbc\x66\x8b\xd2\x5d\xd9\xd3\x1e\x31\x99\x94\x89\xf4\xed\x4f\x78\xa9\xa5\x65\x42\x25\x79\x85\x15\xed\x16\x13\xbe\xdf\x09\x13\x66\xa9\x3f\x67\x93\xaa\x17\x4f\x9a\x11\x19\xcf\x4f\xe5\x0c\xc8\x24\xa8\xc9\x60\x77\x2c\x6b\x4c\xa6\x23\xd4\x4a\xe2\x7e\x19\xe9\x10\x3f\xd2\x91\x71\xf8\x0e\x91\xa5\x76\x64\x66\x7b\x15\xb3\x77\xb6\x66\x4f\x06\x62\xc8\xb6\xd9\x5e\x72\xd4\x3b\x9b\xef\x1d\x43\x04\xa3\xcb\x3a\x7c\x0b\x9a\x91\x19\x33\xc6\x52\x47\x6e\x53\x36\xd6\x59\xc6\xa8\xd1\xdc\xa6\x6c\xb4\xb2\x91\xdc\x06\xd1\x39\xd9\x6d\x2f\xd5\x07\xaa\x64\x6a\x98\x5a\xa6\x9e\xe9\xc8\xd2\x6c\x41\x21\x1e\xf6\x27\xe9\xe6\x40\xf1\xb5\x10\xfe\x38\x11\xe2\x75\x02\xb8\x02\x15\xa7\x8b\x7e\x48\x17\x63\x77\x91\xcb\x65\xdf\x6a\xe5\x9d\x34\x1a\x57\xbb\x5c\xa9\x43\xe1\x3a\x90\x3a\x83\x94\x34\x02\x76\x1c\xbb\xcf\x70\x19\xfa\x80\x3c\xb8\x35\x85\xda\x0e\xdb\xd2\xab\x51\xff\x6a\xe0\xdc\x73\xd3\xa9\x22\x62\x1b\xf8\x30\xe5\x8b\x1b\x77\xdd\xf8\xf3\x74\xfa\xfc\x2f\x51\xb3\x30\x0d\xf5\x67\x63\x6c\xfc\xc3\xfc\xc3\x68\xd3\x0b\x56\x50\xb7\x7a\x3f\x58\x15\xf1\xd0\xbb\xc2\xe8\xc5\x60\x81\x16\x5d\xb8\x45\x48\xda\x77\xda\x5b\xac\xb7\x5b\x75\xf6\xcb\xcf\x1f\xbe\xf3\x72\x7b\xcc\xf9\xb2\x53\xb4\xef\xe2\x2c\x2d\xed\xbd\xf3\xce\x3b\x7f\x53\xd7\xac\xcb\xa7\x45\x9d\x26\xc2\xfd\xef\x4e\xbb\xce\xf9\x8a\xab\xdd\xbe\xf1\x5b\xc3\xe7\x6d\xb4\xf3\xd6\x5b\xac\xad\xf6\x9d\x84\xd7\xcb\x35\xf1\xc9\x2b\xbb\x66\xed\xd8\x7c\xe9\xe2\x09\xb6\x22\x9d\xab\xa0\x66\x95\x91\xaf\x26\x74\xa8\xe0\x0d\x45\xa1\x1a\x91\x0a\x0d\x71\x70\x75\x0b\x0e\x6a\xbb\x4a\x26\x25\xb7\x79\x10\x94\x68\x91\xe1\x17\x1e\xdb\x90\xea\x69\x32\x18\x7c\xae\x5f\xb8\x7c\x77\x2a\xaf\xdc\x49\x3d\x9c\xa5\xbd\xeb\xfc\xcb\x7e\xd1\xbf\x20\xe4\x26\x84\xac\x45\xa1\x3b\x8e\x72\xd9\x4a\xd6\xb2\xee\x10\x8c\x7d\x7f\x7d\x7d\xc0\x68\x4c\xcb\x65\x5c\x80\x0b\xc8\xdf\x29\x2b\xfb\x8e\x0c\x1e\xae\x4c\x4e\xf3\x96\x70\x6d\x63\x8d\xf2\x81\xb3\xb2\xd2\x68\x8c\x2a\xbf\x96\xa2\x2e\x6a\xa1\x62\xd0\x15\x95\x48\x6b\x94\xb7\xf8\xbd\x85\x36\xc6\x2c\x54\x93\x14\xd6\x39\x7f\xd8\x9e\x53\xec\xcc\x6a\x65\x78\xfc\x49\xaa\xc6\x90\x27\x82\xf8\x5e\x98\x47\xd3\xf4\xdf\xf0\x93\xaa\x75\x1d\xf3\xad\xc3\xb3\x6e\xc5\xcd\xf0\x67\xb4\x39\x09\xcd\xe8\x21\xdf\x8e\x06\xe8\x50\x61\x29\xa5\xaa\xb6\x51\xdd\xb5\x9c\x0e\x63\x76\x8e\xc3\xc3\x2c\xc1\x6c\xbf\x2e\xf0\xf9\x35\x83\xcc\x32\x7b\x30\x85\xfb\x32\x5d\x64\x95\xea\x66\x2a\x90\x60\x06\x3f\x2a\x48\xaa\x3b\x4e\x18\x81\xd2\xcb\x48\xd7\xf0\x10\xa1\xd6\x0d\x28\x3b\x96\xb3\x79\x82\xf2\x80\x6a\x6a\x2f\x7c\x14\x3b\x18\x68\x31\x50\x8e\xd9\xfd\x68\x1f\xd0\xee\x17\xec\x22\xda\x8d\x82\x09\x23\xcd\x1e\x44\x95\xec\xcc\x14\x78\x96\xa1\x78\x23\x2b\xd4\xa1\x52\x8e\x14\x50\x1e\x07\x36\x2b\x66\x4c\x42\x8e\x6e\x3e\x90\x46\xf8\x69\x6a\xcc\x0f\x65\x15\x83\x29\x6a\xea\x8f\x1a\xa3\xfe\xc6\x78\x68\x92\x2e\xda\x02\xa7\xc0\x43\x55\x7e\x3d\x39\x16\xd8\x3e\x9a\xd8\x24\x87\x05\x5f\x84\x03\xe5\x26\x46\xaf\x09\x3b\xda\x53\xf4\x67\xdd\x51\xeb\x00\x6b\x40\x75\x47\xab\x82\x2c\xd0\x14\xdd\x8b\x2b\x86\xeb\xa6\x16\x02\x46\x2b\x79\x33\xb1\x90\x91\x25\x55\x61\x29\x83\xa3\xc0\x01\x0a\x00\xfe\x8d\x52\xbf\x1e\x98\xb5\x4e\x52\xbf\x1c\x94\x88\x9a\xa8\x40\x35\x81\xac\x5d\xb4\x60\x09\x54\xaa\xd3\x98\xda\x12\xea\x4c\x44\xb6\x28\xe6\x2d\x91\x44\x67\x68\xd4\x3a\x86\x42\x2e\x99\x38\x71\x11\x6a\x45\x2e\x9a\x38\x71\xc9\xff\xb5\xbf\xa9\x92\x3d\x94\x44\x9d\xaa\xbf\x7d\x5d\x87\xa3\x72\x3e\xe8\x19\x05\x76\x7e\x10\x07\x2f\xe5\xa5\xc2\x27\xc1\x82\xda\xc7\x54\xe1\x53\x9a\x7e\x24\x0e\x68\xf5\x72\x30\x45\x85\x8b\x99\xdd\xd8\xaf\x47\xc5\x01\xa6\xf1\xa3\x14\x81\x34\x62\x9c\x2a\xa9\x87\x4a\xe0\xac\x42\x27\x69\x75\x20\xab\x92\x68\x94\xf3\x14\x95\xf0\x7a\xea\xf5\x6c\x15\xbc\x3e\x2a\x78\xa5\x3f\x1d\x4d\x0f\xa4\x72\x35\x80\xf3\x8b\x81\x31\xf0\x8f\xf3\x8f\x53\xbe\xb9\x54\x6b\xa3\xe8\xac\x25\xd2\x37\xfe\x70\x3c\xe9\x27\x43\x9f\xfe\xfe\xa1\xe5\xcb\x1f\xfa\xbd\xea\x90\xd7\x3e\x40\xbe\x18\x1f\x17\xa9\x36\x3c\xb1\x96\xf9\xc7\x73\x09\xd0\xe9\x55\x13\xc0\xe3\x4d\x6a\x62\x53\x95\xaf\x16\xc2\x0e\xd3\x1b\x7a\x4f\x0a\x5d\x16\x3d\x72\x30\x9c\x17\x23\x9f\x1c\x87\x68\x0a\xa9\x5c\x24\x18\x4f\x8e\x43\x2a\x0a\xff\x71\x5a\xc0\xcd\x74\xf6\xc4\xe7\x27\x1e\xa4\x77\xd1\xd5\x50\xdb\x2c\xda\x6d\x74\x38\xa7\x17\x98\x53\xce\x5e\x4b\xd7\x4d\x9c\xb1\x91\x8a\xd4\x45\xb7\xd5\x5d\xa7\x1a\x3a\xfa\xc3\xfc\xfe\x25\x13\xd9\x73\xf7\x5c\x39\x52\xab\x85\x5e\x63\xf7\xd9\x3e\x55\x0a\x73\xe9\xbc\xa9\x17\x5a\x9d\x8e\xd4\x9e\x73\x33\xcf\xa8\xfa\x2d\x47\x6d\xf0\x97\x35\x39\x4f\xf1\x7b\x80\x7f\x15\xf0\xab\xa4\xd4\x8f\x86\x9f\x07\xd5\xae\x90\xfe\x2e\xc2\xcd\x33\xc2\xea\x7c\x02\x4f\xfe\xfd\x7d\xdd\x73\x4d\x8d\x2d\x57\xec\x21\x9b\x4e\x57\x6e\xb2\xd4\x59\x94\x1b\x0c\xf3\x2e\x46\xec\xbe\xff\x51\xb1\xe6\xd2\xeb\x65\x4e\x2f\xa2\x27\xed\xdb\xb4\xf2\x7b\x95\xe4\x02\x9b\x4d\xf9\xae\x8a\xa0\x33\xf5\xfd\x95\x99\xef\x7c\x50\xa8\x08\xf5\x1f\x65\x4e\x26\x6b\x2f\xb4\x97\x5f\x4b\xef\x3c\x86\x1e\x1a\x23\x31\x03\x3e\x80\x24\xa6\x07\xfb\x50\xb5\x29\xc8\x1e\x84\xd9\xe9\xf3\x3d\xf8\x37\xa8\x0c\x52\xf7\x73\xb2\x2d\x8a\x8c\x25\x50\xb2\x29\xb4\xc4\x44\xe6\x28\xcf\xe0\xcb\x54\x6e\xf9\x83\xbc\xaf\x80\xbc\x93\xc0\xff\xb7\xaa\x25\xaf\xa2\xb7\x1d\xd2\xab\x02\x03\x61\x39\x77\x8d\x45\x5c\x47\x6b\x81\x9e\xd5\xc1\x4d\xe2\x0e\xac\x12\xb6\x6f\xf9\x77\xcb\x97\x5d\xd5\x15\x35\xac\x9e\x95\x6c\x56\x0d\x48\x35\x27\x67\xad\xe6\x5b\x3b\x57\xcf\x12\x1c\xec\x2e\xfe\xfe\x8b\x97\xe9\x6e\x38\x7d\xfc\xaa\x6a\x5f\xf9\xac\xd5\x55\xc7\x55\xb8\xc7\xab\x56\xcf\x72\xb8\xe4\xbe\xf8\xac\xd5\x2c\x6b\x13\x2e\xdb\x45\x69\xbd\x30\xe3\xe2\x6f\xe5\x1f\x60\xec\x4c\x03\x33\x89\x99\xc5\x9c\x8b\xb7\x50\x12\xb7\x83\x15\x51\xb1\x37\x1c\x62\x1d\x40\x49\x45\xdd\x42\x40\x55\x32\xaa\xc6\x13\xc0\x58\xf7\x10\x0b\x7d\x24\x1c\xf2\x14\xbd\xa0\x27\xe4\x68\xbc\x5b\x33\xe3\x9a\xa4\xef\xad\x84\x1e\x45\x54\x53\x24\x50\xcd\xce\x83\x1b\xe3\xba\x51\x5e\x70\x9f\xa3\x41\xa9\x85\xef\xbc\xf7\xce\xc2\xac\x47\x19\xcf\xf3\x57\x3c\x15\xe0\x2d\xe6\x5a\x8e\xf7\xe8\x2a\xa6\x4f\x5b\x61\xb2\x70\x06\xce\x24\x56\xf2\xc2\xfc\x0d\x65\xbc\xd9\x12\xe0\x79\xb7\xce\x71\x5a\xa7\xd9\x4c\xe3\xbf\x5a\x79\xc7\x4a\xf8\x5b\x82\x71\x63\x4d\x6a\x9c\x5e\x4d\x6b\x31\x07\xf8\x3b\x8b\x32\xd1\xe7\x73\x07\xe0\xca\xdb\xca\xdb\xeb\x17\x2e\x5d\xba\x70\x3d\x69\x24\x8d\xe8\xab\x24\x81\xa7\xae\x30\xbb\x39\x03\x71\xaf\x98\x36\xbd\x82\x93\xcd\x41\x8b\x8e\xbf\x8b\x2d\xdb\x30\xdf\x82\xb1\xf2\xd8\xd3\x1c\x9c\x6c\x0a\x9a\x45\xfe\x18\x42\x5d\x29\x43\x8c\x9d\x57\x63\x72\xa9\x6a\x0b\x3f\xce\xe5\x48\xf7\x48\xb2\x77\x7a\x98\x61\xc6\x70\xd3\xd9\x92\x41\xee\x13\x88\x7d\x55\x4f\x05\xa6\x65\x0e\xed\x58\xc2\xfc\x48\xa7\x4e\x81\x72\xa0\xd0\xf3\x9a\x9e\x55\xf6\x2a\x5b\xf1\x6a\x10\x34\xf1\xbb\x73\x70\x70\x67\xea\x59\x65\x03\xbd\x96\xc2\xf8\x2a\x57\xa3\x6c\x4d\xa7\xd9\xbf\xa2\x41\x01\xa0\xc2\x06\x07\xd3\x78\x72\x94\xdc\x9e\x7e\x35\x7b\x6e\x34\x0b\xd7\x00\x90\x9d\x4c\x39\xd5\x7e\xf7\xab\xc6\x69\x29\x50\x27\x35\x9a\x9c\xe4\x28\xb9\x1a\x51\xf9\xde\x38\x82\x55\x76\x66\xde\xd5\xa0\x76\xef\x04\xb8\x7b\x94\x07\x8d\x12\xd9\xcc\xea\x25\xe3\xfd\x5c\x4d\xe6\x4b\xb2\x3c\xf3\x2e\x3f\x5d\x05\xbc\x39\x9d\x1e\x54\x52\x92\x31\x99\x34\x4a\xf3\x0b\xf4\x96\xb9\xa3\x8c\x5e\xdb\x7d\x82\xb5\xd0\xe3\x47\x23\x9d\x68\xe2\x15\xa7\x63\x3c\x82\x00\x84\x31\x14\x97\xf8\xf9\xdb\x76\xa2\x81\xe4\x9d\x83\x19\x47\xba\xa2\xdc\x6f\xc5\x0d\xbe\x74\xca\xea\x2f\x67\x2f\xfd\xf4\x53\xa0\xf5\x9a\xc8\x51\xae\x26\x0d\x03\x6d\x70\x90\xb7\x37\xd6\xa6\x80\xb9\x7a\xa5\xb6\xd1\xce\x2b\xfd\xb8\x28\x15\xed\x11\x78\x99\x28\xb5\x42\x5e\x78\x6f\x53\xd0\x5f\x20\x61\x16\xe2\xb9\x15\x2a\x9c\xa4\xd5\x20\xab\x22\x5f\xa2\x5a\xc0\x63\x6f\x4b\x69\xc7\x94\x54\x8b\x6f\x51\x1f\xb9\x54\x35\x34\x4f\x9c\x7f\x57\x7e\xd8\x15\xe9\xeb\x26\x4d\xdd\x7d\x99\x67\x61\xd1\x46\x56\x3c\x9a\x57\xf6\x84\xaf\x8e\x0d\x00\xb1\x3a\x04\x44\xeb\xdf\xc9\x34\x32\x25\xd2\x35\xd0\xdd\xd7\x97\xf9\x08\xda\x6c\xa7\x46\x0f\x1f\xa4\x38\x26\xf2\x3a\xe8\x27\xc7\x11\x88\x33\xbc\x3c\x21\x2b\x83\x2e\x46\x93\xbc\x32\x1a\x9a\xe9\x34\x54\x5a\x85\x6a\xe4\x56\xc5\x93\x3c\x70\x52\x3c\x91\xf0\xc2\x5a\xc4\xab\x6e\x8a\x30\x45\x9d\x67\x78\x0a\x6e\x7e\x90\xda\xb1\xa9\x2e\xb6\x64\xe3\x2c\x3d\x02\x8d\x32\xda\xef\xb0\x57\x7b\x6a\x9a\x8e\xf3\x4d\x35\x1e\xf6\x6a\xf7\x84\x29\x25\xc7\xee\x86\xd2\xfc\xbe\xe3\x07\x42\xc9\x64\x88\x9f\x1f\x56\x7a\x8b\xcf\x3a\x17\xda\xc5\x3e\xc9\x7d\x77\xa5\x16\x1b\xbf\xee\xfe\xbb\x7f\xf5\x7d\x77\xe4\xfb\xc7\x72\x36\x3f\x72\x0f\x72\x5f\xce\x3b\xfc\xa7\xd1\x62\xff\x91\x04\x78\x8b\x40\xa1\xb5\x90\x81\x62\x8b\xca\x27\x8d\xfd\xe6\xef\xf3\xe3\x54\x70\x41\x9f\x8c\xd0\x15\x9a\xda\x5c\xa7\xf4\x81\xe8\xa7\x87\x2b\x63\x7e\xda\xdf\x4e\xc1\x2b\xd2\x0d\xf7\xe5\xdd\xed\x95\x93\x22\xf5\xc3\xc3\xf5\x91\x49\x95\xed\xdd\x64\x39\x90\x4a\x4a\x77\x9e\x73\xbc\xcd\x52\xc0\x39\xf2\x07\xd2\xa9\x79\xc9\xad\x0b\x63\xb1\x85\x5b\x93\xf3\x80\xa6\x1a\xde\x3c\x92\x81\xe4\x73\xb8\x09\x80\x5d\xa3\x7a\xc3\x99\x5b\x0e\x76\x88\x7e\xb4\x6f\x4f\x91\x44\xb5\xfb\x98\x1f\x16\x4c\x2e\x7b\x20\x4b\xf0\xd7\xc5\x4b\xf1\x6b\x9b\xdf\xf9\xca\x38\x32\xd6\xc1\x91\x19\x11\x31\xb0\x3f\x73\xfa\xd3\x01\x63\x84\xcc\xe0\xf6\xab\xe8\x0d\x10\xe7\xf0\x2c\xe2\x1c\x28\x40\x10\x2f\x85\x8a\xdc\x59\x17\xd2\xdb\xfd\xee\xbb\xee\x72\xfb\xed\xfa\x90\x72\x0f\x60\x87\xcc\x2e\xaa\x21\xee\xd6\x90\xcc\xea\x03\x3e\xc8\x88\x8c\x8b\xa9\x55\x75\x89\xd4\x4d\x1a\x4a\x6a\xb9\x3c\xfe\x04\xae\xea\xa7\xa8\x3c\xcb\xc0\x9a\x35\x03\x6b\x9e\x6f\x09\x34\x86\x95\x83\xe1\xc6\xa0\xf2\x4b\x15\x2b\xcb\x6d\x25\xec\x36\xff\xe0\x1a\x4c\xaa\xfc\xb9\x73\x6c\xef\x85\x17\xf6\x8e\xed\x54\x2e\x19\x51\x69\xf9\x3a\x93\x60\x8d\x69\x52\x69\xc2\x2a\x92\x3d\x0f\xed\x49\x86\x13\xdf\x14\xaf\xbe\xd6\x33\x62\xeb\x1f\x58\x1f\xd8\xb6\x7e\x57\xa0\xfa\xeb\xb1\x13\x5c\x17\xde\x9e\x98\xbb\x7e\xfd\xdc\xee\xd3\xbe\xbd\x7e\x57\xb9\x62\x3c\x05\x96\xf9\x7e\xe7\x60\x1c\xb0\x12\x36\xe1\x8e\x84\xec\x47\x7d\x1f\x7f\x0b\x95\x97\x24\x4f\x85\x18\xd2\xe9\x75\x3d\x3d\x73\x9c\x99\x4f\xc6\x8d\x9f\xb4\xf6\xdb\xb3\x94\x9d\x27\xab\xb2\x03\xff\x76\x7b\xe7\xf9\xa7\xf5\xcc\xf0\x2e\xfc\xd1\xa2\x45\xff\xb6\x71\x36\x3f\x7c\xcd\x08\x6c\xb4\x7b\xa9\x69\x9d\xd1\x3b\x75\xa9\xc9\x1d\x15\x58\x52\xed\x59\x1e\xbc\x7f\x40\xfb\xe5\x79\x1b\xb6\x75\x41\x57\x4b\x42\xe9\x4f\xb4\x00\x39\x9e\x38\xb3\x7c\x2f\x15\x97\x6c\x58\xa8\xf4\x2f\xdc\xb0\x01\x7b\xd0\xdc\xf2\x33\x13\xd1\x28\xbc\x99\x1b\x51\xa6\x43\x2a\xfc\x07\xaf\x16\x92\x6d\x0b\x37\x50\xf9\x08\xd5\xd1\xc0\xf9\x0e\xcf\xf7\x8e\xe0\x9d\xf2\x87\x2c\x3c\xaa\x62\x96\x5f\xc4\x8e\xd5\x43\x72\x26\x5b\xfd\xe1\x42\x5d\x59\x1e\xcd\xd3\x03\x15\xfe\xe1\x90\xa5\xbc\xce\xd2\x97\x39\xba\xd4\x12\x2a\xb7\x0c\x29\x7f\xc4\x89\x65\x4f\x8a\x4c\x49\x51\xfb\x78\xb9\xb3\x20\x99\x29\xd4\x8a\xc2\x90\xa5\xae\xc2\xd2\xd7\x67\xa9\xa8\xb3\x0c\xd1\x08\xed\x9e\x82\xbc\x4d\x61\xdc\x35\x6a\xce\xde\x15\x0d\x0c\x05\x35\x24\xa1\x93\x73\x6e\x5c\xc5\x95\x4a\x74\x0a\x6c\x10\xf9\x73\xbe\x34\x57\xb3\x70\x43\xaa\x2b\x72\x2c\xd2\xa5\x3d\x37\xd8\x51\xa0\x73\x7c\x7e\xd7\x67\x74\x45\x42\x61\x20\x38\x7c\xef\x86\x85\x54\xd7\x96\xea\xdb\x2e\xdc\x80\xfa\xb9\xc8\x97\xa1\xb2\x6e\xe1\xb1\x48\xa8\x3b\xa0\xaa\xf8\xef\xf3\x7b\x19\x0b\x50\xb5\xaa\xa5\x35\x17\x4c\x07\xaa\xf1\xbe\xa8\x81\xcb\xf7\x17\x32\xc4\xee\xa9\xae\xbe\x41\xa9\xce\x1c\xaf\xa9\x21\xe1\xea\x65\xca\x5e\xb2\x84\xb4\x2a\x4f\xec\x44\x0b\x77\x30\xf1\xf0\x77\x66\x16\x57\x2f\xab\xbe\x81\xd5\xc1\x93\xd4\x57\x2b\xeb\x94\x3d\xa4\x8d\x2c\x1e\xae\xc8\xd3\xf2\x2c\xae\x41\xbc\x9f\xea\x1a\xb7\x16\x9e\x1f\xd4\xa9\x27\x2f\x34\x9b\x68\x5c\xc1\xf9\x6b\xbc\x80\x4e\x5b\xdf\x65\xde\xff\x23\x7d\x18\xf7\x84\xc3\xfa\x1f\xe9\x9d\x32\x7b\x31\xf4\xbf\xab\x65\xe7\xee\xcc\x3e\xba\x2e\xb7\xa5\x5b\xe9\xa2\xcc\xde\x4c\x8e\x39\x2b\x2b\x9d\x8a\xce\x59\xa9\xda\x45\xab\xcc\xdc\x11\xa5\xaf\xb0\xcf\xd3\xb4\x85\x3a\xc8\x78\xfb\x75\xb5\x46\x49\xaa\x56\x3a\xe2\x1a\x55\x47\x8a\x2e\x8c\xa3\xc4\xe5\x56\xd2\x84\x26\x36\x70\x51\x20\x97\x03\x51\x07\xf4\x4c\x53\xd6\x50\x3f\x8d\x00\xa2\xee\xa7\x46\x89\x9d\x47\x9a\x16\x51\xaa\x4e\x31\xab\x77\xd3\x61\xaf\x82\x20\xbd\xb3\x2d\x25\xb8\xf8\xe9\xcc\x34\x66\x89\x66\xab\x9f\x1a\x15\x48\x26\xa8\x6e\x81\x1b\xcf\x3e\x11\x34\x51\x95\x3d\x9b\xdf\x43\xb0\xfd\xc3\x62\x36\x14\x52\xc5\xa6\xf4\xa0\x1a\x6e\x3b\xe2\x79\xb5\x52\xf3\x29\x1f\xbc\x3a\xfb\x5b\x6b\x66\x9a\x1d\xf6\xea\xc9\x46\xa3\xd7\x69\x14\x24\x8f\x39\xc2\x72\x4b\xc6\x77\x8b\x63\x2a\xc3\x5c\x00\xba\x49\x19\x67\x6e\x6f\x78\xb5\x2c\x62\xd2\x42\xe5\x8b\x9a\x1e\x76\x56\xfa\xe4\xcd\xa6\xe6\xf5\x36\x51\x7e\xe3\x32\x32\x35\xbf\x8a\x4e\x9f\x78\xe1\x6d\x33\xad\x3a\xa3\xbf\x26\x50\x57\x61\xd3\xeb\xf4\xe6\xa6\xf1\x3d\x4b\x93\x9b\x43\x7f\x42\xfa\x63\x9a\x55\x3f\x01\xdd\x07\x67\x9d\x17\x17\x43\x32\x54\xf4\xd2\x79\x67\x5b\x9d\xe7\x9d\x5d\x70\x55\x91\xee\xc4\x03\x27\x3e\x82\x72\x5f\x03\x14\x4a\x2d\xd3\xc1\x8c\x63\x16\x00\x07\x45\xf7\x2a\x54\x49\x74\x5d\xf6\x6c\x30\x0e\x56\x51\xd0\xa1\x9a\xb0\x36\x44\x3d\x6a\xf1\x54\x7b\x2b\x10\x9f\xbd\xc2\x00\x59\x28\x6a\xde\x15\x0d\xcb\x51\x09\x32\x2e\xb5\x75\x22\xbd\xfc\xa1\x87\x6a\x15\xa3\x8a\xf0\xf5\xe4\x71\x67\x8b\xb5\xc6\xca\xd9\x6e\x1f\x6e\x5c\x33\x79\x46\xd5\xf9\x5c\x52\xf6\x55\x3a\x33\xd5\x9e\x80\xa1\x61\xac\x32\x08\x34\xdb\x8b\xf3\x9d\x8e\x86\x9b\x6c\xa6\x1a\x93\x83\x5c\x87\xd7\xfa\x37\x2b\xd2\xbd\x8e\x9a\x2a\xa7\xad\xb2\xb9\xbc\xa7\xcd\xe2\x8f\xcd\x6f\xdd\x1c\xd9\x52\x6f\x20\x91\xf3\xab\xfd\x75\x16\x9f\x7d\xbc\x53\x60\x5d\xa2\xc9\xc6\x6d\xfd\x42\x6f\xb2\x85\xa4\x06\xcb\xa6\x74\x20\x81\xdd\x4b\x0e\x89\x5b\x79\x97\x34\xb9\xaf\x3b\xdd\xdd\xb7\xb7\x52\x1e\x13\xb3\xd9\x4c\x53\xec\x95\xff\xde\x8c\x19\x2b\xbf\xdf\xe2\x34\xda\x1d\xce\x60\x0d\x27\x3c\x33\x7e\x66\xe3\xd4\x7a\x43\xeb\xf6\xd6\xab\x2b\xfb\x2a\x42\x8d\x92\xc1\x14\xb7\xf9\x5d\x33\x0c\x66\x9e\xc9\xc9\x7e\x3b\xa1\x8f\x9e\xc6\xac\xa0\xb6\x6a\xb2\x96\xcc\xdc\xb8\x91\x13\xad\x02\xf6\xc6\x2a\x04\xf3\xbb\x6c\x23\x2f\x56\xb5\x17\xaa\x85\xa8\xe6\x0c\xf0\x00\x3f\x07\xd4\x49\x37\x1b\x4f\x76\xb4\x70\x41\x52\xe0\x67\x2f\x06\x8a\x66\xde\x05\x84\xd4\xb4\xb4\xd8\xec\x3a\x47\x47\xdb\xd8\x06\x9f\x27\x90\x68\x9e\x10\x2c\xa7\xd4\x4e\x8a\x3e\xf7\xe7\x8d\xb4\xed\xd7\xa2\x7f\x47\xc4\xca\x88\xd3\x44\xe8\x69\x59\xe5\x2b\x72\x61\x3e\x84\xa6\xcd\xd6\xfa\xd7\xce\x6a\x99\xd3\x5c\xad\xd3\x71\x92\xab\x3e\x3a\xb7\xb9\xeb\xac\x71\x0d\xb2\x89\x9d\x9d\xcf\x49\xbd\x94\x15\x4d\xd8\xe4\xae\x69\x9d\x4d\xc7\x0c\xdd\x72\xa2\xdb\x35\x4a\x7f\x41\x00\xeb\x87\x67\x58\x18\xc3\x4f\xc2\x7c\x12\xc1\xf5\x2f\x3b\xf7\x67\x6f\xfa\x08\xb7\xb0\x38\x74\xf9\x80\x95\x97\x70\x11\x6c\x11\xb8\xa0\x73\x90\x33\x2f\xb9\xb2\xd6\xe1\xf0\x5d\xbf\x20\xf3\xb3\x05\x5b\x2a\xf5\x65\xf5\x57\xb2\x35\x77\x5c\xbd\x64\x7a\xa3\x4f\xa7\x7b\x80\x6d\x7b\x80\xd5\xd7\x44\x66\x2e\xf6\x3f\xf8\x2a\xff\xe4\x95\x4b\x94\x15\xb1\xe7\x27\x4d\x5a\x7a\xf9\xc6\xbe\xca\xd3\x5f\x8b\x91\x47\x96\x5c\xb9\xc3\x13\x6a\x6e\xae\x33\x9b\xff\xfa\x57\x41\x6a\xaa\x6f\x0f\x2a\xaf\x10\x41\x5b\xff\xb6\xd2\xf5\xaf\xc0\x1a\x66\xee\x3e\xec\x5c\xd3\x90\xdf\x0f\xac\xda\xbb\x28\xa2\x74\x47\x16\xed\x25\x33\x12\xf2\x80\x9c\x10\x5c\x7b\x51\xa7\x6a\xd1\xa2\x08\x39\xba\x6a\xef\x70\xd9\x21\x39\x91\x90\x0f\x31\x23\xf3\x04\xde\xe5\x24\x79\xae\x78\x66\xa0\x34\xcf\x81\x67\x94\x49\xa3\xe5\xc9\x30\x2e\xc1\xca\x97\x03\xa5\x55\x8e\x33\x7e\x9d\x28\x7b\xe4\xb0\x18\x87\x45\x3a\xd4\x0d\x8c\x2a\xde\xfd\x5f\x17\x17\xea\x04\x6b\x66\x8b\x72\xd3\xdc\xd4\xc0\x40\x8a\x10\x22\xf0\xad\xdf\x65\xb7\x90\x33\x95\x9d\xb8\x25\x47\x96\xb3\x27\x94\x0f\xf7\x8c\x1b\x1a\x78\xfd\xee\x7b\xd2\x03\xc7\x78\x8b\xce\xa8\xe3\xea\x27\x92\x8a\xcc\xb9\x8f\x3d\xf6\xdd\x47\x1f\xa5\x7d\x97\x1c\x05\xde\xb5\x06\x68\x3a\x6a\x81\xda\x51\x72\x32\x21\xeb\x1e\xdc\xdc\xd7\xb7\xb9\x8f\x1c\x29\x74\xb8\x9a\x3e\xea\x16\xfc\xa9\x7a\xd0\xdb\xa0\x00\xc8\xfb\x89\x59\xab\xd6\x6a\x1e\x8e\x12\x57\xcb\xa6\xf0\x8f\xef\xa5\xae\x92\x2e\x74\xb4\x31\x96\x2a\xe0\x97\xfe\x61\x8b\xff\x57\xab\xf4\x82\x76\x95\xcd\xe8\xfe\x22\x2e\xa4\x7f\x74\x3f\x53\xb8\x26\xa9\xb8\xc4\xbe\x99\xc6\xe0\x88\xed\xc5\xe5\xe4\x28\xd5\x9d\x34\xa3\xc6\xd7\x1b\xf9\xcb\x5a\xba\x32\x4f\x15\x9c\xc6\x9c\x4e\xb6\xf1\x07\xb4\x54\x8f\x92\xa3\x54\x5f\x2f\x9f\x36\x95\x65\x89\xf1\x84\x8b\xa6\x0f\x4b\x68\x5d\x21\x2d\xd3\x95\x3d\x71\x84\x16\x97\x47\x73\xd5\x93\x48\x68\x71\x79\x34\x77\x40\x35\xd2\x1a\x8d\x96\x3e\x55\x9b\xb0\x23\xe2\x81\xe2\x02\xae\xa1\xe0\x57\x12\x44\xf9\x1c\x69\x62\x04\xee\x08\xbf\x0b\xfa\x86\x45\x93\xe6\x37\xa0\x8d\x72\x60\xc1\x5a\x48\x02\xf7\xc6\x02\xa1\x3a\x40\xac\xa3\xc8\xcf\x69\x36\x27\xdc\xb1\x28\x7b\xdb\xd2\x9e\x9e\xa5\xf0\x53\xbe\xda\x7c\x60\xf3\x01\xf6\x20\x3c\x32\x2f\xe7\xbc\x78\x76\xaa\x9e\xb3\xf3\xbb\x26\x3f\xfd\xf4\xa4\x49\x4f\x3f\xad\xbc\x0c\x91\x9b\xf1\xb5\x32\x98\xf3\xfd\x86\xaa\xdd\x7d\x8b\x47\x7b\x1e\x3f\x60\xd2\xfc\xdd\xdc\xf5\xcc\x24\x3c\x83\x9f\xf0\x00\x26\x74\x09\x8f\xc2\x9c\xcd\xc3\xb0\xb5\x77\x24\x43\x01\xd1\x2d\xdb\xc0\x8f\x16\xd4\x63\xb9\x43\x2e\xb8\x7a\x59\x49\x2b\x6e\x32\x8a\x3a\xc0\x9d\xfd\x9f\xa9\x5e\x17\x69\x30\xe9\x75\x42\xb9\xa4\x27\xbc\xa5\x22\xdc\x13\xf9\xce\x0b\x46\xd7\x22\xd9\xf4\xc2\xb7\xda\x66\x86\xbd\x22\x2b\x4a\x5e\x9d\x4e\x6f\x22\x0d\xae\xf2\x5e\x97\x6c\x0c\xba\x62\xcf\x71\x57\x3d\xd7\xe4\x0a\x1a\xdd\xdc\x3a\xa7\xce\xa1\xbc\x63\xaa\x96\xac\x4e\x8b\x2f\x31\x6d\x4c\xb4\x92\xd4\x94\x79\x78\xbe\xcc\xa3\x0c\x35\x8c\x5b\x38\x39\x68\x71\x5a\xa5\x6a\x23\xa9\x77\x88\x4e\x21\x58\xef\xd6\x99\x7f\xfa\x53\xb3\xe0\xae\x0f\x32\x4c\xe1\x9d\xa2\x75\xcc\xe4\x53\xdf\xdd\x82\xef\x38\xfa\x0e\xf5\xf6\xe3\x51\xfa\x86\x03\x1f\x50\x7c\xa7\xbe\xcb\x25\x33\xc5\xab\x37\x96\xd9\xec\x29\xaf\xc5\x9c\x8e\xa6\xec\xb6\x32\xa3\xde\x4b\x8e\x7e\xed\x0d\x33\x19\x87\x04\xb4\x0d\x51\x96\x9a\x2c\x24\x1a\xf5\x92\xef\x13\xc1\xe8\x94\x06\x0a\x75\x6f\x9f\x64\x7c\xf4\xd4\x3f\x43\xe2\x01\x20\x06\x5a\x09\x09\x12\xd5\x1c\x19\xa0\x8f\xe4\x94\x1f\xf5\x5c\x65\x52\x41\xf2\x87\xdb\x54\xa3\x1e\xdc\x11\x6f\x95\xd5\x2d\xf0\xca\x1f\xa7\x2a\x83\x0e\xd9\x50\x6d\x32\x11\x7b\x7b\x97\x63\x2a\xa9\x1b\x6f\xf1\x87\x95\x8f\x6f\xba\x82\x3c\x95\xbb\xee\xe5\x08\x52\x97\xd1\x1a\x6b\xc0\x68\x62\xff\x9c\xb1\x06\x65\x83\x53\x10\xa2\xd1\xc6\x96\x03\x8f\x05\x26\x46\xc9\xcd\x64\x89\xb2\x27\xf3\xdf\xf9\xcb\x46\xb8\x82\xb1\x3e\x9d\xde\xc6\x50\x3a\xd2\x83\x01\x11\x8f\x9d\x8a\x45\x96\x35\xf0\x62\x45\x8a\x33\x1e\x8a\x2a\x7a\x13\x86\x37\xe1\x20\x09\x39\x83\xce\xc2\x4b\x47\xd8\x69\xd3\x2c\x82\xf2\xb6\xdd\xe1\x08\x99\x4d\x06\x62\xc7\x3b\x07\x93\x36\xa3\xb1\x87\x18\x3b\x4d\x76\x73\x19\xbd\x83\xb0\x53\x6f\x36\xe9\xed\x5e\xaf\x44\x94\x21\xc1\xfa\xda\x2a\x7a\xcd\x56\xee\x6a\x92\x05\xe6\x8c\x54\xe3\x74\xd8\x04\x4b\x14\x53\xc3\xc3\x63\xf5\xee\xda\x51\x5d\x66\xa7\x01\x78\x88\x66\xe2\xad\xa8\x65\x3f\x36\x2b\x02\xd1\x17\xce\x63\x9c\x76\x9e\x92\x41\xb5\x99\xd2\xb9\x2a\x7b\xba\xaf\x70\x97\x0b\x4d\xae\xc0\x6f\x8b\xa6\xbf\x8a\xba\xbd\xec\xda\x82\xc0\x74\xdc\x47\x4d\xa5\x35\x5e\x4d\x56\xf9\xb6\xfc\xe5\xe5\xda\xfe\x6d\xd1\x3c\x3a\xea\x9e\x5e\xe9\xbc\x8a\xb0\x51\xa3\x3e\x7a\x0a\xc0\x69\x44\x0d\xab\x26\x0f\x5b\x31\x8f\x80\x9e\xd9\x9d\xca\xeb\xdb\xe1\xdd\x14\x74\x5f\x4b\x85\x1f\xcb\xdd\x6a\x83\x4c\x70\xb6\xbb\x05\x0b\xf1\x02\x3c\x28\x0e\x29\x2d\xcb\x94\x0a\x20\x95\xc5\xe9\x61\xbc\xc3\x02\x15\x44\x55\xd6\x26\xa5\x3a\xa8\xe6\xa5\x1a\x1b\xc7\xed\x13\xdc\xe8\x2d\x6e\x03\x0f\xd6\x41\x2c\x68\x8f\x79\x46\xdb\x59\x2c\x89\xc3\xed\xb3\xe2\x92\xe3\xe1\xd1\x3c\x83\x7c\x20\x35\xa2\xd0\xc5\xf5\x90\xd7\x49\xea\xa5\xf7\x6f\x35\x16\x5a\x07\x97\x4b\xa0\xa1\x64\x2c\xab\x3d\x16\x8f\xe1\x61\x2c\x34\x0e\x45\x05\x4a\xaf\xe4\x60\xca\x64\xb9\x56\xde\x34\x76\x80\xe2\x3a\x91\x0b\xeb\x2b\xad\xe9\x11\x8c\x52\x7e\x7f\xd0\x1e\x1b\xb1\x5e\x8e\x52\x7e\x28\x60\x2a\xb3\xb1\xb8\x06\x86\x9f\xfc\x07\x6b\x00\x6d\xa3\xb7\x71\xeb\xf9\xeb\xd0\xca\x90\x81\xe8\xb4\x32\xc6\xf0\x9e\x02\x17\x77\x8e\xe2\xf0\x38\xac\x36\x9b\xd5\xe1\x21\x9f\x58\xd9\x38\x04\x2b\xbc\x30\xe1\x54\x78\x14\x87\xb5\x81\xae\xb5\x64\x22\x13\xe5\xb6\x02\xbf\x64\xa2\xba\xb6\x9a\x15\x7f\xa0\x6d\xe6\x91\x4f\x7c\x0d\x0d\x3e\x48\xc8\x5e\x05\x5e\x3b\x1e\xb9\xb5\xfb\x20\x1b\xf5\x3b\x26\x4a\x26\xf2\xd7\x70\x5b\xa9\xdd\xfc\x02\xb3\xa9\x78\x22\xd9\x8e\x5f\x35\x58\x15\x87\xcf\xce\x6d\xa5\x59\x58\x31\xb3\xec\x77\x4d\xf0\xdd\x7a\x15\x5e\x5e\x4e\x5d\x0a\x04\x71\x47\xf8\xe0\x57\xdb\x59\x4f\xeb\xb9\x9e\xd1\xe1\xad\x7d\x44\x26\xf1\x66\x12\xc7\xe1\xce\x1e\xcc\xf4\x92\x07\x95\x95\xfc\xee\xe1\x21\xae\x86\xfd\xf0\x39\xe5\x47\xef\xff\xf4\xa7\xef\x1f\xff\xaf\x74\xc1\xf8\xa0\xe7\x97\xdb\xd5\x9d\x0d\x19\x6f\xa0\xc4\x0d\xee\x58\x47\xb7\x80\xf6\x09\x74\xea\x24\x0d\xab\xa3\x3d\x86\xcc\x4e\x0c\xed\x33\x65\x37\xfa\x8f\xcf\xcf\xae\x29\x29\xaa\xc5\x45\xd7\x94\x54\x76\x4d\x01\x90\x5b\xa9\x72\x49\x0a\x68\x1d\xed\x7e\xb0\x77\x0b\xef\x07\x0b\xe5\x16\x91\xdc\x1d\xba\x30\x56\xeb\x54\x5c\x4e\xb5\xc6\xe5\xf1\xe4\x6a\x0a\x31\xd0\x56\xb5\x1c\x06\x5c\x0d\xee\x40\x67\x0f\x53\x15\xe0\xc0\x92\x91\x38\xe0\xcd\x99\x88\xac\x7a\xc7\xc0\xf9\xdc\x25\xfc\x2c\xb4\x7b\x86\xa3\x46\x54\x95\xbb\x03\xda\x95\x0a\xec\x8f\x1e\xb1\x19\xcd\x4a\x1b\x79\xd3\x6c\xb4\x3d\x62\x31\x2a\x11\x51\x24\xbf\x33\xf2\xb3\xdc\x10\xa9\xb4\x99\xdd\x06\xf2\x3b\xbc\xce\xe2\x77\x06\xec\x43\xdd\x90\xd7\x91\x5c\x5e\xda\x95\x03\xc8\x33\x24\x0d\x78\xc4\x64\x21\x64\x40\x7e\x27\x8a\x4a\xc4\x68\xc1\x6c\xc9\x9b\x90\x03\xe4\x65\xf8\xff\xb1\x76\x2e\xe0\x51\x15\x77\x1b\x9f\xf7\xec\x6e\xae\xe4\x0e\x61\x13\x03\x84\x24\x40\x08\x21\xec\xcc\xde\x03\x04\x72\xd9\xcd\x05\x45\xc0\x0b\x78\x03\x0c\x11\x02\x84\x80\x5c\x82\x17\xe4\xf3\xfb\x3e\xa4\x94\xda\xd6\x2a\xc5\xd6\xa2\x52\xb4\x8a\x68\x29\x52\x44\x6a\xa9\xa5\x48\x6d\xa4\xb4\xb5\x48\x5b\x50\x4a\x95\x5a\x4a\x5b\x8b\x96\x2a\x45\x2a\x6c\x67\xce\xbe\x21\x81\xa6\x3e\x7d\xfa\x74\xcf\x33\xfb\x9f\x9d\x3d\xf3\x9b\x39\x67\x7f\xe7\xba\x7b\xce\x5e\x18\x6e\xfe\xf7\x62\x78\x72\xae\x2e\xd2\x2d\x99\xcb\xed\xc5\x6c\xbd\x3f\xd3\xe4\x58\x6c\x58\xc2\xbe\xdf\x4f\x5c\x2c\xfb\x00\x3d\x19\x4e\x5f\xee\x65\xb5\x1c\xcb\x7b\x76\x30\xad\xab\xdb\xb6\x6b\xb3\x51\xa5\x59\x27\x0d\x4b\x4f\x9e\x7d\x5b\xc0\x64\xc4\x6f\x07\x68\x94\x9b\xa7\xbb\x7f\xc9\xf4\x38\xde\xb9\xb4\x8b\x99\xf1\x8e\xdb\x1e\x1d\x89\x7d\xec\x1c\xab\x3d\x72\x89\x4a\x8d\xb6\xf7\xea\x5c\xf6\xb9\x04\x73\xa7\xd1\x81\xce\xf8\x15\xc4\x45\x7a\xcb\xa9\xbb\x5b\xe1\x30\x27\x4f\xaa\x9c\xfa\x10\xda\xdc\x95\xe9\xa4\xd9\x67\xbb\xbe\x45\x1f\xb0\x5f\xed\x4a\x2d\x2a\x9d\xe4\xad\x2f\x1b\x91\xe1\xca\x82\x33\xbb\x7f\x99\xf7\xaa\xf2\xb4\xca\xc6\xd4\xf4\xfa\x60\x66\x78\x46\x70\x68\x62\x62\x46\x42\xc6\x90\xb2\xaa\x60\x54\x0e\x7a\xa8\x4f\x9a\xd3\xec\xf2\x6d\xeb\x2c\xc8\xd9\x0b\x2b\x21\x31\xd1\x95\x31\xc8\x3f\x61\x41\x6d\x62\xde\x84\xfa\x9b\x9b\x6a\xb2\x5c\xae\xc4\xc4\xbc\xf9\x4b\xdb\x4a\x8a\xc7\xb5\x36\x06\x0b\x73\x12\x1d\x09\xae\x3d\xd9\xee\xb8\xf7\x9d\xa2\x9f\xb9\x14\xdf\xfe\x5d\xf2\xc5\xed\x92\xd6\xcd\xbe\xe6\xbd\xb8\x7b\x37\xfe\x5c\xfc\x5f\x92\xd6\xc7\x72\xf2\xcd\xba\xef\xd9\x8b\x97\x44\xe8\xad\xce\xf6\xe4\xcc\x7e\xd6\xda\xae\x4d\x44\x0b\xb9\x79\xf6\xfa\x36\xbd\xfb\x8e\x79\xae\xac\x21\x83\xed\x3f\x69\x46\xbf\xa0\xe6\x9d\x1a\x36\xf6\x7c\x9d\x35\xed\x7c\x5d\xcb\x20\xa4\xb5\x98\xdb\x29\x7d\x72\xd3\x32\xc7\xce\x7b\x3e\x71\x3b\xd1\xdf\x77\xfe\x96\x96\xbe\x2d\xf6\xb5\xac\xb0\xae\x5a\xf8\x8a\x73\xc6\x8c\x8c\x31\x1f\x89\x41\x9a\xa8\x1f\xaf\x77\x2c\x79\xb8\x2b\xc6\x26\xc7\x36\xb8\x8e\xea\xa3\x52\xa1\x97\x7d\x7b\xd9\xb1\x3f\x53\xe1\xea\x1b\xbb\xcf\x7e\x9e\x7c\xe1\x90\xeb\x68\xfc\xaa\xd8\x1e\x8f\x27\xb0\xcb\xbe\x17\xa4\x30\x47\x71\x86\xaa\x53\x0b\xa3\x49\x8b\xac\xb5\xa6\xb6\x9e\x14\x46\x3c\xaf\x53\x44\x57\x38\xcc\xb4\x49\xbf\x36\xeb\xf5\x86\x78\x34\xef\x91\x71\xb7\x4e\x9d\x26\x99\xef\x31\x9d\xdb\x62\xa7\xbb\xb9\xb1\x0f\x98\x4f\x37\xdf\xdf\xc6\xc7\x8f\xbd\x11\x4f\x17\xdb\xee\x99\x86\xea\xd4\x6e\xce\x51\xf6\x48\xa6\x8d\x7c\xc6\x3e\x8c\xba\x3c\xb6\xa1\x3b\x6f\x27\xd1\x4b\xbd\xcb\xa7\xf3\x5f\xa5\x16\x4e\x43\xd7\x6b\x7f\x77\x59\xec\x05\x73\xcf\xad\xde\xeb\xd9\x7d\xe8\x59\xf6\x16\xdb\x5e\x76\x59\x79\x4a\x0f\xfe\xa3\x5d\xf3\xab\xbb\x2c\x76\xc1\xb9\xcd\x28\x66\xb7\xb9\x46\xe7\x47\x5f\x36\x2d\xfd\x3f\x65\x3a\xbb\xca\x5e\x64\xea\x6a\xb3\xb1\xc7\x7b\x26\xdd\xa2\xdb\x39\xca\xf4\x78\x2f\xf3\xc5\x7c\x36\x31\xf6\x67\xb2\x8e\x09\xdd\xef\x21\xa2\x23\x7a\x4c\x73\x93\x4e\x7d\x7b\x99\x17\xb7\xea\x78\xde\x2c\x5f\x97\x25\xb7\x3d\xce\xbe\x78\x3b\x71\x25\x63\x87\x75\x2e\x6c\xce\xb2\x60\x98\xce\xe5\x5d\x32\x74\x3f\x8e\xd8\xcf\xe9\x3d\x4a\x9c\x97\x98\x6d\x9f\x43\xd0\x31\xd3\x7e\xd5\x12\xdb\x70\xe9\x60\x7e\xe5\x14\x3b\x1d\x3b\xad\xc7\xb9\x53\x0c\xe3\xb8\x66\xbc\x4d\x97\x50\x3a\xed\x72\x98\x73\xe4\x76\xad\x16\xdd\x4a\xa7\xe9\x9b\x68\xd1\x4b\x4c\x8b\x1d\xcb\xcd\x3f\x7a\x5e\xf2\x30\x9b\x8c\x72\xb3\xaf\xa0\x87\xa4\x38\xc7\xac\xf5\xf5\x70\x84\xb5\xcb\x38\x6c\x17\x27\xc5\x49\x4c\xfc\x94\xe1\x49\x2b\xc1\x2a\xb2\x56\x5a\xab\xad\xe7\xac\x77\x1d\x51\x47\xbb\xe3\x7e\xc7\x3e\x67\x92\x53\x39\x9b\x9d\x9b\x9c\x47\x5d\x37\xbb\x5e\x4a\x58\x9c\xf0\x61\x62\x61\x62\x73\xe2\xfe\xa4\x81\x49\xcd\x49\x2f\x27\x07\x92\x57\x25\xef\x4c\x3e\x96\x52\x9a\x72\x65\xca\xba\x94\xe3\xa9\x9e\xd4\xb5\xa9\xfb\xfb\x24\xf5\x99\x96\x56\x96\x36\x3d\x6d\x5b\x7a\x7a\x7a\x6b\xfa\x8e\x8c\xd2\x8c\xa7\x32\x4e\x64\x66\x67\x5e\x9b\xf9\x40\xe6\x96\xac\xb2\xac\x8e\xac\x9d\xd9\x29\xd9\xa1\xec\x15\xd9\x7b\x72\xf2\x73\x96\xe6\xbc\xde\xb7\xac\xef\xa1\x7e\x63\xfa\x6d\xee\x77\x20\xd7\x99\x1b\xc8\x9d\x99\xbb\x2d\xf7\x42\xff\x29\xfd\x9f\x72\x5b\xee\x0a\xf7\x3c\xf7\x7a\xf7\x36\xf7\xdb\xee\x0b\x79\xa5\x79\xad\x79\xeb\xf2\xf6\xe5\xbd\x99\x77\x2a\x3f\x90\xdf\x9a\xbf\x2b\xff\xcc\x15\xd3\xaf\xd8\x51\xa0\x0a\xd6\x15\x1c\x1f\x50\x30\xa0\x6e\x40\xdb\x80\x75\x03\x76\x0f\x38\x31\xb0\x62\x60\xc7\xc0\x3d\x83\x9a\x07\x1d\x2c\x8c\x16\xbe\x3c\x58\x0d\xee\x18\xbc\xab\x68\x7c\xd1\xfa\xa2\x5d\xc5\xe9\xc5\x45\xc5\xa1\xe2\xbb\x8a\xf7\x16\xbf\x5d\x62\x95\x78\x4a\xa6\x97\xac\x2f\x39\x3e\xc4\x37\x64\xe6\x90\xdd\x43\xfb\x0e\x5d\x39\xf4\xc4\xb0\x49\xc3\x76\x0f\x3b\x5b\xea\x2b\x5d\x54\xfa\xdc\xf0\x8d\xc3\x0f\x94\x0d\x2d\x6b\x2a\x5b\x35\x22\x65\xc4\x5d\xe5\x49\xe5\xad\xe5\x2f\x95\xbf\x37\xb2\x60\xe4\x9a\x91\x1f\x56\x94\x56\xcc\xae\xd8\x54\x71\x78\x54\xfa\xa8\xe8\xa8\x95\xa3\xb6\x8f\x3a\xeb\xa9\xf2\xac\xf2\x1c\x94\xd9\x72\xa6\xdc\x2a\x4f\xaa\x12\x35\x5d\x3d\xa2\x8e\x79\x8b\xbc\x55\xde\x56\xef\x4e\x9f\xdb\x37\xd3\xb7\xd9\x77\xd0\x5f\xee\x7f\xcc\x7f\x32\x50\x15\x58\x11\x38\x1a\xcc\x0f\x4e\x0c\x3e\x18\x3c\x14\xca\x0c\xd5\x84\x56\x87\x3a\xc3\x29\xe1\xa6\xf0\x9a\xf0\x81\xca\x82\xca\xb6\xca\x47\x2a\x8f\x8d\xde\x38\xfa\xe8\x18\xf7\x98\x49\x63\x36\x8d\x39\x3b\x56\x8d\x7d\xaf\xaa\xb2\xea\xfe\xaa\x43\xe3\x4a\xc7\xb5\x8f\xdb\x32\xee\xdc\xf8\x86\xf1\xf7\x8e\x7f\xb5\xda\xaa\x5e\x59\xe3\xae\xd9\x5c\x73\xaa\xd6\x5d\x5b\x53\xdb\x51\xbb\xb1\x76\x7f\x5d\x66\xdd\xcc\xba\xad\x75\x67\x22\x37\x46\x76\x46\x13\xa2\x4b\xa3\x3b\xa2\x67\xea\x03\xf5\x1d\xf5\x3b\xeb\xcf\x34\xe4\x36\xa8\x86\x89\x0d\xf3\x1a\x56\x37\x1c\x68\xcc\x6e\x0c\x35\x4e\x6f\x5c\xd5\xb8\xa5\xc9\x6a\xaa\x68\x9a\x62\xd6\xda\xe8\x2f\x9e\x8e\xdf\x3b\xd7\x5e\xbb\xc3\x5e\x93\x8f\xb7\xbf\x95\x15\xb6\xe5\xaf\x72\xdd\x9e\x63\xef\x0b\x38\xf4\x52\x69\x7e\x75\x30\x4f\xbf\x8a\xe7\xa1\xd7\xf3\x2b\x98\xb7\xf4\x72\xf3\x24\xf3\x0e\x71\xa5\xb6\x30\x9e\x77\x8a\x90\xb8\xc0\xbc\x4b\xdc\x81\x00\xf3\x09\x22\x80\xad\xcc\x27\x89\x5c\xbc\xcb\x7c\xb2\xce\x9f\x63\x3e\x55\x94\x58\x99\xcc\xf7\xd1\x79\x1f\xf3\x39\x3a\x3f\x8d\xf9\x4e\x91\x6b\x75\xf5\xe1\x35\xe1\xb1\x1e\x8c\xe7\x8f\x38\x44\xbe\xb5\x77\xf9\xf2\xe5\x15\x73\xda\xee\x5c\xd4\x3a\x77\xd6\xc2\xf6\x25\x15\xb3\x16\x2e\x10\xb5\x62\xa1\x58\xa4\x97\xcf\xc5\x62\xae\x98\x23\x5a\xc5\x52\x51\x28\x9e\xd1\x49\x09\x8f\xde\x27\xf4\xe9\x5c\xb3\x7e\xb7\x50\x1f\xa9\xdf\x2a\xda\x75\x9c\xa0\xc7\xef\xd0\x79\x33\xfe\x7c\x3d\x6f\x0a\x45\xb5\x68\xd3\x43\x61\x0f\xc2\x12\xfb\xd5\x6d\x3a\xde\xa6\x63\x87\x7e\x6e\xd1\x63\xd6\xeb\xb9\x70\x83\x98\xa4\xb7\x62\x8d\xba\xd5\xab\xc5\x44\x71\x8d\x1e\xaf\x41\xb3\xda\xf4\x52\xdb\xa6\x6b\xb7\xeb\xfa\x4b\xc4\x14\x3d\xfe\x1c\xb1\x4c\x97\x98\x56\xa4\xae\xe9\xd1\x43\x58\x8c\x16\xd7\xe9\xd6\xa7\xea\x7a\xa3\x7b\x65\xfd\x33\x69\xe4\x65\xac\x7f\xb7\x07\x85\x97\xd5\xbb\xde\x9e\x8e\x25\xfa\xfd\x85\xf6\x3c\xe8\xd9\xa7\x49\x36\xc3\x63\xcf\xad\xee\x52\x73\x87\xfd\xa5\x62\x96\x3d\x7e\xc7\xc5\x1a\x15\x22\xa8\x9f\x47\x8b\x05\x9a\x3a\x5f\x33\xcd\x38\xb3\x75\xa9\x69\xb9\x59\xcf\xf1\x0a\xe1\xb7\x53\x48\x78\xed\xdf\x21\xfd\x67\x53\xd9\xfb\x27\xd5\x7b\xe9\x72\x7b\xa8\xd0\xb5\xdb\xf4\xa7\xbc\x48\xf7\x7b\x2e\x7b\xbd\x44\x97\x9a\xdc\x82\xff\xda\x38\x53\x75\x2f\x9b\x75\xcf\x4d\xe9\xd2\x8b\xf3\x64\xaa\x6d\x47\xa1\x9e\xba\x59\x76\xa9\x99\x6e\x33\x3f\x03\x7a\xaf\xd6\x6b\x3f\x7b\xf4\x5c\xeb\xf6\x31\x7a\xb1\xfe\x35\xe2\x76\x3d\xcd\x73\xf5\x94\x18\xd7\xda\xb8\xd4\x9a\xad\xdb\x0b\x42\x89\x5e\x1e\x30\x77\x05\xb5\xe0\xd0\x4b\x44\x9a\x48\xd7\x7b\x52\xeb\xe1\x42\x02\x12\x91\x84\x64\xa4\x20\x15\x7d\x90\x66\x7f\xff\x98\x89\x2c\x64\x23\x47\xbc\x8f\xbe\xfa\xb8\x2e\x17\xfd\xe1\x46\x1e\xf2\x71\x05\x0a\x60\xbe\x86\x1a\x84\x42\x0c\x46\x11\x8a\x51\x82\x21\x18\x8a\x61\x28\xc5\x70\x94\x61\x84\xde\x22\x8d\x84\x39\xd9\xe6\x81\x84\x82\x17\x3e\x7d\x48\x18\x40\x10\x21\x84\x51\x89\xd1\x18\x83\xb1\x30\xd7\x51\x8f\x47\x35\x6a\x50\x8b\x3a\x44\x10\x45\x3d\x1a\xd0\x88\x26\x4c\xc0\x95\xb8\x4a\x6f\x8f\xae\xc6\x24\x4c\xc6\x14\x5c\x83\x6b\x71\x1d\xae\xc7\x54\x4c\xc3\x0d\xb8\x11\x37\xe1\x66\xdc\x82\xe9\x98\x81\x99\xb8\x15\xcd\x98\x85\x16\xdc\x86\xd9\x98\x83\x56\xcc\xc5\x3c\xcc\x47\x1b\x16\xa0\x1d\x0b\xb1\x08\xb7\x63\x31\x96\x60\x29\x96\xa1\x03\xcb\x71\x07\xee\xc4\x5d\xb8\x1b\x2b\x70\x0f\x56\xe2\x7f\x70\x2f\xfe\x17\xff\x87\xff\xc7\x2a\xdc\x87\xd5\xf8\x0c\xd6\xe0\xb3\x58\x8b\xcf\xe1\x7e\x7c\x1e\x5f\xc0\x17\xf1\x00\xbe\x84\x07\xf1\x10\xd6\xe1\xcb\x58\x8f\x87\xf1\x15\x7c\x15\x8f\xe0\x6b\xd8\x80\x47\xf1\x18\x1e\xc7\x46\x7c\x1d\x9b\xf0\x04\x9e\xc4\x37\xf0\x14\x9e\xc6\x66\x3c\x83\x2d\x78\x16\xcf\xe1\x9b\xd8\x8a\x6f\x61\x1b\x9e\xc7\x76\x7c\x1b\x3b\xf0\x02\x76\xe2\x45\xec\xc2\x77\xf0\x12\xbe\x8b\xdd\xf8\x1e\x5e\xc6\xf7\xb1\x07\x3f\xc0\x5e\xbc\x82\x7d\xf8\x21\x5e\xc5\x8f\xd0\x89\xd7\xb0\x1f\x3f\xc6\x01\xfc\x04\x3f\xc5\xcf\xf4\x61\xd4\xcf\x71\x10\x6f\xe0\x10\x7e\x81\x5f\xe2\x57\x38\x8c\x23\x78\x13\x6f\xe1\x28\x7e\x8d\x63\xf8\x0d\xde\xc6\x3b\x38\x8e\xdf\xe2\x5d\xfc\x0e\x27\xf0\x7b\x9c\xc4\x1f\xf0\x47\xfc\x09\xef\xe1\xcf\x38\x85\xf7\xf1\x01\xfe\x82\xd3\xf8\x2b\x3e\xc4\x47\x38\x83\xbf\xe1\x2c\x3e\xc6\x39\xfc\x1d\x9f\xe0\x3c\x2e\x20\x66\x09\x0b\x96\x65\x39\x2c\xa7\xe5\xd2\xdb\xfe\x44\x2b\xc9\x32\xb7\x4f\x4c\xb5\xfa\x58\x69\x56\xba\x95\x61\x65\x5a\x59\x56\x76\x82\xbd\xaa\x94\xf1\xa0\x12\x97\xb5\xcf\xf5\x78\xaa\x3d\x26\x2a\x8f\xa7\x2b\x4a\x46\xc5\xe8\x65\xf4\x31\xfa\x19\x03\x8c\x41\xc6\x10\x63\x98\xb1\x3a\x1e\x55\x34\x1e\xfd\x51\x67\x64\xd9\xe2\x85\xf1\x17\x35\x75\x76\xf4\xca\x1a\x3b\xfa\xa3\xb5\x76\x0c\xb0\xf1\x40\x34\x5e\x39\x48\x58\xd0\x63\x43\x22\xec\x5c\x84\x9d\x8b\xb0\x73\x11\x76\x2a\xc2\x4e\x45\xd8\xa9\x08\x3b\x15\x61\xa7\x22\x1e\xe9\x61\x24\x47\x92\x23\xc9\x91\x3e\x46\xf2\x24\x79\x92\x3c\x49\x9e\x24\x4f\x91\xa7\xc8\x53\xe4\x29\xf2\x14\x79\x8a\x3c\x45\x9e\x22\x4f\x91\xa7\xc8\xf3\x92\xe7\x25\xcf\x4b\x9e\x97\x3c\x2f\x79\x5e\xf2\xbc\xe4\x79\xc9\xf3\x92\xe7\x25\xcf\x47\x9e\x8f\x3c\x1f\x79\x3e\xf2\x7c\xe4\xf9\xc8\xf3\x91\xe7\x23\xcf\x47\x9e\x8f\x3c\x3f\x79\x7e\xf2\xfc\xe4\xf9\xc9\xf3\x93\xe7\x27\xcf\x4f\x9e\x9f\x3c\x3f\x79\x7e\xf2\x02\xe4\x05\xc8\x09\x90\x13\x20\x27\x40\x4e\x80\x9c\x00\x39\x01\x72\x02\xe4\x04\xc9\x09\xb2\x5f\x41\xf2\x82\xe4\x05\xc9\x0b\x92\x17\x24\x2f\x48\x5e\x90\xbc\x20\x79\x21\xf2\x42\xe4\x85\xc8\x0b\x91\x17\x22\x2f\x44\x5e\x88\xbc\x10\x79\x21\xf2\x42\xe4\x85\xc9\x0b\x93\x17\x26\x2f\x4c\x5e\x98\xbc\x30\x79\x61\xf2\xc2\x71\x9e\xa4\xf7\x92\xde\x4b\x7a\x2f\xe3\x0b\xa5\x8e\x7e\xc6\x00\x63\x57\xbd\x10\x63\xbc\x1f\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\xfa\x2f\xe9\xbf\xa4\xff\x92\xfe\x4b\x7a\x2f\xe9\xbd\xa4\xf7\x92\xde\x4b\x7a\x2f\xe9\xbd\xa4\xf7\x92\xde\x4b\x7a\x2f\xe9\xbd\xa4\xf7\x92\xde\x4b\x7a\x2f\x03\xe4\xd1\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x25\xfd\x97\xf4\x5f\xd2\x7f\x49\xff\x65\x97\xf7\x61\x72\xc2\x71\x8e\x8a\x6f\xa4\x74\x94\x8c\x8a\xd1\xcb\xe8\x63\xf4\x33\x06\x18\x59\x9f\xfe\x2b\xfa\xaf\xe8\xbf\xa2\xff\x8a\xfe\x2b\xfa\xaf\xe8\xbf\xa2\xf7\x8a\xde\x2b\xfa\xae\xe8\xb9\xa2\xe7\x8a\x9e\x2b\x7a\xae\xe8\xb9\xa2\xd7\x8a\x5e\x2b\x7a\xad\xe8\xb5\xa2\xd7\x8a\x5e\x2b\x7a\xad\xbc\x5d\xf5\xd9\x3e\xbd\x56\xf4\x5a\xd1\x6b\x45\xaf\x15\xbd\x56\xf4\x5a\xd1\x6b\x45\xaf\x15\xd7\xeb\x8a\x7e\x2b\xfa\xad\xe8\xb7\xa2\xdf\x8a\x7e\x2b\xfa\xad\xe8\xb7\xa2\xdf\x8a\x7e\x2b\xfa\xad\xe8\xb7\xa2\xdf\x2a\xee\x77\x34\x14\x8d\x26\x2c\x93\x51\xbf\x94\x26\x04\xaa\xab\xed\x9d\xd6\x6b\x27\xbd\xa2\xf7\x5b\xff\x11\x00\x00\xff\xff\x9a\x17\x3c\x9c\x5c\xb1\x00\x00")
-
-func _staticFontsGlyphiconsHalflingsRegularTtfBytes() ([]byte, error) {
-	return bindataRead(
-		__staticFontsGlyphiconsHalflingsRegularTtf,
-		"_static/fonts/glyphicons-halflings-regular.ttf",
-	)
-}
-
-func _staticFontsGlyphiconsHalflingsRegularTtf() (*asset, error) {
-	bytes, err := _staticFontsGlyphiconsHalflingsRegularTtfBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/fonts/glyphicons-halflings-regular.ttf", size: 45404, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticFontsGlyphiconsHalflingsRegularWoff = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\xb5\x73\x74\xe4\xcf\xf3\xff\x1b\x6f\x6c\xdb\xda\x78\x63\xdb\xd9\xd8\xd8\xd8\xb6\x6d\x6b\x63\xdb\xb6\x37\x36\x27\xd6\xc6\xc9\xc4\x76\xe6\xee\xfb\xf3\xbd\xff\xfd\xfa\x9c\xc7\xa9\xae\xe7\x54\x4f\x55\xbd\xce\xe9\x6a\x0f\x45\x49\x49\x30\x70\xb0\x7f\x4b\x37\x10\x0c\xe5\x3f\xdb\xa8\xf7\x7f\xfe\xff\xbb\x24\x25\xd5\x14\xc0\xc0\xc0\xb5\xfe\x6d\x09\xff\xc3\x8e\x3e\x7d\x4b\x4a\x5c\x42\xf2\x9f\xe6\xfa\xcf\x27\xf9\x07\x29\xb8\x38\x18\x94\xa2\x2a\x33\xdb\x3f\x2d\xf5\x9f\x2f\xf1\x0f\x43\x8b\x4e\x9b\x28\x13\x3b\x23\xc7\x7f\xda\x16\x18\x18\xc4\x00\x18\x18\x8c\xf3\x46\xed\x41\x90\x89\xbb\x2b\x29\x18\x18\x54\xce\xbf\x18\xa8\xff\x41\x0b\xf1\x62\x61\xe4\xf2\x2f\x0e\xaa\xe0\x9f\x0f\xfb\x1f\x20\x10\x18\xa4\x85\xad\x97\xf9\x3f\xad\x02\x0c\x4c\x01\x07\x0c\x2c\xb5\xc4\x4f\x7f\xd0\xc1\xd2\xcc\xc8\x14\x0c\x4c\xf9\xdf\x7f\x81\xb1\xff\x83\x13\x5a\x81\x79\xcd\xf2\x9f\xf8\x4f\x7b\xf8\xff\xeb\xa3\x84\x17\x87\x42\xb3\xb4\x73\xf5\x04\x03\x53\x41\xfd\x97\x5b\x11\x0c\x0c\xd2\x75\x71\x8c\xd4\xd0\xd6\xc1\xe4\x5f\x9c\xaa\xe1\xbf\x5a\x68\xfe\xc1\xe2\xf0\xfe\x7b\xd6\xce\xc8\xf3\x5f\x5e\xf5\xc8\xff\x7a\xf8\x5f\x1f\xd6\x60\x6b\xf6\x46\x76\x66\xff\xb4\x7f\x79\xc1\xf3\xfe\x9d\x2d\x6a\x66\x28\xc8\x76\x74\x70\xf9\xd7\xab\xa6\xf4\xbf\x7a\x18\xc1\xc0\xe0\x17\xba\x8a\x8f\x38\x3c\xcc\x8c\xff\xd5\xa7\xfb\x2f\x0f\x18\xcc\x7f\x0c\xe1\xaa\x29\xfd\xb3\xff\xfb\x8a\xd3\x02\x45\x73\xff\xd9\x79\xf7\x20\xb7\xff\xb3\x2e\xe9\x9e\x1b\x26\x86\xa6\x86\x86\x7f\x21\x8d\xe1\x60\x50\x0d\x8d\xff\xed\x4d\x31\x21\x93\x19\x0c\x89\x21\xc1\xa0\xa5\xc1\x64\xc1\xfe\xfb\xdd\xfc\xcc\x3c\x67\x60\x60\x72\x60\x70\x7a\xc8\x35\x28\x28\x88\x0c\x5e\xf4\x07\x93\x11\x8b\xf1\x06\xf8\x21\x64\x29\x6a\x20\x5c\x14\x11\x90\xe8\x6a\xec\xef\x40\xc1\x0d\x48\xe8\x3e\x08\x04\x21\xec\x46\x8e\xb8\x2c\x6c\x24\x49\x49\x05\x8f\x18\x0b\xc6\x9a\x86\x80\xf8\x2f\x97\xe7\xc6\x65\xaa\x90\xa5\xaa\x08\xe1\x58\x40\x7a\x89\x95\xea\xf0\xe8\x57\x54\x9c\xdd\x6e\x2f\x3b\x7b\x63\xed\x3c\x03\xb2\xd3\xa4\x92\x5c\x08\xa9\xd3\x22\xe2\xa2\x96\x04\xbe\x7e\x13\x2d\x14\x26\x1b\x1c\x78\x04\x53\x29\xa0\x32\x44\xbf\x25\x98\x94\x1c\x59\x82\x30\x47\xbc\x1d\xbe\xb6\xb4\x73\xd7\x4a\x5f\x1c\x50\xa8\x23\xfb\xf8\xda\xab\x07\x83\xd6\xda\xc5\xfd\xfa\x37\x65\xee\xba\xd7\xe3\xfa\x2a\xdb\xa3\x3f\x34\x32\x2b\x72\x1c\x05\x39\x48\x83\xbc\x51\x12\x02\x0b\xba\xcc\x51\x1d\x50\xff\x4e\xb0\x0a\xb9\xcf\x34\x5b\x4e\x1b\x62\x81\x9c\x2c\x37\xc4\xae\x88\x60\x5f\xcd\xb7\xff\xb3\x23\xe6\x4a\x25\xc0\xa7\xc7\xc2\x90\x86\x0c\x7d\x73\x42\xa9\x49\xe1\x55\x5d\x5a\x62\xb9\x40\xb6\xba\x66\xb2\x3a\x98\xbe\xaa\xa9\xaa\x4a\x1b\xd9\x29\xf5\x1b\xcf\x8c\x49\xce\x11\x3f\x4e\xe7\xb4\x7a\xde\x1e\x0f\x11\x80\x8c\x68\x41\x99\x68\x49\x26\x77\x45\x3b\x77\x35\x8c\x71\xee\xbe\xa1\x48\x4e\xec\xf1\xf7\x29\xce\x77\xb8\xe5\x92\xc1\x49\x75\xba\xdf\xca\x3c\x35\xec\x95\xb2\x55\x45\x65\xda\x8e\x19\xfa\x3f\x9d\xc2\xc7\x78\xc6\xa0\x99\x9c\xc2\xca\xbd\x34\x30\x34\xe0\xcf\xed\x6b\x30\x57\xe9\x72\x17\x78\xba\x93\x9f\x27\x75\x1f\x67\x7d\x52\xaa\x4a\x9f\xba\xdc\xfb\x35\x6a\x8e\x56\xb3\x57\xfa\x6a\x9e\x6a\x25\x35\x8d\xbf\x13\x7d\xf7\xd9\x16\x6c\x5f\x8d\xeb\x30\xf2\x3d\x08\xfc\xa0\x6f\x3f\xb4\x78\x55\xee\xfb\x4d\x70\x17\xdd\xc3\x20\x77\x17\xcc\x4f\x33\x37\xb9\x74\x57\xc2\x4f\x96\x30\x51\xec\x0c\xd0\xf6\x58\xc9\xc1\x99\x6d\xba\xdf\xf2\x3a\xe6\x77\x22\xe6\x41\xc9\xf5\x61\xd4\x7f\xba\xfc\x4a\x31\x49\x78\xbd\xd1\x77\xc8\x21\xaa\xeb\x97\x89\x59\xbf\x26\x63\xb1\xe5\xc4\x9f\xe6\x82\x0d\x0e\x83\x99\x89\xdb\x5b\xf3\xb1\x0e\x92\x62\x00\x80\x2b\x6a\x55\x60\x18\xb4\x28\xe1\xf4\x98\x16\xc2\x2a\x07\x74\x87\x3d\x61\x8c\xf8\x30\x00\x14\xd8\x1b\x4c\x0a\x69\x95\x45\xb5\x4e\xc1\x7a\xec\xc8\x62\xe5\xc2\x19\x00\x08\xed\xda\x15\x09\xd2\x46\x35\x6c\xeb\x44\xb8\x7e\xd5\xd3\x19\xb8\x84\x30\x19\x09\xe8\x2c\xa2\xd5\x22\x00\xba\xb5\x44\x49\x3f\xa8\xd9\x40\x06\xa0\x32\xf7\xb7\xbb\x8a\x84\x21\x68\x84\xdc\x60\x1a\x11\x34\x8e\xab\x4a\x74\xda\x62\x41\x48\x4d\x61\xac\x47\x09\x4f\xfc\xe9\xa2\x3e\xf1\xe1\xf1\x21\xea\x21\x75\x5c\xbc\x35\xd3\x0e\x18\x0a\xc8\x6c\x44\xdc\xa9\xf1\x01\xea\x21\x24\x5c\x3c\x4f\xc4\x47\x05\x4f\xc2\x0c\xfe\x76\xa3\x43\xa3\x94\x13\xe4\x58\x06\x5b\x36\xfe\xc0\xa4\x20\x85\xf0\x31\x16\xb6\x86\x89\x1b\x4c\xce\xfb\xd9\x02\x83\xf0\xa4\xb0\x13\xa5\x7e\xca\xc5\x44\x4e\x9f\x0e\xe4\x08\x86\x16\x70\x8a\xec\xd1\xbc\x79\x02\x5c\x9f\x02\x85\x59\x0f\xc7\x69\xd1\xeb\x3d\x6e\xe9\xa2\x2f\x30\x8a\x66\xce\x4c\x2e\x23\xd3\x15\x6a\x1a\xff\x28\x7c\x1e\x0e\x67\xf8\x1f\x1e\x7f\xc8\xe7\x51\x74\xf3\x24\x5c\x93\xd7\xb6\x34\x23\xa0\x78\x3f\x97\x6a\x79\x29\xc9\x31\x1f\x32\x05\xd9\x66\x40\x26\x20\x84\x3b\x0e\xfa\x5d\xa6\x4f\xdb\xfc\x07\xd0\xe1\xcf\x82\x2f\xe6\x27\xd0\xcb\x97\xe5\x57\x4c\xe3\x1f\x7d\x29\xd7\xcf\x33\xb5\xd3\xa0\x5d\xe2\x5d\xb0\x84\x05\x14\xdc\xff\xe6\xc1\x7f\xf7\xe8\xdf\x0c\x80\xf0\xdc\x18\xe9\x85\xf3\x25\x58\x6e\x65\x11\xf4\xc0\x8e\x5b\xc1\xb3\xa3\xd0\x6e\x33\xd3\x4d\xa6\xd2\xde\x61\xd4\x56\x21\xa4\x71\x7e\x0a\x1d\xd6\x1e\xb7\x6e\x6b\x10\xa7\x42\x25\x85\x0b\x62\x10\x5e\x13\x5d\x93\x23\xd0\xb1\x96\x5e\xdf\x28\x11\xc6\x47\x37\x86\x28\x2d\x2d\x61\x0c\x53\x79\x98\x3c\xff\x5e\x6a\xff\x6e\x29\x97\xed\x5f\x7f\x34\x75\x5e\xdc\x93\x56\x56\x4a\xb9\x72\xc8\x77\x32\x6b\xd9\x8a\x71\x7a\x0e\xfa\xfc\x32\x30\xbf\xda\xea\x74\xb9\xee\x3c\xb1\x3f\xc9\xf1\x99\xed\x44\x9c\x6a\x61\x41\xec\x8c\xb5\x31\xe6\x96\x34\xe5\xcd\xa7\x92\x5f\x4d\x1d\x22\x9f\xfb\x60\xfd\xaa\x11\x79\x59\x4e\x1d\x22\x44\x10\x39\xd6\x46\x2e\x86\x8e\x15\x64\xc0\x1f\x5f\x4b\x1d\x82\x3a\xc0\x88\x0f\xa4\xc8\x71\x0c\xd5\x19\x67\x7f\x5c\xcb\xff\x1a\xe6\x88\x23\xb2\x33\xda\x35\x85\x4d\x31\x6b\xfa\x20\x15\x1a\xcf\xf9\xe6\x35\x26\x10\x57\x12\xf4\x22\xc3\x2b\x86\x1f\x35\x46\x9a\x22\xbe\x75\xf0\x48\x6b\x0f\x2b\x53\x12\xe7\x49\x10\x29\x8f\x98\xc4\x04\xc1\x5a\x44\x86\x19\xaa\x44\x93\x29\x2b\x1e\xe5\x9a\x24\x6d\x1d\x28\x2c\xcf\x9e\x7c\x11\xa6\x14\xfe\x90\x62\x49\x83\x47\x4a\x37\x8e\x66\xbc\x48\xe7\x2e\x1d\xaf\xc5\x60\xfe\xca\xba\x60\xa2\x47\xd3\xb4\x6f\x22\x35\x83\xbc\x32\xd0\x18\xea\x96\x91\x20\x08\x83\x76\x1e\x4b\x7c\xcb\xba\x4e\x88\xe0\x3f\xe8\xfa\xf1\x57\xe8\xf6\x36\xf4\x0c\xf7\x85\xcc\xb3\xf0\x05\xf6\x0f\xa4\xda\x2f\x27\xa8\x7d\x62\x21\x27\x02\x51\xf0\x88\xcd\x79\xc9\xe0\xa4\x12\x0a\xd4\x58\x79\xaa\xde\x4a\xf1\x81\x85\x6c\xf2\xfa\x43\x4c\x21\xed\xda\x97\xcf\xe8\xae\xb1\xfa\x81\x90\xae\x6f\x24\xe3\xd6\x42\x42\xdc\x82\x3f\x17\x54\x6d\xcc\xec\x42\x9b\xca\x44\xb4\x9b\x5b\x9b\xc4\x8d\x51\x0b\xd9\x91\xd6\x65\xe9\x4a\x7e\xf3\xfd\x16\xee\xc4\xb7\x4e\x19\x51\xa2\x84\xcf\xb8\xa6\x71\xb2\xac\xe3\x36\xcc\x3e\xe7\x33\xc2\x8e\x05\x42\xc2\x04\x69\xcd\xc0\x0c\x71\x8a\xc5\xbd\x4a\xee\xd3\x87\x9a\x4e\x41\x62\x6b\x7f\x9a\xec\x35\xe2\x6e\x90\xe3\x02\xbe\xc5\x06\xbf\x86\xce\xbd\x62\x15\x6f\xbe\x60\xd1\x08\x59\x8e\xca\xd7\x68\x1c\xa4\xc7\xae\x30\xf4\x0a\x9a\x24\x99\x4d\xfd\x30\x43\xd3\x80\xec\x5c\xb4\x0c\x47\x21\x15\x10\x7b\x4f\x1a\x08\xc8\x0a\x08\x3f\x1b\xf3\x3f\x7c\xf4\x14\xeb\xcd\xd5\xa3\xa5\x75\x32\x16\x46\xc4\xc5\xec\x60\xbf\x89\xf2\x0c\x97\xa3\xb6\x5c\x0d\x9a\x45\x43\x2a\xc5\xa8\x64\xd7\x63\x83\xea\x1a\x6b\x44\xe1\xfc\xc9\xea\xcb\x08\xe0\xab\x9d\x3b\xb7\xce\xc6\xbc\x4e\x9b\x40\xd2\x76\x03\x08\x87\xef\x59\x03\xaf\xb2\x17\xaa\x3d\xdf\xca\x1e\xc3\xf3\xac\x3a\x0e\xa3\xcb\xbf\xec\xe4\xee\x11\xcf\x37\xd7\xe3\xc4\x5f\x24\x9e\x90\x3d\xca\x9c\x7e\x80\xa6\xdb\x99\xba\x7b\x4c\x44\x3c\x84\xdb\xd8\x5d\x99\xe2\x2d\x0e\x6e\x2f\x3e\x6c\x2c\x5a\x26\x9c\x1b\x7c\xfc\x37\x97\x34\x6d\xfd\x6b\x7c\x40\x2b\xb6\x9a\x6c\xdd\xc0\xa7\x1b\x3f\xc4\x21\x36\xae\x79\x4e\x62\x23\xb6\x69\xbd\x40\x4e\x54\x07\xd4\x54\x6f\xe1\x66\xca\x16\xb4\x5b\x8c\x42\x22\xfc\x9d\x73\xe9\x61\xd5\x89\x1d\xe9\x48\x42\xd2\x6a\x8b\xba\xec\x85\xcb\x46\x74\xe3\x47\xd7\x39\x2d\xae\x7b\x16\xb9\x2c\x57\xc0\x8e\x09\x6b\xc0\x1f\x86\xea\x80\x65\x12\xae\x74\xd1\x3b\x04\x07\xf9\xf3\xd3\x8c\xd1\x04\x00\x27\xca\x30\x4e\x3b\xa7\x2e\x43\xda\xf1\x0a\xd5\xad\xd5\x9e\x0e\x52\x7f\x04\x43\xb2\xad\xa0\x5a\xb8\xe3\x9d\x5c\x73\x8e\xaa\x94\x45\x8d\x9e\x20\xd2\x6d\x2e\x45\xf7\x8b\xc8\x13\x2c\xc5\x8f\xd8\xea\x35\x89\xd6\x78\xaf\x60\x9b\xc1\x2b\x4e\x77\xcc\xba\x0b\xb4\x8d\x93\xd3\xb0\x3f\x5d\x27\xb8\xe1\x7c\x30\x6e\x28\x3f\x96\xc8\x08\x74\x64\x08\x67\xb9\x2f\x30\x32\x14\xc0\xc2\x6d\x84\x88\x9a\xb9\xdb\xc4\x32\x8a\x35\x9d\x7c\x0c\x2f\xc4\xe8\xa3\xe6\xff\xbd\x8a\x82\xd2\x89\x41\x50\x5b\xac\xfd\xe8\xbf\x5c\x9e\xd6\x6d\xf6\xa3\x03\x2e\x2c\xe9\x72\xb2\xf3\xea\x1c\xac\xab\x14\x15\x69\xba\xe1\xc9\xfc\x4d\xb5\x6e\x2d\xe6\xde\x45\x69\x1f\xc2\xee\xc3\x3f\x27\x59\xf8\x91\x6c\x14\xb5\x3d\x27\xcd\xca\x6b\x2a\x1b\x47\xcb\x87\x6b\x39\x6c\xca\x10\x51\xd2\x05\x4c\x11\x0e\x9a\x6e\x96\x20\xe7\xca\x05\x49\x2f\x3f\xbe\x7f\xb8\x8e\x8a\x9d\x30\x3a\xc0\x8f\x55\x85\x43\x84\x12\x31\x1f\x0e\x3d\xfe\xb2\x71\xb8\xde\x6a\x0b\x00\xb5\xf5\xb8\xdc\x2e\x7f\x37\x6f\x00\xcd\x2a\xee\x7a\x37\xba\x3a\x6e\xba\x7d\xe3\x62\xcc\xf4\x0b\x33\x7d\xbc\xfc\xe2\x19\x81\x83\x74\xf9\x0b\x1b\x10\x59\x69\x86\x4f\x1a\xdb\xac\xc7\xc3\x24\xcd\x9e\x4c\xc6\x92\x50\xaa\xaa\x47\x3a\xd9\x18\x60\xc3\x96\x79\x4d\x2e\x64\xe5\xc8\x95\xa3\x07\x81\xc3\xe6\x5d\xbe\x0e\x00\xd8\xee\x34\x2c\xb8\x0a\x74\x5e\x85\x69\xbe\x9e\xe8\x91\xbd\xcb\x79\x29\x6a\x59\xd9\xa9\x0f\x5e\x90\xf4\xd9\x3b\xb8\x67\x5f\xa0\x20\x6e\x8e\xe1\x73\x36\x11\xf3\xf6\x9e\x1e\x21\xb1\x61\x8f\x22\xdc\xcb\xeb\xd8\x88\xe0\x7e\x8a\x10\xce\x18\xec\x3b\x89\xe1\x1e\x67\xee\xa4\xc2\x51\x2b\x41\x1f\x13\x45\x5e\x63\x19\x49\x8e\x02\x4b\x65\xaa\x58\xb6\x0e\x04\xbf\xa8\x71\xaf\x5f\x03\xc2\x94\x11\x70\x82\x28\x24\x8b\x95\x3f\xbb\x17\x6c\x8f\x05\x91\x44\x9a\xf1\x48\x66\x23\x9c\xe8\x33\x14\xaf\x02\x43\x51\x83\xf7\x6a\x3f\x0f\x70\xe7\x40\x31\x0b\xe3\x65\x71\xc7\xbf\x84\xd9\xb8\xca\xb0\xa6\x6d\x87\x19\x2a\x02\x82\x13\xa0\x5d\xbe\x70\x5e\xa2\x16\x4e\xfb\xa0\xcd\xbf\x8d\x23\xf4\x71\xee\x3b\x72\x41\x7c\x6c\x55\x0a\xe5\xbe\x61\x80\x14\xbe\xb2\x63\xa3\x1a\x59\xdd\x23\x45\x32\xb6\xd8\xa2\xca\xf4\x29\xad\xc9\x83\x63\xf8\xf0\x93\xdc\x37\x6d\x6d\xfc\xdd\x8d\x58\xfa\xdf\x2d\x2e\x4a\xad\x8b\xdf\xb6\xbc\xbf\xb2\xdb\xb6\x55\xc4\x79\x41\x16\x38\x95\xab\xc7\xfd\xb0\x8d\x26\xe7\x9c\xc9\x23\x23\x5e\x1e\x50\x6f\x54\x0f\x16\xfb\xb4\x43\x7d\x21\x50\x6b\x14\x34\x2d\xb3\x6e\xa2\xad\x77\x14\x17\x02\xaf\xbf\x84\x85\x42\x04\x47\x25\xd7\x60\x3f\x35\x8c\x63\x59\xf2\x48\x8c\xf0\xf7\xc8\x6a\x8c\xe4\xd9\x5d\xd1\xb5\xdc\x7b\x90\x0b\x3f\xc0\x79\x47\x24\x9f\x9e\x2c\x9f\x9b\x78\x62\xae\xad\x0d\x5a\x3d\x77\x62\x3f\xfa\x0a\xcf\xbc\xe7\xe7\xfc\x5a\x34\xed\x61\xe3\xfd\xb8\xfc\xda\x56\x71\xba\xad\x53\xea\x47\x67\xb3\x88\x67\xaf\xad\x7a\xa5\x2b\xe3\x6a\xfb\xbb\xda\x46\xff\xec\x10\x5a\x95\x7e\x8e\xb8\x02\xaf\x3f\xe4\x3e\x54\x63\xf3\xa3\x8c\x66\x08\xb4\x24\x1e\xba\x24\xba\x8c\x8d\x09\xf0\x45\x95\x59\xd7\x26\x9e\xcc\x61\x49\x52\x61\x5d\x93\x3c\xc3\x1f\x24\xc7\xeb\xb6\x10\xda\x19\xc9\x4d\xd1\x9c\x44\x30\x9e\x57\x47\xf5\x80\x24\xed\xfe\x92\x86\xcd\xa8\xdd\xa7\x9c\x4d\x56\x87\x41\xbf\xbf\x93\x0c\x20\x80\x00\x45\x9b\x06\xe3\x4d\x83\x7e\xab\x95\xd7\xab\xe1\x36\xd4\xdd\xdb\xc3\x9d\xa8\x7d\xdd\x97\xd0\xe3\x7f\xf9\x19\x91\xf4\x4e\x73\xf8\x86\x49\xf6\x2d\x58\x05\x2b\x3c\xc2\xa1\x59\xb6\x58\x76\xdd\xef\x26\x0c\x3c\x49\x2f\xb6\xb7\xb9\x6c\x37\xf9\x53\xb3\xbc\xb6\x67\xfd\x32\xa7\x55\xdb\x1d\xb2\x79\xce\xb6\x18\x37\xeb\x0e\xfa\x38\x6d\xf2\xe6\x1b\x6d\xa7\x5a\xfa\xce\xed\x3b\x5f\x6f\xa2\xb1\x22\x1c\xfa\x8e\xba\xfa\xac\x74\xd3\xdc\x60\xef\x51\xd3\xcb\xfd\x07\x6b\x8e\x1d\xfe\x92\x18\x5a\xff\xfd\xf3\x3b\xda\xc7\x6a\x15\x53\x8f\x58\x8c\x9d\x01\x97\xdc\x4a\x93\x3b\x59\x36\xf1\x9b\x03\x06\xd6\xad\xc6\x5d\xa8\xb1\x63\xc5\xbb\x47\x64\x93\xd8\x67\x55\xd7\x2e\x59\x0c\x1f\x80\x86\x10\x86\x6b\x55\x9c\xc9\xbd\x13\x30\x46\xb4\x92\x00\x40\xb2\x8f\x79\x74\x20\xd1\x61\xb4\x6f\x3a\x2f\x59\xfe\xd3\x60\xf6\xfa\x6d\x4e\x29\x18\xf5\x2e\xda\xca\x81\x9d\x28\xda\x5f\x5e\xfb\x90\x39\xc0\xdb\xee\xcb\xe3\x57\x2e\xe8\x8e\x04\x3b\xb7\x6f\xed\xc2\xe2\x6b\xae\x37\xff\xeb\xb3\x3c\x40\x65\x67\x8e\xdc\x75\xa7\x60\x89\xfa\xa7\x03\xc4\xcf\xc0\x52\x3a\x76\xa2\xd1\x09\x05\x84\x66\xb1\x4d\x29\x36\xb4\xea\x28\x3d\xeb\xa6\x49\xee\x44\x53\x46\xc2\x09\x61\x41\x8a\x4d\x45\x57\x83\xb6\x81\x0b\x8e\x9e\xef\x9e\x33\x38\x19\x66\x78\x4c\x06\x7b\xfa\x21\xab\x3d\x7f\x9b\x66\x31\x36\xa5\x0a\xb8\xb3\x0d\x8e\x49\x1f\xec\x70\x62\x9f\x69\x97\x46\x5d\x59\xe1\x70\x9f\x5d\x32\xa1\xa7\x8d\xcd\xf1\x9a\x65\xb3\xc1\x1b\x76\x17\xe1\x10\xd3\x71\xa8\x92\x62\x93\x0a\xc0\xf7\xf9\xa3\xc0\x6a\xd8\xe3\x77\xa4\x21\xf6\xa1\x5e\x50\x52\xb7\x47\x84\xcd\xb2\x80\xcf\xdc\x62\xe3\xf8\x80\xbb\x68\x86\x6e\x1c\x18\x0e\xfe\x41\xda\xf4\x23\x9b\x26\x13\x0e\xed\x3a\x99\xfe\xaf\xb6\x8b\x99\xb5\x80\xf4\xfd\x57\x91\x97\x37\x7d\xdf\x91\xd5\xac\x23\x5b\xce\xef\xd9\xcb\x02\x2f\xd2\x1a\x07\x26\xc8\xc6\x2d\x72\xcb\x31\xa2\x2d\x9c\xbb\x56\xec\xbf\x11\x29\x5a\x62\xf0\x70\x7f\x6d\xe2\x56\xfa\x52\x93\xdc\x0b\xfe\x74\x57\x8e\xb6\xa1\xa8\x73\xd4\xfc\x2d\x70\xd8\xef\xd6\xc5\x95\x8a\xbf\x29\x3d\xd1\xa0\x42\x89\x74\xf2\x9c\x7b\x85\x04\xd9\xac\xcb\x71\x11\x93\x89\x90\x89\x34\x3d\x33\x6d\x51\x88\x0d\x57\x0b\xd9\x7a\x46\x44\x75\xc3\x3a\x77\x18\xc0\xe3\xd7\xd3\x30\xff\x3e\x81\x81\x01\x3d\xf6\xf5\x36\x47\x2d\x4c\x89\x4d\x42\xdd\x51\x41\x00\xea\x7c\x8e\x2d\xb4\xad\x49\xbd\x11\x40\x5e\x62\x55\x91\xb9\x4d\x0c\xb8\xf3\xe3\x39\x5e\xa3\xc0\x9d\x9d\x0b\xae\xf6\x7e\x17\xc2\x16\x62\xf5\xa6\xae\x7b\x18\x6e\xc4\xff\x85\x6a\xb4\xf1\x6a\xe2\x8b\xa1\xf4\x2d\x56\x46\xf7\x72\x9b\xd7\x6d\x9e\xaf\xa0\xfe\xeb\x70\xcc\xb6\x7f\x81\x51\xd0\x86\x3d\xfe\x13\xef\x58\xea\x11\xbb\xf8\x56\x7b\xc1\xce\x57\xe7\x21\xd1\xe3\x2f\x92\x1f\xee\x7b\xca\x82\xb4\xfd\x82\x8a\x00\xa3\x68\xdf\x79\xe6\xe3\x07\xa2\x3f\xcc\x91\x5d\x4f\x62\x79\x14\xd3\x7a\x62\x23\x1c\xd3\xc0\xd0\x63\x99\x82\x37\x9a\x01\x43\x7a\xf7\x39\x4b\x22\x46\x00\xd5\xbf\xd3\x0d\x68\x93\xdc\x75\xc4\x38\x58\xb0\xf8\x51\x12\x9f\xd5\xd8\x52\x35\xc3\xf4\xf4\xbe\x20\x17\x0b\x5f\x07\xc0\x5d\x63\xf4\x40\xa7\xb7\xe2\x27\x0e\x08\xbf\x9f\x60\xa5\x86\xc5\x25\xad\xcf\x7b\x7f\xe3\x8c\xa9\x26\xf7\x40\xf3\x67\x50\x9b\x41\x23\x5c\x4c\xa8\x97\xb4\x0d\xbf\x6c\x6d\xf2\xf6\xae\x8f\xfd\xd8\xa0\x44\xc9\xb6\x65\x0f\x3c\xbc\x9a\x54\xbb\xff\x71\xf2\x89\x83\x7e\xc7\x8d\x46\xd3\x5e\x67\x44\x78\x38\xb1\xb7\xfd\xf3\x7c\xa2\xb7\xe9\x1a\x03\xf3\x00\x9d\x75\x90\x2d\x13\xf4\xeb\x36\x82\x07\xbf\x07\x5c\x4d\x9b\x10\x07\xbd\x64\x81\x93\x34\x81\xef\x13\xbf\x39\x57\x8b\x2e\x88\x53\xed\x31\x8a\xd8\xb4\x0a\x98\xf2\x11\xfa\x58\x59\xb4\x2a\x84\x76\x21\xc6\x5d\x0b\x54\xbd\xa0\x09\x7c\xf9\x09\x4f\xd1\xcb\x92\x6a\x7e\xcb\x66\xe0\xcf\x55\x5a\xcf\x0b\x9f\x63\x17\x94\x7f\xa9\x00\x27\x93\x80\x11\xf9\x0d\xdb\x50\xe1\x89\x77\x29\x1a\x4a\xba\x6c\x00\xa2\x76\x4e\xb2\x69\x8d\xe7\x56\xf4\xb0\x7c\x87\xf7\x7a\x2f\xff\x73\xcf\x02\x25\xff\x79\x45\xd5\xa6\xdd\xc1\xfb\x72\xcd\x26\xbb\xed\x9e\xe2\x48\xf5\xd8\x21\x7f\xba\xc2\x21\xc0\xe4\x47\x49\x60\x68\x76\xbf\x48\x51\x26\xcd\x52\xa7\xdd\x6a\x5f\x9b\xd5\x92\xcd\xaa\xfd\xd4\x1f\x9d\x44\xc6\x45\xaf\x37\x1d\x25\xa4\xa9\x31\x01\x04\x75\x93\x4c\x9a\xb0\xe8\x49\x60\xbd\x10\xf5\x78\xc2\xa9\x5c\x21\x5e\xba\x84\x5b\xb1\xed\x2c\xdf\x59\xd5\xb3\x20\x7f\x1c\x84\xfb\x0f\x57\x70\x1f\x68\x6e\xc6\x75\x5f\x88\x21\x1a\xf8\x97\x6a\x33\xe0\x8b\x91\x3f\xee\xca\xc5\x88\x34\x0f\x7b\xd3\x38\x9a\x67\x4f\xd1\x2a\xa4\xc8\x08\x63\xb3\xf0\x87\x17\x92\xb1\x1a\xbb\x30\x0b\xe6\x85\x31\x85\xbe\x78\xac\xf8\xd2\x02\x2c\x54\x4a\x2f\xa4\xad\x75\xa0\xb8\xaa\x95\x03\x2f\xb4\x88\xc5\xfc\xdf\xa7\x61\xd5\xde\x0b\x25\x52\x6c\x34\x6f\xde\x50\xbe\xb2\x36\xc6\x3d\x5f\x74\x2f\x69\x54\x62\x72\x67\xa4\xf6\x12\x9f\x45\x22\x51\x1f\xbd\xc8\x16\x0e\x6c\x15\x79\xab\x08\x4c\xce\x27\x91\x90\x3d\x91\x95\xe3\x83\x34\x57\x85\x3e\xfd\xd3\xd3\x20\xcc\x4d\xe4\x5d\xd7\xa5\xbd\xec\x17\xa3\x17\x95\xbb\x46\xb9\xae\xf7\xbf\x53\xc4\x62\x25\xb5\x64\x6b\xe8\xac\x0f\x2d\x62\x3b\x97\x16\x62\x3b\x17\x21\xc1\x37\xa4\xdf\x5f\x3b\x9a\x59\x25\xa4\xda\xc9\x16\x37\x30\x03\x31\xb7\xb5\xbc\xdf\xd1\xd3\xd2\xea\xae\xfa\xce\xd6\xb3\xed\xbd\xd3\xd0\x5a\xdd\xd1\xb7\x84\x1b\x3d\x33\xc1\x1e\x2e\x71\x1f\xb2\x89\x27\x19\x29\xac\x0c\x0a\xe6\x30\x3c\x6f\xe9\x83\x28\xe9\xea\x66\x07\x70\x35\x3f\x92\x0f\x4a\x04\x56\x14\xf5\xcc\x5f\x0f\xef\x03\x75\x35\x03\x62\x5b\x52\x9e\xbe\xb4\xfc\x35\x6a\x84\xaa\x41\xb0\x57\x5f\xb8\xf3\x28\x08\x86\xf2\x66\x56\x35\xbc\xbc\xee\xec\x2b\xbb\x24\x90\x10\x94\x42\x31\x02\x2a\x5f\x28\xf7\x19\xbf\x8d\xa0\x28\x40\x26\x25\xcf\xfa\xdc\xda\xe0\x47\xdb\x92\xb2\xfb\x99\xc6\xb0\xd4\xb0\x34\x3e\x54\xf2\x62\x17\x12\x03\x73\x84\x45\xb5\x51\x33\x88\xed\x62\xe6\x41\xfa\x63\x06\x94\xd1\xaf\xcc\x78\x33\xaf\xd7\x8b\xe6\xbb\x55\x3b\x0a\x7c\x43\x81\x78\x1b\x7c\xd5\xf7\x13\xc8\x39\x61\x70\xa5\x0d\x93\xb0\x89\xa1\x9c\xaf\x0d\x62\x5a\x04\xb9\xcb\x1a\x1d\xa5\x03\xe5\x37\x14\xc0\x51\xad\x00\x7f\x04\xc9\x9b\x90\x0d\xfe\x00\x88\x13\x38\xde\x2f\x77\xb1\xfe\x7a\x93\xae\x56\x2f\x51\x02\x47\x0c\x79\xf7\xe8\xe2\x9a\x84\x25\xd6\x1b\x92\x80\x79\xc9\x54\x77\x8a\x5d\x9e\x70\x48\x78\x66\xe0\x13\xb7\x86\xe4\x79\x25\x0b\x2b\xe6\xd2\xdf\xda\x5f\x72\x90\x38\x04\x0f\x97\x42\x93\xc7\x9e\x41\x82\xc5\x7e\x6f\x00\x8a\x40\x29\x2e\xc0\x42\xb0\x9e\x66\xac\x23\x19\x93\x30\x3a\xe1\x07\x7a\x07\xec\xd5\x47\x77\x23\x8a\xf1\xab\xc7\x44\x21\xc9\x77\x9f\x2d\x87\xaa\x84\x65\xa2\x5a\x8a\x6e\x40\x9c\xd2\x90\xf2\x62\x9d\xfd\x70\x35\x27\x27\xbc\x89\x4e\x10\x5a\x6d\xc2\x89\x78\x54\x61\xe9\x7e\xf2\x20\x2d\x13\x69\x43\x3e\x66\x17\x2a\x41\x90\xdb\x35\x9f\x7d\x8c\x82\xdf\x02\xf6\x9f\x98\x91\x4f\x22\xa1\xee\x9c\xe0\x2a\xb4\x3c\xd4\x43\xf7\x49\xd7\xbc\x09\xcf\x4b\xa0\x64\x77\xee\x45\x93\xcf\xc4\xf7\x71\xcc\x34\x8c\xdc\xa6\x57\x54\xc3\x22\xf8\x55\x81\x7c\xa8\x55\xf2\x6f\x05\xce\xd0\x4a\x73\x81\xb6\x19\xe2\x57\x1a\xd3\x6d\xbb\x60\x25\xfd\x8d\x99\xbf\xbb\x85\x60\x0e\xc0\x2e\x49\xc0\x75\x70\x84\xcf\x8b\xa4\x41\x87\x0d\x61\xfa\x58\xb9\xf8\x5d\xba\xf8\x19\xa5\x3f\x06\x9e\x02\x38\x02\xa7\xa9\x50\x15\x11\x08\xc0\x2b\x7a\x80\x24\x74\x3b\x7a\x06\xa1\x09\x0b\x47\xa4\x3a\x7c\x6f\x19\x82\x71\x92\xcf\x5d\xae\xe6\xf8\xd9\x8c\xf8\x32\x5d\xde\x4b\x75\x88\x68\x81\x27\x60\xb1\x5e\x6f\x6e\x41\xac\x5b\x6e\x99\x0e\xfc\x58\x47\xbc\x13\x0e\x46\xbc\x6e\x4e\xbb\x98\x71\xc1\xcb\x1e\x77\x5e\x36\x90\x36\xa4\xfb\x31\x39\x31\x7e\x2c\x42\xd6\xee\x4d\xd5\x5a\xdf\x07\x4e\x21\x8e\xb7\xa1\xfd\x34\xba\x51\xb2\x6b\x51\xaf\x0f\xa5\x28\x5d\xbe\x36\xf0\xf3\xbb\xe0\x85\xd8\xee\x38\x49\x5e\xab\xea\xc3\x3f\x5b\xb4\x27\x65\xb0\x39\x4c\x92\x28\xef\xc5\xcc\x3f\x8d\xc9\xea\xb5\x4a\xe8\x7d\x25\xe7\x00\x2b\x06\x5d\x94\x01\x5b\x8e\x5d\x5c\xd7\x8e\x87\x97\x1c\x1c\xe5\x23\xdb\xd0\xbb\x6d\x9c\xba\xfd\x76\xad\x7f\xad\x2d\x82\x3a\x2b\x1a\xea\x8a\x6c\x05\x73\xe9\x9f\x6e\xc1\xd1\xe1\xb0\xe4\x14\xa9\xe1\x49\x1b\xa3\xb8\x9f\xb7\x72\x8b\xa0\x79\xc7\x1a\xc2\x70\x1c\xfa\x81\x2b\x36\x8f\x45\x11\x72\x47\x76\xfe\x99\x0e\x7d\xaa\x87\x8c\xf4\xec\xa5\x12\x3d\x7e\x4a\xcc\xef\x1b\x33\x54\x05\x08\x36\xbc\x85\x66\xab\xe4\x47\xac\x22\x67\xb2\x91\x69\xa7\x83\xfb\x62\x22\x5a\x59\x2d\x9d\x31\xd1\xdc\x02\x70\x60\xf2\x21\x23\x45\x82\xb0\x0d\x5b\x8d\xc4\x46\x78\x65\x47\xa0\x74\x77\x9f\x43\x64\x5f\x39\xa6\x1f\x08\xa3\x9b\xab\xd7\x81\xed\xbc\x7a\x28\xa2\x35\x9e\x63\x10\x44\x25\x1f\x1e\x37\x5d\x47\xc8\x19\xc6\x13\x66\xd8\x44\xc6\x81\x73\xfc\xf6\xb5\xcc\xb7\x3f\x13\x12\x4e\xc0\xc3\xac\x7f\xb6\x59\xe7\x2a\xaa\x29\x2b\xec\x83\xee\xde\x09\x92\x76\x97\xcb\x49\xaf\x8e\x1a\x2d\x6e\x82\x74\xe1\x57\x7b\x58\xeb\xe6\x64\xef\x74\xa5\xe9\x4f\x4d\x87\xda\x41\x67\x6b\xb2\x40\x28\xda\x44\x95\x42\x6d\xbf\xab\xba\x8e\xc3\xaf\xce\xf1\xc5\x0c\x92\x12\x13\xe2\x4e\x08\x4c\x4b\x31\xbe\x17\x23\xaf\x6a\xcb\xd8\x44\xea\x58\x31\x44\x27\x3e\x2e\x66\x55\xa9\x74\xfb\x89\xc5\x47\x2f\x1a\x83\xfb\x77\xfc\x2d\x1e\x0f\xc8\xd5\x54\xdd\x36\xaf\xa9\x45\x42\x49\x43\x76\x3e\xcc\x73\x92\x51\x37\x47\xad\x5f\x14\x89\x09\x9c\xca\x5e\x34\xb9\x9e\x62\x6b\x40\x27\xe6\x27\x08\xe5\xfd\x40\x25\xea\x85\x9f\xa7\xe5\xae\x01\x60\x5d\x50\x57\x08\xec\x8e\x3b\x30\xa7\x7f\xce\x00\x71\x4e\xe2\x10\xcf\x6c\xcc\x6b\x9d\x03\xd3\x56\x02\x8f\xad\x90\xb5\x0b\x5a\xef\x5b\xe1\x58\x08\xe2\xc5\x22\x89\xfc\x9b\x6f\xbd\x8c\xb9\x75\x82\x82\x2b\xac\x9b\xfd\xee\x51\xd2\x23\xd8\x42\x87\x18\xe6\x9d\xd9\x1c\xdc\x80\x7b\xc4\xf0\x2f\xaa\x19\xce\x6c\xca\x89\xa6\x2d\x1a\xc7\x22\x79\x03\xe9\xfa\x7d\xad\x59\x63\x47\x0c\x99\x64\x0d\x3c\x27\xfb\x95\xe3\xfe\x6f\x41\x3b\x67\x22\x67\xdc\xa5\x47\x72\xa9\x16\x34\x56\xc1\x64\xea\xde\xe1\x7b\xaa\x12\x59\x30\x55\x32\xab\xbb\x44\xef\x8f\x3b\x1c\x98\xeb\xcf\x9f\x3f\x05\x6e\xbb\xdf\x2d\x1d\x67\x76\x34\xb6\x84\x0a\xb3\x69\x2f\x24\x46\x48\x41\x7f\xb3\x7a\xfb\xfb\x34\x88\x4a\x9b\x9d\xdf\x67\x84\x76\x5a\xce\xc6\xb8\x70\xf2\xea\x3e\x1f\x77\x3e\xa6\x34\x8a\x37\x62\xa2\x26\xfa\xf7\x5b\x31\x99\x5a\x0e\x14\x5f\x3a\xb7\xf8\x1a\x60\x9e\x7f\x97\x14\x2c\x38\x96\x80\x21\xb0\xe7\x25\xfe\x3c\x53\xd1\xb1\x63\x82\x4a\x53\x04\xbc\x08\x92\x2a\xb8\xf5\x25\xc8\xd3\xd4\x4c\x58\x73\x54\x56\xed\x2f\xc1\x1a\x92\xf0\xb5\x28\xb8\xc6\x88\x28\x6f\xb5\x29\x84\x66\x13\x5c\x3f\x78\x9d\x6b\x5c\x66\x9e\xf3\x1b\xa2\x26\x25\x63\x23\x7d\xa3\xb4\xa3\xdb\xf1\xca\x2a\xd9\x68\x5e\xd0\x0a\xcd\xdc\x14\x37\xba\xe2\x25\x9a\xde\x83\xda\x0e\x82\xaa\xca\x82\x72\xea\x66\xcd\x95\x7d\x92\x74\x6a\x66\xe5\x00\x2f\x39\x64\xdc\xb1\xed\x38\xd5\x0b\x09\xfb\x11\xe5\xaf\x55\x78\xcc\xc8\xe6\x28\x09\x12\xbf\x31\x8b\x3a\x5b\x61\x0e\xb8\x35\xc7\x20\x83\xa9\x3f\x65\xab\xeb\xb5\x3a\xe1\xfd\x9b\x22\x00\x31\x64\xf9\xf9\xd7\xe7\x83\x6a\xcb\xc7\x35\xcc\x20\x10\x33\xae\x0d\x81\x1e\x7f\x58\x09\x2d\x32\x12\x26\xec\x06\x45\x74\xa6\x17\x59\xff\x6a\x56\x7b\x49\xfc\xc7\xc0\x9a\xb5\x29\x12\xc1\xdb\x12\xcc\x0a\x80\xfe\xd8\xc3\x77\x1f\xd8\x23\x25\x3f\xd7\xeb\xf7\x4a\x30\x95\x64\xc3\xd5\x11\x37\x14\x31\x27\xc0\xb2\x7a\xc8\x09\x15\xea\x10\x50\xe4\x4d\x0f\x53\x9b\xd7\xb6\xef\xed\x10\x8c\x7d\xc3\xea\x2f\x7b\xe2\xc1\xf2\xc9\xa2\x3a\x07\x30\x4b\x51\xf2\xa0\x2a\x81\x4a\x4d\xa4\x10\x16\x95\x15\x85\x1b\x28\x40\xa2\x82\x84\x40\x5d\x7f\xf9\x1e\x9a\x0c\xf7\x41\xd3\xd1\x9b\x33\xc0\x57\x82\x4c\x45\x46\x5d\x59\x8e\x8e\x40\x77\x43\x2c\x0d\x33\xee\x44\x7a\xc5\x65\x3e\xe1\xf4\x24\xcd\x54\x1e\x7f\xdc\x74\x31\x3f\x71\x12\x44\xb6\x26\xb1\x31\x16\x6f\xde\x7c\x91\xc4\x80\xfa\xc1\xb3\x9c\x15\x42\xc9\x93\x4a\x7a\x2c\xed\xe3\x8f\x6d\xf5\x2d\x2f\x70\x13\x2e\x5f\xa7\xf9\x06\x82\x4e\xd1\x37\x38\x81\x8c\x3c\xe2\x43\x9c\x49\x2e\x6a\x51\x19\xd1\x88\x6d\x19\x9e\xaa\xb2\x38\x0b\x97\x4d\x33\x96\xa4\x14\x7d\x22\x58\x0f\xe5\x3c\xde\xe7\x0c\x9b\x3e\xf3\x51\xd4\x87\x6b\x9f\x21\x03\x99\x07\x9d\xe9\x85\xc6\xcc\x83\x54\x1d\xd2\xa0\x22\x44\xb3\x3e\x81\x7a\x87\xb2\xdb\x99\xa7\xa7\xf5\xc4\xd9\x44\xea\x51\xf4\xe1\x84\xd0\xc3\xb6\xa6\xec\x0e\x74\x98\x6c\xfb\xc4\xff\xb1\xf0\xd6\x97\x19\x80\x3e\xd9\x0e\x7e\x85\xa7\xaa\x3c\x78\x10\x1c\x16\x99\x60\x79\x41\x46\x6a\xd5\xbf\x50\xe1\x7e\x70\x35\x2b\x0b\x81\xc4\xdb\x1c\xa0\x2d\xf4\x71\x4b\xf1\xfa\xbb\xe3\xd7\x92\x1d\x7b\xd3\xbb\x93\xee\x4d\xc4\xe7\xe7\xd4\xfb\x3b\x82\xdf\x2a\x43\x8f\xc4\xfd\xa3\x88\x8f\xc6\x11\xe1\x7c\x7e\x57\x64\x54\x4e\x1d\xe6\xac\x92\x6f\x9e\xf8\xd3\x91\x45\xa5\x10\x8f\x42\xe5\xc8\x98\x8c\xa9\x90\x4f\x09\x14\x24\x5d\xdd\x3b\xda\x24\xf3\xe0\xcd\x3c\x1c\xb3\x9e\x2e\x69\x64\xb5\xec\xeb\x62\x42\x48\x67\xee\xb9\xee\xdf\x1c\x73\x76\x48\x29\xc1\xa9\x6f\xe0\x7f\xa6\x89\xff\x28\x07\xca\x15\xdc\x1b\x14\x20\xe2\xc4\xda\xad\xee\x77\xe2\x90\x86\x97\x11\x66\x2b\xa6\x8f\x91\xbc\x92\x82\x33\x72\x79\x00\x31\xf5\xa7\xa6\xa1\x81\x83\xa1\x0f\xfc\xdb\xc8\xea\x3f\xac\x47\x13\x18\x86\x27\x88\x0e\x23\x79\x53\xfa\xb5\x53\x5a\x9d\x46\xfc\x0b\x58\x9a\x33\xb1\x60\x99\x4b\xed\x92\x6a\x46\x5a\x51\x84\xc5\xa2\xb1\xd3\xc5\xb6\xb0\x24\x03\x9d\xf8\xdb\xcf\x92\xbd\x4a\x3c\xa7\x2c\xbf\xbd\xc5\x3e\x0e\xeb\x3d\x44\x97\xb1\x08\xa4\xab\x58\x68\x5f\x98\x26\xd1\x3e\xe2\xf5\xd0\xfe\xba\xc1\x67\x4b\xee\x88\x5d\x9b\x98\x34\x6a\x8e\xf8\x35\xec\xe3\xd8\xae\x80\x80\x19\x86\xa5\x46\x5f\x18\xc3\x92\xa7\x5e\x9b\xaa\xd6\x96\xb1\x4e\xd6\x0c\x6d\x65\x4e\x89\x0d\xc8\x07\xd0\x6a\xee\x97\x38\x95\xaa\x88\xf1\x2a\x15\x18\xfd\x10\x30\x6e\x5a\x40\x20\x75\x96\x63\xa5\x61\xec\xf6\x29\x98\x79\xfa\x2d\x7d\x3a\xd9\x2b\x4d\xf8\xc9\x3a\xb9\x47\x63\xb8\x6e\x43\x38\x57\x6f\xe6\x59\x44\x99\xdc\xc3\xd4\x69\x19\xef\xb3\x15\x6e\x58\x82\x23\x79\x56\x82\xe0\x9d\x2d\x31\xc4\x52\xf7\x62\xc2\xe1\x49\x64\xae\xb0\x30\x0c\x0d\x15\x7e\x98\xd0\xe7\x77\x92\xbb\xdb\xa7\xfa\x79\xfd\x5b\xb5\xac\x26\x67\x10\x65\x50\x6e\x44\xff\x27\x11\xee\xb9\x39\xcd\xa6\xa3\xd2\x6c\x27\x86\x2a\x7e\xb9\xa1\xa8\x9d\x9d\xf4\xa2\x3b\xcf\x30\xfb\x5e\x64\xa8\xc1\x85\x1d\x69\x9c\x38\x1c\x25\x5a\x21\x78\x46\x74\x10\x43\x20\xcd\x56\x28\x31\x69\x28\x82\xe3\x85\xe6\x44\xed\x15\xb6\xc5\x9a\xa9\x06\xb3\x7c\x1b\x5f\x5a\xd5\xaa\x02\xb2\x8e\x7c\xed\x2d\xcc\xe3\x7d\x0a\xb0\xb6\xb5\x48\x5a\xd0\x27\x90\x61\x77\x88\x04\xbf\x3a\xae\xbd\x0b\x73\x70\x36\xa3\xb1\xea\x60\xf5\x39\x1f\xe3\xa2\xa6\x7d\x36\x43\x59\xd5\x01\x69\xd3\x76\xeb\xfd\xd5\x65\xa2\xdf\xf2\x13\xe2\x15\x24\x24\xd2\x1f\xc9\xe6\x54\xb5\x34\x6f\x38\xb0\xcd\x51\x10\x12\x98\xf3\x4d\x27\x30\x81\x1a\x9a\x1e\x98\xa2\xc5\x2a\xcf\x01\xce\xbb\xce\x59\x74\x0e\xd5\x82\xf6\x34\x17\xfc\x7d\x7d\x7b\x17\xa8\x88\xa2\xef\x66\xd6\x6e\x1d\xf7\x88\x11\x32\x42\x50\x41\x25\x74\xbd\xd9\x43\xbd\xb4\xb6\x60\xa4\x29\xe4\x73\x5d\x34\x45\x54\x3b\xc5\xa6\xfb\xe5\x37\xb1\x48\xa0\x29\x34\xbb\x23\x3b\x8c\x1f\x60\x7c\xb7\x38\x9b\x99\x8d\x53\x6a\x33\x87\x86\xc1\xa8\x17\x10\xd7\xbd\x63\xcc\x15\xe5\x22\x73\x51\x63\x63\x4e\x3d\x9b\xbd\x65\x0e\xec\xf4\xf4\xaf\xe9\x6f\xb7\xbf\xc8\x54\x64\xe4\x79\xd6\xd8\x38\x01\x9f\x45\x4a\xf5\x37\x89\xd4\x24\x1f\x4f\xf7\xa2\x33\xd6\xa3\x0b\x65\x43\x57\x70\xd6\x6c\x9c\x32\x8f\xe4\x53\xb8\xbc\x57\x3f\xe7\x2a\x0d\x21\xa4\xd1\x25\xc0\xaa\x9f\x19\x48\x78\xe8\x4c\x66\x34\x61\x7e\x6a\xc1\x65\xea\x5b\xeb\x4b\xcd\x3e\xae\x70\x5b\x57\xc0\x3e\xfd\xb2\xcd\xa7\x26\x79\xc9\x62\x22\x81\x5d\x7a\xe4\x01\x6c\xd2\x91\x4a\x01\x17\x36\xc8\x86\xc8\x34\xe9\xe1\x3a\x38\x61\x59\xc4\xa2\x17\x42\x29\x52\xb1\xb7\x9c\x8a\x47\x4e\xa2\x01\x2f\xce\x4e\xf9\x8a\xdb\xe3\x72\x7f\x74\x14\x67\x35\x31\x9c\x5e\x19\x46\xb9\xa7\x83\x50\x98\xb5\x1c\x0f\xe2\x6e\xe6\x2e\xd5\xb2\x30\x27\xc6\x93\x1a\x2d\xf8\x05\x07\x07\x4b\xe8\x32\x30\xa3\x74\x65\xb9\x8b\xa1\x1c\x0c\xe1\x89\xf8\xde\x00\xaa\x7a\x4b\xaa\xf3\x38\x10\xe5\x7d\xa3\x01\x4f\x29\x03\x7b\xe8\x29\xe0\x54\x59\xe1\x96\xfe\x37\xd3\x8e\xd3\xbe\xb2\x53\x7d\xb0\x92\xfe\x72\x78\xb8\x17\x02\x12\x2c\x3f\xaf\x3a\x94\x43\xb4\x76\xc7\xf0\x2b\x77\xc6\xe8\x4f\xf0\xbe\x16\x4e\xb8\xf9\xc4\xb9\x50\x9e\x94\x6f\x17\x58\xab\x5a\x95\xc5\x86\xa8\x90\x90\xe9\x25\x82\xbd\xce\xbd\xfc\x42\x0a\x49\xe3\xd4\xa5\x84\x04\x3f\x64\x0c\x8f\x05\x61\x46\x81\xcf\x34\x93\x9c\xfc\x79\xdf\x43\x2e\x73\x97\x86\x41\x01\xe0\x3f\x64\x34\xee\x2c\x96\xe7\xea\x12\xbc\x14\xe3\xdf\x7f\xa3\x57\x29\x53\x6a\x8a\xba\xd7\x11\x6e\xc2\x4a\xc3\xb9\x5f\x85\x15\x9d\xea\x7e\xce\x25\xc0\xb1\x81\xbe\x7f\x16\x23\x26\x4c\xd2\xc0\x82\x86\x3a\x38\x7f\x76\xec\x86\xf3\xbf\x81\xde\xf2\x36\xc7\xae\x26\x7e\xb7\x11\x95\xa5\x23\x45\xb0\xa5\x82\x54\x6f\x8f\x2a\xb2\x70\x79\x40\xcd\x5a\xe1\xac\x05\x7a\xbf\xf7\x53\x8b\xdf\x3f\x6b\xa7\x4d\xcd\x05\x87\xa0\x44\xe3\xfd\xdb\x9a\x26\x97\x67\x18\x23\x53\x5d\xb1\x05\xcb\x34\x13\xf5\xd7\x2f\x1c\x2b\x95\x9c\x73\xae\x74\x74\x1b\x9b\x68\xf2\x26\x8f\xc6\xbf\x97\xe4\x8e\xc6\xee\xb7\xda\x86\xf3\xe8\x5f\x73\x3b\x1d\x04\x5f\x04\x8b\x95\x9f\x91\xc5\xb6\xd1\x20\x9c\x0b\x8b\x04\x88\xd4\x2b\xba\x81\xe6\x2b\x0e\xab\xe0\xc9\x84\x50\x15\x02\xf4\xc2\x64\x15\x66\x6a\x67\xcc\xc7\xf2\xe8\xaa\xd1\x76\x7f\x4f\x09\x3c\x02\x26\x3c\x84\x44\x7e\xff\x14\x9c\x43\x49\x0b\xc9\x9b\x1a\xec\xf1\x7b\xb3\x56\xc6\xe7\x1f\x4b\x70\x1c\x2b\x30\x98\xbd\xbd\xa3\xc5\xe8\x0e\xf4\xeb\x6f\xec\xed\x65\xbd\x0e\xfd\xde\x9f\xc2\x46\x34\xf6\xd7\x09\x41\xb2\xe9\x99\x5d\xa5\x2f\xb4\x05\xc7\x4a\x7a\x38\x21\xfd\x4a\x46\xed\xdc\x99\x7b\x11\x62\x7e\x17\xd9\xa0\x3c\x73\xe3\x95\xcc\xc2\xae\xf6\xfe\x50\xb0\x63\x16\x94\xd8\x32\x4b\xb3\xf7\x3c\xba\xb3\xd1\x61\xb9\x30\x2a\xf1\x61\x58\xeb\x32\x1d\xed\xd0\x79\x30\xbb\x61\xdb\x77\x1b\xb6\x49\x4c\x86\xf7\x48\xcb\xe3\x7f\x59\x84\x14\x83\xe3\x30\xff\xf7\x8b\x8a\xe2\x86\xee\xcb\x7f\x34\xe4\xcd\x9a\x72\x47\x38\x70\x39\x35\x52\xde\xb1\x57\xea\x88\x44\xd1\xc8\xe7\xac\x6e\x66\xb7\xdf\x7c\xf6\x62\xa8\x32\x95\xae\xc1\xfb\xb4\x6a\x2d\x55\x7b\x95\xff\xeb\xb1\xfe\x7a\xed\xf6\x91\xe8\xe3\x73\xd3\xbf\xe1\x35\x6b\xce\xe0\x31\x1f\x12\xcf\xe4\xef\xe2\x14\x71\x56\xbb\xcb\xfd\xfc\xce\xf9\x71\x6f\xd7\x01\x41\xfb\xce\x5b\x5c\xd3\xe7\xe0\x76\xcb\xcf\xdf\x2a\xea\xfb\xf3\x50\xd9\xcb\x2a\x00\x68\xb5\x54\x6d\x2a\x6e\xa2\xcd\x81\xda\x92\x3b\xb9\x69\x97\x1f\x43\x4e\xfd\x68\xa6\xfa\x06\x95\xca\x6a\xc6\x34\xdf\x48\x11\x72\x86\xbd\x09\x3c\xeb\xbf\xd1\x5d\x49\xb7\xa4\xc4\x49\x2e\x56\x2a\x85\xda\xa9\xcf\xea\xf4\x58\x2b\x53\xe7\x68\x75\xde\x8e\x06\x1d\xa1\x38\xe4\xef\xf2\xb3\x74\x58\x4f\x68\x4b\xf5\x34\x62\xc7\x03\xa0\xbd\x81\xfa\xd1\x11\xd0\x5f\xeb\x94\xdc\x26\x2b\x1b\xd4\x2b\x96\x98\x87\x5e\xf9\xdb\x3d\x8f\x59\x33\x1e\xb3\x79\x3e\x53\x40\x1e\x34\x83\x8c\x9e\xe3\x3f\x2e\xe6\xbb\x9d\x47\x59\x76\xba\xee\x47\xcb\x03\xc1\x44\x29\x43\x4b\xc5\x64\x13\xb4\x3a\x97\x22\x2e\x71\xe3\x9b\xe5\x63\xb3\x5e\x7f\x44\xa3\x7b\x06\x08\x3e\x7e\xc7\x62\xbd\x71\xab\xe0\x5b\x01\x8a\x45\x6b\x2d\x38\x09\x23\x7d\xa6\xd4\xed\xca\x92\x5d\xbd\x34\xc9\x8c\xb3\x1c\xc7\xea\x4c\x91\xec\x47\x98\xbd\xa6\x83\xf7\x26\x7b\x84\xdd\x47\x96\x98\x2b\xbb\x13\x01\x54\x63\x26\x9f\x9d\xe9\x4f\x55\x04\xf9\x9e\xb7\x12\xf6\xc9\xbc\x1f\x47\xec\xda\x43\x9f\x8f\xf8\x83\x4d\x3d\x0c\x5e\x3a\x8c\xc7\x36\xce\x6d\xe9\x6f\x26\xd2\xd4\x4f\x63\x06\xe3\x95\x73\x62\x5d\x34\xe3\x75\x34\x06\x6a\x01\x75\xc8\x63\xcf\x29\x1c\x5d\x39\x57\xf7\xf3\x63\xc0\x0c\x4c\x67\x3a\xda\x68\x5a\x99\xd3\x45\xd9\x4c\x42\xf5\x55\x92\x58\x6a\x8a\xc0\xed\x58\x32\x24\xcc\xef\xda\x0a\x4c\x20\xd9\xeb\x6a\x09\x2d\x03\xdf\xe0\xc1\xc1\x1d\xf9\x34\xf0\x6a\x0f\xe1\x96\xc4\x8d\xbe\x8b\xbb\x42\x1d\x17\x8b\x5d\xed\xa5\xe3\x96\xae\xc8\x30\x92\x0f\xbd\x2a\xb0\x85\xab\x56\x68\x4f\x20\x20\xbf\xa0\xe9\x4f\x3f\x9e\xe7\x12\x41\x68\xab\x57\x17\xe4\x67\xba\xfc\xf5\xa3\x98\x97\x43\x66\x58\x32\xff\xad\xf2\xa4\x22\x25\xdd\xb3\x66\x1c\x67\x29\xa1\x1d\xd5\x76\x78\x9d\xf3\x76\xf8\xb2\xe9\x63\x6a\x66\xc4\x72\xef\xf2\x4d\x32\x49\xa2\x4f\xe1\x87\x62\x94\xf1\x57\x82\x23\xa8\x1b\x56\x44\x62\x2b\x70\x32\x76\xc7\x02\x61\x7e\x76\xf6\xb3\x29\xb2\x0f\xaa\xa4\xdc\xea\xee\x3a\x2a\x31\xc7\xbf\x4b\xa4\xa4\xa7\x28\xf3\x6e\x57\x91\xdc\xce\x81\xfe\x8a\x0c\x22\x64\x49\x57\x6d\x20\x27\x1a\x3d\xe1\xce\xae\xc6\x54\x7a\x41\x8d\x75\x89\x32\x0c\x1e\x6e\x02\x55\x09\x1b\x0f\x90\xc8\x8e\x6e\x80\x39\xfd\xee\x62\x6e\x22\x02\xf9\xa3\x7d\x97\x21\x12\xa8\x25\x52\x7b\xa7\x14\x0f\x34\xde\xb7\x71\xb9\x4a\xd4\xfe\x1b\x1e\x03\x87\x92\xe5\x25\x1d\x69\xf9\xa9\x74\x38\xf3\x6d\x0a\x63\xff\xbb\x71\xb0\x66\xa2\x80\x5f\xe9\xd2\x3b\x1f\x78\x7f\x5f\x30\x66\x6b\x05\xb9\x0e\xb5\x27\x3f\xb8\x2a\xa1\x97\xaa\x06\xa6\xf6\xe5\x0a\xb5\x40\xd5\x17\x7b\x7e\x0f\x35\x5e\xd2\x29\xab\xec\x21\x87\x9b\x7f\xd0\x29\xc3\x52\x7f\x89\xf7\xb5\xd9\x6b\x9a\x85\x81\xb2\xa8\x1e\x73\xa1\xd2\x64\x28\x55\xdc\x77\xc7\x60\x67\x12\x33\x5f\x25\x7b\x88\x52\xd2\x12\xb5\xe4\x70\x13\x1d\x41\xa1\xa2\x93\xeb\x34\xb3\xbb\x3c\x0d\xf2\x5f\xba\x49\x27\xca\x95\x58\xcc\x61\x10\x1c\xda\x60\x07\x7b\x18\xbe\xd1\x8d\x7f\x89\x9d\x64\x8a\x9f\x98\xf5\xca\x97\x78\x9e\xd2\x96\x4e\x0e\x0e\xaa\x4c\xad\x1a\x06\x24\x44\x2d\x29\xab\x08\x72\x8f\xdc\x17\x1f\x0b\x1a\x03\xc3\x96\x10\x3c\x14\xe5\x4b\x48\xc3\x80\xf3\x6f\xe6\x8e\x78\xd0\x09\x46\x48\x65\x0c\x42\xd2\xf7\x16\xd2\xaf\xe6\x6c\x35\x38\x91\xad\x2a\xc6\x57\x61\x4d\xf2\xf0\xee\x70\x82\x3b\xea\x03\xaa\x0e\x99\x69\x0d\x06\xac\xcc\x0c\x59\x91\x24\xc3\x29\x45\xee\x26\xd5\x0d\xda\x77\x10\x05\x52\x76\x10\x0f\x03\x23\x15\x89\x38\x00\xff\x0e\x11\xbb\x38\x74\xa0\x77\x15\xe2\x94\xd0\x18\x73\x59\x32\x88\x1d\x30\x0b\xcd\x12\x05\x86\x11\xe1\x65\x8d\x33\xdd\xe1\xb8\x67\xd3\x74\x05\x13\xd4\xc6\x56\xfd\x24\x0d\x5f\x3d\x6a\x26\x11\xa8\xb2\x6b\x7c\x36\x32\xc7\x4c\x8e\xaf\x80\xc4\x75\xc7\xe1\xd6\x64\x3b\xa8\x9b\x6f\xea\x2c\x96\x44\xad\x3d\x93\x88\xb3\x45\x2d\x93\x0b\x6f\x48\x26\xe8\xf8\x9e\xc1\x57\xf6\x78\x24\xcb\x98\x60\x9c\xfb\x77\x90\x91\x91\xe9\x6f\x8b\xc6\xa1\xc2\xc6\x08\xbb\x60\x65\x8f\xa4\x75\x7f\x1a\xf3\x15\xc7\x40\xd7\xec\x5c\x48\x5b\x7d\x71\x53\x48\xd1\x8e\x2b\x87\xdf\xa0\xa0\xd8\x17\x7d\x36\x3f\xcd\xee\x73\x86\x29\x6c\xeb\xb1\x10\x83\xc9\xe0\x4b\xdf\xc7\xb5\x69\x82\x94\xf9\xf9\xd1\x47\x0f\xdb\x62\x10\x8b\x47\x2e\xfe\x01\xe7\xfd\x3d\x5e\xc6\x9d\x7c\x8d\x25\xf9\x96\xf9\xa3\xdc\x61\xd1\xea\x1d\x68\xb6\xcd\x91\x6f\x79\x6c\x79\xac\x7f\xae\x4d\x69\xd9\xb4\xc2\x04\x54\xc9\xda\x27\xa1\x18\x93\xb5\x60\xd5\x47\x05\x7a\x7a\x9e\x18\xc9\xa0\x15\x17\x3b\xdc\xbf\xd6\xac\x69\x8f\xaa\x7a\xd4\x80\x3d\x23\x60\xcb\x0a\x95\xa1\x8b\xcc\x45\x57\x7b\xc2\xb4\x1a\x52\xc8\x06\xb6\xbe\x48\x82\xa9\x6d\x7a\x17\xb2\x9c\xff\xa9\x6a\x7d\xcd\x56\xaf\x7f\x24\x55\x6d\xfd\xe2\xd2\xa8\x07\x81\x23\x61\xda\x89\x92\x68\x72\xf2\x65\xa3\xdb\xe3\x6b\xeb\xa8\x17\x79\xab\xe2\x11\xcf\xa9\x59\x08\xd2\xa6\x58\x42\x85\x85\x5d\x49\x92\xef\x43\x63\xed\xf4\xaa\x9d\xf7\xb6\xc7\x94\xdc\xaa\xe8\x9f\xec\xbe\xcb\x6f\xb6\x65\xfa\x96\xbd\xcb\x7b\x46\xe8\x2e\xbb\x60\x31\x7c\xa3\xf9\xa9\xee\x5b\x17\x58\xdf\x89\xff\x4d\xe2\x2f\xff\x2d\x3b\x34\x1d\x3a\x66\x35\xb8\x4a\x5a\x4c\x34\x1a\x76\x01\x8a\x23\xcc\x2e\x7d\x74\x87\xbb\x3a\x45\x89\x05\xf4\xf0\x10\xfd\xad\x45\xc6\x5d\xbb\xf5\x44\x26\xbd\x40\x90\xff\x56\x48\xc5\x05\x9a\x48\x94\xea\xd6\xe7\x70\xca\x2c\xea\xcc\x95\x08\xa2\x6c\xb0\xb4\xc7\xe1\x0f\xe1\x9f\x6e\xce\x75\xc6\x1e\x99\x0d\x71\xe3\x81\x01\x93\xb1\x9e\x03\x7e\xba\x21\x86\x37\x5c\x5d\xbd\xc9\x31\x2f\x25\x3a\xe5\x53\x93\xe8\xd9\xae\x4a\xb6\x96\x70\xcb\x50\x18\xcd\x65\xf2\x4c\x74\x3f\xd5\x83\xfd\xfa\x4b\x0b\x6d\x9c\xb6\x68\xd6\xb6\xdd\x47\xce\x1e\x23\x25\x95\x9d\x57\xdb\xdf\x62\x91\x7d\xa6\x4a\xee\x55\xf5\xef\x75\xce\xe5\x5f\x41\xb4\xab\xc2\x07\xf4\x6f\xaa\xe6\x83\xc4\x06\xc1\x69\xdf\xdd\x9e\x94\xcc\x7e\xd9\x50\xc4\xdf\xfe\xc6\x63\x9a\xe0\x3e\x9b\x9d\x08\x86\xa6\x8a\x4b\x84\x5e\x2e\xa8\x67\x08\x0a\xab\x12\xfe\x9d\x5d\xac\x26\x57\x5c\xf2\x6d\x21\xac\xab\x1c\x3f\x1f\xde\xd5\x30\x69\x37\x29\x52\x0b\x20\x22\xce\x57\x16\x8c\x6f\x1c\x5e\x12\xe4\xeb\x88\xb5\x2b\xd2\xb5\xcf\x75\xe3\xe7\xf4\x0d\x6b\x11\xab\xff\x0c\x8a\x0d\x5e\x58\xfb\xdd\x90\x68\xfb\x9b\x7f\xe9\xd5\x9f\x56\xcc\xb6\xe8\x48\x1c\x2b\x40\x8d\x5c\xef\x3b\x8b\x75\xfe\xf3\xa2\xf6\x32\x1a\x2d\x52\xde\xcb\xa6\x4a\xe8\xdb\xd0\x92\xbf\x41\x64\xc5\x62\x39\x2e\x77\x88\x60\xd8\xa9\xc0\x81\x24\x79\xd6\x54\x8e\x88\xee\x51\xcc\x92\xb8\xd3\xbb\xbb\x72\x0e\x8c\x18\xc4\x55\xb4\xd6\xa3\xaf\xce\x63\x8c\x8b\xe2\x73\xd8\x17\xff\xcf\xb1\xb2\x77\x13\xa0\x92\x8c\x6f\x1e\xda\xe9\xe6\x4a\x54\xd1\x1b\x1b\xe2\x0a\x09\x6e\xdc\x79\xc1\xf0\x48\x7d\x2e\xf8\x3c\x01\x6b\xd8\x1b\x91\xb2\x2f\xe4\xd5\x79\xac\xa4\xb4\x1e\xb4\x2e\xd2\xd5\x9c\x5a\x82\x4d\xc6\x4e\x2f\xe5\xfe\x32\xe0\x47\x72\x2f\xc6\xad\xd6\xd2\x3a\x74\x3a\x88\x03\x14\x37\x55\x27\x12\x16\xeb\x34\xf2\xab\xfb\xd2\xd9\xfd\xcf\x03\xd7\xfd\xee\xb8\xf0\x0b\x7e\x9c\xfb\x0d\xdb\x54\xe0\xdc\x27\xac\xd5\x37\x1c\xaa\xea\x49\x3b\xfc\xa4\xb5\xe8\x26\xf3\xee\xf4\x38\xa5\xb6\x37\x1c\x03\x9f\x2f\x07\x77\x77\x64\xaf\x0d\x73\x0d\x80\x07\xbb\xd9\x91\x07\x66\x33\x12\x39\x39\xb4\x8e\x29\x5e\xbf\xee\xd4\x9c\x5a\x89\xd8\x64\x2d\xf9\x7a\x36\x99\xd8\xf4\x68\x36\x08\xfa\x68\x8d\xc2\xe0\x6a\x0e\x08\x0f\xb8\xd6\x01\xef\x4c\x4e\x34\x87\xc5\x53\x99\x66\xef\xaf\x1f\xf2\xec\x06\x73\x2c\xc2\xd4\x38\x77\x56\x95\xd3\x6b\x5f\xff\xc4\x10\x1d\xda\xd5\x57\x0e\x25\x82\x5f\xbe\xbf\x17\xf5\xa7\x48\xd5\xa0\x5f\x73\xc4\x1e\xf7\x44\xea\x8b\x34\xe8\xa9\x85\xa0\xdd\x22\x59\x3c\x77\x60\x36\x14\xaf\xbe\x5e\x2d\x9c\x61\x9a\x7f\x68\x44\x78\x94\x38\x8e\x56\xd4\x54\x73\x7f\x1c\xc7\x68\xe7\x44\xb8\x3c\xd9\x7e\x7d\x53\x30\xf0\x97\xf0\x5a\xb1\xbd\xaf\x99\x41\x60\x0e\x78\x5a\xa8\x3e\x19\x18\x8b\xee\xb4\x8d\x57\xce\xe0\x54\xa8\x46\xaf\xcc\x8f\x76\x4a\x25\xe7\xf5\x93\xee\x36\x33\x3a\xd8\x19\x60\x94\xd0\x8e\x24\xaf\xfd\x82\xf5\x26\x7f\x18\x4f\xd0\x90\x93\xe4\x08\x4c\x4e\x9a\xe4\xae\x62\xe6\x82\x77\x5d\x00\x26\x88\x9f\x06\xda\x53\x88\xd3\xd3\xa4\x0f\x0c\x6e\x19\x60\xc9\xac\xbe\x37\x67\x34\xbf\xb4\x7e\xe8\x10\x11\xfb\x39\xe2\xc8\xa8\xd9\x99\xca\xc3\x52\x3d\x73\xf5\x0a\x97\x42\x89\xed\xf0\x12\x4b\x7e\x15\x89\x7a\x51\xa1\xde\x05\xe4\x81\x8d\x02\x1f\x51\x8e\x92\x0f\x56\x11\xea\xe5\x54\xf9\xe5\xba\x55\xcc\xce\x47\x86\xab\xb7\x5d\x1c\xba\x6c\x5e\x26\x7c\xc7\x73\x9d\x23\xfe\x1a\x2c\xb9\x74\xb9\x5d\x66\xfb\xb7\xbf\xa5\x6d\x5e\x7b\xa4\xfc\x6c\xff\xd5\xaa\x01\x54\x42\x18\xe1\xe4\x8b\xd1\xfe\xa3\x37\xad\x37\x6a\x3b\x6f\x57\x76\x3b\x77\x2e\x53\x7c\x41\x1e\x17\x1e\x89\x3b\xa4\x72\x03\x8f\xce\x06\x86\xd2\x3e\x0b\xe9\xea\x52\xc1\xf2\x7c\x60\x80\xe6\x9f\xbc\xf7\xd8\x9a\x7d\x85\x05\xbf\x00\xbb\xcd\x37\x6c\x81\x23\x11\x20\x6f\x82\x4d\x18\xca\xd5\x09\xfd\xe7\xe0\x35\x8f\xfc\x3d\x2e\x6d\xfc\x99\xdf\x20\x62\xff\xb2\x6d\x9d\x72\xc7\x82\xb2\xc9\xab\xaa\x2f\xb2\x92\xc0\xa5\x62\x5d\x7e\xb5\xa5\x12\x7b\xe2\x10\x83\x72\xa5\x00\x4c\x3c\x41\x10\x4e\xef\x90\x79\xc6\x9c\xe9\xcf\xfa\xf2\x61\xb3\x24\x1f\xd4\xf4\x31\xc8\x66\x0f\x5f\xe9\xf6\xf7\x85\x71\x84\x69\xb3\x37\x17\x20\xd1\x6c\xa6\x8d\x91\x33\xc6\xb8\xc9\xab\x6d\x6d\xda\x78\xc0\x48\xd3\xda\xde\x92\x4b\xfb\x41\x1e\x65\x4f\xf2\x9d\xac\x97\x3d\x64\xc0\x63\x77\xdf\xc5\x45\x32\x41\xac\xcb\x3e\xc1\x32\x6d\xfa\x17\xcb\xfd\x3b\x49\x58\xb2\x20\xc2\x5e\xa3\x02\x74\xaf\x4e\x53\x35\x29\x49\xc3\x5d\x44\xe0\xb5\xbd\xd8\x65\x40\x4e\x2c\x62\xcd\xbf\x9b\x8e\x8f\x46\x81\xce\xc1\xac\xf8\x04\xa1\x0e\x19\xb0\xf0\x53\x8f\xf6\x07\x8c\xc3\xb0\x70\x98\x2e\x3a\x0a\x17\x8a\x61\x8c\x82\x05\xb7\xe7\xdc\xae\x45\x8d\x19\x9f\xb9\x9e\xd7\x6d\x6f\xba\xaf\xf9\x4a\x49\x25\xf2\x4e\x52\xc5\xa6\xfe\xb9\x15\x47\x5d\x85\x13\x87\x13\xf5\x8a\x60\x27\x4d\xd7\xc3\xfe\xfd\x75\xcf\xde\x51\xc3\xc2\x2e\xee\xfa\x0f\x8e\x1f\x67\x1f\x36\x73\xda\xc0\xda\xa4\x1c\x22\xf0\x5d\x01\xcd\x7c\xae\x93\xfd\xef\xb3\x31\x5e\x9e\x5f\x32\xb3\x66\xfc\x35\x7d\x29\xef\x83\x4a\x09\x43\x96\x8d\x5a\x70\x46\xe7\x40\xf7\xd5\x8e\xe0\x25\xf2\xd1\xd7\x68\x13\x59\x93\xb7\xc1\x6c\x07\x12\xe9\x69\xc5\x70\x31\x37\xfd\x66\x79\x8e\xa4\xba\x6a\xc8\xa0\x70\xc0\xe2\x88\x4d\x4a\x4a\xd9\xcd\x42\x5a\xf8\x7d\xd9\x63\x68\xb9\xbe\xe7\x7d\x74\x07\xc1\x71\x34\x42\x06\x8c\x3f\x06\x88\xa2\x8a\x8f\x2c\x09\x66\x67\x93\x4e\x94\x03\x9f\xbb\x02\x8a\x19\x5f\xa9\x06\xfa\x12\xe9\xf1\x51\x9a\x30\x6b\x41\x92\x82\x25\x0a\x17\xbf\x41\x94\xf6\x61\xd2\xf1\xc2\x6f\x41\xd2\xc5\x75\x71\x0b\x4f\xc1\x37\xb7\x73\xd4\xf6\x24\x72\x82\x4e\xdf\x75\xae\x87\x3a\x64\x3b\x2d\xb3\x9f\xe1\x20\x33\xdd\xde\x15\x9f\xa1\xcd\x52\x77\xfe\x50\x9b\x7d\xbb\x86\x12\x27\xcf\x43\x79\x35\xb9\x95\x61\x0a\x21\x4f\x38\x20\x66\xe1\x6f\xc2\xa8\x0d\xfe\x51\x96\xa1\x78\x6a\x88\x85\xbe\x33\x32\xa8\x38\xb3\xdd\x17\xf8\xa1\xed\x37\xa3\xe1\x94\xec\xa1\x22\x46\x0f\xc0\x88\xe7\xd1\x75\xe8\x06\x55\x7e\x3b\x29\x5f\x9d\xcd\xdd\x32\xbd\xd1\x42\xe4\x8c\xc7\xc9\xa2\x9e\x11\x00\xed\xcf\xf8\xa5\x5f\xee\x36\x0c\x30\xd3\xcf\x24\xe8\x81\x41\x4d\xa5\xd2\x35\x87\x4c\x70\x58\x28\xde\x65\xd8\xe6\x2e\x95\xd5\xc3\xd6\xac\xf9\xad\xaf\xb3\xf2\x0a\x2a\x7e\x46\x6b\x8f\x25\xdc\xb9\xd0\xfe\x76\xe4\x24\xca\x0f\xd6\x45\x0f\xce\x15\x65\x76\x3d\x89\x0c\xa2\x9f\x2e\x60\xb4\xe7\xc5\x42\x6b\x04\xef\x45\xe2\x9f\x2f\xbb\xc1\x8c\x83\x7d\x39\xa5\x1b\x7f\x43\x45\xa8\xd9\xfb\x9e\xb0\xd3\xe0\xef\xf4\xcb\xef\xf3\x70\xe4\xdc\xc1\xd3\x15\x45\x50\xbe\xae\xfe\x3a\xe4\x9b\x46\x1a\x92\xe4\xee\xf8\xae\x54\x8f\xb0\xe9\x2c\xcf\xea\xa8\x2e\xff\xad\x0b\x73\xcf\x65\xdd\x26\x13\x3f\xd7\x55\xe3\xcd\x8a\xcb\x7d\xb9\x68\xd3\x04\x66\x9e\xe9\x75\xa2\x28\x3a\x4d\xbb\x87\x56\xf0\x47\x4e\x7c\xf3\x6c\xff\xe1\x47\xb3\xce\x59\x6b\xf1\xc7\x9e\x66\xd3\x9f\xe5\xf4\x75\x41\xb6\xa6\x5e\xa1\x4b\xb6\xf1\xe6\xfa\xc6\x67\xee\xec\x93\x9a\xbc\xe5\x25\x01\x9f\xc7\x0b\x73\x8b\x04\x13\x17\xb1\xe6\xad\xa3\x9c\x65\xbb\x83\xa3\x02\xc2\xb4\xcd\x39\xe7\xfd\x3e\x64\x94\x47\xb3\x82\x3e\x8a\xd8\xec\x28\x08\x09\xc1\xc8\xbb\x0f\x76\x8e\x56\x4c\x74\x27\x07\xde\x3d\xd9\x62\x4c\xec\x15\xa8\x8b\x1e\xad\xea\x06\x03\x2b\x87\xae\x14\x25\x06\xcf\x98\x56\xf3\x56\x5e\x66\xaf\x82\xdb\x9e\x7a\x7b\x9e\xf2\x5c\x8d\xfb\x85\x52\xd5\x72\x2e\x01\xd4\xb3\xae\xbb\xab\xd3\x64\x37\x14\xc6\x4b\xbd\x0e\x2a\x88\xdf\x72\x47\x73\x65\xea\xee\x6d\xb3\x04\x3d\x0c\x78\xdd\xfb\xcd\x81\xa1\x97\x23\x40\x1d\x01\xc2\x15\xf7\xec\xf7\x7d\x9b\xfa\xfe\x92\x9f\x0b\xf4\xdf\x7f\x95\x33\x7d\x6e\x44\xe7\x3f\xae\xe4\x75\x5b\x7d\x66\x6d\x84\x08\x78\x20\x09\x97\x1e\xdb\x16\x61\xd9\x65\x38\x3f\x3d\x08\x6e\xe2\xe4\x2a\xd6\x8e\x4d\x3a\x30\x68\x00\x9a\x92\x57\xcf\x27\x72\xbd\x11\xa9\x79\x69\x40\xec\x37\xb3\x1c\x15\x4b\xf8\x61\x64\xc0\x65\x9e\x53\x47\x19\x38\x16\x61\x19\xdd\x19\x25\x62\x75\x72\xf2\x4a\x97\x75\xa2\x2b\x19\x7b\xf7\x08\x15\x1c\xc1\x5b\x91\x8e\xf8\x8c\x09\x1e\xd7\x63\xca\x93\x8a\x1f\xcc\xd5\x59\x01\xcd\xbe\x36\xc5\xa8\xdc\x5e\x2d\x56\x57\x35\xb9\x59\x12\x48\xef\x29\xbb\x2f\xdb\x26\xdc\xf7\x83\x12\xab\x64\xca\xa7\x7a\x9b\xc1\x7e\x08\x54\x22\x59\x41\xe6\xec\x17\x05\x2e\xf4\x75\x22\xdc\x71\x65\x44\xc1\x22\xcd\x6b\x50\xb3\x81\x44\x5b\x82\xe2\x75\x89\x02\x1c\xc8\x6e\x1e\x6d\x2b\x8f\x43\x24\x5d\xd6\xcd\x40\x7b\x29\x24\x30\x6f\x68\x21\xb6\x54\x65\x94\x2a\x66\x19\x81\xab\x8c\x4d\xf3\x4f\xe9\xcf\x53\x88\x67\xf6\x11\xfb\xae\x23\xec\x14\xfd\xcc\xe3\xbe\xc0\x48\x12\x6d\xbb\x9b\x2a\x29\xf2\xe6\x09\xb6\x43\x25\x42\x89\x6d\xe9\x80\xcc\x19\xf3\x4e\x77\x4c\x1d\xfb\xbc\x6f\xfc\x83\x59\x10\xe9\x4a\x56\x22\xbf\x16\x9d\x98\x9b\xe3\x95\xd0\x27\xc4\x97\xfb\x6f\xe0\x0e\x2a\x28\x29\xe3\x09\x88\xfc\xa9\x7e\x7a\x59\x3a\x6f\x29\x9a\x2a\xe8\x25\x31\x7e\xc5\xda\xe5\xd3\x46\x1e\x0a\xeb\x35\x77\x25\x50\xf4\x86\xc2\x00\x80\xa3\x3d\x29\x8b\x3f\xaf\x4f\x64\xf3\x15\x09\x01\x47\x07\x82\x46\xdf\x41\xa4\xad\x6e\xb2\x38\x15\x50\xf4\xb4\x54\x41\x83\x45\x92\x4a\xa1\xb2\xc3\x10\x9a\xa9\xb0\xa9\xa5\xd1\x65\xbd\x2f\x69\xbb\x37\x49\x0c\x01\x94\x74\x09\x27\xcf\xe0\x74\xdf\x8e\x40\x19\x44\x6f\xcd\xc9\x20\xa0\xdf\x2a\x28\x03\x1e\x59\x54\xaf\x36\xed\xef\xdc\xaf\xee\xeb\xb7\x31\xac\xaf\x3e\x8f\x52\x8d\x33\x7d\x52\x96\xf0\x05\x35\x8c\x52\x45\xb1\xa1\x68\x65\xbf\xd3\xbe\x73\x8e\xc6\xf9\xc1\x7d\x1f\x69\x14\x07\xfa\xc3\x4c\x2c\x3e\x3e\xa8\xc7\xd3\xa4\xbc\x09\xe7\xb1\x8a\x7d\x20\xf8\xa3\x02\xc0\xe4\xe8\x1b\x47\x2d\xe6\xd6\xc1\x02\xbb\x2a\x3c\x2f\x69\xcb\xa7\x42\x6c\xcc\xd5\x31\x76\x36\x57\x22\xad\x8d\x36\xef\x39\x78\x65\x55\xc7\x6f\xef\x45\xc3\x48\x0b\x47\xa4\x6c\xd9\x70\x17\xd7\xa9\xa8\xcf\x46\x77\x9a\xa6\xc2\x32\xb3\x9b\x45\x29\x03\xff\xc3\xc5\x3f\x69\xf8\x90\x56\x27\xe2\xd9\xdd\xac\x59\x59\x49\xd1\x9c\x5d\xa6\x22\xa4\x6b\xc2\x34\x3f\x49\x21\x6e\xa2\x8f\xb1\x1a\x9b\x57\x6a\xf9\x85\x96\x16\x93\x79\x02\x8e\xba\x9a\x8d\x4b\x3e\x43\x27\x2a\x5b\x24\x86\x82\x78\x13\xb6\x2d\x9b\x7c\xce\x21\xf7\x2c\xab\xf7\x59\xf2\x0a\xdc\x37\xcb\x33\x94\xfd\x13\x7d\xa0\x2b\x31\xc9\xb3\xa4\x53\x6f\x42\x76\xa5\x94\x01\x36\xb6\xa9\xfc\x1f\xbc\x93\xd9\x06\x16\xc5\x98\xcc\xd7\xf7\x08\x9d\xbf\xac\x9a\xaa\x5b\xa2\x6e\x10\xee\xfb\xe7\x47\x94\x72\x92\x03\xb2\xe3\xfa\xea\x04\x7f\x32\xad\x3c\x08\xcd\x9a\x37\x0d\x86\x6d\xb1\x66\xdc\x1b\x43\xe3\xff\x8e\xf7\x96\xfd\x2c\x6c\x14\x27\xbd\x28\xd5\x80\x07\x77\x10\xe6\x52\x11\xe6\x8c\xe6\x1f\xf8\x02\xa7\x92\x91\xac\x5c\x63\x01\xda\x59\x0b\x20\x25\xea\x06\xc4\xfb\xfd\x0c\x88\xda\x5f\xfc\xd8\x8c\x2e\xe1\xe0\xea\x04\x11\x38\x42\xdf\x41\x57\x7a\xc5\x0e\xbb\xb3\x0b\x13\xf0\xc3\xd8\xcd\xd7\xee\xf5\xa3\xfd\x41\xe5\x51\x1b\xb9\x83\x71\x14\x8a\xdb\x80\x54\xe1\x22\x7a\xcb\x7d\x2b\x05\x8f\x66\x59\xcc\xce\x6a\xd9\x50\x9e\x93\xea\xd0\xb5\xc6\x0f\xad\x99\x27\x1e\xdf\x5d\x9f\xf1\xb6\xda\x7d\x6e\x37\x4e\x69\x79\x23\xc8\xf5\x0f\xb9\x61\xa6\xf3\x10\x83\x14\xda\x6f\xbc\x86\x5b\xad\x30\x2a\x65\xfc\xc6\x1c\x2d\x06\x55\x67\x9f\x92\xf9\xce\x34\xfc\x7b\x53\x1a\xd1\x9a\xf3\x47\x45\x60\xf2\x3c\x54\x47\x34\xaa\x23\xd4\x4b\x52\x8a\x9c\xb3\x25\x3a\xe6\xf9\xdc\xdc\xe0\xb7\x2b\xad\x34\xa0\xcc\xc9\x68\xbc\xf5\x2e\x7c\x7f\xb0\x43\xed\x29\xeb\x9f\x11\x1e\xf8\x7c\x18\x4c\x70\x73\x80\x5e\xbc\xff\x06\x2f\x1e\x63\x19\x60\x21\x45\xae\x20\x81\xbd\x92\xa0\x91\x4b\x14\x46\x7b\xe6\x29\x6a\x3a\x3c\xde\x0b\x3a\x00\x96\x2a\x5b\x5c\xcc\xdf\x2c\xe5\xd4\x6e\x60\x47\x78\x41\x7f\x01\xf6\x0d\x12\x44\x35\xe0\xfc\x55\x09\xb1\xb2\xee\xc2\xae\x06\x59\x07\x97\x39\x42\xb2\x5f\x37\x7f\xbf\x22\xc5\xb8\xaf\x6e\x91\xf8\x28\x38\x77\xf9\x2e\x1d\xac\xe8\xf8\x4d\x63\x45\xf4\x22\x71\x50\xff\xc1\x87\x87\x1a\x64\xc0\xd9\x49\xe2\x3b\x6f\x97\xfc\xf8\xed\x08\xe1\x97\x92\xbc\xbc\x0c\x17\xc4\x58\x72\x99\xd5\x24\x6a\x1d\xc6\xe3\x19\x22\x39\x8d\x1a\x1c\x26\xb4\xdf\xe8\xc1\xb5\x10\x29\x7b\xf7\x3d\x4e\x67\x76\x18\xa4\x6f\x44\x99\xae\xe4\x58\xed\x9b\x2b\xb6\x1b\xf3\xfa\x2f\x2f\x3e\x9d\xd4\x1e\xa1\x4d\x92\x62\xe9\x70\xb9\x3a\x16\xcd\x5c\xef\x92\x53\x13\x8a\x92\xf5\xf5\x79\xba\xc0\x78\x7f\x26\x42\xe7\x3f\xd1\xd8\xc3\xa5\xdf\x27\x45\xa3\x1a\xa2\x21\x1b\x89\xa9\x65\x1b\x52\x42\xa4\x0a\x58\x5e\xa7\x74\x3f\xe7\x0f\xd7\x3f\xa0\x93\x98\x66\x0b\x61\x2f\x9c\xdb\xf3\x15\x9b\xb1\x6d\xe2\xb7\xf9\x34\xc4\x7e\x47\x15\x38\x68\x55\x90\xbe\xe1\xcd\x3c\xc9\xe1\x6d\xe6\xaa\xee\x36\x13\x9c\x63\x39\xb7\xb9\x06\x1f\x5d\x36\x5d\x86\xf2\x7a\x07\x6b\xe9\x35\xbe\x79\xda\xc5\x6c\xca\x5a\x82\x32\x51\x3c\x3b\x30\x6f\xe6\x7f\x3e\x8f\x59\x6e\x72\xa0\xd6\x23\x94\xca\x9d\x1a\x86\xa1\x40\xf3\x79\x99\x42\x3a\x32\x49\x98\x64\xb3\x37\x69\xe5\x89\xf3\x33\x82\xb7\xd8\xda\xb6\x6e\x5a\x39\x32\x75\x1b\x10\xaf\xed\x3b\x2e\xaf\xf2\xc6\x21\xad\xbb\x25\x30\x5f\x75\x3f\x7d\x60\x65\x32\x55\x8f\x68\xeb\x5e\x3b\xdb\xe2\x25\xd6\xff\x3a\x66\xef\xc8\x3a\xe8\x9d\x20\xb6\xf1\x9e\xa5\xf4\x17\x77\x0a\xd3\xe7\x62\x56\xfb\xc5\x7f\x9a\xd0\x1a\xd9\x53\xba\x73\xc0\x2e\x87\x5c\x4e\x79\x33\x9a\x96\x01\x22\xcd\xec\xbe\xa1\xf6\x48\xa9\x7e\x3a\x6c\x47\x0a\xcf\xab\x54\x2d\x24\xe9\xc0\xe3\x02\xc1\x82\x60\x5b\x7d\xe5\x62\x0c\x3e\xe2\xa2\x5f\xb2\xe3\xf7\xeb\xb9\x2e\x15\x5c\x14\xa4\x70\x3e\xf7\x5e\x4f\x40\x0d\xa7\x96\x63\x43\x28\x1e\xa9\x61\x9d\x43\x07\x45\x2d\x2c\xfa\x42\x4d\x2d\x28\x50\x19\xc8\xe6\xd6\x46\x33\x98\x1c\x46\x50\xf1\x56\x71\x69\xf3\x65\xa7\xe0\x9c\x62\x3d\x6c\x0f\xf6\x0e\x83\xbf\x11\x4b\x52\x96\xcd\x0b\x2e\x04\x6e\xd3\xe8\x1e\x4d\x0d\xa6\x30\x41\xef\x4f\xee\xd7\x70\x4e\x3f\x4d\x0e\x00\xf1\x2d\xef\x5f\x31\xb6\x02\x6e\xeb\x7b\x17\xfa\x42\x7c\x85\xe6\xfb\xaf\x8c\x12\xcb\x10\xfe\x87\x17\x4f\x7b\x04\x73\xf6\x73\xee\x2f\xcb\x7e\x1c\x41\x1d\x73\xab\x84\x54\xdd\x59\xe7\x7d\xcf\x9f\x6f\x69\x17\xa7\x8a\xcb\xdd\xcd\xa6\xe7\x71\xfd\x02\xa1\x3a\x10\x54\xe0\xee\x9d\xf1\xa7\xa4\x4b\x50\xcd\xf3\x98\x48\xf6\xf5\x72\xe9\x05\x98\x6e\xa4\xf3\xd3\x29\xf4\xb2\x65\xa1\xc0\x5c\x0a\xd6\x43\xcb\x50\x37\x56\x7c\x69\xe7\x8b\x79\x38\x87\xee\x4f\x3f\x50\x2a\xf6\x23\xc1\xa3\xaa\xce\xbe\x9b\x40\x5b\xcc\x95\x47\x39\x5f\x99\xfd\x5c\x3a\xa6\xfa\x1a\xc2\x75\x30\xfe\xf0\xc1\xe1\x6f\x8c\x0f\xc3\x02\xc9\xed\x93\xeb\xf1\xb6\xfc\x5e\x09\x42\x7b\xa1\xee\xd2\xaf\xf7\xdd\x6a\x1b\xea\xee\xa2\x99\x75\x5b\x9e\x02\xed\xc7\xab\xfc\x78\xe2\xb7\xcb\x38\xd3\xea\x70\x0b\x91\x7d\x9c\x21\xee\x9f\xaa\x28\x52\x7b\x98\xc0\xe6\x49\xf1\x7c\x16\x1e\x85\x24\x2c\x11\x39\x1f\xa2\x9d\x40\xb9\x1f\xdb\xd1\xa1\x10\xb5\x84\x55\xa8\x60\xba\xbf\xf4\xa1\x93\xa9\x34\xd9\xbd\x57\x36\x23\xdd\x78\xce\xf4\xfc\x8f\xde\x8e\xce\xed\x7f\x00\x26\x80\xfe\xb7\x13\xde\x56\xce\x69\xa1\xb7\x67\x48\x23\x47\x0f\x15\x29\x5a\x3f\x6c\xf6\x0d\x95\x5c\x86\x81\x2f\x5b\x93\x1f\xfb\xc9\xf6\x63\x47\xd7\x1b\x02\x04\x76\x68\xaf\x5d\x7f\xf4\x63\x00\x21\xb2\x53\xc4\x04\x36\x2e\xa1\x33\xf7\x6a\x4b\x3c\xa6\x99\x8b\x10\x8f\x66\xda\x3f\x0d\xfd\xf2\xea\x99\xdf\xf5\xd5\x29\x30\x47\xef\x8d\x33\xf8\x54\xa6\x09\x2b\x52\xe6\x07\x70\x5c\x19\x8a\x36\x6c\xf3\x61\x2d\xe9\x9f\x8a\x56\x29\x64\x45\xbf\x13\xc0\xd3\xb6\x14\x42\x58\x49\x79\xea\xf9\xf9\xcb\xac\x34\x4b\xb6\x6f\xbb\x0b\xab\x3d\x4f\xb9\x85\x6f\xae\xd4\x76\x00\x65\x2b\x36\xe0\x11\x1a\x6b\x9f\xa7\x41\x39\x43\xf0\x89\xc2\x8c\xf2\x39\x99\x15\x7b\x1e\x87\xde\x43\xc8\xe8\xda\xe5\x57\x16\xe9\xa9\x6f\x4b\xfb\x1e\x51\x86\x1e\x9e\x8c\xaf\xfd\x6b\x4f\x2d\xb4\xa6\xeb\x45\xfa\x21\xab\x13\x68\x9b\x92\x73\x3f\x7e\x3d\xbf\x68\x05\x99\x68\x27\xdb\x29\x03\x42\x4e\x12\x51\x76\x09\x34\x49\x8e\x8d\x4b\x18\xa1\x2a\xe7\x25\x11\x91\x21\x0a\x83\x30\x81\x50\x72\xb8\x8f\x1c\xcb\xb0\xa6\x8c\x2c\xc6\x68\x21\xfb\x15\x27\x89\x21\x50\x83\xe9\x2b\x15\x29\x6a\xdf\x8f\x93\x7e\x5d\xeb\x84\x7a\x6e\x99\x4c\x81\xa0\xe7\xad\xe9\x0d\xdf\xdf\x8f\x22\xfd\x12\x7c\x3d\x91\xf7\xfd\x3b\xe4\xc7\x24\x13\xe9\x37\x54\xe9\x1d\x80\xf9\x0a\x6b\x55\x40\x91\xda\x99\xfb\xb4\xa6\x4f\xc2\x2b\xb5\x3c\x56\xc7\x31\x28\xae\x56\x56\xa1\x18\x15\x44\x57\x41\x94\xb3\xfb\xee\x36\x15\x1a\x22\xf2\xb7\xc4\x98\x81\xc3\x51\x1c\xcc\x3f\x12\x83\x5e\x4b\x74\x0e\x21\x4a\x09\x30\x5c\x79\xa9\xc7\xc1\x23\x68\x54\xd5\x53\x03\x94\xce\x68\x43\xee\x44\x8f\x35\x48\xca\xf9\xfd\xef\xbd\x66\x12\xa9\x63\x07\x6b\xd8\x27\xa9\xdf\x61\xe2\x7a\xbb\xed\x5f\xbd\x6d\xb7\x8f\x34\x6c\xb7\x25\x9d\xa1\x6d\x5c\x27\x56\x68\x5a\xcb\x68\x84\x54\x50\xea\x42\x61\x68\x74\x42\xc4\x24\x96\x65\x88\xcb\xe5\xbc\x20\xbb\xa1\xe1\x2c\x8c\x66\x9f\x58\x88\xbf\x62\x3e\x70\x0a\x0d\xf6\x80\x7e\x05\x8e\x50\x12\x7c\x61\x8f\x72\x4e\x3e\x8b\x7b\x6a\xcc\xaf\x42\xd7\x56\xe4\x54\x97\xa3\x3b\xac\xb3\x3b\xc9\x20\xe3\x5b\xc6\x1d\x40\x9f\xd3\x68\xf6\x34\xde\x54\x2b\x5a\xc8\xe6\x77\xd2\xa2\x68\xee\x51\xfd\x81\x49\x67\x99\x8e\xad\xb7\xd4\x8d\x62\x8d\xdf\x3e\x35\xa6\xe3\x55\x38\x33\x39\x9d\x7a\xa7\xbf\x45\x66\x1e\x96\x94\xe7\xdd\x15\x87\x55\x97\x1f\xf5\x59\x98\x36\x81\x6f\x3f\x21\x2b\x2e\xb0\xb4\x78\x5c\x3a\x21\x96\xdd\x0b\x8d\x85\x14\x09\x25\xfc\x37\x24\x95\xd4\xfd\x48\x08\x1d\xf2\x74\xb5\xaf\xe4\x30\xf0\x8e\x9c\x07\xce\x56\x6e\x50\x6e\x51\x44\x3a\x87\xa6\x53\xd4\xa9\x22\x82\xca\xb9\xc4\x03\x3d\x70\x90\x88\x3f\x81\x4f\x91\x4e\x00\x04\xe3\x3d\xe6\xcb\x24\xcb\x71\xa4\xcb\x3d\x91\x75\x4c\xfc\xc6\x24\x4b\x25\x63\xe5\xb5\x14\x59\x51\xee\x65\x19\xc4\xae\xc3\x6f\x42\x7b\xf8\x32\x30\x54\x02\x50\x23\x5a\x49\xae\x5b\x18\x8a\xa5\x7c\x9d\xb4\x4a\x44\x49\x96\x90\x5d\xf2\x17\xa6\xc5\x30\x0b\x48\xaa\xe0\x50\xa4\xbf\x69\x90\x2d\x41\xdb\x8d\xf9\x82\xbe\x5f\xaf\x28\x20\xb5\xee\xa4\x9a\x2d\x0d\xa1\x75\x06\xc5\xdf\xdb\x90\x84\x27\xe4\x7a\x09\x86\x55\x5c\x50\x51\xcb\x07\x18\x97\x7f\x98\x62\xd8\xe6\x4f\x70\xb6\x05\x67\x8f\x91\x3b\xc5\xf7\x9a\x66\x90\x82\xcc\x67\x57\xec\x7e\x5b\xf0\xe5\xe4\x32\x5e\x3e\x85\x4e\x63\x88\xc5\xd2\xe4\x94\xae\x9c\xb9\x75\x32\xc0\x74\x17\x96\xce\x92\x7a\x63\xa6\x8b\x18\x32\x42\x8c\xad\xf7\xf4\xfc\xa1\x5c\xf1\x44\x74\x37\x49\x19\xb2\x61\x6b\x87\x29\xd3\xf5\x4c\x73\xd4\x33\xd9\x30\xc6\xfa\xb8\x4c\xb1\x9d\x0d\xdc\xb6\x22\xb4\x6c\x75\x69\xcd\x59\xb7\x72\xbf\x41\xc1\x32\x1a\xca\x1e\x02\xa2\x2a\x30\x58\xa0\x0e\x57\xd8\xde\x46\xff\x51\xc8\xfb\x9b\xe6\xbd\xb4\x85\xdf\x9c\xcd\x25\xeb\xbe\x03\x58\x1f\x98\x4a\xd2\x88\x50\xd1\x9c\x8b\xf9\xd9\xfc\x7b\xfb\xd0\x61\x96\x1d\x2f\x8a\x76\x83\x31\xb4\x55\x4b\xd4\x60\x51\xcc\x96\x5a\xc3\xfe\xa1\xd1\x3e\xbf\xbf\xef\x8f\x3d\xa9\x38\xbb\xae\x0d\x21\x67\x5e\x73\x78\xf6\xc5\xcf\x84\x55\xea\xd6\x2e\x3f\xf3\xf2\x94\x1f\xda\x3d\xca\x1e\xf3\xc2\x94\x29\xea\x94\xb4\x63\x55\x0e\x6d\xbc\x8c\x1f\x3a\x52\x3d\x61\x14\xd4\x31\x23\xe8\xe1\xcc\x44\x61\x7e\x08\x69\x42\xc3\xbb\x3f\xfd\x46\x4b\x7b\x82\xb9\x2e\x45\xc8\x14\x5a\xfe\x7e\x40\xc1\x5a\x3e\xbd\x68\x0a\x16\x1f\x5f\x0b\x59\x69\x35\x39\x4e\x12\x7d\xbe\x42\x3a\x67\xf7\x88\xa4\xad\x17\xfe\x35\xfe\x7a\xba\x17\x72\x9b\x6b\xcc\x9e\xed\x6c\x66\xb4\xbc\x3d\x44\x59\xbb\x3c\x3f\xe2\xe4\xeb\x06\xaa\x82\x44\x1f\x29\x98\x83\x5b\xcf\x84\x17\xfa\x31\x64\xe1\x46\xcf\xbe\xe0\xc9\xa8\x94\x6b\x61\xe9\xa0\x52\x07\xc4\x9d\x78\x7d\x64\x65\x0c\x76\x92\xa6\x0a\x1f\x95\xb4\xa1\xf0\xb9\xd4\xa5\xc0\x4f\x26\x49\x68\xd1\x06\x07\x7a\xf2\x9f\x0a\x2d\x9b\x85\xa4\xed\x40\x44\xf8\x35\xf8\x50\xe3\x0b\xd1\xc8\xe8\xb8\xc7\xee\x2a\x12\x91\x97\xd2\xf1\x0f\x27\x00\x81\xad\x9f\x86\x4a\x74\xca\x39\xf9\xa5\xf1\xc7\x81\x52\x3c\x75\x4b\x92\xf8\x0a\x11\x2b\xaa\x7b\x10\x0a\x52\xe5\x8f\xc2\x6c\x25\x50\x84\x12\x24\x68\x51\x94\xca\xf0\xad\x94\x63\x2e\x8d\xfe\x87\xc9\x6a\x36\x3f\x5b\xc9\x57\x21\x09\x91\x79\xea\x96\xc3\x49\xc0\xb9\xdf\x4d\x80\x92\x25\xb7\xe0\x06\x40\x54\x72\xa1\xef\x52\xeb\x05\xf0\x06\x10\xcf\xf4\x65\x38\x3d\x16\xea\xb6\x92\x1a\x9e\x71\x55\x1b\xea\x99\x8e\x0e\x62\x15\x0d\x79\x4c\x5a\x62\xa3\xad\x71\xd1\xab\xad\x33\x51\xab\x4e\xd7\xc1\x72\x40\x5d\x82\x7a\x9b\x7f\x1c\x3c\xc9\xa5\x13\xd3\xda\x06\x0d\xf0\x3d\x21\x75\x2d\x15\x5e\xda\x9f\xe2\x81\x51\x19\xdc\xe6\xaa\x00\xac\x6b\x19\x28\x49\xe8\xb0\xcb\x31\x49\x5f\xda\x27\x2f\x58\x63\x82\xed\x99\xfa\x13\x76\xa5\x9e\x79\xc5\xe6\xaf\xcd\xe3\x97\xb0\x69\x77\x6f\x19\x32\x2e\xe7\xf9\xb2\x42\x71\x86\x5b\x2a\xc1\x1f\x54\x06\xd3\xfb\x25\xcf\xf3\x7e\xf9\xdf\xcc\x1e\xe0\x18\xbc\xbc\xf0\x91\xf4\xdc\x97\x2e\x57\xf7\x8b\xf7\x05\x3c\xf0\x11\x70\xfa\x7e\x63\xb6\x96\x92\x52\x55\x95\xc2\xc0\x0b\xcd\x96\x4a\x5e\x8a\x1f\xee\x11\xaf\xb9\xf5\x36\x56\xb9\x2e\x61\xd6\x12\xea\xbd\x7d\x8f\x26\x19\xba\xbc\x55\xdf\x18\x73\x64\x65\x77\x83\x82\xc8\xa0\x34\xec\xdb\xd5\xdd\xcd\x91\x66\xe7\x7a\x84\xe5\x42\x93\xb9\xf3\x2f\x8d\xf2\x97\x01\xe1\x3a\x03\x77\x33\x5d\x5e\x71\x5e\x29\x4e\xf3\x39\x63\x37\xbf\x27\x59\x86\x76\x61\x99\x05\x6a\xde\x71\x0a\x7b\xc8\xa5\x60\x4e\x77\x58\x72\x32\xa0\xc3\x45\xb0\x8a\xb5\x92\xff\xcd\xc5\x89\x68\x3f\xae\xc4\xde\x10\xa8\xb5\x9b\x3d\xbd\x4d\xc4\x34\x6b\x10\xb9\x5b\xba\x8f\xdd\x4f\x43\x10\xe8\xfb\xfb\x70\x15\x24\x9c\xe2\x9d\xbe\x35\x3f\xbf\xd7\x04\x57\xe6\xb2\x78\x8f\x61\xeb\xd2\xac\xff\xc0\x67\x63\x63\xdb\x0a\x5c\x72\xae\x31\x20\x77\x09\x29\x3c\xcb\x4d\xde\x12\x5c\xa6\x5e\x0e\xad\x61\xd4\x5d\xdd\xf5\x19\x91\xb5\x5a\x6c\xe1\xd1\x0d\xde\x6e\x6d\x1e\xaf\xec\x64\x3c\xd1\x6f\x34\xdd\x29\x6a\x6d\x32\x59\x44\x6c\x15\xb1\x6f\xdd\xec\x0c\x39\xb4\x38\xf1\x03\x26\x47\x10\xf5\x74\x73\x12\x5b\xaa\x5d\xa7\x9d\x7f\x4c\xa4\xb5\x08\xfd\x80\x74\x49\xd8\xd8\x36\xb0\xa9\x7d\x8b\x95\x07\x9a\xa0\x6b\xe3\x22\x42\x47\xb2\x12\x9d\xeb\x60\x34\xb5\xd8\xeb\x06\x3c\x97\x86\x18\x6f\xb7\xf4\x49\xec\x86\xc7\x9e\x2c\x97\x40\xce\x78\xd4\x74\xfd\xb5\xf9\x01\x30\xcf\xfc\x7d\x14\x29\x18\x8e\xf0\x05\x32\x57\x38\x04\x47\x5d\x16\x0f\x3e\x0d\x5b\x2e\xe8\x30\x3a\xba\xc5\x14\xbf\x39\x43\x4c\xf0\xf7\xa3\x7e\x42\x81\xad\xf9\x23\xdc\x53\xcc\x81\x1a\xe7\x86\x08\x65\x61\x5f\x91\x4d\xac\x38\x5e\xc4\xdf\x59\x4b\x63\x88\x40\x6d\xbc\x54\xd3\xd3\xc8\xc8\xf9\xf7\xb9\x8e\x2f\x84\xe4\x0f\xb2\xd6\x33\x79\x92\x29\x7d\x93\x51\xa1\xc7\xe2\xc7\x0e\xb1\x76\x6a\x59\x8b\xfb\x0a\xc0\xb5\x5d\xbe\xf9\xa6\x4c\xeb\xbc\x66\xa5\xc3\xe6\x56\x1d\x2c\x60\xb7\x12\xe5\xa5\xf6\xa3\xdd\xe8\xea\x14\x20\x20\xad\xf2\x6b\x48\x98\xa8\xac\x78\xf8\xbb\x75\x0a\x5b\xfa\x93\x1a\x7d\xd4\x60\xf1\x29\xd5\xc5\x12\xf3\x4c\x87\xc3\x68\x49\x41\x7f\xe3\x92\xd7\x5e\x04\x10\xa9\x38\xbd\xfb\x2a\x9b\x35\x04\xc0\xc3\xcc\x95\x4d\x5a\xf0\xe5\x3f\xca\xb5\xf7\x19\xdb\x42\xf2\x2b\x65\xf3\xd5\x61\x68\x09\xb8\xf2\x3d\xf8\x8b\x52\xfc\x83\x68\xb1\xde\x65\xe7\x9b\x8c\x74\x2e\xef\x89\x73\x31\x5c\xc8\x0d\x5c\x89\x97\x95\x24\xef\xf6\x51\xc7\xcd\xf5\xa1\x7b\xdc\xf3\x14\xd6\xe7\x00\x6d\x6c\xbf\x29\x46\x9d\x22\x31\x1e\x39\x2f\x04\x72\x59\x81\x83\xdd\x1a\x68\xe4\xc2\x54\x21\x95\xfb\x68\x11\x91\xaf\x24\x4f\x87\xc7\x0d\x38\x59\xf9\x21\x2d\x66\xa1\x87\x97\x2d\x07\x8d\xcb\x4c\x1f\x15\xff\x09\xc8\x8c\xb3\xa0\xef\x63\xbc\x97\x39\x5d\x78\x4a\x6f\x2c\x82\x8a\xfc\xf5\x47\xa2\x08\x3c\x27\x46\xc5\xe9\x0a\x59\x85\xe6\x5d\xb7\xd4\xfc\x51\x48\x6a\x39\x40\x3d\xa1\x57\x04\xdd\x21\xc9\xdd\x18\x4e\x84\x12\x64\xf6\xa8\xdf\xb9\xcc\x1c\x01\xe8\xaf\x2a\x76\xa8\x14\xf2\x0d\x01\x24\xee\x28\xef\xdb\xa5\xb1\x67\x62\x49\x15\xc5\x86\x70\xdd\x4a\x65\x72\xff\x88\xdb\xf8\xf1\x1d\xa9\x87\x0b\x8a\x3d\x02\xd2\x1b\x8a\x1e\x85\xae\x90\x72\x3b\x46\x76\x60\xcd\x51\xbc\xf5\x2a\xe5\xe7\x1b\xac\x35\x1e\x87\xe3\xbc\x13\x21\x5e\x5f\x7b\x8a\x59\x82\xb8\x39\x73\xee\xe2\xac\xe0\xec\xe6\x47\xd4\x69\xcb\xdf\x54\xaa\xb3\xb4\x21\x14\x8b\x08\xcd\xd6\x97\x0e\x9f\x30\xf5\xfb\x73\x5e\x0b\x06\xa0\x7d\x74\x7d\x87\x6d\x53\x7f\xab\x7a\x92\x1e\x5a\xfe\x9c\x6b\x26\xcd\x0a\x46\xcd\x2d\xd5\xe2\xc7\x82\x44\x70\xb5\xdf\xdf\x3b\xbc\x99\xdc\x4b\xb7\xa2\x37\xb1\xec\x53\x60\x46\xf2\xcc\x56\xf7\x6e\x97\x63\xa3\x9f\xfa\x14\xd6\x77\x1c\x03\x63\x9a\x98\xb8\xe4\xfa\x6a\x07\x5a\x0e\x8b\x83\x1e\x8d\x21\xd1\x65\x99\x9c\x9e\x43\x45\x89\x0f\x3b\xc1\xf6\x85\x81\xde\xca\xaf\x40\x0e\xaf\x2c\x6f\x8b\xa7\x70\xbf\x37\xe2\x3f\xa5\x5b\x90\x1f\x22\xa9\x95\x4a\x8c\x2f\x4a\xcc\x6f\x28\x81\x68\x09\x3b\xd5\x60\x17\xa1\xe8\x77\x05\xcb\x9c\xd3\xdf\xe8\x30\x0b\x3d\x39\x98\x21\x5d\xf9\x58\xb3\xd0\x1c\x53\x58\x53\xe5\x0b\xba\x78\x1e\x45\xb6\x9a\x7d\x9f\x81\xdb\x0b\x74\x9f\xad\x7b\x47\x57\x5a\xfa\x76\x71\x41\xef\xf7\x36\x92\x12\x67\xd2\xd0\xcc\xb7\xec\x7e\xbc\x1d\x0c\x78\x0e\xf2\x0a\x55\x0e\x77\x75\xdd\x9c\xe8\xfa\xda\x07\x0a\xf7\x1c\x45\x53\x40\x16\x37\xdd\xec\x5d\xaf\xc5\xea\x48\x73\x0d\xa0\x65\x5f\x86\x50\xc7\xe1\x8e\x04\x23\xa4\x48\xf9\x2f\xab\xeb\xea\xdc\xdd\x6d\x6b\xf4\xa6\x5b\x22\x24\x3c\x00\x13\x3c\xa7\xef\xce\x11\x47\xb2\x21\xc5\xdc\xb2\x0e\xe3\xb3\x8b\xfb\x1f\x73\xa7\x8c\xa5\xfc\xdc\xec\x4b\xa0\x4b\xfb\x4c\x96\x20\x2e\x6e\xd1\xc1\x86\x07\x10\x13\x54\x70\xc5\x25\x96\x8e\x86\x75\xe4\x03\x27\x92\xf2\x16\x16\xbd\xb8\xa2\x8f\x25\xda\x47\x78\xbe\x7c\xe5\xcd\x60\xf5\x2c\x4d\x63\xfa\x1a\x7a\xb8\xe7\xbe\x21\x94\x6c\x28\x11\xf1\x3b\x17\xfe\xc3\x93\x21\xe2\xc9\xae\xb1\xc3\x3b\xc2\x70\x46\x52\xf7\x1a\xc8\x66\xf4\xe8\x1e\x65\xe9\x98\x89\x4c\x05\x49\x0b\x98\x4e\x7e\xfa\xe8\x9b\x0c\x9e\xf8\x5c\xe7\x00\x94\xf1\x49\x6d\x2c\xc4\x13\x58\xa6\x5e\x96\x36\x35\x77\xea\xed\x78\x91\xf3\xf9\x92\x57\xaf\xe7\x62\x4f\xa6\x55\xe6\x3a\x7b\x06\x7b\x63\x4f\xb9\x9c\x2a\x45\x49\x8b\xa9\xad\xba\x57\x28\x51\x86\x9d\x3d\xda\x7a\xf9\x45\x58\x79\x59\xba\x2b\xc4\x8b\xbd\x86\x39\xd3\x6d\x53\x0c\x79\xd6\x65\xc3\xf6\x43\x31\x6e\x61\x30\xd1\xde\xbb\x52\x99\xbb\x75\x3f\x4c\x0e\x06\xd2\x6e\x86\x99\xfd\xf9\x8d\xad\xae\x9e\xe6\xb0\xdf\x04\x5f\xea\xd8\x02\x70\x32\x23\x5a\x98\x2a\x3c\xfa\x9d\x5e\x8a\xe5\x72\xe7\xd5\x61\xd5\x05\xa7\x95\x76\x6c\x53\x6a\x38\x21\x48\x5c\xac\x55\x2f\xcc\xa6\xf4\x52\xcf\x84\x54\xc1\x36\x13\x2b\x58\x6d\x1f\xea\x9b\x34\xc9\xc0\xcc\x9e\x5e\x0c\xc0\xff\xf2\x1b\xbc\x2b\x40\xac\xbb\x11\x12\xb9\xec\xa0\x9e\x91\xcd\xc7\x0e\x1f\x39\x76\x2e\xff\x9e\x50\x7b\x33\x34\x91\x98\x70\x4b\x24\xa6\x91\xa2\xef\x13\x7f\x8a\x8d\x73\xa1\x33\xc3\x02\xc6\x08\xf7\xf6\x2a\x22\x90\x86\x0f\x7b\x08\x2f\x09\xf9\x52\x47\x79\xfe\x3d\x75\xe9\xe9\x96\x4a\xbd\x03\x4b\x27\xce\xab\xa8\x76\x70\x27\x3d\xbe\x61\xbd\x49\x65\x19\x09\xba\x5d\x34\xa4\x8b\x0e\x12\xb3\xff\xb9\x34\x7a\xdf\x43\x8d\x1b\xfb\x8d\xe4\x0f\x3a\xed\x1e\x89\xd1\xfa\xbb\xca\x9f\xd9\x62\x42\x48\x7a\xc6\x81\xaa\xf4\x51\x14\xe2\x75\x17\xfc\x40\x67\x0e\x77\x24\x20\x88\xcc\xc0\x55\x87\x17\x8b\x79\x4c\x0e\x8b\x81\xa6\x71\x10\x28\x89\x44\x8c\xc4\x13\xd8\x2d\x70\xb0\x71\x2c\x15\xc9\x0f\x56\xde\xf1\x4d\xec\x72\x01\xdf\x8d\x20\x85\x9c\xb2\x8b\xb1\xef\xaa\x49\xf2\xf9\x5e\x95\xed\x84\xf6\x1e\x67\x42\x12\x9e\x45\xb9\x72\xeb\xbb\x56\x62\x56\xd0\x8d\xc7\x38\x43\xb7\xbe\x80\xf1\xb3\xa5\xae\x53\x9e\x68\x8c\xb2\x4c\x32\x0c\x22\xd2\x57\xf9\x1b\x1f\x1d\xf9\x9f\x91\xd8\xac\x25\x9e\xad\x45\xb6\x83\xac\x07\xce\x21\x67\xa1\x13\x92\x25\x3f\xdb\x68\x18\x0d\xc4\x31\x01\x63\xd4\x0e\xdd\x8c\xd6\x62\x34\xeb\x7a\x62\xd8\x22\xf6\x40\x11\xef\xd4\xfb\xcc\x58\xc2\x67\x54\xb9\x0d\x1b\x42\x74\x65\x21\x35\xd0\xa0\x2e\x55\xca\x04\x65\x83\x5c\x91\xf2\x23\x63\xfd\x82\xed\x2f\x61\x5b\x66\x38\x6a\x3e\x50\xc6\xc4\x45\x43\xc5\x94\x38\xba\x73\x95\x90\xd2\x2f\x0c\xd3\x62\x09\xd6\x4f\xff\x4f\xef\xf4\x7d\x03\x99\x2e\x7f\xe3\x06\x9c\xe7\x72\x80\xf3\xd6\x69\x8f\x59\xa1\x5b\x74\x8f\x79\x67\xad\xd2\x6f\xe6\x99\xe5\x2e\x8a\x2d\x44\x09\xd0\xf7\x2c\x55\xbd\x0b\xa9\x00\xe2\x08\x42\x22\xc6\x35\x15\xf6\x8a\xb8\x6a\xb8\x24\xd5\xba\xfb\x95\x7f\x68\x1c\xf6\x27\xf1\x21\xe4\xce\x8e\xd3\xa3\x19\xee\x4a\x75\x60\x9e\xd1\x73\xd0\x8c\x1d\xbe\x9b\x66\xca\x77\xc1\x67\xd4\x83\xfc\x4b\x36\x46\x81\x70\x7f\xc3\x38\xea\x53\x25\xb3\x70\x17\x47\x2d\x8d\x0d\x0b\xe0\x7b\x81\x41\x39\x6f\xcd\x83\xec\x81\x9f\xba\xc6\x94\xfe\x28\xfd\x52\x27\xc5\x3f\xe7\x44\xb0\xb4\x28\xef\x36\x9f\x77\xfd\x1f\x82\x11\x88\xfd\x74\xbb\x93\x34\x77\xfd\x8e\x80\xd5\xbf\xfe\xc4\x78\x0f\xa5\x73\xda\xbd\x2d\x52\xfb\x2b\xde\x52\xed\x1c\x5b\x33\xef\x39\xcf\x50\xfb\xfe\xc2\x57\x8d\x4c\x18\x5d\xf4\xc2\x38\xe0\x3f\xc1\xca\x65\xdc\xa7\x97\x9f\xcb\x7e\xa9\xde\x64\xa8\x6c\x97\x55\xce\xf6\xee\x9e\x29\xc9\xb9\xa9\x6e\x2f\x5e\x1f\x9c\x38\x59\xf0\x4d\xd6\xf0\x4d\x90\xd2\xd0\x84\xf0\x7f\x9a\x22\xe6\xb7\x33\xb6\xed\x6e\xab\xcc\x89\x1b\x9b\x1a\xbd\x0b\x12\xed\xa4\x9e\x79\xaa\x9c\xae\xb5\xce\x2a\xc8\x0a\xf0\xea\xbc\xd0\x73\x16\x68\x39\xbe\x2d\xa0\x39\xa9\xe3\xd9\x6b\x93\x3e\x34\xa7\xcb\x02\x55\xd4\x6c\x8d\x01\xc2\x9c\x97\xe0\x3c\x20\x31\xb1\x12\x27\xc8\xf8\xf8\xdc\x5d\x2a\x01\xa2\x99\x08\xb8\x5c\x4b\x81\x79\xfd\x34\x77\x36\xe7\x47\xf4\xd5\x6f\x56\xce\xad\x15\x91\xcf\xd1\xc6\x5f\xcc\x15\x72\xef\xdf\x01\xa9\x9a\xc3\x0b\x2a\x99\x17\x8a\xa4\xd0\x71\xd3\xa0\x11\x4b\x4a\x32\xc3\x18\xd6\x47\xdd\x52\x67\x84\xbe\x72\x92\xb2\xf7\x78\xda\x6c\x23\x3d\xb5\x4c\x15\xa8\xa6\xac\xbb\x8f\x0e\x3c\x72\xa5\x3c\xa1\x7f\x93\x82\x4f\x08\x12\x61\x79\x70\x21\x0c\xfd\xdc\x4b\x4e\x45\x6b\x5f\xf3\x4e\x51\x2c\xc0\xfb\x3e\xa6\x18\x08\x40\x87\xdd\xaa\x9c\x93\x4e\xb4\x56\xb2\xff\xdc\xd7\x6d\xa8\x38\xea\x8f\x0e\xe6\x3b\x9b\x89\x90\xba\x4f\x99\x72\x9a\xc5\xd9\xd4\x61\x1a\x10\x29\xfd\x64\xa2\xcf\x15\x66\x2e\xa4\xd3\x54\x96\x56\x5e\xde\xaf\x5e\x57\x0f\x93\x27\x09\x67\x33\x08\x6b\xa2\x64\x50\x13\x50\xde\x01\x1e\x81\x41\xd0\xca\xfa\x4f\x88\xca\x14\xa9\x2b\x45\xc8\x26\x36\x20\x06\x00\x05\x80\x9a\x62\xc2\x9b\xd7\x0d\x51\xdc\x10\xd4\x45\xa8\xfe\xe2\x8f\xd5\xbb\x9a\xf5\xc7\x28\x87\x18\x17\x4b\x36\x51\xba\xbd\x59\xfc\x23\xcb\x09\x7e\x0f\x4c\xcf\xe4\xaa\xfc\x14\x22\xe7\xd5\x82\x33\xde\x70\xed\x9f\x08\x40\xcc\x0a\xf8\xbf\xd2\x05\xf5\x24\x39\x68\xe1\xf7\xcd\x15\x12\x13\x70\x25\x5d\x4d\xda\x57\xb8\xf6\x49\x45\x27\xd2\xec\xef\xce\x5c\x4a\x42\x3c\x3c\xb5\x24\xb3\xc7\xbb\x85\xbe\x8a\x8c\xad\xed\x98\x1c\xcd\xd1\x70\x4d\x54\x07\xec\xad\x70\xf8\x63\xcd\x40\x45\x66\xf6\x57\xf9\x5f\x4a\x2e\x47\xd0\xc9\x68\x6a\x00\xc1\x7e\x79\xe5\xec\x61\x71\xda\x37\x84\x8c\x8d\x87\x57\x59\xee\x3b\x90\x24\x56\xeb\xdc\x77\x3a\x6b\x31\x63\xf8\x6c\x05\x54\xb7\x35\x36\x2d\x13\x34\x6e\xca\x5b\xd7\x06\xbf\x1c\x6a\x7e\xa1\x8e\x27\x64\x1e\x5b\x4d\x50\x99\x7f\x2f\xc4\x62\xff\x4c\xd2\xd1\xa6\x7d\xca\x11\x0f\x8b\xc8\x1f\x01\x6a\x4d\x01\x97\x31\x40\xff\x40\x7a\x0e\xd4\x2f\x8d\xe0\xcf\xb3\x25\xbf\x22\x21\x9b\x17\x75\x5a\x92\x20\xbb\xf0\x17\x37\x28\x57\x09\x00\x90\xbe\x03\x7e\x43\x8e\xea\x0e\xe9\xd1\x15\xe6\x76\x0b\x7f\x59\x07\xf9\xd6\xb1\x4d\xb6\x79\x08\x85\x34\x5e\x34\x5b\x33\xa8\x1d\xa5\x4f\x89\x14\xfa\x43\xa4\xc0\x96\xa4\x15\x22\x8f\x56\x67\x46\x1e\x2a\xa1\xdd\x5b\x12\xbf\xe0\xf2\x13\x4a\xa7\xc9\xaf\xa4\x8e\x39\xe2\xb4\x9f\x9d\x25\xd5\x36\x53\x03\xae\x06\xa2\x09\x31\xc6\x8a\x4a\xbe\x1d\x64\xa0\x61\x18\x6c\xed\x98\xaf\x74\xb8\x3b\x85\x99\x47\xb8\xbd\xca\xa1\x8c\xaf\x91\xbe\xa6\x01\xb4\xe9\x9c\x1f\x81\xf5\xd6\xe2\xee\x1c\x9e\x0b\x30\x70\x69\x4e\x1c\x29\xd6\x64\x57\xa7\x77\x6b\x29\xf0\x8b\x97\x87\x9d\x3c\xe0\x3e\x96\xfc\x22\x05\x91\x12\x9d\xf8\x03\x7f\xb4\xf7\x8b\x66\x93\xcb\xdc\x33\x33\xb5\x9f\x2a\xc2\x0b\x70\x89\x76\xca\x8a\x2c\x41\x9c\x3e\x24\x97\x83\x5d\x55\xf6\xcb\x2d\xee\x2a\x33\xc2\x60\xf7\xfb\xa6\x97\x25\x43\x2c\x6b\x78\xf8\xd2\xd2\x71\x57\x60\x9e\x89\x65\x76\x63\x75\xe4\x43\x55\xcd\x51\x4d\xb6\x94\x26\x42\xab\x39\xac\xda\xee\xb9\xbc\x72\xd1\xaf\x0d\x9e\x3b\x9b\xb9\xdf\xac\x17\x7d\xec\x5d\x24\x93\x70\xda\x55\xb7\xa4\x8c\xa5\xc1\x15\xef\xc7\x41\xae\x88\x65\xaa\xf5\x4d\x38\xaa\xd1\x8c\x25\x39\xca\x93\x9d\x5a\xab\x20\x88\xb1\xb7\x67\x75\xf8\x19\x75\x3d\xa9\xda\x45\x35\xbc\xe9\x26\x24\x50\x55\xb0\x1b\x31\x8d\xa0\x2f\x5c\x34\x75\xfa\x31\xa4\x63\xc9\x47\x5f\x3c\xa2\x89\x7e\xd6\xdf\x08\xac\xf2\x6e\xc7\xd1\x54\x1a\x43\x77\x26\xe0\x9e\xe3\xa7\x20\x99\xda\x1d\xd4\xbc\xfb\x69\x64\x67\x55\x92\x9c\x46\x69\x33\x00\x5d\xdf\x9e\xf8\x7a\xb6\xd6\x79\x6a\xf3\x7a\x06\xa3\x91\xb3\xa1\x77\xaf\x83\xe0\x9a\x79\xf5\x1e\x64\x38\x22\xd3\xcc\xc5\x45\xde\x2b\x8b\x20\x33\x31\xdd\xf0\x2e\x64\x30\x73\x26\x1e\x24\xbb\xa4\xd9\x0a\x48\x89\xd0\x85\x79\xd9\xe0\x76\xc9\x1a\x00\x09\xd3\x10\xf9\xd6\x8f\x8c\xf3\x9f\x13\xc2\x88\xeb\xad\x03\x52\xf1\xef\xd8\x37\x21\x29\x91\x86\xee\x72\x26\x1e\x85\x04\x99\x32\x29\xb6\x46\xce\x09\x5d\x54\xb6\xd9\x25\x12\x2e\x9b\xf0\x0b\x40\x6c\x67\x14\x71\x1c\xb3\xf6\x10\x35\x92\x8a\x3c\x2b\xe3\xac\x4c\xec\xb2\x76\xe1\xe6\xb0\x50\x53\xf6\xc4\xb2\x6c\xc8\xdf\x63\xa2\x50\xc9\xe2\xd2\xe1\xd0\x1d\x08\x6a\x39\x4c\x30\xa4\xfa\xc1\xdb\x72\x6d\xf4\x5f\x53\xcb\xed\x2e\xa7\x7a\x76\xd5\xf8\x92\x7b\xbc\x89\xbc\xc5\xa9\xec\x10\xee\x84\xdd\x73\x5a\x48\xe1\x81\xfc\x1d\x7c\x31\x1b\xc8\xdd\xce\xe9\xc5\x5f\xcb\x37\x54\xe9\x87\x4d\x58\xc3\x9c\x37\x46\xc0\x88\xf6\xeb\xde\xcf\xf7\xce\xad\xd2\x00\xa9\x99\x41\xc2\xbf\x73\xb3\x6b\xaf\x63\xaf\x63\x0b\xe5\x38\x35\x05\xc4\x97\xbe\x0d\x30\xfb\xa5\x16\x61\x0b\x21\x7f\x82\x73\xc3\x2d\xcb\x13\xdd\x2f\x9c\x5c\x65\x9f\x65\x61\xc6\x56\xe4\xc6\xae\x36\x81\xd2\x1d\x06\xee\x51\xf1\xbd\x39\xb5\xcd\x16\x8e\xb7\xe5\x4c\xdb\x2b\x42\x01\xe7\x39\x1d\x53\xa2\x1d\xa0\x9d\x03\x96\x0e\x03\xa7\x67\xee\x36\x5f\xf3\x0b\x55\x5d\xc2\x1e\xd7\x74\xc0\xd1\x9a\x98\x67\xf1\x62\x6a\x4e\x67\x59\xc0\xd7\xd5\xe5\x6a\x4d\x86\x79\xfd\x7c\x27\xfc\x99\x01\xde\xab\xa4\x68\x04\x7c\x9e\x12\xa6\x15\x7a\xe5\x3c\xea\x37\x4d\x49\x47\x95\x5d\x75\xf8\x4a\xde\x99\x90\x02\xf1\x98\x58\xbf\x83\x89\x99\xf5\x20\xf7\x94\x89\xeb\xaf\xa0\xcd\x80\xfc\xb7\x49\x3f\xf6\x81\x75\xef\x37\xe6\xde\xa7\x6f\x04\xe9\x92\xfc\xa1\x89\xd5\x4a\xa7\xf1\xc5\x7a\x49\xe3\xea\x35\x34\x10\x26\x42\xf3\x8b\xe8\xec\xd9\x2e\xb2\x48\x38\x87\xc1\x93\x27\xd6\xd6\x82\xe5\xc2\x81\xd5\xca\xce\x3d\x8c\x1b\x5b\x2b\x25\x5f\xb3\xea\xbf\x1c\x38\xc7\x96\x49\xa6\x9c\xb8\x1e\x95\x58\x81\xf1\x53\x25\xdf\x67\x37\x21\xfa\x9c\x49\x4c\x38\xab\x64\xe0\xcf\xf5\x48\x81\xb4\xf4\xee\xd8\x2d\x6c\x3d\x41\xec\xea\x26\xb4\x68\x22\x1f\xa4\xd3\xc5\x3c\x6f\x3f\x9e\x62\x8f\xf5\xc7\xbc\x9f\xd7\x3e\xba\x6b\xa1\x51\xf8\x58\x68\x0a\x09\x04\x0e\x37\x17\xc0\x89\xe8\x19\xcc\x38\x96\x39\xab\xba\x35\x00\x46\x0c\xd3\x4e\x96\xd3\xe8\xf4\x0f\xe7\x4d\x4a\xc2\x1e\xe3\x35\x0d\x52\x4d\x1d\xcc\x4b\x8a\x2b\xd6\xcf\xbe\x44\xe8\xd3\xa8\x92\xd0\x32\x76\x63\x70\x14\x4a\xde\x78\xe7\x24\x7c\xb2\x15\x1e\x90\x93\xf1\x58\x7e\x79\x05\xfc\x05\x49\x6f\x08\xf9\x03\x28\x30\xde\x88\x6a\xe8\xf1\x60\x6f\xdf\x5c\xa1\xa0\x22\x22\xfd\x8b\x0d\x07\xc7\x1b\xa5\x94\xa8\xf8\x84\x10\xea\x38\x68\x77\x68\x61\xdd\x2a\x52\x29\x10\x52\xc7\xc6\xdd\x97\xa3\x65\xc6\x6f\x2f\x29\x78\x18\x84\xa4\x72\x5f\x0b\x6b\x8c\xd9\xe5\xf4\x3f\x95\x2d\x00\xf9\xb8\xc8\xfa\x8b\x12\xba\x7f\x11\x1d\x2d\x27\xeb\xcb\x23\x20\x36\xa5\x70\xb2\x31\x0b\xff\x7e\x37\x2c\xd8\x1a\x1e\xce\x70\x2c\x10\x1a\x11\xde\x13\x24\x43\x46\x85\xdc\xf0\x23\xc7\xc4\x84\x9f\x59\xec\xed\x98\x74\x16\x00\x88\x0d\x88\x73\x5c\x93\x72\xb2\xf5\x95\xe9\x68\x03\x4f\xa1\x8e\xc0\xa0\xc1\x84\x30\xfe\xae\x22\x34\xaf\x58\xdd\x4c\x89\xc4\x55\xc4\xc0\xab\x61\xd0\xbe\x48\x20\xa8\xff\x96\x75\xc3\x6f\xe6\x23\xf7\xab\x5c\x43\xfe\xf7\x44\xa6\x01\x63\xe4\x23\x4d\x2a\x0e\x27\xcf\x96\xd2\x8b\x35\x0e\xef\xce\x96\x34\xbf\x14\xdf\xb3\xa9\xd7\xc5\x64\x00\x99\x6f\x9b\x63\x6e\xdc\xf4\x27\x21\x79\xca\xc7\x27\xdf\x8c\x6e\x94\x0d\xe8\xbd\xf1\x69\x2b\x02\xe4\x6b\x7d\x65\x97\x12\x22\xc0\x7b\x0d\xe2\xcd\x2b\x1d\x59\xfb\x51\xde\x1f\x43\x59\x06\xd8\xfd\x73\x20\x12\xc5\x22\x9b\xd5\x3d\x2b\xbe\xcd\xd2\x3b\x67\x69\x7c\x6b\xa2\x8f\xfb\xc9\x33\x17\x18\xba\xda\x5c\x8f\xa3\x74\xf3\x16\x3a\x52\x4d\xe0\x72\xbb\xe5\xd8\x16\x09\x9e\xf3\x4b\x3e\x36\x2a\x20\x3a\x20\xfa\x6e\x66\xef\xc7\xba\x0e\xb9\x1f\x46\x24\xf5\x12\xb8\xf3\x83\x62\xbf\xaf\xed\x23\x70\xcc\xbf\x72\x7b\xdc\xa6\x5f\xac\x0f\x97\x59\xd3\x5a\x90\x1f\x6d\xea\x43\x75\x04\xca\x93\x00\x80\x3e\x70\xa4\x00\x34\x56\x4d\xa9\x4f\x4b\x8f\xc3\x7f\xb3\xa0\xd5\x47\xeb\x95\xa5\xd5\x41\xa7\xc8\x27\x0e\xf7\xc4\xae\xd4\xa3\xc4\x55\x44\x11\xe3\x44\xee\xe7\x66\xfc\x6b\xce\xeb\x03\x0c\x51\x4d\x58\x6c\x3e\xcd\xaa\x70\xc0\xfe\x64\xe7\xcd\x59\x9a\xda\xa2\xc3\x89\x2f\x9d\x42\xd5\x34\xee\x21\x77\x1b\xb1\x72\x6b\xff\xff\x48\x39\xab\xa0\x36\xb8\xf5\x5f\x23\xa5\x38\x14\xb7\x22\xa5\xd0\xe2\x50\x28\xc5\xad\x38\x34\x78\x21\xb8\x13\xdc\x82\x94\x60\x1f\x45\x5b\xac\xb8\xbb\x15\x87\xe0\x50\x5c\x8a\x6b\x02\x04\x2d\x56\x20\x78\x02\x14\xe7\xfc\xbf\x6f\xf6\x9c\xb3\xf7\x9c\x7d\x75\xce\x7a\x66\xd6\x9a\xdf\x9a\x59\xef\xcd\xbb\xe6\xbd\x7c\xec\xe3\xe2\x82\x3a\x6d\xb6\x46\xf7\x0f\xd9\x6f\x6b\x99\x6b\xe1\xef\xe3\xeb\x6f\xd1\x72\x00\x02\xf0\xc4\x26\xc2\x40\x85\x0f\xe4\xf3\xc8\x7e\xbd\xfb\xf6\x3e\x75\xcb\xe7\x8a\xbb\x35\xbb\xec\x54\xaa\xaf\x9b\xe0\x14\xae\x8b\x54\x8e\x9c\x8a\x76\x34\x50\x09\x36\xe8\xf9\xcd\xb8\xeb\x2d\x1d\x9e\x1a\x1c\x6c\xfc\xf8\xde\xdf\x78\xd7\xfb\x76\xa4\x19\x0c\x97\x70\xb6\xcc\x82\x64\x39\x9c\xc1\xf1\x56\x0d\xd6\xab\x61\x26\x90\xce\xa1\x1d\xf9\xd3\xed\xd0\xe5\xf9\x80\x92\x5c\x13\xbb\x96\xca\xbc\x05\x0c\x2f\xdb\xee\xf6\x57\x8f\x6d\xeb\x29\xdc\xef\xef\x9d\x62\x2b\xf7\xf8\xbd\xbe\x75\x13\x4c\x3d\x27\x94\x27\x05\x7c\x0b\xc3\xe7\x97\x25\xd7\xed\x97\xd6\x73\x21\xa8\x57\xb6\xf0\xb9\xb1\x90\x79\x88\xad\x28\x3a\x7f\xc0\x44\x50\x29\x0f\x91\x73\x20\x78\x20\x56\xac\x84\xf0\xdd\x53\xdb\xe6\xd6\x3b\xb9\xb5\x05\xb9\x35\x87\x5f\x8f\x1d\x8d\x99\x22\xd3\x73\x24\xfb\xda\x21\xbd\xdf\x75\x24\x87\x58\x1d\xf2\x81\xf9\xcd\xe2\xad\xaa\x2b\xd1\x1c\x96\x91\xfa\xc1\x5d\x97\xd9\x0c\x37\x5b\xa5\x63\xbb\x65\x12\x6f\x71\x75\x18\xf7\xaf\xd3\xd0\xa6\x81\x69\x53\x34\xd9\x87\x66\x0f\x1b\x95\x53\x6f\x56\x7c\x8e\x61\x4d\xb1\x0f\x4b\xd7\x69\x22\xd9\xb0\x51\x29\x84\xfb\x75\x40\x99\x31\x9d\xda\x64\x77\xb6\xed\x51\x5f\x93\x3f\x67\x35\x8d\xed\xca\xdd\xea\xdd\xcb\x98\x50\x07\x5b\x3b\x01\x74\x86\xfa\x29\xa5\xda\xa6\x07\x94\x95\xeb\x52\xe9\x65\x15\x05\x47\x79\xab\x32\x9e\xa3\x9a\x52\xac\x3d\x66\x4c\x6b\x24\x70\xef\xe5\x4c\x54\xcb\x26\x4e\xd3\xb7\xa2\x33\xa6\xd5\x58\xa7\xcc\xae\x34\x3c\x61\x45\x98\x4e\xb1\xd6\xc4\x64\x34\xc3\x56\x04\xbe\x99\x3b\x73\xbe\x7c\xb9\xad\xdb\x23\xf2\xc8\x36\xfc\x2f\xf1\x94\xdd\x5a\xff\x77\xc3\xcb\x55\x13\xcf\x4f\x84\x61\x13\x39\xe0\x84\xc1\xa1\x0c\xd1\x68\xe0\x14\xa1\xfa\xbb\x85\x53\x7d\xe3\x6f\xbb\x52\x4f\x77\x11\x81\xfa\xdc\xb1\x8f\xf6\x13\x65\x0b\x3d\xeb\x6a\x31\x59\xce\x33\x9b\x31\x46\x46\x59\xc2\xc3\xeb\x06\x09\x5f\xea\x93\x25\x28\x6b\x1e\xf4\xee\xf7\x23\x6c\x2b\xf5\x79\x97\x65\x97\x54\x18\x22\x90\xaa\xa7\x88\x83\x2a\xa6\x49\x05\x49\x9a\x89\x0c\xe3\x9f\x9c\xf3\xdf\x26\x7a\xd6\xa7\xe6\x9d\xcd\xac\xfa\x81\xb6\x28\x84\x86\x46\x7d\x58\x15\x6b\x30\x96\xba\x69\x91\x6c\x4c\xe8\x82\xd5\xd7\xf2\xcc\x31\x19\xbe\xdf\x2a\x7f\x54\x08\x40\x10\xa0\xe5\xd0\xe9\x34\x22\x51\xee\x98\x22\x2a\x10\xa0\x89\x53\x6a\x4b\x72\x0f\xec\xbb\x53\x29\xd3\x08\xf1\xcc\x9f\x46\x2f\xe5\x19\x90\x7e\x97\x38\xb3\x7f\xe6\x75\xd6\x01\xf2\xf2\xd2\xbc\xf4\xf3\x16\x92\x4a\xed\xbb\xe3\x5a\x28\x3e\x1e\xdf\x59\xec\xce\x97\xc7\xcc\xef\x53\x52\x06\xdb\x8b\xa9\x1d\x4f\x74\x1a\xd5\x31\xf0\x26\x3b\x7d\x4e\x34\xfd\xc0\x0b\x0f\x22\xb5\x9f\x74\x70\x28\xe0\x43\xbe\x15\x9b\xd0\xf6\x14\x2e\x3d\xeb\x54\xe5\xd1\x5f\x4d\x7a\x0c\x37\x73\xb4\x23\x4d\xee\x30\x0f\x84\xf1\x24\x1d\x55\x69\xbf\x93\x19\x17\xde\x79\xd4\xd0\xdf\x0d\xee\x9d\x82\x13\xac\xe5\x8e\xc5\x56\x18\x22\xb2\x35\xb2\x76\xd8\x6d\x21\x63\x2b\x1e\x43\xdf\x4f\xa4\x7b\x8d\xf6\x88\xf3\x93\x34\xbc\xce\xbe\xce\xbd\xc9\x64\xc1\xb0\x24\x82\xb3\x63\x3e\xe4\x4a\xed\xb1\xf8\x4d\xd9\xaf\x65\x54\x56\x12\xab\xb4\xf6\xa6\x8d\xa9\x85\x18\x5d\x66\xca\xdc\xe3\xdb\x77\xfa\x78\x66\x3b\x8d\xf6\xca\x32\x30\xee\x30\xba\x4c\x18\x5a\xd4\x98\x39\xb8\x82\x4f\x91\x4a\x26\xc9\x5c\x97\x78\x01\x1f\xb2\x21\x2f\xf8\x65\x5a\x38\x29\x39\x92\x04\x08\x47\x36\x09\xa8\x00\xba\x6f\x39\x32\x87\xd3\xec\x99\x4e\x17\xfd\xa1\xed\x19\x89\xbf\xcf\x78\x8f\x5f\x2f\xd8\xc7\xfd\xfe\x72\xe0\x86\x14\xb9\xb5\x96\xa7\x9b\xcd\xa5\x1b\x95\x21\x8d\x7e\x07\xdd\x3c\x4b\x84\xbe\x0e\xf0\xb6\xa2\x8d\x82\x17\xc9\x36\x3e\xc4\x8e\xbf\x68\xb0\xba\xef\xcf\x28\x28\xb8\xeb\x98\xb6\xf3\xf7\x82\x5c\xe1\x85\xbf\xf9\x38\x2b\x27\x23\x15\x23\x9b\xbd\xb1\x81\x8f\x43\xe7\xc5\x9e\xbe\x9d\xf2\xa5\x67\x7c\x07\xc3\xc1\x3b\x33\xc9\x98\x58\x03\x35\xec\xb0\xa1\xd0\x47\x58\x1f\xa5\xbc\xaa\x27\xfb\x55\x7c\xca\x9d\x4a\xf2\x8e\xe3\xc2\x7a\x0e\xb8\x3f\x32\xf4\xd1\x05\x2b\x56\x70\x69\xaa\x5e\x04\x92\xb5\xa4\x89\x3a\x02\x98\x5d\x2a\x4d\x35\x1a\x7e\xb4\xf3\x55\x06\xdf\xf5\x26\xbd\x4c\xf0\x12\xef\xe3\xff\x52\x11\x9a\xd2\x3c\xb9\x13\x54\xaf\x09\x5e\x33\x6d\xfa\xa9\xb4\xf0\x38\xb5\xbb\x9a\x50\x9b\xcb\x71\x73\xc7\x61\x36\x4c\x13\xdd\x5b\x2f\xf3\x81\xf2\x36\x9e\xad\x47\xf7\xc9\x68\x67\x38\x91\x01\x44\x4f\xda\xe5\xf0\x75\xb0\x39\x7d\xd9\x67\x86\x1b\x9b\x98\x9a\x69\xd8\xae\x78\x09\xe9\x00\x86\x70\xcd\x87\x27\xf6\xc1\x12\xd8\xb5\x2a\x22\x2b\x06\x20\x08\x12\x34\x60\x34\x34\x64\x64\x25\x84\xf4\x17\xb4\x11\xfe\xa6\xe6\x58\x3a\x20\xb6\x66\xcd\xbd\xf5\xfc\x3a\xc1\xc4\xc7\x03\xb2\x0b\x55\xb0\x42\x4c\x9e\x5b\xe5\x97\x0f\x35\xc7\x83\x58\xae\x59\x9d\xb1\x64\x17\x4a\x73\xdc\x7f\x6b\xbf\xef\xb4\xc2\xb8\x5f\xd6\x65\xd1\x3e\x92\x26\xfb\x0b\x27\x89\x9b\xd6\x06\x3f\xc4\x1d\x4a\xdf\x75\xcc\x1e\x58\xfb\xe1\x45\x52\xce\xf3\x0c\x1a\x8a\xd0\x48\xd4\x2e\x13\x49\xa6\xb5\x0d\x93\xaf\x0a\xb6\x5b\x7a\xeb\xd0\x6f\x8a\x05\x77\xba\xbf\x3e\x71\xe8\x1d\x76\xb9\x0f\xec\xe1\x7d\x3a\xee\x5e\xa7\x70\xa7\x7d\x89\xa4\xc1\x10\xb7\xf1\xd1\x26\xf6\xc8\x23\xb1\x91\x63\x3e\xd5\x56\xb2\x59\xf9\xd0\x50\xaa\x5e\xec\x32\xab\xa5\x33\x76\x44\x5c\xec\xfe\x51\x06\x1b\xe5\xdc\x7f\x30\x4f\x5c\x7b\x0b\x01\xd5\xf1\x56\x30\x7d\x69\x0e\x87\x31\x3b\x6a\x21\x8e\xcb\xad\x5a\xae\x95\x17\x2c\x75\x2e\xba\x6f\x5e\x91\x9a\x79\x0c\xfe\xda\x96\xf6\x9e\x13\x2d\x4e\x71\x7f\xd5\x4f\x3f\x57\x33\x13\x46\x5c\x6c\x41\x7a\x04\x50\xd6\xcd\xa3\xac\x29\xa8\x41\x93\x33\x30\x21\x9e\xad\x34\x59\xba\xd5\x9d\x6d\x15\x50\xac\x18\xac\x64\x19\x35\x5a\xf6\x17\x8e\x23\xf2\x56\xeb\x5a\xf4\x85\xf3\x30\x47\x75\xba\xc1\x82\x1a\x40\xfc\x65\x0f\xe5\xf7\xf8\xf9\x5d\x9a\xf9\x49\xa3\xea\xb3\x20\x2c\xa2\xfd\xf3\xd3\x9e\x9e\xf7\x06\x0f\xf7\x9c\xba\x20\x93\x0b\xc4\x35\x32\x77\xae\xc0\x79\x7b\xec\xcf\xa5\x29\xe4\xb2\x47\x72\x1d\xea\xc6\x6b\xfe\xb0\xc3\x4b\x78\x55\x9f\x35\x24\x57\x94\xa7\x0e\xf0\xa0\x9e\xab\x7e\x41\xdf\xd8\x5f\xaa\xeb\x50\x0d\xf2\x3b\xbb\xc3\xbc\xef\x1e\x58\xae\x33\x55\xbf\x95\xd0\x45\x0e\xe9\xbd\xfe\xb6\x55\x8e\x76\x22\xd5\xf2\x18\xf9\x6d\x8b\x1c\xb2\xcd\x12\xb1\x26\x35\xd2\xf3\x0f\xe2\x62\x8a\x14\x89\xb6\x42\x9a\xa8\x84\xcb\x9c\x3a\xd2\x21\x9a\x9c\x85\x52\xe2\xb5\x18\x89\xb3\x4f\xa6\xbb\x7c\x9f\x9f\xe8\x09\x42\x4f\x1d\xbf\x1a\x62\x92\x49\xcc\x63\x70\xb6\x3b\x13\x2b\x8c\x79\xdb\xfa\x79\x69\x92\xea\x5d\x9d\xc4\x2f\x39\xf6\x37\x17\xdc\x9d\x7d\x6c\x56\xa9\x3e\xfa\x7e\xc3\x90\x0d\xbc\x04\x8d\x54\xf1\x5c\xc4\x1b\x34\xe2\x8c\x4c\x15\x9a\x51\xc6\x2d\xf5\x79\x37\x17\xbc\x30\x36\x5d\xf4\x49\x56\xda\xdb\xa5\x4c\x0e\x20\xd8\xaa\xd1\x56\xde\xa8\xb2\xb7\xe0\xf8\xfe\x12\x3f\x54\xe7\xc7\x6b\x61\x60\xb9\x42\xd9\x38\xae\x76\x3e\xed\xa8\x07\x60\xec\x1d\xe8\xd6\xfa\xb5\xd5\xfd\x8d\x77\x03\x36\x8e\xf8\xeb\xb4\xf3\xc7\x8c\x1a\x15\x0e\xa2\x30\x06\x99\x56\x3f\x3a\x78\x53\xc9\x4d\xf3\xd8\xbb\xa2\x20\x3e\x94\xed\xf7\x2a\x63\x57\x6a\xfc\x56\xec\x8a\xaf\x8f\x5c\xc1\x7f\xd5\x63\x7e\xac\xf4\x33\x02\xf7\x7b\xe5\x8b\x9d\x44\x67\xb5\xea\x04\xbf\x50\x46\xdc\xc7\xc6\xb6\x94\xc7\x5e\x27\x01\x00\x6c\x4b\x9f\xe2\x20\x7f\x55\xb1\x99\x22\xe7\xfc\xa7\xfb\x19\x3f\x48\xde\x05\x5e\x53\xc5\xee\xbe\x5d\x1b\xa2\xff\xa5\x66\x44\x0f\xbf\x40\x06\xcd\x02\xf4\x63\xe0\x29\xf6\x7e\xd4\x0b\x2c\xd2\xb2\xde\xb1\x1d\xa3\x47\xb8\xc4\x99\x3b\xe1\xc7\x14\xb1\xdc\x0d\xdd\x43\xd8\x7b\xe7\x17\xa6\x1b\xd8\xeb\x82\x4a\x1e\x9a\xa2\x8e\x77\xa9\x33\x25\x75\x06\xf2\x8f\x1d\xda\x1e\x47\x63\xcc\xc5\x07\x9e\xbe\x35\xd1\x7c\xa3\x87\x39\xdb\x61\x95\x5d\x6b\x25\xf4\x26\x17\x99\xe6\x8d\x37\x77\x65\x5b\xe7\x54\x97\x7e\x99\xcd\x26\xb8\x74\x9a\xdd\xae\x30\x4e\xd5\x09\x75\x65\x9d\x32\xa4\xca\x60\x88\x47\x72\xeb\x8a\xea\xd2\xcc\xe0\x53\x3e\x02\x1b\xee\x67\xde\xb2\x53\x8d\x34\xcf\x2f\xe6\xf0\x58\x90\x5d\x4e\xa9\x88\xc1\xe2\x40\xb1\x12\xa4\x5e\xa5\xcf\x3b\xc6\x4b\xae\x4f\xc5\xa0\x66\x5b\x1c\x11\x99\xb4\x8c\xfe\x2a\x58\xe0\x85\x6e\x19\xd6\x3e\x25\x9c\xf7\x34\xff\x88\x42\x8e\xe6\x23\xce\xf2\x1d\xe6\xe7\x23\x66\x2a\x42\x4d\x32\x80\xb3\x1a\x31\xdc\x7d\x99\xec\x08\x5e\xb1\x5a\x76\x77\x0c\xc7\xc9\x33\xf4\x4d\x85\xef\xdb\x67\x3b\xbc\xbb\x35\xb1\x93\xbb\x34\xb2\xe6\x69\xf1\x74\x54\xca\xd6\x63\xde\xf9\x3c\x0d\x70\x14\x75\xd2\x3c\xa8\x14\x7e\x13\xfd\xa0\xd7\xf1\xe3\xde\xc3\xa0\xd3\x1c\xe1\xba\xbf\x68\x41\x93\x22\x52\xc3\xde\xa0\x5e\xb9\xb7\xb2\x6f\xb0\x02\x4e\xb5\x11\xc0\xcd\xfc\xbc\x21\xde\x31\x63\xd1\xd8\xac\xc9\x08\x4c\x59\x63\xba\xc6\x9e\x65\x06\xb3\x6d\xbd\xfd\x21\x23\xfe\xa8\xab\xfa\xa6\x10\xa3\xd0\x80\xb7\x4b\xcb\x33\x10\xd9\x07\x2d\xfa\x4a\x54\xc6\x24\x76\xc1\x07\xbb\x7d\x95\xaf\x95\x99\x61\x66\x47\xd0\x40\x29\xca\xd1\x6e\x50\xa0\x5a\xd8\x8f\xb3\x6c\xf1\xbc\xaa\xd0\x42\x56\xd8\x32\x30\x0a\xd2\x84\x74\x78\xd0\x67\xe9\x72\x0f\xc3\x84\x6a\xc1\x3a\xb9\xc5\x53\x8b\xc8\x6d\x4c\x1c\xfb\x59\xa5\xee\x2c\xff\x72\xa4\x2a\xfa\x7a\x5a\xbd\xcd\x69\x50\x06\x63\xf3\xb8\xf4\xff\xb9\x34\x3f\x91\xbd\xe0\xce\x99\xa6\x7f\x77\x51\x13\x50\x24\x62\xef\x98\x53\x15\x87\x35\x71\xb9\x49\x9e\xd6\x70\x41\xd2\x51\x32\xb2\xc9\xd6\xf2\xb7\x77\x61\xbe\x60\x94\x54\x2c\x8b\xde\xe4\xf2\x26\x6e\x29\xc4\xd5\x98\xa5\xbe\xd8\xc9\xdf\x59\x39\xfa\xcb\x00\x9f\x0b\x51\x98\x4b\xd0\x4f\xbd\xb4\x9e\x76\xc5\xe8\x9c\xe5\xeb\x82\x19\xab\xf8\xe1\xea\x66\x97\x36\x0b\x82\x8e\x34\x72\x32\x5f\x94\xab\xdf\x6f\xad\x57\x69\x74\xe2\x5a\xb5\x1f\xd4\x16\x8f\x52\x0d\xe3\x6e\x82\xf7\x7b\x62\xe0\xe6\xc7\xee\x19\x8c\x1a\x06\xdf\xbf\x50\xe4\x66\x41\xf4\x97\x0f\xa9\x75\x17\x42\x4d\x45\xe6\x49\xe1\x8a\x4b\x36\x49\xec\x0e\xa4\xad\xbb\x19\x55\x01\x67\x44\xd8\x5d\xa4\x37\x7b\x88\x71\x45\x98\x01\xa0\x37\x29\x03\x57\xc4\x73\x93\xb8\x67\x4d\xe5\xfd\xf7\xc6\xb1\x77\x0f\x16\x1f\x95\xdb\x8c\xa2\x7e\x4f\x62\x10\x5f\x89\xe5\x6b\x08\x3a\x0b\xae\x2e\x8f\x84\xab\x18\xa6\x77\xd2\x73\x64\x0e\xa6\x91\xcd\xc2\x10\x0e\xbe\xb4\xd9\x9d\xab\x49\xc6\xf7\x6b\x16\x15\xd6\x46\xdf\x53\x75\x24\x0c\x21\x52\x7a\x9e\xb3\x3e\x2a\xf1\x0f\x62\x64\x07\xd6\x7c\x84\x05\xf3\x50\xa4\xf2\xf6\xd7\x59\x8a\xf6\x77\x1d\x5c\xee\xf0\xbb\xc9\x70\xc6\xd8\xa7\xd8\xaf\x3b\x8c\x37\xa3\xc5\x6f\xf8\xd3\x67\x2b\x80\x8b\x38\x25\xd9\x8d\xf2\x86\x33\xe1\x80\xb3\xf4\x79\x1c\xaf\xf1\x69\xde\xd7\xed\xa6\x45\xae\x3c\xfe\xb2\x77\xa7\x44\x84\xd6\x57\x74\xe9\x9a\x56\xad\x0f\x54\xbf\x1f\xfa\x54\x99\x67\x34\xa8\x23\xf9\x98\x93\x3f\xd1\xd1\xf4\x6a\x84\x10\xb6\x29\x89\x1d\x8c\x1e\x64\xe5\x68\x3c\x56\xab\xa3\xd7\x8d\xdb\x8b\xd6\x80\x73\x9e\x52\xcb\xe7\xfb\x0c\x06\x93\x2e\x68\x06\xa2\xf8\xba\xd5\xf8\xba\xa1\xd2\x45\x43\x6e\xac\xf8\xae\x51\x19\xf0\xbd\xda\x45\xe1\xc0\x02\x68\x1c\xd8\xe4\x3d\xa6\x75\xdf\x56\xcb\x3e\x9c\xa6\x6f\xd6\xbd\xcc\xb0\xbf\x87\x67\x5c\x1d\x7c\x1d\xcd\xd2\xd2\x72\x1e\x06\x33\x88\xca\xaf\x0d\x96\xd8\xbf\x31\xf8\x4d\xdc\xf0\x89\xc9\xa7\xde\x61\x98\x3f\x72\x81\x09\xc8\xa4\x2b\x54\xbf\x83\x3b\xfc\x23\x59\xb0\xaa\xf8\xc9\x1f\x4c\x58\xb9\x51\x97\xa4\x52\x5c\xad\x94\x80\x3e\xda\x98\x3d\x45\x27\x68\x2c\x66\x8a\xe2\x53\xda\xdc\xb6\xdf\x0c\xb8\x1f\xee\x47\x22\x53\x36\x99\xce\xa1\x19\xe1\x41\x48\x6e\x4e\x98\xac\xcf\x3b\x79\x65\xa9\x7d\xc6\xa5\xfd\x06\x3f\xda\x8b\x0d\x3b\xc6\x11\xa6\x10\xe6\xae\x83\x47\xa8\x13\x14\x09\xf9\x59\x78\x78\xea\x9e\xfa\x8c\xf5\xd4\x34\x0f\xe4\xfb\x0d\x0d\x97\x6a\x65\x57\xd6\x6f\x28\x83\xad\x9f\xe4\x79\x7a\x5d\x5d\xe0\x76\x7d\x63\x90\xee\x5d\x26\xb2\xc3\x5e\x10\xcc\x81\xed\xf0\xfb\xa1\x46\xa6\x64\xce\x9e\x1c\x75\x6c\x8b\x9e\xd6\x00\x64\xe7\xb4\x49\x61\x93\x5c\x63\x61\x7d\xf7\xc3\x97\x05\x05\x47\xf2\xdb\xc0\xeb\x58\x27\xfa\xc2\x3f\x50\x19\x00\x29\x84\x46\x0f\xc3\x4f\xa6\xfe\x17\xdc\x68\xac\xdf\xd0\x7b\xa1\x81\xf3\x71\x0b\x77\xdf\x74\x22\x65\xfd\xfc\x4d\x2a\xef\x56\xda\x83\x65\xfc\xa3\x88\xe3\xf9\xe7\xa0\xe4\x89\x81\x0d\x02\x3e\x9b\xad\xff\xd9\x3b\x68\x89\x53\x07\x5e\xcf\xd1\x3e\x6f\xe9\x6c\xcd\x7c\x5e\xd6\x9e\xa4\x0f\xbb\x82\x14\x79\x01\xd5\x83\x1f\x10\x82\x4e\xe3\x6d\x36\x34\x32\x85\x65\x90\xcf\xcf\xcb\x6e\x20\x25\x8d\x08\x2c\xf5\x18\x6a\xbe\x76\x69\x9e\x82\xd6\xf7\x46\xa5\xdd\x16\x1e\x61\x4c\x69\xaf\xdb\x9d\x52\x55\xf6\x3c\xfc\x0a\x6f\xeb\x7e\x17\x0b\x33\xd5\x76\x39\x4a\x5b\xfe\x1c\xc4\x1e\xc4\x31\x91\x47\x04\xc3\x5f\x8e\xee\xaa\x48\xbc\x74\xff\x79\xd5\x39\x2d\xe2\xdc\xfe\xb6\x9b\x2a\x30\x19\x9b\x70\x5e\x55\xa7\x55\x84\x3a\x26\x0c\xb3\x6e\xed\xab\x40\xd9\x6d\xf6\x29\xdb\xe6\xa1\x54\xe5\xd3\xa7\xfa\xbd\xdd\x37\x33\x97\x09\x47\x2f\x0f\xc9\x5a\xb7\xcd\xf9\x9e\xce\xf8\x54\x84\x83\x4f\xfd\x41\x4c\xd2\x92\xd6\x43\x1b\xfd\xc8\xb1\x67\x6d\x8c\xe6\x95\x45\x83\xd3\x9a\xdf\x97\x56\x41\x9f\xce\x77\xcf\x84\x5f\xfd\x94\xe9\x59\x1d\x1d\xcf\x7f\x2d\x01\xc1\x24\x6f\x0f\xfb\x69\x39\xf9\xdb\x72\x51\x1c\x32\x97\x90\xbf\xa7\x7d\xf8\x4c\x89\x14\xf9\xa5\x30\x81\xb0\xc6\x8c\x7c\xbf\x0f\xa0\x34\x83\x51\xd7\xf0\x28\xb6\xbc\xfb\xaa\x9a\xba\x48\xee\x7b\x4a\x6c\x58\x0b\x5c\x11\xd7\x2a\xef\x36\x02\xd1\x69\x52\x1f\xb0\x25\xb4\x0a\x52\x0c\x64\xe5\x1e\x95\x0e\xed\xb5\x7f\x51\x7d\xbc\x24\xd7\x62\x45\xd2\x46\xc1\xef\x2e\xf2\x63\xfa\x98\x24\x43\x6a\x05\x92\xd6\x5b\xde\xe5\xe2\x90\xcc\x9e\x36\x91\x97\x29\xc4\x69\xa6\x99\xe9\xf8\x43\xd2\x7c\xeb\x63\x7c\xb3\x86\xe1\x3b\x21\x1b\xb0\xaa\x67\x51\x46\xa3\xa8\x62\xda\x33\xc7\x33\xda\xaa\xf5\xfb\x9a\x8f\xa7\x8d\x61\x5c\x53\x94\xc4\x42\x42\x53\x01\x3e\x84\x5d\x5b\x7b\x12\xb0\x65\xcd\xa1\x7d\x06\x92\xf7\xb2\x67\x39\xd7\x3c\x69\x77\x3c\x79\x33\x26\xd6\xbe\x36\x81\xef\x9c\xf8\x72\xbd\x17\x9d\xc6\x95\x98\xc5\x1e\xea\x5f\x8f\x6f\xe7\x08\x4e\xbf\x53\x30\xb5\xad\x4f\xf8\xb4\xa2\xdc\xa5\xf6\xa4\x41\x9d\x89\x6f\xa1\x33\x30\xe2\x4c\xb7\x32\x38\x82\x2d\x54\x96\xc8\x8b\x8c\x9c\x35\x53\x42\x10\x83\x79\xa4\x1c\x03\xd9\xc6\x55\x1c\x97\x1c\xb3\xfc\xb6\x8c\x69\xe5\x8d\x79\xe8\x36\xd9\xa7\x0b\x91\x06\xb8\x76\x99\x95\x63\x23\x30\xaa\xa8\x6c\x5d\x2c\x2b\xac\x6c\x09\x4b\xfb\xa2\x53\xa2\xe6\xe5\x14\x75\xbc\xbf\x58\x04\xcc\xf6\x87\x2a\x41\x26\xef\x61\xf0\x4e\xc9\x37\x2b\x4f\xfc\xf7\x92\x6b\xf3\x62\x28\x1b\x77\x48\xf6\x8a\xaa\x7e\xc5\x2d\xfa\x51\x5b\xdc\x39\x8c\x9b\xe1\x00\xbc\xef\x40\x04\x7f\x29\x45\x49\x39\x73\xa8\x57\x75\x5a\x15\x82\xcb\xad\x93\x3e\xc3\x51\xb1\x09\xa0\x5c\x53\x90\x67\xdb\x24\x68\x1b\x25\x65\xb4\xdc\x1d\xaf\xc7\x47\x6a\x32\x6a\x80\x06\x1c\xdf\x90\xe1\xe6\x8a\xa5\x37\x67\x4d\x60\x0f\xac\x80\x3c\x80\x4e\x7b\x74\x61\x81\x4a\x95\x83\x7b\xa7\xd7\x36\x85\x4c\x54\xc5\x0b\xf3\xb3\xd6\xcd\x5f\x95\x93\x78\x9a\x7e\xef\xbc\x4a\x9b\xe3\x5b\xdc\x4c\xa7\xe7\x33\x1b\x69\xf1\xf5\xb5\x8e\x0d\x95\xee\x51\x6c\x24\x37\x37\x15\xba\xcd\xfd\x48\x15\x55\x1f\xc0\xb4\xe5\xe5\xaf\xf6\x14\x6e\x57\xb1\x5b\xf1\xd9\xdb\xfc\x9a\x8f\xa6\xdd\x24\xe4\x91\xb1\xfd\x81\x0b\x79\x09\x35\x48\x91\x1a\x1f\x0c\x84\x39\x6b\xec\xc2\x29\x63\xdd\xb8\x19\xcd\x6a\x4e\xd6\xf5\xc7\x8b\x28\x8e\xf7\x13\xf0\x7d\x16\xb5\x8a\xa4\x83\x22\x3e\x83\xf1\xe8\x0b\x4b\xf5\x9a\x1d\x19\x0d\x20\x41\xe6\xcd\xc5\x6e\x91\x20\xdd\xe9\x40\xde\xea\x3b\x85\x33\x3d\x06\x9b\xcf\x84\xab\xbc\x7a\x24\x34\x3d\x72\x96\xc3\x48\x43\xbb\x26\x2c\x28\x4e\x3a\xd3\x4f\x21\x1e\x2d\x39\x3d\x6b\x17\xe8\x12\x31\xfc\xc7\x5b\xd0\x56\x6b\xe0\x67\x86\xce\x14\x97\x5f\x8a\xcf\xd9\xde\xc7\x85\xf9\x16\xe6\x0c\x56\x88\xae\x48\x57\x56\x89\xd1\xc7\xa7\xd2\x6e\x27\x1c\xb6\xaa\x19\x6c\x86\x94\xd7\x69\x96\x5c\xf0\x8b\x3d\x6a\x55\xa8\xc9\x8f\x00\xe5\xfc\x79\x32\x64\xad\x79\xbc\x48\x14\x71\xd4\x6a\x29\xc7\xf4\x86\x1d\x3b\xbb\xc4\x0e\x31\x52\x44\xb5\x47\xcf\x52\xe6\x2e\xf0\x5e\x7d\x0f\x66\xd9\x56\xc1\xcb\xcb\x7f\xd0\xea\x75\x0e\x52\x67\x7f\x3a\x94\x7b\x8d\xfb\xe6\xaf\xae\x99\x8e\x36\xe2\x3b\xde\x3d\xdb\x61\x05\x22\x08\x23\xc5\x27\x8d\x90\xac\x0e\xa0\x37\x24\x27\x71\x95\x4f\xac\x73\x3b\xf0\x0b\xa0\xcf\x3a\x87\xf4\x55\xb6\x78\x2d\x32\xd9\x48\xb8\x7b\x6b\x2e\x43\xb4\x85\xe2\x8a\x9d\x10\xde\x04\xef\xbc\xc2\x76\x69\x48\xff\x71\xe2\xe6\x72\x21\xff\xfe\xf2\x29\x9e\xf8\xf4\xf6\xfc\x46\xa4\x73\xe3\x49\xba\x68\xc6\xe4\xfa\xc0\x51\x71\x10\x7a\x6c\xa5\xb4\x50\x11\x8a\xdd\xed\x98\xa2\x57\xb6\xde\x7b\x54\x6c\xa8\x92\x6e\xb8\xd1\xe1\x73\xe5\x1a\xaa\x6f\xa6\xaa\x61\x6a\x8a\xd7\x17\xb7\x23\x0f\x74\x02\xbd\x1c\x04\x13\x5a\xf7\xe8\xde\x46\xbd\x4f\x64\x64\xfb\xd2\x9f\x23\x95\xc8\xcc\x16\x5f\xe1\x74\x15\x68\xad\xdc\xf6\xea\xf2\x0f\xff\xf7\x75\xc8\x6e\xc7\x7a\xe0\xee\x76\xf7\xee\xe8\x10\x6e\x93\xf9\xf1\x8c\xcf\x3b\x4a\xc9\x10\x72\x91\x56\x8a\x4e\x3a\xcc\x7b\x0a\xda\x46\x55\x7a\x7d\x65\x65\xac\x8c\x9c\x27\x37\x20\x2e\xe7\xf3\x3c\x13\x1d\xa0\x2e\x54\x2f\x3a\xba\x86\x13\xd1\x97\x89\xa5\x78\x5c\x3c\xca\x6e\x98\xef\x7d\x8e\xee\x0d\xd2\x0e\x92\x06\x16\xb7\x46\x51\x4e\x4d\x87\xd7\xd3\xcf\x5b\x88\xcd\xd1\x1b\x7c\x4e\x72\xe2\x8a\x94\x35\x87\x7f\x7d\xe6\x0e\x68\xc5\x36\x15\x28\xe5\x0a\x0d\x61\xdf\xb2\xec\x49\x12\x22\x03\x1e\xc5\x84\xa8\xb7\x01\xee\x02\xce\xfd\x23\x8a\x94\x60\xc9\x1d\xa1\x37\x09\x04\x87\x2d\x5d\x36\x22\xc6\x70\x57\x54\x29\xdc\xf9\x31\x71\xa2\x6a\x48\x21\xe1\x6e\x65\x7c\xea\x8f\x6c\xf1\x92\xcb\x45\xfa\xf0\xd4\x99\x68\x74\x5e\x56\x1d\xa9\x2d\x4b\xad\xf5\xe0\x8f\xf3\x97\xb8\xdf\x40\x2a\x59\xd3\x03\x36\x74\x36\xb2\x41\x7c\x5f\x1d\xc7\x9d\xfb\x9d\x47\xb2\xbe\x61\x6f\xdb\xea\x58\x7f\x3a\x56\x1c\xdf\x0e\xe1\xe0\xc0\x9f\x38\xca\x50\x0f\xc9\xcd\x98\x72\xe9\xfb\x41\x8b\x3c\x51\x3e\x00\x26\xcb\x89\x90\x75\x2d\xe8\x5e\x50\xb5\x34\x3d\x97\x35\xc6\x74\x5e\x5a\xe0\x63\xa7\x1b\x3e\xa0\xfd\x8d\x03\x2f\x72\xc5\x04\x28\x80\x71\xa3\x4c\x5d\x19\xff\x32\xd7\x66\x3e\x6e\x49\x61\x59\x12\xee\x71\x90\x81\xdc\xc2\x94\xef\xf4\xf6\x94\xb1\x84\xe8\xa0\xa5\x7a\x39\xb2\xb6\xf8\x7b\x0e\x8d\x21\x0b\x3c\x51\xa1\x75\x9e\x58\xad\x39\xcf\x09\x34\x05\x25\xeb\xa6\xf7\x52\xf6\x69\x67\x9d\xb9\x1b\x7a\xea\xf8\xa9\x79\x7a\x53\xd9\xbf\xb4\x55\xbb\x40\x7f\xb1\xfc\x98\x5b\xce\x04\x2e\xba\x66\x05\xa4\x2a\x66\x66\x35\xff\x31\x4d\x8f\x8b\x2a\x74\xfe\xd6\x5f\x9d\x71\x9e\x9d\x3b\x34\x65\xec\x22\x99\x18\xf0\xb2\x0d\xb2\xc0\x1d\x26\x1c\x55\xc8\x22\xf2\x8a\xc6\x44\x58\xf7\x9c\x37\xdd\xff\xe1\x8e\x8e\x44\xee\x99\xe2\x3a\xd5\x82\x19\xe2\x39\x7d\xeb\x61\x95\x57\x92\xfa\x36\x51\xdf\xd8\x24\x7d\xf5\xfa\x2e\x2d\x0e\xe0\xac\xdc\xcc\xd3\xc3\xf4\xf3\xb1\x92\xcb\x9a\x3c\xbb\xe0\x43\x98\x8f\xda\xec\x29\xa7\x01\xef\x64\xa0\x26\x0d\x80\xd5\x02\x2e\xe6\x0c\xe5\x3e\x94\x0a\x67\x67\xad\xdc\x3d\x28\x42\x57\xeb\xa1\xd2\x5f\x8b\xf6\x61\x42\x54\x84\xb4\x67\xbf\xad\xdf\xce\x94\x5f\x1b\x1e\x6a\x05\xe9\x37\x27\x2c\x42\xf7\x34\x1c\x0e\x7f\x4c\xac\x10\x8a\xc4\xc1\x4f\x07\x96\xb3\xba\x59\x0e\x26\x1f\x64\x7c\xdf\x20\x17\x30\xfe\xe5\x12\xb4\xb0\x41\x4d\xc8\x8c\x93\x33\x4f\xb9\xa1\x53\xe4\x72\x6d\xb1\xb5\xc2\x36\xde\x1b\x9e\x79\xd1\xde\xcb\x9c\x3e\x3e\x9f\x97\xa8\x62\x95\xe5\xc3\xc5\xa6\x50\xa1\xc4\xb0\x7e\x42\xb4\xf7\xaf\x37\x54\xd7\xeb\x04\xaf\xb0\x1f\x4d\x2d\x3b\xfb\x31\x28\xe5\x30\xa3\x31\xbf\xc6\x3f\x35\xff\xb4\x14\xa8\xdb\xa9\x86\x2d\xf7\x2c\x17\xae\x2b\x99\x60\x41\x16\xb3\x84\xa0\x8d\xae\x7c\x42\x48\xf6\x24\xfa\xaf\xa5\x6b\x0c\xea\x3e\x2b\xce\x77\xd4\x9b\x84\x51\xb1\xd2\xb2\x0a\xc3\x14\x03\xac\x7b\xd8\x40\x13\xb7\xa4\x7c\xd2\x17\x91\xd5\x71\xeb\x87\x93\x6d\xfe\x3f\xf7\x79\xcc\xbb\x10\x6e\xbb\x20\x50\x52\x3a\x68\x89\x25\xbd\xed\xa5\x5a\x04\x89\xbe\xa1\xbb\xb4\xb7\xfc\xdb\xb9\x57\x5c\x08\x49\x2c\x29\xb6\x18\x2a\xec\x43\x8a\xd3\x2e\xd0\xdf\xdf\x4f\x96\x4f\x20\xaf\xd0\x83\x0c\x89\x8a\xbc\xe1\x65\xb9\x38\xbb\x43\x9e\x2d\x4b\x0c\x89\xbb\x3f\xf5\x62\x55\x89\xff\xb8\x83\x63\xbb\xb7\x42\xda\x85\x2d\xbc\x0d\xdf\xbd\x23\xd4\x07\x71\xfc\x31\x96\xdb\x22\xfb\x54\x2d\x36\xb5\xfa\x62\x7e\xad\xf1\x8f\x22\x20\x2f\x1e\xb9\x9e\x76\x42\xf5\xf6\x41\x23\xe2\xd5\x5c\xee\xc2\xa9\xaf\x63\x83\xd7\x43\x46\x03\x2c\x97\xd2\x76\x2a\x74\xef\x83\x7b\x8c\xf4\xc9\xd7\xc8\x08\xdf\x84\x93\x88\x0b\x69\xe5\x5f\x08\x77\x3b\x88\x05\xc5\x44\x00\x5e\x23\xc3\xda\xe6\x93\x3b\x1c\xb3\x0a\x4b\xc4\xe0\x8f\x2b\xe9\xed\x98\x9f\xa7\xcd\x9c\x3f\xdd\xca\x09\xdd\x4a\xa0\x9f\x01\x2b\x20\x6e\x1d\xf2\xaf\x2e\xf4\x6d\x3a\x1e\x33\x47\x3c\x7f\x2a\xa3\x75\xd4\xda\x7e\x61\xbd\x49\x83\xc1\x81\x5f\x18\xf4\x2a\xb6\x35\x44\xbc\xa2\xfd\x55\x53\x15\xd3\x4c\x8e\x95\xa8\x1f\x69\x0f\x44\xb7\xc8\x3e\x12\xb7\x8f\x8c\x33\xb9\x58\x4d\x5c\x8b\xdb\xa5\x69\xd4\xbf\x4a\xbc\xc7\x76\x14\x1b\x37\xfc\xa7\x3f\x16\xd3\x9f\xfb\x00\x44\xfc\xb4\x66\x02\x37\x1b\x21\xea\xe9\x8b\xe9\xc9\xe9\xc8\xf4\xa5\xf4\x79\x01\x29\x4b\x1f\xeb\x49\xeb\x63\x4b\xe9\xbe\xbe\x5e\xab\x81\xc4\x01\x68\xd4\x0e\x89\x13\xd1\x01\x5f\x33\x6b\x33\xff\x21\xc1\xa1\xea\x61\x99\xe8\xb6\x68\xa2\xe8\xa5\x47\xa6\x47\xb3\xbb\xeb\xd6\x8f\x9f\x25\xbd\x3b\xfd\xc2\xe1\x07\x64\x2d\x98\xbe\x34\x7f\x38\x99\x48\x72\xf1\xcc\x78\xd7\x44\xd7\x88\x03\x02\x02\xe8\x02\x8c\xaf\x84\xaf\xd2\xce\x64\x28\x9e\x91\xe3\x91\xa5\xb3\xd4\xa9\x69\xa9\xd7\x7d\x70\xcf\x7a\x96\xf6\x2d\x5c\x2b\x76\x1a\xd3\x92\x73\x83\x7c\x83\x90\xd5\x88\xd5\x40\xc1\x45\xc1\x20\x2a\x97\xcc\x9a\x4c\x88\x42\xfd\xd9\x32\xcb\xa5\xa6\xa4\x4a\x40\xc6\x93\xa4\xb0\x5c\x85\xd4\x81\xb7\x5f\x88\xc9\x49\xd9\x1e\x54\x1d\x55\xec\x54\x34\x12\x24\x59\xfd\xd4\x51\x39\xc9\x19\x7a\x11\x30\x92\x72\xb7\x59\x8f\x59\x63\x9b\x18\x9b\xd2\x61\xc9\xc4\x94\x04\xef\x84\x89\x84\x1c\xd6\x0e\xe5\xcb\x8c\xec\x5c\x93\x8c\x15\xcc\x6b\xb6\x6b\x75\x02\x57\x82\x5b\xca\x58\xca\x31\x4a\x5f\x0e\x31\x0e\x80\xee\x52\xc1\x78\x59\x55\xb1\x73\x81\x49\x49\x80\xca\x75\x08\x41\xd2\xd7\x10\xb5\xec\xd1\xb7\x29\xca\x15\xaf\x2a\xf0\x2b\xfc\x61\x0a\x30\x24\x2c\xb7\x1c\xf7\xfb\x89\xf6\x63\x81\x65\xa1\x76\x7e\xbd\x12\x38\xe4\x37\x1d\xaf\x30\x2f\x7f\x35\xb5\x0b\xdf\x11\x17\x23\x49\x0e\x51\x8e\xa9\xb8\x9b\x97\xad\x97\x84\x57\xf7\x9e\xc3\x5e\xc9\x3e\xef\x6c\x47\xc1\x54\xd9\x49\xa9\x79\x59\xb7\xea\x63\x64\x48\x8e\x5c\x74\x2f\xbb\xfc\x8b\xfe\x27\xfd\xde\x11\xfc\x31\x7a\x31\x9b\x31\x76\x74\xab\x42\xfa\x82\xcd\x42\x93\x02\x6b\x76\xac\x96\x89\x16\xd0\xbe\xed\xc4\xf2\xb8\x7d\xc9\x39\x1a\x3b\x1f\x3b\x61\x3b\xdf\xf1\x92\xf1\xfb\xf1\xd7\x19\xe6\xf5\xcf\xea\xdc\xa1\xf6\x0d\xa2\x96\x23\xb6\xfb\xb6\x08\x4b\xbf\x21\xb5\x21\xae\x9f\x8e\xa9\x70\xa9\x03\x9c\x79\x5f\x67\x36\x67\x2c\x67\x6a\xc4\x5d\x9d\x30\x34\xd0\x1a\x64\xbb\x60\xed\x3b\x68\xd6\x2f\x3d\x15\x94\x72\xcf\x8f\x4b\x1a\xf9\x34\xd2\x3a\xee\x43\x9c\xb7\x06\xb9\x06\x77\xf6\x9a\x98\xba\xa8\x84\x58\x9a\x18\xbf\xbb\xf4\x0e\xeb\xaf\xc4\x5f\xd0\xc9\x9d\x2c\xa7\xe4\x83\x77\x22\xef\x5a\xc8\x5b\x78\x5d\xd7\x57\x26\x56\xc6\x56\x5a\xda\x82\xd1\xcf\xd0\x02\xa8\x53\xf4\x30\xca\x1e\x2d\xba\xc5\xb9\xed\xb0\x41\xb7\x8b\xd8\x6c\x1b\x8d\x1e\x51\x9f\xe2\xc6\xc3\xc8\x03\x57\x88\xfd\x63\x0b\xc5\xa4\xc1\x28\xc3\x20\xfb\x47\xb5\x8a\xf5\x8f\x3f\xf4\x6f\x83\xa8\x2c\x06\x1f\xc6\x3f\x2e\xcf\xea\xc4\x4e\x0d\x2c\xb9\x67\x3f\x1f\x7d\xf3\x13\xec\x89\x12\xc2\xfa\x90\x04\x84\x0c\xfc\x91\xac\x9f\xf5\x86\x14\xce\x5e\xaa\xb1\x7d\x4d\xf5\x44\x79\x78\x06\xec\xbd\x78\xfd\x45\x73\x43\x68\x71\x11\x78\x85\x47\xad\xc4\xdc\xbb\x56\x56\x27\xf0\xe0\x6b\x18\xc2\x12\xfe\x66\xcd\x0e\x14\x2c\x21\x22\x24\x21\xd4\x81\xd1\xf7\x29\xe2\xe9\x99\xa2\x80\x3c\xdd\x5f\xd4\x44\x1e\x52\xbb\x35\x63\xf4\xbf\x3d\x8a\x80\x3f\xdf\x1e\x53\xe2\xd6\x45\x2b\x0f\xca\xb1\x9d\x95\x7f\x22\x5a\x30\x7a\x7f\x26\x4a\xbe\x14\xba\x89\xaf\xd0\xe4\x3b\xf8\x02\x15\xb9\xa9\x3a\xbe\x6f\x39\xa8\xbb\xdd\xba\x89\x77\x0d\x3c\x23\x2c\xbc\xf1\x25\xa9\xad\x3b\x5f\xe6\xeb\xc6\x11\xa8\x94\xb9\xee\x72\x4b\x5d\xd5\x68\xd8\x5c\x8c\x6d\x07\x2d\xc5\xd2\xcf\xcf\xc8\x52\x30\x9a\xf4\xc9\xd1\x51\x35\x5a\xfc\xca\x05\x93\x51\x5b\x45\x25\x11\xdc\x39\x59\x30\x94\x7d\x07\xaf\x16\x04\x66\xf1\x1c\x56\x0a\x94\x1b\x86\x8e\x59\x12\x29\x9c\x6e\xd7\x0c\x37\x0d\x37\x01\xfc\x94\xc6\x00\x3e\x1b\x4f\x0c\x6d\xea\x06\x75\xff\xd4\x47\x3e\x3f\x12\x8c\xd9\x29\x1c\xd8\x17\x08\xca\x90\xc6\x6d\xe4\xdf\xfa\x4a\xef\xa1\x16\x93\x4b\xad\x4c\xe0\xf0\x1c\x75\x2e\x79\x3f\xfb\xd2\xfa\x3e\xa6\x51\x80\xf2\xa0\x85\x8e\x56\x3e\x48\x19\x4a\x12\xff\xc8\x00\xc5\x0d\x15\xb3\xb5\x25\x52\xf7\xa9\xac\xad\x9e\x86\xe2\x18\x2f\xb5\xf8\x33\xbd\xd2\xe7\x98\xfb\xfe\xc6\x31\xe5\x4d\x38\xe0\x8b\x91\x87\x67\x08\xa3\x48\x3d\x0f\x88\x5c\x7d\xa5\xe4\x01\xb8\xb3\x97\x45\x5f\x7d\xb1\x23\xb0\x7f\x84\x4a\xcd\x1a\x5f\xd6\x36\xf4\x87\x11\x54\xdc\x67\x01\x72\x0d\xa9\xd3\xdb\xa6\x9b\x21\x52\x23\x63\x0b\x96\x75\xe2\xc6\x8e\x8a\xab\x85\x79\xf8\x55\x50\xc2\xf7\x46\x87\x46\x8f\x52\xae\xe7\x77\xe0\x56\x8b\x09\x12\x6e\x32\xb7\x89\x7b\x2c\xd8\x78\x7c\xfc\xdf\xfd\x71\x81\x7f\x4c\xd6\x05\xd1\x2a\x6d\x1c\x86\xd2\x94\xbd\x5f\x35\x45\xdf\x8f\x88\xa9\x22\xb1\xd9\xbc\x7d\xff\x60\xf1\xe2\xa8\x49\xb8\x94\x77\x74\x68\xf9\xfe\x6b\xd1\xa4\x3b\x1f\x67\x36\x41\xd7\x52\xa2\x1b\xd0\xa6\x92\x42\x9c\xb6\x25\x35\x0b\xdd\x22\x69\x3a\xfb\xc1\x66\x44\x39\x77\x93\x7b\xfa\x48\x7d\xa4\x39\xbd\xec\x9f\x59\x36\xc8\x74\x92\xd9\x79\x29\xe9\xdc\x74\x5a\xd0\x39\x08\x03\x9b\x6e\xc0\x22\x42\xc0\x65\xdf\xb8\xd4\x1a\x62\x67\xc1\x21\x24\x48\x21\x24\x44\xd8\x20\xc8\xd0\x20\x84\x0b\x16\xa4\x01\x0b\x91\xa2\x05\x99\xd1\x0d\x18\x84\xd0\x67\x84\x0d\x78\xd1\x50\xda\xe8\x86\x27\xb6\x9f\x15\xc2\xb9\xc4\x70\x84\x91\x6f\x81\x89\x25\xc0\x4c\x5b\x60\xea\x0e\x30\x97\x24\xe2\x4d\x36\xd9\x5b\x87\x7a\x2c\x6e\x28\x39\x77\x03\x41\x25\x94\xbe\xb2\xe1\xa9\x13\x94\xda\xa9\x81\xe4\x00\xca\x74\xd0\x80\xc9\x00\x25\x63\x68\xc0\xcf\x82\xd2\x65\x35\xe0\x18\x43\xa9\x8c\x1b\x88\x97\xa1\x8c\xcb\x42\x89\xda\x31\x76\xb8\x3e\x36\x44\x12\x6f\x52\x3d\x62\x40\xa0\x57\x75\x84\x1d\xb3\x23\xae\x87\x93\x4c\x1f\x6c\x34\x2f\xad\xdc\x2e\x2d\xcd\x2f\xad\x83\x49\x7e\xc9\x92\x6c\x5a\x90\x6c\x68\x91\x6c\xb9\xc7\xfd\x52\x89\xdb\xb4\x8f\xdb\x00\xc6\x6d\x7d\xd2\xf8\xa5\xa0\xb1\x69\xa3\x61\x49\x5e\xe6\x49\x5f\x06\x7e\x0a\xf2\xa4\x06\x81\x49\x76\x3d\x99\x76\xc1\x98\x34\x9e\x64\x34\x60\xfc\x34\x4f\xba\x34\x30\x8e\x81\x27\x95\x01\x98\x78\xc1\x93\x71\x01\x8c\x2d\xe2\x49\x21\x02\x26\x6c\xf1\x64\x68\x01\xe3\xfa\x7a\xd2\xf8\x82\x49\xff\x78\x32\xff\x41\x63\x90\xa2\x9e\x91\xa2\xf1\xe2\x51\xb4\xf1\xe8\x27\x9a\x28\x4a\x4d\x34\xd1\x14\xea\xf9\x14\x1a\x8b\x1f\x45\xce\x8f\x26\xa8\x45\xd1\xd7\xa2\x9f\xba\xa1\xa8\xdd\xd0\x24\x27\x28\xa6\x13\x34\x26\x33\x8a\x8c\x19\x8d\x9f\x87\xa2\xcb\x43\xe3\x98\xa3\xa8\xcc\xd1\xc4\xeb\x28\xc6\x75\x34\xb6\x0c\x8a\x42\x06\x4d\xd8\x8d\x62\xe8\x46\xe3\x06\xa3\x68\x82\xd1\xa4\x8f\x76\x25\x31\x3c\x63\xed\xb6\xab\x0d\x4c\x86\x53\x55\x06\xf3\x12\x07\x49\x80\xa4\x8b\x89\x1b\xdb\xfb\x06\x4c\x44\x8f\xb3\xd6\x3b\x51\x86\x59\xf0\x76\xfa\x97\x2d\x65\x45\x7f\x9d\xda\xc9\xfa\xa2\xb1\x9d\x38\x54\x32\x60\x07\x05\x23\x16\x69\xa3\x6a\x41\xfe\x76\xdd\x98\x5f\x69\x5f\x76\x97\x58\xca\x32\x3a\x9a\x30\x5e\xcd\xca\xa1\x18\x6a\x92\x21\x62\x98\x69\x4a\xa1\x9d\x6f\xf2\x73\xfa\xb4\x40\x1d\xe4\x37\xe3\x28\x63\x22\xa4\xcf\xed\xab\x98\xc9\xb4\x70\xde\x5a\xfa\x53\x58\xaa\x62\x5f\x1b\x10\xef\x08\xd1\x9b\x2b\xca\xdc\xe1\xe8\xf0\xe9\xd3\x58\xfc\x52\xe1\xc4\x7f\x18\xde\x59\x47\x73\x99\xd8\x69\xc3\x8f\x50\xff\xb7\xa3\xd6\xea\xbf\xe0\xf8\x7f\xc3\xdf\x92\xb6\x3c\x76\xb4\x73\xe9\xe9\xdf\xd6\xb9\x62\xe6\xfa\x77\xe4\x3e\xa4\xf2\x21\x90\x08\xcf\xe9\xd3\xb0\x2c\xab\xd3\x5d\xe4\x3c\x84\x1d\xc2\x7e\x54\xda\xd5\xfe\x27\xae\xff\x05\xcb\xff\x60\xe6\x38\xfc\x58\xfe\x4f\xc1\xad\xfd\xa3\xb6\xc6\xda\x0a\xef\x4a\xf9\x14\x7c\xf5\x92\xb1\xe0\x71\x3a\x78\x4f\xe6\x9c\xf9\x86\xf4\x3e\xf2\x41\xfe\xf1\xff\x31\xae\x46\xfe\x53\x7a\x3a\x70\x4f\xda\x8b\xb9\xfd\xff\x44\xca\xcb\xff\xcf\xca\xf1\xe5\xc7\x0c\x3e\xf4\x47\x1a\x8e\x8f\x2a\x80\x35\xf7\x24\x63\x8f\x4a\xd7\x63\xea\x9a\xa9\x03\x99\xfd\x7f\x47\xfa\x7f\x90\xda\x97\xfc\xdf\x88\xff\x3b\x1f\x4b\xa5\x4f\xfe\x1c\x88\xff\xe7\xe5\x75\xb0\xd4\xbe\xc4\x7f\xa7\x29\xb0\x05\x59\xad\x3f\x35\x69\xbf\x43\xa6\x5b\xba\xb7\x77\x45\xda\x52\x81\x70\xfb\x7b\xa0\x63\xea\x69\xf5\xd3\x60\x60\xfc\xaf\x00\x00\x00\xff\xff\xea\x14\x7b\xf5\x80\x5b\x00\x00")
-
-func _staticFontsGlyphiconsHalflingsRegularWoffBytes() ([]byte, error) {
-	return bindataRead(
-		__staticFontsGlyphiconsHalflingsRegularWoff,
-		"_static/fonts/glyphicons-halflings-regular.woff",
-	)
-}
-
-func _staticFontsGlyphiconsHalflingsRegularWoff() (*asset, error) {
-	bytes, err := _staticFontsGlyphiconsHalflingsRegularWoffBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/fonts/glyphicons-halflings-regular.woff", size: 23424, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticFontsGlyphiconsHalflingsRegularWoff2 = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x00\x27\x40\xd8\xbf\x77\x4f\x46\x32\x00\x01\x00\x00\x00\x00\x46\x6c\x00\x0f\x00\x00\x00\x00\xb1\x5c\x00\x00\x46\x09\x00\x01\x02\x4d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x3f\x46\x46\x54\x4d\x1c\x1a\x20\x06\x60\x00\x8c\x72\x08\x04\x11\x08\x0a\x82\xa9\x24\x81\xe5\x65\x01\x36\x02\x24\x03\x86\x74\x0b\x84\x30\x00\x04\x20\x05\x87\x22\x07\x95\x51\x3f\x77\x65\x62\x66\x06\x1b\x65\x8c\x35\xec\x98\x8f\x80\xf3\x40\xa0\xc2\x3f\xfe\xbe\x08\x0a\xda\xf6\x88\x20\x1b\xac\x74\xf6\xff\x9f\x94\xa0\x86\x8c\xc1\x93\xc7\xd8\xdc\x2c\x33\x2b\x0f\x32\x71\x0d\xcb\x46\x16\xae\x59\x4f\x1b\xec\x04\x26\x3e\xb1\xe9\xb1\x62\xd2\x6d\xa4\x35\x81\x5a\x8e\xe6\x48\x24\xb1\xaa\x59\x8a\x19\x9c\xbd\x7b\xec\x48\x09\x6a\x64\x86\x0b\xd5\x89\x0c\xfc\xb2\x25\xf7\xb9\xd9\xa7\x7f\x79\x22\xa7\x04\x03\xf7\xf6\xd7\x2b\x8d\x40\xb9\x8c\x5d\xbd\xab\x65\x9f\xfb\x7b\xfa\xd2\xfb\x76\x90\xa0\x4e\x63\x8b\x29\x0e\xbb\x6e\xf9\x93\xc9\x3f\x7e\x3f\xe8\x90\xa4\x68\xf2\x00\xff\x9e\x5f\xe7\x26\x69\x1f\xc6\xca\xc8\x1d\xd1\x9d\x05\xc1\xa4\x8b\x3f\xba\x3e\xfc\xe5\x5e\x4b\x20\xfb\x76\xb4\x2d\x1b\x63\xdb\x8d\x31\xed\xf4\x80\x12\x89\x32\x4b\xa0\xe1\x79\xb7\xf5\x2c\x27\x6e\xe4\xab\xe0\x00\x07\x28\xf0\x33\x45\x77\x69\xe0\x42\x05\x17\x82\xa0\x26\xa9\xff\x0b\xe9\xce\x54\xb4\x6c\x68\xd8\x30\x4d\x1a\xd2\xd8\xda\xd2\x86\x64\xe5\x59\xd8\x72\xf1\xef\xb2\xac\xb3\x6e\x74\x69\xde\x5d\xdd\x79\x75\x72\x95\xb4\xbc\xae\xfb\x99\xee\x13\x56\x58\x73\x6a\x0e\xa1\xa2\x9e\xe5\x67\x4d\x6e\x19\xaa\xd3\x99\x48\x81\x57\x95\xa9\xc3\xd4\x08\x20\x72\x32\xf4\x3e\x69\x54\x60\x56\x37\xb8\xcd\x52\x28\xb1\xa8\xc9\xcf\x1a\xe0\xff\x2b\xa0\x6f\x36\xf3\x27\x63\xc5\xc8\x42\xb0\x8f\xe6\x06\xeb\x34\xe7\xb7\xd6\xce\xb9\xdd\x7f\x87\x83\x8b\xe3\xbf\x9a\xae\x54\x09\x5d\x61\x5b\x51\x64\x3c\x33\x77\x71\x38\x2c\x8e\x85\xee\x72\x54\x49\x16\xa1\x38\xe1\x12\x1c\x8e\x30\x3e\x45\xb8\x3f\xf9\x2a\x45\xa8\xe7\x97\xa6\x95\x23\xcf\xfa\x37\x27\x8d\xec\xdd\xfd\x86\x53\x09\x0c\x0b\x6f\x63\xfb\xca\xb7\xed\x5f\x8b\x37\x26\x23\x2a\xd1\x2b\x29\xbd\xb3\xa0\xd0\x2b\x34\x61\x01\xb0\x41\x36\xb6\x63\x8c\xe7\x79\xb1\xd9\xa3\x86\x66\x28\x62\x19\x46\xe9\xe6\xe4\xff\xe9\xb4\x24\x3b\x7b\x20\x59\x41\xc3\x31\x76\x50\x2d\x74\x47\xf8\xff\x8c\xe1\xc0\xb1\x15\xcd\x22\xfb\xb0\x95\xdb\x01\x43\xc2\x66\x2d\x20\x57\x82\xf7\xae\x02\x9a\xd4\x99\xce\x75\x4b\xd6\xb0\x4b\x08\xe3\x23\xad\xed\x0e\xe4\xac\xd6\x2a\x4b\x86\x3c\xdb\xfc\x04\x20\x28\xd1\xdf\xeb\xd4\xf7\xd7\xff\x5a\xfe\x60\xd9\xab\x0c\xe5\xb5\x5b\x97\x25\xea\x59\x05\x54\x8a\xac\x7b\x25\xaf\xc9\x8a\x24\x88\xad\x10\xa0\x73\x7b\x6f\xef\xed\x19\x9c\x17\xf2\x88\x83\x18\xd5\xbb\xef\x76\x74\x22\x70\xe0\x9c\x34\x60\xc8\xea\xdf\xa9\x8a\xcf\xa4\x7d\x6f\x0c\x60\xe3\xb3\xdd\x14\xfa\x27\x6e\x14\x65\xdc\x3e\x0a\xe4\x47\x1e\x35\x73\x00\x7a\xf3\x5f\x4e\xf3\x0a\x92\x50\x4b\xd3\xa6\x76\x6d\x55\xde\x0b\xc9\xbe\x7b\x7a\xbd\xdc\xf2\xee\xf3\xff\x9f\x19\xf8\x7f\xf8\x8c\x87\x22\x33\x60\x6c\x0d\x92\x1c\x03\x96\x57\x23\xd4\x90\xbd\x5e\x18\x40\x2b\x8d\x2c\x07\x63\xb9\xc9\x6b\x6f\xa9\x8d\x41\x4f\x1b\x70\x15\x6e\x75\xb5\xa7\xd4\x7a\xf3\x96\x7a\x4a\x29\xf5\xdc\xce\xa5\x1e\xf7\x98\xdb\x31\xc7\x7d\xe1\xe1\xff\x4f\x3d\xde\x7f\xad\xb6\x80\x78\xbe\x52\xc6\xc4\x60\xc1\x4a\x89\x60\xa7\x71\x83\x15\xa5\x82\x55\x73\x2f\xbf\x2b\xf8\x6b\xd2\x76\xc7\x31\x78\x6c\x1a\xdd\xdf\xe7\x6a\x12\x6c\x05\x96\x45\x6c\xec\x5c\x6e\x44\x8c\xc8\xc3\xc6\xb6\xaf\x56\x86\xb1\xe6\xff\xd0\x6a\x67\x9b\x7b\x5a\x64\xe5\x89\x08\x7a\x37\x8f\x0b\x14\x35\xff\xdf\x21\x04\x78\x6d\xc2\x35\x6f\xc2\x5b\x07\x1c\xbd\xbf\x75\xef\xfb\x26\xb7\xaf\x31\xda\x82\x48\x10\x42\x6b\x41\xe2\xec\x08\x71\x72\xea\xce\x52\x90\xc4\x0d\xa3\xb7\xb0\x16\x8f\x28\x5c\x67\x68\xf4\x10\x14\xc8\x37\x89\xec\xd2\x8a\x79\x8f\x3d\x86\x48\xb5\x5a\xf3\x55\x50\x68\xe9\xd0\x24\x38\x10\x52\x67\xd3\x05\xc4\x18\x80\x7a\xc2\x67\xcd\xad\xc9\x4e\x3a\x8b\xe0\x00\x31\x75\xac\x24\xdc\x85\xaf\xa4\xa1\x9e\x3e\x52\xfd\x5d\xe5\xfa\x14\x97\x0e\xa6\xac\x22\x9f\xf4\x66\x37\xbc\x97\x0b\xf5\x4b\xaf\x5e\x7f\x27\x98\xaa\xeb\xbd\x33\x93\x2b\x45\x2f\xbc\xc4\x5e\x89\x59\x55\x35\x5d\xa8\x4e\x42\x17\x2e\xde\xca\x8b\xa5\xd2\x38\xdf\xc5\x2b\xce\xcd\x8f\x38\x8f\xf9\x2c\x7c\x89\x7b\x4d\x7c\x8c\x41\x8a\x11\xe5\x75\x61\x7c\xd0\x61\x88\x92\x7f\xec\x8e\xd5\xde\xcb\x85\xd5\x9d\x25\x00\x0a\x7f\x6c\x4b\x47\xa2\x50\xed\x2c\x17\x4e\x75\x14\xe6\xdc\xd4\x6b\xfe\x63\xef\x38\x6d\x58\x40\xda\xd3\x64\xff\x17\xcc\x98\x3f\x7f\xf1\xfc\xda\xf3\xa2\xe7\x59\xd3\x26\xcf\xfe\x7b\xea\xf6\x94\xf8\xb3\xc7\xcf\x0e\x3f\xdb\x50\xdc\x28\xae\x14\x47\x8a\x5d\xb6\xbf\xcf\xc6\xe4\xaf\xe4\x4f\xe4\x8f\xe4\xb7\xe4\xd7\xe4\x97\xe4\x06\x72\x2d\xb9\x92\x5c\x4c\x46\xc9\x08\x39\x97\x2c\x26\xa7\x93\x79\xe4\x38\x72\xac\xfb\xb4\xfb\x33\xf7\x1f\xdc\x9f\xb8\x3f\x70\xbf\xef\x3e\xea\x7e\xdb\xfd\xba\xfb\x15\x73\xe8\xe4\xff\x05\x8b\x9e\x12\xd0\x10\xa2\xd3\x44\xc7\x08\xa4\x7a\xf3\x31\xfa\xa1\x3f\x12\x5c\x55\x35\x16\x71\x3d\xad\xd9\x74\xd1\x7a\xd4\x92\x0e\x86\x26\x5a\x90\x6e\x6a\xa2\x25\xe9\x6d\x4d\xb4\x22\x7d\xbc\x89\xd6\x74\x6b\xde\x44\x1b\xba\x77\x68\xa2\x2d\x3d\xff\x6d\xa2\x1d\xbd\x42\x13\xed\xe9\xf5\x37\x36\xf1\xf4\xae\x83\x26\x3a\xd2\xbb\xc9\x71\x74\x22\x0b\xd6\x31\x3a\x93\x15\xac\xeb\xd0\x95\xac\xa9\x75\x3b\xf4\x22\x4b\x5f\xa1\x2f\x4a\x64\xd6\x63\x30\x96\x6c\xb2\xf5\x1c\x30\x1d\xb2\xc5\x27\x5e\x03\x16\x42\xb6\xf9\x38\x56\x43\xb6\xd5\x7a\x67\xb0\x06\xb2\xbd\xac\x0f\x06\x5b\x20\x3b\xca\xfa\x64\xec\x0a\xd9\x59\xd6\x17\x62\x7f\xc8\x83\x75\x1d\x0e\x87\xbc\xa9\x75\x3b\x9c\x40\x9e\x2a\x7d\x01\x06\x79\x89\x7c\x0c\x2e\xa9\xac\x27\x43\x3e\x13\x5c\x67\x3d\x1b\xf2\x39\xe0\x56\xeb\xc5\x90\xaf\x01\xb7\x5b\x6f\x86\x7c\x67\xf0\xa0\xf5\x5e\x90\x1f\x0c\x9e\xb6\x3e\x8a\xfc\x64\xbc\x0a\x39\xad\xcf\xc7\xfb\xe4\x17\xee\xd8\xc5\xf8\x94\x0a\x2a\x45\x7c\x41\xa5\x0e\x8f\xe3\x1b\x2a\x4d\xad\x5b\xe3\x5b\x2a\x6d\x4f\xdc\x0e\xdf\x51\xe9\x7a\x1c\x3f\x50\xe9\x6e\xdd\x1b\x3f\x52\x29\x59\x8f\xc1\x6f\x54\x26\x5b\xcf\x01\x55\x2a\x8b\x95\x35\x10\xc0\x53\xd9\x0c\x4d\x42\x0b\xfe\xec\x04\xa1\xa5\xf0\x5b\xb3\x13\x0a\xad\x84\xdf\x6f\x59\x44\x68\xad\x18\xd5\x7b\xec\xd1\x2c\x7d\x31\x3c\x66\x8d\x26\x02\x36\x68\xa4\x18\x86\x27\x8f\x81\x7f\x07\xca\xa5\x55\x23\x56\xa8\x08\xde\x11\xad\xbc\x1f\xa1\xa5\x45\xfb\x44\x7f\x22\x54\xc1\xa5\xde\xa9\xd0\x06\xa2\x41\x44\x39\x83\xff\x65\x42\xb9\x3a\xa9\x07\x06\x15\xc4\x25\x0f\x02\x4f\x9c\xc1\x20\xd2\xd0\x04\x13\xf1\x82\x87\xd8\x46\x12\x75\x8a\x6e\x20\x16\x37\x3f\x25\x52\x03\x47\x34\x22\x84\xb8\x66\xa9\x67\xc7\xc1\x46\xea\xba\x81\x20\x0e\x61\x3d\x82\x91\x2d\xb3\xd5\x51\x97\xe1\xbd\x79\x2b\x42\xbd\x2c\xc4\xf8\xd0\x32\xc3\xcb\x13\xd6\x85\x35\xa2\x95\x87\xf0\x99\x84\x8c\x78\x6e\xa1\x05\x15\xce\xaa\x04\x66\x2a\x21\xa1\xb3\xe6\xeb\x6c\xe2\x7c\x47\x58\x51\xf8\x20\xde\x83\x55\x70\x94\xe4\x0a\xc5\x45\x75\x20\xc2\x40\x9e\x01\x8f\xa6\xe5\x2d\xf0\x80\x04\x1f\x44\x6f\x2e\x36\x59\x5a\xf6\x82\x2d\x26\x61\x3e\x66\x12\x3f\x9b\x00\xab\x93\x4e\x8f\x4e\x9c\xa2\x09\x5d\xd4\x4f\x2f\x5e\x3b\x5c\xda\x0e\xc2\x4a\xca\x0d\xcf\x42\x06\xc7\x45\x73\x4a\x72\x19\x04\xaa\x90\x19\x01\xa0\xc4\x9a\xf5\xc6\x0f\x14\x27\xf2\x85\x67\x12\x2f\xe3\xe3\xd1\x42\x17\x25\x9f\xa1\x1e\x6f\x20\x1f\x43\xf5\xea\xba\x6e\x95\x37\x8b\xdc\x3a\x05\x7c\xf5\x79\x83\x4b\x74\xb2\x26\xf7\x24\xa7\xd8\x73\xaf\x7c\xb9\xf5\x77\x50\x88\xf9\xc4\x5c\x69\x5d\xbe\x24\x5a\x12\x40\x2b\x01\xcd\x0c\xb6\xb6\xd5\x80\x39\x30\x78\x5d\xbb\x72\xb8\xad\x25\xbe\xc8\xd5\x2b\xf6\x07\x52\x55\xac\x45\x6d\x87\x2b\x16\xdc\xb0\xaa\xa3\x3b\x77\xa0\x75\xac\xc0\x39\x2f\x49\xbc\x05\xd4\x37\xc8\x37\xd5\xa6\xb9\x51\xfe\x6c\x75\x5c\xa6\x79\xd0\x0e\x57\x8b\x10\x4e\x29\xeb\x38\x89\xdc\xb0\x76\x59\xee\x2a\x75\xb4\x6d\x02\x94\xa1\xb1\xbf\xe9\xe2\xa9\xe2\x04\xba\x6d\x28\x09\x66\x06\xca\x45\xbd\xf2\xff\x47\x38\xb2\x0c\xa2\x6a\x23\x49\xac\xbd\x52\x8e\xcc\x17\xf9\x7a\x0c\x23\x71\xb8\xdf\xb7\xdf\x01\x06\x09\x84\x29\x59\xa0\xd7\x24\x8b\x0b\xe1\xd0\x9b\x86\x63\x5f\x25\xbf\x6d\x2d\x7b\x21\x30\x2d\x60\x0b\x3b\xe1\xe5\x85\xac\xf1\x14\x68\x79\x56\xf5\xe4\xc1\x5d\x48\x76\x21\x09\xcf\x1f\x74\x1c\x61\xbd\x5c\x4b\xef\xc5\x10\x1e\x06\xa5\x5b\xcc\x31\x7b\x1a\x03\x22\xe7\x6a\x20\x36\x40\xec\x96\x33\x54\x30\x25\xa5\x13\xbf\x0f\xe9\xce\x98\x22\xc7\xd4\x99\xc6\x5a\x49\x86\x47\xe4\x06\x9b\x53\x93\xf7\x8d\x82\x2e\xb3\x11\xc4\xce\xa3\x70\x07\x81\xc6\xd3\xac\x53\xc6\x31\x65\xe9\xfb\xd9\x93\xc4\xd8\x9b\x9d\xf9\x0c\x59\xc1\x1e\xff\x76\xbb\x38\x1a\x08\x64\xb1\x5c\xb1\x42\xa1\x17\x6c\xa1\x53\xfb\xfe\x52\x29\x06\xd2\x13\xd3\x86\xe7\xf9\xaf\x96\xae\x80\x0b\x7b\x02\x49\x8a\xd3\x86\xf4\xd2\x25\x9d\x94\xaf\x3e\xfb\x30\xd0\x8e\xda\xa6\xb3\x5c\xf0\x27\x94\x63\x67\xbd\x32\x25\x34\xa0\x51\x8e\x44\xa1\x0a\x30\xcd\x92\x33\x42\xb2\x22\xc9\x4d\x8e\xd5\x8e\x26\x80\xdb\x8a\x68\x49\x15\xc7\xc2\xda\xa7\xd2\x52\x67\x10\xb7\x4d\x45\xa4\xa1\xa9\xa1\xb6\x9a\x0d\x49\xbd\xce\x28\x07\xa9\xde\xd5\x35\x55\x96\x44\x5d\x0c\x7d\xf2\x1d\x99\x07\x62\xed\x38\x24\x97\x87\xec\x38\xa8\x3e\xf3\xe1\x58\x20\x01\xb2\x07\x68\x95\x22\x6c\x1e\xb5\xce\x80\xe2\x6a\x1c\x9d\x2e\x25\x88\xdb\x80\x18\x48\x48\xc7\x2d\x0b\x49\x9a\xa6\xdd\xb8\x23\x31\x92\x1d\x43\x01\x34\xf5\xde\x59\xde\x01\x37\x84\xed\xee\xae\x12\xed\x59\xdd\x96\xa1\x56\x0c\x6f\x08\x1a\x3e\x50\xca\x5d\xa1\x36\xbf\xb7\x07\x98\xf6\x99\x4f\x34\xff\x37\x66\x1a\x0d\xbd\x7e\x00\x18\x1e\x41\x4a\x64\x59\x46\xd5\xc2\x80\xca\x2e\x96\xde\x6f\xf5\xc3\xfe\x79\x29\x09\xc6\x38\x6c\xc6\x0b\xb6\x32\x32\x8e\x65\x8a\x9c\x1f\xa6\xd2\x31\x48\x1b\xa1\x5b\x01\x74\x89\xb0\x40\x21\xc8\x85\x0c\x32\x5c\x80\x40\xb8\x08\x35\x06\xc4\xd9\x93\xca\x25\x5a\xd7\xee\xfc\xdb\x6b\xde\x92\x08\x06\x61\xf5\x9d\xae\xdc\x40\xfa\x2e\x60\x6e\xa8\x33\xca\x4f\x46\x8c\xa2\x10\x52\x28\xf3\x85\xa5\xb6\xf7\xff\xbd\x5a\x01\x6b\x4c\x6b\x05\x46\x20\xed\x48\x57\x6a\x59\x1f\x0d\x49\xa4\xea\x35\xd7\xe7\x2a\x13\xf1\x36\xda\xce\x65\xb5\x53\x62\x6b\x2e\xa4\xbc\x35\x46\x2c\x17\xec\x2e\x95\x02\x4e\x30\xdf\xd4\x99\x92\xb9\x80\x7c\x94\x86\x56\xa6\x80\x7c\x7c\x7e\x05\x4e\xf4\x87\x28\x09\x20\x34\xb7\x9d\xec\xda\x5d\x2c\xec\x1a\x4a\x70\x7c\x7e\xf9\x78\x65\xd3\xc9\x41\xa8\xd4\x1c\xa1\xaf\x35\x88\x88\x2f\xbb\xda\xbb\x53\xa4\xfd\x04\xe4\xf4\x94\xd0\x76\xf2\xd7\xdc\x79\x3f\x9b\xf9\xb2\x27\x5f\x76\x7c\x72\xea\x84\xcb\x58\xdc\x06\xf8\xee\x48\xe9\x51\xca\x05\xb0\xb5\x15\x05\xc4\x93\x42\x40\x3d\x0b\xdd\x58\xac\xdf\xfa\xac\x42\x13\x18\x39\xcb\x34\xb3\x98\xab\xc3\x54\xa9\xd8\x42\x89\x42\xa9\x63\xa0\xad\xc1\x18\xab\x48\x91\x50\xa3\xce\x2b\x82\xf2\x89\x81\x5f\x93\x93\xd5\x59\x48\xd9\x23\xac\x24\xaa\xcb\xca\x60\xa0\xec\x46\xf8\xa3\xf1\x42\x3b\xe3\xb5\xc2\x2b\xdb\x11\x94\x85\x42\x50\x52\xb0\x34\xcc\xbc\x20\x74\x04\xad\x3a\x74\x08\xf5\x22\x5a\x13\x45\xba\x4a\x5e\x21\x58\xc2\xc7\x93\xe0\xac\x71\x34\x5f\x64\x54\x57\x28\x35\xe4\xdc\x80\x04\xa7\xe5\xff\xe4\x1f\xb8\xda\x49\xb1\x94\x55\xc5\x87\xd2\x41\xcd\x7a\xfe\x40\x55\x36\xff\x6e\x2e\x57\x47\x58\xfd\xc0\xc1\x05\xe8\x48\xec\x52\x4b\xc0\x16\xdb\x1a\x26\x08\x27\x73\x77\x4d\xb1\x6a\x8a\xca\x8e\x98\x8b\xb1\x3c\x9f\x94\xb1\x98\x33\xa6\x29\x80\x96\x9d\x60\x23\x46\x40\x12\x0c\x18\x0c\x46\x20\xd4\xa2\xe0\x08\xfe\xd8\x76\x8d\x6f\xcd\x62\x24\x78\x0c\x07\xef\x2b\xb2\xe0\xbc\x75\xfb\x26\xb4\x7d\x89\x7c\xca\x58\x18\x26\x5b\xd9\xaa\x90\x38\x46\x8b\x2d\xb9\x45\x26\x2f\x3e\x8a\x2f\x17\xd1\x47\xc5\x2e\x61\xea\x7a\x5e\x8a\xde\x2f\x83\xd4\xfe\x7d\x29\x9c\x11\xb2\x13\x92\xf3\x27\x93\x78\xa9\x91\x24\x4f\x3d\x3c\x01\xc7\xc2\x7a\x99\xa4\x6f\xe4\xa7\xe1\x41\x39\x4d\x1f\xd8\x9d\x26\x1d\xf2\x7e\xee\xa1\x99\xb9\x33\x19\x72\xb5\x05\x33\x67\x9f\x9c\xa6\x27\xbf\x38\xd2\xa3\x5c\xb0\x2d\xb6\x4d\x44\x7a\xc8\xe8\x11\x98\xf8\x6b\xba\xcd\x35\x86\xf9\xb1\xb4\x41\x0a\xdd\xc2\xfd\xaa\x47\x39\xa9\xe4\x7c\x31\x2d\xd8\x21\x20\xc7\x38\x37\xfb\x10\x5b\x9c\x7f\xf4\xa4\x2c\x6d\x52\xee\x75\x7c\xa6\x35\x37\xae\x0a\x1c\x3d\x58\xfe\x91\xa4\x2c\x98\x61\x4a\xa7\xd9\x1b\x16\x9b\xb8\x5e\x74\xb4\x4e\x9b\x34\xd3\xd8\x5c\x66\x10\xd0\x84\x5d\x41\x7a\x1b\x48\x5e\x02\x37\xb7\xaa\x46\x95\xe8\x1c\xfe\x95\x99\x84\x26\x6b\x22\x1b\x4c\x55\x3e\x7d\xec\x3e\xe7\x72\x42\xe5\x58\x28\xdb\x82\xaa\xeb\x82\x02\x54\x9a\x25\xbf\xab\x0b\x4a\xaa\x08\xbf\x84\xad\x64\x68\x4b\xc4\x1f\x94\xf3\xb6\x50\x93\xb2\x4b\xc1\x1e\x54\x46\x61\x41\x87\x33\x48\x48\x86\x43\x5b\x72\x3b\x61\x9b\xc0\xb6\x64\x02\xb7\x99\x17\x87\x1a\x95\x35\x34\xc8\xcc\x0c\x89\x97\x6c\x4c\x84\x6b\x03\x6a\x47\x0f\x1a\x7b\xa1\xec\x38\x9f\x68\x7e\xe4\x8f\x07\x0d\x66\x52\x40\x03\xd0\xec\xfc\x1e\x39\x77\xe0\x42\xa8\xa4\xe0\xd0\x30\xeb\x0b\x7a\x53\xf5\xf4\xb0\xe2\xff\x27\x97\xa0\x14\x02\x61\x37\x03\x82\x40\xdd\x40\x4e\x9b\xb1\x8a\xdd\xc6\xb9\x6c\x91\x62\x18\x6a\x33\x0f\x68\x4e\x04\x13\xee\xb2\x58\xf6\x8b\x46\x2f\xc9\xed\x65\xa2\x73\xa3\xd9\x27\xfa\x8a\x44\x73\x51\xab\xf1\x3c\xfe\x6b\x5e\xd3\xed\x1d\xf2\xd7\xbc\xb2\xfd\x88\x5a\x41\x53\x4f\xa8\x69\x17\x64\xba\x53\x4a\xe3\x78\x1c\x4e\x34\x44\xbd\xb3\xc0\x4b\xd3\x21\xb4\xb9\xd4\xf9\x09\x21\xd9\xab\x76\x89\xac\x68\x41\x60\xdb\x45\x96\xb7\x58\x10\x90\x95\x00\x02\xbc\xca\x2d\x0d\xa2\x50\x0d\xed\xc4\x3a\x9f\x82\xdb\xd1\xa4\x1b\x7f\x43\xea\x3a\xc2\xc2\x57\xcd\x7a\x53\xbd\x73\xc4\x64\x4f\x0c\x3a\x08\x10\xb6\x5f\xbf\xab\xd4\xcb\x60\x8a\x3a\x74\xc1\x61\xce\xb7\xd0\x91\x0b\x1e\xa5\x86\xb2\xcd\xd8\xb3\xfa\xa2\x16\x81\xae\x0d\xbc\x49\x59\xf0\x04\x34\xc7\x0c\x23\x20\x10\x90\xf8\x2a\xd2\x1c\xcd\x2b\x3c\x03\xea\x71\x00\x6e\xb0\x6f\xd4\x00\xb8\x75\x0a\x55\x08\x04\xcc\x63\x77\x77\x8f\xf3\x78\x24\x64\xb5\xb3\xf0\x1b\xc6\xbf\x0e\x9d\x7d\xcf\x81\xfb\xd6\xc1\x39\x34\xcc\xfd\x9a\xed\xfc\x03\x39\x70\xac\x2a\x54\x3a\xfa\x25\x47\x15\x51\xe6\x85\x5e\x61\x9f\x8b\x15\x87\x10\xe4\x03\xdd\xcb\x27\xa8\xe7\x65\x83\x1e\xf4\x62\xf6\xa8\x04\x14\x14\x8a\x6c\x2d\xd5\xdc\x2a\x58\xdd\x4c\xe7\x25\x2a\xc5\xba\x08\x19\xb8\x2e\x85\xda\x8a\xc8\x5c\x8a\x40\x70\x52\x24\x54\xe5\x2a\x4b\xd6\x18\xcd\x05\xbd\x08\xb8\x68\x70\x87\xc0\x88\xc8\xe8\xc1\x82\xdf\xe3\xa6\x6d\xfa\xc1\x1b\x91\xe2\x2d\x2f\x93\x6f\x53\xda\x33\x8e\xdf\xc2\x45\x8d\xe0\xcf\xce\x74\x6f\x7f\xb8\x07\x9e\x7d\xe7\xd0\xb6\x56\x87\x6f\xf5\x07\x65\x4a\x0b\x60\x3c\x99\x24\xf6\xd9\x74\xed\xd8\x12\xbd\xdf\xfc\x1f\x09\xaa\x5d\x67\x2a\xdf\x5a\x9b\x0f\x84\xb5\x36\x71\xb0\xc0\xf9\x6c\x92\x04\xf8\x7e\x1b\xf7\x45\xaf\xab\x0a\x86\x53\xfa\xb8\x2f\x8a\x92\xf9\x69\xa3\xc4\x54\xc6\x74\xcd\x6b\x82\xc7\xae\xe0\xb2\x57\xdd\xc3\xbe\x0c\xdc\x3d\x3f\x6a\xb9\x00\x47\xa2\xd2\xcc\xd4\x55\x55\x05\x41\x4a\xc6\xf5\x0e\xfd\x9b\x02\x1c\x60\x86\xd2\x62\xd4\xcb\x91\x97\x83\x47\xc2\x88\xf5\x51\xcd\x41\xcf\xab\xa9\x81\xf1\xc3\x96\xce\x15\xc6\xda\xec\x63\x83\xfb\xea\xbd\x57\xcb\xef\xa9\x15\xe8\x03\x57\x53\x6d\x08\xdd\xc0\xad\x67\xb3\xba\xda\x46\xcb\xf1\xaa\x91\x26\xa9\x5e\x16\xf5\xfa\xd8\x98\xd4\xa1\x88\x36\x3b\x43\x31\x3a\x3d\xdb\x88\x50\x89\xe4\xba\x14\xd5\x60\xca\xda\x9c\x90\x56\x56\xff\x93\x1d\xd4\x45\x9d\xc6\x35\x22\xb4\x68\x4f\xab\x58\xe0\x7e\x14\x1b\xab\xde\xd8\x4e\x33\x5f\x35\xd3\x81\x5d\xba\x7a\x2d\xfe\xad\xe4\x92\x43\x57\xd3\xdd\x74\xd4\xa5\x8d\x82\xd3\x88\xb4\xd0\x18\xcb\x65\xdc\x5d\xb0\x5c\xb6\x94\xa9\xbf\x1d\x56\xaf\x19\x13\x96\x8b\xff\x63\xd4\x23\x1d\x6d\x5b\xc6\x6b\x75\xde\x97\xad\x5f\xca\xb1\x22\xa9\xc9\xf6\x73\x8d\x48\xb3\x7f\xf4\x3c\x7d\x78\xef\xb1\x02\x85\x6d\x30\x62\xe5\x78\x01\x48\xd3\x71\x62\x1f\x91\x61\x33\x74\x66\xe7\x4d\x54\xdb\xd1\xcf\x2a\x5d\x49\xbb\x18\x1c\x0a\x15\xde\x7d\xd7\x28\x1c\xfa\xbe\x9c\x2c\x4d\x94\x84\x8d\x96\x3d\x8a\x09\xdd\x40\x8c\x4a\x41\xdb\xc6\xd0\x64\x89\x90\xa0\xac\xcb\x3f\xc2\xa7\x36\x50\x56\xb1\xf6\x5b\x0d\x64\x56\xe3\x76\xf4\xe6\x34\x6a\x9e\xd2\xdf\x9b\x91\x6c\x48\x5c\xe2\xf7\xd4\x16\x8c\xc5\xe8\x7b\x98\xd8\xf2\x02\x4d\xf4\xe5\xbd\xc8\x98\x5c\xba\x9d\x8d\xe5\x01\x59\xfe\xdc\x81\x80\x1c\x1d\x81\x60\x39\x4d\xc3\x60\x44\x13\x62\x87\x3c\xc1\x05\x12\x3b\x61\x23\x7a\xf2\x86\x91\x3c\x8a\x78\x22\x06\xf3\x2c\xa8\x64\x82\x67\x43\x69\xbf\x60\x91\x63\x92\x9b\x16\x3a\xc1\x01\x05\xc8\xe2\x49\xb4\xe2\x3e\x6a\x77\xb7\xcc\x7d\x4a\x05\xc8\xc9\x7a\xa7\x8d\x5e\x3a\x56\x2e\x1d\xfd\x3a\xd7\xfe\xda\x8b\x13\x7b\xbf\xcd\xbc\x06\x28\xc8\xb2\x42\xf7\xe6\xcb\x1c\xc9\xa6\x03\x92\xc9\xf3\x78\x8e\x3c\x18\x1e\x44\x62\x23\x22\x53\xa3\xa1\x7b\xf4\x92\x50\xf9\x48\x75\xbd\x4e\x81\x2f\x13\xeb\x7b\x72\x36\x05\x3b\x77\x55\xb6\x13\xe6\xd0\xf2\x12\x73\xd6\x50\x17\xd0\x9e\x93\x3c\xa4\xe7\x58\xc0\xe0\x59\xe2\x73\xd4\xc4\xf7\xde\x4d\x78\x75\xb0\x87\x14\x5c\x9a\x62\x00\x91\xda\x73\xb8\x24\x11\x8e\x98\x11\x78\x8e\xca\x28\xa2\x89\x2f\x5e\x7c\x03\x5e\x2a\x18\x30\x6a\x7e\x6d\xe0\xac\x3b\x23\xb7\x25\x4a\x84\xde\x4d\x34\x9d\xc7\x70\x98\x51\x4d\xd7\xac\xf0\x3a\x3a\x1a\x62\x5c\x43\x32\x67\x66\x01\xb0\xb0\x5d\xbd\x7a\xd2\x50\x38\x54\x99\x20\x55\xaa\x93\x51\x62\xd6\xe8\x74\xba\x83\x1d\x43\x1c\xbd\x54\x9f\x3e\x0a\x70\xa0\x38\x2b\x36\x67\x5f\x32\x96\x6c\xce\xa1\x36\xa7\x48\xf2\x11\x08\x20\xda\xce\x00\xc7\x86\x0f\x48\x9d\x3a\xf7\x0d\x64\x9b\x3c\xe6\x43\xcd\xe9\x36\xb3\xaf\xd8\xa4\xea\x2f\xab\xbf\x36\x7f\xe3\x45\x3a\xc2\x4b\x8b\x94\x22\xcb\x60\x6b\x4a\xa9\x3c\x9b\xcf\xc6\xa2\xe4\x3d\x08\xf9\x76\x9e\x37\x84\xa5\xe2\x4e\x35\xb7\xb5\x60\xb0\xf2\x9b\xb6\x4a\x74\x8b\x12\xd9\x5c\x6a\xb9\x36\xcd\x85\x25\x03\x0e\xcb\x9e\x37\xf4\x2a\xa5\x27\x0f\xa5\x10\x95\x55\x95\xd9\x34\xb1\x3a\xe2\x1b\x58\x2b\x0d\xe4\x5c\x02\x62\x00\xfc\x92\x45\x0a\x11\xe4\xec\xf4\xde\x61\x66\xae\x8a\x78\x8c\x1f\x93\x7d\xc1\x89\x31\x2b\x70\x99\x8b\x42\xa5\xcb\x30\xee\x36\xf2\x17\xe2\x94\xe5\xfd\x9e\x33\x72\xf5\x41\x24\x1b\x4e\x14\x9a\x7e\xb6\x12\xf4\x23\xb3\x64\xaa\x7d\x08\xc7\xd7\xa4\x9f\x50\x37\x68\xf7\x48\x37\x62\xa3\x46\xaa\x9e\xc2\xa7\x85\x82\xac\x38\xf0\x11\x0d\x1e\xb3\x50\x3e\xf8\x42\x74\x19\x1e\x47\x4e\xd0\x14\x15\x8e\xa2\xf0\x6d\xe4\x01\x82\x78\xf4\x40\x8f\x6a\x09\x85\x91\xb8\x7c\x7b\xc0\x73\x39\x12\x19\xe0\xbb\x3d\x0f\xfe\x77\x52\xd9\x2f\xad\xab\x6f\x44\x4a\x73\x35\x16\x7a\x3e\x93\x3b\x8a\x27\x78\xdd\xf1\x45\x06\xd0\x71\x15\x5e\x06\x72\xe9\x5e\x3d\x47\x1a\x3f\xbd\x85\x39\x41\xea\xa1\x06\x7f\xe6\x96\xc7\x41\xe4\x5f\x97\xa3\x06\xed\x4b\x25\xb5\x44\xc9\xae\x3a\x75\x69\x6b\x6a\x6b\xee\x49\x18\x65\xc9\xe6\xbd\x1a\xac\x47\xfa\xd5\x9d\x23\x2a\xa1\x86\x29\xb5\x6a\x6d\x06\x91\xe1\x7c\xbd\x74\xa3\xb9\x7d\x60\x01\x4a\xe6\xa9\x5a\xd8\x88\xe9\xd6\xf7\x17\x07\x03\x48\x0f\x3d\x34\xee\x9d\x7b\x67\xdf\x81\xa2\xbe\x29\xa1\x71\x58\x88\x4d\x41\x2c\x17\x9a\x48\xf8\x8c\x37\x18\xfb\x31\x89\xeb\x56\x22\xa5\xd9\x14\x0f\x6f\x2c\xe7\x59\x23\x68\x12\xfd\xfc\xf7\xdd\xa8\x53\x17\xf2\x5f\xea\x3b\x8b\xcd\x61\x5f\xd4\x97\x5a\x5e\x63\x6e\x34\xa2\xad\x10\x8d\xa0\x82\x48\xb8\x45\xab\xae\x3f\x89\xab\xb0\x11\x7d\xd1\x0a\xc8\x9d\xa1\xbb\xad\xdc\xee\xbc\xd9\xa4\x3d\x7d\x42\x9e\x57\x76\xde\xaa\x55\x65\xf0\xe5\x68\x9a\x17\x83\xda\x00\x47\x1d\x8a\x93\x46\x90\x85\x89\x91\xe6\x3b\x9d\x40\x32\x53\xa5\xd8\x01\x9c\x80\x40\xcb\x66\x20\x05\xfe\xc2\xcb\xfc\x6e\xcd\xe2\x32\xd0\x23\x8d\x8e\xe6\x1e\xb9\xf1\x13\x66\xdd\x59\x3a\x5d\xbf\x4a\x79\xe5\x08\x48\x5d\x1a\xad\x95\x2d\xb7\x98\x47\xd7\x8c\x77\x67\x76\x27\xa1\x07\x04\xe2\x7c\x88\xc4\x30\x65\xf1\x0a\xc3\x5f\x37\x88\xf0\xd2\x90\xab\x6e\x2b\x66\x1e\xdf\xd9\xb8\xe2\xe0\x59\x3c\xab\xe7\x28\xcd\x0a\xaa\x3f\xf6\xec\x92\x10\xa9\x91\x79\xf2\xf9\xa1\x25\x77\x6d\xac\x2b\x6a\x0e\x83\x26\x26\x21\xe8\xa2\x63\x93\x5e\xa1\x75\x27\x9d\x62\xfc\x26\xf1\x68\x9f\x6d\x36\x10\x04\xc2\xa4\x9a\xce\xfb\xb9\x2a\x32\x0c\x3f\x1c\xb4\x41\xc7\x49\x1c\xab\xb6\xc6\xb2\x35\x46\x06\x57\xbe\xd8\x99\x5b\xe2\xc6\x9c\x06\xa8\x42\xf5\x55\x7a\xef\x49\xce\x45\x94\x93\x21\x92\x6d\x3a\x8b\xd2\xfb\x9c\x78\x68\xd4\x65\x97\xdd\xc7\xae\x6e\xbc\x7a\x7c\x5d\x25\x0c\x8d\x90\x6d\x11\xd9\x72\xc1\x55\xb8\x46\x8d\xda\xaf\x90\x05\x94\xf9\x11\x7f\xae\x31\xc6\x0b\x1d\x89\x7d\x3b\x21\x0f\x6e\x20\x46\xbe\x26\xa0\x67\xf2\xda\xf1\xff\x50\x89\x86\xdd\x01\xaf\xaf\x1b\x1b\x3b\x26\xd7\xf6\xa9\x9c\xf8\xef\x18\x24\x24\xb8\x1a\xe9\x46\xab\x29\x1a\x2e\x74\x9b\x42\xed\x51\xa8\x33\xa6\x8e\xbd\x15\x28\x9e\x43\x3d\xfa\xb7\xd3\xe4\xa6\x58\xc0\xce\xd8\x65\x73\xd4\x3b\xdd\x69\xfb\xab\xb6\xad\xd9\x8a\x40\xbc\xd1\x08\x7e\xfc\x4e\xed\xc9\x1b\x1b\xce\xa1\x45\x01\x7f\xeb\x09\xd4\x53\x52\x8b\x14\x04\x14\xa1\x88\x68\x9d\x5c\xe9\xe8\xa3\xfa\xc1\x42\x65\xf1\x6f\x18\xba\x86\xbd\x9c\xa0\xb4\xb9\xce\x01\x62\x54\xd1\x16\x84\x06\x6e\xce\x92\x11\x6a\x75\x02\xb9\xbe\xc0\x1b\x09\x07\x67\x40\xe4\xf7\x27\x04\x71\x51\xeb\x94\x8e\x6e\x78\x2e\x75\x36\x62\x56\x55\x26\xd4\x0c\x9b\x5d\xb9\x3b\xdb\xef\xaa\x15\x21\x43\x5f\x0c\x84\x16\x06\x0b\x08\x9e\x8d\x35\xc6\x2a\xde\x7a\xb9\x1d\xc9\xba\xfb\x6d\x80\x52\x51\x75\x91\xaa\x71\x82\x92\xe0\x16\xa6\xb1\x50\xfc\xda\x5a\x30\x83\xb6\x7d\x6d\xf1\xbc\xa1\xcc\x06\xca\xf5\x6e\xbd\xa6\x5e\x6e\xd5\x4f\x72\xc9\x54\xa6\xe2\xc1\x91\xb5\xce\x3a\x9f\x55\xe3\x27\xd7\x68\xc0\xe6\xa7\x30\x6e\x0f\x5a\xa1\x70\x5e\x19\x52\xe9\x7c\x44\x46\xaa\x5f\x62\x5c\xf2\x40\x96\xd6\x6d\x81\x82\xe8\x44\x45\xeb\x38\x81\x00\xc6\x7b\x6f\xe8\x1e\x47\x4d\x89\xe1\xa0\x9c\x01\x71\xb8\xdd\xcf\x06\x7d\xdc\xee\x53\x64\x20\x16\x14\xae\x0f\x43\x2c\x1b\xfb\x04\x69\xc0\xdc\x9a\x45\xea\x1d\x12\x8a\xe9\x2f\xb0\xde\xc3\x8b\x5b\x18\x64\x38\x5d\x8e\xd7\x2c\x4d\x43\x49\x9a\xce\xd0\xf3\x5f\x75\x97\x2c\x5d\x56\x99\x81\x63\xf1\x22\x97\xe4\xa4\x70\x18\xb0\x06\x67\x1d\x40\xec\x60\x22\x79\x29\x8b\x2c\x3b\x42\xb3\x5e\x65\xad\x9e\x6c\x10\xda\x08\x04\xa1\xaa\x32\x27\x80\x2e\x28\x9d\xcd\xd0\xc4\x98\xe2\x79\x3e\xe0\x2d\x1a\x7c\xce\x68\xad\x18\xdc\x13\xd3\xc4\x77\x9a\xfa\xfe\xea\x3b\xa9\x6a\xe5\xa5\xe8\x1d\x92\xed\xd5\x8d\x18\xd1\xd2\x69\xd4\xbd\xb1\xe4\xaf\xea\x5f\x19\x08\x6f\x7c\x21\x40\xf6\x29\xc9\xa2\xdd\x1e\xaa\xc4\x3d\x7f\xd9\xcc\x8c\x53\x50\x7a\x9d\x97\xe9\x1d\x1f\x88\x2a\x21\x7a\x7d\x29\xbc\x7c\xc6\xa7\x54\x7d\xbd\x6a\x18\x91\x86\x45\xa3\x74\x43\xac\x5a\xe5\xa1\x6e\x8c\x8d\x17\xbd\x7f\xc3\xbd\x9c\x2a\xd5\x9e\xf5\xc9\x34\xdb\x86\xd7\xbd\x5b\xb9\xa9\xb1\x0b\xbe\x39\x1a\xbb\xd0\xae\x05\xa8\x8e\xe8\x88\x17\x91\x06\x92\xdd\x93\xf5\x8c\x7a\x60\x57\x08\x6d\x14\x65\xf4\x6f\x82\x8b\x7c\x6a\x38\x6a\xad\xef\x35\xe1\x18\x90\x39\xbc\xf6\xb5\x40\x2e\xfa\x9a\x45\xbf\x18\x56\xcc\x2f\xcc\x5a\x57\x05\x40\x17\x7c\x97\x81\x66\x5f\xe7\x10\x5c\x22\x24\x1d\x7b\xe1\x05\x9c\x91\x76\x10\xa1\xe0\xf2\x83\xf3\x9c\xc0\xc8\x2f\xb8\x06\xf2\xb6\x3b\x61\xd7\x16\x1c\x3a\x53\x65\xa6\x69\x33\x54\xe4\x47\xcb\x10\x2a\xeb\xce\xfd\x83\x5d\xb8\xc6\xa1\x2f\xcc\x68\x14\xc0\x32\x1d\x43\x33\x32\x24\xbf\xd3\x01\x0e\xf4\xbf\xfc\x31\x7d\xbf\x8c\x44\xa4\xcd\x4e\x58\xc9\xf8\xfe\x74\xec\x3f\x46\xcf\x9d\xed\x7e\x6e\x2c\x50\x6a\x39\x0f\x2e\xee\x96\x3e\xd7\xa3\xfc\xa8\xcd\x7b\x0a\x39\xfd\x7f\xc9\x45\x4e\x2d\x76\x7c\x33\x68\x86\x14\xf2\x91\x43\xc5\xd0\xb8\x45\x94\x95\x87\x20\x58\x54\xe0\xe2\xcb\x3b\x50\x96\x24\xc3\x3d\x8d\x4a\xd6\x2d\x0f\xdd\xd5\x67\xdd\x95\xfd\xf9\x69\x67\x7a\x7e\x71\x97\x28\x41\xe9\x3c\x3a\x68\x13\x31\xb1\x39\xb3\x33\xe1\x4e\xec\xcc\xbd\xf5\x51\x89\x8b\xe3\xc7\x7d\x43\x4c\xd8\x7f\x90\x57\xf9\xdf\xa7\xc2\xd7\x8e\xdc\x7e\x83\x12\x1b\xe1\x0d\x12\xda\x62\x99\xa5\x22\x86\x81\x87\xcd\x7c\xc7\x34\x75\x7d\xee\xf0\xad\xd7\x01\xd9\x63\x99\x79\x80\x04\xe0\x8b\xc8\x36\xb0\xa1\x32\xff\x5b\x20\xa5\xd8\xd6\x5c\x64\xb8\x2c\xb5\xd2\x8e\xd5\xb3\x62\x81\x11\x6b\xf9\xa4\xcc\x44\xe5\xe4\xbe\x25\x30\x54\xdc\x78\xad\xae\x7b\x3d\x3b\xf6\xd4\xb7\x8b\x86\x01\x28\x84\x69\x08\x87\x0b\x8d\xd8\x4c\x53\xb7\xf8\x13\xdf\x31\xbd\x98\xf8\xfd\xa9\xd1\x33\xff\x4e\xcf\x68\x2f\xa4\x36\x3f\xe6\x27\x45\x5e\xf6\x7e\xff\xc6\xd4\x50\xae\x7f\x7b\x73\x5a\xec\x1b\x99\x5a\xd3\x4b\x94\xc4\x9e\x13\x42\x7b\x92\x44\xcc\x74\xf8\x26\x89\xbd\xd4\x7a\x92\xd3\xf7\x29\xb5\x17\x55\x6f\x61\xf9\x35\x51\xa6\x33\x8f\x91\xc8\x97\x88\x72\x7e\xbf\x8e\x91\xbe\x0a\xa6\x01\xf4\xd9\xf9\x46\x5d\x11\xa0\x24\xba\x3c\xe8\x04\xfa\x74\x6d\x28\x9a\x7d\x0c\xfb\xc3\xcf\x4d\x42\x40\x87\x9e\x5b\x9c\x47\x78\xec\x08\xce\x46\xd3\x05\x68\x10\x38\x00\x9b\x23\x7d\x88\xf4\x2c\xa3\x23\xc0\x98\x75\x0b\xfd\x4c\x61\x7a\x28\xde\x51\x68\xb1\x34\x25\xd3\x78\x01\x6d\x04\x60\x55\x13\xe0\x95\xd5\xb9\x2e\x45\x11\xa8\xe3\x76\x31\x61\x92\x9e\x34\x0e\x5f\x27\x2f\x5b\xa8\x64\xb1\x7b\x46\x78\xa8\x49\xca\x35\x39\x9e\x8c\x0c\x83\x44\xf5\x11\x3c\xea\xec\x26\x90\x38\x56\x88\x45\xf3\x46\x67\xc9\xc8\xd9\x0b\xe8\x8a\x98\x23\xf5\x49\x91\xe4\x9f\x8d\x32\x53\xb2\xcd\xee\xff\x5f\x06\x0f\xe3\xa9\x5d\x51\x71\x41\x13\x9a\x6e\x88\xd1\x5f\xf0\x51\x19\xe7\x07\x12\x3e\x62\xde\x98\x34\x67\xa1\x19\xa8\xad\x13\xb1\x2d\xae\x01\x30\x26\x07\x45\x23\x63\xa6\xdc\x69\x38\xbf\x09\x76\x52\x2f\xd5\x34\xff\x18\x72\x9e\x8a\xeb\x08\x9a\x50\x37\x95\xa3\x4b\x03\x73\x4f\x57\x05\xb5\x4e\x33\xd5\x8f\x1e\x76\x45\x5c\x62\x71\x86\x7f\xfb\x51\xdf\x35\x18\x12\x8d\x5a\xb9\xda\xbd\x56\x79\x35\x07\x5d\x8d\x13\xbd\xd6\xf6\xe0\x68\x2f\x09\x69\x29\xc5\xfb\x9d\xa6\x8e\x2d\x2f\xb4\xb0\xe3\xa1\x6b\xb5\x4e\xac\xd1\x8e\xbe\xc8\xc4\x1c\x13\x23\x65\xec\x29\x22\x50\x17\xed\xf1\xfc\x08\x09\x7b\xfd\x4b\x53\x51\x90\x78\x89\xb2\xb8\x9d\xa6\x3e\x61\xe3\x26\xb5\xed\xb6\x8e\x3c\x61\x2c\xeb\xa6\x8c\x17\x48\x45\x48\xcf\xf2\xf3\x89\x0d\x02\x1c\x5d\x94\x25\x2c\x65\x44\x9e\xb3\x55\x7e\x57\xbb\x6c\xfd\x17\x8f\x03\xda\x9b\xab\x3b\x63\xbe\xe1\x98\x93\xf7\x07\x60\xfe\x9d\x3f\x20\xa1\xbe\x70\x91\x4d\x0d\xb2\x0b\x6c\xeb\xea\x2e\x82\x50\xb2\x57\x37\xf5\x06\xa1\xd9\xa3\xc8\x2e\x1a\x2f\xf4\x57\x9c\x23\x3b\x57\xf5\x64\x2a\x96\x3a\x7a\x3b\x45\x32\x86\xa8\x9f\xee\xf6\xe3\xfa\x6a\xa1\x07\xf8\x39\x13\x79\xc9\xdc\x41\xc7\x53\x90\x53\x38\xe8\x75\xc0\x8c\xb6\xe0\xac\x3b\x66\x59\x38\xbb\x6d\x20\x4b\xd1\xaf\xe8\xec\xd4\x84\xd4\xb6\x89\xcd\xa1\x3e\x2c\x84\x17\xa0\x0e\x0d\x5f\xe8\x67\xa5\xb4\xf1\x2d\x6d\xe1\x63\x3c\xd7\x18\x6e\x5d\xd0\xa7\x2d\x14\xae\x35\x91\x32\x63\xa8\xb9\xa1\xae\xf1\x7a\x0d\xac\x37\x64\x20\x50\x9c\x7a\xf3\x13\xe5\x01\xb5\x19\xe0\xf2\xf0\xb2\x56\x84\xf6\x93\xfb\xa5\x4f\x1d\x50\x76\x66\xbc\x52\xfe\x0c\x52\xa4\xd3\xc6\xe0\xb0\x93\x39\x86\x5a\x0d\x2d\x9f\xc1\x1e\xde\x64\x12\x86\xf7\xae\x9a\x88\xbf\x43\x9b\x9a\xf1\xf3\xc7\x60\x2c\xf6\x61\x74\xc1\x3d\x13\x14\x87\x6b\x3f\x76\xa2\xed\x34\x23\x50\x0d\xe8\xa4\x42\xcc\x16\x08\xa1\x9a\xd8\xa5\xb8\x2f\x5b\x17\xf5\x06\x73\x2e\x3c\x61\x15\x30\x65\xc6\x7b\x8f\xb4\x26\x8e\xa2\x76\xfe\x10\xf2\xa1\x61\x7e\x01\x1d\x65\x01\x08\xd0\xf3\x38\x8b\xe8\x29\x66\xe4\xb1\x1c\x17\xdb\x6e\x14\x79\x06\x92\xea\x02\x66\xe4\x42\x50\x1c\x4c\xf9\x75\xf1\xb3\x49\x79\xb2\x48\x18\x3d\x53\xf8\x32\xf6\xd1\x1d\x94\xd2\x22\x5b\xc3\xc8\x28\xd9\xc2\xbc\x4f\x40\xed\x7a\x2a\x49\xb8\xba\x40\xc5\xf6\x30\x97\xe4\xaa\x23\x98\xe3\xdc\xe0\x2c\x1a\xd0\x00\x02\xad\xf4\xc5\x49\x24\x51\xa1\xcf\x79\x0d\x0d\x63\xf4\xd1\x9e\x46\x0d\xeb\x61\x8f\xdf\x9e\x03\x76\x22\xb2\xef\x0e\x7c\x52\xc7\xdc\x98\x09\x27\x57\x9c\x13\x46\xa0\x0c\x78\x3f\x11\xfe\x2b\x61\x4e\x83\x4d\xf6\xae\x8f\x4b\xce\x60\xc6\x44\x05\xde\x03\x2f\xe8\xb7\x6e\x66\x3a\x58\xb8\x49\x38\x3a\x48\x09\xb1\x49\x52\x6d\x5d\x9b\x4b\xf4\xba\x36\x69\x0e\x20\xfc\x40\x55\x12\xcf\x48\x2a\x4e\xed\xd0\x6f\x08\x46\xac\xe0\xfd\x3b\x96\xb1\xa9\x9b\xe1\x87\x8f\x22\x02\x57\x9d\x71\xa3\xbe\x64\x5c\x93\xef\x8a\x88\x89\x91\xd0\x8d\x2a\x43\x3d\x23\xe3\x32\xcf\x36\x78\x94\x37\xec\x3c\x54\xcf\xd5\x1b\x0a\x37\x79\x88\xd4\x72\x55\x3e\x2d\x62\x48\x29\x11\x11\xc9\xba\x7a\xef\x20\x27\x7d\xb6\xd7\xb6\xdd\xee\x77\x9c\x21\x04\x17\x72\xc1\x58\xce\x11\x5a\x8a\xb5\x09\xb4\x2e\x3a\x92\x56\x6e\xd7\x13\x3b\xee\x2d\xee\x3e\x8a\x3a\xe1\x0a\x1d\x02\x36\x01\xe0\x72\xff\xbd\x87\x0e\x1d\x55\xc1\x63\x73\xd5\x34\x6b\xac\x11\x56\x57\xac\x7b\x92\xfa\x89\xf0\x23\xad\x0f\x98\x35\xdf\x91\x30\xc1\x42\xdd\xe3\xec\xc7\x60\xdc\x9d\xff\x30\x75\x9a\xd1\x22\x2e\x51\xca\xc6\x1f\xac\x9b\x64\x42\xb4\x98\x30\xa3\x94\x1d\xf5\x98\x43\x9e\xd0\x72\xf5\x1e\x5d\xed\xef\xe0\x23\xed\xba\x51\x39\x08\x6c\x71\x9c\xe0\x02\x4e\x5e\xf4\xd6\xb3\x8d\xaf\xe9\xf4\x68\x7e\xa0\x4e\x55\x5c\xb4\x20\xbc\x31\x36\x9a\x0a\x7e\xe9\xe8\x15\x93\x1c\xe1\x94\x53\x91\x6e\x87\x54\xf2\x04\x6c\xa2\x91\x5c\xfe\x54\x48\xd2\xb2\xda\x9b\x2d\xd9\xc9\x7e\xaa\x47\x7e\x29\x24\x85\x6f\x51\x37\x1e\x2d\xeb\xaf\x43\xef\x11\xb0\xcc\x01\xc8\xec\x7d\x71\x12\x25\x2f\x1c\x61\x99\xa6\xb3\x76\x4f\xb0\xaa\x7c\x5b\x71\x34\x96\x82\x8a\x92\xb3\x7e\x42\x63\x2d\x24\x4e\xdc\x1b\x37\x3c\x56\x18\x85\x48\x45\x80\x1f\x69\x2d\x99\x9c\x17\xe8\x52\xc8\x46\x93\x0c\x47\x4e\x4d\xe0\x7b\xd0\x22\x33\x95\x90\x0c\xfb\x34\x39\x87\x5b\x15\x84\x6a\x3c\xa0\x90\xe1\xb8\x1d\xac\x57\xd3\xad\x0b\xca\xe3\x68\xd5\xf8\x98\x02\xbf\x6c\x91\x6e\xdb\x06\xc3\x20\xfc\xc8\xd8\x51\xd2\xa8\xda\x89\x47\x07\x63\x71\xa8\xef\x40\x77\xaf\x2f\x65\x20\x71\xff\xa3\xcc\x15\xe6\x01\x67\xce\x1c\xae\xc8\x3c\x88\xa3\xcd\xc6\x10\x0f\x3a\x20\xa0\xea\xe1\x61\xe9\x92\xb7\xa2\x91\x75\xaf\x10\xea\xcd\xf1\x94\x95\x5f\x50\x8b\x60\x0c\xdd\xf9\x62\x7b\x45\xeb\x1c\xe1\xa6\x49\x28\x16\xcf\xd9\x4f\x57\x47\xdd\x1c\xe6\xab\x66\x45\x79\xc0\xdc\xe9\x41\x42\x61\x5f\x9e\xdf\x3b\x4f\x08\x5e\x8e\x44\x51\xa3\xe2\x27\xe8\xb5\x73\xf3\xe5\xe7\x0c\xee\xd0\xec\xf3\x60\x44\xee\x23\xd1\x9a\x69\xaf\x3a\x18\xd1\xb4\xdb\x2b\xdf\x16\x59\x01\x7b\xa9\x7b\xd2\x70\xe5\x26\xb6\xc5\x5c\xdf\x1a\x52\x61\x9e\x9a\xc0\xc1\x97\x67\x10\xfe\xcf\x9e\x30\xff\xc1\x1f\x67\xfa\xef\x54\xf9\x4c\xce\x69\x3c\x27\x9f\x37\xf6\x0f\xa6\x3f\xc0\x05\xd2\x08\xe7\x58\x31\xb9\xc0\xcf\x43\xef\xd6\x0a\x61\xa5\xbf\xe9\xee\x6e\x30\x6f\xb9\x72\x31\xd1\x0c\x99\x1e\x17\x2f\x55\xad\xa5\x89\x9d\x05\xf5\x0b\xce\x6f\x8f\x2f\x0f\x3f\xe9\x95\xe2\x99\xaf\x61\xf9\xd3\x5f\xac\x70\x15\xea\x8f\x48\xd6\xb1\x0d\x47\xf5\xec\xb4\xa0\xa6\xc2\x38\x9f\xdd\xa3\x3f\x33\x46\x89\x30\xf0\x07\x98\x80\xd9\x60\x25\xc3\xf7\xcf\x91\xc4\xd3\x3c\x8d\x0a\x47\x9a\x5d\xd4\xbd\x9c\x19\x38\x62\x6c\xcd\x8f\x25\x2d\x2c\xc2\x29\x7d\x25\x9e\x03\x4a\x94\x3a\x16\xb9\x59\x02\xad\xd2\x6a\xd0\x1b\x10\x54\xcd\x3b\xd0\xab\xc8\xb6\x35\xc5\x92\x3e\x9e\x36\x1c\xbd\x9f\x90\x77\xd2\x7b\x0f\x9c\x56\xe9\xa4\x83\x2e\x26\x13\xa3\xf6\x28\x86\x0e\x6f\xb9\xa9\x2a\xf5\x6e\x3c\xa7\xd8\x6e\x39\x06\xa1\x06\xc1\x4a\x96\x0a\x22\x61\x87\x07\xa9\x0b\xd0\x94\x08\xcd\xdd\x2b\xa1\x86\x0b\x61\xca\x2f\xbb\xfd\xba\xba\xf0\x3b\x37\x7a\x44\xd8\x5a\xce\xb7\x11\x7b\xd7\x74\x8f\x4d\x09\x4d\x70\x97\xa3\x09\x69\xd8\x9a\x6b\xbc\x4e\x50\x77\x88\xd8\x91\xcd\xba\xf0\x48\x60\x54\x0d\xf6\x24\x32\x33\x1b\x96\x86\x66\x1e\xd3\xf6\xc0\xd0\xda\x30\x9a\x7a\x1f\xc6\xc8\x3b\xad\xa1\xa6\x9e\xe7\x22\xf0\x5d\x8c\xf2\xaa\x82\x2a\x8e\x59\xb2\xa4\xe9\x10\x2c\xe4\x51\xa1\x57\x81\xd8\x1b\xe0\x0e\xf4\xb8\x6c\x53\xe9\xb5\xc6\xc5\x4f\x8e\x72\x57\x24\x35\x06\x5d\x4b\xa1\x56\xd9\xbb\x42\xe2\x16\x85\xdc\x9a\x85\x49\xb8\x18\x8a\x18\x6b\xda\x7c\xdf\x3d\xb2\x26\xc1\x5b\xd4\xe8\xea\xde\xd1\xde\xc5\x35\x38\x45\xd3\x52\xa4\x30\xde\x8b\x47\x6b\xab\x73\x1b\x53\xee\xf1\xc1\x6e\xe3\xf2\x6e\x11\x6e\x75\xe3\xfa\xf1\x45\x78\x4b\x8d\xd0\x7f\x72\xf4\xa2\xac\x8c\x7d\x87\x7e\x6d\x1f\xf1\xd9\x60\x10\x8e\x47\x34\x02\x75\x7b\xd0\xd1\xf1\x3d\x5d\x36\x66\xa4\x00\xf7\xe2\x08\xd7\xa8\x0a\x42\x6f\xd6\x1e\x26\x3c\x0d\x99\xc3\xb1\x63\x3b\x32\xa0\xae\x10\x50\x24\xc3\xc7\x83\x7b\x6d\x57\x5f\x63\x1b\x9e\xf5\xc2\xaa\x27\x42\x36\xd0\x8a\x3f\x24\xbd\x5e\x7a\x5b\x8f\x43\x03\x97\x1d\x59\xb9\xdd\xad\x99\xe9\xa5\x6a\xd0\x4e\xf3\x7e\x0b\xfe\xa6\xdb\xae\x30\x05\xbe\xbb\x9b\xfa\x74\xa2\xaf\x06\xfa\xb0\xdb\xe2\x84\x36\x2f\x29\x2d\x89\x31\x7f\x3a\x70\x24\x44\xea\xa5\x85\xc8\x97\x0a\xae\x10\x1a\x0e\x0a\x2c\x27\xb3\x86\xfc\x79\xe0\xf5\xb1\xf8\xc1\x76\x98\xc4\x20\x85\x6e\x9c\x8b\x46\xb3\x54\xd7\xd1\x81\x99\x5b\x04\x27\x61\xa6\x4d\x62\xce\x4a\x5d\xbb\x25\x90\x26\xc3\xae\x10\xac\x6c\x63\x36\x02\x26\xc2\xe8\x49\x70\x46\xe5\x90\xc4\x0d\xa8\xdc\x6f\xf0\x8c\x69\x83\x06\xaa\xc4\xc9\xfe\xe3\x15\x35\xb1\xa0\xf8\x27\x72\xa8\xe4\xcb\xe5\x72\x91\x28\x71\xbc\xef\xe8\x90\xfa\xae\xeb\xee\xbe\x7a\x36\xc1\xb0\xf6\x9e\xee\xc9\x28\x35\xf3\xd0\xe1\x45\x0e\xf4\xe0\xc9\xa2\xd5\x9f\x6c\x13\x5c\x1d\x85\x4c\xf1\x9d\x6b\xba\x37\xab\xb0\x31\xc5\x59\x34\x5e\x29\x02\x62\xd9\x97\xc2\xa6\x38\xf9\xbc\x79\xf8\xc6\x8f\xaa\xe4\xdb\x0a\x4e\x9e\xd8\x3d\x83\x9b\x39\x7a\x54\x96\x5e\x5b\x54\x24\x87\x64\x6b\xc2\x19\x9c\x0d\x51\xe2\x69\x4b\x25\xe1\x88\x36\x8e\xb5\x90\xfc\x71\xb5\xe9\xe7\xf5\xcc\xee\x66\x4f\x7c\x03\xe0\xda\xe0\x63\xd0\x38\x24\x95\x6a\x69\x5e\x76\x1b\x04\x72\xfe\x02\x2e\x51\x51\x52\x22\xe2\x59\xe1\x72\xc4\x8a\xe3\xe2\xf5\xb8\x0d\xa8\xc3\x6b\xf8\x92\xa5\xde\x72\x9f\xba\xbc\x4b\x0e\x88\xa2\xb5\x3c\x51\x49\x89\x22\x11\xb7\x40\xb4\xef\xea\x52\x10\x14\x39\x0d\xf6\xc0\x0f\x2f\xbd\xab\x18\x5c\x26\x37\x59\x7d\x6d\x89\x67\xd2\x8a\x37\xbb\x9c\x1c\x7a\x36\xb8\x2d\x4d\xf9\x75\x3d\x82\xc0\xe9\x2c\xf5\xfe\x4e\x33\x4f\x5c\xba\x36\x8c\xc3\x61\x44\x41\x8e\xbb\xde\xae\xf0\x4c\x64\x5e\x72\xeb\xc9\x2f\x2e\xae\x3e\xfb\xa0\xe6\xe8\x0a\x4e\xb7\x65\xed\xcf\x08\x52\x69\x13\xfb\x34\xa6\xbe\xd5\x21\x33\x52\xf9\xa2\xba\x15\x90\x22\x8d\x34\xa6\x88\xc8\xf1\xfa\x9a\x6e\x99\x62\xe1\x6d\xc9\x2d\x79\x5b\x58\xa6\xa6\xff\xcf\xf1\x2e\x22\xc6\xf4\x19\x21\x94\xf8\xdc\x51\x4b\xaf\xe5\x45\x5c\x07\x4e\x8f\xeb\xb5\x34\x67\xd5\xa0\xd7\x9d\xf8\x05\xa7\xbf\xa6\x83\xef\xb1\x61\x4e\xa6\x70\x82\x0d\x3e\x6b\x29\x39\xc1\xc1\x30\x88\x42\x8d\x5a\xea\xcc\x42\x73\x0a\xf6\xa5\x79\x8a\x19\x72\x8e\x9a\x65\x72\xab\x29\x76\xaf\xa2\xae\x44\xf3\xeb\x74\xe8\x72\x76\x81\x5c\xfb\x76\xf9\x5b\xad\xfc\x3e\xf3\x72\xb5\x4a\x6d\x9c\x81\x02\x96\x0a\x61\x9c\xf2\xab\xb5\xcc\xbc\x9b\x7e\x75\xdd\x17\xea\xea\xd5\x8f\xba\x3e\xb5\x72\x4d\x5a\x9c\xb0\x98\x63\xed\x42\x3c\xf8\xe3\x0f\x60\x29\x5c\x79\xd7\x74\x7c\xdb\x8d\xef\xff\x72\x27\x3c\x86\x18\x1a\x06\xf4\x98\xe0\x3e\xf9\xd7\xd6\xfe\xe3\x5b\xe6\xc3\x8e\x97\xef\xad\xe8\x68\x37\xeb\xfa\xad\xfe\x5a\xc5\x10\x04\x8c\x38\x7f\x63\x61\x49\x81\x21\xb9\x0d\xb4\x70\xe2\xa2\x9f\xcd\xcc\xae\x00\x2c\xb6\x0f\x47\xc3\xed\x0b\xbb\x6b\x07\xa0\x35\x40\xc8\xd9\xf4\xf7\x08\x1b\xff\x60\xf4\xc9\x69\x77\x0d\x88\xd2\x6e\xd0\x9e\x1d\x38\x70\x9f\x76\xbf\x20\xe7\xc9\x13\xe9\x2a\x8f\x9d\xb2\xc2\x27\x4f\x0a\x0b\xdd\x1d\xd4\xfc\x8a\xb2\x14\xa0\xdd\x41\x5b\xc8\x2e\xa1\xa4\x72\x8f\x16\x68\xf3\x54\x0d\x70\x52\x3f\x2b\x3b\xcb\xff\xf3\x5c\x11\x2a\x48\x8b\x73\x4c\x71\xde\xe6\xeb\x55\x9a\xb9\x66\x96\xe2\x3a\x71\x6c\x2d\xc4\x87\xa4\x0c\xf1\x2a\x36\x21\x85\x68\xe7\x2b\xcb\xac\x04\x00\x7b\x68\xb7\x89\xf6\x2d\x20\x6a\x67\xb1\x6b\xc9\x4d\x4d\xc9\xd7\x50\x23\xe3\xb6\xe4\x3a\xbc\x7d\x91\xb1\xb8\x7b\x2f\xdd\xeb\xee\x56\xcb\xdf\xc5\xb6\x43\x5d\xec\x98\x99\xea\xb7\x26\x5b\xb3\x57\x24\xda\xab\x01\x03\x5e\xdf\x23\xe0\xfb\xb8\xb6\x34\x66\x1d\x57\x61\x5c\x0d\x8b\x05\xc1\x35\xec\xf0\xe8\xba\xba\x4d\x5b\x36\x9e\xbd\x1c\x29\x54\xa7\x33\x97\x95\x1b\x15\x9b\x7e\xfc\xda\x1d\xce\x0e\xad\xc9\x12\xd4\xc0\x0a\xe3\x3a\x2e\x20\x5a\x94\xa6\xbc\x02\xa8\x60\x73\xde\x69\x28\xcd\x52\xf4\xa9\x51\x85\xbc\xb2\xcc\x7c\x2f\xfa\x60\xf9\x0a\x69\x6c\xcf\x5e\xb0\x4c\x23\xa0\xa7\xf7\x1d\xf2\x66\xa4\x2d\xf1\xd7\x3b\x2d\x43\x3b\x13\x5f\xdc\xde\xf9\x2a\xf9\x7b\x40\x45\x4d\x43\x6f\x6f\xc3\x82\x5f\xa4\x9c\xc6\xc3\xf5\x37\x17\xbe\x54\xf6\xe3\x72\x71\x7a\xdc\x46\xb5\x25\x17\xd7\xaf\x7c\x99\x9a\x55\x3c\x5a\xa4\xdc\x6f\xc1\x13\x5b\x54\x41\x3d\x8e\x84\x04\xd0\x27\x44\x50\x4a\x5d\xb4\x3b\x2c\x55\x39\x19\xaf\xb5\xca\x51\x9b\xb1\xd3\x70\x8a\x9e\x6b\x06\xf1\x34\x7e\x80\x1d\xea\xe1\x06\xc1\xe2\x06\x5f\x05\x8d\x43\xaa\x5e\x99\x71\x45\xa2\xc5\xae\x90\x9c\x62\x0d\x81\x53\x47\x73\xda\xf8\xdf\xed\x7f\x59\x85\x9d\x32\x4e\x96\x41\x0e\xae\xd2\x75\xed\xae\x1f\x25\xf0\xf0\x53\x44\x84\x82\x20\xd7\x68\x6a\x09\x0a\xff\x79\x3b\x39\x24\xdf\xb4\x49\x41\xa8\xd5\x68\xe4\x04\x45\x4f\x8f\xcc\x11\x84\xe1\xb2\xcb\x7d\x0a\xa8\x16\x67\x89\xb3\xac\x85\x14\x97\x2f\x2b\x20\xf9\xd5\x83\x93\xef\x35\x9d\x4a\x59\xca\x20\x40\xe4\x07\x47\xfa\xf8\x8d\x8d\xfc\xbe\x82\xea\xae\x66\x32\x14\x90\xa2\xb4\x04\x97\x59\xa0\xc4\xe7\x2f\xb8\xc2\xdf\xbc\x99\x7f\x65\x84\xdf\xb7\xb9\xb1\x7c\x76\xde\x2f\x16\x1f\xb8\x22\xde\xff\xd5\x70\xe5\x80\xd8\x7e\xe5\x88\x8b\xf1\x54\xe3\xf4\x38\x1e\x4f\x4b\xf3\x72\x2a\xd2\x0f\x9e\xdd\x2a\x0d\x86\x83\x1d\xc0\x13\x34\x68\x69\xe0\x40\x51\x9b\x99\x33\x67\x22\x1f\xd1\x06\x6a\xb2\x9f\x1c\x3a\xf4\x24\xfb\x95\x3b\x1d\x3a\x94\xfd\xa4\x66\xe0\xcd\xfc\x96\xf7\x8c\x2c\x64\xbd\xae\xae\x7a\xcd\xc8\xc8\x9a\xd9\xc6\xd4\x8c\xea\xba\xb3\x03\xb0\xab\x1a\x75\x25\xe7\xa0\xcb\xa3\x7d\x4f\xf5\x26\xb3\xbe\x82\x69\x19\x32\x0f\x55\xe8\x2c\x0b\x40\x02\xcd\x6b\xea\x6a\x0f\x25\x75\x3f\xdb\x1a\xe3\x34\xa7\x4e\x9c\x4b\x6d\xf6\xde\xf9\xd0\x64\x3f\x35\xc9\xdd\x93\x3b\xca\x30\x99\x59\xef\x9d\xda\xfc\x65\x7d\x73\x5a\xf4\x80\x1b\x87\xaa\x3e\x45\xc6\xab\x55\x73\x5e\x14\xdd\x9c\x76\x7b\xa0\x7f\xeb\x04\xa4\xa6\x66\x51\x3c\xc4\x90\xa1\x89\x56\x50\xfc\xef\xc3\xca\x54\x0e\x66\xcd\xa6\xee\x3f\xa5\x90\xf5\x6d\xd8\x70\xd9\x50\x2a\x00\xc8\x26\x01\xa6\xf7\xd0\x51\x87\x47\x89\xf9\x7b\x63\x9c\x03\x4a\xef\xf1\x45\x50\x18\x65\x32\x29\x8e\x78\x50\xbd\x30\x05\x41\x06\xd1\xdf\xcd\x01\xfb\x4d\xc9\xaa\x5a\x48\xb6\x6a\x95\x22\x11\xd7\xbb\x22\xd9\x41\x05\xac\xd0\x43\x2b\x7a\x71\x89\x6d\x04\x56\x11\x7a\xe1\x96\x9e\x05\xf3\x55\x25\xd8\x43\xb5\x00\x3a\x16\x40\x31\x0e\xe6\xe3\xf0\x57\xb9\x8a\x0c\xee\x5b\x11\x79\x29\xce\x4a\x40\xf7\x6f\xb1\x62\x25\x0c\xf7\x6a\x94\x15\x41\x3e\x29\x4e\x0f\xd2\xc7\x80\xd4\x69\x7f\xe7\xbc\x24\x92\x41\xf3\x88\xc0\xec\x74\x60\x3e\xcc\x3f\x66\x30\x67\x00\xff\x48\x33\x36\x70\xe8\x36\xe1\xcc\x44\x7c\xfb\x04\x4d\x9b\xe4\x0c\xe1\xf6\x10\x34\x4e\x8d\xa1\xb0\x0a\x9c\x92\x20\x34\x4a\xbd\x4a\xda\x83\x1e\xaf\x0a\xb5\x6a\x07\xa4\x9e\x98\xc6\x87\x01\xb4\xf3\x5c\x0d\xed\x70\xa0\x33\xd3\x19\xf8\x1c\x8e\xa3\x38\x8d\xc0\xa6\xaa\xd1\xe3\x96\xd0\xaf\xef\x94\x13\xbb\xad\x36\x70\xae\x11\xf9\x56\x3f\x3a\xac\x24\x82\x73\x44\xf9\xc0\x4e\xfa\x12\xb5\x0e\xc6\xb9\x91\x32\xef\x92\x6e\x92\x2c\xb6\x84\x48\xdd\x4f\x5c\x82\x5b\xb8\xf8\xd5\xb8\x9b\xf6\x4b\xd9\x2d\x29\x9d\xab\x57\x7e\xb9\x69\xe9\x6d\x99\x3f\x18\xff\xae\x83\x54\xd0\x3a\xb0\xcc\xf0\xde\xba\x55\xd6\x65\x59\x8c\xd3\xe3\x8a\x2d\x23\x64\x4a\x65\x29\xaf\xb1\xda\xcf\x5a\xaa\xd5\x35\x94\x3f\xf8\x24\xbb\xe6\x0e\xa1\x5c\x64\xa9\x57\x19\x3c\xb7\xb9\xb9\x2c\xc9\x86\x7f\x86\x9a\x3b\xaf\xd8\xb7\xb8\xc3\x35\xcd\xcd\xf2\x53\xb8\xd5\xb8\xaf\xbc\x1a\x97\x54\xab\x54\x96\xf1\x9a\xce\x08\xcc\x84\x66\x28\x81\x50\x59\x0e\xb0\x76\x3d\x51\x0d\x7e\x44\x58\x7f\x2a\x07\xbc\xdf\x0f\xdd\x38\xf8\xe8\xbe\xa9\x73\x2d\x09\xc2\x08\x05\xcb\xa8\xae\xce\x80\x05\x35\x35\x02\x0c\xad\x0d\x58\xa2\x52\xa5\x6c\x20\x51\x43\xcf\x08\x18\xe1\x19\xa4\xce\x0c\xe1\x93\xf8\x03\xd1\xc0\x6c\x7c\xf2\xfd\x35\xce\x7b\xfb\xd3\xa6\x54\x5c\x74\xea\xbc\x95\x2b\xe9\xef\xa3\x65\xbb\x6e\xc5\xdb\xb8\x8b\x92\xc2\x50\x73\xd3\xe8\x1f\x6c\xfb\x33\x15\x99\x9d\xf9\x55\x4f\xa9\x5b\xfc\xdb\xee\xc7\x5a\xbb\xc9\x53\x33\xe5\xc0\x9d\xee\x9f\x9d\x2a\xe8\xec\x2c\x98\xaa\x86\xdf\x3a\xc3\x9b\x5a\x1f\xfe\xbe\xc6\xd4\x4c\x89\x9b\xac\x86\xd5\x05\x53\x9d\x9d\xf4\x15\x27\xcc\xb5\xe6\xe3\x2a\x07\xf5\xf2\x8c\x2a\x40\xa8\xf8\x19\x9d\x1f\xc4\xb1\x7e\x78\x67\x6e\x6f\x32\xb1\xb9\x19\xe1\x16\x88\xe2\x2d\x0a\x85\x8c\x20\xb3\x57\xb3\x14\x8a\xab\xbd\x56\x1c\x00\x3b\xe4\x70\x5a\xe5\x04\x39\x8d\x3f\x13\x7e\x84\x18\xab\x24\xab\x36\x9f\x3c\x94\xb5\x51\x72\x82\x62\x51\x38\x26\xf3\x14\x73\x65\x1b\x95\xc9\x45\x62\xaf\xda\x51\x14\x2c\xb7\xfe\x5e\x7c\x42\xb2\xb5\xef\xe7\xa2\x98\xef\x56\x64\xac\x56\x2d\xb6\x28\xdc\x5d\xe3\x20\x2e\xef\xe8\xcb\x8e\x38\x1f\x12\x2f\x71\x68\x56\xa1\x1b\x6e\x52\xae\xf3\xae\x51\xc8\x44\x89\x2a\xf9\x55\x28\x2a\x31\x68\xe7\x31\x14\xc3\x60\xd8\x9d\x51\x4c\x12\x7b\x07\x85\x81\x55\x6a\x0b\x0b\x60\x17\xe0\xd2\x22\x9a\x6f\x1e\x33\x00\xdc\xbb\xe6\x99\x56\xa8\x02\x6c\x05\x85\xb5\x3a\x1c\x09\xd1\xed\xea\xc2\xd8\xc0\x0d\x6a\x61\x04\x46\x61\xe0\x10\x9e\x45\xad\xb6\xcc\x9e\x5a\x88\x8b\x67\x31\xb1\xb7\x7a\xfc\xb0\xcc\x32\xd6\xa0\xd5\x3a\x04\xcd\x41\x75\xd9\x5a\x49\x66\x36\x96\x83\x01\x32\xd5\x74\x77\x2b\x08\x8a\x89\x89\x17\x66\x19\x81\xa7\x44\x8f\x8b\x1e\xef\xc9\xe8\x11\xaa\xa9\x11\x43\x4c\x2d\x7d\x14\x67\xbb\xb5\x5a\x18\xb3\x30\x3e\xd2\x84\xf3\x78\x4a\xa2\xfd\xff\xc5\x3e\x5c\xee\x90\xc1\xeb\x51\x89\xb8\x41\xda\x5f\x43\xb6\x69\x82\x68\xdf\xd2\x62\x0f\x6c\x5d\x0d\x05\x1a\x87\x36\xde\xd5\xde\x1d\xdc\x34\x1e\x2a\x0e\x9a\x41\xcb\xaf\x16\xc9\xb0\xfc\x71\x58\x89\xa4\x04\x37\x84\x1b\xbb\x14\x59\xf2\x58\x2e\x80\x2d\xbe\x8a\xda\xd5\xb8\x95\x61\xc9\x87\xc3\x56\xe2\x68\x17\xac\x96\x69\x4b\x67\x95\xd4\xcf\x71\x4e\xb3\x52\xc4\x86\x4e\x28\x72\x11\x27\xc1\x5d\xbc\xe0\x25\xd9\x98\x84\x8f\x85\xad\xf4\x8d\x88\x40\x33\xd4\xcd\x80\xce\xc2\x81\x6a\xa7\x16\x5a\xac\xf7\x4a\x86\x2e\x1f\x3b\xfc\xe3\x6e\x6d\xdd\xfa\xaf\xd5\xce\x2c\x53\xfb\xfb\xd8\x04\x30\x78\xaf\xb3\xc3\x1f\x01\xf5\xf8\xd8\xcd\xbb\xa3\x4f\x46\x07\x33\x33\xad\xd2\xa7\xb6\x85\xab\x3c\x24\x27\xdb\x0e\xbb\xb8\x47\x97\x45\x2b\xb4\xda\x7d\xa5\xa5\xce\xf3\xfa\xf3\xa7\x85\x27\x31\xf0\x66\x33\x9b\xc6\xfd\xd2\x79\xd0\x35\xdd\x2f\x26\xa4\x5a\x9c\x5c\x1a\x52\x42\xf4\x37\x64\x6d\xed\xf4\x5d\xe6\xbc\x38\xa7\xa7\xc2\x5c\xcd\xfd\x01\x84\x33\xdf\x82\x84\xc8\xaa\x98\x40\xf7\xec\x6f\xbf\xfb\x54\xe9\xbf\x33\x65\x75\x5e\x12\x1d\xb7\x57\x40\x8a\xaa\x15\xfc\x94\x96\x8e\xa6\x65\x37\x6c\x96\x1e\x07\x21\x42\xe3\x2c\xc0\x73\x82\xe4\xe6\x31\xdc\xcd\xdf\x24\x9a\xe4\x1b\xf2\xaf\xdb\x5a\xa7\xe3\x26\xd9\x14\x92\xe7\x3f\x03\xaf\xe2\x64\x43\xe9\xe1\x20\x9d\x28\x59\xd0\xa6\x53\x6d\x3e\x82\x4a\x22\x26\x70\x74\xde\xdc\x88\xaa\x50\xe3\x87\x84\x03\x42\x1b\x01\x46\xac\x14\xf8\xb4\x9d\xda\x04\xb1\xe0\xd9\xf8\x34\x8c\x47\xe1\x35\x9c\x09\x74\x5e\x1d\xc4\x86\x24\xaf\xde\xf2\x6a\x2d\x61\xe3\xa0\x8d\x67\x1a\x5e\xc1\x0e\xca\x90\x43\xa4\x12\x96\x85\x83\x41\x02\x73\xd6\x54\x3d\x6b\xa1\x54\x53\x2c\x7c\x0e\x80\x72\xe0\xe5\x0f\x9a\x39\x49\xb7\x0f\xbd\x42\xcf\x98\x07\xd0\xac\x86\xf6\x27\x01\xc2\xec\x76\x47\x00\x41\xb6\xce\x11\x40\x90\xcd\x74\x04\x10\xc0\xcc\x68\x51\xd5\x4e\x6a\x86\x26\x18\x80\x05\xe5\x54\x3d\xce\x03\x78\x74\x3b\x32\x5d\xaf\x12\x50\xa1\x7c\x54\x2d\x07\x09\x4c\xc3\x9e\x83\x92\xc9\xe7\xbf\xf1\x65\x31\x14\xe3\xdd\xbd\x04\x57\xc3\x5a\xc5\x9a\x2a\x4d\x72\x48\x35\x3f\x84\xf1\x82\x3d\xe0\x00\xfd\xd1\x6f\xb0\x03\xac\x22\xeb\xc6\x39\xd1\x4b\x35\xce\xf8\x3d\xab\x27\x6b\xc9\x2d\x2a\x07\x95\x84\xe8\x41\xa6\x12\x0f\x16\x45\x7c\x09\xfe\x20\xde\x20\x71\xd2\x94\x13\x91\x5f\x3f\x5c\xa3\x37\x25\x1e\xf6\x8e\x7c\x4d\x36\xb0\x66\xaa\x2b\x93\x93\x2b\x92\x53\x2a\x7d\xe7\x57\x00\x5f\xdc\x5d\x33\xaa\xa8\xfa\xb6\x66\x6d\xdc\xae\xd8\xd2\xcb\xb3\xd2\xdc\x6d\x20\x77\x21\x96\x97\xee\xf7\xe5\x2e\xdb\x52\x23\x89\xe9\xac\xaa\x3b\xc6\xed\x1f\xfa\xa6\xb1\x71\xa0\x71\xf3\x37\x31\x8a\x01\xd6\xe4\x24\xc2\x95\xdd\x99\xd5\xaf\x5f\x19\xc0\xf3\x69\x4b\xe0\x26\xec\x4a\xa9\xce\xac\x4d\xae\x8a\xac\xf6\xde\x1a\xc7\x65\x6d\x12\xe5\x56\xc4\x35\x50\x7f\xcf\x30\x3e\x14\xbe\xbe\x20\x51\x9d\x15\xe7\xb5\x35\xaa\x86\x57\x95\xa9\x48\xad\x49\x68\xd7\x1a\xe5\xf9\x26\xf9\x34\xd2\x8d\x49\x6c\xd3\x45\x37\x7d\xe2\x73\xc8\xe9\xc4\x6d\x5b\x63\xc8\xbe\xec\x01\xa2\x84\x7c\x8d\x64\x5e\x09\xa2\xec\xf7\x25\x55\x76\xe9\x01\x31\x0f\xa6\x44\x07\x93\xb2\x3e\x93\x2e\xe8\x54\xac\xd2\x1b\x37\x2a\xe9\x3d\x74\x83\x5a\xb8\x5f\xa7\xe3\x9f\xbe\x31\xd0\xa5\x3a\x3d\x30\x70\x5a\xfb\x9a\x06\x36\xd2\x8b\x8a\x4e\x04\x84\x74\x17\x14\x00\x28\xe5\x17\x75\xed\x0e\x1e\xc6\x9d\xad\x3b\x20\xc7\x42\xd2\x5d\xb3\x91\x24\x80\x6b\xa1\xda\x8c\x80\xc2\x2e\xf3\x7b\xaa\x46\xc4\x2a\x03\x12\x2f\x55\x1d\x5a\x92\x1f\xc6\x4e\x08\xfc\x12\xe7\xa0\xa6\x7c\x6f\x71\x8a\xca\x15\x4b\x97\x47\x3b\x5e\xd6\xe4\xbe\x9e\x39\x4e\xa7\xfb\x65\x1f\xff\xed\x78\x4b\x8b\x0c\x92\xa1\xa8\x5c\x87\x77\x68\xfe\xf8\xf1\x7e\xa1\xf2\xf2\x5a\x02\x70\x48\xd4\x62\x91\x12\x8b\xcd\xe4\x89\xb8\x1e\x0c\xbb\x88\xdb\x5b\x6b\xb6\x38\xe2\xc9\xcd\xcc\x6b\xf7\xe2\x2e\x62\x58\x0f\x2e\x51\xbe\x58\x70\x02\xb8\x78\x0e\x59\x61\x5e\xb5\x18\x02\xd0\x22\x98\xd1\x23\xeb\x9f\x18\x99\xe3\x42\xb3\x10\x77\x6e\x62\xa4\xbf\x11\xf3\xe5\x90\x75\xd3\xf0\xa0\xe9\x6d\x35\x9e\x46\xb2\x0f\xbd\x7e\x3e\xc7\xd0\x38\x1f\x05\x80\xad\xc1\x62\xfd\xfa\x8e\xe4\x4e\x3a\xdd\x70\x1b\x34\x0b\xb5\x01\x5b\x67\x76\x5e\x0a\x42\xbd\xd3\x46\xd0\x55\x7a\xfb\x29\x3f\x9c\xbc\x36\x30\x8f\xd0\x46\xc9\xc9\x07\x38\x98\x82\x00\xc2\x2f\x04\x32\xa0\x8c\x43\x08\x38\xa8\x8c\xae\x3e\x18\x9d\x4e\x38\x47\xee\x8f\xcd\x25\x10\x6c\xf3\x25\xe8\x1f\xdd\x35\xba\x46\x48\x98\x7b\x34\x84\x36\x68\x1c\x04\xa7\x8e\xef\xb8\x34\x8e\x25\xc6\x01\x23\x0d\x37\xb8\xe8\x9b\x1d\xf1\x8e\xcd\xfa\xcb\x78\x0c\xf3\x6f\xba\xdc\x4e\x20\x74\xaa\x5c\x95\x27\xdf\xc8\xa8\x0f\x0d\xa8\x20\xfa\xe6\x45\xa3\x8d\x8e\xe6\xbd\x30\x23\x83\x12\xef\x6a\xb8\x4e\xc3\xa3\x56\xef\xd3\xb9\x64\xe0\x3f\x57\x6c\x63\x57\x90\x81\xd7\xf0\x8b\x0b\xc4\xed\x0a\xc5\xbe\xbd\xd6\xb5\xb5\x75\xfb\x2d\x93\x06\xbb\x7d\x0f\x32\x84\x32\xa8\xb6\xa5\x45\x4e\x90\xbf\x7d\x23\x87\xe4\xb5\xb5\x32\x48\x5e\x61\x33\xf0\xf5\xbb\x19\xa5\x72\xbb\xc1\x71\x73\xa7\xa4\xb0\x88\x84\x2d\x8f\x53\x33\x26\xc8\x04\x14\xef\xf4\x84\x66\xb4\xed\x87\xa3\x0e\xcc\xeb\xce\x66\x77\x6c\x2e\x9a\x3d\x57\xf8\x38\xe5\x84\x2c\xf5\xe0\xd2\x63\x48\x03\xe1\xae\x6a\x9e\x63\x54\xea\xb1\x57\xae\x0e\x91\xd7\xa0\x05\x73\x81\x39\x0e\xf2\x30\x9e\xee\x94\x5a\xe0\x44\xce\x4d\x11\x93\xfa\xbe\x43\x32\x92\x5a\x4d\x92\x8d\xdd\xfb\x90\x64\x6a\xb5\x8c\x74\x9f\x16\x22\x38\xc0\x3a\x67\xed\x7b\x2e\xc6\xb1\xb0\xd0\x31\x46\x62\x36\x83\x31\xc7\x38\x22\x06\x79\xd4\xa6\x3e\x98\xf5\xbf\x0c\x92\xad\x57\xc0\x39\xa3\xeb\x20\xd3\x56\x9a\x1a\x8a\x8a\xf6\x0b\xaf\x60\xd7\x12\x6a\xbf\xfd\xae\x16\x9a\x8f\xbf\x54\xf2\x94\x94\x91\x91\x80\x3c\x49\x11\xa3\xbd\x4d\xd4\xb1\x57\x27\x25\xad\x66\x1b\x26\xb8\x5c\x79\xe4\x5a\xeb\x64\x6b\x01\xcd\xb4\xe8\xf9\x52\x04\x79\xd1\x6a\x77\x98\xe6\x05\x15\x7d\xf9\x88\xd0\x80\xcf\xdf\x5b\x38\xc2\x17\xd4\x8d\x02\x86\xbb\xa3\xc7\x62\x42\xed\x20\xa9\x27\x64\x27\x6d\xfc\x6f\xfb\x12\x27\x3c\x9d\x11\xf8\x7c\x45\xd4\xca\xcd\x35\xea\xf5\x3a\x15\xb3\xb9\xda\x8b\x6f\xbc\x9a\xa9\xc9\x3e\xb2\xb5\x72\x2c\x6e\x83\xa9\x69\xc0\xad\xc1\x0d\x0d\x3c\x54\xaa\xb8\x53\xb9\xb9\x9f\x3e\xa5\x16\x64\xb3\x85\x20\xff\xf4\xe9\x71\x4e\xa7\xaa\xdd\x0c\x2e\x67\x04\x2b\x20\xef\x53\x07\xc2\x1a\xeb\xbc\x0d\x51\xfa\xf9\xb7\x81\xbe\xeb\x0f\x09\x0d\x81\xe1\x4b\x02\x61\x42\x08\x01\xbe\x8c\xdb\xe0\x3f\x5f\xed\x8b\xdb\x51\x8d\x45\x20\x87\x93\xb5\x72\xb8\x86\xff\x6a\xa4\xee\x82\x68\x3e\xf4\x95\x45\xa6\x90\xd3\x9b\x3b\x13\x8f\x43\x9d\xd7\xad\x37\x7f\x85\x8b\x1c\xb7\x5e\x71\xaf\x0a\xc6\x60\x55\xaf\x65\xfa\x23\x2d\x9f\x98\x18\xb7\x3b\x6f\x4a\x98\xc4\x8b\xe3\xd2\xe6\x8e\xd4\x9d\x3e\x29\x0b\xc7\xfd\x82\x3b\x4a\x67\xed\xa3\xcf\x7f\xd7\xad\x39\x52\x3b\x4f\x67\xed\xa2\x1f\xc5\xfd\x69\x49\x37\xfa\x7d\x97\xe2\x38\x4b\xa1\x92\x9c\x1f\xdb\x81\x04\x71\xa6\x6a\x8f\xb9\xd1\x65\xd8\x93\xa3\x2b\xd9\x97\x27\x6e\xf1\xcf\xb7\x6b\x33\x8b\xc1\xad\xbb\x07\xfc\x65\x46\xcf\x81\x03\xfb\x05\x9e\xec\x0e\x85\x30\xb1\x9a\xf2\xaf\xdf\x56\x23\xa9\xed\xc6\x70\xa5\x4d\x41\x7a\x62\x5e\x50\x8e\xf7\x56\xcf\x75\xa4\xdb\x7e\xde\x31\x75\xba\x97\xd2\x93\x95\x77\x1e\x6e\xc8\x09\x5e\x9b\x2e\x49\x49\x97\xa1\x8a\x5f\x1c\xc6\xcc\xda\x76\x64\x57\xae\xc8\xf6\x8f\xf3\xce\x5b\x51\x2c\xcd\xde\xe8\x2b\x4c\x0f\x04\xbe\x62\x82\x16\xed\xbe\xc9\x02\xa5\xe5\x84\xc6\xc4\x87\x71\xbc\xfe\x0d\x39\xab\x56\x1c\x7d\xef\x09\x94\xce\x8f\x56\xd1\x77\x34\x71\x55\xe4\x33\x26\x6a\xdb\xc4\xb1\x48\x59\x62\xbc\x20\xfc\xb9\x88\x0b\x15\xbf\x74\x15\x74\x8d\x54\x9c\xf5\x88\x37\xcf\x81\xf2\x92\xab\xd9\x61\x16\x72\x42\x77\x50\x39\x3f\x29\xdb\x75\x95\xe9\x8b\x54\x2f\xd9\x61\xa3\x95\x8e\x41\x31\x39\x96\x11\xb1\x6b\xaa\x4d\x0a\x5c\xd3\xe4\x50\xdd\xdf\x73\x9b\x3c\xd8\x54\x61\x9e\x8d\xd0\xe3\x40\x82\x3c\x3f\x4d\xe2\x0b\x28\x10\x7f\xea\x08\xbc\x2e\xa1\x2c\x27\x25\x3f\x2c\xc7\x25\x81\x61\x1c\x7e\x65\xce\x05\xeb\x12\x8e\xc0\x9d\x8e\x55\xe1\x96\x30\xb5\xcd\x2f\x7a\x51\xd5\xf6\x28\xd1\xb8\xfd\x93\xf7\xa4\x61\x99\xac\xaa\x70\x3a\x2e\x36\xab\x6a\xc2\xff\x64\x46\x40\x5c\x56\xae\x34\xa6\xc9\x7f\x7b\xd3\x52\x69\xec\xfe\x19\x0b\x88\xcd\x38\xe9\xaf\xc9\xaa\x06\x6e\x75\xfd\xae\x46\xef\x98\x4d\x5f\x86\xa7\x3d\xa6\xbd\xf5\x5a\x38\xe1\xa4\x03\x48\xe3\xee\x8a\x6c\x87\x73\x79\x35\x6b\x25\xf5\xcc\x7c\x17\x28\xf0\x69\x39\x02\x0b\x22\xd9\x36\xe4\xba\x7d\xd4\x8b\x7e\x57\x4b\x84\xdb\x9f\x04\x91\x68\x59\xa9\x6b\x8a\xca\xf6\x17\xa4\x13\x97\x5c\xe0\xfb\xd7\x6c\x93\x52\x6d\x0f\x8e\xbb\xb4\x26\xd2\x0d\xba\x1d\xe6\xa6\xf1\xd8\xf2\x30\xbe\xef\xc1\x62\xfb\x5d\x67\xca\x13\x94\xb5\xbe\x22\xc2\xe7\xde\x80\x44\x5e\xfd\xb2\xe6\xbe\xde\x8c\xa8\x6a\xff\xb1\x15\x4a\x2a\x29\x9e\x9a\x36\x03\xa8\xb5\x18\x8b\x2d\x59\x62\xd9\x68\xd6\xe0\xf7\xf2\xe9\x0a\x5a\xfd\xaf\xe9\xdf\x3d\x04\xde\x91\xa6\x41\x2c\x8e\xca\x28\x8e\x9d\x4b\x23\xd0\x09\x0d\x96\xb2\x4f\x66\xc8\x4a\x02\x1b\x3a\xee\x3b\xbe\x49\x86\xbe\xc7\x21\x36\x01\x59\x69\x26\xfb\x64\xd9\xe0\xd6\x25\x6d\xae\x38\x36\x23\xaa\xf6\x99\x51\x81\xb5\xd2\xd4\xcc\x57\x5f\x91\x15\x1b\xf6\x41\x9b\x76\x7d\x3f\x2b\xe8\x47\xe1\x9a\xca\xfa\x09\x63\x63\x0e\x2a\x10\xff\x6d\xfb\xf4\x92\x67\x60\xd2\x3e\x85\x0e\xf5\x71\xb1\xd8\x2b\x1c\xfb\xa3\x3d\xd9\x5b\x35\xc4\xcd\x94\xb7\xb6\xd6\xd7\x3f\xb2\x39\xa3\x57\xc0\xc9\x2b\x5e\xfd\x6f\xaf\x5e\x45\xe0\xe3\x38\x73\x81\x29\xe5\x8f\x66\xe7\x0b\x97\x32\x61\xf4\xe6\x01\x16\xad\x51\x9f\x05\x78\x01\x1a\x7f\xa4\x17\xb7\x69\x87\xe9\x26\x09\x4e\x45\x3e\x22\x5e\x4e\x61\xe4\x61\x9f\x3b\x66\x8a\xd9\xcc\x39\x5d\x4e\x45\x26\x09\x74\x18\x5e\xb0\xc0\x43\x4c\x1a\x7a\x27\xe2\x65\x85\x38\x5a\x52\xf1\x07\x73\x26\x36\xde\x02\xb2\x37\x5f\x00\x1f\xfc\xc3\xa3\x63\x79\x4a\x9e\x91\x31\x1a\x0d\xc4\xfe\x40\x54\x5a\xb0\x3f\x53\x00\x44\x32\xfb\x0a\x1a\x87\x7c\xd4\x50\xf5\xd4\xcb\x4f\xd3\x8c\xe9\x5c\x64\xaa\x1a\x52\xef\xde\xf0\xfb\x37\x7a\x48\xd5\xf8\x83\xee\xb1\x39\x69\xc8\x03\x00\x8b\x51\x23\xb5\xbf\xb6\x82\x7a\x72\xb3\xf3\x63\x2e\xf2\x34\xf6\x86\x47\xfd\x52\x9d\x13\x34\xce\xc8\x71\x78\xa6\xf0\xbe\x3c\x32\x7e\x58\x92\x68\xb5\xf7\x6e\x08\xe1\x19\xe3\x19\xe0\xa9\xb3\xa8\x06\xc5\x32\x88\x61\x75\x42\xad\x4e\x43\xd1\x00\x2b\x9b\xa2\x6b\x97\x58\xf3\x30\x0e\xd1\x0d\x18\x13\x61\x6a\x35\x6e\x03\x3e\xde\x89\xa8\xf5\xb2\x12\x65\x33\xf6\x07\x11\x76\xde\xa7\x17\xd3\xf4\xe9\x3c\xa5\x3e\x81\xb0\x5f\xb2\x9f\x90\xa0\x07\xdb\x0c\x75\x48\x3a\xa0\x04\x90\x58\x52\x9d\xff\x25\x7e\x39\xe1\x04\x21\x34\xf6\xfc\x6f\xd1\x0f\xd1\xbc\xa6\xe8\x1c\x02\x00\x33\x85\xba\xe2\x94\x38\x3f\xb6\x82\x20\xce\x1f\x31\x01\x64\x1a\x23\xef\xd4\xd1\xfc\x1c\x96\x9a\x41\x26\x8b\x84\x84\x7b\x41\x21\x69\x36\x0b\x8b\x8c\xed\xdc\x2f\x58\x61\x9d\x9f\xa3\x1a\xe1\x05\xe3\x87\xa4\x3d\x12\x57\x16\x89\x3b\x7c\xef\x04\xf0\xe4\x1a\x29\x81\x20\xd0\x67\x16\xbe\x7e\xa3\x0d\x3f\x2a\xbe\xe6\x82\xbd\xc3\x20\x7d\xbf\xe3\xda\xa7\x88\x4b\x11\x74\xcc\x3e\x35\x7c\xad\x45\x8e\xb5\xd0\xee\xd1\xdc\xd5\x2e\xa7\xfd\xf4\x41\x07\xfb\x9a\x0b\x51\xf1\x36\xfc\xfa\x0d\x02\x07\xb2\x80\xbe\x28\x36\x1a\x0a\x0a\x36\xd1\x94\xc6\x37\x00\x96\xda\xf7\x3c\x39\xf9\xf9\x5f\xf0\x95\x43\xc1\x0c\x66\x31\x0f\x19\xfe\xeb\xd0\x8e\xe9\x69\x38\x15\x06\xbe\xae\x86\xe5\xbb\x2c\x0d\x56\xbb\x34\x24\xc0\x9f\x75\x74\xc9\xf8\x9c\xf8\xa3\xc6\x69\xc1\x2c\x2e\x60\x76\x36\x72\x09\xe2\xa3\xf2\x8e\x50\x0d\xaf\x1a\xbd\x67\x46\x42\x90\xc9\x8e\xc7\x0a\x74\x1b\xf2\xc3\xe7\xda\x0d\x43\x01\x33\xbd\x3b\x98\x0c\x1f\x04\x18\x2c\xbc\x6f\xc3\x82\x93\xe6\xe3\x9c\x78\x7c\x09\x0a\x2f\x4b\xf3\x4d\x70\xa9\x31\x53\x5f\xbe\x91\x58\x2e\x66\xf7\x56\x8d\xaa\x86\x23\xbc\x55\x3e\xc8\x92\xc8\xf5\x88\x23\x42\xf6\xf1\x5d\xb5\x0d\x41\x15\x1d\x8f\x91\x49\x56\x6f\xc0\xcc\xd0\x86\xcf\xb5\xc0\x91\x14\x15\xbf\xfc\x47\x54\x56\x31\x6e\x72\x2b\xa3\xce\x4f\x58\xc2\x53\x95\x25\x0b\x8b\x9b\x04\xc2\xb3\xb6\x99\x66\xa7\x4f\x5a\x5b\xdb\x5f\xfd\x39\x9c\xfb\x11\x91\x50\xad\xdf\xb0\xf7\x20\x0f\x7b\x47\x6c\x11\x6e\x91\x05\x25\xdf\x23\xda\xdb\x68\xc0\x64\x77\xbf\x48\xa0\xf9\x3d\x86\x7f\x20\xb8\x79\xa9\x65\x2f\xed\xaa\x57\xbc\x19\xb3\xb4\xb6\x3e\x7f\xd2\x2c\xd3\xf6\xac\xb0\x49\x50\x2c\x2a\x4d\x56\x95\xf0\x84\x7e\xc2\xba\x4b\x26\xe3\x65\x15\xa2\xc4\x8b\xbb\xfb\x4d\x06\xec\xbd\xa3\x3d\xf2\x29\x16\x8b\x71\x46\xbf\xa0\x53\xb6\xdf\x08\x1b\x05\xb4\x22\xc9\x47\xd1\xeb\x54\x9a\x46\x99\x8d\x2a\xb9\x4c\x58\x2c\x68\x8a\x5b\x97\xb4\xba\x93\xa7\x77\x8e\x77\xb4\xed\x65\xf1\x57\x51\x45\xd9\x78\xda\xeb\xba\x0c\x3f\xe1\xe8\x93\x7b\x10\x5e\xda\x86\x45\x8e\x78\xef\x68\xbb\x9d\x69\xfd\xbb\x05\xa2\xd7\x82\x8f\x12\x84\x4a\x94\xb3\xc3\xc0\x48\x8f\x07\xa5\x7c\xf3\x5e\xd9\xcd\x93\x8a\x85\xc9\x65\x2a\x5e\x89\xd0\xaf\x2e\xe3\x75\xda\x78\x45\x99\xe8\xeb\xcb\x62\x23\xee\x3b\x9b\x92\xf4\xd4\x9d\x3c\x5d\x7a\x5d\x5c\xed\x83\xd7\x02\x81\xa8\x7f\x77\xda\x4e\xbb\x68\x6f\xbc\x63\x68\x71\xb8\x45\x98\xa3\x3d\x1e\xba\xe7\xe2\x13\x34\x51\x31\x1f\xb8\x37\x0e\xfd\xdd\xe7\x57\xfc\xbd\xcc\x93\x6c\xc3\x95\x36\x8f\xe1\xa7\xbf\x14\xad\x12\x48\x45\x5f\xcc\xa3\xa0\xf9\x71\x79\xff\x89\x1e\xe1\x0b\x59\x06\x52\x8e\xa4\xdb\xab\x1a\x3c\x78\x3d\xd4\x63\x53\xdf\x58\x15\x79\x21\x3d\x30\xfb\x38\xc7\x97\xf3\x78\xd6\x0c\x98\x9d\xf4\xfe\x3f\x1f\xb1\x7b\x7d\xa2\xff\xc0\xee\xdf\x46\x5f\x84\xfc\xe1\xc7\xa0\x99\x7a\xf8\xaf\x89\x6b\x74\xa9\xc9\xb1\xf0\x88\x37\xb3\xca\xda\x82\x7c\x74\xfb\xa7\x2b\x61\xae\x6d\xb8\x3c\x78\x07\x65\x24\x82\xe9\x65\x96\x8f\xe3\xc9\x8d\xaa\xd7\x3c\x5b\x1c\xb9\x54\xf7\xa6\xff\x8f\x58\x5b\x1c\xfe\xe1\x9a\x1b\x0b\x9e\x7f\xf8\x03\xa3\xd9\xf6\x00\x1b\xbf\x73\xbb\x1d\x56\xc3\xcc\x8b\xab\xde\xa7\x55\x9e\xc9\x2a\x11\xb3\xe1\x8c\x68\x97\x53\xa9\x4b\x3d\x46\x65\xcb\x73\x77\x20\x75\x59\xe9\x6f\xcd\xdf\xd9\xaf\x6e\x51\xab\x17\xb3\x3d\x4e\x1b\x45\x3a\x5b\xd2\x28\x7f\x17\x74\x5d\xe5\x0d\x6b\xd7\x7c\xe7\x40\x91\xd9\xbf\x75\x5a\x5c\x39\x7b\x68\x81\xb1\x8f\x76\x10\x7f\x18\x80\x14\xda\xdc\x95\xd3\x86\x2e\xda\xa1\xfa\x73\x61\x83\x89\x24\x75\x2b\x9f\x71\xb9\x19\x77\x3a\x23\xe3\x1f\xe6\x3f\xe5\x65\xfa\x54\x89\x33\x3d\xd0\x14\xac\x07\xd0\xbb\xd0\x21\xb5\x70\xec\x50\x4c\x60\xcd\x3a\x85\x93\xdd\xd0\x52\x03\x3b\x0c\xd8\x67\x08\xca\xae\x0b\x9d\x06\x46\x68\x61\x9d\xce\x90\x3b\xaf\x14\x07\x13\xcc\x13\x86\x35\x49\x65\xa0\x2b\x18\x96\xdb\xd8\xf5\xbb\x0e\x0c\x9d\xd7\x62\x74\x30\x36\x41\x57\x34\x30\x54\xcd\x68\x4a\x63\x63\x3c\x26\x03\xc0\x6d\x4a\x63\x63\xe0\x0a\xb8\x10\x0c\x8d\x07\xf3\xa1\x4f\x1c\x1b\x43\x6e\xab\x57\x3f\xfc\xf9\x4e\x07\xc2\x69\xfe\xee\xbe\x6f\x5d\x28\x58\xd0\x84\xfb\x9f\x7b\xf7\x4c\x7a\xec\x98\xf4\xd9\x3b\xbf\xf0\xd8\xf1\x67\xb9\xef\x7c\xc7\x8f\xe7\x3e\x18\xe4\x39\x7e\x6c\x34\x73\xe6\x56\x79\xf9\xad\x99\x60\x8a\xd7\x55\xdf\x9b\x2c\xf9\x9f\xfe\xf1\xd7\xc5\x23\x5f\xca\x75\xb4\xf9\x2b\x44\x65\xea\xea\xed\x99\xe0\xe9\x4d\xd9\xfc\xa2\x7e\x03\x68\xb3\x71\xaa\xeb\xb2\x87\x92\xb7\x23\x59\xfa\xca\xe6\xf1\x7a\xfc\x24\x3b\xdb\x0c\x35\xcd\xaf\x39\x24\xb5\xcb\x20\x7a\xb2\x1d\x3e\xff\x0a\xbe\x2a\x6a\xf5\x4f\x9f\x8d\xf8\x8c\xf1\xdb\x24\x9c\xd0\x24\x4f\x2f\xaf\xc0\x0f\x15\xc3\x78\x52\xed\xbd\x83\xc2\x74\xfd\x66\x2d\x7d\x2a\x9e\x6f\xef\xc9\xa6\xf8\xd9\xcc\x7c\x33\x86\x4d\x3b\x15\x78\xde\xa8\xaf\x55\x94\x95\xb5\x6c\x2f\x2e\x14\xf1\x7e\x58\xc7\x8e\xaf\x59\xe1\xbc\x34\x99\x78\x33\x26\xe6\xeb\xd7\x78\xae\x22\x3b\xa4\x24\x8d\x4b\x49\xf6\x92\x35\xdb\x64\xda\xad\x0c\xbd\xca\xe1\xfc\xfa\x8b\x7e\x77\x5b\xca\xd4\x4d\x19\x1f\x39\x4f\xd8\xe3\x25\x34\xd2\xe1\x51\xa8\x1c\x7d\xe9\x19\x53\x5e\x9e\xec\x74\x96\x9d\xe8\x40\xd1\xcb\xe6\x0e\x77\x5b\x9b\x59\x3b\x2d\xdd\xf6\xff\x8d\xc0\xba\x73\x3b\xa2\x62\xbe\xcd\x77\x48\x2d\x2a\x05\x0c\xef\x96\x96\xc3\x69\x6d\xbd\xb6\x49\xca\x08\x2d\xb6\x1f\x7f\x31\x65\x2f\x95\x7e\xf8\xa8\x54\x4e\x4e\xae\x2e\xf2\x70\xb2\xdf\xf0\x29\x48\x24\xfb\xeb\xab\x57\xef\xdf\x7e\x8f\xce\xf0\x03\x90\xb5\xc9\xc6\xa6\xed\xbc\x4f\x0a\x28\xa0\xe9\x39\xe8\x86\x2c\xd9\x0d\x5d\x67\x4d\x36\x72\x1d\xea\x2b\x84\x23\xbb\x07\x25\x83\xe0\x2f\x73\xfc\x77\xaf\x41\x18\x9c\x24\x11\xf5\x8c\x71\xc6\x34\xcb\x11\x07\x4f\x3e\x0a\x64\x39\x7d\xf7\x9d\x13\x2b\xf0\xd1\x24\x8c\x73\xf4\x9a\xb3\xfd\x3f\x03\x30\xa3\x99\x14\x61\x2c\x3e\x9d\x79\x8b\xb9\xda\x88\x73\x3c\xf0\xfe\x3d\x86\x2c\xf0\x8b\x63\x5f\x2a\x5c\xe2\x83\x44\xed\xbc\xdc\x7d\xc8\x32\x19\x4d\xed\xb0\xcd\xcc\x54\x38\x17\x2f\x12\xed\x34\xe6\x67\xe6\x27\xda\xa6\x9e\x9d\xe2\x38\x27\xfb\x7d\x22\x8b\x43\xe2\x03\x2a\x84\x5c\x39\xbd\x23\x59\x8f\x1f\x3e\x7a\x24\x8e\xe6\xf6\xd4\x37\x63\x5b\x73\x93\x7c\x22\x24\x7d\xbb\x09\x79\x6d\xcc\x16\xef\xd5\xc0\xab\x7a\x51\x78\x20\x35\xb7\x25\xe5\xa0\x6f\xf9\xe5\x93\x24\x6a\x86\x6b\xd0\xce\x70\x12\x29\xf1\x78\xbd\xc4\xfb\x2d\x3a\xba\x86\xd0\x98\x7c\x3f\x9d\x98\x6f\xe3\xf8\x66\xa7\x87\x67\x46\x72\xc0\x9e\xdf\x32\x15\x1a\x8a\x53\x91\xc7\x5a\xf7\x8c\x02\x11\x71\x7d\x71\x88\x8a\xb5\x09\x85\x6f\x80\x1d\x1f\x2c\x77\x79\xc5\x4f\xa1\x67\xca\x13\x90\x43\x46\x31\xd6\x6c\x98\xe7\x9f\x27\x06\xed\x4c\x35\x54\x33\xf5\xf2\x33\xfb\xd1\xf0\x79\xaa\xa6\x4d\x89\x39\xda\x32\x22\x73\x94\x98\xf2\x35\x14\x75\x1b\x44\x95\x8b\x36\xc0\xd4\x2d\x4a\xec\x89\x55\x08\x86\x62\x05\x73\xba\xcf\x18\x0a\xab\x4f\x29\x83\xcc\x16\x77\xb8\x52\x0d\x2d\x32\xe6\x2f\x35\x66\xdc\x3c\xaa\x42\x51\xcc\x34\x16\x6b\x8c\x98\xea\x90\xad\x9d\x47\xb9\x09\x13\x1f\x29\x25\xdf\xbc\x0e\xe3\x3c\x64\x1e\xc6\xe0\xc4\xaa\xc4\x9e\xed\xbc\x01\x33\xc8\x32\x0b\x60\x8e\x1c\x1a\x61\x02\x12\xd8\xc3\x5d\xa9\xaa\x53\x7b\xa3\x4b\x25\xf4\x5c\x5d\xa6\x33\x08\x26\x81\x8a\x70\x12\x84\x93\xae\xae\x1b\xda\xb8\xda\x1b\x88\x94\x43\xd5\xb8\xa2\x0c\xfd\x9c\xa9\xeb\x86\xb6\x93\x2c\xf7\xc0\x0d\xda\xf9\x5e\xe9\x54\xd0\xd9\xdb\x37\xbf\x68\xbc\x35\x99\x75\xfb\x6c\x44\xc0\xa3\x78\xda\xb7\xb6\x1f\x97\xf1\x03\x4c\x27\x44\xb6\x06\xf0\x84\x18\x72\xc3\x36\x1d\xfa\x76\x0c\x19\xd5\xb7\x66\x63\x5c\xfe\xa3\xfd\xa7\xb5\x67\x41\xea\x05\x91\xc2\x9d\xb3\xa0\x86\xb2\x40\x00\x3f\xb6\xbd\x9e\xc2\xd8\x7f\xc1\x91\x09\x9c\x95\x47\x46\xe6\xc1\x56\x11\x41\xb7\x6c\x2c\xb6\xb5\xc4\x3a\xa7\xbe\xa0\x95\x69\x23\x7e\x4e\x55\xfb\xe6\x93\x44\x56\x7e\x37\xbe\x8f\x6b\xb7\x4b\x60\x1a\x21\x88\x50\x1c\x96\xec\x4d\x58\xb3\xfc\x52\xcd\xc6\x24\x23\xee\x54\x69\x69\x68\xa8\x9e\xae\x6f\x6d\xd5\x98\xc5\x3c\x2e\x38\x55\x6d\xc7\x3c\x92\xce\x33\xef\xd3\xe9\x18\x45\x53\xe4\x34\x0c\xdc\xab\x9d\xfa\x9f\x56\x1e\x39\xb2\xf2\x27\xbd\x99\x62\x76\xd2\x7b\xbf\xa1\xf8\x3f\xee\x56\x56\xde\xfd\xa3\x33\x9d\xd7\x3b\x94\x55\x27\xd6\xac\x91\x02\xc0\xb5\x31\x52\xd9\x56\xe9\x7b\x42\xc7\xf4\xc6\xee\x69\x9f\x07\xeb\xba\x1f\x34\x43\x0b\x52\x68\xdc\xe9\x8a\x72\x36\x7e\x98\x1f\xd3\x96\x90\x4a\x94\xa5\x1f\x50\xe0\xcd\x8e\xd5\xf7\x4d\x1f\x87\x0c\x0b\x04\x37\x47\x8c\xd0\x00\x2d\x2c\x10\x4e\x4c\x6f\xbc\xf4\x3c\x9f\xb2\xb6\xd1\xa3\x7a\xe7\xf7\x95\x95\x32\x48\x26\x12\x7c\x16\x24\xb3\xb2\x92\xbc\x3c\x7b\x0d\x06\xa6\xda\x9c\x93\x4b\xe4\x5f\x9c\xe6\xd6\xff\x6d\x6d\x53\x8c\x29\x3e\x7f\x72\x92\xcf\x9b\x66\x40\x07\x1f\x3d\x9a\xe2\x42\x46\x99\x8b\xef\x43\x42\x00\xb1\xa9\x89\x89\x83\x87\x26\x27\x87\x46\x7d\x19\x40\xa9\x26\xd3\xc8\xff\x19\x04\x84\x79\xd7\x75\x62\xe2\xd0\xc1\xc9\xc9\x43\x3f\x27\xab\x1e\xea\xe5\xf1\xe7\x18\x53\x01\xe6\x34\x39\x2b\x97\xc3\x93\xe4\xb1\x43\xf8\xae\xb3\xed\x49\xc3\xae\xf0\xa4\xd9\x1f\x8c\x2b\xd7\xe7\x98\xeb\x66\x05\x2f\x52\xab\x06\x55\xea\x0d\xcc\x12\xee\x43\x0b\xb0\x01\x46\x75\x3a\x14\x43\x11\x2a\xa3\x7d\x0c\x86\x54\x3a\x1f\xba\xb6\x7d\x7b\xc2\xdc\xdd\xbd\x01\xe2\xb2\xb7\xd4\x75\xaa\xce\xd7\x17\xf8\x95\xac\x65\x5b\x21\xae\x96\x3e\xfa\x3f\x0b\x8b\xc4\x0f\xe5\xfd\xda\xb8\xb8\x22\xc7\x4d\x0a\x38\x67\x7a\x83\xf4\x30\x5c\x48\x6b\x83\xd4\x06\x5a\xb3\x3a\xc4\x68\xe5\x02\xad\x1b\x7e\x99\x03\x40\x13\xaa\x2b\x1d\xf4\x23\xab\x4e\x9d\xea\x0c\xd6\x66\x6a\xbe\xe7\x18\x79\xb5\xee\xe5\x8b\x69\x6f\xc0\x21\x84\x42\xf7\x09\xfe\xf1\xf4\xb7\x93\xb1\x52\x27\xa9\x35\x3e\xda\x13\x03\x60\xda\xf2\x5b\x21\x1a\xc2\xc4\x54\x18\x88\x60\x6d\x1f\x43\x01\x9a\x9d\x0b\x49\x85\xd1\x9d\xc3\x7d\xb8\x6e\x0a\x8f\x3e\x57\xdf\x10\xf4\x01\x21\x4d\x7d\x18\x55\xcb\x61\x76\xf5\xb6\x01\x34\x90\x80\x33\x29\x21\x10\x04\xa7\x01\x0e\x6b\x1b\x63\xc8\x82\x1a\xb2\xf3\x6d\xfe\x3f\x92\x8e\x09\xdd\xe5\x64\xef\x18\x77\x95\xe3\x76\x17\xab\x21\xd7\x94\x3b\x58\xcf\xa1\xdb\xa8\x7d\xbd\x1d\x38\xed\x76\x74\x95\xeb\xd0\x22\xd3\xbc\x23\x0c\x6b\xc2\x76\x17\x58\x8a\x4a\x17\x99\xb1\x5b\x93\x6c\x9d\xb6\x5b\x5a\xdd\x99\x4d\xc3\x80\xa7\xf1\xc3\x16\x58\x43\x07\x33\x6c\xea\x96\x5b\x0d\xc3\x54\x61\xbc\x56\x6a\x8b\xa1\x85\xda\xca\xbb\xa5\xe5\x18\x01\x8c\xd1\xac\x22\xc5\x93\x17\xd1\xf2\x0b\x74\x3a\x15\x82\x28\x9e\xea\xe0\xa6\xc8\xc1\x3c\xbe\x08\x06\x63\x5a\xf3\x76\x65\x97\xfd\x51\x9b\xc7\xee\x54\x85\x9f\xa0\x71\x48\xe1\x91\x69\x7b\xa0\xd2\xe9\x8a\x80\x51\xe5\x9f\x93\x27\xc1\x93\xc3\x96\xbb\x93\x69\xa0\xf6\x50\xd8\xf6\xef\xbf\xad\xe8\xfc\xc8\x03\xaf\xe1\xed\x6d\x4b\xca\x41\xe7\x49\x8a\xf5\xa4\x19\x8f\x90\x42\x46\x83\x0a\xa3\x15\x05\x3d\x89\x89\xb5\x07\xe2\xd6\x07\x54\xe1\x85\xbd\xb6\xe0\x28\xe2\x0f\x9c\x26\x54\x53\x10\x9f\x3f\x2f\xef\xd8\x81\x41\x3a\xd6\xb7\x1e\xac\xbb\xd0\x9e\x56\xa7\x28\xf8\xf7\x40\x77\xef\x0f\x46\x07\x61\x5e\xe3\xa6\x5d\x85\x8c\xb5\xe4\x6f\x5d\x2a\xd3\xf1\x39\x39\x16\x16\xac\x52\xbf\x69\x0e\xe1\xf1\x5f\xd4\xf2\x98\xc0\x99\xfe\xce\xcb\x32\x76\x4d\x93\x80\xd8\x60\x50\xa7\x93\xf4\x66\x03\xa6\xb4\x06\x7b\x51\x06\x59\xcf\x16\xab\xed\x48\x7f\x23\x56\x37\x76\xc5\x37\xcc\xd2\xb0\xa0\x71\x3e\x40\xe0\xf3\xab\x18\x7e\x75\xc9\x98\xd7\x86\x41\x78\xb0\xc3\x2f\x83\x02\xab\x78\xd9\x08\xb0\x42\xfe\xe0\x33\xa3\xc4\xa0\xd9\xf4\x89\x9d\x74\x83\xca\xc1\x79\x1d\x86\x62\x30\x8c\x9e\x16\x6e\x47\x60\x04\xc1\x0c\x18\xf2\x04\x45\xda\x04\x44\xec\xd9\x8d\x1a\xe4\x41\x92\xd4\x3a\x0c\xc6\x50\xd8\x10\x05\x77\x49\x1f\xd3\x37\xcf\xec\x10\x6e\x57\xd3\xf7\x32\x45\x44\x3c\x68\x18\x44\x90\x26\x5a\x98\x88\xc3\x09\xce\xa0\x37\xcd\x33\xe1\x26\xfa\xcd\x1c\xeb\x29\x4c\x44\xcb\x34\x3b\xdc\x37\xe8\xe6\xd1\xb4\x3f\x24\xad\xee\xcb\x6b\x40\xd9\x03\x22\xf6\xbf\x22\x4c\x26\x7e\xa7\xf9\xf6\x31\x1d\xca\xba\x66\xab\x31\x34\x8a\xca\xb1\x7c\xee\xda\xd9\x37\x4f\x73\x8f\x1e\xcd\x7d\xfa\xc6\x4c\x31\x3b\xe9\xdd\x3f\xc2\x7b\x31\x24\x90\x17\x83\x03\xaf\x77\x29\x99\x80\x31\x18\x7d\xd0\xe0\x30\xe8\x7e\x37\x84\xda\x23\x08\x0b\x45\x10\x35\x8a\xaa\x60\x1d\xd2\x71\x26\x6f\x0d\xc7\x6f\x77\xde\xcd\x0b\xcb\xd3\x5f\x93\x85\xe9\xb4\x8a\xaa\xd4\x38\x51\x31\xdb\x1a\x8f\x47\xf3\xbb\xbb\xf3\xeb\xf9\xd3\xc9\x8a\xf9\xea\xf9\x30\x19\x38\xe5\xd7\x02\x68\xb8\xe4\x57\xbf\x65\xa0\x98\x2b\xba\xb2\x5c\xea\xe5\x13\xd4\x89\x86\x52\x8b\xd4\xca\x17\x93\xc3\x55\x3f\x77\xae\xe0\x65\xd9\xf4\xef\x4f\xd9\x19\x8f\xdd\x53\x78\x12\x81\x16\x41\x55\xd6\xcc\x9e\x7f\x33\x98\x7c\x09\x3d\x57\x41\xb5\xcd\xdd\xc5\x52\x9d\x0e\x0a\x50\xf1\xb0\x74\x4f\x25\x51\x22\x31\x59\xd7\x94\x1d\x19\x21\x73\x6f\x25\x25\xad\x5e\x9d\x14\x7a\xff\x5f\x68\x6e\x2c\x04\xfd\x7b\x3f\xd4\xdc\x05\x1f\xf8\x22\x4c\x1c\xac\x17\x35\xb1\x5f\x44\x36\xa9\xee\xec\x04\x2b\xea\xef\xca\xcb\x53\x18\x62\xc6\x3c\x81\xe0\xa7\xcc\x67\x66\x4a\xcb\xf4\xa5\x30\xe4\x62\xec\x5f\xe7\xa1\xd1\x78\xd4\x2d\x1b\xed\x0b\xa3\x18\x3b\x89\x48\xab\xe2\xe3\xe2\xe2\x57\x91\x3a\x47\x98\x4d\xf0\x69\xc4\x45\x65\xcc\x49\x75\xaa\xae\x76\x4a\x5d\x7e\x6d\xc8\xd9\xdf\xd8\x51\x48\x4c\x4b\x6b\xec\xbb\xca\x68\x62\x9e\x41\x3e\x7d\x2e\x28\x68\x95\xdc\x22\x82\xf3\xe3\x55\x5d\xa2\x39\x49\xed\x68\x5f\xee\x56\xaf\x40\x87\x9b\x47\x5a\x10\x30\x43\x0a\xdc\x70\x15\x13\x62\x0d\xf3\x1b\x3a\xe3\x99\x4c\x0c\x33\x8d\xa1\x74\x4e\x2a\xaa\x4e\x0c\xbd\x32\xbf\xd3\x21\xbc\x33\x8c\x8d\x0d\x43\x61\x18\xb3\x97\x79\x6e\x2e\x95\xca\xdd\xc9\x8b\x7f\x57\xe2\x60\xcc\xb3\xdc\x7d\x18\xb1\x51\x42\x1b\xcc\x43\x8f\xaa\xc3\x69\x20\xc1\xa1\x38\x2a\x08\x02\xc3\xef\x7b\x35\x37\x89\xb9\xec\x05\x4f\x23\x61\x54\xa2\xcb\x1d\x42\xbd\x9f\x82\xbd\x55\xed\x6f\xfe\x69\xa7\x30\xe7\x0d\xf3\x5f\xcb\xf0\xd1\xf9\x5e\x0a\x43\x68\x72\x55\x7d\x7e\x72\xc8\x4c\x19\x20\x31\xf2\x7a\xff\x3e\x2e\x2e\xf6\x3d\x1c\x25\x16\x47\xc1\x9a\x9b\x47\x8c\xa3\x0b\xeb\xfc\x6f\x20\x99\x90\x87\x0c\x18\x8c\x45\xe9\x75\x91\x50\xb3\x05\x50\x73\xd8\x98\xde\xb8\xe8\xf3\x38\xad\xf0\x03\xfc\x05\xfe\x50\xa8\x9f\x75\x26\x3b\xe6\xca\x2a\x8c\xeb\xf9\x7c\x69\x26\xa4\xff\x50\x62\xdb\xc8\x9b\xb0\xb2\x98\x68\xd2\x3b\xb4\x5b\x97\x80\x7c\x18\x79\x2a\x63\x83\x56\x9a\x68\x86\xd2\xbc\xd2\x28\xff\x94\x7e\xce\x16\x5f\x41\x95\x71\x55\x32\xb7\xbd\xff\xf4\x47\x49\x51\xc7\x33\x60\xae\x5e\xca\x76\xfe\x3d\xee\x40\xac\x0f\xdc\x4b\x27\xa4\xb5\xd0\x87\xe8\xcb\x5a\x23\x08\x34\x73\x4a\x02\x3d\x0f\xba\xa4\x3a\x73\x59\x05\xa9\xe8\x09\x14\x73\xda\xa5\x62\xc2\x79\x6a\x0c\xaf\xeb\xb7\x1b\x53\x1f\x5f\x45\xdc\x83\x22\x8e\xaa\x8b\x8a\x40\x9e\x7e\x82\x9f\xe7\xb9\x08\x3e\xad\x38\x36\xba\xf1\x23\xce\x79\xb1\xda\xe4\xe5\x5b\xef\xe8\x63\xf2\x53\xef\x84\xa5\xd9\xc5\xac\x96\x90\x94\xf2\xf2\x23\xad\x53\x4a\x94\x47\x5a\xfb\xae\x79\x19\x76\x76\x12\xdd\xdf\x53\xf9\xd1\x8f\x1a\xe6\x89\x9d\x70\xb8\x77\x61\x54\x9a\x9e\xcf\xf5\xc5\x2f\x13\x2c\x1f\x14\x0a\x39\x27\x4a\x6b\x76\x25\x25\x2e\x86\x7e\x6f\xf2\x5b\xcc\xf3\x9f\x20\xe8\xa1\xa7\xfc\x1c\x9c\x13\xc2\x52\xb1\x42\x6a\xa2\x9f\x53\xe8\xc8\x80\x2a\x24\x27\xf8\xe8\x85\x81\x08\xa9\x70\xc3\xa7\x53\xa5\x75\x00\x0b\xe0\x2b\xe7\x07\x39\x18\x5c\xac\xed\x5f\x66\x2b\xe5\xf2\xfc\xf9\x38\xf5\x10\x75\x5c\x2c\xb6\xca\x14\x8f\xd3\x74\xe6\xe5\xf0\x70\x9e\xd1\x8d\xd0\x1e\x6b\xd8\xae\x13\x4a\x30\x68\xf3\x28\x5d\x18\x4e\x11\x84\x51\xc8\x76\xf3\xb3\x57\x1f\xac\x81\xc7\xcc\x37\xf3\x0c\x95\x38\xab\x83\x36\x3a\xdc\xd6\xdd\xa3\xcf\xe2\x0b\xb7\xf1\x57\x63\x93\xaf\x59\x5f\x69\x3e\xfa\xac\x8c\xdd\x22\x91\xdf\x52\xa1\x10\xe7\xf5\x92\xe2\x81\x28\xd3\x65\x18\x5d\xde\x36\xf8\xb9\xaa\x04\x52\x41\x25\x55\x97\x36\x26\xb4\x46\x5d\x94\xbd\x37\x40\xcc\xb3\x6b\x33\x58\x0d\x68\x81\x3f\x8c\xc1\x4b\xef\xe0\x13\xae\x04\xde\x00\x1e\x51\xa4\x32\x99\x42\x08\x6b\xbe\x5b\x3c\x6f\xa5\x2d\x5b\x0d\x11\x00\x73\x7e\x9f\x1c\xee\x30\xaf\x8d\x5d\x54\x9c\xb0\xc3\x32\xc1\xcd\xe4\xaf\x68\xca\xf1\xdd\x4a\xc6\x71\xee\x4b\xb9\x76\xa7\xab\x9f\x28\x33\x32\x4a\x16\xba\xfe\x1a\xed\x2f\x2f\x57\x08\x85\x1a\xbc\x2c\xe3\xac\x19\xc3\xce\xdb\x7a\xef\x0b\x11\x8f\x64\x24\x32\xd1\x63\x41\xb4\x6b\x50\x94\x0f\xb9\x01\x97\x09\xa7\x94\x4b\xff\x2b\x80\xee\xad\x45\x63\xa5\x0b\xdb\xc2\x01\xdc\x07\xe0\x5b\x51\x9e\xf9\xe0\xf3\xf4\xf9\xfb\x69\xfa\x97\x16\x45\x64\x1a\x56\x1c\xc3\xf5\x78\x52\x38\x19\x9a\xe4\x42\xd0\x35\xda\xcc\x16\xc4\x61\x17\x3d\x3a\xa2\x81\x4b\x51\x98\xb6\x9d\x03\xe7\xd3\x1c\x5c\xa0\xb0\x40\xc6\x56\xbb\x5e\x3b\x4b\x72\xcc\x09\x97\x4d\x18\x7b\xfc\xf7\x13\xf1\xc6\x7b\x7f\x23\xfe\xf3\x43\x8f\x77\x7d\x13\x7b\x5e\x2c\xed\x1b\xa7\x24\x30\x52\x63\xff\x5c\x6f\xbe\xab\x51\xbd\xd1\xbc\xc1\xd7\xa5\x50\xfc\xca\x24\xe9\x01\x86\x1b\x59\xca\x76\x70\xe0\xae\x3e\x3f\x0f\x2e\xff\x1f\x80\xc3\x2e\x4b\xa1\xc8\x4b\x41\x62\x8e\xcc\x7f\x36\xfd\xde\xc4\x14\x1f\x35\xbf\x7f\xb7\x6b\xf2\x92\x65\x8e\x2b\x5d\xb2\x46\x16\x3c\x48\xcb\x65\x22\xaa\x3b\x7b\x77\x4e\x89\x79\x78\x2f\xc6\xd7\xe5\x07\x26\x66\xe6\xaa\x84\x2f\x58\x5a\x18\x5b\x9f\xa4\x37\xbb\x9a\xb6\x63\x25\xdf\xc5\x80\x35\xbd\x64\x95\x59\x5f\x9f\x79\x22\xd0\xab\xdf\x9e\xa3\x32\x5c\x33\x37\xd7\x0a\x96\x0b\x1a\x6b\x5c\xc4\xeb\x9d\xb2\x7c\x46\x02\x4f\x20\x8a\x92\x0f\xb4\x36\x38\x8a\xa8\xf1\xf0\x85\x91\x9c\x9c\xc3\xef\xfc\xbd\x17\x84\x8d\xff\x6e\x4b\xf0\x17\x7a\x52\x22\x8f\x0e\x19\x83\xfd\x87\xa0\x96\x9f\x3f\x2f\x37\xd0\x33\x32\x9a\x3a\xd0\xb0\xe1\x3e\x8e\xb0\x65\xf6\x57\x48\x04\xa9\x55\xf2\xab\xfb\x07\x30\x4f\xdd\xd7\xa7\xbe\x35\x85\xb4\xf8\xff\x0d\xa4\xfa\xee\xe4\x65\x33\x48\x87\xae\x63\x6f\xc7\x3e\x6c\x16\x5d\x30\xb6\x32\xb1\x88\x63\xfd\xb9\x87\xdd\x48\xca\x39\x9a\x7b\x5a\x0d\x7b\x73\x4f\xf5\x04\x96\x21\xa5\x41\x2c\xfe\x37\x86\x3f\xc5\xb7\x33\x00\x77\xe4\xbf\x8e\x41\x1c\x0a\xe0\x46\x6a\xda\xff\xb8\x38\xb8\x42\x16\xed\x26\x38\x55\x24\x06\x11\x47\x85\xa1\xc2\x00\xb5\xd9\xdf\xe9\x24\x1b\xb5\x59\x35\x98\x82\x18\x86\x0f\x0e\x46\xe2\x11\x4c\x85\x35\x6e\xc7\xec\xb2\xd8\xf2\x31\x96\x94\x3e\x02\x0c\x71\xf3\xba\x32\xa3\xa3\x2e\xab\x36\x93\x03\x65\x97\xe9\x0a\xee\x0d\xec\xbc\xda\xf5\x97\x9c\x2b\x98\x96\x40\x2f\xac\xa2\xf0\x99\xb5\x6b\xed\x62\x7b\xe0\xf7\x28\x8f\xc5\x37\x07\xd0\x69\x3d\x1b\xa5\xc9\x7b\x6c\xcd\x8d\xac\xdd\x82\xad\xe2\xce\xe6\xbf\xa6\xef\x19\xa0\x38\xab\x03\x85\x31\x67\x9d\x28\x03\xbb\xfc\x13\xe8\x25\xce\x02\xd3\x68\x2f\xeb\x45\x66\xb6\x4d\xb9\xd2\x8d\xdd\x74\x9f\x00\x35\x0f\x1f\x14\xbd\x9f\x1a\xcc\xbc\x76\x67\xbd\x6f\x03\xda\x20\xea\x7e\x01\xe0\xa9\x9b\x57\x4b\x69\xe7\x88\xb6\x55\x07\xab\xdf\xd8\xa3\xdd\x96\xad\x77\xac\x52\x53\x82\x45\xec\x46\xed\xdf\x54\x1f\xac\xb2\x25\xaa\x0d\x60\x3d\xed\x17\x18\x8b\xf8\x7c\x2a\x3d\x31\x82\xf2\x2a\x8e\xf9\x16\x1f\xbb\xfd\xb7\x53\xa7\x58\xf6\xac\x80\x1e\xb7\xd8\x5e\xea\xf2\xd3\x77\x29\x6c\x1f\x96\xc3\xd6\x66\x51\x9d\x48\xe3\x00\x45\x06\xba\xf9\xfa\x8c\x28\x14\x59\x53\xfe\xf4\x84\x53\xcb\x8c\x4b\x8f\xb7\xe6\x31\xde\xd8\xe2\xed\x57\x5d\xb5\x66\x99\x9f\xb7\xb0\x37\xd7\x9a\xb3\x5e\x26\x0f\xfd\x70\xf4\x40\x54\x27\x2e\xec\xc0\x25\x33\x8d\xb3\x95\x84\xc3\x0d\x9a\x9d\xaf\xb4\xdf\xde\xf2\xbb\x11\xf9\x35\xcf\x7a\x61\x54\x66\x36\xd1\xeb\xa9\x41\x35\xdc\x19\x4c\x95\xf3\x58\xcc\xa1\xe6\xb7\xc1\x7c\xf1\x08\x4c\xb8\x7f\x2d\x9f\xe5\x96\xce\xb7\x07\xea\xc4\x1a\x54\x03\x16\xd7\x67\x7b\x41\x29\xaa\xee\xad\xae\x46\xd1\x15\x95\x2e\x22\x14\x68\xfc\x18\x93\xf9\x6a\xa2\xa0\x41\x3b\x10\x2e\xf0\xe2\x7e\xc2\xfe\xa0\x85\x6f\xde\x25\xb0\xe8\x0c\xfd\x47\x23\x1a\xd1\x7d\x26\x5d\x02\x1a\xde\xd7\xbe\x63\xdc\x60\x0f\x43\xbb\x68\x48\x39\x78\x6e\x4e\x86\xb7\x59\x20\xce\x12\x17\x6c\xf0\xb2\x63\xde\x14\x03\xa4\x5c\x2b\x76\x5c\x45\x9f\xa5\xab\xc6\xa7\x31\xa6\x44\x39\x4b\xb7\x58\xe1\x29\x32\x62\x01\x2e\xbf\xf3\xad\x93\x4e\xf4\x07\xda\x17\xfd\x57\xa2\xa7\x8e\xdc\x51\xd7\xa9\x24\xa5\x2f\x05\xa3\x94\x10\x7c\x36\x74\xc3\xb0\x99\xc3\x33\x32\x14\xd4\x9b\xa3\xb4\x37\xa1\xa1\x17\xa6\x00\x96\x32\xb8\xd1\xdb\xd0\xb8\x79\x75\xfc\x30\x65\xd7\xf1\x80\x29\x92\x4e\xd8\x75\x68\x27\x64\x9c\xb6\xc3\xee\x84\xf5\x81\x1f\x7e\x78\x59\x89\xc9\x0b\x1e\x00\x90\x91\xb4\x3e\xc4\xda\x23\x0c\x62\x88\x22\x6b\x33\xd3\x0e\xf0\xc2\x0c\x03\xbc\x83\xae\xcc\x3a\x13\xa0\x39\xbf\xba\xa2\x76\x13\x0f\x88\xfa\x06\x24\xd0\x9f\x43\xdd\x3a\x14\xca\x29\x48\xb9\xcb\x3e\x09\xd5\xa6\x9a\x7a\xc7\xdb\x1c\x3b\x65\x93\x64\x5c\x6a\x17\x6d\x1a\x66\x9f\xe4\x18\x4f\xcf\x61\x25\xf2\x39\x9a\x91\x88\x04\x63\x4b\x9b\x78\xd3\xd0\x0f\xdb\xa5\xc5\x21\x6b\xa9\x25\x48\x81\xde\x44\x6e\x93\x10\x7f\xfc\x7b\x59\xdc\x22\x93\x17\x7b\x6e\x5f\xd6\x7d\x0a\xdf\x29\x39\x83\x3d\x0d\x5f\x2f\xfb\x1e\x87\x5a\xce\x28\xe9\xf9\x3e\x16\x6c\xfa\xb6\xad\xf7\x59\x9e\x06\x9f\xd6\x56\xf7\x8b\x1b\x06\x67\x51\x23\xa7\xdf\xad\x3a\x51\xc4\x01\x95\x17\xc8\x62\x77\x07\x83\xc7\xf7\xd5\x24\xbe\x1b\x7a\x77\xb1\xf0\xd9\xae\x8b\x23\xbf\xbb\xe3\x7f\x55\x14\x98\x3f\x7c\xc5\xf0\xef\x47\x08\xd4\xce\x05\x84\x68\xc1\x1e\x7f\x7a\xfc\x7b\xf9\x6f\xba\x24\x77\xf1\xd7\xcf\x9c\x9d\xb4\xba\x04\x1c\x29\x7c\x56\x68\x89\xda\x3f\xbb\x8c\x0d\x14\x5a\x56\x8f\x37\xbe\x25\x9f\xfc\x47\xfb\x6f\x2f\xa3\xd7\x86\x87\xf4\xe9\x45\xcf\x22\xb9\x4b\xd3\xb2\x85\xa0\xec\x15\xb5\x08\x6c\x06\xa5\x70\x37\x36\xce\x00\x1f\x2d\x7a\x0d\x21\xc1\x6c\x80\x34\x17\x6e\x3e\x94\xbc\x24\x5c\xe1\xd7\x7a\x56\x3f\x73\x7a\xfb\x71\x65\x6a\xec\x51\xe7\xeb\xe9\x5d\x6d\x8b\x9b\x04\xe6\x5e\xe6\x3d\x5e\xb5\x0d\xe4\xa7\xed\xad\xa5\x21\xa0\xb2\xba\x6c\x85\xf4\x48\x42\x34\x73\x4c\x0b\x69\x39\x7d\xde\x1d\x32\xa2\x5e\xd7\xfd\x4b\xd0\x35\x04\xc5\x4f\x42\xfa\x29\xe8\xed\x4f\x0d\xad\xe7\x76\x5e\x7e\xaa\x12\xea\xb5\xfd\xef\xdd\x80\x78\xa6\x93\x72\x6d\x5c\x1e\x4b\xda\x26\x47\x5e\xd0\x07\x35\x8f\x43\xe7\xd0\x10\x4c\x1b\xbc\x7d\x26\x46\xba\xf2\x94\xc0\xcb\xe2\xa8\x42\x5d\x4b\x86\x14\xa1\x6e\x33\x86\x86\x7c\xcd\x73\x47\x6a\x79\x1a\xf0\x16\x6b\xfe\x4f\xaf\xb5\x16\xda\x62\xe5\x73\xdc\xbd\xe6\x61\x57\x3f\x52\x36\xf0\x12\xa1\xb7\xb2\xbe\x4a\x90\xc2\xc7\x66\x68\xe3\xda\x32\x09\x0c\xc4\xca\x6c\x42\x53\x1c\xa7\x5c\x3d\xa2\x6a\xd5\xd5\x56\x97\x1e\xca\x2a\x94\x1f\xf4\x59\xa6\x93\x14\x5e\xa2\xa2\xc1\x99\x04\x84\xcb\xba\x5e\x1a\x45\x29\x07\xd5\xe8\x2a\x94\x03\x5c\xbd\x9f\x04\xa0\x0a\x03\xc2\x14\x82\x72\xd4\x72\x28\x61\xa2\x1d\x40\xf8\x05\x16\x08\x84\x36\x6e\xd4\x8c\x14\xa3\x3f\xa5\x7d\xda\x64\x4c\xb0\xa9\x17\x1e\xa6\x81\x16\x9a\x67\xa2\x0e\x49\x1d\x76\x71\xd8\x4e\x63\xd0\xc7\x61\x8d\x91\xc6\xae\x6b\x8c\xcd\xf7\x6d\x4c\x8c\xf6\x7f\x63\xc1\x41\x21\xa4\xb1\x68\x64\xb8\xa3\xb1\x56\xeb\xf0\xb5\xb9\xce\xf2\x77\x63\x03\x3d\xa2\xa6\xe6\x86\x96\xe1\xe6\x16\x96\xd5\x73\x5f\xd2\x3a\x8a\xff\xd2\x97\x95\xa2\x73\x4c\x1f\xcb\xed\xa3\x67\x3e\x91\x9c\x97\x31\xdc\x2a\x34\x2d\x25\xf0\x13\x26\xcb\xf5\x30\x11\x08\x55\x62\x16\x83\x29\x45\xdc\xac\x8d\x86\x2a\x19\x62\xb8\xd4\xd6\x06\x35\x31\x97\xc4\xef\x84\xe7\x09\xe4\x2b\xe8\x2b\x3b\x98\x8e\x3c\x85\xab\x86\x92\x60\x21\x71\xb0\x66\xcd\x1a\xce\x4d\xf9\x2a\xc6\x2c\x5b\x2f\x47\x4b\x2b\x13\x07\x7b\x17\x04\xc3\x12\x97\xae\x8d\x2c\x3e\x43\xe2\x4c\x13\x81\x8c\x1b\x01\xf3\x9d\x52\x25\x25\x63\x8c\xb3\xc2\xc6\xc3\x7e\x91\x92\x27\x45\x47\x8f\x86\x41\x89\xae\x47\xba\xc2\x3d\x87\x68\xc2\x12\xe4\x9f\x94\x8e\xb0\x38\x1c\x3a\x49\x44\xec\x4e\x02\x29\xc5\x57\xcc\xbb\xe1\x41\x46\x29\x75\x63\x77\x27\x71\x68\xcd\x58\x1f\xc3\xa8\xb2\x4c\x40\x61\x84\xbe\x7e\x8c\x36\xcc\x50\x63\x32\x4c\xe3\x22\xa5\x41\x08\x85\x32\x62\xec\xc8\x55\x09\x99\xc0\x26\x8c\x13\x80\x8b\xff\x0c\xaf\x39\xf6\x41\x23\xd3\x51\x4c\x4f\x07\x04\xac\x3a\x81\x45\x14\x80\x39\x6b\xa7\xa9\x92\x91\xc6\x1a\x66\xde\x4b\x46\x95\x62\x39\x33\x74\xba\x4c\x24\x63\x89\xcb\xac\x70\xff\x4c\x7a\xff\xa0\xbf\x35\xd4\x64\x12\xda\x70\x08\xa2\xfe\xf0\xf9\xdb\xb0\x3e\x24\x60\x9c\x2e\xf7\xef\xab\x1d\x7e\x58\xf3\x07\x3d\xb6\x17\x00\xa1\xe3\x3f\x84\xa0\x18\x4e\x90\x14\xcd\xb0\x1c\x2f\x10\x8a\xc4\x12\xa9\x4c\xae\x50\xaa\xd4\x1a\xad\x4e\x6f\x30\x9a\xcc\x16\xab\xcd\xee\x70\xba\xdc\x1e\xaf\xcf\x0f\x20\x82\x62\x38\x41\x52\x34\xc3\x72\xbc\x20\x4a\xb2\xa2\x6a\xbf\x7d\xf8\x9f\xd0\x0d\xd3\xb2\x1d\xd7\xf3\x83\x30\x8a\x93\x34\xcb\x8b\xb2\xaa\x9b\xb6\xeb\x87\x71\x9a\x97\x75\xdb\x8f\xf3\xba\x9f\xf7\xfb\x41\x08\x46\x50\x0c\x27\x48\x8a\x66\x58\x8e\x17\x44\x49\x56\x54\x4d\x37\x4c\xcb\x76\x5c\xcf\x0f\xc2\x28\x4e\xd2\x2c\x2f\xca\xaa\x6e\xda\xae\x1f\xc6\x69\x5e\xd6\x6d\x3f\xce\xeb\x7e\xde\xef\xf7\x87\x11\x14\xc3\x09\x92\xa2\x19\x96\xe3\x05\x51\x92\x15\x55\xd3\x0d\xd3\xb2\x1d\xd7\xf3\x83\x30\x8a\x93\x34\xcb\x8b\xb2\xaa\x9b\xb6\xeb\x87\x71\x9a\x97\x75\xdb\x8f\xf3\xba\x9f\xf7\xf7\xff\x00\x62\x24\x9c\xab\x74\x56\x26\x67\xae\xcf\x96\xed\x7f\xc1\x72\x3e\xbf\x3c\xd9\x79\xf3\xe5\x3f\x8f\x92\x19\x93\x98\x8d\xfb\x66\xfe\x7b\x9d\xe7\xb4\xb7\x9d\xa3\x00\x89\xb5\x11\x9b\x9e\x25\xee\xb5\xef\xc7\xcc\x7e\xdf\x5a\xfb\xfe\x61\xc5\x7a\x57\xbe\xbf\xbc\xf7\xdc\xf5\xdd\x32\x9f\x1d\xb5\x73\x76\x90\xef\xc0\xcc\xf2\x1d\x90\x99\xd9\x65\x57\x1d\xab\x00\x89\xb5\x91\xe0\x02\x00\x00\x00\x00\x40\x44\x44\x44\x44\x24\x22\x22\x22\x22\x62\x66\x66\x66\x66\xd6\x7d\x03\x90\x58\x1b\x09\x0e\xd3\x4f\x01\x84\x30\xc6\x18\x63\x44\x44\x44\x44\x44\xac\xb5\xd6\x5a\x9b\x36\x57\xf2\x30\x38\x42\xd6\xe7\x10\x49\x1b\xa5\x0f\x00\x00\x00\x00\x00\x00\x00\x00\x90\x13\x00\x00\x00\x83\x2e\x01\x48\xac\x8d\x04\x57\x08\x00\x00\x00\x00\x00\x00\x0a\xa2\xdf\x88\x13\xc7\xd0\x39\xa0\x00\x89\x75\x84\x2a\xa5\x94\x52\x2a\x4a\x5e\x7d\x80\x1e\x14\xc4\x3a\x4d\x94\xb4\x24\x49\x92\x24\x49\xd2\x0e\x46\x82\x8b\x99\x99\x99\x99\x79\xd1\x9f\x9e\xfb\xde\xf3\xc0\x5f\x57\xcd\xc6\xfd\x3c\x47\x3c\xfe\x03\x00\x00\x01\x00\x00\xff\xff\x76\xe8\xc3\x61\x6c\x46\x00\x00")
-
-func _staticFontsGlyphiconsHalflingsRegularWoff2Bytes() ([]byte, error) {
-	return bindataRead(
-		__staticFontsGlyphiconsHalflingsRegularWoff2,
-		"_static/fonts/glyphicons-halflings-regular.woff2",
-	)
-}
-
-func _staticFontsGlyphiconsHalflingsRegularWoff2() (*asset, error) {
-	bytes, err := _staticFontsGlyphiconsHalflingsRegularWoff2Bytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/fonts/glyphicons-halflings-regular.woff2", size: 18028, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticForgotIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x56\xdf\x6f\xeb\x34\x14\x7e\xdf\x5f\x61\x8c\x04\x2f\xb8\xd1\x10\x42\x88\x9b\x44\x42\x97\x21\xdd\x27\x90\xb8\x20\x78\x42\x4e\x7c\x9a\x78\x73\x6c\xcf\x76\xba\xf5\xbf\xe7\xd8\x49\xda\xac\x6d\xd2\xf5\xed\x4e\xea\x62\xc7\xe7\xc7\xe7\xef\x1c\x7f\x4e\xfe\xd5\xaf\xbf\x7f\xfc\xfc\xef\x1f\x0f\xa4\x0d\x9d\x2a\xef\xf2\xf8\x20\x8a\xeb\xa6\xa0\xa0\x69\x79\x47\xf0\x2f\x6f\x81\x8b\xf2\x2e\x8d\xd3\xbc\x83\xc0\x49\xdd\x72\xe7\x21\x14\xb4\x0f\x5b\xf6\xd3\x68\x7a\x5c\x6e\x43\xb0\x0c\x9e\x7b\xb9\x2b\xe8\x3f\xec\xaf\x5f\xd8\x47\xd3\x59\x1e\x64\xa5\x80\x92\xda\xe8\x00\x1a\x7d\x3f\x3d\x14\x20\x1a\x38\xf3\xd6\xbc\x83\x82\xee\x24\xbc\x58\xe3\xc2\xcc\xe1\x45\x8a\xd0\x16\x02\x76\xb2\x06\x96\x26\xdf\x11\xa9\x65\x90\x5c\x31\x5f\x73\x05\xc5\x3d\x9d\x43\x55\x52\x3f\x91\xd6\xc1\xb6\xa0\xd9\x7f\x3e\x20\x80\x3a\xab\xbd\xcf\x2a\x63\x82\x0f\x8e\xdb\x4d\x27\xf5\x06\xdf\x50\xe2\x40\x15\xd4\x87\xbd\x02\xdf\x02\x84\x39\xa6\xa5\x28\x3b\xbe\xec\x7c\xf4\x0e\x32\x28\x28\xa3\x6d\x9e\x0d\xe3\x81\xd6\x6c\xe0\x35\x8d\x2b\x23\xf6\xb3\x84\x9a\xef\x48\xad\xb8\xf7\x05\xc5\x61\xc5\x1d\x19\x1e\x4c\xea\x1d\x20\xed\xd3\x74\x2b\x5f\x41\xb0\x60\x2c\x22\x30\xb8\xf9\x68\x2d\x1b\xc4\x67\xf4\x0c\x7f\x0a\x29\xe4\x21\x64\x64\x13\xd1\x80\x3b\xb1\x39\xb5\x1b\x93\x44\x98\x17\x6d\x07\xe4\x7d\x08\x46\x93\xb0\xb7\x98\x7e\x98\xd0\x93\x00\xc1\x34\x4d\x2c\xbb\xe0\x81\x8f\x93\x08\x42\x29\x6e\xfd\xe1\x35\x77\x4d\xec\xa6\xaf\x2b\xcf\xe0\x95\x77\x56\x01\x1b\xdd\x27\x4b\x76\xbf\x80\x21\xe1\xf0\x96\xeb\x29\xaf\x77\xcc\x68\xb5\xa7\xe5\xe7\x94\x8c\x1c\x59\xc9\xb3\x68\xf7\xce\x30\x12\x89\x62\x88\x80\x96\x5f\x8e\x5b\x9e\x0d\x1c\x2f\xac\xf2\x13\xea\x2b\xc7\xb5\xa0\x53\xef\xd2\xf2\xef\xd4\x85\xfc\x42\xdd\x33\x2c\xfc\x7a\x3b\x4c\x75\x20\x27\x75\xa1\x44\x0a\x2c\xfd\xed\x75\xcb\x7b\x35\x83\x3b\x85\xc5\xc7\x5a\x9d\x95\x5c\x5e\x1c\x29\x38\x39\xa9\x0e\x1a\xe9\x03\x38\xdc\xfe\x34\xbc\x48\xc1\x91\x8a\xa5\x1c\x79\xd6\xab\x77\x51\x77\xf2\x2a\xcf\x70\x53\x73\x49\x78\xc7\x61\xcc\xdb\xfb\xf2\x37\xe3\x1a\x13\x48\x30\x4f\x80\x55\xc3\x17\x6f\x2d\x6c\xf9\x69\x4b\xf6\xa6\xff\x76\x07\x64\x9b\x4c\x51\x24\xe3\x0b\x37\xb8\x10\x2c\x06\xc7\x7a\x59\x67\x76\x52\x00\xc1\x5e\x83\x8e\x4b\x85\x03\x81\xaa\x89\xfa\xd7\xd7\xf1\x50\x78\xf2\x22\x95\x22\x15\x10\x8f\x22\x1b\x03\xc4\xdf\x26\xcf\xec\x0c\xf3\x01\x77\x2c\x36\x0a\x6d\x92\xe5\x61\x0b\x69\x46\xd2\x7f\x26\xf0\xee\xc0\xbd\x90\xa4\x85\x05\x15\xd2\x5b\xc5\xf7\x3f\x13\x6d\x34\x7c\xa0\x25\x4e\x6a\x68\x8d\x12\xb1\x06\xe7\xb4\x4d\xf1\x7d\x5f\xd7\x10\x75\xf5\x42\x86\xc3\xda\xe5\x14\xa3\x10\x0e\x08\xcb\xcd\x66\x33\xe6\x59\xd8\x89\xd4\xb6\x3f\xee\xc4\x99\x97\x2b\x9a\x88\x4d\xcd\x3a\xc1\xbe\x8f\xa7\xf4\xea\x89\x49\xc1\x59\xe3\x4c\x6f\xc9\xe8\xf8\xe3\xd2\x59\x78\x23\x05\x47\x3f\xc6\x85\x38\x13\xf3\x45\xc7\x46\xed\x6d\x1b\x85\x84\x1c\x46\x0c\xf0\xca\x50\xc6\xc2\x35\x5d\x59\x59\x4b\x78\x46\x89\x0f\xf0\x7a\xe4\x0b\xbb\xae\x63\xb1\x83\x91\xf4\x41\x06\xe2\xcd\x4d\xc9\xac\xcc\x05\x7d\x18\x7a\x4e\x08\x97\xca\xc6\xfb\x60\xb6\xa6\xee\xfd\x8d\x44\x54\x61\x95\x86\xf1\x1e\x4a\xdd\x03\x51\xf3\xc6\x10\xe8\x46\xf0\xc7\x2a\x65\xea\x27\xfa\xf6\x9e\x2a\x27\xef\x75\x45\xb9\xc6\x70\xca\x37\xb1\x4b\xbe\xd1\x95\xb7\x1f\xc8\x9f\x7d\xd5\xc9\xb0\x22\x32\xa3\x8e\xaf\x65\x5e\xf9\xbb\xa9\x88\xef\x13\xf7\xd8\x9e\x3f\x5c\xec\xeb\x33\x39\x1b\x4e\xd4\x61\xee\x6b\x27\x6d\x20\xde\xd5\x33\xd9\x7d\xf4\xd9\xe3\x73\x0f\x6e\xbf\x79\xf4\x89\x9d\x64\x54\x5e\xf7\x7a\xfb\x6d\x76\xa3\x73\xfa\x24\x3b\xf7\x41\xba\xd3\x17\x16\xaa\x68\xfa\xd0\xfd\x3f\x00\x00\xff\xff\xdb\xa2\x86\x3d\xf9\x0a\x00\x00")
-
-func _staticForgotIndexHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		__staticForgotIndexHtml,
-		"_static/forgot/index.html",
-	)
-}
-
-func _staticForgotIndexHtml() (*asset, error) {
-	bytes, err := _staticForgotIndexHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/forgot/index.html", size: 2809, mode: os.FileMode(436), modTime: time.Unix(1464068795, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x57\x5d\x6f\xdb\x36\x17\xbe\xef\xaf\x38\xaf\x5a\x20\x2d\x50\x59\x6f\xda\x8b\x6e\x81\x6d\x20\x6b\x02\xac\x58\xb1\x0e\x9b\x5b\x74\x57\x01\x2d\x1e\x4b\x4c\x28\x92\x25\x29\xbb\xda\xb0\xff\xbe\x73\x28\x39\xb1\x95\xb8\x75\xee\x96\x8b\x48\xa4\xce\xc7\x73\x9e\xf3\x41\x7a\xfa\xbf\x8b\x0f\x6f\x17\x7f\xfe\x76\x09\x75\x6c\xf4\xfc\xc9\x94\x1f\xa0\x85\xa9\x66\x19\x9a\x6c\xfe\x04\xe8\x6f\x5a\xa3\x90\xf3\x27\xe9\x3d\xad\x1b\x8c\x02\xca\x5a\xf8\x80\x71\x96\xb5\x71\x95\xff\x30\x88\xde\x7d\xae\x63\x74\x39\x7e\x69\xd5\x7a\x96\x7d\xce\x3f\x9e\xe7\x6f\x6d\xe3\x44\x54\x4b\x8d\x19\x94\xd6\x44\x34\xa4\xfb\xee\x72\x86\xb2\xc2\x7b\xda\x46\x34\x38\xcb\xd6\x0a\x37\xce\xfa\xb8\xa3\xb0\x51\x32\xd6\x33\x89\x6b\x55\x62\x9e\x16\x2f\x41\x19\x15\x95\xd0\x79\x28\x85\xc6\xd9\x69\xb6\x0b\x55\x2b\x73\x03\xb5\xc7\xd5\x2c\x2b\xae\x42\x24\x00\x65\x51\x86\x50\x2c\xad\x8d\x21\x7a\xe1\x26\x8d\x32\x13\xda\xc9\xc0\xa3\x9e\x65\x21\x76\x1a\x43\x8d\x18\x77\x31\x1d\xb2\xb2\x16\x87\x95\xef\xb4\xa3\x8a\x1a\xe7\x2c\x3b\x2d\xfa\xf7\x9e\xd6\xa2\xe7\x35\xbd\x2f\xad\xec\x76\x1c\x1a\xb1\x86\x52\x8b\x10\x66\x19\xbd\x2e\x85\x87\xfe\x91\x2b\xb3\x46\xa2\x7d\xbb\x5c\xa9\xaf\x28\xf3\x68\x1d\x21\xb0\x14\x3c\x4b\xab\x8a\xf0\x59\xb3\x83\x3f\x99\x94\xea\xd6\x24\xb3\x49\x68\xd0\x8f\x64\xc6\x72\x83\x13\x86\xf9\xa0\x6c\x8f\xbc\x8d\xd1\x1a\x88\x9d\x23\xf7\xfd\x22\x1b\x19\x88\xb6\xaa\x38\xed\x52\x44\x31\x2c\x18\x84\xd6\xc2\x85\xdb\x6d\xe1\x2b\xae\xa6\xa7\xcb\x90\xe3\x57\xd1\x38\x8d\xf9\xa0\xbe\x95\xcc\x4f\x0f\x60\x48\x38\x82\x13\x66\xeb\x37\xf8\xdc\x1a\xdd\x65\xf3\x45\x72\x06\x77\xac\x4c\x0b\x96\x3b\xd2\x8c\x22\xa2\x72\x42\x90\xcd\xff\x3b\x6a\xd3\xa2\xe7\xf8\xc0\x57\x31\xa2\x7e\xe9\x85\x91\xd9\xb6\x76\xb3\xf9\xa7\x54\x85\xe2\x81\xbc\x17\x94\xf8\x6f\x97\xc3\x36\x0f\x30\xca\x4b\x06\x4a\x52\xea\x1f\x9f\xb7\x69\xab\x77\xe0\x6e\xcd\xd2\xe3\x5b\x79\xd6\xea\xf0\xc7\x81\x82\x51\xa7\x7a\xac\x54\x88\xe8\x29\xfc\xed\xeb\x83\x14\xdc\x51\x71\xc8\xc7\xb4\x68\xf5\x51\xd4\x8d\xb6\xa6\x05\x05\xb5\x3b\x12\x8e\x68\xc6\x69\x7d\x3a\x64\x8b\x5e\xf6\xbf\xb8\xad\xae\xa6\xce\xcc\xe6\xb0\xa8\x55\x80\x80\x9e\x46\x03\x28\xce\x40\x43\xb3\x32\xdc\x31\xc1\xb3\x38\x9c\x15\x45\x65\x79\xb2\x4f\xac\xaf\x8a\xb2\x91\xb4\x2c\x9e\xd6\xd2\xe7\xbf\x63\x63\x23\x5e\x91\x26\xcd\xda\x2b\x1a\xd2\x75\x60\xa6\x78\x13\xfa\x4d\x48\x9b\x4c\x1a\xac\xac\x87\x96\x6a\x60\xd9\x41\xac\xf1\x18\x1f\xd9\xbc\xb2\x10\xad\xd5\xac\x3f\x21\x2e\xdc\x0e\x13\x7d\xa4\xaf\xe6\xe7\x52\x2a\x53\x81\xa0\x41\xea\x6c\x50\xd1\xfa\x8e\x02\x7f\x35\x0e\x7c\x7f\xbd\x20\x00\x2b\xe5\x43\x04\xca\xa9\x03\x22\x21\x5a\xb0\x4b\xa6\x13\xa8\xaf\x84\x53\x70\x83\xdd\x04\x3e\x29\xb2\xb8\x0f\xf7\x70\x71\xf8\x34\x27\x28\xe2\x0a\xef\x55\x09\xf5\x12\x11\xa0\xb5\xdd\x24\x6b\xca\x90\x78\x5b\xb2\xfc\x9e\xeb\xe4\x75\x54\x0f\xee\x5e\x28\xf0\x1e\xe3\x09\xe5\x4d\x74\xd0\xd9\xf6\x64\x8d\x50\x59\x8a\xc4\x36\x48\xc7\x9d\x44\xa8\x2d\xe1\x92\x20\x22\x4c\x79\x3d\xbf\x65\x58\xc5\xc9\xd0\x69\x93\xd2\x36\x05\x65\xc3\x17\x2b\x6b\xa7\x45\x12\x23\x64\xa4\xc2\x16\x25\x68\x75\x83\x0c\xcc\xb5\x4b\xad\x42\x0d\x1b\x15\xeb\xc1\x5a\xd1\xe0\x8e\xd2\x04\xce\x7d\x43\xce\x92\x00\x87\x16\xed\x0d\x1a\xb6\x02\xce\xd3\x61\x6b\xdb\xa0\xbb\x21\x3e\x12\x7b\x8e\x93\x6a\x32\x18\x2a\xff\xff\xfa\x75\xbe\x7c\xf3\xe3\x2a\x7f\xb3\x12\xa7\x83\xbd\x17\x0f\x46\x3c\x5a\x7b\x3a\x08\x9f\x01\x7e\x4d\x15\xb6\xf8\xf0\xcb\xe5\xaf\xb3\x7d\x63\xf4\xb5\x6c\xbd\x86\x5c\x41\xfe\x33\x64\xe7\x6d\xac\xad\x57\x7f\xa5\x04\x9d\xc1\x4f\x28\x3c\x15\xfc\xb3\xa4\x99\x41\x2e\xe1\xe4\xef\x8c\xcb\x27\x3b\x83\xec\x7b\x64\x65\xff\x9c\xc0\x56\x86\x4f\xe6\x81\x0e\x86\xed\x71\xdc\xcb\x63\xe4\x54\x97\x17\xa8\x31\x1e\x55\xb1\x1f\x03\x8b\x31\xa7\xc2\x39\x6f\x9d\x57\x22\x6e\xf9\xe5\x66\xe2\x2f\xde\xb6\xb4\xc7\x6c\x6f\x84\x89\x9c\x31\xc9\xf6\xf1\xec\x01\x16\x7b\xd6\x1e\xc1\xcb\x67\xb8\xb8\x7c\x7f\xb9\xb8\xfc\x56\xbc\xf7\xe2\xbb\x6d\xd9\x07\x22\x4a\x7d\x77\xec\x70\xb9\xb0\x1b\xa3\xad\x90\x57\xd4\x3b\x57\xdc\x30\x42\x6b\x9a\x31\xe5\x0d\xf5\x57\x48\x9b\x12\x1d\x1a\x89\xa6\x54\x18\xf6\x66\x05\x48\x8b\xc1\x9c\x44\x30\xc8\x7d\x60\x3a\x68\xac\x54\x2b\x55\xf6\x1d\x4a\x9d\x66\x3d\x5d\x4c\x98\xae\x8d\xf5\x37\x2f\x21\x55\xa5\x5a\x01\x07\xc8\xd3\x60\xe8\x20\x92\x1d\x75\x90\xdd\xad\x89\xa1\x64\xcf\x52\xa2\x7b\x82\x9f\x51\x2b\x02\x5d\x47\x60\x5f\xb2\x6f\x98\xfb\x05\x42\x6a\xa1\xb6\xad\x96\x70\xdd\xd2\x30\x62\x30\xd4\x50\x40\x65\x11\xf8\xbe\x0b\x1b\x4f\xf7\x47\x7a\x12\x24\x42\xc7\x09\xa7\x7a\x49\x4b\x43\x1d\x3f\xa0\x6c\x53\x9d\x2c\xde\xff\x31\x81\x77\x06\x36\xb5\x2a\x6b\x28\x05\x4d\x5a\x72\xce\x0f\x22\xc8\x77\x2e\x75\xb6\x4f\xfa\x24\xc7\xc6\xd8\x68\x48\x73\x7a\xf0\xc6\x43\x8a\xdb\xdf\x63\x32\x4f\xe7\x4b\x89\xde\x6c\x9b\x5b\x48\xbe\x40\x0a\xaa\x42\x0d\x8d\x30\xca\xb5\xba\xa7\xd3\xae\x06\xd3\xf4\xaf\x44\x78\x2e\x02\x05\xc2\x31\x39\xf4\x35\x1d\xe3\xb0\xc4\x04\x3d\x61\x92\xad\x67\xb4\x74\x0f\x47\x6d\x1d\x1f\x37\x2f\x52\xf9\x96\x34\x76\x23\x6a\x9d\x24\x87\x54\x72\x7e\x7c\x9b\xf2\x45\xf9\x47\xaa\x5c\xe4\x44\x12\xfe\x8f\x64\x29\x81\x2a\x45\xcb\x18\xc6\x87\xc3\x70\x8a\xde\xad\x43\xe9\x15\x51\x10\x7c\xb9\x33\xc1\xaf\x43\x71\xfd\xa5\x45\xdf\x4d\xae\x43\xba\x3b\x25\xa1\xf9\xf7\xb5\xf6\x7f\x03\x3c\x52\x39\x5d\xfd\xef\xeb\xd0\xf5\x2c\xdd\xe4\xa9\x71\xd2\x0f\xaa\x7f\x03\x00\x00\xff\xff\x38\x4e\xb6\x6e\x61\x0d\x00\x00")
-
-func _staticIndexHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		__staticIndexHtml,
-		"_static/index.html",
-	)
-}
-
-func _staticIndexHtml() (*asset, error) {
-	bytes, err := _staticIndexHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/index.html", size: 3425, mode: os.FileMode(436), modTime: time.Unix(1464068795, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticJsBootstrapMinJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe4\x7d\x6d\x7b\xdb\xb8\xb5\xe0\xf7\xfe\x0a\x89\x93\xab\x90\x23\x8a\x96\x93\xdb\xf6\x96\x0a\xa3\x27\x93\xa4\xcf\x66\x77\x3a\x99\x9d\x64\x6e\x9f\x5d\x5f\xb7\x05\x49\x50\xa2\x23\x8b\x1a\x89\xb6\x93\x5a\xea\x6f\xdf\x73\xf0\x0e\x10\x94\xec\xf4\x76\xbe\xec\x17\x5b\x24\x41\xe0\xe0\xe0\xbc\x9f\x03\xf0\xec\xdb\xe1\x6f\x06\xdf\x0e\xbe\x6b\x9a\x76\xd7\x6e\xc9\x66\x70\xfb\x3c\x79\x9e\xfc\x6e\x10\x2e\xdb\x76\x93\x9e\x9d\x2d\x68\x9b\xcb\x67\x49\xd1\x5c\x47\xd8\xfa\x75\xb3\xf9\xb2\xad\x17\xcb\x76\xf0\x6c\x7a\x7e\x3e\x81\x3f\xbf\x1d\x7c\xbc\xab\xdb\x96\x6e\xe3\xc1\xbb\x75\x91\x60\xa3\xef\xeb\x82\xae\x77\xb4\x1c\xdc\xac\x4b\xba\x1d\xb4\x4b\x3a\xf8\xd3\xbb\x8f\x83\x15\xbf\x0d\x2d\xce\x7e\x53\x57\x61\x80\x4f\xab\x7a\x4d\xcb\x20\xcb\xda\x2f\x1b\xda\x54\x83\xab\xff\x7d\x43\xb7\x5f\xa2\x76\xb9\x6d\xee\x06\x6b\x7a\x37\x78\xbb\xdd\x36\xdb\x30\x50\x40\x3e\xdd\x0d\xfe\x27\xb9\x25\x1f\x8a\x6d\xbd\x69\x07\x5b\xfa\xcb\x4d\xbd\xa5\x3b\xf1\x5e\x10\xcd\xc6\xd5\xcd\xba\x68\xeb\x66\x1d\x92\xe8\x3e\xb8\xd9\xd1\x01\xbc\x56\x17\x6d\x30\xbb\x25\xdb\x41\x9e\x91\xa4\x5a\x27\x57\xbf\x60\xeb\x64\xb7\x59\xd5\x6d\x18\x0c\x82\xe8\x62\x7a\x29\xaf\x12\xe8\x04\x80\xcb\xe1\xd6\x8b\x67\xa3\x51\x7e\x71\x7e\xf9\xe2\x0f\xfb\xfd\x79\x96\xe1\xad\xd1\xe8\x0f\xf8\xe3\xfc\x12\x9f\x3c\xbb\x7c\x71\xbe\xdf\xe3\xed\x97\xcf\xbe\x16\xe4\xc1\x2d\xdd\xee\x00\xdc\xc1\x79\xf2\x87\xe4\x7c\xd0\x6c\x07\x4b\xc0\x2e\x22\x33\xbf\x69\x07\xab\xe6\x8e\xe1\x8f\xac\x55\xbb\xe7\x41\x74\x08\x05\x9a\xe2\xfe\xd9\xca\x07\x83\x3c\x8c\xee\x71\xea\x24\x2b\x9b\xe2\xe6\x9a\xae\xdb\xa4\xd8\x52\xd2\xd2\xb7\x2b\x8a\x57\x61\xa0\x16\x39\x88\xe2\x3c\xbb\xff\x33\xcd\x3f\xd5\xed\xc7\x2d\x59\xef\x6a\xec\x21\x0d\xee\x9c\x3b\x6f\xd7\x65\x10\xff\xa9\xf9\xbb\xd9\xa6\x55\xbf\x29\x3e\x7d\x6f\x3e\x6b\xac\x57\x07\x8d\xd3\xb6\xed\xed\xe6\x30\xab\x00\x93\x08\x7d\x31\xa8\x61\x2a\x11\x2c\xcc\x6d\x53\x97\x83\xe9\x30\x83\x95\xdc\xb5\x5f\x56\xf4\xa2\xb8\x8c\xb6\xb4\xbd\xd9\xae\xef\xe1\x95\x34\x87\xeb\xc3\x8c\xdf\x18\x9e\x1f\xd8\x72\xd3\xeb\x9b\x15\x4c\xd8\x82\x22\x53\x98\xcb\x39\x7e\x8a\x6c\x78\x1e\x97\x59\xbb\xac\x77\x33\x12\xe2\xbf\x28\x01\x28\x00\x3d\x3b\x67\xe6\xea\xcd\xe8\x1e\x5e\x9a\x1e\x22\x46\x5a\x34\x33\xef\xef\xf7\x24\x2c\xa3\x04\x16\x63\xb1\xa0\xdb\x10\x60\xbd\xd9\x6c\x9a\x6d\x9b\xe8\x09\x26\x00\x6e\x24\x41\x1d\xec\x68\xfb\xb1\xbe\xa6\xcd\x4d\x1b\xd2\x38\x8f\x62\x04\xe0\x10\x93\xd0\xe8\xd4\xd7\x49\x06\xcb\x1b\xfb\x1e\x8c\x46\x30\x28\xbd\xc5\xe5\xde\x6d\x68\x51\x93\x55\xe2\x4c\x24\xbb\xcf\xeb\x75\xf9\x11\xf8\x2e\xed\x03\x2f\x2e\xe9\x8a\x2e\x10\x75\x47\x5b\x01\x79\x96\x2b\x9a\x9a\x18\x15\xb3\x22\x61\x9e\xb4\x64\x0b\x92\x24\x4a\xea\x1d\xc7\xea\x3c\x4f\xf8\x0b\xef\xf3\x2b\xf1\x6b\x9b\x90\xcd\x66\xf5\x85\x3d\x8f\xa1\x39\x23\xd3\x5d\x94\xf2\xb5\x3e\x1c\xa2\xc3\x63\x69\x5e\x83\x80\x7d\x26\x94\x14\x4b\x13\x95\x7c\xc1\xc5\x32\xc7\x34\x2b\x92\x92\xb4\x04\xd7\x3a\x21\x00\x4e\x0b\x32\x80\xee\xf7\x9d\xbb\xd0\x12\x19\xbc\xe4\xef\x45\x71\x80\x23\xaf\x17\x5a\x7c\xe5\xa3\x11\xbd\xc8\x2f\x93\x82\xac\x56\x61\x81\x60\xf3\x91\x9e\x5e\x60\x4f\x93\xb2\xde\x5d\xd7\xbb\x5d\x16\xf0\xee\x2e\x9f\xc6\x36\x1d\x02\xba\x90\xe8\xc2\xa0\x00\x51\xf9\x29\x88\x0b\x46\x06\x49\xb1\x6a\x76\x14\x48\xa5\x4c\xfe\xf3\xed\x4f\x1f\xde\xbd\xff\x21\x0b\x98\xa4\x0e\xe2\x32\xf9\xf8\xd3\xab\x1f\x3e\xbc\xfb\x08\x37\xff\xfa\xe6\xe7\x9f\x5e\xe1\x8f\xec\xfc\xb7\x53\x78\xb2\xd9\x36\x6d\x83\x70\xf1\xf7\xad\x81\x14\xa6\x0a\x40\xc6\x22\x29\x69\x8b\x08\xd2\xf4\x1a\xb0\x57\xca\x44\xe3\x23\xd9\xd2\xeb\xe6\x96\x86\x7c\x46\x54\xe1\xae\xca\x68\x42\xda\x16\x5e\x61\x33\xe4\x8b\x0d\xe8\xab\xf6\xfb\x50\x3f\x5b\x6e\x69\x15\x60\xe3\x6a\x34\xaa\xa0\xab\xcd\x8a\x14\x34\x3c\x4b\xbe\x0d\xe7\xd9\x37\x17\x7f\xf9\xaf\xdd\xe5\xb7\x4f\xa2\xb3\x38\x08\x22\xce\x4c\x0b\xe8\xbf\x8a\x66\x80\xce\x1c\xe6\xc1\xc8\xf8\x0d\xad\xc8\xcd\xaa\x05\x72\x5f\x24\x2b\xba\x5e\xb4\x4b\x18\x61\x91\x89\xd9\xed\x50\x72\x0b\x50\xb1\x85\x9c\x08\x0a\xfc\xb7\xb7\x4c\xcc\xb1\x76\xc6\x8c\x40\xd8\x01\x4d\x8a\x6e\x7f\xe4\x83\xd0\x32\x8c\xb0\x5b\x31\xdb\xd7\x2b\xb2\xdb\x85\x41\xbd\x0e\xfa\x98\x6c\x01\x14\xbc\x13\xcd\x2a\x52\xd2\x20\x9a\x2f\x7a\xc4\x46\x11\x79\x05\x51\xe8\x5d\xc3\x28\x85\x95\x81\x25\x17\xc8\x46\x29\xc6\xc0\x9e\xe9\x9f\x59\x1e\xeb\x8b\xe4\x75\xb3\x06\x62\xbc\x29\xda\x66\x9b\x95\xe6\x83\x75\x03\x8f\x2a\xa0\xa7\xd6\x14\x50\x92\x3f\x75\x6f\x54\x89\x1c\xa9\x27\x0c\x52\x54\x68\x63\x1c\x31\x21\x9b\x1a\x89\xb3\x43\x63\xff\x02\x2e\x2d\x0d\x2e\x2d\x35\x3f\x82\x72\x6c\x9b\x35\x23\xa9\xa0\xc9\xaf\x28\x74\x6c\x32\x61\x8e\xec\xdb\x6d\x2e\xf8\xb7\xe0\x82\xa6\x42\x0e\x6e\x9b\xc5\x62\x45\xe1\xe5\x7c\x4e\x13\x7e\x11\x46\x29\xf2\x71\x02\x22\xf9\x43\x0b\xcb\x05\x60\x2a\x46\xd6\x4c\x14\x97\xd1\x3d\x83\xfa\x09\xe5\x8a\x34\x43\xee\xe5\x0c\xdb\x6c\xb0\xcd\x0e\xd6\x8d\x7e\x6e\x41\x42\x86\xf7\x87\xb8\x48\xde\xbc\xfd\xe3\xab\x9f\xbf\xff\xf8\x01\xde\xe4\xcd\xea\xdd\xf7\x0d\x29\x41\x80\x80\xea\x39\xcc\x8a\x0e\x77\xeb\x57\xb2\xfb\x15\x6f\xf9\x11\x3a\x4c\x03\x71\x91\x24\x49\x80\x1d\xeb\x55\x90\x20\x7b\xb4\x5b\x00\xc2\x87\xe4\x2b\x30\xb6\x84\x96\x53\x80\x33\xcc\xd6\x8c\xce\x37\x37\xc0\x18\xf3\xe0\x96\xac\x82\x34\x58\xb6\xd7\x2b\xd0\x76\x12\xed\xc0\x8e\xe3\x2c\x40\x00\x82\x78\x7d\xb3\x5a\x65\x19\x72\x32\xea\x2d\xb8\x35\x1a\x49\x6c\xab\x5b\x30\xce\x05\xbd\x04\x2a\x8e\x0d\xdd\x46\x10\xd8\xcf\x5f\xcc\x55\x66\xad\x44\x87\x20\x3b\xe7\x26\x06\xe1\x3a\xc5\x9b\xb0\x50\x06\x02\xd8\x6a\x85\x2e\x0a\x51\xe6\x91\xb2\xe4\xcc\x08\xcc\xc6\x24\x4f\x01\x6c\x17\xa5\x76\x53\x50\x8d\x1d\xf4\xc3\xbb\x26\xcb\x17\x52\xde\xbd\x62\x9d\x00\x27\xc6\x9c\x08\xa7\x91\x8d\x70\x4e\x31\x99\x43\xb4\x04\xa1\xc9\x6d\x2c\x2b\x39\x25\x74\x81\x78\x33\xe0\xa4\xb9\x03\x65\xc0\xed\x4e\x21\xdc\xe4\xb2\xd9\x7d\x80\xb5\x5c\xaa\x75\x9a\x05\x5b\x00\xbf\x01\x6c\x30\x88\x36\x61\x80\x20\xc1\xfa\x85\xf2\xba\x58\xd2\xe2\x13\xac\x78\x84\xd6\x00\xcc\x12\x45\x1e\xef\x22\x21\x00\xef\x2d\x55\x72\x5d\x88\x30\x79\x37\xb6\x87\x55\x68\x55\x0d\xa2\x94\x77\x9e\x37\x9f\x3b\xe3\xc3\x60\x1d\x00\xc0\x5e\xb3\xbb\xd4\x62\x53\x76\xa9\x60\xb4\x1b\x72\x3c\xb9\xc3\xc7\xee\x08\xf1\xa9\xee\x41\x82\x8f\x46\x85\xa1\xe1\xc0\xf4\x58\xc0\xfd\x03\x5d\x81\x70\x72\xe6\xcb\x94\x16\xd9\xd6\x64\x02\xda\x67\xb7\xc3\xfe\x87\xa7\x07\x78\x00\xe0\x5c\xa2\x97\x5c\xa2\xf3\xa5\x9f\x19\xbf\xa5\x4c\xe7\x57\x96\x50\x2f\xac\x27\xa7\xa5\xba\xe8\xb0\x3c\x25\xd6\x45\x87\x5a\xae\x5b\xf4\xf9\x17\x49\xa0\x68\xac\xa8\x91\x0a\x2d\x9b\x0b\x69\xdf\x81\x75\xa2\x71\x92\xb7\x20\x9b\x41\x91\x96\x20\x3c\xb4\x7e\x66\x77\x91\x06\x99\x79\x54\x2a\xe1\x0b\x4b\xa3\xfb\x41\x49\xf4\x94\x51\xf8\x05\x92\x53\x26\x88\x1c\xd8\x03\x6d\xea\xfe\x66\x8a\x1c\x59\xcb\xa2\x63\x36\x1c\xf8\xbc\x2b\xc0\xc2\xce\x33\xef\x41\xbe\xba\xd9\x7e\x2d\x3e\xb8\xe9\xa6\x40\x73\x66\x6c\x53\x02\x03\x20\x88\xcf\xfe\xc2\x7e\x84\xf5\x3a\x9a\x3f\x39\x4b\x5a\x6c\x0f\x3d\xc0\x54\xa2\x7f\x89\xb5\xdb\xa3\x47\x0b\xb2\x6d\xa0\xd3\x15\xd3\xa4\x7d\xea\x4a\xc8\xff\xd8\xab\x69\xc1\xda\xca\xba\x76\xf0\x3c\x4f\xab\x64\xb7\xaa\x4b\xea\x68\x62\x35\xa0\x47\x17\xaf\x6f\xae\x73\xba\x35\x7b\x41\x01\x0b\xb3\x4b\x17\x73\x7a\xb1\x00\x5d\x02\x9d\xd6\x60\xa5\x6d\x41\x41\xa1\x86\xde\x10\xe8\x0a\xcc\xd6\xe2\x4b\x81\x6a\xdb\xa7\xa5\x8b\x7e\x2d\xfd\x04\x24\x61\x5d\x10\x60\xae\x9d\x57\xcc\x2a\x58\x27\xba\x61\xe0\x68\x78\x61\xa1\x33\x40\xca\x0c\xb5\x18\xbf\x81\x53\x47\xcd\xa2\xef\x48\xb8\x8d\x5b\x4f\xb8\x54\x30\xef\xd4\x2d\xbd\xde\x19\x37\xc4\x38\xc9\x27\xfa\x25\x6f\xc8\xb6\x1c\x8d\x6c\x48\x91\xa4\xe1\x59\xd9\xdc\xad\x13\x0b\xbb\x52\xd1\xb2\xe6\xa2\x45\x2c\xdd\x96\x25\x48\x7c\x86\x67\x73\x08\x36\x87\xd1\x68\x18\x06\xcd\xba\x6d\x6e\x8a\xe5\x0e\xe8\xb9\x05\x65\x33\x50\x81\x03\xf9\x43\x84\x0e\x22\x1f\x34\xd7\x08\x00\x9a\xd2\xdb\x23\x00\xb1\xb1\x04\x38\xfa\xad\x15\x25\xb7\xf4\xc8\x5b\x6c\x99\xc5\x5b\x7e\x7b\xc9\xe7\x0d\xfd\x6e\x3a\xb5\x2c\x29\xb9\x10\xe9\x6f\xe9\xf3\x98\x01\x92\x0a\x84\xc4\x77\x5b\xb2\x49\x41\x75\x4b\x6c\xc3\x6f\x5b\xdd\x0b\x44\x66\x26\x53\x82\xd2\x1e\x9e\x31\x49\xb4\x6f\x81\x7f\xc8\x96\x92\xb3\x9a\x33\x34\x11\x22\x01\xfe\x2d\x7e\x20\xd7\xc0\xda\xf7\xbb\xbb\xba\x05\xee\x24\xc9\xdd\xb2\x2e\x40\xcb\x17\x04\x58\xfa\xf9\xef\xb9\x89\x82\x32\x0b\xed\x2c\xe8\xe2\xd3\x8c\x3f\xf9\x03\x7f\xb2\x86\x9e\xd5\x93\x92\xcb\xb4\x94\xf3\xfc\x81\x74\x65\x9d\x0d\x35\xc3\x5b\xe6\x71\xcf\x73\x10\xd1\x26\xfd\x2a\xed\xab\x99\x0c\xde\x24\xdb\x77\xe2\x32\xb4\x1e\xda\xac\x60\xbc\x33\x34\xfa\x54\xc6\x96\x24\x7f\x30\x06\x55\x77\xd6\xea\xe2\x14\x85\x95\xe5\xed\x37\x92\x41\x11\x73\x6a\x80\xdc\x77\xc0\x32\xef\xd6\x25\xfd\x6c\xad\x8a\x29\x0f\x05\x57\xc1\x68\xb0\x38\xe0\x0a\x82\xc0\x58\xd6\xab\x12\x7e\x03\x93\xe3\x33\x65\xf0\xb0\x86\x30\x22\xf4\x16\x92\xfd\xde\xe4\xd2\xc8\x3b\xf0\x1f\x9b\xed\x9b\x7a\x4b\xd9\xb0\xc6\xf8\x71\x6e\xd9\x6f\x26\x94\x28\x7e\xca\x2c\xc0\x25\x03\x0e\x04\x9b\x64\x9a\x81\x01\xb5\xdf\x07\x6b\x6e\xd9\xa2\x95\x22\x8d\x25\x0e\x0e\x37\x08\x27\xe7\x68\x1e\x96\x12\xbb\x12\x3b\x48\xb2\x91\x5c\x4e\xe1\x2e\xaa\xce\xe7\x93\xf3\xf4\x1c\x04\x7b\x58\x8c\x69\xf4\x6f\xdd\x3e\x67\x5d\x2c\x25\xf4\x17\xf0\xbc\x5d\x23\xd7\x42\x2d\x0f\xa4\x32\xb1\xed\x9b\x9f\x25\xda\xbc\x72\x15\x07\x52\x06\x68\x24\x81\x20\x2f\x7d\x93\xde\xef\xa7\x2f\xc9\x9c\x07\x82\x52\x53\xb4\xce\x5d\xd1\x03\x3e\x37\x3e\xb2\xe5\x87\xa1\x05\x73\xd4\x25\x04\x94\x44\x5a\x20\x6a\x34\x8d\x6a\x05\xa2\x07\xa0\x21\x79\x59\xcc\xf9\x9a\xa4\x1c\x9f\xb1\x83\x25\x12\x39\x68\x62\x9d\x3d\x88\xcd\xa6\xae\xad\x28\x10\xc3\x58\x60\xc0\xd8\x19\xcc\x06\x8e\x82\xd1\xa8\x27\xbc\xe7\x58\x9b\xa7\x22\x8d\xb1\x16\xa1\x21\x00\xe0\x30\x7a\x76\x92\xcf\xed\xb9\x22\xa4\x1e\xcb\xd3\x5a\x20\x77\xd1\x60\x81\x18\x3e\x5d\xb4\xc1\x6c\xbf\xa2\x2b\x8e\x24\xc7\xfd\xc5\x47\x8e\x8f\xce\x59\xe2\x34\x1d\xa2\xa3\x2b\x38\xde\xc3\xdb\xd0\x17\x45\x4b\xc7\x42\x4c\xbc\xcc\x24\xd7\xe6\xf3\x60\x45\x2b\xa4\x15\x96\x1c\x09\xe2\x9a\x47\x92\x81\x65\x2b\x9f\xaf\xe0\x99\x24\x48\x5f\xc6\xbf\x57\xe0\x06\x4f\x2f\xe3\x4f\x3a\x72\xc5\xa6\x65\xd3\x35\x60\x09\x23\x49\xe5\x47\xa6\x5f\xd2\xab\xb8\x94\x90\xa6\xcb\x03\x73\x24\xfd\xe4\xf1\x29\x8a\x87\x9f\xbc\x61\x2f\xa6\xc8\x6c\x78\xa6\xf1\x42\x68\x78\xc1\x27\x1d\xbb\x49\x39\xab\x9d\x07\x0f\x72\x33\xd9\x7c\x57\xc2\x32\xb5\x5e\x57\x02\x3a\xba\xe8\x4a\x98\x2a\xba\x8c\x66\xa0\x67\x56\x1e\xc7\x94\x59\x80\xd7\x36\xee\x1e\x85\x3a\xe5\x43\xf9\x98\xae\xcf\x07\x64\x2b\x84\x9e\x77\xa5\x41\x02\x39\x8f\x0b\x99\x34\x55\x05\x3a\xef\xcf\x75\xd9\x2e\x63\xaa\x1f\x2f\xe1\xb1\x75\x45\x4f\xa7\x1b\x2a\x0b\x8d\x17\x79\xbc\xbc\x4c\xae\x9a\x7a\xcd\x72\x58\x91\x07\x1b\xd0\xa9\xf5\x86\x7c\x60\xbf\x18\xd7\x06\x0d\x9a\xd1\x1a\x63\xe8\xba\x4b\x4b\xd7\xc0\x7d\x53\xf4\xab\xbc\x61\x4d\xaf\x31\x16\x45\x69\x48\x7b\x42\x0e\x95\x0f\x7e\x87\x41\x5c\x17\x5b\x41\x12\x29\x52\x15\xa2\x9c\xcb\x2c\xcb\xdd\x96\x24\x30\xb3\xae\xa4\xcb\x2d\xaf\x7d\x4e\xb7\x7a\x76\xda\xed\x56\xdd\x0a\xc7\xdb\xcd\x0d\x49\xd7\x39\x36\x43\xe8\x24\xf4\x06\xd1\x99\x03\x6d\x87\xd0\x23\x0c\xb4\xf9\xe2\xe7\x63\x15\x3f\x77\x44\x8e\xf6\xf0\xf8\xc8\x0b\xcb\xcd\xab\xa4\x6f\x47\xc5\x8f\x08\x84\x9a\x05\x0c\x23\x6d\xf0\x7e\x81\x5b\x41\x17\x85\x0b\xad\x32\x78\x3c\x89\xf9\xf2\x55\xbc\x80\x17\x31\xb6\xef\xf1\x2c\x51\xef\x2e\x79\xc4\xc6\x35\x53\x67\xbd\x61\x09\x85\x72\xed\x88\x07\x17\x1a\xa0\x4b\xf0\x1f\x1f\xf7\x06\x4c\x81\xbd\x14\x93\xf0\x0e\x04\x4d\x73\xc7\x5f\xc7\xb0\xa2\xc5\x63\x44\x06\xe8\xb6\xa8\x4b\x34\xfa\x2e\x9f\x46\xc7\xd3\x48\x33\x81\x8b\x22\x2e\x24\x2e\xbf\x2e\x71\xc5\x7a\x05\x1b\x31\xef\xa1\x89\x42\x3d\x51\x34\x51\x1c\xa5\x09\x25\xd3\xc2\x32\x3a\x18\xa9\x9f\xaf\x4f\x92\x15\xcd\x6a\x45\x36\x3b\xda\x09\x1b\x94\x3a\x6c\x50\x1c\x0f\x1b\xcc\x86\x14\xe9\x85\xc7\x46\x46\xa3\xb3\xdd\xb2\xb9\xdb\x2f\x01\xe7\x32\x18\x82\x01\x41\xf9\x9c\xd1\x9a\x9d\x91\x53\x20\x58\x49\x39\x1e\x48\xe8\x49\xcb\xa9\x08\x41\xf9\xc0\x08\x81\x2f\x8e\x6f\xce\x50\x2a\x44\x21\x87\x32\xe2\x06\x77\x15\x90\x97\x17\xb8\x56\x59\xf0\xcd\xd3\x71\x9e\xd4\xe5\xf8\x69\x70\x19\xf7\x36\x35\x16\xdc\x7a\xe3\xa9\x18\x4f\x6b\x24\x3b\xc4\xa0\x3d\x79\x74\x73\x84\x81\xcc\x2f\x94\x9d\xfe\xa3\x70\x81\xb8\x2d\x05\x12\xf7\xd5\xb6\x26\xaf\xd6\xe5\x6b\x31\xbc\x90\xc0\x76\x82\xc0\x9a\xa5\xe3\x9e\xc9\x05\xe4\x90\x89\x04\xca\xc3\x73\x95\xcf\x59\xae\x52\x7b\xe7\xbc\x07\xe6\x78\x9b\xe9\xa5\x12\x34\xd2\x7a\x67\x39\x59\x32\xd4\xde\xa7\x93\xef\x50\xe3\x1a\xc4\x3f\x17\x77\xc0\xdb\xa7\xcc\x46\xb3\x47\x40\xfa\x33\x3b\x47\xb7\xbe\x8b\x6d\xe9\x7e\x79\xc6\xc3\x2c\xa1\x70\x8e\x62\x69\x70\x72\xe4\x4b\xbb\x81\x5f\x99\xce\xe7\x86\xac\x99\x84\x34\xfd\xd1\x35\x78\x00\x82\x18\x90\x88\x99\x40\x1f\x86\x14\xe3\x5d\xd2\x23\x08\xf3\x8c\x7a\x99\x91\x25\x4c\x2d\x80\x23\x01\x53\x65\x98\x44\x30\xd3\xc4\x7a\xaf\xdf\x5e\xac\xc0\x5e\xac\x7a\xec\x45\x1b\xa4\x82\x4b\x3f\x1a\x07\x4b\x66\x0b\xc5\xe0\xf5\xf8\x80\x64\xb9\x23\x95\xe6\x65\xa3\xaa\xc5\x0d\xa3\x99\x0d\x86\x65\x29\x68\x78\x0d\x4b\xc1\xc4\x14\x86\x08\xa7\x91\x19\xbd\xa7\x9f\x01\xc3\x25\x0b\xdf\x4f\x1d\x66\xf5\xf7\x5d\x06\xa7\xde\xb7\x99\x8f\x9b\xec\x4b\x93\x70\x4e\xcf\x80\x01\xdb\x9d\x03\x1d\x20\x09\xe1\x24\x82\xc0\x3b\xd8\xb4\xc7\xfc\x61\x2b\xba\xb6\x97\xf4\xc0\xc8\xc6\x67\xc5\x4a\xdf\x63\xc9\x17\x8c\xeb\x2e\x9c\x45\x0d\x24\x52\x90\x6b\xba\x7a\x4d\xc0\xd8\xbf\x08\x76\xc5\x16\xba\x0b\xe2\x85\xb4\x15\x27\x68\x5e\x78\xdc\xee\x8e\xc9\x2a\xe3\x3a\x4b\x19\xe2\x7b\x44\xe2\x1b\xe7\x6f\x8d\x01\x36\xf4\x45\x7d\x09\xc6\xc2\xc1\x66\xd8\xa5\xe5\xeb\xf5\x33\xec\x03\xf8\x55\xf3\xc6\x52\x7a\x5a\x0f\xe0\x0d\xd0\x12\x43\x7f\x09\x81\x15\xfc\xe9\xa5\xee\x8b\xc2\x9d\x69\x81\xe9\x52\xed\x33\xfc\x0f\x26\xa7\x7a\x73\x71\x16\x35\x79\xa9\x99\x11\x54\x0f\x3d\x9f\xbb\xfc\xd0\xa5\xc7\x7e\x66\x38\x3f\xc2\x0c\xb4\xc3\x0c\xc7\xa9\xf8\x31\x1c\x12\x18\x25\x2a\xb0\x54\x25\xed\x50\xfd\x11\xf7\x8d\x47\x11\x3a\x18\x9f\xf6\xd5\x79\x49\x2a\xa6\x5f\x41\xc5\x29\x35\xb8\xcb\xa5\xdc\x6e\xd2\x18\x9b\x5d\x1c\x23\xd4\x39\x97\xaa\x29\x63\xf5\x00\x2d\x19\xab\x47\xa5\xd8\x7d\x6e\x49\xe8\x31\x0e\x22\xee\xa0\xf7\x9b\x2b\xec\xbe\xb0\x1d\x82\xa7\x63\x4f\x17\xdc\x22\xe1\x56\x63\x27\xad\x5f\xe8\xd0\x0b\x5a\x9d\xb3\xe3\xd6\x46\x1e\x82\x5d\x4e\x65\x92\x3d\xc2\x78\x95\x3b\xc3\xbe\x77\xbd\x41\x57\xe2\x60\x0f\x3c\x3e\x2f\x1d\x17\xe8\xc0\x58\xd9\x39\x4d\xfa\xf1\xb0\xe8\xa1\xfe\xc2\xae\xcb\x91\xaf\xcc\xac\x2b\xe5\x3a\x8a\x6b\x5f\x81\x8e\x7a\xf6\x00\xb7\x52\x76\x7b\xb2\x4c\x47\x75\xda\x93\xc1\x34\x57\xd9\x48\x61\x1a\xcb\xc5\x35\x42\x8f\x47\x5a\x76\xbc\xb8\x19\x37\x2f\xd8\x1a\x2e\xb2\xca\x6b\x97\x2c\xb3\xc5\x5c\x66\x7b\x53\xe9\x6a\xce\x0a\xe9\x3c\x2e\xbf\xde\x4f\xf2\x7b\x49\xb3\xc2\xe3\x27\xc5\x45\x56\x80\xa3\xf1\xcd\xc5\xab\xc9\xff\x25\x93\xbf\x5f\x0a\x47\xa3\xe8\xf5\x9f\xec\x9a\xb4\x12\xdf\x26\xd0\x5c\xca\x98\x12\x7d\x71\x6e\xff\xcc\xcb\x34\x57\xd9\x05\xd3\xc5\x02\xe7\x02\xde\x7a\x8e\x21\x7e\x9e\xf1\x01\xb8\xc0\xdb\xd7\xe5\x74\x58\xe2\xe9\x77\x2c\xcd\x8c\x6d\x0e\xeb\x03\x9e\x96\x13\xba\xe2\x01\x06\x6a\x10\x7b\xb3\xa1\x6b\x5e\x80\x31\x1a\x89\x22\x42\x1c\x19\x39\x08\x66\xee\x4f\x52\x15\x6e\x92\x0a\x23\xce\x45\xb3\x6e\x49\xbd\xde\x85\x14\x63\x91\x2a\xeb\x0e\xd0\x53\x25\x81\x8b\xae\xda\x2c\xb7\xcd\x06\x13\x64\x01\xf3\xc3\x8a\xbe\xfa\xba\xd2\xcf\x58\x41\x45\x56\xbb\x6e\xd4\x4a\xcc\xca\x08\x71\xeb\x51\x85\x06\xb0\xc6\x8d\xd0\xf1\x36\x56\xa1\x7c\x9c\xa3\x5b\x9a\x8e\xae\xea\x38\x9a\x95\x96\xfb\xa9\x47\x2c\x99\xfb\xb9\x38\x56\x13\x5a\x76\x6b\x42\x81\x0f\x55\x1f\x93\x9c\x14\x9f\xf0\x02\xab\xad\x1c\x66\x55\xe3\x00\xb3\x2e\x8e\x14\x8b\xda\x58\x30\x9c\x31\x10\x56\x8b\x8e\x2f\xb6\x38\xa2\x8d\x3a\x92\x00\x0d\x2b\xca\x0a\xc4\x12\x59\x43\x16\x0f\x52\x55\x4e\xa6\x1c\x0c\x25\x01\x5c\x7a\xc4\x1e\x0a\x20\xf5\xe1\x02\xf8\xf9\xa1\xc9\x65\xf0\xb4\x2a\xa3\x92\x62\x4d\x6e\x73\xb2\x9d\xc0\x3f\x33\x33\x12\xf6\xd5\xb4\x97\xf5\xad\x1d\x11\xed\xe2\x3a\x02\x57\x6b\x47\xb7\xed\xab\xaa\x45\xa2\x0a\x8d\x6c\xb4\x2c\xbf\x8d\x84\x75\xef\xe5\x3b\x16\x67\x93\x34\x59\x76\xdd\x2b\xbd\x1a\x4b\x20\x8b\xd2\x6f\x28\x72\xb2\x9c\x69\xa6\x12\xf5\x21\x3d\xaa\x27\x00\x0d\xc2\x63\xa5\x96\xd6\xea\x63\x10\xe5\x17\x58\xb0\x1c\x54\x75\xfc\xc1\x22\x84\x4e\x72\xbb\x60\x46\xf5\x59\xf8\xfc\x3f\xf6\xff\x3e\xdd\x3f\xfb\xfd\xfe\xf9\xb3\x48\x0a\x4d\x91\xbf\x86\x55\x7a\xa8\x58\x71\xa4\x1a\x23\x8a\x6e\x71\x6f\x91\xec\xda\x66\xf3\x23\xc0\x4b\x16\x84\xf3\x67\x3c\x2c\x4f\x52\x9f\x10\x91\x58\x0f\xec\xa3\xbe\x21\xf8\x01\xcf\x7e\x3f\xd4\x42\x98\x5d\x2b\xa1\x2c\x5d\x22\xe3\x16\xfa\xb6\xcc\x44\xaa\xa2\xce\xda\xc0\x62\x1a\x85\xd2\x48\x2a\x92\x50\x82\xc1\xaa\x4e\xd7\x4d\x1b\x2a\x58\xa3\xf4\xb6\xde\xd5\xf0\x6b\x40\x30\x29\x44\x65\x62\x44\xd1\x23\xd0\xeb\x4d\x30\x5e\x32\x30\x6b\xab\xf8\xef\x2a\xab\x45\x2a\x5a\x17\x59\x3d\xff\x0f\x03\xc2\xab\x97\x53\xf8\x33\x99\xc4\xff\x3e\x35\xef\xbe\xa8\x55\xf6\x14\xae\xc6\xe3\xf8\x1f\x57\x20\x73\xaf\xb2\x29\x06\xfa\xe9\x2f\xe1\x55\x77\x46\xe8\x5c\x89\x29\x30\x73\x43\x42\x37\xb3\xae\xa4\xdd\x22\xaf\x2d\x9b\x66\xe1\x3c\x3b\x6d\xd3\xa8\x6e\x97\xa7\x6c\x1a\xd5\xa9\x51\x7d\x7c\xba\x89\x46\xf2\xa0\x6a\xb6\xd7\x46\x94\x97\xb0\xcd\x14\x2e\x9d\x1d\x4e\x77\x59\x79\x24\x67\xe4\x16\xe1\x9c\x7c\x4f\xb4\x7d\xc8\x8b\x2e\xa1\x78\xfb\x79\xac\xe9\x84\xb6\xf9\x29\x7d\x68\x57\xf8\x2b\xb5\x77\xdd\x94\x04\x8b\xc5\x16\x7d\xc5\x62\xf4\x44\xd4\xb7\xb2\xa2\x42\xbc\x3b\x18\xc2\x28\x05\x5b\x78\x95\xe8\x1c\xcb\x80\x81\xc5\xd3\x05\x8b\xd4\x61\xe8\x18\xff\x63\x44\xfb\x48\xd5\x97\x53\xa6\xf5\x24\x6f\xca\x2f\x99\xa1\x35\xf0\xda\xc9\xc5\x9b\x05\x62\x65\x4d\x56\xcd\xc2\x9f\x3c\x66\xa0\x4f\x78\x0b\x55\x3f\x22\x95\x8b\x59\xf7\xb5\xfb\x80\x52\xd8\x0c\xd3\x02\xf3\xd5\x6b\xb2\xfa\x0e\x06\xff\x91\x58\x55\x63\x2c\xe2\x02\x7a\x8e\x65\x0b\xa5\xa7\x5c\x2f\xd6\xcd\x96\x7e\x27\xba\x7e\x8d\xe4\xa9\x52\x61\xd2\x25\x43\x7b\xa9\xa5\x6e\xb0\xc3\x02\x15\x4d\x3a\xb8\x89\xea\xb3\x21\x65\xe8\x79\x3d\xf6\x54\x67\xf7\x44\x9c\xb0\x0b\xbe\x3f\x44\x50\xc4\xe1\xd1\x75\x59\xcf\x59\x5d\xd6\x77\xaf\x5e\xff\xaf\x37\x3f\xbd\xff\xf1\xaf\x7d\x1b\x59\xcc\xd2\x2d\x89\x5e\xa7\x46\x2b\x46\x4a\xe8\xd4\x6a\xb9\x76\x8d\x53\x14\x24\xd6\x85\x47\xc9\xd1\x7e\x55\xe5\x1f\x48\x56\xc4\xad\x2c\xb0\xa2\xc3\xb9\xd4\x65\x8c\x60\x69\x57\xf7\x0b\xba\x76\xec\x86\xfc\xe0\x86\xcf\x24\x3e\x69\x64\x11\x0b\xf2\x48\x8f\xe9\x6c\xd1\xd4\x50\x50\x08\xab\x7d\xfd\x20\x89\x47\xe6\xe9\x71\x33\x80\x7b\x8f\x71\x80\x61\x16\x71\xd2\xe0\x8a\x92\xb7\xa0\xbb\x82\x6c\x54\xae\x7f\x4b\x77\xf5\xdf\x75\xe6\xdf\x2c\xf4\xe3\x72\x52\x6c\x69\x32\x26\xed\xee\x75\xe2\xb7\xc1\x7c\xb5\xaa\xbd\x10\xe3\xb2\x1e\xd1\x64\x36\x5d\x0d\xc8\x25\x61\xa7\x7b\x73\xe7\x80\x13\x87\x64\xaf\xdd\x6c\x8e\xbd\xe4\x54\xea\x82\x59\xa1\x3b\x41\xbf\xa9\xf4\x73\xdb\x94\xa5\xf2\x38\xa0\x92\x08\x3d\x12\xd3\x5b\x33\x50\xfa\x62\x3a\x7c\xaf\xd0\xcc\x78\xa6\x6a\xd3\xe4\xe6\x26\xe3\x19\xd9\xc0\x02\x95\x1f\x1b\x04\x96\x8b\x2c\xe3\x21\xa3\xd7\x48\xc8\x8e\x8f\x00\xd7\x34\x62\x3b\x21\xae\x6e\x76\xed\x1b\x86\x53\x4c\xef\x9a\x70\xb8\x55\x09\xa5\x2f\xba\xc8\x36\x3d\x95\x09\x5d\x83\xee\x2c\xe8\x1f\x59\xb1\xb2\xf4\xf5\xbb\x06\x66\x3f\xc1\xd3\x79\x69\xac\xed\x89\x02\x87\xd2\x23\x6a\xa4\x41\xac\x53\x12\x8f\x2b\x39\x48\x1f\xda\xa9\xc3\xf2\x76\x7c\x19\x08\xc7\xbf\x1b\xcd\x13\x3c\x96\x4a\xb2\x37\x6c\x6c\x32\x31\x58\xd0\xfe\x20\xb2\xde\xa6\x22\x98\xfd\xfc\x28\x83\x5a\xc6\x53\x55\x89\x29\xd6\xeb\x7e\x80\x3a\x7b\xdc\xf8\x7b\x3d\x7c\x2d\x9e\xf6\xf2\x98\xcb\xc6\xaa\xb1\x9f\x8f\xfb\x36\xd4\xf5\x05\x41\xc5\xe6\xba\xc7\x64\x1f\x94\x9c\xf9\x13\x8e\x78\x34\x88\xdb\x43\x39\x76\x0f\xa1\x4b\x21\x26\x6f\x64\x56\x09\xc1\xc9\xa5\xd0\x7b\x11\xcc\xdb\x5d\x05\x4c\x1c\x0d\x0c\xac\x9b\xe1\xd6\x67\x2e\xc0\x64\x85\xaa\x81\x2e\x55\x73\xac\x05\x49\x8f\x0a\x97\xa6\xbf\x54\xdc\xf6\xd4\x18\x91\x75\x22\xf9\x8a\x66\x2d\xeb\x41\xaa\xe2\xce\xea\x68\xeb\xb6\x2b\x92\x7d\x53\x45\xff\x8b\x48\x3f\xc6\x50\xcc\x12\xc6\xd4\x56\x92\xce\x70\x88\xe9\xde\xf1\x9c\xf9\x71\xb6\xe9\x9b\xdf\xdc\xae\x11\xe1\x8d\x3d\xb0\x73\x10\xd9\x16\xe6\x9f\x37\xa0\xf5\xa4\x46\x4b\x8d\xf7\x11\x2a\xb7\x03\x8f\xa4\x61\x14\xe6\x4f\x6e\x3b\x36\x03\x47\x49\xbf\x2e\x22\x42\xc9\x5b\xec\x6d\xe9\x79\xc2\xf7\xed\xbd\x62\x2a\x82\x6d\xb5\x0e\xd5\x4d\xd3\x62\x20\x1e\xaa\xd1\x31\x37\x39\x95\x0e\x66\x71\x58\xa9\x3f\xbb\x89\x51\x09\xb2\x64\x75\x79\xad\x23\xa2\x1e\x53\xda\x1e\x22\xef\x74\xee\x1a\x64\x27\xa4\x08\xff\x9f\x06\x81\x4a\xee\xf9\x09\x5b\x0e\xa4\x53\xe8\x3e\x91\x45\x75\x8a\x50\x41\xf6\x88\xc8\x14\x5f\x1a\xf9\xe6\x20\x18\x83\x47\xa9\x14\xbe\x36\xda\x1e\x63\x82\xf9\x78\xcb\xb2\x7d\xbb\x36\x0e\x4b\x8e\x85\x47\xfc\x0d\xbe\x1b\x5f\x89\x17\x26\x85\x8a\x9b\x2d\x1a\x2d\x5c\xdb\x83\xbe\x02\xb7\x0d\x7c\xe9\xc2\xdd\x59\x22\xe7\x36\x77\x45\x59\xc2\xf7\x40\x19\x82\x36\x8c\xd4\x5e\x4b\xf0\x3c\x5d\x1a\x71\x0d\x17\x87\x82\x1c\xe3\x65\x98\xcb\xf0\x5a\x35\x77\x5a\xfa\x15\x47\xde\xab\x1d\x8e\x79\x29\x51\x9a\x87\x62\x17\xa1\x4a\x41\xdb\xe4\xf4\x44\xd3\x91\x97\xe6\xcd\x4c\x15\x2f\x8b\xb0\x4c\x22\x9b\xa3\x42\x5e\xe5\x81\x35\x36\xff\x84\x06\x3d\x81\x89\xc5\x57\x62\x62\x21\x31\x21\x40\xb4\xc5\x9c\x21\x28\x3b\x72\xc1\xb6\x57\xed\x17\xcd\x67\x27\xea\x7f\xd8\x41\x2e\x4c\x84\xf1\xe4\xf9\xcb\xbe\xf0\x72\x02\xbc\x03\xff\x78\x2b\x47\xc2\x16\x80\xae\xfb\x0d\x50\x53\xbd\x5e\x7c\x4f\xab\x36\xe5\x8b\x8a\x5c\xf8\x6e\xf7\xfe\x96\x6e\xab\x55\x73\xc7\x6a\x0b\xc4\x36\x02\xdb\x6f\x07\xb9\x12\x8b\xb7\x7f\xc2\xde\xd3\xbe\xb7\x87\x7d\xaf\x77\x65\xaa\x2d\xae\xfb\xcb\x4d\x3a\xa0\xbb\xb0\x74\x3b\xb7\x9d\xc7\x2e\x7e\xb9\x26\x4b\xea\xf5\x9a\x72\x00\x79\x65\x89\x2c\x9d\xe8\x45\x30\x88\x84\xef\x9a\x9b\x35\x0e\xfd\x9a\xe1\xfa\x27\x5a\x60\xba\x90\x64\x79\xc2\x8a\xe4\x27\x7f\x22\xed\x32\x21\xf9\x8e\x6d\x7a\xae\xda\xe8\xe0\x47\x54\x66\x85\x6b\xc4\xc2\x31\x48\x5e\x10\x6f\xdc\x84\xdd\xbb\xa6\x64\x77\xb3\xa5\x86\x3e\xeb\x6e\x95\xef\x9f\x34\x78\x63\x3b\xfa\x0e\xe4\xb5\xe1\x35\x23\x6e\x03\x81\xcc\x09\x2f\xf3\x07\x8f\x7c\x1a\x9f\x4f\x85\x5b\xef\x06\x76\x6c\xb8\xd9\x69\x35\x89\xb9\x18\xfb\x7d\x20\x52\x35\x1e\xe2\x38\x3e\x70\x4c\xc6\x9e\x99\xfb\xc8\xc6\x3b\xc9\x13\x9d\xfb\x66\xe3\xf4\xed\xa2\xb7\x8b\xc2\xa3\x0a\x10\x64\x57\x81\x62\x09\x33\x03\x22\x44\x30\x51\x73\x99\x88\xce\x03\x2b\x66\xc1\x54\x22\x68\x32\x75\x90\x93\xa1\x09\x26\xc4\x24\x0b\x7b\x5b\x13\xbe\x8d\x62\x41\xc8\x5a\xac\xb4\x83\x5e\xe2\xdc\xaa\x0d\x67\x10\xcc\xf4\x4f\x59\x15\xce\x2e\x7c\x25\xe1\xfc\xc1\xe9\x20\x37\xef\xed\xe4\x2e\x6c\xde\x5d\x6f\xca\x5e\xc5\x50\x7c\x5b\xb0\x65\x92\xb8\x74\x0f\x5b\x21\x2a\xd1\xea\x24\xf1\x59\xe5\xde\xd1\x2a\x72\x3b\x93\x2f\x4c\x3d\x9d\xc2\xd7\xc1\x5f\x1e\x39\x4c\x87\x67\xdf\x88\xcc\x10\xe6\x8f\xa9\x51\x58\x2e\x77\x0f\x63\xb0\x03\xb3\x38\x24\x60\xf9\xf6\x8e\x1f\x5d\x89\x2d\x5c\x76\x04\xcd\x0e\xd9\xfb\x63\x62\xe2\x4d\xd7\x32\xb5\xe3\x0a\x38\xb4\x4c\xc5\x04\xac\x8a\xbe\xe3\x08\x61\x84\x47\xd7\xb3\x73\x23\xe4\x57\xdc\x8a\xdd\x36\xcd\xaa\xad\x37\x47\xce\x34\x09\xe9\x7e\x3f\x3c\x2b\x01\xcb\xdb\xe6\x8b\x5d\x2b\x8d\xd1\x02\x7b\xa3\xb5\xec\xce\xb3\xcf\xba\xb7\x3c\xda\x13\x4b\x67\x55\x35\x3c\x9d\x8c\xdb\xec\x3b\xc5\xc6\xc6\x1d\xba\x66\x19\x2f\xe3\x4e\xcb\xf7\x91\x18\x77\xd8\x46\x5b\x7e\x44\x89\xb1\xeb\x59\x86\xde\xcd\xdd\xd2\x6e\xa3\x7a\x8d\xa7\xb3\xa9\x59\x21\x5c\x0f\x8f\x35\xbb\x81\x64\xb2\xae\xaf\x59\xd6\x07\xa3\xc7\x8c\x0d\x70\xfc\x14\xba\x87\xae\x71\x23\x32\xb2\x7a\x8a\x11\x17\x7a\xbd\x41\x33\x28\x7d\xfa\x02\xe4\xd6\x80\xc9\xac\x4c\x41\x31\x00\x81\x45\xf5\xe5\x4b\x4f\x9b\x09\xd9\x6e\x9b\x3b\x78\x74\x06\xcf\xbc\x0d\x98\x62\x55\x0d\xd8\xdf\xa7\xb1\x20\x4e\xb1\x33\x79\x20\x0e\x0d\x68\xeb\x76\x45\x51\xa5\x97\x74\x45\xbe\xa4\xd3\x18\x8f\x68\x41\x30\x45\xb9\x06\x65\x30\xdf\xd6\xf4\x0e\xad\xc2\xf4\x5e\xcd\x24\x40\x21\xa8\x2c\x81\x74\xea\x6c\x10\x46\xdc\x5a\xe9\x13\xcc\x10\x49\xa7\x46\x2e\xab\x0c\x36\x33\x3a\xc8\x3b\x49\x93\xc2\xa9\x99\x67\x17\x20\x6f\xde\xf3\xeb\x50\xee\x40\x7c\x22\xc1\xb3\x7d\x04\x79\x17\x93\xfa\xc8\xe8\x7f\x94\xd0\x78\x5b\x45\x73\xef\x6d\x5d\x73\x67\x83\x27\x1c\x8c\x4e\x73\x89\x1f\x11\x4a\xe8\x8c\x62\xd3\xe2\x3d\x93\xd6\x88\x61\xb6\x28\xf8\x83\xad\x0b\xfc\x38\xc4\xae\x15\x5a\x83\xc6\x20\xeb\x02\xd9\x4b\x2b\x42\xad\x46\xdc\x6d\xbc\x12\x92\xee\x31\x82\x7f\x93\x8f\xfe\x36\xe0\x8d\x07\xd7\x60\x06\x0e\x72\x90\x3e\x78\xae\x5b\x55\xd3\x72\x70\xb7\xa4\xeb\x01\x2e\x22\x98\xc8\xf5\xdf\x61\x85\xc1\x8b\x54\x6b\x35\x0e\x06\xcd\x9a\x9d\xc1\x28\x2c\x39\x09\xcf\x80\x0b\x99\x21\x9e\xd6\x25\x8e\xd9\xa3\xf6\xa2\xc8\xd2\x50\x7d\x50\x22\xcb\x06\x8a\xcd\xc3\xd5\x64\x32\x93\x7b\x8a\xe8\x45\x75\x89\x76\xa1\x2a\x3a\x5a\x44\x7d\xee\xaa\x01\x5a\xec\xc5\x81\x1d\x5e\xe1\x2a\x47\xb8\x84\x33\xe9\x66\x05\xd7\x64\x7d\x03\x42\x7e\x08\x03\xdd\x8b\xdc\xbb\x3c\xd5\x60\x31\x37\x0e\x21\x00\x2f\x5f\x84\xd9\x30\xfd\xde\x69\xc3\x8e\x1c\x90\x6d\x40\x54\x05\x9d\xfa\xe6\x70\x39\x0e\x1e\x0b\x33\x1b\xd9\xce\x6e\x18\x1d\xd6\x8f\xef\x90\x81\x29\x03\x75\x07\xef\x0b\x9c\x23\xfe\xea\xdb\xb2\x62\xb6\x8f\xef\x95\x68\x11\x28\xd4\xf2\x0e\x7d\x03\xce\x29\x55\xfd\xf9\x23\xca\x1a\xd7\x68\x06\x7e\x16\x1a\x78\xe7\x31\x78\xb4\x84\xed\xbc\x25\xa4\x80\x77\x77\x74\x17\x56\x63\x98\x4e\xea\x49\x98\x15\x39\xea\x6a\x26\x06\x47\xa3\xee\xc1\x21\xf2\x49\x28\x7e\x65\xf7\x2c\x45\x28\xae\x62\x54\x9d\xf2\x02\x95\xbe\x67\x92\xfc\xdc\xc3\x0e\xd8\xd2\xeb\xc1\xa4\x77\xd6\x85\xd5\xb2\x3c\xe5\x5a\x60\x3d\x1d\xb3\x03\xac\xbb\x86\x69\x83\xd2\xb6\xb8\x20\x97\xc3\x0c\x8f\x4b\xc8\xe1\x57\x56\x46\x5d\xb8\x18\x55\x79\x4e\xf6\xca\x07\x86\xb0\x61\x63\x18\x82\x66\x9e\xa7\x98\xf0\xb2\x22\x35\x91\xb6\x12\x0c\x3a\x54\xc0\xf3\xda\x49\x14\x41\x6e\x67\x6e\x47\xc6\x6a\x59\xf8\xc2\x6d\x84\x0f\x1c\x15\xcf\xe7\x8a\xad\x19\x88\x74\x0a\xdb\x52\x22\x44\xef\x85\xe2\xe1\x2c\xe3\x87\xe4\xcc\x85\xd5\x26\x8f\xee\xb8\x64\x9b\xea\x0b\xb0\x38\x36\x61\xe4\x54\xff\x82\x9b\xc5\xde\x2c\x0c\xeb\x83\x87\xbb\xcc\x3b\x19\x6b\x9b\x86\x6c\x23\xbc\xdc\x00\x5b\x48\x13\x06\xfb\x76\xda\xc2\x1d\xc9\x83\x82\xda\x9c\x1b\x2c\x33\x27\x07\x92\xa6\x90\x7f\x77\x6d\x17\x40\xec\x8d\x67\xf6\x0e\xee\x40\xec\x7e\x14\xa5\xb2\x81\xc3\xa3\xf5\xee\x1d\xc7\xda\xc7\xed\x8d\x15\x67\x91\x42\x9e\xe0\x59\xaa\xa6\x66\x8b\x54\x14\x54\xa0\x9b\xc8\x23\x55\x87\x53\x7d\x94\xaa\x35\x08\x13\x48\xff\x3f\xd3\x22\xea\x8a\x23\xc4\x78\xce\xab\x5f\x8d\x95\x08\x23\x79\x8a\xc1\x03\x49\x0c\x47\x38\x4d\x63\x28\xca\x1e\x48\x63\x1c\x64\x97\xc8\x64\x86\xc5\xd7\x31\x23\x32\x11\x96\x3d\x56\x0f\xd1\xd9\x46\x23\xbd\x38\x6b\x49\x25\x95\x21\x77\xf2\x5a\x94\x50\x9e\x20\x24\x6c\xcc\xbe\x72\x93\x3c\x52\xbe\xba\x2a\x4c\xee\x84\xfd\x9a\x3b\xb0\x7f\xdf\xf4\x44\xa3\x3a\xf6\x99\x38\x6c\xcf\xef\x51\x0e\x4b\x19\x39\xd6\x46\x11\x58\x3e\xc2\xa7\xc1\x10\xec\x42\x09\xff\x9f\xdf\xbd\x09\x8d\x49\xf2\xa8\x0c\x6d\xd5\x0c\x71\x5f\x3b\xf3\xc1\x6b\x16\x57\x75\x77\xf3\xe8\x2a\x4f\xf0\xeb\x8a\x6d\x9d\xd3\x32\xff\xa2\x1b\xca\x35\x51\x0e\x0b\x16\x3e\xe9\x38\xb7\x28\x23\x10\x06\x90\x5c\x0f\xad\x08\xad\x3e\x94\x9f\x33\xf7\xdf\x36\xac\x67\x76\x02\x45\x07\x65\xa9\xff\x3d\xb0\xab\xce\xfe\x6b\x37\x27\x37\x6d\x33\x87\xff\x67\x75\x8c\x95\x8b\xcc\x31\x5d\x46\xb3\x2b\x60\x9e\x65\xb6\x54\xe1\x86\x1a\xe3\x0b\x20\x92\xd1\xd5\x42\xe4\xa8\xb3\x67\x59\xe8\x12\xee\x82\x5b\x83\xd1\x40\xf8\x57\xd6\x60\x78\x82\x9f\x13\xe4\xab\x06\x8c\xca\x43\x64\x9e\x97\xe0\xe7\x60\xee\x57\x5b\x70\x2a\xdf\x68\x5e\x39\x79\x95\x4e\x0b\x7e\xbe\x98\x2e\x0a\xb6\x9d\x88\xbe\xdd\x77\xfc\x0d\x5e\x0d\x65\x11\x3c\x2e\xcb\x27\xbd\xf3\xb6\xe1\x71\x74\x20\x89\x55\xd6\x39\x19\xe2\xda\xbc\x25\xa2\xd2\x40\xa1\x57\x9c\xb5\xb0\x46\xb2\xe9\xf6\x64\x3b\x54\xd1\x0c\x68\x20\x6f\xda\xb6\xb9\x06\x0a\x58\x8e\x46\x9f\x12\x7e\x35\xbe\x7e\xd9\x88\x9f\x73\x86\x77\xee\xe8\x8a\x36\xf0\x73\x72\xfd\xa2\xc1\xff\x73\xf9\xba\x3c\xc6\x44\x34\x61\x17\xe3\x15\xf4\xc2\xb6\xd0\xaa\xb3\x4e\xd8\x3f\xd1\x06\x7f\x4f\x56\xd0\x0f\xfe\x98\x8b\xf7\xd3\x65\x6c\x9f\x59\xb1\xb6\xd6\x90\x05\x1b\x36\x6a\x5e\xaf\xc9\xaa\x60\x49\x87\xf2\x3d\x43\x43\xb8\x8c\x3f\xc5\xab\xf8\x5a\x6e\x1f\xc2\x33\xa2\x7f\x94\x54\x17\x6e\xe2\x25\x47\xf1\x2f\xdd\xd8\x23\x35\x84\xdc\x8c\x1e\xdb\x31\x19\x8c\x29\x5f\xad\x98\x76\x82\x13\x42\x62\x12\xbe\xcf\x15\x14\x5e\x48\x4f\x64\x5d\x40\x38\x74\x33\x2e\x55\x4f\x92\xe5\x97\xc7\x15\x23\xfc\xd2\x39\xd7\xcb\x46\x88\x53\xff\xa8\xf3\xa1\x42\x64\xd1\xac\x74\x89\xae\x32\x6f\x89\xdd\x86\x0b\x1d\xfb\x2e\x79\x70\xf8\x1a\x54\x66\xbd\x9e\x70\x86\x3d\x9f\xe2\x6e\x9e\x9e\x26\x8c\x1e\x58\x9b\x59\xbd\xfb\x81\xfc\x10\x2e\x30\x2e\xb5\x60\x95\xc7\xec\x1a\x0b\xc6\x41\x1a\x4c\xf9\xbe\xab\xcd\x38\x5b\xc4\x3c\xf6\x3f\x06\x02\x97\x11\x5d\x14\x9f\x82\x00\x10\xbc\x58\xbb\x07\x68\x02\x2e\x52\x33\x0c\x57\x6a\xb1\xc1\xb2\x09\x5b\xcc\x38\x60\x92\xb2\xd9\x44\x5c\x8c\x58\xb7\x79\x9a\x01\xf1\x98\xe3\x59\xae\xe6\x89\xb1\x3a\xfd\x56\x77\x11\x75\xd5\x45\xd4\x4c\xf0\x50\x31\x1a\x5d\x0d\xf1\x5c\xeb\x90\x4d\x29\xe3\x13\xab\x26\x57\x2e\xff\xff\xa7\x60\x53\x9e\xc7\xa1\x25\xd8\x29\x20\xc0\x8a\x38\x8f\x41\x60\x46\x33\xce\x41\x73\x89\x0e\x7e\x99\x0a\x34\x31\x2e\x15\xa7\xe5\x9c\xc1\xcf\x3d\xe7\x53\xb5\x83\x09\xc4\xc7\x6a\xfe\xec\x5b\xc1\x85\x74\x5c\xa7\x78\x81\x9c\x5d\x8d\xaf\xe2\x35\x3c\x0c\x8c\xe9\x00\xe7\x9a\x33\x09\x66\xa5\x98\x99\xaa\x4d\x12\xc2\xfa\x15\xc6\xae\xc2\xeb\x18\x27\x7f\xb1\xbe\x8c\x57\x9d\x04\x83\x6e\x66\x85\x0c\x55\x01\x2e\x8b\x7e\x09\xe1\x5e\x28\xb9\xc1\xc2\x6c\xbf\x9d\x7e\x1b\x9e\x4f\xc8\x59\x1e\x8d\x83\x7f\x0b\x54\x13\x2e\x9f\x58\x43\xd4\x14\x9d\xa4\x8d\x50\xac\x3d\x79\x40\x4e\xea\xb9\x42\xba\xf0\x62\x31\xb2\xcf\x6b\x61\xed\xa8\x9b\x38\x5d\x48\x2a\x02\x0c\xa7\xcd\xf9\xb9\xc7\x00\x24\x9e\xec\x74\x89\x28\x21\x76\x9e\x16\xd9\x9a\x99\xd0\xcd\x66\xc0\xd7\x61\x80\xe0\x0e\x44\x4a\xe8\x44\x15\x99\xb1\x2d\x89\xdb\xfd\x43\x4b\x5a\xb1\x33\x54\x84\x4a\x8c\xa9\x5b\xac\xf5\xca\x6f\x2e\x78\x77\xc5\x6a\xc9\x26\x92\xb1\x96\x2d\x23\xcf\x5c\x02\x5c\xf0\x6a\x6e\xa7\x86\xcd\x67\x8c\xfb\xf5\xdf\x02\xcf\x5b\xf7\xd9\x51\xca\xde\xad\x1e\x7c\x9c\x7a\xf5\x70\xe9\x59\x3e\xb2\x08\x50\x06\x12\x7c\x11\x68\x67\xcd\x64\xf4\xe3\x44\xae\x79\x16\xca\x6d\xa5\x2c\x2e\xcb\xac\x1a\x11\x5a\x1f\x4a\x03\x8c\x98\x09\x18\x99\x51\x9b\x88\xf6\x6c\xa7\x5d\xff\xf3\xb8\xdb\xbd\xda\x17\x24\x5a\x74\x18\x44\xdb\xd6\x7d\x47\xaa\x69\xa6\xe8\x44\x3d\xa4\x5d\x61\x57\x3d\x66\xb9\x53\x69\x35\x13\xae\x1e\xca\xe6\x32\x0b\xbe\x7b\xff\xe6\xff\xf0\x5d\x86\x7c\xa3\x0f\x3b\x29\xa6\x2f\xf9\xcb\xcf\xf9\xa6\xdc\x8e\xc0\x9c\x85\x15\xfc\xa1\xf1\x3d\x7b\x90\x52\x91\x21\xa6\x4c\xa0\xc5\xfc\xc0\x0e\xb8\xcb\xd9\x6d\x82\xc5\xdd\x9b\x43\x24\xcb\x51\xcb\xb9\x65\x37\x1e\x40\x6a\x0a\x79\x86\xa4\x7d\xcf\xf3\x8a\x69\x39\xef\x4d\x55\xab\xda\xd9\xfd\xde\xc9\xd9\xca\x07\xd0\xa5\x2e\xb0\x05\xcc\x2d\x71\x50\x0e\xab\xae\xf5\x62\xd7\x30\xa6\x00\x57\x3f\xe0\x37\xe0\xbd\x14\xe7\xaf\xf7\xa8\x9b\x33\x5f\xc4\xa0\x8f\xbb\x6b\xe2\xda\x44\x8e\x98\xd5\x5b\x39\xb4\xe5\x47\x38\x3a\xb8\xea\xc8\xc5\xe0\xb1\x50\x27\x4c\xbb\xe4\x1c\xd4\xb3\x67\x93\xe2\xec\xd9\x41\x5a\x83\xe6\x7b\x93\xf2\xe8\x0b\xc2\xf0\xf3\x8e\x04\x8d\xca\xb3\x67\xe6\xeb\x93\xe2\x90\x3e\xa8\xa1\x1c\xe7\xd0\x41\x83\x57\x79\x7a\x70\xc1\x65\x9c\x4d\x0e\x33\x55\x96\xa3\x4d\x65\xb1\x04\x54\x50\x50\x4f\x2a\xc2\x9f\x35\x10\x59\x14\x4c\xfd\x2f\x4e\xdb\xe4\xb8\x83\x8e\x11\xf3\x1e\xc1\x11\x1a\x9b\x44\x32\x6a\xcd\xb1\x5d\x4d\x16\x82\xbe\xc0\x99\x92\x16\x84\xbc\x35\x2e\x67\xcb\x17\x0b\x66\x9f\x33\xc2\xcf\xd8\xef\xc9\x32\xad\x5f\xb2\x5f\xe3\x85\x40\x28\xf2\x93\x6e\xa0\x6e\x4f\x6a\x5e\x94\x23\x36\x95\x89\x35\xa9\xe2\x4f\xe2\xe7\xb8\x1a\x17\xb3\xab\x17\x0b\x6e\x80\x70\x96\xcb\xf8\xd5\xe4\x2a\xfd\x04\x83\x6c\x55\xef\xc6\x33\xe8\x9f\x2d\xd6\xe4\x93\xdc\x51\x38\xa0\x9d\x75\xf3\xcb\x51\xf7\x6c\x7c\x19\x4b\x15\xa8\x56\x0c\x62\x6f\xee\x76\xc5\xe7\x7e\x1f\x7a\x5c\x5e\x0c\x83\xc0\xe3\xb9\xf8\xcf\x9d\xda\x9c\xb9\xaf\xe2\x56\x97\xc9\xc0\x87\xb7\xf6\x89\x94\xcd\x80\x8c\xb3\x7f\xfc\x23\x3c\xa7\xbf\xfb\x96\x1b\x90\x04\x38\xf9\x1a\xb3\xd8\x77\xcb\x1a\x84\xa7\x12\x13\xf0\xb6\x90\x22\xdf\x7d\x79\x87\x85\x05\xfa\xc0\x21\x67\x3b\x4a\xbd\xf1\x9e\x56\x82\x1a\x58\x9d\xe6\x89\x8d\x9c\xf3\x1a\x64\x06\x12\xcc\xea\x61\xa6\xbd\x0c\xb1\x51\xb0\x93\x2f\x32\xd3\x3e\x7f\x93\xef\xda\xa9\x23\x0c\xa2\xd5\xf0\x1f\x90\x45\x3f\x93\xa2\x5d\x7d\x19\x9c\xa3\x31\x03\xe6\xfb\x2d\x5d\x0d\xc4\x9a\x0c\x03\x47\xef\xc3\xa8\x8e\xfb\x61\x9b\x7d\xce\x99\xbb\xfc\xa9\xf1\x5b\x68\x11\x1e\xa1\x75\xac\x31\x9e\x24\xed\x94\x57\x63\x50\xa8\x53\xb6\xa2\xf3\x91\x76\x73\xb1\xe3\xb2\xbf\xfd\xb9\x6f\x7f\xd0\x5b\xf1\xb4\xf7\x2d\xf3\xea\xf8\x06\x23\xeb\xcc\x5f\xfc\x76\x0d\xee\x90\x7f\x60\xbc\x33\xfe\xd5\x03\x9d\x60\x16\xce\x75\x54\x33\x29\x78\x99\xa7\x73\xc3\x13\xc4\x2c\x78\x1e\x02\x9c\x0e\xe0\x27\xee\x15\x17\x11\xe7\xad\x6e\xe8\x7d\xae\x9b\xa4\xfa\x45\x67\xdd\x78\x3d\x43\x4f\xed\xb3\x15\x2b\x15\x04\x24\xc2\xa5\xdc\x9c\xc3\xe8\xa4\x5d\xfd\x6c\x15\x84\xc3\xb4\x09\x47\xb1\x30\x42\xdf\x68\x7c\x88\x07\x58\xe7\xcc\x98\x90\xff\xd7\xe6\x37\xbf\xe6\x66\x22\x91\x14\x2d\xaf\x54\x1a\x9b\xd5\x27\xdb\x1f\x76\x10\x64\x3d\x33\x2f\x64\x45\x91\xb8\xf4\xd5\x14\xc9\x47\xa7\xab\x8a\x64\x9f\xa2\xae\xe8\x57\xab\x51\xd9\x34\x1b\x16\xe1\xfe\x6f\xaa\x51\x91\xdd\xfd\xb7\xd5\xa8\xf0\x22\x11\xd5\x2d\x2f\x12\xe1\x07\x61\x69\xac\x75\x73\xec\x3f\xf2\x17\xf4\xb7\xf9\xe4\x4a\x5c\xed\xf0\x2c\x91\x63\x1f\xe6\x31\x8d\xb8\xbe\xf5\xd5\xbb\x63\xef\x8d\x5a\x13\x59\x6b\x27\xd3\xb1\xf2\xd0\x01\x6e\xbe\x63\x95\x87\xbf\xf8\x44\xce\xee\x58\xf1\x89\x5d\x74\xb2\x7c\xee\xbc\x2b\x34\x28\x3c\x5f\x3e\x7f\xe9\xe9\x5b\xed\x15\xb5\xab\x52\x0e\x91\xc9\xb8\x0f\x9b\xba\x6a\x1e\xd9\x45\xa7\x16\xf5\xff\xf3\x29\xe6\xaf\x0d\x0c\x18\x59\x5c\x15\xb1\xd7\xc1\x02\x1b\x5d\x0f\x0d\x16\x38\x2f\xeb\x7d\xb7\xfa\x50\x63\x1d\xf5\x66\x47\x1d\xf9\x3a\x76\x19\xa0\x50\x63\xf1\x20\xb6\x1e\xb4\xf0\x47\x28\xbc\xe1\x89\x81\x70\xbe\xfd\x13\x64\x43\x63\x02\xa4\xbf\x81\xca\x16\x7d\x9d\xcf\xa9\x4f\xd8\x56\xe8\xee\xba\x3c\x47\x17\x52\xd9\x8c\xb9\xdf\x66\x34\x2d\x46\x85\x7a\xbf\xa9\x98\x27\xa2\xc1\x5c\xfd\xe2\xe6\x22\x61\xe6\xa2\xba\xe9\x96\xa3\xff\xf3\x76\x8f\xb4\x77\x4c\xa5\x21\x50\x3d\x33\x2f\xa4\xd2\x10\x97\x3e\xa5\x21\x1f\x9d\x56\x1a\xb2\xcf\xaf\x53\x1a\x85\xfe\xe0\xd9\xb1\x5d\xf4\xdc\x65\x79\xab\xca\xc2\xb0\x30\x8c\x6d\xb3\xb5\x1a\xcf\xb9\x67\x9c\xba\x45\x63\xa6\x48\xc9\xbb\x1f\x4c\x93\x95\x2a\x99\x63\x22\x8b\xcd\x70\x41\x10\x81\xd5\x8b\x47\xc5\x0c\x56\xf5\xe0\x25\x9e\xb8\xc1\xdb\x31\xcf\x79\x97\x5d\x88\x9c\x16\x6f\xaf\xaf\xf9\x69\xd3\xdc\x60\xea\xec\xc6\xe7\x31\x52\x75\x6a\x9d\x35\x3f\x56\xd5\xc4\xef\x60\xcc\x8c\xff\xda\x6d\xbe\xb8\x5f\x3b\xd9\x36\x05\xdd\xed\xac\x82\xa0\x2d\xad\x40\xd1\x2c\x65\x54\x4a\x34\xe9\x1c\x1e\xf5\x95\x7a\x5a\x43\xe2\xd5\xd4\xc5\x68\x54\x38\xdf\xe5\x31\x60\xe7\xca\x38\x3f\xa2\x8c\x0b\x54\xc6\x05\x3f\x4f\x37\xef\x68\xc7\xdc\x28\xb4\xe4\xa8\x4f\xcf\xa7\xb8\x9e\x16\x97\x7f\x30\xb1\xdb\xc7\x4c\x16\xb6\xdd\xbd\x1d\xfb\x3d\x73\xcd\xae\xc9\x67\xa3\x4a\xdf\x6d\x14\x9f\x08\xfa\xf0\x56\x91\x0d\x9e\x58\x9c\x6e\x82\x9b\xa1\xa4\xc8\x44\xf8\x1c\xcf\x7e\x9a\xce\x1e\x44\x62\x16\x2d\x49\x11\x68\xa2\x80\x99\x9b\xf5\xee\xcf\x8c\x2f\x42\xff\xec\xf9\xe1\xcf\xa0\xa4\x79\xa8\x41\x7f\xc8\xcf\x26\x4a\x23\x50\x65\xed\xfa\x67\xb2\xc7\xe2\xa3\x08\x90\xd7\xd9\xc7\x9e\x9b\xe7\x8e\x09\x02\xd1\xa5\xe3\xb9\x5b\x64\x7e\xf6\x97\x6f\x12\xa3\xf4\x1b\x0f\x37\x93\xb2\x98\x7d\xec\x53\x9e\xd5\x54\x75\x4a\xb0\x2f\x2e\x2a\x46\x44\x2c\x58\x51\xc6\xf4\xf2\x72\xbf\xe7\xc6\x74\xb2\x03\xc3\x3a\xb4\xed\x3b\x29\xc9\x00\x0f\x13\x74\xe7\x0f\xdd\x53\xd3\x64\xd8\x0f\xd8\xe9\x66\xc7\xcb\xad\x10\x6b\xb1\xdc\xef\x6f\xde\x3f\xbf\x64\x3b\x17\x73\xeb\x43\x14\x8c\x07\xfb\xa3\x15\xbd\x68\xb6\x8f\x47\xe4\x40\x18\x96\x85\xb3\xce\x65\xe6\x69\x3e\x2e\x26\xbe\x41\x64\xe4\x50\xee\x69\x14\x13\x54\xc5\x08\x7c\x5e\x32\x0e\x65\x0a\x32\x55\x6e\x61\x92\xde\x10\xd3\x55\x8e\xfc\xc9\x5f\x66\xb2\xdc\x61\xb0\x18\x66\x21\xc9\xaa\x8b\x4a\x9d\x20\x74\x29\x4b\x34\x58\xdf\xf8\x35\x4c\xc2\x62\x5a\x0b\x30\xf6\x5f\xe0\xe1\x70\xd6\xa7\x2c\x7a\x24\x29\x73\xe2\x42\x5e\x66\x4a\x74\x01\x29\xc1\x02\x52\x18\xb2\xba\x20\xf8\x3d\xed\x97\x19\x65\x3f\xc4\x27\x9e\xb3\x0c\xaf\xc7\xe7\x40\x15\x38\x12\xfe\xea\xc0\x82\x6f\x3a\xab\x28\x9f\x59\x7e\x79\x17\xb8\xdc\x86\xcc\x38\xaf\x55\x32\xc7\xf8\xa9\x7d\x10\xf7\xd3\x71\xce\x8e\xed\x16\x87\x61\x1a\xcd\xf8\xd1\xde\xe2\x39\x7e\xd3\x97\x29\x3d\x7e\xa0\x03\xd0\xfc\xaa\x0e\x7c\xdf\x66\x98\xfd\xa6\x94\x87\x3e\xb8\xa7\xfc\xe8\x13\xce\xec\x2f\xe8\xad\x6a\xe3\x40\x3a\xdf\x57\x19\xcd\x93\xa9\x24\x22\x6c\xcd\xe4\x60\x8b\xcd\xdf\xde\xbb\xee\x48\x08\x31\x8d\x9f\xd7\x6d\xbd\xf2\xa9\xdd\xf8\xd4\x47\x3e\x2c\x23\x47\x01\x32\xb3\x2f\xa5\x35\xa3\x6e\x58\xa6\x4e\xee\x3e\x3c\x6d\xec\xe8\x9e\xf5\xde\x1b\xf7\xdc\x7f\x0b\x33\xe6\x69\x4d\x9e\xaf\x01\x60\x4f\xf2\x44\xe4\xbe\x4f\x01\x28\xc9\x29\x4f\xb6\xcc\xe3\xfc\x9f\xfb\x14\xc0\xd7\x6e\x25\x21\x39\xff\x82\xb5\x73\xcf\xf2\xb3\x1f\x72\x50\x7e\xd1\xe5\x22\xf3\x98\xa4\xc7\x6e\xc0\x38\x55\x3f\x66\x0e\x10\xe3\xf1\x9d\x8a\xf2\x6f\x56\xe2\x58\x35\x93\x4f\x22\x3c\xa1\xa4\xa3\xa3\xd8\x67\xb2\xd8\xa7\x33\x1c\x5d\x55\x66\x65\xdf\xd7\x33\xd4\x49\x9f\x78\xa2\xb3\x64\x4a\xc6\xb7\x9e\x6f\xf7\x88\x24\x43\x61\x7f\xe3\x26\x85\x56\x2d\x98\x9c\xfc\x8b\x08\x4e\x36\x95\x21\xdf\x3d\x1c\x85\x29\x31\x33\xf7\x2a\xab\xe6\x7c\xad\x29\x6b\x8d\x73\xa3\xe6\xe1\xec\xb9\x99\x86\x1d\xfa\xf3\xb0\xec\x24\xc5\xfe\x63\xaa\x97\xe6\x09\xbd\x52\xb0\xe6\xa6\xd0\xc1\xa3\x53\xa3\xd8\x6e\xb0\x8c\x97\xea\xc8\x1a\x93\x61\x34\x74\xf7\xb8\xd0\xa9\x91\x90\x66\xd3\xf2\xe2\x20\x77\x5f\x52\xe5\x39\x9e\x77\x38\x26\x0e\xec\x54\xe5\xe2\xb8\xe0\x8f\xc1\xaa\x30\x32\xee\x15\xfb\xd8\xb9\x57\x48\x89\xb5\x7c\x39\xb0\x29\x0c\x9c\x88\x13\xd2\x8d\x3b\xf0\xde\xd3\x94\x11\x76\x14\x15\xbd\x47\x68\xe7\x1e\x11\xfe\x15\x3d\x61\x45\xce\x9c\xa5\x38\xac\x9a\x95\xdc\xa9\x6d\x41\x87\xb6\x13\x25\xc0\x73\xfa\x4f\x6b\xa1\xfc\xe1\x2a\xe8\xab\xf1\x31\x65\x87\x14\xc9\x0f\xcc\x2f\x40\x9c\xa9\x25\x51\x5f\x03\x5a\x66\xb4\xf7\x73\x68\x0b\x05\x6d\xf7\x9b\xec\xfb\xfd\x70\x68\xf4\xc7\x6f\xca\x14\xca\x4c\xbf\xb9\xec\xfd\x78\x7b\xf5\xb8\x6a\x83\x8a\x7d\xa6\xbe\x53\xf5\x60\x07\x89\x49\x3e\x93\x3f\x54\x70\x98\xe4\xde\xc0\x30\xdc\x7e\x40\x50\x18\xfa\xe9\xff\xea\x90\x67\xcb\xa5\xd8\xec\x28\x55\x08\x3f\x5b\x3c\x3a\xf2\x51\x1e\x84\xa3\x77\x8f\x2a\x5f\xde\xce\x67\x79\x8e\xbe\xb3\xa9\x99\x3a\x8d\x7f\xc5\x2f\xc7\x93\xaa\xaa\x3f\x3f\xf8\xc3\xf1\xbc\xf5\x63\xe2\xd3\xc7\x3e\x15\xff\xc0\xef\xc2\x3f\x11\x26\xa7\x9b\x09\x14\x19\x1d\x3b\xe8\xc0\x20\x34\x30\x6c\x7f\x31\x15\xf7\xf6\xcb\xd4\xb4\x3c\x01\xc9\x5a\x9e\x87\xbf\xfe\xe7\xba\x5d\x32\x45\xf5\x7d\xd3\x6c\xbc\x9b\x9b\x8c\xa3\x13\x59\xb7\xd6\x5e\xd0\x9b\xf5\xa6\x36\x4f\x41\xdc\x60\x05\x96\x2c\x6a\x30\x5c\x05\x73\xc8\xb0\xc7\xc2\xf8\xe9\xed\x87\xb7\x1f\xb3\x80\x0d\x32\x60\x7f\xb1\x3a\x51\xfc\x12\x75\x10\xd6\x7e\x4f\x11\x86\x98\xc6\x1c\x87\x29\xb7\x04\x3b\x91\x47\xe7\xdb\xf9\x4e\x55\x81\xb9\x36\xa6\xf7\x27\x9d\x31\x33\xf1\x24\x8b\x4f\xac\x77\xa4\x33\x87\x5a\x1c\x67\xcc\xbc\x31\x51\x7c\x61\x62\x4d\xba\x54\xc5\x4b\x2a\xea\xe1\x86\xec\xe3\x9f\xba\xc4\xc3\xd7\x5a\xf4\x38\xa7\x63\x8d\xef\x17\x59\xc5\xea\x06\x86\xe7\xa9\x2a\xed\x25\x93\x12\x3c\xad\xf1\xc2\xbc\x29\x2a\xc8\x79\x75\x8e\xd9\x79\x5c\x67\xcb\x39\x4d\x59\x2f\xf1\x15\xfc\x5e\xa4\xf9\xcc\x1e\x70\x34\x2a\xa0\x3f\x01\x28\xbf\x07\x76\x56\x3d\xbe\x7a\x99\xc1\x50\xba\xa4\xd8\xcd\xbf\x62\xd1\x84\x49\x03\x76\x72\xbc\x43\x23\x96\x9f\x69\x3e\x38\xf2\xc9\x18\x41\x29\x96\x9e\xe2\xec\x3f\x33\x23\xca\x9e\x25\x75\x3f\xde\x2f\x97\x74\xd6\x07\x85\x28\xe4\x20\x9e\xb3\x35\xbc\x0c\x64\x4e\xd7\xd8\xaf\x71\x8a\x7b\xe3\x73\xdf\xe9\x1d\xdd\xc2\x2d\x8d\x43\x35\x05\x3b\xfc\x62\x99\xdc\xfa\xb4\x98\xa3\x31\x0a\x26\x48\x91\x10\x2a\xf8\xcf\x97\x15\x48\x5c\x05\xe1\x4c\xb5\xa1\x3a\x72\xc3\xc4\xea\x49\x34\x93\xf2\x57\x15\xcb\xe1\xee\xb7\x2a\x83\x41\x40\xbe\x76\xa3\xf5\x94\x95\x8b\x31\x00\x9c\xc2\x7c\x6f\xf3\x8a\x75\x26\xe1\x74\xdf\x10\x04\xaf\x22\x34\xc8\xf8\xe1\x02\xf8\x9d\x82\x78\x57\xa1\x13\xc1\x04\xc3\x6c\x19\xdd\x73\xca\xd6\xac\xe5\x1e\xb4\xc3\xea\xa0\x59\x61\x6b\xa0\xea\x88\x05\xb5\x8d\xc3\xe5\x3c\x98\x04\x63\x3c\xda\x25\x02\x2e\x92\x06\x3f\x6e\x04\xd5\x1a\xa9\xff\x43\x33\x57\xf0\xd2\xd1\xe3\xc3\x2d\x91\xbb\x34\xe5\xad\xb1\x1f\x60\x2e\x27\x6b\x72\x5d\x18\xa5\xdd\x7d\xf8\x27\x78\xa8\xd6\xe5\xa6\xb5\xf2\xa6\xc4\x54\xe3\x40\x80\x81\xa1\x78\x63\x72\x07\x6b\x63\x82\x97\xb7\x58\xd1\xd6\x62\x92\x4f\x2a\xb4\xed\x4d\x4b\x89\xf5\x31\xd3\x3f\xa5\xb5\xc4\x75\x97\xc7\x5e\xe2\x0f\x4e\x5b\x4c\xbc\xb7\xfe\x00\xc1\x91\x50\x00\x9f\xd7\xc9\x8f\x02\xea\xaf\x09\x44\xaa\xca\x3a\x93\x3f\xf6\x7b\xd0\xff\x42\x62\x8a\x5b\xdf\x31\x2c\xb1\x83\x48\x45\x31\x3c\xc7\x9b\xd3\x20\x72\x5e\xfb\x88\xe7\xa9\xe9\x77\xb0\x06\xcc\x78\xa4\x2c\x3d\x54\x66\x56\x20\x62\xf6\xff\x02\x00\x00\xff\xff\x3a\x3a\xdf\xda\x04\x90\x00\x00")
-
-func _staticJsBootstrapMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		__staticJsBootstrapMinJs,
-		"_static/js/bootstrap.min.js",
-	)
-}
-
-func _staticJsBootstrapMinJs() (*asset, error) {
-	bytes, err := _staticJsBootstrapMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/js/bootstrap.min.js", size: 36868, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticJsHtml5shivJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x56\xdd\x72\xdb\x36\x13\xbd\xf7\x53\x40\xcc\x37\x36\x19\xe1\xa3\x94\xa4\x69\x67\x48\x23\xce\x24\xad\x9b\xce\x24\x99\xce\x24\xbd\x72\x9c\x0c\x08\x2c\x49\xd8\x14\x40\x13\x90\x12\x55\xe0\xbb\x77\x21\x52\x3f\x76\x93\x5e\x48\x22\x81\xc5\x62\xf7\xe0\x9c\x03\xcd\x1e\x9f\x90\x37\x1f\xdf\xbd\x7d\x4e\x3e\xd4\x6a\x45\x56\xcf\xd2\x5f\xd2\x39\xf1\xe4\x25\x2f\x79\x77\xcb\x2d\x79\x79\x23\x79\xe3\x8c\xc6\x07\xa3\xbf\x68\xe0\x0d\x79\xd9\xc1\x02\x43\xde\xfd\xf1\x71\xf6\xfb\x9f\x6f\x9f\x92\xb7\x4a\x80\xb6\x20\x4f\x1e\xcf\x4e\xe2\x72\xa9\x85\x53\x46\xc7\x0d\x2d\x93\xcd\xee\x8d\x2c\xe2\x64\xb3\xe2\x1d\xe1\x0c\x52\x68\x60\x01\xda\xd9\xbc\x03\xb7\xec\x74\x64\x5d\xa7\x74\x15\x31\xe6\xd6\x2d\x98\x92\xf0\x0b\x9e\xda\xb6\x51\x2e\x8e\x48\x94\x64\xbc\xdf\x67\x51\x31\x1f\xd2\x14\x4c\x5f\xf1\x2b\x73\x7d\x9d\x17\xde\xc7\x05\xdb\xf4\xb4\x9e\x4e\x69\x18\x62\x35\xd5\x57\xf5\x35\x2b\x92\x31\x3f\x29\x0e\x09\xda\x98\xd3\x82\x8a\x64\x33\x2c\x2b\x93\x5c\x95\x71\x95\xec\x02\x53\xd1\x01\x77\xf0\xdb\x50\x20\x6e\x96\x0b\x8c\x13\x4c\xc5\x45\x92\xe4\x05\x13\xa9\xe0\xa2\x86\x2b\x7e\x7d\x71\x78\x4c\x45\x63\x34\xbc\x37\x12\xe2\x24\xeb\x52\x07\x36\xac\xbc\x88\x0f\x11\x61\xdd\x3e\x31\xce\x25\xf7\x96\x3c\x98\xcc\x0f\xc5\x70\xfd\x86\xaf\xe0\x75\xad\x1a\xd9\x81\x3e\x3d\x9d\xd8\x7d\x76\x91\x96\x1d\xaf\x52\xde\xb6\xa0\xe5\x36\x02\x4b\xcc\x8e\x3a\x75\xa1\xd3\x64\x83\xed\x4d\x8a\xa1\x90\x64\xfc\x0d\x60\x1d\x77\xca\xf8\xfd\xb6\xf7\x93\x97\xb8\xc3\x7e\xf2\x57\x23\x96\x61\x36\x0c\x8e\x51\xa1\x02\x76\x1c\x1c\x27\xf9\xc9\x83\x64\x6c\xcf\x07\x04\x7d\xe8\x6c\x02\xa9\x45\xae\xbd\x03\x57\x1b\x69\x2f\x8e\x4b\xc1\xa0\xac\x8d\x05\x0d\xa5\xf7\xf9\x8f\xb6\x66\x97\xbb\x9c\x51\x4d\xcb\x88\x46\x23\x62\xfb\xad\x06\x8e\x68\x56\x1e\xe3\x4c\x05\xd3\xf7\x6b\xcb\xeb\xe3\x42\x4e\x4f\xe3\x68\x8a\x34\x4d\x6f\x8c\xc2\x14\x69\x07\x6d\xc3\x05\xc4\xb3\xab\x4f\x5f\x3f\xfd\xff\x7a\x3a\xab\xe8\x7e\x03\x64\x61\xf1\xe0\xd4\x06\x38\xfe\x4d\xa0\xa1\xb6\x33\x11\x47\x67\x53\x3e\x3d\x8b\x92\xb3\x3e\x99\x46\xfb\x53\xd6\x7d\x94\xc4\x30\x82\x99\x1c\x8e\xef\x2e\xec\xc1\x91\x7c\x3c\x90\x74\xa0\x7c\x60\x7f\xe0\xeb\x80\xdf\xeb\x0f\x1f\x90\x11\x37\xf8\x29\xd2\x9a\x5b\x7c\x1d\xda\x16\x54\x32\x9e\x0b\x26\x1f\xd4\x12\xb5\xb8\xab\xc4\xe1\x0a\xdc\x38\x66\x5f\xad\x3f\xf2\xea\x3d\x5f\x00\x22\x09\x5c\x46\xc9\xd5\xfc\xda\x7b\x99\xca\x11\xf1\x1d\x50\x22\x55\x5a\x43\x17\x8c\x82\x45\xdf\xce\xad\x5b\x37\xf0\x82\x77\x4e\x89\x06\x28\xb7\x4a\x02\x95\x8a\x37\x06\x21\x52\x95\xe0\x6d\x68\x21\x3c\x2e\x3b\xa0\xa5\x31\x0e\x3a\x1a\xf2\x87\x9f\xaa\x33\xcb\x96\x2e\xb8\xd2\x54\xf3\x15\xb5\xb0\xed\x77\x23\x15\x2a\x9e\xaf\xb3\xa2\x31\xe2\xb6\x5f\xa0\xf7\x6c\x0a\x2e\x6e\x43\xb4\x96\xd9\xa3\xcb\xcb\x79\x2e\x4c\x63\xba\xec\xd1\x7c\x3e\xef\x1d\x2c\x30\xda\xc1\x7e\x99\xc6\x63\xee\xcf\x67\x43\x61\x51\x7e\x12\xda\x57\xe8\x49\x9d\x7b\x05\xa5\xe9\x00\xc5\xd8\x70\xeb\xb6\x42\xa1\x32\x2d\x55\x37\xbe\x84\x83\x1b\xd0\x63\x93\x89\xe8\x2b\xef\x07\xe1\xec\xce\x87\xf7\x01\xd3\x5b\xd6\xa4\xb5\x5b\x34\xcf\xbd\x47\xf1\x58\x36\xfb\x7c\xee\x3f\xc7\x17\x59\xb1\x74\x68\x8d\x7e\xc1\x5b\x6f\xd1\xd5\x84\xf3\x0e\xbe\x39\x8e\xb8\x7b\x53\xdc\x84\x77\x85\xe7\xba\x00\x6f\xb6\x90\x84\x9f\x6d\xff\xc9\xff\x66\x8a\x76\x98\x06\x73\x70\x5f\x78\x81\x0c\xf5\x52\xad\x7c\xa9\xa0\x91\x16\x9c\xaf\x9f\xf8\xfa\xa9\xaf\x9f\xf9\xfa\x27\x5f\x3f\xf7\xf5\xcf\x5e\xf9\x86\x17\xd0\xf8\x46\x79\xd3\xf8\xd6\xdf\x79\xdb\x72\xed\xd1\x3c\x8d\xae\xfc\xb6\x73\xef\x78\x11\xbe\x0b\x23\xd7\xde\x49\xef\x6a\xef\x3a\xbf\x6c\xb6\xdb\xdd\x50\xc3\xa2\x2f\xdb\x2e\x02\x7d\x22\x5a\xb3\x39\xd5\xc1\x0c\xaa\x3c\x3e\x92\x8e\xeb\xd6\xa3\x53\x97\x0f\x19\xc4\x91\x41\xfc\x98\x0a\xe7\xdf\xd6\x7f\xbf\x38\x9f\x85\xef\x28\xbf\x61\x51\xad\xa4\x04\x1d\x29\xc4\x6d\xe0\x6c\xa0\xeb\x04\x8d\xf6\x09\x0b\x46\x12\x00\x0f\x62\xb4\x69\x03\xba\x72\x75\x82\x17\xc4\xf7\xf6\xd8\xd2\x78\xbf\xfd\x43\xf9\xc7\xc1\x89\x23\xa4\x05\x94\x4a\x83\x3c\xdc\x1b\xe2\x20\x77\xef\x4f\x7e\x10\xf1\xdd\x94\xde\xff\x57\xf4\x58\x5b\x8f\x66\xd7\x0b\xee\x44\x1d\xcb\x64\x53\xb1\x1b\x36\x99\xf7\x7d\x12\x0f\xf5\xa2\xad\xee\xee\xb5\xec\x76\x7f\xc5\x61\x62\x5e\x14\x08\xe6\xa0\x15\xb2\xd5\x0a\xe1\x4b\xa9\x0c\x29\xa4\x22\x68\xf1\x2b\xbc\x64\x25\x77\x7c\xfb\xd5\x28\xeb\x88\x04\xc7\x55\x83\xa3\x5b\x49\x91\x83\xa4\xc8\x20\x29\x32\x48\x8a\x0c\x92\x22\x83\xa4\x48\x90\x14\x09\xc2\x21\x0b\x08\xb3\xa8\x2e\x62\x96\xae\x5d\x3a\xd2\x76\xa6\xea\xc0\x5a\x32\xaa\x8d\xd8\xe5\x02\x23\xd7\x64\xa7\x23\xe2\xd4\x02\xc8\x0a\x6b\x33\x11\x5d\x41\x67\x31\x28\x8b\xb6\xff\x05\x22\x3a\x9a\x4d\x36\x79\x32\x61\xec\x76\xe7\x3d\xd4\x2e\xdb\xd6\x74\xce\xfe\xa5\x6f\xb5\xf9\xaa\x77\x96\x92\x55\xf4\xc8\x54\x8f\x17\x8d\x43\x34\xa0\x9b\x45\x88\x36\x5f\x36\x6e\x48\xbf\x3b\x8f\xec\x8e\xde\x83\x3c\x6b\xe9\xf7\x0f\x2c\x3b\x58\x71\xb8\xe5\xf6\x46\x79\x72\x7c\x9d\xff\xe8\xfe\xc0\x13\x43\x4f\x88\x07\x53\xc5\x3f\x02\xc1\x57\xf1\x76\xd8\x39\xf8\xd1\x95\x21\x51\x22\xc0\xf0\x52\x40\xb1\xc0\x48\xda\x5c\x9e\xe3\x67\x3a\x4d\x1e\xd0\x23\x86\x2b\x79\xbd\xf7\x0d\xd1\xf7\xf9\xe8\x19\x0c\xf2\xbb\xb8\x4c\x90\x29\xae\x56\x96\xee\xbc\x15\x8b\xfd\x27\x00\x00\xff\xff\x4a\x39\xf2\xfa\x7d\x09\x00\x00")
-
-func _staticJsHtml5shivJsBytes() ([]byte, error) {
-	return bindataRead(
-		__staticJsHtml5shivJs,
-		"_static/js/html5shiv.js",
-	)
-}
-
-func _staticJsHtml5shivJs() (*asset, error) {
-	bytes, err := _staticJsHtml5shivJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/js/html5shiv.js", size: 2429, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticJsJqueryJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\xbd\x79\x97\x1a\x47\xb2\x28\xfe\xff\xfb\x14\x4d\x8d\x2e\xae\x12\x09\x0d\xb2\x3d\xbf\x3b\x85\xaa\x39\xb2\x96\xb1\x3c\xb2\xe4\xb1\xe4\xed\xd2\x58\xa7\x76\x8a\x06\x8a\x06\x5a\xdd\x72\xc3\x7c\xf6\x5f\x2c\xb9\xd5\x82\xe4\xb9\xf7\xbe\x73\xde\x8c\xd5\x54\x65\x66\xe5\x1a\x19\x19\x11\x19\xcb\xf9\xc3\xce\xd9\xe2\x9f\x37\xe9\xf6\xe3\xd9\x87\x47\x83\x47\x83\x2f\xcf\x0e\x67\x6e\xec\xa9\xb4\x17\xe5\xcd\x3a\x09\xf7\x45\xb9\x86\xf4\xc5\x35\xa6\x0d\xca\x6d\x7e\xbe\x2c\xe2\x74\xbd\x4b\xcf\x1e\x9e\xff\x9f\x4e\x76\xb3\x8e\xb1\x84\x1b\x8a\xc8\xbb\x77\xca\x68\x91\xc6\x7b\x27\x08\xf6\x1f\x37\x69\x99\x9d\xad\xca\xe4\x66\x99\x76\xbb\x27\x32\x06\xe9\xdd\xa6\xdc\xee\x77\x93\xea\x6b\x10\x0e\x92\x32\xbe\x59\xa5\xeb\xfd\x24\x82\x9a\x3b\x43\xcf\x37\x0d\x79\xf7\x45\xe6\x76\x4c\x11\x6f\x3f\xdf\x96\xb7\x67\xeb\xf4\xf6\xec\xf9\x76\x5b\x6e\x5d\x47\xf6\x7f\x9b\x5e\xdf\x14\xdb\x74\x77\x16\x9e\xdd\x16\xeb\x04\xca\xdc\x16\xfb\x39\xbc\xa9\x2f\x1d\x6f\xbc\x4d\xf7\x37\xdb\xf5\x19\xb4\xe2\x1d\x7d\xfa\xeb\x3a\x30\xea\x34\x2b\xd6\x69\xe2\x74\x54\x77\xf9\xfb\x09\xff\xf8\xfb\x79\xb1\x13\xd5\x91\x7f\x08\xb7\x67\x71\x30\x9d\x89\xc4\xea\xbc\x48\x83\x78\xb0\xc3\xe9\x12\x19\x3c\xc5\xe5\x3a\x0e\xf7\x22\x87\xc7\xcd\xcd\x6e\x2e\xe6\xf0\x00\x15\xa6\x77\x6f\x32\x51\x04\xf7\x47\xb1\x08\x8a\xc1\xbe\x7c\xbb\xdf\x16\xeb\x5c\x5c\xc1\xcb\x3c\xdc\xbd\xb9\x5d\xff\xb0\x2d\x37\xe9\x76\xff\x51\x2c\xb1\xd0\x2a\x70\x68\xad\x1c\xb1\x0e\xaa\x7d\x90\x63\xc1\x89\x58\x0f\xb2\x35\xd4\x5d\xec\x29\xe7\x28\xca\xe0\xfc\xf7\xe9\xe5\xee\xf2\xe6\xc5\xf3\x17\x2f\x2e\xef\x9e\x0c\x67\xbd\x43\xed\xfd\xc1\x79\x2e\x36\x50\xac\xbf\xda\xf5\xcf\xc5\x75\x70\xde\x77\xa7\x97\x49\xd8\xff\x63\xe6\x9d\xe7\x85\xd8\xb6\x37\x16\x41\x87\x7f\xda\x40\xf7\x9e\x86\xbb\xd4\xf5\x8e\x63\x6c\x39\x58\x0f\x36\xdb\x72\x5f\xe2\xe4\x05\xf7\x0c\x39\xfe\x4a\xc0\xf8\x77\xfb\xed\x4d\xbc\x2f\xb7\xfe\x5a\xec\xd2\x65\x4a\x8f\x8e\x23\x96\xe9\x3a\xdf\xcf\xfd\xa1\xd8\x97\x4f\xb6\xdb\xf0\xa3\x59\x6d\xdd\x50\x3a\x88\xc3\xe5\xd2\xc5\xa9\x87\xf1\xe4\xe9\xbe\x02\x11\x6a\xe8\x37\xcb\x65\x27\x08\x27\xc3\x8b\x70\x82\x25\xa7\x61\x0f\x7f\x06\x5c\xff\xcc\xe7\xb4\x99\x5f\xad\x0c\x17\xe3\xed\x3e\x8c\xaf\x2a\x55\xe2\x8a\x46\x30\x92\x55\xba\xcd\x53\x2a\x3a\xb0\x06\xe0\x7a\x22\x34\xd0\x03\xc3\x4d\x3f\xbc\x21\x10\x0f\x08\x38\x22\x2c\xbb\x4f\xef\xf8\x55\xbd\x88\xe8\x28\xd2\x30\x9e\xb7\x76\x7d\x80\x39\xd4\x0e\xd4\x0c\xcb\x1c\x6e\xda\x8a\x51\x75\xba\xc3\x2e\x74\x2f\xdc\xb8\x55\x78\x8c\x44\xac\x8b\x87\x3c\x50\x48\x42\x30\xf0\xa0\x5e\x02\xc7\x96\xf9\xad\x55\x9c\x0e\xc2\xcd\x66\xf9\x51\xf6\x67\x9b\x13\x3c\xef\xb0\x82\xac\xd8\xee\xf6\xa7\x2a\x48\xaf\xdd\x21\x94\x59\x86\x9f\x2c\xd2\x1f\x41\x99\xf4\xba\x65\xba\xad\xd5\x12\x71\xd0\x0b\x7b\x2e\x2e\x65\xe4\x0f\xf5\x5c\xd7\xfa\x19\x5f\x04\xc3\x6e\x37\xba\x88\x27\x53\x5a\xdc\x78\x36\xf3\xa7\x33\xac\x7e\x9d\x9c\x1c\xa5\x5e\xac\xc3\xa1\xb9\xae\x0c\x0f\x7e\x2e\x76\x80\x8a\x7c\xd8\xbf\xf0\x23\x76\x1b\x9a\x36\x78\xa3\x87\xa3\x80\xe5\xba\xdb\x43\x1b\x01\xed\x34\xf9\x6c\xb5\x87\xc3\x81\x7d\x02\xf3\x9e\x08\xd8\xfd\xb0\xe9\xf5\x24\x4e\x87\xb3\xc3\x01\x36\xf2\x3c\x18\xc1\xb6\xd7\xc9\x6a\xd8\x8b\xa0\x33\x1a\x67\x88\xc6\xa2\xb2\x5c\xa6\xe1\xda\x20\xcd\xbc\xdb\x75\x17\x41\x5e\xa9\x6c\x2e\x2b\xeb\xf5\x3c\xd1\xc0\xb2\xf9\xe1\x00\x68\x60\xf7\x42\xf5\x2b\xf7\x0e\x07\x37\x07\x2c\xe2\x41\xeb\x41\x50\x40\x7d\x39\x03\xec\xbc\xdf\xf7\xc6\xc5\xc5\x7c\x8c\x15\x01\x7e\xe5\x9d\xe4\x86\x95\x96\x3c\x0f\xfb\x15\x9d\x15\x00\x58\x5e\x1c\xe4\xd3\x88\xf0\x1d\xfe\xe4\x9d\x20\x48\xb0\x7b\xdd\x2e\xfe\x60\xab\x3f\x2c\xc3\x62\xcd\xf3\xec\x26\xd8\x70\x1a\x60\x32\x6d\x70\x48\xf0\xbc\x89\x9b\xc2\x7f\x30\x5c\xc4\x8e\xdd\xae\xc9\x8c\xbd\x49\x8c\xab\xe8\xeb\x74\xbb\x2e\xca\x85\x21\x63\xf3\x81\x9a\x7b\x77\x01\x93\x0c\x95\xfa\x1f\xca\x22\x39\x1b\xca\xde\x50\x11\x48\x55\xc0\x93\x9b\x85\x73\xef\xe1\xb0\x09\x01\x9d\xfb\xf2\xb8\x70\x7a\xee\xaa\xf7\x7d\xb8\x9f\x0f\xb6\x98\xbc\x72\x3d\x6f\xb0\x4d\x37\xcb\x30\x4e\xdd\xf3\xcb\x67\x80\x1d\x1d\xc7\x13\xc5\xee\xc7\x34\x4c\x3e\xfa\x9d\xa1\x48\xf1\xb0\xa9\xc0\x70\xfd\x20\xc2\x7d\xbc\x2e\xcb\x8d\x0d\x88\x47\x61\xd6\xa3\x65\x83\x3b\x2a\x09\x16\x11\x06\x87\xeb\x48\xd5\xc8\xa9\xf1\xe9\xaf\x9a\x28\x48\xfd\x85\x4f\xa4\xd3\xb8\xb0\xdb\x0d\xa1\xa6\x70\xc0\x47\x17\x56\xf4\x1a\xd6\x73\x5b\xc4\x2d\xbb\x0f\x0b\xeb\x03\xc8\x55\xb3\xd6\x31\x0b\x13\x7a\xb0\xd7\xfa\x9b\x70\xbb\x4b\x5f\x2c\xcb\x70\xef\x46\x5e\x6f\x04\x3b\x10\xab\xb5\x96\xa8\x59\xf5\x18\x40\x4a\x41\x67\xc7\x1a\xd8\xe1\x10\x0e\xd6\x65\x92\xbe\x83\x57\x86\x55\x1e\x10\x64\x79\xb2\xf5\x11\x7e\x1b\xda\x7b\xb4\xdb\xed\x5c\x31\x5e\x0b\x85\x63\xa5\x3b\x9e\x95\x63\x7f\x60\x4e\x23\xda\x2b\x0e\x74\x56\x25\xbc\xc9\x1c\xab\x25\x0b\xbc\x15\xc8\x30\x3c\xc1\x14\x46\x87\x83\x6e\x35\xa2\x15\x79\xbe\xda\xec\x3f\x9e\x1c\xb1\x55\x97\xae\x5f\x3e\xc0\x7c\x61\xdb\xa7\x56\x0d\x96\x6b\x12\xf6\x1c\xc7\x6f\xec\xe7\xf0\x70\xb0\x01\x44\xa5\x4e\x8a\xe9\x42\x76\xcd\x03\x74\xa0\x3e\xf3\x55\x3e\xec\x95\x65\x19\x85\xcb\xe7\x1f\xc2\x65\xb3\xa7\x80\x68\x53\xc8\x18\x87\xb8\x2c\xdb\x62\x05\x19\x02\x20\xc1\x1d\x11\xdc\x48\x02\x05\xa8\x22\xa0\xf8\x60\x42\x0b\xa8\x18\x76\x6e\x14\x24\x83\x78\x9b\x86\xfb\xf4\xf9\x32\x45\xfc\xe0\x3a\xbb\x78\x5b\x6c\x20\x13\x0e\x3f\x3a\xf9\x42\x91\x0c\xe6\xb0\x59\xf0\x24\x81\x0d\xf7\x74\x5e\x2c\x13\x00\x98\x01\x80\x0f\x94\x7f\x0d\xab\x0e\xfb\x6b\x55\x7e\x48\x55\x8e\xe7\xc7\xb8\xee\x47\x11\x87\xab\x74\x89\x04\x45\xdb\x0c\x85\x7a\x57\x6e\x84\x03\xb4\x8a\x63\xb6\xe9\xb5\xd8\xd2\x8e\x4b\xd2\xd7\x50\x83\xdf\x4a\xb0\x30\xbc\x61\x3e\x02\xbb\x7a\x06\xa8\x7f\x55\xde\x2a\x2a\x06\x57\xbb\x9a\xd2\x38\xb7\x35\xcd\x07\x08\x70\x88\x10\xba\xc3\xae\xdf\xe3\xa2\xc7\x30\x6b\x8c\xcb\xc7\xf1\x45\x32\x4e\x18\xa1\x46\x72\x81\xa6\x09\xe0\x4c\x81\x3f\xd8\x4c\x67\xe4\x45\x30\x8b\x57\xc7\x74\x09\xd3\x8b\x5f\x27\x0c\x32\x9f\xfd\x42\xc1\x27\x2c\x2e\x2e\xda\xa7\x60\x09\x20\xc9\x45\x78\x32\x13\x55\x22\x2e\x43\x1a\xe3\x2a\xad\xd1\x5b\x16\x31\x0b\x00\x3f\x9d\x8d\xeb\xd8\x04\x06\x2a\xf1\x30\x8c\x77\xa2\xc8\xa3\x58\x38\x3b\xc2\x1a\x36\x54\x22\xa5\x15\x7a\x7e\xce\xe3\x88\x81\xac\xf1\x44\x0c\x1b\x67\xdd\x6c\xd3\x22\x59\xb8\xd7\xd1\xa4\x3f\xf2\xe7\x8a\x7a\x09\x21\x1f\xba\x8b\x4d\xd5\xba\x8a\x53\xc6\xdd\xed\x45\xea\x04\x85\x15\x01\xca\xbb\xb1\x08\xe1\x34\xed\xf5\x66\x41\x04\x13\xa9\x27\x4f\x96\x09\x52\x81\x9b\x04\xa6\xa7\xd1\x2b\xd5\x00\x1c\xe3\x48\xde\x67\x50\x77\xae\xeb\x86\x53\xb4\x13\x8f\xf3\x8b\x6c\x9c\x41\x03\x49\xd0\x01\x0e\x62\x9a\x41\x29\x4f\x24\x80\xe6\xe6\xdd\x6e\x4a\xb4\x0a\xa5\x6a\x94\x92\xd6\xa9\x3b\x6e\x49\xb6\x02\xd5\x0f\xa1\x5e\x98\x7a\x05\x55\x04\x16\x66\x3c\xc9\x45\x3e\xce\xa1\xb9\x34\xc0\xd6\x72\x38\x7b\xe1\x6b\xc1\x0b\x04\x40\x3d\xe7\x16\x53\x6f\xac\x61\x2a\x67\x98\xfa\xec\x07\xb2\x7f\x99\xa4\xfd\x60\xb8\x73\xa4\xb5\x6f\x8a\xc4\x1f\x09\xc0\xa1\x77\xad\x80\x02\xc0\x99\xc9\x4f\x1b\x40\x10\x01\xc0\xc4\x4c\x21\xc0\xf1\x22\xc2\x00\x1b\xb6\x29\x92\x10\x70\x49\x12\x48\x7a\x5c\xd3\x1a\xe2\x91\x07\x33\xdd\x24\xdf\x42\xd9\xb3\x88\x09\x37\x40\x30\xcc\x53\xb9\xf5\x0a\x3c\xa2\x54\x07\xd8\x75\x98\x38\xeb\x07\x0f\x19\xfc\xed\xf5\x60\x91\x24\xb1\x80\x28\xe3\xd6\x7f\x06\xb8\x0c\xf0\xc1\xad\xd8\xdd\x6c\x90\x0b\xf5\x97\x40\x25\xb5\x60\xdb\xb7\x1f\x57\x51\xb9\x24\x1a\x27\x5b\x4f\xf9\x6d\x50\xec\xd3\x6d\x08\x27\xcd\x2c\x88\x1b\x49\x38\x62\xa2\xed\x9d\x6f\x98\xa0\x3b\x83\x13\x38\x4a\xb7\x67\x7c\xce\x9e\xa9\xb9\x38\xa3\x6d\x71\x86\xfd\x38\xfb\x31\xcd\x9f\xdf\x6d\xce\x78\xa7\x31\x35\x21\x1b\x76\x88\x04\x05\x74\x7b\x06\x98\xb6\xba\x1a\xc5\xd4\x99\x32\xea\x3f\x73\x7a\x51\xcf\x99\x39\xb3\x06\x1e\xf3\xc6\xea\x9b\xb3\x9d\x39\xf8\x3b\x1d\xd8\xd9\x0e\xc3\x97\x83\xb0\x82\xb8\x51\x93\xe1\xfa\xb4\x1e\xb7\xd0\x28\x71\xed\xd8\x9e\x74\x46\xbe\x13\xe2\x48\x64\xae\x3c\x3b\x9d\x35\x0d\xba\x02\x1a\xd1\x05\xd2\xee\xfd\x11\x81\xe7\x11\xbb\xb2\x0f\x5a\x8e\x27\x45\x42\x8b\xb9\x28\xc4\x42\x5c\x89\xa5\x58\x89\xb5\x28\xc5\x46\x00\xc6\x17\x3b\xb1\x17\x37\x81\xb3\x2b\xfe\xf8\x63\x99\x3a\xbd\xd1\x43\x24\xc0\x70\x16\xc5\x07\x9b\x17\xbf\x85\x6d\x75\x07\xff\x3e\x06\x79\x08\xdc\xdb\x1f\xfc\xf3\x84\x7f\xbe\x69\xe7\x6e\x91\x74\x42\x10\x5e\x06\x9d\xa1\x27\x00\x50\x9e\x06\xa3\xc7\x8f\xbf\x1c\x89\x67\x40\x43\xd7\xb9\xf3\xe7\x88\x1f\x5e\x04\xcf\x07\x9b\x72\x23\xfe\x8e\xbf\xc8\xe4\x7f\xab\x1e\x5e\xc2\x03\xcb\x02\xbe\x0b\x4e\xe1\xb0\xa1\xa8\xec\xf3\x78\x1c\xf3\xe1\x11\x02\x5f\x83\x5d\x91\x94\xc4\x59\x2c\x57\xa2\x3f\x3a\x8a\x7f\x04\x4e\x3c\x4f\xe3\xab\x34\x39\x30\x4b\x0d\x0f\xe1\xee\xe3\x3a\x3e\x84\x37\xfb\x32\x83\xe1\xef\xe8\x09\x30\xff\xc7\x03\x32\xa2\xdb\x72\xb9\x3b\x24\x69\x96\x6e\x0f\x49\xb1\x0b\xa3\x25\x7c\x30\x2f\x92\x24\x5d\x1f\x8a\x1d\x20\xa6\xc3\x12\x48\xd6\xc3\xea\x66\xb9\x2f\x36\xcb\xf4\x00\xa3\x5b\x1f\xe0\xc4\x49\xca\xf5\xf2\xe3\x41\xca\x54\xa0\xad\x18\x32\x12\x47\xbc\x0a\x9c\xe9\xe5\xe5\xdd\xa3\xe1\xe5\xe5\xfe\xf2\x72\x7b\x79\xb9\xbe\xbc\xcc\x66\x8e\xf8\x3e\x70\xdc\x89\x7f\x09\xff\x1b\x1c\xa0\xc0\x6d\x7f\x76\x98\xfe\x0e\x05\x87\xc3\x3e\xfc\x0d\x87\x33\xaf\xe7\x88\xd7\x81\x73\x79\x39\x75\x7a\xaf\x7a\xce\x43\xd7\xe9\x7d\xdf\x73\x3c\xf8\x48\xbe\x4f\x1f\xfe\xfe\xe0\xd0\xf9\xd7\x6c\x12\x78\x32\x65\xe2\x7f\xe1\x9a\x4a\x7f\xc7\xdf\x2f\x66\xde\x43\xef\x8b\xc3\xa5\x53\xcf\xb8\x74\x30\xe7\xd2\x39\xc8\x7a\xbd\x83\xac\xe5\xf2\x12\x7a\xf7\x26\x80\x33\x51\x37\x78\x79\xe9\xba\xee\xbf\x5f\xb5\x77\xa8\xe7\xb8\x1e\x0c\x75\x36\x3b\x38\xbd\xd7\x50\xf3\x43\xef\x30\x80\x72\x97\xd8\xb4\xf8\x21\x40\xb0\xe4\x7d\xed\x42\x3f\x60\xf4\x4e\x0e\x5b\xf8\x9f\x76\xba\xf3\x3b\xf5\xb1\x47\x15\xff\x2e\x2b\x9d\x79\xaa\x15\xa8\x91\xf3\x1f\xc8\x8f\x7f\x6c\xf9\xf8\xa1\xe0\x1f\xc8\x7e\xdb\x96\xed\x4e\x2f\x7a\xff\xc2\x2e\xc2\x8b\xa7\x8b\xbe\xab\x14\x0d\x54\x51\xe8\xc0\xec\x0b\x18\xef\xc3\x89\x3d\x7b\xd4\xf6\x4f\xf6\x17\x6f\x3c\xf1\x73\xbd\x31\x98\xdc\x07\x50\xee\x97\xe0\xfe\xe5\x33\xbf\x92\xf7\x17\x39\xf5\x90\xfb\xf4\xd5\x93\xb7\x6f\xab\xb9\x30\x50\x93\xff\xee\xc9\xdf\xab\xb9\x9c\x75\x98\x3e\x9c\x61\xf6\x93\x77\xef\x7e\xf4\x6b\xed\xbe\xf6\xc4\x0f\x6f\x9f\xff\xf4\xec\x4d\x3d\x03\x3a\xf9\xf4\xdb\x97\xaf\x6a\x9d\xf1\x5d\x02\x6c\x12\x6b\x1c\x50\x70\x71\x58\xef\xe7\xf8\xaf\x8f\x2f\x5e\xdf\x8d\x91\x3c\x3d\x94\x59\x1f\x11\x97\x04\x17\x39\x3f\xe9\x07\xd8\x1b\x65\x92\xc0\x7a\x4d\x7b\x00\xe1\x9e\x7b\x79\x99\x3c\xf4\xd6\x07\x03\xb1\x32\x43\xbe\x43\x76\x0f\xc0\x41\x4f\x26\x81\x86\x53\x20\xd1\x0c\x67\x43\x6d\xa4\xb8\x13\xfe\x01\xd3\xf0\x40\x16\x59\xa7\x69\xb2\x7b\xca\xa2\xa4\xfa\xd8\xb0\x3a\x5e\x58\xdf\xf4\x2a\xbd\x3e\xe4\x30\x26\x1e\x91\x19\x60\x75\x0c\xf0\x02\x3b\x32\xf1\x26\xd4\x75\xab\x63\xee\x24\x98\xfe\x0e\x7d\x7f\x20\xbb\x78\x14\xbf\x06\xe7\xd8\xab\x62\xbd\xb9\xd9\x4b\x64\x73\xc0\xce\x00\x41\x1f\x1e\xa2\x9b\xfd\xbe\x5c\x7b\x0f\xce\x0b\xf1\x1b\x94\x9b\x5f\x26\xf8\xf8\x5f\x28\x78\xfc\xfd\x7e\xd6\xbb\xbc\xbf\xdc\x3d\xbc\x9c\xae\xc3\x7d\xf1\x21\x3d\xbb\xbc\x3d\x17\x0f\xb8\xb6\xbf\xb8\x53\xc4\x0e\x30\x2d\xee\xe5\x2d\xfc\x85\xd5\x97\x09\x50\x97\x78\x1f\x9c\x4f\x61\x54\xe7\x22\x0c\x83\x73\xd8\x8c\x97\xc0\x87\x47\x61\x05\xd4\x68\xe3\xc1\xbe\x4b\xc2\x7e\x36\xbb\x1f\x89\xbf\x1e\x69\x10\x93\x03\x8f\x10\x36\x21\x0d\x00\x61\x36\x0e\x83\x56\xaa\x2b\x70\x86\x77\x70\x64\xf6\xff\xfa\xf5\xd7\x5f\xfe\x55\x91\x41\x48\xc1\x01\xbd\x10\xa3\xf8\xe9\x22\x99\xf0\x69\x3d\xc8\xb6\xe5\xea\xe9\x3c\xdc\x3e\x05\xce\xc1\x4d\x7a\xf4\x85\xe7\xb7\x66\x5e\x5c\x8c\x86\x87\xaf\xbf\x7e\xf4\xb7\xbf\x8a\xd1\xf0\xd1\x97\xdd\xe4\xf0\xf5\x5f\xbf\x7c\x84\xf2\xb1\x24\xb4\x89\x9b\x15\xca\x4d\xf7\xdb\x8f\xf7\xdf\x4a\xf2\xe6\x79\xf0\x92\xe9\x99\x0f\x03\x02\x3e\xe4\x95\x76\x9e\xa8\xbe\x3d\x9f\xda\xef\x4a\xc4\xa9\xb9\xe9\x23\x90\x45\x40\x73\xa4\x70\x8c\x7e\x1b\xdc\x53\xbd\xfe\x73\x59\x6a\x52\x3d\x7f\xfe\x2e\x9b\x0d\x85\x6c\x16\x58\xb1\x63\x2b\x4b\x10\x5a\x24\xf6\xf8\x16\x1a\x4f\xf1\x68\x92\x54\x35\xfc\x78\x63\x4d\x51\xc7\x70\x36\x1d\x8f\x9a\xd8\xc8\x42\x9a\x70\x38\xc9\xb9\xae\x0c\x0e\x73\x3e\xca\x4b\x3a\xc2\x6f\xf1\x9c\x8d\x06\xe5\xed\x3a\xdd\x3e\x53\x47\xf6\x1d\xb0\x01\x91\x1e\x91\xff\x37\xa4\x88\x13\x5c\x13\x38\x68\x15\xa9\xd9\xb1\x78\xe3\x0e\xfc\x1b\xc1\xaa\xdd\x75\xbb\x7f\xe3\x9f\x11\xbd\xaa\x63\x33\xc1\x0a\x3a\x40\xf2\xba\x2e\x56\x5c\x69\xec\x70\x88\xfc\x0f\x1e\xca\x26\xba\xdd\x15\xcc\x00\xd0\xab\x40\xb5\xac\xc5\xc6\xa3\xfb\x86\x91\xac\xd7\x2d\x83\x07\x83\xf4\x2e\x25\x4e\x15\x8f\xe6\x2c\x28\xa7\xa3\x19\x95\xf9\x5b\x80\x6d\xd1\xed\x84\xbb\x00\xca\x2b\x4f\xf7\x92\x39\xfe\xe6\xe3\xcb\xc4\xcd\x3c\xaf\xd2\x91\xc5\x00\xa8\xd2\x20\xc8\x74\x22\xd3\xe1\x0b\xe0\x1d\x98\x1d\x84\x32\xb7\x24\xf1\xbb\x6d\xa9\xaa\xdb\xdd\x03\x63\xb4\x80\xdf\xcf\xd5\x83\x1d\x2a\xa7\x8f\x66\x2a\x5f\x41\x59\x22\xec\x2e\xee\xbe\xf9\xf8\x2e\xcc\x91\x17\xc6\x91\x09\xea\x21\x0d\xee\xcb\x19\xb4\x11\x57\x4b\x3e\x05\x34\xb2\x63\x1e\x3a\x3a\x91\xf3\xd9\xd6\x74\x49\x1c\x0d\x74\x15\xda\x8b\x07\xd7\x3b\x20\x3d\x3b\x4f\xa6\xc0\xae\x9e\x39\x33\x18\x7c\xe7\x1a\x96\xf5\x7a\xb0\x4f\x77\xc4\x70\xf2\x5a\xd0\x9a\x02\xc4\x88\x5d\x10\x8e\xd5\x54\x59\xe2\xa5\xe8\x04\x5f\xef\xdd\xbb\x57\xbc\x2e\x4f\xf6\x00\x3d\x80\xb1\x52\xd7\x29\x12\x20\x0e\x26\x57\xc1\x95\xe6\x8e\xc3\x50\x00\x52\x79\xd0\x75\x3c\x3f\x1a\xec\xea\x85\xc5\x55\x70\xe3\x89\x6d\x90\xa3\x9c\x64\x1e\x6c\xd5\x96\x58\x06\x3f\x73\x37\xaf\xbc\x89\xf3\x17\xa7\x77\xe5\x3b\x53\x58\x98\x2f\xe0\xa9\xe7\x7c\x31\x73\xe4\x7e\x41\x41\xeb\x76\x3a\x9f\x05\x4b\x1c\x62\xef\x3a\x74\xf1\xcd\x1b\xef\xa0\xa6\x45\x59\xac\x5d\xc0\x55\x1e\x6c\x87\xf7\x6a\xcc\xdd\x6e\x19\xba\x91\x25\x35\xf1\x00\x54\x71\xb6\x76\x1e\xe2\x8c\xc6\x34\xdf\x0e\xe8\xbe\xe5\xad\xbc\x5e\x79\x02\xfb\x79\x47\x13\xcc\x08\xe1\xa3\x77\x7f\xcc\x8a\x35\x6c\xf3\x8f\xf7\x57\x00\x36\x37\xb8\x84\x2c\x87\xa9\x4d\x0a\x6c\x5f\x59\x79\xe1\x1a\xa1\xcb\x3f\x85\xf3\x60\x84\xe7\x15\xed\x65\xb3\xc1\x91\x8c\x66\xa9\x37\xb2\xae\x3a\x39\x02\xbe\x3f\xb5\xf8\x37\x02\xcd\x18\x87\xee\x5d\x00\xf7\x06\x6c\x51\xfa\x8a\xe6\xaf\xdb\x4d\xa0\xc7\xc0\xfa\x44\xd3\x70\xb0\x9b\x17\xd9\xde\xf5\x80\x69\x9c\x52\xd9\x59\x90\xaa\xbe\x44\xa6\xc9\x79\x68\x8b\x85\xa6\x37\x33\xa0\xd2\x81\x8f\xd7\xf9\x45\x68\xdf\xe3\xd4\xa4\x55\x49\xf1\xc1\xf1\xc6\x66\x06\x81\x05\x82\x5d\x2f\x27\x49\x4b\x23\x3a\x23\x3d\x59\xf6\x12\xe0\x9c\x9d\x14\x63\x01\xe6\x40\xde\xda\x42\x7e\x8b\xb0\x8a\x44\x25\x07\x77\x80\x69\xc4\x1b\x42\x49\xef\x33\x7c\xa4\x00\x1f\xc9\x20\x84\xa5\xf8\x36\x5c\x27\xcb\x74\x1a\x4f\xd3\x19\xe0\x57\x53\xdb\x55\xa5\x36\x40\x9b\x21\xa0\xe3\x18\x70\x1d\xc9\xeb\x14\xb6\xe4\xf7\xc8\x7a\x77\xff\x05\xd0\x5c\xde\x6c\xe3\xf4\x25\xca\xf4\x0e\x87\xa7\x40\xdc\xfc\x2b\xac\xa7\x11\x92\xad\x20\xaa\xd8\xe3\xae\xc5\xd0\xd7\x35\x1c\xf7\x6f\x8b\x68\x09\xa8\x17\xb1\x5f\x6c\x71\x25\xfd\x91\x16\xab\x4c\x46\x3e\xa0\x7f\xdd\xe3\xa5\xbd\x50\xe6\x9e\x4a\x0f\xe1\xc4\x76\x55\xec\x26\xd1\x1b\xc4\x4d\xe2\xbc\xd3\xa5\x22\x8c\xd4\x9a\xdf\xd5\xff\xa8\x7e\xd7\x6a\x00\xf8\x54\xa6\x63\xe8\xcd\x3b\xd1\xde\xda\x6e\x0f\xa0\xd0\x6e\x52\x81\x69\xd0\x8b\x84\x9d\x05\x5c\x2c\xf7\x07\xef\x82\x43\x94\xad\xa8\x85\x87\xb5\x14\x79\x90\x55\xc1\x20\x07\x30\x80\x95\x0f\xb2\x69\x3e\x43\x3c\x88\x50\x10\x74\xdc\x04\x7f\xf0\x19\x4e\x68\xfc\xbf\xee\x52\x59\xd9\x0b\xc0\xcc\xb7\x5c\x62\x87\xad\xe8\x1e\xe0\xe7\x18\x07\x19\xc0\x01\xcb\x3d\xf0\x8e\x39\xc3\xf7\x62\xf7\xeb\xf7\xaf\x9a\xfc\x38\x09\x01\xc3\xfa\xe1\x19\x7a\x9a\xd3\x96\x2d\xe8\xcb\xd1\x89\xf3\xed\xbb\xef\x5f\x55\xf1\xb2\x0f\x1b\x4b\xac\xa8\xd5\x74\xaf\x6a\x69\xe1\xfd\x51\x1a\x16\x4e\x9a\xad\xf9\x1f\xf4\x95\x0e\x1f\xd9\x78\xf6\xe6\x16\xb0\xe7\xf5\xee\x4c\xdc\x75\x90\x8b\x12\x10\x41\x2d\x43\x6c\x82\x4e\xe6\xae\x3d\x41\xf7\x53\x30\x69\x21\x70\xbe\x3f\x17\xe9\xad\x87\xd2\x3b\xe0\x9b\xa1\x01\xdc\x3d\xe9\x20\x4c\x92\xe7\x40\x5a\xef\x5f\x15\xbb\x7d\x0a\xfd\x99\x34\x93\x50\x79\x60\x59\x86\x70\x4a\x24\xa1\xe8\x8c\x3c\x3f\xc5\xad\x0c\x58\x8e\x4a\x61\x85\xd6\x2b\x1c\x59\x6b\x53\x1c\x85\xa3\xb4\xef\x09\x05\xef\x82\xc2\x02\x1e\x5b\xfa\x1d\xab\x73\x33\x00\xa2\x5c\x74\xc2\xda\x71\xa6\xb3\x01\x81\x63\x8d\x6d\x4b\x7e\xb2\x6e\x5b\x52\xaf\x30\xe6\xd3\x72\xc5\x18\x13\x8e\x49\xd9\x5c\x93\x64\x40\x1e\x52\x02\x70\xb3\x55\x7d\xd2\x07\xff\xc5\x87\xda\xfa\x14\xcd\xc0\x5f\x22\x89\x73\xa2\x8b\x65\xa5\x8b\x00\x74\x70\xc2\xde\x88\x4e\xad\x42\xac\x0b\xc8\x86\x96\x54\xf7\xa6\xde\x4d\x6c\x6c\xe2\x26\x03\xd8\x2b\xc9\xe0\xe5\xb3\x9a\x68\x06\xe9\x8a\x96\x9d\x54\xa7\xed\xba\xdd\x8d\x41\x36\x35\x5a\xcd\x5c\x25\xc5\x93\x69\x8c\xb7\xd3\x47\x60\x00\xa0\xbd\xe5\x3e\xdd\x56\x5b\xd4\x3b\x4c\x9f\xb6\x51\x08\x3c\x8b\x11\xcc\xb6\xad\x59\x93\x9a\x41\x9c\x7c\x3c\x7a\xbe\x2b\x4f\x54\x3d\xb8\xff\x85\x66\x79\x90\x27\xb1\x8b\xee\x09\x9f\x92\xcd\x34\xee\xa1\x9e\x11\xa4\x2c\x3f\x84\xcb\x9b\x54\xf6\x59\xc8\xbe\x02\xdb\x1f\xb4\xc3\xee\xa4\x4d\x50\xf7\xb9\x35\xb2\x3e\x3f\x49\xf3\xfa\x44\x7d\x42\x7e\x83\x7c\x0a\xb5\xa0\xf8\xc4\x05\x10\x20\xf3\x34\x18\x02\xd6\x3c\x59\x39\xdd\x7f\x3e\xc4\x43\x05\xe6\x50\x9d\xa6\x19\x5d\x48\x78\x78\x4e\xc7\x16\xea\x92\xf4\x7b\xac\x67\x29\x51\xb4\x4f\x76\x54\xf3\x43\x62\x93\xfa\x0c\x59\x54\xf9\x7f\x73\x92\xac\x1a\x36\xf5\x99\x32\xf4\xba\x99\x0f\x20\x83\x61\xec\xd7\xf8\x87\x89\x77\xb3\xc3\xeb\xb3\x88\x1c\x8b\x5b\xdb\xd4\xcd\xdd\xbc\x06\x1c\x8a\xa7\x45\xe0\x3c\x0e\xcf\x98\x7a\xbe\x01\xea\xf9\xe2\xf1\x79\x78\xf1\x98\x25\x0e\x26\xb9\x8f\xe2\xc6\x2f\xce\x56\x3b\xa0\xcf\xca\xdb\x38\xdc\xc0\x28\xd3\xe0\x0b\x28\x5d\x6e\x58\xc2\x2d\xe5\xa1\x94\x76\xce\x89\xf0\xc0\xc9\x17\x8e\x08\x9b\x6b\xed\x4c\xab\xd5\xfd\x0e\xdf\xce\x34\x6e\xeb\x76\xaf\x79\x71\x1c\x14\x4e\xce\x02\x23\x97\x44\x39\xe1\x25\x49\xac\x5a\x2b\x55\x3d\x31\x55\x1d\x0e\xaa\x2a\x23\x01\x9d\xf8\xb4\x17\x0e\x2c\xf6\x39\x55\x57\x91\xfc\x2b\xe0\xf1\xb7\xd5\x06\x79\xed\xdf\xf9\x52\x4a\xdc\xf2\x8d\xc9\x6a\xfd\x32\xfc\x0b\x35\xd7\x7b\xd8\xf2\xe9\xe0\x2f\x83\x1e\x0a\x68\xe8\xb4\xa9\x2d\xef\x09\xca\x9b\xa9\x2d\x6f\x5c\xe7\xad\x10\x24\x81\xf9\x61\x51\x34\x75\xa5\x76\x71\x5c\x2d\xbe\xc6\x33\x4e\x38\xcf\x4e\x4d\x13\xe6\x07\x49\xdb\xda\xd1\x97\x2c\x31\xd3\x22\xe6\x53\x93\x96\xae\x49\x40\xde\x36\x69\x2a\x4b\x38\xbe\x92\xa3\x9f\xa8\xe5\xa1\xf0\xef\x20\x4b\x7d\x29\x06\x0f\x7d\x9c\x2f\x0f\x37\xcd\x0a\x19\x8e\x74\xa7\xca\xab\x0d\xb4\x0b\x4a\x95\x75\x38\x94\x83\xdb\x34\xba\x2a\xf6\xdf\x57\xcb\x62\xc6\xaa\xfc\xa3\x25\xb5\x6c\x2b\xb9\xab\x25\xe2\x8e\xac\xad\x58\x3c\x80\x91\xc4\x25\xec\x42\x04\x56\x2a\x1f\xec\xb4\xaa\x05\xb1\x4c\xc2\xbc\x4f\x77\x1d\xdc\x1d\x34\xb6\xad\x1c\x5b\x27\x70\xc4\x1b\x84\x85\xeb\xe0\x5a\x4f\xbc\x25\xad\xbb\x96\x4c\xee\x01\xa9\x89\xad\xe6\x9e\x2b\x65\xb6\x76\x99\x48\xcd\x48\x39\x88\xcb\x15\x32\x5d\x8a\x0c\xfc\xa1\xdc\x15\xd8\x71\x4f\xec\x51\x54\x63\x15\x5b\xef\xc3\x62\xbd\xf3\x26\x6d\x22\xac\xbf\x55\xb8\xa4\x49\x58\x27\x07\x7d\xe4\xa6\xa2\x2a\x83\x37\xb6\xae\x83\x12\x20\x2c\xdc\x4e\xc2\x82\xa6\xc4\x52\x5e\xe9\xb8\xb1\x6e\x7a\x62\x1e\x81\x97\xf2\xc3\x53\x5d\x07\x26\xed\xaf\xdd\x93\xb9\xa4\x24\x55\x3f\x73\x50\x31\x40\xf2\x63\x51\x50\x11\x0b\x60\x0e\x1d\x30\x4a\xcb\x64\xac\x79\x58\xf1\x4d\x10\x4d\x1a\xf5\x84\xf6\x95\x12\x5e\x71\x89\xe1\x98\xc5\xa1\x9d\x93\x7d\xea\x77\xa2\x53\x59\xfa\xb8\x9a\x24\x40\x7a\x04\x6d\x3c\x02\x34\xe8\x36\xe5\x6e\xde\xe4\xf4\x14\x44\x9e\x3f\x12\xa3\x2e\xce\x3a\xab\xe2\x3d\x4b\x91\x7e\x4e\x13\x5c\xa1\x53\x1f\x51\x43\xc9\x04\xc7\xb7\x46\x0d\xa3\x4a\x83\x90\xf8\x01\x85\x67\x1f\x44\xe8\xa1\x92\x41\xc4\xa5\xa2\x53\xa5\xa0\x7b\x23\xff\x6a\xf2\x9d\x7b\x05\x1f\xf4\xf1\x07\xfa\x34\xf4\xbf\xea\x26\xf8\xf5\xa8\x6d\x81\x4e\x4d\x6c\xac\x55\x13\xcc\xb2\x11\xd5\x60\xbd\xe6\xc1\x34\x9c\xe1\xfd\x7f\x34\x63\x69\x25\x8c\x5c\x8b\xf6\x68\x44\xba\xd3\xd0\xaf\x14\x5f\xb2\xb6\x0e\xe2\xc7\xa9\x2d\x16\x94\x62\x83\x71\x1c\x84\x63\xc3\xcf\x5b\xf0\x93\x0f\x6e\xd6\x2c\x78\x89\xb1\x54\xd4\x5e\x6a\x6e\x97\x92\xbc\xea\x34\xc1\xdb\xc9\x39\x6a\xa8\x24\xbd\x9e\x81\x03\x68\x12\xf3\x04\xe5\xf8\xb2\xd8\x07\xd2\xec\x50\xcf\x23\x1f\xaf\xdf\x3d\x7f\x0d\x4c\x67\xa8\x30\x5e\xfb\x65\x2c\x89\x90\x51\xb2\xc2\x7f\x50\x07\xcb\x7c\xa2\xf1\x67\x63\x31\xda\xe0\x50\x89\x79\x43\x12\xf3\x6a\xb2\xf7\x9d\x70\x82\x2f\x1e\x8c\xf0\xc0\x17\x0d\xcc\x0c\x24\x11\x89\x26\x23\x2d\x9a\x04\xb4\xda\xd9\x32\xd6\x89\x88\xbc\xb1\x85\x95\x90\x42\xf2\x39\xde\x50\x3b\xa3\x39\x46\xf2\x95\xc3\xa1\x05\xd5\x22\xa8\x2a\x6c\x24\x45\xd7\x26\x41\x63\x1a\x2d\x9a\x51\x12\x7e\xef\xfe\x68\x66\x28\x12\x6b\x9e\x1e\x80\x22\x75\x66\x5d\x0c\x69\xa6\x14\x46\x6a\x9d\xdd\xcf\xcc\x92\xd2\x23\x87\x6a\x90\x45\xad\x55\xf1\xe9\x8f\x09\xf4\xe1\x28\xb6\x85\x5a\x35\xb5\x04\xd4\xab\x01\x9a\xf3\x19\xcf\x92\x5d\x52\xd4\x4a\x7a\x93\x94\x2e\x12\x3a\x1b\x45\x84\x56\x15\xf5\xa0\xd9\x6c\x92\xf9\x36\x27\x0d\x6b\xb2\x99\xd4\xf8\x24\xd8\x21\x6e\x16\xb4\xb0\x27\xb4\x90\xd9\x60\xb7\x49\xe3\x22\x2b\xd2\x64\x92\x31\x7f\xe2\x93\x48\x0f\xc7\x4f\x6a\x9f\xc1\xa7\xd4\x3e\x9d\xb7\x1f\x61\xa6\xef\xce\xa8\xa4\x38\xbb\x59\x6f\xd3\xb8\xcc\xd7\xc5\x1f\x69\x72\x96\xde\x6d\xb6\xe9\x6e\x87\xca\x9f\x67\x4e\x2f\xe4\x29\xbd\x59\x17\x40\x34\xbc\x45\xe1\x4b\x53\x04\x62\xb1\x17\xb4\xa9\x01\x9f\x00\xec\x00\x5f\x17\xef\x9f\xdd\xa0\x36\x32\xd0\x56\x3b\x71\x15\x48\xfc\xf8\x76\x8f\x94\x08\xb2\x5e\xa4\x6c\xe0\x0e\x91\x24\xc1\x0c\xf7\x1b\x4f\x2c\x15\xf3\x01\xcc\xde\x34\x43\xe6\x83\x4e\x8c\x69\x86\xf0\x8c\x4b\x44\x87\x78\xe6\x79\x96\x30\x32\x94\x3a\xcf\x24\x7b\x12\x80\xef\x14\x3e\x21\x29\x27\xea\x4d\xa5\x28\xc7\x81\x99\x7c\x87\x5a\x7f\x6d\xea\x85\x8e\x43\x88\x2f\xb3\x0e\x5e\x1c\x49\xc6\xd2\x7c\x44\x52\x87\xc3\xdf\xf8\x67\x44\xaf\xcc\x78\x37\x34\xca\x48\xaf\x90\x2e\x40\xd7\x7b\x8d\x12\xed\x44\x52\xba\x0c\xa1\x19\xba\xec\x24\xc2\x71\x1c\x8e\x31\xc1\x96\x5b\xc6\xbd\x80\x94\x6a\xd5\xcd\xc1\x97\xdc\xf4\x57\x36\xb6\xe4\x9e\xfe\x8c\x4b\xcf\xe5\xcc\xbc\xd1\x45\x17\xd5\x11\x19\x46\x16\x38\x33\x96\x66\x31\xb2\xd8\x05\xf7\x96\x6c\xdb\xff\x7a\x28\x98\x08\xfe\x61\x97\xde\x24\xa5\x3f\x0f\x05\x61\x17\xff\x17\x61\x40\x1d\x75\x9a\x91\xb9\xc3\xdf\x6d\xba\xa4\x8b\x52\xff\xde\xb9\x70\xfc\xfb\xa4\xd8\xfa\x8e\x41\xc2\x8e\x54\xc2\x47\x45\x52\x40\x46\xcd\x7c\x48\xee\xe9\xe4\x6d\xfa\xa1\x28\x6f\x76\x72\xf4\x95\x6f\xff\x75\xaa\xd0\xf1\x28\x20\xe9\x05\x09\x0b\xfc\x7b\xba\x65\x6f\x13\x3e\x4c\x47\xb3\x00\xff\xd4\x04\x07\x22\x9c\x7e\x39\x03\x02\x00\xfe\x02\x2a\x98\x7e\x45\x7f\xbf\x46\xcd\x54\x4b\x07\x51\x16\x45\x86\x85\x60\xf0\x11\xc2\x20\x7d\x88\xf7\x22\xf8\x40\xb7\x04\x42\x03\xb2\xf8\x0a\x76\x0b\x5f\xe0\x7f\xb2\x2f\x15\x7c\x21\x9c\xf5\x7e\xce\x0d\x40\x96\xaa\xe9\x4b\x6f\x22\x7b\xa7\x36\x34\xbc\x0e\x67\xd8\xf1\xaf\x66\x41\xcf\xc5\x9f\x09\x76\x19\x1f\xff\x0a\xc5\x46\x9e\xff\xe8\xa1\xeb\xe0\xcd\x3a\x57\xf6\x25\xe9\xd9\x26\x89\x7a\xf3\xf0\xdb\xaf\xf9\xdb\xff\x6f\x06\xdd\xff\xcf\x46\x01\x1f\x7f\x00\xb9\xd4\x5a\x3c\x2a\x6d\x85\xb6\x9d\xd3\xc1\xe6\x61\x33\xc3\xec\x28\x50\xfb\x65\x40\x73\x20\xaf\x87\xb0\x8e\x09\x6e\x44\x9f\x06\x34\xc1\x92\x41\x75\xca\xfd\xb8\xdb\xfd\x89\x8b\xa3\x44\x1b\x60\x38\x77\x63\xb4\xa9\xe2\x97\xd8\xa8\xf8\x7a\x8e\x16\x4a\xf7\x23\xaf\xaf\x9e\x3d\x5a\x98\x21\xd6\x3b\x34\x73\x18\xe1\x88\xa1\xb1\xd8\x4a\xb1\x57\xeb\x4b\xa0\x5d\x11\xa0\x19\x84\x50\x8f\xe3\xf3\x12\xa7\x76\xf9\x3f\xcb\x4d\x26\x0d\x75\xc0\x4e\x45\x20\xf3\x6f\x6b\xf8\x42\xef\x58\xfb\xa4\xd9\xaf\x8f\xf2\x02\x52\xcb\xb0\x0f\x07\x98\x29\x5b\xe9\xc0\xfd\x5d\x6b\xd0\x40\x51\xd6\x34\x40\x4d\x09\x9c\x54\xbc\x49\x6f\xe9\x57\xc4\x6b\xd0\x82\xd6\x62\x23\x76\xb1\x5e\x60\xed\x3e\x2b\x66\xab\x8b\xd8\xa4\xfc\xd7\xf1\x68\xaf\x1d\x61\xc3\xd4\xf6\x6e\x45\xd5\x56\x27\xab\xab\x89\x40\x9e\xef\x6e\x62\x59\x37\xb1\x42\x6e\x3a\x41\xde\x0e\xe7\xcd\x8f\x26\x6e\xda\x43\xa4\xee\x70\xc2\x04\xe9\xcc\xd8\x57\xf9\x93\xb4\x43\xaf\xbf\xcb\x57\x00\x3f\xd4\x01\x4c\x35\x9c\xc5\x9e\xef\x3c\x34\x99\x76\xc6\x05\xd0\x86\xce\x03\x3b\x8f\xc1\xc9\xc0\x22\x37\xf5\x2f\x59\x04\xd5\x20\x7b\xa9\x86\xa2\x1f\x10\x1d\x7a\x84\x37\xea\x95\x1e\xec\xbe\x1e\x0e\xa9\x86\x53\x55\x73\x6f\x44\x75\xf7\x9c\xbe\xe3\xa3\xe4\x1e\xa0\xab\x89\x6e\x94\x45\x8f\x54\x62\x08\x08\xbb\x10\x9d\x66\xc0\x1e\x48\x78\x07\xd5\x6c\xec\xf4\xfe\x57\x78\x57\xec\x48\x25\x22\xea\x89\x9a\x5e\x3c\xf0\x12\x39\x45\x93\x26\xd8\x74\x3a\x36\xb7\x60\x01\x3c\xf6\xa4\xe0\x7e\x54\x74\x22\x83\x0c\xda\xcd\x27\x8e\x75\xe2\x39\x2d\xa7\xc0\x75\x95\xed\xd8\xa2\xb2\xf2\xa9\x8b\x32\xb1\x0b\x3a\x05\x10\xbf\x73\xe0\xb4\xd9\x1e\xe3\x9a\x4e\xe8\x4c\x91\x13\x1b\xef\x7e\xa5\x19\x86\x55\xb0\x9a\x6e\x66\xc8\x8e\xce\x27\xab\xd3\xfb\x6f\xeb\xe3\xc8\x57\x75\xba\x16\xea\x2f\x83\x0d\x4c\xd5\x7a\x49\x1a\xa4\xa8\x0f\x50\x76\xbb\x95\xe1\x1c\xf5\xfe\x47\xd5\x86\x60\x9a\x4f\xae\xad\x13\xdf\xbf\x1e\xe0\xf4\xd3\xf3\x4c\xe4\xdd\xee\x0e\x7b\x77\x2d\x96\xd0\xaf\x1b\xc0\x88\x2e\xfe\x90\x0d\xd4\x55\xb0\x9c\xae\x24\xf5\xf5\xf2\x19\x66\x55\xde\xa9\xcc\x22\xb8\x02\x52\x9a\x54\x4e\xd6\xc1\x02\xb1\x60\x10\xdc\x76\xbb\x0b\x38\x4e\x60\x36\xd6\xf8\xf4\x68\x26\x56\xf8\x74\x6d\xe9\xe3\x4c\xd7\x33\x3d\x1d\xbd\x1e\x52\xc2\xf0\x1f\x4c\x0b\xb4\x01\x5f\x05\x43\x0f\x45\x32\x9b\x72\xe3\x92\x12\x49\x75\x26\xba\xdd\x5e\x0f\x18\x80\x15\x71\x91\xf7\xd8\x7c\x30\xbd\x85\xc5\xdd\xcf\xc6\x6c\x2c\xa0\x29\x97\x1d\x60\x66\x98\xf8\xff\x3b\x43\x43\xb9\x0a\xd9\x1b\xfc\xf9\x71\xfc\x9b\x2b\x2e\x07\x4a\xc3\xf8\xef\x0d\x41\x4d\xce\x1e\x4e\x52\x9e\xaf\xaa\x75\xc4\xbe\x1f\xa4\x34\x0c\xe0\xb8\xf6\xff\x81\x6a\x32\x43\xe0\xea\xcf\x13\xb4\x59\x3a\xb6\x9c\xbc\x46\xaa\x4f\xd4\x30\x51\x6b\x3b\x9a\xa4\x04\xc5\x8f\x4c\x10\x41\x42\x8d\x7d\xb1\xe8\x08\xc0\xd9\xf2\x22\x15\xa8\x7d\xae\x80\x29\x7d\xad\xfd\x0f\xe3\x9b\x10\x07\x92\x2a\xfe\x6c\x34\x01\x2e\x7b\x1a\x8a\x50\x00\x52\x45\x9b\x3a\xab\xad\x9a\x96\xb1\x1b\xd6\xf9\x21\xfb\x92\x39\x34\x76\x04\x59\x40\x8c\xd2\x89\xab\xe5\x24\xf8\x0e\x0f\xa9\x69\x4e\x34\x4f\x82\x57\xcb\x31\xfe\x50\xca\xd1\x6b\x3b\x56\xb1\xba\x21\xda\x61\x40\xc7\x91\x3c\xe4\xb9\xf1\xef\xd7\xe5\xde\x9f\xb7\x09\x7e\xf1\x8a\x9b\x2d\xa2\xe7\x4d\xfd\x11\x73\xb9\x81\xd3\x51\x1d\x03\x62\x36\xad\x26\x96\x07\x89\x62\xfb\x53\x31\x9d\x21\x1a\x0d\xab\xe3\x41\x8d\x1a\x60\xe6\x72\x54\xa3\x21\x4a\x65\x8e\xc3\x89\xf0\x27\xf3\xaa\x83\x41\x3d\x72\x73\x1e\x13\x49\x23\x12\xe4\x99\xb1\xfa\x0c\xad\x22\x28\x91\x5e\x81\x9f\x22\xb8\xc6\x5b\x29\x58\x83\xfa\x18\x5b\xb4\x0d\x6c\x39\x45\x64\x31\xdf\x78\xd5\x28\x79\xef\x13\xb5\x84\x0d\x4a\x48\x34\x2b\x76\x23\x9b\xd5\x41\x89\x15\x5d\x97\x20\xd7\x05\xe7\x19\x72\xae\xfa\xcc\x0b\xf1\xcc\xc3\x76\x97\xe1\x3a\x3f\xd1\xa6\xd4\x56\x0a\x89\x52\x38\x05\xc0\xf4\x3d\x81\xaf\x68\xf6\xb1\x76\x48\x34\x14\x2f\xc6\x49\x79\x46\x3a\x22\x78\x97\x44\x35\xd5\x75\xaf\xee\x56\x4b\x1f\x33\xb0\x03\xf5\x3c\x4e\xd7\x1a\xef\x40\x67\x56\x9b\x43\xd5\x93\x90\xcd\x0b\x0c\x09\x1b\xe2\xf1\xad\xb8\xd7\xba\x80\xb4\xae\x12\xe3\x19\x01\x29\x4c\xd5\x3e\xdc\x56\xac\xca\x6d\xa5\xc7\x32\x0e\x59\x5c\x6b\x9e\x71\x5f\xce\x2b\xf7\x98\x7c\xd2\x8f\xd8\x7a\xac\x48\x80\x89\x2b\xcb\x56\x2b\x75\x94\xe2\x95\x40\x1a\xa3\x6a\xfe\xa9\xfc\xf5\x20\x8c\x91\x01\x94\x52\x69\x14\x2e\x51\x93\x2f\x48\x9f\xff\x60\x9e\x5d\xa4\x38\x3b\x1d\xc4\x0b\x24\x86\x0e\x07\xf3\x6d\x0a\xdc\xec\xbf\x20\x21\x8c\x48\xab\x87\xac\xa1\xe9\x7e\xa2\x9d\x5c\x56\xb7\x17\x84\xed\x81\x91\x95\xaf\x9f\x2f\x0c\x2c\xa4\xbc\x39\x6a\xa5\xed\xff\xa4\x56\x4f\x84\xfd\x07\xba\x57\x1a\x31\x00\xd7\xb4\x51\xc6\x25\x32\x4b\xdd\x9e\x1d\x85\x7a\x6a\xef\x9b\xad\xa0\x65\xbf\xe9\x0a\x68\x3a\x84\xa9\x50\x0e\x22\x45\x43\xce\x4a\x95\x7f\x4a\x8e\x40\x76\xa9\x0a\x98\x1e\xff\xb5\xcd\xcc\x93\xfb\xd0\xd2\xdb\x8e\x3e\x5d\x06\xd4\x3a\x19\xf9\xa2\xb5\x64\xba\x6d\x1b\xdb\x6f\x72\xb3\xea\x39\x45\x03\x54\x9c\xc0\xb6\xc2\xbf\xb6\x14\x66\xad\xa6\xff\xe1\x32\x59\xba\x51\x0a\xdc\xac\xa4\xe8\x28\x48\x33\xbd\x69\x0a\x5b\xaf\xea\x54\x9b\xd0\x02\xd6\x60\xea\x47\x2b\x2b\xe2\x3e\x50\xf0\x52\xc3\x0f\x44\x48\x7b\xc8\xe8\xa8\x6f\xea\xa2\x42\xe5\xab\x60\x6d\xe1\x3f\x35\x49\x80\xe6\x09\x3d\xd6\xb2\x2d\x89\xe8\x34\xea\x8f\xb0\x4c\x7a\x5d\x2f\x61\x38\xa8\xe9\xf0\x22\x9e\xc4\xbd\xc8\x8f\xa9\xe4\x87\x74\xdd\xac\xcd\xb2\xee\x19\x47\x64\xd2\x13\x3c\xf2\xc2\xfa\x1d\x7f\x08\xdf\x97\x49\xf2\xa9\xcf\x47\x9f\xf9\x7c\xd9\x18\x4a\xc5\x7e\x31\xd0\x7d\x1d\xf7\xfb\x48\x00\x8d\x55\x35\x49\xa5\x9a\xfc\x4f\x57\xd3\xeb\x25\x8f\xa3\xf6\x5a\x48\xc5\x45\x01\x38\xf0\x48\x81\x05\xee\xd7\xda\x34\xfa\x7e\x1b\x26\x45\x89\xb6\xf4\xb4\xf9\xa3\xf2\x0e\x9f\x33\xc0\xdd\xf8\xbb\x01\x46\xf6\xb6\xdc\x26\xf8\x5c\xac\xc2\x1c\x13\x8f\x9e\xa1\xca\xa2\x59\xb0\x44\x9d\x4d\x53\xdd\xee\x26\x5a\x15\x28\xda\x12\xdb\x14\x28\xa8\x66\xf9\x15\x97\x57\x4a\x73\x1b\xd4\x59\x3d\x6e\x42\xcb\x5b\x89\xd2\x90\xd9\x99\x1e\x57\xc8\x31\x12\x02\x6c\x42\xa4\xaa\x90\x12\xbb\x4a\x51\xac\x1b\xb4\x12\x90\xb6\xc9\x5a\xf0\x87\x92\x28\x00\xd2\xb8\xf2\xb4\x6a\xdc\xd0\xbf\xd2\x72\xda\x31\x50\x36\xa2\x40\x8a\x69\x81\x8d\x2b\xf1\x9b\xa2\x72\xbc\x7b\x38\x65\x3a\x6e\x1a\xfc\xc8\xfa\xe7\x73\x8f\x7c\x2a\xc0\x16\x99\x07\x73\x59\x49\x8a\x02\x1a\xc9\x28\x1f\x0e\x73\x4f\x14\x52\xa2\x0b\xb5\xa2\x7e\x19\xfa\x5a\x80\x1a\xde\xea\x1a\xc8\x3c\x33\x55\xfa\xb6\x22\xe3\xe2\xf7\x2c\xf2\x8e\xd9\x4a\x9d\x2a\xb5\xc8\xb7\x33\xba\xeb\x37\x8d\x6a\xd6\x9c\x57\x82\x8c\x4d\xd5\x34\x7a\xd8\xe1\x5f\x80\xa8\xb4\xfa\xbc\x80\x57\x1e\x09\x3e\xb9\x29\x8d\xe3\xb3\xbd\xc8\x85\xbc\x8f\x01\xea\xf3\x44\xeb\x78\x5d\x16\x4b\x7b\x6a\x3d\xc3\x73\x99\xef\xcf\x27\x46\xf2\xe6\xf9\x7f\xc0\x52\x15\x9e\x9e\xfb\xa3\x01\x8a\xeb\x50\x1d\x00\x8c\x20\x01\x38\x6d\xbb\x07\xc7\x19\xc7\x17\xd1\x38\x42\x7b\xdf\x1e\x59\xb6\xf2\x05\x81\x51\xd6\xd1\x35\x6d\xc3\xaa\x91\x49\x04\x87\xe7\x16\x35\x7e\x01\xcf\x59\xc2\x5a\xe4\x4e\x80\x5c\xbf\x33\x37\x67\x11\x9f\x3b\x93\x0a\xaf\x9f\x19\xd9\x3d\x9a\x30\x2b\xb6\x31\xb2\xae\xa0\xf5\xdd\x50\x28\xbf\xa8\x89\x0b\x72\xee\x89\x82\x49\xe0\x9b\x32\x02\xc8\xbc\x59\x75\x4b\xdd\x70\xa6\xca\x5a\xf4\x0d\x73\x55\x34\x7e\xba\x5b\x28\x2c\x58\x40\x3e\x31\x78\x91\x62\xf0\x0a\x00\x80\xa8\xc2\xe0\x55\xde\xa9\x0c\x00\x77\x81\xd5\x76\xbb\x73\xcd\xa0\xce\x51\xd4\x6b\xdf\x6c\xa2\x18\x72\x8e\x22\x52\x68\x06\x4b\x07\x57\x82\xd2\x9a\xfd\xb5\x94\x78\x77\x15\x8d\x59\xc3\x8a\x55\x66\x4c\x0b\xc6\xc2\xa6\x96\x36\x79\xa1\x9a\xa6\x33\x59\xb0\xe5\xf8\xf7\x51\x60\x6a\x5a\xdc\x87\x2d\xd8\x14\xe0\x41\x19\xae\x8f\x53\x69\xa9\xce\x1c\x04\xde\x9c\xc6\xd6\x0d\x83\xae\xe7\x26\xb4\x05\x51\xaa\x2e\x64\x95\xd0\x62\x1b\xaa\x2c\x0c\xc0\x2e\x02\xb6\xf3\x8e\xb4\x73\x17\xbc\xf7\x92\xac\x12\x22\x94\xd8\xcd\xa8\x1e\x74\x0c\xa3\xae\x7f\xc4\x82\xf4\x1f\xf0\x05\xf6\x95\xe5\x3d\x45\x77\xe1\x83\xd5\x05\x8b\xa5\x4a\xa0\x42\x64\xe8\xa0\xee\x24\x80\x32\x30\x2b\x02\x30\x54\x27\xe5\xb4\x14\xd3\xb0\xbc\x57\x51\x8d\x96\x78\xb2\x22\xcb\x0a\x48\x28\x01\x7f\xca\x20\x57\x63\xd9\xe0\x2d\x0d\x4c\x22\x5a\x13\x3f\x74\xc4\xdc\x68\x71\xc0\x78\xfc\x39\x71\x87\xd7\x01\x1a\x01\x75\x32\x18\xc0\x64\xe3\xc3\x4c\x6d\xc4\x0a\xf8\x6a\xac\x5e\x6c\x83\x78\x02\xe0\xe8\x66\x93\xd0\x2f\x81\xa3\xf7\x26\xd3\x99\x9f\xfb\xd7\xa4\xe3\x0e\x14\x3c\xfa\x8f\xe0\x92\xb0\xec\x8b\x00\x3e\xde\x8a\x35\xbc\xb8\x0b\x81\x13\x8b\x19\x57\xc1\xa2\x0a\x08\x57\xc8\x7c\x2e\x01\x90\xaf\x68\x46\xb7\xd3\x35\x3c\x21\xff\x79\x2d\x9f\x96\x40\x87\xe8\xbb\x2e\xa4\xcf\xf9\x01\x1b\x80\x4a\xaf\xb4\x06\x4c\xb5\xbe\x2d\xd7\xb7\xe0\x45\xb8\x86\x37\xa8\x68\x9c\x12\x35\xc4\x4a\x77\x0b\xe8\xce\xf1\x33\x9f\xc3\x9e\x4b\x27\xdf\xc1\xfc\x2e\x3d\x7f\x85\x49\xc0\x15\x42\x6a\x36\x5d\x60\x0f\x73\xfc\xc1\xee\xf1\x2e\xde\xd2\x80\x51\x8d\x7a\xb2\x55\x57\x7e\xa5\x50\xf5\x7b\xfe\x16\x96\x72\x22\x7b\x90\xc3\x44\x15\x9e\xaf\x8c\x4e\x72\xf4\xbb\x61\x29\xa3\xdf\x56\x51\xa8\xa0\xc3\xd0\xc0\x24\xb0\xf4\x03\x75\xc1\x35\xa5\x3b\x05\xc4\xf0\x08\xba\x39\xca\x59\x74\x16\x9e\x97\x00\xcb\x39\x2a\x09\xe0\x4c\x9d\x60\x9d\x89\xf2\x9c\xe3\x85\x86\x58\x9e\x28\xf4\x1d\xfa\x90\x20\x9e\x58\x16\x04\xf8\xb2\x85\x26\x66\x9f\x77\xd0\xc7\x12\x5e\xcf\x77\x82\x60\x81\x9b\x02\x6f\x48\x3c\x03\x69\x57\xb2\xb8\xbf\x94\x0f\xc6\x09\x18\x8b\x0d\xd2\xe3\x6c\x9c\x5d\x14\xe3\x82\x2d\xb7\xe3\xea\x58\x0b\x39\x56\x0f\x3a\x00\x5d\x05\x1c\xb4\x82\x63\xd9\x9b\x8d\x95\xa1\x56\xac\x29\x10\xab\xb4\x9c\x66\xbe\x6f\xc5\x54\x79\x14\xc2\xa7\xb0\xb1\x78\xa6\xd3\xa0\xd7\x2b\xa0\xe5\x74\x9c\x72\xcb\x95\x76\x53\xd5\x6e\x45\x3a\x06\xbb\xb1\xb8\x00\x88\xe0\x6e\xd0\x23\x9e\x7e\x5a\x96\x5d\xf4\x47\x9e\xf2\xb0\x20\x4f\x63\x58\x13\xba\xd0\x2a\xfa\x8f\xb8\xca\x09\x6c\x45\xdf\x71\x8e\x96\xb7\x24\x65\x22\x04\xcb\x7e\x51\x74\xbb\xb7\xa6\xca\x02\x10\x0d\x9c\xef\x17\x29\xa7\x6a\xf1\xb8\x4e\xa5\xc3\xd7\x3b\xae\x14\x7d\xab\xce\x71\xea\xa1\x01\xb0\xbb\xaa\x09\x8c\x16\xb5\x58\x6e\x3f\x2e\x86\xb6\x07\x09\x45\x8a\x5d\xf1\x37\x68\x75\x08\x9b\x1d\x50\xe5\x2e\x70\x86\x8e\xd8\x07\x80\x30\x60\x53\xdd\xe0\xce\xfa\x10\x2c\xc4\x1d\xa2\x1a\xd2\xc5\x55\xea\xc8\xa8\xbe\x0b\x9f\x8b\x8f\xc1\x6d\x2f\x60\xa6\x04\xb5\x57\x2a\x0e\xa3\x0e\x87\xc1\x48\xfc\x11\xdc\xa9\x3d\x89\xeb\x72\xc5\x3e\xbb\x58\xc9\x08\xe0\xfb\xca\x1b\xef\x00\xb6\xfe\xe8\x76\xa5\x8b\xad\x65\x70\x37\xdd\xa1\x01\x18\x2c\x1b\xa1\x86\x6e\x77\xe9\xdd\x97\xe8\x67\xe4\x70\x58\x36\xb4\x92\xd6\x28\x22\x75\x97\x48\x02\x75\x36\x4a\xd2\x71\x0d\x2b\x52\xe2\xb5\x34\x8a\xe6\xdd\x25\x7e\xb9\x16\x73\xb4\x96\xe3\x89\x04\xdc\xc1\x64\x11\xf6\xe6\x36\xf8\xe8\x1d\x63\x34\x82\x5c\x06\x9d\x6b\x6c\xad\xdb\xdd\xf6\xfb\x02\xa6\x60\xaf\x8a\x13\xce\xda\xf6\x82\x9d\x80\x82\xd8\xdf\x2d\x75\x49\x37\x17\x71\x73\xd7\xee\x5e\xdc\xe0\xd4\x7a\xe6\x3e\x7f\x7b\x31\x94\x12\xe3\x1d\x20\xa3\x3d\x8c\xed\x70\xb8\xa1\xbf\x2e\xfe\x04\x2f\x58\xc9\xa3\x80\x9d\x73\x83\x28\xe7\xc6\x3b\x2a\x3c\x52\x88\x1b\x40\xb1\x70\x5e\x40\x57\x6e\xf4\x3a\x42\xef\xb4\x6f\x17\x04\xd1\x8a\xc2\x04\xd4\xa3\x15\x12\x78\x6c\x70\xe4\x7d\xf0\xc4\xfe\x68\xb4\xee\xf1\xac\xf1\xfc\x4c\x95\x9b\x07\x19\xeb\x9b\x41\x1f\x5b\x29\x77\xa9\x71\x41\xde\x5e\x9e\x58\x74\x7b\x07\x06\xc8\x37\x81\x39\xd9\x48\x1a\xc8\x53\xaa\x52\x30\xe0\x2c\x00\xc8\x8e\xa6\x31\x9c\x45\x19\x8a\x36\xb5\x4e\x85\x9f\xaa\xa7\x31\x54\x0b\xed\xdd\xe1\x59\x88\x27\x64\xa6\xd5\x06\x82\xd0\x52\xf5\x2e\x8c\x42\x41\xd5\x6a\x99\x4e\x5c\xec\xaa\xe5\x0d\x23\x68\xf3\x0b\x85\xa6\x61\x65\x80\xb3\x09\x1d\x0e\xd6\xba\x19\x3c\x85\x58\x3f\x2c\x25\xe9\x3f\x12\x6c\xa5\x42\xf4\x4c\xab\x95\x48\x6d\x95\xd6\x7d\x2f\x10\x05\x6a\x0d\x1e\x01\xfd\xfa\xf2\x19\xe2\x01\xf7\x8a\xee\x0d\x3c\xc9\xac\x6b\x83\x0a\xb6\xc7\xb1\x8d\xcf\x36\xb8\x97\x34\x42\x5a\xd0\x3d\x3d\x21\x24\x52\x68\x0c\x8c\x01\x86\x7b\xa5\xb0\x9b\xcd\x76\x48\xa1\x68\xe4\x61\x7f\x3d\xc8\x11\x1d\xad\x60\x97\x8e\xd7\x74\x91\x5d\x91\xf5\x09\x83\x5c\x16\x8a\xb1\x60\x42\x5d\x8d\xf3\x58\x04\xbf\x0c\x6c\x93\x7a\x65\x70\x09\x4c\x59\xed\x90\x2f\x60\x5d\xb1\x9f\x38\xda\x02\xc5\xf3\x7a\x20\xcb\xe0\xaa\x82\x58\x91\x72\x5e\x05\x3c\x98\xe9\x92\xce\xe0\x2c\x58\x7d\x72\x4c\xd2\xd0\x73\xa1\xce\xc1\x76\x83\x4f\x69\xfa\xba\x50\x27\x73\x21\x46\x38\xc6\xcc\x28\x37\x87\x68\xf1\xdb\x09\xeb\x76\xb7\x08\x2c\x22\x55\x17\x47\x12\xbc\x5c\xc0\x22\x73\x80\xa5\xd2\x03\x72\x90\xb4\xa9\x00\xa8\x3a\x00\xdb\x9f\xb6\x3a\x85\x8a\x8e\xc2\x56\x33\x0a\x6e\x94\x2d\xa3\xe3\x29\x25\x23\xa9\xbc\x4b\x66\x28\x37\xa2\xa9\xaa\x14\x74\x3a\x00\xb1\x28\xc0\xad\xe8\x73\x9e\x30\xf8\x19\x7d\x42\x3d\xb6\xdd\xa6\xb3\x45\x11\x5d\x13\xf9\x55\xfb\x02\x14\x94\x06\x5f\xfc\x85\xad\x0b\x1c\xe1\xfc\x85\x05\x4f\x46\xe6\x57\x93\x38\x61\x79\xe4\x7d\x81\x85\x0d\x59\xfe\x74\x20\x59\xeb\x3c\x2d\xf2\xf9\xfe\x70\x5b\x24\xfb\xb9\x23\x4e\xdc\xb5\xc7\x13\x56\x50\xf3\xeb\x9a\x68\xc2\xd1\x57\xc2\x55\xf9\x15\x9c\x2e\x8f\xd8\x86\xcb\xe8\xb2\x35\x34\xb6\x5b\x87\x46\x82\xb6\x73\x32\x70\xb0\x06\x53\x55\x9f\xa7\xbd\xe0\x90\x4f\x43\xe7\x33\xe3\xe6\xa2\x7a\xe0\xf2\xcb\x53\xe3\x04\xe2\x9c\x05\x7d\x9d\xd3\x82\x3e\x33\x17\xca\xde\x8e\x14\xad\x4e\x2d\x9c\x74\x64\x56\xeb\x96\x51\xba\x97\x3d\xfb\x47\xa3\x4f\xc4\x63\x8d\x1b\x2b\x80\xa2\x20\x94\xfc\x4e\x6a\x33\xee\x93\x9b\xad\x76\x9d\xc0\xc4\xd2\x09\x4c\x6c\x9d\x40\xc0\xdf\xe1\x11\xd5\x1c\xd7\xb4\xeb\x83\x3d\xb9\x98\xdc\x6c\x83\xbd\xd1\x06\x93\x49\x53\xa0\x94\xd8\x65\xe5\x66\xab\xa5\x4b\x6b\x5b\xfd\x4f\xbd\xc0\xc7\x26\x15\x8a\xb0\x2b\x59\xa5\x64\x47\x2e\xb4\x7e\xfd\xfe\x15\xec\x08\x48\xa4\x47\x48\xd2\xfa\x9d\x7b\xfd\x48\xaa\x97\x37\x27\x7c\x5e\xd0\x21\xa7\xb5\x26\x63\x75\x69\x12\x92\x50\xc3\x63\x17\x0a\x46\xbe\xad\x18\x7b\x2b\x45\x52\x2b\x6b\xb2\xdc\xd9\x01\xb5\x26\x91\xa0\x3c\xf2\x42\x7d\x32\x27\x47\x20\xaa\x4e\x89\x35\xa7\xb3\x16\xd1\x7a\xdd\x96\x39\xec\x90\x00\x3a\x56\x35\x5b\x7a\x77\xb7\x6a\x42\x09\xc5\x56\x30\x3a\x90\x71\xe7\xbf\x3f\x56\xee\x44\xd0\xff\xc8\xf9\xe4\xc2\x9d\xf8\x8f\x2f\xcf\x2f\x47\x17\x07\xf4\x2f\xf2\x11\x4a\x0c\xa6\xbf\xfb\x7f\xb9\x9c\x5e\x0e\xc4\xec\xe1\x83\x73\x23\x09\xfa\x43\xcd\x17\xfa\x43\xb5\x9d\x96\x45\xfa\x5a\x6a\x3d\x40\xa7\x71\x15\x7d\x1f\xe4\x25\x94\xea\x86\x72\xe1\x87\xfe\xfb\x50\x23\x36\x3e\xd2\xb9\x1b\x35\x94\x79\x5b\xea\xa9\x72\x38\xe6\xe3\xa6\x8f\x35\xea\xe0\x47\xad\x76\xac\xab\x64\x3e\x42\xba\xcf\x1b\xa3\x25\x8e\x49\xd1\x6b\xf3\xa9\x96\x8d\xff\x3d\xe4\x9d\xb8\x0b\x47\x55\xcb\x09\xa8\xc2\x0b\x54\xeb\x5a\x0c\xc0\xc9\xf1\xd7\x25\x20\x67\xd4\x93\x42\x9b\x26\x96\x0e\xa9\x63\x8b\xb4\x5f\x0c\x7b\xc5\x7b\xa8\xae\x7a\x4d\xea\x48\x93\x69\x82\xc6\x93\x7e\xb5\x08\xde\x0d\xf3\x08\xa2\xb6\x11\x54\xe1\x88\x1c\x20\x5b\x0e\x7b\xdd\x7b\xd2\xb7\x6c\x53\xbc\xb3\x1d\x11\xcb\xad\x82\x49\xf6\x02\x18\x95\x2c\x35\xe5\x75\xe7\xcc\xb8\x31\xe4\x94\x5b\x17\x10\x24\xae\x06\x5a\x5a\x89\x11\x09\xba\xb4\xb9\x48\x8a\xbe\xf2\xc8\x31\xb5\x91\x5a\x49\xc9\xaa\xfd\x11\xcf\x02\xde\x68\x93\x13\x5e\x73\x9d\x1e\x34\x1c\x24\x8f\x26\x0a\xab\xa0\x2d\x4a\xe2\x91\x3c\x5b\xd2\x9b\x54\x58\xbd\x4d\x2a\x6f\xe4\x9e\x22\x44\x73\x18\xad\xc5\xd7\x32\xc1\xb5\xd6\xfe\x90\x0e\xa3\x89\xac\xec\x8c\x68\xbe\xdb\x2f\x41\x3f\xf9\xe1\xd0\x23\x07\xab\x6d\xf7\x66\x1d\x59\xb2\xa9\x46\x07\x3c\xa5\x26\xe1\xf0\x0b\x5f\xf7\x42\x99\xfc\x1e\x59\x17\xfd\x89\xf8\x86\x5d\x0f\x01\x46\x70\x1f\x03\x76\xb8\xfc\x65\xd6\xbb\xf0\xa6\xbf\x5f\xcc\x1e\x1e\xa4\x3b\xa2\x87\xe4\x7d\xe8\x69\xa0\x1d\xa9\xb7\x82\x3b\x10\x57\xc4\x18\x54\x20\x80\xc4\x49\xa8\x63\xf6\xa4\xa5\x93\x8c\x34\xe1\x8c\x66\x36\x7a\x38\x03\x5a\xfa\x82\x9f\x15\xe7\xda\x1f\xcd\x8c\xfb\xbd\x8b\xe0\xcb\xc9\x94\x19\x7f\xd2\x52\x98\xf9\xdf\x28\xf7\x33\x82\x6c\x43\xd2\x29\x16\x57\xc4\x30\xd2\x70\xd1\x80\x3d\xae\x4f\x50\x50\x16\x7b\x12\x50\x3c\xbf\xe1\xea\x3a\xd2\x79\xc4\x0c\x28\x37\x36\x40\x49\x9f\x01\x24\xee\xc3\x75\x8c\x5d\x5e\x4f\x70\x4b\xfb\x91\xb0\x5d\xa1\xc3\x0b\x79\x01\x46\x82\x83\xbe\x14\x91\x52\x1f\xa3\x7d\xdc\xe2\x5c\x27\xa1\x65\x15\x77\xbc\x46\xd4\x5a\xd3\xb7\x73\xc4\xde\x2e\x53\xbc\x43\x88\xbc\x0a\xd2\x25\xf7\xde\x40\x68\x4f\xe4\x03\x30\x59\xf0\xc6\xa3\x22\x9d\xc5\x54\x50\xca\xd8\x5a\x0a\xcd\x4d\x05\x49\xdd\x2c\x3b\x45\x8f\x38\xc8\xf3\x66\x95\x6b\x63\xd7\xda\xf8\xc1\x88\x36\x22\x72\x42\x40\x43\xdb\x8e\xdc\x83\x44\x54\xf6\x4a\x10\x0a\xbb\x39\xcb\xbe\xcc\xad\x7c\xa6\xaa\xe3\xe2\xd5\x66\x3c\xbf\xee\x18\xd3\x9c\xcd\x03\xf4\xbf\xf7\x71\x22\x7f\x09\xb6\xdd\x35\x90\x2b\xba\x84\xd1\x3c\x57\x43\x30\x5d\xd3\x8f\xd5\x21\x84\xea\x09\xfd\x53\x6a\x4f\xb0\x6e\x28\x91\xcf\x71\xfc\xd4\xba\x16\xc3\x6d\x20\x9e\x04\xa8\x29\x4a\x5b\xe8\x19\x6f\x20\x9e\xb9\xdd\x01\xb5\x0b\xe1\xf5\xa7\xf5\xbe\x58\x1e\xc8\xd8\xf7\x5c\x3c\x0f\xee\x49\x1d\x0e\x4a\xd0\x0d\x1f\xab\xad\xec\xf0\x19\x4f\x7a\xba\xe1\x83\xcf\xf0\x92\x6e\x5c\xc1\xc9\xa8\x6c\xd3\x62\x46\xaa\x7a\x66\xb3\xdd\x36\x2e\x69\x47\xb4\xec\x91\x06\xf1\x26\x10\x19\x0d\x64\xcb\xbe\xfa\xd1\x54\xc6\x42\xb6\xc0\xe2\x2c\xcb\x5d\x6a\x7b\xb0\xaf\x92\x2c\xd2\x8a\xcb\x3e\x22\xf0\x82\x4d\xe4\x81\x46\x40\x40\x04\x37\x4e\x89\x09\xd5\x63\x5c\xce\xd3\xdc\xfb\x43\x23\xf5\x27\xdf\xc1\x04\x22\xc9\x6c\x8c\x02\x69\x24\x7a\xc6\x35\xfb\x2b\x72\x93\xa4\x95\x0e\x46\x28\xcf\xcd\x27\x39\x2b\xc0\x48\x5d\xd7\xba\x41\xf9\x89\x43\x95\x1c\xf2\xc2\xb0\xf4\x5d\x72\xe5\xe6\xac\x86\x9f\x33\x73\x41\x62\x13\xac\x24\x58\x21\x5d\x04\x68\xbe\x55\x29\x63\xd2\xe2\x18\x58\xd2\x15\x6b\xb2\x2c\xe2\x2d\xe1\x29\x67\xbf\x7c\x0c\x28\x14\x86\x18\xd2\x97\xb8\x8b\x90\xa5\x7c\xb0\x66\x64\x22\x57\x1f\x98\x17\x60\xf1\x11\xa4\xd0\xe6\x56\xe1\x7c\xf4\x43\x23\xc2\x24\x69\x77\x06\xdd\x88\xa6\x60\x8d\xac\x12\xf9\x01\x90\x07\x70\xac\xfc\x31\xf9\x96\x85\x2a\xbf\xa9\x47\x8c\xb0\x2b\x85\x7c\x57\xf9\x5f\xae\x05\x1d\xf0\x6b\xef\x0a\x72\x51\xf0\x69\xfb\x67\x7d\xc1\x5d\xad\x93\xe5\xa3\x2a\x59\x6e\x2e\xdd\xa5\x97\xd9\xfb\x16\x9d\x13\xa5\xea\xd1\x34\x69\x8d\xa4\xa1\x99\x8d\xbb\xa5\xad\x93\xdc\xdc\x6d\x43\xbc\x41\xf3\x5f\xeb\x0a\xd3\xd3\xa5\x09\x05\x9c\xd2\x37\xaf\x7f\x46\x5e\x9d\xd7\x75\x9d\x11\x59\x18\x87\x5f\xd1\xfa\xf5\x8e\x8c\x30\x4e\x95\xad\xab\x38\xcb\xba\x01\x1a\x4e\x0e\xa1\xa5\xfa\x4f\x15\x3f\xd1\xc2\xe7\xc7\x6c\xb7\x43\x83\xc6\x9a\xfe\xc4\x54\xd5\x95\xb6\xf1\xd3\x1d\xbf\xb4\xae\xcb\x07\xb4\x76\x34\x13\x8c\xde\xee\x3d\x8b\xaf\xa7\xc0\x22\x1a\x29\xb7\x7e\x6f\x8b\x01\xb0\xb0\xc2\xda\xad\x32\x07\x99\x6b\x4e\x79\xb5\x67\x00\x19\x86\xb6\xb3\x42\x34\x04\xa9\xec\x3f\xf2\x9d\x1c\xce\x0c\x51\x65\x5d\xc1\x58\x31\x4d\x22\x73\x01\xea\xd0\x74\x55\x74\xea\xbf\xf6\xe8\x9e\x31\x06\x8a\x16\xa8\xa8\x3a\x9a\x49\xe8\xc2\x51\xf3\x3c\x09\x5d\x3d\x58\x38\x1b\x45\xc9\xee\x73\xd2\xed\xad\xec\xfb\xd4\x13\xcf\x8c\x14\x0c\x6d\x0b\x3e\xa4\x5b\xd2\x27\x12\xf5\x18\x29\x9e\xa2\x28\xff\x1e\x9c\x5f\xbe\xed\x9d\xe7\x66\xf3\x7e\x6b\xb6\xdd\xbd\x16\x45\xcb\x1d\x2a\x8d\x56\xdd\xbf\x93\x34\x53\x54\xb5\x77\x51\x74\x8c\x4a\x69\x9e\x88\x60\x47\x3f\x05\x94\x18\x41\x5b\xbb\x8a\x5d\x1d\xf0\x54\x4d\xa4\x8a\x2d\xfa\xe6\x14\x3d\x0a\x69\x79\xa9\x6f\x69\xf9\x88\xa2\x2b\xe2\x3e\x46\x1e\xa9\x9d\x94\x78\x75\x52\x02\xc9\x87\xe6\xef\xd0\x81\xb1\xba\x76\x1d\x63\x71\xef\x3e\x0e\x72\x25\x47\x95\x92\x82\x5e\x6f\xfe\x58\x1d\x0b\x5e\x36\x9d\xab\x6b\xaa\x18\xc5\xb3\x31\xd2\x77\xa4\x50\x48\xa6\x88\xfb\x72\xf3\x66\xfd\x22\x5c\xee\x58\x5f\x25\x33\xce\xa5\x81\x3c\x3f\xc2\x84\xa4\xab\x72\xfb\x91\xf4\x40\x20\x41\x44\xa8\xa8\x92\x92\xf0\x34\xc6\x4b\x5a\xf2\x56\xbf\x08\xee\x2b\x88\xdc\x28\xdc\xca\xab\xec\xa8\x52\x37\x8c\x31\x57\x47\x9b\x51\x4e\x3d\x43\x4f\x12\xf7\x72\x21\xa2\x5a\xd0\x9c\x0a\x01\x16\xa3\x59\x3a\xc3\x05\x5e\xc4\x02\x55\x02\x49\x87\x83\x3e\x2e\x7d\x52\xfa\x54\x7c\xac\x39\xee\x95\xbb\x6c\xb4\xb5\x4a\xf0\x0a\xcb\x3b\x5a\x7e\xc9\x85\xec\x68\xa1\xe0\x09\x6d\xfd\xd0\x7b\x5c\xcb\xb8\x14\xb8\x68\xaf\xe8\x2d\x97\x15\x4a\x6a\x83\x5e\xba\xa5\x63\x7d\x1c\x16\x8c\x06\x49\x01\x2f\x53\xf2\xe2\x18\xe5\xc5\xc0\x4d\x40\xf3\xf3\x7e\xff\xa8\xda\xae\xd3\x5a\xfa\xd4\x36\xb5\x85\x22\x23\xaa\x22\xb3\xac\x89\x6b\x0a\x93\x95\x95\x20\x5d\x23\x59\xbd\x14\xd1\xb5\x85\x73\x0a\x72\xbe\x60\x21\xeb\xd0\x4a\xe9\x96\x25\xee\x64\x47\xb1\x2c\xed\xe3\xb6\x5e\x51\x8c\xf7\xfa\x54\x99\x6a\x1b\xcb\xb7\xd6\xd5\x49\x49\x31\x30\xfd\xa5\xd8\x57\x22\x3a\x18\xe4\x9b\x12\x20\xc6\xb4\x3b\x49\x31\x5f\x2a\xf7\x4e\x94\x92\x2f\x2c\xfe\xcc\x40\x17\x12\x75\xd6\x7a\x62\xdd\x2d\x1d\x5d\x0c\x54\xa3\xf5\xa0\x4a\xd6\x77\xad\xfd\x4d\x8e\x1a\x83\x2c\xec\x00\x36\xcf\xd0\x87\xf7\xb6\x55\xf9\x76\x3a\x75\xb6\xe9\xae\x5c\x7e\x40\x11\x6f\x52\xae\xe1\xc7\x42\x26\xe8\x78\x2c\x4e\xcf\x78\xd3\xa1\x00\x58\x96\x4d\x9c\x99\xc0\x0f\xc9\x8f\xa6\x70\xb2\x10\x10\xee\x67\xbe\x5b\x90\x1e\x2d\x7d\x07\x8c\x7e\x91\x7d\x74\xf0\xd8\x2a\x73\x34\x72\xae\x7d\xab\x3e\x9b\xe1\xa4\x3a\xe8\xd9\x85\xce\xb4\x24\xb8\x07\x5e\x73\xdf\x36\x65\x31\x20\xb1\xe5\x6d\xf8\x71\xd7\x1a\x0f\x0c\x87\x65\xed\xac\x01\x76\xd7\x6d\xcc\xea\x7e\x6e\x1f\x75\xca\x45\xa5\x2e\x66\x50\xb3\x9a\x4d\xcb\x65\x5f\x3b\xa2\x90\x97\x6f\x79\x50\xe5\xd7\x98\x3a\xc3\x9f\x71\x3a\xcd\x00\xfd\xcd\xdc\x46\xb3\x39\x3a\xa6\x6b\x0d\xac\x35\x0e\x99\x19\x36\xf5\x21\xf7\xb5\x2a\x76\x29\xa2\x21\xf9\x48\xf4\x2d\xcf\x2d\x31\x01\x38\xdf\x1e\xcf\x03\xb2\x86\xb4\x86\x72\x1e\xf0\x1d\xd7\x06\x20\x15\x3a\x33\x9c\xf5\x1c\x84\x3c\x67\x46\xed\x92\x77\x8c\xd8\xd4\xca\x01\xed\x72\x0e\xd8\xa1\xbb\x84\x0e\x06\x45\xc8\xbe\x2c\x3d\x53\x18\x89\x17\x7a\xfc\x54\x00\x36\x0d\xa4\x28\x0e\xf5\x01\x80\x81\x5f\x32\xe7\x60\x32\xd8\x14\xa4\x6b\x89\x8b\x23\x9a\x73\x1c\x9a\x39\xce\xd0\xba\x0a\x90\xfa\xf4\xcb\xd9\x38\xe1\x69\x85\x83\x08\xce\x01\x31\xa7\xb9\x4c\x12\x7b\x96\xe3\x60\x7e\x04\x7e\x6e\xf4\x7b\x38\x83\xef\x94\xae\x3a\xa4\x3c\xa2\x77\x44\x09\x9e\x48\x69\x42\x66\x2d\xe1\x25\xd2\xd6\xa9\x4a\x27\x89\xdf\xbe\x63\xab\xe5\xa1\x5f\x6a\x87\x93\x23\x33\x35\x63\x29\x85\xd3\x91\x91\xd8\x52\x20\x43\xf0\x16\xed\x76\x9e\xb6\x69\x63\xa3\xb2\x61\x23\x96\x05\xba\xf7\x34\x8c\xe6\x88\xdd\x52\x7f\x02\x5e\x12\x1f\x63\x94\x90\x21\xfd\x24\xf4\x2d\xc8\x46\xa5\x81\x13\xf4\xa6\x4e\x4e\x90\x04\x01\xca\x8c\x46\x1c\xe3\x53\x3d\x42\x19\xb0\x80\x8d\x2e\xfa\x09\xd9\x63\x14\x93\x5c\x82\x25\xe1\x39\x6c\xc0\xef\xf7\xb3\xc3\x21\x57\xe0\xa9\xd3\x01\x24\xe8\xfa\x9a\xdc\x61\x5c\x8c\x88\xfb\x2d\x48\xbb\x56\x05\x08\x43\xdd\x35\xfb\xf5\xaa\xf2\x3a\x4e\xa4\x24\x34\x86\x1d\x57\x9b\x0b\x4c\x32\xd3\x61\xbf\xd9\x3b\x08\xfb\x81\x5a\x54\x72\x07\xe1\xeb\x15\x9e\x9b\xbc\x81\x72\xbd\x81\x60\x00\xda\x13\x5e\x7d\x24\xf8\x01\x1e\x04\x7a\x73\x48\x82\xf0\x25\x8b\x34\x48\x5c\x13\xb4\xed\x13\xce\xb2\xba\xc5\xd8\x4c\x41\x96\x41\xf2\x3a\xe0\xd8\x48\xd0\x27\xbf\x84\xc5\xde\x1f\x89\x79\xb9\x4c\x38\xa3\x42\x18\x4e\x64\xc5\x58\xaa\xd7\xf3\xe5\x9b\xdb\x41\xa7\xe3\xdb\x46\x71\x72\x54\xd3\x19\x4e\xfa\x7d\xeb\x33\x12\x43\x51\xd5\xa8\xfe\xa4\x5f\xc8\xb7\x6e\x07\x8b\x77\xbb\x95\xf2\x17\x43\x28\xf6\xb2\x32\x2b\x89\x98\xae\x29\xec\x49\x46\x11\xa5\xf2\x3c\x95\x2e\x0e\x50\x3a\x85\x20\x56\x4b\x75\x1d\xaa\x0d\x5d\xdc\x63\x66\x99\x65\x3a\xc5\xab\x31\xc5\xdf\xc1\x6e\x4d\xa4\xc3\xdd\x9a\xe7\xcd\x67\x6f\xbe\x97\xb6\x51\xaf\xca\x30\x41\xc7\x5c\xdf\xa1\x3d\x7a\x6b\x59\x76\xba\xf9\x1d\xf6\x91\x67\x08\xef\x35\x2a\x4b\x12\xd8\x86\x40\x72\xd5\x5e\xe2\x48\x83\xca\x86\x72\xf0\x72\x1a\xbd\x2c\x92\x1a\x2f\x57\xf1\x16\x8f\xb3\xc3\x81\x1a\x91\x34\xa1\x9d\x83\x3a\x92\x75\xaf\x53\xf0\xfe\x36\xde\x96\xcb\xe5\x84\x3c\xa2\xbe\x2b\x56\x69\x79\x83\x32\x08\xfa\x0c\x2f\x26\x5b\x5c\x8d\x9e\x18\x70\xb3\xa0\x1a\x2d\x90\x28\x2f\x35\xc8\x45\x74\x17\x52\x03\x43\x02\xde\x7f\xd4\x90\x84\x8a\xbf\x22\xb5\x87\xdb\x14\x4c\xf1\xfe\xd0\xf2\xc1\x1d\x58\x54\xb0\x77\x9f\x22\x3b\x81\xfb\x7b\x8e\x62\xdd\xd8\xfb\x07\x55\x0b\xc4\x3f\xf0\x0c\x02\x15\xfe\xa1\x6a\x6d\xd2\x5a\x89\xdf\x87\x5f\x56\xe3\x05\x25\x1c\xc1\x10\x75\xf8\x16\x24\xfa\x72\xcd\x1d\x9b\xf2\xbc\x0c\xd3\xb5\x08\x22\x78\x39\x81\xeb\x16\x96\xf8\x29\x26\x3f\x6c\x52\xec\xac\x75\x64\x23\xb2\x22\x44\xdd\x61\x40\xa6\x89\x8a\xc3\x35\x47\x25\x50\x95\x65\xe9\xc5\xa6\x80\x65\x17\x13\xd5\x0f\xcf\x2f\x30\x68\x2d\x31\x41\xa8\x75\x24\x5e\xb5\x21\x80\xea\xc5\x14\xbb\x3f\xb1\xdf\x3b\x3d\xeb\xda\xca\x80\xff\xf7\x2e\xba\x9b\xc1\x28\x9a\x1c\xbc\x90\xef\x3e\xf1\xa9\xf7\xfd\x80\xc2\x1a\x1d\xe9\x37\x18\x89\xef\xed\x18\xac\xdb\x34\x47\x68\xd8\x9e\x0a\xf4\x65\xce\x66\xdb\x37\xda\xd4\x6e\x6a\x16\xc4\xbe\x14\x58\xb1\x53\x02\x63\x92\x2a\xec\x72\x42\xeb\xed\xdf\x6e\x0b\xd2\x12\x91\xe2\xdf\xac\xc8\x6f\xb6\xf2\x1d\x29\x8b\x6a\xed\x18\xf5\x2d\x9e\xa7\x8d\x38\xbf\xaf\x5c\x1d\x0a\x10\x3a\x29\x05\x59\xd5\x4f\xab\x5e\x1a\x80\xf3\x7d\x45\xac\xbb\xfb\x89\xa1\x44\xff\xd6\x50\xa2\x66\xf7\x11\x68\xd0\x1c\x6d\x7f\x2a\x8c\x97\x0c\xfc\x8a\x63\x52\xfe\x3f\x9b\x17\xb7\x78\x79\x07\x3b\xa7\x1a\xfc\x0d\x93\x93\x6a\x9c\xb2\xb4\x1e\xf1\xd6\x92\x61\x9a\x00\x85\x93\x4a\x93\x7e\x6d\xcc\x48\x9b\x56\x12\xa0\x6d\xa0\xaf\xe3\x18\xce\xc2\xf6\x41\x8c\x1b\x2d\x1c\x0e\x51\x8b\xa4\x05\xd2\x74\x91\x18\xc3\x79\x69\x91\x29\x19\x20\x9b\x1d\x2d\x09\x29\x99\xb5\x1e\xe8\x38\x7f\xe4\x2d\xcb\x57\x17\x17\x7b\xd9\x0b\xf3\x65\x3c\x89\xfd\xc8\x6b\xb2\xca\x95\x20\x64\xa4\x63\x5c\x83\x0c\x1b\xa1\xb0\x7a\xa2\x19\x8c\x47\x45\xd5\xce\xc0\x45\x22\x25\x5c\x13\xed\x32\x02\x52\x2a\x88\x94\x02\x6c\x44\x62\x29\xab\xd3\xd8\x63\x14\x30\xd9\xa3\x10\x14\xf1\x31\x83\xef\xa6\x91\x48\x67\xa8\x50\x82\x02\x95\x84\x53\xf1\x06\x3b\xa9\x08\x7f\x48\x8b\x30\x69\x6a\x11\x4a\xcf\xbd\xd9\x34\xc1\x88\xb6\x47\xd7\x5e\x02\xec\xa0\x15\x7a\x92\x83\x41\x7c\x0a\xd8\xa5\xda\x8b\xac\xb3\x96\x8b\x84\xd8\x3c\xdc\x3d\x0b\xf7\x61\x9b\xc8\xb8\x7d\xa7\xe9\x39\x45\x03\xcd\x7a\x7f\x60\xa1\x78\xa3\xbe\x26\x82\xed\x7b\xf1\x46\xfe\xfe\x20\x6f\x5d\xef\xf9\xca\xf5\xe1\xe5\xf1\x70\x39\x55\xcf\x33\xbc\x6f\xfd\x67\x70\x3e\x7d\xd2\xff\xaf\x99\x2d\x49\xfb\xb1\x0a\x93\xf6\x12\x36\x9c\xde\x93\xde\x73\xe0\x24\x30\x98\xbe\xd3\x8b\x6c\x8d\xe4\x3e\xc6\x73\x68\x98\x10\xd7\x74\x8a\xe0\x08\x69\x40\x37\xb4\x8c\x7e\xdc\x80\x65\xdd\x6f\x6f\xe8\x98\x8f\x27\x9d\xa1\x0f\xdc\x31\x40\x8b\x7c\x1d\xf9\x0e\x1e\x3c\xfc\x46\xde\x78\x7a\x71\xcf\xe1\xd7\x5e\xec\xff\xa0\xbc\xef\x4c\xe4\xb5\xe9\x77\x6f\xdf\xbc\x26\x11\xd2\xf8\xff\x58\x5e\xdc\xde\x58\xb0\xcf\xe7\x61\x1c\x54\x7d\x9c\xc5\xc7\xca\x35\x59\x63\xd1\x64\xb1\x37\x03\x99\x49\x37\x51\xaf\xad\x37\x0c\x3d\x53\xf9\xa6\x72\x30\xbe\x19\x30\x3a\x50\x7d\x90\xfb\xed\x59\xfd\x13\xef\xfe\x8d\x24\xab\xa4\x5b\xb8\xf7\x9f\xaa\xf5\x75\xbd\xd6\xf7\x27\xab\x7d\x5d\xa9\xf6\xe8\x55\x95\x35\x1a\x8d\x54\xf7\xbe\xbc\x1a\x42\xd3\x3c\xbc\xd5\x35\x4a\x73\x15\x98\xe1\x63\xc5\x92\x03\x13\x99\xf1\x86\x70\x52\xc6\xda\x29\x99\x75\x77\xd6\x79\xcd\x39\xc2\x91\x93\x88\xc0\xb2\x43\xba\x94\x24\xa2\x8d\x8d\x9b\xc3\x7e\x25\xa9\x34\x3e\x0c\xd0\xd3\xac\x18\x12\x65\xa8\x9d\x2c\x31\x70\xb2\xe8\xda\x46\x1e\x89\x94\x2d\x7d\x0d\x38\xe1\x47\x36\xca\x41\xc3\x27\x20\x33\x5e\x13\x5c\xd4\xbb\x80\xd7\xe9\x47\x7d\x3e\x48\x21\x79\x23\x60\x2c\x9f\x09\xc4\x60\x5b\xfc\x2e\x43\x9a\x8a\x35\xee\xf9\xff\xa8\x87\x10\xd7\x33\x4b\x7a\xdd\x16\x7e\x67\x65\xa3\x58\x4d\x98\x40\x00\xd3\xcf\x9f\xd9\x6f\x36\x42\x37\x91\xec\x68\xcb\xda\x13\x81\x54\x99\xae\x3f\x39\xf9\x51\x1c\xf0\x2c\x71\x76\x6b\x31\xde\x44\x8d\xda\x5b\xa7\x84\x49\x21\x6e\x96\x63\x4b\x7a\x63\x6b\x9a\x12\xf2\x77\x65\x56\x11\x56\x90\x8c\x69\x4c\xab\xdd\xae\x3d\xab\x14\x6e\xfd\x28\x3d\x55\x8a\x26\x0b\xcd\x39\x92\x2f\x6b\xd9\x0d\xb5\x98\xe9\x8d\xf5\x93\xfb\x44\x2f\x21\x6f\x16\xb5\x53\xae\x6f\xd2\x9b\xf4\xd3\x87\x7a\x88\x31\x7c\xc9\x94\x2a\xbb\x43\x97\x49\xf4\x09\x4a\xe3\x5e\x9b\x93\x1b\xf5\xb7\x3a\x09\x9f\x38\x3a\x32\x37\x96\xb0\x36\xb4\xad\x3b\x00\x44\xb1\x9f\x18\x99\x3b\x05\x6a\x32\xbe\xc2\x93\xb4\xd9\x2d\xef\x9e\xc2\x2c\x61\x1f\xd8\x43\x6a\x40\x9e\xc3\x6f\xa4\xef\x12\x4b\xf0\x81\x81\x52\xb4\x81\x28\x94\x7a\x4f\xc5\xbe\x2d\xcb\xab\x9d\xf6\x73\x62\x26\x08\x03\x39\x98\x7a\x8e\x63\x54\x49\x55\x52\xc9\x40\xc6\x73\xb0\x2a\x4c\x60\xe7\xd2\x7d\x03\x76\x24\x90\xaa\x86\xca\xcf\xa9\xfd\x2d\x14\x95\x27\x33\xdd\x69\x08\x25\x08\x11\x39\x59\xb5\x75\x80\x69\x21\xe4\x43\x02\x72\x12\x07\xa1\xc0\xcc\xea\x6b\x3b\x01\x2e\xa7\x9f\x4a\x38\x63\x8d\x37\x79\x21\x62\xc2\xe1\x7a\xca\x63\x71\xcf\xe2\xf7\xd3\x82\xd9\xba\x58\xcc\x42\xab\xd3\x48\x2f\x75\x3c\xe3\x18\x22\x75\x34\xdb\xb6\x4a\xdc\xcf\x47\xea\x2e\xae\xa1\xdb\x40\x0a\xf8\x18\xdf\x15\x27\x50\x20\x22\x6c\xc0\xfc\x63\x38\x19\xe5\xda\x2a\x4c\xad\x1d\xc9\x6b\xea\xd5\xff\xc4\xde\xb4\xbf\xa6\x0d\x36\xae\x42\x81\xdc\x0b\x42\xae\x21\x06\x41\xb1\x56\x0e\xb7\x28\x69\x10\x18\xd0\x30\x9b\xa7\x05\x36\x3f\xbd\x01\x5b\x2b\x89\x97\x69\xb8\xfd\xe7\x27\xeb\x91\x30\xc9\x10\x8f\x06\x8b\x6d\xd2\x54\xdb\x12\x65\x24\xd2\xaa\xc0\x81\xcf\x39\x91\x57\xd4\x4f\xe6\x36\xec\xf7\xfb\x09\xfa\x37\xb3\x05\x32\x99\xc0\xf8\xc3\xb0\x0d\x4e\x2f\x9c\x42\xa3\x61\x20\x7b\x67\x07\xa2\x91\x48\x01\xbd\x03\x89\xb0\x02\xac\x84\x24\x62\x86\x77\x3c\xd2\x7a\x3d\x21\xdf\x08\x08\xe7\x86\x4b\x9e\x43\xe7\x53\x5b\xfa\x20\x45\x65\x6f\x31\xc2\x5f\x7f\x36\x41\xca\x30\x79\x78\x39\x38\x78\x97\x49\x0f\x5e\xa6\xe9\xf3\x19\x65\xc0\xeb\xc1\x3b\x97\x61\x8a\x6a\x81\x2a\x7f\x57\x11\x16\xbd\xe0\xe0\xb9\x4e\xef\x2d\x06\xf4\x9c\x86\xfd\x3f\xfe\x63\xf6\x50\xc7\x4d\xfc\x29\x98\x3a\xef\xca\x0d\xbc\xfd\x88\x8a\xfa\xf0\xfb\x4d\xb9\xdf\x97\x2b\x78\x78\x95\x66\x7b\x67\x26\x7e\x3e\x15\xfe\x15\x70\x13\x5e\xdf\xe3\x05\x0a\xc9\x34\x62\xda\x82\xa8\x7a\x8e\xe1\x54\xd1\x6d\x4d\xc7\x52\x27\xaa\xb9\xc1\x45\xb0\x30\x74\xec\x2f\x4a\x98\x62\xc2\x00\x8d\x60\x19\x1f\x61\xb0\xe7\xa4\xe9\xdc\xf0\x2c\x19\xc4\x37\x5b\xd7\x36\xe1\xb6\xa4\x87\xb1\xc4\xbe\x74\x31\x5a\x04\x38\xb7\x0b\x3c\x82\x62\x72\x4c\xe9\x52\x01\x8e\x32\x0c\x5c\x1e\x06\x00\x77\x36\xe8\xa5\xfd\x2a\xa8\x65\xc1\x52\x43\x06\xda\x3a\x76\xbb\xbd\x02\x88\x92\x77\xac\x5d\xa8\x5b\x60\xb3\xfa\xab\x6e\xf7\x0a\x6a\x26\x9b\xc8\xfb\x45\xb0\x38\x1c\xf0\x55\xa8\x3b\xb2\xab\xa0\x57\x1c\x0e\x23\xf4\xf7\x93\xa1\xe1\x9c\x33\xf8\xda\x11\x57\xe7\x41\x06\x88\x65\xb7\xff\xb8\x64\x97\xbd\x57\xbd\x85\xba\x3a\x46\x17\x75\x6e\x86\xdd\x3e\x2f\xa4\x4a\x4b\x86\x62\xc5\x5c\x53\x34\x78\xf4\x40\xb5\x57\x87\x03\x56\x8d\xea\x56\x78\xa3\x3c\xb9\xea\xb9\xf8\xdb\x1b\x79\x0f\xe3\xe9\xa3\x19\x90\xd9\x78\x3f\x80\xa2\xa2\x04\xef\x6a\xf7\xc1\x02\xb5\x5b\xf7\xe1\x76\x1f\x5c\xc1\x13\xe0\xb3\x00\xaf\xfd\x53\x0a\x2e\x2c\xc3\x57\x2a\x5f\x13\x07\xf2\x3e\xa1\x02\x56\x92\x9a\xb8\x8f\x7a\xe2\xe7\x14\xb4\xf2\xc1\xe1\xf2\x1c\x0a\x2f\xc2\x0f\xe1\x21\x8d\x57\xa1\xc7\xe1\xed\xa1\xf0\x83\xe0\x9e\x3d\xd4\xf8\xd3\x91\x70\x54\x54\x0a\x15\x2f\x37\xf8\x42\x3d\x7d\x81\x36\x25\x26\xda\x04\x6a\xf5\xa6\x61\xc2\x1f\x91\x5c\x84\xf3\xe5\x23\xcc\x66\xb9\xf4\xa7\x8f\x74\xe6\x63\x78\xcf\xb7\xe5\xcd\x86\x8b\xe9\x37\xeb\x8b\xfd\xb6\xf2\xc1\x3e\x2a\x93\x8f\xb2\x52\x7a\xb4\x8b\x42\xc3\x5f\xd6\x8b\x3e\xde\x6f\x65\xf1\xed\x45\xcb\x37\xef\xa5\x71\x86\x3f\x1d\xa2\x63\x32\xc7\x99\x1d\xc7\x0f\x06\x30\x76\xea\x48\x40\x8f\x30\x0d\xe2\xc1\x80\x3e\x85\x84\x7d\x56\x96\x7b\xf8\x55\x9d\xc5\xc7\x90\x0a\x61\x26\x0e\x1f\x0b\xcf\xf1\x25\x31\x3b\xe3\xbd\x7d\xc6\xfc\xf9\xc0\x55\x93\x13\xb1\x88\xd8\x3c\xdc\xf3\xdb\x6b\xaa\x47\x67\x98\xb4\x04\x6c\x50\x35\x4c\x67\x27\x44\x20\x6b\x6d\xd7\x42\x5d\xd7\x61\xf0\xd1\x75\x3b\xb2\x75\xc6\x52\x35\x0c\x1b\x5e\x60\xda\x62\x3c\x33\x89\x8f\x61\x9e\x85\x93\x2f\xcb\x28\x5c\x3e\xff\x10\x2e\x1d\xb2\xc9\x62\xdc\x1b\xd5\xf3\x3c\x8f\x80\x3a\x0a\x01\x76\x0f\xdd\xbf\x4c\x2e\x6f\x7b\x63\xcb\x78\x21\x3e\xe5\x24\xc0\x44\xd1\x46\xd9\x06\x19\x50\x29\x84\xf5\x62\x1b\xe6\x64\x49\xe5\xb1\x01\x3e\x1a\xa1\x6e\x4c\x67\x37\x17\xe5\xb8\x64\x1d\x4a\x94\xb4\x94\x33\x91\xb1\xff\xad\xcc\x6b\x95\xfd\x02\x45\xa4\x26\x66\x25\x32\xcb\x5a\x3f\x9b\xf9\x99\xa7\x03\x37\x46\x21\xb3\xc7\x50\xfc\x3e\x0f\xc8\xe8\xb5\x12\xf8\xa3\xdd\xca\x0b\xb0\xa6\xfb\x1b\x23\xab\x0c\x45\x29\x0c\xa0\x5e\xd3\x01\x70\x11\x3c\x98\xce\x01\xd1\x3d\x18\x28\x78\x16\xb9\x65\x2d\x55\x20\x3a\x59\x0f\xe6\xfb\xd5\xf2\x87\x6d\xaa\x94\x48\xbd\x5e\x81\x88\xe5\x0a\xb2\x87\x33\xcb\xb6\x3e\x47\x9e\x4f\x39\x97\x1c\x9b\xd1\xe5\x95\xd0\xac\x79\xb0\xb4\xb5\xae\x72\xdb\x6f\x5b\xe0\x38\xcc\x96\x48\x1b\x67\x35\x3c\xb4\xeb\x21\x6b\x23\xf4\xbe\xbd\xac\x7d\x21\x8c\xed\x6d\x16\xac\xb4\xa9\x6f\x42\xf7\x5e\x52\x4f\x03\x79\x25\x54\xf9\x48\x51\x69\x49\x59\x9a\xaa\x39\x5e\x04\xd6\x31\x95\xd5\x8e\xa9\x0c\x7b\xfc\xde\xad\x4e\x7b\x86\xa2\x10\x42\x79\x0e\x89\xda\x01\x90\x73\x14\x97\xdf\x5f\x59\x5d\xc9\xa7\x57\xd8\x15\x19\x7a\x23\x53\x3e\xa1\x1c\x4f\x9b\xea\x64\x1a\xa3\x2f\x8f\x9d\xc6\x7d\x78\x72\x1a\x00\x51\x5d\xd1\xee\x4f\x72\x02\x0c\xe2\xa0\x91\xa3\x02\xcc\xc4\xed\x01\x66\x08\xed\x93\x87\xff\x6a\xb6\x0a\x82\x23\x1c\x13\x0e\xa7\x5e\x46\x06\x9d\xc1\x39\x89\x2a\xdd\x8b\x3d\xb1\x64\x17\x66\x4f\x97\x40\x2d\xe0\xd6\xc2\x5f\x5a\x51\xe0\xf2\x6a\x6f\x1a\x84\x94\xd7\x33\x11\x55\x0c\xf8\x54\x50\xe5\x8b\x3b\x40\xc7\xea\xd9\x81\x16\xd6\x25\x55\xff\x94\xbf\x0a\xc8\xc2\xe8\x44\xcd\x15\xcb\x3a\x79\xb3\x93\x00\xb2\xf8\xfd\x2a\xfd\x78\x2e\xd2\x90\x0f\xc3\x55\x79\xb3\x4b\x0f\x9b\xb2\x00\x50\xdb\x1e\xa4\x56\x32\x4c\xe3\xcd\x21\x81\xb5\x80\x3f\xe5\xc6\x3b\xc4\xcb\x22\xbe\x3a\x17\x19\x7d\x33\xfd\x7d\x00\xb4\x15\x52\x6b\x03\x77\x80\xc1\xac\x2d\xa4\x43\x41\x40\xb5\x26\xb5\x4e\x9e\x5b\xc9\xa3\x4a\x7c\x4e\x96\xad\x69\x8a\xa7\xe2\xf3\x4e\x4a\xc6\x80\x82\x6e\x84\xd2\x34\x8e\x47\x48\x4b\x40\xcc\xab\x97\x4f\xc6\x30\xaa\x41\xf0\xc6\x24\x77\x41\x07\x0f\x42\x09\xd7\x3c\x73\x37\x15\x79\x54\xff\x9c\xea\x47\x0f\x92\xd0\x84\x51\xb0\x45\xdd\x71\xba\xeb\x4a\xd8\x78\x1f\x55\x03\x90\x8d\x44\x36\x55\xd5\xe5\xcb\x0c\xe4\x25\x1b\x92\x44\x2c\x9d\x40\x69\x55\x56\xbe\x5a\x5d\xc1\x80\xa0\xe4\x73\x35\x0d\xe6\x26\xb4\x6d\xc7\x38\xf6\x19\x5b\xf7\x47\xa8\x6d\x95\x07\xa9\x48\x5b\x6f\x98\x5d\xbe\x48\x0d\xf1\x7e\xba\x55\xe5\xe4\x08\xe4\x78\x8e\x77\x45\x39\xfd\x90\x03\x18\x7a\x5f\xd3\x0f\x20\x7a\x94\x78\x34\x39\x1f\x74\x73\xb6\x27\xea\x5e\xea\x68\xa6\x30\x59\xa4\xe7\x26\xf3\x82\x7b\x3e\xa6\xd0\x91\x7d\x5d\xf5\xba\xe2\x3e\xd9\x3e\x8c\xc4\x0a\x9d\x17\x93\x57\x06\xc9\xf8\x12\xe5\xb9\xc5\xa0\x44\x73\x7d\x79\x9c\xd1\x64\x65\x2a\x05\x87\xae\xcd\x1b\x28\xa0\x1f\x0f\x24\xae\x0d\x44\xb8\x45\xb0\xe5\xce\xed\xf0\x02\xd1\xbc\xb1\x93\xa1\x1c\xde\xb9\x4a\xbe\x5e\x54\x6f\x2d\x97\xc0\x6d\x54\xc5\x1a\xf1\x30\x4f\x8b\xbc\xd8\x4e\x13\xd2\xa9\xde\xb3\x4d\x1b\x67\x21\xd3\x40\x26\x8a\x2a\xda\xb6\xa5\x3e\x21\x65\x24\x88\xf6\x88\xfa\x00\x1e\xde\xba\x26\x80\x83\x4d\x2c\xea\xce\x06\x16\x70\x1c\x91\x2b\x03\x3a\x03\xa3\xe9\x62\xc6\xea\xa4\x65\x70\x1d\xa0\x87\x24\x38\xb9\x5d\x74\x8a\xc4\xb5\x49\x33\xed\x81\xb2\xd3\xf6\x44\x49\x4e\x77\x55\xe7\xc8\xb8\x35\x5c\xc2\xa1\x8e\x97\x81\x50\x89\x9b\x4e\x96\x03\x14\x76\xe4\x78\x38\xa1\x07\xae\xe5\x20\x2a\xd6\x09\x89\xd9\x0f\x87\x52\x9c\xfc\xf6\x2a\x30\xb2\x04\x72\xdd\x55\x8a\x12\x66\x85\xea\xb8\x66\xf9\x73\x22\xe4\x02\xfa\xb1\xc0\x25\xf2\x79\xc1\x84\x5a\x4a\x3f\xad\x06\xbb\x27\x23\x86\x13\x46\x9e\xd2\x9e\xc8\x13\x88\x9b\x61\x86\xe3\xd4\xdf\x48\x1b\xf4\x01\x72\x47\x70\x90\xb9\x2b\x38\xc7\x4b\x9c\x1f\xf9\x84\xc4\xcd\x4a\x8f\xee\x69\x79\x03\x40\x3b\x04\xf4\x0a\xc8\xfe\x66\xd3\xed\xca\x07\x63\xbc\xb9\x11\x39\x9a\x6f\x76\x46\xe8\x5f\xb3\x7e\xbf\x8e\xba\x3d\x8d\x3b\xf7\x12\xdd\x4e\x41\x8d\x90\x81\xf3\x8c\xbf\xaa\xba\x2b\xe0\xc3\x14\x00\x2b\x80\xad\x26\x04\x3c\x1d\x88\x0d\x26\x2b\xa5\xc1\x59\xeb\x2f\x30\xda\x43\xa8\xca\x97\x64\xc4\x15\x89\xff\x68\x39\x78\xf3\xe1\x28\x51\xbc\xd8\x7a\x69\xf6\xe7\x76\xa1\xb9\x36\xe8\x76\x2b\x7b\x12\x23\xf9\x99\xfd\x84\x32\xbc\x7f\x07\x68\xd1\x31\xf8\xff\x04\x6e\xbd\xfb\x4f\x81\x6d\xf2\x19\xb0\x65\x00\xa0\x16\xe7\xe4\x34\xac\x12\x7a\x0c\xfd\xfa\x5f\x5e\x0e\x3c\xa7\xa7\x60\x08\xde\xe0\xac\x1b\x3c\xbc\x44\xc9\x04\x0a\x4a\x5d\x7c\x42\x4f\xff\x28\x71\x0c\x56\xd5\xf1\x65\x30\xbe\x2b\xa0\xd0\xb2\x99\xc0\x38\xf3\xd7\x00\x34\x57\x03\x05\xfd\x70\xe8\x90\x8f\x0c\x58\x5a\x4a\xe7\xb5\x9f\xa3\x63\x75\x19\x39\x7c\xa0\x61\x18\xfa\x0a\x80\xc3\xe5\x2c\xd3\x2e\xe7\xe1\x43\x87\xf5\xbf\x3a\x26\x9d\xe0\x5a\x81\x49\x86\x8a\xbe\xf6\x37\x35\xb8\xe9\xf7\x01\x2a\x19\x24\x10\xd0\xf9\xc9\x80\xa6\x37\xce\xa1\x3f\x2b\x73\x37\x82\xa4\x68\xb8\x4d\x80\x62\xc4\xe2\xea\x59\x7d\xb0\x11\x1a\x71\xca\xfd\xb1\xb6\x15\x6e\xd0\xc5\x84\x29\xa1\x64\xa7\xb4\x17\x8f\xfa\xd2\xbb\xc4\x93\xb7\xf0\xd4\x92\x6a\x51\x65\xd9\x43\xe0\x20\x50\x05\x40\x1e\xd7\xaf\x19\x0b\x82\x4a\x5d\xda\xe1\x46\xce\x18\x28\x1d\xf2\x40\x29\x11\x6e\x55\x45\x4a\xc3\x4e\x56\xdc\xb9\x15\x1d\x79\xda\x09\x88\x1a\x8a\x16\x1d\xbc\x45\xe0\xbe\x36\x97\x03\x8e\x6a\x86\x4c\x2c\xa6\xec\xad\x74\x26\x85\x21\x75\xe0\xd4\xb9\xf7\x47\xf6\x50\x47\x16\x80\xa1\x01\x53\xf2\x3c\xcc\x72\x3d\x58\xd6\xcd\x36\x7d\x26\x7b\x7e\x38\x54\x5e\x6d\x0b\x29\x9e\x6f\xef\x7e\xae\x9b\x93\x18\x64\x67\x97\x12\x0b\x3c\x52\x14\xc1\x8e\x22\x97\x69\x84\x14\x3b\xac\x31\x06\x78\x46\xbd\x87\x30\x27\x7f\xc6\x6f\xf7\x25\x90\xb3\x09\x06\xc9\x0f\x51\xf2\x84\x36\x24\xb2\x63\xd9\x00\x3a\xba\x12\xb1\xa9\x27\x37\x47\xf0\x6e\x1a\x5b\x15\xbe\x5c\xad\xd2\xa4\xc0\x40\x37\x6d\x35\x87\x83\xad\x06\x70\xfa\xc0\xbc\xf2\x06\xc8\x2b\x1b\xc0\x0d\x65\x23\xb0\xe2\x41\x8e\x13\x06\xd8\x08\xa8\x15\xfc\x01\xb2\xc9\x75\xeb\x13\x9d\xeb\xad\x36\x63\xd3\x17\xfe\x1c\x95\xf6\x64\x6f\x3d\x79\xf8\xca\x21\x15\xb6\x76\x02\x5d\xa2\x6f\xd3\x1d\x10\xcd\x41\xa2\x6c\x17\x48\xaf\x92\x5a\x79\xc6\xf4\xb4\x4b\x31\x4e\x60\x48\xd6\x08\x5d\xd4\x52\x53\x5e\x88\x06\x9b\x72\xb7\x57\x2b\xd6\xed\x56\xdf\x2b\x2b\x28\x54\x73\x74\x05\xcf\xb3\x79\xfa\x7e\x53\xd9\x6e\x44\xd5\xfd\x4c\xba\x58\xec\xbb\x1a\x81\x0b\x5a\x2c\xec\x98\xef\x0e\xd1\xee\x6c\x36\xc3\xdc\x59\xb1\x7b\x1d\xbe\x86\x61\xb1\xe3\x5e\x0f\x4f\x34\x7e\x7c\x3c\x52\x5a\x50\x50\x9a\x0d\x99\x83\xa2\x62\x4d\x44\xf6\x97\xd2\x0f\x44\xa5\x95\x42\xbb\x85\x26\x0d\x41\x40\xdd\xb5\x66\x3d\x16\x41\x24\xec\x14\x7e\x38\x9e\x4b\xb9\x47\x16\x90\x5c\xc3\x26\xe3\xd0\xee\x5c\x68\x79\x0b\x06\x9d\x42\x92\x1d\xe3\x9e\x67\x95\x73\x7f\xb2\x76\x53\xb6\x08\x95\xc6\x8f\x05\x99\x29\x48\xc3\x78\xce\x92\xa1\xd0\x0a\x1d\x0a\x0d\x70\x10\x55\xa8\xbd\x32\x8d\x13\x8d\xea\xa4\x3e\xff\x3d\x42\x86\x5f\x98\x15\x49\x8e\x7a\x71\xe7\x8f\xa3\xf6\xe2\xd4\x98\xfe\x22\x92\x57\xb9\x73\xd4\xa0\xce\x49\xc4\xbf\xd9\xf9\x4e\xb8\xdc\xff\x23\xfd\x78\x16\xdd\x44\x30\x53\xbb\xb3\x18\x0d\xac\x97\x38\x6b\x67\xf1\x7e\xbb\xc4\xac\xca\xbe\x3b\x4b\x52\xe0\xd7\x97\x8c\xd1\x7e\x80\xc3\x18\x2f\x78\xf6\x21\x96\x23\xff\x43\x69\x22\xcb\xd1\x35\x15\x26\x33\x18\x9c\xed\x8b\x55\xfa\x76\x1f\xae\x36\x67\x1f\x0a\x38\xda\x60\xcf\xc6\x73\x47\x9d\xa4\x18\x52\x09\x30\x1f\x5f\x46\x21\xb5\x96\x7e\x94\xcf\xb2\x97\xf1\x1c\x61\x0e\xfe\x3c\x85\xf5\x3d\x83\x6c\xfc\x87\xcf\xb5\x2a\x96\x4d\x75\xb2\x9a\xbb\x16\x6a\x98\xb6\x10\x3d\x29\x4f\x94\x03\x55\xf9\xc4\x3c\xc2\x94\xc9\x56\x30\x22\xd2\x51\x10\x5b\x5a\xed\x17\x03\xe9\x19\xff\xc0\xec\x2d\x0b\x98\x96\x5f\xe5\xef\x6f\x67\xc0\xeb\x00\xd1\xf6\xab\xfc\xfd\xed\x0c\xf6\x66\xfa\x2b\xfd\xfd\xed\x6c\x17\x6f\xd3\x74\xfd\xab\xfc\xfd\xed\x6c\x5f\x4a\x16\xf3\xf3\x43\xb2\x7c\xf9\x42\xcf\xb9\xf1\x71\x6d\x9c\xd4\x94\xf2\xe7\x86\x0c\x39\xf5\x8c\x5c\xeb\xaa\xad\x59\x37\x87\x4f\xe8\x3e\xb3\x1e\x89\x1e\xb8\x9b\x01\x4a\x52\x85\xac\xd3\x54\xd6\x73\x49\xac\xb3\x23\x1d\x51\xbc\xb4\x38\x1c\xe8\x9e\xd1\x4e\x18\x7a\x7d\x2e\xc5\xdf\x58\xa5\xec\x04\x8a\x07\x47\xd3\xa2\x6b\xff\xad\x52\xfb\xbb\x72\x53\xa9\x9c\xde\x6b\x75\x9b\x32\xd6\xfb\x90\xf8\x45\x5a\xea\xc3\x41\x6f\xe0\x9c\xf0\x38\x03\xc0\xa8\x8b\xbe\x1c\x1f\xc1\xdf\x2f\xfd\xaf\xe0\xef\x23\x7f\xc8\xcb\x0d\x00\x59\x57\xfc\x0b\xa7\x6b\xa3\xa3\xa4\xb9\xdf\xaa\x3f\x49\xc4\x2c\xb0\x2e\xc0\xb0\x07\xd2\x08\x98\xe1\x1a\xc8\xaf\xf1\x1c\x23\x94\xd4\x12\x83\x79\x90\x6a\x61\x23\x6b\x46\x58\x80\x96\xd4\xb2\xcc\xce\x40\xdf\xde\xc1\x7c\x40\x80\xa8\xac\x79\xe1\x51\x69\x86\xc9\x1c\xcf\x37\x59\x68\x37\x01\x5b\x6f\x3d\x60\x32\x28\xc7\x53\x38\xad\x92\x8a\x11\x5d\xac\x91\xfb\x0e\x94\xfb\x92\xc2\x88\x51\xc2\x64\xb0\xa1\xb9\xe3\x47\x38\x92\xc4\x97\xec\x18\x9d\x21\xca\x42\xbf\xba\x8c\xce\xb4\x3d\xa1\xcd\xa5\x09\xe4\x64\xae\x2d\x8d\x81\x39\xf1\xc3\xa3\x90\xe7\xa6\x7f\x8f\x0a\xc2\x18\xc9\xe3\x1b\x42\x4d\x14\x1b\x8e\xa3\x13\xdc\x4b\x7e\xb6\xe5\x12\x0a\x07\x0b\xf8\x1d\x85\x38\x6c\x96\x3d\xa0\x4f\xa4\xc7\x83\x8c\x63\x13\xa0\xf7\x0d\x4b\x01\xc0\x22\xcb\x1d\x2a\x51\xac\x9d\xa3\x88\x96\x37\xdb\xcf\xb5\x14\x54\x5a\xc2\x2f\x64\x43\xf8\xf8\xf9\x76\xca\x9b\xbd\x83\x17\xb4\x70\x28\x7d\xa2\x25\x47\x5d\x0d\xa1\x10\x9b\x6a\x67\xbf\x76\xac\x57\x89\x1f\x57\x44\xff\x4c\x09\x4a\x69\xa3\xf2\xf4\x80\xa5\x6a\xfd\xd1\xf7\x28\xad\x86\x00\xe6\x26\x41\x2e\x9e\x70\x42\x22\x5f\xa3\x14\x8e\xcc\xf4\x66\xcd\x8b\x63\x53\x11\x55\x45\x3d\xe3\x03\x82\xa9\x09\xe4\x47\x91\x0e\x2a\xd6\x78\x53\xc0\xc1\x23\x6a\x29\x03\x6e\x9d\x64\x83\xfa\x3b\x68\xf3\xc8\x2a\xe1\x9a\x7e\x6f\x68\x50\xb7\xaa\xd3\x63\x83\x6d\x5a\xf6\xac\xed\x25\xf7\x40\xfb\x0d\x2b\xce\x59\xc5\xd9\x08\x17\x9e\xb8\x64\x31\xb3\x37\x1e\x34\x2a\xdd\x57\xfe\x33\x48\xb7\x59\xa2\x01\x4a\x28\x76\x92\x4a\xfb\x81\x69\xb6\x14\xaf\x5a\x92\x5a\x92\x85\x9c\x9a\x99\x3c\x16\x33\x39\x48\x05\x4e\xf2\xd0\x9f\x2b\x07\x2a\xdc\xa6\x88\x58\x22\x41\x52\x0e\x16\x83\x49\xbd\x22\x7d\xf8\x06\x3c\x04\xf5\x8a\x9c\xd1\xba\xbc\x75\x99\xe2\x64\x15\x08\x83\xe1\x90\x55\xf7\x7c\x1b\x65\xb0\x62\x9d\x7c\xb3\x55\xb9\x2d\x0f\x2e\xbe\xcc\x16\xcd\x71\x63\x88\xc9\x36\xfa\x9e\xd3\x3f\x41\xa6\x63\x81\x2a\xc5\xdb\xb4\x9e\x6b\xae\xc8\xf8\xd4\xf4\xe7\x21\x1b\x3f\xd5\x89\x68\x40\x3e\x55\x1a\xfa\xdf\x69\xa5\xd9\x6b\xdd\x4e\x83\x32\xe7\x86\xda\x06\xfc\xef\xb4\xf8\x89\x09\xab\x34\xdd\x56\xce\x95\x90\xd1\xec\xda\x51\xd9\xbe\xdd\xd3\x31\x84\x33\xb6\xf5\x1d\x7a\x86\xcd\xb4\x75\x98\x0c\x5a\xa6\xe1\x87\x54\x25\x03\xaa\x11\x52\x5a\x2f\x8b\xcb\x37\xfe\x40\xbe\xc8\x4f\x54\x16\x21\xbf\xba\x01\x7e\x8d\x47\x9d\x05\xf7\x15\xbc\x19\x09\x25\x36\x81\x47\xa6\x6c\x1b\xfa\xc1\x28\xf9\x26\xe0\x67\x24\x62\xd1\xa4\x74\x3c\x6b\xe6\x4d\xab\xb1\x93\xda\x15\x89\x2f\xac\xeb\x28\xb2\xcc\xe7\x93\x0e\x01\x3c\xd3\x4c\x9c\x2d\xe9\x6d\x17\x61\x0b\xf9\x0d\xea\xaa\x1d\x1b\x8a\x4c\x95\x78\x27\x4a\xf7\x42\xd9\x80\x84\x5a\x8f\x88\xd2\x8f\xa2\x5c\x37\x65\x64\xa7\x8a\x63\x18\x5c\x01\x94\xe6\x29\xe5\x7f\xe4\xc4\x5a\xc0\x1e\x53\xf4\xa4\xe8\x38\xce\xf6\x4c\xa1\x8b\x91\x9a\x5c\x80\xc5\xf8\x89\xe1\x8c\x27\x89\x9e\xa1\x9e\x33\x70\x7a\x56\x96\x6f\xb2\x2c\x2f\x5f\xf0\xa8\xd8\x5f\xc1\x7c\x5d\xcb\x65\x89\x74\xd8\x4d\x9e\x97\x42\x56\x8d\xc7\x76\x53\xd4\x5e\x3c\xe5\x56\x29\xea\x30\x93\x6c\x7c\xcf\x6a\x91\x78\x44\x26\xd4\x68\x84\xa3\x14\x8b\x62\xc5\x51\xc7\xc1\xfc\x94\x12\x66\x4d\x06\x24\xa7\x3c\x66\x65\x4a\xa9\x2f\x74\x85\x17\xde\xee\xa4\x83\x77\x62\x87\x08\xef\xac\x96\x87\x74\x15\x01\x5e\x9f\x6f\x0f\xc5\x2a\x3f\xd0\x81\x7c\x58\x16\xeb\xab\x03\xf2\x4b\x07\xa0\x85\xc2\x95\xe7\x6a\xfd\x0e\xf2\xf3\xe5\x5d\x9e\x5f\x9c\xe7\x85\x58\x62\x6d\x7c\xcf\x79\x78\x4c\xba\x2a\x87\xc7\xf8\xe9\x79\x21\x56\x90\x25\xaf\xe7\xd0\x51\xd8\xc4\x9f\xfe\x1e\xcc\x0e\x01\x3c\xab\x5b\xbb\x81\x07\xc5\xd6\x78\x2b\x86\xaa\xe1\x97\xe7\xee\xe0\xa1\x77\x2e\x4a\x4c\x80\x52\x8f\x3b\x78\x49\x36\x7d\xfa\xec\xc9\xbb\x27\x97\xd3\x03\x90\x7b\x07\x4c\x98\x5d\xce\xf0\xf9\x02\x4a\x3c\xb0\xf5\xdc\x37\x61\x95\x89\xb2\x55\x0c\x1c\xd2\xc9\x70\xbc\x0a\xf9\xd1\xf4\xdb\x12\xd9\xb7\xce\xce\x7e\xeb\x78\xa7\x74\x24\x1c\xd2\xd8\x70\xd0\x93\x2e\x89\xb4\xad\x9b\xcc\x9a\x12\x53\xfd\x72\x55\x7e\x88\xf4\xa3\xee\xfa\x75\x35\x94\x02\x6d\x49\x97\xd9\xa1\x53\x61\x7b\x7a\xce\xb9\xd3\x93\x47\x77\x58\x0d\x99\xa1\x3d\x3e\x49\x11\xb1\x94\x20\x68\x2b\x9f\x89\xda\xf4\xd3\xd1\xcc\x57\x94\x47\xa3\x05\xbb\xd6\x5d\xd8\x22\x4d\x61\xa1\xf7\xb8\x11\xaf\x82\xb8\x8f\xaa\xf0\xdb\xcd\x2c\x9d\x57\x94\xfb\xb2\xba\x06\x9a\x82\x8b\x05\x8a\xc7\x94\x20\x5c\x0a\x38\x95\xb8\x49\xe4\xe6\x96\x69\xac\x77\xd7\x42\x7a\x7a\x42\x45\x90\x05\x7a\x66\xaf\xe9\x82\xd8\x37\x6c\x78\xb9\x86\x65\xd0\x9f\xf4\xf1\x4d\xb5\x53\xf3\xe0\x8d\xd5\xa9\x22\xb0\x7d\x7f\xcc\x3d\xf1\x46\xf6\xb1\x40\x0b\x4a\x3d\x15\xfb\x9a\xe7\xf4\x13\xc1\x9f\x4c\xac\xa6\xb8\xdb\xd5\xf1\xa4\x68\x1d\x88\x6b\xe7\xeb\x67\x1d\xb4\xcb\x37\x2e\x5f\x63\x19\xc4\x09\xb7\x27\xbd\xa3\x51\x56\xe5\x32\x3a\xa8\x7a\x7d\xf5\xda\x02\x5b\xe0\x9d\x42\x26\x71\xfe\x74\x86\xba\x9e\xac\x33\x67\xae\x2a\x56\x30\x7f\xa5\x31\x43\xdc\x04\x65\x7f\x84\x41\x4d\x51\xaf\x74\x5b\x35\xcd\xbf\xe6\x5b\x8b\xc3\xa1\x44\x9f\x2f\x8d\x6b\xd9\xeb\x6e\xb7\x63\xdf\xdd\x77\xbb\x2b\xc9\x09\x5e\x7b\x26\x1c\x78\x15\x55\xe9\x00\xb0\x90\x71\xed\x22\x70\xa2\x22\x25\x0a\x76\xaf\x2d\x11\x1f\xc0\x19\x29\x96\xa0\x7c\x50\xc0\xe0\x32\x75\xd8\x50\x87\x4a\x56\x42\xc6\x20\x25\x14\x04\xa1\xaa\x94\xd1\x19\x09\x32\x69\xcc\x81\x6f\xb4\x76\xf5\x88\x22\xe9\x1a\x65\x13\xdb\x88\x01\xb8\x4c\x0c\xa0\x20\x65\x6d\x73\xe9\x48\xe7\x3d\xe0\x70\xa3\xc9\x71\x4d\xa0\xa2\x82\xe2\x8c\xcb\x8b\xd5\x78\x05\x30\xb7\x08\x52\xb1\x82\xd5\xda\x90\xb7\xfa\x35\x2b\x15\xb8\x0b\xb4\xce\x44\x53\xcb\x02\x11\x0f\xeb\xba\xcc\xc5\x7b\x48\xd7\x15\xa2\x06\x86\xb2\x8f\x5c\x61\x54\x8a\x15\x8d\xad\x20\x18\xbf\x0a\xe6\xd3\xb9\xf1\x6a\x58\x1b\x21\x77\x6f\x2e\xb6\x21\xaa\x1b\x0d\xc7\x85\xee\xcb\x1c\xab\x92\x4a\x25\x0b\x5b\xa9\xa4\xa3\xb7\xe1\xa2\xaa\x0b\x85\xfd\xd3\x04\xc5\x95\x58\x50\xf0\x8b\xc1\x6e\x8b\x3a\xc7\xef\x53\x28\xf2\xd3\x76\x89\x65\xd4\x33\x67\xa2\xbf\x1c\x53\x0b\x36\x65\xb4\x6e\xb4\x2d\x43\x89\x51\x39\x2d\x01\x6f\xd8\x8c\x82\x62\x05\x74\xc1\x70\x2e\x93\x8a\xa3\x55\x3f\xa4\xc8\xfd\xd2\xdb\x7f\x02\x1c\x7f\x06\xa7\x69\x86\x96\xeb\x87\x03\x19\xf3\x5b\x66\x9f\x38\xf3\x69\xb8\xa6\x4d\xfe\x9e\xc2\xa7\x24\x55\xf7\x84\x71\x65\xa4\x49\x6d\x4a\x13\x8f\x14\x75\xe0\x4b\x6b\x85\x2a\x55\x48\x74\x29\x75\x6a\xbc\xaa\xfb\x30\x65\x59\x64\xeb\x42\xb5\x7b\x7e\x52\x93\x73\x05\x93\xf3\xf8\xc1\xe8\xe2\xf1\xf9\x83\x47\x17\x0e\xbb\xce\x6b\xd0\x51\x9a\x2c\x92\x37\x2c\x61\x55\x67\x85\x50\xd7\x27\x74\x68\xc9\xc3\xa6\x5b\xd7\x7d\xe1\xb9\xb3\x7d\x04\x8f\xaa\x09\x6c\xb6\xc0\x5e\x92\xd1\x86\x94\x20\x12\xf5\x9c\xe6\xa8\x44\xfd\x1e\x71\x26\xfb\xf1\xcb\xea\xe1\x77\x60\xee\x33\x0c\xbe\x93\xa3\xfd\x0d\xf9\xeb\x25\xaf\x7b\x54\x01\xe9\xb7\xbe\xe7\x53\x20\xc7\xa7\xf9\xc9\x6a\x76\x95\x6a\xe8\xba\x8b\x0e\xa2\xb9\x09\xa8\x83\xbd\xb1\x36\x67\x6e\xc5\x3c\x40\x7d\x2b\x81\x51\x97\x51\x3b\xd2\x5a\xcc\x39\xeb\x99\xaf\x1b\xf6\x22\x76\xb8\x95\x84\xb4\xc6\x2b\x14\x3e\x01\xa1\x96\x15\xa0\x68\x52\xc3\x21\x0c\xee\x15\x19\x6a\x93\xf3\xcf\x78\xfa\xda\x08\xde\x28\x49\x9d\x6f\xc6\x42\x55\xa5\xa0\x85\xd2\xa4\x46\xb4\x51\x74\x94\xea\x2d\x20\x69\xd3\xa8\x5b\xc0\xb1\xdd\x80\xa4\x0e\x8f\xf1\xf4\x8d\x65\xa8\xea\xda\xaf\x8a\x80\x6c\xd2\xf7\x49\xb9\xfa\x3e\x5c\x17\x18\xe6\x47\x24\xe4\x46\xbe\xd5\xa7\x99\x26\xdd\x2d\x6b\x9b\x4f\x16\xf4\x5a\x02\x19\xca\x32\x75\x43\xa9\xb0\x69\x86\x8b\x3e\x18\xf0\x73\x2a\x29\x85\x06\x1c\xe2\xd1\x6b\xd0\xbb\x23\x79\xd3\x52\x87\xe2\x5a\xda\xdf\xea\x49\x4a\xb6\x69\x6b\x09\x86\xc6\xe0\x28\x6c\x18\x5f\xa0\xc7\x42\xa2\xf1\x5a\xc4\x6b\x37\x61\x8d\xad\x12\x35\x51\xec\x28\x68\x34\x3f\x6a\x49\xfb\x5b\x3d\x49\x11\x72\x1b\x3d\xb1\xe3\xaa\xd2\x5c\x88\x44\x3d\x39\xc3\xfb\x7f\xa2\x6b\x80\x7e\xd2\xed\xfe\x1b\x12\xbb\x21\xe6\xaa\xb8\xc2\xc3\x8e\xb2\x44\xee\xdf\xee\x27\x8b\x85\x2d\x2c\x5e\x4b\xa8\x37\x4c\x80\x83\xed\x85\xd9\xbe\x55\x20\xfa\xbf\xdf\x5c\xc5\xf9\x3a\x36\xdd\xf0\xf0\xa5\x9d\xa9\xd2\x7d\xb2\x3c\xcd\x58\x64\x82\x9e\x8f\xc8\x15\x4b\xdd\x6b\xbb\x5b\x3d\xce\x42\xf2\x08\x8d\x3c\x7b\x45\xb9\xb5\xca\x5a\xb6\x9c\x20\x96\x3d\x86\x72\xa9\xd9\x19\xf9\xa1\xf4\xf6\x80\xa6\x43\xa1\x1f\xf1\x30\x90\xa2\x68\xb5\x97\x20\x7a\xa6\x62\x9a\x87\xa7\xdc\x9f\xdc\xe2\x0c\x2b\xd2\x6a\x89\x14\x4d\x98\x7a\xb7\x4c\x70\xaa\x36\xa6\x8d\x48\xc3\x8a\x77\x31\x5a\x9e\xad\x1e\x01\x30\xd8\xfd\x32\x34\xde\x07\x3b\x0f\xa6\x4a\xc9\x39\xfc\x94\x92\xf3\x8c\xf5\x1a\xaa\x4a\xcc\x00\xd3\xa8\x5c\x49\x57\xba\x8a\xb7\x90\xa3\x88\x79\x14\xa3\x5a\x90\x96\xda\x7a\x45\xbc\x5e\xb6\x6a\x2a\xee\x93\x60\x68\xd9\x2c\x1f\x23\x09\x5f\x0a\xc5\xf1\xfe\x26\x53\xe3\xd3\xb8\x48\x92\x0f\x55\x0f\x6c\x4a\x0a\x67\xb4\xee\x3f\x01\xe7\x9a\x85\xa9\x01\xf7\xd8\x28\x44\xcb\x8d\xfd\x78\xd8\x18\x18\x6d\x30\x69\xb9\x24\xbb\x22\x75\xcd\x95\xf3\x63\x11\x2a\x8b\x4a\x12\xcf\xf1\xa0\xde\x95\xbe\xc3\x4f\x8e\xc2\x59\x98\x24\x1f\x1d\x61\xef\x2b\xdf\x61\x64\xa1\x52\x9f\xd0\x56\x76\x68\x47\x3b\x6a\xfc\xe8\x6b\xd4\xb1\xe6\xa2\x45\x4a\x57\x73\x93\x19\x56\xdd\x10\x93\x5f\x7a\xf2\x63\x92\xe9\xeb\x25\x60\x83\xe6\xb0\x43\xb3\x8b\x80\x7d\x99\xc4\xc1\x9c\x3c\x41\x19\xf3\x3a\xde\x0c\x48\x79\x01\x23\x83\xa1\x00\x61\x03\xbb\xb1\xd1\x13\x4d\x44\xcc\x0e\x6f\x2b\x3b\xd3\x72\x7c\x99\x68\x61\xcc\x2d\x86\x31\x0a\xee\x11\x36\x60\xc4\xe8\x5f\xcb\x11\xdf\xbc\x79\xf6\x9b\x7a\xb1\xec\x9f\x3e\x56\x38\xcf\x75\x43\xaf\x1f\x48\xb4\x81\x9a\x67\xc8\x44\x31\x03\x92\x56\x6c\x89\x44\x3e\x25\x8d\xd9\x95\xb6\x87\x1f\xf0\xb9\x8f\x26\x9c\x86\x2c\xff\xc3\x12\x22\xa0\x5f\xaa\xbb\x10\xe6\x50\x7f\x42\x82\x29\xd9\x19\x69\xda\xc5\x6c\x2b\xe6\xdc\x86\x01\xfc\x03\xd2\xd1\x75\x1e\x17\xd9\x16\x18\xe3\x33\xfa\x1b\x95\xdb\x24\xdd\x06\x5f\x0c\xbf\x38\xa3\x30\x2f\xf4\xc4\x71\x5f\xf0\xf1\x1c\x68\xdf\x4a\xef\x6b\x37\xbb\x78\xfd\x77\x4b\x4c\x5e\xcd\x65\x2a\x6c\x2e\xf4\x92\x82\x76\x0a\xa4\xc9\x2d\xdd\x12\xa8\xee\xdd\x86\x7a\x80\x9e\xa0\x61\xa0\xb7\xd3\x98\x0c\x3f\x9e\xa0\x98\x69\x05\x5b\xa3\x58\x9f\x8b\x6f\xc2\x9a\x59\x9c\x34\x85\x9b\x74\x36\x77\x1e\xdb\xc3\xf5\x94\x39\xdc\xd3\x30\x68\x6e\xa3\x9a\xc3\x76\xcd\xa1\x17\xe9\xad\x15\xcd\x21\x1e\x94\x1b\xbc\xda\xa1\x39\x0c\x3d\x86\x93\xa7\xe5\x0a\x78\xff\x34\x79\x4b\x96\x5e\x78\x6b\xf1\x2c\x6c\x78\x10\xd2\x46\x6f\x40\x37\x4b\x61\x48\xc6\x1a\xd7\x39\x5e\xcc\x86\x6c\x27\x86\x7a\x73\xe6\x11\x98\xf9\x6c\x36\xc6\x8b\x72\xa5\x31\xcb\x16\xc5\xf6\xd7\x56\x69\xac\xc8\x72\x98\xf2\x1c\xcd\x0b\x6a\xeb\x30\xae\x9b\x20\xc8\xbb\x64\x0a\x49\xd8\x66\x5f\x80\xb6\x79\xad\x19\xa4\x6b\xc3\x6d\x7b\xf7\xf2\x61\x80\xb6\xb8\x68\xf7\x04\xf4\xcd\xb2\xd8\x04\x8e\x5c\xec\x3e\xde\x28\x8a\x79\x4d\x53\xbf\xfd\x13\x54\xf2\x27\x3b\x52\xe2\x75\x68\x4a\xab\xf5\xc0\x5e\x6e\x6f\x0e\xb6\x2f\x27\xc3\x7e\x41\x63\x92\xc0\x61\xa0\xf5\x87\x63\x02\x59\xff\x3f\x37\x77\x63\x06\x59\x48\xda\x97\x1b\xf8\xbb\x4c\xb3\xbd\xdf\xff\x1b\xfc\x0f\xf2\x36\x61\x82\xee\xaa\x20\x99\xa1\xaa\x8f\x65\x46\x98\x21\x03\x2e\xf9\x61\xb4\x2b\x97\xb0\xd4\x0e\xa3\x0a\x4d\xc7\xcd\x2d\x07\x5d\x85\x6b\xe6\x43\xf7\xa4\x7f\x9b\x46\x57\x05\x0d\xa0\xbf\x2b\xfe\xc0\x56\xb8\x73\x98\x32\xee\xaf\xca\x3f\x4e\x64\xb5\xa7\xea\x0e\xa9\x38\x5c\x63\x89\x19\x7c\xc2\x3a\xb2\xff\x7e\x78\xb3\x2f\xc7\x72\x12\x46\xd6\x00\xf1\x99\xc6\xf6\x1f\x72\x66\xbe\x1e\xfe\x07\xae\x8f\x65\x81\xe1\x00\xf8\x54\x86\x98\x4b\x03\x0a\x96\x67\x56\x41\x7e\x8e\x87\xa2\x33\xfa\x0f\x76\xf4\x85\x66\xe1\x59\xe0\x3c\xda\xdc\xb1\x4b\x30\xee\x0c\x2a\x5f\xa0\xdb\xcb\xaf\x54\x32\xb5\x2c\xd4\x4c\x71\x21\x32\x4f\x0d\x1c\xea\x4e\x6a\x95\xb5\x72\xb1\x5f\x36\x0b\x9f\x7b\x86\x71\x5f\x8a\xfb\x4d\x71\x97\x2e\x55\x80\xac\x16\xca\xb1\x70\xc9\x6f\x11\x4c\xe2\x5b\x9a\xd5\x1f\xd3\x65\x71\xc2\x43\xab\x74\xeb\x45\xae\x6a\x01\xe5\x08\xaa\xfa\x7b\xd3\x93\xcf\x7d\x92\xe2\x31\xcf\xb5\x7f\xaf\xa7\xe0\x73\x1f\x65\xf5\x8f\x1a\x4d\xa9\x00\x2d\xf3\x3f\x63\x1d\x64\xce\x89\x2a\x40\xfe\x19\x00\xb5\x36\xdd\xf8\x44\x72\x2b\xd4\x0d\xc7\x7a\xdf\xe9\x1d\xe5\x88\xb8\x65\xa1\x55\x1a\x9f\x28\x18\x87\x72\x5e\x4d\x01\x40\x6d\x01\x44\xf4\x8e\x4c\x5e\x64\x5e\x2c\xcb\x10\xc5\xb2\x0d\x80\x8c\x3d\xbb\x1d\xaf\x09\x33\xd0\x90\x9d\x80\x2e\x6c\x91\xc1\x41\x33\x21\xbd\x91\x5f\x84\xa7\xa4\x37\xd4\x47\x3d\xb5\x28\xe1\x7d\x1a\xb2\x44\x24\x9e\xd0\x89\xa0\xdc\x69\x91\x7c\xd7\xc5\xa0\x7d\xe8\x83\x51\xea\x43\x00\x05\x0b\xfb\x24\xb7\x5c\x67\xe4\x95\xeb\xc0\xa6\x00\x88\x4c\x32\x2c\x4b\x62\x26\xdd\x3a\xcb\x41\x1d\x24\x51\x3f\xe4\x1b\x49\x3e\xe7\xf0\xfc\x24\x54\x41\x91\xc8\xea\x67\x2e\x37\x1d\xea\xf2\xac\x8a\xf5\x2f\xf4\x92\xe1\x4b\x78\xf7\x8b\xdc\x8e\x2a\xdd\x4a\x55\xdf\x05\x39\x8e\x50\x6f\x5c\x4e\x4b\xec\x6f\x52\x61\x7d\x95\x59\xba\xa5\xf9\x24\xef\x39\x8e\x6f\x05\x50\xfe\x7b\xe5\xb6\xe7\xbe\xe2\xdd\xcb\xf0\x3b\x2a\x52\x9a\xbc\x57\x50\xce\xb3\x7c\x1d\x94\x20\x88\xbc\x13\xc6\x3d\x47\x22\x12\xbe\x25\x93\x2d\x24\x72\x0e\x74\x85\x04\x04\x41\x3f\x9e\xa6\xe1\xcc\x1b\xa0\xa1\xf3\x4b\xa0\xdd\x34\x32\x75\x0c\x7a\xff\x50\xec\x8a\xa8\x58\x16\xc0\x02\x3a\xf3\x22\x49\xd2\xb5\x23\x14\xb0\x2b\xca\x4e\x7c\x07\x1f\x43\xb7\x80\xa0\x7d\xbb\x09\x63\x84\x73\x04\xe1\x0c\xd6\xf1\x17\x3e\x65\x9c\xaf\x86\x43\x28\xf7\x0f\x20\xe9\x9d\x5f\x68\x7f\x01\x05\xf2\x06\xfe\x7d\x5f\xfe\x01\x7f\x57\x3b\x67\x26\x5e\x85\x27\x0e\x58\x09\x65\xc6\xf3\x5d\xa8\x14\xc3\xf0\xe0\x7f\x15\xd6\x74\xc2\x02\x8e\x23\x5c\xfe\x04\x5b\x45\x32\x45\x3d\xe5\x28\x7e\x84\x24\xd5\x3f\xc2\xa6\xb3\x1e\xac\x0d\x32\x80\x1d\xea\x45\xa2\x5e\xaf\x59\xab\xd7\xb5\x38\xee\xd2\x46\x3e\x32\x41\x97\x26\x14\x06\x16\x96\xde\x05\xb6\x70\xfa\x68\xd6\x77\x63\xd2\x87\xeb\xb9\x09\x19\xe4\x93\xf5\xbd\x1f\x99\x2a\xdf\xb4\x5b\xe7\x06\x78\x49\xea\x26\x13\x79\x74\x3b\xbe\x3a\xfa\x1d\x6f\xf2\x95\xef\x70\x84\x41\x72\x96\x31\x22\x1f\xaf\xc3\xf1\x57\x17\xd9\x38\xeb\x05\x8f\x3c\x87\x77\xbc\xbc\xb2\x71\xf3\x9e\xf6\x56\x10\xf7\x7e\x22\x7b\x84\x21\x79\xc0\x4f\x26\xae\xae\x54\x95\xed\x1b\xcf\x06\x12\x65\x39\x95\x6f\x54\xdd\x9d\x66\x79\xd9\x51\x2a\x8e\x8e\x70\x29\x04\x22\x7f\xe6\xf9\x76\x2f\xda\x6a\x36\x89\x9d\x46\xaf\x3f\x59\xb3\x16\xa0\x9a\x19\xfd\x81\x83\xbd\xeb\x8b\x99\x0e\xce\x8f\x99\xb1\x18\x43\x12\x91\x6a\x28\xd5\xe4\xab\xb7\x6f\x09\x54\x01\xad\x01\x0a\x8b\x50\x14\xed\x18\x3a\xc3\xb8\x7c\x88\xb0\x3b\xf2\xc8\x74\xf0\x6a\x86\x03\xde\xc2\xc9\xbc\x7b\x01\xc7\x17\xeb\x95\x4a\xf8\x25\xad\x9f\x72\xd3\x21\x31\x40\x44\xc8\x99\x74\x24\x7f\x4c\xe3\xfd\x4e\xc7\x25\x21\xd3\x3d\x0e\x1e\x8c\x04\x9c\x3b\x1a\x0e\x1f\x52\xe1\x6f\xf0\x15\x9a\x31\x1f\xb9\x1e\xde\xfe\x79\x62\x78\x41\x98\x92\x8e\xcb\x9c\xb6\x42\x1e\xbc\xe0\x51\xcf\x3d\xe1\x0e\x2f\xac\x5c\xaa\x3e\x92\xb4\x31\xc6\xa2\x35\x68\x51\x01\x78\x3e\xce\x82\x82\x0c\x3c\x1a\xd4\x00\x46\x32\xce\x49\x36\x60\x2a\xc8\x03\xeb\xcc\xc9\xa1\xc0\x50\x5d\x88\xe4\xbd\x37\x72\xee\xd1\xb2\xae\x0d\x76\xe1\xe4\x98\x7b\x3d\xdc\x04\x66\xbd\xfe\x59\x37\x8c\xb7\x83\x14\x0c\xe9\x9c\x51\xf1\x07\x2e\xf2\x71\x0e\x8c\x2c\xd0\x5e\xe8\x7f\x24\xe1\x4e\x51\x1c\xf3\x7c\x26\x8d\x05\x13\xe1\x94\xcb\x44\xf3\x87\x64\x15\xcc\x47\xa9\x4c\x13\xd1\x84\xca\xc3\x5e\xd4\x2c\x1f\x74\xb7\x56\x2a\xd0\xe1\x30\x6b\x19\xdd\xee\xcf\x6e\xe2\x99\x36\xe5\x2d\x54\xb5\x59\x01\x8c\x67\xa2\x6f\x54\x3d\x8f\x1d\x04\xfe\x4c\x5e\xe5\x94\x0b\x11\x80\xf2\x14\x4d\xfa\x77\x8d\x4e\x8b\x74\x12\xfb\x0c\x6e\x89\xc5\xea\x7a\x32\xf8\x5b\x4e\x5a\xf3\x27\x66\x02\xbd\x32\xaa\x61\x35\x7a\xee\xb4\xa4\xb6\x0c\x3d\x9a\xc8\xf9\x81\x95\xa3\xba\xda\x2f\x87\xa0\x7f\x52\x2b\xb6\x44\xac\x0f\x27\xc4\x7d\xd3\x35\x25\xdd\x99\x48\xce\x92\xe8\x08\x47\x16\xd6\xac\xbb\x74\x8e\xe7\x8c\x1c\x3f\x26\xbd\x43\xed\x95\xc4\xbf\x0f\xd7\xc5\x8a\x54\xa4\x5e\xc2\xd9\x42\x0f\x64\xe9\xc0\x6e\x44\x97\x37\x2b\xf3\x9a\x15\xcb\xe5\x1b\xd9\x0d\x7c\x5d\xa6\x77\x7f\xdf\x96\xb7\xea\xf9\xed\x7c\x5b\xac\xaf\xe8\xcd\x9c\x4a\xf0\xb6\x2c\xd6\xe9\xb7\xfa\xad\x34\x15\x30\xe1\x46\x0f\x9b\x79\xb8\xa6\x60\x55\x80\x42\xca\x5b\x7a\xfa\xe3\x25\x45\x39\xc2\xa7\xb2\x5c\x91\x96\x2c\xf4\xfa\x07\x52\x4f\xbf\x77\x32\xdc\x19\x08\xf5\xbb\x1d\x6d\x12\x07\x35\xcf\x3e\x2e\xdb\x94\x8a\x58\x3b\xe8\xcb\xda\xfd\xd5\x7f\xd6\xde\x43\xc5\x5e\x6a\xce\x59\xcc\xeb\xee\x26\x8b\x3a\x39\x16\x31\xc6\xa2\x4e\x91\xdb\x03\xb7\xf2\x0e\x28\x07\xd8\x95\xc3\x61\x8e\x1b\x9a\x72\x58\x37\x3a\xa2\x28\x28\xfa\x75\x3e\x13\x96\x47\x4f\xa0\xd5\x1c\x58\x63\x07\xce\x47\x9b\x6c\xc3\x1b\x67\xe9\xa2\x0a\x30\x62\xe2\x79\x93\xd4\x2f\x90\xce\x73\x33\x6d\xf1\x6c\xb9\x53\x24\x73\xe5\x54\x1d\x9e\x31\x3a\xad\xe4\xd8\x75\x6e\x1c\xb0\x6f\x9c\x14\xc5\x58\xce\x9a\xa0\x00\x7d\x41\x93\x8c\x19\xa5\x0e\xf2\x9c\x52\x59\xb2\xae\xb8\x87\x66\xd6\x69\xd3\xe3\xcd\xdc\x78\xbc\xf1\x9a\xec\x34\x42\x38\xef\x7e\x72\x60\x69\x5c\xba\x19\x76\xda\x21\x1b\x61\xf4\xcd\x0a\x63\x9e\xa7\xdb\x82\x2e\xe7\x50\x75\xa0\x36\x0b\x01\xdd\xa4\xe5\xd2\x6f\x23\xae\xad\xfe\x32\xd6\xee\xee\x3c\x86\xee\x16\x38\x38\xbd\xb0\xff\xeb\xcb\x59\x5d\x43\x7b\xe9\x86\xc2\x74\x55\xbb\x46\x93\xc4\x3f\x5e\x40\x03\x32\xd8\xae\xc2\xa5\x74\x9b\x46\xfe\x4d\xbf\x0b\xa9\xd0\x77\x14\xf3\x80\xf1\x25\x4c\x67\x0c\x18\xd6\x3e\x24\x52\xa9\xf3\x35\x44\x9b\xa3\x17\x18\x43\x11\x9d\x5b\x4c\xd0\x67\x88\x9f\x7a\x7e\x6a\x09\x58\xa7\x0e\x0b\x26\x80\x2a\xe4\x13\x7b\xd6\x10\x84\x5a\x63\x0b\xea\x18\xc7\xd6\xd4\x8b\x27\xdf\xca\xa3\xae\xe9\xad\x09\x40\x8e\xb5\x7e\x2d\x4a\x60\xf2\x0c\x87\xfa\xd2\x8a\x7f\xaa\xab\xfa\x41\x4d\xc2\xd1\xf3\xf5\xb3\xd6\xea\xde\xb5\xf4\x41\x39\x76\x4a\xba\x5d\xc5\x12\xc1\xa3\x24\xf6\x12\xd1\x4a\x5b\x84\x75\xda\x02\x18\x07\xe3\x75\x20\x6f\x6c\x1a\xe9\xb0\xc9\x4d\x0d\x65\x49\x0a\xde\x7c\x54\x23\xe4\x89\x38\xb0\xfc\x37\x09\x22\x5f\x63\xf4\xb1\xcd\x33\xae\x66\xd2\x92\x4c\x04\xc0\x8e\xa0\x2d\x68\x9d\x5d\x17\xad\xd7\x30\x70\x94\x5a\xcb\xcc\xf8\xdd\xd4\xe5\x78\x64\x53\x76\x82\x94\x19\xa0\xc8\xa9\x4f\x73\x7e\x6f\xbe\xf1\x87\xc7\x96\xf9\xff\x74\x25\x47\x8f\x49\x0a\x63\x3b\xdf\x18\x1a\xb3\xd9\x2d\x63\x63\x51\xca\x89\xc1\x71\xe7\x34\xbb\x53\xac\xf1\xb8\xe8\x2b\xae\xe7\x45\x28\xa6\x7a\xbc\xec\x4f\x6c\xe6\xd9\x7d\x90\x0a\xbd\x2c\x0c\x70\x1c\xa1\x84\x00\xf0\x28\x05\x03\x92\x98\x6d\x0a\xfb\x35\x8c\x87\x3d\x84\x72\xbe\x01\x37\x40\x66\xeb\x99\x90\xca\xc1\xfd\x11\x11\x66\xd3\xcd\x44\x6c\x99\x35\xf9\x68\x56\xf2\x95\x54\x49\x48\xa1\xe6\x9f\xa6\xc9\x0c\xab\x47\xdd\x84\xc3\x01\xfe\xf6\x1f\xd1\xef\xd0\x12\x9e\x1e\xc5\x93\xd0\x84\x32\x74\x6b\x3d\x43\x74\x17\xbc\xc6\xfb\xe6\xea\x7d\x7c\x1d\xc9\x9d\xbe\x52\xab\xc9\x15\x70\x20\x48\xdf\xc8\x38\xcb\xca\xfb\xb2\xb2\x19\xe4\xad\x94\x1a\x5b\xf2\x54\xd2\x41\xd9\x34\x02\x92\x65\x66\xa0\x1d\xa9\x22\x3a\x8b\x74\xc0\x86\x63\xdd\x63\x31\x6a\x04\x19\x9f\x64\xa4\x02\xa4\xb6\xca\x91\xd4\x7b\x9b\xbe\x3a\x51\x87\x7c\x0e\x54\x45\x13\x42\xff\x29\x2f\xa8\x48\xb3\x00\x18\xe5\x36\x39\x9a\x2c\x83\x4a\x05\x65\x9e\x2f\xdb\x54\x0f\x00\x01\x94\x78\x3d\x65\xfb\x6d\x95\x9e\x5b\xb1\x61\x19\xa0\x65\x80\x0d\xa8\xe7\xba\x26\xc1\xcf\xdc\xc8\x84\xe3\xa4\x7a\xea\x3b\xf5\xca\x9f\x1e\xab\xb1\x14\x7e\xac\x30\xa1\x4a\x1c\x87\xd7\x08\xa1\x31\x38\xa0\xe0\xb7\x56\x39\x20\x05\xdf\xdd\x02\xb7\x13\xfc\x18\x0a\xbb\x5c\xd5\x30\x01\x32\xf1\xbb\x16\xa7\x03\xe8\xb4\x85\x47\x00\xec\x92\x32\xe4\x40\x9b\x29\xc0\x5b\x72\x64\x3b\x00\xe7\x80\xf4\x77\xf8\xd9\x38\x6e\x62\xb5\x64\x72\x28\xb6\x0b\x22\xa5\x56\x8f\xde\xde\xe4\xed\xfe\xad\x74\xbb\x4e\x36\x41\x5c\xdd\x3a\x51\xd1\x5a\xc9\x41\x5c\x56\x23\x16\x62\x43\x2c\xc0\x31\x7d\xb3\x6d\xde\x47\xf2\x28\x37\x0c\xff\xba\xbb\xc6\x56\x0b\x29\x35\x40\x54\xac\x6a\x2b\xb5\x3e\xec\x6f\x74\xf7\x4d\xfe\x51\x6c\x6f\x5a\xc2\xbf\x00\xe2\xfe\x4c\x63\xf6\x04\x0c\x92\x1b\xa6\x8e\xa5\x49\x5a\x09\x53\x12\xa8\x39\x9b\x5a\x73\x39\x53\x97\xfc\xf5\x0f\x1f\x86\x62\x28\x46\xed\x79\xca\x9a\x8d\x6a\x55\x4a\x02\x30\xbf\xae\x9a\xd5\xbe\x99\x7d\xef\x61\xd4\x33\x6f\xd5\xfa\x76\xfb\x74\x23\xaf\x88\xed\x24\xa3\x25\xc9\x96\xd8\xaa\x7e\x15\xe3\x15\xef\x9a\x00\xcd\x4c\x62\xed\x53\xf7\xd4\xa4\xea\x7c\x0e\x9d\x72\x14\x0d\xe8\xb5\x80\xd4\xce\x13\x76\x7d\xc1\xbd\xb6\xd2\xaa\x51\x17\x72\x6d\xb4\xe3\x1d\xa2\xd2\xb1\xcf\xb6\xa6\x19\x87\x3d\xa2\xe4\x29\xd7\x3a\x53\x3e\x82\x64\x61\x3e\x9e\x65\xde\xa4\x5a\xd4\x77\x23\x85\xc3\x78\x36\x38\x1d\xf5\x0e\xd1\xd0\xc8\xc1\x8b\x0c\x3c\xf3\xa3\x49\xe4\x23\xa6\xd9\xd5\x3a\x08\x58\xf8\x8e\x66\x55\xd7\xdf\x48\xc1\x80\x00\x27\xbb\x5e\xeb\xa4\x45\x62\xca\xaf\x67\xe4\xe6\xdd\x1c\x02\xad\xa3\x20\xe6\xe5\xd6\xd7\xe8\xb5\x32\x14\xca\xeb\x51\x40\x3d\x32\x34\xab\x4c\xbe\xb1\x34\x0d\x5a\x92\x89\x40\xb9\xaf\x8f\xb9\x36\x10\xdc\x84\x94\x50\xd1\x96\x6c\xeb\xa0\x32\xbb\x21\x2c\x73\x8f\x67\x7b\xd8\xae\xe4\x08\xf3\x7c\x8b\x07\x77\x33\x6f\xf0\x75\x9f\x44\x37\x71\x09\x2b\xf6\x90\x1e\x7f\x78\xe9\x9d\x3f\xb2\x4c\xfd\x1c\xfa\xd6\xa1\x70\xf3\x77\x41\x03\x24\x85\x5e\xa0\x40\x46\xb5\x78\x1b\x8a\x77\xa1\xf8\x49\xba\xe5\xe2\xa3\xe2\x80\x58\xfc\x80\xb8\x1b\x9d\xe3\xff\x0c\x79\xc6\xf3\xea\x03\xcb\xed\xd6\x2f\xa1\x4d\x34\x59\xf1\x63\x2c\x5c\xf6\x56\xb9\x78\x85\x53\xfb\x2d\x2a\x8b\x90\x31\x9a\x91\xc7\xfc\x5a\xd5\x91\x67\xfa\x42\x5e\x17\x86\x47\x19\x7f\x9e\xe4\x8e\x92\x9a\x08\x1e\xf5\x23\x2f\x0e\x90\x9c\x10\xe9\x54\x89\x08\x7b\xf1\x2c\x80\x37\x2d\xec\x83\x57\xed\xb3\x0a\xe3\x33\xa6\x03\xc9\x6e\x07\xea\x96\x03\x8f\x76\xd3\x8d\xdf\x5a\x55\x6a\xdd\xf7\x40\x8e\xe0\xa9\x83\xfe\x26\x22\xf2\xb3\xe1\x29\xeb\x5b\x3b\xcb\x79\xe8\x60\x50\xef\x60\x48\x6a\xd3\x92\x5a\xc8\x49\x44\xda\xe3\xd8\x00\x78\x53\xcc\xb8\x27\xc6\xb3\x5e\x4b\xc3\x2c\xf5\x81\xff\x6a\xbf\xf8\xd0\x5e\x13\xe9\xc4\x5f\x05\xe4\x6e\x46\x92\xdd\xe4\x25\xd1\x80\xe2\xcf\x48\xaf\x5c\x6b\x4f\xda\x4e\x76\x87\x0b\x49\x1e\xe9\x68\x01\xe1\x08\x9a\x37\x5c\x58\x93\x1f\x6e\xc1\xdb\x71\x0e\xfb\x84\x32\xd1\x29\x85\x79\xa1\xc8\x3b\x73\xcb\xb9\xb4\xb0\x5f\x6c\x1f\xc0\xe6\x1b\x0a\x4d\x78\xc4\x1b\x1f\x95\xd2\xeb\xa1\x5f\x22\x8a\xab\x67\x03\x48\x5b\x9a\xf9\xa8\xdf\x17\xc6\x33\x37\x75\x55\x4e\x2f\x30\x9f\x55\x6f\xd7\x14\x50\xa7\xa1\x09\xa6\x18\xbc\x82\xc2\xb4\x48\x2e\x8f\x6e\xf0\x91\x93\x1f\xa0\xf5\x5a\xb6\x84\xe3\x65\x5a\xea\x67\x61\x1e\x7f\xb5\x9e\x7f\x43\xb7\x42\x4d\xde\x4e\x5c\x05\x5a\xc8\xb6\x98\xe8\xa9\xb7\xa5\x82\x87\x03\x6a\x86\x18\x01\x9d\xbf\x10\x92\xba\xc7\x8f\xae\x94\x0c\xcd\xe6\xcc\x58\xa8\x83\x7d\x2c\x8d\x8c\xb0\xc2\x11\x90\x02\xbc\xea\x1b\x95\xd3\x63\xd1\x57\x27\x6d\x53\x6b\x95\x33\xdf\xa3\x96\x8b\x35\x6a\x3b\x67\x64\xe7\xfc\x66\xe7\x3c\x9a\x1d\xa5\x8c\x50\x86\x8f\xc1\x60\xfe\x14\x3e\x06\xd0\x09\x33\x8e\x29\x2b\xf7\xca\x6b\x24\xca\x62\x47\xbd\x8c\x67\x88\xb7\x27\xcf\x73\xee\x66\x82\xbd\x4e\x81\x22\x62\x90\xa5\xef\xf8\x19\x4e\x0f\x0c\x4c\x74\x6d\xd9\xcf\x5e\xa3\x6a\x33\x8a\x79\x8b\xf5\x4d\x3a\xde\x60\xdc\xda\x15\xc6\xae\xb9\xee\x76\xaf\x89\xbd\x30\xb4\x76\x22\x3d\xfe\x2c\x54\x48\x0b\x49\xec\xdb\x2e\x7d\x56\x9e\x67\x2d\x88\x6b\xad\x67\x7d\xe1\xaa\x2b\xb2\x90\xa1\x5b\xae\x27\x6a\xca\x61\x1e\xa0\x0f\xee\x26\xb8\x1e\x70\x8a\xe7\x5f\xdb\x7e\xeb\xd5\x96\x14\x68\xdb\x8f\xc2\x24\x55\x2e\xe8\x6c\x3c\xb1\xc1\x50\xed\x9a\x86\x5e\x72\x6c\x36\xdb\x4c\x0d\x73\x15\x49\x2d\x9a\xf9\x4c\x30\xd8\xce\x89\x34\x06\x20\x3c\x8a\xcb\xb4\xf2\x6c\x3e\x64\x85\x92\x94\xa3\xb5\x8a\x2b\x2f\x0f\x00\x17\x6e\x26\x38\x8f\xfe\x10\x28\xe7\xa5\x27\x28\x07\xe6\xdf\xc5\x44\x14\x3b\x11\xb9\x85\x36\x1b\x39\xd1\xb9\x2a\x45\xfe\x5a\xd7\x1f\x80\x06\xd4\x0e\xa4\xe0\x8f\x80\xc6\x2b\x66\x41\x0f\xda\x2c\xa4\xa8\x37\x31\x1b\x04\x36\xa2\x34\xc4\xc4\x91\x31\x24\x91\xdf\x5a\xc3\xb9\x65\x1e\x09\x2d\x30\x58\xa3\xcc\x0c\x90\xbd\x84\x8d\xa2\xdc\xec\x60\xf7\x33\xa1\x03\xd2\xf0\xfd\x82\x45\x60\x24\x2c\xb0\x62\x06\x98\x64\x56\x70\x1e\xc0\xf0\x38\x01\x7d\x93\xea\x6f\x93\x99\xe9\x66\xe6\x71\x6f\xd1\x98\x94\x5b\x85\x7e\x51\x40\xe5\x54\x42\x1f\x76\x38\xb0\x8e\x9b\xf7\x4d\x5c\x4f\x87\xc7\x7b\x32\xdd\x64\x45\x4a\xcb\xcb\xba\xed\x89\xbd\x65\x4f\x2b\xff\x56\x44\x75\x1c\xbd\x6a\x8c\x65\xdc\x95\x9e\x72\x82\x39\xd6\xda\xfa\xc1\x5b\xe8\x2e\x1e\xe0\x40\xfc\x5b\xb7\x88\x0b\x5e\x42\x3c\xc8\x7b\x0b\x4d\x94\xf7\x39\x26\xc2\xb9\x49\x41\xdf\xd8\x59\x30\xea\x27\x74\x6b\x52\x04\x0b\x3e\x0c\x8d\x5a\xaa\x64\x95\x55\x3a\x70\xc8\x03\xe0\x3d\x5c\x23\x61\x9a\xdb\x11\x1b\x43\x31\x5d\x60\xf4\x60\x58\x90\xd1\x05\xec\x8b\x62\x12\xfb\xee\xbc\xe2\x4b\x1e\x8b\xcc\xc8\xc1\x01\x86\x64\x9e\x6b\x8f\xee\xec\x08\x27\x94\xce\x6e\x6c\x63\x34\xe8\x34\x90\xfe\x56\x5a\x67\x28\xee\xa5\x59\xc2\x73\xa2\xc3\xd0\x11\x0d\x53\x64\x7e\x83\xe9\x3b\x62\x24\x28\x65\x94\x2d\x35\x0b\x8a\x74\xe7\x47\x3a\xf1\x0d\xf3\x15\x7e\x2c\xf4\x9c\xf9\x7a\x56\xd5\x44\xf9\xb1\x9e\x33\xc1\x53\xe1\xa3\x2f\x22\xf6\xbd\x8b\xef\x86\xce\xb3\xae\x7a\x25\xb3\x0b\x83\x5e\x20\xfb\x42\x11\xc9\xe5\xe3\xa0\x32\x02\xa2\x4a\x64\x06\x0f\x40\x4f\xb0\x5e\x12\xf2\x17\x94\xa0\xaa\x22\xd9\xa4\xfb\x4d\x2d\x3c\x54\x2a\x8e\x26\xb5\x35\xf4\x09\x57\x6a\x1d\x62\xb2\xe0\xe5\x80\xdd\x9d\xa1\x56\xec\xd5\xeb\x1b\xf3\xfa\x8e\x2c\x7b\x49\xb7\xb9\xc4\x23\x81\xfb\xb1\xb9\xb0\x02\xd0\x90\xe7\xcf\x65\xec\xcb\x4a\xb2\x64\xaf\xf0\xa0\x5d\xb0\x93\x13\xea\x05\x60\x8e\xab\xd6\x19\xf1\xaa\x84\x57\x65\x47\x69\x22\x6c\x21\x42\xa1\x3e\xd7\xb4\x58\x35\xe0\x1f\xd9\xcd\x7b\xa4\xc3\x6b\x13\x4c\x0b\x66\x2f\x64\xcb\x94\xe1\x51\x51\x58\x33\xe8\xdd\xdd\x47\xf9\x25\xc9\xb0\x13\x13\xe6\x17\x35\xc4\xaf\xc4\x6f\xe4\x19\xad\xd2\x90\x1a\x03\x31\xb4\x18\xf1\xdb\xbc\x2a\x6f\x77\xf4\x09\xd0\xed\xe8\xd4\x61\xeb\x6a\x70\x2e\x84\x82\x7d\xbc\xb9\x02\xaa\x82\x63\x49\xd8\x5d\x43\x9a\x68\x61\x42\x8f\xca\x2c\xf5\x2e\xa3\x7f\xca\x54\x7c\x56\xe3\x52\xd1\x25\x65\x50\x52\x99\x8a\xcf\x1a\xff\xc8\x34\x7e\x43\xf1\xcc\x13\x75\x7d\x66\x2c\x42\xdf\x87\xe2\x5e\x11\xc9\xfe\x3d\x50\xc9\xfe\xb4\xc5\xcd\x91\x94\x9d\x98\x2d\x41\x79\x6a\xea\x7e\x01\x32\x8d\x39\x3a\xa1\x15\x20\x04\x69\xa3\xce\x8e\x42\x56\xee\xd7\x25\x9a\x76\x80\x5a\x6f\x22\x43\x4d\x30\x99\xee\x87\x81\x09\x84\x3f\xb6\xb5\x9a\x87\xe4\x4f\xa0\x66\x4d\x85\x06\x4b\x70\x2c\xd8\xd4\x3e\xe0\xf5\xea\x2b\xf9\xdf\xab\x26\xe9\x28\x2e\x11\x1b\x9a\x48\x00\xf4\xa7\xff\x15\xce\xcc\x7b\x3d\x26\xcd\xa4\x8a\xff\x55\x1d\xc0\x43\x57\x33\x68\x47\x2b\x55\x71\x80\x7e\x20\xd1\x5b\x85\x9c\x14\x8c\xa4\x19\x9b\xa9\x1a\xad\xdf\xbf\x57\xab\xed\xc7\x40\x67\xc5\xdd\xae\x0c\xf6\x66\x4d\x21\xb0\xb9\x06\xa3\x85\x0a\x67\x72\x51\x15\x8b\xed\x85\x41\x2b\x90\x6e\x05\x5d\x56\x1f\x06\x04\xc4\x65\x96\x4d\x86\xbe\xb9\x53\x93\xbd\x32\xc5\x26\xe6\xd1\x37\x8f\x78\xc0\x32\xf3\x8a\xc3\xdd\x4d\xac\xe7\xa9\x29\x35\xf3\xad\x74\x23\xbf\x63\x39\x49\xc2\x7b\x02\xbd\xb0\xd1\x83\xf4\x19\xe7\xca\xd7\x80\x39\xa1\x64\x00\xb4\x3b\x2a\x07\xc9\x49\x11\xe6\xb1\x1a\xe9\xa7\x82\x2c\xe0\x1b\x8c\xbb\x8f\xbf\x46\xc4\x84\x95\xc9\x26\x6b\x81\x5b\x64\x3a\x46\x80\x39\x56\x85\xd9\x59\x08\xbc\x4b\x79\xda\x23\x04\x7b\x39\x62\x4b\x8b\x9f\x3d\xe2\x17\xf4\x25\xb7\x18\x2a\xe2\x11\xc9\x32\x24\x15\x68\x4b\xc2\x19\xa9\xee\x9a\xa3\xa3\x08\xa5\xdf\x09\x99\x77\xea\x7a\x30\xa8\x13\xc9\x21\xc7\x3f\xa2\xa9\x75\xb9\x64\x35\xf6\x11\x93\x15\xef\xa5\x68\xba\x0a\x63\x78\xb3\xe4\xa6\x2a\xa6\x00\x3b\x30\xca\x8a\x75\xb1\x9b\xd3\xed\x58\x44\x28\x93\xc2\x05\xeb\x0b\xa8\x01\xe7\x07\x39\xea\x77\x64\x72\x89\xc8\x2d\x8e\x11\x44\xe7\x52\x5a\xc8\x33\x2b\x0b\xe1\x8d\x53\xed\xa0\xab\xec\x89\xa6\x85\x0d\xfb\x50\x19\x2b\xda\x8e\xf1\x77\x04\xa4\xe6\xf1\x53\x81\x87\xd8\xcd\x84\x1d\xc3\x06\x76\x42\x28\x5d\x53\x58\xdd\xb2\xa2\xee\x9c\x0e\x01\x16\xa1\xe2\x50\x2a\x5d\xd5\xa1\x68\xb7\x12\xed\x86\x66\x9e\xb0\xff\x8e\xbc\x00\x68\x81\x2e\x9f\xd0\x39\xf9\x16\xc4\xbf\xd4\x75\x80\x43\x37\x27\xdf\x19\xda\xd7\x29\x39\x00\xc8\x9b\x05\x7f\x0e\x95\x1b\x63\xf3\x11\x9f\xf1\xc6\xac\xb4\xdf\x1f\x7b\x19\x7e\x82\x58\x58\x5a\x04\x6a\xd1\x63\xb7\x4b\x59\x6a\x3f\x21\x09\x4c\x09\x08\x5d\xbc\xa8\x31\x29\x8e\x8e\x44\xa6\x3c\xc5\xa6\x62\x04\x7c\x63\x27\x22\xcb\x87\xd6\x80\x46\xbc\xf2\xad\xc2\xb1\x8e\x72\x8f\xa9\xc2\x05\x7d\x6a\x4e\x45\x6c\xcf\x15\x52\xb0\x53\x35\xaf\xce\x8c\x42\xbb\x54\xa7\x79\x56\x9d\xe7\x64\x92\x18\x52\x88\x88\x7d\x05\x93\x14\x1d\xb8\x12\x15\x0a\x17\x97\x9d\xd9\xd1\xbc\xf2\xaf\x65\x92\x8f\x56\x2f\x91\x99\xd4\x88\x27\x35\xe2\x49\x95\x56\x82\x38\x97\xd1\x4c\x43\x7a\x48\x6a\x3f\x91\x3d\x97\x58\x8b\x9e\xc7\x88\xe6\x91\x05\x64\x43\x20\x7a\x38\x2c\x79\x42\x61\xc9\xf1\xaf\xec\x6c\xe5\xc5\xc2\x4d\x0a\xdc\xd5\xa0\x8e\x5e\xe5\x62\x5c\x72\xe8\x82\x79\x70\xc1\xdc\x79\xfd\x7a\x5c\x05\xc8\xca\xd6\xd0\xc4\x58\xfe\xda\x47\x51\xe5\x86\x87\x25\xbe\xb0\x6c\x2d\xd7\x4e\x71\xbb\x3a\x29\x6f\x6f\x85\xc6\x7e\x45\x7d\x2f\x9c\x03\xae\xd8\xb6\x94\xda\x2d\xa1\x7f\xcf\xca\xdb\xb5\x0f\x85\xa4\x0c\x41\x50\xe2\x4f\x1b\x4a\xa2\xfe\xcb\xa4\x77\x7c\x21\x86\xc9\x72\x98\x30\xad\x80\x77\x5f\xae\x8d\x76\x11\xd7\x71\xa4\xf4\x37\x37\x7b\x2b\x83\x6a\xe2\x0c\x59\x91\xc9\x93\xd5\x1d\x3f\x6f\x50\xd5\x44\xea\x6a\x94\x91\x42\xd1\x34\x3c\x86\x46\x54\x4f\x93\x24\x60\x7c\x55\x47\xba\x64\x1a\x49\x97\xef\x5c\x98\x60\xc2\xc8\x5a\xc7\xd1\xe3\x58\xc3\x1d\x40\x48\x18\xc4\xe4\xfc\xcf\x95\xfa\xd1\xbc\x6d\x63\x0d\x56\xfd\x3e\x00\xd6\x38\xd6\x72\x36\x29\x32\x06\xf0\x23\x09\xae\xd2\x43\x30\x14\x69\x50\xbd\x13\xe0\x4e\x28\x1a\x05\xdb\x51\xd7\x02\x21\x3a\xd2\xf6\x4d\x09\xac\x52\x56\x44\x0e\xa3\x3e\x84\xcb\x60\xf4\xa5\x30\xa5\xed\x91\xbe\x43\xdc\xf2\x0e\x89\xb7\x5d\xba\x7f\x29\x8b\xbb\x7a\x52\xaa\xd5\x78\xaa\x5e\xa2\xcc\xad\x5a\x42\x56\xcb\xd1\xdf\xbf\x83\x1e\xbe\x63\xfb\x4e\xf5\x05\xd1\x0e\x01\x40\x54\x79\xeb\xff\x75\x08\x6c\x6e\xb8\xdb\xfb\x8f\xe0\x41\x0b\xd9\xbf\x1a\x0e\xe5\xa9\x0d\x7b\x28\xb4\x02\xed\xdb\xe1\x47\x23\x22\x77\x2a\x74\x0a\xf2\x6a\x11\x46\x75\x46\xb5\x15\x3a\x13\xac\x63\x22\x36\x20\x63\x9d\xc2\x15\xb1\x3a\x3a\x24\x1a\x27\xa7\xc6\xa4\x4a\xa5\x6c\x34\xdc\xb8\x4f\x3c\x15\xaf\x04\xa0\xa7\x91\xc5\x2e\x9c\x48\xa1\x31\xfa\xb4\xc1\x03\x5f\xae\xa1\xd2\x9e\x74\x8f\x63\x9c\x19\xaa\xc8\x24\x6f\xd6\x81\xc3\x2e\x79\x29\xac\x35\x24\xc3\x47\x1c\xf7\x28\x45\x9f\xf3\x3b\xf9\x28\x22\xed\xce\x17\x31\x2c\x15\x7b\xa6\x13\x62\x9d\x29\x3e\x31\x16\xd9\x46\xe0\xec\x1d\xe5\xa4\x4b\x06\x5e\x41\xf7\xe3\xf8\xf0\xb3\xca\x0f\x74\x8f\x54\x98\x92\x10\x0e\xf4\x28\x90\x1e\xff\x31\x8e\xeb\xb7\xe4\x3a\x60\x5c\x21\xd0\x30\xfd\x93\xea\x06\x6b\xfa\xf4\xf4\x9d\xbe\x71\x1c\xf4\x6f\x84\xfd\x33\x1f\x9d\x8a\xbd\xd9\xe8\x57\x55\xba\x64\xa4\xe2\x48\x33\x7c\xc9\x91\xcf\xfe\x93\x7f\x1e\x51\x94\xea\x46\x9c\x89\xa0\x12\x07\x4b\x7b\x3a\x9a\x10\xa3\xbb\x51\x7a\x0c\xae\x0c\x09\x52\xf1\x7a\x41\x11\xd1\xa3\x5a\x44\x24\xa4\x29\x69\x56\xb5\xae\x98\x5b\x89\xae\x80\xc7\x82\x32\x9c\x98\x84\x91\xaf\x63\x93\xd8\x4a\x14\x74\x88\xc0\x03\xa5\x55\xe6\x05\x97\x02\x23\x36\x28\x6d\xb9\xd4\xd6\x19\x4c\x82\x54\x6b\xcb\x45\x9e\x37\x49\x7c\x37\xac\xc6\xd8\x81\xc1\xf4\xf0\xe2\x33\xe6\x4a\x72\x5d\x89\x74\x28\x45\x55\xa8\x10\xa5\x54\x01\x8a\x69\xd0\xe9\x33\x0d\x8a\x0d\x27\x65\x9c\x96\x89\x0a\x7d\x4d\x26\xbd\x6a\xc8\x3e\x87\xa5\xb8\xaf\x47\x6f\xe7\x80\xf3\x36\x78\x42\xfb\x0c\xb4\x1c\x13\xb4\xea\x93\x4b\x82\xba\x3a\x7d\x25\x0c\x8f\xed\x7b\xb8\x46\xf0\x21\x19\x54\x55\xed\x0e\x36\xbc\x39\x9e\x80\xc5\x8a\x40\x36\x40\x39\x5f\x84\x24\xba\xe6\x99\x39\x34\x6f\x2d\x02\xb6\xb4\x6e\x08\x80\xfa\x43\xbf\xec\x09\x8b\x43\x36\x2f\x8a\x3b\x62\x90\x63\x71\x62\xa9\x63\x4f\x09\x66\x3b\x23\xf6\x4c\x5e\x75\xab\x15\x13\x98\x87\x51\xfd\x26\x56\x54\x71\x2d\x31\x06\x35\x70\x80\x95\xac\xcd\x46\xcc\x82\x03\xed\xff\xb0\xd9\x27\x8e\xf6\x28\xc7\x7a\x7e\x79\xdb\x3b\xcf\xbd\x56\xa2\x27\x8a\xa4\xb6\xa3\x86\x80\x31\x25\x55\x19\x71\xa3\x9b\xa7\xd9\x61\xa4\x90\xf9\x6b\xc1\x1f\xa4\x9a\xee\x8f\xe5\x37\x93\xda\xce\xf1\x39\x9c\x2f\x57\x8f\xd7\x96\xd2\x0a\x3a\x8e\xf8\xd6\x96\x3d\xcf\x31\x1a\x3d\x28\x27\x58\x07\xe9\x68\x1d\xe3\x0c\x26\xb2\x60\x78\xc0\x1c\x4c\xaa\xe2\x34\x5c\xa9\xcf\xe0\x34\xbe\x4d\xff\x34\x4e\xfb\xa1\x5a\xcd\xa7\x71\x9a\x65\x5b\x34\x35\xb0\x12\xa2\x5f\xb8\x59\x1d\xb7\x35\xfa\xf7\xdf\xc3\x6d\x67\x27\x51\x95\xe9\x00\x9d\xd1\xe2\xff\xa4\x32\x49\xe1\xaa\x0a\x12\xfa\xf3\x48\x26\x24\xc5\xc8\x3f\x89\x50\xb0\x30\x3b\x6d\x57\xe8\x22\x8c\x58\xf5\xbb\x5d\x35\xa4\x86\x7e\xd0\x59\x1f\x29\x15\x3b\x96\x0c\x96\x14\x26\x81\xda\x41\x6e\x61\xe8\xf9\x71\xa4\x7c\xaa\xe9\x4b\xad\xc3\x21\x69\x26\x92\xc7\xc8\x6d\x8a\x92\x9a\xfe\x08\x11\x85\x9c\x1e\xd4\x37\x2f\xd1\xc6\x02\x5d\x3e\xbc\x80\x27\xe1\xc4\xcb\x70\xb7\x43\xfd\x73\xfc\xc5\x8f\x9d\x23\x5d\x4e\x59\x67\x3c\x21\x7a\x4b\xbd\x42\x1d\xfd\x27\x86\x15\xda\x7e\x15\xcc\x1d\x7e\x54\xd1\xb1\xb0\xdf\x74\x8d\x34\x5b\x82\x89\xb9\xba\xe2\x46\x4b\xdd\xa8\x18\x10\xd5\x3e\xfe\xb3\xad\xd4\xb8\x25\xb9\x52\x18\xe6\x2d\x0d\x93\x37\xeb\xe5\x47\xb4\x34\x0b\xef\x5e\xd1\x1e\xc1\x69\x4a\x97\x4b\x69\xaf\x26\xdf\x7e\x90\xba\x0a\xf0\x49\x79\x0b\x59\x6b\x4c\x2f\x97\xf2\xe9\x66\x97\x7e\x1f\x62\xec\x58\xf2\x03\xf0\x0d\xdb\xb6\x08\x65\xdb\xf2\x3c\x29\xd8\x35\xe3\x4c\x54\xc8\x04\x05\xc5\xec\xa5\xa7\xe2\xaa\x83\x58\x4c\x89\x38\x52\xc0\x07\xd3\xcb\xfd\xe5\xf6\x72\x7d\x99\xcd\x6c\x6f\x90\x59\x54\xf1\xb7\x65\x9f\xfb\x52\xdf\xcc\x8e\x5c\x47\x4b\xef\xa1\x66\xfb\xb1\x4a\x23\x25\xc9\x53\xcc\x6b\x53\x35\xb3\xfd\x20\x1a\xdd\x4b\x4b\xd6\xe8\x9d\xc6\x1a\xc8\x49\x49\xcd\x42\xd5\x04\x40\xad\xe1\xb2\x23\x01\xfd\x67\x57\x1a\xd2\xd9\x5d\x9b\xab\x93\x10\xbd\xfc\x85\x76\xcc\x20\x15\x6a\x91\x45\xd1\xd3\x42\xc6\x39\x4c\x83\x0c\xa5\x40\x18\x0e\x19\x69\x8e\x8a\x5e\xc1\x99\xd3\x4b\x31\x64\x84\xa7\x7d\x92\xa5\x91\xc0\x77\x0c\x26\x69\x22\x15\x46\xd3\x9c\x8e\x43\xa3\xee\x0f\x1f\x66\xf4\x21\x39\x08\x49\x7a\x01\xbf\x8d\xf1\x96\x0f\xfa\xba\xc2\x9b\x1a\x14\xa5\xcc\xa5\x2a\x5a\x63\xbe\xc5\x1c\x60\xaf\xe2\xba\x46\xda\xd4\xfe\x5f\x9e\x71\xab\x95\xcf\x4c\x7a\xa7\xe1\x7a\xa5\xc2\xe7\x22\xba\x52\x63\x71\x9c\xff\x97\x96\x89\x5f\xdb\x16\x0b\x23\x5c\xa2\x54\x58\xd5\xa2\x73\xc4\xff\x74\xf1\x58\x64\x50\x5f\x3c\xeb\x72\x44\x4e\xc8\xf8\x94\xce\x6e\x64\xfb\x96\x44\x83\x43\x16\x90\x9a\x0d\x22\xa5\x28\x95\xf5\x43\x36\xbc\x7a\x49\xd2\xb2\xf8\xb1\x59\x7c\xab\x97\x95\xc5\x8f\xf5\xe2\x23\x8d\x89\x1e\x8e\x4e\x87\x52\x8f\x18\x10\xab\xeb\x0d\xb4\xe8\x3d\x5f\xbd\xc8\xa6\xe8\x3c\x6f\x59\xf4\x08\x35\xc7\x71\x91\x52\x74\x47\xca\x3d\x01\x3e\x21\xad\x8c\x0b\x39\x00\x33\xf2\x48\xde\xbe\x1b\x57\xf5\x30\x55\x6a\xfe\x94\x8f\xd0\xc0\x0c\x00\x03\x3b\xed\xb4\x7c\xfa\xfd\x7b\x7d\xa4\xbd\x7f\xef\x68\x47\xeb\xbb\x0a\x4a\x6c\x24\xe9\x85\xc6\x10\xde\x4c\x96\x3a\x8e\x6f\xcb\xbd\xab\xf5\x12\xf6\xf4\xd8\x33\x94\x1c\x56\xfb\x1e\x06\x68\x8d\x02\x04\xbb\x10\xc1\xae\xba\x13\x12\xb9\x13\x5a\xe1\x9f\x36\x47\xeb\x1e\xd0\x70\x1e\x11\x7c\xab\x00\x9a\x63\x1d\x32\x53\x1e\x17\x39\x1c\x17\x97\xdb\x73\x40\x20\x74\x6e\xdc\x3d\x1a\xea\xb3\x03\xe8\xe3\x2a\x29\x09\x0c\xc6\x29\x1c\xa4\x3c\x59\x8d\xc9\x10\xed\x04\x8a\x48\x82\x1a\x60\xb6\xc9\x77\x25\xf5\x97\x8e\x1b\x3e\xd7\x48\x03\x24\x99\x54\x41\x54\x01\x31\x0a\x7c\xd0\x83\xb0\x50\x51\x34\xd3\x80\xac\x0e\x6b\x57\x51\x90\xde\xc3\x0c\xa3\x59\x92\xb2\x66\x09\xdf\xe2\xa6\x6d\x6e\xf8\x94\x83\x30\xf8\x0c\xd6\xc7\xc1\x7b\x57\xa4\xcf\xa0\x45\x4b\xa5\x5a\x86\xe3\xaa\x27\xb7\xfb\xc2\x9d\x91\x0a\xae\x24\x33\x23\x8b\xcc\x8c\x0c\x8c\xa6\xc2\x21\x86\x8e\x0c\xc7\xa8\x2e\xe6\xef\x52\x85\x89\x8d\x07\x30\xbb\x33\x69\x4b\x4f\xd2\x4f\x76\x23\x6f\x76\x03\xef\x3f\x08\xaa\x4d\x27\x3c\xd4\xda\x88\x81\xaa\x65\x39\x4f\xab\x85\x88\x02\xc1\x9c\x82\xc8\xcb\xb0\xa5\x31\xce\x5b\xac\x0c\x85\x2c\x50\xd2\x26\x9f\x74\x1b\xf8\xe7\xc8\x60\xd9\x19\x3b\x5a\x4e\x87\x54\x98\x25\x8a\x96\x6e\x12\xd1\xef\x93\xea\xcc\x9c\xf7\xc3\xf1\x28\x23\x41\x36\x5b\xaa\x78\xb9\x44\x4b\x2e\xd6\xfd\x10\x2c\xa1\xb3\x09\x48\xb4\x8c\xa1\x84\xbe\xd4\x22\x53\x01\xb0\x86\x17\x18\x34\x26\x23\x11\x86\x4f\x21\xb5\x32\x00\xb4\x91\xaf\x2e\x19\xf0\x70\xbe\x48\x27\x73\x1f\x92\x7d\x34\xba\x2d\xc6\x05\x6b\x31\xc4\x41\x32\x2d\x66\xc2\x35\xa2\xb2\xc3\xa1\x40\xdd\x39\x8f\xec\xa8\x2d\x31\xd9\xc4\x12\x93\xf9\x4a\x56\x52\xa3\xda\x54\x3e\xdd\xbc\xb9\xf0\x81\x45\xe2\xaa\xbc\xc3\xa1\x63\x09\x1d\xec\x22\x68\x52\xcb\x81\xd8\xd1\x46\x98\x5d\x77\xe2\x86\xe4\xcd\x25\x34\xab\x15\x8d\x65\xa0\xaa\x48\x7b\xb0\xcd\x1b\xc6\x6b\x15\x41\x83\x99\xd4\x8c\x76\xda\x55\xaa\x0c\x72\x6c\xad\x5a\xc6\x7b\x79\xbf\xef\xa1\x52\x6d\x0e\xb3\x92\x18\x86\xc2\x38\x61\x33\x90\x2d\x6b\x65\x73\x6d\xec\x20\xa2\x3a\xba\xbe\xc3\xe8\x7e\x96\x77\xae\xda\x42\x06\x50\x4c\x64\xc7\x63\x85\xce\x97\xa2\x44\x23\xe3\xac\xd1\xdf\x95\x8d\x3d\x6b\xca\x2c\x2c\xd7\x80\xc6\xde\x68\xa2\xfd\x59\xdb\x03\x40\x5d\x3f\x9e\x53\xc2\xce\xca\xd4\xeb\x68\xa4\xaa\xc4\x56\x55\x5b\x24\xdf\x1c\x27\xd1\x53\xc3\x29\xba\xdc\x2a\x13\xa7\x5c\x03\xde\x92\xc2\x50\x4f\x62\xfd\x42\x0a\x0d\x64\xc0\x1c\xfa\x39\xa8\xa8\x36\x18\xfe\xc6\x7b\x70\x3e\xd6\xbb\x55\x7a\x63\x15\xcd\x28\x37\xd2\xd7\x95\x8e\x99\x4c\x0a\xcd\x32\xe2\x67\x30\x85\x8d\x91\xcc\xc4\x75\x70\xc5\x18\x1b\x36\x22\xfb\x51\x9f\x70\x14\x5b\x3f\x12\x5b\x2b\x4f\x47\x1c\xa0\x02\x26\x8a\x9f\x09\xd7\x89\xc1\xf3\x29\xd2\x67\x11\xa4\xa8\x37\x9a\x08\x94\x07\xa4\x35\x7b\x67\xfb\xbd\x53\x48\xfe\xf7\xba\xd7\x88\xa6\xeb\x91\x8a\xa6\x26\x07\x07\x44\x08\x42\xda\x36\xb8\xb6\xda\x84\xee\x6f\x07\xac\xaa\xe1\x89\xad\x0c\x18\x0a\x3c\x70\x60\x7d\xea\x33\xc1\x8f\x33\xdd\xbb\x86\xb3\x21\xb2\xa2\xb0\x20\x6e\xb2\x42\xb0\x5c\x8b\x66\x48\x69\x60\x45\xc9\x23\xe2\xee\x1d\x77\x0d\x70\xc7\x23\xff\x4b\x61\xcd\x01\x74\x41\x47\x9f\x85\x74\x13\xe2\x30\xb0\x0a\x4d\x5a\x63\x8e\x6e\x3f\x1b\x73\x54\x4a\xa0\x54\x98\x42\xe9\x51\x27\x32\xe1\xa2\xd4\x63\x40\xd6\xb7\x0a\xab\xa3\x55\xb6\xbd\x95\x63\x41\xa2\x94\xb2\x11\xaa\xf2\x9a\x1d\x43\x66\x80\x77\x4a\x35\xfb\x87\x83\x7e\x94\x97\x83\xa9\x88\x65\xf8\x49\x16\x99\x66\xb0\x78\xe5\x40\x85\x8f\x92\x6a\x28\xbf\xc0\x94\x97\xb7\xae\x0a\xfd\xb7\x08\xca\x4a\x5c\xd6\xc3\xe1\x5a\xa8\x05\x5f\xf4\xae\x3d\xd2\x89\x9f\xdb\x81\xab\xc6\xf3\x71\x2d\x65\xc3\x98\x6c\x4e\x2e\xcc\xc7\x88\x79\xd1\x9e\xa0\x16\x59\x0d\x40\x45\x96\x2b\x6c\x2f\x76\x80\xa9\x65\x55\xdc\x31\xa0\x0a\xbd\xa3\x61\x3d\xa0\xf1\x0d\xf3\x1e\xd0\xfd\xe8\x54\x68\x4c\xde\x0b\x41\x7e\x31\x9a\x2c\xfc\x52\x07\x96\xc5\xb1\xac\x54\x7c\xd0\x79\x3d\x38\x28\x7f\x34\x53\x31\x74\xe7\x2a\x54\x29\x80\xc7\x0a\xc3\xb3\xf2\x9c\xce\x51\x24\xba\x0a\x96\xdd\xee\x7c\xba\x9c\x59\x39\xdd\xee\x2b\x18\x31\x49\x48\xe4\xaa\x57\x3e\xd1\xa9\x7c\x21\x1f\x35\x22\xed\x18\x21\x14\x77\xfe\x1a\x57\x37\x6a\x89\xd5\x83\xf7\x90\xa5\xd6\xd5\xe9\x76\xcd\xb3\x6c\x6f\xc3\x37\x85\x6a\xf1\x01\x46\x5e\xb9\x28\xb7\x5a\xb2\x24\x4f\xd3\x8a\x29\x80\x91\x57\x87\x02\x8a\x19\x9c\xe2\xd0\xd0\xe9\x08\x3e\x10\x74\x9a\xb0\xc5\x7a\xaf\x43\x0f\xb1\x06\xb7\x2d\x4b\x02\xbc\xae\xa2\xf0\xcc\x0c\x20\xd5\x50\xac\x6e\x96\x4d\x65\x1a\xa3\xcd\xa9\x90\xa4\xd9\xe5\x80\x14\xf9\x3a\x00\xe3\x22\xbd\x95\xdf\x27\xe8\xdc\x01\x43\xce\x56\xda\x77\x13\x76\x69\x8a\x4a\xb8\xad\xf3\x87\xcc\x66\x23\xd0\x51\xdd\x4a\xb6\x81\x97\xeb\xf1\xb0\x4e\x46\x45\x51\xdd\xc0\x31\xb1\x19\x21\x1a\x95\x72\xe2\xb7\x32\x9e\xf6\x29\xb5\x3e\xcb\xbc\x37\x9e\xb4\x55\x18\xe3\x85\xbe\x3e\xdc\xf4\x51\xeb\xe0\x01\x73\x46\xa7\xcd\x99\x3c\x80\xce\xd4\xd9\x73\x86\x91\xc9\xce\x60\xf2\x8b\x3f\xd2\x33\xb6\xd5\x3a\xe3\x70\x65\x67\x14\x06\xed\x2c\x89\x96\xfc\x40\xb1\x8b\x30\x6e\x30\x3f\xdd\x6c\xf8\x17\xf9\xc5\x33\x1d\xee\xe8\x4c\x45\x38\x3a\x33\xd1\x90\xce\x4c\x04\x24\x8c\x49\xb9\xce\xa1\x21\x22\x0e\xce\x76\x37\xd1\xaa\xd8\x63\x6c\x4a\xaa\x17\x7e\x37\xa8\x4a\x89\x0f\x50\x7d\xba\xdd\x96\x30\x74\x8e\x14\x0a\x88\xe1\xa6\x1a\xe9\xb1\xa9\x13\x50\xbd\x30\x30\x37\x1a\x0d\x29\xfb\x90\x19\x74\x3a\x4f\xa5\x83\xdb\x58\x85\x06\x93\xd3\x19\x35\x17\x7d\x8e\x03\xfc\xc4\x92\x9b\x11\x23\xb9\x61\x06\x8d\x71\xb5\x59\xbf\x70\x39\x90\xd3\x1f\xc0\xd1\xa5\x62\xe7\xa1\xb2\xbb\xc9\x61\x8b\x59\xd4\xc2\xe0\xe0\x7d\x3a\xc4\x1e\x47\xd8\xb3\x23\xe1\xb5\x81\x49\x55\x7b\x40\x9e\x0b\x72\x43\xa0\x32\x84\x0a\x4f\x57\x8d\x9f\xec\x1d\xc7\xf5\x43\x24\x62\x82\xeb\x66\x53\xb7\xa5\x95\x1e\x93\x6b\x18\x9b\x39\x28\xdb\xa9\x4e\xe4\x8d\x91\x62\x68\x46\x55\x0f\x19\x4c\x85\x5d\x56\xa0\x3e\xdb\xd0\xeb\x8d\xc8\x6d\x3b\x87\xa7\xfe\xef\x37\xdc\x1f\x8d\xd3\x49\xa5\xfa\xd4\xf3\xdd\xa4\x35\xbe\x9d\xe9\x8d\x34\xf4\xc0\x0a\x88\x79\x22\xba\x6d\x81\x52\xb3\x65\x19\xb3\xce\xf9\x55\xa4\xf4\x40\xc4\x12\xd9\xf8\x09\xd2\x6c\x74\x2b\xf0\xdd\xdb\x37\xaf\xdb\x68\x45\x4c\xe7\x12\x2e\xb2\xb2\xa4\x49\x41\xaf\xbf\x7e\xff\xaa\xe9\xc1\x95\xe4\x7d\x68\x5a\x55\x57\x91\x8b\x3c\x8b\xf6\x24\x8f\xd0\x71\x40\x08\x30\x1c\x3c\x7b\xf3\xfd\x0f\x58\xe1\xd6\xe3\x8a\x5f\x6c\xcb\xd5\x5b\xfa\x9c\xe8\x3f\x00\xde\xf3\xbb\xd5\x12\x5a\x66\x7f\xcf\x89\x07\x9f\x4a\x0c\x61\x7c\xc2\x76\xe2\x13\x21\x86\xa8\xca\x2d\xed\x44\xcb\x5a\x0c\x80\x05\x53\x5c\xe7\xe5\x1a\xc8\x5c\xa8\x0b\x46\xe3\x9f\x39\x3d\x0a\xe1\x45\xf3\xb6\x82\xe9\xf9\xcb\xe0\xe1\x83\x73\xb1\x86\x27\x77\x3a\xe9\xce\xbc\xf7\xc1\xf4\xf7\xee\xec\xe1\xb9\x28\x89\x18\x1e\x3c\x9c\x00\x81\x79\x76\xb9\x9f\x3d\x74\xa7\xbf\xa3\x08\x64\xf6\x10\xa8\xe0\x7c\x25\x36\xea\x86\x2d\x02\x40\x3f\xc0\xb9\x85\xff\xfa\xbb\x7d\xb9\x0d\xf3\xf4\x30\xe8\xf5\x69\x47\xee\xd0\x72\x22\x83\x73\xff\x00\x38\xe3\x70\x5b\x24\x18\x67\xcb\x87\x16\xaf\xe5\xe7\x7f\x7f\xfe\xee\xf0\xed\xf3\x27\xcf\xd0\xee\x72\x8b\x69\x97\xe7\x97\xe7\xe7\x62\x17\xa1\xd9\xdf\x9e\xfe\xde\x44\x81\xf3\xf0\xdc\x51\x66\x88\xce\x43\x40\x2c\x1f\x5a\x54\x38\x42\x60\x81\x3f\x44\x74\x8b\x13\x2c\xf8\xd7\x88\xf9\x6f\x6d\x31\x7f\x55\x7d\xa5\xb9\x90\x5a\xd7\x11\xdb\x1a\x2b\xdb\x48\xba\x17\xae\xca\x0a\xaa\x62\xbb\xba\x9c\x39\xf6\x94\x7c\x55\x5d\x12\x3b\x3d\xb2\x0a\x82\x33\x62\xe2\xa2\x70\x55\xb9\xcd\x03\x68\x7a\xe8\x08\xbe\x15\x0e\xc9\x9e\x0b\xed\x2e\x95\x36\x74\x8c\xfe\xad\x6a\x79\x44\x77\xc5\xb6\x65\xd1\x5d\x54\x57\x68\x25\xaf\x15\x28\x92\xdb\x47\x66\x22\x72\xa0\x71\x28\xbf\xd0\x5e\x28\xd0\xbf\x0d\x29\xf8\x11\x4e\x0b\xc9\xfd\xcd\xb4\xa2\xf9\x26\x3f\x59\x04\x73\xa9\x08\x7b\x4a\x4d\x74\x71\x38\x00\xd1\x93\x4e\x17\xb3\x49\x36\xe9\x00\x21\xb2\x50\x47\x9d\x8f\xce\x9a\xc3\x7d\x88\x64\x9c\x51\xf4\x5e\x00\xab\x8b\x7f\xd0\xc8\x05\x88\x4d\xcd\x38\xdb\x85\xd1\xa2\x09\x48\xa0\x94\xb4\xd9\xbb\xdd\x9c\x20\xc0\x8c\xfb\x63\x54\xe7\xab\xd7\x83\x70\x11\xde\xbd\x4d\xf7\x7b\xe8\xdb\x6e\x90\x01\x5e\x95\x96\x2b\x14\x19\x5e\x5b\x31\x45\x9e\x11\x31\x4d\x63\x74\x90\xe4\xa2\x0b\xb6\x49\xe8\xe3\x05\x5e\x02\xf3\xe7\xa1\x47\x38\xca\x34\x2e\x08\xad\x78\x94\x30\x69\x14\x3a\xc8\x72\x61\xf8\x47\x74\xca\x99\xa7\xbc\xc8\xda\x91\x2b\x29\x3d\x38\x49\x15\xc3\x90\x30\xf0\x2a\x0e\xb5\xd0\x8c\x95\x89\xcd\x4d\x5e\x35\xc3\xc1\xaa\x58\x49\x92\x9e\x04\x51\x3f\x02\x8b\x03\x83\x4a\xbf\x4d\xc3\x04\xb0\xa4\x23\x3d\xfd\xf7\xdf\x71\xe4\x2f\x72\x9c\xe7\x69\x65\xd8\x39\x0a\x56\xe6\xa4\x0f\x8b\x7f\x99\x21\xc0\x18\x46\x85\x5e\x0d\x60\x05\x22\xd8\x53\x57\x47\x19\x3c\x1f\xbe\x8a\xbd\x8c\xba\xc5\x76\x7f\xba\xb2\x98\xf9\x91\x42\x86\x35\x83\xb1\xc1\xb1\x4b\x06\x27\x74\xf9\xd0\xc3\x8c\x19\x9a\x92\xa5\xb2\xc6\x9c\x7c\x8b\x62\x0c\x2a\x34\x87\xcc\xd5\x42\x67\x13\x37\xeb\xf0\xc0\x31\x9e\xba\xea\x48\x06\x38\x0a\xa3\xa6\x28\x32\x49\x4f\xef\x93\x3a\x90\x1b\x93\x61\x04\xf7\x2b\x7b\x6a\xe5\xee\xa2\x79\xb8\x9a\x8e\x66\x1c\x97\x86\xec\xd6\xac\x0e\x7b\x8b\x69\x5e\xbf\x38\xac\x0c\x28\x9f\x8d\x33\x60\xc8\xe5\xa2\xa8\x0b\x14\x72\x69\x89\xf4\x30\xad\xc0\x8b\x22\x5d\x26\x68\x6c\x43\x21\x55\x5a\xd2\x67\x18\x39\x11\x83\xcc\x50\xdc\x53\xec\xe2\x0b\xd2\x68\x47\x6e\x23\xb0\x13\xe8\xfc\x57\x43\xf0\xc8\xb2\x4d\x58\xcd\x63\xb3\x0c\x2b\x19\x2e\x0c\xeb\x3b\x73\x1a\x4e\x23\xcc\x21\xdd\xfe\xb3\xcf\xc2\xc5\xb4\xa0\xc5\xc8\xd0\x62\x0a\x76\x0f\x3d\x8a\x4e\xee\x59\x11\xd2\x48\x72\x90\xda\x34\xdb\x1c\xa6\x4a\x3a\xfe\x32\x55\xcc\x71\x3d\x75\x2d\xf4\x46\x17\x4a\x68\x50\x30\xc9\x29\xba\x9a\x8f\x7f\xc8\xc2\x80\xe2\xb8\x61\x19\x71\xa5\x57\x14\x6b\xf5\x2c\xf0\xca\xa9\x24\x36\x9f\xc3\x8c\x4c\x9d\xfd\x7c\x5b\xde\xee\x9c\x99\x17\x05\x39\xba\xe5\xa2\x81\xe1\x19\xca\xef\xf2\x60\x5c\x6a\x3f\xae\xbb\x3d\x72\x1f\x95\xa3\x4f\xd0\x8f\x9f\x4f\x96\xbe\xf3\xba\x3c\xe3\x25\xdc\xd1\x1d\x2f\x1c\xb7\x08\x94\x30\x94\x7d\x89\xb3\x70\x54\x57\x52\xaa\x9e\xdd\x0d\xd1\x21\x8e\xc0\xa9\xf7\xa3\xa3\xe5\x87\x2f\x8c\xd1\xf1\xb5\x3f\x14\xcb\x70\xb7\xff\xbe\x4c\x8a\xac\x00\xb6\x05\xed\xe8\xf6\x21\xd9\xd3\xd9\xc8\xc6\xbf\xbf\xd9\x2e\x7d\x79\xfa\x08\x62\x77\x1c\x38\xe2\x1c\x60\x79\x5e\x01\x81\xb2\xf4\x37\x8a\x13\x8f\xd8\x51\x41\x5c\x02\x67\xc6\xa1\xb1\xd0\xdf\x1d\xa4\x61\x3f\x28\xe6\x10\x62\x97\xdd\xc7\x75\xcc\xee\xf8\x68\x6b\x73\xa0\x65\x64\x12\x0b\xa6\x76\xce\xef\xfa\xb7\xb7\xb7\x7d\x58\xd0\x55\x1f\x5a\x4e\xd7\x31\xb0\xef\xc9\x98\xc2\xca\xa3\xf3\xa0\x9f\xde\xbd\xe8\xff\xa7\x23\x90\xca\x42\xc3\x40\xb2\x48\xba\x89\x38\xea\x0e\xd3\x1d\x9b\x65\x88\x84\x2b\x05\xe9\xe0\x14\x7c\x74\xc4\x1d\xbe\x57\x5a\x5a\x2d\xc5\x99\x26\x55\xc4\x62\x47\x9e\x6c\xad\x02\x98\x22\x4b\x2c\xc2\x0f\xa1\x8c\xa4\x74\x54\x7d\x87\xd6\xb1\xce\xf3\xcb\x08\x7e\x2e\xa3\x73\x6e\x12\x5e\xf1\xf7\x9c\xeb\x83\x37\xfc\x85\x5c\xbc\xe3\xb5\xf7\x10\x7f\xec\xa8\x44\xa0\x65\x1c\x39\x0a\x95\x84\xfe\xa5\x55\xb7\x54\x1a\x52\x76\xdc\x03\xb9\x9b\x71\x06\xa8\x8b\x8e\xcf\xf4\x17\x53\x5f\x67\x34\x66\x9c\x68\x7e\xc5\x5a\xf0\x3a\x44\x93\x8d\x32\x1d\x47\xee\x1b\xe2\x10\xce\x57\x73\xb6\xf0\xd2\xab\xb5\xba\x43\x8f\x87\x47\x0d\x1c\x37\x27\xb4\x8d\xa2\x09\x1c\x5f\x74\x82\x55\x0f\x2d\x94\x81\xfa\x90\x5e\x4d\xa5\x88\x49\x98\x60\x82\x86\x01\x5d\xb3\x03\xd4\x82\x89\xef\xb6\xe1\x1a\x86\xbd\xdd\x63\xe2\x5e\x26\xd6\x8c\x2d\xdb\xe4\x6c\x8d\xf0\xa1\x31\x1d\x93\x35\xf4\x8a\x1e\x19\xc4\xca\x9c\xad\x37\x1b\x34\x74\x41\x8b\xd1\x60\x35\x90\x43\x3e\x1c\x80\x2e\x34\xaf\x64\x3a\x6e\x1c\xa0\x94\x83\xc5\xf5\x4d\xba\xfd\xf8\xff\x53\x76\x65\xcd\x6d\x1b\x5b\xfa\x7d\x7e\x85\x85\xf2\xb8\x80\xb0\x45\x53\x8a\x5f\x06\x1a\x18\x15\xfb\xfa\x4e\x7c\xe3\xc4\x9e\x38\x53\xe5\x29\x9a\x95\x02\x40\x70\x91\xb9\xc8\x24\x25\x51\x96\xf8\xdf\xef\xf9\xce\xe9\x15\x6c\x39\x95\x17\x89\x68\x34\x1a\x8d\x5e\x4e\x9f\xfd\x43\xee\xa4\x35\xcc\xb1\xa2\x3f\xfd\x1a\x04\xfb\x32\xa4\xe0\xeb\x6a\xb1\x40\x92\x40\x44\xfa\xac\x1a\x92\x5c\x89\xeb\xdf\x20\xf5\xc0\xb6\x40\x5c\x40\xb5\xbb\xde\xbe\xa6\x66\x59\x83\xb6\x63\x16\x11\x7f\x6e\x88\x43\xbb\x2d\x92\x06\x08\xd7\xd0\xf6\xab\x7d\x71\x0f\xdf\x94\xbb\x8f\xbc\xc7\x07\xea\xe8\xc8\x8c\x24\x9f\x21\x82\x74\x4e\x54\xed\x46\xce\x37\xe2\x7b\x66\xc8\x1b\x62\x0c\xb2\xeb\x5a\x02\xf2\xa6\x59\x36\x1b\xd6\xc7\xe8\x30\xc4\x2a\x9c\x8f\x0e\x35\x11\xbf\xaa\x73\xe7\x10\xe8\xa5\x6b\xb1\x89\xd4\x07\xf4\xe9\xa7\xc5\x22\xec\xd6\x36\x92\xdc\x8a\x3b\x55\x4e\x73\xeb\xea\xf3\x3b\xa2\x48\xb6\xbb\xa3\x0f\x71\x62\x66\xa7\x0b\x86\x81\xb9\x81\xe6\xbf\xb8\x06\x63\x73\xcd\x9e\x8a\x95\xda\xc1\x53\x5f\x9b\x7b\x0f\x0a\x12\xf4\x66\x3e\x6e\x7f\xd5\xdc\x46\x14\xc2\x8b\x1a\x59\x5a\x7e\xa4\xa8\xcc\xb3\x6e\x72\xe2\x63\xcb\xf1\xed\xe7\x2f\x6f\x32\x1b\x9e\x5f\x65\x9c\x69\x70\xb8\x15\x7c\xfe\x7a\x24\xac\xc6\x93\xbd\x09\xbb\xac\x86\x7b\x3d\xe7\x1d\x6c\x5c\x45\xa2\xc7\x26\xea\xf2\xf4\xf0\x70\xeb\x21\x21\xb7\x7d\xae\x08\xb3\xca\xb7\x74\x60\xcc\xda\x87\x03\xfa\xf3\xd5\x46\x55\xef\x33\x17\xf5\xb6\x81\x40\xac\xe8\xb5\x72\x2a\x14\x7b\x09\x15\xdd\x8b\x44\x45\x97\x88\x0b\x55\xcb\x3e\x6d\xf8\x22\x85\xe6\x80\x7f\xd2\xf1\x28\x74\x3f\x83\x10\x69\x4d\x6d\x4b\xb6\xfb\xd9\xcb\x4d\xad\x3c\xda\xdf\x4b\x9e\x3f\x87\x7a\x52\x34\x86\x4d\x7f\xd9\xee\x66\xeb\x31\x82\x13\xc4\x84\xb6\xb4\x25\x52\x85\x6a\x5a\xfe\xc6\x78\x60\xb8\x22\x16\x23\xb2\xc7\x45\x94\x24\x19\x69\x4b\x30\xed\xd1\xcd\x9a\xce\x99\xf5\x92\xa8\x7f\x76\x7f\x19\x97\xa6\x70\xfe\x5e\x8a\x40\xc5\x5f\xa8\xf4\x85\xfc\x53\x41\x23\xc5\x4d\xe7\xab\x7a\x90\xc5\xd6\xdb\xdd\x89\x44\x31\x7b\x37\x2e\xb9\x5c\x1f\xe9\x77\xd9\x7d\xd8\x0e\xc8\x26\xcd\x8c\x7c\x15\x94\xb3\xde\x99\xe8\x1c\x3f\xac\xc4\x61\xea\xe9\x07\x0a\xa6\xce\x95\x19\x15\x8c\x2c\x4c\x58\x58\x20\x08\x92\x50\x7b\xd0\x4b\xb5\x24\x0e\x72\x9f\x29\xd9\xe9\x7a\xa5\xec\x2f\xbe\x18\x2d\x3d\xde\x2a\x67\xb2\xfa\x22\x99\x2c\x89\xec\x31\x1b\xd0\xeb\x31\xfb\x1f\x28\xf5\x12\xa6\x88\x88\xde\x70\x33\x29\xff\xc2\x34\xe9\x74\x0f\x0e\x0f\x1a\x8e\xeb\xe4\xab\xe6\x04\xa4\x2a\xbc\x40\x64\x8c\xfd\x5a\xbc\xcb\xf4\xf7\xe9\xfb\xbd\x22\x5d\xe8\x27\x27\x59\x99\x3c\x4b\xf2\xa4\x4c\xb2\x9e\xfe\x5c\xed\x63\x2a\x57\x78\x63\x43\x22\x5d\x6b\x00\xa1\x65\xc5\xae\xdc\xe3\x13\xbb\x2c\x57\xb4\x4a\x9f\x9e\xfd\x59\x24\xbd\x2f\x08\x99\xc9\x27\xbd\xe8\x6b\x12\x5b\x03\x8d\xcf\x27\x86\x1f\xe2\xa8\x71\x9f\x41\x62\x5e\x78\xdf\xef\x12\xaa\x34\x79\x3b\x39\x35\x75\x4e\x3f\xce\x89\x54\x27\xea\xe8\x49\x56\x8a\x12\x77\xf5\xbd\x46\x7e\xa3\x1d\x89\xe4\x50\xcd\x2c\x71\xb5\xa9\x57\xde\xc2\x71\xe3\x88\x2b\x8f\x85\xd2\x5a\xf5\xc6\x2f\xcb\xe2\x6f\x0a\x64\x2a\x15\xb4\x92\xa9\xd8\x03\x3f\x31\xab\x95\xf8\x1b\x95\x85\x9b\x65\x5f\x33\x61\xc3\xf0\xce\xa8\x7c\xf4\x4e\x4f\xb3\xf5\x61\x71\x99\x28\x62\x61\xaf\xeb\x5e\x72\xf1\xe4\x6b\x31\xe8\x0f\xce\x68\x6e\x92\x2c\x77\xcd\x70\xd4\x37\x8b\xb9\x0b\xce\x70\xd2\x9f\xc9\xb1\x92\x45\xfa\xcb\xeb\x4d\x6e\x0f\x17\x02\xc2\xb9\xec\x0b\xa2\xd6\x47\x62\x7f\x79\xd1\xb8\x4b\x31\x4b\xae\xd5\x5e\x2d\xb3\x42\x06\x51\xf6\x90\xdd\x44\x9a\xd6\x92\x9c\x54\x24\xfc\x33\xb1\x1d\xb9\xd7\xe4\x34\x3f\xd3\x9c\xfa\x99\xb2\x71\xd8\x67\x87\x6c\x4f\xef\x4f\x97\xa6\x13\x6d\xb1\x07\x13\xa3\x77\x2a\x9f\xc6\xfb\xbe\x3b\xcd\x8b\x33\xec\xcc\xab\xce\x1e\x64\x70\xb0\x21\x75\x6e\x74\xb4\xb7\x69\x74\xc0\x4b\x63\x1e\x76\x12\x36\x04\xec\xcf\x74\x5e\x3c\x96\xc6\xcb\x7c\x49\xa2\xab\x43\x2d\x68\x9f\xcd\x84\x36\xde\x50\x37\xe0\x25\x4b\x62\xc2\x4e\x7d\xcb\x1c\x45\x63\x20\x55\x1c\x73\x19\xcb\x35\x4f\xee\x2e\xbe\xa5\xa7\x67\xea\x2e\x3b\x88\x7f\x16\x5f\x41\x48\xb1\x3c\x5b\xe2\xa5\x8b\xfc\x26\x4a\x16\xab\x75\x21\xc6\x6b\xa7\xae\xd5\xad\xba\x2b\x9a\x0b\xb8\x77\xdf\x50\xcf\x6f\x8a\x73\x58\x67\x3a\xa1\x50\x73\x04\x9d\x68\xdb\xcd\xb4\x98\xc1\xd5\x4a\x05\xe3\x56\xbf\x1c\x94\x2f\x88\x17\xba\xa4\x5f\xc5\xf9\x80\xc6\xe0\xc7\xc1\xe0\x25\x1d\x61\x3f\x0e\x5e\x20\xf6\x42\x61\xca\xaf\x8b\x6f\x75\xba\xa4\x59\x46\x4a\x87\xeb\xe2\x27\x5c\x5c\xd3\xe5\x65\xa6\x2e\xcb\xb4\xb3\xef\x6f\xe9\x3c\x8c\x28\x23\xde\xd1\x96\xb6\x3b\x9d\x08\xe3\x6d\x8c\x44\x14\xb7\x74\x23\xfe\x3c\x76\xb4\x7d\x4c\x6f\x6f\xaa\x4e\x13\x2b\x1d\xa5\x4f\x83\xde\x10\xa2\xb0\x50\xd0\xf2\x0e\x79\xa9\x4c\x2a\xfc\x5c\x7f\x8f\x94\xee\x96\xa6\x23\x79\x7a\x57\x5c\x33\x3f\xd1\xaa\x05\xfd\x62\xaa\xb9\xa3\x1f\xbc\x24\x69\x58\x4e\x76\xd0\xb6\xed\x8a\x3b\x85\x80\x5d\xe4\x6f\xa7\x16\xb4\x80\x49\x03\xc5\x02\xfb\x00\x68\xcc\x86\x2b\xa1\x31\x33\x3f\x19\xe2\x06\x88\x14\x77\x60\x01\x68\xac\xbe\x06\x79\x3f\xd6\x6a\xb8\x50\x77\x6a\x3f\xca\xf2\xaf\x7e\xe2\x8f\x35\xd6\xec\x9d\xda\x8d\x5c\xa3\xe0\xa0\xd2\x2d\x18\x5e\x3d\x99\xc1\x6a\xbf\x2c\x65\xbd\x6b\xa1\x35\xe7\xab\x37\xd2\x47\x2c\x7f\x7a\xf3\x22\x47\x73\x1b\xce\x15\xe6\xbd\x84\xca\xbe\x20\x5d\x53\xb8\x71\x5e\xeb\x6d\x68\x37\xcf\xe9\xa9\x39\xf0\x58\x8f\x1c\x3b\xee\xd6\x57\x3e\x56\xf3\x9e\x39\x59\x48\x4a\x8f\x45\xac\xac\x8c\xe7\x3f\x2d\xed\x84\xe5\xab\x8c\x9f\xf9\xc8\x62\xe2\x63\x3e\x23\xf2\x8c\xb1\xbe\x3b\x78\xde\xc0\x47\x05\xbe\x5a\x2a\xb9\x22\x9e\xe2\x38\x9d\x75\xd7\xde\x14\x06\xe8\x86\xda\x5a\x76\x79\x63\x0f\x8a\x31\xe7\x76\x36\x12\x91\xc8\x3c\x2e\x0d\x09\x0b\x7a\x95\xd2\xde\x1a\x86\x36\xe7\xad\x28\x0f\x1a\x65\xe8\x1c\x67\x3a\x98\x6f\x3f\x40\xc2\xb6\x39\x05\xe0\xe6\xab\xbb\x6f\x30\xb2\xa3\xfe\x2b\xf2\x4e\xff\x55\xa2\x48\xb0\xaf\x33\x63\x61\x94\x04\x67\x56\x8d\x40\xa4\x45\xab\x53\xd8\xba\xda\xc9\xb7\x70\xbb\xa9\xae\x80\x87\x78\xcc\x9f\xc7\x46\x25\xee\x27\xeb\x39\x49\xeb\xe6\x42\x07\x69\x78\xe3\x09\x76\x0e\x9f\x7d\xec\x31\x25\xa9\x4d\x8f\xf0\xd7\x33\xe0\xba\x0f\x32\x0f\x2b\xd1\x54\x0b\xc3\x12\x02\x2c\x55\x5d\x05\x26\xe9\x0e\x10\xa9\x44\x7e\x72\xea\x1e\x11\xda\x2a\x01\x97\xd5\x7c\xad\x60\xcc\x22\xa6\xf6\xa8\xd4\x61\x30\x58\x6c\x4d\xf1\xf1\xd6\x26\x60\x85\x2f\x7d\xbb\x5a\xc5\x91\xba\xff\xfe\xa0\x71\x53\x91\x61\xfb\x4e\xc6\x04\xe3\x30\x0c\x47\x44\xa3\x55\x49\x5d\xe4\x72\xd9\xb8\xd9\xc8\xf2\xda\x43\x08\xd5\xbd\x8f\xba\x12\x06\xfd\xbe\x78\xdc\x3c\xde\x1c\xcf\x79\x1d\xba\x9d\x66\xb9\xbc\xea\x7a\x15\xbe\x2c\xb4\xc0\xca\xb4\x46\x30\x9a\x3d\xff\x3b\xf1\x1b\x06\x32\x25\x7c\x3b\x9c\x3f\xbe\x05\xf0\x14\xdf\x4f\x87\xce\x4f\xef\x95\x0c\x20\xc6\xa1\xf2\x6a\xd3\x37\xb9\x63\x74\xbe\xb9\xe3\x49\x3b\xe9\x54\x64\x30\x26\x2c\x19\xde\x32\xb1\x5b\xb1\x9d\x1a\x24\xb9\x7f\x39\x80\x3a\xdc\x47\xbc\x91\x92\x47\xc0\x69\x5e\x0e\x44\xcf\xf2\xaa\x2e\x9e\xff\xe7\xf9\xe0\xf9\x54\xbd\x86\xc9\x72\xf8\x79\xf4\xf4\xb9\xfa\x07\x3b\x21\x97\x9f\x57\x54\xfc\x46\x9b\xcb\xc4\x18\xaf\x23\xdd\x1e\xe6\x4b\xd8\xda\xe8\x88\x69\x77\x6c\x64\xe3\xd0\xb7\x7f\x7e\x37\x46\xee\x4b\x7b\x37\x6d\x57\xd9\xf3\xb9\x63\x37\xfe\xa7\xab\x53\x3f\x4a\x0f\xae\x29\x6d\xed\xa1\xc2\x82\x8a\x02\x8f\xcc\x44\x52\x65\x25\x2d\x34\x98\x70\xd1\x5a\x2f\x19\x26\xc4\xbc\x76\x75\x4d\x36\x12\xac\x2d\x6b\xb0\xac\xbd\x64\x94\xa8\x56\x42\xff\x33\xab\xca\x46\xa4\xb8\x7e\xf2\x04\x82\x17\x1e\x46\x27\xc6\x92\x24\x29\xcc\xf0\x51\x67\xf6\x7d\x2d\x37\x57\x0f\xdb\x91\xb4\xa8\x05\xc1\x22\xea\x7d\x29\x80\xae\x9d\x64\x44\x45\x27\xa9\x4f\x59\xa7\xc6\x5f\xb7\x86\xbf\x2e\xd1\xfa\xa1\xf1\x5d\x1d\x15\xa2\x6b\xfd\xbf\xdf\xdf\xe2\xf4\x24\xda\xc5\xc8\xaa\xbd\x84\xe4\xa3\xc8\x9d\x3a\x3b\x04\xc0\xc5\xc2\x47\x84\xda\x3d\x48\x96\x81\xe1\xca\x97\x5c\xbd\xac\x7f\x15\xa7\xfd\x17\x5d\x9a\x76\xfe\x09\x8f\x18\x33\x5f\x01\xd4\x82\x6c\x2a\x76\x8c\x53\xce\x71\xda\x0e\xbc\x97\x81\x90\x46\xb4\x51\x9c\x69\x10\xb3\xec\xb2\x1a\x89\xcb\xdc\x33\x4f\x97\xf1\x8a\x4e\xe4\x5e\xd2\x3d\x60\x88\x4e\xcf\xab\xc5\xfc\x5b\x14\xeb\x50\x4b\xe7\x3a\x8a\x40\x57\x94\xef\xca\x38\xe1\xb2\x5f\xf4\x18\x09\x89\xd2\x7c\x1d\xa4\x2d\xa4\xa3\xd5\x56\x72\xe7\x15\x8d\x24\x50\xce\x2f\x4f\x87\x88\x10\xd5\xd0\x99\x85\xa2\x47\x08\xaf\xbd\x80\x24\x62\xf8\x30\xe6\x9a\x22\xcd\xb7\x69\x92\x3b\xf7\xe2\x67\xcf\xfe\xa9\xf7\x43\xe0\xe6\x0e\x17\xad\x37\xb5\x03\x9b\x36\xc4\x8b\x3d\x60\x1f\x1e\x4e\x3e\x99\x5b\xa0\x62\xc1\xb7\x05\x38\xbe\x9e\x4b\xff\x45\x08\xe9\x28\xda\x44\xb7\x42\x9a\xac\x14\xcf\xfd\x26\xe2\xb9\x7f\x8f\x6f\xc8\xc5\x45\x52\xf1\x22\xc8\x2b\x3b\xa3\xff\xa0\x19\x85\x95\x9f\x39\xac\x3c\x52\xb5\x89\x57\x15\x18\x63\x21\xbe\xc1\x1a\xde\xcf\x36\x7e\xe6\x06\x48\x4d\x5e\xfe\xfb\xaa\xff\xe9\xd7\x77\x3f\xef\x76\x57\x5a\x28\xd5\x12\x54\x0d\xbc\x6b\x26\x8c\x3f\xd7\xc5\xfd\x80\x73\x51\x9c\x9d\x9f\xff\x48\x3f\x5e\x1c\xd4\xdb\xee\xc6\xc1\x4b\x68\x4c\x16\x74\x26\x6e\xb6\xc5\xc9\xc9\x5b\x38\xf0\xdf\xd2\x29\xf1\x7a\xd3\x12\xe5\xdf\xd1\x8a\xda\xc2\x83\xe7\x6d\x8d\x04\xb1\xf4\x60\x41\x4d\xa0\x9a\xee\xac\x15\xc2\xd2\x23\x67\x0f\x97\xd0\x4e\x5a\x7f\x78\x40\xe3\x27\xb5\xaf\xb1\x2a\xef\xb7\x01\xa8\xbe\xef\xfa\x4b\x07\xb5\x74\x8e\x51\x6c\x81\xe9\x9b\x8a\x77\x20\x71\x2e\x50\xf6\xd4\x22\x96\xe2\x8a\x96\x3e\x8f\x33\x22\x30\xb7\xdb\xdb\xf5\x66\x0c\xf6\x86\x9e\x16\x03\x88\xb3\x5e\xfa\x85\x33\xa0\x64\x79\x05\xb0\x56\xd6\x56\x2f\xfb\xec\xd9\xac\xdf\xd5\xe6\xc6\xca\x52\xf7\x08\xc3\x23\xbb\x6f\x83\x2d\x3f\xf9\x74\xaa\xa7\xa7\x1d\x9f\x32\x7a\x36\x12\x19\x44\xcb\x8b\x24\x9c\x4f\x9d\x87\x95\xbb\xdd\x66\xb3\x63\xf5\xc3\x54\xc1\xa5\x9d\x58\x98\xd8\xc9\xea\xad\x9b\x86\xcd\x14\x40\x96\x5c\xb3\xdf\x1b\xff\x10\x6d\x2c\x7e\xb1\xa8\xce\xbf\x58\xd4\x65\xf1\x4e\xbc\xd8\xd8\xf7\x51\x69\x55\x04\x1c\xc2\xcb\x99\x51\x51\xe4\x5a\xac\xe3\x52\x13\x13\x63\xf5\x8a\x33\x2d\x89\x95\x93\x74\xa0\x74\xcd\x2c\x17\x2c\x62\x94\xab\x99\x27\xf4\xe1\xc6\xcf\xf5\xd0\x14\x8d\x90\xab\x39\x52\x4d\xac\x48\x0c\x2a\x33\xb3\x36\x62\xad\xb8\xe5\x3b\x59\xc4\xad\xc8\xab\x49\x55\xca\xfb\x7a\xbe\xaa\x36\x77\xb9\x2b\x3e\xe4\xf7\x6c\x6f\x0a\x2b\x1e\xe8\xcd\x51\xe3\x42\x0a\xb1\x0d\x77\xf5\x48\x36\xc8\xdd\xe9\x8d\x67\x93\x9a\xaf\x75\x11\xe0\x76\x8c\x4b\x37\xda\xe3\x3c\x3a\xde\xde\xa4\xbd\x60\x08\x65\xa7\x7c\x60\xa4\xad\xa8\xb2\xa5\x41\x32\x2e\xe6\x1d\x1b\xbc\x5f\x66\x4b\x74\x2c\x33\xd1\xaf\xd4\x81\x36\x4f\xbc\x43\x24\x27\x97\x51\xbb\xcc\x59\xed\xd2\x68\x8d\xcb\xfc\x70\x64\x1f\xe0\xd7\x34\xa0\x52\x3e\x8a\x8c\x67\xce\xb2\x56\x52\x91\xac\xb4\x39\xd4\xd9\x32\xd5\x93\xc0\xdc\xf9\x48\x79\xdb\x2c\xa3\xe5\xfb\x53\x77\x27\xb0\x8a\xea\xb7\x3d\xff\x5c\x13\xd7\x86\x56\x1f\x50\x31\x93\x62\x36\x85\x06\xf6\x4b\x36\x44\xea\x66\xe2\x92\x88\xc8\x81\x6f\x70\x52\x20\x1d\xd1\xc1\x91\x65\x6b\x37\x4c\xad\xf4\x18\x88\x04\x86\x3d\xa9\x44\x6f\xcc\x09\x34\x44\x83\x2c\xb9\x2a\x3c\xca\xc0\xf7\x24\xdd\x0c\xe4\xd2\xcc\xbe\xc3\x51\xd3\xe8\x3b\xd8\x6f\x22\x30\x3e\xe8\x28\xa3\x0b\x63\x91\x3f\x26\xa6\x90\x7c\x92\xff\x96\xd6\x5e\x12\xff\xc1\x07\xfd\xbd\xb6\x71\x23\xe5\x05\xdf\x79\x2d\xd7\x6a\xbb\x69\xa8\x8c\xa8\x2b\x1d\x4b\xd4\x42\xc2\x7e\xb2\x5a\x83\x13\xd2\x99\xda\xf8\x13\x1a\x17\x7e\x85\xf4\x6e\xa9\x47\x17\x44\xb3\xf4\x62\xf0\x82\xcf\x20\xb9\xc4\xa7\x8e\x59\x69\x1a\x24\x0a\xaa\x21\x90\x7e\x67\xd1\x19\x6f\xc5\x7f\xd5\x60\x3e\x7f\x81\xc7\x5d\x91\x7d\x2e\xd3\xb2\x78\xf6\xf0\x34\x7b\xf8\x5c\x8a\xa7\xa2\xb7\x1e\xa1\x25\xb9\xca\x93\x46\x5b\x43\xc5\xb8\x7d\x65\x8c\xa3\xc7\xf8\x29\xff\xaa\xc5\x6f\x9c\x75\x36\x12\x69\xd1\x4b\xfe\x14\x7d\xbd\xcf\xc5\xc0\xda\x07\xe7\x82\xe8\xba\xc0\x3b\xd8\xfe\x7d\x95\xa8\x20\xa2\xa5\x03\xa3\x56\xf7\xb9\x92\x49\x4f\xf7\x8b\xe6\x6f\xf8\x58\xcb\xca\x84\xfe\x22\xb5\x79\x27\x1e\xae\xd6\xba\x79\xc6\x73\xab\x7d\x65\x3a\x07\x85\xba\xe0\x91\xbf\x74\x71\x00\xb3\xe5\xde\xc9\xd6\x0e\x3a\xf4\xf1\xdf\xb1\x7c\x50\x7f\xca\x97\x30\xc4\x66\xa0\x44\x47\xe2\xf6\x7e\x30\x9e\x1d\xee\x3f\xbc\x89\xe0\x9b\xa0\x20\x85\x80\x1d\x94\xa8\x59\x59\xc3\x93\x0e\x7f\x2c\x93\xf4\x0b\x9b\x55\x48\x24\xb0\xd5\xcd\x80\xd5\x1d\x63\x8e\x19\x38\x63\x69\xd1\xd5\x45\xa0\xe0\x53\xd9\xb9\x24\xe9\x8d\x25\x6e\x0a\xa3\xe2\x98\x4b\x9e\x3a\xf7\x4f\xf8\x63\xdd\x56\xdb\x27\xab\xf5\xee\x09\x56\x12\x2b\x63\xa7\x34\x04\x07\x15\x0e\x49\x21\x5a\x39\x4e\x24\xde\xc2\x14\xdb\x06\x2d\x4f\x0b\xeb\xa1\x7d\xa0\xe5\x7f\x9c\x93\xdb\x12\x8f\x89\x24\x75\x77\x19\x52\x52\xfa\x7a\x69\x8e\xc5\x33\xfe\xf8\x70\xe8\x9b\xce\x50\x62\x25\xc3\xc1\x11\x1e\x50\xd3\x4e\xd0\x03\x92\x9e\x4f\xd2\x29\xab\x7f\xa6\xc5\xc4\x81\x6d\x58\xe5\xa0\x4f\xde\xd9\x55\x83\xa1\xe6\xbb\x7a\x49\x0e\xdb\x8f\x9c\xb7\x55\xe0\xc6\x1b\x8d\x3e\xd7\x1e\x13\xa0\x89\x35\x34\xd1\x63\x71\x96\x28\xf6\xe2\x1d\xc0\x89\x43\x91\x56\x76\xe8\x90\xbe\xca\x61\xdd\xb1\xb0\x02\x93\x31\x1b\x01\xb8\xb4\xa9\x52\xda\x92\x48\x2b\xc0\x29\xf2\x27\x16\xb9\x16\x1f\xeb\x48\x14\xb1\xf4\xed\x66\xda\xa6\x10\x5d\x7d\xd5\x47\x26\xac\xf2\x3b\x8e\x0b\x5d\xf5\x41\xee\x2e\xec\xaf\xd8\x67\xc7\x92\x7b\xbe\xb3\xde\xcb\xef\xea\x78\x66\xc2\x0b\xcf\xa1\x51\xa0\x39\xe0\xd5\xe8\x25\x0e\x70\xfb\x4e\x42\xc6\xc6\xc6\x40\x6d\xe0\xa1\x67\x34\x9b\x9c\xce\x8e\xaf\x06\x0a\xd7\x5d\x91\x3b\x74\x46\xc9\xeb\x48\x3a\x5f\x46\x37\x29\x92\x0f\xef\x3f\xfe\x81\xa5\x6c\x55\x29\x46\x7e\x0e\x94\xa6\x20\x2c\x1d\xbd\xa9\xf8\x37\x69\x47\xaf\xac\x83\x29\x80\xc8\x57\xb7\xd2\xa9\x75\xd4\x4e\xc7\x25\x0e\xa0\xf1\xfc\x06\xa7\x8f\x56\xab\x79\x6b\x8b\x43\x6b\x11\x92\x9b\x8e\x45\x07\x66\xb6\x07\xad\x81\x50\x96\x9b\x1e\x69\xbd\x82\x3c\x90\x93\x87\x07\xcf\x7b\x90\x59\xc6\x5a\x55\x23\xc6\xfa\xd0\x1e\x16\x56\xeb\xed\xac\xce\xca\xa9\xe4\x55\x47\x9f\xef\x5b\x06\x02\x9b\x81\x72\x16\xb3\x63\x08\xc8\x6e\x98\x46\x28\x7d\xf3\x79\x50\xc5\x34\x6d\x3a\xa1\xe3\x38\xae\xd0\x9e\x12\x51\x4c\x6d\xb6\x51\x5f\xd4\x32\x22\x3a\x93\x69\x49\xe9\xaf\x67\xf5\xe0\xe1\x92\xd7\x1d\x75\xab\x8e\x78\xaa\xb2\xb2\xca\xff\xab\xe8\x42\xba\x7a\x11\x69\x87\x95\xd6\xc8\x71\xb4\xc4\x7b\xfe\xf9\x78\x72\x25\xe7\xf0\xe4\x41\x6a\x6b\xe8\xf6\x04\x31\x8e\x1c\xa3\x0f\x58\x9a\x8b\x04\x8c\xef\xbc\xd1\xa0\x26\x06\x1a\xb2\x6f\x6a\x17\xc9\xa6\x5d\x54\xb0\xa2\xc0\xad\xb2\x58\xe8\x5e\xa4\x92\x57\x58\x37\xcd\x86\x14\x35\x77\x05\x0b\x86\x76\x54\x97\x45\xea\x70\xe2\xf1\x02\x24\x50\x9c\xef\x39\x4b\x5d\xf1\x85\x11\x92\x7b\x73\xff\xc4\x04\x74\x15\x82\x35\x61\xa2\x1b\xd3\xcb\x4c\x2f\x52\x06\xc7\x04\x52\xb6\x6a\x8b\x31\x23\x3a\x02\x30\xdc\x07\x10\x9d\x00\x65\x9a\xee\x7a\x45\x73\x14\x1d\x6d\x4f\xd6\x5a\xd5\x26\x4b\x7b\x13\xe4\x3c\x9e\xc1\x28\xa6\x63\xcf\xfb\x9c\x13\x16\xae\x0d\xeb\x2b\xb9\x3a\x9d\xe1\x6f\x6f\xea\xaa\xa0\x23\x5c\x07\x3f\xf4\x35\xd5\xc2\x3f\x1c\x78\x09\x02\x6a\xa6\x1c\x83\x5f\x42\x3a\x06\x52\x81\x7e\xef\x12\x50\x21\x18\xad\xa5\x40\x4c\x79\xba\xa7\x75\x77\x72\xb3\xef\xa5\x5e\x70\xfc\x2e\xeb\xeb\x63\xaa\x77\xde\x0f\xd2\x6a\xdf\x2e\x1e\x93\x03\xb7\x36\x91\xc3\x12\x24\xaf\xad\x12\xc0\x73\x42\x32\xe6\x81\x5a\x18\xc4\x4d\x78\x70\x8f\x43\xbb\x07\x27\x95\x73\xc9\x0a\x26\x44\x87\xe4\x86\x3e\x1f\x00\xb2\x49\xec\x11\x71\xc8\x40\x45\x1f\x67\xe0\x58\xc6\x8f\xe1\x74\x02\xd2\xa2\x86\xd7\x34\xbf\xb8\xe5\xa1\x46\x0c\xfb\xb4\xfd\x7f\xe9\xf1\x29\x4d\x1a\xd7\xff\x83\x96\x01\x77\xab\x95\x91\x96\x5a\x9f\x3a\xb5\xde\x31\xec\x67\xde\x1e\x94\x59\x45\x3e\xb7\x49\x3d\x37\x06\x18\x93\x8e\x55\xd9\xe0\x37\x1a\x87\xf0\xeb\x4d\xa8\x80\x5d\xbc\xb2\xd2\x1b\x7f\x57\x95\xb5\x04\xed\xc7\xbe\x2d\x4f\xb5\x32\x4e\x66\xe1\x83\x36\x1f\xd0\x21\xe1\x95\xf2\xf1\xe8\x72\x06\xa2\x1f\x42\xe7\x33\xf6\xe4\xaf\x6c\x35\xb0\xee\x18\x1d\xb3\xdf\xb8\xa6\xc0\x85\xd2\xd0\x58\xf0\x7b\x54\xe3\xf1\x89\xd4\xc3\xe0\xb8\x8a\x7a\xcc\x65\x91\x73\xdb\xa7\xf6\x03\x05\xaa\xeb\x0f\xd0\x65\x34\xc9\x03\xa2\x17\xba\x34\xdf\xad\xca\xb0\xae\x0c\x72\x49\x0b\xdb\xff\xde\xbf\xa5\x09\x3d\x1a\x2d\x63\x0a\x63\xf7\x29\x43\xb1\x22\xc4\x8d\x0d\x63\xc1\x83\x86\x32\x3f\x3c\xbc\xa9\x0e\x61\xfe\x61\x0b\x19\x07\x2f\x6c\xbb\x84\x12\x65\x21\xe6\xa4\x5c\x2f\xc0\x47\x42\xe1\x82\x2a\x20\xff\x17\x47\x89\x83\xc7\xdf\x41\x37\x65\x63\x2e\x5a\x9a\x14\x7c\x3c\x5c\x74\x37\x76\x5b\x4e\x4a\xa4\x94\xce\x11\x39\xc3\x7c\x61\x4a\x05\x16\x06\x2f\x6d\xe8\xc2\xeb\x7d\xde\xaa\xa6\x6c\xf3\x89\xbf\x75\x32\x7e\x16\xa1\x0b\x9c\x85\xf9\x88\x96\x74\x92\x48\xe3\x0c\x66\x12\xfe\x7d\x64\x65\xc6\xca\xbd\xa2\x2d\xb1\xf8\xa0\x87\x5f\x45\xc3\x22\x1b\x70\x44\x02\x12\x9d\xa9\x57\x95\x49\x21\x29\x2c\xb6\x23\xf1\xd4\xa4\x60\xf4\x36\x8e\xfb\xf5\x26\x4b\x63\xb0\x5b\xf0\x67\x5e\xbe\xb9\x46\x2d\x3a\x4e\xdd\x2c\x0f\x59\x3c\xdd\x39\x2c\x95\x49\xaf\x32\x3a\x8b\x1c\x1e\x8d\x79\xb2\xa6\x93\x09\xc5\x87\x4e\x1e\x5f\x9e\xc1\xb1\x3f\x83\x6e\x96\xba\xa3\x07\x5e\xda\xcb\x8f\x6d\x39\x52\x4e\xb5\xdf\xf0\xde\x15\xcc\x82\x56\x42\x0d\x0c\xfa\x5d\xae\xf7\xa2\x63\x3a\xbb\x8b\x23\x30\x68\x1d\x73\x0d\xb0\xee\x58\x92\xdb\xa5\xbd\xc3\x44\xc8\x20\x7d\xdc\x88\x59\x8b\xda\xb2\x16\x22\x38\x76\x69\xb5\xc5\x13\xaa\xfb\x30\x5a\xb2\x80\x46\x0b\x0c\xcf\x03\xb2\xcf\xbb\x32\x15\x64\x97\x99\x0a\xe1\x95\x7b\x79\x66\x66\x93\xb1\xa4\x64\xc7\xd6\x0c\x31\x6d\xd1\x1f\xc5\x55\x08\x08\x00\x24\x3f\x94\x63\x5d\x9f\xd8\x18\xd1\x8d\x1d\x8e\xc0\x83\x11\x4b\xff\x98\x93\x86\xe1\xef\x2a\x1d\x7f\x4d\xb7\x60\xce\x3d\x7a\xa4\xf3\xc0\x64\x62\x9f\x00\xc0\x83\x4e\x3e\xf0\x17\x90\x0e\xc2\x46\x6a\x6c\x06\xe4\xe0\x8d\x3e\x65\x9f\xe1\xec\xab\x9d\xd5\x53\x7a\xaf\x4f\x7e\xf8\x21\xd1\xc6\x73\x14\x50\xd3\xf0\x86\xfd\x21\xe1\x4f\xd8\xc6\xad\x51\x5c\x5b\x33\x9a\x66\x98\xaa\xd5\xf8\x63\xbb\x98\x88\x2c\x45\x3b\xe0\x15\xe4\xd1\xc4\x3c\xeb\xc1\x77\x70\xce\x60\x3a\xd6\xf9\x7f\xbf\x5a\x8e\xcd\xef\x34\x11\xdb\x1c\x00\x10\x22\x60\xdb\x2b\xcd\x33\xfc\x86\xc0\x9f\xcb\xff\x45\x4d\xf5\x1e\xbf\x9f\xba\x65\xba\x5a\xbf\x5e\xaf\x26\x24\x26\xed\x8a\x18\x9f\xdc\x7f\x0a\xfa\xcd\x1c\xe7\xd3\xe2\x7d\x2d\x38\x0c\xba\x2d\x7b\x47\x5f\xfe\x86\x24\xbd\x07\x24\xe7\x72\x65\x78\x6c\x85\xe2\xec\xe2\x3f\xfe\x1d\x00\x00\xff\xff\x3c\x97\x45\x2b\x9b\x4e\x01\x00")
-
-func _staticJsJqueryJsBytes() ([]byte, error) {
-	return bindataRead(
-		__staticJsJqueryJs,
-		"_static/js/jquery.js",
-	)
-}
-
-func _staticJsJqueryJs() (*asset, error) {
-	bytes, err := _staticJsJqueryJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/js/jquery.js", size: 85659, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticJsRespondMinJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x57\x5b\x73\xdb\xba\xf1\x7f\xcf\xa7\xa0\xf8\xcf\x9f\x01\x2c\x88\x94\x9c\xbc\x94\x0a\xac\x93\xd8\x4a\x4f\x3a\x76\x72\x1a\x79\xda\x33\x95\x94\x19\x5e\x40\x91\x12\x45\xd0\x04\xa8\x8b\x4d\x7e\xf7\x2e\x78\xd1\xc5\x8e\xa7\xed\x8b\x04\x2c\x16\x8b\xbd\xfe\x76\x69\x5d\x74\xb4\x1f\x4c\xa4\x3c\xf1\xcd\xa5\xd0\x36\x03\xf3\x83\x79\x69\x6b\xeb\x28\xb1\xd6\xce\xae\xb7\x8d\x7c\x19\x6a\x6b\xe6\x47\x8e\xf6\x90\xb3\x6c\xaf\xa5\x3c\xde\x07\x51\x1c\x6b\x17\xda\x35\x4f\xf7\x59\xb4\x08\xa5\x76\xd9\x1f\xbc\xd7\x26\x1e\x97\x52\xfb\x1b\x0b\xe3\x37\x70\x78\x1b\x79\x2c\x11\xcc\xd7\xf2\xc4\x67\x99\x16\x4a\x99\x0a\xdb\xb2\x16\x91\x0c\x73\xd7\xf4\xf8\xda\x12\x8a\x7f\x09\xec\x56\xa3\x80\xe5\xc6\xdc\x85\x67\x85\x64\x99\x75\xfb\xf5\x7a\xfc\x6d\x32\xee\xdd\x7d\xbd\x57\xe2\xb4\x0b\xeb\xcd\x9b\x4e\x90\x27\x9e\x8c\x78\x82\x1c\xfc\xa4\xe7\x82\x69\x42\x66\x91\x27\xf5\xa1\x63\xae\x1d\xe9\x85\x77\x4a\x51\x7a\xba\x29\x8a\xd3\x3b\x1b\x27\xd3\x5c\xe2\x01\x87\xcf\xbd\x7c\xcd\x12\x39\x8e\x99\xfa\x23\x3e\xf5\xcc\x20\xca\x44\x4b\xb8\x0e\xa3\xd8\x2f\x8a\x86\x58\xed\x08\x83\x7b\x5e\xc6\x1c\xc9\x1a\x26\xa4\xbb\xdc\xdf\xeb\x98\x04\x2f\x4f\xfc\x68\xa3\xe3\x61\xc6\x64\x9e\x25\x5a\x60\x46\x3e\xd5\xd7\x0f\x3d\xc9\x84\xec\x0d\x74\x12\x98\x42\xee\x63\x66\x7a\x42\xdc\xb3\x9d\xa4\x7a\xca\x45\xa4\xb4\xb4\x1d\x57\xf0\x38\x97\x6c\x28\x79\x6a\xf7\x06\xfd\x3e\x5b\xeb\x84\x35\xec\xae\xe3\xad\x16\x19\x07\x97\x52\x3d\xe1\x09\x53\x27\x4e\x9a\xb2\xc4\xaf\x34\x44\x01\xa8\x72\x62\xee\xf1\xf5\x24\x61\xd9\xef\xf7\x77\xb7\xf4\x9d\x21\xc2\xfd\xf0\x63\x25\xae\x8e\x2b\xd5\xdf\x75\x9d\xee\x3b\xfd\x4a\xfb\xbf\x83\x82\xda\x93\x56\x45\xde\xd6\x3e\x5c\xa6\xbb\xa1\x56\x7e\xb4\xaa\x1b\x57\xef\x88\x07\xc2\x04\xcb\xe4\x67\x16\xf0\x8c\x21\x46\x7c\x4c\x5c\xfa\xe1\x92\x52\x1a\x98\x3c\x08\x04\x93\xff\x54\x57\x81\x31\x63\x6b\xbe\x61\xb5\x66\x0c\x93\xa7\x2a\x2a\x4c\xd8\x2e\xa9\x1e\xb6\x9d\xb2\x2c\xd1\x31\x14\xb8\x44\x32\x8c\xc4\xb9\x09\x67\x51\x6e\x0f\x34\x17\xe1\xa7\x1c\x75\xfa\xb8\x54\x11\xf5\xe8\x53\x09\x19\x90\xd5\x59\x44\x3d\x78\x3a\x4f\x7d\x08\x06\x3d\x48\xc2\xc0\xa1\x58\x7d\x3a\x9d\x93\x33\x7a\x95\x12\xb4\x33\x18\xca\x6c\xff\xe4\xd2\x84\x6d\x35\xc7\xfc\xf3\xee\xf6\x77\x48\xd8\x1f\x0c\x72\x5e\xc8\xd2\x53\x8a\x23\x0f\x1f\xce\x3f\xc1\xed\x0d\xfb\xf3\xbb\xbb\x64\x1e\xc4\xfa\x2e\xf2\x32\x2e\x78\x20\xab\x8b\xf7\xf7\x7f\xe8\xb8\x6c\x7d\x7f\x7c\xa9\xa1\xb8\x60\xb4\xca\x98\xa3\x95\xc4\xad\xd5\xf0\x28\x43\x78\xe8\x19\x06\xf2\x4c\x0e\x41\x45\xfa\x5f\xc7\xf7\x3a\x71\x08\x18\x0a\x36\xf1\x04\x32\xcc\xdf\x0b\x09\x96\x79\xa1\x93\x2c\xce\xec\xf8\xd0\xa1\x54\xb9\x1c\x38\x26\x8a\xa3\x28\x2e\xfb\xfd\x8a\xa6\x2e\xe4\xc2\x30\xde\xf7\x3f\x9c\xec\x8b\xc2\x45\x5e\xe3\x33\xc1\x54\x12\xe2\x92\x3c\x17\x62\x18\xc0\x0e\xd9\x85\x92\x3c\x8e\x31\x2e\x87\x51\x00\x97\x9c\xa5\xb3\xa3\x01\x68\x04\xde\xc9\x19\xf5\xab\x50\x2f\xd8\x8e\x3e\xd5\x71\xb5\x7e\xab\xfe\xa7\x3f\x67\x4f\xf3\xee\xec\x09\xa9\xc5\xac\x9c\x5f\xcc\x9e\x60\x55\x02\xf1\x62\x56\xe2\x2e\x40\x01\x59\xb1\x7d\x90\x39\x6b\xc8\x09\xeb\x37\x34\xb2\x67\x3d\xf8\xe1\xc5\x9a\x3f\x16\x5b\xe6\xae\x22\x89\x67\x3d\x3c\x3a\x30\x1d\x04\x8e\xec\x5f\x8b\x54\x6b\xb5\x52\xa2\xf3\x2c\x06\xa9\x08\xfe\x66\x08\x4f\xdf\xe9\xf3\x91\xd2\xc3\x9a\x61\x58\x4e\x7f\xda\xd5\x7f\xb7\x39\x98\x61\x6c\x2d\x48\x10\x25\xfe\x44\x65\xb9\x68\x2d\xd0\x2e\x50\xfd\x26\x56\x56\xcc\x26\x33\x31\xef\x8e\xf0\x5b\x8b\xf0\x24\xde\x83\x70\xf5\x37\x13\x5d\x0c\xa2\x9d\xde\xe3\xa7\xde\xbf\x14\xa7\x18\x59\x04\xe0\x73\x6b\x5b\x33\xb8\x23\xe6\x17\xb0\x99\xd5\x40\x3a\x13\x17\x76\x45\xaa\x0f\xa6\xfd\xde\x5f\x66\x26\xdc\x41\xe9\xae\x60\x6b\x5c\x11\x67\x18\xae\x3b\xbb\x93\xeb\xce\xee\x7f\xbb\x5e\x42\x3c\x2a\xf5\xff\x0e\x80\x1d\x31\x31\xc9\xd3\x94\x67\x92\xf9\x67\xc0\x68\x18\x2a\xa6\x10\xef\x53\x22\xd2\x95\x49\x9a\x13\xc7\x3a\x36\x8c\xd7\x4e\xcc\xa6\x90\x49\xe7\x95\x87\xea\x74\x5e\x90\x90\x44\x64\x79\x02\xb6\x64\x45\x97\x2f\x90\x37\x56\x25\xb9\x56\x3f\x89\xfa\xe1\x50\xcc\x24\xa5\xef\xfb\xe4\x01\x98\x17\xac\xe5\x13\x9f\xf7\xf7\xce\xe2\x1b\xe4\x01\xd2\x43\x48\x50\x1d\x4f\xfb\xf3\xa2\x58\x91\xec\x55\x36\xd7\x11\xac\x62\x23\x82\x3e\xbc\xc2\x13\x47\xc9\x0a\x00\x5c\x3e\x87\x04\x28\x4a\x90\xfb\x2b\x4c\x87\xf6\xb1\x34\x15\xee\x43\xcf\x58\x35\xb8\x1c\xf0\x44\x4e\xa2\x47\x06\xd0\xe2\x55\x35\x73\x4e\x0d\x14\xbe\xb4\xe5\xff\x9f\x91\x5f\xdd\xeb\x09\xb8\x68\x0f\xd8\x7a\x58\x03\xf1\x40\xb5\x01\xaf\x28\x90\x47\x83\x97\x8a\x35\x6d\xc8\x7b\xad\x4d\x60\xf2\x5c\x53\xaa\x43\x6b\xf9\x7f\x9d\x3c\xd7\xf5\x48\x3f\xed\x2b\x2e\x00\x96\x61\xac\xce\xa1\xdf\x03\xa1\xc7\xee\x88\x89\x43\xdd\xb3\x06\x10\x8c\x56\x67\x2d\xc0\xc3\xf6\x79\x4f\x70\x7f\xa1\x16\x74\xd9\x0a\xff\x9e\x91\x99\x12\x1f\xd1\xd4\xc9\x04\xfb\x12\x73\x47\x42\x6f\x28\x49\x7e\x8c\xda\x01\x42\x75\x2f\x8e\xc0\x27\x95\x0a\xba\x0a\xd1\xd4\x53\x88\xaf\x5f\x4f\x26\x83\x6b\xbe\x4e\x1d\xa9\x43\xab\x5a\xaa\x09\x04\xd6\x77\xdc\x87\xf7\xa0\xd1\xd7\x21\x05\xde\xa2\xf0\x21\x5e\x90\x83\x9c\x8a\xa9\x30\x63\x96\x2c\x64\xd8\x1b\xcc\x21\xcf\x90\x02\xff\x1b\xf0\x3b\x56\xc9\x74\x1f\xad\x15\x5c\x03\x22\xba\x86\xb1\x30\x8c\xf4\x2a\xeb\x2d\x70\x13\x65\x18\x07\x62\xe6\x64\x8a\x87\xe7\x12\x85\x98\x84\x50\x07\xa2\xbe\xa5\x28\x39\x49\x31\xd9\xf0\xc8\xd7\xfa\xc3\x05\xcd\x20\xe6\x19\x52\x06\x6c\xb4\x28\xd1\x62\x0c\x52\x63\x33\x74\xc4\xf7\x6d\xf2\x47\x06\xbd\x20\x93\x7b\xb4\xc1\xb5\x8d\x5b\x1a\x4f\x37\x73\xb2\xa3\x5b\x53\xe1\x0c\xd9\xab\x05\x20\x06\x79\xa4\xaa\xa4\xc1\xba\x1d\xf9\xd4\x2e\xf7\xe4\x33\xd5\x21\x77\x86\x3b\x70\xeb\xee\xd4\x81\x3b\x7c\x81\x76\x10\x51\x9f\xed\xbe\x07\xe8\x33\xbe\xea\x0d\x46\x51\x51\x48\x84\xed\x01\xc6\x64\x0f\xfc\xfb\x53\xfe\x3d\xf0\xef\x5f\xe7\xdf\x2a\x75\xab\x11\x11\x2e\x3e\x1a\xc6\xa7\xa2\xe8\xa0\xc7\xa2\x60\x57\x74\x87\xd5\x1a\x08\xfb\x2b\x08\x24\x6c\x50\x30\xdd\xd6\xc8\x31\x3f\xdb\x40\xfd\x43\xa2\x1d\xb6\x66\x9a\x8b\x10\xad\x61\x9f\xe5\x80\xca\x73\x68\x40\xad\x9b\xae\x95\x9b\x12\x9c\x3c\xf7\xd1\x35\xc0\x56\x32\xbd\x9e\xd7\xbf\x26\xa8\x0f\xb9\xf0\x0d\x82\x0c\xae\x78\x30\x8c\x87\xb3\xfc\x53\x2c\x78\x98\x34\x31\xa6\xfd\x43\x10\x6e\x94\xf4\x40\x05\x21\x78\xfe\xc0\x4d\x13\x84\xf1\xcb\x22\xac\x52\x16\x4a\xed\x0b\x0d\xa6\x37\x73\x73\xc9\x23\xe8\xe0\xb3\x04\xa6\xc0\xb1\x29\xf7\x29\xe4\xa0\x84\x82\xb7\xa0\xf0\x75\x32\xae\x4d\xa4\x37\xe4\xe1\xbc\xa8\xc6\x84\x9b\x09\xb0\x4d\x22\x17\xa0\x69\x81\x81\xb3\x92\x3b\x09\x19\x93\xa3\xd3\xcd\x01\x41\xbe\xd8\xe3\xb3\x62\x6d\x15\x53\x87\xca\x74\xf4\x05\xc2\x93\xd4\xce\x1c\xe3\xb2\x24\x9b\xb3\xd9\x83\x34\x70\xad\xa6\xdb\x8c\xa5\xb1\xe3\x31\xd4\x34\x74\xf3\xd0\x7a\x89\xde\xc2\xfe\xe1\xac\x32\x40\x0d\x32\x50\x40\xac\xf1\x61\x51\xf4\x87\x2e\xc0\x80\xc8\x5d\x35\xb3\x25\x0b\xd4\x27\xae\x19\xc3\x44\xff\xb5\xc9\x1b\xdd\xd2\x31\xae\x46\xb1\x05\xfd\xc5\xa8\xfa\x52\x05\xd5\xc7\x89\xfe\x76\xa0\x77\xdd\xae\xfe\xf6\xf2\xed\x7b\x98\xad\xa0\x98\x3a\x80\x47\xfe\xd0\x6d\xde\x85\x94\x73\xbb\x54\xc9\x26\x6a\x1d\xd0\x01\x3e\x04\x33\x52\x81\xbd\x8a\x86\x51\xb7\x5b\x1b\xba\x24\x2b\x92\x10\x3e\x0c\x47\x68\x09\x78\xb3\xae\x3d\xb3\x00\x2d\x30\xb6\x81\xc4\xa6\xd1\xfc\x99\xad\xc7\xd1\x00\xf2\xeb\x07\x5b\x8c\x77\xa9\xf9\x76\xd0\x5e\x6d\x09\x97\x80\x03\xc7\x0d\x56\x5e\x87\x2c\x11\x69\x1c\x41\x76\x10\xd0\x8d\xd3\x36\xd9\x0e\xda\xa5\xa0\x1d\xbf\x4a\x87\x29\x68\xb7\xa2\xc9\x34\x9d\x93\xb8\x96\xda\x0c\x53\xab\x56\x00\xaa\x5a\xd9\x33\xc5\x54\x53\x3e\x51\xe9\xb2\x28\x74\xd5\xa2\x49\x55\x30\xf6\xfa\x00\x5f\xa4\x2d\x4e\x7b\x75\x28\x61\x25\x11\x8a\xb8\x1e\x56\x56\xcf\xa3\x0b\x44\x10\x7c\x52\xff\x07\xb3\x71\x17\x9d\xbe\x07\x76\x55\xf3\xca\x0b\x09\x40\xfc\x6f\x25\x94\xb8\xcc\x11\xc4\x75\x7b\xda\x86\xa1\xfc\xfc\xc6\x80\x76\x4c\xf7\x4d\x11\x46\x01\x40\xce\x10\x50\xd7\x0c\x33\x16\x1c\x3f\x16\x60\x3c\xdf\x40\x4f\x6a\xc8\x6e\x9b\x9f\x7c\x5a\x53\xe6\xb4\xd3\x27\x67\x10\x7c\xf2\xd4\x56\x3d\xde\x57\x6a\x94\x80\xab\x27\x07\x6d\x98\x5c\x08\x93\xfb\xb1\x6d\x07\x43\xb7\xcd\x25\x0f\x9a\x84\xab\xfa\x8b\xd7\x68\x43\x9b\x91\x88\x2c\xaa\x41\x3a\x36\x8c\x1a\x17\x84\x2a\x59\xd5\x79\x2a\xaa\x29\xf9\x2d\xdf\xb2\xec\x1a\x26\x14\x30\x86\x55\x0d\xa4\xc3\xa7\x6c\x7e\x6c\x7e\x55\x91\x1f\x86\x89\xba\xe4\x33\x67\x7b\x5d\x57\xfd\x08\x6d\xd0\x2b\x47\x04\x06\x0e\x65\x37\x53\x26\x43\x46\x77\xac\x9f\xed\x7c\x6a\xc3\x80\x68\xcd\x2c\x6c\x99\xea\x3b\x0f\x3e\xca\xe0\xd5\x0c\x30\xfa\x50\x75\xc7\xdc\x56\x05\xdf\x64\x9e\x55\x65\x1e\x55\xa3\x62\xcc\xe1\x5b\x08\x5c\x63\x86\x5c\x48\xb8\x0d\x87\x96\xb2\x8a\x9d\x95\xfc\xa5\x3a\x61\xa7\xec\x69\xc6\x25\xf7\x78\xdc\x85\x46\xee\x37\xf9\xad\x1c\x66\xb3\xe6\x5b\x30\x28\xa1\x5e\x4a\x15\x87\xe1\x0e\xe1\xe3\x17\xdc\x0e\x96\x6a\x72\x5b\xff\xc3\x89\xe1\x5b\x43\x42\x08\x1d\xdf\x1f\x6f\x00\x71\x6f\x23\x21\x19\x7c\xd7\x8e\x5e\x92\x90\x0e\x1f\x36\x30\x37\xe8\x00\x6f\x9d\x01\xb6\x81\x43\x4a\xc7\x0b\x2b\x26\x35\xd8\x9e\x6c\xd5\x64\x7b\xe0\x86\x04\xa8\x3f\x42\x87\xff\x0e\x00\x00\xff\xff\xed\x16\x16\x7b\x19\x11\x00\x00")
-
-func _staticJsRespondMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		__staticJsRespondMinJs,
-		"_static/js/respond.min.js",
-	)
-}
-
-func _staticJsRespondMinJs() (*asset, error) {
-	bytes, err := _staticJsRespondMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/js/respond.min.js", size: 4377, mode: os.FileMode(436), modTime: time.Unix(1463282462, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticJsVainJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\xc1\x8e\x9b\x30\x10\xbd\xe7\x2b\x2c\x37\x07\x5b\x55\x9c\xae\x7a\x44\x69\x0f\xfb\x01\xed\xa1\xb7\xaa\x07\x0b\x0f\x60\xad\x63\x23\xdb\x90\xad\xaa\xfc\x7b\xc7\x09\x04\x03\x8b\xda\x1d\x29\x0a\xd8\x33\x6f\xe6\xbd\x37\xec\x59\xd5\xd9\x32\x6a\x67\x19\x27\x7f\x76\x04\x63\xcf\xe8\x07\x2b\xcf\x40\xb9\xe8\xa5\x61\x94\xf2\x62\x79\xfe\x02\xbf\xbb\x76\xaa\x84\xb1\x34\x85\xae\x08\xdb\xb3\xd8\xe8\x70\xaf\xe7\xe4\x74\x22\x08\x92\xa5\xe4\x68\x87\xc6\x19\x05\x1e\x41\x3d\x9c\x5d\x0f\xcf\x46\x86\xc0\x68\x23\xc3\x21\x74\x65\x09\x21\xe0\x95\x54\x2a\x3b\xbf\x48\x6f\xb5\xad\xc7\xb9\x52\x5c\x09\x98\x00\xef\x6f\x31\x40\x91\xf4\x0c\xde\x3b\xbf\x6a\xa6\xa0\x92\x9d\x89\xb3\x66\x33\xae\x90\xd4\x78\x76\x0a\x12\xcf\xa7\xcf\x4b\x9e\x01\xac\x62\xab\xe2\x2b\x7f\x48\x9a\x12\xb0\x6b\x69\x74\xf9\xb2\x21\x69\x8e\x71\xc5\xff\xf4\xdb\x8d\xa9\xc3\x6d\x66\x9e\x34\xe0\x71\x60\xd1\x68\x05\x2c\x33\x6f\xd2\x33\xbf\xe9\xa5\x27\x49\x25\x72\x5a\x3a\x3f\x24\x24\x92\xf7\x84\xa5\x8f\xef\x14\xf8\x21\xe5\x42\xe2\x41\xf8\x62\x86\x3b\x92\x08\x8d\xbb\x30\x2e\x22\xbc\x46\x46\xbf\x1b\x90\x68\x73\xeb\x5d\x8f\x0c\x88\xb4\x04\xce\x52\x1b\x82\x78\x1e\x99\x89\x11\x64\xb5\x0e\xde\x75\x31\x31\xa4\x47\xd9\xea\x63\xff\xe9\xe8\xa1\xd6\x21\x82\x3f\x7e\xbd\x21\x9c\xe8\xc7\x44\xa4\xc8\x9c\x65\x17\x6d\x95\xbb\x08\xe3\x4a\x99\x74\x16\x8d\x87\x4a\xe0\x19\xbc\x7e\xab\x18\xad\x9c\xaf\x1d\x0e\x48\xbe\x90\xc3\xd3\xd2\xf4\x55\xbb\x7b\xf6\x56\xb3\x69\xa1\xf6\xa2\x86\xc8\x6e\xe5\x5c\x28\x67\x81\xcd\x70\x1f\xeb\xa1\x64\x94\xcb\xa6\xa3\x72\xda\xb6\x5d\x9c\x3e\xde\x99\xd9\x79\x94\xce\x06\x67\x40\xdc\xe4\xbf\x23\x16\xd3\xed\x9b\xd0\xd3\x06\xdd\xfc\x48\x35\x3f\xe9\x39\xd4\xf4\xd7\x68\xd4\xbc\xcd\x44\x8c\x8b\x0a\x99\x6f\xb0\x81\x2d\x2a\x6f\x2d\x01\xe0\x92\x85\x16\x67\x87\x1f\xf8\xfa\x4f\x5e\x90\x93\xfa\x8f\xd8\x9a\x7f\x58\xac\xdd\xb5\xd8\xfd\x0d\x00\x00\xff\xff\x5e\x00\xc3\x9c\x34\x05\x00\x00")
-
-func _staticJsVainJsBytes() ([]byte, error) {
-	return bindataRead(
-		__staticJsVainJs,
-		"_static/js/vain.js",
-	)
-}
-
-func _staticJsVainJs() (*asset, error) {
-	bytes, err := _staticJsVainJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/js/vain.js", size: 1332, mode: os.FileMode(436), modTime: time.Unix(1464068795, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var __staticRegisterIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x56\x4d\x6f\xe3\x36\x10\xbd\xe7\x57\xb0\x2c\xd0\x5e\x4a\xab\x29\x16\x45\xb1\x2b\x09\x28\xb6\x39\xec\xa9\x8b\x76\x5b\xb4\xa7\x82\x12\xc7\x12\x13\x8a\x64\x49\x4a\x89\xfe\x7d\x87\x94\x64\xcb\x8e\x95\x38\xb7\x1a\x48\x4c\x52\xf3\xf1\x34\xf3\xe6\x99\xf9\x57\xbf\xfc\xfa\xf1\xcb\xdf\x9f\xef\x48\x1b\x3a\x55\xde\xe4\xf1\x8b\x28\xae\x9b\x82\x82\xa6\xe5\x0d\xc1\x4f\xde\x02\x17\xe5\x4d\x5a\xa7\x7d\x07\x81\x93\xba\xe5\xce\x43\x28\x68\x1f\xf6\xec\xa7\xd9\xf4\xf8\xb8\x0d\xc1\x32\xf8\xb7\x97\x43\x41\xff\x62\x7f\xfc\xcc\x3e\x9a\xce\xf2\x20\x2b\x05\x94\xd4\x46\x07\xd0\xe8\xfb\xe9\xae\x00\xd1\xc0\x33\x6f\xcd\x3b\x28\xe8\x20\xe1\xd1\x1a\x17\x56\x0e\x8f\x52\x84\xb6\x10\x30\xc8\x1a\x58\xda\x7c\x47\xa4\x96\x41\x72\xc5\x7c\xcd\x15\x14\xb7\x74\x0d\x55\x49\xfd\x40\x5a\x07\xfb\x82\x66\xff\xf8\x80\x00\xea\xac\xf6\x3e\xab\x8c\x09\x3e\x38\x6e\x77\x9d\xd4\x3b\x3c\xa1\xc4\x81\x2a\xa8\x0f\xa3\x02\xdf\x02\x84\x35\xa6\xad\x28\x03\xdf\x76\x3e\x7a\x07\x19\x14\x94\xd1\x36\xcf\xa6\xf5\x54\xd6\x6c\xaa\x6b\x5a\x57\x46\x8c\xab\x84\x9a\x0f\xa4\x56\xdc\xfb\x82\xe2\xb2\xe2\x8e\x4c\x5f\x4c\xea\x01\xb0\xec\xcb\x76\x2f\x9f\x40\xb0\x60\x2c\x22\x30\xf8\xf2\xd1\x5a\x36\x88\xcf\xe8\x15\xfe\x14\x52\xc8\x43\xc8\x58\x4d\x44\x03\xee\xcc\xe6\xdc\x6e\x4e\x12\x61\x5e\xb4\x9d\x90\xf7\x21\x18\x4d\xc2\x68\x31\xfd\xb4\xa1\x67\x01\x82\x69\x9a\xd8\x76\xc1\x03\x9f\x37\x11\x84\x52\xdc\xfa\xc3\x31\x77\x4d\x64\xd3\xd7\x95\x67\xf0\xc4\x3b\xab\x80\xcd\xee\x8b\x25\xbb\xdd\xc0\x90\x70\x78\xcb\xf5\x92\xd7\x3b\x66\xb4\x1a\x69\xf9\x25\x25\x23\xc7\xaa\xe4\x59\xb4\xbb\x32\x8c\xc4\x42\x31\x44\x40\xcb\xff\x8f\x5b\x9e\x4d\x35\xde\x78\xca\xcf\x4a\x5f\x39\xae\x05\x5d\xb8\x4b\xcb\x3f\x13\x0b\xf9\x85\xbe\x67\xd8\xf8\x33\xca\x9c\x1e\xe5\x19\xc6\x5c\xf3\xfa\x0a\x46\xe5\xed\x6d\xf9\x1b\x34\x32\x8e\xda\x54\x7e\x3c\x38\xb5\xb0\xe5\x67\x05\x1c\x29\x6d\x9d\x19\xa4\x00\x32\x9a\xde\x11\xe8\xb8\x54\x04\xb1\xe3\x74\xa3\x73\x5f\x47\x6f\x4f\x1e\xa5\x52\xa4\x02\xe2\x51\x0c\x48\x30\x2b\xdb\x5d\x9e\xd9\x15\xb8\x03\x40\x29\x0a\x8a\xb2\x90\x44\x64\xc2\x9a\x76\x24\xfd\x67\x02\x95\x0e\x41\x93\x34\xb9\x05\x15\xd2\x5b\xc5\xc7\xf7\x44\x1b\x0d\x1f\x68\x89\x9b\x1a\x5a\xa3\x90\xfe\x97\xea\xb3\xc4\xf7\x7d\x5d\x43\x54\x81\x0b\x19\x0e\xcf\x2e\xa7\x98\xc7\x76\x42\x58\xee\x76\xbb\x39\xcf\xc6\x9b\x48\x6d\xfb\xe3\x9b\x38\xf3\xf8\xca\x04\xe3\xe8\xb0\x4e\xb0\x1f\x22\xa7\x9e\xe1\x3f\x37\x4e\xc1\x59\xe3\x4c\x6f\xc9\xec\xf8\xe3\xd6\xd4\x9f\x10\xf7\xe8\xc7\xb8\x10\xcf\xa4\x67\xd3\xb1\x51\xa3\x6d\x23\xed\xc9\x61\xc5\x00\x05\x4e\x19\x0b\xaf\x4d\xc1\x0b\xcf\x12\x9e\x59\x90\x02\x3c\x1d\xeb\xb5\x37\xae\x63\x91\xaa\x58\x74\x9a\xea\x19\x7f\x67\x28\x59\xb5\xb9\xa0\x77\x13\xf3\x84\x70\xa9\x6d\xbc\x0f\x66\x6f\xea\xde\xbf\xb1\x10\x55\x78\xb1\x0c\xb3\x6a\x26\xf6\x40\x9c\xd0\x39\x04\xba\x11\xfc\x63\x95\x32\xf5\x03\x3d\x55\xd5\x72\xf1\xde\x0c\x7b\x55\x85\x53\xbe\xa5\xba\xe4\x1b\x5d\x79\xfb\x81\xfc\xde\x57\x9d\x0c\xdb\x78\x17\xd5\x79\x29\xf3\x0b\x9f\x37\x35\xf1\x0a\xaa\xce\xf4\x7c\x77\x91\xd7\xcb\x08\x9d\x9c\xd9\x65\x00\x2d\xb6\x56\xea\x26\xfe\x68\xbe\x27\xef\xbe\xb7\x4f\x18\xc3\x77\x5c\xa9\xf2\xd3\x3e\xea\xc9\xb7\x03\x10\x24\x4a\x63\x02\xde\x38\x26\x81\x09\xe6\x01\x97\x76\x92\x29\x94\xd8\xb3\x9b\xc0\x64\x8d\xe2\xea\xf0\xbe\x03\x3e\x9c\x4a\x16\xaa\x94\x83\xda\x0c\x51\x42\x78\x49\xf8\x14\x0d\xe7\x7c\x4a\x9a\x64\x6b\x0b\x79\xee\x6b\x27\x6d\x20\xde\xd5\xab\x7c\xf7\x3e\xbb\xc7\x44\x6e\xdc\xdd\xfb\xd4\xc8\x64\x54\xbe\xee\x75\x7a\xe9\x79\xa3\x73\xba\xeb\x3c\xf7\x41\x66\xa4\xab\x0b\x2a\x7b\xba\x41\xfe\x17\x00\x00\xff\xff\xb5\x80\x9e\x5e\x52\x0a\x00\x00")
-
-func _staticRegisterIndexHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		__staticRegisterIndexHtml,
-		"_static/register/index.html",
-	)
-}
-
-func _staticRegisterIndexHtml() (*asset, error) {
-	bytes, err := _staticRegisterIndexHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "_static/register/index.html", size: 2642, mode: os.FileMode(436), modTime: time.Unix(1464068795, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"_static/css/bootstrap.min.css": _staticCssBootstrapMinCss,
-	"_static/css/bootstrap.min.css.map": _staticCssBootstrapMinCssMap,
-	"_static/css/vain.css": _staticCssVainCss,
-	"_static/fonts/glyphicons-halflings-regular.eot": _staticFontsGlyphiconsHalflingsRegularEot,
-	"_static/fonts/glyphicons-halflings-regular.svg": _staticFontsGlyphiconsHalflingsRegularSvg,
-	"_static/fonts/glyphicons-halflings-regular.ttf": _staticFontsGlyphiconsHalflingsRegularTtf,
-	"_static/fonts/glyphicons-halflings-regular.woff": _staticFontsGlyphiconsHalflingsRegularWoff,
-	"_static/fonts/glyphicons-halflings-regular.woff2": _staticFontsGlyphiconsHalflingsRegularWoff2,
-	"_static/forgot/index.html": _staticForgotIndexHtml,
-	"_static/index.html": _staticIndexHtml,
-	"_static/js/bootstrap.min.js": _staticJsBootstrapMinJs,
-	"_static/js/html5shiv.js": _staticJsHtml5shivJs,
-	"_static/js/jquery.js": _staticJsJqueryJs,
-	"_static/js/respond.min.js": _staticJsRespondMinJs,
-	"_static/js/vain.js": _staticJsVainJs,
-	"_static/register/index.html": _staticRegisterIndexHtml,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"_static": &bintree{nil, map[string]*bintree{
-		"css": &bintree{nil, map[string]*bintree{
-			"bootstrap.min.css": &bintree{_staticCssBootstrapMinCss, map[string]*bintree{}},
-			"bootstrap.min.css.map": &bintree{_staticCssBootstrapMinCssMap, map[string]*bintree{}},
-			"vain.css": &bintree{_staticCssVainCss, map[string]*bintree{}},
-		}},
-		"fonts": &bintree{nil, map[string]*bintree{
-			"glyphicons-halflings-regular.eot": &bintree{_staticFontsGlyphiconsHalflingsRegularEot, map[string]*bintree{}},
-			"glyphicons-halflings-regular.svg": &bintree{_staticFontsGlyphiconsHalflingsRegularSvg, map[string]*bintree{}},
-			"glyphicons-halflings-regular.ttf": &bintree{_staticFontsGlyphiconsHalflingsRegularTtf, map[string]*bintree{}},
-			"glyphicons-halflings-regular.woff": &bintree{_staticFontsGlyphiconsHalflingsRegularWoff, map[string]*bintree{}},
-			"glyphicons-halflings-regular.woff2": &bintree{_staticFontsGlyphiconsHalflingsRegularWoff2, map[string]*bintree{}},
-		}},
-		"forgot": &bintree{nil, map[string]*bintree{
-			"index.html": &bintree{_staticForgotIndexHtml, map[string]*bintree{}},
-		}},
-		"index.html": &bintree{_staticIndexHtml, map[string]*bintree{}},
-		"js": &bintree{nil, map[string]*bintree{
-			"bootstrap.min.js": &bintree{_staticJsBootstrapMinJs, map[string]*bintree{}},
-			"html5shiv.js": &bintree{_staticJsHtml5shivJs, map[string]*bintree{}},
-			"jquery.js": &bintree{_staticJsJqueryJs, map[string]*bintree{}},
-			"respond.min.js": &bintree{_staticJsRespondMinJs, map[string]*bintree{}},
-			"vain.js": &bintree{_staticJsVainJs, map[string]*bintree{}},
-		}},
-		"register": &bintree{nil, map[string]*bintree{
-			"index.html": &bintree{_staticRegisterIndexHtml, map[string]*bintree{}},
-		}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
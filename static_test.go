@@ -0,0 +1,156 @@
+package vain
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStaticEmbedded(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	s := NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	tests := []struct {
+		path string
+		ct   string
+	}{
+		{"/_static/css/bootstrap.min.css.map", "application/json"},
+		{"/_static/css/vain.css", "text/css; charset=utf-8"},
+		{"/_static/js/vain.js", "text/javascript; charset=utf-8"},
+		{"/_static/fonts/glyphicons-halflings-regular.woff2", "font/woff2"},
+	}
+	for _, test := range tests {
+		resp, err := http.Get(ts.URL + test.path)
+		if err != nil {
+			t.Fatalf("couldn't GET %s: %v", test.path, err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Fatalf("%s: got %s, want %s", test.path, http.StatusText(got), http.StatusText(want))
+		}
+		if got, want := resp.Header.Get("Content-Type"), test.ct; got != want {
+			t.Errorf("%s: bad content type; got %q, want %q", test.path, got, want)
+		}
+		if got, want := resp.Header.Get("Cache-Control"), "no-cache"; got != want {
+			t.Errorf("%s: bad cache control; got %q, want %q", test.path, got, want)
+		}
+		if resp.Header.Get("ETag") == "" {
+			t.Errorf("%s: missing etag", test.path)
+		}
+	}
+
+	u := s.assets.url("css/vain.css")
+	if !strings.HasPrefix(u, "/_static/css/vain.css?v=") {
+		t.Fatalf("asset url not fingerprinted: %q", u)
+	}
+	if got := s.assets.url("/css/vain.css"); got != u {
+		t.Fatalf("asset url with leading slash: got %q, want %q", got, u)
+	}
+	resp, err := http.Get(ts.URL + u)
+	if err != nil {
+		t.Fatalf("couldn't GET %s: %v", u, err)
+	}
+	resp.Body.Close()
+	if got, want := resp.Header.Get("Cache-Control"), immutable; got != want {
+		t.Errorf("fingerprinted asset; got %q, want %q", got, want)
+	}
+	etag := resp.Header.Get("ETag")
+
+	req, err := http.NewRequest("GET", ts.URL+"/_static/css/vain.css", nil)
+	if err != nil {
+		t.Fatalf("couldn't create request: %v", err)
+	}
+	req.Header.Set("If-None-Match", etag)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("couldn't GET: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusNotModified; got != want {
+		t.Errorf("conditional get; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+
+	for _, p := range []string{"/_static/nope.css", "/_static/fonts/"} {
+		resp, err := http.Get(ts.URL + p)
+		if err != nil {
+			t.Fatalf("couldn't GET %s: %v", p, err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+			t.Errorf("%s: got %s, want %s", p, http.StatusText(got), http.StatusText(want))
+		}
+	}
+
+	resp, err = http.Get(ts.URL + "/_dashboard/")
+	if err != nil {
+		t.Fatalf("couldn't GET dashboard: %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(b), u) {
+		t.Errorf("dashboard should link fingerprinted css %q", u)
+	}
+}
+
+func TestStaticOverride(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	dir, err := ioutil.TempDir("", "vain-static-")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	css := filepath.Join(dir, "custom.css")
+	if err := ioutil.WriteFile(css, []byte("body { color: red; }"), 0644); err != nil {
+		t.Fatalf("couldn't write css: %v", err)
+	}
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, dir, window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	get := func() (string, string) {
+		resp, err := http.Get(ts.URL + "/_static/custom.css")
+		if err != nil {
+			t.Fatalf("couldn't GET: %v", err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusOK; got != want {
+			t.Fatalf("got %s, want %s", http.StatusText(got), http.StatusText(want))
+		}
+		return string(b), resp.Header.Get("ETag")
+	}
+
+	body, before := get()
+	if got, want := body, "body { color: red; }"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if err := ioutil.WriteFile(css, []byte("body { color: blue; }"), 0644); err != nil {
+		t.Fatalf("couldn't write css: %v", err)
+	}
+	body, after := get()
+	if got, want := body, "body { color: blue; }"; got != want {
+		t.Fatalf("live edit not served; got %q, want %q", got, want)
+	}
+	if before == after {
+		t.Fatalf("etag should change with content; both %q", before)
+	}
+}
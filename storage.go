@@ -1,18 +1,104 @@
 package vain
 
-import "time"
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// A Change describes a package that was created, updated or deleted. For
+// deletes Package is what was removed.
+type Change struct {
+	Action  string
+	Package Package
+	// Remote is set if the change was made by another vaind sharing the
+	// store.
+	Remote bool
+}
 
 // Storer defines the db interface.
 type Storer interface {
 	NSForToken(ns namespace, tok Token) error
+	UserNamespaces(e Email) []namespace
 
 	Package(path string) (Package, error)
-	AddPackage(p Package) error
-	RemovePackage(pth path) error
+	// AddPackage, UpdatePackage and RemovePackage add a revision made by
+	// ed to the package's history along with the change, atomically.
+	AddPackage(p Package, ed Edit) error
+	UpdatePackage(p Package, ed Edit) error
+	RemovePackage(pth path, ed Edit) error
 	PackageExists(pth path) bool
 	Pkgs() []Package
+	// QueryPackages returns the page of packages selected by q.
+	QueryPackages(q Query) (Page, error)
+	// Watch registers fn to be called after every change to a package.
+	Watch(fn func(Change))
 
 	Register(e Email) (Token, error)
 	Confirm(tok Token) (Token, error)
 	Forgot(e Email, window time.Duration) (Token, error)
+
+	UserForToken(tok Token) (Email, error)
+	Tokens(e Email) []Token
+	AddToken(e Email) (Token, error)
+	RevokeToken(e Email, tok Token) error
+
+	Record(a AuditEntry) error
+	// AuditLog returns entries for e, or for everyone if e is empty.
+	AuditLog(e Email, n int) []AuditEntry
+
+	// History returns the revisions of the package at pth, newest first.
+	History(pth string) []Revision
+
+	AddHook(h Hook) error
+	RemoveHook(id string) error
+	Hooks() []Hook
+	RecordDelivery(d Delivery) error
+	Deliveries(hook string, n int) []Delivery
+}
+
+// A Backend opens a Storer given a backend-specific data source name, e.g. a
+// file name.
+type Backend func(dsn string) (Storer, error)
+
+var backends = map[string]Backend{
+	"memdb": func(dsn string) (Storer, error) {
+		db, err := NewMemDB(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	},
+}
+
+// RegisterBackend makes a Backend available to OpenStore under scheme. It is
+// meant to be called from init.
+func RegisterBackend(scheme string, b Backend) {
+	backends[scheme] = b
+}
+
+// Backends returns the schemes of the registered Backends, sorted.
+func Backends() []string {
+	ss := []string{}
+	for s := range backends {
+		ss = append(ss, s)
+	}
+	sort.Strings(ss)
+	return ss
+}
+
+// OpenStore opens the Storer described by u, which is a registered scheme
+// followed by a colon and the backend's data source name, e.g.
+// "memdb:vain.db".
+func OpenStore(u string) (Storer, error) {
+	i := strings.Index(u, ":")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid store %q; want SCHEME:DSN, e.g. memdb:vain.db", u)
+	}
+	b, ok := backends[u[:i]]
+	if !ok {
+		return nil, fmt.Errorf("unsupported store %q; available: %s", u[:i], strings.Join(Backends(), ", "))
+	}
+	return b(u[i+1:])
 }
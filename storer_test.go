@@ -0,0 +1,230 @@
+package vain
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// testStorer checks the behaviour every Storer must share with MemDB. db must
+// be empty.
+// importRevision adds r to the history of its package by importing it into
+// db.
+func importRevision(t *testing.T, db Storer, r Revision) {
+	t.Helper()
+	if err := db.(Importer).Import(Registry{Version: RegistryVersion, Revisions: []Revision{r}}); err != nil {
+		t.Fatalf("import revision: %v", err)
+	}
+}
+
+func testStorer(t *testing.T, db Storer) {
+	t.Helper()
+	status := func(err error) int {
+		if err == nil {
+			return http.StatusOK
+		}
+		if e := verrors.ToHTTP(err); e != nil {
+			return e.Code
+		}
+		return 0
+	}
+	want := func(what string, err error, code int) {
+		t.Helper()
+		if got := status(err); got != code {
+			t.Fatalf("%s: got %v (%d), want %s", what, err, got, http.StatusText(code))
+		}
+	}
+
+	// watchers may be called asynchronously
+	var cl sync.Mutex
+	changes := []string{}
+	db.Watch(func(c Change) {
+		cl.Lock()
+		changes = append(changes, c.Action+" "+c.Package.Path)
+		cl.Unlock()
+	})
+
+	// users and tokens
+	reg, err := db.Register("sm@example.org")
+	want("register", err, http.StatusOK)
+	_, err = db.Register("sm@example.org")
+	want("duplicate register", err, http.StatusConflict)
+	tok, err := db.Confirm(reg)
+	want("confirm", err, http.StatusOK)
+	_, err = db.Confirm(reg)
+	want("confirm used token", err, http.StatusNotFound)
+	_, err = db.UserForToken(reg)
+	want("user for old token", err, http.StatusNotFound)
+	if e, err := db.UserForToken(tok); err != nil || e != "sm@example.org" {
+		t.Fatalf("user for token: got %q, %v", e, err)
+	}
+	if got, err := db.Forgot("sm@example.org", window); err != nil || got != tok {
+		t.Fatalf("forgot: got %q, %v, want %q", got, err, tok)
+	}
+	_, err = db.Forgot("nobody@example.org", window)
+	want("forgot missing user", err, http.StatusNotFound)
+	extra, err := db.AddToken("sm@example.org")
+	want("add token", err, http.StatusOK)
+	_, err = db.AddToken("nobody@example.org")
+	want("add token for missing user", err, http.StatusNotFound)
+	if got := db.Tokens("sm@example.org"); len(got) != 2 || (got[0] != tok && got[1] != tok) {
+		t.Fatalf("tokens: got %v", got)
+	}
+	want("revoke other's token", db.RevokeToken("mc@example.org", extra), http.StatusNotFound)
+	want("revoke token", db.RevokeToken("sm@example.org", tok), http.StatusOK)
+	want("revoke last token", db.RevokeToken("sm@example.org", extra), http.StatusConflict)
+	if got, err := db.Forgot("sm@example.org", window); err != nil || got != extra {
+		t.Fatalf("forgot after revoke: got %q, %v, want %q", got, err, extra)
+	}
+	tok = extra
+
+	// namespaces
+	want("claim", db.NSForToken("sm", tok), http.StatusOK)
+	want("claim again", db.NSForToken("sm", tok), http.StatusOK)
+	want("claim with bogus token", db.NSForToken("sm", "bogus"), http.StatusNotFound)
+	reg, _ = db.Register("mc@example.org")
+	mc, _ := db.Confirm(reg)
+	want("claim other's namespace", db.NSForToken("sm", mc), http.StatusUnauthorized)
+	db.NSForToken("zz", tok)
+	if got, want := fmt.Sprint(db.UserNamespaces("sm@example.org")), "[sm zz]"; got != want {
+		t.Fatalf("namespaces: got %s, want %s", got, want)
+	}
+
+	// packages
+	p := Package{Vcs: "git", Repo: "https://example.org/a", Path: "a.org/sm/a"}
+	ed := Edit{Email: "sm@example.org"}
+	want("add", db.AddPackage(p, ed), http.StatusOK)
+	want("add", db.AddPackage(Package{Vcs: "git", Repo: "https://example.org/b", Path: "a.org/sm/b"}, ed), http.StatusOK)
+	if !db.PackageExists("a.org/sm/a") || db.PackageExists("a.org/sm/nope") {
+		t.Fatalf("package exists: wrong answer")
+	}
+	if got, err := db.Package("a.org/sm/a/sub/pkg"); err != nil || got.Path != p.Path {
+		t.Fatalf("package by prefix: got %+v, %v", got, err)
+	}
+	_, err = db.Package("a.org/sm/aa")
+	want("package", err, http.StatusNotFound)
+	p.Vcs = "hg"
+	want("update", db.UpdatePackage(p, Edit{Email: "sm@example.org", Action: actionRollback}), http.StatusOK)
+	if got, _ := db.Package(p.Path); got.Vcs != "hg" {
+		t.Fatalf("update: got %+v", got)
+	}
+	want("update missing", db.UpdatePackage(Package{Path: "a.org/sm/nope"}, ed), http.StatusNotFound)
+	want("remove", db.RemovePackage("a.org/sm/b", ed), http.StatusOK)
+	if got := db.Pkgs(); len(got) != 1 || got[0] != p {
+		t.Fatalf("pkgs: got %+v", got)
+	}
+	if page, err := db.QueryPackages(Query{Vcs: "hg"}); err != nil || page.Total != 1 {
+		t.Fatalf("query: got %+v, %v", page, err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		cl.Lock()
+		got := fmt.Sprint(changes)
+		cl.Unlock()
+		want := "[create a.org/sm/a create a.org/sm/b update a.org/sm/a delete a.org/sm/b]"
+		if got == want {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("changes: got %s, want %s", got, want)
+		}
+	}
+
+	// audit log
+	for i := 0; i < maxAudit+2; i++ {
+		e := Email("sm@example.org")
+		if i%2 == 1 {
+			e = "mc@example.org"
+		}
+		if err := db.Record(AuditEntry{Time: time.Now(), Email: e, Action: fmt.Sprint(i)}); err != nil {
+			t.Fatalf("record: %v", err)
+		}
+	}
+	if got := db.AuditLog("", 2*maxAudit); len(got) != maxAudit || got[0].Action != fmt.Sprint(maxAudit+1) {
+		t.Fatalf("audit log: got %d entries, newest %+v", len(got), got[0])
+	}
+	if got := db.AuditLog("sm@example.org", 2); len(got) != 2 || got[0].Action != fmt.Sprint(maxAudit) || got[1].Action != fmt.Sprint(maxAudit-2) {
+		t.Fatalf("user's audit log: got %+v", got)
+	}
+
+	// revisions, of which writing packages left some
+	history := func(pth string) string {
+		s := []string{}
+		for _, r := range db.History(pth) {
+			s = append(s, fmt.Sprintf("%d %s %s %s", r.Number, r.Email, r.Action, r.Package.Vcs))
+		}
+		return strings.Join(s, "; ")
+	}
+	if got, want := history(p.Path), "2 sm@example.org rollback hg; 1 sm@example.org create git"; got != want {
+		t.Fatalf("history:\ngot  %s\nwant %s", got, want)
+	}
+	if got, want := history("a.org/sm/b"), "2 sm@example.org delete git; 1 sm@example.org create git"; got != want {
+		t.Fatalf("history of removed package:\ngot  %s\nwant %s", got, want)
+	}
+	if rs := db.History(p.Path); rs[0].Time.IsZero() {
+		t.Fatalf("history: revision without time %+v", rs[0])
+	}
+	for i := 0; i < maxRevisions; i++ {
+		importRevision(t, db, Revision{Time: time.Now(), Email: "sm@example.org", Action: actionUpdate, Package: p})
+		if rs := db.History(p.Path); rs[0].Number != i+3 {
+			t.Fatalf("import revision: got %+v", rs[0])
+		}
+	}
+	importRevision(t, db, Revision{Action: actionCreate, Package: Package{Path: "a.org/sm/other"}})
+	if rs := db.History(p.Path); len(rs) != maxRevisions || rs[0].Number != maxRevisions+2 || rs[0].Package.Repo != p.Repo || rs[0].Email != "sm@example.org" {
+		t.Fatalf("history: got %d, newest %+v", len(rs), rs[0])
+	}
+	if rs := db.History("a.org/sm/nope"); len(rs) != 0 {
+		t.Fatalf("history of missing package: got %+v", rs)
+	}
+	// numbered revisions keep their number, replacing any they collide
+	// with
+	for _, r := range []Revision{
+		{Number: maxRevisions + 5, Action: actionUpdate, Package: p},
+		{Number: maxRevisions + 2, Action: actionRollback, Package: p},
+		{Action: actionDelete, Package: p},
+	} {
+		importRevision(t, db, r)
+	}
+	if rs := db.History(p.Path); len(rs) != maxRevisions-2 || rs[0].Number != maxRevisions+6 || rs[1].Number != maxRevisions+5 || rs[2].Action != actionRollback {
+		t.Fatalf("history after numbered revisions: got %d, newest %+v", len(rs), rs[:3])
+	}
+
+	// hooks
+	now := time.Now().UTC()
+	for i, id := range []string{"b", "a"} {
+		if err := db.AddHook(Hook{ID: id, URL: "http://example.org/" + id, Created: now.Add(time.Duration(i) * time.Second)}); err != nil {
+			t.Fatalf("add hook: %v", err)
+		}
+	}
+	if hs := db.Hooks(); len(hs) != 2 || hs[0].ID != "b" || !hs[0].Created.Equal(now) {
+		t.Fatalf("hooks: got %+v", hs)
+	}
+	for i := 0; i < maxDeliveries+1; i++ {
+		if err := db.RecordDelivery(Delivery{Hook: "a", Attempt: i}); err != nil {
+			t.Fatalf("record delivery: %v", err)
+		}
+	}
+	want("delivery for missing hook", db.RecordDelivery(Delivery{Hook: "nope"}), http.StatusNotFound)
+	if ds := db.Deliveries("a", 2*maxDeliveries); len(ds) != maxDeliveries || ds[0].Attempt != maxDeliveries {
+		t.Fatalf("deliveries: got %d, newest %+v", len(ds), ds[0])
+	}
+	want("remove hook", db.RemoveHook("a"), http.StatusOK)
+	want("remove missing hook", db.RemoveHook("a"), http.StatusNotFound)
+	if ds := db.Deliveries("a", 10); len(ds) != 0 {
+		t.Fatalf("deliveries of removed hook: got %+v", ds)
+	}
+}
+
+func TestMemDBStorer(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+	testStorer(t, db)
+}
@@ -0,0 +1,326 @@
+package vain
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+)
+
+// chrome is the data used by the header and footer shared by all pages.
+type chrome struct {
+	Email   Email
+	CSRF    string
+	Error   string
+	Message string
+}
+
+// render writes the named template using data with the given status code.
+func (s *Server) render(w http.ResponseWriter, code int, name string, data interface{}) {
+	w.Header().Set("Content-type", "text/html; charset=utf-8")
+	w.WriteHeader(code)
+	if err := s.templates.ExecuteTemplate(w, name, data); err != nil {
+		fmt.Fprintf(w, "problem rendering page: %v", err)
+	}
+}
+
+// vcsChoice is the data used to render a vcs select element.
+type vcsChoice struct {
+	Vcss []string
+	Cur  string
+}
+
+var funcs = template.FuncMap{
+	// asset and brand are replaced per Server
+	"asset": func(name string) string {
+		return prefix["static"] + name
+	},
+	"choice": func(vcss []string, cur string) vcsChoice {
+		return vcsChoice{Vcss: vcss, Cur: cur}
+	},
+	"brand": func() Branding {
+		return Branding{Name: defaultName}
+	},
+	"refresh": func() int {
+		return landingRefresh
+	},
+	"landings": func() map[string]string {
+		return map[string]string{
+			"":              "default",
+			landingPage:     "landing page",
+			landingRedirect: "redirect",
+		}
+	},
+}
+
+var templates = template.Must(template.New("").Funcs(funcs).Parse(`
+{{define "header"}}<!DOCTYPE html>
+<html lang="en">
+    <head>
+        <meta charset="utf-8">
+        <meta http-equiv="X-UA-Compatible" content="IE=edge">
+        <meta name="viewport" content="width=device-width, initial-scale=1">
+
+        <link href="{{asset "css/bootstrap.min.css"}}" rel="stylesheet">
+        <link href="{{asset "css/vain.css"}}" rel="stylesheet">
+        {{with brand.CSS}}<link href="{{.}}" rel="stylesheet">{{end}}
+
+        <title>{{brand.Name}}</title>
+    </head>
+    <body>
+        <nav class="navbar navbar-inverse navbar-fixed-top" role="navigation">
+            <div class="container">
+                <div class="navbar-header">
+                    <a class="navbar-brand" href="/">{{with brand.Logo}}<img src="{{.}}" alt="" height="20" style="display: inline-block"> {{end}}{{brand.Name}}</a>
+                </div>
+                <ul class="nav navbar-nav">
+                    <li><a href="/_static/register/">register</a></li>
+                    <li><a href="/_pkgs/">packages</a></li>
+                    <li><a href="/_dashboard/">dashboard</a></li>
+                </ul>
+                {{if .Email}}
+                <form class="navbar-form navbar-right" method="post" action="/_dashboard/logout">
+                    <input type="hidden" name="csrf" value="{{.CSRF}}">
+                    <span class="navbar-text">{{.Email}}</span>
+                    <button class="btn btn-default" type="submit">log out</button>
+                </form>
+                {{end}}
+            </div>
+        </nav>
+
+        <div class="container">
+            {{if .Error}}<div class="alert alert-danger" role="alert">{{.Error}}</div>{{end}}
+            {{if .Message}}<div class="alert alert-success" role="alert">{{.Message}}</div>{{end}}
+{{end}}
+
+{{define "footer"}}
+            {{with brand.Contact}}<hr><p><small>Questions? Contact <a href="mailto:{{.}}">{{.}}</a>.</small></p>{{end}}
+        </div>
+
+        <script src="{{asset "js/jquery.js"}}"></script>
+        <script src="{{asset "js/bootstrap.min.js"}}"></script>
+        <script src="{{asset "js/vain.js"}}"></script>
+    </body>
+</html>
+{{end}}
+
+{{define "index"}}{{template "header" .}}
+            <h1>{{brand.Name}}</h1>
+            <p class="lead"> This server implements <a href="https://golang.org/cmd/go/#hdr-Remote_import_paths">remote import paths</a> for use by the <a href="https://golang.org/cmd/go/">go tool</a>. </p>
+
+            <h2>Adding a repository</h2>
+            <p>
+            The first step is to obtain an api key. Visit the <a href="/_static/register/">registration page</a>
+            and follow the instructions to obtain a key.
+            </p>
+            <p> Let's say you've got some code hosted at <code>https://git.example.com/user/foo</code> that you'd like to publish with <code>/foo</code>. Armed with the token you previously obtained (e.g. <code>c033-b79f-7fa1</code>) </p>
+            <pre>
+$ export TOKEN=c033-b79f-7fa1
+$ curl -i -H "Authorization: Bearer $TOKEN" -d '{"repo": "https://git.example.com/user/foo"}' {{.Scheme}}://{{.Host}}/foo </pre>
+            <p>Packages can also be managed from the <a href="/_dashboard/">dashboard</a>.</p>
+
+            <h2>Deleting a repository</h2>
+            <p>Using the appropriate token for the route you want to delete:</p>
+            <pre>
+$ curl -i -H "Authorization: Bearer $TOKEN" -X DELETE {{.Scheme}}://{{.Host}}/foo </pre>
+
+            <h2>go tool</h2>
+            <p> The <a href="https://golang.org/cmd/go/#hdr-Download_and_install_packages_and_dependencies">go tool</a> doesn't need any modification in order to work: </p>
+            <pre>$ go get {{.Host}}/foo</pre>
+            <p> should just work. {{if eq .Scheme "http"}}This server is not hosted using TLS, so you will need to tell the go tool to fetch from it insecurely; only do so if you are not concerned with adversarial manipulation of your source:</p>
+            <pre>$ GOINSECURE={{.Host}} GOPRIVATE={{.Host}} go get {{.Host}}/foo</pre>{{else}}</p>{{end}}
+            <p>Browse the <a href="/_pkgs/">packages</a> already served.</p>
+{{template "footer" .}}{{end}}
+
+{{define "email-form"}}
+            <div id="alert" class="alert alert-danger" style="display: none;">placeholder</div>
+            <div id="success" class="alert alert-success" style="display: none;" role="alert">...</div>
+
+            <div id="input" class="row">
+                <div class="col-md-2"></div>
+                <div class="input-group col-md-6">
+                    <span class="input-group-addon">
+                        <span class="glyphicon glyphicon-envelope"></span>
+                    </span>
+                    <input type="text" class="form-control" id="name" placeholder="Email address" autofocus>
+                    <span class="input-group-btn">
+                        <button id="send" class="btn btn-block" type="button">
+                            <span class="glyphicon glyphicon-send"></span> &nbsp; Submit
+                        </button>
+                    </span>
+                </div>
+                <div class="col-md-4"></div>
+            </div>
+{{end}}
+
+{{define "register"}}{{template "header" .}}
+            <h1>Registration</h1>
+            <p>Please provide your email and instructions will be sent to your email.</p>
+{{template "email-form"}}
+            <p style="padding-top: 40px"><small>If you've forgotten your token please <a href="/_static/forgot/">request instructions to recover</a> a token.</small></p>
+{{template "footer" .}}{{end}}
+
+{{define "forgot"}}{{template "header" .}}
+            <h1>Forgot token</h1>
+            <p>If you've forgotten your token please provide an email and instructions will be sent to you.</p>
+{{template "email-form"}}
+{{template "footer" .}}{{end}}
+
+{{define "login"}}{{template "header" .}}
+            <h1>Dashboard</h1>
+            <p>Log in using the api token you obtained when you <a href="/_static/register/">registered</a>.</p>
+            <form class="form-inline" method="post" action="/_dashboard/login">
+                <input type="password" class="form-control" name="token" placeholder="Token" autofocus>
+                <button class="btn btn-primary" type="submit">log in</button>
+            </form>
+            <p style="padding-top: 40px"><small>If you've forgotten your token please <a href="/_static/forgot/">request instructions to recover</a> a token.</small></p>
+{{template "footer" .}}{{end}}
+
+{{define "directory"}}{{template "header" .}}
+            <h1>Packages</h1>
+            <form class="form-inline" method="get" action="/_pkgs/">
+                <input type="text" class="form-control" name="q" value="{{.Query}}" placeholder="Search by path">
+                <button class="btn btn-default" type="submit">search</button>
+            </form>
+            <p>{{.Total}} package{{if ne .Total 1}}s{{end}}{{if .Query}} matching <code>{{.Query}}</code>{{end}}</p>
+            {{range .Groups}}
+            <h2>{{.Namespace}}</h2>
+            <table class="table">
+                <thead><tr><th>path</th><th>vcs</th><th>repo</th><th></th></tr></thead>
+                <tbody>
+                {{range .Packages}}
+                <tr>
+                    <td><code>{{.Path}}</code></td>
+                    <td>{{.Vcs}}</td>
+                    <td><a href="{{.Repo}}">{{.Repo}}</a></td>
+                    <td><code>go get {{.Path}}</code></td>
+                </tr>
+                {{end}}
+                </tbody>
+            </table>
+            {{end}}
+            {{if gt .Pages 1}}
+            <nav>
+                <ul class="pager">
+                    {{if .Prev}}<li class="previous"><a href="{{.Prev}}">&larr; previous</a></li>{{end}}
+                    <li>page {{.Page}} of {{.Pages}}</li>
+                    {{if .Next}}<li class="next"><a href="{{.Next}}">next &rarr;</a></li>{{end}}
+                </ul>
+            </nav>
+            {{end}}
+{{template "footer" .}}{{end}}
+
+{{define "landing-page"}}<!DOCTYPE html>
+<html lang="en">
+    <head>
+        <meta charset="utf-8">
+        <meta name="viewport" content="width=device-width, initial-scale=1">
+        <meta name="go-import" content="{{.Package.Path}} {{.Package.Vcs}} {{.Package.Repo}}">
+        {{if .Refresh}}<meta http-equiv="refresh" content="{{refresh}}; url={{.Refresh}}">{{end}}
+
+        <link href="{{asset "css/bootstrap.min.css"}}" rel="stylesheet">
+        <link href="{{asset "css/vain.css"}}" rel="stylesheet">
+
+        <title>{{.ImportPath}}</title>
+    </head>
+    <body>
+        <div class="container">
+            <h1><code>{{.ImportPath}}</code></h1>
+            <p>This is a vanity import path for the {{.Package.Vcs}} repository at <a href="{{.Package.Repo}}">{{.Package.Repo}}</a>.</p>
+            <pre>$ go get {{.ImportPath}}</pre>
+            <pre>$ go install {{.ImportPath}}@latest</pre>
+            <ul>
+                <li><a href="{{.Package.Repo}}">source</a></li>
+                <li><a href="{{.Docs}}">documentation</a></li>
+            </ul>
+            {{if .Refresh}}<p><small>You will be sent to the repository in {{refresh}} seconds.</small></p>{{end}}
+        </div>
+    </body>
+</html>
+{{end}}
+
+{{define "vcs"}}<select class="form-control" name="vcs">{{$cur := .Cur}}{{range .Vcss}}<option value="{{.}}"{{if eq . $cur}} selected{{end}}>{{.}}</option>{{end}}</select>{{end}}
+
+{{define "landing"}}<select class="form-control" name="landing" title="what browsers see">{{$cur := .}}{{range $v, $name := landings}}<option value="{{$v}}"{{if eq $v $cur}} selected{{end}}>{{$name}}</option>{{end}}</select>{{end}}
+
+{{define "dashboard"}}{{template "header" .}}
+            <h1>Dashboard</h1>
+
+            <h2>Namespaces</h2>
+            {{if .Namespaces}}
+            <ul>{{range .Namespaces}}<li><code>{{$.Host}}/{{.}}</code></li>{{end}}</ul>
+            {{else}}
+            <p>You don't own any namespaces yet; adding a package claims its namespace.</p>
+            {{end}}
+
+            <h2>Packages</h2>
+            <table class="table">
+                <thead><tr><th>path</th><th>vcs / repo</th><th></th></tr></thead>
+                <tbody>
+                {{range .Packages}}
+                <tr>
+                    <td><code>{{.Path}}</code></td>
+                    <td>
+                        <form class="form-inline" method="post" action="/_dashboard/edit">
+                            <input type="hidden" name="csrf" value="{{$.CSRF}}">
+                            <input type="hidden" name="path" value="{{.Path}}">
+                            {{template "vcs" choice $.Vcss .Vcs}}
+                            <input type="text" class="form-control" name="repo" value="{{.Repo}}">
+                            <input type="text" class="form-control" name="docs" value="{{.Docs}}" placeholder="docs url (optional)">
+                            {{template "landing" .Landing}}
+                            <button class="btn btn-default" type="submit">save</button>
+                        </form>
+                    </td>
+                    <td>
+                        <form method="post" action="/_dashboard/delete">
+                            <input type="hidden" name="csrf" value="{{$.CSRF}}">
+                            <input type="hidden" name="path" value="{{.Path}}">
+                            <button class="btn btn-danger" type="submit">delete</button>
+                        </form>
+                    </td>
+                </tr>
+                {{end}}
+                </tbody>
+            </table>
+            <form class="form-inline" method="post" action="/_dashboard/add">
+                <input type="hidden" name="csrf" value="{{.CSRF}}">
+                <div class="input-group">
+                    <span class="input-group-addon">{{.Host}}/</span>
+                    <input type="text" class="form-control" name="path" placeholder="ns/pkg">
+                </div>
+                {{template "vcs" choice .Vcss "git"}}
+                <input type="text" class="form-control" name="repo" placeholder="https://git.example.com/user/pkg">
+                <input type="text" class="form-control" name="docs" placeholder="docs url (optional)">
+                {{template "landing" ""}}
+                <button class="btn btn-primary" type="submit">add</button>
+            </form>
+
+            <h2>Tokens</h2>
+            <table class="table">
+                {{range .Tokens}}
+                <tr>
+                    <td><code>{{.}}</code></td>
+                    <td>
+                        <form method="post" action="/_dashboard/tokens/revoke">
+                            <input type="hidden" name="csrf" value="{{$.CSRF}}">
+                            <input type="hidden" name="token" value="{{.}}">
+                            <button class="btn btn-danger" type="submit">revoke</button>
+                        </form>
+                    </td>
+                </tr>
+                {{end}}
+            </table>
+            <form method="post" action="/_dashboard/tokens/new">
+                <input type="hidden" name="csrf" value="{{.CSRF}}">
+                <button class="btn btn-default" type="submit">new token</button>
+            </form>
+
+            <h2>Recent activity</h2>
+            <table class="table table-condensed">
+                {{range .Audit}}
+                <tr><td>{{.Time.Format "2006-01-02 15:04:05"}}</td><td>{{.Action}}</td><td><code>{{.Path}}</code></td><td>{{.Detail}}</td></tr>
+                {{else}}
+                <tr><td>nothing yet</td></tr>
+                {{end}}
+            </table>
+{{template "footer" .}}{{end}}
+`))
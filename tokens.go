@@ -0,0 +1,58 @@
+package vain
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// tokens manages the api tokens of the user owning the bearer token:
+//
+//	GET    tokens/       list tokens
+//	POST   tokens/       create a token
+//	DELETE tokens/{tok}  revoke a token
+func (s *Server) tokens(w http.ResponseWriter, req *http.Request) {
+	db := s.store(req.Context())
+	tok := bearer(req)
+	if tok == "" {
+		http.Error(w, "missing token", http.StatusUnauthorized)
+		return
+	}
+	e, err := db.UserForToken(tok)
+	if err := verrors.ToHTTP(err); err != nil {
+		http.Error(w, err.Message, err.Code)
+		return
+	}
+
+	rest := Token(strings.Trim(strings.TrimPrefix(req.URL.Path, prefix["tokens"]), "/"))
+	switch {
+	case rest == "" && req.Method == "GET":
+		writeJSON(w, db.Tokens(e))
+	case rest == "" && req.Method == "POST":
+		if s.refuse(w) {
+			return
+		}
+		nt, err := db.AddToken(e)
+		if err := verrors.ToHTTP(err); err != nil {
+			http.Error(w, err.Message, err.Code)
+			return
+		}
+		audit(db, e, "token-add", "", "")
+		writeJSON(w, struct {
+			Token Token `json:"token"`
+		}{nt})
+	case rest != "" && req.Method == "DELETE":
+		if s.refuse(w) {
+			return
+		}
+		if err := verrors.ToHTTP(db.RevokeToken(e, rest)); err != nil {
+			http.Error(w, err.Message, err.Code)
+			return
+		}
+		audit(db, e, "token-revoke", "", "")
+	default:
+		http.Error(w, fmt.Sprintf("unsupported method %q", req.Method), http.StatusMethodNotAllowed)
+	}
+}
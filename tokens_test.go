@@ -0,0 +1,68 @@
+package vain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTokensAPI(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+
+	resp := hookReq(t, "GET", ts.URL+prefix["tokens"], "", "")
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusUnauthorized; got != want {
+		t.Fatalf("no token; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+
+	resp = hookReq(t, "POST", ts.URL+prefix["tokens"], tok, "")
+	nt := struct {
+		Token Token `json:"token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&nt); err != nil {
+		t.Fatalf("couldn't decode token: %v", err)
+	}
+	resp.Body.Close()
+	if nt.Token == "" {
+		t.Fatalf("no token created")
+	}
+
+	resp = hookReq(t, "GET", ts.URL+prefix["tokens"], nt.Token, "")
+	toks := []Token{}
+	if err := json.NewDecoder(resp.Body).Decode(&toks); err != nil {
+		t.Fatalf("couldn't decode tokens: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := len(toks), 2; got != want {
+		t.Fatalf("bad number of tokens; got %d, want %d", got, want)
+	}
+
+	resp = hookReq(t, "DELETE", ts.URL+prefix["tokens"]+string(tok), nt.Token, "")
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("couldn't revoke; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	resp = hookReq(t, "DELETE", ts.URL+prefix["tokens"]+string(nt.Token), nt.Token, "")
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusConflict; got != want {
+		t.Fatalf("revoked last token; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if _, err := db.UserForToken(tok); err == nil {
+		t.Fatalf("revoked token still valid")
+	}
+}
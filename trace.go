@@ -0,0 +1,229 @@
+package vain
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	verrors "mcquay.me/vain/errors"
+	"mcquay.me/vain/tracing"
+)
+
+// tracedStore wraps a Storer so that each call is recorded as a child span of
+// the span found in ctx.
+type tracedStore struct {
+	ctx context.Context
+	db  Storer
+}
+
+// store returns s.db wrapped such that calls are traced under ctx.
+func (s *Server) store(ctx context.Context) Storer {
+	return tracedStore{ctx: ctx, db: s.db}
+}
+
+func (t tracedStore) NSForToken(ns namespace, tok Token) error {
+	_, span := tracing.Start(t.ctx, "Storer.NSForToken", attribute.String("vain.namespace", string(ns)))
+	err := t.db.NSForToken(ns, tok)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) UserNamespaces(e Email) []namespace {
+	_, span := tracing.Start(t.ctx, "Storer.UserNamespaces")
+	nss := t.db.UserNamespaces(e)
+	tracing.End(span, nil)
+	return nss
+}
+
+func (t tracedStore) Package(pth string) (Package, error) {
+	_, span := tracing.Start(t.ctx, "Storer.Package", attribute.String("vain.path", pth))
+	p, err := t.db.Package(pth)
+	tracing.End(span, err)
+	return p, err
+}
+
+func (t tracedStore) AddPackage(p Package, ed Edit) error {
+	_, span := tracing.Start(t.ctx, "Storer.AddPackage", attribute.String("vain.path", p.Path))
+	err := t.db.AddPackage(p, ed)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) UpdatePackage(p Package, ed Edit) error {
+	_, span := tracing.Start(t.ctx, "Storer.UpdatePackage", attribute.String("vain.path", p.Path))
+	err := t.db.UpdatePackage(p, ed)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) RemovePackage(pth path, ed Edit) error {
+	_, span := tracing.Start(t.ctx, "Storer.RemovePackage", attribute.String("vain.path", string(pth)))
+	err := t.db.RemovePackage(pth, ed)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) PackageExists(pth path) bool {
+	_, span := tracing.Start(t.ctx, "Storer.PackageExists", attribute.String("vain.path", string(pth)))
+	ok := t.db.PackageExists(pth)
+	tracing.End(span, nil)
+	return ok
+}
+
+func (t tracedStore) Pkgs() []Package {
+	_, span := tracing.Start(t.ctx, "Storer.Pkgs")
+	ps := t.db.Pkgs()
+	span.SetAttributes(attribute.Int("vain.packages", len(ps)))
+	tracing.End(span, nil)
+	return ps
+}
+
+func (t tracedStore) QueryPackages(q Query) (Page, error) {
+	_, span := tracing.Start(t.ctx, "Storer.QueryPackages")
+	page, err := t.db.QueryPackages(q)
+	span.SetAttributes(attribute.Int("vain.packages", len(page.Packages)), attribute.Int("vain.total", page.Total))
+	tracing.End(span, err)
+	return page, err
+}
+
+func (t tracedStore) Register(e Email) (Token, error) {
+	_, span := tracing.Start(t.ctx, "Storer.Register")
+	tok, err := t.db.Register(e)
+	tracing.End(span, err)
+	return tok, err
+}
+
+func (t tracedStore) Confirm(tok Token) (Token, error) {
+	_, span := tracing.Start(t.ctx, "Storer.Confirm")
+	tok, err := t.db.Confirm(tok)
+	tracing.End(span, err)
+	return tok, err
+}
+
+func (t tracedStore) Forgot(e Email, window time.Duration) (Token, error) {
+	_, span := tracing.Start(t.ctx, "Storer.Forgot")
+	tok, err := t.db.Forgot(e, window)
+	tracing.End(span, err)
+	return tok, err
+}
+
+func (t tracedStore) UserForToken(tok Token) (Email, error) {
+	_, span := tracing.Start(t.ctx, "Storer.UserForToken")
+	e, err := t.db.UserForToken(tok)
+	tracing.End(span, err)
+	return e, err
+}
+
+func (t tracedStore) Tokens(e Email) []Token {
+	_, span := tracing.Start(t.ctx, "Storer.Tokens")
+	toks := t.db.Tokens(e)
+	tracing.End(span, nil)
+	return toks
+}
+
+func (t tracedStore) AddToken(e Email) (Token, error) {
+	_, span := tracing.Start(t.ctx, "Storer.AddToken")
+	tok, err := t.db.AddToken(e)
+	tracing.End(span, err)
+	return tok, err
+}
+
+func (t tracedStore) RevokeToken(e Email, tok Token) error {
+	_, span := tracing.Start(t.ctx, "Storer.RevokeToken")
+	err := t.db.RevokeToken(e, tok)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) Record(a AuditEntry) error {
+	_, span := tracing.Start(t.ctx, "Storer.Record", attribute.String("vain.action", a.Action))
+	err := t.db.Record(a)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) AuditLog(e Email, n int) []AuditEntry {
+	_, span := tracing.Start(t.ctx, "Storer.AuditLog")
+	as := t.db.AuditLog(e, n)
+	tracing.End(span, nil)
+	return as
+}
+
+func (t tracedStore) History(pth string) []Revision {
+	_, span := tracing.Start(t.ctx, "Storer.History", attribute.String("vain.path", pth))
+	rs := t.db.History(pth)
+	tracing.End(span, nil)
+	return rs
+}
+
+func (t tracedStore) AddHook(h Hook) error {
+	_, span := tracing.Start(t.ctx, "Storer.AddHook", attribute.String("vain.hook", h.ID))
+	err := t.db.AddHook(h)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) RemoveHook(id string) error {
+	_, span := tracing.Start(t.ctx, "Storer.RemoveHook", attribute.String("vain.hook", id))
+	err := t.db.RemoveHook(id)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) Hooks() []Hook {
+	_, span := tracing.Start(t.ctx, "Storer.Hooks")
+	hs := t.db.Hooks()
+	tracing.End(span, nil)
+	return hs
+}
+
+func (t tracedStore) RecordDelivery(d Delivery) error {
+	_, span := tracing.Start(t.ctx, "Storer.RecordDelivery", attribute.String("vain.hook", d.Hook))
+	err := t.db.RecordDelivery(d)
+	tracing.End(span, err)
+	return err
+}
+
+func (t tracedStore) Deliveries(hook string, n int) []Delivery {
+	_, span := tracing.Start(t.ctx, "Storer.Deliveries", attribute.String("vain.hook", hook))
+	ds := t.db.Deliveries(hook, n)
+	tracing.End(span, nil)
+	return ds
+}
+
+func (t tracedStore) Watch(fn func(Change)) {
+	t.db.Watch(fn)
+}
+
+func (t tracedStore) Export() (Registry, error) {
+	_, span := tracing.Start(t.ctx, "Storer.Export")
+	r, err := Export(t.db)
+	tracing.End(span, err)
+	return r, err
+}
+
+func (t tracedStore) Import(r Registry) error {
+	_, span := tracing.Start(t.ctx, "Storer.Import", attribute.Int("vain.packages", len(r.Packages)))
+	var err error
+	if imp, ok := t.db.(Importer); ok {
+		err = imp.Import(r)
+	} else {
+		err = verrors.HTTP{
+			Message: "store does not support bulk import",
+			Code:    http.StatusNotImplemented,
+		}
+	}
+	tracing.End(span, err)
+	return err
+}
+
+// send traces a call to s.mail.Send under ctx.
+func (s *Server) send(ctx context.Context, to mail.Address, subject, msg string) error {
+	_, span := tracing.Start(ctx, "Mailer.Send")
+	err := s.mail.Send(to, subject, msg)
+	tracing.End(span, err)
+	return err
+}
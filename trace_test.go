@@ -0,0 +1,91 @@
+package vain
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(prev)
+
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	mm := &mockMail{}
+	NewServer(sm, db, mm, "", window, true)
+	ts := httptest.NewServer(sm)
+
+	p := Package{
+		Vcs:  "git",
+		Repo: "https://example.org/foo",
+		Path: fmt.Sprintf("%s/foo", strings.TrimPrefix(ts.URL, "http://")),
+		Ns:   "foo",
+	}
+	if err := db.AddPackage(p, Edit{}); err != nil {
+		t.Fatalf("couldn't add package %v: %v", p, err)
+	}
+
+	resp, err := http.Get(ts.URL + "/foo?go-get=1")
+	if err != nil {
+		t.Fatalf("problem getting route: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = http.Post(ts.URL+prefix["register"]+"?email=fake@example.com", "", nil)
+	if err != nil {
+		t.Fatalf("couldn't POST: %v", err)
+	}
+	resp.Body.Close()
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	hookReq(t, "GET", ts.URL+prefix["v1-bulk"], tok, "").Body.Close()
+
+	spans := exp.GetSpans()
+	byName := map[string]tracetest.SpanStub{}
+	for _, s := range spans {
+		byName[s.Name] = s
+	}
+
+	tests := []struct {
+		child  string
+		parent string
+	}{
+		{"Storer.Package", "GET root"},
+		{"Storer.Register", "POST register"},
+		{"Mailer.Send", "POST register"},
+		{"Storer.Export", "GET v1-bulk"},
+	}
+	for _, test := range tests {
+		c, ok := byName[test.child]
+		if !ok {
+			t.Errorf("missing span %q; got %v", test.child, spans.Snapshots())
+			continue
+		}
+		p, ok := byName[test.parent]
+		if !ok {
+			t.Errorf("missing span %q", test.parent)
+			continue
+		}
+		if got, want := c.Parent.SpanID(), p.SpanContext.SpanID(); got != want {
+			t.Errorf("%s should be a child of %s; got parent %v, want %v", test.child, test.parent, got, want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Package tracing wires vain into OpenTelemetry.
+//
+// Spans are recorded against the global TracerProvider, which is a no-op
+// unless Init finds an OTLP exporter configured in the environment using the
+// standard OTEL_* variables, e.g.:
+//
+//	OTEL_EXPORTER_OTLP_ENDPOINT=http://localhost:4318
+//	OTEL_SERVICE_NAME=vaind
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const name = "mcquay.me/vain"
+
+// Init installs an OTLP exporting TracerProvider if the environment asks for
+// one, and returns a function that flushes and stops it. When tracing is not
+// configured the global no-op provider is left in place.
+func Init(ctx context.Context, service string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(
+		propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		),
+	)
+	nop := func(context.Context) error { return nil }
+	if !enabled() {
+		return nop, nil
+	}
+	if p := protocol(); p != "http/protobuf" {
+		return nop, fmt.Errorf("unsupported otlp protocol %q; accepted: http/protobuf", p)
+	}
+
+	exp, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nop, fmt.Errorf("problem creating otlp exporter: %v", err)
+	}
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(service)),
+	)
+	if err != nil {
+		return nop, fmt.Errorf("problem creating resource: %v", err)
+	}
+	// allow OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES to win
+	if env, err := resource.New(ctx, resource.WithFromEnv()); err == nil {
+		if r, err := resource.Merge(res, env); err == nil {
+			res = r
+		}
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// enabled reports whether the environment configures a trace exporter.
+func enabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	switch os.Getenv("OTEL_TRACES_EXPORTER") {
+	case "otlp":
+		return true
+	case "":
+		return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+			os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+	}
+	return false
+}
+
+func protocol() string {
+	if p := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"); p != "" {
+		return p
+	}
+	if p := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); p != "" {
+		return p
+	}
+	return "http/protobuf"
+}
+
+// Tracer returns the vain tracer from the current global TracerProvider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// Start begins a span named name as a child of any span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err (if any) on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Handler wraps h so that each request is recorded as a server span for route,
+// continuing any trace propagated by the caller.
+func Handler(route string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+		ctx, span := Tracer().Start(
+			ctx,
+			fmt.Sprintf("%s %s", req.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.HTTPRouteKey.String(route),
+				semconv.URLPathKey.String(req.URL.Path),
+				semconv.ServerAddressKey.String(req.Host),
+			),
+		)
+		defer span.End()
+
+		sw := &statusWriter{ResponseWriter: w, code: http.StatusOK}
+		h.ServeHTTP(sw, req.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPResponseStatusCodeKey.Int(sw.code))
+		if sw.code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(sw.code))
+		}
+	})
+}
+
+// statusWriter remembers the status code written through it.
+type statusWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.code = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Flush passes through to the underlying ResponseWriter if it can flush.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -32,6 +32,18 @@ func valid(vcs string) bool {
 	return ok
 }
 
+// Values for Package.Landing. The empty string defers to the server default.
+const (
+	landingPage     = "page"
+	landingRedirect = "redirect"
+)
+
+var landings = map[string]bool{
+	"":              true,
+	landingPage:     true,
+	landingRedirect: true,
+}
+
 // Package stores the three pieces of information needed to create the meta
 // tag. Two of these (Vcs and Repo) are stored explicitly, and the third is
 // determined implicitly by the path POSTed to. For more information refer to
@@ -44,6 +56,14 @@ type Package struct {
 	// Repo: the remote repository url
 	Repo string `json:"repo"`
 
+	// Landing: what browsers visiting the path get; "page" for an html
+	// landing page, "redirect" to be sent to the documentation, or "" for the
+	// server default
+	Landing string `json:"landing,omitempty"`
+	// Docs: optional documentation url; defaults to the server's docs base
+	// followed by the import path
+	Docs string `json:"docs,omitempty"`
+
 	Path string    `json:"path"`
 	Ns   namespace `json:"-"`
 }
@@ -55,6 +75,53 @@ type User struct {
 	token      Token
 	Registered bool
 	Requested  time.Time
+	// Disabled users' tokens are refused.
+	Disabled bool
+}
+
+// AuditEntry records a change made by a user.
+type AuditEntry struct {
+	Time   time.Time `json:"time"`
+	Email  Email     `json:"email"`
+	Action string    `json:"action"`
+	Path   string    `json:"path,omitempty"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// Revision records a package as a change left it. Revisions of a path are
+// numbered from 1; for deletes Package is what was removed.
+type Revision struct {
+	Number  int       `json:"revision"`
+	Time    time.Time `json:"time"`
+	Email   Email     `json:"email"`
+	Action  string    `json:"action"`
+	Package Package   `json:"package"`
+}
+
+// An Edit says who changed a package, and when, for its history. Email is
+// empty for changes made with vaind's offline commands. Action, if set, is
+// recorded instead of the kind of change, e.g. "rollback". A zero Time is the
+// time the change is stored.
+type Edit struct {
+	Time   time.Time
+	Email  Email
+	Action string
+}
+
+// now returns ed with Time set to now if it was zero.
+func (ed Edit) now() Edit {
+	if ed.Time.IsZero() {
+		ed.Time = time.Now()
+	}
+	return ed
+}
+
+// revision returns the Revision recording ed's change of p by action.
+func (ed Edit) revision(action string, p Package) Revision {
+	if ed.Action != "" {
+		action = ed.Action
+	}
+	return Revision{Time: ed.now().Time, Email: ed.Email, Action: action, Package: p}
 }
 
 func (p Package) String() string {
@@ -99,6 +166,19 @@ func parseNamespace(path string) (namespace, error) {
 	return namespace(elems[0]), nil
 }
 
+// pkgNS returns the namespace of p, deriving it from the path (host/ns/...)
+// if it is not set.
+func pkgNS(p Package) namespace {
+	if p.Ns != "" {
+		return p.Ns
+	}
+	elems := strings.Split(p.Path, "/")
+	if len(elems) < 2 {
+		return ""
+	}
+	return namespace(elems[1])
+}
+
 // FreshToken returns a random token string.
 func FreshToken() Token {
 	buf := &bytes.Buffer{}
@@ -0,0 +1,413 @@
+package vain
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	verrors "mcquay.me/vain/errors"
+)
+
+// Values for Event.Action.
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+// deliveryEntries is how many deliveries are returned when listing a hook's
+// delivery log.
+const deliveryEntries = 50
+
+// Event describes a change to a package. It is POSTed as json to webhooks
+// and streamed to event subscribers.
+type Event struct {
+	ID        string    `json:"id"`
+	Time      time.Time `json:"time"`
+	Action    string    `json:"action"`
+	Path      string    `json:"path"`
+	Namespace namespace `json:"namespace"`
+	Package   *Package  `json:"package,omitempty"`
+
+	seq uint64
+}
+
+// Hook is a url that is sent events for packages in Namespace, or for all
+// packages if Namespace is empty. Requests carry an X-Vain-Signature header
+// with the hex encoded HMAC-SHA256 of the body keyed by Secret.
+type Hook struct {
+	ID        string    `json:"id"`
+	Namespace namespace `json:"namespace,omitempty"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret,omitempty"`
+	// Owner is the user that added the hook, or empty if added by an
+	// admin.
+	Owner   Email     `json:"owner,omitempty"`
+	Created time.Time `json:"created"`
+}
+
+// Delivery records an attempt to send an event to a hook.
+type Delivery struct {
+	Hook     string        `json:"hook"`
+	Event    string        `json:"event"`
+	Action   string        `json:"action"`
+	Time     time.Time     `json:"time"`
+	Attempt  int           `json:"attempt"`
+	Status   int           `json:"status,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// public returns h without its secret.
+func (h Hook) public() Hook {
+	h.Secret = ""
+	return h
+}
+
+// public returns d without the details of a failure to connect, which can
+// describe the network vain runs on.
+func (d Delivery) public() Delivery {
+	if d.Status == 0 && d.Error != "" {
+		d.Error = "request failed"
+	}
+	return d
+}
+
+func (h Hook) wants(ev Event) bool {
+	return h.Namespace == "" || h.Namespace == ev.Namespace
+}
+
+func newID() string {
+	buf := make([]byte, 8)
+	io.ReadFull(rand.Reader, buf)
+	return hex.EncodeToString(buf)
+}
+
+// webURL reports whether u is an absolute http or https url.
+func webURL(u string) bool {
+	pu, err := url.Parse(u)
+	return err == nil && (pu.Scheme == "http" || pu.Scheme == "https") && pu.Host != ""
+}
+
+// publicIP reports whether ip is routable on the internet, rather than a
+// loopback, link-local, private or otherwise local address.
+func publicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast())
+}
+
+// publicURL returns an error unless every address the host of u resolves to
+// is public.
+func publicURL(u string) error {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, pu.Hostname())
+	if err != nil {
+		return err
+	}
+	for _, ip := range ips {
+		if !publicIP(ip.IP) {
+			return fmt.Errorf("%s is not a public address", ip.IP)
+		}
+	}
+	return nil
+}
+
+// publicOnly is a net.Dialer Control that refuses to connect to addresses
+// that aren't public. It sees the address after resolution, so a name that
+// resolved to a public address when its hook was added can't be pointed
+// somewhere else later.
+func publicOnly(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+		return fmt.Errorf("%s is not a public address", host)
+	}
+	return nil
+}
+
+// sign returns the value of the X-Vain-Signature header for body.
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// notifier delivers events to webhooks in the background.
+type notifier struct {
+	db Storer
+	// client delivers to hooks added by admins, and public to those added
+	// by users, which may only reach public addresses unless private is
+	// set.
+	client  *http.Client
+	public  *http.Client
+	private bool
+	// backoff is how long to wait before each retry; a delivery is
+	// attempted len(backoff)+1 times.
+	backoff []time.Duration
+
+	wg sync.WaitGroup
+}
+
+func newNotifier(db Storer) *notifier {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, Control: publicOnly}
+	return &notifier{
+		db:     db,
+		client: &http.Client{Timeout: 10 * time.Second},
+		public: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{DialContext: dialer.DialContext},
+		},
+		backoff: []time.Duration{time.Second, 10 * time.Second, time.Minute},
+	}
+}
+
+// publish sends ev to each hook interested in it.
+func (n *notifier) publish(ev Event) {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		log.Printf("problem encoding event %s: %v", ev.ID, err)
+		return
+	}
+	for _, h := range n.db.Hooks() {
+		if !h.wants(ev) {
+			continue
+		}
+		n.wg.Add(1)
+		go func(h Hook) {
+			defer n.wg.Done()
+			n.deliver(h, ev, body)
+		}(h)
+	}
+}
+
+// deliver POSTs body to h, retrying until it is accepted with a 2xx or the
+// attempts run out. Every attempt is recorded.
+func (n *notifier) deliver(h Hook, ev Event, body []byte) {
+	for attempt := 1; attempt <= len(n.backoff)+1; attempt++ {
+		if attempt > 1 {
+			time.Sleep(n.backoff[attempt-2])
+		}
+		d := Delivery{
+			Hook:    h.ID,
+			Event:   ev.ID,
+			Action:  ev.Action,
+			Time:    time.Now(),
+			Attempt: attempt,
+		}
+		err := n.post(h, ev, body, &d)
+		d.Duration = time.Since(d.Time)
+		if err != nil {
+			d.Error = err.Error()
+		}
+		if err := n.db.RecordDelivery(d); err != nil {
+			// most likely the hook has been removed
+			log.Printf("problem recording delivery to hook %s: %v", h.ID, err)
+			return
+		}
+		if err == nil {
+			return
+		}
+	}
+}
+
+func (n *notifier) post(h Hook, ev Event, body []byte, d *Delivery) error {
+	req, err := http.NewRequest("POST", h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "vain-webhook")
+	req.Header.Set("X-Vain-Event", ev.Action)
+	req.Header.Set("X-Vain-Delivery", ev.ID)
+	req.Header.Set("X-Vain-Signature", sign(h.Secret, body))
+	c := n.client
+	if h.Owner != "" && !n.private {
+		c = n.public
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	resp.Body.Close()
+	d.Status = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+// newHook validates and stores a hook for ns (or every namespace if empty)
+// on behalf of owner. Unless private is set, u must resolve to public
+// addresses.
+func newHook(db Storer, owner Email, ns namespace, u, secret string, private bool) (Hook, error) {
+	h := Hook{
+		ID:        newID(),
+		Namespace: ns,
+		URL:       u,
+		Secret:    secret,
+		Owner:     owner,
+		Created:   time.Now(),
+	}
+	if !webURL(u) {
+		return h, verrors.HTTP{
+			Message: fmt.Sprintf("invalid hook url %q", u),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if !private {
+		if err := publicURL(u); err != nil {
+			return h, verrors.HTTP{
+				Message: fmt.Sprintf("invalid hook url %q: %v", u, err),
+				Code:    http.StatusBadRequest,
+			}
+		}
+	}
+	if secret == "" {
+		return h, verrors.HTTP{
+			Message: "must provide a secret",
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if err := db.AddHook(h); err != nil {
+		return h, verrors.HTTP{
+			Message: fmt.Sprintf("unable to add hook: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	return h, nil
+}
+
+// bearer returns the api token carried by req, if any.
+func bearer(req *http.Request) Token {
+	const prefix = "Bearer "
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, prefix) {
+		return ""
+	}
+	return Token(strings.TrimPrefix(auth, prefix))
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// hooksAPI manages the webhooks of the user owning the bearer token:
+//
+//	GET    hooks/                list hooks
+//	POST   hooks/                add a hook: {"namespace", "url", "secret"}
+//	DELETE hooks/{id}            remove a hook
+//	GET    hooks/{id}/deliveries recent delivery attempts
+func (s *Server) hooksAPI(w http.ResponseWriter, req *http.Request) {
+	db := s.store(req.Context())
+	tok := bearer(req)
+	if tok == "" {
+		http.Error(w, "missing token", http.StatusUnauthorized)
+		return
+	}
+	e, err := db.UserForToken(tok)
+	if err := verrors.ToHTTP(err); err != nil {
+		http.Error(w, err.Message, err.Code)
+		return
+	}
+
+	owned := map[string]Hook{}
+	for _, h := range db.Hooks() {
+		if h.Owner == e {
+			owned[h.ID] = h
+		}
+	}
+
+	rest := strings.Trim(strings.TrimPrefix(req.URL.Path, prefix["hooks"]), "/")
+	parts := strings.Split(rest, "/")
+	switch {
+	case rest == "" && req.Method == "GET":
+		hs := []Hook{}
+		for _, h := range db.Hooks() {
+			if h.Owner == e {
+				hs = append(hs, h.public())
+			}
+		}
+		writeJSON(w, hs)
+	case rest == "" && req.Method == "POST":
+		if s.refuse(w) {
+			return
+		}
+		in := struct {
+			Namespace namespace `json:"namespace"`
+			URL       string    `json:"url"`
+			Secret    string    `json:"secret"`
+		}{}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			http.Error(w, fmt.Sprintf("unable to parse json from body: %v", err), http.StatusBadRequest)
+			return
+		}
+		mine := false
+		for _, ns := range db.UserNamespaces(e) {
+			mine = mine || ns == in.Namespace
+		}
+		if !mine {
+			http.Error(w, fmt.Sprintf("not authorized against namespace %q", in.Namespace), http.StatusUnauthorized)
+			return
+		}
+		h, err := newHook(db, e, in.Namespace, in.URL, in.Secret, s.hooks.private)
+		if err := verrors.ToHTTP(err); err != nil {
+			http.Error(w, err.Message, err.Code)
+			return
+		}
+		audit(db, e, "hook-add", "", fmt.Sprintf("%s %s", h.Namespace, h.URL))
+		writeJSON(w, h.public())
+	case len(parts) == 1 && req.Method == "DELETE":
+		if s.refuse(w) {
+			return
+		}
+		h, ok := owned[parts[0]]
+		if !ok {
+			http.Error(w, fmt.Sprintf("hook %q not found", parts[0]), http.StatusNotFound)
+			return
+		}
+		if err := verrors.ToHTTP(db.RemoveHook(h.ID)); err != nil {
+			http.Error(w, err.Message, err.Code)
+			return
+		}
+		audit(db, e, "hook-remove", "", fmt.Sprintf("%s %s", h.Namespace, h.URL))
+	case len(parts) == 2 && parts[1] == "deliveries" && req.Method == "GET":
+		h, ok := owned[parts[0]]
+		if !ok {
+			http.Error(w, fmt.Sprintf("hook %q not found", parts[0]), http.StatusNotFound)
+			return
+		}
+		ds := []Delivery{}
+		for _, d := range db.Deliveries(h.ID, deliveryEntries) {
+			ds = append(ds, d.public())
+		}
+		writeJSON(w, ds)
+	case len(parts) > 2 || (len(parts) == 2 && parts[1] != "deliveries"):
+		http.NotFound(w, req)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported method %q", req.Method), http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,265 @@
+package vain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// receiver is a webhook endpoint that records what it is sent, failing the
+// first fail requests.
+type receiver struct {
+	sync.Mutex
+	secret string
+	fail   int
+	events []Event
+	bad    []string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+
+	body, _ := ioutil.ReadAll(req.Body)
+	if got, want := req.Header.Get("X-Vain-Signature"), sign(r.secret, body); got != want {
+		r.bad = append(r.bad, fmt.Sprintf("bad signature %q, want %q", got, want))
+	}
+	if r.fail > 0 {
+		r.fail--
+		http.Error(w, "not now", http.StatusInternalServerError)
+		return
+	}
+	ev := Event{}
+	if err := json.Unmarshal(body, &ev); err != nil {
+		r.bad = append(r.bad, fmt.Sprintf("bad body: %v", err))
+	}
+	if got, want := req.Header.Get("X-Vain-Event"), ev.Action; got != want {
+		r.bad = append(r.bad, fmt.Sprintf("bad event header %q, want %q", got, want))
+	}
+	r.events = append(r.events, ev)
+}
+
+func hookReq(t *testing.T, method, u string, tok Token, body string) *http.Response {
+	req, err := http.NewRequest(method, u, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("couldn't create request: %v", err)
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("couldn't %s %s: %v", method, u, err)
+	}
+	return resp
+}
+
+func TestWebhooks(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	s := NewServer(sm, db, nil, "", window, true)
+	s.hooks.backoff = []time.Duration{0, 0}
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	host := strings.TrimPrefix(ts.URL, "http://")
+
+	rcv := &receiver{secret: "s3kr1t", fail: 2}
+	rs := httptest.NewServer(rcv)
+	defer rs.Close()
+
+	tok, err := db.addUser("sm@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	other, err := db.addUser("other@example.org")
+	if err != nil {
+		t.Fatalf("failure to add user: %v", err)
+	}
+	resp := hookReq(t, "POST", ts.URL+"/foo/first", tok, `{"repo": "https://example.org/first"}`)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("couldn't add package; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+
+	hook := fmt.Sprintf(`{"namespace": "foo", "url": %q, "secret": %q}`, rs.URL, rcv.secret)
+	resp = hookReq(t, "POST", ts.URL+prefix["hooks"], other, hook)
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusUnauthorized; got != want {
+		t.Fatalf("added hook to someone else's namespace; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	for _, bad := range []string{
+		fmt.Sprintf(`{"namespace": "foo", "url": "ftp://example.org", "secret": "x"}`),
+		fmt.Sprintf(`{"namespace": "foo", "url": %q}`, rs.URL),
+		// the receiver is on loopback
+		fmt.Sprintf(`{"namespace": "foo", "url": %q, "secret": "x"}`, rs.URL),
+		`{"namespace": "foo", "url": "http://169.254.169.254/latest/meta-data", "secret": "x"}`,
+	} {
+		resp = hookReq(t, "POST", ts.URL+prefix["hooks"], tok, bad)
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+			t.Fatalf("%s: got %s, want %s", bad, http.StatusText(got), http.StatusText(want))
+		}
+	}
+
+	s.hooks.private = true
+	resp = hookReq(t, "POST", ts.URL+prefix["hooks"], tok, hook)
+	h := Hook{}
+	if err := json.NewDecoder(resp.Body).Decode(&h); err != nil {
+		t.Fatalf("couldn't decode hook: %v", err)
+	}
+	resp.Body.Close()
+	if h.ID == "" || h.Secret != "" {
+		t.Fatalf("bad hook returned: %+v", h)
+	}
+
+	// events for other namespaces are not delivered
+	resp = hookReq(t, "POST", ts.URL+"/bar/baz", other, `{"repo": "https://example.org/baz"}`)
+	resp.Body.Close()
+
+	resp = hookReq(t, "POST", ts.URL+"/foo/second", tok, `{"repo": "https://example.org/second"}`)
+	resp.Body.Close()
+	s.hooks.wg.Wait()
+	p := Package{Path: host + "/foo/second", Repo: "https://example.org/moved"}
+	if err := updatePackage(db, "sm@example.org", p); err != nil {
+		t.Fatalf("couldn't update package: %v", err)
+	}
+	s.hooks.wg.Wait()
+	resp = hookReq(t, "DELETE", ts.URL+"/foo/second", tok, "")
+	resp.Body.Close()
+	s.hooks.wg.Wait()
+
+	rcv.Lock()
+	if len(rcv.bad) > 0 {
+		t.Errorf("receiver problems: %v", rcv.bad)
+	}
+	got := []string{}
+	for _, ev := range rcv.events {
+		got = append(got, fmt.Sprintf("%s %s", ev.Action, ev.Path))
+	}
+	rcv.Unlock()
+	want := []string{
+		"create " + host + "/foo/second",
+		"update " + host + "/foo/second",
+		"delete " + host + "/foo/second",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("bad events; got %v, want %v", got, want)
+	}
+
+	resp = hookReq(t, "GET", ts.URL+prefix["hooks"]+h.ID+"/deliveries", tok, "")
+	ds := []Delivery{}
+	if err := json.NewDecoder(resp.Body).Decode(&ds); err != nil {
+		t.Fatalf("couldn't decode deliveries: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := len(ds), 5; got != want {
+		t.Fatalf("bad number of deliveries; got %d, want %d: %+v", got, want, ds)
+	}
+	// the create was retried twice before succeeding
+	first := ds[len(ds)-3:]
+	for i, d := range []Delivery{
+		{Attempt: 3, Status: http.StatusOK},
+		{Attempt: 2, Status: http.StatusInternalServerError},
+		{Attempt: 1, Status: http.StatusInternalServerError},
+	} {
+		if first[i].Attempt != d.Attempt || first[i].Status != d.Status || first[i].Action != actionCreate {
+			t.Errorf("delivery %d; got %+v, want attempt %d status %d", i, first[i], d.Attempt, d.Status)
+		}
+	}
+
+	resp = hookReq(t, "GET", ts.URL+prefix["hooks"]+h.ID+"/deliveries", other, "")
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Fatalf("other user saw deliveries; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+
+	// private addresses are refused when delivering too, without saying
+	// why to the hook's owner
+	s.hooks.private = false
+	resp = hookReq(t, "POST", ts.URL+"/foo/third", tok, `{"repo": "https://example.org/third"}`)
+	resp.Body.Close()
+	s.hooks.wg.Wait()
+	rcv.Lock()
+	if got, want := len(rcv.events), 3; got != want {
+		t.Errorf("delivered to loopback; got %d events, want %d", got, want)
+	}
+	rcv.Unlock()
+	resp = hookReq(t, "GET", ts.URL+prefix["hooks"]+h.ID+"/deliveries", tok, "")
+	ds = []Delivery{}
+	json.NewDecoder(resp.Body).Decode(&ds)
+	resp.Body.Close()
+	if len(ds) != 8 || ds[0].Status != 0 || ds[0].Error != "request failed" {
+		t.Fatalf("refused delivery; got %+v", ds)
+	}
+	if d := db.Deliveries(h.ID, 1); len(d) != 1 || !strings.Contains(d[0].Error, "not a public address") {
+		t.Fatalf("refused delivery log; got %+v", d)
+	}
+
+	resp = hookReq(t, "DELETE", ts.URL+prefix["hooks"]+h.ID, tok, "")
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("couldn't delete hook; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+	if got, want := len(db.Hooks()), 0; got != want {
+		t.Fatalf("hook not removed; got %d hooks, want %d", got, want)
+	}
+}
+
+func TestAdminWebhooks(t *testing.T) {
+	db, done := TestDB(t)
+	if db == nil {
+		t.Fatalf("could not create temp db")
+	}
+	defer done()
+
+	sm := http.NewServeMux()
+	s := NewServer(sm, db, nil, "", window, true)
+	ts := httptest.NewServer(sm)
+	defer ts.Close()
+	am := http.NewServeMux()
+	NewAdmin(am, s, nil)
+	as := httptest.NewServer(am)
+	defer as.Close()
+
+	rcv := &receiver{secret: "admin"}
+	rs := httptest.NewServer(rcv)
+	defer rs.Close()
+
+	resp, err := http.PostForm(as.URL+"/debug/hooks", url.Values{"url": {rs.URL}, "secret": {rcv.secret}})
+	if err != nil {
+		t.Fatalf("couldn't add hook: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("couldn't add hook; got %s, want %s", http.StatusText(got), http.StatusText(want))
+	}
+
+	for i, e := range []Email{"a@example.org", "b@example.org"} {
+		tok, err := db.addUser(e)
+		if err != nil {
+			t.Fatalf("failure to add user: %v", err)
+		}
+		resp := hookReq(t, "POST", fmt.Sprintf("%s/ns%d/pkg", ts.URL, i), tok, `{"repo": "https://example.org/pkg"}`)
+		resp.Body.Close()
+	}
+	s.hooks.wg.Wait()
+
+	rcv.Lock()
+	defer rcv.Unlock()
+	if len(rcv.bad) > 0 {
+		t.Errorf("receiver problems: %v", rcv.bad)
+	}
+	if got, want := len(rcv.events), 2; got != want {
+		t.Fatalf("admin hook should see every namespace; got %d events, want %d", got, want)
+	}
+}